@@ -2257,37 +2257,4238 @@ func (x *_GenesisState_6_list) IsValid() bool {
 	return x.list != nil
 }
 
+var _ protoreflect.List = (*_GenesisState_7_list)(nil)
+
+type _GenesisState_7_list struct {
+	list *[]*PendingNAVProposal
+}
+
+func (x *_GenesisState_7_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_GenesisState_7_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_GenesisState_7_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*PendingNAVProposal)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_GenesisState_7_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*PendingNAVProposal)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_GenesisState_7_list) AppendMutable() protoreflect.Value {
+	v := new(PendingNAVProposal)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_7_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_GenesisState_7_list) NewElement() protoreflect.Value {
+	v := new(PendingNAVProposal)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_7_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_GenesisState_8_list)(nil)
+
+type _GenesisState_8_list struct {
+	list *[]*NAVSourceSubmission
+}
+
+func (x *_GenesisState_8_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_GenesisState_8_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_GenesisState_8_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*NAVSourceSubmission)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_GenesisState_8_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*NAVSourceSubmission)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_GenesisState_8_list) AppendMutable() protoreflect.Value {
+	v := new(NAVSourceSubmission)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_8_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_GenesisState_8_list) NewElement() protoreflect.Value {
+	v := new(NAVSourceSubmission)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_8_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_GenesisState_9_list)(nil)
+
+type _GenesisState_9_list struct {
+	list *[]*VaultNAVEntry
+}
+
+func (x *_GenesisState_9_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_GenesisState_9_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_GenesisState_9_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*VaultNAVEntry)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_GenesisState_9_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*VaultNAVEntry)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_GenesisState_9_list) AppendMutable() protoreflect.Value {
+	v := new(VaultNAVEntry)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_9_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_GenesisState_9_list) NewElement() protoreflect.Value {
+	v := new(VaultNAVEntry)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_9_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_GenesisState_10_list)(nil)
+
+type _GenesisState_10_list struct {
+	list *[]*VaultNAVEntry
+}
+
+func (x *_GenesisState_10_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_GenesisState_10_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_GenesisState_10_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*VaultNAVEntry)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_GenesisState_10_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*VaultNAVEntry)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_GenesisState_10_list) AppendMutable() protoreflect.Value {
+	v := new(VaultNAVEntry)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_10_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_GenesisState_10_list) NewElement() protoreflect.Value {
+	v := new(VaultNAVEntry)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_10_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_GenesisState_11_list)(nil)
+
+type _GenesisState_11_list struct {
+	list *[]*AssetHaircutEntry
+}
+
+func (x *_GenesisState_11_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_GenesisState_11_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_GenesisState_11_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*AssetHaircutEntry)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_GenesisState_11_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*AssetHaircutEntry)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_GenesisState_11_list) AppendMutable() protoreflect.Value {
+	v := new(AssetHaircutEntry)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_11_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_GenesisState_11_list) NewElement() protoreflect.Value {
+	v := new(AssetHaircutEntry)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_11_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_GenesisState_12_list)(nil)
+
+type _GenesisState_12_list struct {
+	list *[]*SharePriceObservationEntry
+}
+
+func (x *_GenesisState_12_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_GenesisState_12_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_GenesisState_12_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*SharePriceObservationEntry)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_GenesisState_12_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*SharePriceObservationEntry)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_GenesisState_12_list) AppendMutable() protoreflect.Value {
+	v := new(SharePriceObservationEntry)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_12_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_GenesisState_12_list) NewElement() protoreflect.Value {
+	v := new(SharePriceObservationEntry)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_12_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_GenesisState_13_list)(nil)
+
+type _GenesisState_13_list struct {
+	list *[]*OutboundPaymentEntry
+}
+
+func (x *_GenesisState_13_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_GenesisState_13_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_GenesisState_13_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*OutboundPaymentEntry)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_GenesisState_13_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*OutboundPaymentEntry)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_GenesisState_13_list) AppendMutable() protoreflect.Value {
+	v := new(OutboundPaymentEntry)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_13_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_GenesisState_13_list) NewElement() protoreflect.Value {
+	v := new(OutboundPaymentEntry)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_13_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_GenesisState_15_list)(nil)
+
+type _GenesisState_15_list struct {
+	list *[]*AllowlistEntry
+}
+
+func (x *_GenesisState_15_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_GenesisState_15_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_GenesisState_15_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*AllowlistEntry)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_GenesisState_15_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*AllowlistEntry)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_GenesisState_15_list) AppendMutable() protoreflect.Value {
+	v := new(AllowlistEntry)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_15_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_GenesisState_15_list) NewElement() protoreflect.Value {
+	v := new(AllowlistEntry)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_15_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_GenesisState_16_list)(nil)
+
+type _GenesisState_16_list struct {
+	list *[]*VaultNAVEntry
+}
+
+func (x *_GenesisState_16_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_GenesisState_16_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_GenesisState_16_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*VaultNAVEntry)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_GenesisState_16_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*VaultNAVEntry)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_GenesisState_16_list) AppendMutable() protoreflect.Value {
+	v := new(VaultNAVEntry)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_16_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_GenesisState_16_list) NewElement() protoreflect.Value {
+	v := new(VaultNAVEntry)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_16_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_GenesisState                          protoreflect.MessageDescriptor
+	fd_GenesisState_vaults                   protoreflect.FieldDescriptor
+	fd_GenesisState_payout_timeout_queue     protoreflect.FieldDescriptor
+	fd_GenesisState_pending_swap_out_queue   protoreflect.FieldDescriptor
+	fd_GenesisState_fee_timeout_queue        protoreflect.FieldDescriptor
+	fd_GenesisState_params                   protoreflect.FieldDescriptor
+	fd_GenesisState_navs                     protoreflect.FieldDescriptor
+	fd_GenesisState_pending_nav_proposals    protoreflect.FieldDescriptor
+	fd_GenesisState_nav_source_submissions   protoreflect.FieldDescriptor
+	fd_GenesisState_nav_history              protoreflect.FieldDescriptor
+	fd_GenesisState_settlement_prices        protoreflect.FieldDescriptor
+	fd_GenesisState_asset_haircuts           protoreflect.FieldDescriptor
+	fd_GenesisState_share_price_observations protoreflect.FieldDescriptor
+	fd_GenesisState_outbound_payments        protoreflect.FieldDescriptor
+	fd_GenesisState_scheduled_action_queue   protoreflect.FieldDescriptor
+	fd_GenesisState_allowlist                protoreflect.FieldDescriptor
+	fd_GenesisState_nav_references           protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_provlabs_vault_v1_genesis_proto_init()
+	md_GenesisState = File_provlabs_vault_v1_genesis_proto.Messages().ByName("GenesisState")
+	fd_GenesisState_vaults = md_GenesisState.Fields().ByName("vaults")
+	fd_GenesisState_payout_timeout_queue = md_GenesisState.Fields().ByName("payout_timeout_queue")
+	fd_GenesisState_pending_swap_out_queue = md_GenesisState.Fields().ByName("pending_swap_out_queue")
+	fd_GenesisState_fee_timeout_queue = md_GenesisState.Fields().ByName("fee_timeout_queue")
+	fd_GenesisState_params = md_GenesisState.Fields().ByName("params")
+	fd_GenesisState_navs = md_GenesisState.Fields().ByName("navs")
+	fd_GenesisState_pending_nav_proposals = md_GenesisState.Fields().ByName("pending_nav_proposals")
+	fd_GenesisState_nav_source_submissions = md_GenesisState.Fields().ByName("nav_source_submissions")
+	fd_GenesisState_nav_history = md_GenesisState.Fields().ByName("nav_history")
+	fd_GenesisState_settlement_prices = md_GenesisState.Fields().ByName("settlement_prices")
+	fd_GenesisState_asset_haircuts = md_GenesisState.Fields().ByName("asset_haircuts")
+	fd_GenesisState_share_price_observations = md_GenesisState.Fields().ByName("share_price_observations")
+	fd_GenesisState_outbound_payments = md_GenesisState.Fields().ByName("outbound_payments")
+	fd_GenesisState_scheduled_action_queue = md_GenesisState.Fields().ByName("scheduled_action_queue")
+	fd_GenesisState_allowlist = md_GenesisState.Fields().ByName("allowlist")
+	fd_GenesisState_nav_references = md_GenesisState.Fields().ByName("nav_references")
+}
+
+var _ protoreflect.Message = (*fastReflection_GenesisState)(nil)
+
+type fastReflection_GenesisState GenesisState
+
+func (x *GenesisState) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_GenesisState)(x)
+}
+
+func (x *GenesisState) slowProtoReflect() protoreflect.Message {
+	mi := &file_provlabs_vault_v1_genesis_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_GenesisState_messageType fastReflection_GenesisState_messageType
+var _ protoreflect.MessageType = fastReflection_GenesisState_messageType{}
+
+type fastReflection_GenesisState_messageType struct{}
+
+func (x fastReflection_GenesisState_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_GenesisState)(nil)
+}
+func (x fastReflection_GenesisState_messageType) New() protoreflect.Message {
+	return new(fastReflection_GenesisState)
+}
+func (x fastReflection_GenesisState_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_GenesisState
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_GenesisState) Descriptor() protoreflect.MessageDescriptor {
+	return md_GenesisState
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_GenesisState) Type() protoreflect.MessageType {
+	return _fastReflection_GenesisState_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_GenesisState) New() protoreflect.Message {
+	return new(fastReflection_GenesisState)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_GenesisState) Interface() protoreflect.ProtoMessage {
+	return (*GenesisState)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_GenesisState) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if len(x.Vaults) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_1_list{list: &x.Vaults})
+		if !f(fd_GenesisState_vaults, value) {
+			return
+		}
+	}
+	if len(x.PayoutTimeoutQueue) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_2_list{list: &x.PayoutTimeoutQueue})
+		if !f(fd_GenesisState_payout_timeout_queue, value) {
+			return
+		}
+	}
+	if x.PendingSwapOutQueue != nil {
+		value := protoreflect.ValueOfMessage(x.PendingSwapOutQueue.ProtoReflect())
+		if !f(fd_GenesisState_pending_swap_out_queue, value) {
+			return
+		}
+	}
+	if len(x.FeeTimeoutQueue) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_4_list{list: &x.FeeTimeoutQueue})
+		if !f(fd_GenesisState_fee_timeout_queue, value) {
+			return
+		}
+	}
+	if x.Params != nil {
+		value := protoreflect.ValueOfMessage(x.Params.ProtoReflect())
+		if !f(fd_GenesisState_params, value) {
+			return
+		}
+	}
+	if len(x.Navs) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_6_list{list: &x.Navs})
+		if !f(fd_GenesisState_navs, value) {
+			return
+		}
+	}
+	if len(x.PendingNavProposals) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_7_list{list: &x.PendingNavProposals})
+		if !f(fd_GenesisState_pending_nav_proposals, value) {
+			return
+		}
+	}
+	if len(x.NavSourceSubmissions) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_8_list{list: &x.NavSourceSubmissions})
+		if !f(fd_GenesisState_nav_source_submissions, value) {
+			return
+		}
+	}
+	if len(x.NavHistory) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_9_list{list: &x.NavHistory})
+		if !f(fd_GenesisState_nav_history, value) {
+			return
+		}
+	}
+	if len(x.SettlementPrices) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_10_list{list: &x.SettlementPrices})
+		if !f(fd_GenesisState_settlement_prices, value) {
+			return
+		}
+	}
+	if len(x.AssetHaircuts) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_11_list{list: &x.AssetHaircuts})
+		if !f(fd_GenesisState_asset_haircuts, value) {
+			return
+		}
+	}
+	if len(x.SharePriceObservations) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_12_list{list: &x.SharePriceObservations})
+		if !f(fd_GenesisState_share_price_observations, value) {
+			return
+		}
+	}
+	if len(x.OutboundPayments) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_13_list{list: &x.OutboundPayments})
+		if !f(fd_GenesisState_outbound_payments, value) {
+			return
+		}
+	}
+	if x.ScheduledActionQueue != nil {
+		value := protoreflect.ValueOfMessage(x.ScheduledActionQueue.ProtoReflect())
+		if !f(fd_GenesisState_scheduled_action_queue, value) {
+			return
+		}
+	}
+	if len(x.Allowlist) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_15_list{list: &x.Allowlist})
+		if !f(fd_GenesisState_allowlist, value) {
+			return
+		}
+	}
+	if len(x.NavReferences) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_16_list{list: &x.NavReferences})
+		if !f(fd_GenesisState_nav_references, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_GenesisState) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "provlabs.vault.v1.GenesisState.vaults":
+		return len(x.Vaults) != 0
+	case "provlabs.vault.v1.GenesisState.payout_timeout_queue":
+		return len(x.PayoutTimeoutQueue) != 0
+	case "provlabs.vault.v1.GenesisState.pending_swap_out_queue":
+		return x.PendingSwapOutQueue != nil
+	case "provlabs.vault.v1.GenesisState.fee_timeout_queue":
+		return len(x.FeeTimeoutQueue) != 0
+	case "provlabs.vault.v1.GenesisState.params":
+		return x.Params != nil
+	case "provlabs.vault.v1.GenesisState.navs":
+		return len(x.Navs) != 0
+	case "provlabs.vault.v1.GenesisState.pending_nav_proposals":
+		return len(x.PendingNavProposals) != 0
+	case "provlabs.vault.v1.GenesisState.nav_source_submissions":
+		return len(x.NavSourceSubmissions) != 0
+	case "provlabs.vault.v1.GenesisState.nav_history":
+		return len(x.NavHistory) != 0
+	case "provlabs.vault.v1.GenesisState.settlement_prices":
+		return len(x.SettlementPrices) != 0
+	case "provlabs.vault.v1.GenesisState.asset_haircuts":
+		return len(x.AssetHaircuts) != 0
+	case "provlabs.vault.v1.GenesisState.share_price_observations":
+		return len(x.SharePriceObservations) != 0
+	case "provlabs.vault.v1.GenesisState.outbound_payments":
+		return len(x.OutboundPayments) != 0
+	case "provlabs.vault.v1.GenesisState.scheduled_action_queue":
+		return x.ScheduledActionQueue != nil
+	case "provlabs.vault.v1.GenesisState.allowlist":
+		return len(x.Allowlist) != 0
+	case "provlabs.vault.v1.GenesisState.nav_references":
+		return len(x.NavReferences) != 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: provlabs.vault.v1.GenesisState"))
+		}
+		panic(fmt.Errorf("message provlabs.vault.v1.GenesisState does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_GenesisState) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "provlabs.vault.v1.GenesisState.vaults":
+		x.Vaults = nil
+	case "provlabs.vault.v1.GenesisState.payout_timeout_queue":
+		x.PayoutTimeoutQueue = nil
+	case "provlabs.vault.v1.GenesisState.pending_swap_out_queue":
+		x.PendingSwapOutQueue = nil
+	case "provlabs.vault.v1.GenesisState.fee_timeout_queue":
+		x.FeeTimeoutQueue = nil
+	case "provlabs.vault.v1.GenesisState.params":
+		x.Params = nil
+	case "provlabs.vault.v1.GenesisState.navs":
+		x.Navs = nil
+	case "provlabs.vault.v1.GenesisState.pending_nav_proposals":
+		x.PendingNavProposals = nil
+	case "provlabs.vault.v1.GenesisState.nav_source_submissions":
+		x.NavSourceSubmissions = nil
+	case "provlabs.vault.v1.GenesisState.nav_history":
+		x.NavHistory = nil
+	case "provlabs.vault.v1.GenesisState.settlement_prices":
+		x.SettlementPrices = nil
+	case "provlabs.vault.v1.GenesisState.asset_haircuts":
+		x.AssetHaircuts = nil
+	case "provlabs.vault.v1.GenesisState.share_price_observations":
+		x.SharePriceObservations = nil
+	case "provlabs.vault.v1.GenesisState.outbound_payments":
+		x.OutboundPayments = nil
+	case "provlabs.vault.v1.GenesisState.scheduled_action_queue":
+		x.ScheduledActionQueue = nil
+	case "provlabs.vault.v1.GenesisState.allowlist":
+		x.Allowlist = nil
+	case "provlabs.vault.v1.GenesisState.nav_references":
+		x.NavReferences = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: provlabs.vault.v1.GenesisState"))
+		}
+		panic(fmt.Errorf("message provlabs.vault.v1.GenesisState does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_GenesisState) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "provlabs.vault.v1.GenesisState.vaults":
+		if len(x.Vaults) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_1_list{})
+		}
+		listValue := &_GenesisState_1_list{list: &x.Vaults}
+		return protoreflect.ValueOfList(listValue)
+	case "provlabs.vault.v1.GenesisState.payout_timeout_queue":
+		if len(x.PayoutTimeoutQueue) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_2_list{})
+		}
+		listValue := &_GenesisState_2_list{list: &x.PayoutTimeoutQueue}
+		return protoreflect.ValueOfList(listValue)
+	case "provlabs.vault.v1.GenesisState.pending_swap_out_queue":
+		value := x.PendingSwapOutQueue
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "provlabs.vault.v1.GenesisState.fee_timeout_queue":
+		if len(x.FeeTimeoutQueue) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_4_list{})
+		}
+		listValue := &_GenesisState_4_list{list: &x.FeeTimeoutQueue}
+		return protoreflect.ValueOfList(listValue)
+	case "provlabs.vault.v1.GenesisState.params":
+		value := x.Params
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "provlabs.vault.v1.GenesisState.navs":
+		if len(x.Navs) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_6_list{})
+		}
+		listValue := &_GenesisState_6_list{list: &x.Navs}
+		return protoreflect.ValueOfList(listValue)
+	case "provlabs.vault.v1.GenesisState.pending_nav_proposals":
+		if len(x.PendingNavProposals) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_7_list{})
+		}
+		listValue := &_GenesisState_7_list{list: &x.PendingNavProposals}
+		return protoreflect.ValueOfList(listValue)
+	case "provlabs.vault.v1.GenesisState.nav_source_submissions":
+		if len(x.NavSourceSubmissions) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_8_list{})
+		}
+		listValue := &_GenesisState_8_list{list: &x.NavSourceSubmissions}
+		return protoreflect.ValueOfList(listValue)
+	case "provlabs.vault.v1.GenesisState.nav_history":
+		if len(x.NavHistory) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_9_list{})
+		}
+		listValue := &_GenesisState_9_list{list: &x.NavHistory}
+		return protoreflect.ValueOfList(listValue)
+	case "provlabs.vault.v1.GenesisState.settlement_prices":
+		if len(x.SettlementPrices) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_10_list{})
+		}
+		listValue := &_GenesisState_10_list{list: &x.SettlementPrices}
+		return protoreflect.ValueOfList(listValue)
+	case "provlabs.vault.v1.GenesisState.asset_haircuts":
+		if len(x.AssetHaircuts) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_11_list{})
+		}
+		listValue := &_GenesisState_11_list{list: &x.AssetHaircuts}
+		return protoreflect.ValueOfList(listValue)
+	case "provlabs.vault.v1.GenesisState.share_price_observations":
+		if len(x.SharePriceObservations) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_12_list{})
+		}
+		listValue := &_GenesisState_12_list{list: &x.SharePriceObservations}
+		return protoreflect.ValueOfList(listValue)
+	case "provlabs.vault.v1.GenesisState.outbound_payments":
+		if len(x.OutboundPayments) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_13_list{})
+		}
+		listValue := &_GenesisState_13_list{list: &x.OutboundPayments}
+		return protoreflect.ValueOfList(listValue)
+	case "provlabs.vault.v1.GenesisState.scheduled_action_queue":
+		value := x.ScheduledActionQueue
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "provlabs.vault.v1.GenesisState.allowlist":
+		if len(x.Allowlist) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_15_list{})
+		}
+		listValue := &_GenesisState_15_list{list: &x.Allowlist}
+		return protoreflect.ValueOfList(listValue)
+	case "provlabs.vault.v1.GenesisState.nav_references":
+		if len(x.NavReferences) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_16_list{})
+		}
+		listValue := &_GenesisState_16_list{list: &x.NavReferences}
+		return protoreflect.ValueOfList(listValue)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: provlabs.vault.v1.GenesisState"))
+		}
+		panic(fmt.Errorf("message provlabs.vault.v1.GenesisState does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_GenesisState) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "provlabs.vault.v1.GenesisState.vaults":
+		lv := value.List()
+		clv := lv.(*_GenesisState_1_list)
+		x.Vaults = *clv.list
+	case "provlabs.vault.v1.GenesisState.payout_timeout_queue":
+		lv := value.List()
+		clv := lv.(*_GenesisState_2_list)
+		x.PayoutTimeoutQueue = *clv.list
+	case "provlabs.vault.v1.GenesisState.pending_swap_out_queue":
+		x.PendingSwapOutQueue = value.Message().Interface().(*PendingSwapOutQueue)
+	case "provlabs.vault.v1.GenesisState.fee_timeout_queue":
+		lv := value.List()
+		clv := lv.(*_GenesisState_4_list)
+		x.FeeTimeoutQueue = *clv.list
+	case "provlabs.vault.v1.GenesisState.params":
+		x.Params = value.Message().Interface().(*Params)
+	case "provlabs.vault.v1.GenesisState.navs":
+		lv := value.List()
+		clv := lv.(*_GenesisState_6_list)
+		x.Navs = *clv.list
+	case "provlabs.vault.v1.GenesisState.pending_nav_proposals":
+		lv := value.List()
+		clv := lv.(*_GenesisState_7_list)
+		x.PendingNavProposals = *clv.list
+	case "provlabs.vault.v1.GenesisState.nav_source_submissions":
+		lv := value.List()
+		clv := lv.(*_GenesisState_8_list)
+		x.NavSourceSubmissions = *clv.list
+	case "provlabs.vault.v1.GenesisState.nav_history":
+		lv := value.List()
+		clv := lv.(*_GenesisState_9_list)
+		x.NavHistory = *clv.list
+	case "provlabs.vault.v1.GenesisState.settlement_prices":
+		lv := value.List()
+		clv := lv.(*_GenesisState_10_list)
+		x.SettlementPrices = *clv.list
+	case "provlabs.vault.v1.GenesisState.asset_haircuts":
+		lv := value.List()
+		clv := lv.(*_GenesisState_11_list)
+		x.AssetHaircuts = *clv.list
+	case "provlabs.vault.v1.GenesisState.share_price_observations":
+		lv := value.List()
+		clv := lv.(*_GenesisState_12_list)
+		x.SharePriceObservations = *clv.list
+	case "provlabs.vault.v1.GenesisState.outbound_payments":
+		lv := value.List()
+		clv := lv.(*_GenesisState_13_list)
+		x.OutboundPayments = *clv.list
+	case "provlabs.vault.v1.GenesisState.scheduled_action_queue":
+		x.ScheduledActionQueue = value.Message().Interface().(*ScheduledActionQueue)
+	case "provlabs.vault.v1.GenesisState.allowlist":
+		lv := value.List()
+		clv := lv.(*_GenesisState_15_list)
+		x.Allowlist = *clv.list
+	case "provlabs.vault.v1.GenesisState.nav_references":
+		lv := value.List()
+		clv := lv.(*_GenesisState_16_list)
+		x.NavReferences = *clv.list
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: provlabs.vault.v1.GenesisState"))
+		}
+		panic(fmt.Errorf("message provlabs.vault.v1.GenesisState does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_GenesisState) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "provlabs.vault.v1.GenesisState.vaults":
+		if x.Vaults == nil {
+			x.Vaults = []*VaultAccount{}
+		}
+		value := &_GenesisState_1_list{list: &x.Vaults}
+		return protoreflect.ValueOfList(value)
+	case "provlabs.vault.v1.GenesisState.payout_timeout_queue":
+		if x.PayoutTimeoutQueue == nil {
+			x.PayoutTimeoutQueue = []*QueueEntry{}
+		}
+		value := &_GenesisState_2_list{list: &x.PayoutTimeoutQueue}
+		return protoreflect.ValueOfList(value)
+	case "provlabs.vault.v1.GenesisState.pending_swap_out_queue":
+		if x.PendingSwapOutQueue == nil {
+			x.PendingSwapOutQueue = new(PendingSwapOutQueue)
+		}
+		return protoreflect.ValueOfMessage(x.PendingSwapOutQueue.ProtoReflect())
+	case "provlabs.vault.v1.GenesisState.fee_timeout_queue":
+		if x.FeeTimeoutQueue == nil {
+			x.FeeTimeoutQueue = []*QueueEntry{}
+		}
+		value := &_GenesisState_4_list{list: &x.FeeTimeoutQueue}
+		return protoreflect.ValueOfList(value)
+	case "provlabs.vault.v1.GenesisState.params":
+		if x.Params == nil {
+			x.Params = new(Params)
+		}
+		return protoreflect.ValueOfMessage(x.Params.ProtoReflect())
+	case "provlabs.vault.v1.GenesisState.navs":
+		if x.Navs == nil {
+			x.Navs = []*VaultNAVEntry{}
+		}
+		value := &_GenesisState_6_list{list: &x.Navs}
+		return protoreflect.ValueOfList(value)
+	case "provlabs.vault.v1.GenesisState.pending_nav_proposals":
+		if x.PendingNavProposals == nil {
+			x.PendingNavProposals = []*PendingNAVProposal{}
+		}
+		value := &_GenesisState_7_list{list: &x.PendingNavProposals}
+		return protoreflect.ValueOfList(value)
+	case "provlabs.vault.v1.GenesisState.nav_source_submissions":
+		if x.NavSourceSubmissions == nil {
+			x.NavSourceSubmissions = []*NAVSourceSubmission{}
+		}
+		value := &_GenesisState_8_list{list: &x.NavSourceSubmissions}
+		return protoreflect.ValueOfList(value)
+	case "provlabs.vault.v1.GenesisState.nav_history":
+		if x.NavHistory == nil {
+			x.NavHistory = []*VaultNAVEntry{}
+		}
+		value := &_GenesisState_9_list{list: &x.NavHistory}
+		return protoreflect.ValueOfList(value)
+	case "provlabs.vault.v1.GenesisState.settlement_prices":
+		if x.SettlementPrices == nil {
+			x.SettlementPrices = []*VaultNAVEntry{}
+		}
+		value := &_GenesisState_10_list{list: &x.SettlementPrices}
+		return protoreflect.ValueOfList(value)
+	case "provlabs.vault.v1.GenesisState.asset_haircuts":
+		if x.AssetHaircuts == nil {
+			x.AssetHaircuts = []*AssetHaircutEntry{}
+		}
+		value := &_GenesisState_11_list{list: &x.AssetHaircuts}
+		return protoreflect.ValueOfList(value)
+	case "provlabs.vault.v1.GenesisState.share_price_observations":
+		if x.SharePriceObservations == nil {
+			x.SharePriceObservations = []*SharePriceObservationEntry{}
+		}
+		value := &_GenesisState_12_list{list: &x.SharePriceObservations}
+		return protoreflect.ValueOfList(value)
+	case "provlabs.vault.v1.GenesisState.outbound_payments":
+		if x.OutboundPayments == nil {
+			x.OutboundPayments = []*OutboundPaymentEntry{}
+		}
+		value := &_GenesisState_13_list{list: &x.OutboundPayments}
+		return protoreflect.ValueOfList(value)
+	case "provlabs.vault.v1.GenesisState.scheduled_action_queue":
+		if x.ScheduledActionQueue == nil {
+			x.ScheduledActionQueue = new(ScheduledActionQueue)
+		}
+		return protoreflect.ValueOfMessage(x.ScheduledActionQueue.ProtoReflect())
+	case "provlabs.vault.v1.GenesisState.allowlist":
+		if x.Allowlist == nil {
+			x.Allowlist = []*AllowlistEntry{}
+		}
+		value := &_GenesisState_15_list{list: &x.Allowlist}
+		return protoreflect.ValueOfList(value)
+	case "provlabs.vault.v1.GenesisState.nav_references":
+		if x.NavReferences == nil {
+			x.NavReferences = []*VaultNAVEntry{}
+		}
+		value := &_GenesisState_16_list{list: &x.NavReferences}
+		return protoreflect.ValueOfList(value)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: provlabs.vault.v1.GenesisState"))
+		}
+		panic(fmt.Errorf("message provlabs.vault.v1.GenesisState does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_GenesisState) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "provlabs.vault.v1.GenesisState.vaults":
+		list := []*VaultAccount{}
+		return protoreflect.ValueOfList(&_GenesisState_1_list{list: &list})
+	case "provlabs.vault.v1.GenesisState.payout_timeout_queue":
+		list := []*QueueEntry{}
+		return protoreflect.ValueOfList(&_GenesisState_2_list{list: &list})
+	case "provlabs.vault.v1.GenesisState.pending_swap_out_queue":
+		m := new(PendingSwapOutQueue)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "provlabs.vault.v1.GenesisState.fee_timeout_queue":
+		list := []*QueueEntry{}
+		return protoreflect.ValueOfList(&_GenesisState_4_list{list: &list})
+	case "provlabs.vault.v1.GenesisState.params":
+		m := new(Params)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "provlabs.vault.v1.GenesisState.navs":
+		list := []*VaultNAVEntry{}
+		return protoreflect.ValueOfList(&_GenesisState_6_list{list: &list})
+	case "provlabs.vault.v1.GenesisState.pending_nav_proposals":
+		list := []*PendingNAVProposal{}
+		return protoreflect.ValueOfList(&_GenesisState_7_list{list: &list})
+	case "provlabs.vault.v1.GenesisState.nav_source_submissions":
+		list := []*NAVSourceSubmission{}
+		return protoreflect.ValueOfList(&_GenesisState_8_list{list: &list})
+	case "provlabs.vault.v1.GenesisState.nav_history":
+		list := []*VaultNAVEntry{}
+		return protoreflect.ValueOfList(&_GenesisState_9_list{list: &list})
+	case "provlabs.vault.v1.GenesisState.settlement_prices":
+		list := []*VaultNAVEntry{}
+		return protoreflect.ValueOfList(&_GenesisState_10_list{list: &list})
+	case "provlabs.vault.v1.GenesisState.asset_haircuts":
+		list := []*AssetHaircutEntry{}
+		return protoreflect.ValueOfList(&_GenesisState_11_list{list: &list})
+	case "provlabs.vault.v1.GenesisState.share_price_observations":
+		list := []*SharePriceObservationEntry{}
+		return protoreflect.ValueOfList(&_GenesisState_12_list{list: &list})
+	case "provlabs.vault.v1.GenesisState.outbound_payments":
+		list := []*OutboundPaymentEntry{}
+		return protoreflect.ValueOfList(&_GenesisState_13_list{list: &list})
+	case "provlabs.vault.v1.GenesisState.scheduled_action_queue":
+		m := new(ScheduledActionQueue)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "provlabs.vault.v1.GenesisState.allowlist":
+		list := []*AllowlistEntry{}
+		return protoreflect.ValueOfList(&_GenesisState_15_list{list: &list})
+	case "provlabs.vault.v1.GenesisState.nav_references":
+		list := []*VaultNAVEntry{}
+		return protoreflect.ValueOfList(&_GenesisState_16_list{list: &list})
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: provlabs.vault.v1.GenesisState"))
+		}
+		panic(fmt.Errorf("message provlabs.vault.v1.GenesisState does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_GenesisState) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in provlabs.vault.v1.GenesisState", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_GenesisState) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_GenesisState) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_GenesisState) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*GenesisState)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if len(x.Vaults) > 0 {
+			for _, e := range x.Vaults {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.PayoutTimeoutQueue) > 0 {
+			for _, e := range x.PayoutTimeoutQueue {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.PendingSwapOutQueue != nil {
+			l = options.Size(x.PendingSwapOutQueue)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if len(x.FeeTimeoutQueue) > 0 {
+			for _, e := range x.FeeTimeoutQueue {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.Params != nil {
+			l = options.Size(x.Params)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if len(x.Navs) > 0 {
+			for _, e := range x.Navs {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.PendingNavProposals) > 0 {
+			for _, e := range x.PendingNavProposals {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.NavSourceSubmissions) > 0 {
+			for _, e := range x.NavSourceSubmissions {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.NavHistory) > 0 {
+			for _, e := range x.NavHistory {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.SettlementPrices) > 0 {
+			for _, e := range x.SettlementPrices {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.AssetHaircuts) > 0 {
+			for _, e := range x.AssetHaircuts {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.SharePriceObservations) > 0 {
+			for _, e := range x.SharePriceObservations {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.OutboundPayments) > 0 {
+			for _, e := range x.OutboundPayments {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.ScheduledActionQueue != nil {
+			l = options.Size(x.ScheduledActionQueue)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if len(x.Allowlist) > 0 {
+			for _, e := range x.Allowlist {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.NavReferences) > 0 {
+			for _, e := range x.NavReferences {
+				l = options.Size(e)
+				n += 2 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*GenesisState)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.NavReferences) > 0 {
+			for iNdEx := len(x.NavReferences) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.NavReferences[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x1
+				i--
+				dAtA[i] = 0x82
+			}
+		}
+		if len(x.Allowlist) > 0 {
+			for iNdEx := len(x.Allowlist) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Allowlist[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x7a
+			}
+		}
+		if x.ScheduledActionQueue != nil {
+			encoded, err := options.Marshal(x.ScheduledActionQueue)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x72
+		}
+		if len(x.OutboundPayments) > 0 {
+			for iNdEx := len(x.OutboundPayments) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.OutboundPayments[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x6a
+			}
+		}
+		if len(x.SharePriceObservations) > 0 {
+			for iNdEx := len(x.SharePriceObservations) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.SharePriceObservations[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x62
+			}
+		}
+		if len(x.AssetHaircuts) > 0 {
+			for iNdEx := len(x.AssetHaircuts) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.AssetHaircuts[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x5a
+			}
+		}
+		if len(x.SettlementPrices) > 0 {
+			for iNdEx := len(x.SettlementPrices) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.SettlementPrices[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x52
+			}
+		}
+		if len(x.NavHistory) > 0 {
+			for iNdEx := len(x.NavHistory) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.NavHistory[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x4a
+			}
+		}
+		if len(x.NavSourceSubmissions) > 0 {
+			for iNdEx := len(x.NavSourceSubmissions) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.NavSourceSubmissions[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x42
+			}
+		}
+		if len(x.PendingNavProposals) > 0 {
+			for iNdEx := len(x.PendingNavProposals) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.PendingNavProposals[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x3a
+			}
+		}
+		if len(x.Navs) > 0 {
+			for iNdEx := len(x.Navs) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Navs[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x32
+			}
+		}
+		if x.Params != nil {
+			encoded, err := options.Marshal(x.Params)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x2a
+		}
+		if len(x.FeeTimeoutQueue) > 0 {
+			for iNdEx := len(x.FeeTimeoutQueue) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.FeeTimeoutQueue[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x22
+			}
+		}
+		if x.PendingSwapOutQueue != nil {
+			encoded, err := options.Marshal(x.PendingSwapOutQueue)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if len(x.PayoutTimeoutQueue) > 0 {
+			for iNdEx := len(x.PayoutTimeoutQueue) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.PayoutTimeoutQueue[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x12
+			}
+		}
+		if len(x.Vaults) > 0 {
+			for iNdEx := len(x.Vaults) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Vaults[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0xa
+			}
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*GenesisState)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: GenesisState: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: GenesisState: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Vaults", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Vaults = append(x.Vaults, &VaultAccount{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Vaults[len(x.Vaults)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PayoutTimeoutQueue", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.PayoutTimeoutQueue = append(x.PayoutTimeoutQueue, &QueueEntry{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.PayoutTimeoutQueue[len(x.PayoutTimeoutQueue)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PendingSwapOutQueue", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.PendingSwapOutQueue == nil {
+					x.PendingSwapOutQueue = &PendingSwapOutQueue{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.PendingSwapOutQueue); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field FeeTimeoutQueue", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.FeeTimeoutQueue = append(x.FeeTimeoutQueue, &QueueEntry{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.FeeTimeoutQueue[len(x.FeeTimeoutQueue)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 5:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Params == nil {
+					x.Params = &Params{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Params); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 6:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Navs", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Navs = append(x.Navs, &VaultNAVEntry{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Navs[len(x.Navs)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 7:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PendingNavProposals", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.PendingNavProposals = append(x.PendingNavProposals, &PendingNAVProposal{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.PendingNavProposals[len(x.PendingNavProposals)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 8:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field NavSourceSubmissions", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.NavSourceSubmissions = append(x.NavSourceSubmissions, &NAVSourceSubmission{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.NavSourceSubmissions[len(x.NavSourceSubmissions)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 9:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field NavHistory", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.NavHistory = append(x.NavHistory, &VaultNAVEntry{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.NavHistory[len(x.NavHistory)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 10:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SettlementPrices", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.SettlementPrices = append(x.SettlementPrices, &VaultNAVEntry{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.SettlementPrices[len(x.SettlementPrices)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 11:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field AssetHaircuts", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.AssetHaircuts = append(x.AssetHaircuts, &AssetHaircutEntry{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.AssetHaircuts[len(x.AssetHaircuts)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 12:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SharePriceObservations", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.SharePriceObservations = append(x.SharePriceObservations, &SharePriceObservationEntry{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.SharePriceObservations[len(x.SharePriceObservations)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 13:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field OutboundPayments", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.OutboundPayments = append(x.OutboundPayments, &OutboundPaymentEntry{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.OutboundPayments[len(x.OutboundPayments)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 14:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ScheduledActionQueue", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.ScheduledActionQueue == nil {
+					x.ScheduledActionQueue = &ScheduledActionQueue{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.ScheduledActionQueue); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 15:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Allowlist", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Allowlist = append(x.Allowlist, &AllowlistEntry{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Allowlist[len(x.Allowlist)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 16:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field NavReferences", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.NavReferences = append(x.NavReferences, &VaultNAVEntry{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.NavReferences[len(x.NavReferences)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_AllowlistEntry               protoreflect.MessageDescriptor
+	fd_AllowlistEntry_vault_address protoreflect.FieldDescriptor
+	fd_AllowlistEntry_address       protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_provlabs_vault_v1_genesis_proto_init()
+	md_AllowlistEntry = File_provlabs_vault_v1_genesis_proto.Messages().ByName("AllowlistEntry")
+	fd_AllowlistEntry_vault_address = md_AllowlistEntry.Fields().ByName("vault_address")
+	fd_AllowlistEntry_address = md_AllowlistEntry.Fields().ByName("address")
+}
+
+var _ protoreflect.Message = (*fastReflection_AllowlistEntry)(nil)
+
+type fastReflection_AllowlistEntry AllowlistEntry
+
+func (x *AllowlistEntry) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_AllowlistEntry)(x)
+}
+
+func (x *AllowlistEntry) slowProtoReflect() protoreflect.Message {
+	mi := &file_provlabs_vault_v1_genesis_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_AllowlistEntry_messageType fastReflection_AllowlistEntry_messageType
+var _ protoreflect.MessageType = fastReflection_AllowlistEntry_messageType{}
+
+type fastReflection_AllowlistEntry_messageType struct{}
+
+func (x fastReflection_AllowlistEntry_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_AllowlistEntry)(nil)
+}
+func (x fastReflection_AllowlistEntry_messageType) New() protoreflect.Message {
+	return new(fastReflection_AllowlistEntry)
+}
+func (x fastReflection_AllowlistEntry_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_AllowlistEntry
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_AllowlistEntry) Descriptor() protoreflect.MessageDescriptor {
+	return md_AllowlistEntry
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_AllowlistEntry) Type() protoreflect.MessageType {
+	return _fastReflection_AllowlistEntry_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_AllowlistEntry) New() protoreflect.Message {
+	return new(fastReflection_AllowlistEntry)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_AllowlistEntry) Interface() protoreflect.ProtoMessage {
+	return (*AllowlistEntry)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_AllowlistEntry) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.VaultAddress != "" {
+		value := protoreflect.ValueOfString(x.VaultAddress)
+		if !f(fd_AllowlistEntry_vault_address, value) {
+			return
+		}
+	}
+	if x.Address != "" {
+		value := protoreflect.ValueOfString(x.Address)
+		if !f(fd_AllowlistEntry_address, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_AllowlistEntry) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "provlabs.vault.v1.AllowlistEntry.vault_address":
+		return x.VaultAddress != ""
+	case "provlabs.vault.v1.AllowlistEntry.address":
+		return x.Address != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: provlabs.vault.v1.AllowlistEntry"))
+		}
+		panic(fmt.Errorf("message provlabs.vault.v1.AllowlistEntry does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_AllowlistEntry) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "provlabs.vault.v1.AllowlistEntry.vault_address":
+		x.VaultAddress = ""
+	case "provlabs.vault.v1.AllowlistEntry.address":
+		x.Address = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: provlabs.vault.v1.AllowlistEntry"))
+		}
+		panic(fmt.Errorf("message provlabs.vault.v1.AllowlistEntry does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_AllowlistEntry) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "provlabs.vault.v1.AllowlistEntry.vault_address":
+		value := x.VaultAddress
+		return protoreflect.ValueOfString(value)
+	case "provlabs.vault.v1.AllowlistEntry.address":
+		value := x.Address
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: provlabs.vault.v1.AllowlistEntry"))
+		}
+		panic(fmt.Errorf("message provlabs.vault.v1.AllowlistEntry does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_AllowlistEntry) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "provlabs.vault.v1.AllowlistEntry.vault_address":
+		x.VaultAddress = value.Interface().(string)
+	case "provlabs.vault.v1.AllowlistEntry.address":
+		x.Address = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: provlabs.vault.v1.AllowlistEntry"))
+		}
+		panic(fmt.Errorf("message provlabs.vault.v1.AllowlistEntry does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_AllowlistEntry) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "provlabs.vault.v1.AllowlistEntry.vault_address":
+		panic(fmt.Errorf("field vault_address of message provlabs.vault.v1.AllowlistEntry is not mutable"))
+	case "provlabs.vault.v1.AllowlistEntry.address":
+		panic(fmt.Errorf("field address of message provlabs.vault.v1.AllowlistEntry is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: provlabs.vault.v1.AllowlistEntry"))
+		}
+		panic(fmt.Errorf("message provlabs.vault.v1.AllowlistEntry does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_AllowlistEntry) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "provlabs.vault.v1.AllowlistEntry.vault_address":
+		return protoreflect.ValueOfString("")
+	case "provlabs.vault.v1.AllowlistEntry.address":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: provlabs.vault.v1.AllowlistEntry"))
+		}
+		panic(fmt.Errorf("message provlabs.vault.v1.AllowlistEntry does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_AllowlistEntry) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in provlabs.vault.v1.AllowlistEntry", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_AllowlistEntry) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_AllowlistEntry) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_AllowlistEntry) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_AllowlistEntry) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*AllowlistEntry)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.VaultAddress)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Address)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*AllowlistEntry)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.Address) > 0 {
+			i -= len(x.Address)
+			copy(dAtA[i:], x.Address)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Address)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.VaultAddress) > 0 {
+			i -= len(x.VaultAddress)
+			copy(dAtA[i:], x.VaultAddress)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.VaultAddress)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*AllowlistEntry)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: AllowlistEntry: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: AllowlistEntry: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field VaultAddress", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.VaultAddress = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Address = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.List = (*_ScheduledActionQueue_2_list)(nil)
+
+type _ScheduledActionQueue_2_list struct {
+	list *[]*ScheduledAction
+}
+
+func (x *_ScheduledActionQueue_2_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_ScheduledActionQueue_2_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_ScheduledActionQueue_2_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*ScheduledAction)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_ScheduledActionQueue_2_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*ScheduledAction)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_ScheduledActionQueue_2_list) AppendMutable() protoreflect.Value {
+	v := new(ScheduledAction)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_ScheduledActionQueue_2_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_ScheduledActionQueue_2_list) NewElement() protoreflect.Value {
+	v := new(ScheduledAction)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_ScheduledActionQueue_2_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_ScheduledActionQueue                        protoreflect.MessageDescriptor
+	fd_ScheduledActionQueue_latest_sequence_number protoreflect.FieldDescriptor
+	fd_ScheduledActionQueue_actions                protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_provlabs_vault_v1_genesis_proto_init()
+	md_ScheduledActionQueue = File_provlabs_vault_v1_genesis_proto.Messages().ByName("ScheduledActionQueue")
+	fd_ScheduledActionQueue_latest_sequence_number = md_ScheduledActionQueue.Fields().ByName("latest_sequence_number")
+	fd_ScheduledActionQueue_actions = md_ScheduledActionQueue.Fields().ByName("actions")
+}
+
+var _ protoreflect.Message = (*fastReflection_ScheduledActionQueue)(nil)
+
+type fastReflection_ScheduledActionQueue ScheduledActionQueue
+
+func (x *ScheduledActionQueue) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_ScheduledActionQueue)(x)
+}
+
+func (x *ScheduledActionQueue) slowProtoReflect() protoreflect.Message {
+	mi := &file_provlabs_vault_v1_genesis_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_ScheduledActionQueue_messageType fastReflection_ScheduledActionQueue_messageType
+var _ protoreflect.MessageType = fastReflection_ScheduledActionQueue_messageType{}
+
+type fastReflection_ScheduledActionQueue_messageType struct{}
+
+func (x fastReflection_ScheduledActionQueue_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_ScheduledActionQueue)(nil)
+}
+func (x fastReflection_ScheduledActionQueue_messageType) New() protoreflect.Message {
+	return new(fastReflection_ScheduledActionQueue)
+}
+func (x fastReflection_ScheduledActionQueue_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_ScheduledActionQueue
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_ScheduledActionQueue) Descriptor() protoreflect.MessageDescriptor {
+	return md_ScheduledActionQueue
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_ScheduledActionQueue) Type() protoreflect.MessageType {
+	return _fastReflection_ScheduledActionQueue_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_ScheduledActionQueue) New() protoreflect.Message {
+	return new(fastReflection_ScheduledActionQueue)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_ScheduledActionQueue) Interface() protoreflect.ProtoMessage {
+	return (*ScheduledActionQueue)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_ScheduledActionQueue) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.LatestSequenceNumber != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.LatestSequenceNumber)
+		if !f(fd_ScheduledActionQueue_latest_sequence_number, value) {
+			return
+		}
+	}
+	if len(x.Actions) != 0 {
+		value := protoreflect.ValueOfList(&_ScheduledActionQueue_2_list{list: &x.Actions})
+		if !f(fd_ScheduledActionQueue_actions, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_ScheduledActionQueue) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "provlabs.vault.v1.ScheduledActionQueue.latest_sequence_number":
+		return x.LatestSequenceNumber != uint64(0)
+	case "provlabs.vault.v1.ScheduledActionQueue.actions":
+		return len(x.Actions) != 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: provlabs.vault.v1.ScheduledActionQueue"))
+		}
+		panic(fmt.Errorf("message provlabs.vault.v1.ScheduledActionQueue does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ScheduledActionQueue) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "provlabs.vault.v1.ScheduledActionQueue.latest_sequence_number":
+		x.LatestSequenceNumber = uint64(0)
+	case "provlabs.vault.v1.ScheduledActionQueue.actions":
+		x.Actions = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: provlabs.vault.v1.ScheduledActionQueue"))
+		}
+		panic(fmt.Errorf("message provlabs.vault.v1.ScheduledActionQueue does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_ScheduledActionQueue) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "provlabs.vault.v1.ScheduledActionQueue.latest_sequence_number":
+		value := x.LatestSequenceNumber
+		return protoreflect.ValueOfUint64(value)
+	case "provlabs.vault.v1.ScheduledActionQueue.actions":
+		if len(x.Actions) == 0 {
+			return protoreflect.ValueOfList(&_ScheduledActionQueue_2_list{})
+		}
+		listValue := &_ScheduledActionQueue_2_list{list: &x.Actions}
+		return protoreflect.ValueOfList(listValue)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: provlabs.vault.v1.ScheduledActionQueue"))
+		}
+		panic(fmt.Errorf("message provlabs.vault.v1.ScheduledActionQueue does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ScheduledActionQueue) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "provlabs.vault.v1.ScheduledActionQueue.latest_sequence_number":
+		x.LatestSequenceNumber = value.Uint()
+	case "provlabs.vault.v1.ScheduledActionQueue.actions":
+		lv := value.List()
+		clv := lv.(*_ScheduledActionQueue_2_list)
+		x.Actions = *clv.list
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: provlabs.vault.v1.ScheduledActionQueue"))
+		}
+		panic(fmt.Errorf("message provlabs.vault.v1.ScheduledActionQueue does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ScheduledActionQueue) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "provlabs.vault.v1.ScheduledActionQueue.actions":
+		if x.Actions == nil {
+			x.Actions = []*ScheduledAction{}
+		}
+		value := &_ScheduledActionQueue_2_list{list: &x.Actions}
+		return protoreflect.ValueOfList(value)
+	case "provlabs.vault.v1.ScheduledActionQueue.latest_sequence_number":
+		panic(fmt.Errorf("field latest_sequence_number of message provlabs.vault.v1.ScheduledActionQueue is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: provlabs.vault.v1.ScheduledActionQueue"))
+		}
+		panic(fmt.Errorf("message provlabs.vault.v1.ScheduledActionQueue does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_ScheduledActionQueue) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "provlabs.vault.v1.ScheduledActionQueue.latest_sequence_number":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "provlabs.vault.v1.ScheduledActionQueue.actions":
+		list := []*ScheduledAction{}
+		return protoreflect.ValueOfList(&_ScheduledActionQueue_2_list{list: &list})
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: provlabs.vault.v1.ScheduledActionQueue"))
+		}
+		panic(fmt.Errorf("message provlabs.vault.v1.ScheduledActionQueue does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_ScheduledActionQueue) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in provlabs.vault.v1.ScheduledActionQueue", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_ScheduledActionQueue) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ScheduledActionQueue) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_ScheduledActionQueue) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_ScheduledActionQueue) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*ScheduledActionQueue)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.LatestSequenceNumber != 0 {
+			n += 1 + runtime.Sov(uint64(x.LatestSequenceNumber))
+		}
+		if len(x.Actions) > 0 {
+			for _, e := range x.Actions {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*ScheduledActionQueue)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.Actions) > 0 {
+			for iNdEx := len(x.Actions) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Actions[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x12
+			}
+		}
+		if x.LatestSequenceNumber != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.LatestSequenceNumber))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*ScheduledActionQueue)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ScheduledActionQueue: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ScheduledActionQueue: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field LatestSequenceNumber", wireType)
+				}
+				x.LatestSequenceNumber = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.LatestSequenceNumber |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Actions", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Actions = append(x.Actions, &ScheduledAction{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Actions[len(x.Actions)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_AssetHaircutEntry               protoreflect.MessageDescriptor
+	fd_AssetHaircutEntry_vault_address protoreflect.FieldDescriptor
+	fd_AssetHaircutEntry_haircut       protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_provlabs_vault_v1_genesis_proto_init()
+	md_AssetHaircutEntry = File_provlabs_vault_v1_genesis_proto.Messages().ByName("AssetHaircutEntry")
+	fd_AssetHaircutEntry_vault_address = md_AssetHaircutEntry.Fields().ByName("vault_address")
+	fd_AssetHaircutEntry_haircut = md_AssetHaircutEntry.Fields().ByName("haircut")
+}
+
+var _ protoreflect.Message = (*fastReflection_AssetHaircutEntry)(nil)
+
+type fastReflection_AssetHaircutEntry AssetHaircutEntry
+
+func (x *AssetHaircutEntry) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_AssetHaircutEntry)(x)
+}
+
+func (x *AssetHaircutEntry) slowProtoReflect() protoreflect.Message {
+	mi := &file_provlabs_vault_v1_genesis_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_AssetHaircutEntry_messageType fastReflection_AssetHaircutEntry_messageType
+var _ protoreflect.MessageType = fastReflection_AssetHaircutEntry_messageType{}
+
+type fastReflection_AssetHaircutEntry_messageType struct{}
+
+func (x fastReflection_AssetHaircutEntry_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_AssetHaircutEntry)(nil)
+}
+func (x fastReflection_AssetHaircutEntry_messageType) New() protoreflect.Message {
+	return new(fastReflection_AssetHaircutEntry)
+}
+func (x fastReflection_AssetHaircutEntry_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_AssetHaircutEntry
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_AssetHaircutEntry) Descriptor() protoreflect.MessageDescriptor {
+	return md_AssetHaircutEntry
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_AssetHaircutEntry) Type() protoreflect.MessageType {
+	return _fastReflection_AssetHaircutEntry_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_AssetHaircutEntry) New() protoreflect.Message {
+	return new(fastReflection_AssetHaircutEntry)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_AssetHaircutEntry) Interface() protoreflect.ProtoMessage {
+	return (*AssetHaircutEntry)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_AssetHaircutEntry) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.VaultAddress != "" {
+		value := protoreflect.ValueOfString(x.VaultAddress)
+		if !f(fd_AssetHaircutEntry_vault_address, value) {
+			return
+		}
+	}
+	if x.Haircut != nil {
+		value := protoreflect.ValueOfMessage(x.Haircut.ProtoReflect())
+		if !f(fd_AssetHaircutEntry_haircut, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_AssetHaircutEntry) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "provlabs.vault.v1.AssetHaircutEntry.vault_address":
+		return x.VaultAddress != ""
+	case "provlabs.vault.v1.AssetHaircutEntry.haircut":
+		return x.Haircut != nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: provlabs.vault.v1.AssetHaircutEntry"))
+		}
+		panic(fmt.Errorf("message provlabs.vault.v1.AssetHaircutEntry does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_AssetHaircutEntry) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "provlabs.vault.v1.AssetHaircutEntry.vault_address":
+		x.VaultAddress = ""
+	case "provlabs.vault.v1.AssetHaircutEntry.haircut":
+		x.Haircut = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: provlabs.vault.v1.AssetHaircutEntry"))
+		}
+		panic(fmt.Errorf("message provlabs.vault.v1.AssetHaircutEntry does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_AssetHaircutEntry) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "provlabs.vault.v1.AssetHaircutEntry.vault_address":
+		value := x.VaultAddress
+		return protoreflect.ValueOfString(value)
+	case "provlabs.vault.v1.AssetHaircutEntry.haircut":
+		value := x.Haircut
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: provlabs.vault.v1.AssetHaircutEntry"))
+		}
+		panic(fmt.Errorf("message provlabs.vault.v1.AssetHaircutEntry does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_AssetHaircutEntry) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "provlabs.vault.v1.AssetHaircutEntry.vault_address":
+		x.VaultAddress = value.Interface().(string)
+	case "provlabs.vault.v1.AssetHaircutEntry.haircut":
+		x.Haircut = value.Message().Interface().(*AssetHaircut)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: provlabs.vault.v1.AssetHaircutEntry"))
+		}
+		panic(fmt.Errorf("message provlabs.vault.v1.AssetHaircutEntry does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_AssetHaircutEntry) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "provlabs.vault.v1.AssetHaircutEntry.haircut":
+		if x.Haircut == nil {
+			x.Haircut = new(AssetHaircut)
+		}
+		return protoreflect.ValueOfMessage(x.Haircut.ProtoReflect())
+	case "provlabs.vault.v1.AssetHaircutEntry.vault_address":
+		panic(fmt.Errorf("field vault_address of message provlabs.vault.v1.AssetHaircutEntry is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: provlabs.vault.v1.AssetHaircutEntry"))
+		}
+		panic(fmt.Errorf("message provlabs.vault.v1.AssetHaircutEntry does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_AssetHaircutEntry) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "provlabs.vault.v1.AssetHaircutEntry.vault_address":
+		return protoreflect.ValueOfString("")
+	case "provlabs.vault.v1.AssetHaircutEntry.haircut":
+		m := new(AssetHaircut)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: provlabs.vault.v1.AssetHaircutEntry"))
+		}
+		panic(fmt.Errorf("message provlabs.vault.v1.AssetHaircutEntry does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_AssetHaircutEntry) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in provlabs.vault.v1.AssetHaircutEntry", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_AssetHaircutEntry) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_AssetHaircutEntry) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_AssetHaircutEntry) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_AssetHaircutEntry) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*AssetHaircutEntry)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.VaultAddress)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Haircut != nil {
+			l = options.Size(x.Haircut)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*AssetHaircutEntry)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.Haircut != nil {
+			encoded, err := options.Marshal(x.Haircut)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.VaultAddress) > 0 {
+			i -= len(x.VaultAddress)
+			copy(dAtA[i:], x.VaultAddress)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.VaultAddress)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*AssetHaircutEntry)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: AssetHaircutEntry: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: AssetHaircutEntry: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field VaultAddress", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.VaultAddress = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Haircut", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Haircut == nil {
+					x.Haircut = &AssetHaircut{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Haircut); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_SharePriceObservationEntry               protoreflect.MessageDescriptor
+	fd_SharePriceObservationEntry_vault_address protoreflect.FieldDescriptor
+	fd_SharePriceObservationEntry_observation   protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_provlabs_vault_v1_genesis_proto_init()
+	md_SharePriceObservationEntry = File_provlabs_vault_v1_genesis_proto.Messages().ByName("SharePriceObservationEntry")
+	fd_SharePriceObservationEntry_vault_address = md_SharePriceObservationEntry.Fields().ByName("vault_address")
+	fd_SharePriceObservationEntry_observation = md_SharePriceObservationEntry.Fields().ByName("observation")
+}
+
+var _ protoreflect.Message = (*fastReflection_SharePriceObservationEntry)(nil)
+
+type fastReflection_SharePriceObservationEntry SharePriceObservationEntry
+
+func (x *SharePriceObservationEntry) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_SharePriceObservationEntry)(x)
+}
+
+func (x *SharePriceObservationEntry) slowProtoReflect() protoreflect.Message {
+	mi := &file_provlabs_vault_v1_genesis_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_SharePriceObservationEntry_messageType fastReflection_SharePriceObservationEntry_messageType
+var _ protoreflect.MessageType = fastReflection_SharePriceObservationEntry_messageType{}
+
+type fastReflection_SharePriceObservationEntry_messageType struct{}
+
+func (x fastReflection_SharePriceObservationEntry_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_SharePriceObservationEntry)(nil)
+}
+func (x fastReflection_SharePriceObservationEntry_messageType) New() protoreflect.Message {
+	return new(fastReflection_SharePriceObservationEntry)
+}
+func (x fastReflection_SharePriceObservationEntry_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_SharePriceObservationEntry
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_SharePriceObservationEntry) Descriptor() protoreflect.MessageDescriptor {
+	return md_SharePriceObservationEntry
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_SharePriceObservationEntry) Type() protoreflect.MessageType {
+	return _fastReflection_SharePriceObservationEntry_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_SharePriceObservationEntry) New() protoreflect.Message {
+	return new(fastReflection_SharePriceObservationEntry)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_SharePriceObservationEntry) Interface() protoreflect.ProtoMessage {
+	return (*SharePriceObservationEntry)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_SharePriceObservationEntry) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.VaultAddress != "" {
+		value := protoreflect.ValueOfString(x.VaultAddress)
+		if !f(fd_SharePriceObservationEntry_vault_address, value) {
+			return
+		}
+	}
+	if x.Observation != nil {
+		value := protoreflect.ValueOfMessage(x.Observation.ProtoReflect())
+		if !f(fd_SharePriceObservationEntry_observation, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_SharePriceObservationEntry) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "provlabs.vault.v1.SharePriceObservationEntry.vault_address":
+		return x.VaultAddress != ""
+	case "provlabs.vault.v1.SharePriceObservationEntry.observation":
+		return x.Observation != nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: provlabs.vault.v1.SharePriceObservationEntry"))
+		}
+		panic(fmt.Errorf("message provlabs.vault.v1.SharePriceObservationEntry does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_SharePriceObservationEntry) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "provlabs.vault.v1.SharePriceObservationEntry.vault_address":
+		x.VaultAddress = ""
+	case "provlabs.vault.v1.SharePriceObservationEntry.observation":
+		x.Observation = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: provlabs.vault.v1.SharePriceObservationEntry"))
+		}
+		panic(fmt.Errorf("message provlabs.vault.v1.SharePriceObservationEntry does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_SharePriceObservationEntry) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "provlabs.vault.v1.SharePriceObservationEntry.vault_address":
+		value := x.VaultAddress
+		return protoreflect.ValueOfString(value)
+	case "provlabs.vault.v1.SharePriceObservationEntry.observation":
+		value := x.Observation
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: provlabs.vault.v1.SharePriceObservationEntry"))
+		}
+		panic(fmt.Errorf("message provlabs.vault.v1.SharePriceObservationEntry does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_SharePriceObservationEntry) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "provlabs.vault.v1.SharePriceObservationEntry.vault_address":
+		x.VaultAddress = value.Interface().(string)
+	case "provlabs.vault.v1.SharePriceObservationEntry.observation":
+		x.Observation = value.Message().Interface().(*SharePriceObservation)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: provlabs.vault.v1.SharePriceObservationEntry"))
+		}
+		panic(fmt.Errorf("message provlabs.vault.v1.SharePriceObservationEntry does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_SharePriceObservationEntry) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "provlabs.vault.v1.SharePriceObservationEntry.observation":
+		if x.Observation == nil {
+			x.Observation = new(SharePriceObservation)
+		}
+		return protoreflect.ValueOfMessage(x.Observation.ProtoReflect())
+	case "provlabs.vault.v1.SharePriceObservationEntry.vault_address":
+		panic(fmt.Errorf("field vault_address of message provlabs.vault.v1.SharePriceObservationEntry is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: provlabs.vault.v1.SharePriceObservationEntry"))
+		}
+		panic(fmt.Errorf("message provlabs.vault.v1.SharePriceObservationEntry does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_SharePriceObservationEntry) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "provlabs.vault.v1.SharePriceObservationEntry.vault_address":
+		return protoreflect.ValueOfString("")
+	case "provlabs.vault.v1.SharePriceObservationEntry.observation":
+		m := new(SharePriceObservation)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: provlabs.vault.v1.SharePriceObservationEntry"))
+		}
+		panic(fmt.Errorf("message provlabs.vault.v1.SharePriceObservationEntry does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_SharePriceObservationEntry) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in provlabs.vault.v1.SharePriceObservationEntry", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_SharePriceObservationEntry) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_SharePriceObservationEntry) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_SharePriceObservationEntry) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_SharePriceObservationEntry) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*SharePriceObservationEntry)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.VaultAddress)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Observation != nil {
+			l = options.Size(x.Observation)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*SharePriceObservationEntry)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.Observation != nil {
+			encoded, err := options.Marshal(x.Observation)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.VaultAddress) > 0 {
+			i -= len(x.VaultAddress)
+			copy(dAtA[i:], x.VaultAddress)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.VaultAddress)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*SharePriceObservationEntry)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: SharePriceObservationEntry: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: SharePriceObservationEntry: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field VaultAddress", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.VaultAddress = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Observation", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Observation == nil {
+					x.Observation = &SharePriceObservation{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Observation); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
 var (
-	md_GenesisState                        protoreflect.MessageDescriptor
-	fd_GenesisState_vaults                 protoreflect.FieldDescriptor
-	fd_GenesisState_payout_timeout_queue   protoreflect.FieldDescriptor
-	fd_GenesisState_pending_swap_out_queue protoreflect.FieldDescriptor
-	fd_GenesisState_fee_timeout_queue      protoreflect.FieldDescriptor
-	fd_GenesisState_params                 protoreflect.FieldDescriptor
-	fd_GenesisState_navs                   protoreflect.FieldDescriptor
+	md_OutboundPaymentEntry               protoreflect.MessageDescriptor
+	fd_OutboundPaymentEntry_vault_address protoreflect.FieldDescriptor
+	fd_OutboundPaymentEntry_payment       protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_provlabs_vault_v1_genesis_proto_init()
-	md_GenesisState = File_provlabs_vault_v1_genesis_proto.Messages().ByName("GenesisState")
-	fd_GenesisState_vaults = md_GenesisState.Fields().ByName("vaults")
-	fd_GenesisState_payout_timeout_queue = md_GenesisState.Fields().ByName("payout_timeout_queue")
-	fd_GenesisState_pending_swap_out_queue = md_GenesisState.Fields().ByName("pending_swap_out_queue")
-	fd_GenesisState_fee_timeout_queue = md_GenesisState.Fields().ByName("fee_timeout_queue")
-	fd_GenesisState_params = md_GenesisState.Fields().ByName("params")
-	fd_GenesisState_navs = md_GenesisState.Fields().ByName("navs")
+	md_OutboundPaymentEntry = File_provlabs_vault_v1_genesis_proto.Messages().ByName("OutboundPaymentEntry")
+	fd_OutboundPaymentEntry_vault_address = md_OutboundPaymentEntry.Fields().ByName("vault_address")
+	fd_OutboundPaymentEntry_payment = md_OutboundPaymentEntry.Fields().ByName("payment")
 }
 
-var _ protoreflect.Message = (*fastReflection_GenesisState)(nil)
+var _ protoreflect.Message = (*fastReflection_OutboundPaymentEntry)(nil)
 
-type fastReflection_GenesisState GenesisState
+type fastReflection_OutboundPaymentEntry OutboundPaymentEntry
 
-func (x *GenesisState) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_GenesisState)(x)
+func (x *OutboundPaymentEntry) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_OutboundPaymentEntry)(x)
 }
 
-func (x *GenesisState) slowProtoReflect() protoreflect.Message {
-	mi := &file_provlabs_vault_v1_genesis_proto_msgTypes[4]
+func (x *OutboundPaymentEntry) slowProtoReflect() protoreflect.Message {
+	mi := &file_provlabs_vault_v1_genesis_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2298,43 +6499,43 @@ func (x *GenesisState) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_GenesisState_messageType fastReflection_GenesisState_messageType
-var _ protoreflect.MessageType = fastReflection_GenesisState_messageType{}
+var _fastReflection_OutboundPaymentEntry_messageType fastReflection_OutboundPaymentEntry_messageType
+var _ protoreflect.MessageType = fastReflection_OutboundPaymentEntry_messageType{}
 
-type fastReflection_GenesisState_messageType struct{}
+type fastReflection_OutboundPaymentEntry_messageType struct{}
 
-func (x fastReflection_GenesisState_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_GenesisState)(nil)
+func (x fastReflection_OutboundPaymentEntry_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_OutboundPaymentEntry)(nil)
 }
-func (x fastReflection_GenesisState_messageType) New() protoreflect.Message {
-	return new(fastReflection_GenesisState)
+func (x fastReflection_OutboundPaymentEntry_messageType) New() protoreflect.Message {
+	return new(fastReflection_OutboundPaymentEntry)
 }
-func (x fastReflection_GenesisState_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_GenesisState
+func (x fastReflection_OutboundPaymentEntry_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_OutboundPaymentEntry
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_GenesisState) Descriptor() protoreflect.MessageDescriptor {
-	return md_GenesisState
+func (x *fastReflection_OutboundPaymentEntry) Descriptor() protoreflect.MessageDescriptor {
+	return md_OutboundPaymentEntry
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_GenesisState) Type() protoreflect.MessageType {
-	return _fastReflection_GenesisState_messageType
+func (x *fastReflection_OutboundPaymentEntry) Type() protoreflect.MessageType {
+	return _fastReflection_OutboundPaymentEntry_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_GenesisState) New() protoreflect.Message {
-	return new(fastReflection_GenesisState)
+func (x *fastReflection_OutboundPaymentEntry) New() protoreflect.Message {
+	return new(fastReflection_OutboundPaymentEntry)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_GenesisState) Interface() protoreflect.ProtoMessage {
-	return (*GenesisState)(x)
+func (x *fastReflection_OutboundPaymentEntry) Interface() protoreflect.ProtoMessage {
+	return (*OutboundPaymentEntry)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -2342,40 +6543,16 @@ func (x *fastReflection_GenesisState) Interface() protoreflect.ProtoMessage {
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_GenesisState) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if len(x.Vaults) != 0 {
-		value := protoreflect.ValueOfList(&_GenesisState_1_list{list: &x.Vaults})
-		if !f(fd_GenesisState_vaults, value) {
-			return
-		}
-	}
-	if len(x.PayoutTimeoutQueue) != 0 {
-		value := protoreflect.ValueOfList(&_GenesisState_2_list{list: &x.PayoutTimeoutQueue})
-		if !f(fd_GenesisState_payout_timeout_queue, value) {
-			return
-		}
-	}
-	if x.PendingSwapOutQueue != nil {
-		value := protoreflect.ValueOfMessage(x.PendingSwapOutQueue.ProtoReflect())
-		if !f(fd_GenesisState_pending_swap_out_queue, value) {
-			return
-		}
-	}
-	if len(x.FeeTimeoutQueue) != 0 {
-		value := protoreflect.ValueOfList(&_GenesisState_4_list{list: &x.FeeTimeoutQueue})
-		if !f(fd_GenesisState_fee_timeout_queue, value) {
-			return
-		}
-	}
-	if x.Params != nil {
-		value := protoreflect.ValueOfMessage(x.Params.ProtoReflect())
-		if !f(fd_GenesisState_params, value) {
+func (x *fastReflection_OutboundPaymentEntry) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.VaultAddress != "" {
+		value := protoreflect.ValueOfString(x.VaultAddress)
+		if !f(fd_OutboundPaymentEntry_vault_address, value) {
 			return
 		}
 	}
-	if len(x.Navs) != 0 {
-		value := protoreflect.ValueOfList(&_GenesisState_6_list{list: &x.Navs})
-		if !f(fd_GenesisState_navs, value) {
+	if x.Payment != nil {
+		value := protoreflect.ValueOfMessage(x.Payment.ProtoReflect())
+		if !f(fd_OutboundPaymentEntry_payment, value) {
 			return
 		}
 	}
@@ -2392,25 +6569,17 @@ func (x *fastReflection_GenesisState) Range(f func(protoreflect.FieldDescriptor,
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_GenesisState) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_OutboundPaymentEntry) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "provlabs.vault.v1.GenesisState.vaults":
-		return len(x.Vaults) != 0
-	case "provlabs.vault.v1.GenesisState.payout_timeout_queue":
-		return len(x.PayoutTimeoutQueue) != 0
-	case "provlabs.vault.v1.GenesisState.pending_swap_out_queue":
-		return x.PendingSwapOutQueue != nil
-	case "provlabs.vault.v1.GenesisState.fee_timeout_queue":
-		return len(x.FeeTimeoutQueue) != 0
-	case "provlabs.vault.v1.GenesisState.params":
-		return x.Params != nil
-	case "provlabs.vault.v1.GenesisState.navs":
-		return len(x.Navs) != 0
+	case "provlabs.vault.v1.OutboundPaymentEntry.vault_address":
+		return x.VaultAddress != ""
+	case "provlabs.vault.v1.OutboundPaymentEntry.payment":
+		return x.Payment != nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: provlabs.vault.v1.GenesisState"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: provlabs.vault.v1.OutboundPaymentEntry"))
 		}
-		panic(fmt.Errorf("message provlabs.vault.v1.GenesisState does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message provlabs.vault.v1.OutboundPaymentEntry does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -2420,25 +6589,17 @@ func (x *fastReflection_GenesisState) Has(fd protoreflect.FieldDescriptor) bool
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_GenesisState) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_OutboundPaymentEntry) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "provlabs.vault.v1.GenesisState.vaults":
-		x.Vaults = nil
-	case "provlabs.vault.v1.GenesisState.payout_timeout_queue":
-		x.PayoutTimeoutQueue = nil
-	case "provlabs.vault.v1.GenesisState.pending_swap_out_queue":
-		x.PendingSwapOutQueue = nil
-	case "provlabs.vault.v1.GenesisState.fee_timeout_queue":
-		x.FeeTimeoutQueue = nil
-	case "provlabs.vault.v1.GenesisState.params":
-		x.Params = nil
-	case "provlabs.vault.v1.GenesisState.navs":
-		x.Navs = nil
+	case "provlabs.vault.v1.OutboundPaymentEntry.vault_address":
+		x.VaultAddress = ""
+	case "provlabs.vault.v1.OutboundPaymentEntry.payment":
+		x.Payment = nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: provlabs.vault.v1.GenesisState"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: provlabs.vault.v1.OutboundPaymentEntry"))
 		}
-		panic(fmt.Errorf("message provlabs.vault.v1.GenesisState does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message provlabs.vault.v1.OutboundPaymentEntry does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -2448,43 +6609,19 @@ func (x *fastReflection_GenesisState) Clear(fd protoreflect.FieldDescriptor) {
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_GenesisState) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_OutboundPaymentEntry) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "provlabs.vault.v1.GenesisState.vaults":
-		if len(x.Vaults) == 0 {
-			return protoreflect.ValueOfList(&_GenesisState_1_list{})
-		}
-		listValue := &_GenesisState_1_list{list: &x.Vaults}
-		return protoreflect.ValueOfList(listValue)
-	case "provlabs.vault.v1.GenesisState.payout_timeout_queue":
-		if len(x.PayoutTimeoutQueue) == 0 {
-			return protoreflect.ValueOfList(&_GenesisState_2_list{})
-		}
-		listValue := &_GenesisState_2_list{list: &x.PayoutTimeoutQueue}
-		return protoreflect.ValueOfList(listValue)
-	case "provlabs.vault.v1.GenesisState.pending_swap_out_queue":
-		value := x.PendingSwapOutQueue
-		return protoreflect.ValueOfMessage(value.ProtoReflect())
-	case "provlabs.vault.v1.GenesisState.fee_timeout_queue":
-		if len(x.FeeTimeoutQueue) == 0 {
-			return protoreflect.ValueOfList(&_GenesisState_4_list{})
-		}
-		listValue := &_GenesisState_4_list{list: &x.FeeTimeoutQueue}
-		return protoreflect.ValueOfList(listValue)
-	case "provlabs.vault.v1.GenesisState.params":
-		value := x.Params
+	case "provlabs.vault.v1.OutboundPaymentEntry.vault_address":
+		value := x.VaultAddress
+		return protoreflect.ValueOfString(value)
+	case "provlabs.vault.v1.OutboundPaymentEntry.payment":
+		value := x.Payment
 		return protoreflect.ValueOfMessage(value.ProtoReflect())
-	case "provlabs.vault.v1.GenesisState.navs":
-		if len(x.Navs) == 0 {
-			return protoreflect.ValueOfList(&_GenesisState_6_list{})
-		}
-		listValue := &_GenesisState_6_list{list: &x.Navs}
-		return protoreflect.ValueOfList(listValue)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: provlabs.vault.v1.GenesisState"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: provlabs.vault.v1.OutboundPaymentEntry"))
 		}
-		panic(fmt.Errorf("message provlabs.vault.v1.GenesisState does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message provlabs.vault.v1.OutboundPaymentEntry does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -2498,33 +6635,17 @@ func (x *fastReflection_GenesisState) Get(descriptor protoreflect.FieldDescripto
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_GenesisState) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_OutboundPaymentEntry) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "provlabs.vault.v1.GenesisState.vaults":
-		lv := value.List()
-		clv := lv.(*_GenesisState_1_list)
-		x.Vaults = *clv.list
-	case "provlabs.vault.v1.GenesisState.payout_timeout_queue":
-		lv := value.List()
-		clv := lv.(*_GenesisState_2_list)
-		x.PayoutTimeoutQueue = *clv.list
-	case "provlabs.vault.v1.GenesisState.pending_swap_out_queue":
-		x.PendingSwapOutQueue = value.Message().Interface().(*PendingSwapOutQueue)
-	case "provlabs.vault.v1.GenesisState.fee_timeout_queue":
-		lv := value.List()
-		clv := lv.(*_GenesisState_4_list)
-		x.FeeTimeoutQueue = *clv.list
-	case "provlabs.vault.v1.GenesisState.params":
-		x.Params = value.Message().Interface().(*Params)
-	case "provlabs.vault.v1.GenesisState.navs":
-		lv := value.List()
-		clv := lv.(*_GenesisState_6_list)
-		x.Navs = *clv.list
+	case "provlabs.vault.v1.OutboundPaymentEntry.vault_address":
+		x.VaultAddress = value.Interface().(string)
+	case "provlabs.vault.v1.OutboundPaymentEntry.payment":
+		x.Payment = value.Message().Interface().(*OutboundPayment)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: provlabs.vault.v1.GenesisState"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: provlabs.vault.v1.OutboundPaymentEntry"))
 		}
-		panic(fmt.Errorf("message provlabs.vault.v1.GenesisState does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message provlabs.vault.v1.OutboundPaymentEntry does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -2538,88 +6659,48 @@ func (x *fastReflection_GenesisState) Set(fd protoreflect.FieldDescriptor, value
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_GenesisState) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_OutboundPaymentEntry) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "provlabs.vault.v1.GenesisState.vaults":
-		if x.Vaults == nil {
-			x.Vaults = []*VaultAccount{}
-		}
-		value := &_GenesisState_1_list{list: &x.Vaults}
-		return protoreflect.ValueOfList(value)
-	case "provlabs.vault.v1.GenesisState.payout_timeout_queue":
-		if x.PayoutTimeoutQueue == nil {
-			x.PayoutTimeoutQueue = []*QueueEntry{}
-		}
-		value := &_GenesisState_2_list{list: &x.PayoutTimeoutQueue}
-		return protoreflect.ValueOfList(value)
-	case "provlabs.vault.v1.GenesisState.pending_swap_out_queue":
-		if x.PendingSwapOutQueue == nil {
-			x.PendingSwapOutQueue = new(PendingSwapOutQueue)
-		}
-		return protoreflect.ValueOfMessage(x.PendingSwapOutQueue.ProtoReflect())
-	case "provlabs.vault.v1.GenesisState.fee_timeout_queue":
-		if x.FeeTimeoutQueue == nil {
-			x.FeeTimeoutQueue = []*QueueEntry{}
-		}
-		value := &_GenesisState_4_list{list: &x.FeeTimeoutQueue}
-		return protoreflect.ValueOfList(value)
-	case "provlabs.vault.v1.GenesisState.params":
-		if x.Params == nil {
-			x.Params = new(Params)
+	case "provlabs.vault.v1.OutboundPaymentEntry.payment":
+		if x.Payment == nil {
+			x.Payment = new(OutboundPayment)
 		}
-		return protoreflect.ValueOfMessage(x.Params.ProtoReflect())
-	case "provlabs.vault.v1.GenesisState.navs":
-		if x.Navs == nil {
-			x.Navs = []*VaultNAVEntry{}
-		}
-		value := &_GenesisState_6_list{list: &x.Navs}
-		return protoreflect.ValueOfList(value)
+		return protoreflect.ValueOfMessage(x.Payment.ProtoReflect())
+	case "provlabs.vault.v1.OutboundPaymentEntry.vault_address":
+		panic(fmt.Errorf("field vault_address of message provlabs.vault.v1.OutboundPaymentEntry is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: provlabs.vault.v1.GenesisState"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: provlabs.vault.v1.OutboundPaymentEntry"))
 		}
-		panic(fmt.Errorf("message provlabs.vault.v1.GenesisState does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message provlabs.vault.v1.OutboundPaymentEntry does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_GenesisState) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_OutboundPaymentEntry) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "provlabs.vault.v1.GenesisState.vaults":
-		list := []*VaultAccount{}
-		return protoreflect.ValueOfList(&_GenesisState_1_list{list: &list})
-	case "provlabs.vault.v1.GenesisState.payout_timeout_queue":
-		list := []*QueueEntry{}
-		return protoreflect.ValueOfList(&_GenesisState_2_list{list: &list})
-	case "provlabs.vault.v1.GenesisState.pending_swap_out_queue":
-		m := new(PendingSwapOutQueue)
-		return protoreflect.ValueOfMessage(m.ProtoReflect())
-	case "provlabs.vault.v1.GenesisState.fee_timeout_queue":
-		list := []*QueueEntry{}
-		return protoreflect.ValueOfList(&_GenesisState_4_list{list: &list})
-	case "provlabs.vault.v1.GenesisState.params":
-		m := new(Params)
+	case "provlabs.vault.v1.OutboundPaymentEntry.vault_address":
+		return protoreflect.ValueOfString("")
+	case "provlabs.vault.v1.OutboundPaymentEntry.payment":
+		m := new(OutboundPayment)
 		return protoreflect.ValueOfMessage(m.ProtoReflect())
-	case "provlabs.vault.v1.GenesisState.navs":
-		list := []*VaultNAVEntry{}
-		return protoreflect.ValueOfList(&_GenesisState_6_list{list: &list})
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: provlabs.vault.v1.GenesisState"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: provlabs.vault.v1.OutboundPaymentEntry"))
 		}
-		panic(fmt.Errorf("message provlabs.vault.v1.GenesisState does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message provlabs.vault.v1.OutboundPaymentEntry does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_GenesisState) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_OutboundPaymentEntry) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in provlabs.vault.v1.GenesisState", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in provlabs.vault.v1.OutboundPaymentEntry", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -2627,7 +6708,7 @@ func (x *fastReflection_GenesisState) WhichOneof(d protoreflect.OneofDescriptor)
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_GenesisState) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_OutboundPaymentEntry) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -2638,7 +6719,7 @@ func (x *fastReflection_GenesisState) GetUnknown() protoreflect.RawFields {
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_GenesisState) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_OutboundPaymentEntry) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -2650,7 +6731,7 @@ func (x *fastReflection_GenesisState) SetUnknown(fields protoreflect.RawFields)
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_GenesisState) IsValid() bool {
+func (x *fastReflection_OutboundPaymentEntry) IsValid() bool {
 	return x != nil
 }
 
@@ -2660,9 +6741,9 @@ func (x *fastReflection_GenesisState) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_OutboundPaymentEntry) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*GenesisState)
+		x := input.Message.Interface().(*OutboundPaymentEntry)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -2671,40 +6752,16 @@ func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
 		}
 		options := runtime.SizeInputToOptions(input)
 		_ = options
-		var n int
-		var l int
-		_ = l
-		if len(x.Vaults) > 0 {
-			for _, e := range x.Vaults {
-				l = options.Size(e)
-				n += 1 + l + runtime.Sov(uint64(l))
-			}
-		}
-		if len(x.PayoutTimeoutQueue) > 0 {
-			for _, e := range x.PayoutTimeoutQueue {
-				l = options.Size(e)
-				n += 1 + l + runtime.Sov(uint64(l))
-			}
-		}
-		if x.PendingSwapOutQueue != nil {
-			l = options.Size(x.PendingSwapOutQueue)
-			n += 1 + l + runtime.Sov(uint64(l))
-		}
-		if len(x.FeeTimeoutQueue) > 0 {
-			for _, e := range x.FeeTimeoutQueue {
-				l = options.Size(e)
-				n += 1 + l + runtime.Sov(uint64(l))
-			}
-		}
-		if x.Params != nil {
-			l = options.Size(x.Params)
+		var n int
+		var l int
+		_ = l
+		l = len(x.VaultAddress)
+		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		if len(x.Navs) > 0 {
-			for _, e := range x.Navs {
-				l = options.Size(e)
-				n += 1 + l + runtime.Sov(uint64(l))
-			}
+		if x.Payment != nil {
+			l = options.Size(x.Payment)
+			n += 1 + l + runtime.Sov(uint64(l))
 		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
@@ -2716,7 +6773,7 @@ func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*GenesisState)
+		x := input.Message.Interface().(*OutboundPaymentEntry)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -2735,24 +6792,8 @@ func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if len(x.Navs) > 0 {
-			for iNdEx := len(x.Navs) - 1; iNdEx >= 0; iNdEx-- {
-				encoded, err := options.Marshal(x.Navs[iNdEx])
-				if err != nil {
-					return protoiface.MarshalOutput{
-						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-						Buf:               input.Buf,
-					}, err
-				}
-				i -= len(encoded)
-				copy(dAtA[i:], encoded)
-				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
-				i--
-				dAtA[i] = 0x32
-			}
-		}
-		if x.Params != nil {
-			encoded, err := options.Marshal(x.Params)
+		if x.Payment != nil {
+			encoded, err := options.Marshal(x.Payment)
 			if err != nil {
 				return protoiface.MarshalOutput{
 					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -2763,69 +6804,14 @@ func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
 			copy(dAtA[i:], encoded)
 			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
 			i--
-			dAtA[i] = 0x2a
-		}
-		if len(x.FeeTimeoutQueue) > 0 {
-			for iNdEx := len(x.FeeTimeoutQueue) - 1; iNdEx >= 0; iNdEx-- {
-				encoded, err := options.Marshal(x.FeeTimeoutQueue[iNdEx])
-				if err != nil {
-					return protoiface.MarshalOutput{
-						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-						Buf:               input.Buf,
-					}, err
-				}
-				i -= len(encoded)
-				copy(dAtA[i:], encoded)
-				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
-				i--
-				dAtA[i] = 0x22
-			}
+			dAtA[i] = 0x12
 		}
-		if x.PendingSwapOutQueue != nil {
-			encoded, err := options.Marshal(x.PendingSwapOutQueue)
-			if err != nil {
-				return protoiface.MarshalOutput{
-					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-					Buf:               input.Buf,
-				}, err
-			}
-			i -= len(encoded)
-			copy(dAtA[i:], encoded)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+		if len(x.VaultAddress) > 0 {
+			i -= len(x.VaultAddress)
+			copy(dAtA[i:], x.VaultAddress)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.VaultAddress)))
 			i--
-			dAtA[i] = 0x1a
-		}
-		if len(x.PayoutTimeoutQueue) > 0 {
-			for iNdEx := len(x.PayoutTimeoutQueue) - 1; iNdEx >= 0; iNdEx-- {
-				encoded, err := options.Marshal(x.PayoutTimeoutQueue[iNdEx])
-				if err != nil {
-					return protoiface.MarshalOutput{
-						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-						Buf:               input.Buf,
-					}, err
-				}
-				i -= len(encoded)
-				copy(dAtA[i:], encoded)
-				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
-				i--
-				dAtA[i] = 0x12
-			}
-		}
-		if len(x.Vaults) > 0 {
-			for iNdEx := len(x.Vaults) - 1; iNdEx >= 0; iNdEx-- {
-				encoded, err := options.Marshal(x.Vaults[iNdEx])
-				if err != nil {
-					return protoiface.MarshalOutput{
-						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-						Buf:               input.Buf,
-					}, err
-				}
-				i -= len(encoded)
-				copy(dAtA[i:], encoded)
-				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
-				i--
-				dAtA[i] = 0xa
-			}
+			dAtA[i] = 0xa
 		}
 		if input.Buf != nil {
 			input.Buf = append(input.Buf, dAtA...)
@@ -2838,7 +6824,7 @@ func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*GenesisState)
+		x := input.Message.Interface().(*OutboundPaymentEntry)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -2870,17 +6856,17 @@ func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: GenesisState: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: OutboundPaymentEntry: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: GenesisState: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: OutboundPaymentEntry: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Vaults", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field VaultAddress", wireType)
 				}
-				var msglen int
+				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -2890,169 +6876,27 @@ func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					msglen |= int(b&0x7F) << shift
+					stringLen |= uint64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				if msglen < 0 {
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				postIndex := iNdEx + msglen
+				postIndex := iNdEx + intStringLen
 				if postIndex < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.Vaults = append(x.Vaults, &VaultAccount{})
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Vaults[len(x.Vaults)-1]); err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
-				}
+				x.VaultAddress = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
 			case 2:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PayoutTimeoutQueue", wireType)
-				}
-				var msglen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					msglen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				if msglen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + msglen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				x.PayoutTimeoutQueue = append(x.PayoutTimeoutQueue, &QueueEntry{})
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.PayoutTimeoutQueue[len(x.PayoutTimeoutQueue)-1]); err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
-				}
-				iNdEx = postIndex
-			case 3:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PendingSwapOutQueue", wireType)
-				}
-				var msglen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					msglen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				if msglen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + msglen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				if x.PendingSwapOutQueue == nil {
-					x.PendingSwapOutQueue = &PendingSwapOutQueue{}
-				}
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.PendingSwapOutQueue); err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
-				}
-				iNdEx = postIndex
-			case 4:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field FeeTimeoutQueue", wireType)
-				}
-				var msglen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					msglen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				if msglen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + msglen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				x.FeeTimeoutQueue = append(x.FeeTimeoutQueue, &QueueEntry{})
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.FeeTimeoutQueue[len(x.FeeTimeoutQueue)-1]); err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
-				}
-				iNdEx = postIndex
-			case 5:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
-				}
-				var msglen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					msglen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				if msglen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + msglen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				if x.Params == nil {
-					x.Params = &Params{}
-				}
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Params); err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
-				}
-				iNdEx = postIndex
-			case 6:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Navs", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Payment", wireType)
 				}
 				var msglen int
 				for shift := uint(0); ; shift += 7 {
@@ -3079,8 +6923,10 @@ func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.Navs = append(x.Navs, &VaultNAVEntry{})
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Navs[len(x.Navs)-1]); err != nil {
+				if x.Payment == nil {
+					x.Payment = &OutboundPayment{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Payment); err != nil {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
@@ -3350,6 +7196,29 @@ type GenesisState struct {
 	Params *Params `protobuf:"bytes,5,opt,name=params,proto3" json:"params,omitempty"`
 	// navs contains the internal NAV table entries for all vaults at genesis.
 	Navs []*VaultNAVEntry `protobuf:"bytes,6,rep,name=navs,proto3" json:"navs,omitempty"`
+	// pending_nav_proposals contains the NAV updates awaiting approval for all vaults at genesis.
+	PendingNavProposals []*PendingNAVProposal `protobuf:"bytes,7,rep,name=pending_nav_proposals,json=pendingNavProposals,proto3" json:"pending_nav_proposals,omitempty"`
+	// nav_source_submissions contains the per-source NAV submissions for all vaults at genesis.
+	NavSourceSubmissions []*NAVSourceSubmission `protobuf:"bytes,8,rep,name=nav_source_submissions,json=navSourceSubmissions,proto3" json:"nav_source_submissions,omitempty"`
+	// nav_history contains the NAV history time series points for all vaults at genesis.
+	NavHistory []*VaultNAVEntry `protobuf:"bytes,9,rep,name=nav_history,json=navHistory,proto3" json:"nav_history,omitempty"`
+	// settlement_prices contains each vault's most recent exchange settlement price for
+	// each (denom, price denom) pair at genesis.
+	SettlementPrices []*VaultNAVEntry `protobuf:"bytes,10,rep,name=settlement_prices,json=settlementPrices,proto3" json:"settlement_prices,omitempty"`
+	// asset_haircuts contains the valuation haircuts configured for all vaults at genesis.
+	AssetHaircuts []*AssetHaircutEntry `protobuf:"bytes,11,rep,name=asset_haircuts,json=assetHaircuts,proto3" json:"asset_haircuts,omitempty"`
+	// share_price_observations contains the share price accumulator observations for all
+	// vaults at genesis.
+	SharePriceObservations []*SharePriceObservationEntry `protobuf:"bytes,12,rep,name=share_price_observations,json=sharePriceObservations,proto3" json:"share_price_observations,omitempty"`
+	// outbound_payments contains the open exchange payments created by vaults at genesis.
+	OutboundPayments []*OutboundPaymentEntry `protobuf:"bytes,13,rep,name=outbound_payments,json=outboundPayments,proto3" json:"outbound_payments,omitempty"`
+	// scheduled_action_queue contains the scheduled actions awaiting their vaults' timelocks.
+	ScheduledActionQueue *ScheduledActionQueue `protobuf:"bytes,14,opt,name=scheduled_action_queue,json=scheduledActionQueue,proto3" json:"scheduled_action_queue,omitempty"`
+	// allowlist contains the investor allowlist entries for all vaults at genesis.
+	Allowlist []*AllowlistEntry `protobuf:"bytes,15,rep,name=allowlist,proto3" json:"allowlist,omitempty"`
+	// nav_references contains, for all vaults at genesis, the price each denom's NAV change
+	// limit is measured against.
+	NavReferences []*VaultNAVEntry `protobuf:"bytes,16,rep,name=nav_references,json=navReferences,proto3" json:"nav_references,omitempty"`
 }
 
 func (x *GenesisState) Reset() {
@@ -3414,6 +7283,310 @@ func (x *GenesisState) GetNavs() []*VaultNAVEntry {
 	return nil
 }
 
+func (x *GenesisState) GetPendingNavProposals() []*PendingNAVProposal {
+	if x != nil {
+		return x.PendingNavProposals
+	}
+	return nil
+}
+
+func (x *GenesisState) GetNavSourceSubmissions() []*NAVSourceSubmission {
+	if x != nil {
+		return x.NavSourceSubmissions
+	}
+	return nil
+}
+
+func (x *GenesisState) GetNavHistory() []*VaultNAVEntry {
+	if x != nil {
+		return x.NavHistory
+	}
+	return nil
+}
+
+func (x *GenesisState) GetSettlementPrices() []*VaultNAVEntry {
+	if x != nil {
+		return x.SettlementPrices
+	}
+	return nil
+}
+
+func (x *GenesisState) GetAssetHaircuts() []*AssetHaircutEntry {
+	if x != nil {
+		return x.AssetHaircuts
+	}
+	return nil
+}
+
+func (x *GenesisState) GetSharePriceObservations() []*SharePriceObservationEntry {
+	if x != nil {
+		return x.SharePriceObservations
+	}
+	return nil
+}
+
+func (x *GenesisState) GetOutboundPayments() []*OutboundPaymentEntry {
+	if x != nil {
+		return x.OutboundPayments
+	}
+	return nil
+}
+
+func (x *GenesisState) GetScheduledActionQueue() *ScheduledActionQueue {
+	if x != nil {
+		return x.ScheduledActionQueue
+	}
+	return nil
+}
+
+func (x *GenesisState) GetAllowlist() []*AllowlistEntry {
+	if x != nil {
+		return x.Allowlist
+	}
+	return nil
+}
+
+func (x *GenesisState) GetNavReferences() []*VaultNAVEntry {
+	if x != nil {
+		return x.NavReferences
+	}
+	return nil
+}
+
+// AllowlistEntry pairs a vault address with an address on its investor allowlist for genesis
+// import and export.
+type AllowlistEntry struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// vault_address is the bech32 address of the vault that owns the allowlist.
+	VaultAddress string `protobuf:"bytes,1,opt,name=vault_address,json=vaultAddress,proto3" json:"vault_address,omitempty"`
+	// address is the bech32 address on the allowlist.
+	Address string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+}
+
+func (x *AllowlistEntry) Reset() {
+	*x = AllowlistEntry{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_provlabs_vault_v1_genesis_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AllowlistEntry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AllowlistEntry) ProtoMessage() {}
+
+// Deprecated: Use AllowlistEntry.ProtoReflect.Descriptor instead.
+func (*AllowlistEntry) Descriptor() ([]byte, []int) {
+	return file_provlabs_vault_v1_genesis_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *AllowlistEntry) GetVaultAddress() string {
+	if x != nil {
+		return x.VaultAddress
+	}
+	return ""
+}
+
+func (x *AllowlistEntry) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+// ScheduledActionQueue holds the latest sequence number and all scheduled actions.
+type ScheduledActionQueue struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// latest_sequence_number is the most recently assigned scheduled action ID.
+	LatestSequenceNumber uint64 `protobuf:"varint,1,opt,name=latest_sequence_number,json=latestSequenceNumber,proto3" json:"latest_sequence_number,omitempty"`
+	// actions contains all scheduled actions awaiting execution.
+	Actions []*ScheduledAction `protobuf:"bytes,2,rep,name=actions,proto3" json:"actions,omitempty"`
+}
+
+func (x *ScheduledActionQueue) Reset() {
+	*x = ScheduledActionQueue{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_provlabs_vault_v1_genesis_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ScheduledActionQueue) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ScheduledActionQueue) ProtoMessage() {}
+
+// Deprecated: Use ScheduledActionQueue.ProtoReflect.Descriptor instead.
+func (*ScheduledActionQueue) Descriptor() ([]byte, []int) {
+	return file_provlabs_vault_v1_genesis_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *ScheduledActionQueue) GetLatestSequenceNumber() uint64 {
+	if x != nil {
+		return x.LatestSequenceNumber
+	}
+	return 0
+}
+
+func (x *ScheduledActionQueue) GetActions() []*ScheduledAction {
+	if x != nil {
+		return x.Actions
+	}
+	return nil
+}
+
+// AssetHaircutEntry pairs a vault address with one of its valuation haircuts for genesis
+// import and export.
+type AssetHaircutEntry struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// vault_address is the bech32 address of the vault that owns this haircut.
+	VaultAddress string `protobuf:"bytes,1,opt,name=vault_address,json=vaultAddress,proto3" json:"vault_address,omitempty"`
+	// haircut is the valuation haircut configured by the vault.
+	Haircut *AssetHaircut `protobuf:"bytes,2,opt,name=haircut,proto3" json:"haircut,omitempty"`
+}
+
+func (x *AssetHaircutEntry) Reset() {
+	*x = AssetHaircutEntry{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_provlabs_vault_v1_genesis_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AssetHaircutEntry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AssetHaircutEntry) ProtoMessage() {}
+
+// Deprecated: Use AssetHaircutEntry.ProtoReflect.Descriptor instead.
+func (*AssetHaircutEntry) Descriptor() ([]byte, []int) {
+	return file_provlabs_vault_v1_genesis_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *AssetHaircutEntry) GetVaultAddress() string {
+	if x != nil {
+		return x.VaultAddress
+	}
+	return ""
+}
+
+func (x *AssetHaircutEntry) GetHaircut() *AssetHaircut {
+	if x != nil {
+		return x.Haircut
+	}
+	return nil
+}
+
+// SharePriceObservationEntry pairs a vault address with one of its share price
+// accumulator observations for genesis import and export.
+type SharePriceObservationEntry struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// vault_address is the bech32 address of the vault that owns this observation.
+	VaultAddress string `protobuf:"bytes,1,opt,name=vault_address,json=vaultAddress,proto3" json:"vault_address,omitempty"`
+	// observation is the share price accumulator observation.
+	Observation *SharePriceObservation `protobuf:"bytes,2,opt,name=observation,proto3" json:"observation,omitempty"`
+}
+
+func (x *SharePriceObservationEntry) Reset() {
+	*x = SharePriceObservationEntry{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_provlabs_vault_v1_genesis_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SharePriceObservationEntry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SharePriceObservationEntry) ProtoMessage() {}
+
+// Deprecated: Use SharePriceObservationEntry.ProtoReflect.Descriptor instead.
+func (*SharePriceObservationEntry) Descriptor() ([]byte, []int) {
+	return file_provlabs_vault_v1_genesis_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *SharePriceObservationEntry) GetVaultAddress() string {
+	if x != nil {
+		return x.VaultAddress
+	}
+	return ""
+}
+
+func (x *SharePriceObservationEntry) GetObservation() *SharePriceObservation {
+	if x != nil {
+		return x.Observation
+	}
+	return nil
+}
+
+// OutboundPaymentEntry pairs a vault address with one of its open outbound exchange
+// payments for genesis import and export.
+type OutboundPaymentEntry struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// vault_address is the bech32 address of the vault that created the payment.
+	VaultAddress string `protobuf:"bytes,1,opt,name=vault_address,json=vaultAddress,proto3" json:"vault_address,omitempty"`
+	// payment is the open outbound payment.
+	Payment *OutboundPayment `protobuf:"bytes,2,opt,name=payment,proto3" json:"payment,omitempty"`
+}
+
+func (x *OutboundPaymentEntry) Reset() {
+	*x = OutboundPaymentEntry{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_provlabs_vault_v1_genesis_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *OutboundPaymentEntry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OutboundPaymentEntry) ProtoMessage() {}
+
+// Deprecated: Use OutboundPaymentEntry.ProtoReflect.Descriptor instead.
+func (*OutboundPaymentEntry) Descriptor() ([]byte, []int) {
+	return file_provlabs_vault_v1_genesis_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *OutboundPaymentEntry) GetVaultAddress() string {
+	if x != nil {
+		return x.VaultAddress
+	}
+	return ""
+}
+
+func (x *OutboundPaymentEntry) GetPayment() *OutboundPayment {
+	if x != nil {
+		return x.Payment
+	}
+	return nil
+}
+
 var File_provlabs_vault_v1_genesis_proto protoreflect.FileDescriptor
 
 var file_provlabs_vault_v1_genesis_proto_rawDesc = []byte{
@@ -3453,7 +7626,7 @@ var file_provlabs_vault_v1_genesis_proto_rawDesc = []byte{
 	0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x33, 0x0a, 0x03, 0x6e, 0x61, 0x76, 0x18, 0x02, 0x20, 0x01,
 	0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x70, 0x72, 0x6f, 0x76, 0x6c, 0x61, 0x62, 0x73, 0x2e, 0x76, 0x61,
 	0x75, 0x6c, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x56, 0x61, 0x75, 0x6c, 0x74, 0x4e, 0x41, 0x56, 0x42,
-	0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x03, 0x6e, 0x61, 0x76, 0x22, 0xcd, 0x03, 0x0a, 0x0c, 0x47,
+	0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x03, 0x6e, 0x61, 0x76, 0x22, 0xc9, 0x0a, 0x0a, 0x0c, 0x47,
 	0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x3d, 0x0a, 0x06, 0x76,
 	0x61, 0x75, 0x6c, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x70, 0x72,
 	0x6f, 0x76, 0x6c, 0x61, 0x62, 0x73, 0x2e, 0x76, 0x61, 0x75, 0x6c, 0x74, 0x2e, 0x76, 0x31, 0x2e,
@@ -3482,20 +7655,115 @@ var file_provlabs_vault_v1_genesis_proto_rawDesc = []byte{
 	0x0a, 0x04, 0x6e, 0x61, 0x76, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x70,
 	0x72, 0x6f, 0x76, 0x6c, 0x61, 0x62, 0x73, 0x2e, 0x76, 0x61, 0x75, 0x6c, 0x74, 0x2e, 0x76, 0x31,
 	0x2e, 0x56, 0x61, 0x75, 0x6c, 0x74, 0x4e, 0x41, 0x56, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x42, 0x04,
-	0xc8, 0xde, 0x1f, 0x00, 0x52, 0x04, 0x6e, 0x61, 0x76, 0x73, 0x42, 0xc4, 0x01, 0x0a, 0x15, 0x63,
-	0x6f, 0x6d, 0x2e, 0x70, 0x72, 0x6f, 0x76, 0x6c, 0x61, 0x62, 0x73, 0x2e, 0x76, 0x61, 0x75, 0x6c,
-	0x74, 0x2e, 0x76, 0x31, 0x42, 0x0c, 0x47, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x50, 0x72, 0x6f,
-	0x74, 0x6f, 0x50, 0x01, 0x5a, 0x37, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d,
-	0x2f, 0x70, 0x72, 0x6f, 0x76, 0x6c, 0x61, 0x62, 0x73, 0x2f, 0x76, 0x61, 0x75, 0x6c, 0x74, 0x2f,
-	0x61, 0x70, 0x69, 0x2f, 0x70, 0x72, 0x6f, 0x76, 0x6c, 0x61, 0x62, 0x73, 0x2f, 0x76, 0x61, 0x75,
-	0x6c, 0x74, 0x2f, 0x76, 0x31, 0x3b, 0x76, 0x61, 0x75, 0x6c, 0x74, 0x76, 0x31, 0xa2, 0x02, 0x03,
-	0x50, 0x56, 0x58, 0xaa, 0x02, 0x11, 0x50, 0x72, 0x6f, 0x76, 0x6c, 0x61, 0x62, 0x73, 0x2e, 0x56,
-	0x61, 0x75, 0x6c, 0x74, 0x2e, 0x56, 0x31, 0xca, 0x02, 0x11, 0x50, 0x72, 0x6f, 0x76, 0x6c, 0x61,
-	0x62, 0x73, 0x5c, 0x56, 0x61, 0x75, 0x6c, 0x74, 0x5c, 0x56, 0x31, 0xe2, 0x02, 0x1d, 0x50, 0x72,
-	0x6f, 0x76, 0x6c, 0x61, 0x62, 0x73, 0x5c, 0x56, 0x61, 0x75, 0x6c, 0x74, 0x5c, 0x56, 0x31, 0x5c,
-	0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x13, 0x50, 0x72,
-	0x6f, 0x76, 0x6c, 0x61, 0x62, 0x73, 0x3a, 0x3a, 0x56, 0x61, 0x75, 0x6c, 0x74, 0x3a, 0x3a, 0x56,
-	0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0xc8, 0xde, 0x1f, 0x00, 0x52, 0x04, 0x6e, 0x61, 0x76, 0x73, 0x12, 0x5f, 0x0a, 0x15, 0x70, 0x65,
+	0x6e, 0x64, 0x69, 0x6e, 0x67, 0x5f, 0x6e, 0x61, 0x76, 0x5f, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73,
+	0x61, 0x6c, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x70, 0x72, 0x6f, 0x76,
+	0x6c, 0x61, 0x62, 0x73, 0x2e, 0x76, 0x61, 0x75, 0x6c, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x65,
+	0x6e, 0x64, 0x69, 0x6e, 0x67, 0x4e, 0x41, 0x56, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c,
+	0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x13, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x4e,
+	0x61, 0x76, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x73, 0x12, 0x62, 0x0a, 0x16, 0x6e,
+	0x61, 0x76, 0x5f, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x73, 0x75, 0x62, 0x6d, 0x69, 0x73,
+	0x73, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x70, 0x72,
+	0x6f, 0x76, 0x6c, 0x61, 0x62, 0x73, 0x2e, 0x76, 0x61, 0x75, 0x6c, 0x74, 0x2e, 0x76, 0x31, 0x2e,
+	0x4e, 0x41, 0x56, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73,
+	0x69, 0x6f, 0x6e, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x14, 0x6e, 0x61, 0x76, 0x53, 0x6f,
+	0x75, 0x72, 0x63, 0x65, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x12,
+	0x47, 0x0a, 0x0b, 0x6e, 0x61, 0x76, 0x5f, 0x68, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x18, 0x09,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x70, 0x72, 0x6f, 0x76, 0x6c, 0x61, 0x62, 0x73, 0x2e,
+	0x76, 0x61, 0x75, 0x6c, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x56, 0x61, 0x75, 0x6c, 0x74, 0x4e, 0x41,
+	0x56, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x0a, 0x6e, 0x61,
+	0x76, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x12, 0x53, 0x0a, 0x11, 0x73, 0x65, 0x74, 0x74,
+	0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x70, 0x72, 0x69, 0x63, 0x65, 0x73, 0x18, 0x0a, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x70, 0x72, 0x6f, 0x76, 0x6c, 0x61, 0x62, 0x73, 0x2e, 0x76,
+	0x61, 0x75, 0x6c, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x56, 0x61, 0x75, 0x6c, 0x74, 0x4e, 0x41, 0x56,
+	0x45, 0x6e, 0x74, 0x72, 0x79, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x10, 0x73, 0x65, 0x74,
+	0x74, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x50, 0x72, 0x69, 0x63, 0x65, 0x73, 0x12, 0x51, 0x0a,
+	0x0e, 0x61, 0x73, 0x73, 0x65, 0x74, 0x5f, 0x68, 0x61, 0x69, 0x72, 0x63, 0x75, 0x74, 0x73, 0x18,
+	0x0b, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x70, 0x72, 0x6f, 0x76, 0x6c, 0x61, 0x62, 0x73,
+	0x2e, 0x76, 0x61, 0x75, 0x6c, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x73, 0x73, 0x65, 0x74, 0x48,
+	0x61, 0x69, 0x72, 0x63, 0x75, 0x74, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x42, 0x04, 0xc8, 0xde, 0x1f,
+	0x00, 0x52, 0x0d, 0x61, 0x73, 0x73, 0x65, 0x74, 0x48, 0x61, 0x69, 0x72, 0x63, 0x75, 0x74, 0x73,
+	0x12, 0x6d, 0x0a, 0x18, 0x73, 0x68, 0x61, 0x72, 0x65, 0x5f, 0x70, 0x72, 0x69, 0x63, 0x65, 0x5f,
+	0x6f, 0x62, 0x73, 0x65, 0x72, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x0c, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x2d, 0x2e, 0x70, 0x72, 0x6f, 0x76, 0x6c, 0x61, 0x62, 0x73, 0x2e, 0x76, 0x61,
+	0x75, 0x6c, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x68, 0x61, 0x72, 0x65, 0x50, 0x72, 0x69, 0x63,
+	0x65, 0x4f, 0x62, 0x73, 0x65, 0x72, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x6e, 0x74, 0x72,
+	0x79, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x16, 0x73, 0x68, 0x61, 0x72, 0x65, 0x50, 0x72,
+	0x69, 0x63, 0x65, 0x4f, 0x62, 0x73, 0x65, 0x72, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12,
+	0x5a, 0x0a, 0x11, 0x6f, 0x75, 0x74, 0x62, 0x6f, 0x75, 0x6e, 0x64, 0x5f, 0x70, 0x61, 0x79, 0x6d,
+	0x65, 0x6e, 0x74, 0x73, 0x18, 0x0d, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x70, 0x72, 0x6f,
+	0x76, 0x6c, 0x61, 0x62, 0x73, 0x2e, 0x76, 0x61, 0x75, 0x6c, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x4f,
+	0x75, 0x74, 0x62, 0x6f, 0x75, 0x6e, 0x64, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x45, 0x6e,
+	0x74, 0x72, 0x79, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x10, 0x6f, 0x75, 0x74, 0x62, 0x6f,
+	0x75, 0x6e, 0x64, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x63, 0x0a, 0x16, 0x73,
+	0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x64, 0x5f, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f,
+	0x71, 0x75, 0x65, 0x75, 0x65, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x70, 0x72,
+	0x6f, 0x76, 0x6c, 0x61, 0x62, 0x73, 0x2e, 0x76, 0x61, 0x75, 0x6c, 0x74, 0x2e, 0x76, 0x31, 0x2e,
+	0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x64, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x51,
+	0x75, 0x65, 0x75, 0x65, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x14, 0x73, 0x63, 0x68, 0x65,
+	0x64, 0x75, 0x6c, 0x65, 0x64, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x51, 0x75, 0x65, 0x75, 0x65,
+	0x12, 0x45, 0x0a, 0x09, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x6c, 0x69, 0x73, 0x74, 0x18, 0x0f, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x70, 0x72, 0x6f, 0x76, 0x6c, 0x61, 0x62, 0x73, 0x2e, 0x76,
+	0x61, 0x75, 0x6c, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x6c, 0x6c, 0x6f, 0x77, 0x6c, 0x69, 0x73,
+	0x74, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x09, 0x61, 0x6c,
+	0x6c, 0x6f, 0x77, 0x6c, 0x69, 0x73, 0x74, 0x12, 0x4d, 0x0a, 0x0e, 0x6e, 0x61, 0x76, 0x5f, 0x72,
+	0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x18, 0x10, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x20, 0x2e, 0x70, 0x72, 0x6f, 0x76, 0x6c, 0x61, 0x62, 0x73, 0x2e, 0x76, 0x61, 0x75, 0x6c, 0x74,
+	0x2e, 0x76, 0x31, 0x2e, 0x56, 0x61, 0x75, 0x6c, 0x74, 0x4e, 0x41, 0x56, 0x45, 0x6e, 0x74, 0x72,
+	0x79, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x0d, 0x6e, 0x61, 0x76, 0x52, 0x65, 0x66, 0x65,
+	0x72, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x22, 0x4f, 0x0a, 0x0e, 0x41, 0x6c, 0x6c, 0x6f, 0x77, 0x6c,
+	0x69, 0x73, 0x74, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x23, 0x0a, 0x0d, 0x76, 0x61, 0x75, 0x6c,
+	0x74, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0c, 0x76, 0x61, 0x75, 0x6c, 0x74, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x18, 0x0a,
+	0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
+	0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x22, 0x90, 0x01, 0x0a, 0x14, 0x53, 0x63, 0x68, 0x65,
+	0x64, 0x75, 0x6c, 0x65, 0x64, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x51, 0x75, 0x65, 0x75, 0x65,
+	0x12, 0x34, 0x0a, 0x16, 0x6c, 0x61, 0x74, 0x65, 0x73, 0x74, 0x5f, 0x73, 0x65, 0x71, 0x75, 0x65,
+	0x6e, 0x63, 0x65, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x14, 0x6c, 0x61, 0x74, 0x65, 0x73, 0x74, 0x53, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65,
+	0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x42, 0x0a, 0x07, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x70, 0x72, 0x6f, 0x76, 0x6c, 0x61,
+	0x62, 0x73, 0x2e, 0x76, 0x61, 0x75, 0x6c, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x63, 0x68, 0x65,
+	0x64, 0x75, 0x6c, 0x65, 0x64, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x04, 0xc8, 0xde, 0x1f,
+	0x00, 0x52, 0x07, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x79, 0x0a, 0x11, 0x41, 0x73,
+	0x73, 0x65, 0x74, 0x48, 0x61, 0x69, 0x72, 0x63, 0x75, 0x74, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12,
+	0x23, 0x0a, 0x0d, 0x76, 0x61, 0x75, 0x6c, 0x74, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x76, 0x61, 0x75, 0x6c, 0x74, 0x41, 0x64, 0x64,
+	0x72, 0x65, 0x73, 0x73, 0x12, 0x3f, 0x0a, 0x07, 0x68, 0x61, 0x69, 0x72, 0x63, 0x75, 0x74, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x70, 0x72, 0x6f, 0x76, 0x6c, 0x61, 0x62, 0x73,
+	0x2e, 0x76, 0x61, 0x75, 0x6c, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x73, 0x73, 0x65, 0x74, 0x48,
+	0x61, 0x69, 0x72, 0x63, 0x75, 0x74, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x07, 0x68, 0x61,
+	0x69, 0x72, 0x63, 0x75, 0x74, 0x22, 0x93, 0x01, 0x0a, 0x1a, 0x53, 0x68, 0x61, 0x72, 0x65, 0x50,
+	0x72, 0x69, 0x63, 0x65, 0x4f, 0x62, 0x73, 0x65, 0x72, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x45,
+	0x6e, 0x74, 0x72, 0x79, 0x12, 0x23, 0x0a, 0x0d, 0x76, 0x61, 0x75, 0x6c, 0x74, 0x5f, 0x61, 0x64,
+	0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x76, 0x61, 0x75,
+	0x6c, 0x74, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x50, 0x0a, 0x0b, 0x6f, 0x62, 0x73,
+	0x65, 0x72, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x28,
+	0x2e, 0x70, 0x72, 0x6f, 0x76, 0x6c, 0x61, 0x62, 0x73, 0x2e, 0x76, 0x61, 0x75, 0x6c, 0x74, 0x2e,
+	0x76, 0x31, 0x2e, 0x53, 0x68, 0x61, 0x72, 0x65, 0x50, 0x72, 0x69, 0x63, 0x65, 0x4f, 0x62, 0x73,
+	0x65, 0x72, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x0b,
+	0x6f, 0x62, 0x73, 0x65, 0x72, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x7f, 0x0a, 0x14, 0x4f,
+	0x75, 0x74, 0x62, 0x6f, 0x75, 0x6e, 0x64, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x45, 0x6e,
+	0x74, 0x72, 0x79, 0x12, 0x23, 0x0a, 0x0d, 0x76, 0x61, 0x75, 0x6c, 0x74, 0x5f, 0x61, 0x64, 0x64,
+	0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x76, 0x61, 0x75, 0x6c,
+	0x74, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x42, 0x0a, 0x07, 0x70, 0x61, 0x79, 0x6d,
+	0x65, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x70, 0x72, 0x6f, 0x76,
+	0x6c, 0x61, 0x62, 0x73, 0x2e, 0x76, 0x61, 0x75, 0x6c, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x4f, 0x75,
+	0x74, 0x62, 0x6f, 0x75, 0x6e, 0x64, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x42, 0x04, 0xc8,
+	0xde, 0x1f, 0x00, 0x52, 0x07, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x42, 0xc4, 0x01, 0x0a,
+	0x15, 0x63, 0x6f, 0x6d, 0x2e, 0x70, 0x72, 0x6f, 0x76, 0x6c, 0x61, 0x62, 0x73, 0x2e, 0x76, 0x61,
+	0x75, 0x6c, 0x74, 0x2e, 0x76, 0x31, 0x42, 0x0c, 0x47, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x50,
+	0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x37, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63,
+	0x6f, 0x6d, 0x2f, 0x70, 0x72, 0x6f, 0x76, 0x6c, 0x61, 0x62, 0x73, 0x2f, 0x76, 0x61, 0x75, 0x6c,
+	0x74, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x70, 0x72, 0x6f, 0x76, 0x6c, 0x61, 0x62, 0x73, 0x2f, 0x76,
+	0x61, 0x75, 0x6c, 0x74, 0x2f, 0x76, 0x31, 0x3b, 0x76, 0x61, 0x75, 0x6c, 0x74, 0x76, 0x31, 0xa2,
+	0x02, 0x03, 0x50, 0x56, 0x58, 0xaa, 0x02, 0x11, 0x50, 0x72, 0x6f, 0x76, 0x6c, 0x61, 0x62, 0x73,
+	0x2e, 0x56, 0x61, 0x75, 0x6c, 0x74, 0x2e, 0x56, 0x31, 0xca, 0x02, 0x11, 0x50, 0x72, 0x6f, 0x76,
+	0x6c, 0x61, 0x62, 0x73, 0x5c, 0x56, 0x61, 0x75, 0x6c, 0x74, 0x5c, 0x56, 0x31, 0xe2, 0x02, 0x1d,
+	0x50, 0x72, 0x6f, 0x76, 0x6c, 0x61, 0x62, 0x73, 0x5c, 0x56, 0x61, 0x75, 0x6c, 0x74, 0x5c, 0x56,
+	0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x13,
+	0x50, 0x72, 0x6f, 0x76, 0x6c, 0x61, 0x62, 0x73, 0x3a, 0x3a, 0x56, 0x61, 0x75, 0x6c, 0x74, 0x3a,
+	0x3a, 0x56, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -3510,33 +7778,58 @@ func file_provlabs_vault_v1_genesis_proto_rawDescGZIP() []byte {
 	return file_provlabs_vault_v1_genesis_proto_rawDescData
 }
 
-var file_provlabs_vault_v1_genesis_proto_msgTypes = make([]protoimpl.MessageInfo, 5)
+var file_provlabs_vault_v1_genesis_proto_msgTypes = make([]protoimpl.MessageInfo, 10)
 var file_provlabs_vault_v1_genesis_proto_goTypes = []interface{}{
-	(*QueueEntry)(nil),               // 0: provlabs.vault.v1.QueueEntry
-	(*PendingSwapOutQueueEntry)(nil), // 1: provlabs.vault.v1.PendingSwapOutQueueEntry
-	(*PendingSwapOutQueue)(nil),      // 2: provlabs.vault.v1.PendingSwapOutQueue
-	(*VaultNAVEntry)(nil),            // 3: provlabs.vault.v1.VaultNAVEntry
-	(*GenesisState)(nil),             // 4: provlabs.vault.v1.GenesisState
-	(*PendingSwapOut)(nil),           // 5: provlabs.vault.v1.PendingSwapOut
-	(*VaultNAV)(nil),                 // 6: provlabs.vault.v1.VaultNAV
-	(*VaultAccount)(nil),             // 7: provlabs.vault.v1.VaultAccount
-	(*Params)(nil),                   // 8: provlabs.vault.v1.Params
+	(*QueueEntry)(nil),                 // 0: provlabs.vault.v1.QueueEntry
+	(*PendingSwapOutQueueEntry)(nil),   // 1: provlabs.vault.v1.PendingSwapOutQueueEntry
+	(*PendingSwapOutQueue)(nil),        // 2: provlabs.vault.v1.PendingSwapOutQueue
+	(*VaultNAVEntry)(nil),              // 3: provlabs.vault.v1.VaultNAVEntry
+	(*GenesisState)(nil),               // 4: provlabs.vault.v1.GenesisState
+	(*AllowlistEntry)(nil),             // 5: provlabs.vault.v1.AllowlistEntry
+	(*ScheduledActionQueue)(nil),       // 6: provlabs.vault.v1.ScheduledActionQueue
+	(*AssetHaircutEntry)(nil),          // 7: provlabs.vault.v1.AssetHaircutEntry
+	(*SharePriceObservationEntry)(nil), // 8: provlabs.vault.v1.SharePriceObservationEntry
+	(*OutboundPaymentEntry)(nil),       // 9: provlabs.vault.v1.OutboundPaymentEntry
+	(*PendingSwapOut)(nil),             // 10: provlabs.vault.v1.PendingSwapOut
+	(*VaultNAV)(nil),                   // 11: provlabs.vault.v1.VaultNAV
+	(*VaultAccount)(nil),               // 12: provlabs.vault.v1.VaultAccount
+	(*Params)(nil),                     // 13: provlabs.vault.v1.Params
+	(*PendingNAVProposal)(nil),         // 14: provlabs.vault.v1.PendingNAVProposal
+	(*NAVSourceSubmission)(nil),        // 15: provlabs.vault.v1.NAVSourceSubmission
+	(*ScheduledAction)(nil),            // 16: provlabs.vault.v1.ScheduledAction
+	(*AssetHaircut)(nil),               // 17: provlabs.vault.v1.AssetHaircut
+	(*SharePriceObservation)(nil),      // 18: provlabs.vault.v1.SharePriceObservation
+	(*OutboundPayment)(nil),            // 19: provlabs.vault.v1.OutboundPayment
 }
 var file_provlabs_vault_v1_genesis_proto_depIdxs = []int32{
-	5, // 0: provlabs.vault.v1.PendingSwapOutQueueEntry.swap_out:type_name -> provlabs.vault.v1.PendingSwapOut
-	1, // 1: provlabs.vault.v1.PendingSwapOutQueue.entries:type_name -> provlabs.vault.v1.PendingSwapOutQueueEntry
-	6, // 2: provlabs.vault.v1.VaultNAVEntry.nav:type_name -> provlabs.vault.v1.VaultNAV
-	7, // 3: provlabs.vault.v1.GenesisState.vaults:type_name -> provlabs.vault.v1.VaultAccount
-	0, // 4: provlabs.vault.v1.GenesisState.payout_timeout_queue:type_name -> provlabs.vault.v1.QueueEntry
-	2, // 5: provlabs.vault.v1.GenesisState.pending_swap_out_queue:type_name -> provlabs.vault.v1.PendingSwapOutQueue
-	0, // 6: provlabs.vault.v1.GenesisState.fee_timeout_queue:type_name -> provlabs.vault.v1.QueueEntry
-	8, // 7: provlabs.vault.v1.GenesisState.params:type_name -> provlabs.vault.v1.Params
-	3, // 8: provlabs.vault.v1.GenesisState.navs:type_name -> provlabs.vault.v1.VaultNAVEntry
-	9, // [9:9] is the sub-list for method output_type
-	9, // [9:9] is the sub-list for method input_type
-	9, // [9:9] is the sub-list for extension type_name
-	9, // [9:9] is the sub-list for extension extendee
-	0, // [0:9] is the sub-list for field type_name
+	10, // 0: provlabs.vault.v1.PendingSwapOutQueueEntry.swap_out:type_name -> provlabs.vault.v1.PendingSwapOut
+	1,  // 1: provlabs.vault.v1.PendingSwapOutQueue.entries:type_name -> provlabs.vault.v1.PendingSwapOutQueueEntry
+	11, // 2: provlabs.vault.v1.VaultNAVEntry.nav:type_name -> provlabs.vault.v1.VaultNAV
+	12, // 3: provlabs.vault.v1.GenesisState.vaults:type_name -> provlabs.vault.v1.VaultAccount
+	0,  // 4: provlabs.vault.v1.GenesisState.payout_timeout_queue:type_name -> provlabs.vault.v1.QueueEntry
+	2,  // 5: provlabs.vault.v1.GenesisState.pending_swap_out_queue:type_name -> provlabs.vault.v1.PendingSwapOutQueue
+	0,  // 6: provlabs.vault.v1.GenesisState.fee_timeout_queue:type_name -> provlabs.vault.v1.QueueEntry
+	13, // 7: provlabs.vault.v1.GenesisState.params:type_name -> provlabs.vault.v1.Params
+	3,  // 8: provlabs.vault.v1.GenesisState.navs:type_name -> provlabs.vault.v1.VaultNAVEntry
+	14, // 9: provlabs.vault.v1.GenesisState.pending_nav_proposals:type_name -> provlabs.vault.v1.PendingNAVProposal
+	15, // 10: provlabs.vault.v1.GenesisState.nav_source_submissions:type_name -> provlabs.vault.v1.NAVSourceSubmission
+	3,  // 11: provlabs.vault.v1.GenesisState.nav_history:type_name -> provlabs.vault.v1.VaultNAVEntry
+	3,  // 12: provlabs.vault.v1.GenesisState.settlement_prices:type_name -> provlabs.vault.v1.VaultNAVEntry
+	7,  // 13: provlabs.vault.v1.GenesisState.asset_haircuts:type_name -> provlabs.vault.v1.AssetHaircutEntry
+	8,  // 14: provlabs.vault.v1.GenesisState.share_price_observations:type_name -> provlabs.vault.v1.SharePriceObservationEntry
+	9,  // 15: provlabs.vault.v1.GenesisState.outbound_payments:type_name -> provlabs.vault.v1.OutboundPaymentEntry
+	6,  // 16: provlabs.vault.v1.GenesisState.scheduled_action_queue:type_name -> provlabs.vault.v1.ScheduledActionQueue
+	5,  // 17: provlabs.vault.v1.GenesisState.allowlist:type_name -> provlabs.vault.v1.AllowlistEntry
+	3,  // 18: provlabs.vault.v1.GenesisState.nav_references:type_name -> provlabs.vault.v1.VaultNAVEntry
+	16, // 19: provlabs.vault.v1.ScheduledActionQueue.actions:type_name -> provlabs.vault.v1.ScheduledAction
+	17, // 20: provlabs.vault.v1.AssetHaircutEntry.haircut:type_name -> provlabs.vault.v1.AssetHaircut
+	18, // 21: provlabs.vault.v1.SharePriceObservationEntry.observation:type_name -> provlabs.vault.v1.SharePriceObservation
+	19, // 22: provlabs.vault.v1.OutboundPaymentEntry.payment:type_name -> provlabs.vault.v1.OutboundPayment
+	23, // [23:23] is the sub-list for method output_type
+	23, // [23:23] is the sub-list for method input_type
+	23, // [23:23] is the sub-list for extension type_name
+	23, // [23:23] is the sub-list for extension extendee
+	0,  // [0:23] is the sub-list for field type_name
 }
 
 func init() { file_provlabs_vault_v1_genesis_proto_init() }
@@ -3607,6 +7900,66 @@ func file_provlabs_vault_v1_genesis_proto_init() {
 				return nil
 			}
 		}
+		file_provlabs_vault_v1_genesis_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AllowlistEntry); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_provlabs_vault_v1_genesis_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ScheduledActionQueue); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_provlabs_vault_v1_genesis_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AssetHaircutEntry); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_provlabs_vault_v1_genesis_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SharePriceObservationEntry); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_provlabs_vault_v1_genesis_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*OutboundPaymentEntry); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -3614,7 +7967,7 @@ func file_provlabs_vault_v1_genesis_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_provlabs_vault_v1_genesis_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   5,
+			NumMessages:   10,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
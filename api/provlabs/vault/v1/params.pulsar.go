@@ -15,9 +15,13 @@ import (
 )
 
 var (
-	md_Params                      protoreflect.MessageDescriptor
-	fd_Params_tech_fee_address     protoreflect.FieldDescriptor
-	fd_Params_default_aum_fee_bips protoreflect.FieldDescriptor
+	md_Params                               protoreflect.MessageDescriptor
+	fd_Params_tech_fee_address              protoreflect.FieldDescriptor
+	fd_Params_default_aum_fee_bips          protoreflect.FieldDescriptor
+	fd_Params_nav_history_retention_seconds protoreflect.FieldDescriptor
+	fd_Params_share_price_retention_seconds protoreflect.FieldDescriptor
+	fd_Params_circuit_breaker_guardian      protoreflect.FieldDescriptor
+	fd_Params_circuit_breaker               protoreflect.FieldDescriptor
 )
 
 func init() {
@@ -25,6 +29,10 @@ func init() {
 	md_Params = File_provlabs_vault_v1_params_proto.Messages().ByName("Params")
 	fd_Params_tech_fee_address = md_Params.Fields().ByName("tech_fee_address")
 	fd_Params_default_aum_fee_bips = md_Params.Fields().ByName("default_aum_fee_bips")
+	fd_Params_nav_history_retention_seconds = md_Params.Fields().ByName("nav_history_retention_seconds")
+	fd_Params_share_price_retention_seconds = md_Params.Fields().ByName("share_price_retention_seconds")
+	fd_Params_circuit_breaker_guardian = md_Params.Fields().ByName("circuit_breaker_guardian")
+	fd_Params_circuit_breaker = md_Params.Fields().ByName("circuit_breaker")
 }
 
 var _ protoreflect.Message = (*fastReflection_Params)(nil)
@@ -104,6 +112,30 @@ func (x *fastReflection_Params) Range(f func(protoreflect.FieldDescriptor, proto
 			return
 		}
 	}
+	if x.NavHistoryRetentionSeconds != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.NavHistoryRetentionSeconds)
+		if !f(fd_Params_nav_history_retention_seconds, value) {
+			return
+		}
+	}
+	if x.SharePriceRetentionSeconds != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.SharePriceRetentionSeconds)
+		if !f(fd_Params_share_price_retention_seconds, value) {
+			return
+		}
+	}
+	if x.CircuitBreakerGuardian != "" {
+		value := protoreflect.ValueOfString(x.CircuitBreakerGuardian)
+		if !f(fd_Params_circuit_breaker_guardian, value) {
+			return
+		}
+	}
+	if x.CircuitBreaker != nil {
+		value := protoreflect.ValueOfMessage(x.CircuitBreaker.ProtoReflect())
+		if !f(fd_Params_circuit_breaker, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -123,6 +155,14 @@ func (x *fastReflection_Params) Has(fd protoreflect.FieldDescriptor) bool {
 		return x.TechFeeAddress != ""
 	case "provlabs.vault.v1.Params.default_aum_fee_bips":
 		return x.DefaultAumFeeBips != uint32(0)
+	case "provlabs.vault.v1.Params.nav_history_retention_seconds":
+		return x.NavHistoryRetentionSeconds != uint64(0)
+	case "provlabs.vault.v1.Params.share_price_retention_seconds":
+		return x.SharePriceRetentionSeconds != uint64(0)
+	case "provlabs.vault.v1.Params.circuit_breaker_guardian":
+		return x.CircuitBreakerGuardian != ""
+	case "provlabs.vault.v1.Params.circuit_breaker":
+		return x.CircuitBreaker != nil
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: provlabs.vault.v1.Params"))
@@ -143,6 +183,14 @@ func (x *fastReflection_Params) Clear(fd protoreflect.FieldDescriptor) {
 		x.TechFeeAddress = ""
 	case "provlabs.vault.v1.Params.default_aum_fee_bips":
 		x.DefaultAumFeeBips = uint32(0)
+	case "provlabs.vault.v1.Params.nav_history_retention_seconds":
+		x.NavHistoryRetentionSeconds = uint64(0)
+	case "provlabs.vault.v1.Params.share_price_retention_seconds":
+		x.SharePriceRetentionSeconds = uint64(0)
+	case "provlabs.vault.v1.Params.circuit_breaker_guardian":
+		x.CircuitBreakerGuardian = ""
+	case "provlabs.vault.v1.Params.circuit_breaker":
+		x.CircuitBreaker = nil
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: provlabs.vault.v1.Params"))
@@ -165,6 +213,18 @@ func (x *fastReflection_Params) Get(descriptor protoreflect.FieldDescriptor) pro
 	case "provlabs.vault.v1.Params.default_aum_fee_bips":
 		value := x.DefaultAumFeeBips
 		return protoreflect.ValueOfUint32(value)
+	case "provlabs.vault.v1.Params.nav_history_retention_seconds":
+		value := x.NavHistoryRetentionSeconds
+		return protoreflect.ValueOfUint64(value)
+	case "provlabs.vault.v1.Params.share_price_retention_seconds":
+		value := x.SharePriceRetentionSeconds
+		return protoreflect.ValueOfUint64(value)
+	case "provlabs.vault.v1.Params.circuit_breaker_guardian":
+		value := x.CircuitBreakerGuardian
+		return protoreflect.ValueOfString(value)
+	case "provlabs.vault.v1.Params.circuit_breaker":
+		value := x.CircuitBreaker
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: provlabs.vault.v1.Params"))
@@ -189,6 +249,14 @@ func (x *fastReflection_Params) Set(fd protoreflect.FieldDescriptor, value proto
 		x.TechFeeAddress = value.Interface().(string)
 	case "provlabs.vault.v1.Params.default_aum_fee_bips":
 		x.DefaultAumFeeBips = uint32(value.Uint())
+	case "provlabs.vault.v1.Params.nav_history_retention_seconds":
+		x.NavHistoryRetentionSeconds = value.Uint()
+	case "provlabs.vault.v1.Params.share_price_retention_seconds":
+		x.SharePriceRetentionSeconds = value.Uint()
+	case "provlabs.vault.v1.Params.circuit_breaker_guardian":
+		x.CircuitBreakerGuardian = value.Interface().(string)
+	case "provlabs.vault.v1.Params.circuit_breaker":
+		x.CircuitBreaker = value.Message().Interface().(*CircuitBreaker)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: provlabs.vault.v1.Params"))
@@ -209,10 +277,21 @@ func (x *fastReflection_Params) Set(fd protoreflect.FieldDescriptor, value proto
 // Mutable is a mutating operation and unsafe for concurrent use.
 func (x *fastReflection_Params) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
+	case "provlabs.vault.v1.Params.circuit_breaker":
+		if x.CircuitBreaker == nil {
+			x.CircuitBreaker = new(CircuitBreaker)
+		}
+		return protoreflect.ValueOfMessage(x.CircuitBreaker.ProtoReflect())
 	case "provlabs.vault.v1.Params.tech_fee_address":
 		panic(fmt.Errorf("field tech_fee_address of message provlabs.vault.v1.Params is not mutable"))
 	case "provlabs.vault.v1.Params.default_aum_fee_bips":
 		panic(fmt.Errorf("field default_aum_fee_bips of message provlabs.vault.v1.Params is not mutable"))
+	case "provlabs.vault.v1.Params.nav_history_retention_seconds":
+		panic(fmt.Errorf("field nav_history_retention_seconds of message provlabs.vault.v1.Params is not mutable"))
+	case "provlabs.vault.v1.Params.share_price_retention_seconds":
+		panic(fmt.Errorf("field share_price_retention_seconds of message provlabs.vault.v1.Params is not mutable"))
+	case "provlabs.vault.v1.Params.circuit_breaker_guardian":
+		panic(fmt.Errorf("field circuit_breaker_guardian of message provlabs.vault.v1.Params is not mutable"))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: provlabs.vault.v1.Params"))
@@ -230,6 +309,15 @@ func (x *fastReflection_Params) NewField(fd protoreflect.FieldDescriptor) protor
 		return protoreflect.ValueOfString("")
 	case "provlabs.vault.v1.Params.default_aum_fee_bips":
 		return protoreflect.ValueOfUint32(uint32(0))
+	case "provlabs.vault.v1.Params.nav_history_retention_seconds":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "provlabs.vault.v1.Params.share_price_retention_seconds":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "provlabs.vault.v1.Params.circuit_breaker_guardian":
+		return protoreflect.ValueOfString("")
+	case "provlabs.vault.v1.Params.circuit_breaker":
+		m := new(CircuitBreaker)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: provlabs.vault.v1.Params"))
@@ -306,6 +394,20 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 		if x.DefaultAumFeeBips != 0 {
 			n += 1 + runtime.Sov(uint64(x.DefaultAumFeeBips))
 		}
+		if x.NavHistoryRetentionSeconds != 0 {
+			n += 1 + runtime.Sov(uint64(x.NavHistoryRetentionSeconds))
+		}
+		if x.SharePriceRetentionSeconds != 0 {
+			n += 1 + runtime.Sov(uint64(x.SharePriceRetentionSeconds))
+		}
+		l = len(x.CircuitBreakerGuardian)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.CircuitBreaker != nil {
+			l = options.Size(x.CircuitBreaker)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -335,6 +437,37 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if x.CircuitBreaker != nil {
+			encoded, err := options.Marshal(x.CircuitBreaker)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x32
+		}
+		if len(x.CircuitBreakerGuardian) > 0 {
+			i -= len(x.CircuitBreakerGuardian)
+			copy(dAtA[i:], x.CircuitBreakerGuardian)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.CircuitBreakerGuardian)))
+			i--
+			dAtA[i] = 0x2a
+		}
+		if x.SharePriceRetentionSeconds != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.SharePriceRetentionSeconds))
+			i--
+			dAtA[i] = 0x20
+		}
+		if x.NavHistoryRetentionSeconds != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.NavHistoryRetentionSeconds))
+			i--
+			dAtA[i] = 0x18
+		}
 		if x.DefaultAumFeeBips != 0 {
 			i = runtime.EncodeVarint(dAtA, i, uint64(x.DefaultAumFeeBips))
 			i--
@@ -447,6 +580,112 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 						break
 					}
 				}
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field NavHistoryRetentionSeconds", wireType)
+				}
+				x.NavHistoryRetentionSeconds = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.NavHistoryRetentionSeconds |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 4:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SharePriceRetentionSeconds", wireType)
+				}
+				x.SharePriceRetentionSeconds = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.SharePriceRetentionSeconds |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 5:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field CircuitBreakerGuardian", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.CircuitBreakerGuardian = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 6:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field CircuitBreaker", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.CircuitBreaker == nil {
+					x.CircuitBreaker = &CircuitBreaker{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.CircuitBreaker); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -482,124 +721,836 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 	}
 }
 
-// Code generated by protoc-gen-go. DO NOT EDIT.
-// versions:
-// 	protoc-gen-go v1.27.0
-// 	protoc        (unknown)
-// source: provlabs/vault/v1/params.proto
-
-const (
-	// Verify that this generated code is sufficiently up-to-date.
-	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
-	// Verify that runtime/protoimpl is sufficiently up-to-date.
-	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+var (
+	md_CircuitBreaker                   protoreflect.MessageDescriptor
+	fd_CircuitBreaker_swap_in_halted    protoreflect.FieldDescriptor
+	fd_CircuitBreaker_swap_out_halted   protoreflect.FieldDescriptor
+	fd_CircuitBreaker_settlement_halted protoreflect.FieldDescriptor
+	fd_CircuitBreaker_bridge_halted     protoreflect.FieldDescriptor
 )
 
-// Params defines the set of module parameters.
-type Params struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+func init() {
+	file_provlabs_vault_v1_params_proto_init()
+	md_CircuitBreaker = File_provlabs_vault_v1_params_proto.Messages().ByName("CircuitBreaker")
+	fd_CircuitBreaker_swap_in_halted = md_CircuitBreaker.Fields().ByName("swap_in_halted")
+	fd_CircuitBreaker_swap_out_halted = md_CircuitBreaker.Fields().ByName("swap_out_halted")
+	fd_CircuitBreaker_settlement_halted = md_CircuitBreaker.Fields().ByName("settlement_halted")
+	fd_CircuitBreaker_bridge_halted = md_CircuitBreaker.Fields().ByName("bridge_halted")
+}
 
-	// tech_fee_address is the address where all AUM fees are collected and which holds
-	// the authority to update per-vault bips.
-	TechFeeAddress string `protobuf:"bytes,1,opt,name=tech_fee_address,json=techFeeAddress,proto3" json:"tech_fee_address,omitempty"`
-	// default_aum_fee_bips is the default fee rate (in basis points) applied to new vaults upon creation.
-	DefaultAumFeeBips uint32 `protobuf:"varint,2,opt,name=default_aum_fee_bips,json=defaultAumFeeBips,proto3" json:"default_aum_fee_bips,omitempty"`
+var _ protoreflect.Message = (*fastReflection_CircuitBreaker)(nil)
+
+type fastReflection_CircuitBreaker CircuitBreaker
+
+func (x *CircuitBreaker) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_CircuitBreaker)(x)
 }
 
-func (x *Params) Reset() {
-	*x = Params{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_provlabs_vault_v1_params_proto_msgTypes[0]
+func (x *CircuitBreaker) slowProtoReflect() protoreflect.Message {
+	mi := &file_provlabs_vault_v1_params_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
+	return mi.MessageOf(x)
 }
 
-func (x *Params) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
+var _fastReflection_CircuitBreaker_messageType fastReflection_CircuitBreaker_messageType
+var _ protoreflect.MessageType = fastReflection_CircuitBreaker_messageType{}
 
-func (*Params) ProtoMessage() {}
+type fastReflection_CircuitBreaker_messageType struct{}
 
-// Deprecated: Use Params.ProtoReflect.Descriptor instead.
-func (*Params) Descriptor() ([]byte, []int) {
-	return file_provlabs_vault_v1_params_proto_rawDescGZIP(), []int{0}
+func (x fastReflection_CircuitBreaker_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_CircuitBreaker)(nil)
 }
-
-func (x *Params) GetTechFeeAddress() string {
-	if x != nil {
-		return x.TechFeeAddress
-	}
-	return ""
+func (x fastReflection_CircuitBreaker_messageType) New() protoreflect.Message {
+	return new(fastReflection_CircuitBreaker)
+}
+func (x fastReflection_CircuitBreaker_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_CircuitBreaker
 }
 
-func (x *Params) GetDefaultAumFeeBips() uint32 {
-	if x != nil {
-		return x.DefaultAumFeeBips
-	}
-	return 0
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_CircuitBreaker) Descriptor() protoreflect.MessageDescriptor {
+	return md_CircuitBreaker
 }
 
-var File_provlabs_vault_v1_params_proto protoreflect.FileDescriptor
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_CircuitBreaker) Type() protoreflect.MessageType {
+	return _fastReflection_CircuitBreaker_messageType
+}
 
-var file_provlabs_vault_v1_params_proto_rawDesc = []byte{
-	0x0a, 0x1e, 0x70, 0x72, 0x6f, 0x76, 0x6c, 0x61, 0x62, 0x73, 0x2f, 0x76, 0x61, 0x75, 0x6c, 0x74,
-	0x2f, 0x76, 0x31, 0x2f, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x12, 0x11, 0x70, 0x72, 0x6f, 0x76, 0x6c, 0x61, 0x62, 0x73, 0x2e, 0x76, 0x61, 0x75, 0x6c, 0x74,
-	0x2e, 0x76, 0x31, 0x1a, 0x19, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x5f, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x14,
-	0x67, 0x6f, 0x67, 0x6f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x67, 0x6f, 0x67, 0x6f, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x22, 0x83, 0x01, 0x0a, 0x06, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12,
-	0x42, 0x0a, 0x10, 0x74, 0x65, 0x63, 0x68, 0x5f, 0x66, 0x65, 0x65, 0x5f, 0x61, 0x64, 0x64, 0x72,
-	0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63,
-	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72,
-	0x69, 0x6e, 0x67, 0x52, 0x0e, 0x74, 0x65, 0x63, 0x68, 0x46, 0x65, 0x65, 0x41, 0x64, 0x64, 0x72,
-	0x65, 0x73, 0x73, 0x12, 0x2f, 0x0a, 0x14, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x5f, 0x61,
-	0x75, 0x6d, 0x5f, 0x66, 0x65, 0x65, 0x5f, 0x62, 0x69, 0x70, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x0d, 0x52, 0x11, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x41, 0x75, 0x6d, 0x46, 0x65, 0x65,
-	0x42, 0x69, 0x70, 0x73, 0x3a, 0x04, 0xe8, 0xa0, 0x1f, 0x01, 0x42, 0xc3, 0x01, 0x0a, 0x15, 0x63,
-	0x6f, 0x6d, 0x2e, 0x70, 0x72, 0x6f, 0x76, 0x6c, 0x61, 0x62, 0x73, 0x2e, 0x76, 0x61, 0x75, 0x6c,
-	0x74, 0x2e, 0x76, 0x31, 0x42, 0x0b, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x50, 0x72, 0x6f, 0x74,
-	0x6f, 0x50, 0x01, 0x5a, 0x37, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f,
-	0x70, 0x72, 0x6f, 0x76, 0x6c, 0x61, 0x62, 0x73, 0x2f, 0x76, 0x61, 0x75, 0x6c, 0x74, 0x2f, 0x61,
-	0x70, 0x69, 0x2f, 0x70, 0x72, 0x6f, 0x76, 0x6c, 0x61, 0x62, 0x73, 0x2f, 0x76, 0x61, 0x75, 0x6c,
-	0x74, 0x2f, 0x76, 0x31, 0x3b, 0x76, 0x61, 0x75, 0x6c, 0x74, 0x76, 0x31, 0xa2, 0x02, 0x03, 0x50,
-	0x56, 0x58, 0xaa, 0x02, 0x11, 0x50, 0x72, 0x6f, 0x76, 0x6c, 0x61, 0x62, 0x73, 0x2e, 0x56, 0x61,
-	0x75, 0x6c, 0x74, 0x2e, 0x56, 0x31, 0xca, 0x02, 0x11, 0x50, 0x72, 0x6f, 0x76, 0x6c, 0x61, 0x62,
-	0x73, 0x5c, 0x56, 0x61, 0x75, 0x6c, 0x74, 0x5c, 0x56, 0x31, 0xe2, 0x02, 0x1d, 0x50, 0x72, 0x6f,
-	0x76, 0x6c, 0x61, 0x62, 0x73, 0x5c, 0x56, 0x61, 0x75, 0x6c, 0x74, 0x5c, 0x56, 0x31, 0x5c, 0x47,
-	0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x13, 0x50, 0x72, 0x6f,
-	0x76, 0x6c, 0x61, 0x62, 0x73, 0x3a, 0x3a, 0x56, 0x61, 0x75, 0x6c, 0x74, 0x3a, 0x3a, 0x56, 0x31,
-	0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_CircuitBreaker) New() protoreflect.Message {
+	return new(fastReflection_CircuitBreaker)
 }
 
-var (
-	file_provlabs_vault_v1_params_proto_rawDescOnce sync.Once
-	file_provlabs_vault_v1_params_proto_rawDescData = file_provlabs_vault_v1_params_proto_rawDesc
-)
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_CircuitBreaker) Interface() protoreflect.ProtoMessage {
+	return (*CircuitBreaker)(x)
+}
 
-func file_provlabs_vault_v1_params_proto_rawDescGZIP() []byte {
-	file_provlabs_vault_v1_params_proto_rawDescOnce.Do(func() {
-		file_provlabs_vault_v1_params_proto_rawDescData = protoimpl.X.CompressGZIP(file_provlabs_vault_v1_params_proto_rawDescData)
-	})
-	return file_provlabs_vault_v1_params_proto_rawDescData
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_CircuitBreaker) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.SwapInHalted != false {
+		value := protoreflect.ValueOfBool(x.SwapInHalted)
+		if !f(fd_CircuitBreaker_swap_in_halted, value) {
+			return
+		}
+	}
+	if x.SwapOutHalted != false {
+		value := protoreflect.ValueOfBool(x.SwapOutHalted)
+		if !f(fd_CircuitBreaker_swap_out_halted, value) {
+			return
+		}
+	}
+	if x.SettlementHalted != false {
+		value := protoreflect.ValueOfBool(x.SettlementHalted)
+		if !f(fd_CircuitBreaker_settlement_halted, value) {
+			return
+		}
+	}
+	if x.BridgeHalted != false {
+		value := protoreflect.ValueOfBool(x.BridgeHalted)
+		if !f(fd_CircuitBreaker_bridge_halted, value) {
+			return
+		}
+	}
 }
 
-var file_provlabs_vault_v1_params_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
-var file_provlabs_vault_v1_params_proto_goTypes = []interface{}{
-	(*Params)(nil), // 0: provlabs.vault.v1.Params
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_CircuitBreaker) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "provlabs.vault.v1.CircuitBreaker.swap_in_halted":
+		return x.SwapInHalted != false
+	case "provlabs.vault.v1.CircuitBreaker.swap_out_halted":
+		return x.SwapOutHalted != false
+	case "provlabs.vault.v1.CircuitBreaker.settlement_halted":
+		return x.SettlementHalted != false
+	case "provlabs.vault.v1.CircuitBreaker.bridge_halted":
+		return x.BridgeHalted != false
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: provlabs.vault.v1.CircuitBreaker"))
+		}
+		panic(fmt.Errorf("message provlabs.vault.v1.CircuitBreaker does not contain field %s", fd.FullName()))
+	}
 }
-var file_provlabs_vault_v1_params_proto_depIdxs = []int32{
-	0, // [0:0] is the sub-list for method output_type
-	0, // [0:0] is the sub-list for method input_type
-	0, // [0:0] is the sub-list for extension type_name
-	0, // [0:0] is the sub-list for extension extendee
-	0, // [0:0] is the sub-list for field type_name
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_CircuitBreaker) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "provlabs.vault.v1.CircuitBreaker.swap_in_halted":
+		x.SwapInHalted = false
+	case "provlabs.vault.v1.CircuitBreaker.swap_out_halted":
+		x.SwapOutHalted = false
+	case "provlabs.vault.v1.CircuitBreaker.settlement_halted":
+		x.SettlementHalted = false
+	case "provlabs.vault.v1.CircuitBreaker.bridge_halted":
+		x.BridgeHalted = false
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: provlabs.vault.v1.CircuitBreaker"))
+		}
+		panic(fmt.Errorf("message provlabs.vault.v1.CircuitBreaker does not contain field %s", fd.FullName()))
+	}
 }
 
-func init() { file_provlabs_vault_v1_params_proto_init() }
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_CircuitBreaker) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "provlabs.vault.v1.CircuitBreaker.swap_in_halted":
+		value := x.SwapInHalted
+		return protoreflect.ValueOfBool(value)
+	case "provlabs.vault.v1.CircuitBreaker.swap_out_halted":
+		value := x.SwapOutHalted
+		return protoreflect.ValueOfBool(value)
+	case "provlabs.vault.v1.CircuitBreaker.settlement_halted":
+		value := x.SettlementHalted
+		return protoreflect.ValueOfBool(value)
+	case "provlabs.vault.v1.CircuitBreaker.bridge_halted":
+		value := x.BridgeHalted
+		return protoreflect.ValueOfBool(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: provlabs.vault.v1.CircuitBreaker"))
+		}
+		panic(fmt.Errorf("message provlabs.vault.v1.CircuitBreaker does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_CircuitBreaker) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "provlabs.vault.v1.CircuitBreaker.swap_in_halted":
+		x.SwapInHalted = value.Bool()
+	case "provlabs.vault.v1.CircuitBreaker.swap_out_halted":
+		x.SwapOutHalted = value.Bool()
+	case "provlabs.vault.v1.CircuitBreaker.settlement_halted":
+		x.SettlementHalted = value.Bool()
+	case "provlabs.vault.v1.CircuitBreaker.bridge_halted":
+		x.BridgeHalted = value.Bool()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: provlabs.vault.v1.CircuitBreaker"))
+		}
+		panic(fmt.Errorf("message provlabs.vault.v1.CircuitBreaker does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_CircuitBreaker) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "provlabs.vault.v1.CircuitBreaker.swap_in_halted":
+		panic(fmt.Errorf("field swap_in_halted of message provlabs.vault.v1.CircuitBreaker is not mutable"))
+	case "provlabs.vault.v1.CircuitBreaker.swap_out_halted":
+		panic(fmt.Errorf("field swap_out_halted of message provlabs.vault.v1.CircuitBreaker is not mutable"))
+	case "provlabs.vault.v1.CircuitBreaker.settlement_halted":
+		panic(fmt.Errorf("field settlement_halted of message provlabs.vault.v1.CircuitBreaker is not mutable"))
+	case "provlabs.vault.v1.CircuitBreaker.bridge_halted":
+		panic(fmt.Errorf("field bridge_halted of message provlabs.vault.v1.CircuitBreaker is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: provlabs.vault.v1.CircuitBreaker"))
+		}
+		panic(fmt.Errorf("message provlabs.vault.v1.CircuitBreaker does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_CircuitBreaker) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "provlabs.vault.v1.CircuitBreaker.swap_in_halted":
+		return protoreflect.ValueOfBool(false)
+	case "provlabs.vault.v1.CircuitBreaker.swap_out_halted":
+		return protoreflect.ValueOfBool(false)
+	case "provlabs.vault.v1.CircuitBreaker.settlement_halted":
+		return protoreflect.ValueOfBool(false)
+	case "provlabs.vault.v1.CircuitBreaker.bridge_halted":
+		return protoreflect.ValueOfBool(false)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: provlabs.vault.v1.CircuitBreaker"))
+		}
+		panic(fmt.Errorf("message provlabs.vault.v1.CircuitBreaker does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_CircuitBreaker) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in provlabs.vault.v1.CircuitBreaker", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_CircuitBreaker) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_CircuitBreaker) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_CircuitBreaker) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_CircuitBreaker) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*CircuitBreaker)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.SwapInHalted {
+			n += 2
+		}
+		if x.SwapOutHalted {
+			n += 2
+		}
+		if x.SettlementHalted {
+			n += 2
+		}
+		if x.BridgeHalted {
+			n += 2
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*CircuitBreaker)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.BridgeHalted {
+			i--
+			if x.BridgeHalted {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x20
+		}
+		if x.SettlementHalted {
+			i--
+			if x.SettlementHalted {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x18
+		}
+		if x.SwapOutHalted {
+			i--
+			if x.SwapOutHalted {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x10
+		}
+		if x.SwapInHalted {
+			i--
+			if x.SwapInHalted {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*CircuitBreaker)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: CircuitBreaker: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: CircuitBreaker: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SwapInHalted", wireType)
+				}
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				x.SwapInHalted = bool(v != 0)
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SwapOutHalted", wireType)
+				}
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				x.SwapOutHalted = bool(v != 0)
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SettlementHalted", wireType)
+				}
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				x.SettlementHalted = bool(v != 0)
+			case 4:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BridgeHalted", wireType)
+				}
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				x.BridgeHalted = bool(v != 0)
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.27.0
+// 	protoc        (unknown)
+// source: provlabs/vault/v1/params.proto
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// Params defines the set of module parameters.
+type Params struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// tech_fee_address is the address where all AUM fees are collected and which holds
+	// the authority to update per-vault bips.
+	TechFeeAddress string `protobuf:"bytes,1,opt,name=tech_fee_address,json=techFeeAddress,proto3" json:"tech_fee_address,omitempty"`
+	// default_aum_fee_bips is the default fee rate (in basis points) applied to new vaults upon creation.
+	DefaultAumFeeBips uint32 `protobuf:"varint,2,opt,name=default_aum_fee_bips,json=defaultAumFeeBips,proto3" json:"default_aum_fee_bips,omitempty"`
+	// nav_history_retention_seconds is how long internal NAV changes and published share NAVs
+	// are kept in the NAV history time series. A value of 0 disables NAV history recording.
+	NavHistoryRetentionSeconds uint64 `protobuf:"varint,3,opt,name=nav_history_retention_seconds,json=navHistoryRetentionSeconds,proto3" json:"nav_history_retention_seconds,omitempty"`
+	// share_price_retention_seconds is how long share price accumulator observations are kept.
+	// It bounds the windows VaultTWAP and VaultPerformance can cover. A value of 0 disables
+	// recording.
+	SharePriceRetentionSeconds uint64 `protobuf:"varint,4,opt,name=share_price_retention_seconds,json=sharePriceRetentionSeconds,proto3" json:"share_price_retention_seconds,omitempty"`
+	// circuit_breaker_guardian may trip and reset the circuit breaker alongside governance. An
+	// empty string "" leaves the circuit breaker to governance alone. It is set through
+	// UpdateCircuitBreaker; UpdateParams keeps the stored value.
+	CircuitBreakerGuardian string `protobuf:"bytes,5,opt,name=circuit_breaker_guardian,json=circuitBreakerGuardian,proto3" json:"circuit_breaker_guardian,omitempty"`
+	// circuit_breaker halts classes of operations across every vault. It is set through
+	// UpdateCircuitBreaker; UpdateParams keeps the stored value.
+	CircuitBreaker *CircuitBreaker `protobuf:"bytes,6,opt,name=circuit_breaker,json=circuitBreaker,proto3" json:"circuit_breaker,omitempty"`
+}
+
+func (x *Params) Reset() {
+	*x = Params{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_provlabs_vault_v1_params_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Params) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Params) ProtoMessage() {}
+
+// Deprecated: Use Params.ProtoReflect.Descriptor instead.
+func (*Params) Descriptor() ([]byte, []int) {
+	return file_provlabs_vault_v1_params_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Params) GetTechFeeAddress() string {
+	if x != nil {
+		return x.TechFeeAddress
+	}
+	return ""
+}
+
+func (x *Params) GetDefaultAumFeeBips() uint32 {
+	if x != nil {
+		return x.DefaultAumFeeBips
+	}
+	return 0
+}
+
+func (x *Params) GetNavHistoryRetentionSeconds() uint64 {
+	if x != nil {
+		return x.NavHistoryRetentionSeconds
+	}
+	return 0
+}
+
+func (x *Params) GetSharePriceRetentionSeconds() uint64 {
+	if x != nil {
+		return x.SharePriceRetentionSeconds
+	}
+	return 0
+}
+
+func (x *Params) GetCircuitBreakerGuardian() string {
+	if x != nil {
+		return x.CircuitBreakerGuardian
+	}
+	return ""
+}
+
+func (x *Params) GetCircuitBreaker() *CircuitBreaker {
+	if x != nil {
+		return x.CircuitBreaker
+	}
+	return nil
+}
+
+// CircuitBreaker is the module-wide emergency halt. Each flag independently blocks one class
+// of operation in every vault, whatever the vault's own pause and toggle settings.
+type CircuitBreaker struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// swap_in_halted blocks swap-ins.
+	SwapInHalted bool `protobuf:"varint,1,opt,name=swap_in_halted,json=swapInHalted,proto3" json:"swap_in_halted,omitempty"`
+	// swap_out_halted blocks swap-out requests and expedites, and holds pending swap-outs in
+	// the queue instead of paying them out.
+	SwapOutHalted bool `protobuf:"varint,2,opt,name=swap_out_halted,json=swapOutHalted,proto3" json:"swap_out_halted,omitempty"`
+	// settlement_halted blocks accepting asset payments and creating outbound asset payments.
+	SettlementHalted bool `protobuf:"varint,3,opt,name=settlement_halted,json=settlementHalted,proto3" json:"settlement_halted,omitempty"`
+	// bridge_halted blocks bridge mints and burns of vault shares.
+	BridgeHalted bool `protobuf:"varint,4,opt,name=bridge_halted,json=bridgeHalted,proto3" json:"bridge_halted,omitempty"`
+}
+
+func (x *CircuitBreaker) Reset() {
+	*x = CircuitBreaker{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_provlabs_vault_v1_params_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CircuitBreaker) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CircuitBreaker) ProtoMessage() {}
+
+// Deprecated: Use CircuitBreaker.ProtoReflect.Descriptor instead.
+func (*CircuitBreaker) Descriptor() ([]byte, []int) {
+	return file_provlabs_vault_v1_params_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *CircuitBreaker) GetSwapInHalted() bool {
+	if x != nil {
+		return x.SwapInHalted
+	}
+	return false
+}
+
+func (x *CircuitBreaker) GetSwapOutHalted() bool {
+	if x != nil {
+		return x.SwapOutHalted
+	}
+	return false
+}
+
+func (x *CircuitBreaker) GetSettlementHalted() bool {
+	if x != nil {
+		return x.SettlementHalted
+	}
+	return false
+}
+
+func (x *CircuitBreaker) GetBridgeHalted() bool {
+	if x != nil {
+		return x.BridgeHalted
+	}
+	return false
+}
+
+var File_provlabs_vault_v1_params_proto protoreflect.FileDescriptor
+
+var file_provlabs_vault_v1_params_proto_rawDesc = []byte{
+	0x0a, 0x1e, 0x70, 0x72, 0x6f, 0x76, 0x6c, 0x61, 0x62, 0x73, 0x2f, 0x76, 0x61, 0x75, 0x6c, 0x74,
+	0x2f, 0x76, 0x31, 0x2f, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x12, 0x11, 0x70, 0x72, 0x6f, 0x76, 0x6c, 0x61, 0x62, 0x73, 0x2e, 0x76, 0x61, 0x75, 0x6c, 0x74,
+	0x2e, 0x76, 0x31, 0x1a, 0x19, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x5f, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x14,
+	0x67, 0x6f, 0x67, 0x6f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x67, 0x6f, 0x67, 0x6f, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x22, 0xaf, 0x03, 0x0a, 0x06, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12,
+	0x42, 0x0a, 0x10, 0x74, 0x65, 0x63, 0x68, 0x5f, 0x66, 0x65, 0x65, 0x5f, 0x61, 0x64, 0x64, 0x72,
+	0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63,
+	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72,
+	0x69, 0x6e, 0x67, 0x52, 0x0e, 0x74, 0x65, 0x63, 0x68, 0x46, 0x65, 0x65, 0x41, 0x64, 0x64, 0x72,
+	0x65, 0x73, 0x73, 0x12, 0x2f, 0x0a, 0x14, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x5f, 0x61,
+	0x75, 0x6d, 0x5f, 0x66, 0x65, 0x65, 0x5f, 0x62, 0x69, 0x70, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0d, 0x52, 0x11, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x41, 0x75, 0x6d, 0x46, 0x65, 0x65,
+	0x42, 0x69, 0x70, 0x73, 0x12, 0x41, 0x0a, 0x1d, 0x6e, 0x61, 0x76, 0x5f, 0x68, 0x69, 0x73, 0x74,
+	0x6f, 0x72, 0x79, 0x5f, 0x72, 0x65, 0x74, 0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x73, 0x65,
+	0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x1a, 0x6e, 0x61, 0x76,
+	0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x74, 0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e,
+	0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x12, 0x41, 0x0a, 0x1d, 0x73, 0x68, 0x61, 0x72, 0x65,
+	0x5f, 0x70, 0x72, 0x69, 0x63, 0x65, 0x5f, 0x72, 0x65, 0x74, 0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e,
+	0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x1a,
+	0x73, 0x68, 0x61, 0x72, 0x65, 0x50, 0x72, 0x69, 0x63, 0x65, 0x52, 0x65, 0x74, 0x65, 0x6e, 0x74,
+	0x69, 0x6f, 0x6e, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x12, 0x52, 0x0a, 0x18, 0x63, 0x69,
+	0x72, 0x63, 0x75, 0x69, 0x74, 0x5f, 0x62, 0x72, 0x65, 0x61, 0x6b, 0x65, 0x72, 0x5f, 0x67, 0x75,
+	0x61, 0x72, 0x64, 0x69, 0x61, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4,
+	0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
+	0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x16, 0x63, 0x69, 0x72, 0x63, 0x75, 0x69, 0x74, 0x42,
+	0x72, 0x65, 0x61, 0x6b, 0x65, 0x72, 0x47, 0x75, 0x61, 0x72, 0x64, 0x69, 0x61, 0x6e, 0x12, 0x50,
+	0x0a, 0x0f, 0x63, 0x69, 0x72, 0x63, 0x75, 0x69, 0x74, 0x5f, 0x62, 0x72, 0x65, 0x61, 0x6b, 0x65,
+	0x72, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x70, 0x72, 0x6f, 0x76, 0x6c, 0x61,
+	0x62, 0x73, 0x2e, 0x76, 0x61, 0x75, 0x6c, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x69, 0x72, 0x63,
+	0x75, 0x69, 0x74, 0x42, 0x72, 0x65, 0x61, 0x6b, 0x65, 0x72, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00,
+	0x52, 0x0e, 0x63, 0x69, 0x72, 0x63, 0x75, 0x69, 0x74, 0x42, 0x72, 0x65, 0x61, 0x6b, 0x65, 0x72,
+	0x3a, 0x04, 0xe8, 0xa0, 0x1f, 0x01, 0x22, 0xb6, 0x01, 0x0a, 0x0e, 0x43, 0x69, 0x72, 0x63, 0x75,
+	0x69, 0x74, 0x42, 0x72, 0x65, 0x61, 0x6b, 0x65, 0x72, 0x12, 0x24, 0x0a, 0x0e, 0x73, 0x77, 0x61,
+	0x70, 0x5f, 0x69, 0x6e, 0x5f, 0x68, 0x61, 0x6c, 0x74, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x0c, 0x73, 0x77, 0x61, 0x70, 0x49, 0x6e, 0x48, 0x61, 0x6c, 0x74, 0x65, 0x64, 0x12,
+	0x26, 0x0a, 0x0f, 0x73, 0x77, 0x61, 0x70, 0x5f, 0x6f, 0x75, 0x74, 0x5f, 0x68, 0x61, 0x6c, 0x74,
+	0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0d, 0x73, 0x77, 0x61, 0x70, 0x4f, 0x75,
+	0x74, 0x48, 0x61, 0x6c, 0x74, 0x65, 0x64, 0x12, 0x2b, 0x0a, 0x11, 0x73, 0x65, 0x74, 0x74, 0x6c,
+	0x65, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x68, 0x61, 0x6c, 0x74, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x10, 0x73, 0x65, 0x74, 0x74, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x48, 0x61,
+	0x6c, 0x74, 0x65, 0x64, 0x12, 0x23, 0x0a, 0x0d, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x5f, 0x68,
+	0x61, 0x6c, 0x74, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0c, 0x62, 0x72, 0x69,
+	0x64, 0x67, 0x65, 0x48, 0x61, 0x6c, 0x74, 0x65, 0x64, 0x3a, 0x04, 0xe8, 0xa0, 0x1f, 0x01, 0x42,
+	0xc3, 0x01, 0x0a, 0x15, 0x63, 0x6f, 0x6d, 0x2e, 0x70, 0x72, 0x6f, 0x76, 0x6c, 0x61, 0x62, 0x73,
+	0x2e, 0x76, 0x61, 0x75, 0x6c, 0x74, 0x2e, 0x76, 0x31, 0x42, 0x0b, 0x50, 0x61, 0x72, 0x61, 0x6d,
+	0x73, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x37, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62,
+	0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x70, 0x72, 0x6f, 0x76, 0x6c, 0x61, 0x62, 0x73, 0x2f, 0x76, 0x61,
+	0x75, 0x6c, 0x74, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x70, 0x72, 0x6f, 0x76, 0x6c, 0x61, 0x62, 0x73,
+	0x2f, 0x76, 0x61, 0x75, 0x6c, 0x74, 0x2f, 0x76, 0x31, 0x3b, 0x76, 0x61, 0x75, 0x6c, 0x74, 0x76,
+	0x31, 0xa2, 0x02, 0x03, 0x50, 0x56, 0x58, 0xaa, 0x02, 0x11, 0x50, 0x72, 0x6f, 0x76, 0x6c, 0x61,
+	0x62, 0x73, 0x2e, 0x56, 0x61, 0x75, 0x6c, 0x74, 0x2e, 0x56, 0x31, 0xca, 0x02, 0x11, 0x50, 0x72,
+	0x6f, 0x76, 0x6c, 0x61, 0x62, 0x73, 0x5c, 0x56, 0x61, 0x75, 0x6c, 0x74, 0x5c, 0x56, 0x31, 0xe2,
+	0x02, 0x1d, 0x50, 0x72, 0x6f, 0x76, 0x6c, 0x61, 0x62, 0x73, 0x5c, 0x56, 0x61, 0x75, 0x6c, 0x74,
+	0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea,
+	0x02, 0x13, 0x50, 0x72, 0x6f, 0x76, 0x6c, 0x61, 0x62, 0x73, 0x3a, 0x3a, 0x56, 0x61, 0x75, 0x6c,
+	0x74, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_provlabs_vault_v1_params_proto_rawDescOnce sync.Once
+	file_provlabs_vault_v1_params_proto_rawDescData = file_provlabs_vault_v1_params_proto_rawDesc
+)
+
+func file_provlabs_vault_v1_params_proto_rawDescGZIP() []byte {
+	file_provlabs_vault_v1_params_proto_rawDescOnce.Do(func() {
+		file_provlabs_vault_v1_params_proto_rawDescData = protoimpl.X.CompressGZIP(file_provlabs_vault_v1_params_proto_rawDescData)
+	})
+	return file_provlabs_vault_v1_params_proto_rawDescData
+}
+
+var file_provlabs_vault_v1_params_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_provlabs_vault_v1_params_proto_goTypes = []interface{}{
+	(*Params)(nil),         // 0: provlabs.vault.v1.Params
+	(*CircuitBreaker)(nil), // 1: provlabs.vault.v1.CircuitBreaker
+}
+var file_provlabs_vault_v1_params_proto_depIdxs = []int32{
+	1, // 0: provlabs.vault.v1.Params.circuit_breaker:type_name -> provlabs.vault.v1.CircuitBreaker
+	1, // [1:1] is the sub-list for method output_type
+	1, // [1:1] is the sub-list for method input_type
+	1, // [1:1] is the sub-list for extension type_name
+	1, // [1:1] is the sub-list for extension extendee
+	0, // [0:1] is the sub-list for field type_name
+}
+
+func init() { file_provlabs_vault_v1_params_proto_init() }
 func file_provlabs_vault_v1_params_proto_init() {
 	if File_provlabs_vault_v1_params_proto != nil {
 		return
@@ -617,6 +1568,18 @@ func file_provlabs_vault_v1_params_proto_init() {
 				return nil
 			}
 		}
+		file_provlabs_vault_v1_params_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CircuitBreaker); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -624,7 +1587,7 @@ func file_provlabs_vault_v1_params_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_provlabs_vault_v1_params_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   1,
+			NumMessages:   2,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
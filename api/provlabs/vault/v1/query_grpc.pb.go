@@ -28,8 +28,19 @@ const (
 	Query_Params_FullMethodName               = "/provlabs.vault.v1.Query/Params"
 	Query_VaultNavs_FullMethodName            = "/provlabs.vault.v1.Query/VaultNavs"
 	Query_NavValue_FullMethodName             = "/provlabs.vault.v1.Query/NavValue"
+	Query_PendingNAVProposals_FullMethodName  = "/provlabs.vault.v1.Query/PendingNAVProposals"
+	Query_NAVSources_FullMethodName           = "/provlabs.vault.v1.Query/NAVSources"
+	Query_NavHistory_FullMethodName           = "/provlabs.vault.v1.Query/NavHistory"
 	Query_VaultPayment_FullMethodName         = "/provlabs.vault.v1.Query/VaultPayment"
 	Query_VaultPayments_FullMethodName        = "/provlabs.vault.v1.Query/VaultPayments"
+	Query_SharePrice_FullMethodName           = "/provlabs.vault.v1.Query/SharePrice"
+	Query_VaultTWAP_FullMethodName            = "/provlabs.vault.v1.Query/VaultTWAP"
+	Query_VaultPerformance_FullMethodName     = "/provlabs.vault.v1.Query/VaultPerformance"
+	Query_VaultComposition_FullMethodName     = "/provlabs.vault.v1.Query/VaultComposition"
+	Query_VaultHoldings_FullMethodName        = "/provlabs.vault.v1.Query/VaultHoldings"
+	Query_ScheduledActions_FullMethodName     = "/provlabs.vault.v1.Query/ScheduledActions"
+	Query_InvestorEligibility_FullMethodName  = "/provlabs.vault.v1.Query/InvestorEligibility"
+	Query_CircuitBreaker_FullMethodName       = "/provlabs.vault.v1.Query/CircuitBreaker"
 )
 
 // QueryClient is the client API for Query service.
@@ -52,16 +63,49 @@ type QueryClient interface {
 	VaultPendingSwapOuts(ctx context.Context, in *QueryVaultPendingSwapOutsRequest, opts ...grpc.CallOption) (*QueryVaultPendingSwapOutsResponse, error)
 	// Params returns the current module parameters.
 	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
-	// VaultNavs returns a paginated list of all internal NAV entries for a vault.
+	// VaultNavs returns a paginated list of all internal NAV entries for a vault, along with
+	// the vault's valuation haircuts.
 	VaultNavs(ctx context.Context, in *QueryVaultNavsRequest, opts ...grpc.CallOption) (*QueryVaultNavsResponse, error)
 	// NavValue returns a single internal NAV entry for a vault and denom.
 	NavValue(ctx context.Context, in *QueryNavValueRequest, opts ...grpc.CallOption) (*QueryNavValueResponse, error)
+	// PendingNAVProposals returns a paginated list of a vault's NAV updates awaiting approval
+	// because they exceeded the vault's max_nav_change_bips.
+	PendingNAVProposals(ctx context.Context, in *QueryPendingNAVProposalsRequest, opts ...grpc.CallOption) (*QueryPendingNAVProposalsResponse, error)
+	// NAVSources returns each NAV source's submission for a vault and denom, whether it
+	// counts toward the quorum, and the aggregated price when the quorum is met.
+	NAVSources(ctx context.Context, in *QueryNAVSourcesRequest, opts ...grpc.CallOption) (*QueryNAVSourcesResponse, error)
+	// NavHistory returns a paginated, optionally downsampled time series of a vault's NAV for
+	// a denom. Querying the vault's share denom returns its published share NAV history.
+	NavHistory(ctx context.Context, in *QueryNavHistoryRequest, opts ...grpc.CallOption) (*QueryNavHistoryResponse, error)
 	// VaultPayment returns a single pending exchange-module payment targeting a vault,
 	// identified by the payment's source account and external id.
 	VaultPayment(ctx context.Context, in *QueryVaultPaymentRequest, opts ...grpc.CallOption) (*QueryVaultPaymentResponse, error)
 	// VaultPayments returns a paginated list of all pending exchange-module payments
 	// targeting a vault.
 	VaultPayments(ctx context.Context, in *QueryVaultPaymentsRequest, opts ...grpc.CallOption) (*QueryVaultPaymentsResponse, error)
+	// SharePrice returns the vault's NAV per share as a decimal, gross, net of AUM fees, and
+	// with accrued interest estimated to the current block.
+	SharePrice(ctx context.Context, in *QuerySharePriceRequest, opts ...grpc.CallOption) (*QuerySharePriceResponse, error)
+	// VaultTWAP returns the vault's time-weighted average share price over a time window,
+	// read from its share price accumulator.
+	VaultTWAP(ctx context.Context, in *QueryVaultTWAPRequest, opts ...grpc.CallOption) (*QueryVaultTWAPResponse, error)
+	// VaultPerformance returns the vault's share price return metrics over trailing 7, 30, 90
+	// and 365 day windows, read from its share price accumulator.
+	VaultPerformance(ctx context.Context, in *QueryVaultPerformanceRequest, opts ...grpc.CallOption) (*QueryVaultPerformanceResponse, error)
+	// VaultComposition returns the value of each denom a vault holds as a share of its total
+	// vault value, against the vault's concentration limits.
+	VaultComposition(ctx context.Context, in *QueryVaultCompositionRequest, opts ...grpc.CallOption) (*QueryVaultCompositionResponse, error)
+	// VaultHoldings returns each denom a vault holds with the price and valuation that
+	// total vault value is built from, and the balances it leaves unvalued.
+	VaultHoldings(ctx context.Context, in *QueryVaultHoldingsRequest, opts ...grpc.CallOption) (*QueryVaultHoldingsResponse, error)
+	// ScheduledActions returns a paginated list of a vault's scheduled actions awaiting their
+	// timelock, ordered by execute time.
+	ScheduledActions(ctx context.Context, in *QueryScheduledActionsRequest, opts ...grpc.CallOption) (*QueryScheduledActionsResponse, error)
+	// InvestorEligibility reports whether an address may subscribe to and hold a vault's shares
+	// under the vault's eligibility policy.
+	InvestorEligibility(ctx context.Context, in *QueryInvestorEligibilityRequest, opts ...grpc.CallOption) (*QueryInvestorEligibilityResponse, error)
+	// CircuitBreaker returns the module-wide circuit breaker and the guardian allowed to change it.
+	CircuitBreaker(ctx context.Context, in *QueryCircuitBreakerRequest, opts ...grpc.CallOption) (*QueryCircuitBreakerResponse, error)
 }
 
 type queryClient struct {
@@ -162,6 +206,36 @@ func (c *queryClient) NavValue(ctx context.Context, in *QueryNavValueRequest, op
 	return out, nil
 }
 
+func (c *queryClient) PendingNAVProposals(ctx context.Context, in *QueryPendingNAVProposalsRequest, opts ...grpc.CallOption) (*QueryPendingNAVProposalsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryPendingNAVProposalsResponse)
+	err := c.cc.Invoke(ctx, Query_PendingNAVProposals_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) NAVSources(ctx context.Context, in *QueryNAVSourcesRequest, opts ...grpc.CallOption) (*QueryNAVSourcesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryNAVSourcesResponse)
+	err := c.cc.Invoke(ctx, Query_NAVSources_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) NavHistory(ctx context.Context, in *QueryNavHistoryRequest, opts ...grpc.CallOption) (*QueryNavHistoryResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryNavHistoryResponse)
+	err := c.cc.Invoke(ctx, Query_NavHistory_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryClient) VaultPayment(ctx context.Context, in *QueryVaultPaymentRequest, opts ...grpc.CallOption) (*QueryVaultPaymentResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(QueryVaultPaymentResponse)
@@ -182,6 +256,86 @@ func (c *queryClient) VaultPayments(ctx context.Context, in *QueryVaultPaymentsR
 	return out, nil
 }
 
+func (c *queryClient) SharePrice(ctx context.Context, in *QuerySharePriceRequest, opts ...grpc.CallOption) (*QuerySharePriceResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QuerySharePriceResponse)
+	err := c.cc.Invoke(ctx, Query_SharePrice_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) VaultTWAP(ctx context.Context, in *QueryVaultTWAPRequest, opts ...grpc.CallOption) (*QueryVaultTWAPResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryVaultTWAPResponse)
+	err := c.cc.Invoke(ctx, Query_VaultTWAP_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) VaultPerformance(ctx context.Context, in *QueryVaultPerformanceRequest, opts ...grpc.CallOption) (*QueryVaultPerformanceResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryVaultPerformanceResponse)
+	err := c.cc.Invoke(ctx, Query_VaultPerformance_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) VaultComposition(ctx context.Context, in *QueryVaultCompositionRequest, opts ...grpc.CallOption) (*QueryVaultCompositionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryVaultCompositionResponse)
+	err := c.cc.Invoke(ctx, Query_VaultComposition_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) VaultHoldings(ctx context.Context, in *QueryVaultHoldingsRequest, opts ...grpc.CallOption) (*QueryVaultHoldingsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryVaultHoldingsResponse)
+	err := c.cc.Invoke(ctx, Query_VaultHoldings_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ScheduledActions(ctx context.Context, in *QueryScheduledActionsRequest, opts ...grpc.CallOption) (*QueryScheduledActionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryScheduledActionsResponse)
+	err := c.cc.Invoke(ctx, Query_ScheduledActions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) InvestorEligibility(ctx context.Context, in *QueryInvestorEligibilityRequest, opts ...grpc.CallOption) (*QueryInvestorEligibilityResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryInvestorEligibilityResponse)
+	err := c.cc.Invoke(ctx, Query_InvestorEligibility_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) CircuitBreaker(ctx context.Context, in *QueryCircuitBreakerRequest, opts ...grpc.CallOption) (*QueryCircuitBreakerResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryCircuitBreakerResponse)
+	err := c.cc.Invoke(ctx, Query_CircuitBreaker_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 // All implementations must embed UnimplementedQueryServer
 // for forward compatibility.
@@ -202,16 +356,49 @@ type QueryServer interface {
 	VaultPendingSwapOuts(context.Context, *QueryVaultPendingSwapOutsRequest) (*QueryVaultPendingSwapOutsResponse, error)
 	// Params returns the current module parameters.
 	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
-	// VaultNavs returns a paginated list of all internal NAV entries for a vault.
+	// VaultNavs returns a paginated list of all internal NAV entries for a vault, along with
+	// the vault's valuation haircuts.
 	VaultNavs(context.Context, *QueryVaultNavsRequest) (*QueryVaultNavsResponse, error)
 	// NavValue returns a single internal NAV entry for a vault and denom.
 	NavValue(context.Context, *QueryNavValueRequest) (*QueryNavValueResponse, error)
+	// PendingNAVProposals returns a paginated list of a vault's NAV updates awaiting approval
+	// because they exceeded the vault's max_nav_change_bips.
+	PendingNAVProposals(context.Context, *QueryPendingNAVProposalsRequest) (*QueryPendingNAVProposalsResponse, error)
+	// NAVSources returns each NAV source's submission for a vault and denom, whether it
+	// counts toward the quorum, and the aggregated price when the quorum is met.
+	NAVSources(context.Context, *QueryNAVSourcesRequest) (*QueryNAVSourcesResponse, error)
+	// NavHistory returns a paginated, optionally downsampled time series of a vault's NAV for
+	// a denom. Querying the vault's share denom returns its published share NAV history.
+	NavHistory(context.Context, *QueryNavHistoryRequest) (*QueryNavHistoryResponse, error)
 	// VaultPayment returns a single pending exchange-module payment targeting a vault,
 	// identified by the payment's source account and external id.
 	VaultPayment(context.Context, *QueryVaultPaymentRequest) (*QueryVaultPaymentResponse, error)
 	// VaultPayments returns a paginated list of all pending exchange-module payments
 	// targeting a vault.
 	VaultPayments(context.Context, *QueryVaultPaymentsRequest) (*QueryVaultPaymentsResponse, error)
+	// SharePrice returns the vault's NAV per share as a decimal, gross, net of AUM fees, and
+	// with accrued interest estimated to the current block.
+	SharePrice(context.Context, *QuerySharePriceRequest) (*QuerySharePriceResponse, error)
+	// VaultTWAP returns the vault's time-weighted average share price over a time window,
+	// read from its share price accumulator.
+	VaultTWAP(context.Context, *QueryVaultTWAPRequest) (*QueryVaultTWAPResponse, error)
+	// VaultPerformance returns the vault's share price return metrics over trailing 7, 30, 90
+	// and 365 day windows, read from its share price accumulator.
+	VaultPerformance(context.Context, *QueryVaultPerformanceRequest) (*QueryVaultPerformanceResponse, error)
+	// VaultComposition returns the value of each denom a vault holds as a share of its total
+	// vault value, against the vault's concentration limits.
+	VaultComposition(context.Context, *QueryVaultCompositionRequest) (*QueryVaultCompositionResponse, error)
+	// VaultHoldings returns each denom a vault holds with the price and valuation that
+	// total vault value is built from, and the balances it leaves unvalued.
+	VaultHoldings(context.Context, *QueryVaultHoldingsRequest) (*QueryVaultHoldingsResponse, error)
+	// ScheduledActions returns a paginated list of a vault's scheduled actions awaiting their
+	// timelock, ordered by execute time.
+	ScheduledActions(context.Context, *QueryScheduledActionsRequest) (*QueryScheduledActionsResponse, error)
+	// InvestorEligibility reports whether an address may subscribe to and hold a vault's shares
+	// under the vault's eligibility policy.
+	InvestorEligibility(context.Context, *QueryInvestorEligibilityRequest) (*QueryInvestorEligibilityResponse, error)
+	// CircuitBreaker returns the module-wide circuit breaker and the guardian allowed to change it.
+	CircuitBreaker(context.Context, *QueryCircuitBreakerRequest) (*QueryCircuitBreakerResponse, error)
 	mustEmbedUnimplementedQueryServer()
 }
 
@@ -249,12 +436,45 @@ func (UnimplementedQueryServer) VaultNavs(context.Context, *QueryVaultNavsReques
 func (UnimplementedQueryServer) NavValue(context.Context, *QueryNavValueRequest) (*QueryNavValueResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method NavValue not implemented")
 }
+func (UnimplementedQueryServer) PendingNAVProposals(context.Context, *QueryPendingNAVProposalsRequest) (*QueryPendingNAVProposalsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PendingNAVProposals not implemented")
+}
+func (UnimplementedQueryServer) NAVSources(context.Context, *QueryNAVSourcesRequest) (*QueryNAVSourcesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method NAVSources not implemented")
+}
+func (UnimplementedQueryServer) NavHistory(context.Context, *QueryNavHistoryRequest) (*QueryNavHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method NavHistory not implemented")
+}
 func (UnimplementedQueryServer) VaultPayment(context.Context, *QueryVaultPaymentRequest) (*QueryVaultPaymentResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method VaultPayment not implemented")
 }
 func (UnimplementedQueryServer) VaultPayments(context.Context, *QueryVaultPaymentsRequest) (*QueryVaultPaymentsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method VaultPayments not implemented")
 }
+func (UnimplementedQueryServer) SharePrice(context.Context, *QuerySharePriceRequest) (*QuerySharePriceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SharePrice not implemented")
+}
+func (UnimplementedQueryServer) VaultTWAP(context.Context, *QueryVaultTWAPRequest) (*QueryVaultTWAPResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VaultTWAP not implemented")
+}
+func (UnimplementedQueryServer) VaultPerformance(context.Context, *QueryVaultPerformanceRequest) (*QueryVaultPerformanceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VaultPerformance not implemented")
+}
+func (UnimplementedQueryServer) VaultComposition(context.Context, *QueryVaultCompositionRequest) (*QueryVaultCompositionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VaultComposition not implemented")
+}
+func (UnimplementedQueryServer) VaultHoldings(context.Context, *QueryVaultHoldingsRequest) (*QueryVaultHoldingsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VaultHoldings not implemented")
+}
+func (UnimplementedQueryServer) ScheduledActions(context.Context, *QueryScheduledActionsRequest) (*QueryScheduledActionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ScheduledActions not implemented")
+}
+func (UnimplementedQueryServer) InvestorEligibility(context.Context, *QueryInvestorEligibilityRequest) (*QueryInvestorEligibilityResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method InvestorEligibility not implemented")
+}
+func (UnimplementedQueryServer) CircuitBreaker(context.Context, *QueryCircuitBreakerRequest) (*QueryCircuitBreakerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CircuitBreaker not implemented")
+}
 func (UnimplementedQueryServer) mustEmbedUnimplementedQueryServer() {}
 func (UnimplementedQueryServer) testEmbeddedByValue()               {}
 
@@ -438,6 +658,60 @@ func _Query_NavValue_Handler(srv interface{}, ctx context.Context, dec func(inte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_PendingNAVProposals_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPendingNAVProposalsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PendingNAVProposals(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_PendingNAVProposals_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PendingNAVProposals(ctx, req.(*QueryPendingNAVProposalsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_NAVSources_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryNAVSourcesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).NAVSources(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_NAVSources_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).NAVSources(ctx, req.(*QueryNAVSourcesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_NavHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryNavHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).NavHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_NavHistory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).NavHistory(ctx, req.(*QueryNavHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Query_VaultPayment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(QueryVaultPaymentRequest)
 	if err := dec(in); err != nil {
@@ -474,6 +748,150 @@ func _Query_VaultPayments_Handler(srv interface{}, ctx context.Context, dec func
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_SharePrice_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySharePriceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SharePrice(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_SharePrice_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SharePrice(ctx, req.(*QuerySharePriceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_VaultTWAP_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryVaultTWAPRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).VaultTWAP(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_VaultTWAP_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).VaultTWAP(ctx, req.(*QueryVaultTWAPRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_VaultPerformance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryVaultPerformanceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).VaultPerformance(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_VaultPerformance_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).VaultPerformance(ctx, req.(*QueryVaultPerformanceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_VaultComposition_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryVaultCompositionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).VaultComposition(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_VaultComposition_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).VaultComposition(ctx, req.(*QueryVaultCompositionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_VaultHoldings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryVaultHoldingsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).VaultHoldings(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_VaultHoldings_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).VaultHoldings(ctx, req.(*QueryVaultHoldingsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ScheduledActions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryScheduledActionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ScheduledActions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_ScheduledActions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ScheduledActions(ctx, req.(*QueryScheduledActionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_InvestorEligibility_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryInvestorEligibilityRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).InvestorEligibility(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_InvestorEligibility_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).InvestorEligibility(ctx, req.(*QueryInvestorEligibilityRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_CircuitBreaker_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryCircuitBreakerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).CircuitBreaker(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_CircuitBreaker_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).CircuitBreaker(ctx, req.(*QueryCircuitBreakerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Query_ServiceDesc is the grpc.ServiceDesc for Query service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -517,6 +935,18 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "NavValue",
 			Handler:    _Query_NavValue_Handler,
 		},
+		{
+			MethodName: "PendingNAVProposals",
+			Handler:    _Query_PendingNAVProposals_Handler,
+		},
+		{
+			MethodName: "NAVSources",
+			Handler:    _Query_NAVSources_Handler,
+		},
+		{
+			MethodName: "NavHistory",
+			Handler:    _Query_NavHistory_Handler,
+		},
 		{
 			MethodName: "VaultPayment",
 			Handler:    _Query_VaultPayment_Handler,
@@ -525,6 +955,38 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "VaultPayments",
 			Handler:    _Query_VaultPayments_Handler,
 		},
+		{
+			MethodName: "SharePrice",
+			Handler:    _Query_SharePrice_Handler,
+		},
+		{
+			MethodName: "VaultTWAP",
+			Handler:    _Query_VaultTWAP_Handler,
+		},
+		{
+			MethodName: "VaultPerformance",
+			Handler:    _Query_VaultPerformance_Handler,
+		},
+		{
+			MethodName: "VaultComposition",
+			Handler:    _Query_VaultComposition_Handler,
+		},
+		{
+			MethodName: "VaultHoldings",
+			Handler:    _Query_VaultHoldings_Handler,
+		},
+		{
+			MethodName: "ScheduledActions",
+			Handler:    _Query_ScheduledActions_Handler,
+		},
+		{
+			MethodName: "InvestorEligibility",
+			Handler:    _Query_InvestorEligibility_Handler,
+		},
+		{
+			MethodName: "CircuitBreaker",
+			Handler:    _Query_CircuitBreaker_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "provlabs/vault/v1/query.proto",
@@ -19,39 +19,69 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	Msg_CreateVault_FullMethodName            = "/provlabs.vault.v1.Msg/CreateVault"
-	Msg_SetShareDenomMetadata_FullMethodName  = "/provlabs.vault.v1.Msg/SetShareDenomMetadata"
-	Msg_SwapIn_FullMethodName                 = "/provlabs.vault.v1.Msg/SwapIn"
-	Msg_SwapOut_FullMethodName                = "/provlabs.vault.v1.Msg/SwapOut"
-	Msg_UpdateMinInterestRate_FullMethodName  = "/provlabs.vault.v1.Msg/UpdateMinInterestRate"
-	Msg_UpdateMaxInterestRate_FullMethodName  = "/provlabs.vault.v1.Msg/UpdateMaxInterestRate"
-	Msg_UpdateInterestRate_FullMethodName     = "/provlabs.vault.v1.Msg/UpdateInterestRate"
-	Msg_UpdateWithdrawalDelay_FullMethodName  = "/provlabs.vault.v1.Msg/UpdateWithdrawalDelay"
-	Msg_UpdateMinSwapInValue_FullMethodName   = "/provlabs.vault.v1.Msg/UpdateMinSwapInValue"
-	Msg_UpdateMinSwapOutValue_FullMethodName  = "/provlabs.vault.v1.Msg/UpdateMinSwapOutValue"
-	Msg_UpdateMaxSwapInValue_FullMethodName   = "/provlabs.vault.v1.Msg/UpdateMaxSwapInValue"
-	Msg_UpdateMaxSwapOutValue_FullMethodName  = "/provlabs.vault.v1.Msg/UpdateMaxSwapOutValue"
-	Msg_ToggleSwapIn_FullMethodName           = "/provlabs.vault.v1.Msg/ToggleSwapIn"
-	Msg_ToggleSwapOut_FullMethodName          = "/provlabs.vault.v1.Msg/ToggleSwapOut"
-	Msg_DepositInterestFunds_FullMethodName   = "/provlabs.vault.v1.Msg/DepositInterestFunds"
-	Msg_WithdrawInterestFunds_FullMethodName  = "/provlabs.vault.v1.Msg/WithdrawInterestFunds"
-	Msg_DepositPrincipalFunds_FullMethodName  = "/provlabs.vault.v1.Msg/DepositPrincipalFunds"
-	Msg_WithdrawPrincipalFunds_FullMethodName = "/provlabs.vault.v1.Msg/WithdrawPrincipalFunds"
-	Msg_ExpeditePendingSwapOut_FullMethodName = "/provlabs.vault.v1.Msg/ExpeditePendingSwapOut"
-	Msg_PauseVault_FullMethodName             = "/provlabs.vault.v1.Msg/PauseVault"
-	Msg_UnpauseVault_FullMethodName           = "/provlabs.vault.v1.Msg/UnpauseVault"
-	Msg_SetBridgeAddress_FullMethodName       = "/provlabs.vault.v1.Msg/SetBridgeAddress"
-	Msg_ToggleBridge_FullMethodName           = "/provlabs.vault.v1.Msg/ToggleBridge"
-	Msg_BridgeMintShares_FullMethodName       = "/provlabs.vault.v1.Msg/BridgeMintShares"
-	Msg_BridgeBurnShares_FullMethodName       = "/provlabs.vault.v1.Msg/BridgeBurnShares"
-	Msg_SetAssetManager_FullMethodName        = "/provlabs.vault.v1.Msg/SetAssetManager"
-	Msg_UpdateParams_FullMethodName           = "/provlabs.vault.v1.Msg/UpdateParams"
-	Msg_UpdateVaultAUMFeeBips_FullMethodName  = "/provlabs.vault.v1.Msg/UpdateVaultAUMFeeBips"
-	Msg_UpdateVaultNAV_FullMethodName         = "/provlabs.vault.v1.Msg/UpdateVaultNAV"
-	Msg_RemoveVaultNAV_FullMethodName         = "/provlabs.vault.v1.Msg/RemoveVaultNAV"
-	Msg_UpdateNAVAuthority_FullMethodName     = "/provlabs.vault.v1.Msg/UpdateNAVAuthority"
-	Msg_AcceptAsset_FullMethodName            = "/provlabs.vault.v1.Msg/AcceptAsset"
-	Msg_RejectAsset_FullMethodName            = "/provlabs.vault.v1.Msg/RejectAsset"
+	Msg_CreateVault_FullMethodName               = "/provlabs.vault.v1.Msg/CreateVault"
+	Msg_SetShareDenomMetadata_FullMethodName     = "/provlabs.vault.v1.Msg/SetShareDenomMetadata"
+	Msg_SwapIn_FullMethodName                    = "/provlabs.vault.v1.Msg/SwapIn"
+	Msg_SwapOut_FullMethodName                   = "/provlabs.vault.v1.Msg/SwapOut"
+	Msg_UpdateMinInterestRate_FullMethodName     = "/provlabs.vault.v1.Msg/UpdateMinInterestRate"
+	Msg_UpdateMaxInterestRate_FullMethodName     = "/provlabs.vault.v1.Msg/UpdateMaxInterestRate"
+	Msg_UpdateInterestRate_FullMethodName        = "/provlabs.vault.v1.Msg/UpdateInterestRate"
+	Msg_UpdateWithdrawalDelay_FullMethodName     = "/provlabs.vault.v1.Msg/UpdateWithdrawalDelay"
+	Msg_UpdateMinSwapInValue_FullMethodName      = "/provlabs.vault.v1.Msg/UpdateMinSwapInValue"
+	Msg_UpdateMinSwapOutValue_FullMethodName     = "/provlabs.vault.v1.Msg/UpdateMinSwapOutValue"
+	Msg_UpdateMaxSwapInValue_FullMethodName      = "/provlabs.vault.v1.Msg/UpdateMaxSwapInValue"
+	Msg_UpdateMaxSwapOutValue_FullMethodName     = "/provlabs.vault.v1.Msg/UpdateMaxSwapOutValue"
+	Msg_ToggleSwapIn_FullMethodName              = "/provlabs.vault.v1.Msg/ToggleSwapIn"
+	Msg_ToggleSwapOut_FullMethodName             = "/provlabs.vault.v1.Msg/ToggleSwapOut"
+	Msg_DepositInterestFunds_FullMethodName      = "/provlabs.vault.v1.Msg/DepositInterestFunds"
+	Msg_WithdrawInterestFunds_FullMethodName     = "/provlabs.vault.v1.Msg/WithdrawInterestFunds"
+	Msg_DepositPrincipalFunds_FullMethodName     = "/provlabs.vault.v1.Msg/DepositPrincipalFunds"
+	Msg_WithdrawPrincipalFunds_FullMethodName    = "/provlabs.vault.v1.Msg/WithdrawPrincipalFunds"
+	Msg_ExpeditePendingSwapOut_FullMethodName    = "/provlabs.vault.v1.Msg/ExpeditePendingSwapOut"
+	Msg_PauseVault_FullMethodName                = "/provlabs.vault.v1.Msg/PauseVault"
+	Msg_UnpauseVault_FullMethodName              = "/provlabs.vault.v1.Msg/UnpauseVault"
+	Msg_SetBridgeAddress_FullMethodName          = "/provlabs.vault.v1.Msg/SetBridgeAddress"
+	Msg_ToggleBridge_FullMethodName              = "/provlabs.vault.v1.Msg/ToggleBridge"
+	Msg_BridgeMintShares_FullMethodName          = "/provlabs.vault.v1.Msg/BridgeMintShares"
+	Msg_BridgeBurnShares_FullMethodName          = "/provlabs.vault.v1.Msg/BridgeBurnShares"
+	Msg_SetAssetManager_FullMethodName           = "/provlabs.vault.v1.Msg/SetAssetManager"
+	Msg_UpdateParams_FullMethodName              = "/provlabs.vault.v1.Msg/UpdateParams"
+	Msg_UpdateVaultAUMFeeBips_FullMethodName     = "/provlabs.vault.v1.Msg/UpdateVaultAUMFeeBips"
+	Msg_UpdateVaultNAV_FullMethodName            = "/provlabs.vault.v1.Msg/UpdateVaultNAV"
+	Msg_RemoveVaultNAV_FullMethodName            = "/provlabs.vault.v1.Msg/RemoveVaultNAV"
+	Msg_UpdateNAVAuthority_FullMethodName        = "/provlabs.vault.v1.Msg/UpdateNAVAuthority"
+	Msg_AcceptAsset_FullMethodName               = "/provlabs.vault.v1.Msg/AcceptAsset"
+	Msg_RejectAsset_FullMethodName               = "/provlabs.vault.v1.Msg/RejectAsset"
+	Msg_UpdateNAVStalenessLimit_FullMethodName   = "/provlabs.vault.v1.Msg/UpdateNAVStalenessLimit"
+	Msg_UpdateNAVChangeLimit_FullMethodName      = "/provlabs.vault.v1.Msg/UpdateNAVChangeLimit"
+	Msg_UpdateSettlementTolerance_FullMethodName = "/provlabs.vault.v1.Msg/UpdateSettlementTolerance"
+	Msg_ApproveNAVProposal_FullMethodName        = "/provlabs.vault.v1.Msg/ApproveNAVProposal"
+	Msg_UpdateNAVSources_FullMethodName          = "/provlabs.vault.v1.Msg/UpdateNAVSources"
+	Msg_SubmitSourceNAV_FullMethodName           = "/provlabs.vault.v1.Msg/SubmitSourceNAV"
+	Msg_BatchUpdateVaultNAV_FullMethodName       = "/provlabs.vault.v1.Msg/BatchUpdateVaultNAV"
+	Msg_UpdatePriceSources_FullMethodName        = "/provlabs.vault.v1.Msg/UpdatePriceSources"
+	Msg_UpdateAssetHaircut_FullMethodName        = "/provlabs.vault.v1.Msg/UpdateAssetHaircut"
+	Msg_BatchAcceptAssets_FullMethodName         = "/provlabs.vault.v1.Msg/BatchAcceptAssets"
+	Msg_CreateAssetPayment_FullMethodName        = "/provlabs.vault.v1.Msg/CreateAssetPayment"
+	Msg_CancelAssetPayment_FullMethodName        = "/provlabs.vault.v1.Msg/CancelAssetPayment"
+	Msg_ExpireAssetPayment_FullMethodName        = "/provlabs.vault.v1.Msg/ExpireAssetPayment"
+	Msg_UpdateAcquisitionPolicy_FullMethodName   = "/provlabs.vault.v1.Msg/UpdateAcquisitionPolicy"
+	Msg_UpdateConcentrationLimits_FullMethodName = "/provlabs.vault.v1.Msg/UpdateConcentrationLimits"
+	Msg_UpdateMinLiquidity_FullMethodName        = "/provlabs.vault.v1.Msg/UpdateMinLiquidity"
+	Msg_CollectAssetIncome_FullMethodName        = "/provlabs.vault.v1.Msg/CollectAssetIncome"
+	Msg_GrantRole_FullMethodName                 = "/provlabs.vault.v1.Msg/GrantRole"
+	Msg_RevokeRole_FullMethodName                = "/provlabs.vault.v1.Msg/RevokeRole"
+	Msg_ProposeAdminTransfer_FullMethodName      = "/provlabs.vault.v1.Msg/ProposeAdminTransfer"
+	Msg_AcceptAdminTransfer_FullMethodName       = "/provlabs.vault.v1.Msg/AcceptAdminTransfer"
+	Msg_CancelAdminTransfer_FullMethodName       = "/provlabs.vault.v1.Msg/CancelAdminTransfer"
+	Msg_RecoverVaultAdmin_FullMethodName         = "/provlabs.vault.v1.Msg/RecoverVaultAdmin"
+	Msg_UpdateTimelock_FullMethodName            = "/provlabs.vault.v1.Msg/UpdateTimelock"
+	Msg_CancelScheduledAction_FullMethodName     = "/provlabs.vault.v1.Msg/CancelScheduledAction"
+	Msg_UpdateEligibilityPolicy_FullMethodName   = "/provlabs.vault.v1.Msg/UpdateEligibilityPolicy"
+	Msg_UpdateAllowlist_FullMethodName           = "/provlabs.vault.v1.Msg/UpdateAllowlist"
+	Msg_UpdatePositionCap_FullMethodName         = "/provlabs.vault.v1.Msg/UpdatePositionCap"
+	Msg_UpdateMaxTotalValue_FullMethodName       = "/provlabs.vault.v1.Msg/UpdateMaxTotalValue"
+	Msg_UpdateCircuitBreaker_FullMethodName      = "/provlabs.vault.v1.Msg/UpdateCircuitBreaker"
 )
 
 // MsgClient is the client API for Msg service.
@@ -92,24 +122,25 @@ type MsgClient interface {
 	// ToggleSwapOut allows enabling or disabling swap-out operations for a vault.
 	ToggleSwapOut(ctx context.Context, in *MsgToggleSwapOutRequest, opts ...grpc.CallOption) (*MsgToggleSwapOutResponse, error)
 	// DepositInterestFunds allows depositing funds into the vault for paying interest.
-	// May be signed by the vault admin or the configured asset manager.
+	// Must be signed by a holder of the vault's reserve funder role.
 	DepositInterestFunds(ctx context.Context, in *MsgDepositInterestFundsRequest, opts ...grpc.CallOption) (*MsgDepositInterestFundsResponse, error)
 	// WithdrawInterestFunds allows withdrawing unused interest funds.
-	// May be signed by the vault admin or the configured asset manager.
+	// Must be signed by a holder of the vault's reserve funder role.
 	WithdrawInterestFunds(ctx context.Context, in *MsgWithdrawInterestFundsRequest, opts ...grpc.CallOption) (*MsgWithdrawInterestFundsResponse, error)
 	// DepositPrincipalFunds allows depositing principal funds into a vault.
-	// May be signed by the vault admin or the configured asset manager.
+	// Must be signed by a holder of the vault's reserve funder role.
 	DepositPrincipalFunds(ctx context.Context, in *MsgDepositPrincipalFundsRequest, opts ...grpc.CallOption) (*MsgDepositPrincipalFundsResponse, error)
 	// WithdrawPrincipalFunds allows withdrawing principal funds from a vault.
-	// May be signed by the vault admin or the configured asset manager.
+	// Must be signed by a holder of the vault's reserve funder role.
 	WithdrawPrincipalFunds(ctx context.Context, in *MsgWithdrawPrincipalFundsRequest, opts ...grpc.CallOption) (*MsgWithdrawPrincipalFundsResponse, error)
 	// ExpeditePendingSwapOut expedites a pending swap out from a vault.
+	// Must be signed by a holder of the vault's limits manager role.
 	ExpeditePendingSwapOut(ctx context.Context, in *MsgExpeditePendingSwapOutRequest, opts ...grpc.CallOption) (*MsgExpeditePendingSwapOutResponse, error)
 	// PauseVault pauses user-facing swap operations for a vault and records a reason.
-	// May be signed by the vault admin or the configured asset manager.
+	// Must be signed by a holder of the vault's pauser role.
 	PauseVault(ctx context.Context, in *MsgPauseVaultRequest, opts ...grpc.CallOption) (*MsgPauseVaultResponse, error)
 	// UnpauseVault re-enables user-facing swap operations for a vault.
-	// May be signed by the vault admin or the configured asset manager.
+	// Must be signed by a holder of the vault's pauser role.
 	UnpauseVault(ctx context.Context, in *MsgUnpauseVaultRequest, opts ...grpc.CallOption) (*MsgUnpauseVaultResponse, error)
 	// SetBridgeAddress sets the single external bridge address allowed to mint or burn shares for a vault.
 	SetBridgeAddress(ctx context.Context, in *MsgSetBridgeAddressRequest, opts ...grpc.CallOption) (*MsgSetBridgeAddressResponse, error)
@@ -120,30 +151,133 @@ type MsgClient interface {
 	// BridgeBurnShares burns local share marker supply for a vault; must be signed by the configured bridge address.
 	BridgeBurnShares(ctx context.Context, in *MsgBridgeBurnSharesRequest, opts ...grpc.CallOption) (*MsgBridgeBurnSharesResponse, error)
 	// SetAssetManager sets or clears the optional asset manager address for a vault.
-	// The vault admin must sign this transaction. Passing an empty address clears it.
+	// The vault admin must sign this transaction. Passing an empty address clears it. The asset
+	// manager's roles move from the previous asset manager to the new one.
 	SetAssetManager(ctx context.Context, in *MsgSetAssetManagerRequest, opts ...grpc.CallOption) (*MsgSetAssetManagerResponse, error)
 	// UpdateParams updates the module parameters.
 	UpdateParams(ctx context.Context, in *MsgUpdateParamsRequest, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
 	// UpdateVaultAUMFeeBips updates the AUM fee bips for a specific vault.
 	UpdateVaultAUMFeeBips(ctx context.Context, in *MsgUpdateVaultAUMFeeBipsRequest, opts ...grpc.CallOption) (*MsgUpdateVaultAUMFeeBipsResponse, error)
 	// UpdateVaultNAV creates or updates a vault's internal net asset value entry for a denom.
-	// Must be signed by the vault's NAV authority.
+	// Must be signed by a holder of the vault's NAV authority role.
 	UpdateVaultNAV(ctx context.Context, in *MsgUpdateVaultNAVRequest, opts ...grpc.CallOption) (*MsgUpdateVaultNAVResponse, error)
 	// RemoveVaultNAV deletes a vault's internal net asset value entry for a denom the vault
 	// does not hold, revoking the authorization to acquire it at that price.
-	// Must be signed by the vault's NAV authority.
+	// Must be signed by a holder of the vault's NAV authority role.
 	RemoveVaultNAV(ctx context.Context, in *MsgRemoveVaultNAVRequest, opts ...grpc.CallOption) (*MsgRemoveVaultNAVResponse, error)
 	// UpdateNAVAuthority rotates the address authorized to mutate a vault's internal NAV table.
 	// Must be signed by the vault admin.
 	UpdateNAVAuthority(ctx context.Context, in *MsgUpdateNAVAuthorityRequest, opts ...grpc.CallOption) (*MsgUpdateNAVAuthorityResponse, error)
 	// AcceptAsset settles a pending exchange-module payment whose target is the vault,
 	// exchanging an external asset for the vault's underlying asset.
-	// Must be signed by the vault's asset manager; the admin cannot settle.
+	// Must be signed by a holder of the vault's settlement manager role.
 	AcceptAsset(ctx context.Context, in *MsgAcceptAssetRequest, opts ...grpc.CallOption) (*MsgAcceptAssetResponse, error)
 	// RejectAsset declines a pending exchange-module payment whose target is the vault,
 	// causing the exchange module to cancel it and refund the source's escrow.
-	// Must be signed by the vault's asset manager; the admin cannot reject.
+	// Must be signed by a holder of the vault's settlement manager role.
 	RejectAsset(ctx context.Context, in *MsgRejectAssetRequest, opts ...grpc.CallOption) (*MsgRejectAssetResponse, error)
+	// UpdateNAVStalenessLimit sets the maximum age of a held denom's internal NAV before the
+	// vault's pricing-dependent operations are blocked, and whether a stale NAV auto-pauses the vault.
+	// Must be signed by the vault admin.
+	UpdateNAVStalenessLimit(ctx context.Context, in *MsgUpdateNAVStalenessLimitRequest, opts ...grpc.CallOption) (*MsgUpdateNAVStalenessLimitResponse, error)
+	// UpdateNAVChangeLimit sets the largest single-update move the NAV authority may apply to a
+	// held denom's internal NAV, and the approver who must confirm larger moves.
+	// Must be signed by the vault admin.
+	UpdateNAVChangeLimit(ctx context.Context, in *MsgUpdateNAVChangeLimitRequest, opts ...grpc.CallOption) (*MsgUpdateNAVChangeLimitResponse, error)
+	// UpdateSettlementTolerance sets how far asset settlements may trade from the vault's internal
+	// NAV, and whether a settlement marks the NAV to its price.
+	// Must be signed by the vault admin.
+	UpdateSettlementTolerance(ctx context.Context, in *MsgUpdateSettlementToleranceRequest, opts ...grpc.CallOption) (*MsgUpdateSettlementToleranceResponse, error)
+	// ApproveNAVProposal applies a pending NAV proposal that exceeded the vault's max_nav_change_bips.
+	// Must be signed by the vault's NAV approver, who may not be the proposal's proposer.
+	ApproveNAVProposal(ctx context.Context, in *MsgApproveNAVProposalRequest, opts ...grpc.CallOption) (*MsgApproveNAVProposalResponse, error)
+	// UpdateNAVSources sets the vault's independent NAV sources, the quorum of fresh submissions
+	// required before their aggregated price is used, and how submissions are aggregated.
+	// Must be signed by the vault admin.
+	UpdateNAVSources(ctx context.Context, in *MsgUpdateNAVSourcesRequest, opts ...grpc.CallOption) (*MsgUpdateNAVSourcesResponse, error)
+	// SubmitSourceNAV records a NAV source's price for a denom in the vault's per-source table.
+	// Must be signed by one of the vault's NAV sources.
+	SubmitSourceNAV(ctx context.Context, in *MsgSubmitSourceNAVRequest, opts ...grpc.CallOption) (*MsgSubmitSourceNAVResponse, error)
+	// BatchUpdateVaultNAV creates or updates a vault's internal net asset value entries for
+	// many denoms at once, reconciling the vault a single time.
+	// Must be signed by a holder of the vault's NAV authority role.
+	BatchUpdateVaultNAV(ctx context.Context, in *MsgBatchUpdateVaultNAVRequest, opts ...grpc.CallOption) (*MsgBatchUpdateVaultNAVResponse, error)
+	// UpdatePriceSources sets the ordered chain of sources a vault consults to price its
+	// held denoms. Must be signed by the vault admin.
+	UpdatePriceSources(ctx context.Context, in *MsgUpdatePriceSourcesRequest, opts ...grpc.CallOption) (*MsgUpdatePriceSourcesResponse, error)
+	// UpdateAssetHaircut sets the valuation haircut a vault applies to a held denom.
+	// Must be signed by a holder of the vault's NAV authority role.
+	UpdateAssetHaircut(ctx context.Context, in *MsgUpdateAssetHaircutRequest, opts ...grpc.CallOption) (*MsgUpdateAssetHaircutResponse, error)
+	// BatchAcceptAssets settles many pending exchange-module payments whose target is the vault,
+	// reconciling the vault a single time. Either every payment settles or none does, unless
+	// best_effort is set. Must be signed by a holder of the vault's settlement manager role.
+	BatchAcceptAssets(ctx context.Context, in *MsgBatchAcceptAssetsRequest, opts ...grpc.CallOption) (*MsgBatchAcceptAssetsResponse, error)
+	// CreateAssetPayment creates an x/exchange payment with the vault's payment escrow account
+	// as its source, offering a held asset or the underlying asset to a counterparty.
+	// Must be signed by a holder of the vault's settlement manager role.
+	CreateAssetPayment(ctx context.Context, in *MsgCreateAssetPaymentRequest, opts ...grpc.CallOption) (*MsgCreateAssetPaymentResponse, error)
+	// CancelAssetPayment cancels an open payment created by CreateAssetPayment and returns its
+	// escrow to the principal. Must be signed by a holder of the vault's settlement manager
+	// role.
+	CancelAssetPayment(ctx context.Context, in *MsgCancelAssetPaymentRequest, opts ...grpc.CallOption) (*MsgCancelAssetPaymentResponse, error)
+	// ExpireAssetPayment cancels an open payment created by CreateAssetPayment once it has
+	// expired and returns its escrow to the principal. Any account may sign it.
+	ExpireAssetPayment(ctx context.Context, in *MsgExpireAssetPaymentRequest, opts ...grpc.CallOption) (*MsgExpireAssetPaymentResponse, error)
+	// UpdateAcquisitionPolicy replaces the policy restricting which assets a vault may price and
+	// acquire. Must be signed by the vault admin.
+	UpdateAcquisitionPolicy(ctx context.Context, in *MsgUpdateAcquisitionPolicyRequest, opts ...grpc.CallOption) (*MsgUpdateAcquisitionPolicyResponse, error)
+	// UpdateConcentrationLimits sets the largest shares of total vault value a single
+	// non-underlying asset, and all non-underlying assets together, may make up. Must be signed
+	// by the vault admin.
+	UpdateConcentrationLimits(ctx context.Context, in *MsgUpdateConcentrationLimitsRequest, opts ...grpc.CallOption) (*MsgUpdateConcentrationLimitsResponse, error)
+	// UpdateMinLiquidity sets the share of total vault value a vault must keep liquid in its
+	// underlying asset. Must be signed by the vault admin.
+	UpdateMinLiquidity(ctx context.Context, in *MsgUpdateMinLiquidityRequest, opts ...grpc.CallOption) (*MsgUpdateMinLiquidityResponse, error)
+	// CollectAssetIncome moves income earned by a held asset from the vault's principal into
+	// its interest reserves, or back. Must be signed by a holder of the vault's settlement
+	// manager role.
+	CollectAssetIncome(ctx context.Context, in *MsgCollectAssetIncomeRequest, opts ...grpc.CallOption) (*MsgCollectAssetIncomeResponse, error)
+	// GrantRole authorizes an address to perform the operations of a role on a vault. Must be
+	// signed by the vault admin.
+	GrantRole(ctx context.Context, in *MsgGrantRoleRequest, opts ...grpc.CallOption) (*MsgGrantRoleResponse, error)
+	// RevokeRole removes an address's grant of a role on a vault. Must be signed by the vault
+	// admin.
+	RevokeRole(ctx context.Context, in *MsgRevokeRoleRequest, opts ...grpc.CallOption) (*MsgRevokeRoleResponse, error)
+	// ProposeAdminTransfer proposes a new admin for a vault, replacing any pending proposal.
+	// Must be signed by the vault admin.
+	ProposeAdminTransfer(ctx context.Context, in *MsgProposeAdminTransferRequest, opts ...grpc.CallOption) (*MsgProposeAdminTransferResponse, error)
+	// AcceptAdminTransfer completes a pending admin transfer. Must be signed by the proposed
+	// admin before the proposal expires.
+	AcceptAdminTransfer(ctx context.Context, in *MsgAcceptAdminTransferRequest, opts ...grpc.CallOption) (*MsgAcceptAdminTransferResponse, error)
+	// CancelAdminTransfer withdraws a pending admin transfer. Must be signed by the vault admin.
+	CancelAdminTransfer(ctx context.Context, in *MsgCancelAdminTransferRequest, opts ...grpc.CallOption) (*MsgCancelAdminTransferResponse, error)
+	// RecoverVaultAdmin replaces a vault's admin immediately, for vaults whose admin key is lost
+	// or compromised. Must be signed by the governance module account.
+	RecoverVaultAdmin(ctx context.Context, in *MsgRecoverVaultAdminRequest, opts ...grpc.CallOption) (*MsgRecoverVaultAdminResponse, error)
+	// UpdateTimelock sets the delay applied to the vault's sensitive configuration changes.
+	// Raising it takes effect immediately; lowering it is itself subject to the current timelock.
+	// Must be signed by the vault admin.
+	UpdateTimelock(ctx context.Context, in *MsgUpdateTimelockRequest, opts ...grpc.CallOption) (*MsgUpdateTimelockResponse, error)
+	// CancelScheduledAction discards a scheduled action before it executes. Must be signed by
+	// the vault admin or a holder of the vault's guardian role.
+	CancelScheduledAction(ctx context.Context, in *MsgCancelScheduledActionRequest, opts ...grpc.CallOption) (*MsgCancelScheduledActionResponse, error)
+	// UpdateEligibilityPolicy replaces the policy restricting which addresses may subscribe to
+	// and hold a vault's shares. Must be signed by the vault admin.
+	UpdateEligibilityPolicy(ctx context.Context, in *MsgUpdateEligibilityPolicyRequest, opts ...grpc.CallOption) (*MsgUpdateEligibilityPolicyResponse, error)
+	// UpdateAllowlist adds addresses to and removes addresses from a vault's investor
+	// allowlist. Must be signed by the vault admin.
+	UpdateAllowlist(ctx context.Context, in *MsgUpdateAllowlistRequest, opts ...grpc.CallOption) (*MsgUpdateAllowlistResponse, error)
+	// UpdatePositionCap sets the largest position a single account may build in a vault through
+	// swap-ins, and the addresses exempt from it. Must be signed by a holder of the vault's
+	// limits manager role.
+	UpdatePositionCap(ctx context.Context, in *MsgUpdatePositionCapRequest, opts ...grpc.CallOption) (*MsgUpdatePositionCapResponse, error)
+	// UpdateMaxTotalValue sets the net total value a vault may reach through swap-ins. Must be
+	// signed by a holder of the vault's limits manager role.
+	UpdateMaxTotalValue(ctx context.Context, in *MsgUpdateMaxTotalValueRequest, opts ...grpc.CallOption) (*MsgUpdateMaxTotalValueResponse, error)
+	// UpdateCircuitBreaker trips or resets the module-wide circuit breaker and, when signed by
+	// governance, appoints or removes its guardian. Must be signed by governance or the circuit
+	// breaker guardian.
+	UpdateCircuitBreaker(ctx context.Context, in *MsgUpdateCircuitBreakerRequest, opts ...grpc.CallOption) (*MsgUpdateCircuitBreakerResponse, error)
 }
 
 type msgClient struct {
@@ -484,6 +618,306 @@ func (c *msgClient) RejectAsset(ctx context.Context, in *MsgRejectAssetRequest,
 	return out, nil
 }
 
+func (c *msgClient) UpdateNAVStalenessLimit(ctx context.Context, in *MsgUpdateNAVStalenessLimitRequest, opts ...grpc.CallOption) (*MsgUpdateNAVStalenessLimitResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgUpdateNAVStalenessLimitResponse)
+	err := c.cc.Invoke(ctx, Msg_UpdateNAVStalenessLimit_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) UpdateNAVChangeLimit(ctx context.Context, in *MsgUpdateNAVChangeLimitRequest, opts ...grpc.CallOption) (*MsgUpdateNAVChangeLimitResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgUpdateNAVChangeLimitResponse)
+	err := c.cc.Invoke(ctx, Msg_UpdateNAVChangeLimit_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) UpdateSettlementTolerance(ctx context.Context, in *MsgUpdateSettlementToleranceRequest, opts ...grpc.CallOption) (*MsgUpdateSettlementToleranceResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgUpdateSettlementToleranceResponse)
+	err := c.cc.Invoke(ctx, Msg_UpdateSettlementTolerance_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) ApproveNAVProposal(ctx context.Context, in *MsgApproveNAVProposalRequest, opts ...grpc.CallOption) (*MsgApproveNAVProposalResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgApproveNAVProposalResponse)
+	err := c.cc.Invoke(ctx, Msg_ApproveNAVProposal_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) UpdateNAVSources(ctx context.Context, in *MsgUpdateNAVSourcesRequest, opts ...grpc.CallOption) (*MsgUpdateNAVSourcesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgUpdateNAVSourcesResponse)
+	err := c.cc.Invoke(ctx, Msg_UpdateNAVSources_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SubmitSourceNAV(ctx context.Context, in *MsgSubmitSourceNAVRequest, opts ...grpc.CallOption) (*MsgSubmitSourceNAVResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgSubmitSourceNAVResponse)
+	err := c.cc.Invoke(ctx, Msg_SubmitSourceNAV_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) BatchUpdateVaultNAV(ctx context.Context, in *MsgBatchUpdateVaultNAVRequest, opts ...grpc.CallOption) (*MsgBatchUpdateVaultNAVResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgBatchUpdateVaultNAVResponse)
+	err := c.cc.Invoke(ctx, Msg_BatchUpdateVaultNAV_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) UpdatePriceSources(ctx context.Context, in *MsgUpdatePriceSourcesRequest, opts ...grpc.CallOption) (*MsgUpdatePriceSourcesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgUpdatePriceSourcesResponse)
+	err := c.cc.Invoke(ctx, Msg_UpdatePriceSources_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) UpdateAssetHaircut(ctx context.Context, in *MsgUpdateAssetHaircutRequest, opts ...grpc.CallOption) (*MsgUpdateAssetHaircutResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgUpdateAssetHaircutResponse)
+	err := c.cc.Invoke(ctx, Msg_UpdateAssetHaircut_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) BatchAcceptAssets(ctx context.Context, in *MsgBatchAcceptAssetsRequest, opts ...grpc.CallOption) (*MsgBatchAcceptAssetsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgBatchAcceptAssetsResponse)
+	err := c.cc.Invoke(ctx, Msg_BatchAcceptAssets_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) CreateAssetPayment(ctx context.Context, in *MsgCreateAssetPaymentRequest, opts ...grpc.CallOption) (*MsgCreateAssetPaymentResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgCreateAssetPaymentResponse)
+	err := c.cc.Invoke(ctx, Msg_CreateAssetPayment_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) CancelAssetPayment(ctx context.Context, in *MsgCancelAssetPaymentRequest, opts ...grpc.CallOption) (*MsgCancelAssetPaymentResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgCancelAssetPaymentResponse)
+	err := c.cc.Invoke(ctx, Msg_CancelAssetPayment_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) ExpireAssetPayment(ctx context.Context, in *MsgExpireAssetPaymentRequest, opts ...grpc.CallOption) (*MsgExpireAssetPaymentResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgExpireAssetPaymentResponse)
+	err := c.cc.Invoke(ctx, Msg_ExpireAssetPayment_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) UpdateAcquisitionPolicy(ctx context.Context, in *MsgUpdateAcquisitionPolicyRequest, opts ...grpc.CallOption) (*MsgUpdateAcquisitionPolicyResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgUpdateAcquisitionPolicyResponse)
+	err := c.cc.Invoke(ctx, Msg_UpdateAcquisitionPolicy_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) UpdateConcentrationLimits(ctx context.Context, in *MsgUpdateConcentrationLimitsRequest, opts ...grpc.CallOption) (*MsgUpdateConcentrationLimitsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgUpdateConcentrationLimitsResponse)
+	err := c.cc.Invoke(ctx, Msg_UpdateConcentrationLimits_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) UpdateMinLiquidity(ctx context.Context, in *MsgUpdateMinLiquidityRequest, opts ...grpc.CallOption) (*MsgUpdateMinLiquidityResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgUpdateMinLiquidityResponse)
+	err := c.cc.Invoke(ctx, Msg_UpdateMinLiquidity_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) CollectAssetIncome(ctx context.Context, in *MsgCollectAssetIncomeRequest, opts ...grpc.CallOption) (*MsgCollectAssetIncomeResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgCollectAssetIncomeResponse)
+	err := c.cc.Invoke(ctx, Msg_CollectAssetIncome_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) GrantRole(ctx context.Context, in *MsgGrantRoleRequest, opts ...grpc.CallOption) (*MsgGrantRoleResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgGrantRoleResponse)
+	err := c.cc.Invoke(ctx, Msg_GrantRole_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RevokeRole(ctx context.Context, in *MsgRevokeRoleRequest, opts ...grpc.CallOption) (*MsgRevokeRoleResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgRevokeRoleResponse)
+	err := c.cc.Invoke(ctx, Msg_RevokeRole_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) ProposeAdminTransfer(ctx context.Context, in *MsgProposeAdminTransferRequest, opts ...grpc.CallOption) (*MsgProposeAdminTransferResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgProposeAdminTransferResponse)
+	err := c.cc.Invoke(ctx, Msg_ProposeAdminTransfer_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) AcceptAdminTransfer(ctx context.Context, in *MsgAcceptAdminTransferRequest, opts ...grpc.CallOption) (*MsgAcceptAdminTransferResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgAcceptAdminTransferResponse)
+	err := c.cc.Invoke(ctx, Msg_AcceptAdminTransfer_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) CancelAdminTransfer(ctx context.Context, in *MsgCancelAdminTransferRequest, opts ...grpc.CallOption) (*MsgCancelAdminTransferResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgCancelAdminTransferResponse)
+	err := c.cc.Invoke(ctx, Msg_CancelAdminTransfer_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RecoverVaultAdmin(ctx context.Context, in *MsgRecoverVaultAdminRequest, opts ...grpc.CallOption) (*MsgRecoverVaultAdminResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgRecoverVaultAdminResponse)
+	err := c.cc.Invoke(ctx, Msg_RecoverVaultAdmin_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) UpdateTimelock(ctx context.Context, in *MsgUpdateTimelockRequest, opts ...grpc.CallOption) (*MsgUpdateTimelockResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgUpdateTimelockResponse)
+	err := c.cc.Invoke(ctx, Msg_UpdateTimelock_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) CancelScheduledAction(ctx context.Context, in *MsgCancelScheduledActionRequest, opts ...grpc.CallOption) (*MsgCancelScheduledActionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgCancelScheduledActionResponse)
+	err := c.cc.Invoke(ctx, Msg_CancelScheduledAction_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) UpdateEligibilityPolicy(ctx context.Context, in *MsgUpdateEligibilityPolicyRequest, opts ...grpc.CallOption) (*MsgUpdateEligibilityPolicyResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgUpdateEligibilityPolicyResponse)
+	err := c.cc.Invoke(ctx, Msg_UpdateEligibilityPolicy_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) UpdateAllowlist(ctx context.Context, in *MsgUpdateAllowlistRequest, opts ...grpc.CallOption) (*MsgUpdateAllowlistResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgUpdateAllowlistResponse)
+	err := c.cc.Invoke(ctx, Msg_UpdateAllowlist_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) UpdatePositionCap(ctx context.Context, in *MsgUpdatePositionCapRequest, opts ...grpc.CallOption) (*MsgUpdatePositionCapResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgUpdatePositionCapResponse)
+	err := c.cc.Invoke(ctx, Msg_UpdatePositionCap_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) UpdateMaxTotalValue(ctx context.Context, in *MsgUpdateMaxTotalValueRequest, opts ...grpc.CallOption) (*MsgUpdateMaxTotalValueResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgUpdateMaxTotalValueResponse)
+	err := c.cc.Invoke(ctx, Msg_UpdateMaxTotalValue_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) UpdateCircuitBreaker(ctx context.Context, in *MsgUpdateCircuitBreakerRequest, opts ...grpc.CallOption) (*MsgUpdateCircuitBreakerResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgUpdateCircuitBreakerResponse)
+	err := c.cc.Invoke(ctx, Msg_UpdateCircuitBreaker_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 // All implementations must embed UnimplementedMsgServer
 // for forward compatibility.
@@ -522,24 +956,25 @@ type MsgServer interface {
 	// ToggleSwapOut allows enabling or disabling swap-out operations for a vault.
 	ToggleSwapOut(context.Context, *MsgToggleSwapOutRequest) (*MsgToggleSwapOutResponse, error)
 	// DepositInterestFunds allows depositing funds into the vault for paying interest.
-	// May be signed by the vault admin or the configured asset manager.
+	// Must be signed by a holder of the vault's reserve funder role.
 	DepositInterestFunds(context.Context, *MsgDepositInterestFundsRequest) (*MsgDepositInterestFundsResponse, error)
 	// WithdrawInterestFunds allows withdrawing unused interest funds.
-	// May be signed by the vault admin or the configured asset manager.
+	// Must be signed by a holder of the vault's reserve funder role.
 	WithdrawInterestFunds(context.Context, *MsgWithdrawInterestFundsRequest) (*MsgWithdrawInterestFundsResponse, error)
 	// DepositPrincipalFunds allows depositing principal funds into a vault.
-	// May be signed by the vault admin or the configured asset manager.
+	// Must be signed by a holder of the vault's reserve funder role.
 	DepositPrincipalFunds(context.Context, *MsgDepositPrincipalFundsRequest) (*MsgDepositPrincipalFundsResponse, error)
 	// WithdrawPrincipalFunds allows withdrawing principal funds from a vault.
-	// May be signed by the vault admin or the configured asset manager.
+	// Must be signed by a holder of the vault's reserve funder role.
 	WithdrawPrincipalFunds(context.Context, *MsgWithdrawPrincipalFundsRequest) (*MsgWithdrawPrincipalFundsResponse, error)
 	// ExpeditePendingSwapOut expedites a pending swap out from a vault.
+	// Must be signed by a holder of the vault's limits manager role.
 	ExpeditePendingSwapOut(context.Context, *MsgExpeditePendingSwapOutRequest) (*MsgExpeditePendingSwapOutResponse, error)
 	// PauseVault pauses user-facing swap operations for a vault and records a reason.
-	// May be signed by the vault admin or the configured asset manager.
+	// Must be signed by a holder of the vault's pauser role.
 	PauseVault(context.Context, *MsgPauseVaultRequest) (*MsgPauseVaultResponse, error)
 	// UnpauseVault re-enables user-facing swap operations for a vault.
-	// May be signed by the vault admin or the configured asset manager.
+	// Must be signed by a holder of the vault's pauser role.
 	UnpauseVault(context.Context, *MsgUnpauseVaultRequest) (*MsgUnpauseVaultResponse, error)
 	// SetBridgeAddress sets the single external bridge address allowed to mint or burn shares for a vault.
 	SetBridgeAddress(context.Context, *MsgSetBridgeAddressRequest) (*MsgSetBridgeAddressResponse, error)
@@ -550,30 +985,133 @@ type MsgServer interface {
 	// BridgeBurnShares burns local share marker supply for a vault; must be signed by the configured bridge address.
 	BridgeBurnShares(context.Context, *MsgBridgeBurnSharesRequest) (*MsgBridgeBurnSharesResponse, error)
 	// SetAssetManager sets or clears the optional asset manager address for a vault.
-	// The vault admin must sign this transaction. Passing an empty address clears it.
+	// The vault admin must sign this transaction. Passing an empty address clears it. The asset
+	// manager's roles move from the previous asset manager to the new one.
 	SetAssetManager(context.Context, *MsgSetAssetManagerRequest) (*MsgSetAssetManagerResponse, error)
 	// UpdateParams updates the module parameters.
 	UpdateParams(context.Context, *MsgUpdateParamsRequest) (*MsgUpdateParamsResponse, error)
 	// UpdateVaultAUMFeeBips updates the AUM fee bips for a specific vault.
 	UpdateVaultAUMFeeBips(context.Context, *MsgUpdateVaultAUMFeeBipsRequest) (*MsgUpdateVaultAUMFeeBipsResponse, error)
 	// UpdateVaultNAV creates or updates a vault's internal net asset value entry for a denom.
-	// Must be signed by the vault's NAV authority.
+	// Must be signed by a holder of the vault's NAV authority role.
 	UpdateVaultNAV(context.Context, *MsgUpdateVaultNAVRequest) (*MsgUpdateVaultNAVResponse, error)
 	// RemoveVaultNAV deletes a vault's internal net asset value entry for a denom the vault
 	// does not hold, revoking the authorization to acquire it at that price.
-	// Must be signed by the vault's NAV authority.
+	// Must be signed by a holder of the vault's NAV authority role.
 	RemoveVaultNAV(context.Context, *MsgRemoveVaultNAVRequest) (*MsgRemoveVaultNAVResponse, error)
 	// UpdateNAVAuthority rotates the address authorized to mutate a vault's internal NAV table.
 	// Must be signed by the vault admin.
 	UpdateNAVAuthority(context.Context, *MsgUpdateNAVAuthorityRequest) (*MsgUpdateNAVAuthorityResponse, error)
 	// AcceptAsset settles a pending exchange-module payment whose target is the vault,
 	// exchanging an external asset for the vault's underlying asset.
-	// Must be signed by the vault's asset manager; the admin cannot settle.
+	// Must be signed by a holder of the vault's settlement manager role.
 	AcceptAsset(context.Context, *MsgAcceptAssetRequest) (*MsgAcceptAssetResponse, error)
 	// RejectAsset declines a pending exchange-module payment whose target is the vault,
 	// causing the exchange module to cancel it and refund the source's escrow.
-	// Must be signed by the vault's asset manager; the admin cannot reject.
+	// Must be signed by a holder of the vault's settlement manager role.
 	RejectAsset(context.Context, *MsgRejectAssetRequest) (*MsgRejectAssetResponse, error)
+	// UpdateNAVStalenessLimit sets the maximum age of a held denom's internal NAV before the
+	// vault's pricing-dependent operations are blocked, and whether a stale NAV auto-pauses the vault.
+	// Must be signed by the vault admin.
+	UpdateNAVStalenessLimit(context.Context, *MsgUpdateNAVStalenessLimitRequest) (*MsgUpdateNAVStalenessLimitResponse, error)
+	// UpdateNAVChangeLimit sets the largest single-update move the NAV authority may apply to a
+	// held denom's internal NAV, and the approver who must confirm larger moves.
+	// Must be signed by the vault admin.
+	UpdateNAVChangeLimit(context.Context, *MsgUpdateNAVChangeLimitRequest) (*MsgUpdateNAVChangeLimitResponse, error)
+	// UpdateSettlementTolerance sets how far asset settlements may trade from the vault's internal
+	// NAV, and whether a settlement marks the NAV to its price.
+	// Must be signed by the vault admin.
+	UpdateSettlementTolerance(context.Context, *MsgUpdateSettlementToleranceRequest) (*MsgUpdateSettlementToleranceResponse, error)
+	// ApproveNAVProposal applies a pending NAV proposal that exceeded the vault's max_nav_change_bips.
+	// Must be signed by the vault's NAV approver, who may not be the proposal's proposer.
+	ApproveNAVProposal(context.Context, *MsgApproveNAVProposalRequest) (*MsgApproveNAVProposalResponse, error)
+	// UpdateNAVSources sets the vault's independent NAV sources, the quorum of fresh submissions
+	// required before their aggregated price is used, and how submissions are aggregated.
+	// Must be signed by the vault admin.
+	UpdateNAVSources(context.Context, *MsgUpdateNAVSourcesRequest) (*MsgUpdateNAVSourcesResponse, error)
+	// SubmitSourceNAV records a NAV source's price for a denom in the vault's per-source table.
+	// Must be signed by one of the vault's NAV sources.
+	SubmitSourceNAV(context.Context, *MsgSubmitSourceNAVRequest) (*MsgSubmitSourceNAVResponse, error)
+	// BatchUpdateVaultNAV creates or updates a vault's internal net asset value entries for
+	// many denoms at once, reconciling the vault a single time.
+	// Must be signed by a holder of the vault's NAV authority role.
+	BatchUpdateVaultNAV(context.Context, *MsgBatchUpdateVaultNAVRequest) (*MsgBatchUpdateVaultNAVResponse, error)
+	// UpdatePriceSources sets the ordered chain of sources a vault consults to price its
+	// held denoms. Must be signed by the vault admin.
+	UpdatePriceSources(context.Context, *MsgUpdatePriceSourcesRequest) (*MsgUpdatePriceSourcesResponse, error)
+	// UpdateAssetHaircut sets the valuation haircut a vault applies to a held denom.
+	// Must be signed by a holder of the vault's NAV authority role.
+	UpdateAssetHaircut(context.Context, *MsgUpdateAssetHaircutRequest) (*MsgUpdateAssetHaircutResponse, error)
+	// BatchAcceptAssets settles many pending exchange-module payments whose target is the vault,
+	// reconciling the vault a single time. Either every payment settles or none does, unless
+	// best_effort is set. Must be signed by a holder of the vault's settlement manager role.
+	BatchAcceptAssets(context.Context, *MsgBatchAcceptAssetsRequest) (*MsgBatchAcceptAssetsResponse, error)
+	// CreateAssetPayment creates an x/exchange payment with the vault's payment escrow account
+	// as its source, offering a held asset or the underlying asset to a counterparty.
+	// Must be signed by a holder of the vault's settlement manager role.
+	CreateAssetPayment(context.Context, *MsgCreateAssetPaymentRequest) (*MsgCreateAssetPaymentResponse, error)
+	// CancelAssetPayment cancels an open payment created by CreateAssetPayment and returns its
+	// escrow to the principal. Must be signed by a holder of the vault's settlement manager
+	// role.
+	CancelAssetPayment(context.Context, *MsgCancelAssetPaymentRequest) (*MsgCancelAssetPaymentResponse, error)
+	// ExpireAssetPayment cancels an open payment created by CreateAssetPayment once it has
+	// expired and returns its escrow to the principal. Any account may sign it.
+	ExpireAssetPayment(context.Context, *MsgExpireAssetPaymentRequest) (*MsgExpireAssetPaymentResponse, error)
+	// UpdateAcquisitionPolicy replaces the policy restricting which assets a vault may price and
+	// acquire. Must be signed by the vault admin.
+	UpdateAcquisitionPolicy(context.Context, *MsgUpdateAcquisitionPolicyRequest) (*MsgUpdateAcquisitionPolicyResponse, error)
+	// UpdateConcentrationLimits sets the largest shares of total vault value a single
+	// non-underlying asset, and all non-underlying assets together, may make up. Must be signed
+	// by the vault admin.
+	UpdateConcentrationLimits(context.Context, *MsgUpdateConcentrationLimitsRequest) (*MsgUpdateConcentrationLimitsResponse, error)
+	// UpdateMinLiquidity sets the share of total vault value a vault must keep liquid in its
+	// underlying asset. Must be signed by the vault admin.
+	UpdateMinLiquidity(context.Context, *MsgUpdateMinLiquidityRequest) (*MsgUpdateMinLiquidityResponse, error)
+	// CollectAssetIncome moves income earned by a held asset from the vault's principal into
+	// its interest reserves, or back. Must be signed by a holder of the vault's settlement
+	// manager role.
+	CollectAssetIncome(context.Context, *MsgCollectAssetIncomeRequest) (*MsgCollectAssetIncomeResponse, error)
+	// GrantRole authorizes an address to perform the operations of a role on a vault. Must be
+	// signed by the vault admin.
+	GrantRole(context.Context, *MsgGrantRoleRequest) (*MsgGrantRoleResponse, error)
+	// RevokeRole removes an address's grant of a role on a vault. Must be signed by the vault
+	// admin.
+	RevokeRole(context.Context, *MsgRevokeRoleRequest) (*MsgRevokeRoleResponse, error)
+	// ProposeAdminTransfer proposes a new admin for a vault, replacing any pending proposal.
+	// Must be signed by the vault admin.
+	ProposeAdminTransfer(context.Context, *MsgProposeAdminTransferRequest) (*MsgProposeAdminTransferResponse, error)
+	// AcceptAdminTransfer completes a pending admin transfer. Must be signed by the proposed
+	// admin before the proposal expires.
+	AcceptAdminTransfer(context.Context, *MsgAcceptAdminTransferRequest) (*MsgAcceptAdminTransferResponse, error)
+	// CancelAdminTransfer withdraws a pending admin transfer. Must be signed by the vault admin.
+	CancelAdminTransfer(context.Context, *MsgCancelAdminTransferRequest) (*MsgCancelAdminTransferResponse, error)
+	// RecoverVaultAdmin replaces a vault's admin immediately, for vaults whose admin key is lost
+	// or compromised. Must be signed by the governance module account.
+	RecoverVaultAdmin(context.Context, *MsgRecoverVaultAdminRequest) (*MsgRecoverVaultAdminResponse, error)
+	// UpdateTimelock sets the delay applied to the vault's sensitive configuration changes.
+	// Raising it takes effect immediately; lowering it is itself subject to the current timelock.
+	// Must be signed by the vault admin.
+	UpdateTimelock(context.Context, *MsgUpdateTimelockRequest) (*MsgUpdateTimelockResponse, error)
+	// CancelScheduledAction discards a scheduled action before it executes. Must be signed by
+	// the vault admin or a holder of the vault's guardian role.
+	CancelScheduledAction(context.Context, *MsgCancelScheduledActionRequest) (*MsgCancelScheduledActionResponse, error)
+	// UpdateEligibilityPolicy replaces the policy restricting which addresses may subscribe to
+	// and hold a vault's shares. Must be signed by the vault admin.
+	UpdateEligibilityPolicy(context.Context, *MsgUpdateEligibilityPolicyRequest) (*MsgUpdateEligibilityPolicyResponse, error)
+	// UpdateAllowlist adds addresses to and removes addresses from a vault's investor
+	// allowlist. Must be signed by the vault admin.
+	UpdateAllowlist(context.Context, *MsgUpdateAllowlistRequest) (*MsgUpdateAllowlistResponse, error)
+	// UpdatePositionCap sets the largest position a single account may build in a vault through
+	// swap-ins, and the addresses exempt from it. Must be signed by a holder of the vault's
+	// limits manager role.
+	UpdatePositionCap(context.Context, *MsgUpdatePositionCapRequest) (*MsgUpdatePositionCapResponse, error)
+	// UpdateMaxTotalValue sets the net total value a vault may reach through swap-ins. Must be
+	// signed by a holder of the vault's limits manager role.
+	UpdateMaxTotalValue(context.Context, *MsgUpdateMaxTotalValueRequest) (*MsgUpdateMaxTotalValueResponse, error)
+	// UpdateCircuitBreaker trips or resets the module-wide circuit breaker and, when signed by
+	// governance, appoints or removes its guardian. Must be signed by governance or the circuit
+	// breaker guardian.
+	UpdateCircuitBreaker(context.Context, *MsgUpdateCircuitBreakerRequest) (*MsgUpdateCircuitBreakerResponse, error)
 	mustEmbedUnimplementedMsgServer()
 }
 
@@ -683,6 +1221,96 @@ func (UnimplementedMsgServer) AcceptAsset(context.Context, *MsgAcceptAssetReques
 func (UnimplementedMsgServer) RejectAsset(context.Context, *MsgRejectAssetRequest) (*MsgRejectAssetResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RejectAsset not implemented")
 }
+func (UnimplementedMsgServer) UpdateNAVStalenessLimit(context.Context, *MsgUpdateNAVStalenessLimitRequest) (*MsgUpdateNAVStalenessLimitResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateNAVStalenessLimit not implemented")
+}
+func (UnimplementedMsgServer) UpdateNAVChangeLimit(context.Context, *MsgUpdateNAVChangeLimitRequest) (*MsgUpdateNAVChangeLimitResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateNAVChangeLimit not implemented")
+}
+func (UnimplementedMsgServer) UpdateSettlementTolerance(context.Context, *MsgUpdateSettlementToleranceRequest) (*MsgUpdateSettlementToleranceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateSettlementTolerance not implemented")
+}
+func (UnimplementedMsgServer) ApproveNAVProposal(context.Context, *MsgApproveNAVProposalRequest) (*MsgApproveNAVProposalResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ApproveNAVProposal not implemented")
+}
+func (UnimplementedMsgServer) UpdateNAVSources(context.Context, *MsgUpdateNAVSourcesRequest) (*MsgUpdateNAVSourcesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateNAVSources not implemented")
+}
+func (UnimplementedMsgServer) SubmitSourceNAV(context.Context, *MsgSubmitSourceNAVRequest) (*MsgSubmitSourceNAVResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SubmitSourceNAV not implemented")
+}
+func (UnimplementedMsgServer) BatchUpdateVaultNAV(context.Context, *MsgBatchUpdateVaultNAVRequest) (*MsgBatchUpdateVaultNAVResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchUpdateVaultNAV not implemented")
+}
+func (UnimplementedMsgServer) UpdatePriceSources(context.Context, *MsgUpdatePriceSourcesRequest) (*MsgUpdatePriceSourcesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdatePriceSources not implemented")
+}
+func (UnimplementedMsgServer) UpdateAssetHaircut(context.Context, *MsgUpdateAssetHaircutRequest) (*MsgUpdateAssetHaircutResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateAssetHaircut not implemented")
+}
+func (UnimplementedMsgServer) BatchAcceptAssets(context.Context, *MsgBatchAcceptAssetsRequest) (*MsgBatchAcceptAssetsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchAcceptAssets not implemented")
+}
+func (UnimplementedMsgServer) CreateAssetPayment(context.Context, *MsgCreateAssetPaymentRequest) (*MsgCreateAssetPaymentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateAssetPayment not implemented")
+}
+func (UnimplementedMsgServer) CancelAssetPayment(context.Context, *MsgCancelAssetPaymentRequest) (*MsgCancelAssetPaymentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelAssetPayment not implemented")
+}
+func (UnimplementedMsgServer) ExpireAssetPayment(context.Context, *MsgExpireAssetPaymentRequest) (*MsgExpireAssetPaymentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExpireAssetPayment not implemented")
+}
+func (UnimplementedMsgServer) UpdateAcquisitionPolicy(context.Context, *MsgUpdateAcquisitionPolicyRequest) (*MsgUpdateAcquisitionPolicyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateAcquisitionPolicy not implemented")
+}
+func (UnimplementedMsgServer) UpdateConcentrationLimits(context.Context, *MsgUpdateConcentrationLimitsRequest) (*MsgUpdateConcentrationLimitsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateConcentrationLimits not implemented")
+}
+func (UnimplementedMsgServer) UpdateMinLiquidity(context.Context, *MsgUpdateMinLiquidityRequest) (*MsgUpdateMinLiquidityResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateMinLiquidity not implemented")
+}
+func (UnimplementedMsgServer) CollectAssetIncome(context.Context, *MsgCollectAssetIncomeRequest) (*MsgCollectAssetIncomeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CollectAssetIncome not implemented")
+}
+func (UnimplementedMsgServer) GrantRole(context.Context, *MsgGrantRoleRequest) (*MsgGrantRoleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GrantRole not implemented")
+}
+func (UnimplementedMsgServer) RevokeRole(context.Context, *MsgRevokeRoleRequest) (*MsgRevokeRoleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeRole not implemented")
+}
+func (UnimplementedMsgServer) ProposeAdminTransfer(context.Context, *MsgProposeAdminTransferRequest) (*MsgProposeAdminTransferResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ProposeAdminTransfer not implemented")
+}
+func (UnimplementedMsgServer) AcceptAdminTransfer(context.Context, *MsgAcceptAdminTransferRequest) (*MsgAcceptAdminTransferResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AcceptAdminTransfer not implemented")
+}
+func (UnimplementedMsgServer) CancelAdminTransfer(context.Context, *MsgCancelAdminTransferRequest) (*MsgCancelAdminTransferResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelAdminTransfer not implemented")
+}
+func (UnimplementedMsgServer) RecoverVaultAdmin(context.Context, *MsgRecoverVaultAdminRequest) (*MsgRecoverVaultAdminResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RecoverVaultAdmin not implemented")
+}
+func (UnimplementedMsgServer) UpdateTimelock(context.Context, *MsgUpdateTimelockRequest) (*MsgUpdateTimelockResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateTimelock not implemented")
+}
+func (UnimplementedMsgServer) CancelScheduledAction(context.Context, *MsgCancelScheduledActionRequest) (*MsgCancelScheduledActionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelScheduledAction not implemented")
+}
+func (UnimplementedMsgServer) UpdateEligibilityPolicy(context.Context, *MsgUpdateEligibilityPolicyRequest) (*MsgUpdateEligibilityPolicyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateEligibilityPolicy not implemented")
+}
+func (UnimplementedMsgServer) UpdateAllowlist(context.Context, *MsgUpdateAllowlistRequest) (*MsgUpdateAllowlistResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateAllowlist not implemented")
+}
+func (UnimplementedMsgServer) UpdatePositionCap(context.Context, *MsgUpdatePositionCapRequest) (*MsgUpdatePositionCapResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdatePositionCap not implemented")
+}
+func (UnimplementedMsgServer) UpdateMaxTotalValue(context.Context, *MsgUpdateMaxTotalValueRequest) (*MsgUpdateMaxTotalValueResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateMaxTotalValue not implemented")
+}
+func (UnimplementedMsgServer) UpdateCircuitBreaker(context.Context, *MsgUpdateCircuitBreakerRequest) (*MsgUpdateCircuitBreakerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateCircuitBreaker not implemented")
+}
 func (UnimplementedMsgServer) mustEmbedUnimplementedMsgServer() {}
 func (UnimplementedMsgServer) testEmbeddedByValue()             {}
 
@@ -1298,10 +1926,550 @@ func _Msg_RejectAsset_Handler(srv interface{}, ctx context.Context, dec func(int
 	return interceptor(ctx, in, info, handler)
 }
 
-// Msg_ServiceDesc is the grpc.ServiceDesc for Msg service.
-// It's only intended for direct use with grpc.RegisterService,
-// and not to be introspected or modified (even as a copy)
-var Msg_ServiceDesc = grpc.ServiceDesc{
+func _Msg_UpdateNAVStalenessLimit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateNAVStalenessLimitRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateNAVStalenessLimit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_UpdateNAVStalenessLimit_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateNAVStalenessLimit(ctx, req.(*MsgUpdateNAVStalenessLimitRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UpdateNAVChangeLimit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateNAVChangeLimitRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateNAVChangeLimit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_UpdateNAVChangeLimit_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateNAVChangeLimit(ctx, req.(*MsgUpdateNAVChangeLimitRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UpdateSettlementTolerance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateSettlementToleranceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateSettlementTolerance(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_UpdateSettlementTolerance_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateSettlementTolerance(ctx, req.(*MsgUpdateSettlementToleranceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ApproveNAVProposal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgApproveNAVProposalRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ApproveNAVProposal(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_ApproveNAVProposal_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ApproveNAVProposal(ctx, req.(*MsgApproveNAVProposalRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UpdateNAVSources_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateNAVSourcesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateNAVSources(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_UpdateNAVSources_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateNAVSources(ctx, req.(*MsgUpdateNAVSourcesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SubmitSourceNAV_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSubmitSourceNAVRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SubmitSourceNAV(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_SubmitSourceNAV_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SubmitSourceNAV(ctx, req.(*MsgSubmitSourceNAVRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_BatchUpdateVaultNAV_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgBatchUpdateVaultNAVRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).BatchUpdateVaultNAV(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_BatchUpdateVaultNAV_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).BatchUpdateVaultNAV(ctx, req.(*MsgBatchUpdateVaultNAVRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UpdatePriceSources_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdatePriceSourcesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdatePriceSources(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_UpdatePriceSources_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdatePriceSources(ctx, req.(*MsgUpdatePriceSourcesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UpdateAssetHaircut_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateAssetHaircutRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateAssetHaircut(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_UpdateAssetHaircut_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateAssetHaircut(ctx, req.(*MsgUpdateAssetHaircutRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_BatchAcceptAssets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgBatchAcceptAssetsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).BatchAcceptAssets(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_BatchAcceptAssets_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).BatchAcceptAssets(ctx, req.(*MsgBatchAcceptAssetsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_CreateAssetPayment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCreateAssetPaymentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CreateAssetPayment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_CreateAssetPayment_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CreateAssetPayment(ctx, req.(*MsgCreateAssetPaymentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_CancelAssetPayment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCancelAssetPaymentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CancelAssetPayment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_CancelAssetPayment_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CancelAssetPayment(ctx, req.(*MsgCancelAssetPaymentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ExpireAssetPayment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgExpireAssetPaymentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ExpireAssetPayment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_ExpireAssetPayment_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ExpireAssetPayment(ctx, req.(*MsgExpireAssetPaymentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UpdateAcquisitionPolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateAcquisitionPolicyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateAcquisitionPolicy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_UpdateAcquisitionPolicy_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateAcquisitionPolicy(ctx, req.(*MsgUpdateAcquisitionPolicyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UpdateConcentrationLimits_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateConcentrationLimitsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateConcentrationLimits(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_UpdateConcentrationLimits_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateConcentrationLimits(ctx, req.(*MsgUpdateConcentrationLimitsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UpdateMinLiquidity_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateMinLiquidityRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateMinLiquidity(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_UpdateMinLiquidity_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateMinLiquidity(ctx, req.(*MsgUpdateMinLiquidityRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_CollectAssetIncome_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCollectAssetIncomeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CollectAssetIncome(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_CollectAssetIncome_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CollectAssetIncome(ctx, req.(*MsgCollectAssetIncomeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_GrantRole_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgGrantRoleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).GrantRole(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_GrantRole_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).GrantRole(ctx, req.(*MsgGrantRoleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RevokeRole_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRevokeRoleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RevokeRole(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_RevokeRole_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RevokeRole(ctx, req.(*MsgRevokeRoleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ProposeAdminTransfer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgProposeAdminTransferRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ProposeAdminTransfer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_ProposeAdminTransfer_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ProposeAdminTransfer(ctx, req.(*MsgProposeAdminTransferRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_AcceptAdminTransfer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgAcceptAdminTransferRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).AcceptAdminTransfer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_AcceptAdminTransfer_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).AcceptAdminTransfer(ctx, req.(*MsgAcceptAdminTransferRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_CancelAdminTransfer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCancelAdminTransferRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CancelAdminTransfer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_CancelAdminTransfer_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CancelAdminTransfer(ctx, req.(*MsgCancelAdminTransferRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RecoverVaultAdmin_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRecoverVaultAdminRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RecoverVaultAdmin(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_RecoverVaultAdmin_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RecoverVaultAdmin(ctx, req.(*MsgRecoverVaultAdminRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UpdateTimelock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateTimelockRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateTimelock(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_UpdateTimelock_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateTimelock(ctx, req.(*MsgUpdateTimelockRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_CancelScheduledAction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCancelScheduledActionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CancelScheduledAction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_CancelScheduledAction_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CancelScheduledAction(ctx, req.(*MsgCancelScheduledActionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UpdateEligibilityPolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateEligibilityPolicyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateEligibilityPolicy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_UpdateEligibilityPolicy_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateEligibilityPolicy(ctx, req.(*MsgUpdateEligibilityPolicyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UpdateAllowlist_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateAllowlistRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateAllowlist(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_UpdateAllowlist_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateAllowlist(ctx, req.(*MsgUpdateAllowlistRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UpdatePositionCap_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdatePositionCapRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdatePositionCap(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_UpdatePositionCap_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdatePositionCap(ctx, req.(*MsgUpdatePositionCapRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UpdateMaxTotalValue_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateMaxTotalValueRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateMaxTotalValue(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_UpdateMaxTotalValue_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateMaxTotalValue(ctx, req.(*MsgUpdateMaxTotalValueRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UpdateCircuitBreaker_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateCircuitBreakerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateCircuitBreaker(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_UpdateCircuitBreaker_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateCircuitBreaker(ctx, req.(*MsgUpdateCircuitBreakerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// Msg_ServiceDesc is the grpc.ServiceDesc for Msg service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var Msg_ServiceDesc = grpc.ServiceDesc{
 	ServiceName: "provlabs.vault.v1.Msg",
 	HandlerType: (*MsgServer)(nil),
 	Methods: []grpc.MethodDesc{
@@ -1437,6 +2605,126 @@ var Msg_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "RejectAsset",
 			Handler:    _Msg_RejectAsset_Handler,
 		},
+		{
+			MethodName: "UpdateNAVStalenessLimit",
+			Handler:    _Msg_UpdateNAVStalenessLimit_Handler,
+		},
+		{
+			MethodName: "UpdateNAVChangeLimit",
+			Handler:    _Msg_UpdateNAVChangeLimit_Handler,
+		},
+		{
+			MethodName: "UpdateSettlementTolerance",
+			Handler:    _Msg_UpdateSettlementTolerance_Handler,
+		},
+		{
+			MethodName: "ApproveNAVProposal",
+			Handler:    _Msg_ApproveNAVProposal_Handler,
+		},
+		{
+			MethodName: "UpdateNAVSources",
+			Handler:    _Msg_UpdateNAVSources_Handler,
+		},
+		{
+			MethodName: "SubmitSourceNAV",
+			Handler:    _Msg_SubmitSourceNAV_Handler,
+		},
+		{
+			MethodName: "BatchUpdateVaultNAV",
+			Handler:    _Msg_BatchUpdateVaultNAV_Handler,
+		},
+		{
+			MethodName: "UpdatePriceSources",
+			Handler:    _Msg_UpdatePriceSources_Handler,
+		},
+		{
+			MethodName: "UpdateAssetHaircut",
+			Handler:    _Msg_UpdateAssetHaircut_Handler,
+		},
+		{
+			MethodName: "BatchAcceptAssets",
+			Handler:    _Msg_BatchAcceptAssets_Handler,
+		},
+		{
+			MethodName: "CreateAssetPayment",
+			Handler:    _Msg_CreateAssetPayment_Handler,
+		},
+		{
+			MethodName: "CancelAssetPayment",
+			Handler:    _Msg_CancelAssetPayment_Handler,
+		},
+		{
+			MethodName: "ExpireAssetPayment",
+			Handler:    _Msg_ExpireAssetPayment_Handler,
+		},
+		{
+			MethodName: "UpdateAcquisitionPolicy",
+			Handler:    _Msg_UpdateAcquisitionPolicy_Handler,
+		},
+		{
+			MethodName: "UpdateConcentrationLimits",
+			Handler:    _Msg_UpdateConcentrationLimits_Handler,
+		},
+		{
+			MethodName: "UpdateMinLiquidity",
+			Handler:    _Msg_UpdateMinLiquidity_Handler,
+		},
+		{
+			MethodName: "CollectAssetIncome",
+			Handler:    _Msg_CollectAssetIncome_Handler,
+		},
+		{
+			MethodName: "GrantRole",
+			Handler:    _Msg_GrantRole_Handler,
+		},
+		{
+			MethodName: "RevokeRole",
+			Handler:    _Msg_RevokeRole_Handler,
+		},
+		{
+			MethodName: "ProposeAdminTransfer",
+			Handler:    _Msg_ProposeAdminTransfer_Handler,
+		},
+		{
+			MethodName: "AcceptAdminTransfer",
+			Handler:    _Msg_AcceptAdminTransfer_Handler,
+		},
+		{
+			MethodName: "CancelAdminTransfer",
+			Handler:    _Msg_CancelAdminTransfer_Handler,
+		},
+		{
+			MethodName: "RecoverVaultAdmin",
+			Handler:    _Msg_RecoverVaultAdmin_Handler,
+		},
+		{
+			MethodName: "UpdateTimelock",
+			Handler:    _Msg_UpdateTimelock_Handler,
+		},
+		{
+			MethodName: "CancelScheduledAction",
+			Handler:    _Msg_CancelScheduledAction_Handler,
+		},
+		{
+			MethodName: "UpdateEligibilityPolicy",
+			Handler:    _Msg_UpdateEligibilityPolicy_Handler,
+		},
+		{
+			MethodName: "UpdateAllowlist",
+			Handler:    _Msg_UpdateAllowlist_Handler,
+		},
+		{
+			MethodName: "UpdatePositionCap",
+			Handler:    _Msg_UpdatePositionCap_Handler,
+		},
+		{
+			MethodName: "UpdateMaxTotalValue",
+			Handler:    _Msg_UpdateMaxTotalValue_Handler,
+		},
+		{
+			MethodName: "UpdateCircuitBreaker",
+			Handler:    _Msg_UpdateCircuitBreaker_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "provlabs/vault/v1/tx.proto",
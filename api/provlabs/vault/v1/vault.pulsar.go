@@ -11,43 +11,255 @@ import (
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoiface "google.golang.org/protobuf/runtime/protoiface"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	anypb "google.golang.org/protobuf/types/known/anypb"
 	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	reflect "reflect"
 	sync "sync"
 )
 
+var _ protoreflect.List = (*_VaultAccount_34_list)(nil)
+
+type _VaultAccount_34_list struct {
+	list *[]string
+}
+
+func (x *_VaultAccount_34_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_VaultAccount_34_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfString((*x.list)[i])
+}
+
+func (x *_VaultAccount_34_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_VaultAccount_34_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_VaultAccount_34_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message VaultAccount at list field NavSources as it is not of Message kind"))
+}
+
+func (x *_VaultAccount_34_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_VaultAccount_34_list) NewElement() protoreflect.Value {
+	v := ""
+	return protoreflect.ValueOfString(v)
+}
+
+func (x *_VaultAccount_34_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_VaultAccount_38_list)(nil)
+
+type _VaultAccount_38_list struct {
+	list *[]PriceSourceType
+}
+
+func (x *_VaultAccount_38_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_VaultAccount_38_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfEnum((protoreflect.EnumNumber)((*x.list)[i]))
+}
+
+func (x *_VaultAccount_38_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Enum()
+	concreteValue := (PriceSourceType)(valueUnwrapped)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_VaultAccount_38_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Enum()
+	concreteValue := (PriceSourceType)(valueUnwrapped)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_VaultAccount_38_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message VaultAccount at list field PriceSources as it is not of Message kind"))
+}
+
+func (x *_VaultAccount_38_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_VaultAccount_38_list) NewElement() protoreflect.Value {
+	v := 0
+	return protoreflect.ValueOfEnum((protoreflect.EnumNumber)(v))
+}
+
+func (x *_VaultAccount_38_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_VaultAccount_45_list)(nil)
+
+type _VaultAccount_45_list struct {
+	list *[]*RoleGrant
+}
+
+func (x *_VaultAccount_45_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_VaultAccount_45_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_VaultAccount_45_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*RoleGrant)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_VaultAccount_45_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*RoleGrant)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_VaultAccount_45_list) AppendMutable() protoreflect.Value {
+	v := new(RoleGrant)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_VaultAccount_45_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_VaultAccount_45_list) NewElement() protoreflect.Value {
+	v := new(RoleGrant)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_VaultAccount_45_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_VaultAccount_50_list)(nil)
+
+type _VaultAccount_50_list struct {
+	list *[]string
+}
+
+func (x *_VaultAccount_50_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_VaultAccount_50_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfString((*x.list)[i])
+}
+
+func (x *_VaultAccount_50_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_VaultAccount_50_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_VaultAccount_50_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message VaultAccount at list field PositionCapExemptions as it is not of Message kind"))
+}
+
+func (x *_VaultAccount_50_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_VaultAccount_50_list) NewElement() protoreflect.Value {
+	v := ""
+	return protoreflect.ValueOfString(v)
+}
+
+func (x *_VaultAccount_50_list) IsValid() bool {
+	return x.list != nil
+}
+
 var (
-	md_VaultAccount                          protoreflect.MessageDescriptor
-	fd_VaultAccount_base_account             protoreflect.FieldDescriptor
-	fd_VaultAccount_total_shares             protoreflect.FieldDescriptor
-	fd_VaultAccount_underlying_asset         protoreflect.FieldDescriptor
-	fd_VaultAccount_payment_denom            protoreflect.FieldDescriptor
-	fd_VaultAccount_admin                    protoreflect.FieldDescriptor
-	fd_VaultAccount_current_interest_rate    protoreflect.FieldDescriptor
-	fd_VaultAccount_desired_interest_rate    protoreflect.FieldDescriptor
-	fd_VaultAccount_min_interest_rate        protoreflect.FieldDescriptor
-	fd_VaultAccount_max_interest_rate        protoreflect.FieldDescriptor
-	fd_VaultAccount_period_start             protoreflect.FieldDescriptor
-	fd_VaultAccount_period_timeout           protoreflect.FieldDescriptor
-	fd_VaultAccount_swap_in_enabled          protoreflect.FieldDescriptor
-	fd_VaultAccount_swap_out_enabled         protoreflect.FieldDescriptor
-	fd_VaultAccount_withdrawal_delay_seconds protoreflect.FieldDescriptor
-	fd_VaultAccount_paused                   protoreflect.FieldDescriptor
-	fd_VaultAccount_paused_balance           protoreflect.FieldDescriptor
-	fd_VaultAccount_paused_reason            protoreflect.FieldDescriptor
-	fd_VaultAccount_bridge_address           protoreflect.FieldDescriptor
-	fd_VaultAccount_bridge_enabled           protoreflect.FieldDescriptor
-	fd_VaultAccount_asset_manager            protoreflect.FieldDescriptor
-	fd_VaultAccount_fee_period_start         protoreflect.FieldDescriptor
-	fd_VaultAccount_fee_period_timeout       protoreflect.FieldDescriptor
-	fd_VaultAccount_outstanding_aum_fee      protoreflect.FieldDescriptor
-	fd_VaultAccount_aum_fee_bips             protoreflect.FieldDescriptor
-	fd_VaultAccount_min_swap_in_value        protoreflect.FieldDescriptor
-	fd_VaultAccount_min_swap_out_value       protoreflect.FieldDescriptor
-	fd_VaultAccount_max_swap_in_value        protoreflect.FieldDescriptor
-	fd_VaultAccount_max_swap_out_value       protoreflect.FieldDescriptor
-	fd_VaultAccount_nav_authority            protoreflect.FieldDescriptor
+	md_VaultAccount                              protoreflect.MessageDescriptor
+	fd_VaultAccount_base_account                 protoreflect.FieldDescriptor
+	fd_VaultAccount_total_shares                 protoreflect.FieldDescriptor
+	fd_VaultAccount_underlying_asset             protoreflect.FieldDescriptor
+	fd_VaultAccount_payment_denom                protoreflect.FieldDescriptor
+	fd_VaultAccount_admin                        protoreflect.FieldDescriptor
+	fd_VaultAccount_current_interest_rate        protoreflect.FieldDescriptor
+	fd_VaultAccount_desired_interest_rate        protoreflect.FieldDescriptor
+	fd_VaultAccount_min_interest_rate            protoreflect.FieldDescriptor
+	fd_VaultAccount_max_interest_rate            protoreflect.FieldDescriptor
+	fd_VaultAccount_period_start                 protoreflect.FieldDescriptor
+	fd_VaultAccount_period_timeout               protoreflect.FieldDescriptor
+	fd_VaultAccount_swap_in_enabled              protoreflect.FieldDescriptor
+	fd_VaultAccount_swap_out_enabled             protoreflect.FieldDescriptor
+	fd_VaultAccount_withdrawal_delay_seconds     protoreflect.FieldDescriptor
+	fd_VaultAccount_paused                       protoreflect.FieldDescriptor
+	fd_VaultAccount_paused_balance               protoreflect.FieldDescriptor
+	fd_VaultAccount_paused_reason                protoreflect.FieldDescriptor
+	fd_VaultAccount_bridge_address               protoreflect.FieldDescriptor
+	fd_VaultAccount_bridge_enabled               protoreflect.FieldDescriptor
+	fd_VaultAccount_asset_manager                protoreflect.FieldDescriptor
+	fd_VaultAccount_fee_period_start             protoreflect.FieldDescriptor
+	fd_VaultAccount_fee_period_timeout           protoreflect.FieldDescriptor
+	fd_VaultAccount_outstanding_aum_fee          protoreflect.FieldDescriptor
+	fd_VaultAccount_aum_fee_bips                 protoreflect.FieldDescriptor
+	fd_VaultAccount_min_swap_in_value            protoreflect.FieldDescriptor
+	fd_VaultAccount_min_swap_out_value           protoreflect.FieldDescriptor
+	fd_VaultAccount_max_swap_in_value            protoreflect.FieldDescriptor
+	fd_VaultAccount_max_swap_out_value           protoreflect.FieldDescriptor
+	fd_VaultAccount_nav_authority                protoreflect.FieldDescriptor
+	fd_VaultAccount_max_nav_age_seconds          protoreflect.FieldDescriptor
+	fd_VaultAccount_auto_pause_on_stale_nav      protoreflect.FieldDescriptor
+	fd_VaultAccount_max_nav_change_bips          protoreflect.FieldDescriptor
+	fd_VaultAccount_nav_approver                 protoreflect.FieldDescriptor
+	fd_VaultAccount_nav_sources                  protoreflect.FieldDescriptor
+	fd_VaultAccount_nav_source_quorum            protoreflect.FieldDescriptor
+	fd_VaultAccount_nav_aggregation              protoreflect.FieldDescriptor
+	fd_VaultAccount_nav_source_max_age_seconds   protoreflect.FieldDescriptor
+	fd_VaultAccount_price_sources                protoreflect.FieldDescriptor
+	fd_VaultAccount_settlement_tolerance_bips    protoreflect.FieldDescriptor
+	fd_VaultAccount_update_nav_on_settlement     protoreflect.FieldDescriptor
+	fd_VaultAccount_acquisition_policy           protoreflect.FieldDescriptor
+	fd_VaultAccount_max_asset_concentration_bips protoreflect.FieldDescriptor
+	fd_VaultAccount_max_non_underlying_bips      protoreflect.FieldDescriptor
+	fd_VaultAccount_min_liquidity_bips           protoreflect.FieldDescriptor
+	fd_VaultAccount_role_grants                  protoreflect.FieldDescriptor
+	fd_VaultAccount_pending_admin_transfer       protoreflect.FieldDescriptor
+	fd_VaultAccount_timelock_seconds             protoreflect.FieldDescriptor
+	fd_VaultAccount_eligibility_policy           protoreflect.FieldDescriptor
+	fd_VaultAccount_max_position_value           protoreflect.FieldDescriptor
+	fd_VaultAccount_position_cap_exemptions      protoreflect.FieldDescriptor
+	fd_VaultAccount_max_total_value              protoreflect.FieldDescriptor
 )
 
 func init() {
@@ -82,6 +294,28 @@ func init() {
 	fd_VaultAccount_max_swap_in_value = md_VaultAccount.Fields().ByName("max_swap_in_value")
 	fd_VaultAccount_max_swap_out_value = md_VaultAccount.Fields().ByName("max_swap_out_value")
 	fd_VaultAccount_nav_authority = md_VaultAccount.Fields().ByName("nav_authority")
+	fd_VaultAccount_max_nav_age_seconds = md_VaultAccount.Fields().ByName("max_nav_age_seconds")
+	fd_VaultAccount_auto_pause_on_stale_nav = md_VaultAccount.Fields().ByName("auto_pause_on_stale_nav")
+	fd_VaultAccount_max_nav_change_bips = md_VaultAccount.Fields().ByName("max_nav_change_bips")
+	fd_VaultAccount_nav_approver = md_VaultAccount.Fields().ByName("nav_approver")
+	fd_VaultAccount_nav_sources = md_VaultAccount.Fields().ByName("nav_sources")
+	fd_VaultAccount_nav_source_quorum = md_VaultAccount.Fields().ByName("nav_source_quorum")
+	fd_VaultAccount_nav_aggregation = md_VaultAccount.Fields().ByName("nav_aggregation")
+	fd_VaultAccount_nav_source_max_age_seconds = md_VaultAccount.Fields().ByName("nav_source_max_age_seconds")
+	fd_VaultAccount_price_sources = md_VaultAccount.Fields().ByName("price_sources")
+	fd_VaultAccount_settlement_tolerance_bips = md_VaultAccount.Fields().ByName("settlement_tolerance_bips")
+	fd_VaultAccount_update_nav_on_settlement = md_VaultAccount.Fields().ByName("update_nav_on_settlement")
+	fd_VaultAccount_acquisition_policy = md_VaultAccount.Fields().ByName("acquisition_policy")
+	fd_VaultAccount_max_asset_concentration_bips = md_VaultAccount.Fields().ByName("max_asset_concentration_bips")
+	fd_VaultAccount_max_non_underlying_bips = md_VaultAccount.Fields().ByName("max_non_underlying_bips")
+	fd_VaultAccount_min_liquidity_bips = md_VaultAccount.Fields().ByName("min_liquidity_bips")
+	fd_VaultAccount_role_grants = md_VaultAccount.Fields().ByName("role_grants")
+	fd_VaultAccount_pending_admin_transfer = md_VaultAccount.Fields().ByName("pending_admin_transfer")
+	fd_VaultAccount_timelock_seconds = md_VaultAccount.Fields().ByName("timelock_seconds")
+	fd_VaultAccount_eligibility_policy = md_VaultAccount.Fields().ByName("eligibility_policy")
+	fd_VaultAccount_max_position_value = md_VaultAccount.Fields().ByName("max_position_value")
+	fd_VaultAccount_position_cap_exemptions = md_VaultAccount.Fields().ByName("position_cap_exemptions")
+	fd_VaultAccount_max_total_value = md_VaultAccount.Fields().ByName("max_total_value")
 }
 
 var _ protoreflect.Message = (*fastReflection_VaultAccount)(nil)
@@ -323,6 +557,138 @@ func (x *fastReflection_VaultAccount) Range(f func(protoreflect.FieldDescriptor,
 			return
 		}
 	}
+	if x.MaxNavAgeSeconds != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.MaxNavAgeSeconds)
+		if !f(fd_VaultAccount_max_nav_age_seconds, value) {
+			return
+		}
+	}
+	if x.AutoPauseOnStaleNav != false {
+		value := protoreflect.ValueOfBool(x.AutoPauseOnStaleNav)
+		if !f(fd_VaultAccount_auto_pause_on_stale_nav, value) {
+			return
+		}
+	}
+	if x.MaxNavChangeBips != uint32(0) {
+		value := protoreflect.ValueOfUint32(x.MaxNavChangeBips)
+		if !f(fd_VaultAccount_max_nav_change_bips, value) {
+			return
+		}
+	}
+	if x.NavApprover != "" {
+		value := protoreflect.ValueOfString(x.NavApprover)
+		if !f(fd_VaultAccount_nav_approver, value) {
+			return
+		}
+	}
+	if len(x.NavSources) != 0 {
+		value := protoreflect.ValueOfList(&_VaultAccount_34_list{list: &x.NavSources})
+		if !f(fd_VaultAccount_nav_sources, value) {
+			return
+		}
+	}
+	if x.NavSourceQuorum != uint32(0) {
+		value := protoreflect.ValueOfUint32(x.NavSourceQuorum)
+		if !f(fd_VaultAccount_nav_source_quorum, value) {
+			return
+		}
+	}
+	if x.NavAggregation != 0 {
+		value := protoreflect.ValueOfEnum((protoreflect.EnumNumber)(x.NavAggregation))
+		if !f(fd_VaultAccount_nav_aggregation, value) {
+			return
+		}
+	}
+	if x.NavSourceMaxAgeSeconds != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.NavSourceMaxAgeSeconds)
+		if !f(fd_VaultAccount_nav_source_max_age_seconds, value) {
+			return
+		}
+	}
+	if len(x.PriceSources) != 0 {
+		value := protoreflect.ValueOfList(&_VaultAccount_38_list{list: &x.PriceSources})
+		if !f(fd_VaultAccount_price_sources, value) {
+			return
+		}
+	}
+	if x.SettlementToleranceBips != uint32(0) {
+		value := protoreflect.ValueOfUint32(x.SettlementToleranceBips)
+		if !f(fd_VaultAccount_settlement_tolerance_bips, value) {
+			return
+		}
+	}
+	if x.UpdateNavOnSettlement != false {
+		value := protoreflect.ValueOfBool(x.UpdateNavOnSettlement)
+		if !f(fd_VaultAccount_update_nav_on_settlement, value) {
+			return
+		}
+	}
+	if x.AcquisitionPolicy != nil {
+		value := protoreflect.ValueOfMessage(x.AcquisitionPolicy.ProtoReflect())
+		if !f(fd_VaultAccount_acquisition_policy, value) {
+			return
+		}
+	}
+	if x.MaxAssetConcentrationBips != uint32(0) {
+		value := protoreflect.ValueOfUint32(x.MaxAssetConcentrationBips)
+		if !f(fd_VaultAccount_max_asset_concentration_bips, value) {
+			return
+		}
+	}
+	if x.MaxNonUnderlyingBips != uint32(0) {
+		value := protoreflect.ValueOfUint32(x.MaxNonUnderlyingBips)
+		if !f(fd_VaultAccount_max_non_underlying_bips, value) {
+			return
+		}
+	}
+	if x.MinLiquidityBips != uint32(0) {
+		value := protoreflect.ValueOfUint32(x.MinLiquidityBips)
+		if !f(fd_VaultAccount_min_liquidity_bips, value) {
+			return
+		}
+	}
+	if len(x.RoleGrants) != 0 {
+		value := protoreflect.ValueOfList(&_VaultAccount_45_list{list: &x.RoleGrants})
+		if !f(fd_VaultAccount_role_grants, value) {
+			return
+		}
+	}
+	if x.PendingAdminTransfer != nil {
+		value := protoreflect.ValueOfMessage(x.PendingAdminTransfer.ProtoReflect())
+		if !f(fd_VaultAccount_pending_admin_transfer, value) {
+			return
+		}
+	}
+	if x.TimelockSeconds != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.TimelockSeconds)
+		if !f(fd_VaultAccount_timelock_seconds, value) {
+			return
+		}
+	}
+	if x.EligibilityPolicy != nil {
+		value := protoreflect.ValueOfMessage(x.EligibilityPolicy.ProtoReflect())
+		if !f(fd_VaultAccount_eligibility_policy, value) {
+			return
+		}
+	}
+	if x.MaxPositionValue != "" {
+		value := protoreflect.ValueOfString(x.MaxPositionValue)
+		if !f(fd_VaultAccount_max_position_value, value) {
+			return
+		}
+	}
+	if len(x.PositionCapExemptions) != 0 {
+		value := protoreflect.ValueOfList(&_VaultAccount_50_list{list: &x.PositionCapExemptions})
+		if !f(fd_VaultAccount_position_cap_exemptions, value) {
+			return
+		}
+	}
+	if x.MaxTotalValue != "" {
+		value := protoreflect.ValueOfString(x.MaxTotalValue)
+		if !f(fd_VaultAccount_max_total_value, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -396,6 +762,50 @@ func (x *fastReflection_VaultAccount) Has(fd protoreflect.FieldDescriptor) bool
 		return x.MaxSwapOutValue != ""
 	case "provlabs.vault.v1.VaultAccount.nav_authority":
 		return x.NavAuthority != ""
+	case "provlabs.vault.v1.VaultAccount.max_nav_age_seconds":
+		return x.MaxNavAgeSeconds != uint64(0)
+	case "provlabs.vault.v1.VaultAccount.auto_pause_on_stale_nav":
+		return x.AutoPauseOnStaleNav != false
+	case "provlabs.vault.v1.VaultAccount.max_nav_change_bips":
+		return x.MaxNavChangeBips != uint32(0)
+	case "provlabs.vault.v1.VaultAccount.nav_approver":
+		return x.NavApprover != ""
+	case "provlabs.vault.v1.VaultAccount.nav_sources":
+		return len(x.NavSources) != 0
+	case "provlabs.vault.v1.VaultAccount.nav_source_quorum":
+		return x.NavSourceQuorum != uint32(0)
+	case "provlabs.vault.v1.VaultAccount.nav_aggregation":
+		return x.NavAggregation != 0
+	case "provlabs.vault.v1.VaultAccount.nav_source_max_age_seconds":
+		return x.NavSourceMaxAgeSeconds != uint64(0)
+	case "provlabs.vault.v1.VaultAccount.price_sources":
+		return len(x.PriceSources) != 0
+	case "provlabs.vault.v1.VaultAccount.settlement_tolerance_bips":
+		return x.SettlementToleranceBips != uint32(0)
+	case "provlabs.vault.v1.VaultAccount.update_nav_on_settlement":
+		return x.UpdateNavOnSettlement != false
+	case "provlabs.vault.v1.VaultAccount.acquisition_policy":
+		return x.AcquisitionPolicy != nil
+	case "provlabs.vault.v1.VaultAccount.max_asset_concentration_bips":
+		return x.MaxAssetConcentrationBips != uint32(0)
+	case "provlabs.vault.v1.VaultAccount.max_non_underlying_bips":
+		return x.MaxNonUnderlyingBips != uint32(0)
+	case "provlabs.vault.v1.VaultAccount.min_liquidity_bips":
+		return x.MinLiquidityBips != uint32(0)
+	case "provlabs.vault.v1.VaultAccount.role_grants":
+		return len(x.RoleGrants) != 0
+	case "provlabs.vault.v1.VaultAccount.pending_admin_transfer":
+		return x.PendingAdminTransfer != nil
+	case "provlabs.vault.v1.VaultAccount.timelock_seconds":
+		return x.TimelockSeconds != uint64(0)
+	case "provlabs.vault.v1.VaultAccount.eligibility_policy":
+		return x.EligibilityPolicy != nil
+	case "provlabs.vault.v1.VaultAccount.max_position_value":
+		return x.MaxPositionValue != ""
+	case "provlabs.vault.v1.VaultAccount.position_cap_exemptions":
+		return len(x.PositionCapExemptions) != 0
+	case "provlabs.vault.v1.VaultAccount.max_total_value":
+		return x.MaxTotalValue != ""
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: provlabs.vault.v1.VaultAccount"))
@@ -470,6 +880,50 @@ func (x *fastReflection_VaultAccount) Clear(fd protoreflect.FieldDescriptor) {
 		x.MaxSwapOutValue = ""
 	case "provlabs.vault.v1.VaultAccount.nav_authority":
 		x.NavAuthority = ""
+	case "provlabs.vault.v1.VaultAccount.max_nav_age_seconds":
+		x.MaxNavAgeSeconds = uint64(0)
+	case "provlabs.vault.v1.VaultAccount.auto_pause_on_stale_nav":
+		x.AutoPauseOnStaleNav = false
+	case "provlabs.vault.v1.VaultAccount.max_nav_change_bips":
+		x.MaxNavChangeBips = uint32(0)
+	case "provlabs.vault.v1.VaultAccount.nav_approver":
+		x.NavApprover = ""
+	case "provlabs.vault.v1.VaultAccount.nav_sources":
+		x.NavSources = nil
+	case "provlabs.vault.v1.VaultAccount.nav_source_quorum":
+		x.NavSourceQuorum = uint32(0)
+	case "provlabs.vault.v1.VaultAccount.nav_aggregation":
+		x.NavAggregation = 0
+	case "provlabs.vault.v1.VaultAccount.nav_source_max_age_seconds":
+		x.NavSourceMaxAgeSeconds = uint64(0)
+	case "provlabs.vault.v1.VaultAccount.price_sources":
+		x.PriceSources = nil
+	case "provlabs.vault.v1.VaultAccount.settlement_tolerance_bips":
+		x.SettlementToleranceBips = uint32(0)
+	case "provlabs.vault.v1.VaultAccount.update_nav_on_settlement":
+		x.UpdateNavOnSettlement = false
+	case "provlabs.vault.v1.VaultAccount.acquisition_policy":
+		x.AcquisitionPolicy = nil
+	case "provlabs.vault.v1.VaultAccount.max_asset_concentration_bips":
+		x.MaxAssetConcentrationBips = uint32(0)
+	case "provlabs.vault.v1.VaultAccount.max_non_underlying_bips":
+		x.MaxNonUnderlyingBips = uint32(0)
+	case "provlabs.vault.v1.VaultAccount.min_liquidity_bips":
+		x.MinLiquidityBips = uint32(0)
+	case "provlabs.vault.v1.VaultAccount.role_grants":
+		x.RoleGrants = nil
+	case "provlabs.vault.v1.VaultAccount.pending_admin_transfer":
+		x.PendingAdminTransfer = nil
+	case "provlabs.vault.v1.VaultAccount.timelock_seconds":
+		x.TimelockSeconds = uint64(0)
+	case "provlabs.vault.v1.VaultAccount.eligibility_policy":
+		x.EligibilityPolicy = nil
+	case "provlabs.vault.v1.VaultAccount.max_position_value":
+		x.MaxPositionValue = ""
+	case "provlabs.vault.v1.VaultAccount.position_cap_exemptions":
+		x.PositionCapExemptions = nil
+	case "provlabs.vault.v1.VaultAccount.max_total_value":
+		x.MaxTotalValue = ""
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: provlabs.vault.v1.VaultAccount"))
@@ -573,6 +1027,84 @@ func (x *fastReflection_VaultAccount) Get(descriptor protoreflect.FieldDescripto
 	case "provlabs.vault.v1.VaultAccount.nav_authority":
 		value := x.NavAuthority
 		return protoreflect.ValueOfString(value)
+	case "provlabs.vault.v1.VaultAccount.max_nav_age_seconds":
+		value := x.MaxNavAgeSeconds
+		return protoreflect.ValueOfUint64(value)
+	case "provlabs.vault.v1.VaultAccount.auto_pause_on_stale_nav":
+		value := x.AutoPauseOnStaleNav
+		return protoreflect.ValueOfBool(value)
+	case "provlabs.vault.v1.VaultAccount.max_nav_change_bips":
+		value := x.MaxNavChangeBips
+		return protoreflect.ValueOfUint32(value)
+	case "provlabs.vault.v1.VaultAccount.nav_approver":
+		value := x.NavApprover
+		return protoreflect.ValueOfString(value)
+	case "provlabs.vault.v1.VaultAccount.nav_sources":
+		if len(x.NavSources) == 0 {
+			return protoreflect.ValueOfList(&_VaultAccount_34_list{})
+		}
+		listValue := &_VaultAccount_34_list{list: &x.NavSources}
+		return protoreflect.ValueOfList(listValue)
+	case "provlabs.vault.v1.VaultAccount.nav_source_quorum":
+		value := x.NavSourceQuorum
+		return protoreflect.ValueOfUint32(value)
+	case "provlabs.vault.v1.VaultAccount.nav_aggregation":
+		value := x.NavAggregation
+		return protoreflect.ValueOfEnum((protoreflect.EnumNumber)(value))
+	case "provlabs.vault.v1.VaultAccount.nav_source_max_age_seconds":
+		value := x.NavSourceMaxAgeSeconds
+		return protoreflect.ValueOfUint64(value)
+	case "provlabs.vault.v1.VaultAccount.price_sources":
+		if len(x.PriceSources) == 0 {
+			return protoreflect.ValueOfList(&_VaultAccount_38_list{})
+		}
+		listValue := &_VaultAccount_38_list{list: &x.PriceSources}
+		return protoreflect.ValueOfList(listValue)
+	case "provlabs.vault.v1.VaultAccount.settlement_tolerance_bips":
+		value := x.SettlementToleranceBips
+		return protoreflect.ValueOfUint32(value)
+	case "provlabs.vault.v1.VaultAccount.update_nav_on_settlement":
+		value := x.UpdateNavOnSettlement
+		return protoreflect.ValueOfBool(value)
+	case "provlabs.vault.v1.VaultAccount.acquisition_policy":
+		value := x.AcquisitionPolicy
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "provlabs.vault.v1.VaultAccount.max_asset_concentration_bips":
+		value := x.MaxAssetConcentrationBips
+		return protoreflect.ValueOfUint32(value)
+	case "provlabs.vault.v1.VaultAccount.max_non_underlying_bips":
+		value := x.MaxNonUnderlyingBips
+		return protoreflect.ValueOfUint32(value)
+	case "provlabs.vault.v1.VaultAccount.min_liquidity_bips":
+		value := x.MinLiquidityBips
+		return protoreflect.ValueOfUint32(value)
+	case "provlabs.vault.v1.VaultAccount.role_grants":
+		if len(x.RoleGrants) == 0 {
+			return protoreflect.ValueOfList(&_VaultAccount_45_list{})
+		}
+		listValue := &_VaultAccount_45_list{list: &x.RoleGrants}
+		return protoreflect.ValueOfList(listValue)
+	case "provlabs.vault.v1.VaultAccount.pending_admin_transfer":
+		value := x.PendingAdminTransfer
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "provlabs.vault.v1.VaultAccount.timelock_seconds":
+		value := x.TimelockSeconds
+		return protoreflect.ValueOfUint64(value)
+	case "provlabs.vault.v1.VaultAccount.eligibility_policy":
+		value := x.EligibilityPolicy
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "provlabs.vault.v1.VaultAccount.max_position_value":
+		value := x.MaxPositionValue
+		return protoreflect.ValueOfString(value)
+	case "provlabs.vault.v1.VaultAccount.position_cap_exemptions":
+		if len(x.PositionCapExemptions) == 0 {
+			return protoreflect.ValueOfList(&_VaultAccount_50_list{})
+		}
+		listValue := &_VaultAccount_50_list{list: &x.PositionCapExemptions}
+		return protoreflect.ValueOfList(listValue)
+	case "provlabs.vault.v1.VaultAccount.max_total_value":
+		value := x.MaxTotalValue
+		return protoreflect.ValueOfString(value)
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: provlabs.vault.v1.VaultAccount"))
@@ -651,6 +1183,58 @@ func (x *fastReflection_VaultAccount) Set(fd protoreflect.FieldDescriptor, value
 		x.MaxSwapOutValue = value.Interface().(string)
 	case "provlabs.vault.v1.VaultAccount.nav_authority":
 		x.NavAuthority = value.Interface().(string)
+	case "provlabs.vault.v1.VaultAccount.max_nav_age_seconds":
+		x.MaxNavAgeSeconds = value.Uint()
+	case "provlabs.vault.v1.VaultAccount.auto_pause_on_stale_nav":
+		x.AutoPauseOnStaleNav = value.Bool()
+	case "provlabs.vault.v1.VaultAccount.max_nav_change_bips":
+		x.MaxNavChangeBips = uint32(value.Uint())
+	case "provlabs.vault.v1.VaultAccount.nav_approver":
+		x.NavApprover = value.Interface().(string)
+	case "provlabs.vault.v1.VaultAccount.nav_sources":
+		lv := value.List()
+		clv := lv.(*_VaultAccount_34_list)
+		x.NavSources = *clv.list
+	case "provlabs.vault.v1.VaultAccount.nav_source_quorum":
+		x.NavSourceQuorum = uint32(value.Uint())
+	case "provlabs.vault.v1.VaultAccount.nav_aggregation":
+		x.NavAggregation = (NAVAggregation)(value.Enum())
+	case "provlabs.vault.v1.VaultAccount.nav_source_max_age_seconds":
+		x.NavSourceMaxAgeSeconds = value.Uint()
+	case "provlabs.vault.v1.VaultAccount.price_sources":
+		lv := value.List()
+		clv := lv.(*_VaultAccount_38_list)
+		x.PriceSources = *clv.list
+	case "provlabs.vault.v1.VaultAccount.settlement_tolerance_bips":
+		x.SettlementToleranceBips = uint32(value.Uint())
+	case "provlabs.vault.v1.VaultAccount.update_nav_on_settlement":
+		x.UpdateNavOnSettlement = value.Bool()
+	case "provlabs.vault.v1.VaultAccount.acquisition_policy":
+		x.AcquisitionPolicy = value.Message().Interface().(*AcquisitionPolicy)
+	case "provlabs.vault.v1.VaultAccount.max_asset_concentration_bips":
+		x.MaxAssetConcentrationBips = uint32(value.Uint())
+	case "provlabs.vault.v1.VaultAccount.max_non_underlying_bips":
+		x.MaxNonUnderlyingBips = uint32(value.Uint())
+	case "provlabs.vault.v1.VaultAccount.min_liquidity_bips":
+		x.MinLiquidityBips = uint32(value.Uint())
+	case "provlabs.vault.v1.VaultAccount.role_grants":
+		lv := value.List()
+		clv := lv.(*_VaultAccount_45_list)
+		x.RoleGrants = *clv.list
+	case "provlabs.vault.v1.VaultAccount.pending_admin_transfer":
+		x.PendingAdminTransfer = value.Message().Interface().(*PendingAdminTransfer)
+	case "provlabs.vault.v1.VaultAccount.timelock_seconds":
+		x.TimelockSeconds = value.Uint()
+	case "provlabs.vault.v1.VaultAccount.eligibility_policy":
+		x.EligibilityPolicy = value.Message().Interface().(*EligibilityPolicy)
+	case "provlabs.vault.v1.VaultAccount.max_position_value":
+		x.MaxPositionValue = value.Interface().(string)
+	case "provlabs.vault.v1.VaultAccount.position_cap_exemptions":
+		lv := value.List()
+		clv := lv.(*_VaultAccount_50_list)
+		x.PositionCapExemptions = *clv.list
+	case "provlabs.vault.v1.VaultAccount.max_total_value":
+		x.MaxTotalValue = value.Interface().(string)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: provlabs.vault.v1.VaultAccount"))
@@ -691,6 +1275,45 @@ func (x *fastReflection_VaultAccount) Mutable(fd protoreflect.FieldDescriptor) p
 			x.OutstandingAumFee = new(v1beta11.Coin)
 		}
 		return protoreflect.ValueOfMessage(x.OutstandingAumFee.ProtoReflect())
+	case "provlabs.vault.v1.VaultAccount.nav_sources":
+		if x.NavSources == nil {
+			x.NavSources = []string{}
+		}
+		value := &_VaultAccount_34_list{list: &x.NavSources}
+		return protoreflect.ValueOfList(value)
+	case "provlabs.vault.v1.VaultAccount.price_sources":
+		if x.PriceSources == nil {
+			x.PriceSources = []PriceSourceType{}
+		}
+		value := &_VaultAccount_38_list{list: &x.PriceSources}
+		return protoreflect.ValueOfList(value)
+	case "provlabs.vault.v1.VaultAccount.acquisition_policy":
+		if x.AcquisitionPolicy == nil {
+			x.AcquisitionPolicy = new(AcquisitionPolicy)
+		}
+		return protoreflect.ValueOfMessage(x.AcquisitionPolicy.ProtoReflect())
+	case "provlabs.vault.v1.VaultAccount.role_grants":
+		if x.RoleGrants == nil {
+			x.RoleGrants = []*RoleGrant{}
+		}
+		value := &_VaultAccount_45_list{list: &x.RoleGrants}
+		return protoreflect.ValueOfList(value)
+	case "provlabs.vault.v1.VaultAccount.pending_admin_transfer":
+		if x.PendingAdminTransfer == nil {
+			x.PendingAdminTransfer = new(PendingAdminTransfer)
+		}
+		return protoreflect.ValueOfMessage(x.PendingAdminTransfer.ProtoReflect())
+	case "provlabs.vault.v1.VaultAccount.eligibility_policy":
+		if x.EligibilityPolicy == nil {
+			x.EligibilityPolicy = new(EligibilityPolicy)
+		}
+		return protoreflect.ValueOfMessage(x.EligibilityPolicy.ProtoReflect())
+	case "provlabs.vault.v1.VaultAccount.position_cap_exemptions":
+		if x.PositionCapExemptions == nil {
+			x.PositionCapExemptions = []string{}
+		}
+		value := &_VaultAccount_50_list{list: &x.PositionCapExemptions}
+		return protoreflect.ValueOfList(value)
 	case "provlabs.vault.v1.VaultAccount.underlying_asset":
 		panic(fmt.Errorf("field underlying_asset of message provlabs.vault.v1.VaultAccount is not mutable"))
 	case "provlabs.vault.v1.VaultAccount.payment_denom":
@@ -741,6 +1364,36 @@ func (x *fastReflection_VaultAccount) Mutable(fd protoreflect.FieldDescriptor) p
 		panic(fmt.Errorf("field max_swap_out_value of message provlabs.vault.v1.VaultAccount is not mutable"))
 	case "provlabs.vault.v1.VaultAccount.nav_authority":
 		panic(fmt.Errorf("field nav_authority of message provlabs.vault.v1.VaultAccount is not mutable"))
+	case "provlabs.vault.v1.VaultAccount.max_nav_age_seconds":
+		panic(fmt.Errorf("field max_nav_age_seconds of message provlabs.vault.v1.VaultAccount is not mutable"))
+	case "provlabs.vault.v1.VaultAccount.auto_pause_on_stale_nav":
+		panic(fmt.Errorf("field auto_pause_on_stale_nav of message provlabs.vault.v1.VaultAccount is not mutable"))
+	case "provlabs.vault.v1.VaultAccount.max_nav_change_bips":
+		panic(fmt.Errorf("field max_nav_change_bips of message provlabs.vault.v1.VaultAccount is not mutable"))
+	case "provlabs.vault.v1.VaultAccount.nav_approver":
+		panic(fmt.Errorf("field nav_approver of message provlabs.vault.v1.VaultAccount is not mutable"))
+	case "provlabs.vault.v1.VaultAccount.nav_source_quorum":
+		panic(fmt.Errorf("field nav_source_quorum of message provlabs.vault.v1.VaultAccount is not mutable"))
+	case "provlabs.vault.v1.VaultAccount.nav_aggregation":
+		panic(fmt.Errorf("field nav_aggregation of message provlabs.vault.v1.VaultAccount is not mutable"))
+	case "provlabs.vault.v1.VaultAccount.nav_source_max_age_seconds":
+		panic(fmt.Errorf("field nav_source_max_age_seconds of message provlabs.vault.v1.VaultAccount is not mutable"))
+	case "provlabs.vault.v1.VaultAccount.settlement_tolerance_bips":
+		panic(fmt.Errorf("field settlement_tolerance_bips of message provlabs.vault.v1.VaultAccount is not mutable"))
+	case "provlabs.vault.v1.VaultAccount.update_nav_on_settlement":
+		panic(fmt.Errorf("field update_nav_on_settlement of message provlabs.vault.v1.VaultAccount is not mutable"))
+	case "provlabs.vault.v1.VaultAccount.max_asset_concentration_bips":
+		panic(fmt.Errorf("field max_asset_concentration_bips of message provlabs.vault.v1.VaultAccount is not mutable"))
+	case "provlabs.vault.v1.VaultAccount.max_non_underlying_bips":
+		panic(fmt.Errorf("field max_non_underlying_bips of message provlabs.vault.v1.VaultAccount is not mutable"))
+	case "provlabs.vault.v1.VaultAccount.min_liquidity_bips":
+		panic(fmt.Errorf("field min_liquidity_bips of message provlabs.vault.v1.VaultAccount is not mutable"))
+	case "provlabs.vault.v1.VaultAccount.timelock_seconds":
+		panic(fmt.Errorf("field timelock_seconds of message provlabs.vault.v1.VaultAccount is not mutable"))
+	case "provlabs.vault.v1.VaultAccount.max_position_value":
+		panic(fmt.Errorf("field max_position_value of message provlabs.vault.v1.VaultAccount is not mutable"))
+	case "provlabs.vault.v1.VaultAccount.max_total_value":
+		panic(fmt.Errorf("field max_total_value of message provlabs.vault.v1.VaultAccount is not mutable"))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: provlabs.vault.v1.VaultAccount"))
@@ -816,6 +1469,57 @@ func (x *fastReflection_VaultAccount) NewField(fd protoreflect.FieldDescriptor)
 		return protoreflect.ValueOfString("")
 	case "provlabs.vault.v1.VaultAccount.nav_authority":
 		return protoreflect.ValueOfString("")
+	case "provlabs.vault.v1.VaultAccount.max_nav_age_seconds":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "provlabs.vault.v1.VaultAccount.auto_pause_on_stale_nav":
+		return protoreflect.ValueOfBool(false)
+	case "provlabs.vault.v1.VaultAccount.max_nav_change_bips":
+		return protoreflect.ValueOfUint32(uint32(0))
+	case "provlabs.vault.v1.VaultAccount.nav_approver":
+		return protoreflect.ValueOfString("")
+	case "provlabs.vault.v1.VaultAccount.nav_sources":
+		list := []string{}
+		return protoreflect.ValueOfList(&_VaultAccount_34_list{list: &list})
+	case "provlabs.vault.v1.VaultAccount.nav_source_quorum":
+		return protoreflect.ValueOfUint32(uint32(0))
+	case "provlabs.vault.v1.VaultAccount.nav_aggregation":
+		return protoreflect.ValueOfEnum(0)
+	case "provlabs.vault.v1.VaultAccount.nav_source_max_age_seconds":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "provlabs.vault.v1.VaultAccount.price_sources":
+		list := []PriceSourceType{}
+		return protoreflect.ValueOfList(&_VaultAccount_38_list{list: &list})
+	case "provlabs.vault.v1.VaultAccount.settlement_tolerance_bips":
+		return protoreflect.ValueOfUint32(uint32(0))
+	case "provlabs.vault.v1.VaultAccount.update_nav_on_settlement":
+		return protoreflect.ValueOfBool(false)
+	case "provlabs.vault.v1.VaultAccount.acquisition_policy":
+		m := new(AcquisitionPolicy)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "provlabs.vault.v1.VaultAccount.max_asset_concentration_bips":
+		return protoreflect.ValueOfUint32(uint32(0))
+	case "provlabs.vault.v1.VaultAccount.max_non_underlying_bips":
+		return protoreflect.ValueOfUint32(uint32(0))
+	case "provlabs.vault.v1.VaultAccount.min_liquidity_bips":
+		return protoreflect.ValueOfUint32(uint32(0))
+	case "provlabs.vault.v1.VaultAccount.role_grants":
+		list := []*RoleGrant{}
+		return protoreflect.ValueOfList(&_VaultAccount_45_list{list: &list})
+	case "provlabs.vault.v1.VaultAccount.pending_admin_transfer":
+		m := new(PendingAdminTransfer)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "provlabs.vault.v1.VaultAccount.timelock_seconds":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "provlabs.vault.v1.VaultAccount.eligibility_policy":
+		m := new(EligibilityPolicy)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "provlabs.vault.v1.VaultAccount.max_position_value":
+		return protoreflect.ValueOfString("")
+	case "provlabs.vault.v1.VaultAccount.position_cap_exemptions":
+		list := []string{}
+		return protoreflect.ValueOfList(&_VaultAccount_50_list{list: &list})
+	case "provlabs.vault.v1.VaultAccount.max_total_value":
+		return protoreflect.ValueOfString("")
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: provlabs.vault.v1.VaultAccount"))
@@ -991,24 +1695,109 @@ func (x *fastReflection_VaultAccount) ProtoMethods() *protoiface.Methods {
 		if l > 0 {
 			n += 2 + l + runtime.Sov(uint64(l))
 		}
-		if x.unknownFields != nil {
-			n += len(x.unknownFields)
+		if x.MaxNavAgeSeconds != 0 {
+			n += 2 + runtime.Sov(uint64(x.MaxNavAgeSeconds))
 		}
-		return protoiface.SizeOutput{
-			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-			Size:              n,
+		if x.AutoPauseOnStaleNav {
+			n += 3
 		}
-	}
-
-	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*VaultAccount)
-		if x == nil {
-			return protoiface.MarshalOutput{
-				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-				Buf:               input.Buf,
-			}, nil
+		if x.MaxNavChangeBips != 0 {
+			n += 2 + runtime.Sov(uint64(x.MaxNavChangeBips))
 		}
-		options := runtime.MarshalInputToOptions(input)
+		l = len(x.NavApprover)
+		if l > 0 {
+			n += 2 + l + runtime.Sov(uint64(l))
+		}
+		if len(x.NavSources) > 0 {
+			for _, s := range x.NavSources {
+				l = len(s)
+				n += 2 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.NavSourceQuorum != 0 {
+			n += 2 + runtime.Sov(uint64(x.NavSourceQuorum))
+		}
+		if x.NavAggregation != 0 {
+			n += 2 + runtime.Sov(uint64(x.NavAggregation))
+		}
+		if x.NavSourceMaxAgeSeconds != 0 {
+			n += 2 + runtime.Sov(uint64(x.NavSourceMaxAgeSeconds))
+		}
+		if len(x.PriceSources) > 0 {
+			l = 0
+			for _, e := range x.PriceSources {
+				l += runtime.Sov(uint64(e))
+			}
+			n += 2 + runtime.Sov(uint64(l)) + l
+		}
+		if x.SettlementToleranceBips != 0 {
+			n += 2 + runtime.Sov(uint64(x.SettlementToleranceBips))
+		}
+		if x.UpdateNavOnSettlement {
+			n += 3
+		}
+		if x.AcquisitionPolicy != nil {
+			l = options.Size(x.AcquisitionPolicy)
+			n += 2 + l + runtime.Sov(uint64(l))
+		}
+		if x.MaxAssetConcentrationBips != 0 {
+			n += 2 + runtime.Sov(uint64(x.MaxAssetConcentrationBips))
+		}
+		if x.MaxNonUnderlyingBips != 0 {
+			n += 2 + runtime.Sov(uint64(x.MaxNonUnderlyingBips))
+		}
+		if x.MinLiquidityBips != 0 {
+			n += 2 + runtime.Sov(uint64(x.MinLiquidityBips))
+		}
+		if len(x.RoleGrants) > 0 {
+			for _, e := range x.RoleGrants {
+				l = options.Size(e)
+				n += 2 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.PendingAdminTransfer != nil {
+			l = options.Size(x.PendingAdminTransfer)
+			n += 2 + l + runtime.Sov(uint64(l))
+		}
+		if x.TimelockSeconds != 0 {
+			n += 2 + runtime.Sov(uint64(x.TimelockSeconds))
+		}
+		if x.EligibilityPolicy != nil {
+			l = options.Size(x.EligibilityPolicy)
+			n += 2 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.MaxPositionValue)
+		if l > 0 {
+			n += 2 + l + runtime.Sov(uint64(l))
+		}
+		if len(x.PositionCapExemptions) > 0 {
+			for _, s := range x.PositionCapExemptions {
+				l = len(s)
+				n += 2 + l + runtime.Sov(uint64(l))
+			}
+		}
+		l = len(x.MaxTotalValue)
+		if l > 0 {
+			n += 2 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*VaultAccount)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
 		_ = options
 		size := options.Size(x)
 		dAtA := make([]byte, size)
@@ -1020,6 +1809,238 @@ func (x *fastReflection_VaultAccount) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if len(x.MaxTotalValue) > 0 {
+			i -= len(x.MaxTotalValue)
+			copy(dAtA[i:], x.MaxTotalValue)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.MaxTotalValue)))
+			i--
+			dAtA[i] = 0x3
+			i--
+			dAtA[i] = 0x9a
+		}
+		if len(x.PositionCapExemptions) > 0 {
+			for iNdEx := len(x.PositionCapExemptions) - 1; iNdEx >= 0; iNdEx-- {
+				i -= len(x.PositionCapExemptions[iNdEx])
+				copy(dAtA[i:], x.PositionCapExemptions[iNdEx])
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.PositionCapExemptions[iNdEx])))
+				i--
+				dAtA[i] = 0x3
+				i--
+				dAtA[i] = 0x92
+			}
+		}
+		if len(x.MaxPositionValue) > 0 {
+			i -= len(x.MaxPositionValue)
+			copy(dAtA[i:], x.MaxPositionValue)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.MaxPositionValue)))
+			i--
+			dAtA[i] = 0x3
+			i--
+			dAtA[i] = 0x8a
+		}
+		if x.EligibilityPolicy != nil {
+			encoded, err := options.Marshal(x.EligibilityPolicy)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x3
+			i--
+			dAtA[i] = 0x82
+		}
+		if x.TimelockSeconds != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.TimelockSeconds))
+			i--
+			dAtA[i] = 0x2
+			i--
+			dAtA[i] = 0xf8
+		}
+		if x.PendingAdminTransfer != nil {
+			encoded, err := options.Marshal(x.PendingAdminTransfer)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x2
+			i--
+			dAtA[i] = 0xf2
+		}
+		if len(x.RoleGrants) > 0 {
+			for iNdEx := len(x.RoleGrants) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.RoleGrants[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x2
+				i--
+				dAtA[i] = 0xea
+			}
+		}
+		if x.MinLiquidityBips != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.MinLiquidityBips))
+			i--
+			dAtA[i] = 0x2
+			i--
+			dAtA[i] = 0xe0
+		}
+		if x.MaxNonUnderlyingBips != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.MaxNonUnderlyingBips))
+			i--
+			dAtA[i] = 0x2
+			i--
+			dAtA[i] = 0xd8
+		}
+		if x.MaxAssetConcentrationBips != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.MaxAssetConcentrationBips))
+			i--
+			dAtA[i] = 0x2
+			i--
+			dAtA[i] = 0xd0
+		}
+		if x.AcquisitionPolicy != nil {
+			encoded, err := options.Marshal(x.AcquisitionPolicy)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x2
+			i--
+			dAtA[i] = 0xca
+		}
+		if x.UpdateNavOnSettlement {
+			i--
+			if x.UpdateNavOnSettlement {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x2
+			i--
+			dAtA[i] = 0xc0
+		}
+		if x.SettlementToleranceBips != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.SettlementToleranceBips))
+			i--
+			dAtA[i] = 0x2
+			i--
+			dAtA[i] = 0xb8
+		}
+		if len(x.PriceSources) > 0 {
+			var pksize2 int
+			for _, num := range x.PriceSources {
+				pksize2 += runtime.Sov(uint64(num))
+			}
+			i -= pksize2
+			j1 := i
+			for _, num1 := range x.PriceSources {
+				num := uint64(num1)
+				for num >= 1<<7 {
+					dAtA[j1] = uint8(uint64(num)&0x7f | 0x80)
+					num >>= 7
+					j1++
+				}
+				dAtA[j1] = uint8(num)
+				j1++
+			}
+			i = runtime.EncodeVarint(dAtA, i, uint64(pksize2))
+			i--
+			dAtA[i] = 0x2
+			i--
+			dAtA[i] = 0xb2
+		}
+		if x.NavSourceMaxAgeSeconds != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.NavSourceMaxAgeSeconds))
+			i--
+			dAtA[i] = 0x2
+			i--
+			dAtA[i] = 0xa8
+		}
+		if x.NavAggregation != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.NavAggregation))
+			i--
+			dAtA[i] = 0x2
+			i--
+			dAtA[i] = 0xa0
+		}
+		if x.NavSourceQuorum != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.NavSourceQuorum))
+			i--
+			dAtA[i] = 0x2
+			i--
+			dAtA[i] = 0x98
+		}
+		if len(x.NavSources) > 0 {
+			for iNdEx := len(x.NavSources) - 1; iNdEx >= 0; iNdEx-- {
+				i -= len(x.NavSources[iNdEx])
+				copy(dAtA[i:], x.NavSources[iNdEx])
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.NavSources[iNdEx])))
+				i--
+				dAtA[i] = 0x2
+				i--
+				dAtA[i] = 0x92
+			}
+		}
+		if len(x.NavApprover) > 0 {
+			i -= len(x.NavApprover)
+			copy(dAtA[i:], x.NavApprover)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.NavApprover)))
+			i--
+			dAtA[i] = 0x2
+			i--
+			dAtA[i] = 0x8a
+		}
+		if x.MaxNavChangeBips != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.MaxNavChangeBips))
+			i--
+			dAtA[i] = 0x2
+			i--
+			dAtA[i] = 0x80
+		}
+		if x.AutoPauseOnStaleNav {
+			i--
+			if x.AutoPauseOnStaleNav {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xf8
+		}
+		if x.MaxNavAgeSeconds != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.MaxNavAgeSeconds))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xf0
+		}
 		if len(x.NavAuthority) > 0 {
 			i -= len(x.NavAuthority)
 			copy(dAtA[i:], x.NavAuthority)
@@ -2146,19 +3167,7655 @@ func (x *fastReflection_VaultAccount) ProtoMethods() *protoiface.Methods {
 				}
 				x.NavAuthority = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
-			default:
-				iNdEx = preIndex
-				skippy, err := runtime.Skip(dAtA[iNdEx:])
-				if err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+			case 30:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MaxNavAgeSeconds", wireType)
 				}
-				if (skippy < 0) || (iNdEx+skippy) < 0 {
+				x.MaxNavAgeSeconds = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.MaxNavAgeSeconds |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 31:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field AutoPauseOnStaleNav", wireType)
+				}
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				x.AutoPauseOnStaleNav = bool(v != 0)
+			case 32:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MaxNavChangeBips", wireType)
+				}
+				x.MaxNavChangeBips = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.MaxNavChangeBips |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 33:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field NavApprover", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				if (iNdEx + skippy) > l {
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				if !options.DiscardUnknown {
+				x.NavApprover = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 34:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field NavSources", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.NavSources = append(x.NavSources, string(dAtA[iNdEx:postIndex]))
+				iNdEx = postIndex
+			case 35:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field NavSourceQuorum", wireType)
+				}
+				x.NavSourceQuorum = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.NavSourceQuorum |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 36:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field NavAggregation", wireType)
+				}
+				x.NavAggregation = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.NavAggregation |= NAVAggregation(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 37:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field NavSourceMaxAgeSeconds", wireType)
+				}
+				x.NavSourceMaxAgeSeconds = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.NavSourceMaxAgeSeconds |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 38:
+				if wireType == 0 {
+					var v PriceSourceType
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+						}
+						if iNdEx >= l {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= PriceSourceType(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					x.PriceSources = append(x.PriceSources, v)
+				} else if wireType == 2 {
+					var packedLen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+						}
+						if iNdEx >= l {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						packedLen |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if packedLen < 0 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+					}
+					postIndex := iNdEx + packedLen
+					if postIndex < 0 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+					}
+					if postIndex > l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					var elementCount int
+					if elementCount != 0 && len(x.PriceSources) == 0 {
+						x.PriceSources = make([]PriceSourceType, 0, elementCount)
+					}
+					for iNdEx < postIndex {
+						var v PriceSourceType
+						for shift := uint(0); ; shift += 7 {
+							if shift >= 64 {
+								return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+							}
+							if iNdEx >= l {
+								return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+							}
+							b := dAtA[iNdEx]
+							iNdEx++
+							v |= PriceSourceType(b&0x7F) << shift
+							if b < 0x80 {
+								break
+							}
+						}
+						x.PriceSources = append(x.PriceSources, v)
+					}
+				} else {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PriceSources", wireType)
+				}
+			case 39:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SettlementToleranceBips", wireType)
+				}
+				x.SettlementToleranceBips = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.SettlementToleranceBips |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 40:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field UpdateNavOnSettlement", wireType)
+				}
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				x.UpdateNavOnSettlement = bool(v != 0)
+			case 41:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field AcquisitionPolicy", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.AcquisitionPolicy == nil {
+					x.AcquisitionPolicy = &AcquisitionPolicy{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.AcquisitionPolicy); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 42:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MaxAssetConcentrationBips", wireType)
+				}
+				x.MaxAssetConcentrationBips = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.MaxAssetConcentrationBips |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 43:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MaxNonUnderlyingBips", wireType)
+				}
+				x.MaxNonUnderlyingBips = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.MaxNonUnderlyingBips |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 44:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MinLiquidityBips", wireType)
+				}
+				x.MinLiquidityBips = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.MinLiquidityBips |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 45:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RoleGrants", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.RoleGrants = append(x.RoleGrants, &RoleGrant{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.RoleGrants[len(x.RoleGrants)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 46:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PendingAdminTransfer", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.PendingAdminTransfer == nil {
+					x.PendingAdminTransfer = &PendingAdminTransfer{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.PendingAdminTransfer); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 47:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TimelockSeconds", wireType)
+				}
+				x.TimelockSeconds = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.TimelockSeconds |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 48:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field EligibilityPolicy", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.EligibilityPolicy == nil {
+					x.EligibilityPolicy = &EligibilityPolicy{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.EligibilityPolicy); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 49:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MaxPositionValue", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.MaxPositionValue = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 50:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PositionCapExemptions", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.PositionCapExemptions = append(x.PositionCapExemptions, string(dAtA[iNdEx:postIndex]))
+				iNdEx = postIndex
+			case 51:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MaxTotalValue", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.MaxTotalValue = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.List = (*_EligibilityPolicy_2_list)(nil)
+
+type _EligibilityPolicy_2_list struct {
+	list *[]string
+}
+
+func (x *_EligibilityPolicy_2_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_EligibilityPolicy_2_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfString((*x.list)[i])
+}
+
+func (x *_EligibilityPolicy_2_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_EligibilityPolicy_2_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_EligibilityPolicy_2_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message EligibilityPolicy at list field RequiredAttributes as it is not of Message kind"))
+}
+
+func (x *_EligibilityPolicy_2_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_EligibilityPolicy_2_list) NewElement() protoreflect.Value {
+	v := ""
+	return protoreflect.ValueOfString(v)
+}
+
+func (x *_EligibilityPolicy_2_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_EligibilityPolicy                     protoreflect.MessageDescriptor
+	fd_EligibilityPolicy_enabled             protoreflect.FieldDescriptor
+	fd_EligibilityPolicy_required_attributes protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_provlabs_vault_v1_vault_proto_init()
+	md_EligibilityPolicy = File_provlabs_vault_v1_vault_proto.Messages().ByName("EligibilityPolicy")
+	fd_EligibilityPolicy_enabled = md_EligibilityPolicy.Fields().ByName("enabled")
+	fd_EligibilityPolicy_required_attributes = md_EligibilityPolicy.Fields().ByName("required_attributes")
+}
+
+var _ protoreflect.Message = (*fastReflection_EligibilityPolicy)(nil)
+
+type fastReflection_EligibilityPolicy EligibilityPolicy
+
+func (x *EligibilityPolicy) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_EligibilityPolicy)(x)
+}
+
+func (x *EligibilityPolicy) slowProtoReflect() protoreflect.Message {
+	mi := &file_provlabs_vault_v1_vault_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_EligibilityPolicy_messageType fastReflection_EligibilityPolicy_messageType
+var _ protoreflect.MessageType = fastReflection_EligibilityPolicy_messageType{}
+
+type fastReflection_EligibilityPolicy_messageType struct{}
+
+func (x fastReflection_EligibilityPolicy_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_EligibilityPolicy)(nil)
+}
+func (x fastReflection_EligibilityPolicy_messageType) New() protoreflect.Message {
+	return new(fastReflection_EligibilityPolicy)
+}
+func (x fastReflection_EligibilityPolicy_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_EligibilityPolicy
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_EligibilityPolicy) Descriptor() protoreflect.MessageDescriptor {
+	return md_EligibilityPolicy
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_EligibilityPolicy) Type() protoreflect.MessageType {
+	return _fastReflection_EligibilityPolicy_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_EligibilityPolicy) New() protoreflect.Message {
+	return new(fastReflection_EligibilityPolicy)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_EligibilityPolicy) Interface() protoreflect.ProtoMessage {
+	return (*EligibilityPolicy)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_EligibilityPolicy) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Enabled != false {
+		value := protoreflect.ValueOfBool(x.Enabled)
+		if !f(fd_EligibilityPolicy_enabled, value) {
+			return
+		}
+	}
+	if len(x.RequiredAttributes) != 0 {
+		value := protoreflect.ValueOfList(&_EligibilityPolicy_2_list{list: &x.RequiredAttributes})
+		if !f(fd_EligibilityPolicy_required_attributes, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_EligibilityPolicy) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "provlabs.vault.v1.EligibilityPolicy.enabled":
+		return x.Enabled != false
+	case "provlabs.vault.v1.EligibilityPolicy.required_attributes":
+		return len(x.RequiredAttributes) != 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: provlabs.vault.v1.EligibilityPolicy"))
+		}
+		panic(fmt.Errorf("message provlabs.vault.v1.EligibilityPolicy does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EligibilityPolicy) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "provlabs.vault.v1.EligibilityPolicy.enabled":
+		x.Enabled = false
+	case "provlabs.vault.v1.EligibilityPolicy.required_attributes":
+		x.RequiredAttributes = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: provlabs.vault.v1.EligibilityPolicy"))
+		}
+		panic(fmt.Errorf("message provlabs.vault.v1.EligibilityPolicy does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_EligibilityPolicy) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "provlabs.vault.v1.EligibilityPolicy.enabled":
+		value := x.Enabled
+		return protoreflect.ValueOfBool(value)
+	case "provlabs.vault.v1.EligibilityPolicy.required_attributes":
+		if len(x.RequiredAttributes) == 0 {
+			return protoreflect.ValueOfList(&_EligibilityPolicy_2_list{})
+		}
+		listValue := &_EligibilityPolicy_2_list{list: &x.RequiredAttributes}
+		return protoreflect.ValueOfList(listValue)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: provlabs.vault.v1.EligibilityPolicy"))
+		}
+		panic(fmt.Errorf("message provlabs.vault.v1.EligibilityPolicy does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EligibilityPolicy) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "provlabs.vault.v1.EligibilityPolicy.enabled":
+		x.Enabled = value.Bool()
+	case "provlabs.vault.v1.EligibilityPolicy.required_attributes":
+		lv := value.List()
+		clv := lv.(*_EligibilityPolicy_2_list)
+		x.RequiredAttributes = *clv.list
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: provlabs.vault.v1.EligibilityPolicy"))
+		}
+		panic(fmt.Errorf("message provlabs.vault.v1.EligibilityPolicy does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EligibilityPolicy) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "provlabs.vault.v1.EligibilityPolicy.required_attributes":
+		if x.RequiredAttributes == nil {
+			x.RequiredAttributes = []string{}
+		}
+		value := &_EligibilityPolicy_2_list{list: &x.RequiredAttributes}
+		return protoreflect.ValueOfList(value)
+	case "provlabs.vault.v1.EligibilityPolicy.enabled":
+		panic(fmt.Errorf("field enabled of message provlabs.vault.v1.EligibilityPolicy is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: provlabs.vault.v1.EligibilityPolicy"))
+		}
+		panic(fmt.Errorf("message provlabs.vault.v1.EligibilityPolicy does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_EligibilityPolicy) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "provlabs.vault.v1.EligibilityPolicy.enabled":
+		return protoreflect.ValueOfBool(false)
+	case "provlabs.vault.v1.EligibilityPolicy.required_attributes":
+		list := []string{}
+		return protoreflect.ValueOfList(&_EligibilityPolicy_2_list{list: &list})
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: provlabs.vault.v1.EligibilityPolicy"))
+		}
+		panic(fmt.Errorf("message provlabs.vault.v1.EligibilityPolicy does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_EligibilityPolicy) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in provlabs.vault.v1.EligibilityPolicy", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_EligibilityPolicy) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EligibilityPolicy) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_EligibilityPolicy) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_EligibilityPolicy) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*EligibilityPolicy)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.Enabled {
+			n += 2
+		}
+		if len(x.RequiredAttributes) > 0 {
+			for _, s := range x.RequiredAttributes {
+				l = len(s)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*EligibilityPolicy)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.RequiredAttributes) > 0 {
+			for iNdEx := len(x.RequiredAttributes) - 1; iNdEx >= 0; iNdEx-- {
+				i -= len(x.RequiredAttributes[iNdEx])
+				copy(dAtA[i:], x.RequiredAttributes[iNdEx])
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.RequiredAttributes[iNdEx])))
+				i--
+				dAtA[i] = 0x12
+			}
+		}
+		if x.Enabled {
+			i--
+			if x.Enabled {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*EligibilityPolicy)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EligibilityPolicy: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EligibilityPolicy: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Enabled", wireType)
+				}
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				x.Enabled = bool(v != 0)
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RequiredAttributes", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.RequiredAttributes = append(x.RequiredAttributes, string(dAtA[iNdEx:postIndex]))
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_PendingAdminTransfer             protoreflect.MessageDescriptor
+	fd_PendingAdminTransfer_new_admin   protoreflect.FieldDescriptor
+	fd_PendingAdminTransfer_expiry_time protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_provlabs_vault_v1_vault_proto_init()
+	md_PendingAdminTransfer = File_provlabs_vault_v1_vault_proto.Messages().ByName("PendingAdminTransfer")
+	fd_PendingAdminTransfer_new_admin = md_PendingAdminTransfer.Fields().ByName("new_admin")
+	fd_PendingAdminTransfer_expiry_time = md_PendingAdminTransfer.Fields().ByName("expiry_time")
+}
+
+var _ protoreflect.Message = (*fastReflection_PendingAdminTransfer)(nil)
+
+type fastReflection_PendingAdminTransfer PendingAdminTransfer
+
+func (x *PendingAdminTransfer) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_PendingAdminTransfer)(x)
+}
+
+func (x *PendingAdminTransfer) slowProtoReflect() protoreflect.Message {
+	mi := &file_provlabs_vault_v1_vault_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_PendingAdminTransfer_messageType fastReflection_PendingAdminTransfer_messageType
+var _ protoreflect.MessageType = fastReflection_PendingAdminTransfer_messageType{}
+
+type fastReflection_PendingAdminTransfer_messageType struct{}
+
+func (x fastReflection_PendingAdminTransfer_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_PendingAdminTransfer)(nil)
+}
+func (x fastReflection_PendingAdminTransfer_messageType) New() protoreflect.Message {
+	return new(fastReflection_PendingAdminTransfer)
+}
+func (x fastReflection_PendingAdminTransfer_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_PendingAdminTransfer
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_PendingAdminTransfer) Descriptor() protoreflect.MessageDescriptor {
+	return md_PendingAdminTransfer
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_PendingAdminTransfer) Type() protoreflect.MessageType {
+	return _fastReflection_PendingAdminTransfer_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_PendingAdminTransfer) New() protoreflect.Message {
+	return new(fastReflection_PendingAdminTransfer)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_PendingAdminTransfer) Interface() protoreflect.ProtoMessage {
+	return (*PendingAdminTransfer)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_PendingAdminTransfer) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.NewAdmin != "" {
+		value := protoreflect.ValueOfString(x.NewAdmin)
+		if !f(fd_PendingAdminTransfer_new_admin, value) {
+			return
+		}
+	}
+	if x.ExpiryTime != nil {
+		value := protoreflect.ValueOfMessage(x.ExpiryTime.ProtoReflect())
+		if !f(fd_PendingAdminTransfer_expiry_time, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_PendingAdminTransfer) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "provlabs.vault.v1.PendingAdminTransfer.new_admin":
+		return x.NewAdmin != ""
+	case "provlabs.vault.v1.PendingAdminTransfer.expiry_time":
+		return x.ExpiryTime != nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: provlabs.vault.v1.PendingAdminTransfer"))
+		}
+		panic(fmt.Errorf("message provlabs.vault.v1.PendingAdminTransfer does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_PendingAdminTransfer) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "provlabs.vault.v1.PendingAdminTransfer.new_admin":
+		x.NewAdmin = ""
+	case "provlabs.vault.v1.PendingAdminTransfer.expiry_time":
+		x.ExpiryTime = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: provlabs.vault.v1.PendingAdminTransfer"))
+		}
+		panic(fmt.Errorf("message provlabs.vault.v1.PendingAdminTransfer does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_PendingAdminTransfer) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "provlabs.vault.v1.PendingAdminTransfer.new_admin":
+		value := x.NewAdmin
+		return protoreflect.ValueOfString(value)
+	case "provlabs.vault.v1.PendingAdminTransfer.expiry_time":
+		value := x.ExpiryTime
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: provlabs.vault.v1.PendingAdminTransfer"))
+		}
+		panic(fmt.Errorf("message provlabs.vault.v1.PendingAdminTransfer does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_PendingAdminTransfer) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "provlabs.vault.v1.PendingAdminTransfer.new_admin":
+		x.NewAdmin = value.Interface().(string)
+	case "provlabs.vault.v1.PendingAdminTransfer.expiry_time":
+		x.ExpiryTime = value.Message().Interface().(*timestamppb.Timestamp)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: provlabs.vault.v1.PendingAdminTransfer"))
+		}
+		panic(fmt.Errorf("message provlabs.vault.v1.PendingAdminTransfer does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_PendingAdminTransfer) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "provlabs.vault.v1.PendingAdminTransfer.expiry_time":
+		if x.ExpiryTime == nil {
+			x.ExpiryTime = new(timestamppb.Timestamp)
+		}
+		return protoreflect.ValueOfMessage(x.ExpiryTime.ProtoReflect())
+	case "provlabs.vault.v1.PendingAdminTransfer.new_admin":
+		panic(fmt.Errorf("field new_admin of message provlabs.vault.v1.PendingAdminTransfer is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: provlabs.vault.v1.PendingAdminTransfer"))
+		}
+		panic(fmt.Errorf("message provlabs.vault.v1.PendingAdminTransfer does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_PendingAdminTransfer) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "provlabs.vault.v1.PendingAdminTransfer.new_admin":
+		return protoreflect.ValueOfString("")
+	case "provlabs.vault.v1.PendingAdminTransfer.expiry_time":
+		m := new(timestamppb.Timestamp)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: provlabs.vault.v1.PendingAdminTransfer"))
+		}
+		panic(fmt.Errorf("message provlabs.vault.v1.PendingAdminTransfer does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_PendingAdminTransfer) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in provlabs.vault.v1.PendingAdminTransfer", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_PendingAdminTransfer) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_PendingAdminTransfer) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_PendingAdminTransfer) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_PendingAdminTransfer) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*PendingAdminTransfer)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.NewAdmin)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.ExpiryTime != nil {
+			l = options.Size(x.ExpiryTime)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*PendingAdminTransfer)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.ExpiryTime != nil {
+			encoded, err := options.Marshal(x.ExpiryTime)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.NewAdmin) > 0 {
+			i -= len(x.NewAdmin)
+			copy(dAtA[i:], x.NewAdmin)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.NewAdmin)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*PendingAdminTransfer)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: PendingAdminTransfer: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: PendingAdminTransfer: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field NewAdmin", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.NewAdmin = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ExpiryTime", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.ExpiryTime == nil {
+					x.ExpiryTime = &timestamppb.Timestamp{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.ExpiryTime); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_ScheduledAction               protoreflect.MessageDescriptor
+	fd_ScheduledAction_id            protoreflect.FieldDescriptor
+	fd_ScheduledAction_vault_address protoreflect.FieldDescriptor
+	fd_ScheduledAction_authority     protoreflect.FieldDescriptor
+	fd_ScheduledAction_execute_time  protoreflect.FieldDescriptor
+	fd_ScheduledAction_msg           protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_provlabs_vault_v1_vault_proto_init()
+	md_ScheduledAction = File_provlabs_vault_v1_vault_proto.Messages().ByName("ScheduledAction")
+	fd_ScheduledAction_id = md_ScheduledAction.Fields().ByName("id")
+	fd_ScheduledAction_vault_address = md_ScheduledAction.Fields().ByName("vault_address")
+	fd_ScheduledAction_authority = md_ScheduledAction.Fields().ByName("authority")
+	fd_ScheduledAction_execute_time = md_ScheduledAction.Fields().ByName("execute_time")
+	fd_ScheduledAction_msg = md_ScheduledAction.Fields().ByName("msg")
+}
+
+var _ protoreflect.Message = (*fastReflection_ScheduledAction)(nil)
+
+type fastReflection_ScheduledAction ScheduledAction
+
+func (x *ScheduledAction) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_ScheduledAction)(x)
+}
+
+func (x *ScheduledAction) slowProtoReflect() protoreflect.Message {
+	mi := &file_provlabs_vault_v1_vault_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_ScheduledAction_messageType fastReflection_ScheduledAction_messageType
+var _ protoreflect.MessageType = fastReflection_ScheduledAction_messageType{}
+
+type fastReflection_ScheduledAction_messageType struct{}
+
+func (x fastReflection_ScheduledAction_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_ScheduledAction)(nil)
+}
+func (x fastReflection_ScheduledAction_messageType) New() protoreflect.Message {
+	return new(fastReflection_ScheduledAction)
+}
+func (x fastReflection_ScheduledAction_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_ScheduledAction
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_ScheduledAction) Descriptor() protoreflect.MessageDescriptor {
+	return md_ScheduledAction
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_ScheduledAction) Type() protoreflect.MessageType {
+	return _fastReflection_ScheduledAction_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_ScheduledAction) New() protoreflect.Message {
+	return new(fastReflection_ScheduledAction)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_ScheduledAction) Interface() protoreflect.ProtoMessage {
+	return (*ScheduledAction)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_ScheduledAction) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Id != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.Id)
+		if !f(fd_ScheduledAction_id, value) {
+			return
+		}
+	}
+	if x.VaultAddress != "" {
+		value := protoreflect.ValueOfString(x.VaultAddress)
+		if !f(fd_ScheduledAction_vault_address, value) {
+			return
+		}
+	}
+	if x.Authority != "" {
+		value := protoreflect.ValueOfString(x.Authority)
+		if !f(fd_ScheduledAction_authority, value) {
+			return
+		}
+	}
+	if x.ExecuteTime != int64(0) {
+		value := protoreflect.ValueOfInt64(x.ExecuteTime)
+		if !f(fd_ScheduledAction_execute_time, value) {
+			return
+		}
+	}
+	if x.Msg != nil {
+		value := protoreflect.ValueOfMessage(x.Msg.ProtoReflect())
+		if !f(fd_ScheduledAction_msg, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_ScheduledAction) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "provlabs.vault.v1.ScheduledAction.id":
+		return x.Id != uint64(0)
+	case "provlabs.vault.v1.ScheduledAction.vault_address":
+		return x.VaultAddress != ""
+	case "provlabs.vault.v1.ScheduledAction.authority":
+		return x.Authority != ""
+	case "provlabs.vault.v1.ScheduledAction.execute_time":
+		return x.ExecuteTime != int64(0)
+	case "provlabs.vault.v1.ScheduledAction.msg":
+		return x.Msg != nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: provlabs.vault.v1.ScheduledAction"))
+		}
+		panic(fmt.Errorf("message provlabs.vault.v1.ScheduledAction does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ScheduledAction) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "provlabs.vault.v1.ScheduledAction.id":
+		x.Id = uint64(0)
+	case "provlabs.vault.v1.ScheduledAction.vault_address":
+		x.VaultAddress = ""
+	case "provlabs.vault.v1.ScheduledAction.authority":
+		x.Authority = ""
+	case "provlabs.vault.v1.ScheduledAction.execute_time":
+		x.ExecuteTime = int64(0)
+	case "provlabs.vault.v1.ScheduledAction.msg":
+		x.Msg = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: provlabs.vault.v1.ScheduledAction"))
+		}
+		panic(fmt.Errorf("message provlabs.vault.v1.ScheduledAction does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_ScheduledAction) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "provlabs.vault.v1.ScheduledAction.id":
+		value := x.Id
+		return protoreflect.ValueOfUint64(value)
+	case "provlabs.vault.v1.ScheduledAction.vault_address":
+		value := x.VaultAddress
+		return protoreflect.ValueOfString(value)
+	case "provlabs.vault.v1.ScheduledAction.authority":
+		value := x.Authority
+		return protoreflect.ValueOfString(value)
+	case "provlabs.vault.v1.ScheduledAction.execute_time":
+		value := x.ExecuteTime
+		return protoreflect.ValueOfInt64(value)
+	case "provlabs.vault.v1.ScheduledAction.msg":
+		value := x.Msg
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: provlabs.vault.v1.ScheduledAction"))
+		}
+		panic(fmt.Errorf("message provlabs.vault.v1.ScheduledAction does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ScheduledAction) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "provlabs.vault.v1.ScheduledAction.id":
+		x.Id = value.Uint()
+	case "provlabs.vault.v1.ScheduledAction.vault_address":
+		x.VaultAddress = value.Interface().(string)
+	case "provlabs.vault.v1.ScheduledAction.authority":
+		x.Authority = value.Interface().(string)
+	case "provlabs.vault.v1.ScheduledAction.execute_time":
+		x.ExecuteTime = value.Int()
+	case "provlabs.vault.v1.ScheduledAction.msg":
+		x.Msg = value.Message().Interface().(*anypb.Any)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: provlabs.vault.v1.ScheduledAction"))
+		}
+		panic(fmt.Errorf("message provlabs.vault.v1.ScheduledAction does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ScheduledAction) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "provlabs.vault.v1.ScheduledAction.msg":
+		if x.Msg == nil {
+			x.Msg = new(anypb.Any)
+		}
+		return protoreflect.ValueOfMessage(x.Msg.ProtoReflect())
+	case "provlabs.vault.v1.ScheduledAction.id":
+		panic(fmt.Errorf("field id of message provlabs.vault.v1.ScheduledAction is not mutable"))
+	case "provlabs.vault.v1.ScheduledAction.vault_address":
+		panic(fmt.Errorf("field vault_address of message provlabs.vault.v1.ScheduledAction is not mutable"))
+	case "provlabs.vault.v1.ScheduledAction.authority":
+		panic(fmt.Errorf("field authority of message provlabs.vault.v1.ScheduledAction is not mutable"))
+	case "provlabs.vault.v1.ScheduledAction.execute_time":
+		panic(fmt.Errorf("field execute_time of message provlabs.vault.v1.ScheduledAction is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: provlabs.vault.v1.ScheduledAction"))
+		}
+		panic(fmt.Errorf("message provlabs.vault.v1.ScheduledAction does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_ScheduledAction) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "provlabs.vault.v1.ScheduledAction.id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "provlabs.vault.v1.ScheduledAction.vault_address":
+		return protoreflect.ValueOfString("")
+	case "provlabs.vault.v1.ScheduledAction.authority":
+		return protoreflect.ValueOfString("")
+	case "provlabs.vault.v1.ScheduledAction.execute_time":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "provlabs.vault.v1.ScheduledAction.msg":
+		m := new(anypb.Any)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: provlabs.vault.v1.ScheduledAction"))
+		}
+		panic(fmt.Errorf("message provlabs.vault.v1.ScheduledAction does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_ScheduledAction) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in provlabs.vault.v1.ScheduledAction", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_ScheduledAction) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ScheduledAction) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_ScheduledAction) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_ScheduledAction) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*ScheduledAction)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.Id != 0 {
+			n += 1 + runtime.Sov(uint64(x.Id))
+		}
+		l = len(x.VaultAddress)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Authority)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.ExecuteTime != 0 {
+			n += 1 + runtime.Sov(uint64(x.ExecuteTime))
+		}
+		if x.Msg != nil {
+			l = options.Size(x.Msg)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*ScheduledAction)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.Msg != nil {
+			encoded, err := options.Marshal(x.Msg)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x2a
+		}
+		if x.ExecuteTime != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.ExecuteTime))
+			i--
+			dAtA[i] = 0x20
+		}
+		if len(x.Authority) > 0 {
+			i -= len(x.Authority)
+			copy(dAtA[i:], x.Authority)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Authority)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if len(x.VaultAddress) > 0 {
+			i -= len(x.VaultAddress)
+			copy(dAtA[i:], x.VaultAddress)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.VaultAddress)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if x.Id != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Id))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*ScheduledAction)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ScheduledAction: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ScheduledAction: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				}
+				x.Id = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Id |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field VaultAddress", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.VaultAddress = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Authority = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 4:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ExecuteTime", wireType)
+				}
+				x.ExecuteTime = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.ExecuteTime |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 5:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Msg", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Msg == nil {
+					x.Msg = &anypb.Any{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Msg); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_RoleGrant         protoreflect.MessageDescriptor
+	fd_RoleGrant_role    protoreflect.FieldDescriptor
+	fd_RoleGrant_address protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_provlabs_vault_v1_vault_proto_init()
+	md_RoleGrant = File_provlabs_vault_v1_vault_proto.Messages().ByName("RoleGrant")
+	fd_RoleGrant_role = md_RoleGrant.Fields().ByName("role")
+	fd_RoleGrant_address = md_RoleGrant.Fields().ByName("address")
+}
+
+var _ protoreflect.Message = (*fastReflection_RoleGrant)(nil)
+
+type fastReflection_RoleGrant RoleGrant
+
+func (x *RoleGrant) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_RoleGrant)(x)
+}
+
+func (x *RoleGrant) slowProtoReflect() protoreflect.Message {
+	mi := &file_provlabs_vault_v1_vault_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_RoleGrant_messageType fastReflection_RoleGrant_messageType
+var _ protoreflect.MessageType = fastReflection_RoleGrant_messageType{}
+
+type fastReflection_RoleGrant_messageType struct{}
+
+func (x fastReflection_RoleGrant_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_RoleGrant)(nil)
+}
+func (x fastReflection_RoleGrant_messageType) New() protoreflect.Message {
+	return new(fastReflection_RoleGrant)
+}
+func (x fastReflection_RoleGrant_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_RoleGrant
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_RoleGrant) Descriptor() protoreflect.MessageDescriptor {
+	return md_RoleGrant
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_RoleGrant) Type() protoreflect.MessageType {
+	return _fastReflection_RoleGrant_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_RoleGrant) New() protoreflect.Message {
+	return new(fastReflection_RoleGrant)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_RoleGrant) Interface() protoreflect.ProtoMessage {
+	return (*RoleGrant)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_RoleGrant) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Role != 0 {
+		value := protoreflect.ValueOfEnum((protoreflect.EnumNumber)(x.Role))
+		if !f(fd_RoleGrant_role, value) {
+			return
+		}
+	}
+	if x.Address != "" {
+		value := protoreflect.ValueOfString(x.Address)
+		if !f(fd_RoleGrant_address, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_RoleGrant) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "provlabs.vault.v1.RoleGrant.role":
+		return x.Role != 0
+	case "provlabs.vault.v1.RoleGrant.address":
+		return x.Address != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: provlabs.vault.v1.RoleGrant"))
+		}
+		panic(fmt.Errorf("message provlabs.vault.v1.RoleGrant does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_RoleGrant) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "provlabs.vault.v1.RoleGrant.role":
+		x.Role = 0
+	case "provlabs.vault.v1.RoleGrant.address":
+		x.Address = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: provlabs.vault.v1.RoleGrant"))
+		}
+		panic(fmt.Errorf("message provlabs.vault.v1.RoleGrant does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_RoleGrant) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "provlabs.vault.v1.RoleGrant.role":
+		value := x.Role
+		return protoreflect.ValueOfEnum((protoreflect.EnumNumber)(value))
+	case "provlabs.vault.v1.RoleGrant.address":
+		value := x.Address
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: provlabs.vault.v1.RoleGrant"))
+		}
+		panic(fmt.Errorf("message provlabs.vault.v1.RoleGrant does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_RoleGrant) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "provlabs.vault.v1.RoleGrant.role":
+		x.Role = (VaultRole)(value.Enum())
+	case "provlabs.vault.v1.RoleGrant.address":
+		x.Address = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: provlabs.vault.v1.RoleGrant"))
+		}
+		panic(fmt.Errorf("message provlabs.vault.v1.RoleGrant does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_RoleGrant) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "provlabs.vault.v1.RoleGrant.role":
+		panic(fmt.Errorf("field role of message provlabs.vault.v1.RoleGrant is not mutable"))
+	case "provlabs.vault.v1.RoleGrant.address":
+		panic(fmt.Errorf("field address of message provlabs.vault.v1.RoleGrant is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: provlabs.vault.v1.RoleGrant"))
+		}
+		panic(fmt.Errorf("message provlabs.vault.v1.RoleGrant does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_RoleGrant) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "provlabs.vault.v1.RoleGrant.role":
+		return protoreflect.ValueOfEnum(0)
+	case "provlabs.vault.v1.RoleGrant.address":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: provlabs.vault.v1.RoleGrant"))
+		}
+		panic(fmt.Errorf("message provlabs.vault.v1.RoleGrant does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_RoleGrant) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in provlabs.vault.v1.RoleGrant", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_RoleGrant) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_RoleGrant) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_RoleGrant) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_RoleGrant) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*RoleGrant)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.Role != 0 {
+			n += 1 + runtime.Sov(uint64(x.Role))
+		}
+		l = len(x.Address)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*RoleGrant)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.Address) > 0 {
+			i -= len(x.Address)
+			copy(dAtA[i:], x.Address)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Address)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if x.Role != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Role))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*RoleGrant)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: RoleGrant: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: RoleGrant: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Role", wireType)
+				}
+				x.Role = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Role |= VaultRole(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Address = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_VaultNAV                      protoreflect.MessageDescriptor
+	fd_VaultNAV_denom                protoreflect.FieldDescriptor
+	fd_VaultNAV_price                protoreflect.FieldDescriptor
+	fd_VaultNAV_volume               protoreflect.FieldDescriptor
+	fd_VaultNAV_source               protoreflect.FieldDescriptor
+	fd_VaultNAV_updated_block_height protoreflect.FieldDescriptor
+	fd_VaultNAV_updated_time         protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_provlabs_vault_v1_vault_proto_init()
+	md_VaultNAV = File_provlabs_vault_v1_vault_proto.Messages().ByName("VaultNAV")
+	fd_VaultNAV_denom = md_VaultNAV.Fields().ByName("denom")
+	fd_VaultNAV_price = md_VaultNAV.Fields().ByName("price")
+	fd_VaultNAV_volume = md_VaultNAV.Fields().ByName("volume")
+	fd_VaultNAV_source = md_VaultNAV.Fields().ByName("source")
+	fd_VaultNAV_updated_block_height = md_VaultNAV.Fields().ByName("updated_block_height")
+	fd_VaultNAV_updated_time = md_VaultNAV.Fields().ByName("updated_time")
+}
+
+var _ protoreflect.Message = (*fastReflection_VaultNAV)(nil)
+
+type fastReflection_VaultNAV VaultNAV
+
+func (x *VaultNAV) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_VaultNAV)(x)
+}
+
+func (x *VaultNAV) slowProtoReflect() protoreflect.Message {
+	mi := &file_provlabs_vault_v1_vault_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_VaultNAV_messageType fastReflection_VaultNAV_messageType
+var _ protoreflect.MessageType = fastReflection_VaultNAV_messageType{}
+
+type fastReflection_VaultNAV_messageType struct{}
+
+func (x fastReflection_VaultNAV_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_VaultNAV)(nil)
+}
+func (x fastReflection_VaultNAV_messageType) New() protoreflect.Message {
+	return new(fastReflection_VaultNAV)
+}
+func (x fastReflection_VaultNAV_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_VaultNAV
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_VaultNAV) Descriptor() protoreflect.MessageDescriptor {
+	return md_VaultNAV
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_VaultNAV) Type() protoreflect.MessageType {
+	return _fastReflection_VaultNAV_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_VaultNAV) New() protoreflect.Message {
+	return new(fastReflection_VaultNAV)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_VaultNAV) Interface() protoreflect.ProtoMessage {
+	return (*VaultNAV)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_VaultNAV) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Denom != "" {
+		value := protoreflect.ValueOfString(x.Denom)
+		if !f(fd_VaultNAV_denom, value) {
+			return
+		}
+	}
+	if x.Price != nil {
+		value := protoreflect.ValueOfMessage(x.Price.ProtoReflect())
+		if !f(fd_VaultNAV_price, value) {
+			return
+		}
+	}
+	if x.Volume != "" {
+		value := protoreflect.ValueOfString(x.Volume)
+		if !f(fd_VaultNAV_volume, value) {
+			return
+		}
+	}
+	if x.Source != "" {
+		value := protoreflect.ValueOfString(x.Source)
+		if !f(fd_VaultNAV_source, value) {
+			return
+		}
+	}
+	if x.UpdatedBlockHeight != int64(0) {
+		value := protoreflect.ValueOfInt64(x.UpdatedBlockHeight)
+		if !f(fd_VaultNAV_updated_block_height, value) {
+			return
+		}
+	}
+	if x.UpdatedTime != nil {
+		value := protoreflect.ValueOfMessage(x.UpdatedTime.ProtoReflect())
+		if !f(fd_VaultNAV_updated_time, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_VaultNAV) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "provlabs.vault.v1.VaultNAV.denom":
+		return x.Denom != ""
+	case "provlabs.vault.v1.VaultNAV.price":
+		return x.Price != nil
+	case "provlabs.vault.v1.VaultNAV.volume":
+		return x.Volume != ""
+	case "provlabs.vault.v1.VaultNAV.source":
+		return x.Source != ""
+	case "provlabs.vault.v1.VaultNAV.updated_block_height":
+		return x.UpdatedBlockHeight != int64(0)
+	case "provlabs.vault.v1.VaultNAV.updated_time":
+		return x.UpdatedTime != nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: provlabs.vault.v1.VaultNAV"))
+		}
+		panic(fmt.Errorf("message provlabs.vault.v1.VaultNAV does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_VaultNAV) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "provlabs.vault.v1.VaultNAV.denom":
+		x.Denom = ""
+	case "provlabs.vault.v1.VaultNAV.price":
+		x.Price = nil
+	case "provlabs.vault.v1.VaultNAV.volume":
+		x.Volume = ""
+	case "provlabs.vault.v1.VaultNAV.source":
+		x.Source = ""
+	case "provlabs.vault.v1.VaultNAV.updated_block_height":
+		x.UpdatedBlockHeight = int64(0)
+	case "provlabs.vault.v1.VaultNAV.updated_time":
+		x.UpdatedTime = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: provlabs.vault.v1.VaultNAV"))
+		}
+		panic(fmt.Errorf("message provlabs.vault.v1.VaultNAV does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_VaultNAV) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "provlabs.vault.v1.VaultNAV.denom":
+		value := x.Denom
+		return protoreflect.ValueOfString(value)
+	case "provlabs.vault.v1.VaultNAV.price":
+		value := x.Price
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "provlabs.vault.v1.VaultNAV.volume":
+		value := x.Volume
+		return protoreflect.ValueOfString(value)
+	case "provlabs.vault.v1.VaultNAV.source":
+		value := x.Source
+		return protoreflect.ValueOfString(value)
+	case "provlabs.vault.v1.VaultNAV.updated_block_height":
+		value := x.UpdatedBlockHeight
+		return protoreflect.ValueOfInt64(value)
+	case "provlabs.vault.v1.VaultNAV.updated_time":
+		value := x.UpdatedTime
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: provlabs.vault.v1.VaultNAV"))
+		}
+		panic(fmt.Errorf("message provlabs.vault.v1.VaultNAV does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_VaultNAV) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "provlabs.vault.v1.VaultNAV.denom":
+		x.Denom = value.Interface().(string)
+	case "provlabs.vault.v1.VaultNAV.price":
+		x.Price = value.Message().Interface().(*v1beta11.Coin)
+	case "provlabs.vault.v1.VaultNAV.volume":
+		x.Volume = value.Interface().(string)
+	case "provlabs.vault.v1.VaultNAV.source":
+		x.Source = value.Interface().(string)
+	case "provlabs.vault.v1.VaultNAV.updated_block_height":
+		x.UpdatedBlockHeight = value.Int()
+	case "provlabs.vault.v1.VaultNAV.updated_time":
+		x.UpdatedTime = value.Message().Interface().(*timestamppb.Timestamp)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: provlabs.vault.v1.VaultNAV"))
+		}
+		panic(fmt.Errorf("message provlabs.vault.v1.VaultNAV does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_VaultNAV) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "provlabs.vault.v1.VaultNAV.price":
+		if x.Price == nil {
+			x.Price = new(v1beta11.Coin)
+		}
+		return protoreflect.ValueOfMessage(x.Price.ProtoReflect())
+	case "provlabs.vault.v1.VaultNAV.updated_time":
+		if x.UpdatedTime == nil {
+			x.UpdatedTime = new(timestamppb.Timestamp)
+		}
+		return protoreflect.ValueOfMessage(x.UpdatedTime.ProtoReflect())
+	case "provlabs.vault.v1.VaultNAV.denom":
+		panic(fmt.Errorf("field denom of message provlabs.vault.v1.VaultNAV is not mutable"))
+	case "provlabs.vault.v1.VaultNAV.volume":
+		panic(fmt.Errorf("field volume of message provlabs.vault.v1.VaultNAV is not mutable"))
+	case "provlabs.vault.v1.VaultNAV.source":
+		panic(fmt.Errorf("field source of message provlabs.vault.v1.VaultNAV is not mutable"))
+	case "provlabs.vault.v1.VaultNAV.updated_block_height":
+		panic(fmt.Errorf("field updated_block_height of message provlabs.vault.v1.VaultNAV is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: provlabs.vault.v1.VaultNAV"))
+		}
+		panic(fmt.Errorf("message provlabs.vault.v1.VaultNAV does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_VaultNAV) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "provlabs.vault.v1.VaultNAV.denom":
+		return protoreflect.ValueOfString("")
+	case "provlabs.vault.v1.VaultNAV.price":
+		m := new(v1beta11.Coin)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "provlabs.vault.v1.VaultNAV.volume":
+		return protoreflect.ValueOfString("")
+	case "provlabs.vault.v1.VaultNAV.source":
+		return protoreflect.ValueOfString("")
+	case "provlabs.vault.v1.VaultNAV.updated_block_height":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "provlabs.vault.v1.VaultNAV.updated_time":
+		m := new(timestamppb.Timestamp)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: provlabs.vault.v1.VaultNAV"))
+		}
+		panic(fmt.Errorf("message provlabs.vault.v1.VaultNAV does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_VaultNAV) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in provlabs.vault.v1.VaultNAV", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_VaultNAV) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_VaultNAV) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_VaultNAV) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_VaultNAV) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*VaultNAV)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Denom)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Price != nil {
+			l = options.Size(x.Price)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Volume)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Source)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.UpdatedBlockHeight != 0 {
+			n += 1 + runtime.Sov(uint64(x.UpdatedBlockHeight))
+		}
+		if x.UpdatedTime != nil {
+			l = options.Size(x.UpdatedTime)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*VaultNAV)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.UpdatedTime != nil {
+			encoded, err := options.Marshal(x.UpdatedTime)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x32
+		}
+		if x.UpdatedBlockHeight != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.UpdatedBlockHeight))
+			i--
+			dAtA[i] = 0x28
+		}
+		if len(x.Source) > 0 {
+			i -= len(x.Source)
+			copy(dAtA[i:], x.Source)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Source)))
+			i--
+			dAtA[i] = 0x22
+		}
+		if len(x.Volume) > 0 {
+			i -= len(x.Volume)
+			copy(dAtA[i:], x.Volume)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Volume)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if x.Price != nil {
+			encoded, err := options.Marshal(x.Price)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.Denom) > 0 {
+			i -= len(x.Denom)
+			copy(dAtA[i:], x.Denom)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Denom)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*VaultNAV)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: VaultNAV: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: VaultNAV: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Denom = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Price == nil {
+					x.Price = &v1beta11.Coin{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Price); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Volume", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Volume = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Source", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Source = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 5:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field UpdatedBlockHeight", wireType)
+				}
+				x.UpdatedBlockHeight = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.UpdatedBlockHeight |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 6:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field UpdatedTime", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.UpdatedTime == nil {
+					x.UpdatedTime = &timestamppb.Timestamp{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.UpdatedTime); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_PendingNAVProposal               protoreflect.MessageDescriptor
+	fd_PendingNAVProposal_vault_address protoreflect.FieldDescriptor
+	fd_PendingNAVProposal_nav           protoreflect.FieldDescriptor
+	fd_PendingNAVProposal_proposer      protoreflect.FieldDescriptor
+	fd_PendingNAVProposal_expiry_time   protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_provlabs_vault_v1_vault_proto_init()
+	md_PendingNAVProposal = File_provlabs_vault_v1_vault_proto.Messages().ByName("PendingNAVProposal")
+	fd_PendingNAVProposal_vault_address = md_PendingNAVProposal.Fields().ByName("vault_address")
+	fd_PendingNAVProposal_nav = md_PendingNAVProposal.Fields().ByName("nav")
+	fd_PendingNAVProposal_proposer = md_PendingNAVProposal.Fields().ByName("proposer")
+	fd_PendingNAVProposal_expiry_time = md_PendingNAVProposal.Fields().ByName("expiry_time")
+}
+
+var _ protoreflect.Message = (*fastReflection_PendingNAVProposal)(nil)
+
+type fastReflection_PendingNAVProposal PendingNAVProposal
+
+func (x *PendingNAVProposal) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_PendingNAVProposal)(x)
+}
+
+func (x *PendingNAVProposal) slowProtoReflect() protoreflect.Message {
+	mi := &file_provlabs_vault_v1_vault_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_PendingNAVProposal_messageType fastReflection_PendingNAVProposal_messageType
+var _ protoreflect.MessageType = fastReflection_PendingNAVProposal_messageType{}
+
+type fastReflection_PendingNAVProposal_messageType struct{}
+
+func (x fastReflection_PendingNAVProposal_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_PendingNAVProposal)(nil)
+}
+func (x fastReflection_PendingNAVProposal_messageType) New() protoreflect.Message {
+	return new(fastReflection_PendingNAVProposal)
+}
+func (x fastReflection_PendingNAVProposal_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_PendingNAVProposal
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_PendingNAVProposal) Descriptor() protoreflect.MessageDescriptor {
+	return md_PendingNAVProposal
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_PendingNAVProposal) Type() protoreflect.MessageType {
+	return _fastReflection_PendingNAVProposal_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_PendingNAVProposal) New() protoreflect.Message {
+	return new(fastReflection_PendingNAVProposal)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_PendingNAVProposal) Interface() protoreflect.ProtoMessage {
+	return (*PendingNAVProposal)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_PendingNAVProposal) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.VaultAddress != "" {
+		value := protoreflect.ValueOfString(x.VaultAddress)
+		if !f(fd_PendingNAVProposal_vault_address, value) {
+			return
+		}
+	}
+	if x.Nav != nil {
+		value := protoreflect.ValueOfMessage(x.Nav.ProtoReflect())
+		if !f(fd_PendingNAVProposal_nav, value) {
+			return
+		}
+	}
+	if x.Proposer != "" {
+		value := protoreflect.ValueOfString(x.Proposer)
+		if !f(fd_PendingNAVProposal_proposer, value) {
+			return
+		}
+	}
+	if x.ExpiryTime != nil {
+		value := protoreflect.ValueOfMessage(x.ExpiryTime.ProtoReflect())
+		if !f(fd_PendingNAVProposal_expiry_time, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_PendingNAVProposal) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "provlabs.vault.v1.PendingNAVProposal.vault_address":
+		return x.VaultAddress != ""
+	case "provlabs.vault.v1.PendingNAVProposal.nav":
+		return x.Nav != nil
+	case "provlabs.vault.v1.PendingNAVProposal.proposer":
+		return x.Proposer != ""
+	case "provlabs.vault.v1.PendingNAVProposal.expiry_time":
+		return x.ExpiryTime != nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: provlabs.vault.v1.PendingNAVProposal"))
+		}
+		panic(fmt.Errorf("message provlabs.vault.v1.PendingNAVProposal does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_PendingNAVProposal) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "provlabs.vault.v1.PendingNAVProposal.vault_address":
+		x.VaultAddress = ""
+	case "provlabs.vault.v1.PendingNAVProposal.nav":
+		x.Nav = nil
+	case "provlabs.vault.v1.PendingNAVProposal.proposer":
+		x.Proposer = ""
+	case "provlabs.vault.v1.PendingNAVProposal.expiry_time":
+		x.ExpiryTime = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: provlabs.vault.v1.PendingNAVProposal"))
+		}
+		panic(fmt.Errorf("message provlabs.vault.v1.PendingNAVProposal does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_PendingNAVProposal) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "provlabs.vault.v1.PendingNAVProposal.vault_address":
+		value := x.VaultAddress
+		return protoreflect.ValueOfString(value)
+	case "provlabs.vault.v1.PendingNAVProposal.nav":
+		value := x.Nav
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "provlabs.vault.v1.PendingNAVProposal.proposer":
+		value := x.Proposer
+		return protoreflect.ValueOfString(value)
+	case "provlabs.vault.v1.PendingNAVProposal.expiry_time":
+		value := x.ExpiryTime
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: provlabs.vault.v1.PendingNAVProposal"))
+		}
+		panic(fmt.Errorf("message provlabs.vault.v1.PendingNAVProposal does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_PendingNAVProposal) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "provlabs.vault.v1.PendingNAVProposal.vault_address":
+		x.VaultAddress = value.Interface().(string)
+	case "provlabs.vault.v1.PendingNAVProposal.nav":
+		x.Nav = value.Message().Interface().(*VaultNAV)
+	case "provlabs.vault.v1.PendingNAVProposal.proposer":
+		x.Proposer = value.Interface().(string)
+	case "provlabs.vault.v1.PendingNAVProposal.expiry_time":
+		x.ExpiryTime = value.Message().Interface().(*timestamppb.Timestamp)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: provlabs.vault.v1.PendingNAVProposal"))
+		}
+		panic(fmt.Errorf("message provlabs.vault.v1.PendingNAVProposal does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_PendingNAVProposal) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "provlabs.vault.v1.PendingNAVProposal.nav":
+		if x.Nav == nil {
+			x.Nav = new(VaultNAV)
+		}
+		return protoreflect.ValueOfMessage(x.Nav.ProtoReflect())
+	case "provlabs.vault.v1.PendingNAVProposal.expiry_time":
+		if x.ExpiryTime == nil {
+			x.ExpiryTime = new(timestamppb.Timestamp)
+		}
+		return protoreflect.ValueOfMessage(x.ExpiryTime.ProtoReflect())
+	case "provlabs.vault.v1.PendingNAVProposal.vault_address":
+		panic(fmt.Errorf("field vault_address of message provlabs.vault.v1.PendingNAVProposal is not mutable"))
+	case "provlabs.vault.v1.PendingNAVProposal.proposer":
+		panic(fmt.Errorf("field proposer of message provlabs.vault.v1.PendingNAVProposal is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: provlabs.vault.v1.PendingNAVProposal"))
+		}
+		panic(fmt.Errorf("message provlabs.vault.v1.PendingNAVProposal does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_PendingNAVProposal) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "provlabs.vault.v1.PendingNAVProposal.vault_address":
+		return protoreflect.ValueOfString("")
+	case "provlabs.vault.v1.PendingNAVProposal.nav":
+		m := new(VaultNAV)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "provlabs.vault.v1.PendingNAVProposal.proposer":
+		return protoreflect.ValueOfString("")
+	case "provlabs.vault.v1.PendingNAVProposal.expiry_time":
+		m := new(timestamppb.Timestamp)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: provlabs.vault.v1.PendingNAVProposal"))
+		}
+		panic(fmt.Errorf("message provlabs.vault.v1.PendingNAVProposal does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_PendingNAVProposal) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in provlabs.vault.v1.PendingNAVProposal", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_PendingNAVProposal) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_PendingNAVProposal) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_PendingNAVProposal) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_PendingNAVProposal) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*PendingNAVProposal)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.VaultAddress)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Nav != nil {
+			l = options.Size(x.Nav)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Proposer)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.ExpiryTime != nil {
+			l = options.Size(x.ExpiryTime)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*PendingNAVProposal)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.ExpiryTime != nil {
+			encoded, err := options.Marshal(x.ExpiryTime)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x22
+		}
+		if len(x.Proposer) > 0 {
+			i -= len(x.Proposer)
+			copy(dAtA[i:], x.Proposer)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Proposer)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if x.Nav != nil {
+			encoded, err := options.Marshal(x.Nav)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.VaultAddress) > 0 {
+			i -= len(x.VaultAddress)
+			copy(dAtA[i:], x.VaultAddress)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.VaultAddress)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*PendingNAVProposal)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: PendingNAVProposal: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: PendingNAVProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field VaultAddress", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.VaultAddress = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Nav", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Nav == nil {
+					x.Nav = &VaultNAV{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Nav); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Proposer", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Proposer = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ExpiryTime", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.ExpiryTime == nil {
+					x.ExpiryTime = &timestamppb.Timestamp{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.ExpiryTime); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_NAVSourceSubmission               protoreflect.MessageDescriptor
+	fd_NAVSourceSubmission_vault_address protoreflect.FieldDescriptor
+	fd_NAVSourceSubmission_source        protoreflect.FieldDescriptor
+	fd_NAVSourceSubmission_nav           protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_provlabs_vault_v1_vault_proto_init()
+	md_NAVSourceSubmission = File_provlabs_vault_v1_vault_proto.Messages().ByName("NAVSourceSubmission")
+	fd_NAVSourceSubmission_vault_address = md_NAVSourceSubmission.Fields().ByName("vault_address")
+	fd_NAVSourceSubmission_source = md_NAVSourceSubmission.Fields().ByName("source")
+	fd_NAVSourceSubmission_nav = md_NAVSourceSubmission.Fields().ByName("nav")
+}
+
+var _ protoreflect.Message = (*fastReflection_NAVSourceSubmission)(nil)
+
+type fastReflection_NAVSourceSubmission NAVSourceSubmission
+
+func (x *NAVSourceSubmission) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_NAVSourceSubmission)(x)
+}
+
+func (x *NAVSourceSubmission) slowProtoReflect() protoreflect.Message {
+	mi := &file_provlabs_vault_v1_vault_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_NAVSourceSubmission_messageType fastReflection_NAVSourceSubmission_messageType
+var _ protoreflect.MessageType = fastReflection_NAVSourceSubmission_messageType{}
+
+type fastReflection_NAVSourceSubmission_messageType struct{}
+
+func (x fastReflection_NAVSourceSubmission_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_NAVSourceSubmission)(nil)
+}
+func (x fastReflection_NAVSourceSubmission_messageType) New() protoreflect.Message {
+	return new(fastReflection_NAVSourceSubmission)
+}
+func (x fastReflection_NAVSourceSubmission_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_NAVSourceSubmission
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_NAVSourceSubmission) Descriptor() protoreflect.MessageDescriptor {
+	return md_NAVSourceSubmission
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_NAVSourceSubmission) Type() protoreflect.MessageType {
+	return _fastReflection_NAVSourceSubmission_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_NAVSourceSubmission) New() protoreflect.Message {
+	return new(fastReflection_NAVSourceSubmission)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_NAVSourceSubmission) Interface() protoreflect.ProtoMessage {
+	return (*NAVSourceSubmission)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_NAVSourceSubmission) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.VaultAddress != "" {
+		value := protoreflect.ValueOfString(x.VaultAddress)
+		if !f(fd_NAVSourceSubmission_vault_address, value) {
+			return
+		}
+	}
+	if x.Source != "" {
+		value := protoreflect.ValueOfString(x.Source)
+		if !f(fd_NAVSourceSubmission_source, value) {
+			return
+		}
+	}
+	if x.Nav != nil {
+		value := protoreflect.ValueOfMessage(x.Nav.ProtoReflect())
+		if !f(fd_NAVSourceSubmission_nav, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_NAVSourceSubmission) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "provlabs.vault.v1.NAVSourceSubmission.vault_address":
+		return x.VaultAddress != ""
+	case "provlabs.vault.v1.NAVSourceSubmission.source":
+		return x.Source != ""
+	case "provlabs.vault.v1.NAVSourceSubmission.nav":
+		return x.Nav != nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: provlabs.vault.v1.NAVSourceSubmission"))
+		}
+		panic(fmt.Errorf("message provlabs.vault.v1.NAVSourceSubmission does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_NAVSourceSubmission) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "provlabs.vault.v1.NAVSourceSubmission.vault_address":
+		x.VaultAddress = ""
+	case "provlabs.vault.v1.NAVSourceSubmission.source":
+		x.Source = ""
+	case "provlabs.vault.v1.NAVSourceSubmission.nav":
+		x.Nav = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: provlabs.vault.v1.NAVSourceSubmission"))
+		}
+		panic(fmt.Errorf("message provlabs.vault.v1.NAVSourceSubmission does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_NAVSourceSubmission) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "provlabs.vault.v1.NAVSourceSubmission.vault_address":
+		value := x.VaultAddress
+		return protoreflect.ValueOfString(value)
+	case "provlabs.vault.v1.NAVSourceSubmission.source":
+		value := x.Source
+		return protoreflect.ValueOfString(value)
+	case "provlabs.vault.v1.NAVSourceSubmission.nav":
+		value := x.Nav
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: provlabs.vault.v1.NAVSourceSubmission"))
+		}
+		panic(fmt.Errorf("message provlabs.vault.v1.NAVSourceSubmission does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_NAVSourceSubmission) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "provlabs.vault.v1.NAVSourceSubmission.vault_address":
+		x.VaultAddress = value.Interface().(string)
+	case "provlabs.vault.v1.NAVSourceSubmission.source":
+		x.Source = value.Interface().(string)
+	case "provlabs.vault.v1.NAVSourceSubmission.nav":
+		x.Nav = value.Message().Interface().(*VaultNAV)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: provlabs.vault.v1.NAVSourceSubmission"))
+		}
+		panic(fmt.Errorf("message provlabs.vault.v1.NAVSourceSubmission does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_NAVSourceSubmission) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "provlabs.vault.v1.NAVSourceSubmission.nav":
+		if x.Nav == nil {
+			x.Nav = new(VaultNAV)
+		}
+		return protoreflect.ValueOfMessage(x.Nav.ProtoReflect())
+	case "provlabs.vault.v1.NAVSourceSubmission.vault_address":
+		panic(fmt.Errorf("field vault_address of message provlabs.vault.v1.NAVSourceSubmission is not mutable"))
+	case "provlabs.vault.v1.NAVSourceSubmission.source":
+		panic(fmt.Errorf("field source of message provlabs.vault.v1.NAVSourceSubmission is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: provlabs.vault.v1.NAVSourceSubmission"))
+		}
+		panic(fmt.Errorf("message provlabs.vault.v1.NAVSourceSubmission does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_NAVSourceSubmission) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "provlabs.vault.v1.NAVSourceSubmission.vault_address":
+		return protoreflect.ValueOfString("")
+	case "provlabs.vault.v1.NAVSourceSubmission.source":
+		return protoreflect.ValueOfString("")
+	case "provlabs.vault.v1.NAVSourceSubmission.nav":
+		m := new(VaultNAV)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: provlabs.vault.v1.NAVSourceSubmission"))
+		}
+		panic(fmt.Errorf("message provlabs.vault.v1.NAVSourceSubmission does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_NAVSourceSubmission) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in provlabs.vault.v1.NAVSourceSubmission", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_NAVSourceSubmission) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_NAVSourceSubmission) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_NAVSourceSubmission) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_NAVSourceSubmission) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*NAVSourceSubmission)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.VaultAddress)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Source)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Nav != nil {
+			l = options.Size(x.Nav)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*NAVSourceSubmission)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.Nav != nil {
+			encoded, err := options.Marshal(x.Nav)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if len(x.Source) > 0 {
+			i -= len(x.Source)
+			copy(dAtA[i:], x.Source)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Source)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.VaultAddress) > 0 {
+			i -= len(x.VaultAddress)
+			copy(dAtA[i:], x.VaultAddress)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.VaultAddress)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*NAVSourceSubmission)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: NAVSourceSubmission: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: NAVSourceSubmission: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field VaultAddress", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.VaultAddress = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Source", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Source = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Nav", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Nav == nil {
+					x.Nav = &VaultNAV{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Nav); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.List = (*_AccountBalance_2_list)(nil)
+
+type _AccountBalance_2_list struct {
+	list *[]*v1beta11.Coin
+}
+
+func (x *_AccountBalance_2_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_AccountBalance_2_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_AccountBalance_2_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta11.Coin)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_AccountBalance_2_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta11.Coin)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_AccountBalance_2_list) AppendMutable() protoreflect.Value {
+	v := new(v1beta11.Coin)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_AccountBalance_2_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_AccountBalance_2_list) NewElement() protoreflect.Value {
+	v := new(v1beta11.Coin)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_AccountBalance_2_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_AccountBalance         protoreflect.MessageDescriptor
+	fd_AccountBalance_address protoreflect.FieldDescriptor
+	fd_AccountBalance_coins   protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_provlabs_vault_v1_vault_proto_init()
+	md_AccountBalance = File_provlabs_vault_v1_vault_proto.Messages().ByName("AccountBalance")
+	fd_AccountBalance_address = md_AccountBalance.Fields().ByName("address")
+	fd_AccountBalance_coins = md_AccountBalance.Fields().ByName("coins")
+}
+
+var _ protoreflect.Message = (*fastReflection_AccountBalance)(nil)
+
+type fastReflection_AccountBalance AccountBalance
+
+func (x *AccountBalance) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_AccountBalance)(x)
+}
+
+func (x *AccountBalance) slowProtoReflect() protoreflect.Message {
+	mi := &file_provlabs_vault_v1_vault_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_AccountBalance_messageType fastReflection_AccountBalance_messageType
+var _ protoreflect.MessageType = fastReflection_AccountBalance_messageType{}
+
+type fastReflection_AccountBalance_messageType struct{}
+
+func (x fastReflection_AccountBalance_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_AccountBalance)(nil)
+}
+func (x fastReflection_AccountBalance_messageType) New() protoreflect.Message {
+	return new(fastReflection_AccountBalance)
+}
+func (x fastReflection_AccountBalance_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_AccountBalance
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_AccountBalance) Descriptor() protoreflect.MessageDescriptor {
+	return md_AccountBalance
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_AccountBalance) Type() protoreflect.MessageType {
+	return _fastReflection_AccountBalance_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_AccountBalance) New() protoreflect.Message {
+	return new(fastReflection_AccountBalance)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_AccountBalance) Interface() protoreflect.ProtoMessage {
+	return (*AccountBalance)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_AccountBalance) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Address != "" {
+		value := protoreflect.ValueOfString(x.Address)
+		if !f(fd_AccountBalance_address, value) {
+			return
+		}
+	}
+	if len(x.Coins) != 0 {
+		value := protoreflect.ValueOfList(&_AccountBalance_2_list{list: &x.Coins})
+		if !f(fd_AccountBalance_coins, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_AccountBalance) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "provlabs.vault.v1.AccountBalance.address":
+		return x.Address != ""
+	case "provlabs.vault.v1.AccountBalance.coins":
+		return len(x.Coins) != 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: provlabs.vault.v1.AccountBalance"))
+		}
+		panic(fmt.Errorf("message provlabs.vault.v1.AccountBalance does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_AccountBalance) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "provlabs.vault.v1.AccountBalance.address":
+		x.Address = ""
+	case "provlabs.vault.v1.AccountBalance.coins":
+		x.Coins = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: provlabs.vault.v1.AccountBalance"))
+		}
+		panic(fmt.Errorf("message provlabs.vault.v1.AccountBalance does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_AccountBalance) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "provlabs.vault.v1.AccountBalance.address":
+		value := x.Address
+		return protoreflect.ValueOfString(value)
+	case "provlabs.vault.v1.AccountBalance.coins":
+		if len(x.Coins) == 0 {
+			return protoreflect.ValueOfList(&_AccountBalance_2_list{})
+		}
+		listValue := &_AccountBalance_2_list{list: &x.Coins}
+		return protoreflect.ValueOfList(listValue)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: provlabs.vault.v1.AccountBalance"))
+		}
+		panic(fmt.Errorf("message provlabs.vault.v1.AccountBalance does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_AccountBalance) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "provlabs.vault.v1.AccountBalance.address":
+		x.Address = value.Interface().(string)
+	case "provlabs.vault.v1.AccountBalance.coins":
+		lv := value.List()
+		clv := lv.(*_AccountBalance_2_list)
+		x.Coins = *clv.list
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: provlabs.vault.v1.AccountBalance"))
+		}
+		panic(fmt.Errorf("message provlabs.vault.v1.AccountBalance does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_AccountBalance) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "provlabs.vault.v1.AccountBalance.coins":
+		if x.Coins == nil {
+			x.Coins = []*v1beta11.Coin{}
+		}
+		value := &_AccountBalance_2_list{list: &x.Coins}
+		return protoreflect.ValueOfList(value)
+	case "provlabs.vault.v1.AccountBalance.address":
+		panic(fmt.Errorf("field address of message provlabs.vault.v1.AccountBalance is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: provlabs.vault.v1.AccountBalance"))
+		}
+		panic(fmt.Errorf("message provlabs.vault.v1.AccountBalance does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_AccountBalance) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "provlabs.vault.v1.AccountBalance.address":
+		return protoreflect.ValueOfString("")
+	case "provlabs.vault.v1.AccountBalance.coins":
+		list := []*v1beta11.Coin{}
+		return protoreflect.ValueOfList(&_AccountBalance_2_list{list: &list})
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: provlabs.vault.v1.AccountBalance"))
+		}
+		panic(fmt.Errorf("message provlabs.vault.v1.AccountBalance does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_AccountBalance) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in provlabs.vault.v1.AccountBalance", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_AccountBalance) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_AccountBalance) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_AccountBalance) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_AccountBalance) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*AccountBalance)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Address)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if len(x.Coins) > 0 {
+			for _, e := range x.Coins {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*AccountBalance)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.Coins) > 0 {
+			for iNdEx := len(x.Coins) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Coins[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x12
+			}
+		}
+		if len(x.Address) > 0 {
+			i -= len(x.Address)
+			copy(dAtA[i:], x.Address)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Address)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*AccountBalance)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: AccountBalance: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: AccountBalance: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Address = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Coins", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Coins = append(x.Coins, &v1beta11.Coin{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Coins[len(x.Coins)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_PendingSwapOut               protoreflect.MessageDescriptor
+	fd_PendingSwapOut_owner         protoreflect.FieldDescriptor
+	fd_PendingSwapOut_vault_address protoreflect.FieldDescriptor
+	fd_PendingSwapOut_shares        protoreflect.FieldDescriptor
+	fd_PendingSwapOut_redeem_denom  protoreflect.FieldDescriptor
+	fd_PendingSwapOut_failure_count protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_provlabs_vault_v1_vault_proto_init()
+	md_PendingSwapOut = File_provlabs_vault_v1_vault_proto.Messages().ByName("PendingSwapOut")
+	fd_PendingSwapOut_owner = md_PendingSwapOut.Fields().ByName("owner")
+	fd_PendingSwapOut_vault_address = md_PendingSwapOut.Fields().ByName("vault_address")
+	fd_PendingSwapOut_shares = md_PendingSwapOut.Fields().ByName("shares")
+	fd_PendingSwapOut_redeem_denom = md_PendingSwapOut.Fields().ByName("redeem_denom")
+	fd_PendingSwapOut_failure_count = md_PendingSwapOut.Fields().ByName("failure_count")
+}
+
+var _ protoreflect.Message = (*fastReflection_PendingSwapOut)(nil)
+
+type fastReflection_PendingSwapOut PendingSwapOut
+
+func (x *PendingSwapOut) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_PendingSwapOut)(x)
+}
+
+func (x *PendingSwapOut) slowProtoReflect() protoreflect.Message {
+	mi := &file_provlabs_vault_v1_vault_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_PendingSwapOut_messageType fastReflection_PendingSwapOut_messageType
+var _ protoreflect.MessageType = fastReflection_PendingSwapOut_messageType{}
+
+type fastReflection_PendingSwapOut_messageType struct{}
+
+func (x fastReflection_PendingSwapOut_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_PendingSwapOut)(nil)
+}
+func (x fastReflection_PendingSwapOut_messageType) New() protoreflect.Message {
+	return new(fastReflection_PendingSwapOut)
+}
+func (x fastReflection_PendingSwapOut_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_PendingSwapOut
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_PendingSwapOut) Descriptor() protoreflect.MessageDescriptor {
+	return md_PendingSwapOut
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_PendingSwapOut) Type() protoreflect.MessageType {
+	return _fastReflection_PendingSwapOut_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_PendingSwapOut) New() protoreflect.Message {
+	return new(fastReflection_PendingSwapOut)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_PendingSwapOut) Interface() protoreflect.ProtoMessage {
+	return (*PendingSwapOut)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_PendingSwapOut) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Owner != "" {
+		value := protoreflect.ValueOfString(x.Owner)
+		if !f(fd_PendingSwapOut_owner, value) {
+			return
+		}
+	}
+	if x.VaultAddress != "" {
+		value := protoreflect.ValueOfString(x.VaultAddress)
+		if !f(fd_PendingSwapOut_vault_address, value) {
+			return
+		}
+	}
+	if x.Shares != nil {
+		value := protoreflect.ValueOfMessage(x.Shares.ProtoReflect())
+		if !f(fd_PendingSwapOut_shares, value) {
+			return
+		}
+	}
+	if x.RedeemDenom != "" {
+		value := protoreflect.ValueOfString(x.RedeemDenom)
+		if !f(fd_PendingSwapOut_redeem_denom, value) {
+			return
+		}
+	}
+	if x.FailureCount != uint32(0) {
+		value := protoreflect.ValueOfUint32(x.FailureCount)
+		if !f(fd_PendingSwapOut_failure_count, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_PendingSwapOut) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "provlabs.vault.v1.PendingSwapOut.owner":
+		return x.Owner != ""
+	case "provlabs.vault.v1.PendingSwapOut.vault_address":
+		return x.VaultAddress != ""
+	case "provlabs.vault.v1.PendingSwapOut.shares":
+		return x.Shares != nil
+	case "provlabs.vault.v1.PendingSwapOut.redeem_denom":
+		return x.RedeemDenom != ""
+	case "provlabs.vault.v1.PendingSwapOut.failure_count":
+		return x.FailureCount != uint32(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: provlabs.vault.v1.PendingSwapOut"))
+		}
+		panic(fmt.Errorf("message provlabs.vault.v1.PendingSwapOut does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_PendingSwapOut) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "provlabs.vault.v1.PendingSwapOut.owner":
+		x.Owner = ""
+	case "provlabs.vault.v1.PendingSwapOut.vault_address":
+		x.VaultAddress = ""
+	case "provlabs.vault.v1.PendingSwapOut.shares":
+		x.Shares = nil
+	case "provlabs.vault.v1.PendingSwapOut.redeem_denom":
+		x.RedeemDenom = ""
+	case "provlabs.vault.v1.PendingSwapOut.failure_count":
+		x.FailureCount = uint32(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: provlabs.vault.v1.PendingSwapOut"))
+		}
+		panic(fmt.Errorf("message provlabs.vault.v1.PendingSwapOut does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_PendingSwapOut) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "provlabs.vault.v1.PendingSwapOut.owner":
+		value := x.Owner
+		return protoreflect.ValueOfString(value)
+	case "provlabs.vault.v1.PendingSwapOut.vault_address":
+		value := x.VaultAddress
+		return protoreflect.ValueOfString(value)
+	case "provlabs.vault.v1.PendingSwapOut.shares":
+		value := x.Shares
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "provlabs.vault.v1.PendingSwapOut.redeem_denom":
+		value := x.RedeemDenom
+		return protoreflect.ValueOfString(value)
+	case "provlabs.vault.v1.PendingSwapOut.failure_count":
+		value := x.FailureCount
+		return protoreflect.ValueOfUint32(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: provlabs.vault.v1.PendingSwapOut"))
+		}
+		panic(fmt.Errorf("message provlabs.vault.v1.PendingSwapOut does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_PendingSwapOut) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "provlabs.vault.v1.PendingSwapOut.owner":
+		x.Owner = value.Interface().(string)
+	case "provlabs.vault.v1.PendingSwapOut.vault_address":
+		x.VaultAddress = value.Interface().(string)
+	case "provlabs.vault.v1.PendingSwapOut.shares":
+		x.Shares = value.Message().Interface().(*v1beta11.Coin)
+	case "provlabs.vault.v1.PendingSwapOut.redeem_denom":
+		x.RedeemDenom = value.Interface().(string)
+	case "provlabs.vault.v1.PendingSwapOut.failure_count":
+		x.FailureCount = uint32(value.Uint())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: provlabs.vault.v1.PendingSwapOut"))
+		}
+		panic(fmt.Errorf("message provlabs.vault.v1.PendingSwapOut does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_PendingSwapOut) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "provlabs.vault.v1.PendingSwapOut.shares":
+		if x.Shares == nil {
+			x.Shares = new(v1beta11.Coin)
+		}
+		return protoreflect.ValueOfMessage(x.Shares.ProtoReflect())
+	case "provlabs.vault.v1.PendingSwapOut.owner":
+		panic(fmt.Errorf("field owner of message provlabs.vault.v1.PendingSwapOut is not mutable"))
+	case "provlabs.vault.v1.PendingSwapOut.vault_address":
+		panic(fmt.Errorf("field vault_address of message provlabs.vault.v1.PendingSwapOut is not mutable"))
+	case "provlabs.vault.v1.PendingSwapOut.redeem_denom":
+		panic(fmt.Errorf("field redeem_denom of message provlabs.vault.v1.PendingSwapOut is not mutable"))
+	case "provlabs.vault.v1.PendingSwapOut.failure_count":
+		panic(fmt.Errorf("field failure_count of message provlabs.vault.v1.PendingSwapOut is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: provlabs.vault.v1.PendingSwapOut"))
+		}
+		panic(fmt.Errorf("message provlabs.vault.v1.PendingSwapOut does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_PendingSwapOut) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "provlabs.vault.v1.PendingSwapOut.owner":
+		return protoreflect.ValueOfString("")
+	case "provlabs.vault.v1.PendingSwapOut.vault_address":
+		return protoreflect.ValueOfString("")
+	case "provlabs.vault.v1.PendingSwapOut.shares":
+		m := new(v1beta11.Coin)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "provlabs.vault.v1.PendingSwapOut.redeem_denom":
+		return protoreflect.ValueOfString("")
+	case "provlabs.vault.v1.PendingSwapOut.failure_count":
+		return protoreflect.ValueOfUint32(uint32(0))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: provlabs.vault.v1.PendingSwapOut"))
+		}
+		panic(fmt.Errorf("message provlabs.vault.v1.PendingSwapOut does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_PendingSwapOut) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in provlabs.vault.v1.PendingSwapOut", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_PendingSwapOut) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_PendingSwapOut) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_PendingSwapOut) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_PendingSwapOut) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*PendingSwapOut)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Owner)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.VaultAddress)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Shares != nil {
+			l = options.Size(x.Shares)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.RedeemDenom)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.FailureCount != 0 {
+			n += 1 + runtime.Sov(uint64(x.FailureCount))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*PendingSwapOut)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.FailureCount != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.FailureCount))
+			i--
+			dAtA[i] = 0x28
+		}
+		if len(x.RedeemDenom) > 0 {
+			i -= len(x.RedeemDenom)
+			copy(dAtA[i:], x.RedeemDenom)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.RedeemDenom)))
+			i--
+			dAtA[i] = 0x22
+		}
+		if x.Shares != nil {
+			encoded, err := options.Marshal(x.Shares)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if len(x.VaultAddress) > 0 {
+			i -= len(x.VaultAddress)
+			copy(dAtA[i:], x.VaultAddress)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.VaultAddress)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.Owner) > 0 {
+			i -= len(x.Owner)
+			copy(dAtA[i:], x.Owner)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Owner)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*PendingSwapOut)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: PendingSwapOut: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: PendingSwapOut: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Owner = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field VaultAddress", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.VaultAddress = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Shares", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Shares == nil {
+					x.Shares = &v1beta11.Coin{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Shares); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RedeemDenom", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.RedeemDenom = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 5:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field FailureCount", wireType)
+				}
+				x.FailureCount = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.FailureCount |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.List = (*_AcquisitionPolicy_1_list)(nil)
+
+type _AcquisitionPolicy_1_list struct {
+	list *[]string
+}
+
+func (x *_AcquisitionPolicy_1_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_AcquisitionPolicy_1_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfString((*x.list)[i])
+}
+
+func (x *_AcquisitionPolicy_1_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_AcquisitionPolicy_1_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_AcquisitionPolicy_1_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message AcquisitionPolicy at list field DenomPatterns as it is not of Message kind"))
+}
+
+func (x *_AcquisitionPolicy_1_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_AcquisitionPolicy_1_list) NewElement() protoreflect.Value {
+	v := ""
+	return protoreflect.ValueOfString(v)
+}
+
+func (x *_AcquisitionPolicy_1_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_AcquisitionPolicy_2_list)(nil)
+
+type _AcquisitionPolicy_2_list struct {
+	list *[]string
+}
+
+func (x *_AcquisitionPolicy_2_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_AcquisitionPolicy_2_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfString((*x.list)[i])
+}
+
+func (x *_AcquisitionPolicy_2_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_AcquisitionPolicy_2_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_AcquisitionPolicy_2_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message AcquisitionPolicy at list field ScopeSpecificationIds as it is not of Message kind"))
+}
+
+func (x *_AcquisitionPolicy_2_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_AcquisitionPolicy_2_list) NewElement() protoreflect.Value {
+	v := ""
+	return protoreflect.ValueOfString(v)
+}
+
+func (x *_AcquisitionPolicy_2_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_AcquisitionPolicy                         protoreflect.MessageDescriptor
+	fd_AcquisitionPolicy_denom_patterns          protoreflect.FieldDescriptor
+	fd_AcquisitionPolicy_scope_specification_ids protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_provlabs_vault_v1_vault_proto_init()
+	md_AcquisitionPolicy = File_provlabs_vault_v1_vault_proto.Messages().ByName("AcquisitionPolicy")
+	fd_AcquisitionPolicy_denom_patterns = md_AcquisitionPolicy.Fields().ByName("denom_patterns")
+	fd_AcquisitionPolicy_scope_specification_ids = md_AcquisitionPolicy.Fields().ByName("scope_specification_ids")
+}
+
+var _ protoreflect.Message = (*fastReflection_AcquisitionPolicy)(nil)
+
+type fastReflection_AcquisitionPolicy AcquisitionPolicy
+
+func (x *AcquisitionPolicy) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_AcquisitionPolicy)(x)
+}
+
+func (x *AcquisitionPolicy) slowProtoReflect() protoreflect.Message {
+	mi := &file_provlabs_vault_v1_vault_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_AcquisitionPolicy_messageType fastReflection_AcquisitionPolicy_messageType
+var _ protoreflect.MessageType = fastReflection_AcquisitionPolicy_messageType{}
+
+type fastReflection_AcquisitionPolicy_messageType struct{}
+
+func (x fastReflection_AcquisitionPolicy_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_AcquisitionPolicy)(nil)
+}
+func (x fastReflection_AcquisitionPolicy_messageType) New() protoreflect.Message {
+	return new(fastReflection_AcquisitionPolicy)
+}
+func (x fastReflection_AcquisitionPolicy_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_AcquisitionPolicy
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_AcquisitionPolicy) Descriptor() protoreflect.MessageDescriptor {
+	return md_AcquisitionPolicy
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_AcquisitionPolicy) Type() protoreflect.MessageType {
+	return _fastReflection_AcquisitionPolicy_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_AcquisitionPolicy) New() protoreflect.Message {
+	return new(fastReflection_AcquisitionPolicy)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_AcquisitionPolicy) Interface() protoreflect.ProtoMessage {
+	return (*AcquisitionPolicy)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_AcquisitionPolicy) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if len(x.DenomPatterns) != 0 {
+		value := protoreflect.ValueOfList(&_AcquisitionPolicy_1_list{list: &x.DenomPatterns})
+		if !f(fd_AcquisitionPolicy_denom_patterns, value) {
+			return
+		}
+	}
+	if len(x.ScopeSpecificationIds) != 0 {
+		value := protoreflect.ValueOfList(&_AcquisitionPolicy_2_list{list: &x.ScopeSpecificationIds})
+		if !f(fd_AcquisitionPolicy_scope_specification_ids, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_AcquisitionPolicy) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "provlabs.vault.v1.AcquisitionPolicy.denom_patterns":
+		return len(x.DenomPatterns) != 0
+	case "provlabs.vault.v1.AcquisitionPolicy.scope_specification_ids":
+		return len(x.ScopeSpecificationIds) != 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: provlabs.vault.v1.AcquisitionPolicy"))
+		}
+		panic(fmt.Errorf("message provlabs.vault.v1.AcquisitionPolicy does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_AcquisitionPolicy) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "provlabs.vault.v1.AcquisitionPolicy.denom_patterns":
+		x.DenomPatterns = nil
+	case "provlabs.vault.v1.AcquisitionPolicy.scope_specification_ids":
+		x.ScopeSpecificationIds = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: provlabs.vault.v1.AcquisitionPolicy"))
+		}
+		panic(fmt.Errorf("message provlabs.vault.v1.AcquisitionPolicy does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_AcquisitionPolicy) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "provlabs.vault.v1.AcquisitionPolicy.denom_patterns":
+		if len(x.DenomPatterns) == 0 {
+			return protoreflect.ValueOfList(&_AcquisitionPolicy_1_list{})
+		}
+		listValue := &_AcquisitionPolicy_1_list{list: &x.DenomPatterns}
+		return protoreflect.ValueOfList(listValue)
+	case "provlabs.vault.v1.AcquisitionPolicy.scope_specification_ids":
+		if len(x.ScopeSpecificationIds) == 0 {
+			return protoreflect.ValueOfList(&_AcquisitionPolicy_2_list{})
+		}
+		listValue := &_AcquisitionPolicy_2_list{list: &x.ScopeSpecificationIds}
+		return protoreflect.ValueOfList(listValue)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: provlabs.vault.v1.AcquisitionPolicy"))
+		}
+		panic(fmt.Errorf("message provlabs.vault.v1.AcquisitionPolicy does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_AcquisitionPolicy) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "provlabs.vault.v1.AcquisitionPolicy.denom_patterns":
+		lv := value.List()
+		clv := lv.(*_AcquisitionPolicy_1_list)
+		x.DenomPatterns = *clv.list
+	case "provlabs.vault.v1.AcquisitionPolicy.scope_specification_ids":
+		lv := value.List()
+		clv := lv.(*_AcquisitionPolicy_2_list)
+		x.ScopeSpecificationIds = *clv.list
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: provlabs.vault.v1.AcquisitionPolicy"))
+		}
+		panic(fmt.Errorf("message provlabs.vault.v1.AcquisitionPolicy does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_AcquisitionPolicy) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "provlabs.vault.v1.AcquisitionPolicy.denom_patterns":
+		if x.DenomPatterns == nil {
+			x.DenomPatterns = []string{}
+		}
+		value := &_AcquisitionPolicy_1_list{list: &x.DenomPatterns}
+		return protoreflect.ValueOfList(value)
+	case "provlabs.vault.v1.AcquisitionPolicy.scope_specification_ids":
+		if x.ScopeSpecificationIds == nil {
+			x.ScopeSpecificationIds = []string{}
+		}
+		value := &_AcquisitionPolicy_2_list{list: &x.ScopeSpecificationIds}
+		return protoreflect.ValueOfList(value)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: provlabs.vault.v1.AcquisitionPolicy"))
+		}
+		panic(fmt.Errorf("message provlabs.vault.v1.AcquisitionPolicy does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_AcquisitionPolicy) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "provlabs.vault.v1.AcquisitionPolicy.denom_patterns":
+		list := []string{}
+		return protoreflect.ValueOfList(&_AcquisitionPolicy_1_list{list: &list})
+	case "provlabs.vault.v1.AcquisitionPolicy.scope_specification_ids":
+		list := []string{}
+		return protoreflect.ValueOfList(&_AcquisitionPolicy_2_list{list: &list})
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: provlabs.vault.v1.AcquisitionPolicy"))
+		}
+		panic(fmt.Errorf("message provlabs.vault.v1.AcquisitionPolicy does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_AcquisitionPolicy) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in provlabs.vault.v1.AcquisitionPolicy", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_AcquisitionPolicy) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_AcquisitionPolicy) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_AcquisitionPolicy) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_AcquisitionPolicy) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*AcquisitionPolicy)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if len(x.DenomPatterns) > 0 {
+			for _, s := range x.DenomPatterns {
+				l = len(s)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.ScopeSpecificationIds) > 0 {
+			for _, s := range x.ScopeSpecificationIds {
+				l = len(s)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*AcquisitionPolicy)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.ScopeSpecificationIds) > 0 {
+			for iNdEx := len(x.ScopeSpecificationIds) - 1; iNdEx >= 0; iNdEx-- {
+				i -= len(x.ScopeSpecificationIds[iNdEx])
+				copy(dAtA[i:], x.ScopeSpecificationIds[iNdEx])
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ScopeSpecificationIds[iNdEx])))
+				i--
+				dAtA[i] = 0x12
+			}
+		}
+		if len(x.DenomPatterns) > 0 {
+			for iNdEx := len(x.DenomPatterns) - 1; iNdEx >= 0; iNdEx-- {
+				i -= len(x.DenomPatterns[iNdEx])
+				copy(dAtA[i:], x.DenomPatterns[iNdEx])
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.DenomPatterns[iNdEx])))
+				i--
+				dAtA[i] = 0xa
+			}
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*AcquisitionPolicy)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: AcquisitionPolicy: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: AcquisitionPolicy: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field DenomPatterns", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.DenomPatterns = append(x.DenomPatterns, string(dAtA[iNdEx:postIndex]))
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ScopeSpecificationIds", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.ScopeSpecificationIds = append(x.ScopeSpecificationIds, string(dAtA[iNdEx:postIndex]))
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_AssetHaircut              protoreflect.MessageDescriptor
+	fd_AssetHaircut_denom        protoreflect.FieldDescriptor
+	fd_AssetHaircut_haircut_bips protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_provlabs_vault_v1_vault_proto_init()
+	md_AssetHaircut = File_provlabs_vault_v1_vault_proto.Messages().ByName("AssetHaircut")
+	fd_AssetHaircut_denom = md_AssetHaircut.Fields().ByName("denom")
+	fd_AssetHaircut_haircut_bips = md_AssetHaircut.Fields().ByName("haircut_bips")
+}
+
+var _ protoreflect.Message = (*fastReflection_AssetHaircut)(nil)
+
+type fastReflection_AssetHaircut AssetHaircut
+
+func (x *AssetHaircut) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_AssetHaircut)(x)
+}
+
+func (x *AssetHaircut) slowProtoReflect() protoreflect.Message {
+	mi := &file_provlabs_vault_v1_vault_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_AssetHaircut_messageType fastReflection_AssetHaircut_messageType
+var _ protoreflect.MessageType = fastReflection_AssetHaircut_messageType{}
+
+type fastReflection_AssetHaircut_messageType struct{}
+
+func (x fastReflection_AssetHaircut_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_AssetHaircut)(nil)
+}
+func (x fastReflection_AssetHaircut_messageType) New() protoreflect.Message {
+	return new(fastReflection_AssetHaircut)
+}
+func (x fastReflection_AssetHaircut_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_AssetHaircut
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_AssetHaircut) Descriptor() protoreflect.MessageDescriptor {
+	return md_AssetHaircut
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_AssetHaircut) Type() protoreflect.MessageType {
+	return _fastReflection_AssetHaircut_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_AssetHaircut) New() protoreflect.Message {
+	return new(fastReflection_AssetHaircut)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_AssetHaircut) Interface() protoreflect.ProtoMessage {
+	return (*AssetHaircut)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_AssetHaircut) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Denom != "" {
+		value := protoreflect.ValueOfString(x.Denom)
+		if !f(fd_AssetHaircut_denom, value) {
+			return
+		}
+	}
+	if x.HaircutBips != uint32(0) {
+		value := protoreflect.ValueOfUint32(x.HaircutBips)
+		if !f(fd_AssetHaircut_haircut_bips, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_AssetHaircut) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "provlabs.vault.v1.AssetHaircut.denom":
+		return x.Denom != ""
+	case "provlabs.vault.v1.AssetHaircut.haircut_bips":
+		return x.HaircutBips != uint32(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: provlabs.vault.v1.AssetHaircut"))
+		}
+		panic(fmt.Errorf("message provlabs.vault.v1.AssetHaircut does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_AssetHaircut) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "provlabs.vault.v1.AssetHaircut.denom":
+		x.Denom = ""
+	case "provlabs.vault.v1.AssetHaircut.haircut_bips":
+		x.HaircutBips = uint32(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: provlabs.vault.v1.AssetHaircut"))
+		}
+		panic(fmt.Errorf("message provlabs.vault.v1.AssetHaircut does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_AssetHaircut) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "provlabs.vault.v1.AssetHaircut.denom":
+		value := x.Denom
+		return protoreflect.ValueOfString(value)
+	case "provlabs.vault.v1.AssetHaircut.haircut_bips":
+		value := x.HaircutBips
+		return protoreflect.ValueOfUint32(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: provlabs.vault.v1.AssetHaircut"))
+		}
+		panic(fmt.Errorf("message provlabs.vault.v1.AssetHaircut does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_AssetHaircut) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "provlabs.vault.v1.AssetHaircut.denom":
+		x.Denom = value.Interface().(string)
+	case "provlabs.vault.v1.AssetHaircut.haircut_bips":
+		x.HaircutBips = uint32(value.Uint())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: provlabs.vault.v1.AssetHaircut"))
+		}
+		panic(fmt.Errorf("message provlabs.vault.v1.AssetHaircut does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_AssetHaircut) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "provlabs.vault.v1.AssetHaircut.denom":
+		panic(fmt.Errorf("field denom of message provlabs.vault.v1.AssetHaircut is not mutable"))
+	case "provlabs.vault.v1.AssetHaircut.haircut_bips":
+		panic(fmt.Errorf("field haircut_bips of message provlabs.vault.v1.AssetHaircut is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: provlabs.vault.v1.AssetHaircut"))
+		}
+		panic(fmt.Errorf("message provlabs.vault.v1.AssetHaircut does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_AssetHaircut) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "provlabs.vault.v1.AssetHaircut.denom":
+		return protoreflect.ValueOfString("")
+	case "provlabs.vault.v1.AssetHaircut.haircut_bips":
+		return protoreflect.ValueOfUint32(uint32(0))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: provlabs.vault.v1.AssetHaircut"))
+		}
+		panic(fmt.Errorf("message provlabs.vault.v1.AssetHaircut does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_AssetHaircut) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in provlabs.vault.v1.AssetHaircut", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_AssetHaircut) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_AssetHaircut) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_AssetHaircut) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_AssetHaircut) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*AssetHaircut)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Denom)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.HaircutBips != 0 {
+			n += 1 + runtime.Sov(uint64(x.HaircutBips))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*AssetHaircut)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.HaircutBips != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.HaircutBips))
+			i--
+			dAtA[i] = 0x10
+		}
+		if len(x.Denom) > 0 {
+			i -= len(x.Denom)
+			copy(dAtA[i:], x.Denom)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Denom)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*AssetHaircut)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: AssetHaircut: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: AssetHaircut: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Denom = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field HaircutBips", wireType)
+				}
+				x.HaircutBips = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.HaircutBips |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_SharePriceObservation              protoreflect.MessageDescriptor
+	fd_SharePriceObservation_time         protoreflect.FieldDescriptor
+	fd_SharePriceObservation_block_height protoreflect.FieldDescriptor
+	fd_SharePriceObservation_price        protoreflect.FieldDescriptor
+	fd_SharePriceObservation_cumulative   protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_provlabs_vault_v1_vault_proto_init()
+	md_SharePriceObservation = File_provlabs_vault_v1_vault_proto.Messages().ByName("SharePriceObservation")
+	fd_SharePriceObservation_time = md_SharePriceObservation.Fields().ByName("time")
+	fd_SharePriceObservation_block_height = md_SharePriceObservation.Fields().ByName("block_height")
+	fd_SharePriceObservation_price = md_SharePriceObservation.Fields().ByName("price")
+	fd_SharePriceObservation_cumulative = md_SharePriceObservation.Fields().ByName("cumulative")
+}
+
+var _ protoreflect.Message = (*fastReflection_SharePriceObservation)(nil)
+
+type fastReflection_SharePriceObservation SharePriceObservation
+
+func (x *SharePriceObservation) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_SharePriceObservation)(x)
+}
+
+func (x *SharePriceObservation) slowProtoReflect() protoreflect.Message {
+	mi := &file_provlabs_vault_v1_vault_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_SharePriceObservation_messageType fastReflection_SharePriceObservation_messageType
+var _ protoreflect.MessageType = fastReflection_SharePriceObservation_messageType{}
+
+type fastReflection_SharePriceObservation_messageType struct{}
+
+func (x fastReflection_SharePriceObservation_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_SharePriceObservation)(nil)
+}
+func (x fastReflection_SharePriceObservation_messageType) New() protoreflect.Message {
+	return new(fastReflection_SharePriceObservation)
+}
+func (x fastReflection_SharePriceObservation_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_SharePriceObservation
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_SharePriceObservation) Descriptor() protoreflect.MessageDescriptor {
+	return md_SharePriceObservation
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_SharePriceObservation) Type() protoreflect.MessageType {
+	return _fastReflection_SharePriceObservation_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_SharePriceObservation) New() protoreflect.Message {
+	return new(fastReflection_SharePriceObservation)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_SharePriceObservation) Interface() protoreflect.ProtoMessage {
+	return (*SharePriceObservation)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_SharePriceObservation) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Time != nil {
+		value := protoreflect.ValueOfMessage(x.Time.ProtoReflect())
+		if !f(fd_SharePriceObservation_time, value) {
+			return
+		}
+	}
+	if x.BlockHeight != int64(0) {
+		value := protoreflect.ValueOfInt64(x.BlockHeight)
+		if !f(fd_SharePriceObservation_block_height, value) {
+			return
+		}
+	}
+	if x.Price != "" {
+		value := protoreflect.ValueOfString(x.Price)
+		if !f(fd_SharePriceObservation_price, value) {
+			return
+		}
+	}
+	if x.Cumulative != "" {
+		value := protoreflect.ValueOfString(x.Cumulative)
+		if !f(fd_SharePriceObservation_cumulative, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_SharePriceObservation) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "provlabs.vault.v1.SharePriceObservation.time":
+		return x.Time != nil
+	case "provlabs.vault.v1.SharePriceObservation.block_height":
+		return x.BlockHeight != int64(0)
+	case "provlabs.vault.v1.SharePriceObservation.price":
+		return x.Price != ""
+	case "provlabs.vault.v1.SharePriceObservation.cumulative":
+		return x.Cumulative != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: provlabs.vault.v1.SharePriceObservation"))
+		}
+		panic(fmt.Errorf("message provlabs.vault.v1.SharePriceObservation does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_SharePriceObservation) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "provlabs.vault.v1.SharePriceObservation.time":
+		x.Time = nil
+	case "provlabs.vault.v1.SharePriceObservation.block_height":
+		x.BlockHeight = int64(0)
+	case "provlabs.vault.v1.SharePriceObservation.price":
+		x.Price = ""
+	case "provlabs.vault.v1.SharePriceObservation.cumulative":
+		x.Cumulative = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: provlabs.vault.v1.SharePriceObservation"))
+		}
+		panic(fmt.Errorf("message provlabs.vault.v1.SharePriceObservation does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_SharePriceObservation) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "provlabs.vault.v1.SharePriceObservation.time":
+		value := x.Time
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "provlabs.vault.v1.SharePriceObservation.block_height":
+		value := x.BlockHeight
+		return protoreflect.ValueOfInt64(value)
+	case "provlabs.vault.v1.SharePriceObservation.price":
+		value := x.Price
+		return protoreflect.ValueOfString(value)
+	case "provlabs.vault.v1.SharePriceObservation.cumulative":
+		value := x.Cumulative
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: provlabs.vault.v1.SharePriceObservation"))
+		}
+		panic(fmt.Errorf("message provlabs.vault.v1.SharePriceObservation does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_SharePriceObservation) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "provlabs.vault.v1.SharePriceObservation.time":
+		x.Time = value.Message().Interface().(*timestamppb.Timestamp)
+	case "provlabs.vault.v1.SharePriceObservation.block_height":
+		x.BlockHeight = value.Int()
+	case "provlabs.vault.v1.SharePriceObservation.price":
+		x.Price = value.Interface().(string)
+	case "provlabs.vault.v1.SharePriceObservation.cumulative":
+		x.Cumulative = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: provlabs.vault.v1.SharePriceObservation"))
+		}
+		panic(fmt.Errorf("message provlabs.vault.v1.SharePriceObservation does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_SharePriceObservation) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "provlabs.vault.v1.SharePriceObservation.time":
+		if x.Time == nil {
+			x.Time = new(timestamppb.Timestamp)
+		}
+		return protoreflect.ValueOfMessage(x.Time.ProtoReflect())
+	case "provlabs.vault.v1.SharePriceObservation.block_height":
+		panic(fmt.Errorf("field block_height of message provlabs.vault.v1.SharePriceObservation is not mutable"))
+	case "provlabs.vault.v1.SharePriceObservation.price":
+		panic(fmt.Errorf("field price of message provlabs.vault.v1.SharePriceObservation is not mutable"))
+	case "provlabs.vault.v1.SharePriceObservation.cumulative":
+		panic(fmt.Errorf("field cumulative of message provlabs.vault.v1.SharePriceObservation is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: provlabs.vault.v1.SharePriceObservation"))
+		}
+		panic(fmt.Errorf("message provlabs.vault.v1.SharePriceObservation does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_SharePriceObservation) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "provlabs.vault.v1.SharePriceObservation.time":
+		m := new(timestamppb.Timestamp)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "provlabs.vault.v1.SharePriceObservation.block_height":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "provlabs.vault.v1.SharePriceObservation.price":
+		return protoreflect.ValueOfString("")
+	case "provlabs.vault.v1.SharePriceObservation.cumulative":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: provlabs.vault.v1.SharePriceObservation"))
+		}
+		panic(fmt.Errorf("message provlabs.vault.v1.SharePriceObservation does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_SharePriceObservation) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in provlabs.vault.v1.SharePriceObservation", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_SharePriceObservation) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_SharePriceObservation) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_SharePriceObservation) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_SharePriceObservation) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*SharePriceObservation)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.Time != nil {
+			l = options.Size(x.Time)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.BlockHeight != 0 {
+			n += 1 + runtime.Sov(uint64(x.BlockHeight))
+		}
+		l = len(x.Price)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Cumulative)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*SharePriceObservation)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.Cumulative) > 0 {
+			i -= len(x.Cumulative)
+			copy(dAtA[i:], x.Cumulative)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Cumulative)))
+			i--
+			dAtA[i] = 0x22
+		}
+		if len(x.Price) > 0 {
+			i -= len(x.Price)
+			copy(dAtA[i:], x.Price)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Price)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if x.BlockHeight != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.BlockHeight))
+			i--
+			dAtA[i] = 0x10
+		}
+		if x.Time != nil {
+			encoded, err := options.Marshal(x.Time)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*SharePriceObservation)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: SharePriceObservation: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: SharePriceObservation: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Time == nil {
+					x.Time = &timestamppb.Timestamp{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Time); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
+				}
+				x.BlockHeight = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.BlockHeight |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Price = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Cumulative", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Cumulative = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
 					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
 				}
 				iNdEx += skippy
@@ -2181,37 +10838,139 @@ func (x *fastReflection_VaultAccount) ProtoMethods() *protoiface.Methods {
 	}
 }
 
+var _ protoreflect.List = (*_OutboundPayment_3_list)(nil)
+
+type _OutboundPayment_3_list struct {
+	list *[]*v1beta11.Coin
+}
+
+func (x *_OutboundPayment_3_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_OutboundPayment_3_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_OutboundPayment_3_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta11.Coin)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_OutboundPayment_3_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta11.Coin)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_OutboundPayment_3_list) AppendMutable() protoreflect.Value {
+	v := new(v1beta11.Coin)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_OutboundPayment_3_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_OutboundPayment_3_list) NewElement() protoreflect.Value {
+	v := new(v1beta11.Coin)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_OutboundPayment_3_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_OutboundPayment_4_list)(nil)
+
+type _OutboundPayment_4_list struct {
+	list *[]*v1beta11.Coin
+}
+
+func (x *_OutboundPayment_4_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_OutboundPayment_4_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_OutboundPayment_4_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta11.Coin)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_OutboundPayment_4_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta11.Coin)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_OutboundPayment_4_list) AppendMutable() protoreflect.Value {
+	v := new(v1beta11.Coin)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_OutboundPayment_4_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_OutboundPayment_4_list) NewElement() protoreflect.Value {
+	v := new(v1beta11.Coin)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_OutboundPayment_4_list) IsValid() bool {
+	return x.list != nil
+}
+
 var (
-	md_VaultNAV                      protoreflect.MessageDescriptor
-	fd_VaultNAV_denom                protoreflect.FieldDescriptor
-	fd_VaultNAV_price                protoreflect.FieldDescriptor
-	fd_VaultNAV_volume               protoreflect.FieldDescriptor
-	fd_VaultNAV_source               protoreflect.FieldDescriptor
-	fd_VaultNAV_updated_block_height protoreflect.FieldDescriptor
-	fd_VaultNAV_updated_time         protoreflect.FieldDescriptor
+	md_OutboundPayment               protoreflect.MessageDescriptor
+	fd_OutboundPayment_external_id   protoreflect.FieldDescriptor
+	fd_OutboundPayment_target        protoreflect.FieldDescriptor
+	fd_OutboundPayment_source_amount protoreflect.FieldDescriptor
+	fd_OutboundPayment_target_amount protoreflect.FieldDescriptor
+	fd_OutboundPayment_direction     protoreflect.FieldDescriptor
+	fd_OutboundPayment_expiry_time   protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_provlabs_vault_v1_vault_proto_init()
-	md_VaultNAV = File_provlabs_vault_v1_vault_proto.Messages().ByName("VaultNAV")
-	fd_VaultNAV_denom = md_VaultNAV.Fields().ByName("denom")
-	fd_VaultNAV_price = md_VaultNAV.Fields().ByName("price")
-	fd_VaultNAV_volume = md_VaultNAV.Fields().ByName("volume")
-	fd_VaultNAV_source = md_VaultNAV.Fields().ByName("source")
-	fd_VaultNAV_updated_block_height = md_VaultNAV.Fields().ByName("updated_block_height")
-	fd_VaultNAV_updated_time = md_VaultNAV.Fields().ByName("updated_time")
+	md_OutboundPayment = File_provlabs_vault_v1_vault_proto.Messages().ByName("OutboundPayment")
+	fd_OutboundPayment_external_id = md_OutboundPayment.Fields().ByName("external_id")
+	fd_OutboundPayment_target = md_OutboundPayment.Fields().ByName("target")
+	fd_OutboundPayment_source_amount = md_OutboundPayment.Fields().ByName("source_amount")
+	fd_OutboundPayment_target_amount = md_OutboundPayment.Fields().ByName("target_amount")
+	fd_OutboundPayment_direction = md_OutboundPayment.Fields().ByName("direction")
+	fd_OutboundPayment_expiry_time = md_OutboundPayment.Fields().ByName("expiry_time")
 }
 
-var _ protoreflect.Message = (*fastReflection_VaultNAV)(nil)
+var _ protoreflect.Message = (*fastReflection_OutboundPayment)(nil)
 
-type fastReflection_VaultNAV VaultNAV
+type fastReflection_OutboundPayment OutboundPayment
 
-func (x *VaultNAV) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_VaultNAV)(x)
+func (x *OutboundPayment) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_OutboundPayment)(x)
 }
 
-func (x *VaultNAV) slowProtoReflect() protoreflect.Message {
-	mi := &file_provlabs_vault_v1_vault_proto_msgTypes[1]
+func (x *OutboundPayment) slowProtoReflect() protoreflect.Message {
+	mi := &file_provlabs_vault_v1_vault_proto_msgTypes[13]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2222,43 +10981,43 @@ func (x *VaultNAV) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_VaultNAV_messageType fastReflection_VaultNAV_messageType
-var _ protoreflect.MessageType = fastReflection_VaultNAV_messageType{}
+var _fastReflection_OutboundPayment_messageType fastReflection_OutboundPayment_messageType
+var _ protoreflect.MessageType = fastReflection_OutboundPayment_messageType{}
 
-type fastReflection_VaultNAV_messageType struct{}
+type fastReflection_OutboundPayment_messageType struct{}
 
-func (x fastReflection_VaultNAV_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_VaultNAV)(nil)
+func (x fastReflection_OutboundPayment_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_OutboundPayment)(nil)
 }
-func (x fastReflection_VaultNAV_messageType) New() protoreflect.Message {
-	return new(fastReflection_VaultNAV)
+func (x fastReflection_OutboundPayment_messageType) New() protoreflect.Message {
+	return new(fastReflection_OutboundPayment)
 }
-func (x fastReflection_VaultNAV_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_VaultNAV
+func (x fastReflection_OutboundPayment_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_OutboundPayment
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
-// type information for the message.
-func (x *fastReflection_VaultNAV) Descriptor() protoreflect.MessageDescriptor {
-	return md_VaultNAV
+// type information for the message.
+func (x *fastReflection_OutboundPayment) Descriptor() protoreflect.MessageDescriptor {
+	return md_OutboundPayment
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_VaultNAV) Type() protoreflect.MessageType {
-	return _fastReflection_VaultNAV_messageType
+func (x *fastReflection_OutboundPayment) Type() protoreflect.MessageType {
+	return _fastReflection_OutboundPayment_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_VaultNAV) New() protoreflect.Message {
-	return new(fastReflection_VaultNAV)
+func (x *fastReflection_OutboundPayment) New() protoreflect.Message {
+	return new(fastReflection_OutboundPayment)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_VaultNAV) Interface() protoreflect.ProtoMessage {
-	return (*VaultNAV)(x)
+func (x *fastReflection_OutboundPayment) Interface() protoreflect.ProtoMessage {
+	return (*OutboundPayment)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -2266,40 +11025,40 @@ func (x *fastReflection_VaultNAV) Interface() protoreflect.ProtoMessage {
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_VaultNAV) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.Denom != "" {
-		value := protoreflect.ValueOfString(x.Denom)
-		if !f(fd_VaultNAV_denom, value) {
+func (x *fastReflection_OutboundPayment) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.ExternalId != "" {
+		value := protoreflect.ValueOfString(x.ExternalId)
+		if !f(fd_OutboundPayment_external_id, value) {
 			return
 		}
 	}
-	if x.Price != nil {
-		value := protoreflect.ValueOfMessage(x.Price.ProtoReflect())
-		if !f(fd_VaultNAV_price, value) {
+	if x.Target != "" {
+		value := protoreflect.ValueOfString(x.Target)
+		if !f(fd_OutboundPayment_target, value) {
 			return
 		}
 	}
-	if x.Volume != "" {
-		value := protoreflect.ValueOfString(x.Volume)
-		if !f(fd_VaultNAV_volume, value) {
+	if len(x.SourceAmount) != 0 {
+		value := protoreflect.ValueOfList(&_OutboundPayment_3_list{list: &x.SourceAmount})
+		if !f(fd_OutboundPayment_source_amount, value) {
 			return
 		}
 	}
-	if x.Source != "" {
-		value := protoreflect.ValueOfString(x.Source)
-		if !f(fd_VaultNAV_source, value) {
+	if len(x.TargetAmount) != 0 {
+		value := protoreflect.ValueOfList(&_OutboundPayment_4_list{list: &x.TargetAmount})
+		if !f(fd_OutboundPayment_target_amount, value) {
 			return
 		}
 	}
-	if x.UpdatedBlockHeight != int64(0) {
-		value := protoreflect.ValueOfInt64(x.UpdatedBlockHeight)
-		if !f(fd_VaultNAV_updated_block_height, value) {
+	if x.Direction != "" {
+		value := protoreflect.ValueOfString(x.Direction)
+		if !f(fd_OutboundPayment_direction, value) {
 			return
 		}
 	}
-	if x.UpdatedTime != nil {
-		value := protoreflect.ValueOfMessage(x.UpdatedTime.ProtoReflect())
-		if !f(fd_VaultNAV_updated_time, value) {
+	if x.ExpiryTime != nil {
+		value := protoreflect.ValueOfMessage(x.ExpiryTime.ProtoReflect())
+		if !f(fd_OutboundPayment_expiry_time, value) {
 			return
 		}
 	}
@@ -2316,25 +11075,25 @@ func (x *fastReflection_VaultNAV) Range(f func(protoreflect.FieldDescriptor, pro
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_VaultNAV) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_OutboundPayment) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "provlabs.vault.v1.VaultNAV.denom":
-		return x.Denom != ""
-	case "provlabs.vault.v1.VaultNAV.price":
-		return x.Price != nil
-	case "provlabs.vault.v1.VaultNAV.volume":
-		return x.Volume != ""
-	case "provlabs.vault.v1.VaultNAV.source":
-		return x.Source != ""
-	case "provlabs.vault.v1.VaultNAV.updated_block_height":
-		return x.UpdatedBlockHeight != int64(0)
-	case "provlabs.vault.v1.VaultNAV.updated_time":
-		return x.UpdatedTime != nil
+	case "provlabs.vault.v1.OutboundPayment.external_id":
+		return x.ExternalId != ""
+	case "provlabs.vault.v1.OutboundPayment.target":
+		return x.Target != ""
+	case "provlabs.vault.v1.OutboundPayment.source_amount":
+		return len(x.SourceAmount) != 0
+	case "provlabs.vault.v1.OutboundPayment.target_amount":
+		return len(x.TargetAmount) != 0
+	case "provlabs.vault.v1.OutboundPayment.direction":
+		return x.Direction != ""
+	case "provlabs.vault.v1.OutboundPayment.expiry_time":
+		return x.ExpiryTime != nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: provlabs.vault.v1.VaultNAV"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: provlabs.vault.v1.OutboundPayment"))
 		}
-		panic(fmt.Errorf("message provlabs.vault.v1.VaultNAV does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message provlabs.vault.v1.OutboundPayment does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -2344,25 +11103,25 @@ func (x *fastReflection_VaultNAV) Has(fd protoreflect.FieldDescriptor) bool {
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_VaultNAV) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_OutboundPayment) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "provlabs.vault.v1.VaultNAV.denom":
-		x.Denom = ""
-	case "provlabs.vault.v1.VaultNAV.price":
-		x.Price = nil
-	case "provlabs.vault.v1.VaultNAV.volume":
-		x.Volume = ""
-	case "provlabs.vault.v1.VaultNAV.source":
-		x.Source = ""
-	case "provlabs.vault.v1.VaultNAV.updated_block_height":
-		x.UpdatedBlockHeight = int64(0)
-	case "provlabs.vault.v1.VaultNAV.updated_time":
-		x.UpdatedTime = nil
+	case "provlabs.vault.v1.OutboundPayment.external_id":
+		x.ExternalId = ""
+	case "provlabs.vault.v1.OutboundPayment.target":
+		x.Target = ""
+	case "provlabs.vault.v1.OutboundPayment.source_amount":
+		x.SourceAmount = nil
+	case "provlabs.vault.v1.OutboundPayment.target_amount":
+		x.TargetAmount = nil
+	case "provlabs.vault.v1.OutboundPayment.direction":
+		x.Direction = ""
+	case "provlabs.vault.v1.OutboundPayment.expiry_time":
+		x.ExpiryTime = nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: provlabs.vault.v1.VaultNAV"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: provlabs.vault.v1.OutboundPayment"))
 		}
-		panic(fmt.Errorf("message provlabs.vault.v1.VaultNAV does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message provlabs.vault.v1.OutboundPayment does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -2372,31 +11131,37 @@ func (x *fastReflection_VaultNAV) Clear(fd protoreflect.FieldDescriptor) {
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_VaultNAV) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_OutboundPayment) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "provlabs.vault.v1.VaultNAV.denom":
-		value := x.Denom
+	case "provlabs.vault.v1.OutboundPayment.external_id":
+		value := x.ExternalId
 		return protoreflect.ValueOfString(value)
-	case "provlabs.vault.v1.VaultNAV.price":
-		value := x.Price
-		return protoreflect.ValueOfMessage(value.ProtoReflect())
-	case "provlabs.vault.v1.VaultNAV.volume":
-		value := x.Volume
+	case "provlabs.vault.v1.OutboundPayment.target":
+		value := x.Target
 		return protoreflect.ValueOfString(value)
-	case "provlabs.vault.v1.VaultNAV.source":
-		value := x.Source
+	case "provlabs.vault.v1.OutboundPayment.source_amount":
+		if len(x.SourceAmount) == 0 {
+			return protoreflect.ValueOfList(&_OutboundPayment_3_list{})
+		}
+		listValue := &_OutboundPayment_3_list{list: &x.SourceAmount}
+		return protoreflect.ValueOfList(listValue)
+	case "provlabs.vault.v1.OutboundPayment.target_amount":
+		if len(x.TargetAmount) == 0 {
+			return protoreflect.ValueOfList(&_OutboundPayment_4_list{})
+		}
+		listValue := &_OutboundPayment_4_list{list: &x.TargetAmount}
+		return protoreflect.ValueOfList(listValue)
+	case "provlabs.vault.v1.OutboundPayment.direction":
+		value := x.Direction
 		return protoreflect.ValueOfString(value)
-	case "provlabs.vault.v1.VaultNAV.updated_block_height":
-		value := x.UpdatedBlockHeight
-		return protoreflect.ValueOfInt64(value)
-	case "provlabs.vault.v1.VaultNAV.updated_time":
-		value := x.UpdatedTime
+	case "provlabs.vault.v1.OutboundPayment.expiry_time":
+		value := x.ExpiryTime
 		return protoreflect.ValueOfMessage(value.ProtoReflect())
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: provlabs.vault.v1.VaultNAV"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: provlabs.vault.v1.OutboundPayment"))
 		}
-		panic(fmt.Errorf("message provlabs.vault.v1.VaultNAV does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message provlabs.vault.v1.OutboundPayment does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -2410,25 +11175,29 @@ func (x *fastReflection_VaultNAV) Get(descriptor protoreflect.FieldDescriptor) p
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_VaultNAV) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_OutboundPayment) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "provlabs.vault.v1.VaultNAV.denom":
-		x.Denom = value.Interface().(string)
-	case "provlabs.vault.v1.VaultNAV.price":
-		x.Price = value.Message().Interface().(*v1beta11.Coin)
-	case "provlabs.vault.v1.VaultNAV.volume":
-		x.Volume = value.Interface().(string)
-	case "provlabs.vault.v1.VaultNAV.source":
-		x.Source = value.Interface().(string)
-	case "provlabs.vault.v1.VaultNAV.updated_block_height":
-		x.UpdatedBlockHeight = value.Int()
-	case "provlabs.vault.v1.VaultNAV.updated_time":
-		x.UpdatedTime = value.Message().Interface().(*timestamppb.Timestamp)
+	case "provlabs.vault.v1.OutboundPayment.external_id":
+		x.ExternalId = value.Interface().(string)
+	case "provlabs.vault.v1.OutboundPayment.target":
+		x.Target = value.Interface().(string)
+	case "provlabs.vault.v1.OutboundPayment.source_amount":
+		lv := value.List()
+		clv := lv.(*_OutboundPayment_3_list)
+		x.SourceAmount = *clv.list
+	case "provlabs.vault.v1.OutboundPayment.target_amount":
+		lv := value.List()
+		clv := lv.(*_OutboundPayment_4_list)
+		x.TargetAmount = *clv.list
+	case "provlabs.vault.v1.OutboundPayment.direction":
+		x.Direction = value.Interface().(string)
+	case "provlabs.vault.v1.OutboundPayment.expiry_time":
+		x.ExpiryTime = value.Message().Interface().(*timestamppb.Timestamp)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: provlabs.vault.v1.VaultNAV"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: provlabs.vault.v1.OutboundPayment"))
 		}
-		panic(fmt.Errorf("message provlabs.vault.v1.VaultNAV does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message provlabs.vault.v1.OutboundPayment does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -2442,68 +11211,74 @@ func (x *fastReflection_VaultNAV) Set(fd protoreflect.FieldDescriptor, value pro
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_VaultNAV) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_OutboundPayment) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "provlabs.vault.v1.VaultNAV.price":
-		if x.Price == nil {
-			x.Price = new(v1beta11.Coin)
+	case "provlabs.vault.v1.OutboundPayment.source_amount":
+		if x.SourceAmount == nil {
+			x.SourceAmount = []*v1beta11.Coin{}
 		}
-		return protoreflect.ValueOfMessage(x.Price.ProtoReflect())
-	case "provlabs.vault.v1.VaultNAV.updated_time":
-		if x.UpdatedTime == nil {
-			x.UpdatedTime = new(timestamppb.Timestamp)
+		value := &_OutboundPayment_3_list{list: &x.SourceAmount}
+		return protoreflect.ValueOfList(value)
+	case "provlabs.vault.v1.OutboundPayment.target_amount":
+		if x.TargetAmount == nil {
+			x.TargetAmount = []*v1beta11.Coin{}
 		}
-		return protoreflect.ValueOfMessage(x.UpdatedTime.ProtoReflect())
-	case "provlabs.vault.v1.VaultNAV.denom":
-		panic(fmt.Errorf("field denom of message provlabs.vault.v1.VaultNAV is not mutable"))
-	case "provlabs.vault.v1.VaultNAV.volume":
-		panic(fmt.Errorf("field volume of message provlabs.vault.v1.VaultNAV is not mutable"))
-	case "provlabs.vault.v1.VaultNAV.source":
-		panic(fmt.Errorf("field source of message provlabs.vault.v1.VaultNAV is not mutable"))
-	case "provlabs.vault.v1.VaultNAV.updated_block_height":
-		panic(fmt.Errorf("field updated_block_height of message provlabs.vault.v1.VaultNAV is not mutable"))
+		value := &_OutboundPayment_4_list{list: &x.TargetAmount}
+		return protoreflect.ValueOfList(value)
+	case "provlabs.vault.v1.OutboundPayment.expiry_time":
+		if x.ExpiryTime == nil {
+			x.ExpiryTime = new(timestamppb.Timestamp)
+		}
+		return protoreflect.ValueOfMessage(x.ExpiryTime.ProtoReflect())
+	case "provlabs.vault.v1.OutboundPayment.external_id":
+		panic(fmt.Errorf("field external_id of message provlabs.vault.v1.OutboundPayment is not mutable"))
+	case "provlabs.vault.v1.OutboundPayment.target":
+		panic(fmt.Errorf("field target of message provlabs.vault.v1.OutboundPayment is not mutable"))
+	case "provlabs.vault.v1.OutboundPayment.direction":
+		panic(fmt.Errorf("field direction of message provlabs.vault.v1.OutboundPayment is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: provlabs.vault.v1.VaultNAV"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: provlabs.vault.v1.OutboundPayment"))
 		}
-		panic(fmt.Errorf("message provlabs.vault.v1.VaultNAV does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message provlabs.vault.v1.OutboundPayment does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_VaultNAV) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_OutboundPayment) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "provlabs.vault.v1.VaultNAV.denom":
+	case "provlabs.vault.v1.OutboundPayment.external_id":
 		return protoreflect.ValueOfString("")
-	case "provlabs.vault.v1.VaultNAV.price":
-		m := new(v1beta11.Coin)
-		return protoreflect.ValueOfMessage(m.ProtoReflect())
-	case "provlabs.vault.v1.VaultNAV.volume":
+	case "provlabs.vault.v1.OutboundPayment.target":
 		return protoreflect.ValueOfString("")
-	case "provlabs.vault.v1.VaultNAV.source":
+	case "provlabs.vault.v1.OutboundPayment.source_amount":
+		list := []*v1beta11.Coin{}
+		return protoreflect.ValueOfList(&_OutboundPayment_3_list{list: &list})
+	case "provlabs.vault.v1.OutboundPayment.target_amount":
+		list := []*v1beta11.Coin{}
+		return protoreflect.ValueOfList(&_OutboundPayment_4_list{list: &list})
+	case "provlabs.vault.v1.OutboundPayment.direction":
 		return protoreflect.ValueOfString("")
-	case "provlabs.vault.v1.VaultNAV.updated_block_height":
-		return protoreflect.ValueOfInt64(int64(0))
-	case "provlabs.vault.v1.VaultNAV.updated_time":
+	case "provlabs.vault.v1.OutboundPayment.expiry_time":
 		m := new(timestamppb.Timestamp)
 		return protoreflect.ValueOfMessage(m.ProtoReflect())
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: provlabs.vault.v1.VaultNAV"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: provlabs.vault.v1.OutboundPayment"))
 		}
-		panic(fmt.Errorf("message provlabs.vault.v1.VaultNAV does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message provlabs.vault.v1.OutboundPayment does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_VaultNAV) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_OutboundPayment) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in provlabs.vault.v1.VaultNAV", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in provlabs.vault.v1.OutboundPayment", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -2511,7 +11286,7 @@ func (x *fastReflection_VaultNAV) WhichOneof(d protoreflect.OneofDescriptor) pro
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_VaultNAV) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_OutboundPayment) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -2522,7 +11297,7 @@ func (x *fastReflection_VaultNAV) GetUnknown() protoreflect.RawFields {
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_VaultNAV) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_OutboundPayment) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -2534,7 +11309,7 @@ func (x *fastReflection_VaultNAV) SetUnknown(fields protoreflect.RawFields) {
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_VaultNAV) IsValid() bool {
+func (x *fastReflection_OutboundPayment) IsValid() bool {
 	return x != nil
 }
 
@@ -2544,9 +11319,9 @@ func (x *fastReflection_VaultNAV) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_VaultNAV) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_OutboundPayment) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*VaultNAV)
+		x := input.Message.Interface().(*OutboundPayment)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -2558,27 +11333,32 @@ func (x *fastReflection_VaultNAV) ProtoMethods() *protoiface.Methods {
 		var n int
 		var l int
 		_ = l
-		l = len(x.Denom)
+		l = len(x.ExternalId)
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		if x.Price != nil {
-			l = options.Size(x.Price)
-			n += 1 + l + runtime.Sov(uint64(l))
-		}
-		l = len(x.Volume)
+		l = len(x.Target)
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		l = len(x.Source)
+		if len(x.SourceAmount) > 0 {
+			for _, e := range x.SourceAmount {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.TargetAmount) > 0 {
+			for _, e := range x.TargetAmount {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		l = len(x.Direction)
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		if x.UpdatedBlockHeight != 0 {
-			n += 1 + runtime.Sov(uint64(x.UpdatedBlockHeight))
-		}
-		if x.UpdatedTime != nil {
-			l = options.Size(x.UpdatedTime)
+		if x.ExpiryTime != nil {
+			l = options.Size(x.ExpiryTime)
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
 		if x.unknownFields != nil {
@@ -2591,7 +11371,7 @@ func (x *fastReflection_VaultNAV) ProtoMethods() *protoiface.Methods {
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*VaultNAV)
+		x := input.Message.Interface().(*OutboundPayment)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -2610,8 +11390,8 @@ func (x *fastReflection_VaultNAV) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if x.UpdatedTime != nil {
-			encoded, err := options.Marshal(x.UpdatedTime)
+		if x.ExpiryTime != nil {
+			encoded, err := options.Marshal(x.ExpiryTime)
 			if err != nil {
 				return protoiface.MarshalOutput{
 					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -2624,43 +11404,56 @@ func (x *fastReflection_VaultNAV) ProtoMethods() *protoiface.Methods {
 			i--
 			dAtA[i] = 0x32
 		}
-		if x.UpdatedBlockHeight != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.UpdatedBlockHeight))
-			i--
-			dAtA[i] = 0x28
-		}
-		if len(x.Source) > 0 {
-			i -= len(x.Source)
-			copy(dAtA[i:], x.Source)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Source)))
+		if len(x.Direction) > 0 {
+			i -= len(x.Direction)
+			copy(dAtA[i:], x.Direction)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Direction)))
 			i--
-			dAtA[i] = 0x22
+			dAtA[i] = 0x2a
 		}
-		if len(x.Volume) > 0 {
-			i -= len(x.Volume)
-			copy(dAtA[i:], x.Volume)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Volume)))
-			i--
-			dAtA[i] = 0x1a
+		if len(x.TargetAmount) > 0 {
+			for iNdEx := len(x.TargetAmount) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.TargetAmount[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x22
+			}
 		}
-		if x.Price != nil {
-			encoded, err := options.Marshal(x.Price)
-			if err != nil {
-				return protoiface.MarshalOutput{
-					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-					Buf:               input.Buf,
-				}, err
+		if len(x.SourceAmount) > 0 {
+			for iNdEx := len(x.SourceAmount) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.SourceAmount[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x1a
 			}
-			i -= len(encoded)
-			copy(dAtA[i:], encoded)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+		}
+		if len(x.Target) > 0 {
+			i -= len(x.Target)
+			copy(dAtA[i:], x.Target)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Target)))
 			i--
 			dAtA[i] = 0x12
 		}
-		if len(x.Denom) > 0 {
-			i -= len(x.Denom)
-			copy(dAtA[i:], x.Denom)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Denom)))
+		if len(x.ExternalId) > 0 {
+			i -= len(x.ExternalId)
+			copy(dAtA[i:], x.ExternalId)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ExternalId)))
 			i--
 			dAtA[i] = 0xa
 		}
@@ -2675,7 +11468,7 @@ func (x *fastReflection_VaultNAV) ProtoMethods() *protoiface.Methods {
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*VaultNAV)
+		x := input.Message.Interface().(*OutboundPayment)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -2707,15 +11500,15 @@ func (x *fastReflection_VaultNAV) ProtoMethods() *protoiface.Methods {
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: VaultNAV: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: OutboundPayment: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: VaultNAV: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: OutboundPayment: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ExternalId", wireType)
 				}
 				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
@@ -2743,11 +11536,43 @@ func (x *fastReflection_VaultNAV) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.Denom = string(dAtA[iNdEx:postIndex])
+				x.ExternalId = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
 			case 2:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Target", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Target = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SourceAmount", wireType)
 				}
 				var msglen int
 				for shift := uint(0); ; shift += 7 {
@@ -2774,18 +11599,16 @@ func (x *fastReflection_VaultNAV) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				if x.Price == nil {
-					x.Price = &v1beta11.Coin{}
-				}
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Price); err != nil {
+				x.SourceAmount = append(x.SourceAmount, &v1beta11.Coin{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.SourceAmount[len(x.SourceAmount)-1]); err != nil {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
-			case 3:
+			case 4:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Volume", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TargetAmount", wireType)
 				}
-				var stringLen uint64
+				var msglen int
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -2795,27 +11618,29 @@ func (x *fastReflection_VaultNAV) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
+					msglen |= int(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
+				if msglen < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				postIndex := iNdEx + intStringLen
+				postIndex := iNdEx + msglen
 				if postIndex < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.Volume = string(dAtA[iNdEx:postIndex])
+				x.TargetAmount = append(x.TargetAmount, &v1beta11.Coin{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.TargetAmount[len(x.TargetAmount)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
 				iNdEx = postIndex
-			case 4:
+			case 5:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Source", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Direction", wireType)
 				}
 				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
@@ -2843,30 +11668,11 @@ func (x *fastReflection_VaultNAV) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.Source = string(dAtA[iNdEx:postIndex])
+				x.Direction = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
-			case 5:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field UpdatedBlockHeight", wireType)
-				}
-				x.UpdatedBlockHeight = 0
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					x.UpdatedBlockHeight |= int64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
 			case 6:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field UpdatedTime", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ExpiryTime", wireType)
 				}
 				var msglen int
 				for shift := uint(0); ; shift += 7 {
@@ -2893,10 +11699,10 @@ func (x *fastReflection_VaultNAV) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				if x.UpdatedTime == nil {
-					x.UpdatedTime = &timestamppb.Timestamp{}
+				if x.ExpiryTime == nil {
+					x.ExpiryTime = &timestamppb.Timestamp{}
 				}
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.UpdatedTime); err != nil {
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.ExpiryTime); err != nil {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
@@ -2935,1802 +11741,1582 @@ func (x *fastReflection_VaultNAV) ProtoMethods() *protoiface.Methods {
 	}
 }
 
-var _ protoreflect.List = (*_AccountBalance_2_list)(nil)
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.27.0
+// 	protoc        (unknown)
+// source: provlabs/vault/v1/vault.proto
 
-type _AccountBalance_2_list struct {
-	list *[]*v1beta11.Coin
-}
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
 
-func (x *_AccountBalance_2_list) Len() int {
-	if x.list == nil {
-		return 0
+// NAVAggregation selects how a vault combines its fresh NAV source submissions into the
+// price used for valuation.
+type NAVAggregation int32
+
+const (
+	// NAV_AGGREGATION_MEDIAN uses the median per-unit price. With an even number of fresh
+	// submissions it is the mean of the two middle prices.
+	NAVAggregation_NAV_AGGREGATION_MEDIAN NAVAggregation = 0
+	// NAV_AGGREGATION_MIN uses the lowest per-unit price, for conservative valuation.
+	NAVAggregation_NAV_AGGREGATION_MIN NAVAggregation = 1
+)
+
+// Enum value maps for NAVAggregation.
+var (
+	NAVAggregation_name = map[int32]string{
+		0: "NAV_AGGREGATION_MEDIAN",
+		1: "NAV_AGGREGATION_MIN",
 	}
-	return len(*x.list)
-}
+	NAVAggregation_value = map[string]int32{
+		"NAV_AGGREGATION_MEDIAN": 0,
+		"NAV_AGGREGATION_MIN":    1,
+	}
+)
 
-func (x *_AccountBalance_2_list) Get(i int) protoreflect.Value {
-	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+func (x NAVAggregation) Enum() *NAVAggregation {
+	p := new(NAVAggregation)
+	*p = x
+	return p
 }
 
-func (x *_AccountBalance_2_list) Set(i int, value protoreflect.Value) {
-	valueUnwrapped := value.Message()
-	concreteValue := valueUnwrapped.Interface().(*v1beta11.Coin)
-	(*x.list)[i] = concreteValue
+func (x NAVAggregation) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
 }
 
-func (x *_AccountBalance_2_list) Append(value protoreflect.Value) {
-	valueUnwrapped := value.Message()
-	concreteValue := valueUnwrapped.Interface().(*v1beta11.Coin)
-	*x.list = append(*x.list, concreteValue)
+func (NAVAggregation) Descriptor() protoreflect.EnumDescriptor {
+	return file_provlabs_vault_v1_vault_proto_enumTypes[0].Descriptor()
 }
 
-func (x *_AccountBalance_2_list) AppendMutable() protoreflect.Value {
-	v := new(v1beta11.Coin)
-	*x.list = append(*x.list, v)
-	return protoreflect.ValueOfMessage(v.ProtoReflect())
+func (NAVAggregation) Type() protoreflect.EnumType {
+	return &file_provlabs_vault_v1_vault_proto_enumTypes[0]
 }
 
-func (x *_AccountBalance_2_list) Truncate(n int) {
-	for i := n; i < len(*x.list); i++ {
-		(*x.list)[i] = nil
-	}
-	*x.list = (*x.list)[:n]
+func (x NAVAggregation) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
 }
 
-func (x *_AccountBalance_2_list) NewElement() protoreflect.Value {
-	v := new(v1beta11.Coin)
-	return protoreflect.ValueOfMessage(v.ProtoReflect())
+// Deprecated: Use NAVAggregation.Descriptor instead.
+func (NAVAggregation) EnumDescriptor() ([]byte, []int) {
+	return file_provlabs_vault_v1_vault_proto_rawDescGZIP(), []int{0}
 }
 
-func (x *_AccountBalance_2_list) IsValid() bool {
-	return x.list != nil
-}
+// PriceSourceType identifies a source a vault may consult to price a held denom.
+type PriceSourceType int32
+
+const (
+	// PRICE_SOURCE_TYPE_INTERNAL_NAV is the vault's internal NAV table, including the
+	// aggregate of its NAV sources once their quorum is met.
+	PriceSourceType_PRICE_SOURCE_TYPE_INTERNAL_NAV PriceSourceType = 0
+	// PRICE_SOURCE_TYPE_MARKER_NAV is the denom's marker-module net asset value in the
+	// vault's underlying asset.
+	PriceSourceType_PRICE_SOURCE_TYPE_MARKER_NAV PriceSourceType = 1
+	// PRICE_SOURCE_TYPE_EXCHANGE_SETTLEMENT is the price of the most recent vault settlement
+	// of the denom against the vault's underlying asset through the exchange module.
+	PriceSourceType_PRICE_SOURCE_TYPE_EXCHANGE_SETTLEMENT PriceSourceType = 2
+)
 
+// Enum value maps for PriceSourceType.
 var (
-	md_AccountBalance         protoreflect.MessageDescriptor
-	fd_AccountBalance_address protoreflect.FieldDescriptor
-	fd_AccountBalance_coins   protoreflect.FieldDescriptor
+	PriceSourceType_name = map[int32]string{
+		0: "PRICE_SOURCE_TYPE_INTERNAL_NAV",
+		1: "PRICE_SOURCE_TYPE_MARKER_NAV",
+		2: "PRICE_SOURCE_TYPE_EXCHANGE_SETTLEMENT",
+	}
+	PriceSourceType_value = map[string]int32{
+		"PRICE_SOURCE_TYPE_INTERNAL_NAV":        0,
+		"PRICE_SOURCE_TYPE_MARKER_NAV":          1,
+		"PRICE_SOURCE_TYPE_EXCHANGE_SETTLEMENT": 2,
+	}
 )
 
-func init() {
-	file_provlabs_vault_v1_vault_proto_init()
-	md_AccountBalance = File_provlabs_vault_v1_vault_proto.Messages().ByName("AccountBalance")
-	fd_AccountBalance_address = md_AccountBalance.Fields().ByName("address")
-	fd_AccountBalance_coins = md_AccountBalance.Fields().ByName("coins")
+func (x PriceSourceType) Enum() *PriceSourceType {
+	p := new(PriceSourceType)
+	*p = x
+	return p
 }
 
-var _ protoreflect.Message = (*fastReflection_AccountBalance)(nil)
+func (x PriceSourceType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
 
-type fastReflection_AccountBalance AccountBalance
+func (PriceSourceType) Descriptor() protoreflect.EnumDescriptor {
+	return file_provlabs_vault_v1_vault_proto_enumTypes[1].Descriptor()
+}
 
-func (x *AccountBalance) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_AccountBalance)(x)
+func (PriceSourceType) Type() protoreflect.EnumType {
+	return &file_provlabs_vault_v1_vault_proto_enumTypes[1]
 }
 
-func (x *AccountBalance) slowProtoReflect() protoreflect.Message {
-	mi := &file_provlabs_vault_v1_vault_proto_msgTypes[2]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
+func (x PriceSourceType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
 }
 
-var _fastReflection_AccountBalance_messageType fastReflection_AccountBalance_messageType
-var _ protoreflect.MessageType = fastReflection_AccountBalance_messageType{}
+// Deprecated: Use PriceSourceType.Descriptor instead.
+func (PriceSourceType) EnumDescriptor() ([]byte, []int) {
+	return file_provlabs_vault_v1_vault_proto_rawDescGZIP(), []int{1}
+}
 
-type fastReflection_AccountBalance_messageType struct{}
+// VaultRole is an operational role on a vault. Each role authorizes a fixed set of
+// messages, so operations staff can hold only the keys their job needs.
+type VaultRole int32
 
-func (x fastReflection_AccountBalance_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_AccountBalance)(nil)
-}
-func (x fastReflection_AccountBalance_messageType) New() protoreflect.Message {
-	return new(fastReflection_AccountBalance)
+const (
+	// VAULT_ROLE_UNSPECIFIED is not a role and cannot be granted.
+	VaultRole_VAULT_ROLE_UNSPECIFIED VaultRole = 0
+	// VAULT_ROLE_PAUSER may pause and unpause the vault.
+	VaultRole_VAULT_ROLE_PAUSER VaultRole = 1
+	// VAULT_ROLE_RATE_SETTER may change the vault's interest rate within its bounds.
+	VaultRole_VAULT_ROLE_RATE_SETTER VaultRole = 2
+	// VAULT_ROLE_LIMITS_MANAGER may change the withdrawal delay and swap limits, and expedite
+	// queued swap-outs.
+	VaultRole_VAULT_ROLE_LIMITS_MANAGER VaultRole = 3
+	// VAULT_ROLE_RESERVE_FUNDER may deposit and withdraw interest reserves and principal funds.
+	VaultRole_VAULT_ROLE_RESERVE_FUNDER VaultRole = 4
+	// VAULT_ROLE_NAV_AUTHORITY may write the vault's internal NAV table and asset haircuts.
+	VaultRole_VAULT_ROLE_NAV_AUTHORITY VaultRole = 5
+	// VAULT_ROLE_SETTLEMENT_MANAGER may settle, reject and offer asset trades, and collect
+	// asset income.
+	VaultRole_VAULT_ROLE_SETTLEMENT_MANAGER VaultRole = 6
+	// VAULT_ROLE_GUARDIAN may cancel the vault's scheduled actions before they execute.
+	VaultRole_VAULT_ROLE_GUARDIAN VaultRole = 7
+)
+
+// Enum value maps for VaultRole.
+var (
+	VaultRole_name = map[int32]string{
+		0: "VAULT_ROLE_UNSPECIFIED",
+		1: "VAULT_ROLE_PAUSER",
+		2: "VAULT_ROLE_RATE_SETTER",
+		3: "VAULT_ROLE_LIMITS_MANAGER",
+		4: "VAULT_ROLE_RESERVE_FUNDER",
+		5: "VAULT_ROLE_NAV_AUTHORITY",
+		6: "VAULT_ROLE_SETTLEMENT_MANAGER",
+		7: "VAULT_ROLE_GUARDIAN",
+	}
+	VaultRole_value = map[string]int32{
+		"VAULT_ROLE_UNSPECIFIED":        0,
+		"VAULT_ROLE_PAUSER":             1,
+		"VAULT_ROLE_RATE_SETTER":        2,
+		"VAULT_ROLE_LIMITS_MANAGER":     3,
+		"VAULT_ROLE_RESERVE_FUNDER":     4,
+		"VAULT_ROLE_NAV_AUTHORITY":      5,
+		"VAULT_ROLE_SETTLEMENT_MANAGER": 6,
+		"VAULT_ROLE_GUARDIAN":           7,
+	}
+)
+
+func (x VaultRole) Enum() *VaultRole {
+	p := new(VaultRole)
+	*p = x
+	return p
 }
-func (x fastReflection_AccountBalance_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_AccountBalance
+
+func (x VaultRole) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
 }
 
-// Descriptor returns message descriptor, which contains only the protobuf
-// type information for the message.
-func (x *fastReflection_AccountBalance) Descriptor() protoreflect.MessageDescriptor {
-	return md_AccountBalance
+func (VaultRole) Descriptor() protoreflect.EnumDescriptor {
+	return file_provlabs_vault_v1_vault_proto_enumTypes[2].Descriptor()
 }
 
-// Type returns the message type, which encapsulates both Go and protobuf
-// type information. If the Go type information is not needed,
-// it is recommended that the message descriptor be used instead.
-func (x *fastReflection_AccountBalance) Type() protoreflect.MessageType {
-	return _fastReflection_AccountBalance_messageType
+func (VaultRole) Type() protoreflect.EnumType {
+	return &file_provlabs_vault_v1_vault_proto_enumTypes[2]
 }
 
-// New returns a newly allocated and mutable empty message.
-func (x *fastReflection_AccountBalance) New() protoreflect.Message {
-	return new(fastReflection_AccountBalance)
+func (x VaultRole) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
 }
 
-// Interface unwraps the message reflection interface and
-// returns the underlying ProtoMessage interface.
-func (x *fastReflection_AccountBalance) Interface() protoreflect.ProtoMessage {
-	return (*AccountBalance)(x)
+// Deprecated: Use VaultRole.Descriptor instead.
+func (VaultRole) EnumDescriptor() ([]byte, []int) {
+	return file_provlabs_vault_v1_vault_proto_rawDescGZIP(), []int{2}
 }
 
-// Range iterates over every populated field in an undefined order,
-// calling f for each field descriptor and value encountered.
-// Range returns immediately if f returns false.
-// While iterating, mutating operations may only be performed
-// on the current field descriptor.
-func (x *fastReflection_AccountBalance) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.Address != "" {
-		value := protoreflect.ValueOfString(x.Address)
-		if !f(fd_AccountBalance_address, value) {
-			return
-		}
-	}
-	if len(x.Coins) != 0 {
-		value := protoreflect.ValueOfList(&_AccountBalance_2_list{list: &x.Coins})
-		if !f(fd_AccountBalance_coins, value) {
-			return
-		}
-	}
+// VaultAccount represents a central holding place for assets, governed by a set of rules.
+// It is based on the ERC-4626 standard and builds upon the Provenance Marker module.
+type VaultAccount struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// base_account cosmos account information including address and coin holdings.
+	BaseAccount *v1beta1.BaseAccount `protobuf:"bytes,1,opt,name=base_account,json=baseAccount,proto3" json:"base_account,omitempty"`
+	// total_shares is the total number of shares that have ever been issued by the vault.
+	// It serves as the canonical supply-of-record for all shares, regardless of whether
+	// they are held locally on Provenance or externally (e.g., bridged).
+	TotalShares *v1beta11.Coin `protobuf:"bytes,2,opt,name=total_shares,json=totalShares,proto3" json:"total_shares,omitempty"`
+	// underlying_asset is the vault’s single principal collateral AND valuation/base unit.
+	// - Exactly one denom.
+	// - Total Vault Value (TVV) and NAV-per-share are computed and reported in this denom.
+	// - Interest accrual, fees, and internal accounting are measured in this denom.
+	// - Held assets acquired via settlement must have an internal NAV record priced INTO this denom.
+	UnderlyingAsset string `protobuf:"bytes,3,opt,name=underlying_asset,json=underlyingAsset,proto3" json:"underlying_asset,omitempty"`
+	// payment_denom always equals underlying_asset. Vaults are single-denom; the
+	// field is inert and retained only for wire compatibility.
+	//
+	// Deprecated: The mixed-denom vault concept has been flattened into a single
+	// underlying denom. Deletion of this field is deferred to a future major release.
+	//
+	// Deprecated: Do not use.
+	PaymentDenom string `protobuf:"bytes,4,opt,name=payment_denom,json=paymentDenom,proto3" json:"payment_denom,omitempty"`
+	// admin is the address that has administrative privileges over the vault.
+	Admin string `protobuf:"bytes,5,opt,name=admin,proto3" json:"admin,omitempty"`
+	// current_interest_rate is a decimal string (e.g., "0.9" for 90% and "0.9001353" for 90.01353%) representing the actual annual interest rate currently being applied.
+	// This may be adjusted programmatically (e.g., due to lack of funds).
+	CurrentInterestRate string `protobuf:"bytes,6,opt,name=current_interest_rate,json=currentInterestRate,proto3" json:"current_interest_rate,omitempty"`
+	// desired_interest_rate is a decimal string (e.g., "0.9" for 90% and "0.9001353" for 90.01353%) representing the target annual interest rate that the vault intends to apply.
+	DesiredInterestRate string `protobuf:"bytes,7,opt,name=desired_interest_rate,json=desiredInterestRate,proto3" json:"desired_interest_rate,omitempty"`
+	// min_interest_rate is a decimal string (e.g., "0.9" for 90% and "0.9001353" for 90.01353%) representing the lowest annual interest rate the admin is allowed to set.
+	// If unset (empty string), there is no lower limit.
+	MinInterestRate string `protobuf:"bytes,8,opt,name=min_interest_rate,json=minInterestRate,proto3" json:"min_interest_rate,omitempty"`
+	// max_interest_rate is a decimal string (e.g., "0.9" for 90% and "0.9001353" for 90.01353%) representing the highest annual interest rate the admin is allowed to set.
+	// If unset (empty string), there is no upper limit.
+	MaxInterestRate string `protobuf:"bytes,9,opt,name=max_interest_rate,json=maxInterestRate,proto3" json:"max_interest_rate,omitempty"`
+	// The start time (in Unix seconds) of the current interest accrual period.
+	PeriodStart int64 `protobuf:"varint,10,opt,name=period_start,json=periodStart,proto3" json:"period_start,omitempty"`
+	// The expire time (in Unix seconds) of the current interest accrual period.
+	PeriodTimeout int64 `protobuf:"varint,11,opt,name=period_timeout,json=periodTimeout,proto3" json:"period_timeout,omitempty"`
+	// swap_in_enabled indicates whether users are allowed to deposit into the vault.
+	SwapInEnabled bool `protobuf:"varint,12,opt,name=swap_in_enabled,json=swapInEnabled,proto3" json:"swap_in_enabled,omitempty"`
+	// swap_out_enabled indicates whether users are allowed to withdraw from the vault.
+	SwapOutEnabled bool `protobuf:"varint,13,opt,name=swap_out_enabled,json=swapOutEnabled,proto3" json:"swap_out_enabled,omitempty"`
+	// withdrawal_delay_seconds is the configured time period (in seconds) that a withdrawal
+	// request must wait in the pending queue before being processed.
+	WithdrawalDelaySeconds uint64 `protobuf:"varint,14,opt,name=withdrawal_delay_seconds,json=withdrawalDelaySeconds,proto3" json:"withdrawal_delay_seconds,omitempty"`
+	// paused indicates that all user-facing swap-in and swap-out operations are disabled.
+	Paused bool `protobuf:"varint,15,opt,name=paused,proto3" json:"paused,omitempty"`
+	// paused_balance is the total vault value snapshot taken at the moment of pausing.
+	// This value is used for all NAV calculations while the vault is paused to prevent
+	// apparent devaluation during collateral rebalancing. It is cleared upon unpausing.
+	// Nothing done during the pause moves it, neither a principal deposit or withdrawal
+	// nor a NAV repricing; those all take effect together when the vault is unpaused.
+	PausedBalance *v1beta11.Coin `protobuf:"bytes,16,opt,name=paused_balance,json=pausedBalance,proto3" json:"paused_balance,omitempty"`
+	// paused_reason is a human-readable string explaining why the vault was paused, particularly for automatic pauses.
+	PausedReason string `protobuf:"bytes,17,opt,name=paused_reason,json=pausedReason,proto3" json:"paused_reason,omitempty"`
+	// bridge_address is the single external address allowed to mint or burn shares on behalf
+	// of this vault (e.g., for bridging to another chain). All mint/burn must flow through the
+	// vault keeper, which enforces that marker supply never exceeds total_shares.
+	BridgeAddress string `protobuf:"bytes,18,opt,name=bridge_address,json=bridgeAddress,proto3" json:"bridge_address,omitempty"`
+	// bridge_enabled indicates whether the bridge functionality is active. If false, the
+	// bridge_address has no effect and cannot mint or burn.
+	BridgeEnabled bool `protobuf:"varint,19,opt,name=bridge_enabled,json=bridgeEnabled,proto3" json:"bridge_enabled,omitempty"`
+	// asset_manager is an optional address that, when set, holds the management roles and the
+	// settlement manager role (e.g., pausing/unpausing, depositing/withdrawing principal or
+	// interest funds, settling assets). Changing it via MsgSetAssetManager moves those roles from
+	// the previous asset manager to the new one.
+	AssetManager string `protobuf:"bytes,20,opt,name=asset_manager,json=assetManager,proto3" json:"asset_manager,omitempty"`
+	// fee_period_start is the start time (in Unix seconds) of the current AUM fee collection period.
+	// This is a module-managed timestamp kept in sync with the fee timeout queue machinery.
+	FeePeriodStart int64 `protobuf:"varint,21,opt,name=fee_period_start,json=feePeriodStart,proto3" json:"fee_period_start,omitempty"`
+	// fee_period_timeout is the end time (in Unix seconds) of the current AUM fee collection period.
+	// This is a module-managed timestamp kept in sync with the fee timeout queue machinery.
+	FeePeriodTimeout int64 `protobuf:"varint,22,opt,name=fee_period_timeout,json=feePeriodTimeout,proto3" json:"fee_period_timeout,omitempty"`
+	// outstanding_aum_fee is the amount of AUM fee that has been calculated but not yet collected
+	// due to insufficient liquidity in the principal marker. This amount is always denominated
+	// in the vault's underlying_asset, must be preserved, and is carried into valuation computations.
+	OutstandingAumFee *v1beta11.Coin `protobuf:"bytes,23,opt,name=outstanding_aum_fee,json=outstandingAumFee,proto3" json:"outstanding_aum_fee,omitempty"`
+	// aum_fee_bips is the AUM fee rate (in basis points) for this specific vault.
+	// Units: Basis Points (1 bps = 0.01%).
+	// Valid Range: 0 to 10000 (0% to 100%).
+	// A value of 0 disables AUM fee collection for this vault.
+	// Negative values are not supported (uint32).
+	AumFeeBips uint32 `protobuf:"varint,24,opt,name=aum_fee_bips,json=aumFeeBips,proto3" json:"aum_fee_bips,omitempty"`
+	// min_swap_in_value is a string representing the minimum value required for a deposit.
+	// - The value is measured in the underlying_asset.
+	// - Values must be non-negative (>= 0).
+	// - If empty ("") or "0", there is no minimum limit.
+	MinSwapInValue string `protobuf:"bytes,25,opt,name=min_swap_in_value,json=minSwapInValue,proto3" json:"min_swap_in_value,omitempty"`
+	// min_swap_out_value is a string representing the minimum value required for a withdrawal.
+	// - The value is measured in the underlying_asset.
+	// - Outgoing redemptions are converted to this unit before checking.
+	// - Values must be non-negative (>= 0).
+	// - If empty ("") or "0", there is no minimum limit.
+	MinSwapOutValue string `protobuf:"bytes,26,opt,name=min_swap_out_value,json=minSwapOutValue,proto3" json:"min_swap_out_value,omitempty"`
+	// max_swap_in_value is a string representing the maximum value allowed for a deposit.
+	// - The value is measured in the underlying_asset.
+	// - Values must be positive (> 0).
+	// - An empty string "" indicates no maximum limit.
+	MaxSwapInValue string `protobuf:"bytes,27,opt,name=max_swap_in_value,json=maxSwapInValue,proto3" json:"max_swap_in_value,omitempty"`
+	// max_swap_out_value is a string representing the maximum value allowed for a withdrawal.
+	// - The value is measured in the underlying_asset.
+	// - Outgoing redemptions are converted to this unit before checking.
+	// - Values must be positive (> 0).
+	// - An empty string "" indicates no maximum limit.
+	MaxSwapOutValue string `protobuf:"bytes,28,opt,name=max_swap_out_value,json=maxSwapOutValue,proto3" json:"max_swap_out_value,omitempty"`
+	// nav_authority is the address holding the NAV authority role, which authorizes mutating
+	// this vault's internal NAV table via MsgUpdateVaultNAV. If empty, the vault admin is
+	// treated as the NAV authority. Rotating it via MsgUpdateNAVAuthority moves the role.
+	NavAuthority string `protobuf:"bytes,29,opt,name=nav_authority,json=navAuthority,proto3" json:"nav_authority,omitempty"`
+	// max_nav_age_seconds is the maximum age (in seconds) an internal NAV entry for a held
+	// denom may reach before the vault treats its valuation as stale. While any held denom's
+	// NAV is older than this limit, swap-ins, swap-out requests, and asset settlements are
+	// rejected until the NAV authority refreshes the price.
+	// A value of 0 disables the staleness check.
+	MaxNavAgeSeconds uint64 `protobuf:"varint,30,opt,name=max_nav_age_seconds,json=maxNavAgeSeconds,proto3" json:"max_nav_age_seconds,omitempty"`
+	// auto_pause_on_stale_nav, when true, makes the EndBlocker automatically pause the vault
+	// once any held denom's NAV exceeds max_nav_age_seconds. It has no effect while
+	// max_nav_age_seconds is 0.
+	AutoPauseOnStaleNav bool `protobuf:"varint,31,opt,name=auto_pause_on_stale_nav,json=autoPauseOnStaleNav,proto3" json:"auto_pause_on_stale_nav,omitempty"`
+	// max_nav_change_bips is the largest move, in basis points of the current price, that
+	// the NAV authority may apply to a held denom's internal NAV in a single update. A
+	// larger update is stored as a PendingNAVProposal and takes effect only once
+	// nav_approver confirms it. A value of 0 disables the guardrail.
+	MaxNavChangeBips uint32 `protobuf:"varint,32,opt,name=max_nav_change_bips,json=maxNavChangeBips,proto3" json:"max_nav_change_bips,omitempty"`
+	// nav_approver is the address that confirms NAV proposals exceeding
+	// max_nav_change_bips. It must be set while the guardrail is enabled and can never
+	// approve a proposal it submitted itself.
+	NavApprover string `protobuf:"bytes,33,opt,name=nav_approver,json=navApprover,proto3" json:"nav_approver,omitempty"`
+	// nav_sources are the independent pricing agents permitted to submit per-source NAV
+	// prices for the vault's denoms. Submissions are recorded separately from the NAV
+	// authority's entry and aggregated once nav_source_quorum of them are fresh.
+	NavSources []string `protobuf:"bytes,34,rep,name=nav_sources,json=navSources,proto3" json:"nav_sources,omitempty"`
+	// nav_source_quorum is the number of fresh source submissions a denom needs before
+	// the aggregated source price replaces the NAV authority's entry in valuation. A
+	// value of 0 disables aggregation.
+	NavSourceQuorum uint32 `protobuf:"varint,35,opt,name=nav_source_quorum,json=navSourceQuorum,proto3" json:"nav_source_quorum,omitempty"`
+	// nav_aggregation selects how fresh source submissions are combined into one price.
+	NavAggregation NAVAggregation `protobuf:"varint,36,opt,name=nav_aggregation,json=navAggregation,proto3,enum=provlabs.vault.v1.NAVAggregation" json:"nav_aggregation,omitempty"`
+	// nav_source_max_age_seconds is how long a source submission counts toward the quorum
+	// after it was submitted. It must be positive while nav_source_quorum is non-zero.
+	NavSourceMaxAgeSeconds uint64 `protobuf:"varint,37,opt,name=nav_source_max_age_seconds,json=navSourceMaxAgeSeconds,proto3" json:"nav_source_max_age_seconds,omitempty"`
+	// price_sources is the ordered chain of sources consulted to price a held denom; the
+	// first source with a price for the denom values it. When empty, only the internal NAV
+	// table is consulted.
+	PriceSources []PriceSourceType `protobuf:"varint,38,rep,packed,name=price_sources,json=priceSources,proto3,enum=provlabs.vault.v1.PriceSourceType" json:"price_sources,omitempty"`
+	// settlement_tolerance_bips is how far, in basis points of the asset leg's NAV-implied
+	// value, an asset settlement's payment leg may deviate from the vault's internal NAV. A
+	// value of 0 requires settlements to trade at exactly the recorded NAV.
+	SettlementToleranceBips uint32 `protobuf:"varint,39,opt,name=settlement_tolerance_bips,json=settlementToleranceBips,proto3" json:"settlement_tolerance_bips,omitempty"`
+	// update_nav_on_settlement marks a single-denom asset's internal NAV to the price of each
+	// settlement that traded away from it, provided the move does not exceed
+	// max_nav_change_bips.
+	UpdateNavOnSettlement bool `protobuf:"varint,40,opt,name=update_nav_on_settlement,json=updateNavOnSettlement,proto3" json:"update_nav_on_settlement,omitempty"`
+	// acquisition_policy restricts which assets the vault may price and acquire. An empty
+	// policy permits any asset.
+	AcquisitionPolicy *AcquisitionPolicy `protobuf:"bytes,41,opt,name=acquisition_policy,json=acquisitionPolicy,proto3" json:"acquisition_policy,omitempty"`
+	// max_asset_concentration_bips caps the value of any single non-underlying held denom as a
+	// share of total vault value, in basis points. A value of 0 disables the limit.
+	MaxAssetConcentrationBips uint32 `protobuf:"varint,42,opt,name=max_asset_concentration_bips,json=maxAssetConcentrationBips,proto3" json:"max_asset_concentration_bips,omitempty"`
+	// max_non_underlying_bips caps the combined value of every non-underlying held denom as a
+	// share of total vault value, in basis points. A value of 0 disables the limit.
+	MaxNonUnderlyingBips uint32 `protobuf:"varint,43,opt,name=max_non_underlying_bips,json=maxNonUnderlyingBips,proto3" json:"max_non_underlying_bips,omitempty"`
+	// min_liquidity_bips is the share of total vault value, in basis points, the principal
+	// marker must keep in the underlying asset on top of the value of queued swap-outs after an
+	// inbound asset settlement or a principal withdrawal. A value of 0 disables the buffer.
+	MinLiquidityBips uint32 `protobuf:"varint,44,opt,name=min_liquidity_bips,json=minLiquidityBips,proto3" json:"min_liquidity_bips,omitempty"`
+	// role_grants is the vault's role table: each entry authorizes one address to perform the
+	// operations of one role. Grants are sorted by role and then address, and are managed by the
+	// admin via MsgGrantRole and MsgRevokeRole.
+	RoleGrants []*RoleGrant `protobuf:"bytes,45,rep,name=role_grants,json=roleGrants,proto3" json:"role_grants,omitempty"`
+	// pending_admin_transfer is the admin transfer proposed via MsgProposeAdminTransfer and
+	// awaiting acceptance by the proposed admin, if any.
+	PendingAdminTransfer *PendingAdminTransfer `protobuf:"bytes,46,opt,name=pending_admin_transfer,json=pendingAdminTransfer,proto3" json:"pending_admin_transfer,omitempty"`
+	// timelock_seconds delays the vault's sensitive configuration changes: while it is non-zero,
+	// such a message is held as a ScheduledAction and applied this many seconds later, unless
+	// the admin or a guardian cancels it first. A value of 0 applies changes immediately.
+	TimelockSeconds uint64 `protobuf:"varint,47,opt,name=timelock_seconds,json=timelockSeconds,proto3" json:"timelock_seconds,omitempty"`
+	// eligibility_policy restricts which addresses may subscribe to and hold the vault's
+	// shares. A disabled policy lets any address hold shares.
+	EligibilityPolicy *EligibilityPolicy `protobuf:"bytes,48,opt,name=eligibility_policy,json=eligibilityPolicy,proto3" json:"eligibility_policy,omitempty"`
+	// max_position_value caps the value, measured in the underlying_asset, of the shares a single
+	// account may hold after a swap-in or share transfer. An empty string "" indicates no cap.
+	MaxPositionValue string `protobuf:"bytes,49,opt,name=max_position_value,json=maxPositionValue,proto3" json:"max_position_value,omitempty"`
+	// position_cap_exemptions are the addresses max_position_value does not apply to, such as
+	// the bridge or a seed investor.
+	PositionCapExemptions []string `protobuf:"bytes,50,rep,name=position_cap_exemptions,json=positionCapExemptions,proto3" json:"position_cap_exemptions,omitempty"`
+	// max_total_value caps the vault's net total value, measured in the underlying_asset, that
+	// swap-ins may bring it to. An empty string "" indicates no cap.
+	MaxTotalValue string `protobuf:"bytes,51,opt,name=max_total_value,json=maxTotalValue,proto3" json:"max_total_value,omitempty"`
 }
 
-// Has reports whether a field is populated.
-//
-// Some fields have the property of nullability where it is possible to
-// distinguish between the default value of a field and whether the field
-// was explicitly populated with the default value. Singular message fields,
-// member fields of a oneof, and proto2 scalar fields are nullable. Such
-// fields are populated only if explicitly set.
-//
-// In other cases (aside from the nullable cases above),
-// a proto3 scalar field is populated if it contains a non-zero value, and
-// a repeated field is populated if it is non-empty.
-func (x *fastReflection_AccountBalance) Has(fd protoreflect.FieldDescriptor) bool {
-	switch fd.FullName() {
-	case "provlabs.vault.v1.AccountBalance.address":
-		return x.Address != ""
-	case "provlabs.vault.v1.AccountBalance.coins":
-		return len(x.Coins) != 0
-	default:
-		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: provlabs.vault.v1.AccountBalance"))
-		}
-		panic(fmt.Errorf("message provlabs.vault.v1.AccountBalance does not contain field %s", fd.FullName()))
+func (x *VaultAccount) Reset() {
+	*x = VaultAccount{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_provlabs_vault_v1_vault_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
 }
 
-// Clear clears the field such that a subsequent Has call reports false.
-//
-// Clearing an extension field clears both the extension type and value
-// associated with the given field number.
-//
-// Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_AccountBalance) Clear(fd protoreflect.FieldDescriptor) {
-	switch fd.FullName() {
-	case "provlabs.vault.v1.AccountBalance.address":
-		x.Address = ""
-	case "provlabs.vault.v1.AccountBalance.coins":
-		x.Coins = nil
-	default:
-		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: provlabs.vault.v1.AccountBalance"))
-		}
-		panic(fmt.Errorf("message provlabs.vault.v1.AccountBalance does not contain field %s", fd.FullName()))
-	}
+func (x *VaultAccount) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-// Get retrieves the value for a field.
-//
-// For unpopulated scalars, it returns the default value, where
-// the default value of a bytes scalar is guaranteed to be a copy.
-// For unpopulated composite types, it returns an empty, read-only view
-// of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_AccountBalance) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
-	switch descriptor.FullName() {
-	case "provlabs.vault.v1.AccountBalance.address":
-		value := x.Address
-		return protoreflect.ValueOfString(value)
-	case "provlabs.vault.v1.AccountBalance.coins":
-		if len(x.Coins) == 0 {
-			return protoreflect.ValueOfList(&_AccountBalance_2_list{})
-		}
-		listValue := &_AccountBalance_2_list{list: &x.Coins}
-		return protoreflect.ValueOfList(listValue)
-	default:
-		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: provlabs.vault.v1.AccountBalance"))
-		}
-		panic(fmt.Errorf("message provlabs.vault.v1.AccountBalance does not contain field %s", descriptor.FullName()))
-	}
-}
+func (*VaultAccount) ProtoMessage() {}
 
-// Set stores the value for a field.
-//
-// For a field belonging to a oneof, it implicitly clears any other field
-// that may be currently set within the same oneof.
-// For extension fields, it implicitly stores the provided ExtensionType.
-// When setting a composite type, it is unspecified whether the stored value
-// aliases the source's memory in any way. If the composite value is an
-// empty, read-only value, then it panics.
-//
-// Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_AccountBalance) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
-	switch fd.FullName() {
-	case "provlabs.vault.v1.AccountBalance.address":
-		x.Address = value.Interface().(string)
-	case "provlabs.vault.v1.AccountBalance.coins":
-		lv := value.List()
-		clv := lv.(*_AccountBalance_2_list)
-		x.Coins = *clv.list
-	default:
-		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: provlabs.vault.v1.AccountBalance"))
-		}
-		panic(fmt.Errorf("message provlabs.vault.v1.AccountBalance does not contain field %s", fd.FullName()))
-	}
+// Deprecated: Use VaultAccount.ProtoReflect.Descriptor instead.
+func (*VaultAccount) Descriptor() ([]byte, []int) {
+	return file_provlabs_vault_v1_vault_proto_rawDescGZIP(), []int{0}
 }
 
-// Mutable returns a mutable reference to a composite type.
-//
-// If the field is unpopulated, it may allocate a composite value.
-// For a field belonging to a oneof, it implicitly clears any other field
-// that may be currently set within the same oneof.
-// For extension fields, it implicitly stores the provided ExtensionType
-// if not already stored.
-// It panics if the field does not contain a composite type.
-//
-// Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_AccountBalance) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
-	switch fd.FullName() {
-	case "provlabs.vault.v1.AccountBalance.coins":
-		if x.Coins == nil {
-			x.Coins = []*v1beta11.Coin{}
-		}
-		value := &_AccountBalance_2_list{list: &x.Coins}
-		return protoreflect.ValueOfList(value)
-	case "provlabs.vault.v1.AccountBalance.address":
-		panic(fmt.Errorf("field address of message provlabs.vault.v1.AccountBalance is not mutable"))
-	default:
-		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: provlabs.vault.v1.AccountBalance"))
-		}
-		panic(fmt.Errorf("message provlabs.vault.v1.AccountBalance does not contain field %s", fd.FullName()))
+func (x *VaultAccount) GetBaseAccount() *v1beta1.BaseAccount {
+	if x != nil {
+		return x.BaseAccount
 	}
+	return nil
 }
 
-// NewField returns a new value that is assignable to the field
-// for the given descriptor. For scalars, this returns the default value.
-// For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_AccountBalance) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
-	switch fd.FullName() {
-	case "provlabs.vault.v1.AccountBalance.address":
-		return protoreflect.ValueOfString("")
-	case "provlabs.vault.v1.AccountBalance.coins":
-		list := []*v1beta11.Coin{}
-		return protoreflect.ValueOfList(&_AccountBalance_2_list{list: &list})
-	default:
-		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: provlabs.vault.v1.AccountBalance"))
-		}
-		panic(fmt.Errorf("message provlabs.vault.v1.AccountBalance does not contain field %s", fd.FullName()))
+func (x *VaultAccount) GetTotalShares() *v1beta11.Coin {
+	if x != nil {
+		return x.TotalShares
 	}
+	return nil
 }
 
-// WhichOneof reports which field within the oneof is populated,
-// returning nil if none are populated.
-// It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_AccountBalance) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
-	switch d.FullName() {
-	default:
-		panic(fmt.Errorf("%s is not a oneof field in provlabs.vault.v1.AccountBalance", d.FullName()))
+func (x *VaultAccount) GetUnderlyingAsset() string {
+	if x != nil {
+		return x.UnderlyingAsset
 	}
-	panic("unreachable")
-}
-
-// GetUnknown retrieves the entire list of unknown fields.
-// The caller may only mutate the contents of the RawFields
-// if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_AccountBalance) GetUnknown() protoreflect.RawFields {
-	return x.unknownFields
-}
-
-// SetUnknown stores an entire list of unknown fields.
-// The raw fields must be syntactically valid according to the wire format.
-// An implementation may panic if this is not the case.
-// Once stored, the caller must not mutate the content of the RawFields.
-// An empty RawFields may be passed to clear the fields.
-//
-// SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_AccountBalance) SetUnknown(fields protoreflect.RawFields) {
-	x.unknownFields = fields
-}
-
-// IsValid reports whether the message is valid.
-//
-// An invalid message is an empty, read-only value.
-//
-// An invalid message often corresponds to a nil pointer of the concrete
-// message type, but the details are implementation dependent.
-// Validity is not part of the protobuf data model, and may not
-// be preserved in marshaling or other operations.
-func (x *fastReflection_AccountBalance) IsValid() bool {
-	return x != nil
-}
-
-// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
-// This method may return nil.
-//
-// The returned methods type is identical to
-// "google.golang.org/protobuf/runtime/protoiface".Methods.
-// Consult the protoiface package documentation for details.
-func (x *fastReflection_AccountBalance) ProtoMethods() *protoiface.Methods {
-	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*AccountBalance)
-		if x == nil {
-			return protoiface.SizeOutput{
-				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-				Size:              0,
-			}
-		}
-		options := runtime.SizeInputToOptions(input)
-		_ = options
-		var n int
-		var l int
-		_ = l
-		l = len(x.Address)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
-		}
-		if len(x.Coins) > 0 {
-			for _, e := range x.Coins {
-				l = options.Size(e)
-				n += 1 + l + runtime.Sov(uint64(l))
-			}
-		}
-		if x.unknownFields != nil {
-			n += len(x.unknownFields)
-		}
-		return protoiface.SizeOutput{
-			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-			Size:              n,
-		}
+	return ""
+}
+
+// Deprecated: Do not use.
+func (x *VaultAccount) GetPaymentDenom() string {
+	if x != nil {
+		return x.PaymentDenom
 	}
+	return ""
+}
 
-	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*AccountBalance)
-		if x == nil {
-			return protoiface.MarshalOutput{
-				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-				Buf:               input.Buf,
-			}, nil
-		}
-		options := runtime.MarshalInputToOptions(input)
-		_ = options
-		size := options.Size(x)
-		dAtA := make([]byte, size)
-		i := len(dAtA)
-		_ = i
-		var l int
-		_ = l
-		if x.unknownFields != nil {
-			i -= len(x.unknownFields)
-			copy(dAtA[i:], x.unknownFields)
-		}
-		if len(x.Coins) > 0 {
-			for iNdEx := len(x.Coins) - 1; iNdEx >= 0; iNdEx-- {
-				encoded, err := options.Marshal(x.Coins[iNdEx])
-				if err != nil {
-					return protoiface.MarshalOutput{
-						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-						Buf:               input.Buf,
-					}, err
-				}
-				i -= len(encoded)
-				copy(dAtA[i:], encoded)
-				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
-				i--
-				dAtA[i] = 0x12
-			}
-		}
-		if len(x.Address) > 0 {
-			i -= len(x.Address)
-			copy(dAtA[i:], x.Address)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Address)))
-			i--
-			dAtA[i] = 0xa
-		}
-		if input.Buf != nil {
-			input.Buf = append(input.Buf, dAtA...)
-		} else {
-			input.Buf = dAtA
-		}
-		return protoiface.MarshalOutput{
-			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-			Buf:               input.Buf,
-		}, nil
+func (x *VaultAccount) GetAdmin() string {
+	if x != nil {
+		return x.Admin
 	}
-	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*AccountBalance)
-		if x == nil {
-			return protoiface.UnmarshalOutput{
-				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-				Flags:             input.Flags,
-			}, nil
-		}
-		options := runtime.UnmarshalInputToOptions(input)
-		_ = options
-		dAtA := input.Buf
-		l := len(dAtA)
-		iNdEx := 0
-		for iNdEx < l {
-			preIndex := iNdEx
-			var wire uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-				}
-				if iNdEx >= l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				wire |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			fieldNum := int32(wire >> 3)
-			wireType := int(wire & 0x7)
-			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: AccountBalance: wiretype end group for non-group")
-			}
-			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: AccountBalance: illegal tag %d (wire type %d)", fieldNum, wire)
-			}
-			switch fieldNum {
-			case 1:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
-				}
-				var stringLen uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + intStringLen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				x.Address = string(dAtA[iNdEx:postIndex])
-				iNdEx = postIndex
-			case 2:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Coins", wireType)
-				}
-				var msglen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					msglen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				if msglen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + msglen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				x.Coins = append(x.Coins, &v1beta11.Coin{})
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Coins[len(x.Coins)-1]); err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
-				}
-				iNdEx = postIndex
-			default:
-				iNdEx = preIndex
-				skippy, err := runtime.Skip(dAtA[iNdEx:])
-				if err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
-				}
-				if (skippy < 0) || (iNdEx+skippy) < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if (iNdEx + skippy) > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				if !options.DiscardUnknown {
-					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
-				}
-				iNdEx += skippy
-			}
-		}
+	return ""
+}
 
-		if iNdEx > l {
-			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-		}
-		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+func (x *VaultAccount) GetCurrentInterestRate() string {
+	if x != nil {
+		return x.CurrentInterestRate
 	}
-	return &protoiface.Methods{
-		NoUnkeyedLiterals: struct{}{},
-		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
-		Size:              size,
-		Marshal:           marshal,
-		Unmarshal:         unmarshal,
-		Merge:             nil,
-		CheckInitialized:  nil,
+	return ""
+}
+
+func (x *VaultAccount) GetDesiredInterestRate() string {
+	if x != nil {
+		return x.DesiredInterestRate
 	}
+	return ""
 }
 
-var (
-	md_PendingSwapOut               protoreflect.MessageDescriptor
-	fd_PendingSwapOut_owner         protoreflect.FieldDescriptor
-	fd_PendingSwapOut_vault_address protoreflect.FieldDescriptor
-	fd_PendingSwapOut_shares        protoreflect.FieldDescriptor
-	fd_PendingSwapOut_redeem_denom  protoreflect.FieldDescriptor
-	fd_PendingSwapOut_failure_count protoreflect.FieldDescriptor
-)
+func (x *VaultAccount) GetMinInterestRate() string {
+	if x != nil {
+		return x.MinInterestRate
+	}
+	return ""
+}
 
-func init() {
-	file_provlabs_vault_v1_vault_proto_init()
-	md_PendingSwapOut = File_provlabs_vault_v1_vault_proto.Messages().ByName("PendingSwapOut")
-	fd_PendingSwapOut_owner = md_PendingSwapOut.Fields().ByName("owner")
-	fd_PendingSwapOut_vault_address = md_PendingSwapOut.Fields().ByName("vault_address")
-	fd_PendingSwapOut_shares = md_PendingSwapOut.Fields().ByName("shares")
-	fd_PendingSwapOut_redeem_denom = md_PendingSwapOut.Fields().ByName("redeem_denom")
-	fd_PendingSwapOut_failure_count = md_PendingSwapOut.Fields().ByName("failure_count")
+func (x *VaultAccount) GetMaxInterestRate() string {
+	if x != nil {
+		return x.MaxInterestRate
+	}
+	return ""
+}
+
+func (x *VaultAccount) GetPeriodStart() int64 {
+	if x != nil {
+		return x.PeriodStart
+	}
+	return 0
+}
+
+func (x *VaultAccount) GetPeriodTimeout() int64 {
+	if x != nil {
+		return x.PeriodTimeout
+	}
+	return 0
+}
+
+func (x *VaultAccount) GetSwapInEnabled() bool {
+	if x != nil {
+		return x.SwapInEnabled
+	}
+	return false
 }
 
-var _ protoreflect.Message = (*fastReflection_PendingSwapOut)(nil)
+func (x *VaultAccount) GetSwapOutEnabled() bool {
+	if x != nil {
+		return x.SwapOutEnabled
+	}
+	return false
+}
 
-type fastReflection_PendingSwapOut PendingSwapOut
+func (x *VaultAccount) GetWithdrawalDelaySeconds() uint64 {
+	if x != nil {
+		return x.WithdrawalDelaySeconds
+	}
+	return 0
+}
 
-func (x *PendingSwapOut) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_PendingSwapOut)(x)
+func (x *VaultAccount) GetPaused() bool {
+	if x != nil {
+		return x.Paused
+	}
+	return false
 }
 
-func (x *PendingSwapOut) slowProtoReflect() protoreflect.Message {
-	mi := &file_provlabs_vault_v1_vault_proto_msgTypes[3]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *VaultAccount) GetPausedBalance() *v1beta11.Coin {
+	if x != nil {
+		return x.PausedBalance
 	}
-	return mi.MessageOf(x)
+	return nil
 }
 
-var _fastReflection_PendingSwapOut_messageType fastReflection_PendingSwapOut_messageType
-var _ protoreflect.MessageType = fastReflection_PendingSwapOut_messageType{}
+func (x *VaultAccount) GetPausedReason() string {
+	if x != nil {
+		return x.PausedReason
+	}
+	return ""
+}
 
-type fastReflection_PendingSwapOut_messageType struct{}
+func (x *VaultAccount) GetBridgeAddress() string {
+	if x != nil {
+		return x.BridgeAddress
+	}
+	return ""
+}
 
-func (x fastReflection_PendingSwapOut_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_PendingSwapOut)(nil)
+func (x *VaultAccount) GetBridgeEnabled() bool {
+	if x != nil {
+		return x.BridgeEnabled
+	}
+	return false
 }
-func (x fastReflection_PendingSwapOut_messageType) New() protoreflect.Message {
-	return new(fastReflection_PendingSwapOut)
+
+func (x *VaultAccount) GetAssetManager() string {
+	if x != nil {
+		return x.AssetManager
+	}
+	return ""
 }
-func (x fastReflection_PendingSwapOut_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_PendingSwapOut
+
+func (x *VaultAccount) GetFeePeriodStart() int64 {
+	if x != nil {
+		return x.FeePeriodStart
+	}
+	return 0
 }
 
-// Descriptor returns message descriptor, which contains only the protobuf
-// type information for the message.
-func (x *fastReflection_PendingSwapOut) Descriptor() protoreflect.MessageDescriptor {
-	return md_PendingSwapOut
+func (x *VaultAccount) GetFeePeriodTimeout() int64 {
+	if x != nil {
+		return x.FeePeriodTimeout
+	}
+	return 0
 }
 
-// Type returns the message type, which encapsulates both Go and protobuf
-// type information. If the Go type information is not needed,
-// it is recommended that the message descriptor be used instead.
-func (x *fastReflection_PendingSwapOut) Type() protoreflect.MessageType {
-	return _fastReflection_PendingSwapOut_messageType
+func (x *VaultAccount) GetOutstandingAumFee() *v1beta11.Coin {
+	if x != nil {
+		return x.OutstandingAumFee
+	}
+	return nil
 }
 
-// New returns a newly allocated and mutable empty message.
-func (x *fastReflection_PendingSwapOut) New() protoreflect.Message {
-	return new(fastReflection_PendingSwapOut)
+func (x *VaultAccount) GetAumFeeBips() uint32 {
+	if x != nil {
+		return x.AumFeeBips
+	}
+	return 0
 }
 
-// Interface unwraps the message reflection interface and
-// returns the underlying ProtoMessage interface.
-func (x *fastReflection_PendingSwapOut) Interface() protoreflect.ProtoMessage {
-	return (*PendingSwapOut)(x)
+func (x *VaultAccount) GetMinSwapInValue() string {
+	if x != nil {
+		return x.MinSwapInValue
+	}
+	return ""
 }
 
-// Range iterates over every populated field in an undefined order,
-// calling f for each field descriptor and value encountered.
-// Range returns immediately if f returns false.
-// While iterating, mutating operations may only be performed
-// on the current field descriptor.
-func (x *fastReflection_PendingSwapOut) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.Owner != "" {
-		value := protoreflect.ValueOfString(x.Owner)
-		if !f(fd_PendingSwapOut_owner, value) {
-			return
-		}
+func (x *VaultAccount) GetMinSwapOutValue() string {
+	if x != nil {
+		return x.MinSwapOutValue
 	}
-	if x.VaultAddress != "" {
-		value := protoreflect.ValueOfString(x.VaultAddress)
-		if !f(fd_PendingSwapOut_vault_address, value) {
-			return
-		}
+	return ""
+}
+
+func (x *VaultAccount) GetMaxSwapInValue() string {
+	if x != nil {
+		return x.MaxSwapInValue
 	}
-	if x.Shares != nil {
-		value := protoreflect.ValueOfMessage(x.Shares.ProtoReflect())
-		if !f(fd_PendingSwapOut_shares, value) {
-			return
-		}
+	return ""
+}
+
+func (x *VaultAccount) GetMaxSwapOutValue() string {
+	if x != nil {
+		return x.MaxSwapOutValue
 	}
-	if x.RedeemDenom != "" {
-		value := protoreflect.ValueOfString(x.RedeemDenom)
-		if !f(fd_PendingSwapOut_redeem_denom, value) {
-			return
-		}
+	return ""
+}
+
+func (x *VaultAccount) GetNavAuthority() string {
+	if x != nil {
+		return x.NavAuthority
 	}
-	if x.FailureCount != uint32(0) {
-		value := protoreflect.ValueOfUint32(x.FailureCount)
-		if !f(fd_PendingSwapOut_failure_count, value) {
-			return
-		}
+	return ""
+}
+
+func (x *VaultAccount) GetMaxNavAgeSeconds() uint64 {
+	if x != nil {
+		return x.MaxNavAgeSeconds
 	}
+	return 0
 }
 
-// Has reports whether a field is populated.
-//
-// Some fields have the property of nullability where it is possible to
-// distinguish between the default value of a field and whether the field
-// was explicitly populated with the default value. Singular message fields,
-// member fields of a oneof, and proto2 scalar fields are nullable. Such
-// fields are populated only if explicitly set.
-//
-// In other cases (aside from the nullable cases above),
-// a proto3 scalar field is populated if it contains a non-zero value, and
-// a repeated field is populated if it is non-empty.
-func (x *fastReflection_PendingSwapOut) Has(fd protoreflect.FieldDescriptor) bool {
-	switch fd.FullName() {
-	case "provlabs.vault.v1.PendingSwapOut.owner":
-		return x.Owner != ""
-	case "provlabs.vault.v1.PendingSwapOut.vault_address":
-		return x.VaultAddress != ""
-	case "provlabs.vault.v1.PendingSwapOut.shares":
-		return x.Shares != nil
-	case "provlabs.vault.v1.PendingSwapOut.redeem_denom":
-		return x.RedeemDenom != ""
-	case "provlabs.vault.v1.PendingSwapOut.failure_count":
-		return x.FailureCount != uint32(0)
-	default:
-		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: provlabs.vault.v1.PendingSwapOut"))
-		}
-		panic(fmt.Errorf("message provlabs.vault.v1.PendingSwapOut does not contain field %s", fd.FullName()))
+func (x *VaultAccount) GetAutoPauseOnStaleNav() bool {
+	if x != nil {
+		return x.AutoPauseOnStaleNav
 	}
+	return false
 }
 
-// Clear clears the field such that a subsequent Has call reports false.
-//
-// Clearing an extension field clears both the extension type and value
-// associated with the given field number.
-//
-// Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_PendingSwapOut) Clear(fd protoreflect.FieldDescriptor) {
-	switch fd.FullName() {
-	case "provlabs.vault.v1.PendingSwapOut.owner":
-		x.Owner = ""
-	case "provlabs.vault.v1.PendingSwapOut.vault_address":
-		x.VaultAddress = ""
-	case "provlabs.vault.v1.PendingSwapOut.shares":
-		x.Shares = nil
-	case "provlabs.vault.v1.PendingSwapOut.redeem_denom":
-		x.RedeemDenom = ""
-	case "provlabs.vault.v1.PendingSwapOut.failure_count":
-		x.FailureCount = uint32(0)
-	default:
-		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: provlabs.vault.v1.PendingSwapOut"))
-		}
-		panic(fmt.Errorf("message provlabs.vault.v1.PendingSwapOut does not contain field %s", fd.FullName()))
+func (x *VaultAccount) GetMaxNavChangeBips() uint32 {
+	if x != nil {
+		return x.MaxNavChangeBips
 	}
+	return 0
 }
 
-// Get retrieves the value for a field.
-//
-// For unpopulated scalars, it returns the default value, where
-// the default value of a bytes scalar is guaranteed to be a copy.
-// For unpopulated composite types, it returns an empty, read-only view
-// of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_PendingSwapOut) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
-	switch descriptor.FullName() {
-	case "provlabs.vault.v1.PendingSwapOut.owner":
-		value := x.Owner
-		return protoreflect.ValueOfString(value)
-	case "provlabs.vault.v1.PendingSwapOut.vault_address":
-		value := x.VaultAddress
-		return protoreflect.ValueOfString(value)
-	case "provlabs.vault.v1.PendingSwapOut.shares":
-		value := x.Shares
-		return protoreflect.ValueOfMessage(value.ProtoReflect())
-	case "provlabs.vault.v1.PendingSwapOut.redeem_denom":
-		value := x.RedeemDenom
-		return protoreflect.ValueOfString(value)
-	case "provlabs.vault.v1.PendingSwapOut.failure_count":
-		value := x.FailureCount
-		return protoreflect.ValueOfUint32(value)
-	default:
-		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: provlabs.vault.v1.PendingSwapOut"))
-		}
-		panic(fmt.Errorf("message provlabs.vault.v1.PendingSwapOut does not contain field %s", descriptor.FullName()))
+func (x *VaultAccount) GetNavApprover() string {
+	if x != nil {
+		return x.NavApprover
+	}
+	return ""
+}
+
+func (x *VaultAccount) GetNavSources() []string {
+	if x != nil {
+		return x.NavSources
 	}
+	return nil
 }
 
-// Set stores the value for a field.
-//
-// For a field belonging to a oneof, it implicitly clears any other field
-// that may be currently set within the same oneof.
-// For extension fields, it implicitly stores the provided ExtensionType.
-// When setting a composite type, it is unspecified whether the stored value
-// aliases the source's memory in any way. If the composite value is an
-// empty, read-only value, then it panics.
-//
-// Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_PendingSwapOut) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
-	switch fd.FullName() {
-	case "provlabs.vault.v1.PendingSwapOut.owner":
-		x.Owner = value.Interface().(string)
-	case "provlabs.vault.v1.PendingSwapOut.vault_address":
-		x.VaultAddress = value.Interface().(string)
-	case "provlabs.vault.v1.PendingSwapOut.shares":
-		x.Shares = value.Message().Interface().(*v1beta11.Coin)
-	case "provlabs.vault.v1.PendingSwapOut.redeem_denom":
-		x.RedeemDenom = value.Interface().(string)
-	case "provlabs.vault.v1.PendingSwapOut.failure_count":
-		x.FailureCount = uint32(value.Uint())
-	default:
-		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: provlabs.vault.v1.PendingSwapOut"))
-		}
-		panic(fmt.Errorf("message provlabs.vault.v1.PendingSwapOut does not contain field %s", fd.FullName()))
+func (x *VaultAccount) GetNavSourceQuorum() uint32 {
+	if x != nil {
+		return x.NavSourceQuorum
 	}
+	return 0
 }
 
-// Mutable returns a mutable reference to a composite type.
-//
-// If the field is unpopulated, it may allocate a composite value.
-// For a field belonging to a oneof, it implicitly clears any other field
-// that may be currently set within the same oneof.
-// For extension fields, it implicitly stores the provided ExtensionType
-// if not already stored.
-// It panics if the field does not contain a composite type.
-//
-// Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_PendingSwapOut) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
-	switch fd.FullName() {
-	case "provlabs.vault.v1.PendingSwapOut.shares":
-		if x.Shares == nil {
-			x.Shares = new(v1beta11.Coin)
-		}
-		return protoreflect.ValueOfMessage(x.Shares.ProtoReflect())
-	case "provlabs.vault.v1.PendingSwapOut.owner":
-		panic(fmt.Errorf("field owner of message provlabs.vault.v1.PendingSwapOut is not mutable"))
-	case "provlabs.vault.v1.PendingSwapOut.vault_address":
-		panic(fmt.Errorf("field vault_address of message provlabs.vault.v1.PendingSwapOut is not mutable"))
-	case "provlabs.vault.v1.PendingSwapOut.redeem_denom":
-		panic(fmt.Errorf("field redeem_denom of message provlabs.vault.v1.PendingSwapOut is not mutable"))
-	case "provlabs.vault.v1.PendingSwapOut.failure_count":
-		panic(fmt.Errorf("field failure_count of message provlabs.vault.v1.PendingSwapOut is not mutable"))
-	default:
-		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: provlabs.vault.v1.PendingSwapOut"))
-		}
-		panic(fmt.Errorf("message provlabs.vault.v1.PendingSwapOut does not contain field %s", fd.FullName()))
+func (x *VaultAccount) GetNavAggregation() NAVAggregation {
+	if x != nil {
+		return x.NavAggregation
 	}
+	return NAVAggregation_NAV_AGGREGATION_MEDIAN
 }
 
-// NewField returns a new value that is assignable to the field
-// for the given descriptor. For scalars, this returns the default value.
-// For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_PendingSwapOut) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
-	switch fd.FullName() {
-	case "provlabs.vault.v1.PendingSwapOut.owner":
-		return protoreflect.ValueOfString("")
-	case "provlabs.vault.v1.PendingSwapOut.vault_address":
-		return protoreflect.ValueOfString("")
-	case "provlabs.vault.v1.PendingSwapOut.shares":
-		m := new(v1beta11.Coin)
-		return protoreflect.ValueOfMessage(m.ProtoReflect())
-	case "provlabs.vault.v1.PendingSwapOut.redeem_denom":
-		return protoreflect.ValueOfString("")
-	case "provlabs.vault.v1.PendingSwapOut.failure_count":
-		return protoreflect.ValueOfUint32(uint32(0))
-	default:
-		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: provlabs.vault.v1.PendingSwapOut"))
-		}
-		panic(fmt.Errorf("message provlabs.vault.v1.PendingSwapOut does not contain field %s", fd.FullName()))
+func (x *VaultAccount) GetNavSourceMaxAgeSeconds() uint64 {
+	if x != nil {
+		return x.NavSourceMaxAgeSeconds
 	}
+	return 0
 }
 
-// WhichOneof reports which field within the oneof is populated,
-// returning nil if none are populated.
-// It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_PendingSwapOut) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
-	switch d.FullName() {
-	default:
-		panic(fmt.Errorf("%s is not a oneof field in provlabs.vault.v1.PendingSwapOut", d.FullName()))
+func (x *VaultAccount) GetPriceSources() []PriceSourceType {
+	if x != nil {
+		return x.PriceSources
 	}
-	panic("unreachable")
+	return nil
 }
 
-// GetUnknown retrieves the entire list of unknown fields.
-// The caller may only mutate the contents of the RawFields
-// if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_PendingSwapOut) GetUnknown() protoreflect.RawFields {
-	return x.unknownFields
+func (x *VaultAccount) GetSettlementToleranceBips() uint32 {
+	if x != nil {
+		return x.SettlementToleranceBips
+	}
+	return 0
 }
 
-// SetUnknown stores an entire list of unknown fields.
-// The raw fields must be syntactically valid according to the wire format.
-// An implementation may panic if this is not the case.
-// Once stored, the caller must not mutate the content of the RawFields.
-// An empty RawFields may be passed to clear the fields.
-//
-// SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_PendingSwapOut) SetUnknown(fields protoreflect.RawFields) {
-	x.unknownFields = fields
+func (x *VaultAccount) GetUpdateNavOnSettlement() bool {
+	if x != nil {
+		return x.UpdateNavOnSettlement
+	}
+	return false
 }
 
-// IsValid reports whether the message is valid.
-//
-// An invalid message is an empty, read-only value.
-//
-// An invalid message often corresponds to a nil pointer of the concrete
-// message type, but the details are implementation dependent.
-// Validity is not part of the protobuf data model, and may not
-// be preserved in marshaling or other operations.
-func (x *fastReflection_PendingSwapOut) IsValid() bool {
-	return x != nil
+func (x *VaultAccount) GetAcquisitionPolicy() *AcquisitionPolicy {
+	if x != nil {
+		return x.AcquisitionPolicy
+	}
+	return nil
 }
 
-// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
-// This method may return nil.
-//
-// The returned methods type is identical to
-// "google.golang.org/protobuf/runtime/protoiface".Methods.
-// Consult the protoiface package documentation for details.
-func (x *fastReflection_PendingSwapOut) ProtoMethods() *protoiface.Methods {
-	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*PendingSwapOut)
-		if x == nil {
-			return protoiface.SizeOutput{
-				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-				Size:              0,
-			}
-		}
-		options := runtime.SizeInputToOptions(input)
-		_ = options
-		var n int
-		var l int
-		_ = l
-		l = len(x.Owner)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
-		}
-		l = len(x.VaultAddress)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
-		}
-		if x.Shares != nil {
-			l = options.Size(x.Shares)
-			n += 1 + l + runtime.Sov(uint64(l))
-		}
-		l = len(x.RedeemDenom)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
-		}
-		if x.FailureCount != 0 {
-			n += 1 + runtime.Sov(uint64(x.FailureCount))
-		}
-		if x.unknownFields != nil {
-			n += len(x.unknownFields)
-		}
-		return protoiface.SizeOutput{
-			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-			Size:              n,
-		}
+func (x *VaultAccount) GetMaxAssetConcentrationBips() uint32 {
+	if x != nil {
+		return x.MaxAssetConcentrationBips
 	}
+	return 0
+}
 
-	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*PendingSwapOut)
-		if x == nil {
-			return protoiface.MarshalOutput{
-				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-				Buf:               input.Buf,
-			}, nil
-		}
-		options := runtime.MarshalInputToOptions(input)
-		_ = options
-		size := options.Size(x)
-		dAtA := make([]byte, size)
-		i := len(dAtA)
-		_ = i
-		var l int
-		_ = l
-		if x.unknownFields != nil {
-			i -= len(x.unknownFields)
-			copy(dAtA[i:], x.unknownFields)
-		}
-		if x.FailureCount != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.FailureCount))
-			i--
-			dAtA[i] = 0x28
-		}
-		if len(x.RedeemDenom) > 0 {
-			i -= len(x.RedeemDenom)
-			copy(dAtA[i:], x.RedeemDenom)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.RedeemDenom)))
-			i--
-			dAtA[i] = 0x22
-		}
-		if x.Shares != nil {
-			encoded, err := options.Marshal(x.Shares)
-			if err != nil {
-				return protoiface.MarshalOutput{
-					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-					Buf:               input.Buf,
-				}, err
-			}
-			i -= len(encoded)
-			copy(dAtA[i:], encoded)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
-			i--
-			dAtA[i] = 0x1a
-		}
-		if len(x.VaultAddress) > 0 {
-			i -= len(x.VaultAddress)
-			copy(dAtA[i:], x.VaultAddress)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.VaultAddress)))
-			i--
-			dAtA[i] = 0x12
-		}
-		if len(x.Owner) > 0 {
-			i -= len(x.Owner)
-			copy(dAtA[i:], x.Owner)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Owner)))
-			i--
-			dAtA[i] = 0xa
-		}
-		if input.Buf != nil {
-			input.Buf = append(input.Buf, dAtA...)
-		} else {
-			input.Buf = dAtA
-		}
-		return protoiface.MarshalOutput{
-			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-			Buf:               input.Buf,
-		}, nil
+func (x *VaultAccount) GetMaxNonUnderlyingBips() uint32 {
+	if x != nil {
+		return x.MaxNonUnderlyingBips
+	}
+	return 0
+}
+
+func (x *VaultAccount) GetMinLiquidityBips() uint32 {
+	if x != nil {
+		return x.MinLiquidityBips
+	}
+	return 0
+}
+
+func (x *VaultAccount) GetRoleGrants() []*RoleGrant {
+	if x != nil {
+		return x.RoleGrants
 	}
-	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*PendingSwapOut)
-		if x == nil {
-			return protoiface.UnmarshalOutput{
-				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-				Flags:             input.Flags,
-			}, nil
-		}
-		options := runtime.UnmarshalInputToOptions(input)
-		_ = options
-		dAtA := input.Buf
-		l := len(dAtA)
-		iNdEx := 0
-		for iNdEx < l {
-			preIndex := iNdEx
-			var wire uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-				}
-				if iNdEx >= l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				wire |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			fieldNum := int32(wire >> 3)
-			wireType := int(wire & 0x7)
-			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: PendingSwapOut: wiretype end group for non-group")
-			}
-			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: PendingSwapOut: illegal tag %d (wire type %d)", fieldNum, wire)
-			}
-			switch fieldNum {
-			case 1:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
-				}
-				var stringLen uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + intStringLen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				x.Owner = string(dAtA[iNdEx:postIndex])
-				iNdEx = postIndex
-			case 2:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field VaultAddress", wireType)
-				}
-				var stringLen uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + intStringLen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				x.VaultAddress = string(dAtA[iNdEx:postIndex])
-				iNdEx = postIndex
-			case 3:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Shares", wireType)
-				}
-				var msglen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					msglen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				if msglen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + msglen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				if x.Shares == nil {
-					x.Shares = &v1beta11.Coin{}
-				}
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Shares); err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
-				}
-				iNdEx = postIndex
-			case 4:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RedeemDenom", wireType)
-				}
-				var stringLen uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + intStringLen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				x.RedeemDenom = string(dAtA[iNdEx:postIndex])
-				iNdEx = postIndex
-			case 5:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field FailureCount", wireType)
-				}
-				x.FailureCount = 0
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					x.FailureCount |= uint32(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-			default:
-				iNdEx = preIndex
-				skippy, err := runtime.Skip(dAtA[iNdEx:])
-				if err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
-				}
-				if (skippy < 0) || (iNdEx+skippy) < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if (iNdEx + skippy) > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				if !options.DiscardUnknown {
-					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
-				}
-				iNdEx += skippy
-			}
-		}
+	return nil
+}
+
+func (x *VaultAccount) GetPendingAdminTransfer() *PendingAdminTransfer {
+	if x != nil {
+		return x.PendingAdminTransfer
+	}
+	return nil
+}
+
+func (x *VaultAccount) GetTimelockSeconds() uint64 {
+	if x != nil {
+		return x.TimelockSeconds
+	}
+	return 0
+}
+
+func (x *VaultAccount) GetEligibilityPolicy() *EligibilityPolicy {
+	if x != nil {
+		return x.EligibilityPolicy
+	}
+	return nil
+}
+
+func (x *VaultAccount) GetMaxPositionValue() string {
+	if x != nil {
+		return x.MaxPositionValue
+	}
+	return ""
+}
+
+func (x *VaultAccount) GetPositionCapExemptions() []string {
+	if x != nil {
+		return x.PositionCapExemptions
+	}
+	return nil
+}
+
+func (x *VaultAccount) GetMaxTotalValue() string {
+	if x != nil {
+		return x.MaxTotalValue
+	}
+	return ""
+}
+
+// EligibilityPolicy restricts a vault's investors, such as to the accredited investors a
+// regulated fund may accept. While enabled, an address is eligible when it is on the vault's
+// allowlist, or when required_attributes is non-empty and the address holds every one of them.
+type EligibilityPolicy struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// enabled turns the policy on. When false every address is eligible.
+	Enabled bool `protobuf:"varint,1,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	// required_attributes are the account attribute names an address must hold to be eligible
+	// without being allowlisted. A name starting with "*." matches any attribute ending with the
+	// rest of the name. When empty, only allowlisted addresses are eligible.
+	RequiredAttributes []string `protobuf:"bytes,2,rep,name=required_attributes,json=requiredAttributes,proto3" json:"required_attributes,omitempty"`
+}
+
+func (x *EligibilityPolicy) Reset() {
+	*x = EligibilityPolicy{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_provlabs_vault_v1_vault_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EligibilityPolicy) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
 
-		if iNdEx > l {
-			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-		}
-		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+func (*EligibilityPolicy) ProtoMessage() {}
+
+// Deprecated: Use EligibilityPolicy.ProtoReflect.Descriptor instead.
+func (*EligibilityPolicy) Descriptor() ([]byte, []int) {
+	return file_provlabs_vault_v1_vault_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *EligibilityPolicy) GetEnabled() bool {
+	if x != nil {
+		return x.Enabled
 	}
-	return &protoiface.Methods{
-		NoUnkeyedLiterals: struct{}{},
-		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
-		Size:              size,
-		Marshal:           marshal,
-		Unmarshal:         unmarshal,
-		Merge:             nil,
-		CheckInitialized:  nil,
+	return false
+}
+
+func (x *EligibilityPolicy) GetRequiredAttributes() []string {
+	if x != nil {
+		return x.RequiredAttributes
 	}
+	return nil
 }
 
-// Code generated by protoc-gen-go. DO NOT EDIT.
-// versions:
-// 	protoc-gen-go v1.27.0
-// 	protoc        (unknown)
-// source: provlabs/vault/v1/vault.proto
+// PendingAdminTransfer is a proposed change of a vault's admin. It takes effect only when the
+// proposed admin accepts it, so a vault can never be handed to an address that cannot sign.
+type PendingAdminTransfer struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
 
-const (
-	// Verify that this generated code is sufficiently up-to-date.
-	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
-	// Verify that runtime/protoimpl is sufficiently up-to-date.
-	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
-)
+	// new_admin is the bech32 address that may accept the transfer.
+	NewAdmin string `protobuf:"bytes,1,opt,name=new_admin,json=newAdmin,proto3" json:"new_admin,omitempty"`
+	// expiry_time is the block time at or after which the transfer can no longer be accepted.
+	// It is unset when the transfer does not expire.
+	ExpiryTime *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=expiry_time,json=expiryTime,proto3" json:"expiry_time,omitempty"`
+}
 
-// VaultAccount represents a central holding place for assets, governed by a set of rules.
-// It is based on the ERC-4626 standard and builds upon the Provenance Marker module.
-type VaultAccount struct {
+func (x *PendingAdminTransfer) Reset() {
+	*x = PendingAdminTransfer{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_provlabs_vault_v1_vault_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PendingAdminTransfer) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PendingAdminTransfer) ProtoMessage() {}
+
+// Deprecated: Use PendingAdminTransfer.ProtoReflect.Descriptor instead.
+func (*PendingAdminTransfer) Descriptor() ([]byte, []int) {
+	return file_provlabs_vault_v1_vault_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *PendingAdminTransfer) GetNewAdmin() string {
+	if x != nil {
+		return x.NewAdmin
+	}
+	return ""
+}
+
+func (x *PendingAdminTransfer) GetExpiryTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpiryTime
+	}
+	return nil
+}
+
+// ScheduledAction is a sensitive configuration message held by a vault's timelock until its
+// execute time.
+type ScheduledAction struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// base_account cosmos account information including address and coin holdings.
-	BaseAccount *v1beta1.BaseAccount `protobuf:"bytes,1,opt,name=base_account,json=baseAccount,proto3" json:"base_account,omitempty"`
-	// total_shares is the total number of shares that have ever been issued by the vault.
-	// It serves as the canonical supply-of-record for all shares, regardless of whether
-	// they are held locally on Provenance or externally (e.g., bridged).
-	TotalShares *v1beta11.Coin `protobuf:"bytes,2,opt,name=total_shares,json=totalShares,proto3" json:"total_shares,omitempty"`
-	// underlying_asset is the vault’s single principal collateral AND valuation/base unit.
-	// - Exactly one denom.
-	// - Total Vault Value (TVV) and NAV-per-share are computed and reported in this denom.
-	// - Interest accrual, fees, and internal accounting are measured in this denom.
-	// - Held assets acquired via settlement must have an internal NAV record priced INTO this denom.
-	UnderlyingAsset string `protobuf:"bytes,3,opt,name=underlying_asset,json=underlyingAsset,proto3" json:"underlying_asset,omitempty"`
-	// payment_denom always equals underlying_asset. Vaults are single-denom; the
-	// field is inert and retained only for wire compatibility.
-	//
-	// Deprecated: The mixed-denom vault concept has been flattened into a single
-	// underlying denom. Deletion of this field is deferred to a future major release.
-	//
-	// Deprecated: Do not use.
-	PaymentDenom string `protobuf:"bytes,4,opt,name=payment_denom,json=paymentDenom,proto3" json:"payment_denom,omitempty"`
-	// admin is the address that has administrative privileges over the vault.
-	Admin string `protobuf:"bytes,5,opt,name=admin,proto3" json:"admin,omitempty"`
-	// current_interest_rate is a decimal string (e.g., "0.9" for 90% and "0.9001353" for 90.01353%) representing the actual annual interest rate currently being applied.
-	// This may be adjusted programmatically (e.g., due to lack of funds).
-	CurrentInterestRate string `protobuf:"bytes,6,opt,name=current_interest_rate,json=currentInterestRate,proto3" json:"current_interest_rate,omitempty"`
-	// desired_interest_rate is a decimal string (e.g., "0.9" for 90% and "0.9001353" for 90.01353%) representing the target annual interest rate that the vault intends to apply.
-	DesiredInterestRate string `protobuf:"bytes,7,opt,name=desired_interest_rate,json=desiredInterestRate,proto3" json:"desired_interest_rate,omitempty"`
-	// min_interest_rate is a decimal string (e.g., "0.9" for 90% and "0.9001353" for 90.01353%) representing the lowest annual interest rate the admin is allowed to set.
-	// If unset (empty string), there is no lower limit.
-	MinInterestRate string `protobuf:"bytes,8,opt,name=min_interest_rate,json=minInterestRate,proto3" json:"min_interest_rate,omitempty"`
-	// max_interest_rate is a decimal string (e.g., "0.9" for 90% and "0.9001353" for 90.01353%) representing the highest annual interest rate the admin is allowed to set.
-	// If unset (empty string), there is no upper limit.
-	MaxInterestRate string `protobuf:"bytes,9,opt,name=max_interest_rate,json=maxInterestRate,proto3" json:"max_interest_rate,omitempty"`
-	// The start time (in Unix seconds) of the current interest accrual period.
-	PeriodStart int64 `protobuf:"varint,10,opt,name=period_start,json=periodStart,proto3" json:"period_start,omitempty"`
-	// The expire time (in Unix seconds) of the current interest accrual period.
-	PeriodTimeout int64 `protobuf:"varint,11,opt,name=period_timeout,json=periodTimeout,proto3" json:"period_timeout,omitempty"`
-	// swap_in_enabled indicates whether users are allowed to deposit into the vault.
-	SwapInEnabled bool `protobuf:"varint,12,opt,name=swap_in_enabled,json=swapInEnabled,proto3" json:"swap_in_enabled,omitempty"`
-	// swap_out_enabled indicates whether users are allowed to withdraw from the vault.
-	SwapOutEnabled bool `protobuf:"varint,13,opt,name=swap_out_enabled,json=swapOutEnabled,proto3" json:"swap_out_enabled,omitempty"`
-	// withdrawal_delay_seconds is the configured time period (in seconds) that a withdrawal
-	// request must wait in the pending queue before being processed.
-	WithdrawalDelaySeconds uint64 `protobuf:"varint,14,opt,name=withdrawal_delay_seconds,json=withdrawalDelaySeconds,proto3" json:"withdrawal_delay_seconds,omitempty"`
-	// paused indicates that all user-facing swap-in and swap-out operations are disabled.
-	Paused bool `protobuf:"varint,15,opt,name=paused,proto3" json:"paused,omitempty"`
-	// paused_balance is the total vault value snapshot taken at the moment of pausing.
-	// This value is used for all NAV calculations while the vault is paused to prevent
-	// apparent devaluation during collateral rebalancing. It is cleared upon unpausing.
-	// Nothing done during the pause moves it, neither a principal deposit or withdrawal
-	// nor a NAV repricing; those all take effect together when the vault is unpaused.
-	PausedBalance *v1beta11.Coin `protobuf:"bytes,16,opt,name=paused_balance,json=pausedBalance,proto3" json:"paused_balance,omitempty"`
-	// paused_reason is a human-readable string explaining why the vault was paused, particularly for automatic pauses.
-	PausedReason string `protobuf:"bytes,17,opt,name=paused_reason,json=pausedReason,proto3" json:"paused_reason,omitempty"`
-	// bridge_address is the single external address allowed to mint or burn shares on behalf
-	// of this vault (e.g., for bridging to another chain). All mint/burn must flow through the
-	// vault keeper, which enforces that marker supply never exceeds total_shares.
-	BridgeAddress string `protobuf:"bytes,18,opt,name=bridge_address,json=bridgeAddress,proto3" json:"bridge_address,omitempty"`
-	// bridge_enabled indicates whether the bridge functionality is active. If false, the
-	// bridge_address has no effect and cannot mint or burn.
-	BridgeEnabled bool `protobuf:"varint,19,opt,name=bridge_enabled,json=bridgeEnabled,proto3" json:"bridge_enabled,omitempty"`
-	// asset_manager is an optional address that, when set, is authorized to manage certain
-	// collateral operations alongside the admin (e.g., pausing/unpausing, depositing/withdrawing
-	// principal or interest funds). If unset (empty string), only the admin may perform those actions.
-	AssetManager string `protobuf:"bytes,20,opt,name=asset_manager,json=assetManager,proto3" json:"asset_manager,omitempty"`
-	// fee_period_start is the start time (in Unix seconds) of the current AUM fee collection period.
-	// This is a module-managed timestamp kept in sync with the fee timeout queue machinery.
-	FeePeriodStart int64 `protobuf:"varint,21,opt,name=fee_period_start,json=feePeriodStart,proto3" json:"fee_period_start,omitempty"`
-	// fee_period_timeout is the end time (in Unix seconds) of the current AUM fee collection period.
-	// This is a module-managed timestamp kept in sync with the fee timeout queue machinery.
-	FeePeriodTimeout int64 `protobuf:"varint,22,opt,name=fee_period_timeout,json=feePeriodTimeout,proto3" json:"fee_period_timeout,omitempty"`
-	// outstanding_aum_fee is the amount of AUM fee that has been calculated but not yet collected
-	// due to insufficient liquidity in the principal marker. This amount is always denominated
-	// in the vault's underlying_asset, must be preserved, and is carried into valuation computations.
-	OutstandingAumFee *v1beta11.Coin `protobuf:"bytes,23,opt,name=outstanding_aum_fee,json=outstandingAumFee,proto3" json:"outstanding_aum_fee,omitempty"`
-	// aum_fee_bips is the AUM fee rate (in basis points) for this specific vault.
-	// Units: Basis Points (1 bps = 0.01%).
-	// Valid Range: 0 to 10000 (0% to 100%).
-	// A value of 0 disables AUM fee collection for this vault.
-	// Negative values are not supported (uint32).
-	AumFeeBips uint32 `protobuf:"varint,24,opt,name=aum_fee_bips,json=aumFeeBips,proto3" json:"aum_fee_bips,omitempty"`
-	// min_swap_in_value is a string representing the minimum value required for a deposit.
-	// - The value is measured in the underlying_asset.
-	// - Values must be non-negative (>= 0).
-	// - If empty ("") or "0", there is no minimum limit.
-	MinSwapInValue string `protobuf:"bytes,25,opt,name=min_swap_in_value,json=minSwapInValue,proto3" json:"min_swap_in_value,omitempty"`
-	// min_swap_out_value is a string representing the minimum value required for a withdrawal.
-	// - The value is measured in the underlying_asset.
-	// - Outgoing redemptions are converted to this unit before checking.
-	// - Values must be non-negative (>= 0).
-	// - If empty ("") or "0", there is no minimum limit.
-	MinSwapOutValue string `protobuf:"bytes,26,opt,name=min_swap_out_value,json=minSwapOutValue,proto3" json:"min_swap_out_value,omitempty"`
-	// max_swap_in_value is a string representing the maximum value allowed for a deposit.
-	// - The value is measured in the underlying_asset.
-	// - Values must be positive (> 0).
-	// - An empty string "" indicates no maximum limit.
-	MaxSwapInValue string `protobuf:"bytes,27,opt,name=max_swap_in_value,json=maxSwapInValue,proto3" json:"max_swap_in_value,omitempty"`
-	// max_swap_out_value is a string representing the maximum value allowed for a withdrawal.
-	// - The value is measured in the underlying_asset.
-	// - Outgoing redemptions are converted to this unit before checking.
-	// - Values must be positive (> 0).
-	// - An empty string "" indicates no maximum limit.
-	MaxSwapOutValue string `protobuf:"bytes,28,opt,name=max_swap_out_value,json=maxSwapOutValue,proto3" json:"max_swap_out_value,omitempty"`
-	// nav_authority is the address authorized to mutate this vault's internal NAV
-	// table via MsgUpdateVaultNAV. If empty, the vault admin is treated as the NAV
-	// authority. It is rotated only via MsgUpdateNAVAuthority.
-	NavAuthority string `protobuf:"bytes,29,opt,name=nav_authority,json=navAuthority,proto3" json:"nav_authority,omitempty"`
+	// id is the unique identifier of the scheduled action.
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// vault_address is the bech32 address of the vault the action configures.
+	VaultAddress string `protobuf:"bytes,2,opt,name=vault_address,json=vaultAddress,proto3" json:"vault_address,omitempty"`
+	// authority is the address that signed the scheduled message.
+	Authority string `protobuf:"bytes,3,opt,name=authority,proto3" json:"authority,omitempty"`
+	// execute_time is the UNIX timestamp (in seconds) at or after which the action is applied.
+	ExecuteTime int64 `protobuf:"varint,4,opt,name=execute_time,json=executeTime,proto3" json:"execute_time,omitempty"`
+	// msg is the scheduled message. Its signer's authority is checked again when it executes.
+	Msg *anypb.Any `protobuf:"bytes,5,opt,name=msg,proto3" json:"msg,omitempty"`
+}
+
+func (x *ScheduledAction) Reset() {
+	*x = ScheduledAction{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_provlabs_vault_v1_vault_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ScheduledAction) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ScheduledAction) ProtoMessage() {}
+
+// Deprecated: Use ScheduledAction.ProtoReflect.Descriptor instead.
+func (*ScheduledAction) Descriptor() ([]byte, []int) {
+	return file_provlabs_vault_v1_vault_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *ScheduledAction) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *ScheduledAction) GetVaultAddress() string {
+	if x != nil {
+		return x.VaultAddress
+	}
+	return ""
+}
+
+func (x *ScheduledAction) GetAuthority() string {
+	if x != nil {
+		return x.Authority
+	}
+	return ""
+}
+
+func (x *ScheduledAction) GetExecuteTime() int64 {
+	if x != nil {
+		return x.ExecuteTime
+	}
+	return 0
+}
+
+func (x *ScheduledAction) GetMsg() *anypb.Any {
+	if x != nil {
+		return x.Msg
+	}
+	return nil
+}
+
+// RoleGrant authorizes an address to perform the operations of a role on a vault.
+type RoleGrant struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// role is the granted role.
+	Role VaultRole `protobuf:"varint,1,opt,name=role,proto3,enum=provlabs.vault.v1.VaultRole" json:"role,omitempty"`
+	// address is the bech32 address holding the role.
+	Address string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+}
+
+func (x *RoleGrant) Reset() {
+	*x = RoleGrant{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_provlabs_vault_v1_vault_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RoleGrant) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RoleGrant) ProtoMessage() {}
+
+// Deprecated: Use RoleGrant.ProtoReflect.Descriptor instead.
+func (*RoleGrant) Descriptor() ([]byte, []int) {
+	return file_provlabs_vault_v1_vault_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *RoleGrant) GetRole() VaultRole {
+	if x != nil {
+		return x.Role
+	}
+	return VaultRole_VAULT_ROLE_UNSPECIFIED
+}
+
+func (x *RoleGrant) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+// VaultNAV is a single internal net asset value entry recording the price of one
+// asset denom held by a vault. The vault module is the sole source of truth for
+// these values; the NAV authority maintains them via MsgUpdateVaultNAV.
+type VaultNAV struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// denom is the asset denomination this entry prices.
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	// price is the total value of `volume` units of the denom, denominated in the
+	// vault's underlying asset. The per-unit value is price divided by volume.
+	Price *v1beta11.Coin `protobuf:"bytes,2,opt,name=price,proto3" json:"price,omitempty"`
+	// volume is the number of units of the denom that price covers. It must be
+	// positive; the per-unit value of the denom is price divided by volume.
+	Volume string `protobuf:"bytes,3,opt,name=volume,proto3" json:"volume,omitempty"`
+	// source identifies the origin of this NAV entry (for example an oracle name
+	// or the settlement path), mirroring the Marker module's NAV source attribution.
+	Source string `protobuf:"bytes,4,opt,name=source,proto3" json:"source,omitempty"`
+	// updated_block_height is the block height at which this entry was last updated.
+	UpdatedBlockHeight int64 `protobuf:"varint,5,opt,name=updated_block_height,json=updatedBlockHeight,proto3" json:"updated_block_height,omitempty"`
+	// updated_time is the block time at which this entry was last updated.
+	UpdatedTime *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=updated_time,json=updatedTime,proto3" json:"updated_time,omitempty"`
 }
 
-func (x *VaultAccount) Reset() {
-	*x = VaultAccount{}
+func (x *VaultNAV) Reset() {
+	*x = VaultNAV{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_provlabs_vault_v1_vault_proto_msgTypes[0]
+		mi := &file_provlabs_vault_v1_vault_proto_msgTypes[5]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *VaultAccount) String() string {
+func (x *VaultNAV) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*VaultAccount) ProtoMessage() {}
+func (*VaultNAV) ProtoMessage() {}
 
-// Deprecated: Use VaultAccount.ProtoReflect.Descriptor instead.
-func (*VaultAccount) Descriptor() ([]byte, []int) {
-	return file_provlabs_vault_v1_vault_proto_rawDescGZIP(), []int{0}
+// Deprecated: Use VaultNAV.ProtoReflect.Descriptor instead.
+func (*VaultNAV) Descriptor() ([]byte, []int) {
+	return file_provlabs_vault_v1_vault_proto_rawDescGZIP(), []int{5}
 }
 
-func (x *VaultAccount) GetBaseAccount() *v1beta1.BaseAccount {
+func (x *VaultNAV) GetDenom() string {
 	if x != nil {
-		return x.BaseAccount
+		return x.Denom
 	}
-	return nil
+	return ""
 }
 
-func (x *VaultAccount) GetTotalShares() *v1beta11.Coin {
+func (x *VaultNAV) GetPrice() *v1beta11.Coin {
 	if x != nil {
-		return x.TotalShares
+		return x.Price
 	}
 	return nil
 }
 
-func (x *VaultAccount) GetUnderlyingAsset() string {
+func (x *VaultNAV) GetVolume() string {
 	if x != nil {
-		return x.UnderlyingAsset
+		return x.Volume
 	}
 	return ""
 }
 
-// Deprecated: Do not use.
-func (x *VaultAccount) GetPaymentDenom() string {
+func (x *VaultNAV) GetSource() string {
 	if x != nil {
-		return x.PaymentDenom
+		return x.Source
 	}
 	return ""
 }
 
-func (x *VaultAccount) GetAdmin() string {
+func (x *VaultNAV) GetUpdatedBlockHeight() int64 {
 	if x != nil {
-		return x.Admin
+		return x.UpdatedBlockHeight
 	}
-	return ""
+	return 0
 }
 
-func (x *VaultAccount) GetCurrentInterestRate() string {
+func (x *VaultNAV) GetUpdatedTime() *timestamppb.Timestamp {
 	if x != nil {
-		return x.CurrentInterestRate
+		return x.UpdatedTime
 	}
-	return ""
+	return nil
 }
 
-func (x *VaultAccount) GetDesiredInterestRate() string {
-	if x != nil {
-		return x.DesiredInterestRate
-	}
-	return ""
+// PendingNAVProposal is an internal NAV update that moved a held denom's price by more
+// than the vault's max_nav_change_bips. It is held until the vault's nav_approver
+// confirms it or it expires.
+type PendingNAVProposal struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// vault_address is the bech32 address of the vault the proposal prices.
+	VaultAddress string `protobuf:"bytes,1,opt,name=vault_address,json=vaultAddress,proto3" json:"vault_address,omitempty"`
+	// nav is the proposed NAV entry. Its updated_block_height and updated_time record
+	// when the proposal was submitted; both are restamped when it is approved.
+	Nav *VaultNAV `protobuf:"bytes,2,opt,name=nav,proto3" json:"nav,omitempty"`
+	// proposer is the NAV authority address that submitted the update.
+	Proposer string `protobuf:"bytes,3,opt,name=proposer,proto3" json:"proposer,omitempty"`
+	// expiry_time is the block time at or after which the proposal can no longer be
+	// approved and is removed by the EndBlocker.
+	ExpiryTime *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=expiry_time,json=expiryTime,proto3" json:"expiry_time,omitempty"`
 }
 
-func (x *VaultAccount) GetMinInterestRate() string {
-	if x != nil {
-		return x.MinInterestRate
+func (x *PendingNAVProposal) Reset() {
+	*x = PendingNAVProposal{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_provlabs_vault_v1_vault_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return ""
 }
 
-func (x *VaultAccount) GetMaxInterestRate() string {
+func (x *PendingNAVProposal) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PendingNAVProposal) ProtoMessage() {}
+
+// Deprecated: Use PendingNAVProposal.ProtoReflect.Descriptor instead.
+func (*PendingNAVProposal) Descriptor() ([]byte, []int) {
+	return file_provlabs_vault_v1_vault_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *PendingNAVProposal) GetVaultAddress() string {
 	if x != nil {
-		return x.MaxInterestRate
+		return x.VaultAddress
 	}
 	return ""
 }
 
-func (x *VaultAccount) GetPeriodStart() int64 {
+func (x *PendingNAVProposal) GetNav() *VaultNAV {
 	if x != nil {
-		return x.PeriodStart
+		return x.Nav
 	}
-	return 0
+	return nil
 }
 
-func (x *VaultAccount) GetPeriodTimeout() int64 {
+func (x *PendingNAVProposal) GetProposer() string {
 	if x != nil {
-		return x.PeriodTimeout
+		return x.Proposer
 	}
-	return 0
+	return ""
 }
 
-func (x *VaultAccount) GetSwapInEnabled() bool {
+func (x *PendingNAVProposal) GetExpiryTime() *timestamppb.Timestamp {
 	if x != nil {
-		return x.SwapInEnabled
+		return x.ExpiryTime
 	}
-	return false
+	return nil
 }
 
-func (x *VaultAccount) GetSwapOutEnabled() bool {
-	if x != nil {
-		return x.SwapOutEnabled
-	}
-	return false
+// NAVSourceSubmission is a price for one denom submitted by one of a vault's NAV sources.
+type NAVSourceSubmission struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// vault_address is the bech32 address of the vault the submission prices.
+	VaultAddress string `protobuf:"bytes,1,opt,name=vault_address,json=vaultAddress,proto3" json:"vault_address,omitempty"`
+	// source is the address of the NAV source that submitted the price.
+	Source string `protobuf:"bytes,2,opt,name=source,proto3" json:"source,omitempty"`
+	// nav is the submitted NAV entry. Its updated_block_height and updated_time record
+	// when it was submitted and determine whether it is fresh.
+	Nav *VaultNAV `protobuf:"bytes,3,opt,name=nav,proto3" json:"nav,omitempty"`
 }
 
-func (x *VaultAccount) GetWithdrawalDelaySeconds() uint64 {
-	if x != nil {
-		return x.WithdrawalDelaySeconds
+func (x *NAVSourceSubmission) Reset() {
+	*x = NAVSourceSubmission{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_provlabs_vault_v1_vault_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return 0
 }
 
-func (x *VaultAccount) GetPaused() bool {
-	if x != nil {
-		return x.Paused
-	}
-	return false
+func (x *NAVSourceSubmission) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *VaultAccount) GetPausedBalance() *v1beta11.Coin {
-	if x != nil {
-		return x.PausedBalance
-	}
-	return nil
+func (*NAVSourceSubmission) ProtoMessage() {}
+
+// Deprecated: Use NAVSourceSubmission.ProtoReflect.Descriptor instead.
+func (*NAVSourceSubmission) Descriptor() ([]byte, []int) {
+	return file_provlabs_vault_v1_vault_proto_rawDescGZIP(), []int{7}
 }
 
-func (x *VaultAccount) GetPausedReason() string {
+func (x *NAVSourceSubmission) GetVaultAddress() string {
 	if x != nil {
-		return x.PausedReason
+		return x.VaultAddress
 	}
 	return ""
 }
 
-func (x *VaultAccount) GetBridgeAddress() string {
+func (x *NAVSourceSubmission) GetSource() string {
 	if x != nil {
-		return x.BridgeAddress
+		return x.Source
 	}
 	return ""
 }
 
-func (x *VaultAccount) GetBridgeEnabled() bool {
+func (x *NAVSourceSubmission) GetNav() *VaultNAV {
 	if x != nil {
-		return x.BridgeEnabled
+		return x.Nav
 	}
-	return false
+	return nil
 }
 
-func (x *VaultAccount) GetAssetManager() string {
-	if x != nil {
-		return x.AssetManager
-	}
-	return ""
+// AccountBalance represents the coin balance of a single account.
+type AccountBalance struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// address is the account address.
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// coins is the balance of the account.
+	Coins []*v1beta11.Coin `protobuf:"bytes,2,rep,name=coins,proto3" json:"coins,omitempty"`
 }
 
-func (x *VaultAccount) GetFeePeriodStart() int64 {
-	if x != nil {
-		return x.FeePeriodStart
+func (x *AccountBalance) Reset() {
+	*x = AccountBalance{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_provlabs_vault_v1_vault_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return 0
 }
 
-func (x *VaultAccount) GetFeePeriodTimeout() int64 {
+func (x *AccountBalance) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AccountBalance) ProtoMessage() {}
+
+// Deprecated: Use AccountBalance.ProtoReflect.Descriptor instead.
+func (*AccountBalance) Descriptor() ([]byte, []int) {
+	return file_provlabs_vault_v1_vault_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *AccountBalance) GetAddress() string {
 	if x != nil {
-		return x.FeePeriodTimeout
+		return x.Address
 	}
-	return 0
+	return ""
 }
 
-func (x *VaultAccount) GetOutstandingAumFee() *v1beta11.Coin {
+func (x *AccountBalance) GetCoins() []*v1beta11.Coin {
 	if x != nil {
-		return x.OutstandingAumFee
+		return x.Coins
 	}
 	return nil
 }
 
-func (x *VaultAccount) GetAumFeeBips() uint32 {
-	if x != nil {
-		return x.AumFeeBips
-	}
-	return 0
+// PendingSwapOut are swap outs that have not yet been processed and completed.
+type PendingSwapOut struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// owner is the address initiating the swap out.
+	Owner string `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty"`
+	// vault_address is the address of the vault processing the withdrawal.
+	VaultAddress string `protobuf:"bytes,2,opt,name=vault_address,json=vaultAddress,proto3" json:"vault_address,omitempty"`
+	// shares are the shares that were escrowed by the user.
+	Shares *v1beta11.Coin `protobuf:"bytes,3,opt,name=shares,proto3" json:"shares,omitempty"`
+	// redeem_denom is the denomination of the asset to be redeemed. Always the
+	// vault's underlying_asset; retained for wire compatibility.
+	//
+	// Deprecated: Do not use.
+	RedeemDenom string `protobuf:"bytes,4,opt,name=redeem_denom,json=redeemDenom,proto3" json:"redeem_denom,omitempty"`
+	// failure_count is the number of consecutive failed processing attempts that
+	// left this request queued. Each failure re-keys the request to a later retry
+	// time so it cannot hold the front of the queue. Reset when expedited.
+	FailureCount uint32 `protobuf:"varint,5,opt,name=failure_count,json=failureCount,proto3" json:"failure_count,omitempty"`
 }
 
-func (x *VaultAccount) GetMinSwapInValue() string {
-	if x != nil {
-		return x.MinSwapInValue
+func (x *PendingSwapOut) Reset() {
+	*x = PendingSwapOut{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_provlabs_vault_v1_vault_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return ""
 }
 
-func (x *VaultAccount) GetMinSwapOutValue() string {
+func (x *PendingSwapOut) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PendingSwapOut) ProtoMessage() {}
+
+// Deprecated: Use PendingSwapOut.ProtoReflect.Descriptor instead.
+func (*PendingSwapOut) Descriptor() ([]byte, []int) {
+	return file_provlabs_vault_v1_vault_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *PendingSwapOut) GetOwner() string {
 	if x != nil {
-		return x.MinSwapOutValue
+		return x.Owner
 	}
 	return ""
 }
 
-func (x *VaultAccount) GetMaxSwapInValue() string {
+func (x *PendingSwapOut) GetVaultAddress() string {
 	if x != nil {
-		return x.MaxSwapInValue
+		return x.VaultAddress
 	}
 	return ""
 }
 
-func (x *VaultAccount) GetMaxSwapOutValue() string {
+func (x *PendingSwapOut) GetShares() *v1beta11.Coin {
 	if x != nil {
-		return x.MaxSwapOutValue
+		return x.Shares
 	}
-	return ""
+	return nil
 }
 
-func (x *VaultAccount) GetNavAuthority() string {
+// Deprecated: Do not use.
+func (x *PendingSwapOut) GetRedeemDenom() string {
 	if x != nil {
-		return x.NavAuthority
+		return x.RedeemDenom
 	}
 	return ""
 }
 
-// VaultNAV is a single internal net asset value entry recording the price of one
-// asset denom held by a vault. The vault module is the sole source of truth for
-// these values; the NAV authority maintains them via MsgUpdateVaultNAV.
-type VaultNAV struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	// denom is the asset denomination this entry prices.
-	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
-	// price is the total value of `volume` units of the denom, denominated in the
-	// vault's underlying asset. The per-unit value is price divided by volume.
-	Price *v1beta11.Coin `protobuf:"bytes,2,opt,name=price,proto3" json:"price,omitempty"`
-	// volume is the number of units of the denom that price covers. It must be
-	// positive; the per-unit value of the denom is price divided by volume.
-	Volume string `protobuf:"bytes,3,opt,name=volume,proto3" json:"volume,omitempty"`
-	// source identifies the origin of this NAV entry (for example an oracle name
-	// or the settlement path), mirroring the Marker module's NAV source attribution.
-	Source string `protobuf:"bytes,4,opt,name=source,proto3" json:"source,omitempty"`
-	// updated_block_height is the block height at which this entry was last updated.
-	UpdatedBlockHeight int64 `protobuf:"varint,5,opt,name=updated_block_height,json=updatedBlockHeight,proto3" json:"updated_block_height,omitempty"`
-	// updated_time is the block time at which this entry was last updated.
-	UpdatedTime *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=updated_time,json=updatedTime,proto3" json:"updated_time,omitempty"`
-}
-
-func (x *VaultNAV) Reset() {
-	*x = VaultNAV{}
+func (x *PendingSwapOut) GetFailureCount() uint32 {
+	if x != nil {
+		return x.FailureCount
+	}
+	return 0
+}
+
+// AcquisitionPolicy lists the assets a vault may price in its internal NAV table and acquire
+// through settlement, such as the collateral types named in its offering documents.
+type AcquisitionPolicy struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// denom_patterns are the denoms the vault may acquire. A pattern is an exact denom, or a
+	// prefix followed by a trailing "*" that matches every denom starting with the prefix
+	// (e.g. "nft/*"). When empty, any denom is permitted.
+	DenomPatterns []string `protobuf:"bytes,1,rep,name=denom_patterns,json=denomPatterns,proto3" json:"denom_patterns,omitempty"`
+	// scope_specification_ids are the bech32 scope specification ids a metadata value-owner
+	// denom's (nft/<scope-id>) scope must be created from. When empty, any scope is permitted.
+	ScopeSpecificationIds []string `protobuf:"bytes,2,rep,name=scope_specification_ids,json=scopeSpecificationIds,proto3" json:"scope_specification_ids,omitempty"`
+}
+
+func (x *AcquisitionPolicy) Reset() {
+	*x = AcquisitionPolicy{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_provlabs_vault_v1_vault_proto_msgTypes[1]
+		mi := &file_provlabs_vault_v1_vault_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *VaultNAV) String() string {
+func (x *AcquisitionPolicy) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*VaultNAV) ProtoMessage() {}
+func (*AcquisitionPolicy) ProtoMessage() {}
 
-// Deprecated: Use VaultNAV.ProtoReflect.Descriptor instead.
-func (*VaultNAV) Descriptor() ([]byte, []int) {
-	return file_provlabs_vault_v1_vault_proto_rawDescGZIP(), []int{1}
+// Deprecated: Use AcquisitionPolicy.ProtoReflect.Descriptor instead.
+func (*AcquisitionPolicy) Descriptor() ([]byte, []int) {
+	return file_provlabs_vault_v1_vault_proto_rawDescGZIP(), []int{10}
 }
 
-func (x *VaultNAV) GetDenom() string {
+func (x *AcquisitionPolicy) GetDenomPatterns() []string {
 	if x != nil {
-		return x.Denom
+		return x.DenomPatterns
 	}
-	return ""
+	return nil
 }
 
-func (x *VaultNAV) GetPrice() *v1beta11.Coin {
+func (x *AcquisitionPolicy) GetScopeSpecificationIds() []string {
 	if x != nil {
-		return x.Price
+		return x.ScopeSpecificationIds
 	}
 	return nil
 }
 
-func (x *VaultNAV) GetVolume() string {
-	if x != nil {
-		return x.Volume
-	}
-	return ""
+// AssetHaircut is the valuation haircut a vault applies to one held denom. The denom's
+// value is reduced by haircut_bips basis points wherever it counts toward total vault
+// value, and so toward share pricing.
+type AssetHaircut struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// denom is the held asset denomination the haircut applies to.
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	// haircut_bips is the haircut in basis points; 10000 values the denom at zero.
+	HaircutBips uint32 `protobuf:"varint,2,opt,name=haircut_bips,json=haircutBips,proto3" json:"haircut_bips,omitempty"`
 }
 
-func (x *VaultNAV) GetSource() string {
-	if x != nil {
-		return x.Source
+func (x *AssetHaircut) Reset() {
+	*x = AssetHaircut{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_provlabs_vault_v1_vault_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return ""
 }
 
-func (x *VaultNAV) GetUpdatedBlockHeight() int64 {
+func (x *AssetHaircut) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AssetHaircut) ProtoMessage() {}
+
+// Deprecated: Use AssetHaircut.ProtoReflect.Descriptor instead.
+func (*AssetHaircut) Descriptor() ([]byte, []int) {
+	return file_provlabs_vault_v1_vault_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *AssetHaircut) GetDenom() string {
 	if x != nil {
-		return x.UpdatedBlockHeight
+		return x.Denom
 	}
-	return 0
+	return ""
 }
 
-func (x *VaultNAV) GetUpdatedTime() *timestamppb.Timestamp {
+func (x *AssetHaircut) GetHaircutBips() uint32 {
 	if x != nil {
-		return x.UpdatedTime
+		return x.HaircutBips
 	}
-	return nil
+	return 0
 }
 
-// AccountBalance represents the coin balance of a single account.
-type AccountBalance struct {
+// SharePriceObservation is one point of a vault's cumulative share price accumulator,
+// recorded when reconciliation observes a new share price. Between observations the
+// price is taken to hold at the last observed value.
+type SharePriceObservation struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// address is the account address.
-	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
-	// coins is the balance of the account.
-	Coins []*v1beta11.Coin `protobuf:"bytes,2,rep,name=coins,proto3" json:"coins,omitempty"`
+	// time is the block time the observation was recorded at.
+	Time *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=time,proto3" json:"time,omitempty"`
+	// block_height is the block height the observation was recorded at.
+	BlockHeight int64 `protobuf:"varint,2,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
+	// price is the net share price from time on, in underlying asset units per ShareScalar
+	// base shares (1 share at the initial deposit parity).
+	Price string `protobuf:"bytes,3,opt,name=price,proto3" json:"price,omitempty"`
+	// cumulative is the sum over the series of each earlier price multiplied by the seconds
+	// it was in effect, up to time.
+	Cumulative string `protobuf:"bytes,4,opt,name=cumulative,proto3" json:"cumulative,omitempty"`
 }
 
-func (x *AccountBalance) Reset() {
-	*x = AccountBalance{}
+func (x *SharePriceObservation) Reset() {
+	*x = SharePriceObservation{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_provlabs_vault_v1_vault_proto_msgTypes[2]
+		mi := &file_provlabs_vault_v1_vault_proto_msgTypes[12]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *AccountBalance) String() string {
+func (x *SharePriceObservation) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AccountBalance) ProtoMessage() {}
+func (*SharePriceObservation) ProtoMessage() {}
 
-// Deprecated: Use AccountBalance.ProtoReflect.Descriptor instead.
-func (*AccountBalance) Descriptor() ([]byte, []int) {
-	return file_provlabs_vault_v1_vault_proto_rawDescGZIP(), []int{2}
+// Deprecated: Use SharePriceObservation.ProtoReflect.Descriptor instead.
+func (*SharePriceObservation) Descriptor() ([]byte, []int) {
+	return file_provlabs_vault_v1_vault_proto_rawDescGZIP(), []int{12}
 }
 
-func (x *AccountBalance) GetAddress() string {
+func (x *SharePriceObservation) GetTime() *timestamppb.Timestamp {
 	if x != nil {
-		return x.Address
+		return x.Time
+	}
+	return nil
+}
+
+func (x *SharePriceObservation) GetBlockHeight() int64 {
+	if x != nil {
+		return x.BlockHeight
+	}
+	return 0
+}
+
+func (x *SharePriceObservation) GetPrice() string {
+	if x != nil {
+		return x.Price
 	}
 	return ""
 }
 
-func (x *AccountBalance) GetCoins() []*v1beta11.Coin {
+func (x *SharePriceObservation) GetCumulative() string {
 	if x != nil {
-		return x.Coins
+		return x.Cumulative
 	}
-	return nil
+	return ""
 }
 
-// PendingSwapOut are swap outs that have not yet been processed and completed.
-type PendingSwapOut struct {
+// OutboundPayment is an x/exchange payment created by the vault, with the vault's payment
+// escrow account as its source. The vault's side of the trade is staged from the principal
+// marker into the escrow account, where the exchange module holds it until the target
+// accepts or rejects the payment, or the vault cancels it.
+type OutboundPayment struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// owner is the address initiating the swap out.
-	Owner string `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty"`
-	// vault_address is the address of the vault processing the withdrawal.
-	VaultAddress string `protobuf:"bytes,2,opt,name=vault_address,json=vaultAddress,proto3" json:"vault_address,omitempty"`
-	// shares are the shares that were escrowed by the user.
-	Shares *v1beta11.Coin `protobuf:"bytes,3,opt,name=shares,proto3" json:"shares,omitempty"`
-	// redeem_denom is the denomination of the asset to be redeemed. Always the
-	// vault's underlying_asset; retained for wire compatibility.
-	//
-	// Deprecated: Do not use.
-	RedeemDenom string `protobuf:"bytes,4,opt,name=redeem_denom,json=redeemDenom,proto3" json:"redeem_denom,omitempty"`
-	// failure_count is the number of consecutive failed processing attempts that
-	// left this request queued. Each failure re-keys the request to a later retry
-	// time so it cannot hold the front of the queue. Reset when expedited.
-	FailureCount uint32 `protobuf:"varint,5,opt,name=failure_count,json=failureCount,proto3" json:"failure_count,omitempty"`
-}
-
-func (x *PendingSwapOut) Reset() {
-	*x = PendingSwapOut{}
+	// external_id, together with the vault's payment escrow address, identifies the payment.
+	ExternalId string `protobuf:"bytes,1,opt,name=external_id,json=externalId,proto3" json:"external_id,omitempty"`
+	// target is the bech32 address of the counterparty that may accept the payment.
+	Target string `protobuf:"bytes,2,opt,name=target,proto3" json:"target,omitempty"`
+	// source_amount is the funds the vault offers, held in its payment escrow account.
+	SourceAmount []*v1beta11.Coin `protobuf:"bytes,3,rep,name=source_amount,json=sourceAmount,proto3" json:"source_amount,omitempty"`
+	// target_amount is the funds the vault asks for in return.
+	TargetAmount []*v1beta11.Coin `protobuf:"bytes,4,rep,name=target_amount,json=targetAmount,proto3" json:"target_amount,omitempty"`
+	// direction indicates whether the asset moves into the vault ("inbound") or out of the
+	// vault ("outbound") relative to the vault's underlying asset.
+	Direction string `protobuf:"bytes,5,opt,name=direction,proto3" json:"direction,omitempty"`
+	// expiry_time is the block time at or after which anyone may expire the payment, releasing
+	// its escrow.
+	ExpiryTime *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=expiry_time,json=expiryTime,proto3" json:"expiry_time,omitempty"`
+}
+
+func (x *OutboundPayment) Reset() {
+	*x = OutboundPayment{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_provlabs_vault_v1_vault_proto_msgTypes[3]
+		mi := &file_provlabs_vault_v1_vault_proto_msgTypes[13]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *PendingSwapOut) String() string {
+func (x *OutboundPayment) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PendingSwapOut) ProtoMessage() {}
+func (*OutboundPayment) ProtoMessage() {}
 
-// Deprecated: Use PendingSwapOut.ProtoReflect.Descriptor instead.
-func (*PendingSwapOut) Descriptor() ([]byte, []int) {
-	return file_provlabs_vault_v1_vault_proto_rawDescGZIP(), []int{3}
+// Deprecated: Use OutboundPayment.ProtoReflect.Descriptor instead.
+func (*OutboundPayment) Descriptor() ([]byte, []int) {
+	return file_provlabs_vault_v1_vault_proto_rawDescGZIP(), []int{13}
 }
 
-func (x *PendingSwapOut) GetOwner() string {
+func (x *OutboundPayment) GetExternalId() string {
 	if x != nil {
-		return x.Owner
+		return x.ExternalId
 	}
 	return ""
 }
 
-func (x *PendingSwapOut) GetVaultAddress() string {
+func (x *OutboundPayment) GetTarget() string {
 	if x != nil {
-		return x.VaultAddress
+		return x.Target
 	}
 	return ""
 }
 
-func (x *PendingSwapOut) GetShares() *v1beta11.Coin {
+func (x *OutboundPayment) GetSourceAmount() []*v1beta11.Coin {
 	if x != nil {
-		return x.Shares
+		return x.SourceAmount
 	}
 	return nil
 }
 
-// Deprecated: Do not use.
-func (x *PendingSwapOut) GetRedeemDenom() string {
+func (x *OutboundPayment) GetTargetAmount() []*v1beta11.Coin {
 	if x != nil {
-		return x.RedeemDenom
+		return x.TargetAmount
+	}
+	return nil
+}
+
+func (x *OutboundPayment) GetDirection() string {
+	if x != nil {
+		return x.Direction
 	}
 	return ""
 }
 
-func (x *PendingSwapOut) GetFailureCount() uint32 {
+func (x *OutboundPayment) GetExpiryTime() *timestamppb.Timestamp {
 	if x != nil {
-		return x.FailureCount
+		return x.ExpiryTime
 	}
-	return 0
+	return nil
 }
 
 var File_provlabs_vault_v1_vault_proto protoreflect.FileDescriptor
@@ -4746,168 +13332,408 @@ var file_provlabs_vault_v1_vault_proto_rawDesc = []byte{
 	0x74, 0x6f, 0x1a, 0x19, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f,
 	0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x14, 0x67,
 	0x6f, 0x67, 0x6f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x67, 0x6f, 0x67, 0x6f, 0x2e, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x22, 0xcd, 0x0c, 0x0a, 0x0c, 0x56, 0x61, 0x75, 0x6c, 0x74, 0x41, 0x63,
-	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x49, 0x0a, 0x0c, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x61, 0x63,
-	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x63, 0x6f,
-	0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61,
-	0x31, 0x2e, 0x42, 0x61, 0x73, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x42, 0x04, 0xd0,
-	0xde, 0x1f, 0x01, 0x52, 0x0b, 0x62, 0x61, 0x73, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74,
-	0x12, 0x42, 0x0a, 0x0c, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x73, 0x68, 0x61, 0x72, 0x65, 0x73,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e,
-	0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x43, 0x6f, 0x69,
-	0x6e, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x0b, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x53, 0x68,
-	0x61, 0x72, 0x65, 0x73, 0x12, 0x29, 0x0a, 0x10, 0x75, 0x6e, 0x64, 0x65, 0x72, 0x6c, 0x79, 0x69,
-	0x6e, 0x67, 0x5f, 0x61, 0x73, 0x73, 0x65, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f,
-	0x75, 0x6e, 0x64, 0x65, 0x72, 0x6c, 0x79, 0x69, 0x6e, 0x67, 0x41, 0x73, 0x73, 0x65, 0x74, 0x12,
-	0x27, 0x0a, 0x0d, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x64, 0x65, 0x6e, 0x6f, 0x6d,
-	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x42, 0x02, 0x18, 0x01, 0x52, 0x0c, 0x70, 0x61, 0x79, 0x6d,
-	0x65, 0x6e, 0x74, 0x44, 0x65, 0x6e, 0x6f, 0x6d, 0x12, 0x2e, 0x0a, 0x05, 0x61, 0x64, 0x6d, 0x69,
-	0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73,
-	0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e,
-	0x67, 0x52, 0x05, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x12, 0x48, 0x0a, 0x15, 0x63, 0x75, 0x72, 0x72,
-	0x65, 0x6e, 0x74, 0x5f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x65, 0x73, 0x74, 0x5f, 0x72, 0x61, 0x74,
-	0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x42, 0x14, 0xd2, 0xb4, 0x2d, 0x10, 0x63, 0x6f, 0x73,
-	0x6d, 0x6f, 0x73, 0x2e, 0x44, 0x65, 0x63, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x13, 0x63,
-	0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x65, 0x73, 0x74, 0x52, 0x61,
-	0x74, 0x65, 0x12, 0x48, 0x0a, 0x15, 0x64, 0x65, 0x73, 0x69, 0x72, 0x65, 0x64, 0x5f, 0x69, 0x6e,
-	0x74, 0x65, 0x72, 0x65, 0x73, 0x74, 0x5f, 0x72, 0x61, 0x74, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28,
-	0x09, 0x42, 0x14, 0xd2, 0xb4, 0x2d, 0x10, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x44, 0x65,
-	0x63, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x13, 0x64, 0x65, 0x73, 0x69, 0x72, 0x65, 0x64,
-	0x49, 0x6e, 0x74, 0x65, 0x72, 0x65, 0x73, 0x74, 0x52, 0x61, 0x74, 0x65, 0x12, 0x40, 0x0a, 0x11,
-	0x6d, 0x69, 0x6e, 0x5f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x65, 0x73, 0x74, 0x5f, 0x72, 0x61, 0x74,
-	0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x42, 0x14, 0xd2, 0xb4, 0x2d, 0x10, 0x63, 0x6f, 0x73,
-	0x6d, 0x6f, 0x73, 0x2e, 0x44, 0x65, 0x63, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x0f, 0x6d,
-	0x69, 0x6e, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x65, 0x73, 0x74, 0x52, 0x61, 0x74, 0x65, 0x12, 0x40,
-	0x0a, 0x11, 0x6d, 0x61, 0x78, 0x5f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x65, 0x73, 0x74, 0x5f, 0x72,
-	0x61, 0x74, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x42, 0x14, 0xd2, 0xb4, 0x2d, 0x10, 0x63,
-	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x44, 0x65, 0x63, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52,
-	0x0f, 0x6d, 0x61, 0x78, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x65, 0x73, 0x74, 0x52, 0x61, 0x74, 0x65,
-	0x12, 0x21, 0x0a, 0x0c, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x5f, 0x73, 0x74, 0x61, 0x72, 0x74,
-	0x18, 0x0a, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x53, 0x74,
-	0x61, 0x72, 0x74, 0x12, 0x25, 0x0a, 0x0e, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x5f, 0x74, 0x69,
-	0x6d, 0x65, 0x6f, 0x75, 0x74, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0d, 0x70, 0x65, 0x72,
-	0x69, 0x6f, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x12, 0x26, 0x0a, 0x0f, 0x73, 0x77,
-	0x61, 0x70, 0x5f, 0x69, 0x6e, 0x5f, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x18, 0x0c, 0x20,
-	0x01, 0x28, 0x08, 0x52, 0x0d, 0x73, 0x77, 0x61, 0x70, 0x49, 0x6e, 0x45, 0x6e, 0x61, 0x62, 0x6c,
-	0x65, 0x64, 0x12, 0x28, 0x0a, 0x10, 0x73, 0x77, 0x61, 0x70, 0x5f, 0x6f, 0x75, 0x74, 0x5f, 0x65,
-	0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0e, 0x73, 0x77,
-	0x61, 0x70, 0x4f, 0x75, 0x74, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x12, 0x38, 0x0a, 0x18,
-	0x77, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x61, 0x6c, 0x5f, 0x64, 0x65, 0x6c, 0x61, 0x79,
-	0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x04, 0x52, 0x16,
-	0x77, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x61, 0x6c, 0x44, 0x65, 0x6c, 0x61, 0x79, 0x53,
-	0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x61, 0x75, 0x73, 0x65, 0x64,
-	0x18, 0x0f, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x70, 0x61, 0x75, 0x73, 0x65, 0x64, 0x12, 0x46,
-	0x0a, 0x0e, 0x70, 0x61, 0x75, 0x73, 0x65, 0x64, 0x5f, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65,
-	0x18, 0x10, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e,
-	0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x43, 0x6f, 0x69,
-	0x6e, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x0d, 0x70, 0x61, 0x75, 0x73, 0x65, 0x64, 0x42,
-	0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x70, 0x61, 0x75, 0x73, 0x65, 0x64,
-	0x5f, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x11, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x70,
-	0x61, 0x75, 0x73, 0x65, 0x64, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x3f, 0x0a, 0x0e, 0x62,
-	0x72, 0x69, 0x64, 0x67, 0x65, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x12, 0x20,
-	0x01, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e,
-	0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x0d, 0x62,
-	0x72, 0x69, 0x64, 0x67, 0x65, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x25, 0x0a, 0x0e,
-	0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x5f, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x18, 0x13,
-	0x20, 0x01, 0x28, 0x08, 0x52, 0x0d, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x45, 0x6e, 0x61, 0x62,
-	0x6c, 0x65, 0x64, 0x12, 0x3d, 0x0a, 0x0d, 0x61, 0x73, 0x73, 0x65, 0x74, 0x5f, 0x6d, 0x61, 0x6e,
-	0x61, 0x67, 0x65, 0x72, 0x18, 0x14, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14,
-	0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74,
-	0x72, 0x69, 0x6e, 0x67, 0x52, 0x0c, 0x61, 0x73, 0x73, 0x65, 0x74, 0x4d, 0x61, 0x6e, 0x61, 0x67,
-	0x65, 0x72, 0x12, 0x28, 0x0a, 0x10, 0x66, 0x65, 0x65, 0x5f, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64,
-	0x5f, 0x73, 0x74, 0x61, 0x72, 0x74, 0x18, 0x15, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0e, 0x66, 0x65,
-	0x65, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x53, 0x74, 0x61, 0x72, 0x74, 0x12, 0x2c, 0x0a, 0x12,
-	0x66, 0x65, 0x65, 0x5f, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x6f,
-	0x75, 0x74, 0x18, 0x16, 0x20, 0x01, 0x28, 0x03, 0x52, 0x10, 0x66, 0x65, 0x65, 0x50, 0x65, 0x72,
-	0x69, 0x6f, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x12, 0x4f, 0x0a, 0x13, 0x6f, 0x75,
-	0x74, 0x73, 0x74, 0x61, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x5f, 0x61, 0x75, 0x6d, 0x5f, 0x66, 0x65,
-	0x65, 0x18, 0x17, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
-	0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x43, 0x6f,
-	0x69, 0x6e, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x11, 0x6f, 0x75, 0x74, 0x73, 0x74, 0x61,
-	0x6e, 0x64, 0x69, 0x6e, 0x67, 0x41, 0x75, 0x6d, 0x46, 0x65, 0x65, 0x12, 0x20, 0x0a, 0x0c, 0x61,
-	0x75, 0x6d, 0x5f, 0x66, 0x65, 0x65, 0x5f, 0x62, 0x69, 0x70, 0x73, 0x18, 0x18, 0x20, 0x01, 0x28,
-	0x0d, 0x52, 0x0a, 0x61, 0x75, 0x6d, 0x46, 0x65, 0x65, 0x42, 0x69, 0x70, 0x73, 0x12, 0x3f, 0x0a,
-	0x11, 0x6d, 0x69, 0x6e, 0x5f, 0x73, 0x77, 0x61, 0x70, 0x5f, 0x69, 0x6e, 0x5f, 0x76, 0x61, 0x6c,
-	0x75, 0x65, 0x18, 0x19, 0x20, 0x01, 0x28, 0x09, 0x42, 0x14, 0xd2, 0xb4, 0x2d, 0x10, 0x63, 0x6f,
-	0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x49, 0x6e, 0x74, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x0e,
-	0x6d, 0x69, 0x6e, 0x53, 0x77, 0x61, 0x70, 0x49, 0x6e, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x41,
-	0x0a, 0x12, 0x6d, 0x69, 0x6e, 0x5f, 0x73, 0x77, 0x61, 0x70, 0x5f, 0x6f, 0x75, 0x74, 0x5f, 0x76,
-	0x61, 0x6c, 0x75, 0x65, 0x18, 0x1a, 0x20, 0x01, 0x28, 0x09, 0x42, 0x14, 0xd2, 0xb4, 0x2d, 0x10,
-	0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x49, 0x6e, 0x74, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67,
-	0x52, 0x0f, 0x6d, 0x69, 0x6e, 0x53, 0x77, 0x61, 0x70, 0x4f, 0x75, 0x74, 0x56, 0x61, 0x6c, 0x75,
-	0x65, 0x12, 0x3f, 0x0a, 0x11, 0x6d, 0x61, 0x78, 0x5f, 0x73, 0x77, 0x61, 0x70, 0x5f, 0x69, 0x6e,
-	0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x1b, 0x20, 0x01, 0x28, 0x09, 0x42, 0x14, 0xd2, 0xb4,
-	0x2d, 0x10, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x49, 0x6e, 0x74, 0x53, 0x74, 0x72, 0x69,
-	0x6e, 0x67, 0x52, 0x0e, 0x6d, 0x61, 0x78, 0x53, 0x77, 0x61, 0x70, 0x49, 0x6e, 0x56, 0x61, 0x6c,
-	0x75, 0x65, 0x12, 0x41, 0x0a, 0x12, 0x6d, 0x61, 0x78, 0x5f, 0x73, 0x77, 0x61, 0x70, 0x5f, 0x6f,
-	0x75, 0x74, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x1c, 0x20, 0x01, 0x28, 0x09, 0x42, 0x14,
-	0xd2, 0xb4, 0x2d, 0x10, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x49, 0x6e, 0x74, 0x53, 0x74,
-	0x72, 0x69, 0x6e, 0x67, 0x52, 0x0f, 0x6d, 0x61, 0x78, 0x53, 0x77, 0x61, 0x70, 0x4f, 0x75, 0x74,
-	0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x3d, 0x0a, 0x0d, 0x6e, 0x61, 0x76, 0x5f, 0x61, 0x75, 0x74,
-	0x68, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x18, 0x1d, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4,
-	0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
-	0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x0c, 0x6e, 0x61, 0x76, 0x41, 0x75, 0x74, 0x68, 0x6f,
-	0x72, 0x69, 0x74, 0x79, 0x22, 0xaf, 0x02, 0x0a, 0x08, 0x56, 0x61, 0x75, 0x6c, 0x74, 0x4e, 0x41,
-	0x56, 0x12, 0x14, 0x0a, 0x05, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x05, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x12, 0x35, 0x0a, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e,
-	0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x43, 0x6f, 0x69,
-	0x6e, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x12, 0x43,
-	0x0a, 0x06, 0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x2b,
-	0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x15, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64,
-	0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x49, 0x6e, 0x74, 0xd2, 0xb4, 0x2d,
-	0x0a, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x49, 0x6e, 0x74, 0x52, 0x06, 0x76, 0x6f, 0x6c,
-	0x75, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x18, 0x04, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x30, 0x0a, 0x14, 0x75,
-	0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x68, 0x65, 0x69,
-	0x67, 0x68, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x12, 0x75, 0x70, 0x64, 0x61, 0x74,
-	0x65, 0x64, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x47, 0x0a,
-	0x0c, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x06, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x42,
-	0x08, 0xc8, 0xde, 0x1f, 0x00, 0x90, 0xdf, 0x1f, 0x01, 0x52, 0x0b, 0x75, 0x70, 0x64, 0x61, 0x74,
-	0x65, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x22, 0x97, 0x01, 0x0a, 0x0e, 0x41, 0x63, 0x63, 0x6f, 0x75,
-	0x6e, 0x74, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64,
-	0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72,
-	0x65, 0x73, 0x73, 0x12, 0x61, 0x0a, 0x05, 0x63, 0x6f, 0x69, 0x6e, 0x73, 0x18, 0x02, 0x20, 0x03,
-	0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65,
-	0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x42, 0x30, 0xc8,
-	0xde, 0x1f, 0x00, 0xaa, 0xdf, 0x1f, 0x28, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f,
-	0x6d, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2d,
-	0x73, 0x64, 0x6b, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x73, 0x52,
-	0x05, 0x63, 0x6f, 0x69, 0x6e, 0x73, 0x3a, 0x08, 0x88, 0xa0, 0x1f, 0x00, 0xe8, 0xa0, 0x1f, 0x00,
-	0x22, 0x84, 0x02, 0x0a, 0x0e, 0x50, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x53, 0x77, 0x61, 0x70,
-	0x4f, 0x75, 0x74, 0x12, 0x2e, 0x0a, 0x05, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01,
+	0x6f, 0x74, 0x6f, 0x1a, 0x19, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2f, 0x61, 0x6e, 0x79, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1f,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f,
+	0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22,
+	0xca, 0x17, 0x0a, 0x0c, 0x56, 0x61, 0x75, 0x6c, 0x74, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x12, 0x49, 0x0a, 0x0c, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e,
+	0x61, 0x75, 0x74, 0x68, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x42, 0x61, 0x73,
+	0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x42, 0x04, 0xd0, 0xde, 0x1f, 0x01, 0x52, 0x0b,
+	0x62, 0x61, 0x73, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x42, 0x0a, 0x0c, 0x74,
+	0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x73, 0x68, 0x61, 0x72, 0x65, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e,
+	0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x42, 0x04, 0xc8, 0xde,
+	0x1f, 0x00, 0x52, 0x0b, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x53, 0x68, 0x61, 0x72, 0x65, 0x73, 0x12,
+	0x29, 0x0a, 0x10, 0x75, 0x6e, 0x64, 0x65, 0x72, 0x6c, 0x79, 0x69, 0x6e, 0x67, 0x5f, 0x61, 0x73,
+	0x73, 0x65, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x75, 0x6e, 0x64, 0x65, 0x72,
+	0x6c, 0x79, 0x69, 0x6e, 0x67, 0x41, 0x73, 0x73, 0x65, 0x74, 0x12, 0x27, 0x0a, 0x0d, 0x70, 0x61,
+	0x79, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x09, 0x42, 0x02, 0x18, 0x01, 0x52, 0x0c, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x44, 0x65,
+	0x6e, 0x6f, 0x6d, 0x12, 0x2e, 0x0a, 0x05, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x18, 0x05, 0x20, 0x01,
 	0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41,
-	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x05, 0x6f, 0x77,
-	0x6e, 0x65, 0x72, 0x12, 0x3d, 0x0a, 0x0d, 0x76, 0x61, 0x75, 0x6c, 0x74, 0x5f, 0x61, 0x64, 0x64,
+	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x05, 0x61, 0x64,
+	0x6d, 0x69, 0x6e, 0x12, 0x48, 0x0a, 0x15, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x5f, 0x69,
+	0x6e, 0x74, 0x65, 0x72, 0x65, 0x73, 0x74, 0x5f, 0x72, 0x61, 0x74, 0x65, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x09, 0x42, 0x14, 0xd2, 0xb4, 0x2d, 0x10, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x44,
+	0x65, 0x63, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x13, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e,
+	0x74, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x65, 0x73, 0x74, 0x52, 0x61, 0x74, 0x65, 0x12, 0x48, 0x0a,
+	0x15, 0x64, 0x65, 0x73, 0x69, 0x72, 0x65, 0x64, 0x5f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x65, 0x73,
+	0x74, 0x5f, 0x72, 0x61, 0x74, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x42, 0x14, 0xd2, 0xb4,
+	0x2d, 0x10, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x44, 0x65, 0x63, 0x53, 0x74, 0x72, 0x69,
+	0x6e, 0x67, 0x52, 0x13, 0x64, 0x65, 0x73, 0x69, 0x72, 0x65, 0x64, 0x49, 0x6e, 0x74, 0x65, 0x72,
+	0x65, 0x73, 0x74, 0x52, 0x61, 0x74, 0x65, 0x12, 0x40, 0x0a, 0x11, 0x6d, 0x69, 0x6e, 0x5f, 0x69,
+	0x6e, 0x74, 0x65, 0x72, 0x65, 0x73, 0x74, 0x5f, 0x72, 0x61, 0x74, 0x65, 0x18, 0x08, 0x20, 0x01,
+	0x28, 0x09, 0x42, 0x14, 0xd2, 0xb4, 0x2d, 0x10, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x44,
+	0x65, 0x63, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x0f, 0x6d, 0x69, 0x6e, 0x49, 0x6e, 0x74,
+	0x65, 0x72, 0x65, 0x73, 0x74, 0x52, 0x61, 0x74, 0x65, 0x12, 0x40, 0x0a, 0x11, 0x6d, 0x61, 0x78,
+	0x5f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x65, 0x73, 0x74, 0x5f, 0x72, 0x61, 0x74, 0x65, 0x18, 0x09,
+	0x20, 0x01, 0x28, 0x09, 0x42, 0x14, 0xd2, 0xb4, 0x2d, 0x10, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
+	0x2e, 0x44, 0x65, 0x63, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x0f, 0x6d, 0x61, 0x78, 0x49,
+	0x6e, 0x74, 0x65, 0x72, 0x65, 0x73, 0x74, 0x52, 0x61, 0x74, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x70,
+	0x65, 0x72, 0x69, 0x6f, 0x64, 0x5f, 0x73, 0x74, 0x61, 0x72, 0x74, 0x18, 0x0a, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x0b, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x53, 0x74, 0x61, 0x72, 0x74, 0x12, 0x25,
+	0x0a, 0x0e, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74,
+	0x18, 0x0b, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0d, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x54, 0x69,
+	0x6d, 0x65, 0x6f, 0x75, 0x74, 0x12, 0x26, 0x0a, 0x0f, 0x73, 0x77, 0x61, 0x70, 0x5f, 0x69, 0x6e,
+	0x5f, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0d,
+	0x73, 0x77, 0x61, 0x70, 0x49, 0x6e, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x12, 0x28, 0x0a,
+	0x10, 0x73, 0x77, 0x61, 0x70, 0x5f, 0x6f, 0x75, 0x74, 0x5f, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65,
+	0x64, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0e, 0x73, 0x77, 0x61, 0x70, 0x4f, 0x75, 0x74,
+	0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x12, 0x38, 0x0a, 0x18, 0x77, 0x69, 0x74, 0x68, 0x64,
+	0x72, 0x61, 0x77, 0x61, 0x6c, 0x5f, 0x64, 0x65, 0x6c, 0x61, 0x79, 0x5f, 0x73, 0x65, 0x63, 0x6f,
+	0x6e, 0x64, 0x73, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x04, 0x52, 0x16, 0x77, 0x69, 0x74, 0x68, 0x64,
+	0x72, 0x61, 0x77, 0x61, 0x6c, 0x44, 0x65, 0x6c, 0x61, 0x79, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64,
+	0x73, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x61, 0x75, 0x73, 0x65, 0x64, 0x18, 0x0f, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x06, 0x70, 0x61, 0x75, 0x73, 0x65, 0x64, 0x12, 0x46, 0x0a, 0x0e, 0x70, 0x61, 0x75,
+	0x73, 0x65, 0x64, 0x5f, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x10, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e,
+	0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x42, 0x04, 0xc8, 0xde,
+	0x1f, 0x00, 0x52, 0x0d, 0x70, 0x61, 0x75, 0x73, 0x65, 0x64, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63,
+	0x65, 0x12, 0x23, 0x0a, 0x0d, 0x70, 0x61, 0x75, 0x73, 0x65, 0x64, 0x5f, 0x72, 0x65, 0x61, 0x73,
+	0x6f, 0x6e, 0x18, 0x11, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x70, 0x61, 0x75, 0x73, 0x65, 0x64,
+	0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x3f, 0x0a, 0x0e, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65,
+	0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x12, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18,
+	0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65,
+	0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x0d, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65,
+	0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x25, 0x0a, 0x0e, 0x62, 0x72, 0x69, 0x64, 0x67,
+	0x65, 0x5f, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x18, 0x13, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x0d, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x12, 0x3d,
+	0x0a, 0x0d, 0x61, 0x73, 0x73, 0x65, 0x74, 0x5f, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x18,
+	0x14, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f,
+	0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52,
+	0x0c, 0x61, 0x73, 0x73, 0x65, 0x74, 0x4d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x12, 0x28, 0x0a,
+	0x10, 0x66, 0x65, 0x65, 0x5f, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x5f, 0x73, 0x74, 0x61, 0x72,
+	0x74, 0x18, 0x15, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0e, 0x66, 0x65, 0x65, 0x50, 0x65, 0x72, 0x69,
+	0x6f, 0x64, 0x53, 0x74, 0x61, 0x72, 0x74, 0x12, 0x2c, 0x0a, 0x12, 0x66, 0x65, 0x65, 0x5f, 0x70,
+	0x65, 0x72, 0x69, 0x6f, 0x64, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x18, 0x16, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x10, 0x66, 0x65, 0x65, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x54, 0x69,
+	0x6d, 0x65, 0x6f, 0x75, 0x74, 0x12, 0x4f, 0x0a, 0x13, 0x6f, 0x75, 0x74, 0x73, 0x74, 0x61, 0x6e,
+	0x64, 0x69, 0x6e, 0x67, 0x5f, 0x61, 0x75, 0x6d, 0x5f, 0x66, 0x65, 0x65, 0x18, 0x17, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65,
+	0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x42, 0x04, 0xc8,
+	0xde, 0x1f, 0x00, 0x52, 0x11, 0x6f, 0x75, 0x74, 0x73, 0x74, 0x61, 0x6e, 0x64, 0x69, 0x6e, 0x67,
+	0x41, 0x75, 0x6d, 0x46, 0x65, 0x65, 0x12, 0x20, 0x0a, 0x0c, 0x61, 0x75, 0x6d, 0x5f, 0x66, 0x65,
+	0x65, 0x5f, 0x62, 0x69, 0x70, 0x73, 0x18, 0x18, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x61, 0x75,
+	0x6d, 0x46, 0x65, 0x65, 0x42, 0x69, 0x70, 0x73, 0x12, 0x3f, 0x0a, 0x11, 0x6d, 0x69, 0x6e, 0x5f,
+	0x73, 0x77, 0x61, 0x70, 0x5f, 0x69, 0x6e, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x19, 0x20,
+	0x01, 0x28, 0x09, 0x42, 0x14, 0xd2, 0xb4, 0x2d, 0x10, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e,
+	0x49, 0x6e, 0x74, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x0e, 0x6d, 0x69, 0x6e, 0x53, 0x77,
+	0x61, 0x70, 0x49, 0x6e, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x41, 0x0a, 0x12, 0x6d, 0x69, 0x6e,
+	0x5f, 0x73, 0x77, 0x61, 0x70, 0x5f, 0x6f, 0x75, 0x74, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18,
+	0x1a, 0x20, 0x01, 0x28, 0x09, 0x42, 0x14, 0xd2, 0xb4, 0x2d, 0x10, 0x63, 0x6f, 0x73, 0x6d, 0x6f,
+	0x73, 0x2e, 0x49, 0x6e, 0x74, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x0f, 0x6d, 0x69, 0x6e,
+	0x53, 0x77, 0x61, 0x70, 0x4f, 0x75, 0x74, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x3f, 0x0a, 0x11,
+	0x6d, 0x61, 0x78, 0x5f, 0x73, 0x77, 0x61, 0x70, 0x5f, 0x69, 0x6e, 0x5f, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x18, 0x1b, 0x20, 0x01, 0x28, 0x09, 0x42, 0x14, 0xd2, 0xb4, 0x2d, 0x10, 0x63, 0x6f, 0x73,
+	0x6d, 0x6f, 0x73, 0x2e, 0x49, 0x6e, 0x74, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x0e, 0x6d,
+	0x61, 0x78, 0x53, 0x77, 0x61, 0x70, 0x49, 0x6e, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x41, 0x0a,
+	0x12, 0x6d, 0x61, 0x78, 0x5f, 0x73, 0x77, 0x61, 0x70, 0x5f, 0x6f, 0x75, 0x74, 0x5f, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x18, 0x1c, 0x20, 0x01, 0x28, 0x09, 0x42, 0x14, 0xd2, 0xb4, 0x2d, 0x10, 0x63,
+	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x49, 0x6e, 0x74, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52,
+	0x0f, 0x6d, 0x61, 0x78, 0x53, 0x77, 0x61, 0x70, 0x4f, 0x75, 0x74, 0x56, 0x61, 0x6c, 0x75, 0x65,
+	0x12, 0x3d, 0x0a, 0x0d, 0x6e, 0x61, 0x76, 0x5f, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x74,
+	0x79, 0x18, 0x1d, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73,
+	0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e,
+	0x67, 0x52, 0x0c, 0x6e, 0x61, 0x76, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x12,
+	0x2d, 0x0a, 0x13, 0x6d, 0x61, 0x78, 0x5f, 0x6e, 0x61, 0x76, 0x5f, 0x61, 0x67, 0x65, 0x5f, 0x73,
+	0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18, 0x1e, 0x20, 0x01, 0x28, 0x04, 0x52, 0x10, 0x6d, 0x61,
+	0x78, 0x4e, 0x61, 0x76, 0x41, 0x67, 0x65, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x12, 0x34,
+	0x0a, 0x17, 0x61, 0x75, 0x74, 0x6f, 0x5f, 0x70, 0x61, 0x75, 0x73, 0x65, 0x5f, 0x6f, 0x6e, 0x5f,
+	0x73, 0x74, 0x61, 0x6c, 0x65, 0x5f, 0x6e, 0x61, 0x76, 0x18, 0x1f, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x13, 0x61, 0x75, 0x74, 0x6f, 0x50, 0x61, 0x75, 0x73, 0x65, 0x4f, 0x6e, 0x53, 0x74, 0x61, 0x6c,
+	0x65, 0x4e, 0x61, 0x76, 0x12, 0x2d, 0x0a, 0x13, 0x6d, 0x61, 0x78, 0x5f, 0x6e, 0x61, 0x76, 0x5f,
+	0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x5f, 0x62, 0x69, 0x70, 0x73, 0x18, 0x20, 0x20, 0x01, 0x28,
+	0x0d, 0x52, 0x10, 0x6d, 0x61, 0x78, 0x4e, 0x61, 0x76, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x42,
+	0x69, 0x70, 0x73, 0x12, 0x3b, 0x0a, 0x0c, 0x6e, 0x61, 0x76, 0x5f, 0x61, 0x70, 0x70, 0x72, 0x6f,
+	0x76, 0x65, 0x72, 0x18, 0x21, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63,
+	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72,
+	0x69, 0x6e, 0x67, 0x52, 0x0b, 0x6e, 0x61, 0x76, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x72,
+	0x12, 0x39, 0x0a, 0x0b, 0x6e, 0x61, 0x76, 0x5f, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x18,
+	0x22, 0x20, 0x03, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f,
+	0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52,
+	0x0a, 0x6e, 0x61, 0x76, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x12, 0x2a, 0x0a, 0x11, 0x6e,
+	0x61, 0x76, 0x5f, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x71, 0x75, 0x6f, 0x72, 0x75, 0x6d,
+	0x18, 0x23, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0f, 0x6e, 0x61, 0x76, 0x53, 0x6f, 0x75, 0x72, 0x63,
+	0x65, 0x51, 0x75, 0x6f, 0x72, 0x75, 0x6d, 0x12, 0x4a, 0x0a, 0x0f, 0x6e, 0x61, 0x76, 0x5f, 0x61,
+	0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x24, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x21, 0x2e, 0x70, 0x72, 0x6f, 0x76, 0x6c, 0x61, 0x62, 0x73, 0x2e, 0x76, 0x61, 0x75, 0x6c,
+	0x74, 0x2e, 0x76, 0x31, 0x2e, 0x4e, 0x41, 0x56, 0x41, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x52, 0x0e, 0x6e, 0x61, 0x76, 0x41, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x12, 0x3a, 0x0a, 0x1a, 0x6e, 0x61, 0x76, 0x5f, 0x73, 0x6f, 0x75, 0x72, 0x63,
+	0x65, 0x5f, 0x6d, 0x61, 0x78, 0x5f, 0x61, 0x67, 0x65, 0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64,
+	0x73, 0x18, 0x25, 0x20, 0x01, 0x28, 0x04, 0x52, 0x16, 0x6e, 0x61, 0x76, 0x53, 0x6f, 0x75, 0x72,
+	0x63, 0x65, 0x4d, 0x61, 0x78, 0x41, 0x67, 0x65, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x12,
+	0x47, 0x0a, 0x0d, 0x70, 0x72, 0x69, 0x63, 0x65, 0x5f, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73,
+	0x18, 0x26, 0x20, 0x03, 0x28, 0x0e, 0x32, 0x22, 0x2e, 0x70, 0x72, 0x6f, 0x76, 0x6c, 0x61, 0x62,
+	0x73, 0x2e, 0x76, 0x61, 0x75, 0x6c, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x72, 0x69, 0x63, 0x65,
+	0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x54, 0x79, 0x70, 0x65, 0x52, 0x0c, 0x70, 0x72, 0x69, 0x63,
+	0x65, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x12, 0x3a, 0x0a, 0x19, 0x73, 0x65, 0x74, 0x74,
+	0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x74, 0x6f, 0x6c, 0x65, 0x72, 0x61, 0x6e, 0x63, 0x65,
+	0x5f, 0x62, 0x69, 0x70, 0x73, 0x18, 0x27, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x17, 0x73, 0x65, 0x74,
+	0x74, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x54, 0x6f, 0x6c, 0x65, 0x72, 0x61, 0x6e, 0x63, 0x65,
+	0x42, 0x69, 0x70, 0x73, 0x12, 0x37, 0x0a, 0x18, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x5f, 0x6e,
+	0x61, 0x76, 0x5f, 0x6f, 0x6e, 0x5f, 0x73, 0x65, 0x74, 0x74, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74,
+	0x18, 0x28, 0x20, 0x01, 0x28, 0x08, 0x52, 0x15, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4e, 0x61,
+	0x76, 0x4f, 0x6e, 0x53, 0x65, 0x74, 0x74, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x59, 0x0a,
+	0x12, 0x61, 0x63, 0x71, 0x75, 0x69, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x70, 0x6f, 0x6c,
+	0x69, 0x63, 0x79, 0x18, 0x29, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x70, 0x72, 0x6f, 0x76,
+	0x6c, 0x61, 0x62, 0x73, 0x2e, 0x76, 0x61, 0x75, 0x6c, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x63,
+	0x71, 0x75, 0x69, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x42,
+	0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x11, 0x61, 0x63, 0x71, 0x75, 0x69, 0x73, 0x69, 0x74, 0x69,
+	0x6f, 0x6e, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x3f, 0x0a, 0x1c, 0x6d, 0x61, 0x78, 0x5f,
+	0x61, 0x73, 0x73, 0x65, 0x74, 0x5f, 0x63, 0x6f, 0x6e, 0x63, 0x65, 0x6e, 0x74, 0x72, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x5f, 0x62, 0x69, 0x70, 0x73, 0x18, 0x2a, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x19,
+	0x6d, 0x61, 0x78, 0x41, 0x73, 0x73, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x63, 0x65, 0x6e, 0x74, 0x72,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x69, 0x70, 0x73, 0x12, 0x35, 0x0a, 0x17, 0x6d, 0x61, 0x78,
+	0x5f, 0x6e, 0x6f, 0x6e, 0x5f, 0x75, 0x6e, 0x64, 0x65, 0x72, 0x6c, 0x79, 0x69, 0x6e, 0x67, 0x5f,
+	0x62, 0x69, 0x70, 0x73, 0x18, 0x2b, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x14, 0x6d, 0x61, 0x78, 0x4e,
+	0x6f, 0x6e, 0x55, 0x6e, 0x64, 0x65, 0x72, 0x6c, 0x79, 0x69, 0x6e, 0x67, 0x42, 0x69, 0x70, 0x73,
+	0x12, 0x2c, 0x0a, 0x12, 0x6d, 0x69, 0x6e, 0x5f, 0x6c, 0x69, 0x71, 0x75, 0x69, 0x64, 0x69, 0x74,
+	0x79, 0x5f, 0x62, 0x69, 0x70, 0x73, 0x18, 0x2c, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x10, 0x6d, 0x69,
+	0x6e, 0x4c, 0x69, 0x71, 0x75, 0x69, 0x64, 0x69, 0x74, 0x79, 0x42, 0x69, 0x70, 0x73, 0x12, 0x43,
+	0x0a, 0x0b, 0x72, 0x6f, 0x6c, 0x65, 0x5f, 0x67, 0x72, 0x61, 0x6e, 0x74, 0x73, 0x18, 0x2d, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x70, 0x72, 0x6f, 0x76, 0x6c, 0x61, 0x62, 0x73, 0x2e, 0x76,
+	0x61, 0x75, 0x6c, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x6f, 0x6c, 0x65, 0x47, 0x72, 0x61, 0x6e,
+	0x74, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x0a, 0x72, 0x6f, 0x6c, 0x65, 0x47, 0x72, 0x61,
+	0x6e, 0x74, 0x73, 0x12, 0x5d, 0x0a, 0x16, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x5f, 0x61,
+	0x64, 0x6d, 0x69, 0x6e, 0x5f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x18, 0x2e, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x70, 0x72, 0x6f, 0x76, 0x6c, 0x61, 0x62, 0x73, 0x2e, 0x76,
+	0x61, 0x75, 0x6c, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x41,
+	0x64, 0x6d, 0x69, 0x6e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x52, 0x14, 0x70, 0x65,
+	0x6e, 0x64, 0x69, 0x6e, 0x67, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66,
+	0x65, 0x72, 0x12, 0x29, 0x0a, 0x10, 0x74, 0x69, 0x6d, 0x65, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x73,
+	0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18, 0x2f, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0f, 0x74, 0x69,
+	0x6d, 0x65, 0x6c, 0x6f, 0x63, 0x6b, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x12, 0x59, 0x0a,
+	0x12, 0x65, 0x6c, 0x69, 0x67, 0x69, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x5f, 0x70, 0x6f, 0x6c,
+	0x69, 0x63, 0x79, 0x18, 0x30, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x70, 0x72, 0x6f, 0x76,
+	0x6c, 0x61, 0x62, 0x73, 0x2e, 0x76, 0x61, 0x75, 0x6c, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x6c,
+	0x69, 0x67, 0x69, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x42,
+	0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x11, 0x65, 0x6c, 0x69, 0x67, 0x69, 0x62, 0x69, 0x6c, 0x69,
+	0x74, 0x79, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x42, 0x0a, 0x12, 0x6d, 0x61, 0x78, 0x5f,
+	0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x31,
+	0x20, 0x01, 0x28, 0x09, 0x42, 0x14, 0xd2, 0xb4, 0x2d, 0x10, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
+	0x2e, 0x49, 0x6e, 0x74, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x10, 0x6d, 0x61, 0x78, 0x50,
+	0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x50, 0x0a, 0x17,
+	0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x63, 0x61, 0x70, 0x5f, 0x65, 0x78, 0x65,
+	0x6d, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x32, 0x20, 0x03, 0x28, 0x09, 0x42, 0x18, 0xd2,
+	0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73,
+	0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x15, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f,
+	0x6e, 0x43, 0x61, 0x70, 0x45, 0x78, 0x65, 0x6d, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x3c,
+	0x0a, 0x0f, 0x6d, 0x61, 0x78, 0x5f, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x18, 0x33, 0x20, 0x01, 0x28, 0x09, 0x42, 0x14, 0xd2, 0xb4, 0x2d, 0x10, 0x63, 0x6f, 0x73,
+	0x6d, 0x6f, 0x73, 0x2e, 0x49, 0x6e, 0x74, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x0d, 0x6d,
+	0x61, 0x78, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x5e, 0x0a, 0x11,
+	0x45, 0x6c, 0x69, 0x67, 0x69, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x50, 0x6f, 0x6c, 0x69, 0x63,
+	0x79, 0x12, 0x18, 0x0a, 0x07, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x07, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x12, 0x2f, 0x0a, 0x13, 0x72,
+	0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74,
+	0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x12, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72,
+	0x65, 0x64, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x73, 0x22, 0x90, 0x01, 0x0a,
+	0x14, 0x50, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x54, 0x72, 0x61,
+	0x6e, 0x73, 0x66, 0x65, 0x72, 0x12, 0x35, 0x0a, 0x09, 0x6e, 0x65, 0x77, 0x5f, 0x61, 0x64, 0x6d,
+	0x69, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f,
+	0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69,
+	0x6e, 0x67, 0x52, 0x08, 0x6e, 0x65, 0x77, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x12, 0x41, 0x0a, 0x0b,
+	0x65, 0x78, 0x70, 0x69, 0x72, 0x79, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x42, 0x04, 0x90,
+	0xdf, 0x1f, 0x01, 0x52, 0x0a, 0x65, 0x78, 0x70, 0x69, 0x72, 0x79, 0x54, 0x69, 0x6d, 0x65, 0x22,
+	0x80, 0x02, 0x0a, 0x0f, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x64, 0x41, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x02, 0x69, 0x64, 0x12, 0x3d, 0x0a, 0x0d, 0x76, 0x61, 0x75, 0x6c, 0x74, 0x5f, 0x61, 0x64, 0x64,
 	0x72, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14,
 	0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74,
 	0x72, 0x69, 0x6e, 0x67, 0x52, 0x0c, 0x76, 0x61, 0x75, 0x6c, 0x74, 0x41, 0x64, 0x64, 0x72, 0x65,
-	0x73, 0x73, 0x12, 0x37, 0x0a, 0x06, 0x73, 0x68, 0x61, 0x72, 0x65, 0x73, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65,
-	0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x42, 0x04, 0xc8,
-	0xde, 0x1f, 0x00, 0x52, 0x06, 0x73, 0x68, 0x61, 0x72, 0x65, 0x73, 0x12, 0x25, 0x0a, 0x0c, 0x72,
-	0x65, 0x64, 0x65, 0x65, 0x6d, 0x5f, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x18, 0x04, 0x20, 0x01, 0x28,
-	0x09, 0x42, 0x02, 0x18, 0x01, 0x52, 0x0b, 0x72, 0x65, 0x64, 0x65, 0x65, 0x6d, 0x44, 0x65, 0x6e,
-	0x6f, 0x6d, 0x12, 0x23, 0x0a, 0x0d, 0x66, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x5f, 0x63, 0x6f,
-	0x75, 0x6e, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0c, 0x66, 0x61, 0x69, 0x6c, 0x75,
-	0x72, 0x65, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x42, 0xc2, 0x01, 0x0a, 0x15, 0x63, 0x6f, 0x6d, 0x2e,
+	0x73, 0x73, 0x12, 0x36, 0x0a, 0x09, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f,
+	0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52,
+	0x09, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x12, 0x21, 0x0a, 0x0c, 0x65, 0x78,
+	0x65, 0x63, 0x75, 0x74, 0x65, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x0b, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x43, 0x0a,
+	0x03, 0x6d, 0x73, 0x67, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x41, 0x6e, 0x79,
+	0x42, 0x1b, 0xca, 0xb4, 0x2d, 0x17, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73,
+	0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x52, 0x03, 0x6d,
+	0x73, 0x67, 0x22, 0x71, 0x0a, 0x09, 0x52, 0x6f, 0x6c, 0x65, 0x47, 0x72, 0x61, 0x6e, 0x74, 0x12,
+	0x30, 0x0a, 0x04, 0x72, 0x6f, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1c, 0x2e,
 	0x70, 0x72, 0x6f, 0x76, 0x6c, 0x61, 0x62, 0x73, 0x2e, 0x76, 0x61, 0x75, 0x6c, 0x74, 0x2e, 0x76,
-	0x31, 0x42, 0x0a, 0x56, 0x61, 0x75, 0x6c, 0x74, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a,
-	0x37, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x70, 0x72, 0x6f, 0x76,
-	0x6c, 0x61, 0x62, 0x73, 0x2f, 0x76, 0x61, 0x75, 0x6c, 0x74, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x70,
-	0x72, 0x6f, 0x76, 0x6c, 0x61, 0x62, 0x73, 0x2f, 0x76, 0x61, 0x75, 0x6c, 0x74, 0x2f, 0x76, 0x31,
-	0x3b, 0x76, 0x61, 0x75, 0x6c, 0x74, 0x76, 0x31, 0xa2, 0x02, 0x03, 0x50, 0x56, 0x58, 0xaa, 0x02,
-	0x11, 0x50, 0x72, 0x6f, 0x76, 0x6c, 0x61, 0x62, 0x73, 0x2e, 0x56, 0x61, 0x75, 0x6c, 0x74, 0x2e,
-	0x56, 0x31, 0xca, 0x02, 0x11, 0x50, 0x72, 0x6f, 0x76, 0x6c, 0x61, 0x62, 0x73, 0x5c, 0x56, 0x61,
-	0x75, 0x6c, 0x74, 0x5c, 0x56, 0x31, 0xe2, 0x02, 0x1d, 0x50, 0x72, 0x6f, 0x76, 0x6c, 0x61, 0x62,
-	0x73, 0x5c, 0x56, 0x61, 0x75, 0x6c, 0x74, 0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65,
-	0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x13, 0x50, 0x72, 0x6f, 0x76, 0x6c, 0x61, 0x62,
-	0x73, 0x3a, 0x3a, 0x56, 0x61, 0x75, 0x6c, 0x74, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x33,
+	0x31, 0x2e, 0x56, 0x61, 0x75, 0x6c, 0x74, 0x52, 0x6f, 0x6c, 0x65, 0x52, 0x04, 0x72, 0x6f, 0x6c,
+	0x65, 0x12, 0x32, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41,
+	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x07, 0x61, 0x64,
+	0x64, 0x72, 0x65, 0x73, 0x73, 0x22, 0xaf, 0x02, 0x0a, 0x08, 0x56, 0x61, 0x75, 0x6c, 0x74, 0x4e,
+	0x41, 0x56, 0x12, 0x14, 0x0a, 0x05, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x05, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x12, 0x35, 0x0a, 0x05, 0x70, 0x72, 0x69, 0x63,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
+	0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x43, 0x6f,
+	0x69, 0x6e, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x12,
+	0x43, 0x0a, 0x06, 0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42,
+	0x2b, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x15, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73,
+	0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x49, 0x6e, 0x74, 0xd2, 0xb4,
+	0x2d, 0x0a, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x49, 0x6e, 0x74, 0x52, 0x06, 0x76, 0x6f,
+	0x6c, 0x75, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x30, 0x0a, 0x14,
+	0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x68, 0x65,
+	0x69, 0x67, 0x68, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x12, 0x75, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x64, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x47,
+	0x0a, 0x0c, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
+	0x42, 0x08, 0xc8, 0xde, 0x1f, 0x00, 0x90, 0xdf, 0x1f, 0x01, 0x52, 0x0b, 0x75, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x22, 0x85, 0x02, 0x0a, 0x12, 0x50, 0x65, 0x6e, 0x64,
+	0x69, 0x6e, 0x67, 0x4e, 0x41, 0x56, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x12, 0x3d,
+	0x0a, 0x0d, 0x76, 0x61, 0x75, 0x6c, 0x74, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f,
+	0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52,
+	0x0c, 0x76, 0x61, 0x75, 0x6c, 0x74, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x33, 0x0a,
+	0x03, 0x6e, 0x61, 0x76, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x70, 0x72, 0x6f,
+	0x76, 0x6c, 0x61, 0x62, 0x73, 0x2e, 0x76, 0x61, 0x75, 0x6c, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x56,
+	0x61, 0x75, 0x6c, 0x74, 0x4e, 0x41, 0x56, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x03, 0x6e,
+	0x61, 0x76, 0x12, 0x34, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x65, 0x72, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
+	0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x08,
+	0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x65, 0x72, 0x12, 0x45, 0x0a, 0x0b, 0x65, 0x78, 0x70, 0x69,
+	0x72, 0x79, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x42, 0x08, 0xc8, 0xde, 0x1f, 0x00, 0x90,
+	0xdf, 0x1f, 0x01, 0x52, 0x0a, 0x65, 0x78, 0x70, 0x69, 0x72, 0x79, 0x54, 0x69, 0x6d, 0x65, 0x22,
+	0xbb, 0x01, 0x0a, 0x13, 0x4e, 0x41, 0x56, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x53, 0x75, 0x62,
+	0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x3d, 0x0a, 0x0d, 0x76, 0x61, 0x75, 0x6c, 0x74,
+	0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18,
+	0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65,
+	0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x0c, 0x76, 0x61, 0x75, 0x6c, 0x74, 0x41,
+	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x30, 0x0a, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d,
+	0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67,
+	0x52, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x33, 0x0a, 0x03, 0x6e, 0x61, 0x76, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x70, 0x72, 0x6f, 0x76, 0x6c, 0x61, 0x62, 0x73,
+	0x2e, 0x76, 0x61, 0x75, 0x6c, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x56, 0x61, 0x75, 0x6c, 0x74, 0x4e,
+	0x41, 0x56, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x03, 0x6e, 0x61, 0x76, 0x22, 0x97, 0x01,
+	0x0a, 0x0e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65,
+	0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x61, 0x0a, 0x05, 0x63, 0x6f,
+	0x69, 0x6e, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f, 0x73, 0x6d,
+	0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e,
+	0x43, 0x6f, 0x69, 0x6e, 0x42, 0x30, 0xc8, 0xde, 0x1f, 0x00, 0xaa, 0xdf, 0x1f, 0x28, 0x67, 0x69,
+	0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f,
+	0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2d, 0x73, 0x64, 0x6b, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73,
+	0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x73, 0x52, 0x05, 0x63, 0x6f, 0x69, 0x6e, 0x73, 0x3a, 0x08, 0x88,
+	0xa0, 0x1f, 0x00, 0xe8, 0xa0, 0x1f, 0x00, 0x22, 0x84, 0x02, 0x0a, 0x0e, 0x50, 0x65, 0x6e, 0x64,
+	0x69, 0x6e, 0x67, 0x53, 0x77, 0x61, 0x70, 0x4f, 0x75, 0x74, 0x12, 0x2e, 0x0a, 0x05, 0x6f, 0x77,
+	0x6e, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63,
+	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72,
+	0x69, 0x6e, 0x67, 0x52, 0x05, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x12, 0x3d, 0x0a, 0x0d, 0x76, 0x61,
+	0x75, 0x6c, 0x74, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64,
+	0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x0c, 0x76, 0x61, 0x75,
+	0x6c, 0x74, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x37, 0x0a, 0x06, 0x73, 0x68, 0x61,
+	0x72, 0x65, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f, 0x73, 0x6d,
+	0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e,
+	0x43, 0x6f, 0x69, 0x6e, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x06, 0x73, 0x68, 0x61, 0x72,
+	0x65, 0x73, 0x12, 0x25, 0x0a, 0x0c, 0x72, 0x65, 0x64, 0x65, 0x65, 0x6d, 0x5f, 0x64, 0x65, 0x6e,
+	0x6f, 0x6d, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x42, 0x02, 0x18, 0x01, 0x52, 0x0b, 0x72, 0x65,
+	0x64, 0x65, 0x65, 0x6d, 0x44, 0x65, 0x6e, 0x6f, 0x6d, 0x12, 0x23, 0x0a, 0x0d, 0x66, 0x61, 0x69,
+	0x6c, 0x75, 0x72, 0x65, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d,
+	0x52, 0x0c, 0x66, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x72,
+	0x0a, 0x11, 0x41, 0x63, 0x71, 0x75, 0x69, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x6f, 0x6c,
+	0x69, 0x63, 0x79, 0x12, 0x25, 0x0a, 0x0e, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x5f, 0x70, 0x61, 0x74,
+	0x74, 0x65, 0x72, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0d, 0x64, 0x65, 0x6e,
+	0x6f, 0x6d, 0x50, 0x61, 0x74, 0x74, 0x65, 0x72, 0x6e, 0x73, 0x12, 0x36, 0x0a, 0x17, 0x73, 0x63,
+	0x6f, 0x70, 0x65, 0x5f, 0x73, 0x70, 0x65, 0x63, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x15, 0x73, 0x63, 0x6f,
+	0x70, 0x65, 0x53, 0x70, 0x65, 0x63, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49,
+	0x64, 0x73, 0x22, 0x47, 0x0a, 0x0c, 0x41, 0x73, 0x73, 0x65, 0x74, 0x48, 0x61, 0x69, 0x72, 0x63,
+	0x75, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x05, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x12, 0x21, 0x0a, 0x0c, 0x68, 0x61, 0x69, 0x72,
+	0x63, 0x75, 0x74, 0x5f, 0x62, 0x69, 0x70, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0b,
+	0x68, 0x61, 0x69, 0x72, 0x63, 0x75, 0x74, 0x42, 0x69, 0x70, 0x73, 0x22, 0x90, 0x02, 0x0a, 0x15,
+	0x53, 0x68, 0x61, 0x72, 0x65, 0x50, 0x72, 0x69, 0x63, 0x65, 0x4f, 0x62, 0x73, 0x65, 0x72, 0x76,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x38, 0x0a, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x42,
+	0x08, 0xc8, 0xde, 0x1f, 0x00, 0x90, 0xdf, 0x1f, 0x01, 0x52, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x12,
+	0x21, 0x0a, 0x0c, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x65, 0x69, 0x67,
+	0x68, 0x74, 0x12, 0x47, 0x0a, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x42, 0x31, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x1b, 0x63, 0x6f, 0x73, 0x6d, 0x6f,
+	0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x4c, 0x65, 0x67,
+	0x61, 0x63, 0x79, 0x44, 0x65, 0x63, 0xd2, 0xb4, 0x2d, 0x0a, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
+	0x2e, 0x44, 0x65, 0x63, 0x52, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x12, 0x51, 0x0a, 0x0a, 0x63,
+	0x75, 0x6d, 0x75, 0x6c, 0x61, 0x74, 0x69, 0x76, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x42,
+	0x31, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x1b, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73,
+	0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x4c, 0x65, 0x67, 0x61, 0x63,
+	0x79, 0x44, 0x65, 0x63, 0xd2, 0xb4, 0x2d, 0x0a, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x44,
+	0x65, 0x63, 0x52, 0x0a, 0x63, 0x75, 0x6d, 0x75, 0x6c, 0x61, 0x74, 0x69, 0x76, 0x65, 0x22, 0xad,
+	0x03, 0x0a, 0x0f, 0x4f, 0x75, 0x74, 0x62, 0x6f, 0x75, 0x6e, 0x64, 0x50, 0x61, 0x79, 0x6d, 0x65,
+	0x6e, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x65, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x5f, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x65, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61,
+	0x6c, 0x49, 0x64, 0x12, 0x30, 0x0a, 0x06, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e,
+	0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x06, 0x74,
+	0x61, 0x72, 0x67, 0x65, 0x74, 0x12, 0x70, 0x0a, 0x0d, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f,
+	0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63,
+	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74,
+	0x61, 0x31, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x42, 0x30, 0xc8, 0xde, 0x1f, 0x00, 0xaa, 0xdf, 0x1f,
+	0x28, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6f, 0x73, 0x6d,
+	0x6f, 0x73, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2d, 0x73, 0x64, 0x6b, 0x2f, 0x74, 0x79,
+	0x70, 0x65, 0x73, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x73, 0x52, 0x0c, 0x73, 0x6f, 0x75, 0x72, 0x63,
+	0x65, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x70, 0x0a, 0x0d, 0x74, 0x61, 0x72, 0x67, 0x65,
+	0x74, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19,
+	0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62,
+	0x65, 0x74, 0x61, 0x31, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x42, 0x30, 0xc8, 0xde, 0x1f, 0x00, 0xaa,
+	0xdf, 0x1f, 0x28, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6f,
+	0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2d, 0x73, 0x64, 0x6b, 0x2f,
+	0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x73, 0x52, 0x0c, 0x74, 0x61, 0x72,
+	0x67, 0x65, 0x74, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x64, 0x69, 0x72,
+	0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x64, 0x69,
+	0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x45, 0x0a, 0x0b, 0x65, 0x78, 0x70, 0x69, 0x72,
+	0x79, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x42, 0x08, 0xc8, 0xde, 0x1f, 0x00, 0x90, 0xdf,
+	0x1f, 0x01, 0x52, 0x0a, 0x65, 0x78, 0x70, 0x69, 0x72, 0x79, 0x54, 0x69, 0x6d, 0x65, 0x2a, 0x45,
+	0x0a, 0x0e, 0x4e, 0x41, 0x56, 0x41, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x12, 0x1a, 0x0a, 0x16, 0x4e, 0x41, 0x56, 0x5f, 0x41, 0x47, 0x47, 0x52, 0x45, 0x47, 0x41, 0x54,
+	0x49, 0x4f, 0x4e, 0x5f, 0x4d, 0x45, 0x44, 0x49, 0x41, 0x4e, 0x10, 0x00, 0x12, 0x17, 0x0a, 0x13,
+	0x4e, 0x41, 0x56, 0x5f, 0x41, 0x47, 0x47, 0x52, 0x45, 0x47, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f,
+	0x4d, 0x49, 0x4e, 0x10, 0x01, 0x2a, 0x82, 0x01, 0x0a, 0x0f, 0x50, 0x72, 0x69, 0x63, 0x65, 0x53,
+	0x6f, 0x75, 0x72, 0x63, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x22, 0x0a, 0x1e, 0x50, 0x52, 0x49,
+	0x43, 0x45, 0x5f, 0x53, 0x4f, 0x55, 0x52, 0x43, 0x45, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x49,
+	0x4e, 0x54, 0x45, 0x52, 0x4e, 0x41, 0x4c, 0x5f, 0x4e, 0x41, 0x56, 0x10, 0x00, 0x12, 0x20, 0x0a,
+	0x1c, 0x50, 0x52, 0x49, 0x43, 0x45, 0x5f, 0x53, 0x4f, 0x55, 0x52, 0x43, 0x45, 0x5f, 0x54, 0x59,
+	0x50, 0x45, 0x5f, 0x4d, 0x41, 0x52, 0x4b, 0x45, 0x52, 0x5f, 0x4e, 0x41, 0x56, 0x10, 0x01, 0x12,
+	0x29, 0x0a, 0x25, 0x50, 0x52, 0x49, 0x43, 0x45, 0x5f, 0x53, 0x4f, 0x55, 0x52, 0x43, 0x45, 0x5f,
+	0x54, 0x59, 0x50, 0x45, 0x5f, 0x45, 0x58, 0x43, 0x48, 0x41, 0x4e, 0x47, 0x45, 0x5f, 0x53, 0x45,
+	0x54, 0x54, 0x4c, 0x45, 0x4d, 0x45, 0x4e, 0x54, 0x10, 0x02, 0x2a, 0xf2, 0x01, 0x0a, 0x09, 0x56,
+	0x61, 0x75, 0x6c, 0x74, 0x52, 0x6f, 0x6c, 0x65, 0x12, 0x1a, 0x0a, 0x16, 0x56, 0x41, 0x55, 0x4c,
+	0x54, 0x5f, 0x52, 0x4f, 0x4c, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49,
+	0x45, 0x44, 0x10, 0x00, 0x12, 0x15, 0x0a, 0x11, 0x56, 0x41, 0x55, 0x4c, 0x54, 0x5f, 0x52, 0x4f,
+	0x4c, 0x45, 0x5f, 0x50, 0x41, 0x55, 0x53, 0x45, 0x52, 0x10, 0x01, 0x12, 0x1a, 0x0a, 0x16, 0x56,
+	0x41, 0x55, 0x4c, 0x54, 0x5f, 0x52, 0x4f, 0x4c, 0x45, 0x5f, 0x52, 0x41, 0x54, 0x45, 0x5f, 0x53,
+	0x45, 0x54, 0x54, 0x45, 0x52, 0x10, 0x02, 0x12, 0x1d, 0x0a, 0x19, 0x56, 0x41, 0x55, 0x4c, 0x54,
+	0x5f, 0x52, 0x4f, 0x4c, 0x45, 0x5f, 0x4c, 0x49, 0x4d, 0x49, 0x54, 0x53, 0x5f, 0x4d, 0x41, 0x4e,
+	0x41, 0x47, 0x45, 0x52, 0x10, 0x03, 0x12, 0x1d, 0x0a, 0x19, 0x56, 0x41, 0x55, 0x4c, 0x54, 0x5f,
+	0x52, 0x4f, 0x4c, 0x45, 0x5f, 0x52, 0x45, 0x53, 0x45, 0x52, 0x56, 0x45, 0x5f, 0x46, 0x55, 0x4e,
+	0x44, 0x45, 0x52, 0x10, 0x04, 0x12, 0x1c, 0x0a, 0x18, 0x56, 0x41, 0x55, 0x4c, 0x54, 0x5f, 0x52,
+	0x4f, 0x4c, 0x45, 0x5f, 0x4e, 0x41, 0x56, 0x5f, 0x41, 0x55, 0x54, 0x48, 0x4f, 0x52, 0x49, 0x54,
+	0x59, 0x10, 0x05, 0x12, 0x21, 0x0a, 0x1d, 0x56, 0x41, 0x55, 0x4c, 0x54, 0x5f, 0x52, 0x4f, 0x4c,
+	0x45, 0x5f, 0x53, 0x45, 0x54, 0x54, 0x4c, 0x45, 0x4d, 0x45, 0x4e, 0x54, 0x5f, 0x4d, 0x41, 0x4e,
+	0x41, 0x47, 0x45, 0x52, 0x10, 0x06, 0x12, 0x17, 0x0a, 0x13, 0x56, 0x41, 0x55, 0x4c, 0x54, 0x5f,
+	0x52, 0x4f, 0x4c, 0x45, 0x5f, 0x47, 0x55, 0x41, 0x52, 0x44, 0x49, 0x41, 0x4e, 0x10, 0x07, 0x42,
+	0xc2, 0x01, 0x0a, 0x15, 0x63, 0x6f, 0x6d, 0x2e, 0x70, 0x72, 0x6f, 0x76, 0x6c, 0x61, 0x62, 0x73,
+	0x2e, 0x76, 0x61, 0x75, 0x6c, 0x74, 0x2e, 0x76, 0x31, 0x42, 0x0a, 0x56, 0x61, 0x75, 0x6c, 0x74,
+	0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x37, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e,
+	0x63, 0x6f, 0x6d, 0x2f, 0x70, 0x72, 0x6f, 0x76, 0x6c, 0x61, 0x62, 0x73, 0x2f, 0x76, 0x61, 0x75,
+	0x6c, 0x74, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x70, 0x72, 0x6f, 0x76, 0x6c, 0x61, 0x62, 0x73, 0x2f,
+	0x76, 0x61, 0x75, 0x6c, 0x74, 0x2f, 0x76, 0x31, 0x3b, 0x76, 0x61, 0x75, 0x6c, 0x74, 0x76, 0x31,
+	0xa2, 0x02, 0x03, 0x50, 0x56, 0x58, 0xaa, 0x02, 0x11, 0x50, 0x72, 0x6f, 0x76, 0x6c, 0x61, 0x62,
+	0x73, 0x2e, 0x56, 0x61, 0x75, 0x6c, 0x74, 0x2e, 0x56, 0x31, 0xca, 0x02, 0x11, 0x50, 0x72, 0x6f,
+	0x76, 0x6c, 0x61, 0x62, 0x73, 0x5c, 0x56, 0x61, 0x75, 0x6c, 0x74, 0x5c, 0x56, 0x31, 0xe2, 0x02,
+	0x1d, 0x50, 0x72, 0x6f, 0x76, 0x6c, 0x61, 0x62, 0x73, 0x5c, 0x56, 0x61, 0x75, 0x6c, 0x74, 0x5c,
+	0x56, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02,
+	0x13, 0x50, 0x72, 0x6f, 0x76, 0x6c, 0x61, 0x62, 0x73, 0x3a, 0x3a, 0x56, 0x61, 0x75, 0x6c, 0x74,
+	0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -4922,30 +13748,61 @@ func file_provlabs_vault_v1_vault_proto_rawDescGZIP() []byte {
 	return file_provlabs_vault_v1_vault_proto_rawDescData
 }
 
-var file_provlabs_vault_v1_vault_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
+var file_provlabs_vault_v1_vault_proto_enumTypes = make([]protoimpl.EnumInfo, 3)
+var file_provlabs_vault_v1_vault_proto_msgTypes = make([]protoimpl.MessageInfo, 14)
 var file_provlabs_vault_v1_vault_proto_goTypes = []interface{}{
-	(*VaultAccount)(nil),          // 0: provlabs.vault.v1.VaultAccount
-	(*VaultNAV)(nil),              // 1: provlabs.vault.v1.VaultNAV
-	(*AccountBalance)(nil),        // 2: provlabs.vault.v1.AccountBalance
-	(*PendingSwapOut)(nil),        // 3: provlabs.vault.v1.PendingSwapOut
-	(*v1beta1.BaseAccount)(nil),   // 4: cosmos.auth.v1beta1.BaseAccount
-	(*v1beta11.Coin)(nil),         // 5: cosmos.base.v1beta1.Coin
-	(*timestamppb.Timestamp)(nil), // 6: google.protobuf.Timestamp
+	(NAVAggregation)(0),           // 0: provlabs.vault.v1.NAVAggregation
+	(PriceSourceType)(0),          // 1: provlabs.vault.v1.PriceSourceType
+	(VaultRole)(0),                // 2: provlabs.vault.v1.VaultRole
+	(*VaultAccount)(nil),          // 3: provlabs.vault.v1.VaultAccount
+	(*EligibilityPolicy)(nil),     // 4: provlabs.vault.v1.EligibilityPolicy
+	(*PendingAdminTransfer)(nil),  // 5: provlabs.vault.v1.PendingAdminTransfer
+	(*ScheduledAction)(nil),       // 6: provlabs.vault.v1.ScheduledAction
+	(*RoleGrant)(nil),             // 7: provlabs.vault.v1.RoleGrant
+	(*VaultNAV)(nil),              // 8: provlabs.vault.v1.VaultNAV
+	(*PendingNAVProposal)(nil),    // 9: provlabs.vault.v1.PendingNAVProposal
+	(*NAVSourceSubmission)(nil),   // 10: provlabs.vault.v1.NAVSourceSubmission
+	(*AccountBalance)(nil),        // 11: provlabs.vault.v1.AccountBalance
+	(*PendingSwapOut)(nil),        // 12: provlabs.vault.v1.PendingSwapOut
+	(*AcquisitionPolicy)(nil),     // 13: provlabs.vault.v1.AcquisitionPolicy
+	(*AssetHaircut)(nil),          // 14: provlabs.vault.v1.AssetHaircut
+	(*SharePriceObservation)(nil), // 15: provlabs.vault.v1.SharePriceObservation
+	(*OutboundPayment)(nil),       // 16: provlabs.vault.v1.OutboundPayment
+	(*v1beta1.BaseAccount)(nil),   // 17: cosmos.auth.v1beta1.BaseAccount
+	(*v1beta11.Coin)(nil),         // 18: cosmos.base.v1beta1.Coin
+	(*timestamppb.Timestamp)(nil), // 19: google.protobuf.Timestamp
+	(*anypb.Any)(nil),             // 20: google.protobuf.Any
 }
 var file_provlabs_vault_v1_vault_proto_depIdxs = []int32{
-	4, // 0: provlabs.vault.v1.VaultAccount.base_account:type_name -> cosmos.auth.v1beta1.BaseAccount
-	5, // 1: provlabs.vault.v1.VaultAccount.total_shares:type_name -> cosmos.base.v1beta1.Coin
-	5, // 2: provlabs.vault.v1.VaultAccount.paused_balance:type_name -> cosmos.base.v1beta1.Coin
-	5, // 3: provlabs.vault.v1.VaultAccount.outstanding_aum_fee:type_name -> cosmos.base.v1beta1.Coin
-	5, // 4: provlabs.vault.v1.VaultNAV.price:type_name -> cosmos.base.v1beta1.Coin
-	6, // 5: provlabs.vault.v1.VaultNAV.updated_time:type_name -> google.protobuf.Timestamp
-	5, // 6: provlabs.vault.v1.AccountBalance.coins:type_name -> cosmos.base.v1beta1.Coin
-	5, // 7: provlabs.vault.v1.PendingSwapOut.shares:type_name -> cosmos.base.v1beta1.Coin
-	8, // [8:8] is the sub-list for method output_type
-	8, // [8:8] is the sub-list for method input_type
-	8, // [8:8] is the sub-list for extension type_name
-	8, // [8:8] is the sub-list for extension extendee
-	0, // [0:8] is the sub-list for field type_name
+	17, // 0: provlabs.vault.v1.VaultAccount.base_account:type_name -> cosmos.auth.v1beta1.BaseAccount
+	18, // 1: provlabs.vault.v1.VaultAccount.total_shares:type_name -> cosmos.base.v1beta1.Coin
+	18, // 2: provlabs.vault.v1.VaultAccount.paused_balance:type_name -> cosmos.base.v1beta1.Coin
+	18, // 3: provlabs.vault.v1.VaultAccount.outstanding_aum_fee:type_name -> cosmos.base.v1beta1.Coin
+	0,  // 4: provlabs.vault.v1.VaultAccount.nav_aggregation:type_name -> provlabs.vault.v1.NAVAggregation
+	1,  // 5: provlabs.vault.v1.VaultAccount.price_sources:type_name -> provlabs.vault.v1.PriceSourceType
+	13, // 6: provlabs.vault.v1.VaultAccount.acquisition_policy:type_name -> provlabs.vault.v1.AcquisitionPolicy
+	7,  // 7: provlabs.vault.v1.VaultAccount.role_grants:type_name -> provlabs.vault.v1.RoleGrant
+	5,  // 8: provlabs.vault.v1.VaultAccount.pending_admin_transfer:type_name -> provlabs.vault.v1.PendingAdminTransfer
+	4,  // 9: provlabs.vault.v1.VaultAccount.eligibility_policy:type_name -> provlabs.vault.v1.EligibilityPolicy
+	19, // 10: provlabs.vault.v1.PendingAdminTransfer.expiry_time:type_name -> google.protobuf.Timestamp
+	20, // 11: provlabs.vault.v1.ScheduledAction.msg:type_name -> google.protobuf.Any
+	2,  // 12: provlabs.vault.v1.RoleGrant.role:type_name -> provlabs.vault.v1.VaultRole
+	18, // 13: provlabs.vault.v1.VaultNAV.price:type_name -> cosmos.base.v1beta1.Coin
+	19, // 14: provlabs.vault.v1.VaultNAV.updated_time:type_name -> google.protobuf.Timestamp
+	8,  // 15: provlabs.vault.v1.PendingNAVProposal.nav:type_name -> provlabs.vault.v1.VaultNAV
+	19, // 16: provlabs.vault.v1.PendingNAVProposal.expiry_time:type_name -> google.protobuf.Timestamp
+	8,  // 17: provlabs.vault.v1.NAVSourceSubmission.nav:type_name -> provlabs.vault.v1.VaultNAV
+	18, // 18: provlabs.vault.v1.AccountBalance.coins:type_name -> cosmos.base.v1beta1.Coin
+	18, // 19: provlabs.vault.v1.PendingSwapOut.shares:type_name -> cosmos.base.v1beta1.Coin
+	19, // 20: provlabs.vault.v1.SharePriceObservation.time:type_name -> google.protobuf.Timestamp
+	18, // 21: provlabs.vault.v1.OutboundPayment.source_amount:type_name -> cosmos.base.v1beta1.Coin
+	18, // 22: provlabs.vault.v1.OutboundPayment.target_amount:type_name -> cosmos.base.v1beta1.Coin
+	19, // 23: provlabs.vault.v1.OutboundPayment.expiry_time:type_name -> google.protobuf.Timestamp
+	24, // [24:24] is the sub-list for method output_type
+	24, // [24:24] is the sub-list for method input_type
+	24, // [24:24] is the sub-list for extension type_name
+	24, // [24:24] is the sub-list for extension extendee
+	0,  // [0:24] is the sub-list for field type_name
 }
 
 func init() { file_provlabs_vault_v1_vault_proto_init() }
@@ -4967,7 +13824,7 @@ func file_provlabs_vault_v1_vault_proto_init() {
 			}
 		}
 		file_provlabs_vault_v1_vault_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*VaultNAV); i {
+			switch v := v.(*EligibilityPolicy); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4979,7 +13836,7 @@ func file_provlabs_vault_v1_vault_proto_init() {
 			}
 		}
 		file_provlabs_vault_v1_vault_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AccountBalance); i {
+			switch v := v.(*PendingAdminTransfer); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4991,6 +13848,78 @@ func file_provlabs_vault_v1_vault_proto_init() {
 			}
 		}
 		file_provlabs_vault_v1_vault_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ScheduledAction); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_provlabs_vault_v1_vault_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RoleGrant); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_provlabs_vault_v1_vault_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*VaultNAV); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_provlabs_vault_v1_vault_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PendingNAVProposal); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_provlabs_vault_v1_vault_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*NAVSourceSubmission); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_provlabs_vault_v1_vault_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AccountBalance); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_provlabs_vault_v1_vault_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*PendingSwapOut); i {
 			case 0:
 				return &v.state
@@ -5002,19 +13931,68 @@ func file_provlabs_vault_v1_vault_proto_init() {
 				return nil
 			}
 		}
+		file_provlabs_vault_v1_vault_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AcquisitionPolicy); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_provlabs_vault_v1_vault_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AssetHaircut); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_provlabs_vault_v1_vault_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SharePriceObservation); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_provlabs_vault_v1_vault_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*OutboundPayment); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_provlabs_vault_v1_vault_proto_rawDesc,
-			NumEnums:      0,
-			NumMessages:   4,
+			NumEnums:      3,
+			NumMessages:   14,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
 		GoTypes:           file_provlabs_vault_v1_vault_proto_goTypes,
 		DependencyIndexes: file_provlabs_vault_v1_vault_proto_depIdxs,
+		EnumInfos:         file_provlabs_vault_v1_vault_proto_enumTypes,
 		MessageInfos:      file_provlabs_vault_v1_vault_proto_msgTypes,
 	}.Build()
 	File_provlabs_vault_v1_vault_proto = out.File
@@ -24,6 +24,10 @@ const (
 	// visited per BeginBlocker.
 	MaxFeeTimeoutsPerBlock = 100
 
+	// MaxScheduledActionsPerBlock is the maximum number of due ScheduledActionQueue
+	// entries executed per BeginBlocker.
+	MaxScheduledActionsPerBlock = 100
+
 	// MaxPayoutVerificationsPerBlock is the maximum number of PayoutVerificationSet
 	// entries visited per EndBlocker.
 	MaxPayoutVerificationsPerBlock = 100
@@ -49,18 +53,35 @@ func (k *Keeper) BeginBlocker(ctx sdk.Context) error {
 	if err := k.handleVaultFeeTimeouts(ctx, MaxFeeTimeoutsPerBlock); err != nil {
 		return fmt.Errorf("failed to handle vault fee timeouts: %w", err)
 	}
+	if err := k.handleScheduledActions(ctx, MaxScheduledActionsPerBlock); err != nil {
+		return fmt.Errorf("failed to handle scheduled actions: %w", err)
+	}
 	return nil
 }
 
 // EndBlocker is a hook that is called at the end of every block.
 func (k *Keeper) EndBlocker(ctx sdk.Context) error {
-	if err := k.processPendingSwapOuts(ctx, MaxSwapOutBatchSize); err != nil {
-		return fmt.Errorf("failed to process pending swap outs: %w", err)
+	// A module-wide swap-out halt leaves pending swap outs queued, untouched, until the
+	// circuit breaker is reset. A breaker that cannot be read is treated as halted.
+	if breaker, err := k.getCircuitBreaker(ctx); err != nil {
+		k.getLogger(ctx).Error("failed to read circuit breaker, leaving pending swap outs queued", "error", err)
+	} else if !breaker.SwapOutHalted {
+		if err := k.processPendingSwapOuts(ctx, MaxSwapOutBatchSize); err != nil {
+			return fmt.Errorf("failed to process pending swap outs: %w", err)
+		}
 	}
 
 	if err := k.handleReconciledVaults(ctx, MaxPayoutVerificationsPerBlock); err != nil {
 		return fmt.Errorf("failed to handle reconciled vaults: %w", err)
 	}
 
+	if err := k.handleStaleNAVVaults(ctx); err != nil {
+		return fmt.Errorf("failed to handle stale NAV vaults: %w", err)
+	}
+
+	if err := k.handleExpiredNAVProposals(ctx); err != nil {
+		return fmt.Errorf("failed to handle expired NAV proposals: %w", err)
+	}
+
 	return nil
 }
@@ -0,0 +1,65 @@
+package keeper
+
+import (
+	"fmt"
+	"slices"
+
+	"github.com/provlabs/vault/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	metadatatypes "github.com/provenance-io/provenance/x/metadata/types"
+)
+
+// requireAcquisitionPermitted returns an error unless the vault's acquisition policy
+// permits it to acquire denom. The denom must match one of the policy's denom patterns,
+// and a metadata value-owner denom (nft/<scope-id>) must name a scope created from one of
+// the policy's scope specifications. Either list left empty permits anything, so an empty
+// policy permits every denom.
+func (k Keeper) requireAcquisitionPermitted(ctx sdk.Context, vault *types.VaultAccount, denom string) error {
+	policy := vault.AcquisitionPolicy
+	if !policy.PermitsDenom(denom) {
+		return fmt.Errorf("denom %q is not permitted by the acquisition policy of vault %s", denom, vault.Address)
+	}
+	if len(policy.ScopeSpecificationIds) == 0 {
+		return nil
+	}
+	scopeAddr, err := metadatatypes.MetadataAddressFromDenom(denom)
+	if err != nil {
+		return nil
+	}
+	scope, found := k.MetadataKeeper.GetScope(ctx, scopeAddr)
+	if !found {
+		return fmt.Errorf("denom %q is not an existing metadata scope", denom)
+	}
+	if !policy.PermitsScopeSpecification(scope.SpecificationId.String()) {
+		return fmt.Errorf("scope of denom %q has specification %s, which is not permitted by the acquisition policy of vault %s", denom, scope.SpecificationId, vault.Address)
+	}
+	return nil
+}
+
+// requireNAVAcquisitionPermitted applies the vault's acquisition policy to a NAV entry for
+// denom. Pricing a denom the vault does not hold is what authorizes the asset manager to
+// acquire it, so that is checked against the policy. A held denom may always be repriced,
+// so tightening the policy cannot leave an existing position without a fresh NAV.
+func (k Keeper) requireNAVAcquisitionPermitted(ctx sdk.Context, vault *types.VaultAccount, denom string) error {
+	if !k.heldBalance(ctx, *vault, denom).IsZero() {
+		return nil
+	}
+	return k.requireAcquisitionPermitted(ctx, vault, denom)
+}
+
+// SetAcquisitionPolicy updates the acquisition policy for a vault. Tightening it only blocks
+// new pricing and acquisition; existing NAV entries and positions are kept.
+func (k *Keeper) SetAcquisitionPolicy(ctx sdk.Context, vault *types.VaultAccount, policy types.AcquisitionPolicy, authority string) error {
+	current := vault.AcquisitionPolicy
+	if slices.Equal(current.DenomPatterns, policy.DenomPatterns) && slices.Equal(current.ScopeSpecificationIds, policy.ScopeSpecificationIds) {
+		return nil
+	}
+	vault.AcquisitionPolicy = policy
+	if err := k.SetVaultAccount(ctx, vault); err != nil {
+		return fmt.Errorf("failed to set vault account: %w", err)
+	}
+	k.emitEvent(ctx, types.NewEventAcquisitionPolicyUpdated(vault.Address, authority, policy))
+	return nil
+}
@@ -0,0 +1,251 @@
+package keeper_test
+
+import (
+	"github.com/google/uuid"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	metadatatypes "github.com/provenance-io/provenance/x/metadata/types"
+
+	"github.com/provlabs/vault/keeper"
+	"github.com/provlabs/vault/types"
+)
+
+func (s *TestSuite) TestKeeper_SetVaultNAV_AcquisitionPolicy() {
+	underlying, share := "under", "vshare"
+	permittedSpec := metadatatypes.ScopeSpecMetadataAddress(uuid.MustParse(scopeSpecUUID)).String()
+	otherSpec := metadatatypes.ScopeSpecMetadataAddress(uuid.MustParse("00000000-0000-4000-8000-0000000000ee")).String()
+
+	tests := []struct {
+		name                string
+		policy              types.AcquisitionPolicy
+		scopeDenom          bool
+		denom               string
+		held                bool
+		expectedErrContains string
+	}{
+		{
+			name:  "empty policy permits any denom",
+			denom: "loancoin",
+		},
+		{
+			name:   "exact pattern permits the denom",
+			policy: types.AcquisitionPolicy{DenomPatterns: []string{"loancoin"}},
+			denom:  "loancoin",
+		},
+		{
+			name:   "prefix pattern permits a matching denom",
+			policy: types.AcquisitionPolicy{DenomPatterns: []string{"loan*"}},
+			denom:  "loancoin",
+		},
+		{
+			name:                "denom outside every pattern is rejected",
+			policy:              types.AcquisitionPolicy{DenomPatterns: []string{"bond*"}},
+			denom:               "loancoin",
+			expectedErrContains: "is not permitted by the acquisition policy",
+		},
+		{
+			name:   "held denom outside the policy may still be repriced",
+			policy: types.AcquisitionPolicy{DenomPatterns: []string{"bond*"}},
+			denom:  "loancoin",
+			held:   true,
+		},
+		{
+			name:       "scope from a permitted specification is permitted",
+			policy:     types.AcquisitionPolicy{DenomPatterns: []string{"nft/*"}, ScopeSpecificationIds: []string{permittedSpec}},
+			scopeDenom: true,
+		},
+		{
+			name:                "scope from another specification is rejected",
+			policy:              types.AcquisitionPolicy{DenomPatterns: []string{"nft/*"}, ScopeSpecificationIds: []string{otherSpec}},
+			scopeDenom:          true,
+			expectedErrContains: "which is not permitted by the acquisition policy",
+		},
+		{
+			name:                "scope denom outside the denom patterns is rejected",
+			policy:              types.AcquisitionPolicy{DenomPatterns: []string{"loan*"}, ScopeSpecificationIds: []string{permittedSpec}},
+			scopeDenom:          true,
+			expectedErrContains: "is not permitted by the acquisition policy",
+		},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			origCtx := s.ctx
+			defer func() { s.ctx = origCtx }()
+			s.ctx, _ = s.ctx.CacheContext()
+
+			vault := s.setupBaseVault(underlying, share)
+			denom := tc.denom
+			if tc.scopeDenom {
+				denom = s.requireScope("00000000-0000-4000-8000-000000000001")
+			} else {
+				s.requireSimpleMarker(denom)
+			}
+			if tc.held {
+				s.Require().NoError(FundAccount(s.ctx, s.simApp.BankKeeper, vault.PrincipalMarkerAddress(), sdk.NewCoins(sdk.NewInt64Coin(denom, 1))), "failed to fund principal with %s", denom)
+			}
+			vault.AcquisitionPolicy = tc.policy
+
+			err := s.k.SetVaultNAV(s.ctx, vault, types.VaultNAV{Denom: denom, Price: sdk.NewInt64Coin(underlying, 5), Volume: sdkmath.NewInt(1)}, s.adminAddr.String())
+			if tc.expectedErrContains != "" {
+				s.Require().ErrorContains(err, tc.expectedErrContains, "SetVaultNAV should reject case %q", tc.name)
+				return
+			}
+			s.Require().NoError(err, "SetVaultNAV should accept case %q", tc.name)
+		})
+	}
+}
+
+func (s *TestSuite) TestKeeper_SubmitSourceNAV_AcquisitionPolicy() {
+	underlying, share, denom := "under", "vshare", "loancoin"
+
+	tests := []struct {
+		name                string
+		policy              types.AcquisitionPolicy
+		held                bool
+		expectedErrContains string
+	}{
+		{
+			name:   "permitted denom is accepted",
+			policy: types.AcquisitionPolicy{DenomPatterns: []string{"loan*"}},
+		},
+		{
+			name:                "denom outside every pattern is rejected",
+			policy:              types.AcquisitionPolicy{DenomPatterns: []string{"bond*"}},
+			expectedErrContains: "is not permitted by the acquisition policy",
+		},
+		{
+			name:   "held denom outside the policy may still be repriced",
+			policy: types.AcquisitionPolicy{DenomPatterns: []string{"bond*"}},
+			held:   true,
+		},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			origCtx := s.ctx
+			defer func() { s.ctx = origCtx }()
+			s.ctx, _ = s.ctx.CacheContext()
+
+			vault := s.setupBaseVault(underlying, share)
+			s.requireSimpleMarker(denom)
+			if tc.held {
+				s.Require().NoError(FundAccount(s.ctx, s.simApp.BankKeeper, vault.PrincipalMarkerAddress(), sdk.NewCoins(sdk.NewInt64Coin(denom, 1))), "failed to fund principal with %s", denom)
+			}
+			vault.AcquisitionPolicy = tc.policy
+
+			nav := types.VaultNAV{Denom: denom, Price: sdk.NewInt64Coin(underlying, 5), Volume: sdkmath.NewInt(1)}
+			err := s.k.SubmitSourceNAV(s.ctx, vault, nav, navSourceAddrs()[0])
+			if tc.expectedErrContains != "" {
+				s.Require().ErrorContains(err, tc.expectedErrContains, "SubmitSourceNAV should reject case %q", tc.name)
+				return
+			}
+			s.Require().NoError(err, "SubmitSourceNAV should accept case %q", tc.name)
+		})
+	}
+}
+
+func (s *TestSuite) TestMsgServer_AcceptAsset_AcquisitionPolicy() {
+	underlying, share, asset := "under", "vshare", "rwacoin"
+	externalID := "policy"
+
+	tests := []struct {
+		name                string
+		sourceAmount        sdk.Coins
+		targetAmount        sdk.Coins
+		expectedErrContains string
+	}{
+		{
+			name:                "inbound acquisition of a denom the policy does not permit is rejected",
+			sourceAmount:        sdk.NewCoins(sdk.NewInt64Coin(asset, 10)),
+			targetAmount:        sdk.NewCoins(sdk.NewInt64Coin(underlying, 5)),
+			expectedErrContains: "is not permitted by the acquisition policy",
+		},
+		{
+			name:         "outbound sale of a denom the policy does not permit settles",
+			sourceAmount: sdk.NewCoins(sdk.NewInt64Coin(underlying, 5)),
+			targetAmount: sdk.NewCoins(sdk.NewInt64Coin(asset, 10)),
+		},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			origCtx := s.ctx
+			defer func() { s.ctx = origCtx }()
+			s.ctx, _ = s.ctx.CacheContext()
+
+			fundPrincipal := tc.targetAmount
+			vault, _, source := s.setupAcceptAssetScenario(acceptAssetScenario{
+				underlying:    underlying,
+				share:         share,
+				assetMarker:   asset,
+				seedNav:       &types.VaultNAV{Denom: asset, Price: sdk.NewInt64Coin(underlying, 5), Volume: sdkmath.NewInt(10)},
+				fundSource:    tc.sourceAmount,
+				fundPrincipal: fundPrincipal,
+				sourceAmount:  tc.sourceAmount,
+				targetAmount:  tc.targetAmount,
+				externalID:    externalID,
+			})
+			vault.AcquisitionPolicy = types.AcquisitionPolicy{DenomPatterns: []string{"nft/*"}}
+			s.Require().NoError(s.k.SetVaultAccount(s.ctx, vault), "failed to store vault acquisition policy")
+
+			_, err := keeper.NewMsgServer(s.simApp.VaultKeeper).AcceptAsset(s.ctx, &types.MsgAcceptAssetRequest{
+				Authority:    s.assetManagerAddr.String(),
+				VaultAddress: vault.GetAddress().String(),
+				Source:       source.String(),
+				ExternalId:   externalID,
+			})
+			if tc.expectedErrContains != "" {
+				s.Require().ErrorContains(err, tc.expectedErrContains, "AcceptAsset should reject case %q", tc.name)
+				return
+			}
+			s.Require().NoError(err, "AcceptAsset should settle case %q", tc.name)
+		})
+	}
+}
+
+func (s *TestSuite) TestMsgServer_UpdateAcquisitionPolicy() {
+	underlying, share := "under", "vshare"
+	policy := types.AcquisitionPolicy{DenomPatterns: []string{"nft/*", "loancoin"}}
+
+	tests := []struct {
+		name        string
+		signer      func() string
+		policy      types.AcquisitionPolicy
+		expectErr   string
+		expectEvent bool
+	}{
+		{name: "admin sets the policy", signer: func() string { return s.adminAddr.String() }, policy: policy, expectEvent: true},
+		{name: "unchanged policy is a no-op", signer: func() string { return s.adminAddr.String() }},
+		{name: "asset manager cannot set the policy", signer: func() string { return s.assetManagerAddr.String() }, policy: policy, expectErr: "failed to validate admin"},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+			vault, _ := s.setupAssetSettlementVault(underlying, share)
+			s.ctx = s.ctx.WithEventManager(sdk.NewEventManager())
+
+			_, err := keeper.NewMsgServer(s.simApp.VaultKeeper).UpdateAcquisitionPolicy(s.ctx, &types.MsgUpdateAcquisitionPolicyRequest{
+				Authority:    tc.signer(),
+				VaultAddress: vault.Address,
+				Policy:       tc.policy,
+			})
+			if tc.expectErr != "" {
+				s.Require().ErrorContains(err, tc.expectErr, "UpdateAcquisitionPolicy error")
+				return
+			}
+			s.Require().NoError(err, "UpdateAcquisitionPolicy should succeed")
+
+			updated, err := s.k.GetVault(s.ctx, vault.GetAddress())
+			s.Require().NoError(err, "should reload vault")
+			s.Assert().Equal(tc.policy.DenomPatterns, updated.AcquisitionPolicy.DenomPatterns, "stored denom patterns")
+			if tc.expectEvent {
+				s.requireTypedEventEmitted(types.NewEventAcquisitionPolicyUpdated(vault.Address, tc.signer(), tc.policy))
+			} else {
+				s.Assert().Empty(s.ctx.EventManager().Events(), "no-op update should not emit events")
+			}
+		})
+	}
+}
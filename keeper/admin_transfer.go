@@ -0,0 +1,107 @@
+package keeper
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/provlabs/vault/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// SetPendingAdminTransfer records newAdmin as the vault's pending admin, replacing any pending
+// proposal, and emits an EventAdminTransferProposed. When expirationSeconds is positive the
+// proposal can be accepted only until that many seconds after the current block time. The
+// caller is responsible for verifying that authority is the vault admin.
+func (k *Keeper) SetPendingAdminTransfer(ctx sdk.Context, vault *types.VaultAccount, newAdmin string, expirationSeconds uint64, authority string) error {
+	if newAdmin == vault.Admin {
+		return fmt.Errorf("%s is already the vault admin", newAdmin)
+	}
+
+	transfer := types.PendingAdminTransfer{NewAdmin: newAdmin}
+	if expirationSeconds > 0 {
+		expiry := ctx.BlockTime().Add(time.Duration(expirationSeconds) * time.Second)
+		transfer.ExpiryTime = &expiry
+	}
+	vault.PendingAdminTransfer = &transfer
+	if err := k.SetVaultAccount(ctx, vault); err != nil {
+		return fmt.Errorf("failed to set vault account: %w", err)
+	}
+
+	k.emitEvent(ctx, types.NewEventAdminTransferProposed(vault.Address, authority, transfer))
+	return nil
+}
+
+// ClearPendingAdminTransfer withdraws the vault's pending admin transfer and emits an
+// EventAdminTransferCancelled. It returns an error if no transfer is pending. The caller is
+// responsible for verifying that authority is the vault admin.
+func (k *Keeper) ClearPendingAdminTransfer(ctx sdk.Context, vault *types.VaultAccount, authority string) error {
+	if vault.PendingAdminTransfer == nil {
+		return fmt.Errorf("vault %s has no pending admin transfer", vault.Address)
+	}
+
+	newAdmin := vault.PendingAdminTransfer.NewAdmin
+	vault.PendingAdminTransfer = nil
+	if err := k.SetVaultAccount(ctx, vault); err != nil {
+		return fmt.Errorf("failed to set vault account: %w", err)
+	}
+
+	k.emitEvent(ctx, types.NewEventAdminTransferCancelled(vault.Address, authority, newAdmin))
+	return nil
+}
+
+// CompleteAdminTransfer completes the vault's pending admin transfer on behalf of signer, who
+// must be the proposed admin, provided the proposal has not expired.
+func (k *Keeper) CompleteAdminTransfer(ctx sdk.Context, vault *types.VaultAccount, signer string) error {
+	transfer := vault.PendingAdminTransfer
+	if transfer == nil {
+		return fmt.Errorf("vault %s has no pending admin transfer", vault.Address)
+	}
+	if signer != transfer.NewAdmin {
+		return fmt.Errorf("unauthorized: %s is not the pending admin of vault %s", signer, vault.Address)
+	}
+	if transfer.IsExpired(ctx.BlockTime()) {
+		return fmt.Errorf("admin transfer for vault %s expired at %s", vault.Address, transfer.ExpiryTime)
+	}
+	return k.TransferVaultAdmin(ctx, vault, transfer.NewAdmin, signer)
+}
+
+// TransferVaultAdmin makes newAdmin the vault's admin and emits an EventAdminTransferred.
+// Everything the outgoing admin held by virtue of being admin passes to newAdmin: its role
+// grants, and the nav_authority and asset_manager fields when they name the outgoing admin.
+// Any pending admin transfer is cleared, emitting an EventAdminTransferCancelled when it
+// proposed a different address. The caller is responsible for verifying that authority may
+// replace the admin.
+func (k *Keeper) TransferVaultAdmin(ctx sdk.Context, vault *types.VaultAccount, newAdmin, authority string) error {
+	previous := vault.Admin
+	if newAdmin == previous {
+		return fmt.Errorf("%s is already the vault admin", newAdmin)
+	}
+
+	if pending := vault.PendingAdminTransfer; pending != nil && pending.NewAdmin != newAdmin {
+		k.emitEvent(ctx, types.NewEventAdminTransferCancelled(vault.Address, authority, pending.NewAdmin))
+	}
+	vault.PendingAdminTransfer = nil
+
+	var held []types.VaultRole
+	for _, grant := range vault.RoleGrants {
+		if grant.Address == previous {
+			held = append(held, grant.Role)
+		}
+	}
+	vault.Admin = newAdmin
+	k.moveRoles(ctx, vault, held, previous, newAdmin, authority)
+	if vault.NavAuthority == previous {
+		vault.NavAuthority = newAdmin
+	}
+	if vault.AssetManager == previous {
+		vault.AssetManager = newAdmin
+	}
+
+	if err := k.SetVaultAccount(ctx, vault); err != nil {
+		return fmt.Errorf("failed to set vault account: %w", err)
+	}
+
+	k.emitEvent(ctx, types.NewEventAdminTransferred(vault.Address, authority, previous, newAdmin))
+	return nil
+}
@@ -0,0 +1,233 @@
+package keeper_test
+
+import (
+	"time"
+
+	"github.com/provlabs/vault/keeper"
+	"github.com/provlabs/vault/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func (s *TestSuite) TestMsgServer_ProposeAndAcceptAdminTransfer() {
+	underlying, share := "under", "vshare"
+	newAdmin := sdk.AccAddress("newAdminAddr________").String()
+	stranger := sdk.AccAddress("strangerAddr________").String()
+
+	tests := []struct {
+		name        string
+		proposer    func() string
+		expiration  uint64
+		elapsed     time.Duration
+		acceptor    string
+		proposeErr  string
+		expectedErr string
+	}{
+		{
+			name:     "nominee accepts without expiry",
+			acceptor: newAdmin,
+			elapsed:  365 * 24 * time.Hour,
+		},
+		{
+			name:       "nominee accepts before expiry",
+			expiration: 3600,
+			elapsed:    time.Hour - time.Second,
+			acceptor:   newAdmin,
+		},
+		{
+			name:        "nominee cannot accept at expiry",
+			expiration:  3600,
+			elapsed:     time.Hour,
+			acceptor:    newAdmin,
+			expectedErr: "expired at",
+		},
+		{
+			name:        "another address cannot accept",
+			acceptor:    stranger,
+			expectedErr: "is not the pending admin",
+		},
+		{
+			name:       "non-admin cannot propose",
+			proposer:   func() string { return stranger },
+			proposeErr: "failed to validate admin",
+		},
+		{
+			name:       "admin cannot nominate itself",
+			proposer:   func() string { return s.adminAddr.String() },
+			proposeErr: "is already the vault admin",
+		},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+			vault := s.setupBaseVault(underlying, share)
+			admin := s.adminAddr.String()
+			msgServer := keeper.NewMsgServer(s.simApp.VaultKeeper)
+			s.ctx = s.ctx.WithEventManager(sdk.NewEventManager())
+
+			proposer, nominee := admin, newAdmin
+			if tc.proposer != nil {
+				proposer = tc.proposer()
+				if proposer == admin {
+					nominee = admin
+				}
+			}
+			_, err := msgServer.ProposeAdminTransfer(s.ctx, &types.MsgProposeAdminTransferRequest{
+				Authority:         proposer,
+				VaultAddress:      vault.Address,
+				NewAdmin:          nominee,
+				ExpirationSeconds: tc.expiration,
+			})
+			if tc.proposeErr != "" {
+				s.Require().ErrorContains(err, tc.proposeErr, "ProposeAdminTransfer error")
+				return
+			}
+			s.Require().NoError(err, "ProposeAdminTransfer")
+
+			got, err := s.k.GetVault(s.ctx, vault.GetAddress())
+			s.Require().NoError(err, "GetVault")
+			s.Require().NotNil(got.PendingAdminTransfer, "pending admin transfer should be recorded")
+			s.Assert().Equal(admin, got.Admin, "admin should not change until the nominee accepts")
+			s.requireTypedEventEmitted(types.NewEventAdminTransferProposed(vault.Address, admin, *got.PendingAdminTransfer))
+
+			s.ctx = s.ctx.WithBlockTime(s.ctx.BlockTime().Add(tc.elapsed)).WithEventManager(sdk.NewEventManager())
+			_, err = msgServer.AcceptAdminTransfer(s.ctx, &types.MsgAcceptAdminTransferRequest{
+				NewAdmin:     tc.acceptor,
+				VaultAddress: vault.Address,
+			})
+			if tc.expectedErr != "" {
+				s.Require().ErrorContains(err, tc.expectedErr, "AcceptAdminTransfer error")
+				return
+			}
+			s.Require().NoError(err, "AcceptAdminTransfer")
+
+			got, err = s.k.GetVault(s.ctx, vault.GetAddress())
+			s.Require().NoError(err, "GetVault")
+			s.Assert().Equal(newAdmin, got.Admin, "admin after accept")
+			s.Assert().Nil(got.PendingAdminTransfer, "pending admin transfer should be cleared")
+			s.requireTypedEventEmitted(types.NewEventAdminTransferred(vault.Address, newAdmin, admin, newAdmin))
+		})
+	}
+}
+
+func (s *TestSuite) TestMsgServer_AdminTransfer_MovesRoles() {
+	underlying, share := "under", "vshare"
+	admin := s.adminAddr.String()
+	manager := s.assetManagerAddr.String()
+	newAdmin := sdk.AccAddress("newAdminAddr________").String()
+
+	vault, _ := s.setupAssetSettlementVault(underlying, share)
+	msgServer := keeper.NewMsgServer(s.simApp.VaultKeeper)
+	_, err := msgServer.ProposeAdminTransfer(s.ctx, &types.MsgProposeAdminTransferRequest{
+		Authority:    admin,
+		VaultAddress: vault.Address,
+		NewAdmin:     newAdmin,
+	})
+	s.Require().NoError(err, "ProposeAdminTransfer")
+	_, err = msgServer.AcceptAdminTransfer(s.ctx, &types.MsgAcceptAdminTransferRequest{
+		NewAdmin:     newAdmin,
+		VaultAddress: vault.Address,
+	})
+	s.Require().NoError(err, "AcceptAdminTransfer")
+
+	got, err := s.k.GetVault(s.ctx, vault.GetAddress())
+	s.Require().NoError(err, "GetVault")
+	s.Require().NoError(got.Validate(), "vault should remain valid after the transfer")
+	for _, role := range types.ManagementRoles() {
+		s.Assert().True(got.HasRole(newAdmin, role), "new admin should hold %s", role)
+		s.Assert().False(got.HasRole(admin, role), "previous admin should lose %s", role)
+	}
+	for _, role := range types.AssetManagerRoles() {
+		s.Assert().True(got.HasRole(manager, role), "asset manager should keep %s", role)
+	}
+	s.Assert().Equal(manager, got.AssetManager, "asset manager should be unchanged")
+
+	_, err = msgServer.PauseVault(s.ctx, &types.MsgPauseVaultRequest{
+		Authority:    admin,
+		VaultAddress: vault.Address,
+		Reason:       "stale key",
+	})
+	s.Require().ErrorContains(err, "does not hold role VAULT_ROLE_PAUSER", "previous admin must lose its authority")
+	_, err = msgServer.PauseVault(s.ctx, &types.MsgPauseVaultRequest{
+		Authority:    newAdmin,
+		VaultAddress: vault.Address,
+		Reason:       "incident",
+	})
+	s.Require().NoError(err, "new admin should be able to pause")
+}
+
+func (s *TestSuite) TestMsgServer_CancelAdminTransfer() {
+	underlying, share := "under", "vshare"
+	admin := s.adminAddr.String()
+	newAdmin := sdk.AccAddress("newAdminAddr________").String()
+
+	vault := s.setupBaseVault(underlying, share)
+	msgServer := keeper.NewMsgServer(s.simApp.VaultKeeper)
+	cancel := func(authority string) error {
+		_, err := msgServer.CancelAdminTransfer(s.ctx, &types.MsgCancelAdminTransferRequest{
+			Authority:    authority,
+			VaultAddress: vault.Address,
+		})
+		return err
+	}
+
+	s.Require().ErrorContains(cancel(admin), "has no pending admin transfer", "cancel with nothing pending")
+
+	_, err := msgServer.ProposeAdminTransfer(s.ctx, &types.MsgProposeAdminTransferRequest{
+		Authority:    admin,
+		VaultAddress: vault.Address,
+		NewAdmin:     newAdmin,
+	})
+	s.Require().NoError(err, "ProposeAdminTransfer")
+	s.Require().ErrorContains(cancel(newAdmin), "failed to validate admin", "nominee cannot cancel")
+
+	s.ctx = s.ctx.WithEventManager(sdk.NewEventManager())
+	s.Require().NoError(cancel(admin), "admin cancel")
+	s.requireTypedEventEmitted(types.NewEventAdminTransferCancelled(vault.Address, admin, newAdmin))
+
+	_, err = msgServer.AcceptAdminTransfer(s.ctx, &types.MsgAcceptAdminTransferRequest{
+		NewAdmin:     newAdmin,
+		VaultAddress: vault.Address,
+	})
+	s.Require().ErrorContains(err, "has no pending admin transfer", "cancelled transfer cannot be accepted")
+}
+
+func (s *TestSuite) TestMsgServer_RecoverVaultAdmin() {
+	underlying, share := "under", "vshare"
+	admin := s.adminAddr.String()
+	nominee := sdk.AccAddress("nomineeAddr_________").String()
+	recovered := sdk.AccAddress("recoveredAddr_______").String()
+
+	vault := s.setupBaseVault(underlying, share)
+	msgServer := keeper.NewMsgServer(s.simApp.VaultKeeper)
+	recover := func(authority string) error {
+		_, err := msgServer.RecoverVaultAdmin(s.ctx, &types.MsgRecoverVaultAdminRequest{
+			Authority:    authority,
+			VaultAddress: vault.Address,
+			NewAdmin:     recovered,
+		})
+		return err
+	}
+
+	_, err := msgServer.ProposeAdminTransfer(s.ctx, &types.MsgProposeAdminTransferRequest{
+		Authority:    admin,
+		VaultAddress: vault.Address,
+		NewAdmin:     nominee,
+	})
+	s.Require().NoError(err, "ProposeAdminTransfer")
+	s.Require().ErrorContains(recover(admin), "unauthorized", "the vault admin cannot use the governance override")
+
+	s.ctx = s.ctx.WithEventManager(sdk.NewEventManager())
+	s.Require().NoError(recover(s.govAuthority), "RecoverVaultAdmin")
+	s.requireTypedEventEmitted(types.NewEventAdminTransferCancelled(vault.Address, s.govAuthority, nominee))
+	s.requireTypedEventEmitted(types.NewEventAdminTransferred(vault.Address, s.govAuthority, admin, recovered))
+
+	got, err := s.k.GetVault(s.ctx, vault.GetAddress())
+	s.Require().NoError(err, "GetVault")
+	s.Assert().Equal(recovered, got.Admin, "admin after recovery")
+	s.Assert().Nil(got.PendingAdminTransfer, "recovery should clear the pending transfer")
+	for _, role := range types.ManagementRoles() {
+		s.Assert().True(got.HasRole(recovered, role), "recovered admin should hold %s", role)
+	}
+}
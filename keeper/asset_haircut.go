@@ -0,0 +1,88 @@
+package keeper
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/provlabs/vault/types"
+
+	"cosmossdk.io/collections"
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// GetAssetHaircut returns the valuation haircut, in basis points, the vault applies to
+// denom. It returns zero when the vault has no haircut for the denom.
+func (k Keeper) GetAssetHaircut(ctx sdk.Context, vaultAddr sdk.AccAddress, denom string) (uint32, error) {
+	haircutBips, err := k.AssetHaircuts.Get(ctx, collections.Join(vaultAddr, denom))
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return 0, nil
+		}
+		return 0, fmt.Errorf("failed to get asset haircut for denom %q on vault %s: %w", denom, vaultAddr, err)
+	}
+	return haircutBips, nil
+}
+
+// GetAssetHaircuts returns every valuation haircut the vault applies, in denom order.
+func (k Keeper) GetAssetHaircuts(ctx sdk.Context, vaultAddr sdk.AccAddress) ([]types.AssetHaircut, error) {
+	haircuts := make([]types.AssetHaircut, 0)
+	haircutRange := collections.NewPrefixedPairRange[sdk.AccAddress, string](vaultAddr)
+	err := k.AssetHaircuts.Walk(ctx, haircutRange, func(key collections.Pair[sdk.AccAddress, string], haircutBips uint32) (bool, error) {
+		haircuts = append(haircuts, types.AssetHaircut{Denom: key.K2(), HaircutBips: haircutBips})
+		return false, nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to iterate asset haircuts for vault %s: %w", vaultAddr, err)
+	}
+	return haircuts, nil
+}
+
+// SetAssetHaircut updates the valuation haircut for a denom of a vault; zero removes it. A
+// change that moves a held denom's value past max_nav_change_bips is rejected.
+func (k *Keeper) SetAssetHaircut(ctx sdk.Context, vault *types.VaultAccount, denom string, haircutBips uint32, signer string) error {
+	current, err := k.GetAssetHaircut(ctx, vault.GetAddress(), denom)
+	if err != nil {
+		return err
+	}
+	if current == haircutBips {
+		return nil
+	}
+	if vault.MaxNavChangeBips > 0 && !k.heldBalance(ctx, *vault, denom).IsZero() {
+		exceeds, err := navChangeExceedsLimit(haircutValueFraction(denom, current), haircutValueFraction(denom, haircutBips), vault.MaxNavChangeBips)
+		if err != nil {
+			return fmt.Errorf("failed to check NAV change limit: %w", err)
+		}
+		if exceeds {
+			return fmt.Errorf("changing the haircut for denom %q on vault %s from %d to %d bips moves its value by more than the %d bips change limit",
+				denom, vault.Address, current, haircutBips, vault.MaxNavChangeBips)
+		}
+	}
+
+	key := collections.Join(vault.GetAddress(), denom)
+	if haircutBips == 0 {
+		err = k.AssetHaircuts.Remove(ctx, key)
+	} else {
+		err = k.AssetHaircuts.Set(ctx, key, haircutBips)
+	}
+	if err != nil {
+		return fmt.Errorf("failed to set asset haircut for denom %q on vault %s: %w", denom, vault.Address, err)
+	}
+	if err := k.publishShareNav(ctx, vault); err != nil {
+		return fmt.Errorf("failed to publish share NAV after asset haircut update: %w", err)
+	}
+
+	k.emitEvent(ctx, types.NewEventAssetHaircutUpdated(vault.Address, signer, denom, haircutBips))
+	return nil
+}
+
+// haircutValueFraction expresses the share of a denom's value that survives haircutBips as
+// a NAV entry, so navChangeExceedsLimit can measure a haircut change like a repricing.
+func haircutValueFraction(denom string, haircutBips uint32) types.VaultNAV {
+	return types.VaultNAV{
+		Denom:  denom,
+		Price:  sdk.Coin{Denom: denom, Amount: sdkmath.NewIntFromUint64(uint64(types.MaxHaircutBips - haircutBips))},
+		Volume: sdkmath.NewIntFromUint64(uint64(types.MaxHaircutBips)),
+	}
+}
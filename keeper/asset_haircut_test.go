@@ -0,0 +1,166 @@
+package keeper_test
+
+import (
+	"cosmossdk.io/collections"
+
+	"github.com/provlabs/vault/keeper"
+	"github.com/provlabs/vault/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func (s *TestSuite) TestMsgServer_UpdateAssetHaircut() {
+	underlying, share, held := "under", "vshare", "heldcoin"
+
+	tests := []struct {
+		name        string
+		signer      func() string
+		initial     uint32
+		denom       string
+		haircutBips uint32
+		expectErr   string
+		expectEvent bool
+	}{
+		{
+			name:        "NAV authority sets a haircut",
+			signer:      func() string { return s.adminAddr.String() },
+			denom:       held,
+			haircutBips: 2_500,
+			expectEvent: true,
+		},
+		{
+			name:        "zero removes the haircut",
+			signer:      func() string { return s.adminAddr.String() },
+			initial:     2_500,
+			denom:       held,
+			expectEvent: true,
+		},
+		{
+			name:        "unchanged haircut is a no-op",
+			signer:      func() string { return s.adminAddr.String() },
+			initial:     2_500,
+			denom:       held,
+			haircutBips: 2_500,
+		},
+		{
+			name:        "underlying asset cannot carry a haircut",
+			signer:      func() string { return s.adminAddr.String() },
+			denom:       underlying,
+			haircutBips: 100,
+			expectErr:   "cannot set a haircut on vault",
+		},
+		{
+			name:        "share denom cannot carry a haircut",
+			signer:      func() string { return s.adminAddr.String() },
+			denom:       share,
+			haircutBips: 100,
+			expectErr:   "cannot set a haircut on vault",
+		},
+		{
+			name:        "non NAV authority is rejected",
+			signer:      func() string { return s.assetManagerAddr.String() },
+			denom:       held,
+			haircutBips: 100,
+			expectErr:   "failed to validate NAV authority role",
+		},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+			vault := s.setupNAVChangeLimitVault(underlying, share, held, 0)
+			s.Require().NoError(s.k.SetAssetHaircut(s.ctx, vault, held, tc.initial, s.adminAddr.String()), "should seed initial haircut")
+			s.ctx = s.ctx.WithEventManager(sdk.NewEventManager())
+
+			_, err := keeper.NewMsgServer(s.simApp.VaultKeeper).UpdateAssetHaircut(s.ctx, &types.MsgUpdateAssetHaircutRequest{
+				Signer:       tc.signer(),
+				VaultAddress: vault.Address,
+				Denom:        tc.denom,
+				HaircutBips:  tc.haircutBips,
+			})
+			if tc.expectErr != "" {
+				s.Require().ErrorContains(err, tc.expectErr, "UpdateAssetHaircut error")
+				return
+			}
+			s.Require().NoError(err, "UpdateAssetHaircut should succeed")
+
+			haircutBips, err := s.k.GetAssetHaircut(s.ctx, vault.GetAddress(), tc.denom)
+			s.Require().NoError(err, "GetAssetHaircut should not error")
+			s.Assert().Equal(tc.haircutBips, haircutBips, "stored haircut")
+			if tc.expectEvent {
+				s.requireTypedEventEmitted(types.NewEventAssetHaircutUpdated(vault.Address, tc.signer(), tc.denom, tc.haircutBips))
+				return
+			}
+			for _, ev := range s.ctx.EventManager().Events() {
+				s.Assert().NotEqual("provlabs.vault.v1.EventAssetHaircutUpdated", ev.Type, "no event should be emitted for an unchanged haircut")
+			}
+		})
+	}
+}
+
+func (s *TestSuite) TestMsgServer_UpdateAssetHaircut_ChangeLimit() {
+	underlying, share, held := "under", "vshare", "heldcoin"
+
+	tests := []struct {
+		name        string
+		initial     uint32
+		haircutBips uint32
+		expectErr   string
+	}{
+		{name: "haircut that moves the value by the limit is accepted", haircutBips: 1_000},
+		{name: "haircut that moves the value past the limit is rejected", haircutBips: 1_001, expectErr: "more than the 1000 bips change limit"},
+		{name: "removing a haircut is measured against the haircut value", initial: 1_000, expectErr: "more than the 1000 bips change limit"},
+		{name: "lifting a full haircut is rejected", initial: 10_000, haircutBips: 9_000, expectErr: "more than the 1000 bips change limit"},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+			vault := s.setupNAVChangeLimitVault(underlying, share, held, 0)
+			s.Require().NoError(s.k.SetAssetHaircut(s.ctx, vault, held, tc.initial, s.adminAddr.String()), "should seed initial haircut")
+			s.Require().NoError(s.k.SetNAVChangeLimit(s.ctx, vault, 1_000, s.assetManagerAddr.String(), s.adminAddr.String()), "should set NAV change limit")
+			investor := s.CreateAndFundAccount(sdk.NewInt64Coin(underlying, 100))
+			_, err := s.k.SwapIn(s.ctx, vault.GetAddress(), investor, sdk.NewInt64Coin(underlying, 100))
+			s.Require().NoError(err, "swap in should succeed")
+			before, err := s.k.GetNetTVV(s.ctx, *vault)
+			s.Require().NoError(err, "GetNetTVV should not error")
+
+			_, err = keeper.NewMsgServer(s.simApp.VaultKeeper).UpdateAssetHaircut(s.ctx, &types.MsgUpdateAssetHaircutRequest{
+				Signer:       s.adminAddr.String(),
+				VaultAddress: vault.Address,
+				Denom:        held,
+				HaircutBips:  tc.haircutBips,
+			})
+			if tc.expectErr != "" {
+				s.Require().ErrorContains(err, tc.expectErr, "UpdateAssetHaircut error")
+				return
+			}
+			s.Require().NoError(err, "UpdateAssetHaircut should succeed")
+
+			point, err := s.k.NAVHistory.Get(s.ctx, collections.Join3(vault.GetAddress(), share, s.ctx.BlockTime().UTC()))
+			s.Require().NoError(err, "the haircut should record a share NAV history point")
+			published, err := s.k.MarkerKeeper.GetNetAssetValue(s.ctx, share, underlying)
+			s.Require().NoError(err, "GetNetAssetValue should not error")
+			s.Require().NotNil(published, "share NAV should be published")
+			s.Assert().Equal(published.Price, point.Price, "share NAV history point should carry the haircut-adjusted share price")
+			after, err := s.k.GetNetTVV(s.ctx, *vault)
+			s.Require().NoError(err, "GetNetTVV should not error")
+			s.Assert().True(after.LT(before), "the haircut should lower the vault value from %s, got %s", before, after)
+		})
+	}
+}
+
+func (s *TestSuite) TestQueryServer_VaultNavs_Haircuts() {
+	underlying, share, held := "under", "vshare", "heldcoin"
+	vault := s.setupStaleNAVVault(underlying, share, held, 0, false)
+	s.Require().NoError(s.k.SetAssetHaircut(s.ctx, vault, held, 1_000, s.adminAddr.String()), "should set haircut on held denom")
+	s.Require().NoError(s.k.SetAssetHaircut(s.ctx, vault, "loancoin", 500, s.adminAddr.String()), "should set haircut on unpriced denom")
+
+	resp, err := keeper.NewQueryServer(s.simApp.VaultKeeper).VaultNavs(s.ctx, &types.QueryVaultNavsRequest{Id: share})
+	s.Require().NoError(err, "VaultNavs should succeed")
+	s.Require().Len(resp.Navs, 1, "one internal NAV entry")
+	s.Assert().Equal([]types.AssetHaircut{
+		{Denom: held, HaircutBips: 1_000},
+		{Denom: "loancoin", HaircutBips: 500},
+	}, resp.Haircuts, "vault haircuts in denom order")
+}
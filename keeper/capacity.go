@@ -0,0 +1,78 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdkmath "cosmossdk.io/math"
+
+	"github.com/provlabs/vault/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// SetMaxTotalValue updates the deposit capacity for a vault.
+func (k *Keeper) SetMaxTotalValue(ctx sdk.Context, vault *types.VaultAccount, maxValue string, authority string) error {
+	if err := types.ValidateMaxTotalValue(maxValue); err != nil {
+		return err
+	}
+	if vault.MaxTotalValue == maxValue {
+		return nil
+	}
+	vault.MaxTotalValue = maxValue
+	if err := k.SetVaultAccount(ctx, vault); err != nil {
+		return fmt.Errorf("failed to set vault account: %w", err)
+	}
+	k.emitEvent(ctx, types.NewEventMaxTotalValueUpdated(vault.Address, authority, maxValue))
+	return nil
+}
+
+// remainingCapacity returns how much more value, in the underlying asset, swap-ins may add to
+// a vault currently worth tvv before it reaches its max total value. It is never negative.
+// ok is false when the vault has no cap.
+func remainingCapacity(vault *types.VaultAccount, tvv sdkmath.Int) (remaining sdkmath.Int, ok bool, err error) {
+	if vault.MaxTotalValue == "" {
+		return sdkmath.Int{}, false, nil
+	}
+	maxValue, valid := sdkmath.NewIntFromString(vault.MaxTotalValue)
+	if !valid {
+		return sdkmath.Int{}, false, fmt.Errorf("invalid max total value: %s", vault.MaxTotalValue)
+	}
+	remaining = maxValue.Sub(tvv)
+	if remaining.IsNegative() {
+		remaining = sdkmath.ZeroInt()
+	}
+	return remaining, true, nil
+}
+
+// netRemainingCapacity returns the vault's remaining capacity measured against its net total
+// value (see GetNetTVV), the basis requireWithinCapacity enforces. ok is false when the vault
+// has no cap.
+func (k Keeper) netRemainingCapacity(ctx sdk.Context, vault *types.VaultAccount) (remaining sdkmath.Int, ok bool, err error) {
+	if vault.MaxTotalValue == "" {
+		return sdkmath.Int{}, false, nil
+	}
+	tvv, err := k.GetNetTVV(ctx, *vault)
+	if err != nil {
+		return sdkmath.Int{}, false, fmt.Errorf("failed to get net total vault value: %w", err)
+	}
+	return remainingCapacity(vault, tvv)
+}
+
+// requireWithinCapacity returns an error unless a swap-in of asset leaves the vault's net total
+// value at or below its max total value. The vault must already be reconciled so that its net
+// total value reflects accrued interest and fees.
+func (k Keeper) requireWithinCapacity(ctx sdk.Context, vault *types.VaultAccount, asset sdk.Coin) error {
+	remaining, capped, err := k.netRemainingCapacity(ctx, vault)
+	if err != nil || !capped {
+		return err
+	}
+	depositValue, err := k.ToUnderlyingAssetAmount(ctx, *vault, asset)
+	if err != nil {
+		return fmt.Errorf("failed to value deposit: %w", err)
+	}
+	if depositValue.GT(remaining) {
+		return fmt.Errorf("swap in of %s%s would exceed the remaining capacity of %s%s in vault %s (max total value %s%s)",
+			depositValue, vault.UnderlyingAsset, remaining, vault.UnderlyingAsset, vault.Address, vault.MaxTotalValue, vault.UnderlyingAsset)
+	}
+	return nil
+}
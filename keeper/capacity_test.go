@@ -0,0 +1,146 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/provlabs/vault/keeper"
+	"github.com/provlabs/vault/types"
+)
+
+func (s *TestSuite) TestKeeper_SwapIn_MaxTotalValue() {
+	underlying, share := "under", "vshare"
+
+	tests := []struct {
+		name      string
+		maxValue  string
+		deposits  []int64
+		expectErr string
+	}{
+		{name: "no cap admits any deposit", deposits: []int64{600, 600}},
+		{name: "deposit up to the cap is admitted", maxValue: "1000", deposits: []int64{1000}},
+		{name: "single deposit above the cap is rejected", maxValue: "1000", deposits: []int64{1001}, expectErr: "would exceed the remaining capacity of 1000under"},
+		{name: "deposits from separate accounts share the cap", maxValue: "1000", deposits: []int64{600, 600}, expectErr: "would exceed the remaining capacity of 400under"},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+			vault := s.setupBaseVault(underlying, share)
+			s.Require().NoError(s.k.SetMaxTotalValue(s.ctx, vault, tc.maxValue, s.adminAddr.String()), "should set max total value")
+
+			var err error
+			for _, amount := range tc.deposits {
+				investor := s.CreateAndFundAccount(sdk.NewInt64Coin(underlying, amount))
+				if _, err = s.k.SwapIn(s.ctx, vault.GetAddress(), investor, sdk.NewInt64Coin(underlying, amount)); err != nil {
+					break
+				}
+			}
+			if tc.expectErr != "" {
+				s.Require().ErrorContains(err, tc.expectErr, "SwapIn should reject case %q", tc.name)
+				return
+			}
+			s.Require().NoError(err, "SwapIn should accept case %q", tc.name)
+		})
+	}
+}
+
+func (s *TestSuite) TestMsgServer_UpdateMaxTotalValue() {
+	underlying, share := "under", "vshare"
+
+	tests := []struct {
+		name        string
+		signer      func() string
+		maxValue    string
+		expectErr   string
+		expectEvent bool
+	}{
+		{name: "limits manager sets the cap", signer: func() string { return s.adminAddr.String() }, maxValue: "1000000", expectEvent: true},
+		{name: "unchanged cap is a no-op", signer: func() string { return s.adminAddr.String() }},
+		{name: "address without the limits manager role cannot set the cap", signer: func() string { return s.assetManagerAddr.String() }, maxValue: "1000000", expectErr: "failed to validate limits manager role"},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+			vault := s.setupBaseVault(underlying, share)
+			s.ctx = s.ctx.WithEventManager(sdk.NewEventManager())
+
+			_, err := keeper.NewMsgServer(s.simApp.VaultKeeper).UpdateMaxTotalValue(s.ctx, &types.MsgUpdateMaxTotalValueRequest{
+				Authority:     tc.signer(),
+				VaultAddress:  vault.Address,
+				MaxTotalValue: tc.maxValue,
+			})
+			if tc.expectErr != "" {
+				s.Require().ErrorContains(err, tc.expectErr, "UpdateMaxTotalValue error")
+				return
+			}
+			s.Require().NoError(err, "UpdateMaxTotalValue should succeed")
+
+			updated, err := s.k.GetVault(s.ctx, vault.GetAddress())
+			s.Require().NoError(err, "should reload vault")
+			s.Assert().Equal(tc.maxValue, updated.MaxTotalValue, "stored max total value")
+			if tc.expectEvent {
+				s.requireTypedEventEmitted(types.NewEventMaxTotalValueUpdated(vault.Address, tc.signer(), tc.maxValue))
+			} else {
+				s.Assert().Empty(s.ctx.EventManager().Events(), "no-op update should not emit events")
+			}
+		})
+	}
+}
+
+func (s *TestSuite) TestQueryServer_EstimateSwapIn_RemainingCapacity() {
+	underlying, share := "under", "vshare"
+	s.SetupTest()
+	vault := s.setupBaseVault(underlying, share)
+	investor := s.CreateAndFundAccount(sdk.NewInt64Coin(underlying, 400))
+	_, err := s.k.SwapIn(s.ctx, vault.GetAddress(), investor, sdk.NewInt64Coin(underlying, 400))
+	s.Require().NoError(err, "swap in should succeed")
+
+	req := &types.QueryEstimateSwapInRequest{VaultAddress: vault.Address, Assets: sdk.NewInt64Coin(underlying, 100)}
+	resp, err := keeper.NewQueryServer(s.simApp.VaultKeeper).EstimateSwapIn(s.ctx, req)
+	s.Require().NoError(err, "EstimateSwapIn without a cap")
+	s.Assert().Nil(resp.RemainingCapacity, "uncapped vault should not report remaining capacity")
+
+	vault, err = s.k.GetVault(s.ctx, vault.GetAddress())
+	s.Require().NoError(err, "should reload vault")
+	s.Require().NoError(s.k.SetMaxTotalValue(s.ctx, vault, "1000", s.adminAddr.String()), "should set max total value")
+	resp, err = keeper.NewQueryServer(s.simApp.VaultKeeper).EstimateSwapIn(s.ctx, req)
+	s.Require().NoError(err, "EstimateSwapIn with a cap")
+	s.Require().NotNil(resp.RemainingCapacity, "capped vault should report remaining capacity")
+	s.Assert().Equal(sdk.NewInt64Coin(underlying, 600).String(), resp.RemainingCapacity.String(), "remaining capacity")
+
+	s.Require().NoError(s.k.SetMaxTotalValue(s.ctx, vault, "300", s.adminAddr.String()), "should lower max total value")
+	resp, err = keeper.NewQueryServer(s.simApp.VaultKeeper).EstimateSwapIn(s.ctx, req)
+	s.Require().NoError(err, "EstimateSwapIn with the vault over its cap")
+	s.Assert().Equal(sdk.NewInt64Coin(underlying, 0).String(), resp.RemainingCapacity.String(), "remaining capacity should not go negative")
+}
+
+func (s *TestSuite) TestQueryServer_EstimateSwapIn_RemainingCapacityNetOfAUMFee() {
+	underlying, share := "under", "vshare"
+	s.SetupTest()
+	vault := s.setupBaseVault(underlying, share)
+	investor := s.CreateAndFundAccount(sdk.NewInt64Coin(underlying, 1_100))
+	_, err := s.k.SwapIn(s.ctx, vault.GetAddress(), investor, sdk.NewInt64Coin(underlying, 400))
+	s.Require().NoError(err, "swap in should succeed")
+
+	vault, err = s.k.GetVault(s.ctx, vault.GetAddress())
+	s.Require().NoError(err, "should reload vault")
+	vault.OutstandingAumFee = sdk.NewInt64Coin(underlying, 100)
+	s.Require().NoError(s.k.SetVaultAccount(s.ctx, vault), "should record an outstanding AUM fee")
+	s.Require().NoError(s.k.SetMaxTotalValue(s.ctx, vault, "1000", s.adminAddr.String()), "should set max total value")
+
+	gross, err := s.k.GetTVV(s.ctx, *vault)
+	s.Require().NoError(err, "should get gross TVV")
+	net, err := s.k.GetNetTVV(s.ctx, *vault)
+	s.Require().NoError(err, "should get net TVV")
+	s.Require().NotEqual(gross, net, "the outstanding AUM fee should separate gross and net TVV")
+
+	req := &types.QueryEstimateSwapInRequest{VaultAddress: vault.Address, Assets: sdk.NewInt64Coin(underlying, 700)}
+	resp, err := keeper.NewQueryServer(s.simApp.VaultKeeper).EstimateSwapIn(s.ctx, req)
+	s.Require().NoError(err, "EstimateSwapIn with a cap")
+	s.Require().NotNil(resp.RemainingCapacity, "capped vault should report remaining capacity")
+	s.Assert().Equal(sdk.NewInt64Coin(underlying, 1_000).Amount.Sub(net).String(), resp.RemainingCapacity.Amount.String(), "remaining capacity should be measured against net TVV")
+
+	_, err = keeper.NewMsgServer(s.simApp.VaultKeeper).SwapIn(s.ctx, &types.MsgSwapInRequest{Owner: investor.String(), VaultAddress: vault.Address, Assets: *resp.RemainingCapacity})
+	s.Assert().NoError(err, "a swap in of the reported remaining capacity should be accepted")
+}
@@ -0,0 +1,68 @@
+package keeper
+
+import (
+	"errors"
+	"fmt"
+
+	"cosmossdk.io/collections"
+
+	"github.com/provlabs/vault/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// getParamsOrDefault returns the stored params, falling back to the defaults only when
+// params have not been stored.
+func (k Keeper) getParamsOrDefault(ctx sdk.Context) (types.Params, error) {
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		if !errors.Is(err, collections.ErrNotFound) {
+			return types.Params{}, fmt.Errorf("failed to get params: %w", err)
+		}
+		return types.DefaultParams(), nil
+	}
+	return params, nil
+}
+
+// getCircuitBreaker returns the module-wide circuit breaker, falling back to the default
+// (nothing halted) when params have not been stored.
+func (k Keeper) getCircuitBreaker(ctx sdk.Context) (types.CircuitBreaker, error) {
+	params, err := k.getParamsOrDefault(ctx)
+	if err != nil {
+		return types.CircuitBreaker{}, err
+	}
+	return params.CircuitBreaker, nil
+}
+
+// SetCircuitBreaker updates the module-wide circuit breaker and its guardian.
+func (k *Keeper) SetCircuitBreaker(ctx sdk.Context, breaker types.CircuitBreaker, guardian, authority string) error {
+	params, err := k.getParamsOrDefault(ctx)
+	if err != nil {
+		return err
+	}
+	if params.CircuitBreaker.Equal(breaker) && params.CircuitBreakerGuardian == guardian {
+		return nil
+	}
+	params.CircuitBreaker = breaker
+	params.CircuitBreakerGuardian = guardian
+	if err := k.Params.Set(ctx, params); err != nil {
+		return fmt.Errorf("failed to set params: %w", err)
+	}
+	k.emitEvent(ctx, types.NewEventCircuitBreakerUpdated(authority, breaker, guardian))
+	return nil
+}
+
+// requireNotHalted returns an error if the module-wide circuit breaker blocks msg. Message
+// handlers for every haltable operation call it before touching any vault, so a tripped
+// breaker applies to all vaults regardless of their own pause state. A breaker that cannot
+// be read blocks msg as well.
+func (k Keeper) requireNotHalted(ctx sdk.Context, msg sdk.Msg) error {
+	breaker, err := k.getCircuitBreaker(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to read circuit breaker: %w", err)
+	}
+	if op := breaker.HaltedOperation(msg); op != "" {
+		return fmt.Errorf("%s is halted by the module circuit breaker", op)
+	}
+	return nil
+}
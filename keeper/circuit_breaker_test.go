@@ -0,0 +1,186 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/provlabs/vault/keeper"
+	"github.com/provlabs/vault/types"
+)
+
+func (s *TestSuite) TestMsgServer_UpdateCircuitBreaker() {
+	guardian := sdk.AccAddress("guardianAddr________").String()
+	newGuardian := sdk.AccAddress("newGuardianAddr_____").String()
+	tripped := types.CircuitBreaker{SwapInHalted: true, SettlementHalted: true}
+
+	tests := []struct {
+		name           string
+		authority      func() string
+		guardian       string
+		msgGuardian    string
+		breaker        types.CircuitBreaker
+		expectGuardian string
+		expectErr      string
+		expectEvent    bool
+	}{
+		{name: "governance trips the breaker", authority: func() string { return s.govAuthority }, breaker: tripped, expectEvent: true},
+		{name: "guardian trips the breaker", authority: func() string { return guardian }, guardian: guardian, breaker: tripped, expectGuardian: guardian, expectEvent: true},
+		{name: "governance appoints a guardian", authority: func() string { return s.govAuthority }, msgGuardian: guardian, expectGuardian: guardian, expectEvent: true},
+		{name: "governance replaces the guardian", authority: func() string { return s.govAuthority }, guardian: guardian, msgGuardian: newGuardian, breaker: tripped, expectGuardian: newGuardian, expectEvent: true},
+		{name: "governance removes the guardian", authority: func() string { return s.govAuthority }, guardian: guardian, expectEvent: true},
+		{name: "unchanged breaker is a no-op", authority: func() string { return s.govAuthority }},
+		{name: "guardian cannot change the guardian", authority: func() string { return guardian }, guardian: guardian, msgGuardian: newGuardian, breaker: tripped, expectErr: "only the governance authority may change the circuit breaker guardian"},
+		{name: "guardian cannot act when none is configured", authority: func() string { return guardian }, breaker: tripped, expectErr: "neither the governance authority nor the circuit breaker guardian"},
+		{name: "other addresses cannot trip the breaker", authority: func() string { return s.adminAddr.String() }, guardian: guardian, breaker: tripped, expectErr: "neither the governance authority nor the circuit breaker guardian"},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+			params := types.DefaultParams()
+			params.CircuitBreakerGuardian = tc.guardian
+			s.Require().NoError(s.k.Params.Set(s.ctx, params), "should set params")
+			s.ctx = s.ctx.WithEventManager(sdk.NewEventManager())
+
+			_, err := keeper.NewMsgServer(s.simApp.VaultKeeper).UpdateCircuitBreaker(s.ctx, &types.MsgUpdateCircuitBreakerRequest{
+				Authority:      tc.authority(),
+				CircuitBreaker: tc.breaker,
+				Guardian:       tc.msgGuardian,
+			})
+			if tc.expectErr != "" {
+				s.Require().ErrorContains(err, tc.expectErr, "UpdateCircuitBreaker error")
+				return
+			}
+			s.Require().NoError(err, "UpdateCircuitBreaker should succeed")
+
+			resp, err := keeper.NewQueryServer(s.simApp.VaultKeeper).CircuitBreaker(s.ctx, &types.QueryCircuitBreakerRequest{})
+			s.Require().NoError(err, "CircuitBreaker query should succeed")
+			s.Assert().Equal(tc.breaker, resp.CircuitBreaker, "queried circuit breaker")
+			s.Assert().Equal(tc.expectGuardian, resp.Guardian, "queried guardian")
+			if tc.expectEvent {
+				s.requireTypedEventEmitted(types.NewEventCircuitBreakerUpdated(tc.authority(), tc.breaker, tc.expectGuardian))
+			} else {
+				s.Assert().Empty(s.ctx.EventManager().Events(), "no-op update should not emit events")
+			}
+		})
+	}
+}
+
+func (s *TestSuite) TestMsgServer_UpdateParamsKeepsCircuitBreaker() {
+	s.SetupTest()
+	guardian := sdk.AccAddress("guardianAddr________").String()
+	tripped := types.CircuitBreaker{SwapOutHalted: true, BridgeHalted: true}
+	s.Require().NoError(s.k.SetCircuitBreaker(s.ctx, tripped, guardian, s.govAuthority), "should trip the circuit breaker")
+
+	params := types.DefaultParams()
+	params.NavHistoryRetentionSeconds = 3_600
+	s.Require().Equal(types.CircuitBreaker{}, params.CircuitBreaker, "proposal should carry a reset breaker")
+	s.Require().Empty(params.CircuitBreakerGuardian, "proposal should carry no guardian")
+	_, err := keeper.NewMsgServer(s.simApp.VaultKeeper).UpdateParams(s.ctx, &types.MsgUpdateParamsRequest{
+		Authority: s.govAuthority,
+		Params:    params,
+	})
+	s.Require().NoError(err, "UpdateParams should succeed")
+
+	stored, err := s.k.Params.Get(s.ctx)
+	s.Require().NoError(err, "should get params")
+	s.Assert().Equal(uint64(3_600), stored.NavHistoryRetentionSeconds, "other params should be updated")
+	s.Assert().Equal(tripped, stored.CircuitBreaker, "tripped circuit breaker should be kept")
+	s.Assert().Equal(guardian, stored.CircuitBreakerGuardian, "circuit breaker guardian should be kept")
+}
+
+func (s *TestSuite) TestMsgServer_CircuitBreakerHaltsOperations() {
+	underlying, share := "under", "vshare"
+
+	tests := []struct {
+		name    string
+		breaker types.CircuitBreaker
+		call    func(msgServer types.MsgServer, vault *types.VaultAccount, investor sdk.AccAddress) error
+	}{
+		{
+			name:    "swap in",
+			breaker: types.CircuitBreaker{SwapInHalted: true},
+			call: func(msgServer types.MsgServer, vault *types.VaultAccount, investor sdk.AccAddress) error {
+				_, err := msgServer.SwapIn(s.ctx, &types.MsgSwapInRequest{Owner: investor.String(), VaultAddress: vault.Address, Assets: sdk.NewInt64Coin(underlying, 100)})
+				return err
+			},
+		},
+		{
+			name:    "swap out",
+			breaker: types.CircuitBreaker{SwapOutHalted: true},
+			call: func(msgServer types.MsgServer, vault *types.VaultAccount, investor sdk.AccAddress) error {
+				_, err := msgServer.SwapOut(s.ctx, &types.MsgSwapOutRequest{Owner: investor.String(), VaultAddress: vault.Address, Assets: sdk.NewInt64Coin(share, 100)})
+				return err
+			},
+		},
+		{
+			name:    "settlement",
+			breaker: types.CircuitBreaker{SettlementHalted: true},
+			call: func(msgServer types.MsgServer, vault *types.VaultAccount, _ sdk.AccAddress) error {
+				_, err := msgServer.AcceptAsset(s.ctx, &types.MsgAcceptAssetRequest{Authority: s.adminAddr.String(), VaultAddress: vault.Address})
+				return err
+			},
+		},
+		{
+			name:    "bridge",
+			breaker: types.CircuitBreaker{BridgeHalted: true},
+			call: func(msgServer types.MsgServer, vault *types.VaultAccount, investor sdk.AccAddress) error {
+				_, err := msgServer.BridgeMintShares(s.ctx, &types.MsgBridgeMintSharesRequest{Bridge: investor.String(), VaultAddress: vault.Address, Shares: sdk.NewInt64Coin(share, 100)})
+				return err
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+			vault := s.setupBaseVault(underlying, share)
+			investor := s.CreateAndFundAccount(sdk.NewInt64Coin(underlying, 1_000))
+			_, err := s.k.SwapIn(s.ctx, vault.GetAddress(), investor, sdk.NewInt64Coin(underlying, 500))
+			s.Require().NoError(err, "seed swap in should succeed")
+
+			msgServer := keeper.NewMsgServer(s.simApp.VaultKeeper)
+
+			s.Require().NoError(s.k.SetCircuitBreaker(s.ctx, tc.breaker, "", s.govAuthority), "should trip the circuit breaker")
+			s.Require().ErrorContains(tc.call(msgServer, vault, investor), "halted by the module circuit breaker", "%s should be halted", tc.name)
+
+			s.Require().NoError(s.k.SetCircuitBreaker(s.ctx, types.CircuitBreaker{}, "", s.govAuthority), "should reset the circuit breaker")
+			err = tc.call(msgServer, vault, investor)
+			if err != nil {
+				s.Assert().NotContains(err.Error(), "halted by the module circuit breaker", "%s should no longer be halted", tc.name)
+			}
+		})
+	}
+}
+
+func (s *TestSuite) TestMsgServer_UnreadableCircuitBreakerHaltsOperations() {
+	underlying, share := "under", "vshare"
+	s.SetupTest()
+	vault := s.setupBaseVault(underlying, share)
+	investor := s.CreateAndFundAccount(sdk.NewInt64Coin(underlying, 1_000))
+	s.Require().NoError(s.k.TestAccessor_corruptParams(s.T(), s.ctx), "should corrupt params")
+
+	_, err := keeper.NewMsgServer(s.simApp.VaultKeeper).SwapIn(s.ctx, &types.MsgSwapInRequest{Owner: investor.String(), VaultAddress: vault.Address, Assets: sdk.NewInt64Coin(underlying, 100)})
+	s.Require().ErrorContains(err, "failed to read circuit breaker", "swap in should fail closed when the breaker cannot be read")
+}
+
+func (s *TestSuite) TestKeeper_EndBlocker_CircuitBreakerHoldsSwapOuts() {
+	underlying, share := "under", "vshare"
+	s.SetupTest()
+	vault := s.setupBaseVault(underlying, share)
+	investor := s.CreateAndFundAccount(sdk.NewInt64Coin(underlying, 1_000))
+	shares, err := s.k.SwapIn(s.ctx, vault.GetAddress(), investor, sdk.NewInt64Coin(underlying, 500))
+	s.Require().NoError(err, "swap in should succeed")
+	requestID, err := s.k.SwapOut(s.ctx, vault.GetAddress(), investor, *shares)
+	s.Require().NoError(err, "swap out should succeed")
+
+	s.Require().NoError(s.k.SetCircuitBreaker(s.ctx, types.CircuitBreaker{SwapOutHalted: true}, "", s.govAuthority), "should halt swap outs")
+	s.Require().NoError(s.k.EndBlocker(s.ctx), "EndBlocker should not error while halted")
+	_, _, err = s.k.PendingSwapOutQueue.GetByID(s.ctx, requestID)
+	s.Require().NoError(err, "pending swap out should stay queued while swap outs are halted")
+
+	s.Require().NoError(s.k.SetCircuitBreaker(s.ctx, types.CircuitBreaker{}, "", s.govAuthority), "should reset the circuit breaker")
+	s.Require().NoError(s.k.EndBlocker(s.ctx), "EndBlocker should not error after reset")
+	_, _, err = s.k.PendingSwapOutQueue.GetByID(s.ctx, requestID)
+	s.Require().Error(err, "pending swap out should be processed once the breaker is reset")
+	s.Assert().Equal(int64(1_000), s.simApp.BankKeeper.GetBalance(s.ctx, investor, underlying).Amount.Int64(), "investor should be paid out")
+}
@@ -0,0 +1,275 @@
+package keeper
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/provlabs/vault/types"
+
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// bipsPerUnit is the number of basis points in a whole.
+const bipsPerUnit = 10_000
+
+// assetComposition is the value, in the vault's underlying asset, of each denom in a set of
+// vault holdings.
+type assetComposition struct {
+	// denoms are the valued denoms in sorted order, the underlying asset included.
+	denoms []string
+	// values holds the value of each denom in denoms, after haircuts.
+	values map[string]math.Int
+	// nonUnderlying is the combined value of every denom other than the underlying asset.
+	nonUnderlying math.Int
+	// total is the combined value of every denom.
+	total math.Int
+}
+
+// weightBips returns the share of the composition's total value that value makes up, in
+// basis points. An empty composition has no weight.
+func (c assetComposition) weightBips(value math.Int) math.LegacyDec {
+	if !c.total.IsPositive() {
+		return math.LegacyZeroDec()
+	}
+	return math.LegacyNewDecFromInt(value).MulInt64(bipsPerUnit).QuoInt(c.total)
+}
+
+// denomWeightBips returns the weight of denom in basis points, or of the combined
+// non-underlying holdings when denom is empty. A denom the composition does not hold has
+// no weight.
+func (c assetComposition) denomWeightBips(denom string) math.LegacyDec {
+	if denom == "" {
+		return c.weightBips(c.nonUnderlying)
+	}
+	value, ok := c.values[denom]
+	if !ok {
+		return math.LegacyZeroDec()
+	}
+	return c.weightBips(value)
+}
+
+// concentrationBreach is a holding whose weight exceeds one of the vault's concentration
+// limits. An empty denom is a breach of the combined non-underlying limit.
+type concentrationBreach struct {
+	denom      string
+	weightBips math.LegacyDec
+	limitBips  uint32
+}
+
+// breaches returns every holding in the composition above one of the vault's concentration
+// limits: each non-underlying denom over max_asset_concentration_bips, then the combined
+// non-underlying holdings over max_non_underlying_bips.
+func (c assetComposition) breaches(vault *types.VaultAccount) []concentrationBreach {
+	var breaches []concentrationBreach
+	if vault.MaxAssetConcentrationBips > 0 {
+		for _, denom := range c.denoms {
+			if denom == vault.UnderlyingAsset {
+				continue
+			}
+			weight := c.denomWeightBips(denom)
+			if weight.GT(math.LegacyNewDec(int64(vault.MaxAssetConcentrationBips))) {
+				breaches = append(breaches, concentrationBreach{denom: denom, weightBips: weight, limitBips: vault.MaxAssetConcentrationBips})
+			}
+		}
+	}
+	if vault.MaxNonUnderlyingBips > 0 {
+		weight := c.denomWeightBips("")
+		if weight.GT(math.LegacyNewDec(int64(vault.MaxNonUnderlyingBips))) {
+			breaches = append(breaches, concentrationBreach{weightBips: weight, limitBips: vault.MaxNonUnderlyingBips})
+		}
+	}
+	return breaches
+}
+
+// compositionOf values each of balances in the vault's underlying asset. The underlying
+// asset counts at its identity price and the share denom is skipped. Any other denom is
+// valued as in GetTVV, net of the vault's haircut for it, and a denom no price source values
+// is skipped.
+func (k Keeper) compositionOf(ctx sdk.Context, vault types.VaultAccount, balances sdk.Coins) (assetComposition, error) {
+	c := assetComposition{
+		values:        make(map[string]math.Int, len(balances)),
+		nonUnderlying: math.ZeroInt(),
+		total:         math.ZeroInt(),
+	}
+	for _, balance := range balances {
+		if balance.Denom == vault.TotalShares.Denom || balance.IsZero() {
+			continue
+		}
+		value := balance.Amount
+		if balance.Denom != vault.UnderlyingAsset {
+			var err error
+			value, err = k.haircutHeldValue(ctx, vault, balance)
+			if err != nil {
+				if errors.Is(err, ErrInternalNAVNotFound) {
+					continue
+				}
+				return assetComposition{}, err
+			}
+			c.nonUnderlying, err = c.nonUnderlying.SafeAdd(value)
+			if err != nil {
+				return assetComposition{}, fmt.Errorf("failed to add %s value to non-underlying holdings: %w", balance.Denom, err)
+			}
+		}
+		total, err := c.total.SafeAdd(value)
+		if err != nil {
+			return assetComposition{}, fmt.Errorf("failed to add %s value to total vault value: %w", balance.Denom, err)
+		}
+		c.total = total
+		c.denoms = append(c.denoms, balance.Denom)
+		c.values[balance.Denom] = value
+	}
+	return c, nil
+}
+
+// vaultComposition values the vault's live holdings across its principal marker and payment
+// escrow account. Unlike GetTVV, it ignores any pause snapshot.
+func (k Keeper) vaultComposition(ctx sdk.Context, vault types.VaultAccount) (assetComposition, error) {
+	return k.compositionOf(ctx, vault, k.heldBalances(ctx, vault))
+}
+
+// concentrationSnapshot returns the vault's current composition for a later
+// concentration check, or nil when the vault sets no concentration limit.
+func (k Keeper) concentrationSnapshot(ctx sdk.Context, vault *types.VaultAccount) (*assetComposition, error) {
+	if !vault.HasConcentrationLimits() {
+		return nil, nil
+	}
+	c, err := k.vaultComposition(ctx, *vault)
+	if err != nil {
+		return nil, fmt.Errorf("failed to value vault composition: %w", err)
+	}
+	return &c, nil
+}
+
+// worsenedBreaches returns each concentration limit after breaches with a higher weight
+// than before gave the same holding. A holding that was already over its limit and only
+// drifted lower is not counted, so a vault that market moves pushed over a limit can still
+// trade its way back under it.
+func worsenedBreaches(vault *types.VaultAccount, before, after assetComposition) []concentrationBreach {
+	var worsened []concentrationBreach
+	for _, breach := range after.breaches(vault) {
+		if breach.weightBips.GT(before.denomWeightBips(breach.denom)) {
+			worsened = append(worsened, breach)
+		}
+	}
+	return worsened
+}
+
+// requireConcentrationNotWorsened rejects a change to the vault's holdings that left a
+// concentration limit breached with a higher weight than before, comparing after against
+// the before snapshot. A nil before, from a vault with no limits, passes.
+func requireConcentrationNotWorsened(vault *types.VaultAccount, before *assetComposition, after assetComposition) error {
+	if before == nil {
+		return nil
+	}
+	breaches := worsenedBreaches(vault, *before, after)
+	if len(breaches) == 0 {
+		return nil
+	}
+	breach := breaches[0]
+	if breach.denom == "" {
+		return fmt.Errorf("non-underlying holdings would make up %s bips of the value of vault %s, above its limit of %d bips", breach.weightBips, vault.Address, breach.limitBips)
+	}
+	return fmt.Errorf("denom %q would make up %s bips of the value of vault %s, above its limit of %d bips", breach.denom, breach.weightBips, vault.Address, breach.limitBips)
+}
+
+// requireSettlementConcentration rejects an inbound settlement that left the vault's
+// holdings breaching a concentration limit with a higher weight than before, comparing the
+// vault's holdings now against the before snapshot taken ahead of the settlement.
+func (k Keeper) requireSettlementConcentration(ctx sdk.Context, vault *types.VaultAccount, before *assetComposition) error {
+	if before == nil {
+		return nil
+	}
+	after, err := k.vaultComposition(ctx, *vault)
+	if err != nil {
+		return fmt.Errorf("failed to value vault composition: %w", err)
+	}
+	return requireConcentrationNotWorsened(vault, before, after)
+}
+
+// requireProjectedConcentration rejects an inbound trade the vault offers that, once
+// accepted, would leave its holdings breaching a concentration limit with a higher weight
+// than now. The projection adds assetCoins to the vault's holdings and removes paymentCoin.
+func (k Keeper) requireProjectedConcentration(ctx sdk.Context, vault *types.VaultAccount, assetCoins sdk.Coins, paymentCoin sdk.Coin) error {
+	before, err := k.concentrationSnapshot(ctx, vault)
+	if err != nil || before == nil {
+		return err
+	}
+	projected, negative := k.heldBalances(ctx, *vault).Add(assetCoins...).SafeSub(paymentCoin)
+	if negative {
+		return fmt.Errorf("insufficient holdings of %s to pay %s", paymentCoin.Denom, paymentCoin)
+	}
+	after, err := k.compositionOf(ctx, *vault, projected)
+	if err != nil {
+		return fmt.Errorf("failed to value projected vault composition: %w", err)
+	}
+	return requireConcentrationNotWorsened(vault, before, after)
+}
+
+// emitConcentrationWarnings emits an EventConcentrationLimitBreached for each concentration
+// limit the vault's holdings now breach with a higher weight than in the before snapshot.
+// Market moves are reported this way rather than rejected: a price update must still apply.
+func (k Keeper) emitConcentrationWarnings(ctx sdk.Context, vault *types.VaultAccount, before *assetComposition) error {
+	if before == nil {
+		return nil
+	}
+	after, err := k.vaultComposition(ctx, *vault)
+	if err != nil {
+		return fmt.Errorf("failed to value vault composition: %w", err)
+	}
+	for _, breach := range worsenedBreaches(vault, *before, after) {
+		k.emitEvent(ctx, types.NewEventConcentrationLimitBreached(vault.Address, breach.denom, breach.weightBips, breach.limitBips))
+	}
+	return nil
+}
+
+// GetVaultComposition returns the vault's live total value and the weight of each valued
+// denom it holds, and of its combined non-underlying holdings, against its concentration
+// limits.
+func (k Keeper) GetVaultComposition(ctx sdk.Context, vault types.VaultAccount) (sdk.Coin, []types.AssetWeight, types.AssetWeight, error) {
+	c, err := k.vaultComposition(ctx, vault)
+	if err != nil {
+		return sdk.Coin{}, nil, types.AssetWeight{}, err
+	}
+	breached := make(map[string]bool)
+	for _, breach := range c.breaches(&vault) {
+		breached[breach.denom] = true
+	}
+
+	assets := make([]types.AssetWeight, 0, len(c.denoms))
+	for _, denom := range c.denoms {
+		weight := types.AssetWeight{
+			Denom:      denom,
+			Value:      sdk.NewCoin(vault.UnderlyingAsset, c.values[denom]),
+			WeightBips: c.denomWeightBips(denom),
+			Breached:   breached[denom],
+		}
+		if denom != vault.UnderlyingAsset {
+			weight.LimitBips = vault.MaxAssetConcentrationBips
+		}
+		assets = append(assets, weight)
+	}
+	nonUnderlying := types.AssetWeight{
+		Value:      sdk.NewCoin(vault.UnderlyingAsset, c.nonUnderlying),
+		WeightBips: c.denomWeightBips(""),
+		LimitBips:  vault.MaxNonUnderlyingBips,
+		Breached:   breached[""],
+	}
+	return sdk.NewCoin(vault.UnderlyingAsset, c.total), assets, nonUnderlying, nil
+}
+
+// SetConcentrationLimits updates the concentration limits for a vault. Holdings already above
+// a new limit are left in place.
+func (k *Keeper) SetConcentrationLimits(ctx sdk.Context, vault *types.VaultAccount, maxAssetConcentrationBips, maxNonUnderlyingBips uint32, authority string) error {
+	if vault.MaxAssetConcentrationBips == maxAssetConcentrationBips && vault.MaxNonUnderlyingBips == maxNonUnderlyingBips {
+		return nil
+	}
+	vault.MaxAssetConcentrationBips = maxAssetConcentrationBips
+	vault.MaxNonUnderlyingBips = maxNonUnderlyingBips
+	if err := k.SetVaultAccount(ctx, vault); err != nil {
+		return fmt.Errorf("failed to set vault account: %w", err)
+	}
+	k.emitEvent(ctx, types.NewEventConcentrationLimitsUpdated(vault.Address, authority, maxAssetConcentrationBips, maxNonUnderlyingBips))
+	return nil
+}
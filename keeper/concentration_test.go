@@ -0,0 +1,209 @@
+package keeper_test
+
+import (
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/provlabs/vault/keeper"
+	"github.com/provlabs/vault/types"
+)
+
+// setupConcentrationVault builds an asset-settlement vault whose principal holds 1,000 of its
+// underlying asset and the given held coins, each priced at one underlying per unit. The
+// asset denom is registered and priced the same way, ready to be bought.
+func (s *TestSuite) setupConcentrationVault(underlying, share, asset string, held sdk.Coins, maxAssetBips, maxNonUnderlyingBips uint32) *types.VaultAccount {
+	vault, principalAddr := s.setupAssetSettlementVault(underlying, share)
+	for _, denom := range append(held.Denoms(), asset) {
+		s.requireSimpleMarker(denom)
+		s.Require().NoError(s.k.SetVaultNAV(s.ctx, vault, types.VaultNAV{Denom: denom, Price: sdk.NewInt64Coin(underlying, 1), Volume: sdkmath.NewInt(1)}, s.adminAddr.String()), "failed to price %s", denom)
+	}
+	s.Require().NoError(FundAccount(s.ctx, s.simApp.BankKeeper, principalAddr, held.Add(sdk.NewInt64Coin(underlying, 1_000))), "failed to fund principal")
+	vault.MaxAssetConcentrationBips = maxAssetBips
+	vault.MaxNonUnderlyingBips = maxNonUnderlyingBips
+	s.Require().NoError(s.k.SetVaultAccount(s.ctx, vault), "failed to set concentration limits")
+	return vault
+}
+
+func (s *TestSuite) TestMsgServer_AcceptAsset_ConcentrationLimits() {
+	underlying, share, asset := "under", "vshare", "rwacoin"
+
+	tests := []struct {
+		name                 string
+		held                 sdk.Coins
+		maxAssetBips         uint32
+		maxNonUnderlyingBips uint32
+		buy                  int64
+		expectedErrContains  string
+	}{
+		{
+			name:         "purchase within the single-asset limit settles",
+			maxAssetBips: 1_500,
+			buy:          100,
+		},
+		{
+			name:                "purchase past the single-asset limit is rejected",
+			maxAssetBips:        1_500,
+			buy:                 200,
+			expectedErrContains: `denom "rwacoin" would make up 2000.000000000000000000 bips`,
+		},
+		{
+			name:                 "purchase past the non-underlying limit is rejected",
+			held:                 sdk.NewCoins(sdk.NewInt64Coin("bondcoin", 700)),
+			maxNonUnderlyingBips: 4_500,
+			buy:                  200,
+			expectedErrContains:  "non-underlying holdings would make up 5294.117647058823529411 bips",
+		},
+		{
+			name:         "a limit breached by another holding does not block a purchase that leaves it unchanged",
+			held:         sdk.NewCoins(sdk.NewInt64Coin("bondcoin", 500)),
+			maxAssetBips: 1_500,
+			buy:          100,
+		},
+		{
+			name: "no limits permits any purchase",
+			buy:  900,
+		},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			origCtx := s.ctx
+			defer func() { s.ctx = origCtx }()
+			s.ctx, _ = s.ctx.CacheContext()
+
+			vault := s.setupConcentrationVault(underlying, share, asset, tc.held, tc.maxAssetBips, tc.maxNonUnderlyingBips)
+			source := s.CreateAndFundAccount(sdk.NewInt64Coin("stake", 1_000))
+			s.Require().NoError(FundAccount(s.ctx, s.simApp.BankKeeper, source, sdk.NewCoins(sdk.NewInt64Coin(asset, tc.buy))), "failed to fund source")
+			s.createPayment(source, vault.GetAddress(), sdk.NewCoins(sdk.NewInt64Coin(asset, tc.buy)), sdk.NewCoins(sdk.NewInt64Coin(underlying, tc.buy)), "buy")
+
+			_, err := keeper.NewMsgServer(s.simApp.VaultKeeper).AcceptAsset(s.ctx, &types.MsgAcceptAssetRequest{
+				Authority:    s.assetManagerAddr.String(),
+				VaultAddress: vault.Address,
+				Source:       source.String(),
+				ExternalId:   "buy",
+			})
+			if tc.expectedErrContains != "" {
+				s.Require().ErrorContains(err, tc.expectedErrContains, "AcceptAsset should reject case %q", tc.name)
+				return
+			}
+			s.Require().NoError(err, "AcceptAsset should settle case %q", tc.name)
+		})
+	}
+}
+
+func (s *TestSuite) TestKeeper_SetVaultNAV_ConcentrationWarning() {
+	underlying, share, asset := "under", "vshare", "rwacoin"
+
+	tests := []struct {
+		name         string
+		price        int64
+		expectedWarn *types.EventConcentrationLimitBreached
+	}{
+		{
+			name:  "a markup past the limit warns and still applies",
+			price: 3,
+			expectedWarn: types.NewEventConcentrationLimitBreached(
+				"", asset, sdkmath.LegacyMustNewDecFromStr("2307.692307692307692307"), 1_500,
+			),
+		},
+		{
+			name:  "a markdown raises no warning",
+			price: 0,
+		},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			origCtx := s.ctx
+			defer func() { s.ctx = origCtx }()
+			s.ctx, _ = s.ctx.CacheContext()
+
+			vault := s.setupConcentrationVault(underlying, share, asset, sdk.NewCoins(sdk.NewInt64Coin(asset, 100)), 1_500, 0)
+			s.ctx = s.ctx.WithEventManager(sdk.NewEventManager())
+
+			err := s.k.SetVaultNAV(s.ctx, vault, types.VaultNAV{Denom: asset, Price: sdk.NewInt64Coin(underlying, tc.price), Volume: sdkmath.NewInt(1)}, s.adminAddr.String())
+			s.Require().NoError(err, "SetVaultNAV should apply the price")
+
+			nav, err := s.k.GetVaultNAV(s.ctx, vault.GetAddress(), asset)
+			s.Require().NoError(err, "GetVaultNAV")
+			s.Assert().Equal(tc.price, nav.Price.Amount.Int64(), "the new price should be stored")
+			if tc.expectedWarn != nil {
+				tc.expectedWarn.VaultAddress = vault.Address
+				s.requireTypedEventEmitted(tc.expectedWarn)
+				return
+			}
+			for _, ev := range s.ctx.EventManager().Events() {
+				s.Assert().NotEqual("provlabs.vault.v1.EventConcentrationLimitBreached", ev.Type, "no concentration warning expected")
+			}
+		})
+	}
+}
+
+func (s *TestSuite) TestQueryServer_VaultComposition() {
+	underlying, share, asset := "under", "vshare", "rwacoin"
+	vault := s.setupConcentrationVault(underlying, share, asset, sdk.NewCoins(sdk.NewInt64Coin(asset, 250), sdk.NewInt64Coin("bondcoin", 250)), 2_000, 6_000)
+
+	resp, err := keeper.NewQueryServer(s.simApp.VaultKeeper).VaultComposition(s.ctx, &types.QueryVaultCompositionRequest{Id: vault.Address})
+	s.Require().NoError(err, "VaultComposition")
+	s.Assert().Equal(sdk.NewInt64Coin(underlying, 1_500), resp.TotalValue, "total value")
+	s.Assert().Equal([]types.AssetWeight{
+		{Denom: "bondcoin", Value: sdk.NewInt64Coin(underlying, 250), WeightBips: sdkmath.LegacyMustNewDecFromStr("1666.666666666666666666"), LimitBips: 2_000},
+		{Denom: asset, Value: sdk.NewInt64Coin(underlying, 250), WeightBips: sdkmath.LegacyMustNewDecFromStr("1666.666666666666666666"), LimitBips: 2_000},
+		{Denom: underlying, Value: sdk.NewInt64Coin(underlying, 1_000), WeightBips: sdkmath.LegacyMustNewDecFromStr("6666.666666666666666666")},
+	}, resp.Assets, "asset weights")
+	s.Assert().Equal(types.AssetWeight{
+		Value:      sdk.NewInt64Coin(underlying, 500),
+		WeightBips: sdkmath.LegacyMustNewDecFromStr("3333.333333333333333333"),
+		LimitBips:  6_000,
+	}, resp.NonUnderlying, "non-underlying weight")
+
+	_, err = keeper.NewQueryServer(s.simApp.VaultKeeper).VaultComposition(s.ctx, &types.QueryVaultCompositionRequest{})
+	s.Assert().ErrorContains(err, "id must be provided", "empty id should be rejected")
+}
+
+func (s *TestSuite) TestMsgServer_UpdateConcentrationLimits() {
+	underlying, share := "under", "vshare"
+
+	tests := []struct {
+		name        string
+		signer      func() string
+		assetBips   uint32
+		nonUndBips  uint32
+		expectErr   string
+		expectEvent bool
+	}{
+		{name: "admin sets the limits", signer: func() string { return s.adminAddr.String() }, assetBips: 1_500, nonUndBips: 8_000, expectEvent: true},
+		{name: "unchanged limits are a no-op", signer: func() string { return s.adminAddr.String() }},
+		{name: "asset manager cannot set the limits", signer: func() string { return s.assetManagerAddr.String() }, assetBips: 1_500, expectErr: "failed to validate admin"},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+			vault, _ := s.setupAssetSettlementVault(underlying, share)
+			s.ctx = s.ctx.WithEventManager(sdk.NewEventManager())
+
+			_, err := keeper.NewMsgServer(s.simApp.VaultKeeper).UpdateConcentrationLimits(s.ctx, &types.MsgUpdateConcentrationLimitsRequest{
+				Authority:                 tc.signer(),
+				VaultAddress:              vault.Address,
+				MaxAssetConcentrationBips: tc.assetBips,
+				MaxNonUnderlyingBips:      tc.nonUndBips,
+			})
+			if tc.expectErr != "" {
+				s.Require().ErrorContains(err, tc.expectErr, "UpdateConcentrationLimits error")
+				return
+			}
+			s.Require().NoError(err, "UpdateConcentrationLimits should succeed")
+
+			updated, err := s.k.GetVault(s.ctx, vault.GetAddress())
+			s.Require().NoError(err, "should reload vault")
+			s.Assert().Equal(tc.assetBips, updated.MaxAssetConcentrationBips, "stored single-asset limit")
+			s.Assert().Equal(tc.nonUndBips, updated.MaxNonUnderlyingBips, "stored non-underlying limit")
+			if tc.expectEvent {
+				s.requireTypedEventEmitted(types.NewEventConcentrationLimitsUpdated(vault.Address, tc.signer(), tc.assetBips, tc.nonUndBips))
+			} else {
+				s.Assert().Empty(s.ctx.EventManager().Events(), "no-op update should not emit events")
+			}
+		})
+	}
+}
@@ -0,0 +1,152 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"cosmossdk.io/collections"
+
+	"github.com/provlabs/vault/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	markertypes "github.com/provenance-io/provenance/x/marker/types"
+)
+
+// SetEligibilityPolicy updates the eligibility policy for a vault. Tightening it does not force
+// existing shareholders out.
+func (k *Keeper) SetEligibilityPolicy(ctx sdk.Context, vault *types.VaultAccount, policy types.EligibilityPolicy, authority string) error {
+	current := vault.EligibilityPolicy
+	if current.Enabled == policy.Enabled && slices.Equal(current.RequiredAttributes, policy.RequiredAttributes) {
+		return nil
+	}
+	vault.EligibilityPolicy = policy
+	if err := k.SetVaultAccount(ctx, vault); err != nil {
+		return fmt.Errorf("failed to set vault account: %w", err)
+	}
+	k.emitEvent(ctx, types.NewEventEligibilityPolicyUpdated(vault.Address, authority, policy))
+	return nil
+}
+
+// UpdateVaultAllowlist adds and removes addresses from the vault's investor allowlist and
+// emits an EventAllowlistUpdated. Adding an address that is already allowlisted, or removing
+// one that is not, is a no-op for that address. The caller is responsible for verifying that
+// authority may perform the update.
+func (k *Keeper) UpdateVaultAllowlist(ctx sdk.Context, vault *types.VaultAccount, add, remove []string, authority string) error {
+	for _, addr := range add {
+		investor, err := sdk.AccAddressFromBech32(addr)
+		if err != nil {
+			return fmt.Errorf("invalid allowlist address %s: %w", addr, err)
+		}
+		if err := k.InvestorAllowlist.Set(ctx, collections.Join(vault.GetAddress(), investor)); err != nil {
+			return fmt.Errorf("failed to add %s to allowlist: %w", addr, err)
+		}
+	}
+	for _, addr := range remove {
+		investor, err := sdk.AccAddressFromBech32(addr)
+		if err != nil {
+			return fmt.Errorf("invalid allowlist address %s: %w", addr, err)
+		}
+		if err := k.InvestorAllowlist.Remove(ctx, collections.Join(vault.GetAddress(), investor)); err != nil {
+			return fmt.Errorf("failed to remove %s from allowlist: %w", addr, err)
+		}
+	}
+	k.emitEvent(ctx, types.NewEventAllowlistUpdated(vault.Address, authority, add, remove))
+	return nil
+}
+
+// CheckEligibility evaluates addr against the vault's eligibility policy. It reports whether
+// addr is eligible, whether it is on the vault's allowlist, and which of the policy's
+// required attributes it does not hold. Attributes past their expiration date are treated
+// as not held. When the policy is disabled every address is eligible.
+func (k Keeper) CheckEligibility(ctx sdk.Context, vault *types.VaultAccount, addr sdk.AccAddress) (eligible, allowlisted bool, missing []string, err error) {
+	allowlisted, err = k.InvestorAllowlist.Has(ctx, collections.Join(vault.GetAddress(), addr))
+	if err != nil {
+		return false, false, nil, fmt.Errorf("failed to read allowlist: %w", err)
+	}
+
+	policy := vault.EligibilityPolicy
+	if len(policy.RequiredAttributes) > 0 {
+		attrs, err := k.AttrKeeper.GetAllAttributesAddr(ctx, addr)
+		if err != nil {
+			return false, false, nil, fmt.Errorf("failed to get attributes of %s: %w", addr, err)
+		}
+		held := make([]string, 0, len(attrs))
+		for _, attr := range attrs {
+			if attr.ExpirationDate != nil && attr.ExpirationDate.Before(ctx.BlockTime()) {
+				continue
+			}
+			held = append(held, attr.Name)
+		}
+		missing = policy.MissingAttributes(held)
+	}
+
+	switch {
+	case !policy.Enabled, allowlisted:
+		eligible = true
+	default:
+		eligible = len(policy.RequiredAttributes) > 0 && len(missing) == 0
+	}
+	return eligible, allowlisted, missing, nil
+}
+
+// requireEligible returns an error unless addr is eligible to hold shares of the vault.
+func (k Keeper) requireEligible(ctx sdk.Context, vault *types.VaultAccount, addr sdk.AccAddress) error {
+	if !vault.EligibilityPolicy.Enabled {
+		return nil
+	}
+	eligible, _, missing, err := k.CheckEligibility(ctx, vault, addr)
+	if err != nil {
+		return fmt.Errorf("failed to check eligibility of %s: %w", addr, err)
+	}
+	if eligible {
+		return nil
+	}
+	if len(missing) > 0 {
+		return fmt.Errorf("%s is not eligible to hold shares of vault %s: missing required attributes %v", addr, vault.Address, missing)
+	}
+	return fmt.Errorf("%s is not eligible to hold shares of vault %s: not on the allowlist", addr, vault.Address)
+}
+
+// SendRestrictionFn is a bank send restriction that blocks transfers of a vault's shares to
+// addresses that are not eligible under the vault's eligibility policy, or whose position
+// would then exceed the vault's position cap. Transfers from the
+// vault or its share marker are issuance and refund paths whose recipients are checked where
+// they originate, and transfers to the vault, its share marker, or its bridge address are
+// redemptions or bridge-outs, so neither is restricted. Transfers made with the marker
+// module's bypass are not restricted either.
+func (k Keeper) SendRestrictionFn(goCtx context.Context, fromAddr, toAddr sdk.AccAddress, amt sdk.Coins) (sdk.AccAddress, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if markertypes.HasBypass(ctx) {
+		return toAddr, nil
+	}
+	for _, coin := range amt {
+		vault := k.shareVault(ctx, coin.Denom)
+		if vault == nil || (!vault.EligibilityPolicy.Enabled && vault.MaxPositionValue == "") {
+			continue
+		}
+		shareMarker := vault.PrincipalMarkerAddress()
+		if fromAddr.Equals(vault.GetAddress()) || fromAddr.Equals(shareMarker) ||
+			toAddr.Equals(vault.GetAddress()) || toAddr.Equals(shareMarker) ||
+			(vault.BridgeAddress != "" && toAddr.String() == vault.BridgeAddress) {
+			continue
+		}
+		if err := k.requireEligible(ctx, vault, toAddr); err != nil {
+			return nil, err
+		}
+		if err := k.requireTransferWithinPositionCap(ctx, vault, toAddr, coin.Amount); err != nil {
+			return nil, err
+		}
+	}
+	return toAddr, nil
+}
+
+// shareVault returns the vault whose share denom is denom, or nil if there is none.
+func (k Keeper) shareVault(ctx sdk.Context, denom string) *types.VaultAccount {
+	vault, err := k.GetVault(ctx, types.GetVaultAddress(denom))
+	if err != nil || vault == nil || vault.TotalShares.Denom != denom {
+		return nil
+	}
+	return vault
+}
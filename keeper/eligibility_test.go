@@ -0,0 +1,209 @@
+package keeper_test
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	attrtypes "github.com/provenance-io/provenance/x/attribute/types"
+
+	"github.com/provlabs/vault/keeper"
+	"github.com/provlabs/vault/types"
+)
+
+// setEligibilityAttribute gives addr the named attribute, expiring after ttl.
+func (s *TestSuite) setEligibilityAttribute(addr sdk.AccAddress, name string, ttl time.Duration) {
+	if !s.simApp.NameKeeper.NameExists(s.ctx, name) {
+		s.Require().NoError(s.simApp.NameKeeper.SetNameRecord(s.ctx, name, s.adminAddr, false), "should bind attribute name %s", name)
+	}
+	expireTime := s.ctx.BlockTime().Add(ttl)
+	attr := attrtypes.NewAttribute(name, addr.String(), attrtypes.AttributeType_String, []byte("true"), &expireTime, "")
+	s.Require().NoError(s.simApp.AttributeKeeper.SetAttribute(s.ctx, attr, s.adminAddr), "should set attribute %s", name)
+}
+
+func (s *TestSuite) TestKeeper_SwapIn_EligibilityPolicy() {
+	underlying, share := "under", "vshare"
+	policy := types.EligibilityPolicy{Enabled: true, RequiredAttributes: []string{"accredited.kyc.io", "*.aml.io"}}
+
+	tests := []struct {
+		name        string
+		policy      types.EligibilityPolicy
+		allowlisted bool
+		attributes  []string
+		expired     bool
+		expectErr   string
+	}{
+		{name: "disabled policy admits anyone", policy: types.EligibilityPolicy{RequiredAttributes: policy.RequiredAttributes}},
+		{name: "investor without attributes is rejected", policy: policy, expectErr: "missing required attributes [accredited.kyc.io *.aml.io]"},
+		{name: "investor with only some attributes is rejected", policy: policy, attributes: []string{"accredited.kyc.io"}, expectErr: "missing required attributes [*.aml.io]"},
+		{name: "investor with every attribute is admitted", policy: policy, attributes: []string{"accredited.kyc.io", "passed.aml.io"}},
+		{name: "expired attributes are not held", policy: policy, attributes: []string{"accredited.kyc.io", "passed.aml.io"}, expired: true, expectErr: "missing required attributes"},
+		{name: "allowlisted investor is admitted without attributes", policy: policy, allowlisted: true},
+		{name: "allowlist-only policy rejects other investors", policy: types.EligibilityPolicy{Enabled: true}, expectErr: "not on the allowlist"},
+		{name: "allowlist-only policy admits allowlisted investors", policy: types.EligibilityPolicy{Enabled: true}, allowlisted: true},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+			vault := s.setupBaseVault(underlying, share)
+			investor := s.CreateAndFundAccount(sdk.NewInt64Coin(underlying, 1_000))
+			for _, name := range tc.attributes {
+				s.setEligibilityAttribute(investor, name, time.Hour)
+			}
+			if tc.expired {
+				s.SetCtxBlockTime(s.ctx.BlockTime().Add(2 * time.Hour))
+			}
+			s.Require().NoError(s.k.SetEligibilityPolicy(s.ctx, vault, tc.policy, s.adminAddr.String()), "should set eligibility policy")
+			if tc.allowlisted {
+				s.Require().NoError(s.k.UpdateVaultAllowlist(s.ctx, vault, []string{investor.String()}, nil, s.adminAddr.String()), "should allowlist investor")
+			}
+
+			_, err := s.k.SwapIn(s.ctx, vault.GetAddress(), investor, sdk.NewInt64Coin(underlying, 100))
+			if tc.expectErr != "" {
+				s.Require().ErrorContains(err, tc.expectErr, "SwapIn should reject case %q", tc.name)
+				return
+			}
+			s.Require().NoError(err, "SwapIn should accept case %q", tc.name)
+			s.Assert().True(s.simApp.BankKeeper.GetBalance(s.ctx, investor, share).IsPositive(), "investor should receive shares")
+		})
+	}
+}
+
+func (s *TestSuite) TestKeeper_SendRestrictionFn_ShareTransfers() {
+	underlying, share := "under", "vshare"
+	s.SetupTest()
+	vault := s.setupBaseVault(underlying, share)
+	holder := s.CreateAndFundAccount(sdk.NewInt64Coin(underlying, 1_000))
+	outsider := s.CreateAndFundAccount(sdk.NewInt64Coin("stake", 1))
+	_, err := s.k.SwapIn(s.ctx, vault.GetAddress(), holder, sdk.NewInt64Coin(underlying, 100))
+	s.Require().NoError(err, "holder should swap in before the policy is enabled")
+
+	s.Require().NoError(s.k.SetEligibilityPolicy(s.ctx, vault, types.EligibilityPolicy{Enabled: true}, s.adminAddr.String()), "should enable eligibility policy")
+	shares := sdk.NewCoins(sdk.NewCoin(share, s.simApp.BankKeeper.GetBalance(s.ctx, holder, share).Amount.QuoRaw(4)))
+
+	err = s.simApp.BankKeeper.SendCoins(s.ctx, holder, outsider, shares)
+	s.Require().ErrorContains(err, "not on the allowlist", "share transfer to an ineligible address should be rejected")
+
+	err = s.simApp.BankKeeper.SendCoins(s.ctx, holder, outsider, sdk.NewCoins(sdk.NewInt64Coin(underlying, 1)))
+	s.Require().NoError(err, "transfers of other denoms should not be restricted")
+
+	s.Require().NoError(s.k.UpdateVaultAllowlist(s.ctx, vault, []string{outsider.String()}, nil, s.adminAddr.String()), "should allowlist outsider")
+	err = s.simApp.BankKeeper.SendCoins(s.ctx, holder, outsider, shares)
+	s.Require().NoError(err, "share transfer to an allowlisted address should succeed")
+
+	s.Require().NoError(s.k.UpdateVaultAllowlist(s.ctx, vault, nil, []string{outsider.String()}, s.adminAddr.String()), "should remove outsider from the allowlist")
+	_, err = s.k.SwapOut(s.ctx, vault.GetAddress(), outsider, shares[0])
+	s.Require().NoError(err, "a shareholder removed from the allowlist should still be able to swap out")
+}
+
+func (s *TestSuite) TestMsgServer_UpdateEligibilityPolicy() {
+	underlying, share := "under", "vshare"
+	policy := types.EligibilityPolicy{Enabled: true, RequiredAttributes: []string{"accredited.kyc.io"}}
+
+	tests := []struct {
+		name        string
+		signer      func() string
+		policy      types.EligibilityPolicy
+		expectErr   string
+		expectEvent bool
+	}{
+		{name: "admin sets the policy", signer: func() string { return s.adminAddr.String() }, policy: policy, expectEvent: true},
+		{name: "unchanged policy is a no-op", signer: func() string { return s.adminAddr.String() }},
+		{name: "asset manager cannot set the policy", signer: func() string { return s.assetManagerAddr.String() }, policy: policy, expectErr: "failed to validate admin"},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+			vault := s.setupBaseVault(underlying, share)
+			s.ctx = s.ctx.WithEventManager(sdk.NewEventManager())
+
+			_, err := keeper.NewMsgServer(s.simApp.VaultKeeper).UpdateEligibilityPolicy(s.ctx, &types.MsgUpdateEligibilityPolicyRequest{
+				Authority:    tc.signer(),
+				VaultAddress: vault.Address,
+				Policy:       tc.policy,
+			})
+			if tc.expectErr != "" {
+				s.Require().ErrorContains(err, tc.expectErr, "UpdateEligibilityPolicy error")
+				return
+			}
+			s.Require().NoError(err, "UpdateEligibilityPolicy should succeed")
+
+			updated, err := s.k.GetVault(s.ctx, vault.GetAddress())
+			s.Require().NoError(err, "should reload vault")
+			s.Assert().Equal(tc.policy, updated.EligibilityPolicy, "stored eligibility policy")
+			if tc.expectEvent {
+				s.requireTypedEventEmitted(types.NewEventEligibilityPolicyUpdated(vault.Address, tc.signer(), tc.policy))
+			} else {
+				s.Assert().Empty(s.ctx.EventManager().Events(), "no-op update should not emit events")
+			}
+		})
+	}
+}
+
+func (s *TestSuite) TestMsgServer_UpdateAllowlist() {
+	underlying, share := "under", "vshare"
+	s.SetupTest()
+	vault := s.setupBaseVault(underlying, share)
+	investor := s.CreateAndFundAccount(sdk.NewInt64Coin("stake", 1))
+	msgServer := keeper.NewMsgServer(s.simApp.VaultKeeper)
+
+	_, err := msgServer.UpdateAllowlist(s.ctx, &types.MsgUpdateAllowlistRequest{
+		Authority:    s.assetManagerAddr.String(),
+		VaultAddress: vault.Address,
+		Add:          []string{investor.String()},
+	})
+	s.Require().ErrorContains(err, "failed to validate admin", "only the admin should update the allowlist")
+
+	s.ctx = s.ctx.WithEventManager(sdk.NewEventManager())
+	_, err = msgServer.UpdateAllowlist(s.ctx, &types.MsgUpdateAllowlistRequest{
+		Authority:    s.adminAddr.String(),
+		VaultAddress: vault.Address,
+		Add:          []string{investor.String()},
+	})
+	s.Require().NoError(err, "admin should add to the allowlist")
+	s.requireTypedEventEmitted(types.NewEventAllowlistUpdated(vault.Address, s.adminAddr.String(), []string{investor.String()}, nil))
+
+	resp, err := keeper.NewQueryServer(s.simApp.VaultKeeper).InvestorEligibility(s.ctx, &types.QueryInvestorEligibilityRequest{Id: vault.Address, Address: investor.String()})
+	s.Require().NoError(err, "InvestorEligibility should succeed")
+	s.Assert().True(resp.Allowlisted, "investor should be allowlisted")
+
+	_, err = msgServer.UpdateAllowlist(s.ctx, &types.MsgUpdateAllowlistRequest{
+		Authority:    s.adminAddr.String(),
+		VaultAddress: vault.Address,
+		Remove:       []string{investor.String()},
+	})
+	s.Require().NoError(err, "admin should remove from the allowlist")
+
+	resp, err = keeper.NewQueryServer(s.simApp.VaultKeeper).InvestorEligibility(s.ctx, &types.QueryInvestorEligibilityRequest{Id: vault.Address, Address: investor.String()})
+	s.Require().NoError(err, "InvestorEligibility should succeed")
+	s.Assert().False(resp.Allowlisted, "investor should no longer be allowlisted")
+}
+
+func (s *TestSuite) TestQueryServer_InvestorEligibility() {
+	underlying, share := "under", "vshare"
+	s.SetupTest()
+	vault := s.setupBaseVault(underlying, share)
+	investor := s.CreateAndFundAccount(sdk.NewInt64Coin("stake", 1))
+	s.setEligibilityAttribute(investor, "accredited.kyc.io", time.Hour)
+	queryServer := keeper.NewQueryServer(s.simApp.VaultKeeper)
+
+	resp, err := queryServer.InvestorEligibility(s.ctx, &types.QueryInvestorEligibilityRequest{Id: share, Address: investor.String()})
+	s.Require().NoError(err, "InvestorEligibility should succeed")
+	s.Assert().True(resp.Eligible, "every address should be eligible while the policy is disabled")
+
+	policy := types.EligibilityPolicy{Enabled: true, RequiredAttributes: []string{"accredited.kyc.io", "*.aml.io"}}
+	s.Require().NoError(s.k.SetEligibilityPolicy(s.ctx, vault, policy, s.adminAddr.String()), "should set eligibility policy")
+	resp, err = queryServer.InvestorEligibility(s.ctx, &types.QueryInvestorEligibilityRequest{Id: vault.Address, Address: investor.String()})
+	s.Require().NoError(err, "InvestorEligibility should succeed")
+	s.Assert().False(resp.Eligible, "investor missing an attribute should not be eligible")
+	s.Assert().False(resp.Allowlisted, "investor should not be allowlisted")
+	s.Assert().Equal([]string{"*.aml.io"}, resp.MissingAttributes, "missing attributes")
+
+	_, err = queryServer.InvestorEligibility(s.ctx, &types.QueryInvestorEligibilityRequest{Id: vault.Address, Address: "bad"})
+	s.Require().ErrorContains(err, "invalid address", "invalid address should be rejected")
+	_, err = queryServer.InvestorEligibility(s.ctx, &types.QueryInvestorEligibilityRequest{Id: "missing", Address: investor.String()})
+	s.Require().ErrorContains(err, "not found", "unknown vault should be rejected")
+	_, err = queryServer.InvestorEligibility(s.ctx, nil)
+	s.Require().ErrorContains(err, "invalid request", "nil request should be rejected")
+}
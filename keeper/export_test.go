@@ -49,6 +49,13 @@ func (k Keeper) TestAccessor_handleVaultFeeTimeouts(t *testing.T, ctx context.Co
 	return k.handleVaultFeeTimeouts(sdkCtx, limit)
 }
 
+// TestAccessor_handleScheduledActions exposes this keeper's handleScheduledActions function for unit tests.
+func (k Keeper) TestAccessor_handleScheduledActions(t *testing.T, ctx context.Context, limit int) error {
+	t.Helper()
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	return k.handleScheduledActions(sdkCtx, limit)
+}
+
 // TestAccessor_processSwapOutJobs exposes this keeper's processSwapOutJobs function for unit tests.
 func (k Keeper) TestAccessor_processSwapOutJobs(t *testing.T, ctx context.Context, jobsToProcess []types.PayoutJob) {
 	t.Helper()
@@ -127,19 +134,19 @@ func (k Keeper) TestAccessor_getRefundReason(err error) string {
 }
 
 // TestAccessor_checkSettlementNAVGuardrail exposes this keeper's checkSettlementNAVGuardrail function for unit tests.
-func (k Keeper) TestAccessor_checkSettlementNAVGuardrail(t *testing.T, ctx context.Context, vault *types.VaultAccount, assetCoin, paymentCoin sdk.Coin) error {
+func (k Keeper) TestAccessor_checkSettlementNAVGuardrail(t *testing.T, ctx context.Context, vault *types.VaultAccount, assetCoins sdk.Coins, paymentCoin sdk.Coin) (sdkmath.LegacyDec, error) {
 	t.Helper()
-	return k.checkSettlementNAVGuardrail(sdk.UnwrapSDKContext(ctx), vault, assetCoin, paymentCoin)
+	return k.checkSettlementNAVGuardrail(sdk.UnwrapSDKContext(ctx), vault, assetCoins, paymentCoin)
 }
 
 // TestAccessor_removeDrainedSettlementNAV exposes this keeper's removeDrainedSettlementNAV function for unit tests.
-func (k Keeper) TestAccessor_removeDrainedSettlementNAV(t *testing.T, ctx context.Context, vault *types.VaultAccount, assetDenom, direction string) error {
+func (k Keeper) TestAccessor_removeDrainedSettlementNAV(t *testing.T, ctx context.Context, vault *types.VaultAccount, assetDenoms []string, direction string) error {
 	t.Helper()
-	return k.removeDrainedSettlementNAV(sdk.UnwrapSDKContext(ctx), vault, assetDenom, direction)
+	return k.removeDrainedSettlementNAV(sdk.UnwrapSDKContext(ctx), vault, assetDenoms, direction)
 }
 
 // TestAccessor_settlementLegCoins exposes the settlementLegCoins function for unit tests.
-func (k Keeper) TestAccessor_settlementLegCoins(t *testing.T, payment *exchange.Payment, direction, underlyingDenom string) (sdk.Coin, sdk.Coin, error) {
+func (k Keeper) TestAccessor_settlementLegCoins(t *testing.T, payment *exchange.Payment, direction, underlyingDenom string) (sdk.Coins, sdk.Coin, error) {
 	t.Helper()
 	return settlementLegCoins(payment, direction, underlyingDenom)
 }
@@ -171,3 +178,19 @@ func (k Keeper) TestAccessor_corruptVaultNAV(t *testing.T, ctx context.Context,
 	}
 	return nil
 }
+
+// TestAccessor_recordSharePrice exposes this keeper's recordSharePrice function for unit tests.
+func (k Keeper) TestAccessor_recordSharePrice(t *testing.T, ctx context.Context, vault *types.VaultAccount) error {
+	t.Helper()
+	return k.recordSharePrice(sdk.UnwrapSDKContext(ctx), vault)
+}
+
+// TestAccessor_corruptParams writes undecodable bytes at the params entry so unit tests can
+// exercise params lookup failures other than not-found.
+func (k Keeper) TestAccessor_corruptParams(t *testing.T, ctx context.Context) error {
+	t.Helper()
+	if err := k.storeService.OpenKVStore(ctx).Set(types.ParamsKeyPrefix.Bytes(), []byte{0xFF}); err != nil {
+		return fmt.Errorf("failed to write corrupt params bytes: %w", err)
+	}
+	return nil
+}
@@ -3,6 +3,7 @@ package keeper
 import (
 	"fmt"
 	"math"
+	"time"
 
 	"github.com/provlabs/vault/types"
 
@@ -29,6 +30,8 @@ func (k Keeper) InitGenesis(ctx sdk.Context, genState *types.GenesisState) {
 		params.TechFeeAddress = types.GetDefaultTechFeeAddress(ctx.ChainID()).String()
 	}
 	params.DefaultAumFeeBips = genState.Params.DefaultAumFeeBips
+	params.NavHistoryRetentionSeconds = genState.Params.NavHistoryRetentionSeconds
+	params.SharePriceRetentionSeconds = genState.Params.SharePriceRetentionSeconds
 
 	if err := k.Params.Set(ctx, params); err != nil {
 		panic(fmt.Errorf("failed to set params: %w", err))
@@ -139,6 +142,122 @@ func (k Keeper) InitGenesis(ctx sdk.Context, genState *types.GenesisState) {
 			panic(fmt.Errorf("failed to import vault nav for %s/%s: %w", entry.VaultAddress, entry.Nav.Denom, err))
 		}
 	}
+
+	for _, proposal := range genState.PendingNavProposals {
+		addr, err := sdk.AccAddressFromBech32(proposal.VaultAddress)
+		if err != nil {
+			panic(fmt.Errorf("invalid vault address in nav proposal: %w", err))
+		}
+		vault, ok := k.tryGetVault(ctx, addr)
+		if !ok {
+			panic(fmt.Errorf("nav proposal for unknown vault %s", proposal.VaultAddress))
+		}
+		if err := validateVaultNAVFields(vault, proposal.Nav); err != nil {
+			panic(fmt.Errorf("invalid nav proposal for vault %s: %w", proposal.VaultAddress, err))
+		}
+		if err := k.PendingNAVProposals.Set(ctx, collections.Join(addr, proposal.Nav.Denom), proposal); err != nil {
+			panic(fmt.Errorf("failed to import nav proposal for %s/%s: %w", proposal.VaultAddress, proposal.Nav.Denom, err))
+		}
+	}
+
+	for _, submission := range genState.NavSourceSubmissions {
+		addr, err := sdk.AccAddressFromBech32(submission.VaultAddress)
+		if err != nil {
+			panic(fmt.Errorf("invalid vault address in nav source submission: %w", err))
+		}
+		source, err := sdk.AccAddressFromBech32(submission.Source)
+		if err != nil {
+			panic(fmt.Errorf("invalid source address in nav source submission: %w", err))
+		}
+		vault, ok := k.tryGetVault(ctx, addr)
+		if !ok {
+			panic(fmt.Errorf("nav source submission for unknown vault %s", submission.VaultAddress))
+		}
+		if err := validateVaultNAVFields(vault, submission.Nav); err != nil {
+			panic(fmt.Errorf("invalid nav source submission for vault %s: %w", submission.VaultAddress, err))
+		}
+		if err := k.NAVSourceSubmissions.Set(ctx, collections.Join3(addr, submission.Nav.Denom, source), submission.Nav); err != nil {
+			panic(fmt.Errorf("failed to import nav source submission for %s/%s/%s: %w", submission.VaultAddress, submission.Nav.Denom, submission.Source, err))
+		}
+	}
+
+	for _, entry := range genState.NavHistory {
+		addr, err := sdk.AccAddressFromBech32(entry.VaultAddress)
+		if err != nil {
+			panic(fmt.Errorf("invalid vault address in nav history: %w", err))
+		}
+		if err := k.NAVHistory.Set(ctx, collections.Join3(addr, entry.Nav.Denom, entry.Nav.UpdatedTime.UTC()), entry.Nav); err != nil {
+			panic(fmt.Errorf("failed to import nav history for %s/%s at %s: %w", entry.VaultAddress, entry.Nav.Denom, entry.Nav.UpdatedTime, err))
+		}
+	}
+
+	for _, entry := range genState.SettlementPrices {
+		addr, err := sdk.AccAddressFromBech32(entry.VaultAddress)
+		if err != nil {
+			panic(fmt.Errorf("invalid vault address in settlement price: %w", err))
+		}
+		if err := k.SettlementPrices.Set(ctx, collections.Join3(addr, entry.Nav.Denom, entry.Nav.Price.Denom), entry.Nav); err != nil {
+			panic(fmt.Errorf("failed to import settlement price for %s/%s in %s: %w", entry.VaultAddress, entry.Nav.Denom, entry.Nav.Price.Denom, err))
+		}
+	}
+
+	for _, entry := range genState.AssetHaircuts {
+		addr, err := sdk.AccAddressFromBech32(entry.VaultAddress)
+		if err != nil {
+			panic(fmt.Errorf("invalid vault address in asset haircuts: %w", err))
+		}
+		if err := k.AssetHaircuts.Set(ctx, collections.Join(addr, entry.Haircut.Denom), entry.Haircut.HaircutBips); err != nil {
+			panic(fmt.Errorf("failed to import asset haircut for %s/%s: %w", entry.VaultAddress, entry.Haircut.Denom, err))
+		}
+	}
+
+	for _, entry := range genState.SharePriceObservations {
+		addr, err := sdk.AccAddressFromBech32(entry.VaultAddress)
+		if err != nil {
+			panic(fmt.Errorf("invalid vault address in share price observations: %w", err))
+		}
+		if err := k.SharePriceObservations.Set(ctx, collections.Join(addr, entry.Observation.Time.UTC()), entry.Observation); err != nil {
+			panic(fmt.Errorf("failed to import share price observation for %s at %s: %w", entry.VaultAddress, entry.Observation.Time, err))
+		}
+	}
+
+	for _, entry := range genState.OutboundPayments {
+		addr, err := sdk.AccAddressFromBech32(entry.VaultAddress)
+		if err != nil {
+			panic(fmt.Errorf("invalid vault address in outbound payments: %w", err))
+		}
+		if err := k.OutboundPayments.Set(ctx, collections.Join(addr, entry.Payment.ExternalId), entry.Payment); err != nil {
+			panic(fmt.Errorf("failed to import outbound payment %s/%q: %w", entry.VaultAddress, entry.Payment.ExternalId, err))
+		}
+	}
+
+	if err := k.ScheduledActionQueue.Import(ctx, &genState.ScheduledActionQueue); err != nil {
+		panic(fmt.Errorf("failed to import scheduled action queue: %w", err))
+	}
+
+	for _, entry := range genState.Allowlist {
+		vaultAddr, err := sdk.AccAddressFromBech32(entry.VaultAddress)
+		if err != nil {
+			panic(fmt.Errorf("invalid vault address in allowlist: %w", err))
+		}
+		addr, err := sdk.AccAddressFromBech32(entry.Address)
+		if err != nil {
+			panic(fmt.Errorf("invalid address in allowlist: %w", err))
+		}
+		if err := k.InvestorAllowlist.Set(ctx, collections.Join(vaultAddr, addr)); err != nil {
+			panic(fmt.Errorf("failed to import allowlist entry %s/%s: %w", entry.VaultAddress, entry.Address, err))
+		}
+	}
+
+	for _, entry := range genState.NavReferences {
+		addr, err := sdk.AccAddressFromBech32(entry.VaultAddress)
+		if err != nil {
+			panic(fmt.Errorf("invalid vault address in nav reference: %w", err))
+		}
+		if err := k.NAVReferences.Set(ctx, collections.Join(addr, entry.Nav.Denom), entry.Nav); err != nil {
+			panic(fmt.Errorf("failed to import nav reference for %s/%s: %w", entry.VaultAddress, entry.Nav.Denom, err))
+		}
+	}
 }
 
 // ExportGenesis exports the current state of the vault module.
@@ -203,12 +322,133 @@ func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
 		panic(fmt.Errorf("failed to walk vault navs: %w", err))
 	}
 
+	proposals := make([]types.PendingNAVProposal, 0)
+	err = k.PendingNAVProposals.Walk(ctx, nil, func(_ collections.Pair[sdk.AccAddress, string], value types.PendingNAVProposal) (stop bool, err error) {
+		proposals = append(proposals, value)
+		return false, nil
+	})
+	if err != nil {
+		panic(fmt.Errorf("failed to walk nav proposals: %w", err))
+	}
+
+	submissions := make([]types.NAVSourceSubmission, 0)
+	err = k.NAVSourceSubmissions.Walk(ctx, nil, func(key collections.Triple[sdk.AccAddress, string, sdk.AccAddress], value types.VaultNAV) (stop bool, err error) {
+		submissions = append(submissions, types.NAVSourceSubmission{
+			VaultAddress: key.K1().String(),
+			Source:       key.K3().String(),
+			Nav:          value,
+		})
+		return false, nil
+	})
+	if err != nil {
+		panic(fmt.Errorf("failed to walk nav source submissions: %w", err))
+	}
+
+	history := make([]types.VaultNAVEntry, 0)
+	err = k.NAVHistory.Walk(ctx, nil, func(key collections.Triple[sdk.AccAddress, string, time.Time], value types.VaultNAV) (stop bool, err error) {
+		history = append(history, types.VaultNAVEntry{
+			VaultAddress: key.K1().String(),
+			Nav:          value,
+		})
+		return false, nil
+	})
+	if err != nil {
+		panic(fmt.Errorf("failed to walk nav history: %w", err))
+	}
+
+	settlementPrices := make([]types.VaultNAVEntry, 0)
+	err = k.SettlementPrices.Walk(ctx, nil, func(key collections.Triple[sdk.AccAddress, string, string], value types.VaultNAV) (stop bool, err error) {
+		settlementPrices = append(settlementPrices, types.VaultNAVEntry{
+			VaultAddress: key.K1().String(),
+			Nav:          value,
+		})
+		return false, nil
+	})
+	if err != nil {
+		panic(fmt.Errorf("failed to walk settlement prices: %w", err))
+	}
+
+	haircuts := make([]types.AssetHaircutEntry, 0)
+	err = k.AssetHaircuts.Walk(ctx, nil, func(key collections.Pair[sdk.AccAddress, string], haircutBips uint32) (stop bool, err error) {
+		haircuts = append(haircuts, types.AssetHaircutEntry{
+			VaultAddress: key.K1().String(),
+			Haircut:      types.AssetHaircut{Denom: key.K2(), HaircutBips: haircutBips},
+		})
+		return false, nil
+	})
+	if err != nil {
+		panic(fmt.Errorf("failed to walk asset haircuts: %w", err))
+	}
+
+	observations := make([]types.SharePriceObservationEntry, 0)
+	err = k.SharePriceObservations.Walk(ctx, nil, func(key collections.Pair[sdk.AccAddress, time.Time], value types.SharePriceObservation) (stop bool, err error) {
+		observations = append(observations, types.SharePriceObservationEntry{
+			VaultAddress: key.K1().String(),
+			Observation:  value,
+		})
+		return false, nil
+	})
+	if err != nil {
+		panic(fmt.Errorf("failed to walk share price observations: %w", err))
+	}
+
+	outboundPayments := make([]types.OutboundPaymentEntry, 0)
+	err = k.OutboundPayments.Walk(ctx, nil, func(key collections.Pair[sdk.AccAddress, string], value types.OutboundPayment) (stop bool, err error) {
+		outboundPayments = append(outboundPayments, types.OutboundPaymentEntry{
+			VaultAddress: key.K1().String(),
+			Payment:      value,
+		})
+		return false, nil
+	})
+	if err != nil {
+		panic(fmt.Errorf("failed to walk outbound payments: %w", err))
+	}
+
+	scheduledActionQueue, err := k.ScheduledActionQueue.Export(ctx)
+	if err != nil {
+		panic(fmt.Errorf("failed to export scheduled action queue: %w", err))
+	}
+
+	allowlist := make([]types.AllowlistEntry, 0)
+	err = k.InvestorAllowlist.Walk(ctx, nil, func(key collections.Pair[sdk.AccAddress, sdk.AccAddress]) (stop bool, err error) {
+		allowlist = append(allowlist, types.AllowlistEntry{
+			VaultAddress: key.K1().String(),
+			Address:      key.K2().String(),
+		})
+		return false, nil
+	})
+	if err != nil {
+		panic(fmt.Errorf("failed to walk investor allowlist: %w", err))
+	}
+
+	references := make([]types.VaultNAVEntry, 0)
+	err = k.NAVReferences.Walk(ctx, nil, func(key collections.Pair[sdk.AccAddress, string], value types.VaultNAV) (stop bool, err error) {
+		references = append(references, types.VaultNAVEntry{
+			VaultAddress: key.K1().String(),
+			Nav:          value,
+		})
+		return false, nil
+	})
+	if err != nil {
+		panic(fmt.Errorf("failed to walk nav references: %w", err))
+	}
+
 	return &types.GenesisState{
-		Vaults:              vaults,
-		PayoutTimeoutQueue:  paymentTimeoutQueue,
-		FeeTimeoutQueue:     feeTimeoutQueue,
-		PendingSwapOutQueue: *pendingSwapOutQueue,
-		Params:              params,
-		Navs:                navs,
+		Vaults:                 vaults,
+		PayoutTimeoutQueue:     paymentTimeoutQueue,
+		FeeTimeoutQueue:        feeTimeoutQueue,
+		PendingSwapOutQueue:    *pendingSwapOutQueue,
+		Params:                 params,
+		Navs:                   navs,
+		PendingNavProposals:    proposals,
+		NavSourceSubmissions:   submissions,
+		NavHistory:             history,
+		SettlementPrices:       settlementPrices,
+		AssetHaircuts:          haircuts,
+		SharePriceObservations: observations,
+		OutboundPayments:       outboundPayments,
+		ScheduledActionQueue:   *scheduledActionQueue,
+		Allowlist:              allowlist,
+		NavReferences:          references,
 	}
 }
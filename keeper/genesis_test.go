@@ -488,6 +488,276 @@ func (s *TestSuite) TestVaultGenesis_RoundTrip_NAVs() {
 	s.Assert().ElementsMatch(genesis.Navs, exported.Navs, "exported NAV table should match the imported table")
 }
 
+// TestVaultGenesis_RoundTrip_PendingNAVProposals verifies pending NAV proposals
+// survive a genesis export/import round trip.
+func (s *TestSuite) TestVaultGenesis_RoundTrip_PendingNAVProposals() {
+	shareDenom := "navshare"
+	underlying := "navunder"
+	vaultAddr := types.GetVaultAddress(shareDenom)
+
+	proposedTime := time.Unix(1700000000, 0).UTC()
+	genesis := buildSingleVaultGenesisState(shareDenom, underlying, s.adminAddr.String(), nil)
+	genesis.PendingNavProposals = []types.PendingNAVProposal{
+		{
+			VaultAddress: vaultAddr.String(),
+			Nav: types.VaultNAV{
+				Denom:              "rwaone",
+				Price:              sdk.NewInt64Coin(underlying, 40),
+				Volume:             sdkmath.NewInt(100),
+				Source:             "oracle-one",
+				UpdatedBlockHeight: 7,
+				UpdatedTime:        proposedTime,
+			},
+			Proposer:   s.adminAddr.String(),
+			ExpiryTime: proposedTime.Add(types.NAVProposalLifetime * time.Second),
+		},
+	}
+	s.k.InitGenesis(s.ctx, genesis)
+
+	stored, err := s.k.PendingNAVProposals.Get(s.ctx, collections.Join(vaultAddr, "rwaone"))
+	s.Require().NoError(err, "NAV proposal should exist after InitGenesis")
+	s.Assert().Equal(genesis.PendingNavProposals[0], stored, "imported NAV proposal mismatch")
+
+	exported := s.k.ExportGenesis(s.ctx)
+	s.Assert().Equal(genesis.PendingNavProposals, exported.PendingNavProposals, "exported NAV proposals should match the imported proposals")
+}
+
+// TestVaultGenesis_RoundTrip_NAVSourceSubmissions verifies per-source NAV submissions
+// survive a genesis export/import round trip.
+func (s *TestSuite) TestVaultGenesis_RoundTrip_NAVSourceSubmissions() {
+	shareDenom := "navshare"
+	underlying := "navunder"
+	vaultAddr := types.GetVaultAddress(shareDenom)
+	source := sdk.AccAddress("nav_source_1________")
+
+	genesis := buildSingleVaultGenesisState(shareDenom, underlying, s.adminAddr.String(), nil)
+	genesis.NavSourceSubmissions = []types.NAVSourceSubmission{
+		{
+			VaultAddress: vaultAddr.String(),
+			Source:       source.String(),
+			Nav: types.VaultNAV{
+				Denom:              "rwaone",
+				Price:              sdk.NewInt64Coin(underlying, 40),
+				Volume:             sdkmath.NewInt(100),
+				Source:             source.String(),
+				UpdatedBlockHeight: 7,
+				UpdatedTime:        time.Unix(1700000000, 0).UTC(),
+			},
+		},
+	}
+	s.k.InitGenesis(s.ctx, genesis)
+
+	stored, err := s.k.NAVSourceSubmissions.Get(s.ctx, collections.Join3(vaultAddr, "rwaone", source))
+	s.Require().NoError(err, "NAV source submission should exist after InitGenesis")
+	s.Assert().Equal(genesis.NavSourceSubmissions[0].Nav, stored, "imported NAV source submission mismatch")
+
+	exported := s.k.ExportGenesis(s.ctx)
+	s.Assert().Equal(genesis.NavSourceSubmissions, exported.NavSourceSubmissions, "exported NAV source submissions should match the imported submissions")
+}
+
+func (s *TestSuite) TestVaultGenesis_RoundTrip_NAVHistory() {
+	shareDenom := "navshare"
+	underlying := "navunder"
+	vaultAddr := types.GetVaultAddress(shareDenom)
+
+	genesis := buildSingleVaultGenesisState(shareDenom, underlying, s.adminAddr.String(), nil)
+	for i, price := range []int64{40, 42} {
+		genesis.NavHistory = append(genesis.NavHistory, types.VaultNAVEntry{
+			VaultAddress: vaultAddr.String(),
+			Nav: types.VaultNAV{
+				Denom:              "rwaone",
+				Price:              sdk.NewInt64Coin(underlying, price),
+				Volume:             sdkmath.NewInt(100),
+				Source:             "oracle",
+				UpdatedBlockHeight: int64(7 + i),
+				UpdatedTime:        time.Unix(int64(1700000000+60*i), 0).UTC(),
+			},
+		})
+	}
+	s.k.InitGenesis(s.ctx, genesis)
+
+	stored, err := s.k.NAVHistory.Get(s.ctx, collections.Join3(vaultAddr, "rwaone", time.Unix(1700000060, 0).UTC()))
+	s.Require().NoError(err, "NAV history point should exist after InitGenesis")
+	s.Assert().Equal(genesis.NavHistory[1].Nav, stored, "imported NAV history point mismatch")
+
+	exported := s.k.ExportGenesis(s.ctx)
+	s.Assert().Equal(genesis.NavHistory, exported.NavHistory, "exported NAV history should match the imported history")
+}
+
+func (s *TestSuite) TestVaultGenesis_RoundTrip_NAVReferences() {
+	shareDenom := "navshare"
+	underlying := "navunder"
+	vaultAddr := types.GetVaultAddress(shareDenom)
+
+	genesis := buildSingleVaultGenesisState(shareDenom, underlying, s.adminAddr.String(), nil)
+	genesis.NavReferences = []types.VaultNAVEntry{
+		{
+			VaultAddress: vaultAddr.String(),
+			Nav: types.VaultNAV{
+				Denom:              "rwaone",
+				Price:              sdk.NewInt64Coin(underlying, 45),
+				Volume:             sdkmath.NewInt(10),
+				Source:             "oracle",
+				UpdatedBlockHeight: 9,
+				UpdatedTime:        time.Unix(1700000000, 0).UTC(),
+			},
+		},
+	}
+	s.k.InitGenesis(s.ctx, genesis)
+
+	stored, err := s.k.NAVReferences.Get(s.ctx, collections.Join(vaultAddr, "rwaone"))
+	s.Require().NoError(err, "NAV reference should exist after InitGenesis")
+	s.Assert().Equal(genesis.NavReferences[0].Nav, stored, "imported NAV reference mismatch")
+
+	exported := s.k.ExportGenesis(s.ctx)
+	s.Assert().Equal(genesis.NavReferences, exported.NavReferences, "exported NAV references should match the imported references")
+}
+
+func (s *TestSuite) TestVaultGenesis_RoundTrip_SettlementPrices() {
+	shareDenom := "navshare"
+	underlying := "navunder"
+	vaultAddr := types.GetVaultAddress(shareDenom)
+
+	genesis := buildSingleVaultGenesisState(shareDenom, underlying, s.adminAddr.String(), nil)
+	genesis.SettlementPrices = []types.VaultNAVEntry{
+		{
+			VaultAddress: vaultAddr.String(),
+			Nav: types.VaultNAV{
+				Denom:              "rwaone",
+				Price:              sdk.NewInt64Coin(underlying, 45),
+				Volume:             sdkmath.NewInt(10),
+				Source:             vaultAddr.String(),
+				UpdatedBlockHeight: 9,
+				UpdatedTime:        time.Unix(1700000000, 0).UTC(),
+			},
+		},
+	}
+	s.k.InitGenesis(s.ctx, genesis)
+
+	stored, err := s.k.SettlementPrices.Get(s.ctx, collections.Join3(vaultAddr, "rwaone", underlying))
+	s.Require().NoError(err, "settlement price should exist after InitGenesis")
+	s.Assert().Equal(genesis.SettlementPrices[0].Nav, stored, "imported settlement price mismatch")
+
+	exported := s.k.ExportGenesis(s.ctx)
+	s.Assert().Equal(genesis.SettlementPrices, exported.SettlementPrices, "exported settlement prices should match the imported prices")
+}
+
+func (s *TestSuite) TestVaultGenesis_RoundTrip_AssetHaircuts() {
+	shareDenom := "navshare"
+	underlying := "navunder"
+	vaultAddr := types.GetVaultAddress(shareDenom)
+
+	genesis := buildSingleVaultGenesisState(shareDenom, underlying, s.adminAddr.String(), nil)
+	genesis.AssetHaircuts = []types.AssetHaircutEntry{
+		{VaultAddress: vaultAddr.String(), Haircut: types.AssetHaircut{Denom: "rwaone", HaircutBips: 250}},
+		{VaultAddress: vaultAddr.String(), Haircut: types.AssetHaircut{Denom: "rwatwo", HaircutBips: 10_000}},
+	}
+	s.k.InitGenesis(s.ctx, genesis)
+
+	haircutBips, err := s.k.GetAssetHaircut(s.ctx, vaultAddr, "rwaone")
+	s.Require().NoError(err, "GetAssetHaircut should not error after InitGenesis")
+	s.Assert().Equal(uint32(250), haircutBips, "imported asset haircut mismatch")
+
+	exported := s.k.ExportGenesis(s.ctx)
+	s.Assert().Equal(genesis.AssetHaircuts, exported.AssetHaircuts, "exported asset haircuts should match the imported haircuts")
+}
+
+func (s *TestSuite) TestVaultGenesis_RoundTrip_SharePriceObservations() {
+	shareDenom := "navshare"
+	underlying := "navunder"
+	vaultAddr := types.GetVaultAddress(shareDenom)
+	first := time.Unix(1_700_000_000, 0).UTC()
+
+	genesis := buildSingleVaultGenesisState(shareDenom, underlying, s.adminAddr.String(), nil)
+	genesis.SharePriceObservations = []types.SharePriceObservationEntry{
+		{VaultAddress: vaultAddr.String(), Observation: types.SharePriceObservation{Time: first, BlockHeight: 10, Price: sdkmath.LegacyOneDec(), Cumulative: sdkmath.LegacyZeroDec()}},
+		{VaultAddress: vaultAddr.String(), Observation: types.SharePriceObservation{Time: first.Add(time.Hour), BlockHeight: 20, Price: sdkmath.LegacyMustNewDecFromStr("1.01"), Cumulative: sdkmath.LegacyNewDec(3_600)}},
+	}
+	s.k.InitGenesis(s.ctx, genesis)
+
+	observation, err := s.k.SharePriceObservations.Get(s.ctx, collections.Join(vaultAddr, first.Add(time.Hour)))
+	s.Require().NoError(err, "share price observation should be imported")
+	s.Assert().Equal(sdkmath.LegacyNewDec(3_600), observation.Cumulative, "imported share price cumulative mismatch")
+
+	exported := s.k.ExportGenesis(s.ctx)
+	s.Assert().Equal(genesis.SharePriceObservations, exported.SharePriceObservations, "exported share price observations should match the imported observations")
+}
+
+func (s *TestSuite) TestVaultGenesis_RoundTrip_OutboundPayments() {
+	shareDenom := "navshare"
+	underlying := "navunder"
+	vaultAddr := types.GetVaultAddress(shareDenom)
+
+	genesis := buildSingleVaultGenesisState(shareDenom, underlying, s.adminAddr.String(), nil)
+	genesis.OutboundPayments = []types.OutboundPaymentEntry{
+		{VaultAddress: vaultAddr.String(), Payment: types.OutboundPayment{
+			ExternalId:   "sale-1",
+			Target:       s.adminAddr.String(),
+			SourceAmount: sdk.NewCoins(sdk.NewInt64Coin("rwaone", 1)),
+			TargetAmount: sdk.NewCoins(sdk.NewInt64Coin(underlying, 100)),
+			Direction:    types.AssetDirectionOutbound,
+			ExpiryTime:   time.Unix(1_700_000_000, 0).UTC(),
+		}},
+	}
+	s.k.InitGenesis(s.ctx, genesis)
+
+	payment, err := s.k.GetOutboundPayment(s.ctx, vaultAddr, "sale-1")
+	s.Require().NoError(err, "outbound payment should be imported")
+	s.Assert().Equal(types.AssetDirectionOutbound, payment.Direction, "imported outbound payment direction mismatch")
+
+	exported := s.k.ExportGenesis(s.ctx)
+	s.Assert().Equal(genesis.OutboundPayments, exported.OutboundPayments, "exported outbound payments should match the imported payments")
+}
+
+func (s *TestSuite) TestVaultGenesis_RoundTrip_ScheduledActions() {
+	shareDenom := "navshare"
+	underlying := "navunder"
+	vaultAddr := types.GetVaultAddress(shareDenom)
+
+	action, err := types.NewScheduledAction(vaultAddr.String(), s.adminAddr.String(), 1_700_000_000, &types.MsgUpdateWithdrawalDelayRequest{
+		Authority:              s.adminAddr.String(),
+		VaultAddress:           vaultAddr.String(),
+		WithdrawalDelaySeconds: 3600,
+	})
+	s.Require().NoError(err, "NewScheduledAction")
+	action.Id = 4
+
+	genesis := buildSingleVaultGenesisState(shareDenom, underlying, s.adminAddr.String(), nil)
+	genesis.ScheduledActionQueue = types.ScheduledActionQueue{
+		LatestSequenceNumber: 5,
+		Actions:              []types.ScheduledAction{action},
+	}
+	s.k.InitGenesis(s.ctx, genesis)
+
+	imported, err := s.k.ScheduledActionQueue.GetByID(s.ctx, 4)
+	s.Require().NoError(err, "scheduled action should be imported")
+	s.Assert().Equal(action.MsgTypeURL(), imported.MsgTypeURL(), "imported scheduled action message type mismatch")
+
+	exported := s.k.ExportGenesis(s.ctx)
+	s.Assert().Equal(genesis.ScheduledActionQueue.LatestSequenceNumber, exported.ScheduledActionQueue.LatestSequenceNumber, "exported latest sequence number mismatch")
+	s.Require().Len(exported.ScheduledActionQueue.Actions, 1, "exported scheduled actions")
+	s.Assert().Equal(action.Id, exported.ScheduledActionQueue.Actions[0].Id, "exported scheduled action id mismatch")
+	s.Assert().Equal(action.Msg.Value, exported.ScheduledActionQueue.Actions[0].Msg.Value, "exported scheduled message mismatch")
+}
+
+func (s *TestSuite) TestVaultGenesis_RoundTrip_Allowlist() {
+	shareDenom := "navshare"
+	underlying := "navunder"
+	vaultAddr := types.GetVaultAddress(shareDenom)
+	entry := types.AllowlistEntry{VaultAddress: vaultAddr.String(), Address: s.adminAddr.String()}
+
+	genesis := buildSingleVaultGenesisState(shareDenom, underlying, s.adminAddr.String(), nil)
+	genesis.Allowlist = []types.AllowlistEntry{entry}
+	s.k.InitGenesis(s.ctx, genesis)
+
+	allowlisted, err := s.k.InvestorAllowlist.Has(s.ctx, collections.Join(vaultAddr, s.adminAddr))
+	s.Require().NoError(err, "allowlist lookup should succeed")
+	s.Assert().True(allowlisted, "allowlist entry should be imported")
+
+	exported := s.k.ExportGenesis(s.ctx)
+	s.Assert().Equal([]types.AllowlistEntry{entry}, exported.Allowlist, "exported allowlist mismatch")
+}
+
 // TestVaultGenesis_InitPanicsOnInvalidNAV verifies genesis validation rejects
 // NAV entries that price a self-priced denom or carry a non-positive price or volume.
 func (s *TestSuite) TestVaultGenesis_InitPanicsOnInvalidNAV() {
@@ -0,0 +1,65 @@
+package keeper
+
+import (
+	"fmt"
+
+	"github.com/provlabs/vault/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// GetVaultHoldings returns the vault's live total value and a breakdown of each denom it
+// holds across its principal marker and payment escrow account: its balance, the price it
+// was valued at and the source that supplied it, the haircut applied, its value in the
+// underlying asset and its share of the total. Held balances that no price source values,
+// and that total vault value therefore leaves out, are returned separately. The share denom
+// is never valued and appears in neither.
+//
+// The holdings are valued as in GetTVV, so the total matches the vault's TVV except while
+// the vault is paused, when TVV is the pause snapshot rather than its live holdings.
+func (k Keeper) GetVaultHoldings(ctx sdk.Context, vault types.VaultAccount) (sdk.Coin, []types.VaultHolding, sdk.Coins, error) {
+	balances := k.heldBalances(ctx, vault)
+	c, err := k.compositionOf(ctx, vault, balances)
+	if err != nil {
+		return sdk.Coin{}, nil, nil, fmt.Errorf("failed to value vault holdings: %w", err)
+	}
+
+	holdings := make([]types.VaultHolding, 0, len(c.denoms))
+	unvalued := sdk.NewCoins()
+	for _, balance := range balances {
+		if balance.Denom == vault.TotalShares.Denom || balance.IsZero() {
+			continue
+		}
+		value, valued := c.values[balance.Denom]
+		if !valued {
+			unvalued = unvalued.Add(balance)
+			continue
+		}
+		holding := types.VaultHolding{
+			Balance:    balance,
+			Value:      sdk.NewCoin(vault.UnderlyingAsset, value),
+			WeightBips: c.weightBips(value),
+		}
+		if balance.Denom != vault.UnderlyingAsset {
+			nav, sourceType, found, err := k.resolveNAV(ctx, vault, balance.Denom)
+			if err != nil {
+				return sdk.Coin{}, nil, nil, err
+			}
+			if !found {
+				return sdk.Coin{}, nil, nil, fmt.Errorf("no price source prices valued denom %q on vault %s", balance.Denom, vault.GetAddress())
+			}
+			holding.Nav = &nav
+			holding.PriceSource = sourceType
+			holding.AgeBlocks = ctx.BlockHeight() - nav.UpdatedBlockHeight
+			if !nav.UpdatedTime.IsZero() {
+				holding.AgeSeconds = int64(ctx.BlockTime().Sub(nav.UpdatedTime).Seconds())
+			}
+			holding.HaircutBips, err = k.GetAssetHaircut(ctx, vault.GetAddress(), balance.Denom)
+			if err != nil {
+				return sdk.Coin{}, nil, nil, err
+			}
+		}
+		holdings = append(holdings, holding)
+	}
+	return sdk.NewCoin(vault.UnderlyingAsset, c.total), holdings, unvalued, nil
+}
@@ -0,0 +1,64 @@
+package keeper_test
+
+import (
+	"time"
+
+	"github.com/provlabs/vault/keeper"
+	"github.com/provlabs/vault/types"
+
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func (s *TestSuite) TestQueryServer_VaultHoldings() {
+	underlying, share, asset, unpriced := "under", "vshare", "rwacoin", "junkcoin"
+	vault, principalAddr := s.setupAssetSettlementVault(underlying, share)
+	s.requireSimpleMarker(asset)
+
+	pricedAt := s.ctx.BlockTime()
+	s.Require().NoError(s.k.SetVaultNAV(s.ctx, vault, types.VaultNAV{Denom: asset, Price: sdk.NewInt64Coin(underlying, 3), Volume: sdkmath.NewInt(2), Source: "oracle"}, s.adminAddr.String()), "failed to price %s", asset)
+	s.Require().NoError(s.k.SetAssetHaircut(s.ctx, vault, asset, 1_000, s.adminAddr.String()), "failed to set haircut")
+	s.Require().NoError(FundAccount(s.ctx, s.simApp.BankKeeper, principalAddr, sdk.NewCoins(
+		sdk.NewInt64Coin(underlying, 1_000),
+		sdk.NewInt64Coin(asset, 200),
+		sdk.NewInt64Coin(unpriced, 50),
+	)), "failed to fund principal")
+
+	s.ctx = s.ctx.WithBlockHeight(s.ctx.BlockHeight() + 10).WithBlockTime(pricedAt.Add(time.Minute))
+
+	resp, err := keeper.NewQueryServer(s.simApp.VaultKeeper).VaultHoldings(s.ctx, &types.QueryVaultHoldingsRequest{Id: vault.Address})
+	s.Require().NoError(err, "VaultHoldings")
+
+	tvv, err := s.k.GetTVV(s.ctx, *vault)
+	s.Require().NoError(err, "GetTVV")
+	s.Assert().Equal(sdk.NewInt64Coin(underlying, 1_270), resp.TotalValue, "total value")
+	s.Assert().Equal(tvv, resp.TotalValue.Amount, "total value should match TVV")
+	s.Assert().Equal(sdk.NewCoins(sdk.NewInt64Coin(unpriced, 50)), resp.Unvalued, "unvalued balances")
+
+	s.Require().Len(resp.Holdings, 2, "holdings")
+	held := resp.Holdings[0]
+	s.Assert().Equal(sdk.NewInt64Coin(asset, 200), held.Balance, "asset balance")
+	s.Require().NotNil(held.Nav, "asset NAV")
+	s.Assert().Equal(sdk.NewInt64Coin(underlying, 3), held.Nav.Price, "asset NAV price")
+	s.Assert().Equal(sdkmath.NewInt(2), held.Nav.Volume, "asset NAV volume")
+	s.Assert().Equal("oracle", held.Nav.Source, "asset NAV source")
+	s.Assert().Equal(types.PriceSourceType_PRICE_SOURCE_TYPE_INTERNAL_NAV, held.PriceSource, "asset price source")
+	s.Assert().Equal(int64(10), held.AgeBlocks, "asset NAV age in blocks")
+	s.Assert().Equal(int64(60), held.AgeSeconds, "asset NAV age in seconds")
+	s.Assert().Equal(uint32(1_000), held.HaircutBips, "asset haircut")
+	s.Assert().Equal(sdk.NewInt64Coin(underlying, 270), held.Value, "asset value after haircut")
+	s.Assert().Equal(sdkmath.LegacyNewDec(270*10_000).QuoInt64(1_270), held.WeightBips, "asset weight")
+
+	s.Assert().Equal(types.VaultHolding{
+		Balance:    sdk.NewInt64Coin(underlying, 1_000),
+		Value:      sdk.NewInt64Coin(underlying, 1_000),
+		WeightBips: sdkmath.LegacyNewDec(1_000 * 10_000).QuoInt64(1_270),
+	}, resp.Holdings[1], "underlying holding")
+
+	_, err = keeper.NewQueryServer(s.simApp.VaultKeeper).VaultHoldings(s.ctx, &types.QueryVaultHoldingsRequest{})
+	s.Assert().ErrorContains(err, "id must be provided", "empty id should be rejected")
+
+	_, err = keeper.NewQueryServer(s.simApp.VaultKeeper).VaultHoldings(s.ctx, &types.QueryVaultHoldingsRequest{Id: "nosuchshare"})
+	s.Assert().ErrorContains(err, "not found", "unknown vault should be rejected")
+}
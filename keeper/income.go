@@ -0,0 +1,49 @@
+package keeper
+
+import (
+	"fmt"
+
+	"github.com/provlabs/vault/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// TransferAssetIncome moves amount of income earned by sourceAsset, a held asset such as an
+// nft/scope loan paying coupons, from the vault's principal marker into its interest
+// reserves, or from the reserves back to the principal when toPrincipal is set, and emits an
+// EventAssetIncomeCollected tagged with the source asset. The caller is responsible for
+// verifying that authority may move the income and for reconciling the vault first.
+//
+// Income paid to the principal marker raises total vault value on its own. Collecting it
+// into the reserves instead funds interest payouts, so the event trail records how much of
+// the vault's interest each held asset paid for. Income leaving the principal spends its
+// underlying asset, so the move must leave the vault's liquidity buffer intact.
+func (k *Keeper) TransferAssetIncome(ctx sdk.Context, vault *types.VaultAccount, sourceAsset string, amount sdk.Coin, toPrincipal bool, authority string) error {
+	if amount.Denom != vault.UnderlyingAsset {
+		return fmt.Errorf("income must be paid in underlying asset %q: got %q", vault.UnderlyingAsset, amount.Denom)
+	}
+	if sourceAsset == vault.UnderlyingAsset || sourceAsset == vault.TotalShares.Denom {
+		return fmt.Errorf("source asset %q must be a held asset other than the vault's underlying asset or shares", sourceAsset)
+	}
+	if !k.heldBalance(ctx, *vault, sourceAsset).IsPositive() {
+		return fmt.Errorf("vault %s does not hold source asset %q", vault.Address, sourceAsset)
+	}
+
+	direction := types.IncomeDirectionToReserves
+	if toPrincipal {
+		direction = types.IncomeDirectionToPrincipal
+		if err := k.returnToPrincipal(ctx, vault, sdk.NewCoins(amount)); err != nil {
+			return fmt.Errorf("failed to return income from reserves to principal: %w", err)
+		}
+	} else {
+		if err := k.stageFromPrincipal(ctx, vault, sdk.NewCoins(amount)); err != nil {
+			return fmt.Errorf("failed to collect income from principal into reserves: %w", err)
+		}
+		if err := k.requireLiquidityBuffer(ctx, vault); err != nil {
+			return err
+		}
+	}
+
+	k.emitEvent(ctx, types.NewEventAssetIncomeCollected(vault.Address, authority, sourceAsset, amount, direction))
+	return nil
+}
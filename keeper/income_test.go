@@ -0,0 +1,137 @@
+package keeper_test
+
+import (
+	"github.com/provlabs/vault/keeper"
+	"github.com/provlabs/vault/types"
+
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func (s *TestSuite) TestMsgServer_CollectAssetIncome() {
+	underlying, share, asset := "under", "vshare", "loancoin"
+
+	tests := []struct {
+		name              string
+		setup             func(vault *types.VaultAccount)
+		signer            func() string
+		sourceAsset       string
+		amount            sdk.Coin
+		toPrincipal       bool
+		expectedErr       string
+		expectedPrincipal int64
+		expectedReserves  int64
+	}{
+		{
+			name:              "asset manager collects income into the reserves",
+			amount:            sdk.NewInt64Coin(underlying, 100),
+			expectedPrincipal: 900,
+			expectedReserves:  300,
+		},
+		{
+			name:              "asset manager returns income to the principal",
+			amount:            sdk.NewInt64Coin(underlying, 50),
+			toPrincipal:       true,
+			expectedPrincipal: 1_050,
+			expectedReserves:  150,
+		},
+		{
+			name:        "admin cannot collect income",
+			signer:      func() string { return s.adminAddr.String() },
+			amount:      sdk.NewInt64Coin(underlying, 100),
+			expectedErr: "failed to validate settlement manager role",
+		},
+		{
+			name: "paused vault rejects collection",
+			setup: func(vault *types.VaultAccount) {
+				vault.Paused = true
+				vault.PausedBalance = sdk.NewInt64Coin(underlying, 1_000)
+				s.Require().NoError(s.k.SetVaultAccount(s.ctx, vault), "failed to pause vault")
+			},
+			amount:      sdk.NewInt64Coin(underlying, 100),
+			expectedErr: "asset income cannot be collected while paused",
+		},
+		{
+			name:        "income in another denom is rejected",
+			amount:      sdk.NewInt64Coin(asset, 1),
+			expectedErr: `income must be paid in underlying asset "under"`,
+		},
+		{
+			name:        "underlying asset cannot be the source",
+			sourceAsset: underlying,
+			amount:      sdk.NewInt64Coin(underlying, 100),
+			expectedErr: `source asset "under" must be a held asset`,
+		},
+		{
+			name:        "source asset the vault does not hold is rejected",
+			sourceAsset: "othercoin",
+			amount:      sdk.NewInt64Coin(underlying, 100),
+			expectedErr: `does not hold source asset "othercoin"`,
+		},
+		{
+			name:        "collection beyond the principal balance fails",
+			amount:      sdk.NewInt64Coin(underlying, 1_001),
+			expectedErr: "failed to collect income from principal into reserves",
+		},
+		{
+			name:        "return beyond the reserves fails",
+			amount:      sdk.NewInt64Coin(underlying, 201),
+			toPrincipal: true,
+			expectedErr: "failed to return income from reserves to principal",
+		},
+		{
+			name: "collection that dips into the liquidity buffer is rejected",
+			setup: func(vault *types.VaultAccount) {
+				s.Require().NoError(s.k.SetVaultNAV(s.ctx, vault, types.VaultNAV{Denom: asset, Price: sdk.NewInt64Coin(underlying, 50), Volume: sdkmath.NewInt(1)}, s.adminAddr.String()), "failed to price %s", asset)
+				vault.MinLiquidityBips = 6_500
+				s.Require().NoError(s.k.SetVaultAccount(s.ctx, vault), "failed to set min liquidity")
+			},
+			amount:      sdk.NewInt64Coin(underlying, 100),
+			expectedErr: types.ErrInsufficientLiquidity.Error(),
+		},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+			vault, principalAddr := s.setupAssetSettlementVault(underlying, share)
+			s.requireSimpleMarker(asset)
+			s.Require().NoError(FundAccount(s.ctx, s.simApp.BankKeeper, principalAddr, sdk.NewCoins(sdk.NewInt64Coin(underlying, 1_000), sdk.NewInt64Coin(asset, 10))), "failed to fund principal")
+			s.Require().NoError(FundAccount(s.ctx, s.simApp.BankKeeper, vault.GetAddress(), sdk.NewCoins(sdk.NewInt64Coin(underlying, 200))), "failed to fund reserves")
+			if tc.setup != nil {
+				tc.setup(vault)
+			}
+			signer := s.assetManagerAddr.String()
+			if tc.signer != nil {
+				signer = tc.signer()
+			}
+			sourceAsset := asset
+			if tc.sourceAsset != "" {
+				sourceAsset = tc.sourceAsset
+			}
+			s.ctx = s.ctx.WithEventManager(sdk.NewEventManager())
+
+			_, err := keeper.NewMsgServer(s.simApp.VaultKeeper).CollectAssetIncome(s.ctx, &types.MsgCollectAssetIncomeRequest{
+				Authority:    signer,
+				VaultAddress: vault.Address,
+				SourceAsset:  sourceAsset,
+				Amount:       tc.amount,
+				ToPrincipal:  tc.toPrincipal,
+			})
+			if tc.expectedErr != "" {
+				s.Require().ErrorContains(err, tc.expectedErr, "CollectAssetIncome error")
+				return
+			}
+			s.Require().NoError(err, "CollectAssetIncome should succeed")
+
+			s.Assert().Equal(sdk.NewInt64Coin(underlying, tc.expectedPrincipal), s.simApp.BankKeeper.GetBalance(s.ctx, principalAddr, underlying), "principal balance")
+			s.Assert().Equal(sdk.NewInt64Coin(underlying, tc.expectedReserves), s.simApp.BankKeeper.GetBalance(s.ctx, vault.GetAddress(), underlying), "reserve balance")
+			direction := types.IncomeDirectionToReserves
+			if tc.toPrincipal {
+				direction = types.IncomeDirectionToPrincipal
+			}
+			s.requireTypedEventEmitted(types.NewEventAssetIncomeCollected(vault.Address, signer, sourceAsset, tc.amount, direction))
+		})
+	}
+}
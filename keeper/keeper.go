@@ -3,6 +3,7 @@ package keeper
 import (
 	"errors"
 	"fmt"
+	"time"
 
 	"github.com/provlabs/vault/queue"
 	"github.com/provlabs/vault/types"
@@ -37,13 +38,25 @@ type Keeper struct {
 	ExchangeKeeper      types.ExchangeKeeper
 	ExchangeQueryServer types.ExchangeQueryServer
 
-	Params                collections.Item[types.Params]
-	Vaults                collections.Map[sdk.AccAddress, []byte]
-	NAVs                  collections.Map[collections.Pair[sdk.AccAddress, string], types.VaultNAV]
-	PayoutVerificationSet collections.KeySet[sdk.AccAddress]
-	PayoutTimeoutQueue    *queue.PayoutTimeoutQueue
-	FeeTimeoutQueue       *queue.FeeTimeoutQueue
-	PendingSwapOutQueue   *queue.PendingSwapOutQueue
+	Params                 collections.Item[types.Params]
+	Vaults                 collections.Map[sdk.AccAddress, []byte]
+	NAVs                   collections.Map[collections.Pair[sdk.AccAddress, string], types.VaultNAV]
+	PendingNAVProposals    collections.Map[collections.Pair[sdk.AccAddress, string], types.PendingNAVProposal]
+	NAVSourceSubmissions   collections.Map[collections.Triple[sdk.AccAddress, string, sdk.AccAddress], types.VaultNAV]
+	NAVHistory             collections.Map[collections.Triple[sdk.AccAddress, string, time.Time], types.VaultNAV]
+	SettlementPrices       collections.Map[collections.Triple[sdk.AccAddress, string, string], types.VaultNAV]
+	AssetHaircuts          collections.Map[collections.Pair[sdk.AccAddress, string], uint32]
+	SharePriceObservations collections.Map[collections.Pair[sdk.AccAddress, time.Time], types.SharePriceObservation]
+	OutboundPayments       collections.Map[collections.Pair[sdk.AccAddress, string], types.OutboundPayment]
+	InvestorAllowlist      collections.KeySet[collections.Pair[sdk.AccAddress, sdk.AccAddress]]
+	NAVReferences          collections.Map[collections.Pair[sdk.AccAddress, string], types.VaultNAV]
+	PayoutVerificationSet  collections.KeySet[sdk.AccAddress]
+	PayoutTimeoutQueue     *queue.PayoutTimeoutQueue
+	FeeTimeoutQueue        *queue.FeeTimeoutQueue
+	PendingSwapOutQueue    *queue.PendingSwapOutQueue
+	ScheduledActionQueue   *queue.ScheduledActionQueue
+
+	priceSources map[types.PriceSourceType]PriceSource
 }
 
 // NewMsgServer creates a new Keeper for the module.
@@ -70,27 +83,37 @@ func NewKeeper(
 	builder := collections.NewSchemaBuilder(storeService)
 
 	keeper := &Keeper{
-		cdc:                   cdc,
-		storeService:          storeService,
-		eventService:          eventService,
-		AddressCodec:          addressCodec,
-		authority:             authority,
-		authorityString:       authorityString,
-		Params:                collections.NewItem(builder, types.ParamsKeyPrefix, types.ParamsKeyName, codec.CollValue[types.Params](cdc)),
-		Vaults:                collections.NewMap(builder, types.VaultsKeyPrefix, types.VaultsName, sdk.AccAddressKey, collections.BytesValue),
-		NAVs:                  collections.NewMap(builder, types.NAVsKeyPrefix, types.NAVsName, collections.PairKeyCodec(sdk.AccAddressKey, collections.StringKey), codec.CollValue[types.VaultNAV](cdc)),
-		PayoutVerificationSet: collections.NewKeySet(builder, types.VaultPayoutVerificationSetPrefix, types.VaultPayoutVerificationSetName, sdk.AccAddressKey),
-		PayoutTimeoutQueue:    queue.NewPayoutTimeoutQueue(builder),
-		FeeTimeoutQueue:       queue.NewFeeTimeoutQueue(builder),
-		PendingSwapOutQueue:   queue.NewPendingSwapOutQueue(builder, cdc),
-		AuthKeeper:            authKeeper,
-		MarkerKeeper:          markerkeeper,
-		MetadataKeeper:        metadatakeeper,
-		BankKeeper:            bankkeeper,
-		NameKeeper:            namekeeper,
-		AttrKeeper:            attributekeeper,
-		ExchangeKeeper:        exchangekeeper,
-		ExchangeQueryServer:   exchangeQueryServer,
+		cdc:                    cdc,
+		storeService:           storeService,
+		eventService:           eventService,
+		AddressCodec:           addressCodec,
+		authority:              authority,
+		authorityString:        authorityString,
+		Params:                 collections.NewItem(builder, types.ParamsKeyPrefix, types.ParamsKeyName, codec.CollValue[types.Params](cdc)),
+		Vaults:                 collections.NewMap(builder, types.VaultsKeyPrefix, types.VaultsName, sdk.AccAddressKey, collections.BytesValue),
+		NAVs:                   collections.NewMap(builder, types.NAVsKeyPrefix, types.NAVsName, collections.PairKeyCodec(sdk.AccAddressKey, collections.StringKey), codec.CollValue[types.VaultNAV](cdc)),
+		PendingNAVProposals:    collections.NewMap(builder, types.PendingNAVProposalsKeyPrefix, types.PendingNAVProposalsName, collections.PairKeyCodec(sdk.AccAddressKey, collections.StringKey), codec.CollValue[types.PendingNAVProposal](cdc)),
+		NAVSourceSubmissions:   collections.NewMap(builder, types.NAVSourceSubmissionsKeyPrefix, types.NAVSourceSubmissionsName, collections.TripleKeyCodec(sdk.AccAddressKey, collections.StringKey, sdk.AccAddressKey), codec.CollValue[types.VaultNAV](cdc)),
+		NAVHistory:             collections.NewMap(builder, types.NAVHistoryKeyPrefix, types.NAVHistoryName, collections.TripleKeyCodec(sdk.AccAddressKey, collections.StringKey, sdk.TimeKey), codec.CollValue[types.VaultNAV](cdc)),
+		SettlementPrices:       collections.NewMap(builder, types.SettlementPricesKeyPrefix, types.SettlementPricesName, collections.TripleKeyCodec(sdk.AccAddressKey, collections.StringKey, collections.StringKey), codec.CollValue[types.VaultNAV](cdc)),
+		AssetHaircuts:          collections.NewMap(builder, types.AssetHaircutsKeyPrefix, types.AssetHaircutsName, collections.PairKeyCodec(sdk.AccAddressKey, collections.StringKey), collections.Uint32Value),
+		SharePriceObservations: collections.NewMap(builder, types.SharePriceObservationsKeyPrefix, types.SharePriceObservationsName, collections.PairKeyCodec(sdk.AccAddressKey, sdk.TimeKey), codec.CollValue[types.SharePriceObservation](cdc)),
+		OutboundPayments:       collections.NewMap(builder, types.OutboundPaymentsKeyPrefix, types.OutboundPaymentsName, collections.PairKeyCodec(sdk.AccAddressKey, collections.StringKey), codec.CollValue[types.OutboundPayment](cdc)),
+		InvestorAllowlist:      collections.NewKeySet(builder, types.InvestorAllowlistKeyPrefix, types.InvestorAllowlistName, collections.PairKeyCodec(sdk.AccAddressKey, sdk.AccAddressKey)),
+		NAVReferences:          collections.NewMap(builder, types.NAVReferencesKeyPrefix, types.NAVReferencesName, collections.PairKeyCodec(sdk.AccAddressKey, collections.StringKey), codec.CollValue[types.VaultNAV](cdc)),
+		PayoutVerificationSet:  collections.NewKeySet(builder, types.VaultPayoutVerificationSetPrefix, types.VaultPayoutVerificationSetName, sdk.AccAddressKey),
+		PayoutTimeoutQueue:     queue.NewPayoutTimeoutQueue(builder),
+		FeeTimeoutQueue:        queue.NewFeeTimeoutQueue(builder),
+		PendingSwapOutQueue:    queue.NewPendingSwapOutQueue(builder, cdc),
+		ScheduledActionQueue:   queue.NewScheduledActionQueue(builder, cdc),
+		AuthKeeper:             authKeeper,
+		MarkerKeeper:           markerkeeper,
+		MetadataKeeper:         metadatakeeper,
+		BankKeeper:             bankkeeper,
+		NameKeeper:             namekeeper,
+		AttrKeeper:             attributekeeper,
+		ExchangeKeeper:         exchangekeeper,
+		ExchangeQueryServer:    exchangeQueryServer,
 	}
 
 	schema, err := builder.Build()
@@ -99,9 +122,20 @@ func NewKeeper(
 	}
 
 	keeper.schema = schema
+	keeper.priceSources = map[types.PriceSourceType]PriceSource{
+		types.PriceSourceType_PRICE_SOURCE_TYPE_INTERNAL_NAV:        internalNAVPriceSource{keeper: keeper},
+		types.PriceSourceType_PRICE_SOURCE_TYPE_MARKER_NAV:          markerNAVPriceSource{keeper: keeper},
+		types.PriceSourceType_PRICE_SOURCE_TYPE_EXCHANGE_SETTLEMENT: settlementPriceSource{prices: keeper.SettlementPrices},
+	}
 	return keeper
 }
 
+// SetPriceSource replaces the implementation consulted for a price-source type, letting
+// an app supply its own pricing for that link of a vault's price-source chain.
+func (k *Keeper) SetPriceSource(sourceType types.PriceSourceType, source PriceSource) {
+	k.priceSources[sourceType] = source
+}
+
 // GetAuthority returns the module's authority.
 func (k Keeper) GetAuthority() []byte {
 	return k.authority
@@ -0,0 +1,104 @@
+package keeper
+
+import (
+	"fmt"
+
+	"github.com/provlabs/vault/types"
+
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// queuedSwapOutShares returns the total shares escrowed by the vault's queued swap-outs.
+func (k Keeper) queuedSwapOutShares(ctx sdk.Context, vault types.VaultAccount) (math.Int, error) {
+	total := math.ZeroInt()
+	err := k.PendingSwapOutQueue.WalkByVault(ctx, vault.GetAddress(), func(_ int64, _ uint64, req types.PendingSwapOut) (bool, error) {
+		var err error
+		total, err = total.SafeAdd(req.Shares.Amount)
+		if err != nil {
+			return true, fmt.Errorf("failed to add queued swap-out shares: %w", err)
+		}
+		return false, nil
+	})
+	if err != nil {
+		return math.Int{}, fmt.Errorf("failed to walk pending swap-outs for vault %s: %w", vault.Address, err)
+	}
+	return total, nil
+}
+
+// GetLiquidityBuffer reports the underlying asset held at the vault's principal marker
+// against what it must keep: the value of the vault's queued swap-outs at the current share
+// price, plus min_liquidity_bips of the vault's live total value. Total value is taken from
+// live holdings, as in VaultComposition, so a paused vault's buffer reflects principal
+// movements made during the pause.
+func (k Keeper) GetLiquidityBuffer(ctx sdk.Context, vault types.VaultAccount) (types.LiquidityBuffer, error) {
+	liquid := k.BankKeeper.GetBalance(ctx, vault.PrincipalMarkerAddress(), vault.UnderlyingAsset)
+
+	queued, err := k.queuedSwapOutShares(ctx, vault)
+	if err != nil {
+		return types.LiquidityBuffer{}, err
+	}
+	reserved := sdk.NewCoin(vault.UnderlyingAsset, math.ZeroInt())
+	if queued.IsPositive() {
+		reserved, err = k.ConvertSharesToRedeemCoin(ctx, vault, queued)
+		if err != nil {
+			return types.LiquidityBuffer{}, fmt.Errorf("failed to value queued swap-outs: %w", err)
+		}
+	}
+
+	required := math.ZeroInt()
+	if vault.MinLiquidityBips > 0 {
+		c, err := k.vaultComposition(ctx, vault)
+		if err != nil {
+			return types.LiquidityBuffer{}, fmt.Errorf("failed to value vault composition: %w", err)
+		}
+		required = c.total.MulRaw(int64(vault.MinLiquidityBips)).QuoRaw(bipsPerUnit)
+	}
+
+	shortfall := required.Add(reserved.Amount).Sub(liquid.Amount)
+	if shortfall.IsNegative() {
+		shortfall = math.ZeroInt()
+	}
+	return types.LiquidityBuffer{
+		MinLiquidityBips:    vault.MinLiquidityBips,
+		Liquid:              liquid,
+		ReservedRedemptions: reserved,
+		Required:            sdk.NewCoin(vault.UnderlyingAsset, required),
+		Shortfall:           sdk.NewCoin(vault.UnderlyingAsset, shortfall),
+	}, nil
+}
+
+// requireLiquidityBuffer fails with an error wrapping types.ErrInsufficientLiquidity when
+// the vault's principal marker holds less of its underlying asset than its queued swap-outs
+// and min_liquidity_bips buffer require. It is called after a change that spends the
+// underlying asset on something else. The queued swap-outs are always reserved; the buffer
+// applies only when min_liquidity_bips is set.
+func (k Keeper) requireLiquidityBuffer(ctx sdk.Context, vault *types.VaultAccount) error {
+	buffer, err := k.GetLiquidityBuffer(ctx, *vault)
+	if err != nil {
+		return err
+	}
+	if !buffer.Shortfall.IsPositive() {
+		return nil
+	}
+	if vault.MinLiquidityBips == 0 {
+		return fmt.Errorf("vault %s would hold %s liquid, short %s of the %s reserved for queued swap-outs: %w",
+			vault.Address, buffer.Liquid, buffer.Shortfall, buffer.ReservedRedemptions, types.ErrInsufficientLiquidity)
+	}
+	return fmt.Errorf("vault %s would hold %s liquid, short %s of the %s reserved for queued swap-outs plus the %s min liquidity buffer: %w",
+		vault.Address, buffer.Liquid, buffer.Shortfall, buffer.ReservedRedemptions, buffer.Required, types.ErrInsufficientLiquidity)
+}
+
+// SetMinLiquidity updates the minimum liquidity buffer for a vault.
+func (k *Keeper) SetMinLiquidity(ctx sdk.Context, vault *types.VaultAccount, minLiquidityBips uint32, authority string) error {
+	if vault.MinLiquidityBips == minLiquidityBips {
+		return nil
+	}
+	vault.MinLiquidityBips = minLiquidityBips
+	if err := k.SetVaultAccount(ctx, vault); err != nil {
+		return fmt.Errorf("failed to set vault account: %w", err)
+	}
+	k.emitEvent(ctx, types.NewEventMinLiquidityUpdated(vault.Address, authority, minLiquidityBips))
+	return nil
+}
@@ -0,0 +1,217 @@
+package keeper_test
+
+import (
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/provlabs/vault/keeper"
+	"github.com/provlabs/vault/types"
+)
+
+// setupLiquidityVault builds a vault like setupConcentrationVault, with minLiquidityBips set,
+// a recorded share supply at the initial deposit parity for its total value, and
+// queuedShares of that supply waiting in the swap-out queue.
+func (s *TestSuite) setupLiquidityVault(underlying, share, asset string, held sdk.Coins, minLiquidityBips uint32, queuedShares int64) *types.VaultAccount {
+	vault := s.setupConcentrationVault(underlying, share, asset, held, 0, 0)
+	total := held.AmountOf(asset).Add(held.AmountOf("bondcoin")).AddRaw(1_000)
+	vault.TotalShares = sdk.NewCoin(share, total.MulRaw(1_000_000))
+	vault.MinLiquidityBips = minLiquidityBips
+	s.Require().NoError(s.k.SetVaultAccount(s.ctx, vault), "failed to set liquidity settings")
+	if queuedShares > 0 {
+		_, err := s.k.PendingSwapOutQueue.Enqueue(s.ctx, s.ctx.BlockTime().Unix()+86_400, &types.PendingSwapOut{
+			Owner:        s.CreateAndFundAccount(sdk.NewInt64Coin("stake", 1)).String(),
+			VaultAddress: vault.Address,
+			Shares:       sdk.NewInt64Coin(share, queuedShares),
+			RedeemDenom:  underlying,
+		})
+		s.Require().NoError(err, "failed to enqueue swap-out")
+	}
+	return vault
+}
+
+func (s *TestSuite) TestKeeper_GetLiquidityBuffer() {
+	underlying, share, asset := "under", "vshare", "rwacoin"
+
+	tests := []struct {
+		name             string
+		minLiquidityBips uint32
+		queuedShares     int64
+		expected         types.LiquidityBuffer
+	}{
+		{
+			name: "no buffer and no queue requires nothing",
+			expected: types.LiquidityBuffer{
+				Liquid:              sdk.NewInt64Coin(underlying, 1_000),
+				ReservedRedemptions: sdk.NewInt64Coin(underlying, 0),
+				Required:            sdk.NewInt64Coin(underlying, 0),
+				Shortfall:           sdk.NewInt64Coin(underlying, 0),
+			},
+		},
+		{
+			name:             "queued swap-outs and the buffer within the liquid balance",
+			minLiquidityBips: 2_000,
+			queuedShares:     300_000_000,
+			expected: types.LiquidityBuffer{
+				MinLiquidityBips:    2_000,
+				Liquid:              sdk.NewInt64Coin(underlying, 1_000),
+				ReservedRedemptions: sdk.NewInt64Coin(underlying, 300),
+				Required:            sdk.NewInt64Coin(underlying, 300),
+				Shortfall:           sdk.NewInt64Coin(underlying, 0),
+			},
+		},
+		{
+			name:             "queued swap-outs and the buffer beyond the liquid balance",
+			minLiquidityBips: 5_000,
+			queuedShares:     300_000_000,
+			expected: types.LiquidityBuffer{
+				MinLiquidityBips:    5_000,
+				Liquid:              sdk.NewInt64Coin(underlying, 1_000),
+				ReservedRedemptions: sdk.NewInt64Coin(underlying, 300),
+				Required:            sdk.NewInt64Coin(underlying, 750),
+				Shortfall:           sdk.NewInt64Coin(underlying, 50),
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			origCtx := s.ctx
+			defer func() { s.ctx = origCtx }()
+			s.ctx, _ = s.ctx.CacheContext()
+
+			vault := s.setupLiquidityVault(underlying, share, asset, sdk.NewCoins(sdk.NewInt64Coin(asset, 500)), tc.minLiquidityBips, tc.queuedShares)
+			buffer, err := s.k.GetLiquidityBuffer(s.ctx, *vault)
+			s.Require().NoError(err, "GetLiquidityBuffer")
+			s.Assert().Equal(tc.expected, buffer, "liquidity buffer")
+
+			resp, err := keeper.NewQueryServer(s.simApp.VaultKeeper).Vault(s.ctx, &types.QueryVaultRequest{Id: vault.Address})
+			s.Require().NoError(err, "Vault query")
+			s.Assert().Equal(tc.expected, resp.LiquidityBuffer, "Vault query liquidity buffer")
+		})
+	}
+}
+
+func (s *TestSuite) TestMsgServer_AcceptAsset_MinLiquidity() {
+	underlying, share, asset := "under", "vshare", "rwacoin"
+
+	tests := []struct {
+		name            string
+		bips            uint32
+		queuedShares    int64
+		buy             int64
+		expectShortfall bool
+	}{
+		{name: "purchase leaving the buffer intact settles", bips: 2_000, buy: 700},
+		{name: "purchase eating into the buffer is rejected", bips: 2_000, buy: 900, expectShortfall: true},
+		{name: "purchase eating into queued swap-outs is rejected", bips: 2_000, queuedShares: 200_000_000, buy: 700, expectShortfall: true},
+		{name: "without a buffer, purchase leaving queued swap-outs covered settles", queuedShares: 200_000_000, buy: 800},
+		{name: "without a buffer, purchase eating into queued swap-outs is rejected", queuedShares: 200_000_000, buy: 900, expectShortfall: true},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			origCtx := s.ctx
+			defer func() { s.ctx = origCtx }()
+			s.ctx, _ = s.ctx.CacheContext()
+
+			vault := s.setupLiquidityVault(underlying, share, asset, nil, tc.bips, tc.queuedShares)
+			source := s.CreateAndFundAccount(sdk.NewInt64Coin("stake", 1_000))
+			s.Require().NoError(FundAccount(s.ctx, s.simApp.BankKeeper, source, sdk.NewCoins(sdk.NewInt64Coin(asset, tc.buy))), "failed to fund source")
+			s.createPayment(source, vault.GetAddress(), sdk.NewCoins(sdk.NewInt64Coin(asset, tc.buy)), sdk.NewCoins(sdk.NewInt64Coin(underlying, tc.buy)), "buy")
+
+			_, err := keeper.NewMsgServer(s.simApp.VaultKeeper).AcceptAsset(s.ctx, &types.MsgAcceptAssetRequest{
+				Authority:    s.assetManagerAddr.String(),
+				VaultAddress: vault.Address,
+				Source:       source.String(),
+				ExternalId:   "buy",
+			})
+			if tc.expectShortfall {
+				s.Require().ErrorIs(err, types.ErrInsufficientLiquidity, "AcceptAsset should fail with ErrInsufficientLiquidity")
+				return
+			}
+			s.Require().NoError(err, "AcceptAsset should settle")
+		})
+	}
+}
+
+func (s *TestSuite) TestMsgServer_WithdrawPrincipalFunds_MinLiquidity() {
+	underlying, share, asset := "under", "vshare", "rwacoin"
+
+	tests := []struct {
+		name            string
+		amount          int64
+		expectShortfall bool
+	}{
+		{name: "withdrawal leaving the buffer intact succeeds", amount: 800},
+		{name: "withdrawal eating into the buffer is rejected", amount: 900, expectShortfall: true},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			origCtx := s.ctx
+			defer func() { s.ctx = origCtx }()
+			s.ctx, _ = s.ctx.CacheContext()
+
+			vault := s.setupLiquidityVault(underlying, share, asset, sdk.NewCoins(sdk.NewInt64Coin(asset, 500)), 2_000, 0)
+			vault.Paused = true
+			vault.PausedBalance = sdk.NewInt64Coin(underlying, 1_500)
+			s.Require().NoError(s.k.SetVaultAccount(s.ctx, vault), "failed to pause vault")
+
+			_, err := keeper.NewMsgServer(s.simApp.VaultKeeper).WithdrawPrincipalFunds(s.ctx, &types.MsgWithdrawPrincipalFundsRequest{
+				Authority:    s.adminAddr.String(),
+				VaultAddress: vault.Address,
+				Amount:       sdk.NewInt64Coin(underlying, tc.amount),
+			})
+			if tc.expectShortfall {
+				s.Require().ErrorIs(err, types.ErrInsufficientLiquidity, "WithdrawPrincipalFunds should fail with ErrInsufficientLiquidity")
+				return
+			}
+			s.Require().NoError(err, "WithdrawPrincipalFunds should succeed")
+			s.Assert().Equal(sdkmath.NewInt(1_000-tc.amount), s.simApp.BankKeeper.GetBalance(s.ctx, vault.PrincipalMarkerAddress(), underlying).Amount, "principal underlying after withdrawal")
+		})
+	}
+}
+
+func (s *TestSuite) TestMsgServer_UpdateMinLiquidity() {
+	underlying, share := "under", "vshare"
+
+	tests := []struct {
+		name        string
+		signer      func() string
+		bips        uint32
+		expectErr   string
+		expectEvent bool
+	}{
+		{name: "admin sets the buffer", signer: func() string { return s.adminAddr.String() }, bips: 1_000, expectEvent: true},
+		{name: "unchanged buffer is a no-op", signer: func() string { return s.adminAddr.String() }},
+		{name: "asset manager cannot set the buffer", signer: func() string { return s.assetManagerAddr.String() }, bips: 1_000, expectErr: "failed to validate admin"},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+			vault, _ := s.setupAssetSettlementVault(underlying, share)
+			s.ctx = s.ctx.WithEventManager(sdk.NewEventManager())
+
+			_, err := keeper.NewMsgServer(s.simApp.VaultKeeper).UpdateMinLiquidity(s.ctx, &types.MsgUpdateMinLiquidityRequest{
+				Authority:        tc.signer(),
+				VaultAddress:     vault.Address,
+				MinLiquidityBips: tc.bips,
+			})
+			if tc.expectErr != "" {
+				s.Require().ErrorContains(err, tc.expectErr, "UpdateMinLiquidity error")
+				return
+			}
+			s.Require().NoError(err, "UpdateMinLiquidity should succeed")
+
+			updated, err := s.k.GetVault(s.ctx, vault.GetAddress())
+			s.Require().NoError(err, "should reload vault")
+			s.Assert().Equal(tc.bips, updated.MinLiquidityBips, "stored min liquidity bips")
+			if tc.expectEvent {
+				s.requireTypedEventEmitted(types.NewEventMinLiquidityUpdated(vault.Address, tc.signer(), tc.bips))
+			} else {
+				s.Assert().Empty(s.ctx.EventManager().Events(), "no-op update should not emit events")
+			}
+		})
+	}
+}
@@ -1,6 +1,7 @@
 package keeper
 
 import (
+	"errors"
 	"fmt"
 
 	"github.com/provlabs/vault/types"
@@ -223,3 +224,56 @@ func (k Keeper) migrateEnableMarkerDepositProtection(ctx sdk.Context) error {
 
 	return nil
 }
+
+// migrateRoleGrants seeds every vault's role table from its authority fields, so each
+// address keeps exactly the access it had before per-vault roles: the admin holds the
+// management roles, the asset manager (when set) holds the management roles and the
+// settlement manager role, and the NAV authority (the admin when unset) holds the NAV
+// authority role. bridge_address is left as a field, since it authorizes only bridge mints
+// and burns rather than vault operations. Idempotent: grants already present are kept.
+func (k Keeper) migrateRoleGrants(ctx sdk.Context) error {
+	for _, acc := range k.AuthKeeper.GetAllAccounts(ctx) {
+		vault, ok := acc.(*types.VaultAccount)
+		if !ok {
+			continue
+		}
+		if !vault.SeedRoleGrants() {
+			continue
+		}
+		k.getLogger(ctx).Info("seeded vault role grants from authority fields",
+			"vault", vault.Address,
+			"role_grants", len(vault.RoleGrants),
+		)
+		if err := k.SetVaultAccount(ctx, vault); err != nil {
+			return fmt.Errorf("failed to persist role grants for vault %s: %w", vault.Address, err)
+		}
+	}
+	return nil
+}
+
+// migrateParamDefaults seeds the module params added in ConsensusVersion 3 with their
+// defaults. Params stored before version 3 decode these fields as zero, which would
+// disable the features they configure rather than leave them at their defaults:
+// nav_history_retention_seconds becomes DefaultNAVHistoryRetention and
+// share_price_retention_seconds becomes DefaultSharePriceRetention. Chains that never
+// stored params already fall back to the defaults and are left untouched. Idempotent:
+// a non-zero value is kept.
+func (k Keeper) migrateParamDefaults(ctx sdk.Context) error {
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return nil
+		}
+		return fmt.Errorf("failed to get params: %w", err)
+	}
+	if params.NavHistoryRetentionSeconds == 0 {
+		params.NavHistoryRetentionSeconds = types.DefaultNAVHistoryRetention
+	}
+	if params.SharePriceRetentionSeconds == 0 {
+		params.SharePriceRetentionSeconds = types.DefaultSharePriceRetention
+	}
+	if err := k.Params.Set(ctx, params); err != nil {
+		return fmt.Errorf("failed to set params: %w", err)
+	}
+	return nil
+}
@@ -193,7 +193,7 @@ func (s *TestSuite) TestVaultModule_RunMigrations() {
 
 		newVM, err := s.simApp.ModuleManager.RunMigrations(s.ctx, s.simApp.Configurator(), fromVM)
 		s.Require().NoError(err, "RunMigrations must succeed; vault v1->v2 handler must be registered for vault %s", legacy.Address)
-		s.Require().Equal(uint64(2), newVM[types.ModuleName], "vault module version should advance to ConsensusVersion 2")
+		s.Require().Equal(uint64(3), newVM[types.ModuleName], "vault module version should advance to ConsensusVersion 3")
 
 		acct := s.simApp.AccountKeeper.GetAccount(s.ctx, legacy.GetAddress())
 		got, ok := acct.(*types.VaultAccount)
@@ -204,6 +204,22 @@ func (s *TestSuite) TestVaultModule_RunMigrations() {
 		s.Require().ErrorIs(err, collections.ErrNotFound, "the flatten migration must not seed internal NAV entries")
 	})
 
+	s.Run("vault pinned to v2 runs the registered v2->v3 role migration", func() {
+		s.SetupTest()
+		legacy := s.createLegacyVaultAccount("vsharemmv2", underlying, underlying)
+
+		fromVM := s.simApp.ModuleManager.GetVersionMap()
+		fromVM[types.ModuleName] = 2
+
+		newVM, err := s.simApp.ModuleManager.RunMigrations(s.ctx, s.simApp.Configurator(), fromVM)
+		s.Require().NoError(err, "RunMigrations must succeed; vault v2->v3 handler must be registered for vault %s", legacy.Address)
+		s.Require().Equal(uint64(3), newVM[types.ModuleName], "vault module version should advance to ConsensusVersion 3")
+
+		got, err := s.k.GetVault(s.ctx, legacy.GetAddress())
+		s.Require().NoError(err, "GetVault %s", legacy.Address)
+		s.True(got.HasRole(s.adminAddr.String(), types.VaultRole_VAULT_ROLE_PAUSER), "the v2->v3 migration should grant the admin its management roles")
+	})
+
 	s.Run("version map already at current ConsensusVersion is a no-op", func() {
 		s.SetupTest()
 		legacy := s.createLegacyVaultAccount("vsharemmnoop", underlying, payment)
@@ -311,3 +327,117 @@ func (s *TestSuite) TestKeeper_MigrateEnableMarkerDepositProtection() {
 		s.Equal(before, after, "a marker already carrying deposit protection from creation must pass through the migration unchanged")
 	})
 }
+
+func (s *TestSuite) TestKeeper_MigrateRoleGrants() {
+	underlying := "ylds"
+	admin := s.adminAddr.String()
+	manager := s.assetManagerAddr.String()
+	oracle := sdk.AccAddress("navOracleAddr_______").String()
+
+	runMigration := func() {
+		s.Require().NoError(keeper.NewMigrator(s.simApp.VaultKeeper).Migrate2to3(s.ctx), "2->3 migration should succeed")
+	}
+
+	loadVault := func(vault *types.VaultAccount) *types.VaultAccount {
+		got, err := s.k.GetVault(s.ctx, vault.GetAddress())
+		s.Require().NoError(err, "GetVault %s", vault.Address)
+		return got
+	}
+
+	s.Run("authority fields become role grants", func() {
+		s.SetupTest()
+		legacy := s.createLegacyVaultAccount("vsharerolesv2", underlying, underlying)
+		legacy.AssetManager = manager
+		legacy.NavAuthority = oracle
+		s.simApp.AccountKeeper.SetAccount(s.ctx, legacy)
+
+		runMigration()
+
+		got := loadVault(legacy)
+		for _, role := range types.ManagementRoles() {
+			s.True(got.HasRole(admin, role), "admin should hold %s", role)
+		}
+		for _, role := range types.AssetManagerRoles() {
+			s.True(got.HasRole(manager, role), "asset manager should hold %s", role)
+		}
+		s.False(got.HasRole(admin, types.VaultRole_VAULT_ROLE_SETTLEMENT_MANAGER), "admin must not gain settlement")
+		s.True(got.HasRole(oracle, types.VaultRole_VAULT_ROLE_NAV_AUTHORITY), "NAV authority should hold the NAV role")
+		s.False(got.HasRole(admin, types.VaultRole_VAULT_ROLE_NAV_AUTHORITY), "admin must not gain the NAV role when another address is the NAV authority")
+		s.Len(got.RoleGrants, len(types.ManagementRoles())+len(types.AssetManagerRoles())+1, "role table size")
+	})
+
+	s.Run("unset NAV authority grants the NAV role to the admin", func() {
+		s.SetupTest()
+		legacy := s.createLegacyVaultAccount("vsharerolesnav", underlying, underlying)
+		legacy.NavAuthority = ""
+		s.simApp.AccountKeeper.SetAccount(s.ctx, legacy)
+
+		runMigration()
+
+		s.True(loadVault(legacy).HasRole(admin, types.VaultRole_VAULT_ROLE_NAV_AUTHORITY), "admin should hold the NAV role")
+	})
+
+	s.Run("second run is idempotent", func() {
+		s.SetupTest()
+		legacy := s.createLegacyVaultAccount("vsharerolesidem", underlying, underlying)
+
+		runMigration()
+		first := loadVault(legacy)
+		runMigration()
+		second := loadVault(legacy)
+
+		s.Equal(first.RoleGrants, second.RoleGrants, "a second migration run must not change the role table")
+	})
+}
+
+func (s *TestSuite) TestKeeper_MigrateParamDefaults() {
+	runMigration := func() {
+		s.Require().NoError(keeper.NewMigrator(s.simApp.VaultKeeper).Migrate2to3(s.ctx), "2->3 migration should succeed")
+	}
+	getParams := func() types.Params {
+		params, err := s.k.Params.Get(s.ctx)
+		s.Require().NoError(err, "should get params")
+		return params
+	}
+
+	s.Run("params stored before version 3 gain the default retention", func() {
+		s.SetupTest()
+		legacy := types.DefaultParams()
+		legacy.NavHistoryRetentionSeconds = 0
+		legacy.SharePriceRetentionSeconds = 0
+		s.Require().NoError(s.k.Params.Set(s.ctx, legacy), "should store version 2 params")
+
+		runMigration()
+
+		got := getParams()
+		s.Equal(uint64(types.DefaultNAVHistoryRetention), got.NavHistoryRetentionSeconds, "NAV history retention should be seeded")
+		s.Equal(uint64(types.DefaultSharePriceRetention), got.SharePriceRetentionSeconds, "share price retention should be seeded")
+		s.Equal(legacy.TechFeeAddress, got.TechFeeAddress, "tech fee address should be kept")
+		s.Equal(legacy.DefaultAumFeeBips, got.DefaultAumFeeBips, "default AUM fee bips should be kept")
+	})
+
+	s.Run("a configured retention is kept", func() {
+		s.SetupTest()
+		params := types.DefaultParams()
+		params.NavHistoryRetentionSeconds = 3_600
+		params.SharePriceRetentionSeconds = 7_200
+		s.Require().NoError(s.k.Params.Set(s.ctx, params), "should store params")
+
+		runMigration()
+
+		got := getParams()
+		s.Equal(uint64(3_600), got.NavHistoryRetentionSeconds, "configured NAV history retention should be kept")
+		s.Equal(uint64(7_200), got.SharePriceRetentionSeconds, "configured share price retention should be kept")
+	})
+
+	s.Run("chains without stored params are left untouched", func() {
+		s.SetupTest()
+		s.Require().NoError(s.k.Params.Remove(s.ctx), "should remove params")
+
+		runMigration()
+
+		has, err := s.k.Params.Has(s.ctx)
+		s.Require().NoError(err, "should check params")
+		s.False(has, "params should not be written")
+	})
+}
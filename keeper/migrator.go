@@ -32,3 +32,16 @@ func (m Migrator) Migrate1to2(ctx sdk.Context) error {
 	}
 	return nil
 }
+
+// Migrate2to3 advances the vault module from ConsensusVersion 2 to 3 by seeding every
+// vault's role table from its admin, asset manager and NAV authority fields, and the
+// params added in version 3 with their defaults.
+func (m Migrator) Migrate2to3(ctx sdk.Context) error {
+	if err := m.keeper.migrateRoleGrants(ctx); err != nil {
+		return fmt.Errorf("failed to migrate role grants: %w", err)
+	}
+	if err := m.keeper.migrateParamDefaults(ctx); err != nil {
+		return fmt.Errorf("failed to migrate param defaults: %w", err)
+	}
+	return nil
+}
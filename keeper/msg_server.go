@@ -86,6 +86,9 @@ func (k msgServer) SetShareDenomMetadata(goCtx context.Context, msg *types.MsgSe
 // SwapIn handles depositing assets accepted by the vault and mints vault shares to the recipient.
 func (k msgServer) SwapIn(goCtx context.Context, msg *types.MsgSwapInRequest) (*types.MsgSwapInResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := k.requireNotHalted(ctx, msg); err != nil {
+		return nil, err
+	}
 
 	vaultAddr := sdk.MustAccAddressFromBech32(msg.VaultAddress)
 	ownerAddr := sdk.MustAccAddressFromBech32(msg.Owner)
@@ -101,6 +104,9 @@ func (k msgServer) SwapIn(goCtx context.Context, msg *types.MsgSwapInRequest) (*
 // SwapOut handles redeeming vault shares for assets accepted by the vault and transfers them to the recipient.
 func (k msgServer) SwapOut(goCtx context.Context, msg *types.MsgSwapOutRequest) (*types.MsgSwapOutResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := k.requireNotHalted(ctx, msg); err != nil {
+		return nil, err
+	}
 
 	vaultAddr := sdk.MustAccAddressFromBech32(msg.VaultAddress)
 	ownerAddr := sdk.MustAccAddressFromBech32(msg.Owner)
@@ -179,8 +185,8 @@ func (k msgServer) UpdateInterestRate(goCtx context.Context, msg *types.MsgUpdat
 	if err != nil {
 		return nil, err
 	}
-	if err = vault.ValidateManagementAuthority(msg.Authority); err != nil {
-		return nil, fmt.Errorf("failed to validate management authority: %w", err)
+	if err = vault.ValidateRole(msg.Authority, types.VaultRole_VAULT_ROLE_RATE_SETTER); err != nil {
+		return nil, fmt.Errorf("failed to validate rate setter role: %w", err)
 	}
 
 	newRate, err := sdkmath.LegacyNewDecFromStr(msg.NewRate)
@@ -249,8 +255,16 @@ func (k msgServer) UpdateWithdrawalDelay(goCtx context.Context, msg *types.MsgUp
 	if err != nil {
 		return nil, err
 	}
-	if err = vault.ValidateManagementAuthority(msg.Authority); err != nil {
-		return nil, fmt.Errorf("failed to validate management authority: %w", err)
+	if err = vault.ValidateRole(msg.Authority, types.VaultRole_VAULT_ROLE_LIMITS_MANAGER); err != nil {
+		return nil, fmt.Errorf("failed to validate limits manager role: %w", err)
+	}
+
+	scheduled, err := k.scheduleIfTimelocked(ctx, vault, msg.Authority, msg)
+	if err != nil {
+		return nil, fmt.Errorf("failed to schedule withdrawal delay update: %w", err)
+	}
+	if scheduled {
+		return &types.MsgUpdateWithdrawalDelayResponse{}, nil
 	}
 
 	if err := k.SetWithdrawalDelay(ctx, vault, msg.WithdrawalDelaySeconds, msg.Authority); err != nil {
@@ -269,8 +283,8 @@ func (k msgServer) UpdateMinSwapInValue(goCtx context.Context, msg *types.MsgUpd
 	if err != nil {
 		return nil, err
 	}
-	if err := vault.ValidateManagementAuthority(msg.Authority); err != nil {
-		return nil, fmt.Errorf("unauthorized: %w", err)
+	if err := vault.ValidateRole(msg.Authority, types.VaultRole_VAULT_ROLE_LIMITS_MANAGER); err != nil {
+		return nil, fmt.Errorf("failed to validate limits manager role: %w", err)
 	}
 
 	if err := k.SetMinSwapInValue(ctx, vault, msg.MinSwapInValue, msg.Authority); err != nil {
@@ -289,8 +303,8 @@ func (k msgServer) UpdateMinSwapOutValue(goCtx context.Context, msg *types.MsgUp
 	if err != nil {
 		return nil, err
 	}
-	if err := vault.ValidateManagementAuthority(msg.Authority); err != nil {
-		return nil, fmt.Errorf("unauthorized: %w", err)
+	if err := vault.ValidateRole(msg.Authority, types.VaultRole_VAULT_ROLE_LIMITS_MANAGER); err != nil {
+		return nil, fmt.Errorf("failed to validate limits manager role: %w", err)
 	}
 
 	if err := k.SetMinSwapOutValue(ctx, vault, msg.MinSwapOutValue, msg.Authority); err != nil {
@@ -309,8 +323,8 @@ func (k msgServer) UpdateMaxSwapInValue(goCtx context.Context, msg *types.MsgUpd
 	if err != nil {
 		return nil, err
 	}
-	if err := vault.ValidateManagementAuthority(msg.Authority); err != nil {
-		return nil, fmt.Errorf("unauthorized: %w", err)
+	if err := vault.ValidateRole(msg.Authority, types.VaultRole_VAULT_ROLE_LIMITS_MANAGER); err != nil {
+		return nil, fmt.Errorf("failed to validate limits manager role: %w", err)
 	}
 
 	if err := k.SetMaxSwapInValue(ctx, vault, msg.MaxSwapInValue, msg.Authority); err != nil {
@@ -329,8 +343,16 @@ func (k msgServer) UpdateMaxSwapOutValue(goCtx context.Context, msg *types.MsgUp
 	if err != nil {
 		return nil, err
 	}
-	if err := vault.ValidateManagementAuthority(msg.Authority); err != nil {
-		return nil, fmt.Errorf("unauthorized: %w", err)
+	if err := vault.ValidateRole(msg.Authority, types.VaultRole_VAULT_ROLE_LIMITS_MANAGER); err != nil {
+		return nil, fmt.Errorf("failed to validate limits manager role: %w", err)
+	}
+
+	scheduled, err := k.scheduleIfTimelocked(ctx, vault, msg.Authority, msg)
+	if err != nil {
+		return nil, fmt.Errorf("failed to schedule max swap out value update: %w", err)
+	}
+	if scheduled {
+		return &types.MsgUpdateMaxSwapOutValueResponse{}, nil
 	}
 
 	if err := k.SetMaxSwapOutValue(ctx, vault, msg.MaxSwapOutValue, msg.Authority); err != nil {
@@ -393,8 +415,8 @@ func (k msgServer) DepositInterestFunds(goCtx context.Context, msg *types.MsgDep
 		return nil, err
 	}
 
-	if err = vault.ValidateManagementAuthority(msg.Authority); err != nil {
-		return nil, fmt.Errorf("failed to validate management authority: %w", err)
+	if err = vault.ValidateRole(msg.Authority, types.VaultRole_VAULT_ROLE_RESERVE_FUNDER); err != nil {
+		return nil, fmt.Errorf("failed to validate reserve funder role: %w", err)
 	}
 
 	if vault.UnderlyingAsset != msg.Amount.Denom {
@@ -426,8 +448,8 @@ func (k msgServer) WithdrawInterestFunds(goCtx context.Context, msg *types.MsgWi
 		return nil, err
 	}
 
-	if err = vault.ValidateManagementAuthority(msg.Authority); err != nil {
-		return nil, fmt.Errorf("failed to validate management authority: %w", err)
+	if err = vault.ValidateRole(msg.Authority, types.VaultRole_VAULT_ROLE_RESERVE_FUNDER); err != nil {
+		return nil, fmt.Errorf("failed to validate reserve funder role: %w", err)
 	}
 	if vault.UnderlyingAsset != msg.Amount.Denom {
 		return nil, fmt.Errorf("denom not supported for vault must be of type \"%s\" : got \"%s\"", vault.UnderlyingAsset, msg.Amount.Denom)
@@ -456,8 +478,8 @@ func (k msgServer) DepositPrincipalFunds(goCtx context.Context, msg *types.MsgDe
 	if err != nil {
 		return nil, err
 	}
-	if err = vault.ValidateManagementAuthority(msg.Authority); err != nil {
-		return nil, fmt.Errorf("failed to validate management authority: %w", err)
+	if err = vault.ValidateRole(msg.Authority, types.VaultRole_VAULT_ROLE_RESERVE_FUNDER); err != nil {
+		return nil, fmt.Errorf("failed to validate reserve funder role: %w", err)
 	}
 
 	if !vault.Paused {
@@ -496,8 +518,8 @@ func (k msgServer) WithdrawPrincipalFunds(goCtx context.Context, msg *types.MsgW
 	if err != nil {
 		return nil, err
 	}
-	if err = vault.ValidateManagementAuthority(msg.Authority); err != nil {
-		return nil, fmt.Errorf("failed to validate management authority: %w", err)
+	if err = vault.ValidateRole(msg.Authority, types.VaultRole_VAULT_ROLE_RESERVE_FUNDER); err != nil {
+		return nil, fmt.Errorf("failed to validate reserve funder role: %w", err)
 	}
 
 	if !vault.Paused {
@@ -524,6 +546,9 @@ func (k msgServer) WithdrawPrincipalFunds(goCtx context.Context, msg *types.MsgW
 	); err != nil {
 		return nil, fmt.Errorf("failed to withdraw principal funds: %w", err)
 	}
+	if err := k.requireLiquidityBuffer(ctx, vault); err != nil {
+		return nil, err
+	}
 
 	k.emitEvent(ctx, types.NewEventWithdrawPrincipalFunds(msg.VaultAddress, msg.Authority, msg.Amount))
 
@@ -533,6 +558,9 @@ func (k msgServer) WithdrawPrincipalFunds(goCtx context.Context, msg *types.MsgW
 // ExpeditePendingSwapOut expedites a pending swap out from a vault.
 func (k msgServer) ExpeditePendingSwapOut(goCtx context.Context, msg *types.MsgExpeditePendingSwapOutRequest) (*types.MsgExpeditePendingSwapOutResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := k.requireNotHalted(ctx, msg); err != nil {
+		return nil, err
+	}
 
 	_, swapOut, err := k.PendingSwapOutQueue.GetByID(ctx, msg.RequestId)
 	if err != nil {
@@ -544,8 +572,8 @@ func (k msgServer) ExpeditePendingSwapOut(goCtx context.Context, msg *types.MsgE
 	if err != nil {
 		return nil, err
 	}
-	if err = vault.ValidateManagementAuthority(msg.Authority); err != nil {
-		return nil, fmt.Errorf("failed to validate management authority: %w", err)
+	if err = vault.ValidateRole(msg.Authority, types.VaultRole_VAULT_ROLE_LIMITS_MANAGER); err != nil {
+		return nil, fmt.Errorf("failed to validate limits manager role: %w", err)
 	}
 
 	if err := k.PendingSwapOutQueue.ExpediteSwapOut(ctx, msg.RequestId); err != nil {
@@ -579,8 +607,8 @@ func (k msgServer) PauseVault(goCtx context.Context, msg *types.MsgPauseVaultReq
 	if err != nil {
 		return nil, err
 	}
-	if err = vault.ValidateManagementAuthority(msg.Authority); err != nil {
-		return nil, fmt.Errorf("failed to validate management authority: %w", err)
+	if err = vault.ValidateRole(msg.Authority, types.VaultRole_VAULT_ROLE_PAUSER); err != nil {
+		return nil, fmt.Errorf("failed to validate pauser role: %w", err)
 	}
 
 	if vault.Paused {
@@ -633,8 +661,8 @@ func (k msgServer) UnpauseVault(goCtx context.Context, msg *types.MsgUnpauseVaul
 	if err != nil {
 		return nil, err
 	}
-	if err = vault.ValidateManagementAuthority(msg.Authority); err != nil {
-		return nil, fmt.Errorf("failed to validate management authority: %w", err)
+	if err = vault.ValidateRole(msg.Authority, types.VaultRole_VAULT_ROLE_PAUSER); err != nil {
+		return nil, fmt.Errorf("failed to validate pauser role: %w", err)
 	}
 
 	if !vault.Paused {
@@ -683,12 +711,17 @@ func (k msgServer) SetBridgeAddress(goCtx context.Context, msg *types.MsgSetBrid
 		return nil, fmt.Errorf("failed to validate admin: %w", err)
 	}
 
-	vault.BridgeAddress = msg.BridgeAddress
-	if err := k.SetVaultAccount(ctx, vault); err != nil {
-		return nil, fmt.Errorf("failed to set vault account: %w", err)
+	scheduled, err := k.scheduleIfTimelocked(ctx, vault, msg.Admin, msg)
+	if err != nil {
+		return nil, fmt.Errorf("failed to schedule bridge address change: %w", err)
+	}
+	if scheduled {
+		return &types.MsgSetBridgeAddressResponse{}, nil
 	}
 
-	k.emitEvent(ctx, types.NewEventBridgeAddressSet(vaultAddr.String(), msg.Admin, msg.BridgeAddress))
+	if err := k.SetVaultBridgeAddress(ctx, vault, msg.BridgeAddress, msg.Admin); err != nil {
+		return nil, fmt.Errorf("failed to set bridge address: %w", err)
+	}
 
 	return &types.MsgSetBridgeAddressResponse{}, nil
 }
@@ -720,6 +753,9 @@ func (k msgServer) ToggleBridge(goCtx context.Context, msg *types.MsgToggleBridg
 // and the mint amount must not exceed (total_shares - current marker supply).
 func (k msgServer) BridgeMintShares(goCtx context.Context, msg *types.MsgBridgeMintSharesRequest) (*types.MsgBridgeMintSharesResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := k.requireNotHalted(ctx, msg); err != nil {
+		return nil, err
+	}
 
 	vaultAddr := sdk.MustAccAddressFromBech32(msg.VaultAddress)
 	bridgeAddr := sdk.MustAccAddressFromBech32(msg.Bridge)
@@ -764,6 +800,9 @@ func (k msgServer) BridgeMintShares(goCtx context.Context, msg *types.MsgBridgeM
 // and the burn amount must not exceed the current marker supply. Shares are burned from the bridge account balance.
 func (k msgServer) BridgeBurnShares(goCtx context.Context, msg *types.MsgBridgeBurnSharesRequest) (*types.MsgBridgeBurnSharesResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := k.requireNotHalted(ctx, msg); err != nil {
+		return nil, err
+	}
 
 	vaultAddr := sdk.MustAccAddressFromBech32(msg.VaultAddress)
 	bridgeAddr := sdk.MustAccAddressFromBech32(msg.Bridge)
@@ -801,7 +840,8 @@ func (k msgServer) BridgeBurnShares(goCtx context.Context, msg *types.MsgBridgeB
 }
 
 // SetAssetManager sets or clears the optional asset manager address for a vault.
-// Only the vault admin may call this. Passing an empty AssetManager clears it.
+// Only the vault admin may call this. Passing an empty AssetManager clears it. The asset
+// manager roles move from the previous asset manager to the new one.
 func (k msgServer) SetAssetManager(goCtx context.Context, msg *types.MsgSetAssetManagerRequest) (*types.MsgSetAssetManagerResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
@@ -815,13 +855,17 @@ func (k msgServer) SetAssetManager(goCtx context.Context, msg *types.MsgSetAsset
 		return nil, fmt.Errorf("failed to validate admin: %w", err)
 	}
 
-	vault.AssetManager = msg.AssetManager
-
-	if err := k.SetVaultAccount(ctx, vault); err != nil {
-		return nil, fmt.Errorf("failed to set vault account: %w", err)
+	scheduled, err := k.scheduleIfTimelocked(ctx, vault, msg.Admin, msg)
+	if err != nil {
+		return nil, fmt.Errorf("failed to schedule asset manager change: %w", err)
+	}
+	if scheduled {
+		return &types.MsgSetAssetManagerResponse{}, nil
 	}
 
-	k.emitEvent(ctx, types.NewEventAssetManagerSet(vaultAddr.String(), msg.Admin, msg.AssetManager))
+	if err := k.SetVaultAssetManager(ctx, vault, msg.AssetManager, msg.Admin); err != nil {
+		return nil, fmt.Errorf("failed to set asset manager: %w", err)
+	}
 
 	return &types.MsgSetAssetManagerResponse{}, nil
 }
@@ -838,11 +882,20 @@ func (k msgServer) UpdateParams(goCtx context.Context, msg *types.MsgUpdateParam
 		return nil, fmt.Errorf("invalid params: %w", err)
 	}
 
-	if err := k.Params.Set(ctx, msg.Params); err != nil {
+	// The circuit breaker and its guardian are only changed through UpdateCircuitBreaker.
+	stored, err := k.getParamsOrDefault(ctx)
+	if err != nil {
+		return nil, err
+	}
+	params := msg.Params
+	params.CircuitBreaker = stored.CircuitBreaker
+	params.CircuitBreakerGuardian = stored.CircuitBreakerGuardian
+
+	if err := k.Params.Set(ctx, params); err != nil {
 		return nil, fmt.Errorf("failed to set params: %w", err)
 	}
 
-	k.emitEvent(ctx, types.NewEventParamsUpdated(msg.Params))
+	k.emitEvent(ctx, types.NewEventParamsUpdated(params))
 
 	return &types.MsgUpdateParamsResponse{}, nil
 }
@@ -878,7 +931,7 @@ func (k msgServer) UpdateVaultAUMFeeBips(goCtx context.Context, msg *types.MsgUp
 }
 
 // UpdateVaultNAV creates or updates a vault's internal NAV entry for a denom.
-// Only the vault's NAV authority is authorized to perform this operation.
+// Only holders of the vault's NAV authority role are authorized to perform this operation.
 //
 // The price lands in the vault's internal NAV table and nowhere else. A vault does not
 // own the assets it prices, so it does not mirror an asset price into that asset's
@@ -901,6 +954,12 @@ func (k msgServer) UpdateVaultAUMFeeBips(goCtx context.Context, msg *types.MsgUp
 // The reconcile settles accrued interest against the total vault value that held
 // before the price change. It is a no-op on a paused vault, whose interest and
 // fee accrual is already halted.
+//
+// When the vault sets max_nav_change_bips, an update that moves a held denom's price
+// by more than the limit is not applied. It is stored as a pending NAV proposal that
+// takes effect only once the vault's NAV approver confirms it (see ApproveNAVProposal),
+// and the response reports pending_approval. An update that is applied directly
+// supersedes any proposal still pending for the denom.
 func (k msgServer) UpdateVaultNAV(goCtx context.Context, msg *types.MsgUpdateVaultNAVRequest) (*types.MsgUpdateVaultNAVResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
@@ -909,25 +968,39 @@ func (k msgServer) UpdateVaultNAV(goCtx context.Context, msg *types.MsgUpdateVau
 	if err != nil {
 		return nil, fmt.Errorf("failed to get vault %s: %w", msg.VaultAddress, err)
 	}
-	if err := vault.ValidateNAVAuthority(msg.Signer); err != nil {
-		return nil, fmt.Errorf("failed to validate NAV authority: %w", err)
+	if err := vault.ValidateRole(msg.Signer, types.VaultRole_VAULT_ROLE_NAV_AUTHORITY); err != nil {
+		return nil, fmt.Errorf("failed to validate NAV authority role: %w", err)
+	}
+
+	nav := types.NewVaultNAV(msg.Denom, msg.Price, msg.Volume, msg.Source)
+	pending, current, err := k.requiresNAVApproval(ctx, vault, nav)
+	if err != nil {
+		return nil, fmt.Errorf("failed to check NAV change limit: %w", err)
+	}
+	if pending {
+		if err := k.ProposeVaultNAV(ctx, vault, nav, current, msg.Signer); err != nil {
+			return nil, fmt.Errorf("failed to propose vault NAV: %w", err)
+		}
+		return &types.MsgUpdateVaultNAVResponse{PendingApproval: true}, nil
 	}
 
 	if err := k.reconcileVault(ctx, vault); err != nil {
 		return nil, fmt.Errorf("failed to reconcile vault before NAV update: %w", err)
 	}
 
-	nav := types.NewVaultNAV(msg.Denom, msg.Price, msg.Volume, msg.Source)
 	if err := k.SetVaultNAV(ctx, vault, nav, msg.Signer); err != nil {
 		return nil, fmt.Errorf("failed to update vault NAV: %w", err)
 	}
+	if err := k.removePendingNAVProposal(ctx, vault, msg.Denom); err != nil {
+		return nil, err
+	}
 
 	return &types.MsgUpdateVaultNAVResponse{}, nil
 }
 
 // RemoveVaultNAV deletes a vault's internal NAV entry for a denom the vault does not
 // hold, revoking the authorization to acquire that denom at that price. Only the
-// vault's NAV authority is authorized to perform this operation.
+// vault's NAV authority role holders are authorized to perform this operation.
 //
 // A denom still held at the principal marker cannot be removed: dropping its entry
 // would erase the held balance from the vault's value rather than restate it. Such an
@@ -948,11 +1021,11 @@ func (k msgServer) RemoveVaultNAV(goCtx context.Context, msg *types.MsgRemoveVau
 	if err != nil {
 		return nil, fmt.Errorf("failed to get vault %s: %w", msg.VaultAddress, err)
 	}
-	if err := vault.ValidateNAVAuthority(msg.Signer); err != nil {
-		return nil, fmt.Errorf("failed to validate NAV authority: %w", err)
+	if err := vault.ValidateRole(msg.Signer, types.VaultRole_VAULT_ROLE_NAV_AUTHORITY); err != nil {
+		return nil, fmt.Errorf("failed to validate NAV authority role: %w", err)
 	}
 
-	balance := k.BankKeeper.GetBalance(ctx, vault.PrincipalMarkerAddress(), msg.Denom)
+	balance := k.heldBalance(ctx, *vault, msg.Denom)
 	if !balance.IsZero() {
 		return nil, fmt.Errorf("vault %s still holds %s: write a held asset down through UpdateVaultNAV instead of removing its NAV", msg.VaultAddress, balance)
 	}
@@ -977,6 +1050,15 @@ func (k msgServer) UpdateNAVAuthority(goCtx context.Context, msg *types.MsgUpdat
 	if err := vault.ValidateAdmin(msg.Signer); err != nil {
 		return nil, fmt.Errorf("failed to validate admin: %w", err)
 	}
+
+	scheduled, err := k.scheduleIfTimelocked(ctx, vault, msg.Signer, msg)
+	if err != nil {
+		return nil, fmt.Errorf("failed to schedule NAV authority update: %w", err)
+	}
+	if scheduled {
+		return &types.MsgUpdateNAVAuthorityResponse{}, nil
+	}
+
 	if err := k.SetNAVAuthority(ctx, vault, msg.NewAuthority, msg.Signer); err != nil {
 		return nil, fmt.Errorf("failed to set NAV authority: %w", err)
 	}
@@ -985,8 +1067,8 @@ func (k msgServer) UpdateNAVAuthority(goCtx context.Context, msg *types.MsgUpdat
 }
 
 // AcceptAsset settles a pending exchange-module payment whose target is the vault,
-// exchanging an external asset for the vault's underlying asset. Only the vault's asset
-// manager may sign it; the admin cannot settle.
+// exchanging an external asset for the vault's underlying asset. Only a holder of the
+// vault's settlement manager role may sign it.
 //
 // The exchange module's AcceptPayment operates on the caller's primary account, so the
 // vault account is used as an atomic staging hop while the principal marker account
@@ -996,74 +1078,40 @@ func (k msgServer) UpdateNAVAuthority(goCtx context.Context, msg *types.MsgUpdat
 //
 // All steps run in the message handler; any failure reverts the whole transaction. The
 // settlement direction (inbound or outbound) is derived from which payment leg carries
-// the vault's underlying asset.
+// the vault's underlying asset. The asset leg may carry several coins, each settled at its
+// own internal NAV. A single-coin settlement's price is recorded as the latest exchange
+// settlement price for the asset denom; a bundle settles only its total, so it discovers
+// no per-denom price.
 func (k msgServer) AcceptAsset(goCtx context.Context, msg *types.MsgAcceptAssetRequest) (*types.MsgAcceptAssetResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := k.requireNotHalted(ctx, msg); err != nil {
+		return nil, err
+	}
 
 	vaultAddr := sdk.MustAccAddressFromBech32(msg.VaultAddress)
 	vault, err := k.getVault(ctx, vaultAddr)
 	if err != nil {
 		return nil, err
 	}
-	if err = vault.ValidateAssetManagerAuthority(msg.Authority); err != nil {
-		return nil, fmt.Errorf("failed to validate asset manager authority: %w", err)
+	if err = vault.ValidateRole(msg.Authority, types.VaultRole_VAULT_ROLE_SETTLEMENT_MANAGER); err != nil {
+		return nil, fmt.Errorf("failed to validate settlement manager role: %w", err)
 	}
 	if vault.Paused {
 		return nil, fmt.Errorf("vault %s is paused: assets cannot be accepted while paused", msg.VaultAddress)
 	}
 
-	sourceAddr := sdk.MustAccAddressFromBech32(msg.Source)
-	payment, err := k.ExchangeKeeper.GetPayment(ctx, sourceAddr, msg.ExternalId)
-	if err != nil {
-		return nil, fmt.Errorf("failed to get payment: %w", err)
-	}
-	if payment == nil {
-		return nil, fmt.Errorf("payment not found for source %s and external id %q", msg.Source, msg.ExternalId)
-	}
-	if payment.Target != msg.VaultAddress {
-		return nil, fmt.Errorf("payment target %s is not vault %s", payment.Target, msg.VaultAddress)
-	}
-
-	direction, err := assetSettlementDirection(vault, payment)
-	if err != nil {
-		return nil, err
-	}
-
-	assetCoin, paymentCoin, err := settlementLegCoins(payment, direction, vault.UnderlyingAsset)
+	settlement, err := k.resolveAssetSettlement(ctx, vault, msg.Source, msg.ExternalId)
 	if err != nil {
-		return nil, fmt.Errorf("failed to resolve settlement leg coins: %w", err)
-	}
-	if err := k.checkSettlementNAVGuardrail(ctx, vault, assetCoin, paymentCoin); err != nil {
 		return nil, err
 	}
 
 	if err := k.reconcileVault(ctx, vault); err != nil {
 		return nil, fmt.Errorf("failed to reconcile vault before settlement: %w", err)
 	}
-
-	principalAddr := vault.PrincipalMarkerAddress()
-
-	for _, coin := range payment.TargetAmount {
-		if bal := k.BankKeeper.GetBalance(ctx, principalAddr, coin.Denom); bal.Amount.LT(coin.Amount) {
-			return nil, fmt.Errorf("insufficient principal balance for %s: have %s, need %s", coin.Denom, bal.Amount, coin.Amount)
-		}
-	}
-
-	if err := k.stageFromPrincipal(ctx, vault, payment.TargetAmount); err != nil {
-		return nil, fmt.Errorf("failed to stage target amount from principal to vault: %w", err)
-	}
-
-	if err := k.ExchangeKeeper.AcceptPayment(ctx, payment); err != nil {
-		return nil, fmt.Errorf("failed to accept payment: %w", err)
-	}
-
-	if err := k.returnToPrincipal(ctx, vault, payment.SourceAmount); err != nil {
-		return nil, fmt.Errorf("failed to move source amount from vault to principal: %w", err)
+	if err := k.requirePrincipalBalance(ctx, vault, settlement.payment.TargetAmount); err != nil {
+		return nil, err
 	}
-
-	k.emitEvent(ctx, types.NewEventAssetAccepted(msg.VaultAddress, msg.Source, msg.ExternalId, payment.SourceAmount, payment.TargetAmount, direction))
-
-	if err := k.removeDrainedSettlementNAV(ctx, vault, assetCoin.Denom, direction); err != nil {
+	if err := k.settleAsset(ctx, vault, settlement); err != nil {
 		return nil, err
 	}
 
@@ -1071,8 +1119,8 @@ func (k msgServer) AcceptAsset(goCtx context.Context, msg *types.MsgAcceptAssetR
 }
 
 // RejectAsset declines a pending exchange-module payment whose target is the vault. The
-// exchange module cancels the payment and refunds the source's escrow. Only the vault's
-// asset manager may sign it; the admin cannot reject.
+// exchange module cancels the payment and refunds the source's escrow. Only a holder of the
+// vault's settlement manager role may sign it.
 func (k msgServer) RejectAsset(goCtx context.Context, msg *types.MsgRejectAssetRequest) (*types.MsgRejectAssetResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
@@ -1081,8 +1129,8 @@ func (k msgServer) RejectAsset(goCtx context.Context, msg *types.MsgRejectAssetR
 	if err != nil {
 		return nil, err
 	}
-	if err := vault.ValidateAssetManagerAuthority(msg.Authority); err != nil {
-		return nil, fmt.Errorf("failed to validate asset manager authority: %w", err)
+	if err := vault.ValidateRole(msg.Authority, types.VaultRole_VAULT_ROLE_SETTLEMENT_MANAGER); err != nil {
+		return nil, fmt.Errorf("failed to validate settlement manager role: %w", err)
 	}
 
 	sourceAddr := sdk.MustAccAddressFromBech32(msg.Source)
@@ -1130,3 +1178,904 @@ func assetSettlementDirection(vault *types.VaultAccount, payment *exchange.Payme
 		return "", fmt.Errorf("zero-priced payment must carry the asset on exactly one leg: source_amount=%q target_amount=%q", payment.SourceAmount, payment.TargetAmount)
 	}
 }
+
+// UpdateNAVStalenessLimit sets the maximum age of a held denom's internal NAV before the
+// vault's swaps and asset settlements are blocked, and whether a stale NAV auto-pauses the
+// vault. Only the vault admin is authorized to perform this operation.
+func (k msgServer) UpdateNAVStalenessLimit(goCtx context.Context, msg *types.MsgUpdateNAVStalenessLimitRequest) (*types.MsgUpdateNAVStalenessLimitResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	vaultAddr := sdk.MustAccAddressFromBech32(msg.VaultAddress)
+	vault, err := k.getVault(ctx, vaultAddr)
+	if err != nil {
+		return nil, err
+	}
+	if err := vault.ValidateAdmin(msg.Authority); err != nil {
+		return nil, fmt.Errorf("failed to validate admin: %w", err)
+	}
+
+	if err := k.SetNAVStalenessLimit(ctx, vault, msg.MaxNavAgeSeconds, msg.AutoPauseOnStaleNav, msg.Authority); err != nil {
+		return nil, fmt.Errorf("failed to set NAV staleness limit: %w", err)
+	}
+
+	return &types.MsgUpdateNAVStalenessLimitResponse{}, nil
+}
+
+// UpdateNAVChangeLimit sets the largest move the NAV authority may apply to a held
+// denom's internal NAV in a single update, and the NAV approver who confirms larger
+// moves. Only the vault admin is authorized to perform this operation.
+func (k msgServer) UpdateNAVChangeLimit(goCtx context.Context, msg *types.MsgUpdateNAVChangeLimitRequest) (*types.MsgUpdateNAVChangeLimitResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	vaultAddr := sdk.MustAccAddressFromBech32(msg.VaultAddress)
+	vault, err := k.getVault(ctx, vaultAddr)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get vault %s: %w", msg.VaultAddress, err)
+	}
+	if err := vault.ValidateAdmin(msg.Authority); err != nil {
+		return nil, fmt.Errorf("failed to validate admin: %w", err)
+	}
+
+	scheduled, err := k.scheduleIfTimelocked(ctx, vault, msg.Authority, msg)
+	if err != nil {
+		return nil, fmt.Errorf("failed to schedule NAV change limit update: %w", err)
+	}
+	if scheduled {
+		return &types.MsgUpdateNAVChangeLimitResponse{}, nil
+	}
+
+	if err := k.SetNAVChangeLimit(ctx, vault, msg.MaxNavChangeBips, msg.NavApprover, msg.Authority); err != nil {
+		return nil, fmt.Errorf("failed to set NAV change limit: %w", err)
+	}
+
+	return &types.MsgUpdateNAVChangeLimitResponse{}, nil
+}
+
+// UpdateSettlementTolerance sets how far asset settlements may trade from the vault's
+// internal NAV, and whether a settlement marks the NAV to its price. Only the vault admin
+// is authorized to perform this operation.
+func (k msgServer) UpdateSettlementTolerance(goCtx context.Context, msg *types.MsgUpdateSettlementToleranceRequest) (*types.MsgUpdateSettlementToleranceResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	vaultAddr := sdk.MustAccAddressFromBech32(msg.VaultAddress)
+	vault, err := k.getVault(ctx, vaultAddr)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get vault %s: %w", msg.VaultAddress, err)
+	}
+	if err := vault.ValidateAdmin(msg.Authority); err != nil {
+		return nil, fmt.Errorf("failed to validate admin: %w", err)
+	}
+
+	if err := k.SetSettlementTolerance(ctx, vault, msg.SettlementToleranceBips, msg.UpdateNavOnSettlement, msg.Authority); err != nil {
+		return nil, fmt.Errorf("failed to set settlement tolerance: %w", err)
+	}
+
+	return &types.MsgUpdateSettlementToleranceResponse{}, nil
+}
+
+// ApproveNAVProposal applies a NAV update the vault held back for exceeding its
+// max_nav_change_bips. Only the vault's NAV approver is authorized to perform this
+// operation, and never for a proposal it submitted itself.
+//
+// Like UpdateVaultNAV, the vault is reconciled first so accrued interest settles
+// against the total vault value that held before the price change.
+func (k msgServer) ApproveNAVProposal(goCtx context.Context, msg *types.MsgApproveNAVProposalRequest) (*types.MsgApproveNAVProposalResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	vaultAddr := sdk.MustAccAddressFromBech32(msg.VaultAddress)
+	vault, err := k.getVault(ctx, vaultAddr)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get vault %s: %w", msg.VaultAddress, err)
+	}
+	if vault.NavApprover == "" || msg.Approver != vault.NavApprover {
+		return nil, fmt.Errorf("unauthorized: %s is not the vault NAV approver", msg.Approver)
+	}
+
+	if err := k.reconcileVault(ctx, vault); err != nil {
+		return nil, fmt.Errorf("failed to reconcile vault before NAV update: %w", err)
+	}
+
+	if err := k.Keeper.ApproveNAVProposal(ctx, vault, msg.Denom, msg.Approver); err != nil {
+		return nil, fmt.Errorf("failed to approve NAV proposal: %w", err)
+	}
+
+	return &types.MsgApproveNAVProposalResponse{}, nil
+}
+
+// UpdateNAVSources sets the vault's NAV sources and how their submissions are
+// aggregated. Only the vault admin is authorized to perform this operation.
+//
+// The vault is reconciled first: changing the sources or quorum can change which price
+// values a held denom, so accrued interest settles against the prior valuation.
+func (k msgServer) UpdateNAVSources(goCtx context.Context, msg *types.MsgUpdateNAVSourcesRequest) (*types.MsgUpdateNAVSourcesResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	vaultAddr := sdk.MustAccAddressFromBech32(msg.VaultAddress)
+	vault, err := k.getVault(ctx, vaultAddr)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get vault %s: %w", msg.VaultAddress, err)
+	}
+	if err := vault.ValidateAdmin(msg.Authority); err != nil {
+		return nil, fmt.Errorf("failed to validate admin: %w", err)
+	}
+
+	scheduled, err := k.scheduleIfTimelocked(ctx, vault, msg.Authority, msg)
+	if err != nil {
+		return nil, fmt.Errorf("failed to schedule NAV source update: %w", err)
+	}
+	if scheduled {
+		return &types.MsgUpdateNAVSourcesResponse{}, nil
+	}
+
+	if err := k.reconcileVault(ctx, vault); err != nil {
+		return nil, fmt.Errorf("failed to reconcile vault before NAV source update: %w", err)
+	}
+
+	if err := k.SetNAVSources(ctx, vault, msg.NavSources, msg.NavSourceQuorum, msg.NavAggregation, msg.NavSourceMaxAgeSeconds, msg.Authority); err != nil {
+		return nil, fmt.Errorf("failed to set NAV sources: %w", err)
+	}
+
+	return &types.MsgUpdateNAVSourcesResponse{}, nil
+}
+
+// SubmitSourceNAV records a NAV source's price for a denom. Only one of the vault's
+// registered NAV sources is authorized to perform this operation.
+//
+// Like UpdateVaultNAV, the vault is reconciled first so accrued interest settles
+// against the total vault value that held before the submission can move the
+// aggregated price.
+func (k msgServer) SubmitSourceNAV(goCtx context.Context, msg *types.MsgSubmitSourceNAVRequest) (*types.MsgSubmitSourceNAVResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	vaultAddr := sdk.MustAccAddressFromBech32(msg.VaultAddress)
+	vault, err := k.getVault(ctx, vaultAddr)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get vault %s: %w", msg.VaultAddress, err)
+	}
+	if !vault.IsNAVSource(msg.Source) {
+		return nil, fmt.Errorf("unauthorized: %s is not a NAV source of vault %s", msg.Source, msg.VaultAddress)
+	}
+
+	if err := k.reconcileVault(ctx, vault); err != nil {
+		return nil, fmt.Errorf("failed to reconcile vault before NAV source submission: %w", err)
+	}
+
+	nav := types.NewVaultNAV(msg.Denom, msg.Price, msg.Volume, "")
+	if err := k.Keeper.SubmitSourceNAV(ctx, vault, nav, sdk.MustAccAddressFromBech32(msg.Source)); err != nil {
+		return nil, fmt.Errorf("failed to submit source NAV: %w", err)
+	}
+
+	return &types.MsgSubmitSourceNAVResponse{}, nil
+}
+
+// BatchUpdateVaultNAV creates or updates many of a vault's internal NAV entries in one
+// message. Only holders of the vault's NAV authority role are authorized to perform this
+// operation.
+//
+// Every entry is validated against the vault and chain state before anything is
+// written, so a single bad entry rejects the whole batch. Each entry is then handled as
+// UpdateVaultNAV would handle it: an entry that moves a held denom's price by more than
+// max_nav_change_bips is stored as a pending NAV proposal, and every other entry is
+// written to the NAV table. The vault is reconciled once, before the first write, so
+// accrued interest settles against the value that held before the batch moved any
+// price. Per-denom EventNAVUpdated and EventNAVProposed events are followed by a single
+// EventVaultNAVBatchUpdated.
+//
+// Like UpdateVaultNAV, the batch is accepted whether or not the vault is paused.
+func (k msgServer) BatchUpdateVaultNAV(goCtx context.Context, msg *types.MsgBatchUpdateVaultNAVRequest) (*types.MsgBatchUpdateVaultNAVResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	vaultAddr := sdk.MustAccAddressFromBech32(msg.VaultAddress)
+	vault, err := k.getVault(ctx, vaultAddr)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get vault %s: %w", msg.VaultAddress, err)
+	}
+	if err := vault.ValidateRole(msg.Signer, types.VaultRole_VAULT_ROLE_NAV_AUTHORITY); err != nil {
+		return nil, fmt.Errorf("failed to validate NAV authority role: %w", err)
+	}
+
+	navs := make([]types.VaultNAV, len(msg.Updates))
+	pending := make([]bool, len(msg.Updates))
+	previous := make([]types.VaultNAV, len(msg.Updates))
+	anyDirect := false
+	for i, update := range msg.Updates {
+		navs[i] = types.NewVaultNAV(update.Denom, update.Price, update.Volume, update.Source)
+		if err := validateVaultNAVFields(vault, navs[i]); err != nil {
+			return nil, fmt.Errorf("invalid NAV update for denom %q: %w", update.Denom, err)
+		}
+		if err := k.requireNAVDenomRegistered(ctx, update.Denom); err != nil {
+			return nil, fmt.Errorf("invalid NAV update for denom %q: %w", update.Denom, err)
+		}
+		if err := k.requireNAVAcquisitionPermitted(ctx, vault, update.Denom); err != nil {
+			return nil, fmt.Errorf("invalid NAV update for denom %q: %w", update.Denom, err)
+		}
+		pending[i], previous[i], err = k.requiresNAVApproval(ctx, vault, navs[i])
+		if err != nil {
+			return nil, fmt.Errorf("failed to check NAV change limit for denom %q: %w", update.Denom, err)
+		}
+		anyDirect = anyDirect || !pending[i]
+	}
+
+	if anyDirect {
+		if err := k.reconcileVault(ctx, vault); err != nil {
+			return nil, fmt.Errorf("failed to reconcile vault before NAV batch update: %w", err)
+		}
+	}
+
+	var updatedDenoms, pendingDenoms []string
+	for i, nav := range navs {
+		if pending[i] {
+			if err := k.ProposeVaultNAV(ctx, vault, nav, previous[i], msg.Signer); err != nil {
+				return nil, fmt.Errorf("failed to propose vault NAV for denom %q: %w", nav.Denom, err)
+			}
+			pendingDenoms = append(pendingDenoms, nav.Denom)
+			continue
+		}
+		if err := k.SetVaultNAV(ctx, vault, nav, msg.Signer); err != nil {
+			return nil, fmt.Errorf("failed to update vault NAV for denom %q: %w", nav.Denom, err)
+		}
+		if err := k.removePendingNAVProposal(ctx, vault, nav.Denom); err != nil {
+			return nil, err
+		}
+		updatedDenoms = append(updatedDenoms, nav.Denom)
+	}
+
+	k.emitEvent(ctx, types.NewEventVaultNAVBatchUpdated(msg.VaultAddress, msg.Signer, updatedDenoms, pendingDenoms))
+	return &types.MsgBatchUpdateVaultNAVResponse{PendingDenoms: pendingDenoms}, nil
+}
+
+// UpdatePriceSources sets the ordered chain of price sources that value the vault's
+// holdings. Only the vault admin is authorized to perform this operation.
+//
+// The vault is reconciled first: changing the chain can change which price values a
+// held denom, so accrued interest settles against the prior valuation.
+func (k msgServer) UpdatePriceSources(goCtx context.Context, msg *types.MsgUpdatePriceSourcesRequest) (*types.MsgUpdatePriceSourcesResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	vaultAddr := sdk.MustAccAddressFromBech32(msg.VaultAddress)
+	vault, err := k.getVault(ctx, vaultAddr)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get vault %s: %w", msg.VaultAddress, err)
+	}
+	if err := vault.ValidateAdmin(msg.Authority); err != nil {
+		return nil, fmt.Errorf("failed to validate admin: %w", err)
+	}
+
+	scheduled, err := k.scheduleIfTimelocked(ctx, vault, msg.Authority, msg)
+	if err != nil {
+		return nil, fmt.Errorf("failed to schedule price source update: %w", err)
+	}
+	if scheduled {
+		return &types.MsgUpdatePriceSourcesResponse{}, nil
+	}
+
+	if err := k.reconcileVault(ctx, vault); err != nil {
+		return nil, fmt.Errorf("failed to reconcile vault before price source update: %w", err)
+	}
+
+	if err := k.Keeper.SetPriceSources(ctx, vault, msg.PriceSources, msg.Authority); err != nil {
+		return nil, fmt.Errorf("failed to set price sources: %w", err)
+	}
+
+	return &types.MsgUpdatePriceSourcesResponse{}, nil
+}
+
+// UpdateAssetHaircut sets the valuation haircut a vault applies to a held denom. Only the
+// vault's NAV authority role holders are authorized to perform this operation.
+//
+// The vault is reconciled first so accrued interest settles against the total vault
+// value that held before the haircut changed it. Like a repricing, a change that moves a
+// held denom's value by more than max_nav_change_bips is rejected.
+func (k msgServer) UpdateAssetHaircut(goCtx context.Context, msg *types.MsgUpdateAssetHaircutRequest) (*types.MsgUpdateAssetHaircutResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	vaultAddr := sdk.MustAccAddressFromBech32(msg.VaultAddress)
+	vault, err := k.getVault(ctx, vaultAddr)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get vault %s: %w", msg.VaultAddress, err)
+	}
+	if err := vault.ValidateRole(msg.Signer, types.VaultRole_VAULT_ROLE_NAV_AUTHORITY); err != nil {
+		return nil, fmt.Errorf("failed to validate NAV authority role: %w", err)
+	}
+	if msg.Denom == vault.UnderlyingAsset || msg.Denom == vault.TotalShares.Denom {
+		return nil, fmt.Errorf("cannot set a haircut on vault %s underlying or share denom %q", msg.VaultAddress, msg.Denom)
+	}
+
+	if err := k.reconcileVault(ctx, vault); err != nil {
+		return nil, fmt.Errorf("failed to reconcile vault before asset haircut update: %w", err)
+	}
+
+	if err := k.Keeper.SetAssetHaircut(ctx, vault, msg.Denom, msg.HaircutBips, msg.Signer); err != nil {
+		return nil, fmt.Errorf("failed to set asset haircut: %w", err)
+	}
+
+	return &types.MsgUpdateAssetHaircutResponse{}, nil
+}
+
+// BatchAcceptAssets settles many pending exchange-module payments whose target is the vault
+// in one message, such as an end-of-day settlement of loan sales. Only a holder of the
+// settlement manager role may sign it; like AcceptAsset, it is rejected while the vault is paused.
+//
+// Every payment is resolved and checked against the NAV guardrail before any funds move.
+// The vault is then reconciled once, and the principal marker must hold the combined
+// target amount of every payment to be settled before the first one settles. Payments then
+// settle in request order exactly as AcceptAsset settles them.
+//
+// By default the batch is all-or-nothing: any payment that cannot be settled rejects the
+// whole message. With best_effort set, a payment that fails its checks or its settlement is
+// reported in the response and left pending, and the remaining payments still settle. A
+// single EventAssetsBatchAccepted follows the per-payment EventAssetAccepted events.
+func (k msgServer) BatchAcceptAssets(goCtx context.Context, msg *types.MsgBatchAcceptAssetsRequest) (*types.MsgBatchAcceptAssetsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := k.requireNotHalted(ctx, msg); err != nil {
+		return nil, err
+	}
+
+	vaultAddr := sdk.MustAccAddressFromBech32(msg.VaultAddress)
+	vault, err := k.getVault(ctx, vaultAddr)
+	if err != nil {
+		return nil, err
+	}
+	if err = vault.ValidateRole(msg.Authority, types.VaultRole_VAULT_ROLE_SETTLEMENT_MANAGER); err != nil {
+		return nil, fmt.Errorf("failed to validate settlement manager role: %w", err)
+	}
+	if vault.Paused {
+		return nil, fmt.Errorf("vault %s is paused: assets cannot be accepted while paused", msg.VaultAddress)
+	}
+
+	results := make([]types.AssetPaymentResult, len(msg.Payments))
+	settlements := make([]*assetSettlement, len(msg.Payments))
+	required := sdk.NewCoins()
+	resolved := 0
+	for i, ref := range msg.Payments {
+		results[i] = types.AssetPaymentResult{Source: ref.Source, ExternalId: ref.ExternalId}
+		settlement, err := k.resolveAssetSettlement(ctx, vault, ref.Source, ref.ExternalId)
+		if err != nil {
+			if !msg.BestEffort {
+				return nil, fmt.Errorf("failed to resolve payment at index %d: %w", i, err)
+			}
+			results[i].Error = err.Error()
+			continue
+		}
+		settlements[i] = &settlement
+		required = required.Add(settlement.payment.TargetAmount...)
+		resolved++
+	}
+
+	var accepted, failed uint32
+	if resolved > 0 {
+		if err := k.reconcileVault(ctx, vault); err != nil {
+			return nil, fmt.Errorf("failed to reconcile vault before settlement: %w", err)
+		}
+		if err := k.requirePrincipalBalance(ctx, vault, required); err != nil {
+			return nil, fmt.Errorf("failed to fund batch settlement: %w", err)
+		}
+	}
+
+	for i, settlement := range settlements {
+		if settlement == nil {
+			failed++
+			continue
+		}
+		if !msg.BestEffort {
+			if err := k.settleAsset(ctx, vault, *settlement); err != nil {
+				return nil, fmt.Errorf("failed to settle payment at index %d: %w", i, err)
+			}
+		} else {
+			cacheCtx, write := ctx.CacheContext()
+			if err := k.settleAsset(cacheCtx, vault, *settlement); err != nil {
+				results[i].Error = err.Error()
+				failed++
+				continue
+			}
+			write()
+		}
+		results[i].Accepted = true
+		accepted++
+	}
+
+	k.emitEvent(ctx, types.NewEventAssetsBatchAccepted(msg.VaultAddress, msg.Authority, accepted, failed))
+	return &types.MsgBatchAcceptAssetsResponse{Results: results}, nil
+}
+
+// CreateAssetPayment creates an x/exchange payment from the vault, offering a held asset or
+// the underlying asset to a counterparty, so that selling a held position does not depend on
+// the buyer initiating the payment. Only a holder of the settlement manager role may sign it,
+// and it is rejected while the vault is paused.
+//
+// The payment is checked against the NAV guardrail exactly as AcceptAsset checks a payment
+// created against the vault, and its source amount is escrowed from the principal into the
+// vault's payment escrow account, the payment's source on the exchange module. The vault is
+// reconciled first. See CreateOutboundPayment.
+func (k msgServer) CreateAssetPayment(goCtx context.Context, msg *types.MsgCreateAssetPaymentRequest) (*types.MsgCreateAssetPaymentResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := k.requireNotHalted(ctx, msg); err != nil {
+		return nil, err
+	}
+
+	vaultAddr := sdk.MustAccAddressFromBech32(msg.VaultAddress)
+	vault, err := k.getVault(ctx, vaultAddr)
+	if err != nil {
+		return nil, err
+	}
+	if err = vault.ValidateRole(msg.Authority, types.VaultRole_VAULT_ROLE_SETTLEMENT_MANAGER); err != nil {
+		return nil, fmt.Errorf("failed to validate settlement manager role: %w", err)
+	}
+	if vault.Paused {
+		return nil, fmt.Errorf("vault %s is paused: payments cannot be created while paused", msg.VaultAddress)
+	}
+
+	if err := k.reconcileVault(ctx, vault); err != nil {
+		return nil, fmt.Errorf("failed to reconcile vault before creating payment: %w", err)
+	}
+	if _, err := k.CreateOutboundPayment(ctx, vault, msg.Target, msg.SourceAmount, msg.TargetAmount, msg.ExternalId, msg.ExpirationSeconds); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgCreateAssetPaymentResponse{Source: vault.PaymentEscrowAddress().String()}, nil
+}
+
+// CancelAssetPayment cancels an open payment the vault created and sweeps its escrow back to
+// the principal. Only a holder of the settlement manager role may sign it. Cancelling moves
+// no value, since escrowed funds already count toward the vault's TVV, so it is accepted
+// while paused.
+func (k msgServer) CancelAssetPayment(goCtx context.Context, msg *types.MsgCancelAssetPaymentRequest) (*types.MsgCancelAssetPaymentResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	vaultAddr := sdk.MustAccAddressFromBech32(msg.VaultAddress)
+	vault, err := k.getVault(ctx, vaultAddr)
+	if err != nil {
+		return nil, err
+	}
+	if err = vault.ValidateRole(msg.Authority, types.VaultRole_VAULT_ROLE_SETTLEMENT_MANAGER); err != nil {
+		return nil, fmt.Errorf("failed to validate settlement manager role: %w", err)
+	}
+
+	payment, err := k.GetOutboundPayment(ctx, vaultAddr, msg.ExternalId)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get outbound payment %q for vault %s: %w", msg.ExternalId, msg.VaultAddress, err)
+	}
+	if _, err := k.closeOutboundPayment(ctx, vault, payment, types.PaymentClosedCancelled); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgCancelAssetPaymentResponse{}, nil
+}
+
+// ExpireAssetPayment cancels an open payment the vault created once its expiry time has
+// passed and sweeps its escrow back to the principal. Any account may sign it, so an expired
+// payment's escrow does not wait on the asset manager. Like CancelAssetPayment, it is
+// accepted while paused.
+func (k msgServer) ExpireAssetPayment(goCtx context.Context, msg *types.MsgExpireAssetPaymentRequest) (*types.MsgExpireAssetPaymentResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	vaultAddr := sdk.MustAccAddressFromBech32(msg.VaultAddress)
+	vault, err := k.getVault(ctx, vaultAddr)
+	if err != nil {
+		return nil, err
+	}
+
+	payment, err := k.GetOutboundPayment(ctx, vaultAddr, msg.ExternalId)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get outbound payment %q for vault %s: %w", msg.ExternalId, msg.VaultAddress, err)
+	}
+	if ctx.BlockTime().Before(payment.ExpiryTime) {
+		return nil, fmt.Errorf("payment %q does not expire until %s", msg.ExternalId, payment.ExpiryTime)
+	}
+	if _, err := k.closeOutboundPayment(ctx, vault, payment, types.PaymentClosedExpired); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgExpireAssetPaymentResponse{}, nil
+}
+
+// UpdateAcquisitionPolicy replaces the policy restricting which assets a vault may price
+// and acquire. Only the vault admin is authorized to perform this operation.
+func (k msgServer) UpdateAcquisitionPolicy(goCtx context.Context, msg *types.MsgUpdateAcquisitionPolicyRequest) (*types.MsgUpdateAcquisitionPolicyResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	vaultAddr := sdk.MustAccAddressFromBech32(msg.VaultAddress)
+	vault, err := k.getVault(ctx, vaultAddr)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get vault %s: %w", msg.VaultAddress, err)
+	}
+	if err := vault.ValidateAdmin(msg.Authority); err != nil {
+		return nil, fmt.Errorf("failed to validate admin: %w", err)
+	}
+
+	if err := k.SetAcquisitionPolicy(ctx, vault, msg.Policy, msg.Authority); err != nil {
+		return nil, fmt.Errorf("failed to set acquisition policy: %w", err)
+	}
+
+	return &types.MsgUpdateAcquisitionPolicyResponse{}, nil
+}
+
+// UpdateConcentrationLimits sets the largest shares of total vault value a single
+// non-underlying asset, and all non-underlying assets together, may make up. Only the vault
+// admin is authorized to perform this operation.
+func (k msgServer) UpdateConcentrationLimits(goCtx context.Context, msg *types.MsgUpdateConcentrationLimitsRequest) (*types.MsgUpdateConcentrationLimitsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	vaultAddr := sdk.MustAccAddressFromBech32(msg.VaultAddress)
+	vault, err := k.getVault(ctx, vaultAddr)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get vault %s: %w", msg.VaultAddress, err)
+	}
+	if err := vault.ValidateAdmin(msg.Authority); err != nil {
+		return nil, fmt.Errorf("failed to validate admin: %w", err)
+	}
+
+	if err := k.SetConcentrationLimits(ctx, vault, msg.MaxAssetConcentrationBips, msg.MaxNonUnderlyingBips, msg.Authority); err != nil {
+		return nil, fmt.Errorf("failed to set concentration limits: %w", err)
+	}
+
+	return &types.MsgUpdateConcentrationLimitsResponse{}, nil
+}
+
+// UpdateMinLiquidity sets the share of total vault value a vault must keep liquid in its
+// underlying asset. Only the vault admin is authorized to perform this operation.
+func (k msgServer) UpdateMinLiquidity(goCtx context.Context, msg *types.MsgUpdateMinLiquidityRequest) (*types.MsgUpdateMinLiquidityResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	vaultAddr := sdk.MustAccAddressFromBech32(msg.VaultAddress)
+	vault, err := k.getVault(ctx, vaultAddr)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get vault %s: %w", msg.VaultAddress, err)
+	}
+	if err := vault.ValidateAdmin(msg.Authority); err != nil {
+		return nil, fmt.Errorf("failed to validate admin: %w", err)
+	}
+
+	if err := k.SetMinLiquidity(ctx, vault, msg.MinLiquidityBips, msg.Authority); err != nil {
+		return nil, fmt.Errorf("failed to set min liquidity: %w", err)
+	}
+
+	return &types.MsgUpdateMinLiquidityResponse{}, nil
+}
+
+// CollectAssetIncome moves income earned by a held asset from the vault's principal into
+// its interest reserves, or back to the principal. Only settlement manager role holders are
+// authorized to perform this operation, and not while the vault is paused: the move changes
+// total vault value, which a paused vault holds frozen.
+func (k msgServer) CollectAssetIncome(goCtx context.Context, msg *types.MsgCollectAssetIncomeRequest) (*types.MsgCollectAssetIncomeResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	vaultAddr := sdk.MustAccAddressFromBech32(msg.VaultAddress)
+	vault, err := k.getVault(ctx, vaultAddr)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get vault %s: %w", msg.VaultAddress, err)
+	}
+	if err := vault.ValidateRole(msg.Authority, types.VaultRole_VAULT_ROLE_SETTLEMENT_MANAGER); err != nil {
+		return nil, fmt.Errorf("failed to validate settlement manager role: %w", err)
+	}
+	if vault.Paused {
+		return nil, fmt.Errorf("vault %s is paused: asset income cannot be collected while paused", msg.VaultAddress)
+	}
+
+	if err := k.reconcileVault(ctx, vault); err != nil {
+		return nil, fmt.Errorf("failed to reconcile vault before collecting income: %w", err)
+	}
+	if err := k.TransferAssetIncome(ctx, vault, msg.SourceAsset, msg.Amount, msg.ToPrincipal, msg.Authority); err != nil {
+		return nil, fmt.Errorf("failed to collect asset income: %w", err)
+	}
+
+	return &types.MsgCollectAssetIncomeResponse{}, nil
+}
+
+// GrantRole grants a role on a vault to an address. The grant is held by the vault's timelock.
+// Only the vault admin is authorized to perform this operation.
+func (k msgServer) GrantRole(goCtx context.Context, msg *types.MsgGrantRoleRequest) (*types.MsgGrantRoleResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	vaultAddr := sdk.MustAccAddressFromBech32(msg.VaultAddress)
+	vault, err := k.getVault(ctx, vaultAddr)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get vault %s: %w", msg.VaultAddress, err)
+	}
+	if err := vault.ValidateAdmin(msg.Authority); err != nil {
+		return nil, fmt.Errorf("failed to validate admin: %w", err)
+	}
+
+	scheduled, err := k.scheduleIfTimelocked(ctx, vault, msg.Authority, msg)
+	if err != nil {
+		return nil, fmt.Errorf("failed to schedule role grant: %w", err)
+	}
+	if scheduled {
+		return &types.MsgGrantRoleResponse{}, nil
+	}
+
+	if err := k.AddRoleGrant(ctx, vault, msg.Role, msg.Address, msg.Authority); err != nil {
+		return nil, fmt.Errorf("failed to grant role: %w", err)
+	}
+
+	return &types.MsgGrantRoleResponse{}, nil
+}
+
+// RevokeRole revokes a role on a vault from an address. Revoking a guardian is held by the
+// vault's timelock, since guardians are who cancel timelocked changes; other revocations only
+// remove authority and apply immediately. Only the vault admin is authorized to perform this
+// operation.
+func (k msgServer) RevokeRole(goCtx context.Context, msg *types.MsgRevokeRoleRequest) (*types.MsgRevokeRoleResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	vaultAddr := sdk.MustAccAddressFromBech32(msg.VaultAddress)
+	vault, err := k.getVault(ctx, vaultAddr)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get vault %s: %w", msg.VaultAddress, err)
+	}
+	if err := vault.ValidateAdmin(msg.Authority); err != nil {
+		return nil, fmt.Errorf("failed to validate admin: %w", err)
+	}
+
+	if msg.Role == types.VaultRole_VAULT_ROLE_GUARDIAN {
+		scheduled, err := k.scheduleIfTimelocked(ctx, vault, msg.Authority, msg)
+		if err != nil {
+			return nil, fmt.Errorf("failed to schedule role revocation: %w", err)
+		}
+		if scheduled {
+			return &types.MsgRevokeRoleResponse{}, nil
+		}
+	}
+
+	if err := k.RemoveRoleGrant(ctx, vault, msg.Role, msg.Address, msg.Authority); err != nil {
+		return nil, fmt.Errorf("failed to revoke role: %w", err)
+	}
+
+	return &types.MsgRevokeRoleResponse{}, nil
+}
+
+// ProposeAdminTransfer nominates a new admin for a vault. The nominee becomes admin only once
+// it signs an AcceptAdminTransfer. The nomination is held by the vault's timelock. Only the vault
+// admin is authorized to perform this operation.
+func (k msgServer) ProposeAdminTransfer(goCtx context.Context, msg *types.MsgProposeAdminTransferRequest) (*types.MsgProposeAdminTransferResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	vaultAddr := sdk.MustAccAddressFromBech32(msg.VaultAddress)
+	vault, err := k.getVault(ctx, vaultAddr)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get vault %s: %w", msg.VaultAddress, err)
+	}
+	if err := vault.ValidateAdmin(msg.Authority); err != nil {
+		return nil, fmt.Errorf("failed to validate admin: %w", err)
+	}
+
+	scheduled, err := k.scheduleIfTimelocked(ctx, vault, msg.Authority, msg)
+	if err != nil {
+		return nil, fmt.Errorf("failed to schedule admin transfer: %w", err)
+	}
+	if scheduled {
+		return &types.MsgProposeAdminTransferResponse{}, nil
+	}
+
+	if err := k.SetPendingAdminTransfer(ctx, vault, msg.NewAdmin, msg.ExpirationSeconds, msg.Authority); err != nil {
+		return nil, fmt.Errorf("failed to propose admin transfer: %w", err)
+	}
+
+	return &types.MsgProposeAdminTransferResponse{}, nil
+}
+
+// AcceptAdminTransfer completes a vault's pending admin transfer. Only the proposed admin is
+// authorized to perform this operation, and only before the proposal expires.
+func (k msgServer) AcceptAdminTransfer(goCtx context.Context, msg *types.MsgAcceptAdminTransferRequest) (*types.MsgAcceptAdminTransferResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	vaultAddr := sdk.MustAccAddressFromBech32(msg.VaultAddress)
+	vault, err := k.getVault(ctx, vaultAddr)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get vault %s: %w", msg.VaultAddress, err)
+	}
+
+	if err := k.CompleteAdminTransfer(ctx, vault, msg.NewAdmin); err != nil {
+		return nil, fmt.Errorf("failed to accept admin transfer: %w", err)
+	}
+
+	return &types.MsgAcceptAdminTransferResponse{}, nil
+}
+
+// CancelAdminTransfer withdraws a vault's pending admin transfer. Only the vault admin is
+// authorized to perform this operation.
+func (k msgServer) CancelAdminTransfer(goCtx context.Context, msg *types.MsgCancelAdminTransferRequest) (*types.MsgCancelAdminTransferResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	vaultAddr := sdk.MustAccAddressFromBech32(msg.VaultAddress)
+	vault, err := k.getVault(ctx, vaultAddr)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get vault %s: %w", msg.VaultAddress, err)
+	}
+	if err := vault.ValidateAdmin(msg.Authority); err != nil {
+		return nil, fmt.Errorf("failed to validate admin: %w", err)
+	}
+
+	if err := k.ClearPendingAdminTransfer(ctx, vault, msg.Authority); err != nil {
+		return nil, fmt.Errorf("failed to cancel admin transfer: %w", err)
+	}
+
+	return &types.MsgCancelAdminTransferResponse{}, nil
+}
+
+// RecoverVaultAdmin replaces a vault's admin without the current admin's signature, for
+// vaults whose admin key has been lost. Only the governance authority is authorized to
+// perform this operation.
+func (k msgServer) RecoverVaultAdmin(goCtx context.Context, msg *types.MsgRecoverVaultAdminRequest) (*types.MsgRecoverVaultAdminResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := k.validateGovAuthority(msg.Authority); err != nil {
+		return nil, err
+	}
+
+	vaultAddr := sdk.MustAccAddressFromBech32(msg.VaultAddress)
+	vault, err := k.getVault(ctx, vaultAddr)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get vault %s: %w", msg.VaultAddress, err)
+	}
+
+	if err := k.TransferVaultAdmin(ctx, vault, msg.NewAdmin, msg.Authority); err != nil {
+		return nil, fmt.Errorf("failed to recover vault admin: %w", err)
+	}
+
+	return &types.MsgRecoverVaultAdminResponse{}, nil
+}
+
+// UpdateTimelock sets the delay applied to a vault's sensitive configuration changes. Raising
+// the timelock takes effect immediately, while lowering or disabling it is itself held by the
+// current timelock. Only the vault admin is authorized to perform this operation.
+func (k msgServer) UpdateTimelock(goCtx context.Context, msg *types.MsgUpdateTimelockRequest) (*types.MsgUpdateTimelockResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	vaultAddr := sdk.MustAccAddressFromBech32(msg.VaultAddress)
+	vault, err := k.getVault(ctx, vaultAddr)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get vault %s: %w", msg.VaultAddress, err)
+	}
+	if err := vault.ValidateAdmin(msg.Authority); err != nil {
+		return nil, fmt.Errorf("failed to validate admin: %w", err)
+	}
+
+	if msg.TimelockSeconds < vault.TimelockSeconds {
+		scheduled, err := k.scheduleIfTimelocked(ctx, vault, msg.Authority, msg)
+		if err != nil {
+			return nil, fmt.Errorf("failed to schedule timelock update: %w", err)
+		}
+		if scheduled {
+			return &types.MsgUpdateTimelockResponse{}, nil
+		}
+	}
+
+	if err := k.SetTimelock(ctx, vault, msg.TimelockSeconds, msg.Authority); err != nil {
+		return nil, fmt.Errorf("failed to set timelock: %w", err)
+	}
+
+	return &types.MsgUpdateTimelockResponse{}, nil
+}
+
+// CancelScheduledAction discards a vault's scheduled action before it executes. The vault
+// admin and holders of the vault's guardian role are authorized to perform this operation.
+func (k msgServer) CancelScheduledAction(goCtx context.Context, msg *types.MsgCancelScheduledActionRequest) (*types.MsgCancelScheduledActionResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	vaultAddr := sdk.MustAccAddressFromBech32(msg.VaultAddress)
+	vault, err := k.getVault(ctx, vaultAddr)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get vault %s: %w", msg.VaultAddress, err)
+	}
+	if err := vault.ValidateAdmin(msg.Authority); err != nil {
+		if err := vault.ValidateRole(msg.Authority, types.VaultRole_VAULT_ROLE_GUARDIAN); err != nil {
+			return nil, fmt.Errorf("failed to validate admin or guardian: %w", err)
+		}
+	}
+
+	if err := k.RemoveScheduledAction(ctx, vault, msg.ActionId, msg.Authority); err != nil {
+		return nil, fmt.Errorf("failed to cancel scheduled action: %w", err)
+	}
+
+	return &types.MsgCancelScheduledActionResponse{}, nil
+}
+
+// UpdateEligibilityPolicy replaces the vault's investor eligibility policy, which gates
+// swap-ins and share transfers. Only the vault admin is authorized to perform this operation.
+func (k msgServer) UpdateEligibilityPolicy(goCtx context.Context, msg *types.MsgUpdateEligibilityPolicyRequest) (*types.MsgUpdateEligibilityPolicyResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	vaultAddr := sdk.MustAccAddressFromBech32(msg.VaultAddress)
+	vault, err := k.getVault(ctx, vaultAddr)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get vault %s: %w", msg.VaultAddress, err)
+	}
+	if err := vault.ValidateAdmin(msg.Authority); err != nil {
+		return nil, fmt.Errorf("failed to validate admin: %w", err)
+	}
+
+	if err := k.SetEligibilityPolicy(ctx, vault, msg.Policy, msg.Authority); err != nil {
+		return nil, fmt.Errorf("failed to set eligibility policy: %w", err)
+	}
+
+	return &types.MsgUpdateEligibilityPolicyResponse{}, nil
+}
+
+// UpdateAllowlist adds and removes addresses from the vault's investor allowlist. Only the
+// vault admin is authorized to perform this operation.
+func (k msgServer) UpdateAllowlist(goCtx context.Context, msg *types.MsgUpdateAllowlistRequest) (*types.MsgUpdateAllowlistResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	vaultAddr := sdk.MustAccAddressFromBech32(msg.VaultAddress)
+	vault, err := k.getVault(ctx, vaultAddr)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get vault %s: %w", msg.VaultAddress, err)
+	}
+	if err := vault.ValidateAdmin(msg.Authority); err != nil {
+		return nil, fmt.Errorf("failed to validate admin: %w", err)
+	}
+
+	if err := k.UpdateVaultAllowlist(ctx, vault, msg.Add, msg.Remove, msg.Authority); err != nil {
+		return nil, fmt.Errorf("failed to update allowlist: %w", err)
+	}
+
+	return &types.MsgUpdateAllowlistResponse{}, nil
+}
+
+// UpdatePositionCap sets the largest position a single account may build in the vault
+// through swap-ins, and the addresses exempt from it. Only a holder of the vault's limits
+// manager role is authorized to perform this operation.
+func (k msgServer) UpdatePositionCap(goCtx context.Context, msg *types.MsgUpdatePositionCapRequest) (*types.MsgUpdatePositionCapResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	vaultAddr := sdk.MustAccAddressFromBech32(msg.VaultAddress)
+	vault, err := k.getVault(ctx, vaultAddr)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get vault %s: %w", msg.VaultAddress, err)
+	}
+	if err := vault.ValidateRole(msg.Authority, types.VaultRole_VAULT_ROLE_LIMITS_MANAGER); err != nil {
+		return nil, fmt.Errorf("failed to validate limits manager role: %w", err)
+	}
+
+	if err := k.SetPositionCap(ctx, vault, msg.MaxPositionValue, msg.Exemptions, msg.Authority); err != nil {
+		return nil, fmt.Errorf("failed to set position cap: %w", err)
+	}
+
+	return &types.MsgUpdatePositionCapResponse{}, nil
+}
+
+// UpdateMaxTotalValue sets the net total value a vault may reach through swap-ins. Only a
+// holder of the vault's limits manager role is authorized to perform this operation.
+func (k msgServer) UpdateMaxTotalValue(goCtx context.Context, msg *types.MsgUpdateMaxTotalValueRequest) (*types.MsgUpdateMaxTotalValueResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	vaultAddr := sdk.MustAccAddressFromBech32(msg.VaultAddress)
+	vault, err := k.getVault(ctx, vaultAddr)
+	if err != nil {
+		return nil, err
+	}
+	if err := vault.ValidateRole(msg.Authority, types.VaultRole_VAULT_ROLE_LIMITS_MANAGER); err != nil {
+		return nil, fmt.Errorf("failed to validate limits manager role: %w", err)
+	}
+
+	if err := k.SetMaxTotalValue(ctx, vault, msg.MaxTotalValue, msg.Authority); err != nil {
+		return nil, fmt.Errorf("failed to set max total value: %w", err)
+	}
+
+	return &types.MsgUpdateMaxTotalValueResponse{}, nil
+}
+
+// UpdateCircuitBreaker trips or resets the module-wide circuit breaker. Only governance and
+// the circuit breaker guardian named in params are authorized to perform this operation.
+func (k msgServer) UpdateCircuitBreaker(goCtx context.Context, msg *types.MsgUpdateCircuitBreakerRequest) (*types.MsgUpdateCircuitBreakerResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	guardian := msg.Guardian
+	if msg.Authority != k.GetAuthorityString() {
+		params, err := k.Params.Get(ctx)
+		if err != nil || params.CircuitBreakerGuardian == "" || msg.Authority != params.CircuitBreakerGuardian {
+			return nil, fmt.Errorf("unauthorized: %s is neither the governance authority nor the circuit breaker guardian", msg.Authority)
+		}
+		if guardian != "" {
+			return nil, fmt.Errorf("unauthorized: only the governance authority may change the circuit breaker guardian")
+		}
+		guardian = params.CircuitBreakerGuardian
+	}
+
+	if err := k.SetCircuitBreaker(ctx, msg.CircuitBreaker, guardian, msg.Authority); err != nil {
+		return nil, fmt.Errorf("failed to set circuit breaker: %w", err)
+	}
+
+	return &types.MsgUpdateCircuitBreakerResponse{}, nil
+}
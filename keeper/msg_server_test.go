@@ -1071,6 +1071,7 @@ func (s *TestSuite) TestMsgServer_UpdateMinSwapInValue() {
 		vault, err := s.k.GetVault(s.ctx, vaultAddr)
 		s.Require().NoError(err, "unexpected error fetching vault for asset manager setup at address %s", vaultAddr.String())
 		vault.AssetManager = assetManager.String()
+		vault.SeedRoleGrants()
 		s.k.AuthKeeper.SetAccount(s.ctx, vault)
 	}
 
@@ -1220,6 +1221,7 @@ func (s *TestSuite) TestMsgServer_UpdateMinSwapOutValue() {
 		vault, err := s.k.GetVault(s.ctx, vaultAddr)
 		s.Require().NoError(err, "unexpected error fetching vault for asset manager setup at address %s", vaultAddr.String())
 		vault.AssetManager = assetManager.String()
+		vault.SeedRoleGrants()
 		s.k.AuthKeeper.SetAccount(s.ctx, vault)
 	}
 
@@ -1369,6 +1371,7 @@ func (s *TestSuite) TestMsgServer_UpdateMaxSwapInValue() {
 		vault, err := s.k.GetVault(s.ctx, vaultAddr)
 		s.Require().NoError(err, "unexpected error fetching vault for asset manager setup at address %s", vaultAddr.String())
 		vault.AssetManager = assetManager.String()
+		vault.SeedRoleGrants()
 		s.k.AuthKeeper.SetAccount(s.ctx, vault)
 	}
 
@@ -1518,6 +1521,7 @@ func (s *TestSuite) TestMsgServer_UpdateMaxSwapOutValue() {
 		vault, err := s.k.GetVault(s.ctx, vaultAddr)
 		s.Require().NoError(err, "unexpected error fetching vault for asset manager setup at address %s", vaultAddr.String())
 		vault.AssetManager = assetManager.String()
+		vault.SeedRoleGrants()
 		s.k.AuthKeeper.SetAccount(s.ctx, vault)
 	}
 
@@ -2111,6 +2115,7 @@ func (s *TestSuite) TestMsgServer_UpdateInterestRate() {
 				v, err := s.k.GetVault(s.ctx, vaultAddr)
 				s.Require().NoError(err, "failed to get vault %s", vaultAddr)
 				v.AssetManager = assetMgr.String()
+				v.SeedRoleGrants()
 				s.Require().NoError(s.k.SetVaultAccount(s.ctx, v), "failed to set vault account with asset manager %s", assetMgr)
 			},
 			postCheckArgs: postCheckArgs{
@@ -2632,6 +2637,7 @@ func (s *TestSuite) TestMsgServer_UpdateWithdrawalDelay() {
 		v, err := s.k.GetVault(s.ctx, vaultAddr)
 		s.Require().NoError(err, "setup: should load vault")
 		v.AssetManager = assetMgr.String()
+		v.SeedRoleGrants()
 		s.k.AuthKeeper.SetAccount(s.ctx, v)
 
 		s.ctx = s.ctx.WithEventManager(sdk.NewEventManager())
@@ -6062,7 +6068,7 @@ func (s *TestSuite) TestMsgServer_UpdateVaultNAV_Failures() {
 				Price:        sdk.NewInt64Coin(underlying, 100),
 				Volume:       sdkmath.NewInt(1),
 			},
-			expectedErrSubstrs: []string{"unauthorized", "is not the vault NAV authority"},
+			expectedErrSubstrs: []string{"unauthorized", "does not hold role VAULT_ROLE_NAV_AUTHORITY"},
 		},
 		{
 			name:  "rejects vault share denom",
@@ -6420,7 +6426,7 @@ func (s *TestSuite) TestMsgServer_RemoveVaultNAV() {
 				VaultAddress: vaultAddr.String(),
 				Denom:        navDenom,
 			},
-			expectedErrSubstrs: []string{"failed to validate NAV authority", "is not the vault NAV authority"},
+			expectedErrSubstrs: []string{"failed to validate NAV authority role", "does not hold role VAULT_ROLE_NAV_AUTHORITY"},
 		},
 		{
 			name: "denom with no NAV entry cannot be removed",
@@ -6519,6 +6525,8 @@ func (s *TestSuite) TestMsgServer_UpdateNAVAuthority() {
 			s.Require().NoError(err, "post-check: failed to get vault")
 			s.Assert().Equal(expectedAuthority, vault.NavAuthority, "post-check: nav authority mismatch")
 			s.Assert().Equal(expectedAuthority, vault.GetNAVAuthority(), "post-check: resolved nav authority mismatch")
+			s.Assert().True(vault.HasRole(expectedAuthority, types.VaultRole_VAULT_ROLE_NAV_AUTHORITY), "post-check: new nav authority should hold the NAV authority role")
+			s.Assert().False(vault.HasRole(admin.String(), types.VaultRole_VAULT_ROLE_NAV_AUTHORITY), "post-check: previous nav authority should lose the NAV authority role")
 		},
 	}
 
@@ -6534,6 +6542,18 @@ func (s *TestSuite) TestMsgServer_UpdateNAVAuthority() {
 		},
 		postCheckArgs: newAuthority.String(),
 		expectedEvents: sdk.Events{
+			sdk.NewEvent("provlabs.vault.v1.EventRoleRevoked",
+				sdk.NewAttribute("address", admin.String()),
+				sdk.NewAttribute("authority", admin.String()),
+				sdk.NewAttribute("role", types.VaultRole_VAULT_ROLE_NAV_AUTHORITY.String()),
+				sdk.NewAttribute("vault_address", vaultAddr.String()),
+			),
+			sdk.NewEvent("provlabs.vault.v1.EventRoleGranted",
+				sdk.NewAttribute("address", newAuthority.String()),
+				sdk.NewAttribute("authority", admin.String()),
+				sdk.NewAttribute("role", types.VaultRole_VAULT_ROLE_NAV_AUTHORITY.String()),
+				sdk.NewAttribute("vault_address", vaultAddr.String()),
+			),
 			sdk.NewEvent("provlabs.vault.v1.EventNAVAuthorityUpdated",
 				sdk.NewAttribute("admin", admin.String()),
 				sdk.NewAttribute("new_authority", newAuthority.String()),
@@ -6625,7 +6645,7 @@ func (s *TestSuite) TestMsgServer_UpdateNAVAuthority_RotationChangesNAVAuthority
 		Volume:       sdkmath.NewInt(1),
 	})
 	s.Require().Error(err, "former admin should no longer be the nav authority")
-	s.Assert().Contains(err.Error(), "is not the vault NAV authority", "unexpected error for stale authority")
+	s.Assert().Contains(err.Error(), "does not hold role VAULT_ROLE_NAV_AUTHORITY", "unexpected error for stale authority")
 
 	_, err = msgServer.UpdateVaultNAV(s.ctx, &types.MsgUpdateVaultNAVRequest{
 		Signer:       oracle.String(),
@@ -6683,7 +6703,7 @@ func (s *TestSuite) TestMsgServer_UpdateNAVAuthority_ResetToEmptyFallsBackToAdmi
 		Volume:       sdkmath.NewInt(1),
 	})
 	s.Require().Error(err, "the former delegated authority should lose NAV update rights after the reset")
-	s.Assert().Contains(err.Error(), "is not the vault NAV authority", "unexpected error for the former authority")
+	s.Assert().Contains(err.Error(), "does not hold role VAULT_ROLE_NAV_AUTHORITY", "unexpected error for the former authority")
 
 	_, err = msgServer.UpdateVaultNAV(s.ctx, &types.MsgUpdateVaultNAVRequest{
 		Signer:       admin.String(),
@@ -6779,7 +6799,7 @@ func (s *TestSuite) TestMsgServer_AcceptAsset_Inbound() {
 	s.assertBalance(vaultAddr, asset, sdkmath.NewInt(0))
 	s.assertBalance(vaultAddr, underlying, sdkmath.NewInt(0))
 
-	s.requireTypedEventEmitted(types.NewEventAssetAccepted(vaultAddr.String(), source.String(), externalID, sourceAmount, targetAmount, types.AssetDirectionInbound))
+	s.requireTypedEventEmitted(types.NewEventAssetAccepted(vaultAddr.String(), source.String(), externalID, sourceAmount, targetAmount, types.AssetDirectionInbound, sdk.NewDecCoin(underlying, sdkmath.NewInt(5)), sdkmath.LegacyZeroDec(), false))
 }
 
 func (s *TestSuite) TestMsgServer_AcceptAsset_Outbound() {
@@ -6819,7 +6839,7 @@ func (s *TestSuite) TestMsgServer_AcceptAsset_Outbound() {
 	s.assertBalance(vaultAddr, asset, sdkmath.NewInt(0))
 	s.assertBalance(vaultAddr, underlying, sdkmath.NewInt(0))
 
-	s.requireTypedEventEmitted(types.NewEventAssetAccepted(vaultAddr.String(), source.String(), externalID, sourceAmount, targetAmount, types.AssetDirectionOutbound))
+	s.requireTypedEventEmitted(types.NewEventAssetAccepted(vaultAddr.String(), source.String(), externalID, sourceAmount, targetAmount, types.AssetDirectionOutbound, sdk.NewDecCoin(underlying, sdkmath.NewInt(5)), sdkmath.LegacyZeroDec(), false))
 
 	s.requireTypedEventEmitted(types.NewEventNAVRemoved(vaultAddr.String(), seededNAV, ""))
 	_, err = s.k.GetVaultNAV(s.ctx, vaultAddr, asset)
@@ -6863,7 +6883,7 @@ func (s *TestSuite) TestMsgServer_AcceptAsset_ZeroPriceOutbound() {
 	s.assertBalance(vaultAddr, asset, sdkmath.NewInt(0))
 	s.assertBalance(vaultAddr, underlying, sdkmath.NewInt(0))
 
-	s.requireTypedEventEmitted(types.NewEventAssetAccepted(vaultAddr.String(), source.String(), externalID, sourceAmount, targetAmount, types.AssetDirectionOutbound))
+	s.requireTypedEventEmitted(types.NewEventAssetAccepted(vaultAddr.String(), source.String(), externalID, sourceAmount, targetAmount, types.AssetDirectionOutbound, sdk.NewDecCoin(underlying, sdkmath.NewInt(0)), sdkmath.LegacyZeroDec(), false))
 
 	s.requireTypedEventEmitted(types.NewEventNAVRemoved(vaultAddr.String(), seededNAV, ""))
 	_, err = s.k.GetVaultNAV(s.ctx, vaultAddr, asset)
@@ -6905,7 +6925,7 @@ func (s *TestSuite) TestMsgServer_AcceptAsset_ZeroPriceInbound() {
 	s.assertBalance(vaultAddr, asset, sdkmath.NewInt(0))
 	s.assertBalance(vaultAddr, underlying, sdkmath.NewInt(0))
 
-	s.requireTypedEventEmitted(types.NewEventAssetAccepted(vaultAddr.String(), source.String(), externalID, sourceAmount, targetAmount, types.AssetDirectionInbound))
+	s.requireTypedEventEmitted(types.NewEventAssetAccepted(vaultAddr.String(), source.String(), externalID, sourceAmount, targetAmount, types.AssetDirectionInbound, sdk.NewDecCoin(underlying, sdkmath.NewInt(0)), sdkmath.LegacyZeroDec(), false))
 
 	stored, err := s.k.GetVaultNAV(s.ctx, vaultAddr, asset)
 	s.Require().NoError(err, "NAV entry for %s should exist after the zero-price inbound settlement", asset)
@@ -7007,7 +7027,7 @@ func (s *TestSuite) TestMsgServer_AcceptAsset_RestrictedMarker() {
 			s.assertBalance(vaultAddr, restrictedDenom, sdkmath.NewInt(0))
 			s.assertBalance(vaultAddr, underlying, sdkmath.NewInt(0))
 
-			s.requireTypedEventEmitted(types.NewEventAssetAccepted(vaultAddr.String(), source.String(), externalID, sourceAmount, targetAmount, types.AssetDirectionInbound))
+			s.requireTypedEventEmitted(types.NewEventAssetAccepted(vaultAddr.String(), source.String(), externalID, sourceAmount, targetAmount, types.AssetDirectionInbound, sdk.NewDecCoin(underlying, sdkmath.NewInt(5)), sdkmath.LegacyZeroDec(), false))
 		})
 	}
 }
@@ -7055,7 +7075,7 @@ func (s *TestSuite) TestMsgServer_AcceptAsset_Failures() {
 					ExternalId:   "unauth",
 				}
 			},
-			expectedErrSubstrs: []string{"failed to validate asset manager authority", "unauthorized authority"},
+			expectedErrSubstrs: []string{"failed to validate settlement manager role", "unauthorized authority"},
 		},
 		{
 			name: "admin cannot settle, only the asset manager may",
@@ -7076,7 +7096,7 @@ func (s *TestSuite) TestMsgServer_AcceptAsset_Failures() {
 					ExternalId:   "admin-settle",
 				}
 			},
-			expectedErrSubstrs: []string{"failed to validate asset manager authority", "unauthorized authority"},
+			expectedErrSubstrs: []string{"failed to validate settlement manager role", "unauthorized authority"},
 		},
 		{
 			name: "vault without an asset manager cannot settle",
@@ -7104,7 +7124,7 @@ func (s *TestSuite) TestMsgServer_AcceptAsset_Failures() {
 					ExternalId:   "no-mgr",
 				}
 			},
-			expectedErrSubstrs: []string{"failed to validate asset manager authority", "no asset manager set"},
+			expectedErrSubstrs: []string{"failed to validate settlement manager role", "does not hold role VAULT_ROLE_SETTLEMENT_MANAGER"},
 		},
 		{
 			name: "payment not found",
@@ -7357,19 +7377,20 @@ func (s *TestSuite) TestMsgServer_AcceptAsset_NAVGuardrail() {
 			targetAmount:  sdk.NewCoins(sdk.NewInt64Coin(underlying, 6)),
 		},
 		{
-			name:               "asset leg with multiple coins cannot be priced and is rejected",
+			name:               "asset leg bundling a coin without a NAV entry is rejected",
+			seedNav:            &types.VaultNAV{Denom: asset, Price: sdk.NewInt64Coin(underlying, 5), Volume: sdkmath.NewInt(10)},
 			fundSource:         sdk.NewCoins(sdk.NewInt64Coin(asset, 10), sdk.NewInt64Coin("othercoin", 5)),
 			fundPrincipal:      sdk.NewCoins(sdk.NewInt64Coin(underlying, 5)),
 			sourceAmount:       sdk.NewCoins(sdk.NewInt64Coin(asset, 10), sdk.NewInt64Coin("othercoin", 5)),
 			targetAmount:       sdk.NewCoins(sdk.NewInt64Coin(underlying, 5)),
-			expectedErrSubstrs: []string{"one asset coin"},
+			expectedErrSubstrs: []string{"has no internal NAV entry", "othercoin"},
 		},
 		{
 			name:               "empty asset leg cannot be priced and is rejected",
 			fundPrincipal:      sdk.NewCoins(sdk.NewInt64Coin(underlying, 5)),
 			sourceAmount:       sdk.NewCoins(),
 			targetAmount:       sdk.NewCoins(sdk.NewInt64Coin(underlying, 5)),
-			expectedErrSubstrs: []string{"one asset coin"},
+			expectedErrSubstrs: []string{"at least one asset coin"},
 		},
 	}
 
@@ -7467,7 +7488,7 @@ func (s *TestSuite) TestMsgServer_AcceptAsset_RequiresNAVAuthorityPriceFirst() {
 	managerPriceMsg := *priceMsg
 	managerPriceMsg.Signer = s.assetManagerAddr.String()
 	_, err = msgServer.UpdateVaultNAV(s.ctx, &managerPriceMsg)
-	s.Require().ErrorContains(err, "is not the vault NAV authority", "the asset manager must not be able to price %s itself", asset)
+	s.Require().ErrorContains(err, "does not hold role VAULT_ROLE_NAV_AUTHORITY", "the asset manager must not be able to price %s itself", asset)
 
 	tvvBeforePricing, err := s.k.GetTVV(s.ctx, *vault)
 	s.Require().NoError(err, "failed to read TVV before pricing %s", asset)
@@ -7942,7 +7963,7 @@ func (s *TestSuite) TestMsgServer_RejectAsset_Failures() {
 					ExternalId:   "unauth",
 				}
 			},
-			expectedErrSubstrs: []string{"failed to validate asset manager authority", "unauthorized authority"},
+			expectedErrSubstrs: []string{"failed to validate settlement manager role", "unauthorized authority"},
 		},
 		{
 			name: "admin cannot reject, only the asset manager may",
@@ -7962,7 +7983,7 @@ func (s *TestSuite) TestMsgServer_RejectAsset_Failures() {
 					ExternalId:   "admin-reject",
 				}
 			},
-			expectedErrSubstrs: []string{"failed to validate asset manager authority", "unauthorized authority"},
+			expectedErrSubstrs: []string{"failed to validate settlement manager role", "unauthorized authority"},
 		},
 		{
 			name: "vault without an asset manager cannot reject",
@@ -7989,7 +8010,7 @@ func (s *TestSuite) TestMsgServer_RejectAsset_Failures() {
 					ExternalId:   "no-mgr",
 				}
 			},
-			expectedErrSubstrs: []string{"failed to validate asset manager authority", "no asset manager set"},
+			expectedErrSubstrs: []string{"failed to validate settlement manager role", "does not hold role VAULT_ROLE_SETTLEMENT_MANAGER"},
 		},
 		{
 			name: "payment does not exist",
@@ -3,10 +3,13 @@ package keeper
 import (
 	"errors"
 	"fmt"
+	"math/big"
+	"strings"
 
 	"github.com/provlabs/vault/types"
 
 	"cosmossdk.io/collections"
+	"cosmossdk.io/math"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
@@ -68,22 +71,25 @@ func validateVaultNAVFields(vault *types.VaultAccount, nav types.VaultNAV) error
 // contributes nothing to total vault value until the asset arrives at the principal
 // marker (see GetTVV). The nav argument supplies the denom, price, volume, and
 // source; the updated block height and time are stamped from ctx before the entry
-// is stored.
+// is stored and appended to the vault's NAV history.
 //
 // The denom may not be the vault's share denom, whose value is derived from
 // the vault's total holdings rather than set externally. The denom must also
 // name an asset that exists on-chain: a registered marker, or for a metadata
-// value-owner denom (nft/<scope-id>) an existing metadata scope.
+// value-owner denom (nft/<scope-id>) an existing metadata scope. A denom the vault
+// does not hold must be permitted by the vault's acquisition policy.
 // The price must be a valid coin denominated in the vault's underlying asset.
 // Its amount may be zero so the authority can write a worthless held asset
 // down to zero. The volume must be positive.
 //
 // This method does NOT verify that signer is authorized to mutate the vault's
 // NAV table; signer is recorded for event attribution only. Callers must run
-// vault.ValidateNAVAuthority (or an equivalent check) before invoking it.
+// vault.ValidateRole with types.VaultRole_VAULT_ROLE_NAV_AUTHORITY before invoking it.
 //
 // An EventNAVUpdated event is emitted with signer recorded as the NAV authority
-// that performed the update.
+// that performed the update. When the new price leaves the vault's holdings above one of
+// its concentration limits with a higher weight than before, an
+// EventConcentrationLimitBreached warning follows; the update still applies.
 func (k *Keeper) SetVaultNAV(ctx sdk.Context, vault *types.VaultAccount, nav types.VaultNAV, signer string) error {
 	if err := validateVaultNAVFields(vault, nav); err != nil {
 		return err
@@ -91,16 +97,36 @@ func (k *Keeper) SetVaultNAV(ctx sdk.Context, vault *types.VaultAccount, nav typ
 	if err := k.requireNAVDenomRegistered(ctx, nav.Denom); err != nil {
 		return err
 	}
+	if err := k.requireNAVAcquisitionPermitted(ctx, vault, nav.Denom); err != nil {
+		return err
+	}
+	before, err := k.concentrationSnapshot(ctx, vault)
+	if err != nil {
+		return err
+	}
+
+	var previous *types.VaultNAV
+	if current, err := k.GetVaultNAV(ctx, vault.GetAddress(), nav.Denom); err == nil {
+		previous = &current
+	} else if !errors.Is(err, collections.ErrNotFound) {
+		return fmt.Errorf("failed to get internal NAV for denom %q on vault %s: %w", nav.Denom, vault.Address, err)
+	}
 
 	nav.UpdatedBlockHeight = ctx.BlockHeight()
 	nav.UpdatedTime = ctx.BlockTime().UTC()
 	if err := k.NAVs.Set(ctx, collections.Join(vault.GetAddress(), nav.Denom), nav); err != nil {
 		return fmt.Errorf("failed to store vault NAV: %w", err)
 	}
+	if err := k.updateNAVReference(ctx, vault, previous, nav); err != nil {
+		return err
+	}
+	if err := k.recordNAVHistory(ctx, vault.GetAddress(), nav); err != nil {
+		return err
+	}
 
 	k.emitEvent(ctx, types.NewEventNAVUpdated(vault.Address, nav, signer))
 
-	return nil
+	return k.emitConcentrationWarnings(ctx, vault, before)
 }
 
 // GetVaultNAV returns the internal NAV entry for the given vault address and
@@ -109,10 +135,19 @@ func (k *Keeper) GetVaultNAV(ctx sdk.Context, vaultAddr sdk.AccAddress, denom st
 	return k.NAVs.Get(ctx, collections.Join(vaultAddr, denom))
 }
 
-// checkSettlementNAVGuardrail requires an asset settlement to trade exactly at the
-// vault's internal NAV entry for the asset denom, so the manager cannot settle at
-// off-NAV prices. Equality is checked by cross-multiplication
-// (assetAmount * navPrice == paymentAmount * navVolume) to avoid rounding.
+// checkSettlementNAVGuardrail requires an asset settlement to trade within the vault's
+// settlement_tolerance_bips of its internal NAV entries for the asset denoms, so the
+// manager cannot settle at off-NAV prices. Where the vault's NAV sources have met their
+// quorum for a denom, their aggregate stands in for the entry, as it does in valuation
+// (see internalNAV). Each asset coin is valued against its own entry, and the payment
+// coin may deviate from the sum of those NAV-implied values by at
+// most the tolerance, in basis points of that sum. With no tolerance the two must be
+// equal. The sum is kept as an exact fraction, so a single coin at zero tolerance reduces
+// to the cross-multiplication assetAmount * navPrice == paymentAmount * navVolume, and a
+// bundle cannot round its way off NAV. As with the single-coin cross-multiplication, a
+// settlement whose asset amount times NAV price, or payment amount times NAV volume,
+// overflows 256 bits is rejected. It returns the NAV-implied value, truncated to decimal
+// precision.
 //
 // A denom with no entry is rejected rather than waved through. The NAV authority must
 // price a denom before the asset manager can trade it, so the manager cannot mint a
@@ -123,34 +158,61 @@ func (k *Keeper) GetVaultNAV(ctx sdk.Context, vaultAddr sdk.AccAddress, denom st
 // (see GetTVV). Pricing first and settling second is therefore the acquisition path,
 // and both messages can ride in a single transaction so the authority's price and the
 // manager's settlement commit atomically.
-func (k *Keeper) checkSettlementNAVGuardrail(ctx sdk.Context, vault *types.VaultAccount, assetCoin, paymentCoin sdk.Coin) error {
-	nav, err := k.GetVaultNAV(ctx, vault.GetAddress(), assetCoin.Denom)
-	if err != nil {
-		if errors.Is(err, collections.ErrNotFound) {
-			return fmt.Errorf("denom %q has no internal NAV entry on vault %s: the NAV authority must price it before it can be settled", assetCoin.Denom, vault.Address)
+func (k *Keeper) checkSettlementNAVGuardrail(ctx sdk.Context, vault *types.VaultAccount, assetCoins sdk.Coins, paymentCoin sdk.Coin) (math.LegacyDec, error) {
+	navValue := new(big.Rat)
+	navPrices := make([]string, 0, len(assetCoins))
+	for _, assetCoin := range assetCoins {
+		nav, found, err := k.internalNAV(ctx, *vault, assetCoin.Denom)
+		if err != nil {
+			return math.LegacyDec{}, err
+		}
+		if !found {
+			return math.LegacyDec{}, fmt.Errorf("denom %q has no internal NAV entry on vault %s: the NAV authority must price it before it can be settled", assetCoin.Denom, vault.Address)
 		}
-		return fmt.Errorf("failed to get internal NAV for denom %q on vault %s: %w", assetCoin.Denom, vault.Address, err)
-	}
 
-	if nav.Price.Denom != paymentCoin.Denom {
-		return fmt.Errorf("settlement of %s for %s is priced in %q but internal NAV for %q on vault %s is priced in %q",
-			assetCoin, paymentCoin, paymentCoin.Denom, assetCoin.Denom, vault.Address, nav.Price.Denom)
-	}
+		if nav.Price.Denom != paymentCoin.Denom {
+			return math.LegacyDec{}, fmt.Errorf("settlement of %s for %s is priced in %q but internal NAV for %q on vault %s is priced in %q",
+				assetCoins, paymentCoin, paymentCoin.Denom, assetCoin.Denom, vault.Address, nav.Price.Denom)
+		}
+		if !nav.Volume.IsPositive() {
+			return math.LegacyDec{}, fmt.Errorf("internal NAV for %q on vault %s has non-positive volume %s", assetCoin.Denom, vault.Address, nav.Volume)
+		}
 
-	assetValue, err := assetCoin.Amount.SafeMul(nav.Price.Amount)
-	if err != nil {
-		return fmt.Errorf("failed to multiply settlement asset amount %s by NAV price %s: %w", assetCoin.Amount, nav.Price.Amount, err)
-	}
-	paymentValue, err := paymentCoin.Amount.SafeMul(nav.Volume)
-	if err != nil {
-		return fmt.Errorf("failed to multiply settlement payment amount %s by NAV volume %s: %w", paymentCoin.Amount, nav.Volume, err)
+		assetValue, err := assetCoin.Amount.SafeMul(nav.Price.Amount)
+		if err != nil {
+			return math.LegacyDec{}, fmt.Errorf("failed to multiply settlement asset amount %s by NAV price %s: %w", assetCoin.Amount, nav.Price.Amount, err)
+		}
+		if _, err := paymentCoin.Amount.SafeMul(nav.Volume); err != nil {
+			return math.LegacyDec{}, fmt.Errorf("failed to multiply settlement payment amount %s by NAV volume %s: %w", paymentCoin.Amount, nav.Volume, err)
+		}
+		navValue.Add(navValue, new(big.Rat).SetFrac(assetValue.BigInt(), nav.Volume.BigInt()))
+		navPrices = append(navPrices, fmt.Sprintf("%s per %s%s", nav.Price, nav.Volume, assetCoin.Denom))
 	}
-	if !assetValue.Equal(paymentValue) {
-		return fmt.Errorf("settlement of %s for %s does not match internal NAV of %s per %s%s on vault %s",
-			assetCoin, paymentCoin, nav.Price, nav.Volume, assetCoin.Denom, vault.Address)
+
+	deviation := new(big.Rat).Sub(new(big.Rat).SetInt(paymentCoin.Amount.BigInt()), navValue)
+	deviation.Abs(deviation).Mul(deviation, big.NewRat(10_000, 1))
+	band := new(big.Rat).Mul(navValue, big.NewRat(int64(vault.SettlementToleranceBips), 1))
+	if deviation.Cmp(band) > 0 {
+		if vault.SettlementToleranceBips == 0 {
+			return math.LegacyDec{}, fmt.Errorf("settlement of %s for %s does not match internal NAV of %s on vault %s",
+				assetCoins, paymentCoin, strings.Join(navPrices, ", "), vault.Address)
+		}
+		return math.LegacyDec{}, fmt.Errorf("settlement of %s for %s is more than %d bips from internal NAV of %s on vault %s",
+			assetCoins, paymentCoin, vault.SettlementToleranceBips, strings.Join(navPrices, ", "), vault.Address)
 	}
 
-	return nil
+	return ratToLegacyDec(navValue)
+}
+
+// ratToLegacyDec converts r to a decimal truncated to LegacyDec precision. It returns an
+// error when the value exceeds the LegacyDec range.
+func ratToLegacyDec(r *big.Rat) (math.LegacyDec, error) {
+	num := new(big.Int).Exp(big.NewInt(10), big.NewInt(math.LegacyPrecision), nil)
+	num.Mul(num, r.Num()).Quo(num, r.Denom())
+	if num.BitLen() > math.MaxBitLen {
+		return math.LegacyDec{}, fmt.Errorf("value %s exceeds the decimal range", r.FloatString(math.LegacyPrecision))
+	}
+	return math.LegacyNewDecFromBigIntWithPrec(num, math.LegacyPrecision), nil
 }
 
 // RemoveVaultNAV deletes the internal net asset value entry for a denom on the
@@ -162,7 +224,10 @@ func (k *Keeper) checkSettlementNAVGuardrail(ctx sdk.Context, vault *types.Vault
 // once it has drained the vault's last unit of a denom, and the NAV authority,
 // which revokes a price it set for a denom the vault never acquired. The
 // signer is recorded on the event for attribution and is empty for the
-// protocol-initiated settlement removal.
+// protocol-initiated settlement removal. Any NAV proposal still pending for the
+// denom, and every NAV source submission for it, is discarded with the entry. The
+// removal is recorded in the NAV history as a point with a zero price and volume, so
+// the series does not end on a price the vault no longer stands behind.
 //
 // This method does NOT verify that signer is authorized to mutate the vault's
 // NAV table, nor that the vault has stopped holding the denom; callers own both
@@ -175,6 +240,25 @@ func (k *Keeper) RemoveVaultNAV(ctx sdk.Context, vault *types.VaultAccount, deno
 	if err := k.NAVs.Remove(ctx, collections.Join(vault.GetAddress(), denom)); err != nil {
 		return fmt.Errorf("failed to remove internal NAV for denom %q on vault %s: %w", denom, vault.Address, err)
 	}
+	if err := k.NAVReferences.Remove(ctx, collections.Join(vault.GetAddress(), denom)); err != nil {
+		return fmt.Errorf("failed to remove NAV reference for denom %q on vault %s: %w", denom, vault.Address, err)
+	}
+	if err := k.removePendingNAVProposal(ctx, vault, denom); err != nil {
+		return err
+	}
+	if err := k.removeNAVSourceSubmissions(ctx, vault, denom); err != nil {
+		return err
+	}
+	if err := k.recordNAVHistory(ctx, vault.GetAddress(), types.VaultNAV{
+		Denom:              denom,
+		Price:              sdk.NewInt64Coin(nav.Price.Denom, 0),
+		Volume:             math.ZeroInt(),
+		Source:             nav.Source,
+		UpdatedBlockHeight: ctx.BlockHeight(),
+		UpdatedTime:        ctx.BlockTime().UTC(),
+	}); err != nil {
+		return err
+	}
 	k.emitEvent(ctx, types.NewEventNAVRemoved(vault.Address, nav, signer))
 	return nil
 }
@@ -184,13 +268,18 @@ func (k *Keeper) RemoveVaultNAV(ctx sdk.Context, vault *types.VaultAccount, deno
 // to perform this rotation (typically via vault.ValidateAdmin); signer is
 // recorded on the emitted EventNAVAuthorityUpdated for attribution only.
 //
+// The NAV authority role moves from the previous NAV authority to the new one, which is
+// the admin when newAuthority is empty.
+//
 // When newAuthority equals the current vault.NavAuthority this is a no-op: the
 // vault is left unchanged and no event is emitted.
 func (k *Keeper) SetNAVAuthority(ctx sdk.Context, vault *types.VaultAccount, newAuthority, signer string) error {
 	if vault.NavAuthority == newAuthority {
 		return nil
 	}
+	previous := vault.GetNAVAuthority()
 	vault.NavAuthority = newAuthority
+	k.moveRoles(ctx, vault, []types.VaultRole{types.VaultRole_VAULT_ROLE_NAV_AUTHORITY}, previous, vault.GetNAVAuthority(), signer)
 	if err := k.SetVaultAccount(ctx, vault); err != nil {
 		return fmt.Errorf("failed to set vault account: %w", err)
 	}
@@ -0,0 +1,109 @@
+package keeper_test
+
+import (
+	"github.com/provlabs/vault/keeper"
+	"github.com/provlabs/vault/types"
+
+	"cosmossdk.io/collections"
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func (s *TestSuite) TestMsgServer_BatchUpdateVaultNAV() {
+	underlying, share, held := "under", "vshare", "heldcoin"
+	update := func(denom string, price, volume int64) types.NAVUpdate {
+		return types.NAVUpdate{Denom: denom, Price: sdk.NewInt64Coin(underlying, price), Volume: sdkmath.NewInt(volume), Source: "batch"}
+	}
+
+	tests := []struct {
+		name          string
+		signer        func() string
+		maxBips       uint32
+		updates       []types.NAVUpdate
+		expectErr     string
+		expectUpdated []string
+		expectPending []string
+	}{
+		{
+			name:          "every entry is written",
+			signer:        func() string { return s.adminAddr.String() },
+			updates:       []types.NAVUpdate{update(held, 2, 1), update("loancoin", 3, 2)},
+			expectUpdated: []string{held, "loancoin"},
+		},
+		{
+			name:          "entry over the change limit is held while the rest apply",
+			signer:        func() string { return s.adminAddr.String() },
+			maxBips:       1_000,
+			updates:       []types.NAVUpdate{update(held, 2, 1), update("loancoin", 3, 2)},
+			expectUpdated: []string{"loancoin"},
+			expectPending: []string{held},
+		},
+		{
+			name:      "one unregistered denom rejects the whole batch",
+			signer:    func() string { return s.adminAddr.String() },
+			updates:   []types.NAVUpdate{update("loancoin", 3, 2), update("nosuchcoin", 1, 1)},
+			expectErr: "is not a registered marker",
+		},
+		{
+			name:      "share denom cannot be priced",
+			signer:    func() string { return s.adminAddr.String() },
+			updates:   []types.NAVUpdate{update(share, 1, 1)},
+			expectErr: "cannot set NAV for vault share denom",
+		},
+		{
+			name:      "non NAV authority is rejected",
+			signer:    func() string { return s.assetManagerAddr.String() },
+			updates:   []types.NAVUpdate{update(held, 2, 1)},
+			expectErr: "failed to validate NAV authority role",
+		},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+			vault := s.setupNAVChangeLimitVault(underlying, share, held, tc.maxBips)
+			s.requireSimpleMarker("loancoin")
+			s.ctx = s.ctx.WithEventManager(sdk.NewEventManager())
+
+			resp, err := keeper.NewMsgServer(s.simApp.VaultKeeper).BatchUpdateVaultNAV(s.ctx, &types.MsgBatchUpdateVaultNAVRequest{
+				Signer:       tc.signer(),
+				VaultAddress: vault.Address,
+				Updates:      tc.updates,
+			})
+			if tc.expectErr != "" {
+				s.Require().ErrorContains(err, tc.expectErr, "BatchUpdateVaultNAV error")
+				has, err := s.k.NAVs.Has(s.ctx, collections.Join(vault.GetAddress(), "loancoin"))
+				s.Require().NoError(err, "NAVs.Has should not error")
+				s.Assert().False(has, "no entry of a rejected batch should be written")
+				return
+			}
+			s.Require().NoError(err, "BatchUpdateVaultNAV should succeed")
+			s.Assert().Equal(tc.expectPending, resp.PendingDenoms, "pending denoms in response")
+
+			for _, u := range tc.updates {
+				nav, err := s.k.GetVaultNAV(s.ctx, vault.GetAddress(), u.Denom)
+				pending, perr := s.k.PendingNAVProposals.Has(s.ctx, collections.Join(vault.GetAddress(), u.Denom))
+				s.Require().NoError(perr, "PendingNAVProposals.Has should not error")
+				if pending {
+					s.Require().NoError(err, "held denom %s should keep its entry", u.Denom)
+					s.Assert().Equal(sdk.NewInt64Coin(underlying, 1), nav.Price, "held denom %s price should be unchanged", u.Denom)
+					continue
+				}
+				s.Require().NoError(err, "denom %s should have an entry", u.Denom)
+				s.Assert().Equal(u.Price, nav.Price, "denom %s price", u.Denom)
+				s.Assert().Equal(u.Volume, nav.Volume, "denom %s volume", u.Denom)
+				s.Assert().Equal(u.Source, nav.Source, "denom %s source", u.Denom)
+			}
+
+			navUpdated := 0
+			for _, ev := range s.ctx.EventManager().Events() {
+				if ev.Type == "provlabs.vault.v1.EventNAVUpdated" {
+					navUpdated++
+				}
+			}
+			s.Assert().Equal(len(tc.expectUpdated), navUpdated, "number of EventNAVUpdated events")
+			s.requireTypedEventEmitted(types.NewEventVaultNAVBatchUpdated(vault.Address, tc.signer(), tc.expectUpdated, tc.expectPending))
+		})
+	}
+}
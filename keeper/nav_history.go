@@ -0,0 +1,169 @@
+package keeper
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/provlabs/vault/types"
+
+	"cosmossdk.io/collections"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+)
+
+// navHistoryRetention returns the module's NAV history retention in seconds, falling back to
+// the default when params have not been stored.
+func (k Keeper) navHistoryRetention(ctx sdk.Context) uint64 {
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		params = types.DefaultParams()
+	}
+	return params.NavHistoryRetentionSeconds
+}
+
+// recordNAVHistory appends nav to the vault's NAV history for nav.Denom at the current block
+// time and prunes that series' points older than the retention window. Recording is
+// disabled when Params.nav_history_retention_seconds is zero.
+//
+// Points are keyed by block time, so a series holds at most one point per block: a later
+// change in the same block replaces the earlier one, leaving the value the block ended on.
+// Pruning happens only when a series is appended to, so a series that stops changing keeps
+// its last points until it changes again.
+func (k Keeper) recordNAVHistory(ctx sdk.Context, vaultAddr sdk.AccAddress, nav types.VaultNAV) error {
+	retention := k.navHistoryRetention(ctx)
+	if retention == 0 {
+		return nil
+	}
+
+	now := ctx.BlockTime().UTC()
+	if err := k.NAVHistory.Set(ctx, collections.Join3(vaultAddr, nav.Denom, now), nav); err != nil {
+		return fmt.Errorf("failed to record NAV history for denom %q on vault %s: %w", nav.Denom, vaultAddr, err)
+	}
+
+	cutoff := now.Add(-time.Duration(retention) * time.Second)
+	var expired []collections.Triple[sdk.AccAddress, string, time.Time]
+	seriesRange := collections.NewSuperPrefixedTripleRange[sdk.AccAddress, string, time.Time](vaultAddr, nav.Denom)
+	err := k.NAVHistory.Walk(ctx, seriesRange, func(key collections.Triple[sdk.AccAddress, string, time.Time], _ types.VaultNAV) (bool, error) {
+		if !key.K3().Before(cutoff) {
+			return true, nil
+		}
+		expired = append(expired, key)
+		return false, nil
+	})
+	if err != nil {
+		return fmt.Errorf("failed to walk NAV history for denom %q on vault %s: %w", nav.Denom, vaultAddr, err)
+	}
+	for _, key := range expired {
+		if err := k.NAVHistory.Remove(ctx, key); err != nil {
+			return fmt.Errorf("failed to prune NAV history for denom %q on vault %s: %w", nav.Denom, vaultAddr, err)
+		}
+	}
+	return nil
+}
+
+// navHistoryPage returns a page of the vault's NAV history for denom within [start, end),
+// walking the range once. A non-zero intervalSeconds keeps only the first point in each
+// epoch-aligned interval, tracked as the interval of the point last kept. Pages end on an
+// interval boundary and the next key is the time of the following interval's first point
+// in iteration order, so a page resumed from it needs nothing from the page before. offset
+// counts kept points, not stored ones.
+func (k Keeper) navHistoryPage(ctx sdk.Context, vaultAddr sdk.AccAddress, denom string, start, end *time.Time, intervalSeconds uint64, pageReq *query.PageRequest) ([]types.VaultNAV, *query.PageResponse, error) {
+	if pageReq == nil {
+		pageReq = &query.PageRequest{}
+	}
+	if pageReq.Offset > 0 && len(pageReq.Key) > 0 {
+		return nil, nil, fmt.Errorf("%w: either offset or key is expected, got both", types.ErrInvalidRequest)
+	}
+	limit, countTotal := pageReq.Limit, pageReq.CountTotal && len(pageReq.Key) == 0
+	if limit == 0 {
+		limit, countTotal = query.DefaultLimit, len(pageReq.Key) == 0
+	}
+
+	rng := new(collections.Range[collections.Triple[sdk.AccAddress, string, time.Time]]).
+		Prefix(collections.TripleSuperPrefix[sdk.AccAddress, string, time.Time](vaultAddr, denom))
+	if start != nil {
+		rng.StartInclusive(collections.Join3(vaultAddr, denom, *start))
+	}
+	if end != nil {
+		rng.EndExclusive(collections.Join3(vaultAddr, denom, *end))
+	}
+	if len(pageReq.Key) > 0 {
+		_, resume, err := sdk.TimeKey.Decode(pageReq.Key)
+		if err != nil {
+			return nil, nil, fmt.Errorf("%w: invalid pagination key: %w", types.ErrInvalidRequest, err)
+		}
+		if pageReq.Reverse {
+			rng.EndInclusive(collections.Join3(vaultAddr, denom, resume))
+		} else {
+			rng.StartInclusive(collections.Join3(vaultAddr, denom, resume))
+		}
+	}
+	if pageReq.Reverse {
+		rng.Descending()
+	}
+
+	iter, err := k.NAVHistory.Iterate(ctx, rng)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to iterate NAV history for denom %q on vault %s: %w", denom, vaultAddr, err)
+	}
+	defer iter.Close()
+
+	interval := int64(intervalSeconds) //nolint:gosec // G115: NavHistory caps the interval at MaxNAVHistoryRetention.
+	intervalOf := func(at time.Time) int64 {
+		if interval == 0 {
+			return at.UnixNano()
+		}
+		return at.Unix() - at.Unix()%interval
+	}
+
+	var (
+		entries         []types.VaultNAV
+		nextKey         []byte
+		kept            uint64
+		candidate       *types.VaultNAV
+		candidateBucket int64
+	)
+	keep := func(nav types.VaultNAV) {
+		kept++
+		if kept > pageReq.Offset && uint64(len(entries)) < limit {
+			entries = append(entries, nav)
+		}
+	}
+	// The candidate is the point kept for the current interval: the first one seen when
+	// ascending, and the last one seen when descending, since both are its earliest point.
+	for ; iter.Valid(); iter.Next() {
+		kv, err := iter.KeyValue()
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to read NAV history for denom %q on vault %s: %w", denom, vaultAddr, err)
+		}
+		at := kv.Key.K3()
+		bucket := intervalOf(at)
+		if candidate != nil && bucket != candidateBucket {
+			keep(*candidate)
+			candidate = nil
+			if nextKey == nil && uint64(len(entries)) == limit {
+				nextKey = make([]byte, sdk.TimeKey.Size(at))
+				if _, err := sdk.TimeKey.Encode(nextKey, at); err != nil {
+					return nil, nil, fmt.Errorf("failed to encode NAV history pagination key: %w", err)
+				}
+				if !countTotal {
+					break
+				}
+			}
+		}
+		if candidate == nil || pageReq.Reverse {
+			nav := kv.Value
+			candidate, candidateBucket = &nav, bucket
+		}
+	}
+	if candidate != nil {
+		keep(*candidate)
+	}
+
+	pageRes := &query.PageResponse{NextKey: nextKey}
+	if countTotal {
+		pageRes.Total = kept
+	}
+	return entries, pageRes, nil
+}
@@ -0,0 +1,254 @@
+package keeper_test
+
+import (
+	"time"
+
+	"github.com/provlabs/vault/keeper"
+	"github.com/provlabs/vault/types"
+	"github.com/provlabs/vault/utils"
+
+	"cosmossdk.io/collections"
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+)
+
+// navHistoryPrices returns the recorded prices for denom on the vault, in time order.
+func (s *TestSuite) navHistoryPrices(vault *types.VaultAccount, denom string) []int64 {
+	var prices []int64
+	seriesRange := collections.NewSuperPrefixedTripleRange[sdk.AccAddress, string, time.Time](vault.GetAddress(), denom)
+	err := s.k.NAVHistory.Walk(s.ctx, seriesRange, func(_ collections.Triple[sdk.AccAddress, string, time.Time], nav types.VaultNAV) (bool, error) {
+		prices = append(prices, nav.Price.Amount.Int64())
+		return false, nil
+	})
+	s.Require().NoError(err, "NAVHistory.Walk should not error")
+	return prices
+}
+
+// setNAVHistoryRetention stores module params with the given NAV history retention.
+func (s *TestSuite) setNAVHistoryRetention(retention uint64) {
+	params := types.DefaultParams()
+	params.NavHistoryRetentionSeconds = retention
+	s.Require().NoError(s.k.Params.Set(s.ctx, params), "should set params with retention %d", retention)
+}
+
+func (s *TestSuite) TestSetVaultNAV_RecordsHistory() {
+	underlying, share, held := "under", "vshare", "heldcoin"
+
+	tests := []struct {
+		name         string
+		retention    uint64
+		updates      []int64
+		step         time.Duration
+		expectPrices []int64
+	}{
+		{
+			name:         "each block's change is recorded",
+			retention:    types.DefaultNAVHistoryRetention,
+			updates:      []int64{2, 3, 4},
+			step:         time.Minute,
+			expectPrices: []int64{1, 2, 3, 4},
+		},
+		{
+			name:         "a later change in the same block replaces the earlier one",
+			retention:    types.DefaultNAVHistoryRetention,
+			updates:      []int64{2, 3},
+			expectPrices: []int64{3},
+		},
+		{
+			name:         "points older than the retention window are pruned",
+			retention:    90,
+			updates:      []int64{2, 3, 4},
+			step:         time.Minute,
+			expectPrices: []int64{3, 4},
+		},
+		{
+			name:      "zero retention disables recording",
+			retention: 0,
+			updates:   []int64{2, 3},
+			step:      time.Minute,
+		},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+			s.setNAVHistoryRetention(tc.retention)
+			vault := s.setupStaleNAVVault(underlying, share, held, 0, false)
+			for _, price := range tc.updates {
+				s.SetCtxBlockTime(s.ctx.BlockTime().Add(tc.step))
+				s.setVaultNAV(vault, held, sdk.NewInt64Coin(underlying, price), 1)
+			}
+
+			s.Assert().Equal(tc.expectPrices, s.navHistoryPrices(vault, held), "recorded NAV history prices")
+		})
+	}
+}
+
+func (s *TestSuite) TestRemoveVaultNAV_RecordsHistory() {
+	underlying, share, held := "under", "vshare", "heldcoin"
+	vault := s.setupStaleNAVVault(underlying, share, held, 0, false)
+	s.SetCtxBlockTime(s.ctx.BlockTime().Add(time.Minute))
+
+	s.Require().NoError(s.k.RemoveVaultNAV(s.ctx, vault, held, s.adminAddr.String()), "RemoveVaultNAV should not error")
+
+	s.Assert().Equal([]int64{1, 0}, s.navHistoryPrices(vault, held), "recorded NAV history prices")
+	point, err := s.k.NAVHistory.Get(s.ctx, collections.Join3(vault.GetAddress(), held, s.ctx.BlockTime().UTC()))
+	s.Require().NoError(err, "removal point should be recorded")
+	s.Assert().Equal(underlying, point.Price.Denom, "removal point price denom")
+	s.Assert().True(point.Volume.IsZero(), "removal point volume should be zero")
+	s.Assert().Equal(s.ctx.BlockTime().UTC(), point.UpdatedTime, "removal point time")
+}
+
+func (s *TestSuite) TestPublishShareNav_RecordsHistory() {
+	underlying, share, held := "under", "vshare", "heldcoin"
+	vault := s.setupStaleNAVVault(underlying, share, held, 0, false)
+	vault.TotalShares = sdk.NewCoin(share, sdkmath.NewInt(1_000).Mul(utils.ShareScalar))
+	s.Require().NoError(s.k.SetVaultAccount(s.ctx, vault), "should set vault shares")
+
+	s.Require().NoError(s.k.TestAccessor_publishShareNav(s.T(), s.ctx, vault), "publishShareNav should not error")
+
+	published, err := s.k.MarkerKeeper.GetNetAssetValue(s.ctx, share, underlying)
+	s.Require().NoError(err, "GetNetAssetValue should not error")
+	s.Require().NotNil(published, "share NAV should be published")
+	point, err := s.k.NAVHistory.Get(s.ctx, collections.Join3(vault.GetAddress(), share, s.ctx.BlockTime().UTC()))
+	s.Require().NoError(err, "share NAV history point should be recorded")
+	s.Assert().Equal(published.Price, point.Price, "recorded share NAV price")
+	s.Assert().Equal(sdkmath.NewIntFromUint64(published.Volume), point.Volume, "recorded share NAV volume")
+	s.Assert().Equal(types.ModuleName, point.Source, "recorded share NAV source")
+}
+
+func (s *TestSuite) TestQueryServer_NavHistory() {
+	underlying, share, held := "under", "vshare", "heldcoin"
+	vault := s.setupStaleNAVVault(underlying, share, held, 0, false)
+	start := s.ctx.BlockTime()
+	for i := int64(1); i <= 5; i++ {
+		s.SetCtxBlockTime(start.Add(time.Duration(i) * 40 * time.Second))
+		s.setVaultNAV(vault, held, sdk.NewInt64Coin(underlying, i+1), 1)
+	}
+	at := func(seconds int) *time.Time {
+		t := start.Add(time.Duration(seconds) * time.Second)
+		return &t
+	}
+
+	tests := []struct {
+		name         string
+		req          *types.QueryNavHistoryRequest
+		expectPrices []int64
+		expectErr    string
+	}{
+		{
+			name:         "full series by vault address",
+			req:          &types.QueryNavHistoryRequest{Id: vault.Address, Denom: held},
+			expectPrices: []int64{1, 2, 3, 4, 5, 6},
+		},
+		{
+			name:         "time range by share denom",
+			req:          &types.QueryNavHistoryRequest{Id: share, Denom: held, StartTime: at(40), EndTime: at(160)},
+			expectPrices: []int64{2, 3, 4},
+		},
+		{
+			name:         "interval downsampling keeps the first point per interval",
+			req:          &types.QueryNavHistoryRequest{Id: vault.Address, Denom: held, IntervalSeconds: 100},
+			expectPrices: []int64{1, 4, 6},
+		},
+		{
+			name:         "reverse downsampling keeps the first point per interval",
+			req:          &types.QueryNavHistoryRequest{Id: vault.Address, Denom: held, IntervalSeconds: 100, Pagination: &query.PageRequest{Reverse: true}},
+			expectPrices: []int64{6, 4, 1},
+		},
+		{
+			name:         "offset counts downsampled points",
+			req:          &types.QueryNavHistoryRequest{Id: vault.Address, Denom: held, IntervalSeconds: 100, Pagination: &query.PageRequest{Offset: 1}},
+			expectPrices: []int64{4, 6},
+		},
+		{
+			name:      "offset and key together",
+			req:       &types.QueryNavHistoryRequest{Id: vault.Address, Denom: held, Pagination: &query.PageRequest{Offset: 1, Key: []byte{1}}},
+			expectErr: "either offset or key is expected",
+		},
+		{
+			name:         "downsampling ignores points before the start time",
+			req:          &types.QueryNavHistoryRequest{Id: vault.Address, Denom: held, StartTime: at(120), IntervalSeconds: 100},
+			expectPrices: []int64{4, 6},
+		},
+		{
+			name:      "unknown vault",
+			req:       &types.QueryNavHistoryRequest{Id: "unknownshare", Denom: held},
+			expectErr: "not found",
+		},
+		{
+			name:      "empty denom",
+			req:       &types.QueryNavHistoryRequest{Id: vault.Address},
+			expectErr: "denom must be provided",
+		},
+		{
+			name:      "start time not before end time",
+			req:       &types.QueryNavHistoryRequest{Id: vault.Address, Denom: held, StartTime: at(80), EndTime: at(80)},
+			expectErr: "start_time must be before end_time",
+		},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			resp, err := keeper.NewQueryServer(s.simApp.VaultKeeper).NavHistory(s.ctx, tc.req)
+			if tc.expectErr != "" {
+				s.Require().ErrorContains(err, tc.expectErr, "NavHistory error")
+				return
+			}
+			s.Require().NoError(err, "NavHistory should succeed")
+			var prices []int64
+			for _, entry := range resp.Entries {
+				prices = append(prices, entry.Price.Amount.Int64())
+			}
+			s.Assert().Equal(tc.expectPrices, prices, "returned NAV history prices")
+		})
+	}
+}
+
+func (s *TestSuite) TestQueryServer_NavHistory_KeyPagination() {
+	underlying, share, held := "under", "vshare", "heldcoin"
+	vault := s.setupStaleNAVVault(underlying, share, held, 0, false)
+	start := s.ctx.BlockTime()
+	for i := int64(1); i <= 5; i++ {
+		s.SetCtxBlockTime(start.Add(time.Duration(i) * 40 * time.Second))
+		s.setVaultNAV(vault, held, sdk.NewInt64Coin(underlying, i+1), 1)
+	}
+
+	tests := []struct {
+		name         string
+		interval     uint64
+		reverse      bool
+		expectPrices []int64
+	}{
+		{name: "every point", expectPrices: []int64{1, 2, 3, 4, 5, 6}},
+		{name: "every point in reverse", reverse: true, expectPrices: []int64{6, 5, 4, 3, 2, 1}},
+		{name: "downsampled", interval: 100, expectPrices: []int64{1, 4, 6}},
+		{name: "downsampled in reverse", interval: 100, reverse: true, expectPrices: []int64{6, 4, 1}},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			var prices []int64
+			var key []byte
+			for page := 0; page <= len(tc.expectPrices); page++ {
+				resp, err := keeper.NewQueryServer(s.simApp.VaultKeeper).NavHistory(s.ctx, &types.QueryNavHistoryRequest{
+					Id:              vault.Address,
+					Denom:           held,
+					IntervalSeconds: tc.interval,
+					Pagination:      &query.PageRequest{Key: key, Limit: 1, Reverse: tc.reverse},
+				})
+				s.Require().NoError(err, "NavHistory page %d should succeed", page)
+				for _, entry := range resp.Entries {
+					prices = append(prices, entry.Price.Amount.Int64())
+				}
+				key = resp.Pagination.NextKey
+				if key == nil {
+					break
+				}
+			}
+			s.Assert().Equal(tc.expectPrices, prices, "NAV history prices across pages")
+		})
+	}
+}
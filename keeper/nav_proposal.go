@@ -0,0 +1,233 @@
+package keeper
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/provlabs/vault/types"
+
+	"cosmossdk.io/collections"
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// navChangeExceedsLimit reports whether moving a denom's per-unit price from prev to
+// next is larger than maxBips basis points of the prev per-unit price. The per-unit
+// prices are compared by cross-multiplication so no precision is lost to division:
+// the move exceeds the limit when |next.Price*prev.Volume - prev.Price*next.Volume| *
+// 10,000 > maxBips * prev.Price*next.Volume. Any move away from a zero price exceeds
+// every limit.
+func navChangeExceedsLimit(prev, next types.VaultNAV, maxBips uint32) (bool, error) {
+	prevValue, err := prev.Price.Amount.SafeMul(next.Volume)
+	if err != nil {
+		return false, fmt.Errorf("failed to multiply current NAV price %s by proposed volume %s: %w", prev.Price.Amount, next.Volume, err)
+	}
+	nextValue, err := next.Price.Amount.SafeMul(prev.Volume)
+	if err != nil {
+		return false, fmt.Errorf("failed to multiply proposed NAV price %s by current volume %s: %w", next.Price.Amount, prev.Volume, err)
+	}
+	change, err := nextValue.Sub(prevValue).Abs().SafeMul(sdkmath.NewInt(10_000))
+	if err != nil {
+		return false, fmt.Errorf("failed to scale NAV change to basis points: %w", err)
+	}
+	allowed, err := prevValue.SafeMul(sdkmath.NewIntFromUint64(uint64(maxBips)))
+	if err != nil {
+		return false, fmt.Errorf("failed to scale current NAV value by %d bips: %w", maxBips, err)
+	}
+	return change.GT(allowed), nil
+}
+
+// requiresNAVApproval reports whether a NAV update must be held for the vault's NAV
+// approver because it moves a held denom's price by more than max_nav_change_bips from
+// the denom's reference price (see updateNAVReference). It also returns the entry the
+// update would replace.
+//
+// Only a repricing of a denom the vault holds at its principal marker is guarded: the
+// first price for a denom and a price for an unheld denom move no share value, so they
+// apply directly. The guardrail is disabled when MaxNavChangeBips is zero.
+func (k Keeper) requiresNAVApproval(ctx sdk.Context, vault *types.VaultAccount, nav types.VaultNAV) (bool, types.VaultNAV, error) {
+	if vault.MaxNavChangeBips == 0 {
+		return false, types.VaultNAV{}, nil
+	}
+
+	current, err := k.GetVaultNAV(ctx, vault.GetAddress(), nav.Denom)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return false, types.VaultNAV{}, nil
+		}
+		return false, types.VaultNAV{}, fmt.Errorf("failed to get internal NAV for denom %q on vault %s: %w", nav.Denom, vault.Address, err)
+	}
+	if k.heldBalance(ctx, *vault, nav.Denom).IsZero() {
+		return false, current, nil
+	}
+
+	reference, err := k.NAVReferences.Get(ctx, collections.Join(vault.GetAddress(), nav.Denom))
+	if err != nil {
+		if !errors.Is(err, collections.ErrNotFound) {
+			return false, current, fmt.Errorf("failed to get NAV reference for denom %q on vault %s: %w", nav.Denom, vault.Address, err)
+		}
+		reference = current
+	}
+
+	exceeds, err := navChangeExceedsLimit(reference, nav, vault.MaxNavChangeBips)
+	if err != nil {
+		return false, current, err
+	}
+	return exceeds, current, nil
+}
+
+// updateNAVReference maintains the price that max_nav_change_bips measures a denom's
+// repricings against, after SetVaultNAV replaced previous (nil for a first price) with nav.
+// A repricing the limit measured, of a held denom while the limit is set, leaves the
+// reference where it was, so a run of updates that each stay within the limit cannot walk
+// the price further than the limit from it; moving past that takes an approved proposal
+// (see ApproveNAVProposal). Every other write moves the reference to nav.
+func (k *Keeper) updateNAVReference(ctx sdk.Context, vault *types.VaultAccount, previous *types.VaultNAV, nav types.VaultNAV) error {
+	key := collections.Join(vault.GetAddress(), nav.Denom)
+	reference := nav
+	if previous != nil && vault.MaxNavChangeBips > 0 && !k.heldBalance(ctx, *vault, nav.Denom).IsZero() {
+		has, err := k.NAVReferences.Has(ctx, key)
+		if err != nil {
+			return fmt.Errorf("failed to get NAV reference for denom %q on vault %s: %w", nav.Denom, vault.Address, err)
+		}
+		if has {
+			return nil
+		}
+		reference = *previous
+	}
+	if err := k.NAVReferences.Set(ctx, key, reference); err != nil {
+		return fmt.Errorf("failed to set NAV reference for denom %q on vault %s: %w", nav.Denom, vault.Address, err)
+	}
+	return nil
+}
+
+// ProposeVaultNAV stores nav as a pending proposal awaiting the vault's NAV approver and
+// emits an EventNAVProposed. previous is the entry the proposal would replace and is
+// recorded on the event only. The proposal is validated exactly as SetVaultNAV validates
+// a direct update, so an approval can only fail on state that changed in between.
+//
+// A vault holds at most one proposal per denom: a newer proposal replaces an older one,
+// restarting its expiry. Like SetVaultNAV, this method does NOT verify that proposer is
+// authorized to price the vault.
+func (k *Keeper) ProposeVaultNAV(ctx sdk.Context, vault *types.VaultAccount, nav, previous types.VaultNAV, proposer string) error {
+	if err := validateVaultNAVFields(vault, nav); err != nil {
+		return err
+	}
+	if err := k.requireNAVDenomRegistered(ctx, nav.Denom); err != nil {
+		return err
+	}
+
+	nav.UpdatedBlockHeight = ctx.BlockHeight()
+	nav.UpdatedTime = ctx.BlockTime().UTC()
+	proposal := types.PendingNAVProposal{
+		VaultAddress: vault.Address,
+		Nav:          nav,
+		Proposer:     proposer,
+		ExpiryTime:   nav.UpdatedTime.Add(types.NAVProposalLifetime * time.Second),
+	}
+	if err := k.PendingNAVProposals.Set(ctx, collections.Join(vault.GetAddress(), nav.Denom), proposal); err != nil {
+		return fmt.Errorf("failed to store NAV proposal: %w", err)
+	}
+
+	k.emitEvent(ctx, types.NewEventNAVProposed(proposal, previous))
+	return nil
+}
+
+// ApproveNAVProposal applies the vault's pending NAV proposal for denom through
+// SetVaultNAV, removes it, and emits an EventNAVProposalApproved. The applied entry is
+// attributed to the proposer on the EventNAVUpdated, since the proposer priced it, and
+// becomes the denom's reference price for the NAV change limit.
+//
+// The approval is rejected when no proposal is pending, when it has expired, when the
+// approver is the proposer, or when the proposer is no longer the vault's NAV authority.
+// The caller is responsible for verifying that approver is the vault's NAV approver.
+func (k *Keeper) ApproveNAVProposal(ctx sdk.Context, vault *types.VaultAccount, denom, approver string) error {
+	key := collections.Join(vault.GetAddress(), denom)
+	proposal, err := k.PendingNAVProposals.Get(ctx, key)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return fmt.Errorf("no pending NAV proposal for denom %q on vault %s", denom, vault.Address)
+		}
+		return fmt.Errorf("failed to get NAV proposal for denom %q on vault %s: %w", denom, vault.Address, err)
+	}
+	if !ctx.BlockTime().Before(proposal.ExpiryTime) {
+		return fmt.Errorf("NAV proposal for denom %q on vault %s expired at %s", denom, vault.Address, proposal.ExpiryTime)
+	}
+	if proposal.Proposer == approver {
+		return fmt.Errorf("NAV proposal for denom %q on vault %s must be approved by an address other than its proposer %s", denom, vault.Address, approver)
+	}
+	if err := vault.ValidateRole(proposal.Proposer, types.VaultRole_VAULT_ROLE_NAV_AUTHORITY); err != nil {
+		return fmt.Errorf("NAV proposal for denom %q on vault %s is no longer valid: %w", denom, vault.Address, err)
+	}
+
+	if err := k.PendingNAVProposals.Remove(ctx, key); err != nil {
+		return fmt.Errorf("failed to remove NAV proposal: %w", err)
+	}
+	if err := k.SetVaultNAV(ctx, vault, proposal.Nav, proposal.Proposer); err != nil {
+		return err
+	}
+	applied, err := k.GetVaultNAV(ctx, vault.GetAddress(), denom)
+	if err != nil {
+		return fmt.Errorf("failed to get internal NAV for denom %q on vault %s: %w", denom, vault.Address, err)
+	}
+	if err := k.NAVReferences.Set(ctx, key, applied); err != nil {
+		return fmt.Errorf("failed to set NAV reference for denom %q on vault %s: %w", denom, vault.Address, err)
+	}
+
+	k.emitEvent(ctx, types.NewEventNAVProposalApproved(proposal, approver))
+	return nil
+}
+
+// removePendingNAVProposal discards any pending NAV proposal for denom on the vault. It is
+// a no-op when none is pending.
+func (k *Keeper) removePendingNAVProposal(ctx sdk.Context, vault *types.VaultAccount, denom string) error {
+	if err := k.PendingNAVProposals.Remove(ctx, collections.Join(vault.GetAddress(), denom)); err != nil {
+		return fmt.Errorf("failed to remove NAV proposal for denom %q on vault %s: %w", denom, vault.Address, err)
+	}
+	return nil
+}
+
+// SetNAVChangeLimit updates the NAV change limit and approver for a vault. Pending proposals
+// stay approvable by the new approver until they expire.
+func (k *Keeper) SetNAVChangeLimit(ctx sdk.Context, vault *types.VaultAccount, maxNAVChangeBips uint32, navApprover, authority string) error {
+	if vault.MaxNavChangeBips == maxNAVChangeBips && vault.NavApprover == navApprover {
+		return nil
+	}
+	vault.MaxNavChangeBips = maxNAVChangeBips
+	vault.NavApprover = navApprover
+	if err := k.SetVaultAccount(ctx, vault); err != nil {
+		return fmt.Errorf("failed to set vault account: %w", err)
+	}
+	k.emitEvent(ctx, types.NewEventNAVChangeLimitUpdated(vault.Address, authority, maxNAVChangeBips, navApprover))
+	return nil
+}
+
+// handleExpiredNAVProposals discards every pending NAV proposal whose expiry time has
+// been reached and emits an EventNAVProposalExpired for each. The vault's NAV entry is
+// left at its last applied price.
+//
+// Proposals are capped at one per (vault, denom) and live for a day, so the set is small
+// enough to walk in full each block. Expired proposals are collected first and removed
+// after the walk completes.
+func (k *Keeper) handleExpiredNAVProposals(ctx sdk.Context) error {
+	var expired []collections.KeyValue[collections.Pair[sdk.AccAddress, string], types.PendingNAVProposal]
+	err := k.PendingNAVProposals.Walk(ctx, nil, func(key collections.Pair[sdk.AccAddress, string], proposal types.PendingNAVProposal) (bool, error) {
+		if !ctx.BlockTime().Before(proposal.ExpiryTime) {
+			expired = append(expired, collections.KeyValue[collections.Pair[sdk.AccAddress, string], types.PendingNAVProposal]{Key: key, Value: proposal})
+		}
+		return false, nil
+	})
+	if err != nil {
+		return fmt.Errorf("failed to walk NAV proposals: %w", err)
+	}
+
+	for _, kv := range expired {
+		if err := k.PendingNAVProposals.Remove(ctx, kv.Key); err != nil {
+			return fmt.Errorf("failed to remove expired NAV proposal for denom %q on vault %s: %w", kv.Key.K2(), kv.Key.K1(), err)
+		}
+		k.emitEvent(ctx, types.NewEventNAVProposalExpired(kv.Value))
+	}
+	return nil
+}
@@ -0,0 +1,405 @@
+package keeper_test
+
+import (
+	"time"
+
+	"github.com/provlabs/vault/keeper"
+	"github.com/provlabs/vault/types"
+
+	"cosmossdk.io/collections"
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	markertypes "github.com/provenance-io/provenance/x/marker/types"
+)
+
+// setupNAVChangeLimitVault creates a vault holding heldDenom priced at par (see
+// setupStaleNAVVault) whose NAV changes are limited to maxBips, with the asset manager
+// configured as the NAV approver. The admin remains the NAV authority.
+func (s *TestSuite) setupNAVChangeLimitVault(underlying, share, heldDenom string, maxBips uint32) *types.VaultAccount {
+	vault := s.setupStaleNAVVault(underlying, share, heldDenom, 0, false)
+	s.Require().NoError(s.k.SetNAVChangeLimit(s.ctx, vault, maxBips, s.assetManagerAddr.String(), s.adminAddr.String()), "should set NAV change limit")
+	return vault
+}
+
+// proposeNAV submits an UpdateVaultNAV from the admin (the NAV authority) and requires
+// that it was held for approval.
+func (s *TestSuite) proposeNAV(vault *types.VaultAccount, denom string, price sdk.Coin, volume int64) {
+	resp, err := keeper.NewMsgServer(s.simApp.VaultKeeper).UpdateVaultNAV(s.ctx, &types.MsgUpdateVaultNAVRequest{
+		Signer:       s.adminAddr.String(),
+		VaultAddress: vault.Address,
+		Denom:        denom,
+		Price:        price,
+		Volume:       sdkmath.NewInt(volume),
+	})
+	s.Require().NoError(err, "UpdateVaultNAV should succeed")
+	s.Require().True(resp.PendingApproval, "UpdateVaultNAV should be held for approval")
+}
+
+func (s *TestSuite) TestMsgServer_UpdateVaultNAV_ChangeLimit() {
+	underlying, share, held := "under", "vshare", "heldcoin"
+
+	tests := []struct {
+		name          string
+		maxBips       uint32
+		denom         string
+		price         int64
+		volume        int64
+		setup         func(vault *types.VaultAccount)
+		expectPending bool
+	}{
+		{name: "limit disabled applies a large move", maxBips: 0, denom: held, price: 1, volume: 2},
+		{name: "move at the limit applies", maxBips: 500, denom: held, price: 105, volume: 100},
+		{name: "markdown beyond the limit is held", maxBips: 500, denom: held, price: 94, volume: 100, expectPending: true},
+		{name: "markup beyond the limit is held", maxBips: 500, denom: held, price: 106, volume: 100, expectPending: true},
+		{
+			name:    "unheld denom applies a large move",
+			maxBips: 500,
+			denom:   held,
+			price:   1,
+			volume:  2,
+			setup: func(vault *types.VaultAccount) {
+				principal := vault.PrincipalMarkerAddress()
+				bal := s.simApp.BankKeeper.GetBalance(s.ctx, principal, held)
+				s.Require().NoError(s.simApp.BankKeeper.SendCoins(markertypes.WithBypass(s.ctx), principal, s.adminAddr, sdk.NewCoins(bal)), "should drain held denom")
+			},
+		},
+		{
+			name:    "first price for a denom applies",
+			maxBips: 500,
+			denom:   "newcoin",
+			price:   7,
+			volume:  1,
+			setup: func(_ *types.VaultAccount) {
+				s.requireSimpleMarker("newcoin")
+			},
+		},
+		{
+			name:    "held move from a zero price is held",
+			maxBips: 500,
+			denom:   held,
+			price:   1,
+			volume:  1,
+			setup: func(vault *types.VaultAccount) {
+				s.proposeNAV(vault, held, sdk.NewInt64Coin(underlying, 0), 1)
+				s.Require().NoError(s.k.ApproveNAVProposal(s.ctx, vault, held, s.assetManagerAddr.String()), "should approve the markdown to zero")
+			},
+			expectPending: true,
+		},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+			vault := s.setupStaleNAVVault(underlying, share, held, 0, false)
+			if tc.maxBips > 0 {
+				s.Require().NoError(s.k.SetNAVChangeLimit(s.ctx, vault, tc.maxBips, s.assetManagerAddr.String(), s.adminAddr.String()), "should set NAV change limit")
+			}
+			if tc.setup != nil {
+				tc.setup(vault)
+			}
+			before, _ := s.k.GetVaultNAV(s.ctx, vault.GetAddress(), tc.denom)
+			s.ctx = s.ctx.WithEventManager(sdk.NewEventManager())
+
+			price := sdk.NewInt64Coin(underlying, tc.price)
+			resp, err := keeper.NewMsgServer(s.simApp.VaultKeeper).UpdateVaultNAV(s.ctx, &types.MsgUpdateVaultNAVRequest{
+				Signer:       s.adminAddr.String(),
+				VaultAddress: vault.Address,
+				Denom:        tc.denom,
+				Price:        price,
+				Volume:       sdkmath.NewInt(tc.volume),
+			})
+			s.Require().NoError(err, "UpdateVaultNAV should succeed")
+			s.Assert().Equal(tc.expectPending, resp.PendingApproval, "response pending_approval")
+
+			stored, err := s.k.GetVaultNAV(s.ctx, vault.GetAddress(), tc.denom)
+			s.Require().NoError(err, "NAV entry should exist")
+			proposal, propErr := s.k.PendingNAVProposals.Get(s.ctx, collections.Join(vault.GetAddress(), tc.denom))
+			if !tc.expectPending {
+				s.Assert().Equal(price, stored.Price, "NAV price should be applied directly")
+				s.Assert().Error(propErr, "no proposal should be stored for a direct update")
+				return
+			}
+
+			s.Assert().Equal(before.Price, stored.Price, "held NAV price should not change")
+			s.Require().NoError(propErr, "proposal should be stored")
+			s.Assert().Equal(price, proposal.Nav.Price, "proposal price")
+			s.Assert().Equal(s.adminAddr.String(), proposal.Proposer, "proposal proposer")
+			s.Assert().Equal(s.ctx.BlockTime().Add(types.NAVProposalLifetime*time.Second), proposal.ExpiryTime, "proposal expiry time")
+			s.requireTypedEventEmitted(types.NewEventNAVProposed(proposal, before))
+		})
+	}
+}
+
+func (s *TestSuite) TestMsgServer_UpdateVaultNAV_ChangeLimitReference() {
+	underlying, share, held := "under", "vshare", "heldcoin"
+
+	s.SetupTest()
+	vault := s.setupNAVChangeLimitVault(underlying, share, held, 500)
+	update := func(price int64) bool {
+		resp, err := keeper.NewMsgServer(s.simApp.VaultKeeper).UpdateVaultNAV(s.ctx, &types.MsgUpdateVaultNAVRequest{
+			Signer:       s.adminAddr.String(),
+			VaultAddress: vault.Address,
+			Denom:        held,
+			Price:        sdk.NewInt64Coin(underlying, price),
+			Volume:       sdkmath.NewInt(100),
+		})
+		s.Require().NoError(err, "UpdateVaultNAV to %d should succeed", price)
+		return resp.PendingApproval
+	}
+
+	s.Require().False(update(105), "a first +500 bips update should apply directly")
+	s.SetCtxBlockTime(s.ctx.BlockTime().Add(time.Minute))
+	s.Require().True(update(110), "a second +500 bips update should be held, since it moves the price 1000 bips from the reference")
+	s.Require().False(update(104), "an update back within the limit of the reference should apply directly")
+
+	s.Require().True(update(110), "the move past the limit should be held again")
+	s.Require().NoError(s.k.ApproveNAVProposal(s.ctx, vault, held, s.assetManagerAddr.String()), "should approve the held update")
+	reference, err := s.k.NAVReferences.Get(s.ctx, collections.Join(vault.GetAddress(), held))
+	s.Require().NoError(err, "NAV reference should exist")
+	s.Assert().Equal(sdk.NewInt64Coin(underlying, 110), reference.Price, "an approved update should become the reference")
+	s.Assert().False(update(115), "an update within the limit of the approved price should apply directly")
+}
+
+func (s *TestSuite) TestMsgServer_UpdateVaultNAV_DirectUpdateSupersedesProposal() {
+	underlying, share, held := "under", "vshare", "heldcoin"
+	vault := s.setupNAVChangeLimitVault(underlying, share, held, 500)
+	s.proposeNAV(vault, held, sdk.NewInt64Coin(underlying, 50), 100)
+
+	resp, err := keeper.NewMsgServer(s.simApp.VaultKeeper).UpdateVaultNAV(s.ctx, &types.MsgUpdateVaultNAVRequest{
+		Signer:       s.adminAddr.String(),
+		VaultAddress: vault.Address,
+		Denom:        held,
+		Price:        sdk.NewInt64Coin(underlying, 99),
+		Volume:       sdkmath.NewInt(100),
+	})
+	s.Require().NoError(err, "within-limit UpdateVaultNAV should succeed")
+	s.Assert().False(resp.PendingApproval, "within-limit update should apply directly")
+
+	has, err := s.k.PendingNAVProposals.Has(s.ctx, collections.Join(vault.GetAddress(), held))
+	s.Require().NoError(err, "PendingNAVProposals.Has should not error")
+	s.Assert().False(has, "direct update should discard the pending proposal")
+}
+
+func (s *TestSuite) TestMsgServer_ApproveNAVProposal() {
+	underlying, share, held := "under", "vshare", "heldcoin"
+	proposedPrice := sdk.NewInt64Coin(underlying, 50)
+
+	tests := []struct {
+		name      string
+		setup     func(vault *types.VaultAccount)
+		signer    func() string
+		denom     string
+		expectErr string
+	}{
+		{
+			name:   "NAV approver applies the proposal",
+			signer: func() string { return s.assetManagerAddr.String() },
+			denom:  held,
+		},
+		{
+			name:      "other signer is rejected",
+			signer:    func() string { return s.adminAddr.String() },
+			denom:     held,
+			expectErr: "is not the vault NAV approver",
+		},
+		{
+			name: "proposer cannot approve its own proposal",
+			setup: func(vault *types.VaultAccount) {
+				s.Require().NoError(s.k.SetNAVAuthority(s.ctx, vault, s.assetManagerAddr.String(), s.adminAddr.String()), "should rotate NAV authority")
+				resp, err := keeper.NewMsgServer(s.simApp.VaultKeeper).UpdateVaultNAV(s.ctx, &types.MsgUpdateVaultNAVRequest{
+					Signer:       s.assetManagerAddr.String(),
+					VaultAddress: vault.Address,
+					Denom:        held,
+					Price:        sdk.NewInt64Coin(underlying, 40),
+					Volume:       sdkmath.NewInt(100),
+				})
+				s.Require().NoError(err, "approver-as-authority UpdateVaultNAV should succeed")
+				s.Require().True(resp.PendingApproval, "update should be held for approval")
+			},
+			signer:    func() string { return s.assetManagerAddr.String() },
+			denom:     held,
+			expectErr: "must be approved by an address other than its proposer",
+		},
+		{
+			name: "expired proposal is rejected",
+			setup: func(_ *types.VaultAccount) {
+				s.SetCtxBlockTime(s.ctx.BlockTime().Add(types.NAVProposalLifetime * time.Second))
+			},
+			signer:    func() string { return s.assetManagerAddr.String() },
+			denom:     held,
+			expectErr: "expired",
+		},
+		{
+			name: "proposal from a rotated-out NAV authority is rejected",
+			setup: func(vault *types.VaultAccount) {
+				s.Require().NoError(s.k.SetNAVAuthority(s.ctx, vault, sdk.AccAddress("new_nav_authority___").String(), s.adminAddr.String()), "should rotate NAV authority")
+			},
+			signer:    func() string { return s.assetManagerAddr.String() },
+			denom:     held,
+			expectErr: "is no longer valid",
+		},
+		{
+			name:      "missing proposal is rejected",
+			signer:    func() string { return s.assetManagerAddr.String() },
+			denom:     "othercoin",
+			expectErr: "no pending NAV proposal",
+		},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+			vault := s.setupNAVChangeLimitVault(underlying, share, held, 500)
+			s.proposeNAV(vault, held, proposedPrice, 100)
+			if tc.setup != nil {
+				tc.setup(vault)
+			}
+			s.SetCtxBlockTime(s.ctx.BlockTime().Add(time.Minute))
+			s.ctx = s.ctx.WithEventManager(sdk.NewEventManager())
+
+			_, err := keeper.NewMsgServer(s.simApp.VaultKeeper).ApproveNAVProposal(s.ctx, &types.MsgApproveNAVProposalRequest{
+				Approver:     tc.signer(),
+				VaultAddress: vault.Address,
+				Denom:        tc.denom,
+			})
+			if tc.expectErr != "" {
+				s.Require().ErrorContains(err, tc.expectErr, "ApproveNAVProposal error")
+				return
+			}
+			s.Require().NoError(err, "ApproveNAVProposal should succeed")
+
+			stored, err := s.k.GetVaultNAV(s.ctx, vault.GetAddress(), held)
+			s.Require().NoError(err, "NAV entry should exist")
+			s.Assert().Equal(proposedPrice, stored.Price, "approved NAV price")
+			s.Assert().Equal(s.ctx.BlockTime(), stored.UpdatedTime, "approved NAV should be stamped at approval")
+			has, err := s.k.PendingNAVProposals.Has(s.ctx, collections.Join(vault.GetAddress(), held))
+			s.Require().NoError(err, "PendingNAVProposals.Has should not error")
+			s.Assert().False(has, "approved proposal should be removed")
+			s.requireTypedEventEmitted(types.NewEventNAVProposalApproved(types.PendingNAVProposal{
+				VaultAddress: vault.Address,
+				Nav:          stored,
+				Proposer:     s.adminAddr.String(),
+			}, s.assetManagerAddr.String()))
+		})
+	}
+}
+
+func (s *TestSuite) TestMsgServer_UpdateNAVChangeLimit() {
+	underlying, share := "under", "vshare"
+	approver := sdk.AccAddress("nav_approver________").String()
+
+	tests := []struct {
+		name        string
+		signer      func() string
+		maxBips     uint32
+		approver    string
+		expectErr   string
+		expectEvent bool
+	}{
+		{name: "admin sets the limit", signer: func() string { return s.adminAddr.String() }, maxBips: 500, approver: approver, expectEvent: true},
+		{name: "unchanged limit is a no-op", signer: func() string { return s.adminAddr.String() }},
+		{name: "asset manager cannot set the limit", signer: func() string { return s.assetManagerAddr.String() }, maxBips: 500, approver: approver, expectErr: "failed to validate admin"},
+		{name: "limit without an approver is rejected", signer: func() string { return s.adminAddr.String() }, maxBips: 500, expectErr: "nav approver is required"},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+			vault, _ := s.setupAssetSettlementVault(underlying, share)
+			s.ctx = s.ctx.WithEventManager(sdk.NewEventManager())
+
+			_, err := keeper.NewMsgServer(s.simApp.VaultKeeper).UpdateNAVChangeLimit(s.ctx, &types.MsgUpdateNAVChangeLimitRequest{
+				Authority:        tc.signer(),
+				VaultAddress:     vault.Address,
+				MaxNavChangeBips: tc.maxBips,
+				NavApprover:      tc.approver,
+			})
+			if tc.expectErr != "" {
+				s.Require().ErrorContains(err, tc.expectErr, "UpdateNAVChangeLimit error")
+				return
+			}
+			s.Require().NoError(err, "UpdateNAVChangeLimit should succeed")
+
+			updated, err := s.k.GetVault(s.ctx, vault.GetAddress())
+			s.Require().NoError(err, "should reload vault")
+			s.Assert().Equal(tc.maxBips, updated.MaxNavChangeBips, "stored max NAV change bips")
+			s.Assert().Equal(tc.approver, updated.NavApprover, "stored NAV approver")
+			if tc.expectEvent {
+				s.requireTypedEventEmitted(types.NewEventNAVChangeLimitUpdated(vault.Address, tc.signer(), tc.maxBips, tc.approver))
+			} else {
+				s.Assert().Empty(s.ctx.EventManager().Events(), "no-op update should not emit events")
+			}
+		})
+	}
+}
+
+func (s *TestSuite) TestEndBlocker_ExpiresNAVProposals() {
+	underlying, share, held := "under", "vshare", "heldcoin"
+
+	tests := []struct {
+		name         string
+		advance      time.Duration
+		expectExpire bool
+	}{
+		{name: "proposal before expiry is kept", advance: types.NAVProposalLifetime*time.Second - time.Second},
+		{name: "proposal at expiry is discarded", advance: types.NAVProposalLifetime * time.Second, expectExpire: true},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+			vault := s.setupNAVChangeLimitVault(underlying, share, held, 500)
+			s.proposeNAV(vault, held, sdk.NewInt64Coin(underlying, 50), 100)
+			proposal, err := s.k.PendingNAVProposals.Get(s.ctx, collections.Join(vault.GetAddress(), held))
+			s.Require().NoError(err, "proposal should be stored")
+			s.SetCtxBlockTime(s.ctx.BlockTime().Add(tc.advance))
+			s.ctx = s.ctx.WithEventManager(sdk.NewEventManager())
+
+			s.Require().NoError(s.k.EndBlocker(s.ctx), "EndBlocker should not error")
+
+			has, err := s.k.PendingNAVProposals.Has(s.ctx, collections.Join(vault.GetAddress(), held))
+			s.Require().NoError(err, "PendingNAVProposals.Has should not error")
+			s.Assert().Equal(!tc.expectExpire, has, "proposal presence after EndBlocker")
+			stored, err := s.k.GetVaultNAV(s.ctx, vault.GetAddress(), held)
+			s.Require().NoError(err, "NAV entry should exist")
+			s.Assert().Equal(sdk.NewInt64Coin(underlying, 1), stored.Price, "NAV price should be unchanged")
+			if tc.expectExpire {
+				s.requireTypedEventEmitted(types.NewEventNAVProposalExpired(proposal))
+			}
+		})
+	}
+}
+
+func (s *TestSuite) TestQueryServer_PendingNAVProposals() {
+	underlying, share, held := "under", "vshare", "heldcoin"
+	vault := s.setupNAVChangeLimitVault(underlying, share, held, 500)
+	s.proposeNAV(vault, held, sdk.NewInt64Coin(underlying, 50), 100)
+
+	tests := []struct {
+		name      string
+		id        string
+		expectLen int
+		expectErr string
+	}{
+		{name: "by vault address", id: vault.Address, expectLen: 1},
+		{name: "by share denom", id: share, expectLen: 1},
+		{name: "unknown vault", id: "unknownshare", expectErr: "not found"},
+		{name: "empty id", id: "", expectErr: "id must be provided"},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			resp, err := keeper.NewQueryServer(s.simApp.VaultKeeper).PendingNAVProposals(s.ctx, &types.QueryPendingNAVProposalsRequest{Id: tc.id})
+			if tc.expectErr != "" {
+				s.Require().ErrorContains(err, tc.expectErr, "PendingNAVProposals error")
+				return
+			}
+			s.Require().NoError(err, "PendingNAVProposals should succeed")
+			s.Require().Len(resp.Proposals, tc.expectLen, "number of pending proposals")
+			s.Assert().Equal(held, resp.Proposals[0].Nav.Denom, "proposal denom")
+			s.Assert().Equal(sdk.NewInt64Coin(underlying, 50), resp.Proposals[0].Nav.Price, "proposal price")
+		})
+	}
+}
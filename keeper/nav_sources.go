@@ -0,0 +1,251 @@
+package keeper
+
+import (
+	"fmt"
+	"slices"
+	"sort"
+
+	"github.com/provlabs/vault/types"
+
+	"cosmossdk.io/collections"
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// SetNAVSources updates the NAV sources and their aggregation for a vault, discarding the
+// submissions of sources dropped from the set.
+func (k *Keeper) SetNAVSources(ctx sdk.Context, vault *types.VaultAccount, sources []string, quorum uint32, aggregation types.NAVAggregation, maxAgeSeconds uint64, authority string) error {
+	if slices.Equal(vault.NavSources, sources) && vault.NavSourceQuorum == quorum &&
+		vault.NavAggregation == aggregation && vault.NavSourceMaxAgeSeconds == maxAgeSeconds {
+		return nil
+	}
+
+	vault.NavSources = sources
+	vault.NavSourceQuorum = quorum
+	vault.NavAggregation = aggregation
+	vault.NavSourceMaxAgeSeconds = maxAgeSeconds
+	if err := k.SetVaultAccount(ctx, vault); err != nil {
+		return fmt.Errorf("failed to set vault account: %w", err)
+	}
+
+	var revoked []collections.Triple[sdk.AccAddress, string, sdk.AccAddress]
+	submissionRange := collections.NewPrefixedTripleRange[sdk.AccAddress, string, sdk.AccAddress](vault.GetAddress())
+	err := k.NAVSourceSubmissions.Walk(ctx, submissionRange, func(key collections.Triple[sdk.AccAddress, string, sdk.AccAddress], _ types.VaultNAV) (bool, error) {
+		if !vault.IsNAVSource(key.K3().String()) {
+			revoked = append(revoked, key)
+		}
+		return false, nil
+	})
+	if err != nil {
+		return fmt.Errorf("failed to walk NAV source submissions for vault %s: %w", vault.Address, err)
+	}
+	for _, key := range revoked {
+		if err := k.NAVSourceSubmissions.Remove(ctx, key); err != nil {
+			return fmt.Errorf("failed to remove NAV source submission for denom %q from source %s: %w", key.K2(), key.K3(), err)
+		}
+	}
+
+	k.emitEvent(ctx, types.NewEventNAVSourcesUpdated(vault.Address, authority, sources, quorum, aggregation, maxAgeSeconds))
+	return nil
+}
+
+// SubmitSourceNAV records nav as source's latest price for nav.Denom in the vault's
+// per-source table and emits an EventSourceNAVSubmitted. The submission is validated
+// exactly as SetVaultNAV validates a NAV authority update, including the vault's
+// acquisition policy for a denom it does not hold. Its source is set to the submitting
+// address, and its updated block height and time are stamped from ctx.
+//
+// The submission does not touch the NAV authority's entry; it feeds the aggregate that
+// UnitPriceFraction uses once the vault's quorum of fresh submissions exists. When the
+// submission changes that aggregate, the new aggregate is appended to the vault's NAV
+// history. Like a NAV authority update, it is held to max_nav_change_bips: a submission
+// that would move a held denom's aggregate by more than the limit from the price in
+// effect before it is rejected, since sources have no approval path. The caller is
+// responsible for verifying that source is one of the vault's NAV sources.
+func (k *Keeper) SubmitSourceNAV(ctx sdk.Context, vault *types.VaultAccount, nav types.VaultNAV, source sdk.AccAddress) error {
+	nav.Source = source.String()
+	if err := validateVaultNAVFields(vault, nav); err != nil {
+		return err
+	}
+	if err := k.requireNAVDenomRegistered(ctx, nav.Denom); err != nil {
+		return err
+	}
+	if err := k.requireNAVAcquisitionPermitted(ctx, vault, nav.Denom); err != nil {
+		return err
+	}
+
+	previous, hadPrevious, err := k.internalNAV(ctx, *vault, nav.Denom)
+	if err != nil {
+		return err
+	}
+
+	nav.UpdatedBlockHeight = ctx.BlockHeight()
+	nav.UpdatedTime = ctx.BlockTime().UTC()
+	cacheCtx, write := ctx.CacheContext()
+	if err := k.NAVSourceSubmissions.Set(cacheCtx, collections.Join3(vault.GetAddress(), nav.Denom, source), nav); err != nil {
+		return fmt.Errorf("failed to store NAV source submission: %w", err)
+	}
+	agg, found, err := k.AggregatedSourceNAV(cacheCtx, *vault, nav.Denom)
+	if err != nil {
+		return err
+	}
+	if found && hadPrevious && vault.MaxNavChangeBips > 0 && !k.heldBalance(ctx, *vault, nav.Denom).IsZero() {
+		exceeds, err := navChangeExceedsLimit(previous, agg, vault.MaxNavChangeBips)
+		if err != nil {
+			return fmt.Errorf("failed to check NAV change limit: %w", err)
+		}
+		if exceeds {
+			return fmt.Errorf("submission would move the aggregated NAV for denom %q on vault %s from %s per %s to %s per %s, more than the %d bips change limit",
+				nav.Denom, vault.Address, previous.Price, previous.Volume, agg.Price, agg.Volume, vault.MaxNavChangeBips)
+		}
+	}
+	write()
+
+	if found && (!hadPrevious || !previous.Price.Equal(agg.Price) || !previous.Volume.Equal(agg.Volume)) {
+		if err := k.recordNAVHistory(ctx, vault.GetAddress(), agg); err != nil {
+			return err
+		}
+	}
+
+	k.emitEvent(ctx, types.NewEventSourceNAVSubmitted(types.NAVSourceSubmission{
+		VaultAddress: vault.Address,
+		Source:       source.String(),
+		Nav:          nav,
+	}))
+	return nil
+}
+
+// NAVSourceContributions returns every source submission for denom on the vault, in
+// source address order, marking each as fresh when it is no older than the vault's
+// nav_source_max_age_seconds at the current block time.
+func (k Keeper) NAVSourceContributions(ctx sdk.Context, vault types.VaultAccount, denom string) ([]types.NAVSourceContribution, error) {
+	var contributions []types.NAVSourceContribution
+	submissionRange := collections.NewSuperPrefixedTripleRange[sdk.AccAddress, string, sdk.AccAddress](vault.GetAddress(), denom)
+	err := k.NAVSourceSubmissions.Walk(ctx, submissionRange, func(key collections.Triple[sdk.AccAddress, string, sdk.AccAddress], nav types.VaultNAV) (bool, error) {
+		contributions = append(contributions, types.NAVSourceContribution{
+			Source: key.K3().String(),
+			Nav:    nav,
+			Fresh:  navAgeSeconds(ctx, nav) <= vault.NavSourceMaxAgeSeconds,
+		})
+		return false, nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to walk NAV source submissions for denom %q on vault %s: %w", denom, vault.Address, err)
+	}
+	return contributions, nil
+}
+
+// AggregatedSourceNAV combines the vault's fresh source submissions for denom into a
+// single NAV entry according to the vault's nav_aggregation. found is false when
+// aggregation is disabled or fewer than nav_source_quorum fresh submissions exist, in
+// which case the NAV authority's entry prices the denom.
+//
+// Submissions are ordered by per-unit price using cross-multiplication. The median of an
+// even number of submissions is the mean of the two middle prices, expressed exactly as
+// (p1*v2 + p2*v1) / (2*v1*v2). The aggregate is stamped with the oldest contributing
+// submission's height and time so it never appears fresher than its inputs.
+func (k Keeper) AggregatedSourceNAV(ctx sdk.Context, vault types.VaultAccount, denom string) (agg types.VaultNAV, found bool, err error) {
+	if vault.NavSourceQuorum == 0 {
+		return types.VaultNAV{}, false, nil
+	}
+
+	contributions, err := k.NAVSourceContributions(ctx, vault, denom)
+	if err != nil {
+		return types.VaultNAV{}, false, err
+	}
+	fresh := make([]types.VaultNAV, 0, len(contributions))
+	for _, c := range contributions {
+		if c.Fresh {
+			fresh = append(fresh, c.Nav)
+		}
+	}
+	if len(fresh) < int(vault.NavSourceQuorum) {
+		return types.VaultNAV{}, false, nil
+	}
+
+	var sortErr error
+	sort.SliceStable(fresh, func(i, j int) bool {
+		lhs, err := fresh[i].Price.Amount.SafeMul(fresh[j].Volume)
+		if err != nil {
+			sortErr = err
+			return false
+		}
+		rhs, err := fresh[j].Price.Amount.SafeMul(fresh[i].Volume)
+		if err != nil {
+			sortErr = err
+			return false
+		}
+		return lhs.LT(rhs)
+	})
+	if sortErr != nil {
+		return types.VaultNAV{}, false, fmt.Errorf("failed to order NAV source submissions for denom %q on vault %s: %w", denom, vault.Address, sortErr)
+	}
+
+	oldest := fresh[0]
+	for _, nav := range fresh[1:] {
+		if nav.UpdatedTime.Before(oldest.UpdatedTime) {
+			oldest = nav
+		}
+	}
+
+	var price, volume sdkmath.Int
+	switch {
+	case vault.NavAggregation == types.NAVAggregation_NAV_AGGREGATION_MIN:
+		price, volume = fresh[0].Price.Amount, fresh[0].Volume
+	case len(fresh)%2 == 1:
+		mid := fresh[len(fresh)/2]
+		price, volume = mid.Price.Amount, mid.Volume
+	default:
+		lo, hi := fresh[len(fresh)/2-1], fresh[len(fresh)/2]
+		price, volume, err = meanNAVPrice(lo, hi)
+		if err != nil {
+			return types.VaultNAV{}, false, fmt.Errorf("failed to average NAV source submissions for denom %q on vault %s: %w", denom, vault.Address, err)
+		}
+	}
+
+	return types.VaultNAV{
+		Denom:              denom,
+		Price:              sdk.NewCoin(vault.UnderlyingAsset, price),
+		Volume:             volume,
+		Source:             vault.NavAggregation.String(),
+		UpdatedBlockHeight: oldest.UpdatedBlockHeight,
+		UpdatedTime:        oldest.UpdatedTime,
+	}, true, nil
+}
+
+// meanNAVPrice returns the price and volume of the mean of two per-unit prices as the
+// exact fraction (a.Price*b.Volume + b.Price*a.Volume) / (2*a.Volume*b.Volume).
+func meanNAVPrice(a, b types.VaultNAV) (sdkmath.Int, sdkmath.Int, error) {
+	lhs, err := a.Price.Amount.SafeMul(b.Volume)
+	if err != nil {
+		return sdkmath.Int{}, sdkmath.Int{}, err
+	}
+	rhs, err := b.Price.Amount.SafeMul(a.Volume)
+	if err != nil {
+		return sdkmath.Int{}, sdkmath.Int{}, err
+	}
+	price, err := lhs.SafeAdd(rhs)
+	if err != nil {
+		return sdkmath.Int{}, sdkmath.Int{}, err
+	}
+	volume, err := a.Volume.SafeMul(b.Volume)
+	if err != nil {
+		return sdkmath.Int{}, sdkmath.Int{}, err
+	}
+	volume, err = volume.SafeMul(sdkmath.NewInt(2))
+	if err != nil {
+		return sdkmath.Int{}, sdkmath.Int{}, err
+	}
+	return price, volume, nil
+}
+
+// removeNAVSourceSubmissions discards every source submission for denom on the vault.
+// It is a no-op when none exist.
+func (k *Keeper) removeNAVSourceSubmissions(ctx sdk.Context, vault *types.VaultAccount, denom string) error {
+	submissionRange := collections.NewSuperPrefixedTripleRange[sdk.AccAddress, string, sdk.AccAddress](vault.GetAddress(), denom)
+	if err := k.NAVSourceSubmissions.Clear(ctx, submissionRange); err != nil {
+		return fmt.Errorf("failed to remove NAV source submissions for denom %q on vault %s: %w", denom, vault.Address, err)
+	}
+	return nil
+}
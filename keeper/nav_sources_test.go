@@ -0,0 +1,425 @@
+package keeper_test
+
+import (
+	"time"
+
+	"github.com/provlabs/vault/keeper"
+	"github.com/provlabs/vault/types"
+
+	"cosmossdk.io/collections"
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// navSourceAddrs returns the three NAV source addresses used by the NAV source tests.
+func navSourceAddrs() []sdk.AccAddress {
+	return []sdk.AccAddress{
+		sdk.AccAddress("nav_source_1________"),
+		sdk.AccAddress("nav_source_2________"),
+		sdk.AccAddress("nav_source_3________"),
+	}
+}
+
+// setupNAVSourcesVault creates a vault holding heldDenom priced at par (see
+// setupStaleNAVVault) with the three navSourceAddrs registered as NAV sources, the
+// given quorum and aggregation, and a one-hour submission freshness window.
+func (s *TestSuite) setupNAVSourcesVault(underlying, share, heldDenom string, quorum uint32, aggregation types.NAVAggregation) *types.VaultAccount {
+	vault := s.setupStaleNAVVault(underlying, share, heldDenom, 0, false)
+	var sources []string
+	for _, addr := range navSourceAddrs() {
+		sources = append(sources, addr.String())
+	}
+	s.Require().NoError(s.k.SetNAVSources(s.ctx, vault, sources, quorum, aggregation, 3600, s.adminAddr.String()), "should set NAV sources")
+	return vault
+}
+
+// submitSourceNAV records a source submission for denom through the msg server.
+func (s *TestSuite) submitSourceNAV(vault *types.VaultAccount, source sdk.AccAddress, denom string, price sdk.Coin, volume int64) {
+	_, err := keeper.NewMsgServer(s.simApp.VaultKeeper).SubmitSourceNAV(s.ctx, &types.MsgSubmitSourceNAVRequest{
+		Source:       source.String(),
+		VaultAddress: vault.Address,
+		Denom:        denom,
+		Price:        price,
+		Volume:       sdkmath.NewInt(volume),
+	})
+	s.Require().NoError(err, "SubmitSourceNAV from %s should succeed", source)
+}
+
+func (s *TestSuite) TestAggregatedSourceNAV() {
+	underlying, share, held := "under", "vshare", "heldcoin"
+	sources := navSourceAddrs()
+
+	type submission struct {
+		source sdk.AccAddress
+		price  int64
+		volume int64
+	}
+
+	tests := []struct {
+		name        string
+		quorum      uint32
+		aggregation types.NAVAggregation
+		submissions []submission
+		setup       func()
+		expectFound bool
+		expectNum   int64
+		expectDen   int64
+	}{
+		{
+			name:        "aggregation disabled uses the NAV authority entry",
+			quorum:      0,
+			submissions: []submission{{sources[0], 5, 1}, {sources[1], 5, 1}},
+			expectNum:   1,
+			expectDen:   1,
+		},
+		{
+			name:        "below quorum uses the NAV authority entry",
+			quorum:      3,
+			submissions: []submission{{sources[0], 5, 1}, {sources[1], 5, 1}},
+			expectNum:   1,
+			expectDen:   1,
+		},
+		{
+			name:        "odd count uses the median submission",
+			quorum:      3,
+			submissions: []submission{{sources[0], 3, 2}, {sources[1], 1, 1}, {sources[2], 5, 2}},
+			expectFound: true,
+			expectNum:   3,
+			expectDen:   2,
+		},
+		{
+			name:        "even count averages the middle submissions",
+			quorum:      2,
+			submissions: []submission{{sources[0], 1, 1}, {sources[1], 2, 1}},
+			expectFound: true,
+			expectNum:   3,
+			expectDen:   2,
+		},
+		{
+			name:        "min aggregation uses the lowest submission",
+			quorum:      2,
+			aggregation: types.NAVAggregation_NAV_AGGREGATION_MIN,
+			submissions: []submission{{sources[0], 3, 2}, {sources[1], 1, 2}, {sources[2], 5, 2}},
+			expectFound: true,
+			expectNum:   1,
+			expectDen:   2,
+		},
+		{
+			name:        "stale submissions do not count toward the quorum",
+			quorum:      2,
+			submissions: []submission{{sources[0], 5, 1}},
+			setup: func() {
+				s.SetCtxBlockTime(s.ctx.BlockTime().Add(3601 * time.Second))
+			},
+			expectNum: 1,
+			expectDen: 1,
+		},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+			vault := s.setupNAVSourcesVault(underlying, share, held, tc.quorum, tc.aggregation)
+			for _, sub := range tc.submissions {
+				s.submitSourceNAV(vault, sub.source, held, sdk.NewInt64Coin(underlying, sub.price), sub.volume)
+			}
+			if tc.setup != nil {
+				tc.setup()
+				s.submitSourceNAV(vault, sources[1], held, sdk.NewInt64Coin(underlying, 7), 1)
+			}
+
+			agg, found, err := s.k.AggregatedSourceNAV(s.ctx, *vault, held)
+			s.Require().NoError(err, "AggregatedSourceNAV should not error")
+			s.Assert().Equal(tc.expectFound, found, "aggregate found")
+			if tc.expectFound {
+				s.Assert().Equal(sdk.NewInt64Coin(underlying, tc.expectNum), agg.Price, "aggregate price")
+				s.Assert().Equal(sdkmath.NewInt(tc.expectDen), agg.Volume, "aggregate volume")
+			}
+
+			num, den, err := s.k.UnitPriceFraction(s.ctx, held, *vault)
+			s.Require().NoError(err, "UnitPriceFraction should not error")
+			s.Assert().Equal(sdkmath.NewInt(tc.expectNum), num, "unit price numerator")
+			s.Assert().Equal(sdkmath.NewInt(tc.expectDen), den, "unit price denominator")
+		})
+	}
+}
+
+func (s *TestSuite) TestMsgServer_SubmitSourceNAV() {
+	underlying, share, held := "under", "vshare", "heldcoin"
+	source := navSourceAddrs()[0]
+
+	tests := []struct {
+		name      string
+		signer    sdk.AccAddress
+		denom     string
+		expectErr string
+	}{
+		{name: "registered source submits a price", signer: source, denom: held},
+		{name: "NAV authority is not a source", signer: s.adminAddr, denom: held, expectErr: "is not a NAV source"},
+		{name: "share denom cannot be priced", signer: source, denom: share, expectErr: "cannot set NAV for vault share denom"},
+		{name: "unregistered denom cannot be priced", signer: source, denom: "nosuchcoin", expectErr: "is not a registered marker"},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+			vault := s.setupNAVSourcesVault(underlying, share, held, 2, types.NAVAggregation_NAV_AGGREGATION_MEDIAN)
+			s.ctx = s.ctx.WithEventManager(sdk.NewEventManager())
+
+			price := sdk.NewInt64Coin(underlying, 2)
+			_, err := keeper.NewMsgServer(s.simApp.VaultKeeper).SubmitSourceNAV(s.ctx, &types.MsgSubmitSourceNAVRequest{
+				Source:       tc.signer.String(),
+				VaultAddress: vault.Address,
+				Denom:        tc.denom,
+				Price:        price,
+				Volume:       sdkmath.NewInt(1),
+			})
+			if tc.expectErr != "" {
+				s.Require().ErrorContains(err, tc.expectErr, "SubmitSourceNAV error")
+				return
+			}
+			s.Require().NoError(err, "SubmitSourceNAV should succeed")
+
+			stored, err := s.k.NAVSourceSubmissions.Get(s.ctx, collections.Join3(vault.GetAddress(), tc.denom, tc.signer))
+			s.Require().NoError(err, "submission should be stored")
+			s.Assert().Equal(price, stored.Price, "submitted price")
+			s.Assert().Equal(tc.signer.String(), stored.Source, "submission source")
+			s.Assert().Equal(s.ctx.BlockTime(), stored.UpdatedTime, "submission time")
+			nav, err := s.k.GetVaultNAV(s.ctx, vault.GetAddress(), held)
+			s.Require().NoError(err, "NAV entry should exist")
+			s.Assert().Equal(sdk.NewInt64Coin(underlying, 1), nav.Price, "NAV authority entry should be unchanged")
+			s.requireTypedEventEmitted(types.NewEventSourceNAVSubmitted(types.NAVSourceSubmission{
+				VaultAddress: vault.Address,
+				Source:       tc.signer.String(),
+				Nav:          stored,
+			}))
+		})
+	}
+}
+
+func (s *TestSuite) TestMsgServer_SubmitSourceNAV_ChangeLimit() {
+	underlying, share, held := "under", "vshare", "heldcoin"
+	sources := navSourceAddrs()
+
+	s.SetupTest()
+	msgServer := keeper.NewMsgServer(s.simApp.VaultKeeper)
+	vault := s.setupNAVSourcesVault(underlying, share, held, 2, types.NAVAggregation_NAV_AGGREGATION_MEDIAN)
+	approver := sdk.AccAddress("nav_approver________").String()
+	s.Require().NoError(s.k.SetNAVChangeLimit(s.ctx, vault, 100, approver, s.adminAddr.String()), "should set NAV change limit")
+	submit := func(source sdk.AccAddress, price int64) error {
+		_, err := msgServer.SubmitSourceNAV(s.ctx, &types.MsgSubmitSourceNAVRequest{
+			Source:       source.String(),
+			VaultAddress: vault.Address,
+			Denom:        held,
+			Price:        sdk.NewInt64Coin(underlying, price),
+			Volume:       sdkmath.NewInt(100),
+		})
+		return err
+	}
+
+	s.Require().NoError(submit(sources[0], 101), "a submission below quorum moves no price")
+	s.Require().ErrorContains(submit(sources[1], 102), "more than the 100 bips change limit",
+		"a submission that moves the aggregate 150 bips from the NAV authority entry should be rejected")
+	_, err := s.k.NAVSourceSubmissions.Get(s.ctx, collections.Join3(vault.GetAddress(), held, sources[1]))
+	s.Require().ErrorIs(err, collections.ErrNotFound, "a rejected submission should not be stored")
+
+	s.Require().NoError(submit(sources[1], 101), "a submission that moves the aggregate by the limit should be accepted")
+	agg, found, err := s.k.AggregatedSourceNAV(s.ctx, *vault, held)
+	s.Require().NoError(err, "AggregatedSourceNAV should not error")
+	s.Require().True(found, "quorum should be met")
+	point, err := s.k.NAVHistory.Get(s.ctx, collections.Join3(vault.GetAddress(), held, s.ctx.BlockTime().UTC()))
+	s.Require().NoError(err, "the new aggregate should be recorded in NAV history")
+	s.Assert().Equal(agg, point, "NAV history point")
+}
+
+func (s *TestSuite) TestMsgServer_UpdateNAVSources() {
+	underlying, share, held := "under", "vshare", "heldcoin"
+	sources := navSourceAddrs()
+
+	tests := []struct {
+		name          string
+		signer        func() string
+		sources       []string
+		quorum        uint32
+		expectErr     string
+		expectEvent   bool
+		expectRemoved bool
+	}{
+		{
+			name:        "admin keeps sources and changes the quorum",
+			signer:      func() string { return s.adminAddr.String() },
+			sources:     []string{sources[0].String(), sources[1].String(), sources[2].String()},
+			quorum:      3,
+			expectEvent: true,
+		},
+		{
+			name:          "removed source's submissions are discarded",
+			signer:        func() string { return s.adminAddr.String() },
+			sources:       []string{sources[1].String(), sources[2].String()},
+			quorum:        2,
+			expectEvent:   true,
+			expectRemoved: true,
+		},
+		{
+			name:    "unchanged settings are a no-op",
+			signer:  func() string { return s.adminAddr.String() },
+			sources: []string{sources[0].String(), sources[1].String(), sources[2].String()},
+			quorum:  2,
+		},
+		{
+			name:      "asset manager cannot update sources",
+			signer:    func() string { return s.assetManagerAddr.String() },
+			sources:   []string{sources[0].String()},
+			quorum:    1,
+			expectErr: "failed to validate admin",
+		},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+			vault := s.setupNAVSourcesVault(underlying, share, held, 2, types.NAVAggregation_NAV_AGGREGATION_MEDIAN)
+			s.submitSourceNAV(vault, sources[0], held, sdk.NewInt64Coin(underlying, 2), 1)
+			s.ctx = s.ctx.WithEventManager(sdk.NewEventManager())
+
+			_, err := keeper.NewMsgServer(s.simApp.VaultKeeper).UpdateNAVSources(s.ctx, &types.MsgUpdateNAVSourcesRequest{
+				Authority:              tc.signer(),
+				VaultAddress:           vault.Address,
+				NavSources:             tc.sources,
+				NavSourceQuorum:        tc.quorum,
+				NavSourceMaxAgeSeconds: 3600,
+			})
+			if tc.expectErr != "" {
+				s.Require().ErrorContains(err, tc.expectErr, "UpdateNAVSources error")
+				return
+			}
+			s.Require().NoError(err, "UpdateNAVSources should succeed")
+
+			updated, err := s.k.GetVault(s.ctx, vault.GetAddress())
+			s.Require().NoError(err, "should reload vault")
+			s.Assert().Equal(tc.sources, updated.NavSources, "stored NAV sources")
+			s.Assert().Equal(tc.quorum, updated.NavSourceQuorum, "stored NAV source quorum")
+			has, err := s.k.NAVSourceSubmissions.Has(s.ctx, collections.Join3(vault.GetAddress(), held, sources[0]))
+			s.Require().NoError(err, "NAVSourceSubmissions.Has should not error")
+			s.Assert().Equal(!tc.expectRemoved, has, "first source's submission presence")
+			if tc.expectEvent {
+				s.requireTypedEventEmitted(types.NewEventNAVSourcesUpdated(vault.Address, tc.signer(), tc.sources, tc.quorum, types.NAVAggregation_NAV_AGGREGATION_MEDIAN, 3600))
+			} else {
+				s.Assert().Empty(s.ctx.EventManager().Events(), "no-op update should not emit events")
+			}
+		})
+	}
+}
+
+func (s *TestSuite) TestRemoveVaultNAV_DiscardsSourceSubmissions() {
+	underlying, share := "under", "vshare"
+	source := navSourceAddrs()[0]
+	vault := s.setupNAVSourcesVault(underlying, share, "heldcoin", 1, types.NAVAggregation_NAV_AGGREGATION_MEDIAN)
+	s.requireSimpleMarker("unheldcoin")
+	s.setVaultNAV(vault, "unheldcoin", sdk.NewInt64Coin(underlying, 1), 1)
+	s.submitSourceNAV(vault, source, "unheldcoin", sdk.NewInt64Coin(underlying, 2), 1)
+
+	s.Require().NoError(s.k.RemoveVaultNAV(s.ctx, vault, "unheldcoin", s.adminAddr.String()), "RemoveVaultNAV should succeed")
+
+	has, err := s.k.NAVSourceSubmissions.Has(s.ctx, collections.Join3(vault.GetAddress(), "unheldcoin", source))
+	s.Require().NoError(err, "NAVSourceSubmissions.Has should not error")
+	s.Assert().False(has, "source submission should be removed with the NAV entry")
+}
+
+func (s *TestSuite) TestQueryServer_NAVSources() {
+	underlying, share, held := "under", "vshare", "heldcoin"
+	sources := navSourceAddrs()
+	vault := s.setupNAVSourcesVault(underlying, share, held, 2, types.NAVAggregation_NAV_AGGREGATION_MEDIAN)
+	s.submitSourceNAV(vault, sources[0], held, sdk.NewInt64Coin(underlying, 2), 1)
+	s.SetCtxBlockTime(s.ctx.BlockTime().Add(3601 * time.Second))
+	s.submitSourceNAV(vault, sources[1], held, sdk.NewInt64Coin(underlying, 3), 1)
+	s.submitSourceNAV(vault, sources[2], held, sdk.NewInt64Coin(underlying, 4), 1)
+
+	tests := []struct {
+		name      string
+		id        string
+		denom     string
+		expectErr string
+	}{
+		{name: "by vault address", id: vault.Address, denom: held},
+		{name: "by share denom", id: share, denom: held},
+		{name: "unknown vault", id: "unknownshare", denom: held, expectErr: "not found"},
+		{name: "empty denom", id: vault.Address, expectErr: "denom must be provided"},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			resp, err := keeper.NewQueryServer(s.simApp.VaultKeeper).NAVSources(s.ctx, &types.QueryNAVSourcesRequest{Id: tc.id, Denom: tc.denom})
+			if tc.expectErr != "" {
+				s.Require().ErrorContains(err, tc.expectErr, "NAVSources error")
+				return
+			}
+			s.Require().NoError(err, "NAVSources should succeed")
+			s.Require().Len(resp.Contributions, 3, "number of contributions")
+			fresh := make(map[string]bool)
+			for _, c := range resp.Contributions {
+				fresh[c.Source] = c.Fresh
+			}
+			s.Assert().False(fresh[sources[0].String()], "first source's submission should be stale")
+			s.Assert().True(fresh[sources[1].String()], "second source's submission should be fresh")
+			s.Assert().Equal(uint32(2), resp.Quorum, "quorum")
+			s.Require().NotNil(resp.Aggregate, "aggregate should be set once the quorum is met")
+			s.Assert().Equal(sdk.NewInt64Coin(underlying, 7), resp.Aggregate.Price, "aggregate price")
+			s.Assert().Equal(sdkmath.NewInt(2), resp.Aggregate.Volume, "aggregate volume")
+		})
+	}
+}
+
+func (s *TestSuite) TestMsgServer_AcceptAsset_NAVSourceAggregate() {
+	underlying, share, asset := "under", "vshare", "rwacoin"
+	externalID := "aggregate-settlement"
+
+	tests := []struct {
+		name         string
+		paymentPrice int64
+		expectErr    string
+	}{
+		{name: "settlement at the aggregate price passes the guardrail", paymentPrice: 6},
+		{name: "settlement at the NAV authority entry is rejected once the quorum is met", paymentPrice: 5, expectErr: "does not match internal NAV of 120under per 200rwacoin"},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+			sourceAmount := sdk.NewCoins(sdk.NewInt64Coin(asset, 10))
+			targetAmount := sdk.NewCoins(sdk.NewInt64Coin(underlying, tc.paymentPrice))
+			vault, _, source := s.setupAcceptAssetScenario(acceptAssetScenario{
+				underlying:    underlying,
+				share:         share,
+				assetMarker:   asset,
+				seedNav:       &types.VaultNAV{Denom: asset, Price: sdk.NewInt64Coin(underlying, 5), Volume: sdkmath.NewInt(10)},
+				fundSource:    sourceAmount,
+				fundPrincipal: targetAmount,
+				sourceAmount:  sourceAmount,
+				targetAmount:  targetAmount,
+				externalID:    externalID,
+			})
+			var sources []string
+			for _, addr := range navSourceAddrs()[:2] {
+				sources = append(sources, addr.String())
+			}
+			s.Require().NoError(s.k.SetNAVSources(s.ctx, vault, sources, 2, types.NAVAggregation_NAV_AGGREGATION_MEDIAN, 3600, s.adminAddr.String()), "should set NAV sources")
+			for _, addr := range navSourceAddrs()[:2] {
+				s.submitSourceNAV(vault, addr, asset, sdk.NewInt64Coin(underlying, 6), 10)
+			}
+
+			_, err := keeper.NewMsgServer(s.simApp.VaultKeeper).AcceptAsset(s.ctx, &types.MsgAcceptAssetRequest{
+				Authority:    s.assetManagerAddr.String(),
+				VaultAddress: vault.Address,
+				Source:       source.String(),
+				ExternalId:   externalID,
+			})
+			if tc.expectErr != "" {
+				s.Require().ErrorContains(err, tc.expectErr, "AcceptAsset error")
+				return
+			}
+			s.Require().NoError(err, "AcceptAsset should succeed")
+		})
+	}
+}
@@ -0,0 +1,190 @@
+package keeper
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/provlabs/vault/types"
+
+	"cosmossdk.io/collections"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// StaleNAVPauseReason is the stable reason recorded on a vault that the EndBlocker
+// auto-paused because a held denom's internal NAV exceeded max_nav_age_seconds.
+const StaleNAVPauseReason = "internal NAV exceeded max_nav_age_seconds"
+
+// navAgeSeconds returns the age of a NAV entry in whole seconds at the block time of
+// ctx. An entry stamped after the current block time (unreachable through SetVaultNAV,
+// which stamps the block time) is treated as age zero.
+func navAgeSeconds(ctx sdk.Context, nav types.VaultNAV) uint64 {
+	age := ctx.BlockTime().Unix() - nav.UpdatedTime.Unix()
+	if age < 0 {
+		return 0
+	}
+	return uint64(age)
+}
+
+// OldestHeldNAV returns the price that was updated least recently among those valuing the
+// denoms the vault currently holds at its principal marker, along with its age in seconds
+// at the current block time. Like GetTVV, it walks the vault's NAV table and skips the
+// underlying asset, the share denom, and any denom with a zero principal balance, since
+// none of those draw a price from the table.
+//
+// Each denom is measured by the price valuing it (see heldNAVInUse), so a fresh NAV source
+// aggregate or a fresh fallback source keeps a stale NAV authority entry from counting.
+//
+// found is false when the vault holds no NAV-priced denom beyond its underlying asset.
+func (k Keeper) OldestHeldNAV(ctx sdk.Context, vault types.VaultAccount) (oldest types.VaultNAV, age uint64, found bool, err error) {
+	navRange := collections.NewPrefixedPairRange[sdk.AccAddress, string](vault.GetAddress())
+	err = k.NAVs.Walk(ctx, navRange, func(key collections.Pair[sdk.AccAddress, string], entry types.VaultNAV) (bool, error) {
+		denom := key.K2()
+		if denom == vault.TotalShares.Denom || denom == vault.UnderlyingAsset {
+			return false, nil
+		}
+		if k.heldBalance(ctx, vault, denom).IsZero() {
+			return false, nil
+		}
+		nav, err := k.heldNAVInUse(ctx, vault, entry)
+		if err != nil {
+			return true, err
+		}
+		if !found || nav.UpdatedTime.Before(oldest.UpdatedTime) {
+			oldest, found = nav, true
+		}
+		return false, nil
+	})
+	if err != nil {
+		return types.VaultNAV{}, 0, false, fmt.Errorf("failed to iterate vault NAV entries for %s: %w", vault.GetAddress(), err)
+	}
+	if !found {
+		return types.VaultNAV{}, 0, false, nil
+	}
+	return oldest, navAgeSeconds(ctx, oldest), true, nil
+}
+
+// heldNAVInUse returns the price whose age counts against max_nav_age_seconds for a held
+// denom with the internal NAV entry. That is the NAV source aggregate when its quorum is
+// met, otherwise the price the vault's price-source chain resolves. The entry itself is
+// returned when no source has a fresh price, so the age reported is that of the stale
+// entry, and when the marker NAV resolves, since it records no update time.
+func (k Keeper) heldNAVInUse(ctx sdk.Context, vault types.VaultAccount, entry types.VaultNAV) (types.VaultNAV, error) {
+	agg, found, err := k.AggregatedSourceNAV(ctx, vault, entry.Denom)
+	if err != nil {
+		return types.VaultNAV{}, err
+	}
+	if found {
+		return agg, nil
+	}
+	nav, sourceType, found, err := k.resolveNAV(ctx, vault, entry.Denom)
+	switch {
+	case errors.Is(err, types.ErrStaleNAV):
+		return entry, nil
+	case err != nil:
+		return types.VaultNAV{}, err
+	case !found || sourceType == types.PriceSourceType_PRICE_SOURCE_TYPE_MARKER_NAV:
+		return entry, nil
+	}
+	return nav, nil
+}
+
+// requireFreshNAVs enforces the vault's max_nav_age_seconds limit before a
+// pricing-dependent operation. It fails with an error wrapping types.ErrStaleNAV when
+// the oldest NAV among the vault's held denoms, or the NAV of any additional denom the
+// caller is about to trade at (e.g. the asset leg of a settlement the vault does not
+// hold yet), is older than the limit. A denom in denoms without a NAV entry is ignored
+// here; the callers' own pricing checks reject it.
+//
+// The check is disabled when MaxNavAgeSeconds is zero.
+func (k Keeper) requireFreshNAVs(ctx sdk.Context, vault *types.VaultAccount, denoms ...string) error {
+	if vault.MaxNavAgeSeconds == 0 {
+		return nil
+	}
+
+	oldest, age, found, err := k.OldestHeldNAV(ctx, *vault)
+	if err != nil {
+		return err
+	}
+	if found && age > vault.MaxNavAgeSeconds {
+		return staleNAVError(vault, oldest.Denom, age)
+	}
+
+	for _, denom := range denoms {
+		if denom == vault.UnderlyingAsset {
+			continue
+		}
+		nav, found, err := k.internalNAV(ctx, *vault, denom)
+		if err != nil {
+			return err
+		}
+		if !found {
+			continue
+		}
+		if age := navAgeSeconds(ctx, nav); age > vault.MaxNavAgeSeconds {
+			return staleNAVError(vault, denom, age)
+		}
+	}
+
+	return nil
+}
+
+// staleNAVError builds the error returned for a NAV entry older than the vault's limit.
+func staleNAVError(vault *types.VaultAccount, denom string, age uint64) error {
+	return fmt.Errorf("internal NAV for denom %q on vault %s was last updated %d seconds ago, exceeding the %d second limit: %w",
+		denom, vault.Address, age, vault.MaxNavAgeSeconds, types.ErrStaleNAV)
+}
+
+// SetNAVStalenessLimit updates the NAV staleness limit and stale-NAV auto-pause for a vault.
+func (k *Keeper) SetNAVStalenessLimit(ctx sdk.Context, vault *types.VaultAccount, maxNAVAgeSeconds uint64, autoPause bool, authority string) error {
+	if vault.MaxNavAgeSeconds == maxNAVAgeSeconds && vault.AutoPauseOnStaleNav == autoPause {
+		return nil
+	}
+	vault.MaxNavAgeSeconds = maxNAVAgeSeconds
+	vault.AutoPauseOnStaleNav = autoPause
+	if err := k.SetVaultAccount(ctx, vault); err != nil {
+		return fmt.Errorf("failed to set vault account: %w", err)
+	}
+	k.emitEvent(ctx, types.NewEventNAVStalenessLimitUpdated(vault.Address, authority, maxNAVAgeSeconds, autoPause))
+	return nil
+}
+
+// handleStaleNAVVaults auto-pauses every unpaused vault that opted in with
+// auto_pause_on_stale_nav and holds a denom whose internal NAV is older than its
+// max_nav_age_seconds. Pausing freezes the share price at the last valuation, so a
+// stale mark cannot keep pricing redemptions queued before the limit was crossed; the
+// NAV authority refreshes the price and the admin unpauses.
+//
+// Vault creation is governance-gated, so the vault set is small enough to walk in full
+// each block. Stale vaults are collected first and paused after the walk completes. A
+// vault that fails to load or value is logged and skipped so one bad vault cannot halt
+// the chain.
+func (k *Keeper) handleStaleNAVVaults(ctx sdk.Context) error {
+	var stale []*types.VaultAccount
+	err := k.Vaults.Walk(ctx, nil, func(addr sdk.AccAddress, _ []byte) (bool, error) {
+		vault, err := k.GetVault(ctx, addr)
+		if err != nil || vault == nil {
+			k.getLogger(ctx).Error("failed to load vault for NAV staleness check", "vault_address", addr.String(), "error", err)
+			return false, nil
+		}
+		if vault.Paused || !vault.AutoPauseOnStaleNav || vault.MaxNavAgeSeconds == 0 {
+			return false, nil
+		}
+		if err := k.requireFreshNAVs(ctx, vault); err != nil {
+			if errors.Is(err, types.ErrStaleNAV) {
+				stale = append(stale, vault)
+				return false, nil
+			}
+			k.getLogger(ctx).Error("failed to check vault NAV staleness", "vault_address", addr.String(), "error", err)
+		}
+		return false, nil
+	})
+	if err != nil {
+		return fmt.Errorf("failed to walk vaults: %w", err)
+	}
+
+	for _, vault := range stale {
+		k.autoPauseVault(ctx, vault, StaleNAVPauseReason)
+	}
+	return nil
+}
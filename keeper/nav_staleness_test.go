@@ -0,0 +1,336 @@
+package keeper_test
+
+import (
+	"time"
+
+	"github.com/provlabs/vault/keeper"
+	"github.com/provlabs/vault/types"
+
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	markertypes "github.com/provenance-io/provenance/x/marker/types"
+)
+
+// setupStaleNAVVault creates a swap-enabled vault holding heldDenom at its principal
+// marker, priced at par through the internal NAV table at the current block time, and
+// applies the given staleness limit. It returns the refreshed vault.
+func (s *TestSuite) setupStaleNAVVault(underlying, share, heldDenom string, maxAge uint64, autoPause bool) *types.VaultAccount {
+	s.SetCtxBlockTime(time.Unix(1_700_000_000, 0).UTC())
+	vault := s.setupHeldAssetVault(underlying, share, heldDenom, 1, 1)
+	s.Require().NoError(FundAccount(s.ctx, s.simApp.BankKeeper, vault.PrincipalMarkerAddress(), sdk.NewCoins(sdk.NewInt64Coin(heldDenom, 1_000))),
+		"should fund principal with held denom %s", heldDenom)
+	vault.SwapInEnabled = true
+	vault.SwapOutEnabled = true
+	s.Require().NoError(s.k.SetVaultAccount(s.ctx, vault), "should enable swaps on vault %s", share)
+	s.Require().NoError(s.k.SetNAVStalenessLimit(s.ctx, vault, maxAge, autoPause, s.adminAddr.String()), "should set NAV staleness limit")
+	vault, err := s.k.GetVault(s.ctx, vault.GetAddress())
+	s.Require().NoError(err, "should reload vault %s", share)
+	return vault
+}
+
+func (s *TestSuite) TestOldestHeldNAV() {
+	underlying, share, held := "under", "vshare", "heldcoin"
+
+	tests := []struct {
+		name        string
+		setup       func(vault *types.VaultAccount)
+		advance     time.Duration
+		expectFound bool
+		expectDenom string
+		expectAge   uint64
+	}{
+		{
+			name:        "held denom reports its age",
+			advance:     90 * time.Second,
+			expectFound: true,
+			expectDenom: held,
+			expectAge:   90,
+		},
+		{
+			name: "oldest of several held denoms is reported",
+			setup: func(vault *types.VaultAccount) {
+				s.SetCtxBlockTime(s.ctx.BlockTime().Add(30 * time.Second))
+				s.requireSimpleMarker("freshcoin")
+				s.setVaultNAV(vault, "freshcoin", sdk.NewInt64Coin(underlying, 1), 1)
+				s.Require().NoError(FundAccount(s.ctx, s.simApp.BankKeeper, vault.PrincipalMarkerAddress(), sdk.NewCoins(sdk.NewInt64Coin("freshcoin", 5))), "should fund fresh denom")
+			},
+			advance:     10 * time.Second,
+			expectFound: true,
+			expectDenom: held,
+			expectAge:   40,
+		},
+		{
+			name: "a fresh NAV source quorum is measured over a stale authority entry",
+			setup: func(vault *types.VaultAccount) {
+				var sources []string
+				for _, addr := range navSourceAddrs() {
+					sources = append(sources, addr.String())
+				}
+				s.Require().NoError(s.k.SetNAVSources(s.ctx, vault, sources, 2, types.NAVAggregation_NAV_AGGREGATION_MEDIAN, 3_600, s.adminAddr.String()), "should set NAV sources")
+				s.SetCtxBlockTime(s.ctx.BlockTime().Add(2 * time.Hour))
+				for _, source := range navSourceAddrs()[:2] {
+					s.submitSourceNAV(vault, source, held, sdk.NewInt64Coin(underlying, 1), 1)
+				}
+			},
+			advance:     60 * time.Second,
+			expectFound: true,
+			expectDenom: held,
+			expectAge:   60,
+		},
+		{
+			name: "a fresh fallback price is measured over a stale authority entry",
+			setup: func(vault *types.VaultAccount) {
+				s.Require().NoError(s.k.SetNAVStalenessLimit(s.ctx, vault, 3_600, false, s.adminAddr.String()), "should set NAV staleness limit")
+				s.setPriceSources(vault, types.PriceSourceType_PRICE_SOURCE_TYPE_INTERNAL_NAV, types.PriceSourceType_PRICE_SOURCE_TYPE_EXCHANGE_SETTLEMENT)
+				s.SetCtxBlockTime(s.ctx.BlockTime().Add(2 * time.Hour))
+				s.setSettlementPrice(vault, held, sdk.NewInt64Coin(underlying, 1), 1)
+			},
+			advance:     10 * time.Second,
+			expectFound: true,
+			expectDenom: held,
+			expectAge:   10,
+		},
+		{
+			name: "a stale authority entry is measured when no source is fresh",
+			setup: func(vault *types.VaultAccount) {
+				s.Require().NoError(s.k.SetNAVStalenessLimit(s.ctx, vault, 3_600, false, s.adminAddr.String()), "should set NAV staleness limit")
+			},
+			advance:     2 * time.Hour,
+			expectFound: true,
+			expectDenom: held,
+			expectAge:   7_200,
+		},
+		{
+			name: "priced but unheld denom is ignored",
+			setup: func(vault *types.VaultAccount) {
+				principal := vault.PrincipalMarkerAddress()
+				bal := s.simApp.BankKeeper.GetBalance(s.ctx, principal, held)
+				s.Require().NoError(s.simApp.BankKeeper.SendCoins(markertypes.WithBypass(s.ctx), principal, s.adminAddr, sdk.NewCoins(bal)), "should drain held denom")
+			},
+			advance:     time.Hour,
+			expectFound: false,
+		},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+			vault := s.setupStaleNAVVault(underlying, share, held, 0, false)
+			if tc.setup != nil {
+				tc.setup(vault)
+			}
+			s.SetCtxBlockTime(s.ctx.BlockTime().Add(tc.advance))
+
+			nav, age, found, err := s.k.OldestHeldNAV(s.ctx, *vault)
+			s.Require().NoError(err, "OldestHeldNAV should not error")
+			s.Require().Equal(tc.expectFound, found, "OldestHeldNAV found")
+			s.Assert().Equal(tc.expectDenom, nav.Denom, "OldestHeldNAV denom")
+			s.Assert().Equal(tc.expectAge, age, "OldestHeldNAV age")
+		})
+	}
+}
+
+func (s *TestSuite) TestNAVStaleness_BlocksPricingDependentOperations() {
+	underlying, share, held := "under", "vshare", "heldcoin"
+
+	tests := []struct {
+		name      string
+		maxAge    uint64
+		advance   time.Duration
+		refresh   bool
+		expectErr bool
+	}{
+		{name: "limit disabled allows an old NAV", maxAge: 0, advance: 48 * time.Hour},
+		{name: "NAV within limit is accepted", maxAge: 3_600, advance: 3_600 * time.Second},
+		{name: "NAV past limit is rejected", maxAge: 3_600, advance: 3_601 * time.Second, expectErr: true},
+		{name: "refreshed NAV is accepted again", maxAge: 3_600, advance: 2 * time.Hour, refresh: true},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+			vault := s.setupStaleNAVVault(underlying, share, held, tc.maxAge, false)
+			s.Require().NoError(FundAccount(s.ctx, s.simApp.BankKeeper, s.adminAddr, sdk.NewCoins(sdk.NewInt64Coin(underlying, 1_000))), "should fund admin")
+			_, err := s.k.SwapIn(s.ctx, vault.GetAddress(), s.adminAddr, sdk.NewInt64Coin(underlying, 100))
+			s.Require().NoError(err, "initial swap in should succeed while the NAV is fresh")
+
+			s.SetCtxBlockTime(s.ctx.BlockTime().Add(tc.advance))
+			if tc.refresh {
+				s.setVaultNAV(vault, held, sdk.NewInt64Coin(underlying, 1), 1)
+			}
+
+			shares := s.simApp.BankKeeper.GetBalance(s.ctx, s.adminAddr, share)
+			_, swapInErr := s.k.SwapIn(s.ctx, vault.GetAddress(), s.adminAddr, sdk.NewInt64Coin(underlying, 100))
+			_, swapOutErr := s.k.SwapOut(s.ctx, vault.GetAddress(), s.adminAddr, sdk.NewCoin(share, shares.Amount.QuoRaw(2)))
+
+			if tc.expectErr {
+				s.Assert().ErrorIs(swapInErr, types.ErrStaleNAV, "SwapIn should fail with ErrStaleNAV")
+				s.Assert().ErrorIs(swapOutErr, types.ErrStaleNAV, "SwapOut should fail with ErrStaleNAV")
+				return
+			}
+			s.Assert().NoError(swapInErr, "SwapIn should succeed")
+			s.Assert().NoError(swapOutErr, "SwapOut should succeed")
+		})
+	}
+}
+
+func (s *TestSuite) TestMsgServer_AcceptAsset_StaleNAV() {
+	underlying, share, asset := "under", "vshare", "rwacoin"
+	externalID := "p2p-stale"
+
+	tests := []struct {
+		name      string
+		advance   time.Duration
+		expectErr bool
+	}{
+		{name: "fresh asset NAV settles", advance: time.Minute},
+		{name: "stale asset NAV is rejected even when unheld", advance: 2 * time.Hour, expectErr: true},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+			s.SetCtxBlockTime(time.Unix(1_700_000_000, 0).UTC())
+			sourceAmount := sdk.NewCoins(sdk.NewInt64Coin(asset, 10))
+			targetAmount := sdk.NewCoins(sdk.NewInt64Coin(underlying, 5))
+			vault, _, source := s.setupAcceptAssetScenario(acceptAssetScenario{
+				underlying:    underlying,
+				share:         share,
+				assetMarker:   asset,
+				seedNav:       &types.VaultNAV{Denom: asset, Price: sdk.NewInt64Coin(underlying, 5), Volume: sdkmath.NewInt(10)},
+				fundSource:    sourceAmount,
+				fundPrincipal: targetAmount,
+				sourceAmount:  sourceAmount,
+				targetAmount:  targetAmount,
+				externalID:    externalID,
+			})
+			s.Require().NoError(s.k.SetNAVStalenessLimit(s.ctx, vault, 3_600, false, s.adminAddr.String()), "should set NAV staleness limit")
+			s.SetCtxBlockTime(s.ctx.BlockTime().Add(tc.advance))
+
+			_, err := keeper.NewMsgServer(s.simApp.VaultKeeper).AcceptAsset(s.ctx, &types.MsgAcceptAssetRequest{
+				Authority:    s.assetManagerAddr.String(),
+				VaultAddress: vault.GetAddress().String(),
+				Source:       source.String(),
+				ExternalId:   externalID,
+			})
+			if tc.expectErr {
+				s.Assert().ErrorIs(err, types.ErrStaleNAV, "AcceptAsset should fail with ErrStaleNAV")
+				return
+			}
+			s.Assert().NoError(err, "AcceptAsset should succeed")
+		})
+	}
+}
+
+func (s *TestSuite) TestMsgServer_UpdateNAVStalenessLimit() {
+	underlying, share := "under", "vshare"
+
+	tests := []struct {
+		name        string
+		signer      func() string
+		maxAge      uint64
+		autoPause   bool
+		expectErr   string
+		expectEvent bool
+	}{
+		{name: "admin sets the limit", signer: func() string { return s.adminAddr.String() }, maxAge: 86_400, autoPause: true, expectEvent: true},
+		{name: "unchanged limit is a no-op", signer: func() string { return s.adminAddr.String() }, maxAge: 0, autoPause: false},
+		{name: "asset manager cannot set the limit", signer: func() string { return s.assetManagerAddr.String() }, maxAge: 60, expectErr: "failed to validate admin"},
+		{name: "limit above the cap is rejected", signer: func() string { return s.adminAddr.String() }, maxAge: types.MaxNAVAge + 1, expectErr: "max NAV age cannot exceed"},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+			vault, _ := s.setupAssetSettlementVault(underlying, share)
+			s.ctx = s.ctx.WithEventManager(sdk.NewEventManager())
+
+			_, err := keeper.NewMsgServer(s.simApp.VaultKeeper).UpdateNAVStalenessLimit(s.ctx, &types.MsgUpdateNAVStalenessLimitRequest{
+				Authority:           tc.signer(),
+				VaultAddress:        vault.GetAddress().String(),
+				MaxNavAgeSeconds:    tc.maxAge,
+				AutoPauseOnStaleNav: tc.autoPause,
+			})
+			if tc.expectErr != "" {
+				s.Require().ErrorContains(err, tc.expectErr, "UpdateNAVStalenessLimit error")
+				return
+			}
+			s.Require().NoError(err, "UpdateNAVStalenessLimit should succeed")
+
+			updated, err := s.k.GetVault(s.ctx, vault.GetAddress())
+			s.Require().NoError(err, "should reload vault")
+			s.Assert().Equal(tc.maxAge, updated.MaxNavAgeSeconds, "stored max NAV age")
+			s.Assert().Equal(tc.autoPause, updated.AutoPauseOnStaleNav, "stored auto-pause flag")
+			if tc.expectEvent {
+				s.requireTypedEventEmitted(types.NewEventNAVStalenessLimitUpdated(vault.Address, tc.signer(), tc.maxAge, tc.autoPause))
+			} else {
+				s.Assert().Empty(s.ctx.EventManager().Events(), "no-op update should not emit events")
+			}
+		})
+	}
+}
+
+func (s *TestSuite) TestEndBlocker_AutoPausesStaleNAVVault() {
+	underlying, share, held := "under", "vshare", "heldcoin"
+
+	tests := []struct {
+		name        string
+		autoPause   bool
+		advance     time.Duration
+		expectPause bool
+	}{
+		{name: "opted-in vault with stale NAV is paused", autoPause: true, advance: 2 * time.Hour, expectPause: true},
+		{name: "opted-in vault with fresh NAV stays unpaused", autoPause: true, advance: 30 * time.Minute},
+		{name: "vault without auto-pause stays unpaused", autoPause: false, advance: 2 * time.Hour},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+			vault := s.setupStaleNAVVault(underlying, share, held, 3_600, tc.autoPause)
+			s.SetCtxBlockTime(s.ctx.BlockTime().Add(tc.advance))
+
+			s.Require().NoError(s.k.EndBlocker(s.ctx), "EndBlocker should not error")
+
+			updated, err := s.k.GetVault(s.ctx, vault.GetAddress())
+			s.Require().NoError(err, "should reload vault")
+			s.Assert().Equal(tc.expectPause, updated.Paused, "vault paused state")
+			if tc.expectPause {
+				s.Assert().Equal(keeper.StaleNAVPauseReason, updated.PausedReason, "paused reason")
+				paused := s.findLastEventVaultPaused()
+				s.Require().NotNil(paused, "EventVaultPaused should be emitted")
+				s.Assert().Equal(keeper.StaleNAVPauseReason, paused.Reason, "EventVaultPaused reason")
+			}
+		})
+	}
+}
+
+func (s *TestSuite) TestQueryServer_Vault_ReportsOldestNAVAge() {
+	underlying, share, held := "under", "vshare", "heldcoin"
+	vault := s.setupStaleNAVVault(underlying, share, held, 3_600, false)
+
+	tests := []struct {
+		name        string
+		advance     time.Duration
+		expectAge   uint64
+		expectStale bool
+	}{
+		{name: "fresh NAV", advance: 10 * time.Minute, expectAge: 600},
+		{name: "stale NAV", advance: 2 * time.Hour, expectAge: 7_200, expectStale: true},
+	}
+
+	start := s.ctx.BlockTime()
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			s.SetCtxBlockTime(start.Add(tc.advance))
+			resp, err := keeper.NewQueryServer(s.simApp.VaultKeeper).Vault(s.ctx, &types.QueryVaultRequest{Id: vault.Address})
+			s.Require().NoError(err, "Vault query should succeed")
+			s.Assert().Equal(held, resp.OldestNavDenom, "oldest NAV denom")
+			s.Assert().Equal(tc.expectAge, resp.OldestNavAgeSeconds, "oldest NAV age")
+			s.Assert().Equal(tc.expectStale, resp.NavStale, "NAV stale flag")
+			s.Assert().Equal(tc.expectStale, resp.TotalVaultValue.IsZero(), "total vault value is only withheld while the NAV is stale")
+		})
+	}
+}
@@ -467,8 +467,10 @@ func (s *TestSuite) TestKeeper_CheckSettlementNAVGuardrail() {
 		name                string
 		seedNav             *types.VaultNAV
 		corruptNav          bool
+		toleranceBips       uint32
 		assetCoin           sdk.Coin
 		paymentCoin         sdk.Coin
+		expectedNAVValue    string
 		expectedErrContains string
 	}{
 		{
@@ -513,10 +515,43 @@ func (s *TestSuite) TestKeeper_CheckSettlementNAVGuardrail() {
 			expectedErrContains: "does not match internal NAV",
 		},
 		{
-			name:        "settlement at the exact NAV price passes the guardrail even though the vault holds none of the asset yet",
-			seedNav:     &types.VaultNAV{Denom: asset, Price: sdk.NewInt64Coin(underlying, 5), Volume: sdkmath.NewInt(10)},
-			assetCoin:   sdk.NewInt64Coin(asset, 10),
-			paymentCoin: sdk.NewInt64Coin(underlying, 5),
+			name:             "settlement at the exact NAV price passes the guardrail even though the vault holds none of the asset yet",
+			seedNav:          &types.VaultNAV{Denom: asset, Price: sdk.NewInt64Coin(underlying, 5), Volume: sdkmath.NewInt(10)},
+			assetCoin:        sdk.NewInt64Coin(asset, 10),
+			paymentCoin:      sdk.NewInt64Coin(underlying, 5),
+			expectedNAVValue: "5.000000000000000000",
+		},
+		{
+			name:             "settlement above NAV within the tolerance passes",
+			seedNav:          &types.VaultNAV{Denom: asset, Price: sdk.NewInt64Coin(underlying, 100), Volume: sdkmath.NewInt(10)},
+			toleranceBips:    100,
+			assetCoin:        sdk.NewInt64Coin(asset, 10),
+			paymentCoin:      sdk.NewInt64Coin(underlying, 101),
+			expectedNAVValue: "100.000000000000000000",
+		},
+		{
+			name:             "settlement below NAV within the tolerance passes",
+			seedNav:          &types.VaultNAV{Denom: asset, Price: sdk.NewInt64Coin(underlying, 100), Volume: sdkmath.NewInt(10)},
+			toleranceBips:    100,
+			assetCoin:        sdk.NewInt64Coin(asset, 10),
+			paymentCoin:      sdk.NewInt64Coin(underlying, 99),
+			expectedNAVValue: "100.000000000000000000",
+		},
+		{
+			name:                "settlement outside the tolerance is rejected",
+			seedNav:             &types.VaultNAV{Denom: asset, Price: sdk.NewInt64Coin(underlying, 100), Volume: sdkmath.NewInt(10)},
+			toleranceBips:       100,
+			assetCoin:           sdk.NewInt64Coin(asset, 10),
+			paymentCoin:         sdk.NewInt64Coin(underlying, 102),
+			expectedErrContains: "is more than 100 bips from internal NAV",
+		},
+		{
+			name:             "fractional NAV-implied value is reported truncated to decimal precision",
+			seedNav:          &types.VaultNAV{Denom: asset, Price: sdk.NewInt64Coin(underlying, 100), Volume: sdkmath.NewInt(3)},
+			toleranceBips:    100,
+			assetCoin:        sdk.NewInt64Coin(asset, 1),
+			paymentCoin:      sdk.NewInt64Coin(underlying, 33),
+			expectedNAVValue: "33.333333333333333333",
 		},
 	}
 
@@ -527,6 +562,7 @@ func (s *TestSuite) TestKeeper_CheckSettlementNAVGuardrail() {
 			s.ctx, _ = s.ctx.CacheContext()
 
 			vault := s.setupBaseVault(underlying, share)
+			vault.SettlementToleranceBips = tc.toleranceBips
 
 			if tc.seedNav != nil {
 				s.Require().NoError(
@@ -541,10 +577,11 @@ func (s *TestSuite) TestKeeper_CheckSettlementNAVGuardrail() {
 				)
 			}
 
-			err := s.k.TestAccessor_checkSettlementNAVGuardrail(s.T(), s.ctx, vault, tc.assetCoin, tc.paymentCoin)
+			navValue, err := s.k.TestAccessor_checkSettlementNAVGuardrail(s.T(), s.ctx, vault, sdk.Coins{tc.assetCoin}, tc.paymentCoin)
 
 			if tc.expectedErrContains == "" {
 				s.Require().NoError(err, "guardrail should pass for asset %s payment %s", tc.assetCoin, tc.paymentCoin)
+				s.Assert().Equal(tc.expectedNAVValue, navValue.String(), "NAV-implied value for asset %s", tc.assetCoin)
 				return
 			}
 
@@ -553,6 +590,66 @@ func (s *TestSuite) TestKeeper_CheckSettlementNAVGuardrail() {
 	}
 }
 
+func (s *TestSuite) TestKeeper_CheckSettlementNAVGuardrail_Bundle() {
+	underlying, share := "under", "vaultshares"
+	rwa, rwb := "rwa", "rwb"
+
+	tests := []struct {
+		name                string
+		assetCoins          sdk.Coins
+		paymentCoin         sdk.Coin
+		expectedErrContains []string
+	}{
+		{
+			name:        "bundle whose NAV-implied values sum to the payment passes",
+			assetCoins:  sdk.NewCoins(sdk.NewInt64Coin(rwa, 2), sdk.NewInt64Coin(rwb, 4)),
+			paymentCoin: sdk.NewInt64Coin(underlying, 5),
+		},
+		{
+			name:        "fractional NAV-implied values that sum to a whole payment pass",
+			assetCoins:  sdk.NewCoins(sdk.NewInt64Coin(rwa, 1), sdk.NewInt64Coin(rwb, 1)),
+			paymentCoin: sdk.NewInt64Coin(underlying, 2),
+		},
+		{
+			name:                "bundle off the summed NAV value is rejected with every NAV price",
+			assetCoins:          sdk.NewCoins(sdk.NewInt64Coin(rwa, 2), sdk.NewInt64Coin(rwb, 4)),
+			paymentCoin:         sdk.NewInt64Coin(underlying, 6),
+			expectedErrContains: []string{"does not match internal NAV", "3under per 2rwa", "1under per 2rwb"},
+		},
+		{
+			name:                "bundle with an unpriced coin is rejected",
+			assetCoins:          sdk.NewCoins(sdk.NewInt64Coin(rwa, 2), sdk.NewInt64Coin("rwc", 1)),
+			paymentCoin:         sdk.NewInt64Coin(underlying, 3),
+			expectedErrContains: []string{"has no internal NAV entry", "rwc"},
+		},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			origCtx := s.ctx
+			defer func() { s.ctx = origCtx }()
+			s.ctx, _ = s.ctx.CacheContext()
+
+			vault := s.setupBaseVault(underlying, share)
+			for _, nav := range []types.VaultNAV{
+				{Denom: rwa, Price: sdk.NewInt64Coin(underlying, 3), Volume: sdkmath.NewInt(2)},
+				{Denom: rwb, Price: sdk.NewInt64Coin(underlying, 1), Volume: sdkmath.NewInt(2)},
+			} {
+				s.Require().NoError(s.k.NAVs.Set(s.ctx, collections.Join(vault.GetAddress(), nav.Denom), nav), "failed to seed NAV entry for denom %s", nav.Denom)
+			}
+
+			_, err := s.k.TestAccessor_checkSettlementNAVGuardrail(s.T(), s.ctx, vault, tc.assetCoins, tc.paymentCoin)
+			if len(tc.expectedErrContains) == 0 {
+				s.Require().NoError(err, "guardrail should pass for assets %s payment %s", tc.assetCoins, tc.paymentCoin)
+				return
+			}
+			for _, substr := range tc.expectedErrContains {
+				s.Assert().ErrorContains(err, substr, "guardrail error for assets %s payment %s", tc.assetCoins, tc.paymentCoin)
+			}
+		})
+	}
+}
+
 func (s *TestSuite) TestKeeper_SetNAVAuthority_PersistsAndEmits() {
 	cases := []struct {
 		name                string
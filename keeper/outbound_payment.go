@@ -0,0 +1,183 @@
+package keeper
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/provlabs/vault/types"
+
+	"cosmossdk.io/collections"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/provenance-io/provenance/x/exchange"
+	markertypes "github.com/provenance-io/provenance/x/marker/types"
+)
+
+// CreateOutboundPayment creates an x/exchange payment with the vault's payment escrow
+// account as its source, offering sourceAmount to target in return for targetAmount.
+//
+// A vault-created payment is checked as the mirror of one a counterparty creates against
+// the vault: the vault's source leg is what a counterparty payment would carry as its
+// target leg. So exactly one leg must carry the vault's underlying asset, which sets the
+// settlement direction, an asset the vault acquires must be permitted by its acquisition
+// policy, and the asset leg must trade within the vault's settlement tolerance of the
+// internal NAV of each of its denoms, as in AcceptAsset. An inbound trade must also leave
+// the vault within its concentration limits once accepted. The source amount is then
+// staged from the principal marker into the escrow account, where the exchange module
+// holds it until the payment closes, and an inbound trade must leave the principal within
+// its liquidity buffer. The vault records the payment so it can sweep the escrow back to
+// the principal once the payment is accepted, rejected, cancelled, or expired.
+func (k *Keeper) CreateOutboundPayment(ctx sdk.Context, vault *types.VaultAccount, target string, sourceAmount, targetAmount sdk.Coins, externalID string, expirationSeconds uint64) (types.OutboundPayment, error) {
+	vaultAddr := vault.GetAddress()
+	key := collections.Join(vaultAddr, externalID)
+	if has, err := k.OutboundPayments.Has(ctx, key); err != nil {
+		return types.OutboundPayment{}, fmt.Errorf("failed to check outbound payment %q for vault %s: %w", externalID, vault.Address, err)
+	} else if has {
+		return types.OutboundPayment{}, fmt.Errorf("vault %s already has an open payment with external id %q", vault.Address, externalID)
+	}
+
+	mirror := &exchange.Payment{SourceAmount: targetAmount, TargetAmount: sourceAmount}
+	direction, err := assetSettlementDirection(vault, mirror)
+	if err != nil {
+		return types.OutboundPayment{}, fmt.Errorf("failed to resolve payment direction: %w", err)
+	}
+	assetCoins, paymentCoin, err := settlementLegCoins(mirror, direction, vault.UnderlyingAsset)
+	if err != nil {
+		return types.OutboundPayment{}, fmt.Errorf("failed to resolve payment leg coins: %w", err)
+	}
+	if err := k.requireSettlementAcquisitionPermitted(ctx, vault, assetCoins, direction); err != nil {
+		return types.OutboundPayment{}, err
+	}
+	if _, err := k.checkSettlementNAVGuardrail(ctx, vault, assetCoins, paymentCoin); err != nil {
+		return types.OutboundPayment{}, err
+	}
+	if err := k.requireFreshNAVs(ctx, vault, assetCoins.Denoms()...); err != nil {
+		return types.OutboundPayment{}, err
+	}
+	if err := k.requirePrincipalBalance(ctx, vault, sourceAmount); err != nil {
+		return types.OutboundPayment{}, err
+	}
+	if direction == types.AssetDirectionInbound {
+		if err := k.requireProjectedConcentration(ctx, vault, assetCoins, paymentCoin); err != nil {
+			return types.OutboundPayment{}, err
+		}
+	}
+
+	escrowAddr := vault.PaymentEscrowAddress()
+	if !sourceAmount.IsZero() {
+		if err := k.BankKeeper.SendCoins(markertypes.WithBypass(ctx), vault.PrincipalMarkerAddress(), escrowAddr, sourceAmount); err != nil {
+			return types.OutboundPayment{}, fmt.Errorf("failed to escrow source amount from principal: %w", err)
+		}
+	}
+	if direction == types.AssetDirectionInbound {
+		if err := k.requireLiquidityBuffer(ctx, vault); err != nil {
+			return types.OutboundPayment{}, err
+		}
+	}
+	err = k.ExchangeKeeper.CreatePayment(ctx, &exchange.Payment{
+		Source:       escrowAddr.String(),
+		SourceAmount: sourceAmount,
+		Target:       target,
+		TargetAmount: targetAmount,
+		ExternalId:   externalID,
+	})
+	if err != nil {
+		return types.OutboundPayment{}, fmt.Errorf("failed to create payment: %w", err)
+	}
+
+	payment := types.OutboundPayment{
+		ExternalId:   externalID,
+		Target:       target,
+		SourceAmount: sourceAmount,
+		TargetAmount: targetAmount,
+		Direction:    direction,
+		ExpiryTime:   ctx.BlockTime().UTC().Add(time.Duration(expirationSeconds) * time.Second),
+	}
+	if err := k.OutboundPayments.Set(ctx, key, payment); err != nil {
+		return types.OutboundPayment{}, fmt.Errorf("failed to record outbound payment %q for vault %s: %w", externalID, vault.Address, err)
+	}
+
+	k.emitEvent(ctx, types.NewEventAssetPaymentCreated(vault.Address, escrowAddr.String(), payment))
+	return payment, nil
+}
+
+// GetOutboundPayment returns the vault's open outbound payment with the given external id.
+func (k Keeper) GetOutboundPayment(ctx sdk.Context, vaultAddr sdk.AccAddress, externalID string) (types.OutboundPayment, error) {
+	return k.OutboundPayments.Get(ctx, collections.Join(vaultAddr, externalID))
+}
+
+// closeOutboundPayment closes one of the vault's outbound payments. A payment still open on
+// the exchange module is cancelled, releasing the hold on its escrow, and reason is reported;
+// one the target has already accepted or rejected is reported as completed. Either way the
+// vault's record is removed, the escrow account's free funds are swept back to the
+// principal, and the NAV entry of any asset denom an accepted outbound sale drained is
+// dropped. It returns the reason reported.
+func (k *Keeper) closeOutboundPayment(ctx sdk.Context, vault *types.VaultAccount, payment types.OutboundPayment, reason string) (string, error) {
+	escrowAddr := vault.PaymentEscrowAddress()
+	open, err := k.ExchangeKeeper.GetPayment(ctx, escrowAddr, payment.ExternalId)
+	if err != nil {
+		return "", fmt.Errorf("failed to get payment: %w", err)
+	}
+	if open == nil {
+		reason = types.PaymentClosedCompleted
+	} else if err := k.ExchangeKeeper.CancelPayments(ctx, escrowAddr, []string{payment.ExternalId}); err != nil {
+		return "", fmt.Errorf("failed to cancel payment %q: %w", payment.ExternalId, err)
+	}
+
+	if err := k.OutboundPayments.Remove(ctx, collections.Join(vault.GetAddress(), payment.ExternalId)); err != nil {
+		return "", fmt.Errorf("failed to remove outbound payment %q for vault %s: %w", payment.ExternalId, vault.Address, err)
+	}
+	if err := k.sweepPaymentEscrow(ctx, vault); err != nil {
+		return "", err
+	}
+	if err := k.removeDrainedSettlementNAV(ctx, vault, payment.SourceAmount.Denoms(), payment.Direction); err != nil {
+		return "", err
+	}
+
+	k.emitEvent(ctx, types.NewEventAssetPaymentClosed(vault.Address, payment.ExternalId, reason))
+	return reason, nil
+}
+
+// sweepPaymentEscrow moves every spendable balance of the vault's payment escrow account
+// back to the principal marker. Funds the exchange module holds for open payments are not
+// spendable and stay in escrow; everything else is either a closed payment's returned
+// source amount or an accepted payment's proceeds.
+func (k *Keeper) sweepPaymentEscrow(ctx sdk.Context, vault *types.VaultAccount) error {
+	escrowAddr := vault.PaymentEscrowAddress()
+	free := k.BankKeeper.SpendableCoins(ctx, escrowAddr)
+	if free.IsZero() {
+		return nil
+	}
+	if err := k.BankKeeper.SendCoins(markertypes.WithBypass(ctx), escrowAddr, vault.PrincipalMarkerAddress(), free); err != nil {
+		return fmt.Errorf("failed to sweep payment escrow to principal: %w", err)
+	}
+	return nil
+}
+
+// closeCompletedOutboundPayments closes each of the vault's outbound payments that is no
+// longer open on the exchange module because the target accepted or rejected it, sweeping
+// the proceeds or returned funds back to the principal.
+func (k *Keeper) closeCompletedOutboundPayments(ctx sdk.Context, vault *types.VaultAccount) error {
+	escrowAddr := vault.PaymentEscrowAddress()
+	var completed []types.OutboundPayment
+	err := k.OutboundPayments.Walk(ctx, collections.NewPrefixedPairRange[sdk.AccAddress, string](vault.GetAddress()), func(_ collections.Pair[sdk.AccAddress, string], payment types.OutboundPayment) (bool, error) {
+		open, err := k.ExchangeKeeper.GetPayment(ctx, escrowAddr, payment.ExternalId)
+		if err != nil {
+			return true, fmt.Errorf("failed to get payment %q: %w", payment.ExternalId, err)
+		}
+		if open == nil {
+			completed = append(completed, payment)
+		}
+		return false, nil
+	})
+	if err != nil {
+		return fmt.Errorf("failed to walk outbound payments for vault %s: %w", vault.Address, err)
+	}
+	for _, payment := range completed {
+		if _, err := k.closeOutboundPayment(ctx, vault, payment, types.PaymentClosedCompleted); err != nil {
+			return err
+		}
+	}
+	return nil
+}
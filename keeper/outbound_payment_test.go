@@ -0,0 +1,293 @@
+package keeper_test
+
+import (
+	"time"
+
+	"cosmossdk.io/collections"
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/provlabs/vault/keeper"
+	"github.com/provlabs/vault/types"
+)
+
+// setupOutboundPaymentVault builds an asset-settlement vault whose principal holds 10 units of
+// asset priced at 5 underlying per 10 units, plus underlyingBalance of its underlying asset,
+// and a funded counterparty. It returns the vault and the counterparty address.
+func (s *TestSuite) setupOutboundPaymentVault(underlying, share, asset string, underlyingBalance int64) (*types.VaultAccount, sdk.AccAddress) {
+	fundPrincipal := sdk.NewCoins(sdk.NewInt64Coin(asset, 10))
+	if underlyingBalance > 0 {
+		fundPrincipal = fundPrincipal.Add(sdk.NewInt64Coin(underlying, underlyingBalance))
+	}
+	vault, _, _ := s.setupAcceptAssetScenario(acceptAssetScenario{
+		underlying:    underlying,
+		share:         share,
+		assetMarker:   asset,
+		seedNav:       &types.VaultNAV{Denom: asset, Price: sdk.NewInt64Coin(underlying, 5), Volume: sdkmath.NewInt(10)},
+		fundPrincipal: fundPrincipal,
+		omitPayment:   true,
+	})
+	counterparty := s.CreateAndFundAccount(sdk.NewInt64Coin(underlying, 100))
+	s.Require().NoError(FundAccount(s.ctx, s.simApp.BankKeeper, counterparty, sdk.NewCoins(sdk.NewInt64Coin(asset, 10))), "failed to fund counterparty with %s", asset)
+	return vault, counterparty
+}
+
+func (s *TestSuite) TestMsgServer_CreateAssetPayment() {
+	underlying, share, asset := "under", "vshare", "rwacoin"
+
+	tests := []struct {
+		name                string
+		underlyingBalance   int64
+		sourceAmount        sdk.Coins
+		targetAmount        sdk.Coins
+		authority           func() string
+		paused              bool
+		maxAssetBips        uint32
+		expectedDirection   string
+		expectedPrincipal   sdk.Coins
+		expectedErrContains string
+	}{
+		{
+			name:              "selling a held asset escrows it from the principal",
+			sourceAmount:      sdk.NewCoins(sdk.NewInt64Coin(asset, 10)),
+			targetAmount:      sdk.NewCoins(sdk.NewInt64Coin(underlying, 5)),
+			expectedDirection: types.AssetDirectionOutbound,
+			expectedPrincipal: sdk.NewCoins(),
+		},
+		{
+			name:              "buying an asset escrows the underlying from the principal",
+			underlyingBalance: 4,
+			sourceAmount:      sdk.NewCoins(sdk.NewInt64Coin(underlying, 4)),
+			targetAmount:      sdk.NewCoins(sdk.NewInt64Coin(asset, 8)),
+			expectedDirection: types.AssetDirectionInbound,
+			expectedPrincipal: sdk.NewCoins(sdk.NewInt64Coin(asset, 10)),
+		},
+		{
+			name:                "a price off the internal NAV is rejected",
+			sourceAmount:        sdk.NewCoins(sdk.NewInt64Coin(asset, 10)),
+			targetAmount:        sdk.NewCoins(sdk.NewInt64Coin(underlying, 6)),
+			expectedErrContains: "does not match internal NAV",
+		},
+		{
+			name:                "an amount the principal does not hold is rejected",
+			sourceAmount:        sdk.NewCoins(sdk.NewInt64Coin(underlying, 4)),
+			targetAmount:        sdk.NewCoins(sdk.NewInt64Coin(asset, 8)),
+			expectedErrContains: "insufficient principal balance for under",
+		},
+		{
+			name:                "the admin cannot create a payment",
+			sourceAmount:        sdk.NewCoins(sdk.NewInt64Coin(asset, 10)),
+			targetAmount:        sdk.NewCoins(sdk.NewInt64Coin(underlying, 5)),
+			authority:           func() string { return s.adminAddr.String() },
+			expectedErrContains: "failed to validate settlement manager role",
+		},
+		{
+			name:                "a paused vault cannot create a payment",
+			sourceAmount:        sdk.NewCoins(sdk.NewInt64Coin(asset, 10)),
+			targetAmount:        sdk.NewCoins(sdk.NewInt64Coin(underlying, 5)),
+			paused:              true,
+			expectedErrContains: "payments cannot be created while paused",
+		},
+		{
+			name:                "buying past a concentration limit is rejected",
+			underlyingBalance:   4,
+			sourceAmount:        sdk.NewCoins(sdk.NewInt64Coin(underlying, 4)),
+			targetAmount:        sdk.NewCoins(sdk.NewInt64Coin(asset, 8)),
+			maxAssetBips:        6_000,
+			expectedErrContains: `denom "rwacoin" would make up 10000.000000000000000000 bips`,
+		},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			origCtx := s.ctx
+			defer func() { s.ctx = origCtx }()
+			s.ctx, _ = s.ctx.CacheContext()
+
+			vault, counterparty := s.setupOutboundPaymentVault(underlying, share, asset, tc.underlyingBalance)
+			if tc.paused {
+				vault.Paused = true
+				s.Require().NoError(s.k.SetVaultAccount(s.ctx, vault), "failed to pause vault")
+			}
+			if tc.maxAssetBips > 0 {
+				vault.MaxAssetConcentrationBips = tc.maxAssetBips
+				s.Require().NoError(s.k.SetVaultAccount(s.ctx, vault), "failed to set concentration limit")
+			}
+			tvvBefore, err := s.k.GetTVV(s.ctx, *vault)
+			s.Require().NoError(err, "GetTVV before creating the payment")
+			authority := s.assetManagerAddr.String()
+			if tc.authority != nil {
+				authority = tc.authority()
+			}
+
+			resp, err := keeper.NewMsgServer(s.simApp.VaultKeeper).CreateAssetPayment(s.ctx, &types.MsgCreateAssetPaymentRequest{
+				Authority:         authority,
+				VaultAddress:      vault.GetAddress().String(),
+				Target:            counterparty.String(),
+				SourceAmount:      tc.sourceAmount,
+				TargetAmount:      tc.targetAmount,
+				ExternalId:        "sale-1",
+				ExpirationSeconds: 3600,
+			})
+			if tc.expectedErrContains != "" {
+				s.Require().ErrorContains(err, tc.expectedErrContains, "CreateAssetPayment should reject case %q", tc.name)
+				return
+			}
+			s.Require().NoError(err, "CreateAssetPayment should succeed for case %q", tc.name)
+
+			escrowAddr := vault.PaymentEscrowAddress()
+			s.Assert().Equal(escrowAddr.String(), resp.Source, "response should name the escrow account as the payment source")
+			s.Assert().Equal(tc.expectedPrincipal.String(), s.simApp.BankKeeper.GetAllBalances(s.ctx, vault.PrincipalMarkerAddress()).String(), "principal balances after escrow")
+			s.Assert().Equal(tc.sourceAmount.String(), s.simApp.BankKeeper.GetAllBalances(s.ctx, escrowAddr).String(), "escrow should hold the source amount")
+			s.Assert().True(s.simApp.BankKeeper.SpendableCoins(s.ctx, escrowAddr).IsZero(), "the exchange module should hold the escrowed source amount")
+
+			payment, err := s.simApp.ExchangeKeeper.GetPayment(s.ctx, escrowAddr, "sale-1")
+			s.Require().NoError(err, "GetPayment")
+			s.Require().NotNil(payment, "the exchange payment should exist")
+			s.Assert().Equal(counterparty.String(), payment.Target, "payment target")
+
+			record, err := s.k.GetOutboundPayment(s.ctx, vault.GetAddress(), "sale-1")
+			s.Require().NoError(err, "GetOutboundPayment")
+			s.Assert().Equal(tc.expectedDirection, record.Direction, "recorded direction")
+			s.Assert().Equal(s.ctx.BlockTime().Add(time.Hour).Unix(), record.ExpiryTime.Unix(), "recorded expiry time")
+
+			tvvAfter, err := s.k.GetTVV(s.ctx, *vault)
+			s.Require().NoError(err, "GetTVV after creating the payment")
+			s.Assert().Equal(tvvBefore.String(), tvvAfter.String(), "escrowed funds should still count toward TVV")
+			_, err = s.k.GetVaultNAV(s.ctx, vault.GetAddress(), asset)
+			s.Assert().NoError(err, "the NAV entry of an escrowed asset should be kept")
+		})
+	}
+}
+
+func (s *TestSuite) TestOutboundPayment_Close() {
+	underlying, share, asset := "under", "vshare", "rwacoin"
+
+	tests := []struct {
+		name                string
+		close               func(vault *types.VaultAccount, counterparty sdk.AccAddress) error
+		expectedReason      string
+		expectedPrincipal   sdk.Coins
+		expectNavRemoved    bool
+		expectedErrContains string
+	}{
+		{
+			name: "accepted by the target sweeps the proceeds on the next reconcile",
+			close: func(vault *types.VaultAccount, counterparty sdk.AccAddress) error {
+				payment, err := s.simApp.ExchangeKeeper.GetPayment(s.ctx, vault.PaymentEscrowAddress(), "sale-1")
+				s.Require().NoError(err, "GetPayment")
+				s.Require().NoError(s.simApp.ExchangeKeeper.AcceptPayment(s.ctx, payment), "counterparty AcceptPayment")
+				return s.k.TestAccessor_reconcileVault(s.T(), s.ctx, vault)
+			},
+			expectedReason:    types.PaymentClosedCompleted,
+			expectedPrincipal: sdk.NewCoins(sdk.NewInt64Coin(underlying, 5)),
+			expectNavRemoved:  true,
+		},
+		{
+			name: "rejected by the target returns the escrow on the next reconcile",
+			close: func(vault *types.VaultAccount, counterparty sdk.AccAddress) error {
+				s.Require().NoError(s.simApp.ExchangeKeeper.RejectPayment(s.ctx, counterparty, vault.PaymentEscrowAddress(), "sale-1"), "counterparty RejectPayment")
+				return s.k.TestAccessor_reconcileVault(s.T(), s.ctx, vault)
+			},
+			expectedReason:    types.PaymentClosedCompleted,
+			expectedPrincipal: sdk.NewCoins(sdk.NewInt64Coin(asset, 10)),
+		},
+		{
+			name: "cancelled by the asset manager",
+			close: func(vault *types.VaultAccount, _ sdk.AccAddress) error {
+				_, err := keeper.NewMsgServer(s.simApp.VaultKeeper).CancelAssetPayment(s.ctx, &types.MsgCancelAssetPaymentRequest{
+					Authority:    s.assetManagerAddr.String(),
+					VaultAddress: vault.GetAddress().String(),
+					ExternalId:   "sale-1",
+				})
+				return err
+			},
+			expectedReason:    types.PaymentClosedCancelled,
+			expectedPrincipal: sdk.NewCoins(sdk.NewInt64Coin(asset, 10)),
+		},
+		{
+			name: "cancelling by anyone else is rejected",
+			close: func(vault *types.VaultAccount, counterparty sdk.AccAddress) error {
+				_, err := keeper.NewMsgServer(s.simApp.VaultKeeper).CancelAssetPayment(s.ctx, &types.MsgCancelAssetPaymentRequest{
+					Authority:    counterparty.String(),
+					VaultAddress: vault.GetAddress().String(),
+					ExternalId:   "sale-1",
+				})
+				return err
+			},
+			expectedErrContains: "failed to validate settlement manager role",
+		},
+		{
+			name: "expired by anyone after its expiry time",
+			close: func(vault *types.VaultAccount, counterparty sdk.AccAddress) error {
+				s.ctx = s.ctx.WithBlockTime(s.ctx.BlockTime().Add(time.Hour))
+				_, err := keeper.NewMsgServer(s.simApp.VaultKeeper).ExpireAssetPayment(s.ctx, &types.MsgExpireAssetPaymentRequest{
+					Signer:       counterparty.String(),
+					VaultAddress: vault.GetAddress().String(),
+					ExternalId:   "sale-1",
+				})
+				return err
+			},
+			expectedReason:    types.PaymentClosedExpired,
+			expectedPrincipal: sdk.NewCoins(sdk.NewInt64Coin(asset, 10)),
+		},
+		{
+			name: "expiring before the expiry time is rejected",
+			close: func(vault *types.VaultAccount, counterparty sdk.AccAddress) error {
+				_, err := keeper.NewMsgServer(s.simApp.VaultKeeper).ExpireAssetPayment(s.ctx, &types.MsgExpireAssetPaymentRequest{
+					Signer:       counterparty.String(),
+					VaultAddress: vault.GetAddress().String(),
+					ExternalId:   "sale-1",
+				})
+				return err
+			},
+			expectedErrContains: "does not expire until",
+		},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			origCtx := s.ctx
+			defer func() { s.ctx = origCtx }()
+			s.ctx, _ = s.ctx.CacheContext()
+
+			vault, counterparty := s.setupOutboundPaymentVault(underlying, share, asset, 0)
+			_, err := keeper.NewMsgServer(s.simApp.VaultKeeper).CreateAssetPayment(s.ctx, &types.MsgCreateAssetPaymentRequest{
+				Authority:         s.assetManagerAddr.String(),
+				VaultAddress:      vault.GetAddress().String(),
+				Target:            counterparty.String(),
+				SourceAmount:      sdk.NewCoins(sdk.NewInt64Coin(asset, 10)),
+				TargetAmount:      sdk.NewCoins(sdk.NewInt64Coin(underlying, 5)),
+				ExternalId:        "sale-1",
+				ExpirationSeconds: 3600,
+			})
+			s.Require().NoError(err, "CreateAssetPayment")
+			s.ctx = s.ctx.WithEventManager(sdk.NewEventManager())
+
+			err = tc.close(vault, counterparty)
+			if tc.expectedErrContains != "" {
+				s.Require().ErrorContains(err, tc.expectedErrContains, "closing should fail for case %q", tc.name)
+				_, err = s.k.GetOutboundPayment(s.ctx, vault.GetAddress(), "sale-1")
+				s.Assert().NoError(err, "a payment that failed to close should stay recorded")
+				return
+			}
+			s.Require().NoError(err, "closing should succeed for case %q", tc.name)
+
+			escrowAddr := vault.PaymentEscrowAddress()
+			s.Assert().Equal(tc.expectedPrincipal.String(), s.simApp.BankKeeper.GetAllBalances(s.ctx, vault.PrincipalMarkerAddress()).String(), "principal balances after closing")
+			s.Assert().True(s.simApp.BankKeeper.GetAllBalances(s.ctx, escrowAddr).IsZero(), "escrow should be empty after closing")
+			payment, err := s.simApp.ExchangeKeeper.GetPayment(s.ctx, escrowAddr, "sale-1")
+			s.Require().NoError(err, "GetPayment")
+			s.Assert().Nil(payment, "the exchange payment should be gone")
+			_, err = s.k.GetOutboundPayment(s.ctx, vault.GetAddress(), "sale-1")
+			s.Assert().ErrorIs(err, collections.ErrNotFound, "the outbound payment record should be removed")
+			_, err = s.k.GetVaultNAV(s.ctx, vault.GetAddress(), asset)
+			if tc.expectNavRemoved {
+				s.Assert().ErrorIs(err, collections.ErrNotFound, "the NAV entry of a sold-out asset should be removed")
+			} else {
+				s.Assert().NoError(err, "the NAV entry of a returned asset should be kept")
+			}
+			s.requireTypedEventEmitted(types.NewEventAssetPaymentClosed(vault.GetAddress().String(), "sale-1", tc.expectedReason))
+		})
+	}
+}
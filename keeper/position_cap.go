@@ -0,0 +1,91 @@
+package keeper
+
+import (
+	"fmt"
+	"slices"
+
+	sdkmath "cosmossdk.io/math"
+
+	"github.com/provlabs/vault/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// SetPositionCap updates the per-account position cap and its exemptions for a vault.
+func (k *Keeper) SetPositionCap(ctx sdk.Context, vault *types.VaultAccount, maxValue string, exemptions []string, authority string) error {
+	if err := types.ValidatePositionCap(maxValue, exemptions); err != nil {
+		return err
+	}
+	if vault.MaxPositionValue == maxValue && slices.Equal(vault.PositionCapExemptions, exemptions) {
+		return nil
+	}
+	vault.MaxPositionValue = maxValue
+	vault.PositionCapExemptions = exemptions
+	if err := k.SetVaultAccount(ctx, vault); err != nil {
+		return fmt.Errorf("failed to set vault account: %w", err)
+	}
+	k.emitEvent(ctx, types.NewEventPositionCapUpdated(vault.Address, authority, maxValue, exemptions))
+	return nil
+}
+
+// requireWithinPositionCap returns an error unless the recipient of a swap-in of asset stays
+// within the vault's position cap. The recipient's position is the value of the shares it
+// already holds at the current share price plus the value of the deposit. Shares escrowed
+// in pending swap-outs are already on their way out and do not count.
+func (k Keeper) requireWithinPositionCap(ctx sdk.Context, vault *types.VaultAccount, recipient sdk.AccAddress, asset sdk.Coin) error {
+	maxValue, capped, err := positionCap(vault, recipient)
+	if err != nil || !capped {
+		return err
+	}
+
+	held := k.BankKeeper.GetBalance(ctx, recipient, vault.TotalShares.Denom)
+	heldValue, err := k.ConvertSharesToRedeemCoin(ctx, *vault, held.Amount)
+	if err != nil {
+		return fmt.Errorf("failed to value position: %w", err)
+	}
+	depositValue, err := k.ToUnderlyingAssetAmount(ctx, *vault, asset)
+	if err != nil {
+		return fmt.Errorf("failed to value deposit: %w", err)
+	}
+
+	position := heldValue.Amount.Add(depositValue)
+	if position.GT(maxValue) {
+		return fmt.Errorf("swap in would bring the position of %s in vault %s to %s%s, above the cap of %s%s",
+			recipient, vault.Address, position, vault.UnderlyingAsset, maxValue, vault.UnderlyingAsset)
+	}
+	return nil
+}
+
+// requireTransferWithinPositionCap returns an error unless the recipient of a transfer of
+// shares stays within the vault's position cap. The recipient's position is the shares it
+// already holds plus the transferred shares, valued at the current share price.
+func (k Keeper) requireTransferWithinPositionCap(ctx sdk.Context, vault *types.VaultAccount, recipient sdk.AccAddress, shares sdkmath.Int) error {
+	maxValue, capped, err := positionCap(vault, recipient)
+	if err != nil || !capped {
+		return err
+	}
+
+	held := k.BankKeeper.GetBalance(ctx, recipient, vault.TotalShares.Denom)
+	position, err := k.ConvertSharesToRedeemCoin(ctx, *vault, held.Amount.Add(shares))
+	if err != nil {
+		return fmt.Errorf("failed to value position: %w", err)
+	}
+
+	if position.Amount.GT(maxValue) {
+		return fmt.Errorf("share transfer would bring the position of %s in vault %s to %s, above the cap of %s%s",
+			recipient, vault.Address, position, maxValue, vault.UnderlyingAsset)
+	}
+	return nil
+}
+
+// positionCap returns the vault's position cap and whether it applies to addr.
+func positionCap(vault *types.VaultAccount, addr sdk.AccAddress) (sdkmath.Int, bool, error) {
+	if vault.MaxPositionValue == "" || vault.IsPositionCapExempt(addr.String()) {
+		return sdkmath.Int{}, false, nil
+	}
+	maxValue, ok := sdkmath.NewIntFromString(vault.MaxPositionValue)
+	if !ok {
+		return sdkmath.Int{}, false, fmt.Errorf("invalid max position value: %s", vault.MaxPositionValue)
+	}
+	return maxValue, true, nil
+}
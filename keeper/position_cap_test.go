@@ -0,0 +1,143 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/provlabs/vault/keeper"
+	"github.com/provlabs/vault/types"
+)
+
+func (s *TestSuite) TestKeeper_SwapIn_PositionCap() {
+	underlying, share := "under", "vshare"
+
+	tests := []struct {
+		name      string
+		maxValue  string
+		exempt    bool
+		deposits  []int64
+		expectErr string
+	}{
+		{name: "no cap admits any position", deposits: []int64{600, 600}},
+		{name: "deposit within the cap is admitted", maxValue: "1000", deposits: []int64{1000}},
+		{name: "single deposit above the cap is rejected", maxValue: "1000", deposits: []int64{1001}, expectErr: "above the cap of 1000under"},
+		{name: "repeated deposits cannot exceed the cap", maxValue: "1000", deposits: []int64{600, 600}, expectErr: "to 1200under, above the cap"},
+		{name: "exempt address is not capped", maxValue: "1000", exempt: true, deposits: []int64{600, 600}},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+			vault := s.setupBaseVault(underlying, share)
+			investor := s.CreateAndFundAccount(sdk.NewInt64Coin(underlying, 2_000))
+			var exemptions []string
+			if tc.exempt {
+				exemptions = []string{investor.String()}
+			}
+			s.Require().NoError(s.k.SetPositionCap(s.ctx, vault, tc.maxValue, exemptions, s.adminAddr.String()), "should set position cap")
+
+			var err error
+			for _, amount := range tc.deposits {
+				if _, err = s.k.SwapIn(s.ctx, vault.GetAddress(), investor, sdk.NewInt64Coin(underlying, amount)); err != nil {
+					break
+				}
+			}
+			if tc.expectErr != "" {
+				s.Require().ErrorContains(err, tc.expectErr, "SwapIn should reject case %q", tc.name)
+				return
+			}
+			s.Require().NoError(err, "SwapIn should accept case %q", tc.name)
+		})
+	}
+}
+
+func (s *TestSuite) TestKeeper_SwapIn_PositionCapCountsRecipient() {
+	underlying, share := "under", "vshare"
+	s.SetupTest()
+	vault := s.setupBaseVault(underlying, share)
+	investor := s.CreateAndFundAccount(sdk.NewInt64Coin(underlying, 2_000))
+	other := s.CreateAndFundAccount(sdk.NewInt64Coin(underlying, 2_000))
+	s.Require().NoError(s.k.SetPositionCap(s.ctx, vault, "1000", nil, s.adminAddr.String()), "should set position cap")
+
+	_, err := s.k.SwapIn(s.ctx, vault.GetAddress(), investor, sdk.NewInt64Coin(underlying, 800))
+	s.Require().NoError(err, "first swap in should be within the cap")
+	_, err = s.k.SwapIn(s.ctx, vault.GetAddress(), other, sdk.NewInt64Coin(underlying, 800))
+	s.Require().NoError(err, "another account's position should be capped separately")
+	_, err = s.k.SwapIn(s.ctx, vault.GetAddress(), investor, sdk.NewInt64Coin(underlying, 300))
+	s.Require().ErrorContains(err, "above the cap", "investor's existing shares should count toward the cap")
+}
+
+func (s *TestSuite) TestKeeper_SendRestrictionFn_PositionCap() {
+	underlying, share := "under", "vshare"
+	s.SetupTest()
+	vault := s.setupBaseVault(underlying, share)
+	holder := s.CreateAndFundAccount(sdk.NewInt64Coin(underlying, 2_000))
+	recipient := s.CreateAndFundAccount(sdk.NewInt64Coin(underlying, 2_000))
+	_, err := s.k.SwapIn(s.ctx, vault.GetAddress(), holder, sdk.NewInt64Coin(underlying, 1_000))
+	s.Require().NoError(err, "holder should swap in before the cap is set")
+	_, err = s.k.SwapIn(s.ctx, vault.GetAddress(), recipient, sdk.NewInt64Coin(underlying, 800))
+	s.Require().NoError(err, "recipient should swap in before the cap is set")
+	vault, err = s.k.GetVault(s.ctx, vault.GetAddress())
+	s.Require().NoError(err, "should reload vault")
+	s.Require().NoError(s.k.SetPositionCap(s.ctx, vault, "1000", nil, s.adminAddr.String()), "should set position cap")
+
+	tenth := s.simApp.BankKeeper.GetBalance(s.ctx, holder, share).Amount.QuoRaw(10)
+	err = s.simApp.BankKeeper.SendCoins(s.ctx, holder, recipient, sdk.NewCoins(sdk.NewCoin(share, tenth.MulRaw(3))))
+	s.Require().ErrorContains(err, "share transfer would bring the position of "+recipient.String(), "transfer above the cap should be rejected")
+
+	err = s.simApp.BankKeeper.SendCoins(s.ctx, holder, recipient, sdk.NewCoins(sdk.NewCoin(share, tenth)))
+	s.Require().NoError(err, "transfer within the cap should succeed")
+
+	s.Require().NoError(s.k.SetPositionCap(s.ctx, vault, "1000", []string{recipient.String()}, s.adminAddr.String()), "should exempt recipient")
+	err = s.simApp.BankKeeper.SendCoins(s.ctx, holder, recipient, sdk.NewCoins(sdk.NewCoin(share, tenth.MulRaw(3))))
+	s.Require().NoError(err, "transfer to an exempt address should succeed")
+
+	_, err = s.k.SwapOut(s.ctx, vault.GetAddress(), holder, sdk.NewCoin(share, tenth))
+	s.Require().NoError(err, "redemptions should not be capped")
+}
+
+func (s *TestSuite) TestMsgServer_UpdatePositionCap() {
+	underlying, share := "under", "vshare"
+
+	tests := []struct {
+		name        string
+		signer      func() string
+		maxValue    string
+		exemptions  func() []string
+		expectErr   string
+		expectEvent bool
+	}{
+		{name: "limits manager sets the cap", signer: func() string { return s.adminAddr.String() }, maxValue: "1000", exemptions: func() []string { return []string{s.assetManagerAddr.String()} }, expectEvent: true},
+		{name: "unchanged cap is a no-op", signer: func() string { return s.adminAddr.String() }, exemptions: func() []string { return nil }},
+		{name: "address without the limits manager role cannot set the cap", signer: func() string { return s.assetManagerAddr.String() }, maxValue: "1000", exemptions: func() []string { return nil }, expectErr: "does not hold role VAULT_ROLE_LIMITS_MANAGER"},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+			vault := s.setupBaseVault(underlying, share)
+			s.ctx = s.ctx.WithEventManager(sdk.NewEventManager())
+
+			_, err := keeper.NewMsgServer(s.simApp.VaultKeeper).UpdatePositionCap(s.ctx, &types.MsgUpdatePositionCapRequest{
+				Authority:        tc.signer(),
+				VaultAddress:     vault.Address,
+				MaxPositionValue: tc.maxValue,
+				Exemptions:       tc.exemptions(),
+			})
+			if tc.expectErr != "" {
+				s.Require().ErrorContains(err, tc.expectErr, "UpdatePositionCap error")
+				return
+			}
+			s.Require().NoError(err, "UpdatePositionCap should succeed")
+
+			updated, err := s.k.GetVault(s.ctx, vault.GetAddress())
+			s.Require().NoError(err, "should reload vault")
+			s.Assert().Equal(tc.maxValue, updated.MaxPositionValue, "stored max position value")
+			s.Assert().Equal(tc.exemptions(), updated.PositionCapExemptions, "stored exemptions")
+			if tc.expectEvent {
+				s.requireTypedEventEmitted(types.NewEventPositionCapUpdated(vault.Address, tc.signer(), tc.maxValue, tc.exemptions()))
+			} else {
+				s.Assert().Empty(s.ctx.EventManager().Events(), "no-op update should not emit events")
+			}
+		})
+	}
+}
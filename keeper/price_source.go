@@ -0,0 +1,191 @@
+package keeper
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+
+	"github.com/provlabs/vault/types"
+
+	"cosmossdk.io/collections"
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// PriceSource prices a denom held by a vault. A vault's price-source chain consults its
+// sources in order, and the first source with a price for the denom values it.
+type PriceSource interface {
+	// Price returns the value of nav.Volume units of denom as nav.Price, or found=false
+	// when the source has no price for the denom.
+	Price(ctx sdk.Context, vault types.VaultAccount, denom string) (nav types.VaultNAV, found bool, err error)
+}
+
+// internalNAVPriceSource prices a denom from the vault's internal NAV table, using the
+// aggregate of the vault's NAV sources in place of the entry once their quorum is met
+// (see AggregatedSourceNAV). The entry must exist either way: the NAV authority's price
+// registers the denom and remains the fallback below quorum. A price older than the
+// vault's max_nav_age_seconds is rejected as stale.
+type internalNAVPriceSource struct {
+	keeper *Keeper
+}
+
+// Price implements PriceSource.
+func (s internalNAVPriceSource) Price(ctx sdk.Context, vault types.VaultAccount, denom string) (types.VaultNAV, bool, error) {
+	nav, found, err := s.keeper.internalNAV(ctx, vault, denom)
+	if err != nil || !found {
+		return types.VaultNAV{}, false, err
+	}
+	if vault.MaxNavAgeSeconds > 0 {
+		if age := navAgeSeconds(ctx, nav); age > vault.MaxNavAgeSeconds {
+			return types.VaultNAV{}, false, staleNAVError(&vault, denom, age)
+		}
+	}
+	return nav, true, nil
+}
+
+// internalNAV returns the price the internal NAV source gives denom: the vault's NAV table
+// entry, replaced by the aggregate of its NAV sources once their quorum is met. found is
+// false when the denom has no entry.
+func (k Keeper) internalNAV(ctx sdk.Context, vault types.VaultAccount, denom string) (types.VaultNAV, bool, error) {
+	nav, err := k.GetVaultNAV(ctx, vault.GetAddress(), denom)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return types.VaultNAV{}, false, nil
+		}
+		return types.VaultNAV{}, false, fmt.Errorf("failed to get internal NAV for denom %q on vault %s: %w", denom, vault.GetAddress(), err)
+	}
+	agg, found, err := k.AggregatedSourceNAV(ctx, vault, denom)
+	if err != nil {
+		return types.VaultNAV{}, false, err
+	}
+	if found {
+		nav = agg
+	}
+	return nav, true, nil
+}
+
+// markerNAVPriceSource prices a denom from its marker-module net asset value in the
+// vault's underlying asset. A NAV with zero volume carries no price and is skipped.
+//
+// A marker NAV records only the block height it was set at, not a time, so its age cannot
+// be measured against max_nav_age_seconds. A vault with a staleness limit therefore never
+// prices from this source: the price is rejected as stale and the chain moves on.
+//
+// The marker keeper is read from the vault keeper at call time because app wiring may
+// inject it after NewKeeper.
+type markerNAVPriceSource struct {
+	keeper *Keeper
+}
+
+// Price implements PriceSource.
+func (s markerNAVPriceSource) Price(ctx sdk.Context, vault types.VaultAccount, denom string) (types.VaultNAV, bool, error) {
+	nav, err := s.keeper.MarkerKeeper.GetNetAssetValue(ctx, denom, vault.UnderlyingAsset)
+	if err != nil {
+		return types.VaultNAV{}, false, fmt.Errorf("failed to get marker NAV for denom %q in %q: %w", denom, vault.UnderlyingAsset, err)
+	}
+	if nav == nil || nav.Volume == 0 {
+		return types.VaultNAV{}, false, nil
+	}
+	if vault.MaxNavAgeSeconds > 0 {
+		return types.VaultNAV{}, false, fmt.Errorf("marker NAV for denom %q on vault %s has no update time to check against the %d second limit: %w",
+			denom, vault.Address, vault.MaxNavAgeSeconds, types.ErrStaleNAV)
+	}
+	return types.VaultNAV{
+		Denom:              denom,
+		Price:              nav.Price,
+		Volume:             sdkmath.NewIntFromUint64(nav.Volume),
+		Source:             "marker",
+		UpdatedBlockHeight: int64(nav.UpdatedBlockHeight), //nolint:gosec // G115: block heights fit in int64.
+	}, true, nil
+}
+
+// settlementPriceSource prices a denom from the vault's own most recent settlement of that
+// denom against its underlying asset (see recordSettlementPrice). A settlement price older
+// than the vault's max_nav_age_seconds is rejected as stale.
+type settlementPriceSource struct {
+	prices collections.Map[collections.Triple[sdk.AccAddress, string, string], types.VaultNAV]
+}
+
+// Price implements PriceSource.
+func (s settlementPriceSource) Price(ctx sdk.Context, vault types.VaultAccount, denom string) (types.VaultNAV, bool, error) {
+	nav, err := s.prices.Get(ctx, collections.Join3(vault.GetAddress(), denom, vault.UnderlyingAsset))
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return types.VaultNAV{}, false, nil
+		}
+		return types.VaultNAV{}, false, fmt.Errorf("failed to get settlement price for denom %q in %q on vault %s: %w", denom, vault.UnderlyingAsset, vault.Address, err)
+	}
+	if vault.MaxNavAgeSeconds > 0 {
+		if age := navAgeSeconds(ctx, nav); age > vault.MaxNavAgeSeconds {
+			return types.VaultNAV{}, false, fmt.Errorf("settlement price for denom %q on vault %s was recorded %d seconds ago, exceeding the %d second limit: %w",
+				denom, vault.Address, age, vault.MaxNavAgeSeconds, types.ErrStaleNAV)
+		}
+	}
+	return nav, true, nil
+}
+
+// resolveNAV walks the vault's price-source chain and returns the first price found for
+// denom together with the source that supplied it. found is false when no source in the
+// chain prices the denom.
+//
+// A source that rejects its price as stale (an error wrapping types.ErrStaleNAV) does not
+// end the walk; a later source may still price the denom. If none does, the stale error is
+// returned rather than found=false, so the denom is never silently left unvalued.
+func (k Keeper) resolveNAV(ctx sdk.Context, vault types.VaultAccount, denom string) (nav types.VaultNAV, sourceType types.PriceSourceType, found bool, err error) {
+	var staleErr error
+	for _, sourceType = range vault.PriceSourceChain() {
+		source, ok := k.priceSources[sourceType]
+		if !ok {
+			return types.VaultNAV{}, sourceType, false, fmt.Errorf("no implementation registered for price source %s", sourceType)
+		}
+		nav, found, err = source.Price(ctx, vault, denom)
+		if errors.Is(err, types.ErrStaleNAV) {
+			if staleErr == nil {
+				staleErr = err
+			}
+			continue
+		}
+		if err != nil || found {
+			return nav, sourceType, found, err
+		}
+	}
+	return types.VaultNAV{}, sourceType, false, staleErr
+}
+
+// recordSettlementPrice stores the price at which a vault just settled assetCoin against
+// paymentCoin through the exchange module, replacing the vault's previous settlement price
+// for that (denom, price denom) pair. The entry's source is the settling vault's address.
+//
+// A settlement with a zero leg discovers no price (a write-off moves no payment), so it
+// is not recorded.
+func (k *Keeper) recordSettlementPrice(ctx sdk.Context, vault *types.VaultAccount, assetCoin, paymentCoin sdk.Coin) error {
+	if !assetCoin.IsPositive() || !paymentCoin.IsPositive() {
+		return nil
+	}
+	nav := types.VaultNAV{
+		Denom:              assetCoin.Denom,
+		Price:              paymentCoin,
+		Volume:             assetCoin.Amount,
+		Source:             vault.Address,
+		UpdatedBlockHeight: ctx.BlockHeight(),
+		UpdatedTime:        ctx.BlockTime().UTC(),
+	}
+	if err := k.SettlementPrices.Set(ctx, collections.Join3(vault.GetAddress(), assetCoin.Denom, paymentCoin.Denom), nav); err != nil {
+		return fmt.Errorf("failed to record settlement price for denom %q: %w", assetCoin.Denom, err)
+	}
+	return nil
+}
+
+// SetPriceSources updates the price-source chain for a vault.
+func (k *Keeper) SetPriceSources(ctx sdk.Context, vault *types.VaultAccount, sources []types.PriceSourceType, authority string) error {
+	if slices.Equal(vault.PriceSources, sources) {
+		return nil
+	}
+	vault.PriceSources = sources
+	if err := k.SetVaultAccount(ctx, vault); err != nil {
+		return fmt.Errorf("failed to set vault account: %w", err)
+	}
+	k.emitEvent(ctx, types.NewEventPriceSourcesUpdated(vault.Address, authority, sources))
+	return nil
+}
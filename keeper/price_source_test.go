@@ -0,0 +1,377 @@
+package keeper_test
+
+import (
+	"time"
+
+	markertypes "github.com/provenance-io/provenance/x/marker/types"
+
+	"github.com/provlabs/vault/keeper"
+	"github.com/provlabs/vault/types"
+
+	"cosmossdk.io/collections"
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// setMarkerNAV publishes a marker-module net asset value for denom.
+func (s *TestSuite) setMarkerNAV(denom string, price sdk.Coin, volume uint64) {
+	marker, err := s.k.MarkerKeeper.GetMarkerByDenom(s.ctx, denom)
+	s.Require().NoError(err, "GetMarkerByDenom(%q) should not error", denom)
+	s.Require().NoError(s.k.MarkerKeeper.SetNetAssetValue(s.ctx, marker, markertypes.NetAssetValue{Price: price, Volume: volume}, "test"),
+		"should set marker NAV for %s", denom)
+}
+
+// setSettlementPrice stores a settlement price recorded by vault for denom in price.Denom
+// at the current block time.
+func (s *TestSuite) setSettlementPrice(vault *types.VaultAccount, denom string, price sdk.Coin, volume int64) {
+	nav := types.VaultNAV{Denom: denom, Price: price, Volume: sdkmath.NewInt(volume), Source: vault.Address, UpdatedTime: s.ctx.BlockTime().UTC()}
+	s.Require().NoError(s.k.SettlementPrices.Set(s.ctx, collections.Join3(vault.GetAddress(), denom, price.Denom), nav), "should set settlement price for %s", denom)
+}
+
+// setPriceSources stores the vault's price-source chain directly.
+func (s *TestSuite) setPriceSources(vault *types.VaultAccount, sources ...types.PriceSourceType) {
+	vault.PriceSources = sources
+	s.Require().NoError(s.k.SetVaultAccount(s.ctx, vault), "should set price sources on vault %s", vault.Address)
+}
+
+func (s *TestSuite) TestUnitPriceFractionWithSource() {
+	underlying, share, held, loan := "under", "vshare", "heldcoin", "loancoin"
+	internal := types.PriceSourceType_PRICE_SOURCE_TYPE_INTERNAL_NAV
+	marker := types.PriceSourceType_PRICE_SOURCE_TYPE_MARKER_NAV
+	settlement := types.PriceSourceType_PRICE_SOURCE_TYPE_EXCHANGE_SETTLEMENT
+
+	tests := []struct {
+		name         string
+		sources      []types.PriceSourceType
+		setup        func(vault *types.VaultAccount)
+		denom        string
+		expectNum    int64
+		expectDen    int64
+		expectSource types.PriceSourceType
+		expectTVV    int64
+		expectErr    string
+	}{
+		{
+			name:         "default chain prices from the internal NAV table",
+			denom:        held,
+			expectNum:    1,
+			expectDen:    1,
+			expectSource: internal,
+			expectTVV:    1_000,
+		},
+		{
+			name:      "default chain ignores a marker NAV",
+			setup:     func(_ *types.VaultAccount) { s.setMarkerNAV(loan, sdk.NewInt64Coin(underlying, 3), 1) },
+			denom:     loan,
+			expectErr: "no internal NAV entry for denom \"loancoin\"",
+			expectTVV: 1_000,
+		},
+		{
+			name:         "marker NAV values a denom without an internal entry",
+			sources:      []types.PriceSourceType{internal, marker},
+			setup:        func(_ *types.VaultAccount) { s.setMarkerNAV(loan, sdk.NewInt64Coin(underlying, 3), 1) },
+			denom:        loan,
+			expectNum:    3,
+			expectDen:    1,
+			expectSource: marker,
+			expectTVV:    1_300,
+		},
+		{
+			name:         "settlement price values a denom without an internal entry",
+			sources:      []types.PriceSourceType{internal, marker, settlement},
+			setup:        func(vault *types.VaultAccount) { s.setSettlementPrice(vault, loan, sdk.NewInt64Coin(underlying, 5), 2) },
+			denom:        loan,
+			expectNum:    5,
+			expectDen:    2,
+			expectSource: settlement,
+			expectTVV:    1_250,
+		},
+		{
+			name:    "earlier source wins",
+			sources: []types.PriceSourceType{marker, internal},
+			setup: func(vault *types.VaultAccount) {
+				s.setMarkerNAV(held, sdk.NewInt64Coin(underlying, 2), 1)
+				s.setSettlementPrice(vault, loan, sdk.NewInt64Coin(underlying, 5), 2)
+			},
+			denom:        held,
+			expectNum:    2,
+			expectDen:    1,
+			expectSource: marker,
+			expectTVV:    2_000,
+		},
+		{
+			name:      "denom no source prices",
+			sources:   []types.PriceSourceType{internal, marker},
+			denom:     loan,
+			expectErr: "no price source prices denom \"loancoin\"",
+			expectTVV: 1_000,
+		},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+			vault := s.setupStaleNAVVault(underlying, share, held, 0, false)
+			s.requireSimpleMarker(loan)
+			s.Require().NoError(FundAccount(s.ctx, s.simApp.BankKeeper, vault.PrincipalMarkerAddress(), sdk.NewCoins(sdk.NewInt64Coin(loan, 100))),
+				"should fund principal with %s", loan)
+			s.setPriceSources(vault, tc.sources...)
+			if tc.setup != nil {
+				tc.setup(vault)
+			}
+
+			num, den, source, err := s.k.UnitPriceFractionWithSource(s.ctx, tc.denom, *vault)
+			if tc.expectErr != "" {
+				s.Require().ErrorContains(err, tc.expectErr, "UnitPriceFractionWithSource error")
+				s.Assert().ErrorIs(err, keeper.ErrInternalNAVNotFound, "error should wrap ErrInternalNAVNotFound")
+			} else {
+				s.Require().NoError(err, "UnitPriceFractionWithSource should succeed")
+				s.Assert().Equal(sdkmath.NewInt(tc.expectNum), num, "numerator")
+				s.Assert().Equal(sdkmath.NewInt(tc.expectDen), den, "denominator")
+				s.Assert().Equal(tc.expectSource, source, "price source")
+			}
+
+			tvv, err := s.k.GetTVV(s.ctx, *vault)
+			s.Require().NoError(err, "GetTVV should not error")
+			s.Assert().Equal(sdkmath.NewInt(tc.expectTVV), tvv, "total vault value")
+		})
+	}
+}
+
+func (s *TestSuite) TestUnitPriceFractionWithSource_ExternalPriceScope() {
+	underlying, share, held, loan := "under", "vshare", "heldcoin", "loancoin"
+	internal := types.PriceSourceType_PRICE_SOURCE_TYPE_INTERNAL_NAV
+	marker := types.PriceSourceType_PRICE_SOURCE_TYPE_MARKER_NAV
+	settlement := types.PriceSourceType_PRICE_SOURCE_TYPE_EXCHANGE_SETTLEMENT
+	setStaleInternalNAV := func(vault *types.VaultAccount) {
+		nav := types.VaultNAV{Denom: loan, Price: sdk.NewInt64Coin(underlying, 3), Volume: sdkmath.NewInt(1), UpdatedTime: s.ctx.BlockTime().Add(-61 * time.Second)}
+		s.Require().NoError(s.k.NAVs.Set(s.ctx, collections.Join(vault.GetAddress(), loan), nav), "should set a stale internal NAV")
+	}
+
+	tests := []struct {
+		name         string
+		maxAge       uint64
+		sources      []types.PriceSourceType
+		setup        func(vault *types.VaultAccount)
+		advance      time.Duration
+		expectNum    int64
+		expectSource types.PriceSourceType
+		expectErrIs  error
+	}{
+		{
+			name:    "another vault's settlement price is not used",
+			sources: []types.PriceSourceType{settlement},
+			setup: func(_ *types.VaultAccount) {
+				other := types.VaultNAV{Denom: loan, Price: sdk.NewInt64Coin(underlying, 5), Volume: sdkmath.NewInt(1), UpdatedTime: s.ctx.BlockTime()}
+				s.Require().NoError(s.k.SettlementPrices.Set(s.ctx, collections.Join3(types.GetVaultAddress("othershare"), loan, underlying), other),
+					"should set another vault's settlement price")
+			},
+			expectErrIs: keeper.ErrInternalNAVNotFound,
+		},
+		{
+			name:         "settlement price within the staleness limit is used",
+			maxAge:       60,
+			sources:      []types.PriceSourceType{settlement},
+			setup:        func(vault *types.VaultAccount) { s.setSettlementPrice(vault, loan, sdk.NewInt64Coin(underlying, 5), 1) },
+			advance:      60 * time.Second,
+			expectNum:    5,
+			expectSource: settlement,
+		},
+		{
+			name:        "settlement price older than the staleness limit is rejected",
+			maxAge:      60,
+			sources:     []types.PriceSourceType{settlement},
+			setup:       func(vault *types.VaultAccount) { s.setSettlementPrice(vault, loan, sdk.NewInt64Coin(underlying, 5), 1) },
+			advance:     61 * time.Second,
+			expectErrIs: types.ErrStaleNAV,
+		},
+		{
+			name:        "marker NAV is rejected when a staleness limit is set",
+			maxAge:      60,
+			sources:     []types.PriceSourceType{marker},
+			setup:       func(_ *types.VaultAccount) { s.setMarkerNAV(loan, sdk.NewInt64Coin(underlying, 3), 1) },
+			expectErrIs: types.ErrStaleNAV,
+		},
+		{
+			name:    "a stale source falls through to a fresh one",
+			maxAge:  60,
+			sources: []types.PriceSourceType{marker, settlement},
+			setup: func(vault *types.VaultAccount) {
+				s.setMarkerNAV(loan, sdk.NewInt64Coin(underlying, 3), 1)
+				s.setSettlementPrice(vault, loan, sdk.NewInt64Coin(underlying, 5), 1)
+			},
+			expectNum:    5,
+			expectSource: settlement,
+		},
+		{
+			name:        "internal NAV older than the staleness limit is rejected",
+			maxAge:      60,
+			sources:     []types.PriceSourceType{internal},
+			setup:       setStaleInternalNAV,
+			expectErrIs: types.ErrStaleNAV,
+		},
+		{
+			name:    "a stale internal NAV falls through to a fresh settlement price",
+			maxAge:  60,
+			sources: []types.PriceSourceType{internal, settlement},
+			setup: func(vault *types.VaultAccount) {
+				setStaleInternalNAV(vault)
+				s.setSettlementPrice(vault, loan, sdk.NewInt64Coin(underlying, 5), 1)
+			},
+			expectNum:    5,
+			expectSource: settlement,
+		},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+			vault := s.setupStaleNAVVault(underlying, share, held, tc.maxAge, false)
+			s.requireSimpleMarker(loan)
+			s.setPriceSources(vault, tc.sources...)
+			tc.setup(vault)
+			s.SetCtxBlockTime(s.ctx.BlockTime().Add(tc.advance))
+
+			num, den, source, err := s.k.UnitPriceFractionWithSource(s.ctx, loan, *vault)
+			if tc.expectErrIs != nil {
+				s.Require().ErrorIs(err, tc.expectErrIs, "UnitPriceFractionWithSource error")
+				return
+			}
+			s.Require().NoError(err, "UnitPriceFractionWithSource should succeed")
+			s.Assert().Equal(sdkmath.NewInt(tc.expectNum), num, "numerator")
+			s.Assert().Equal(sdkmath.OneInt(), den, "denominator")
+			s.Assert().Equal(tc.expectSource, source, "price source")
+		})
+	}
+}
+
+func (s *TestSuite) TestMsgServer_UpdatePriceSources() {
+	underlying, share, held := "under", "vshare", "heldcoin"
+	marker := types.PriceSourceType_PRICE_SOURCE_TYPE_MARKER_NAV
+	internal := types.PriceSourceType_PRICE_SOURCE_TYPE_INTERNAL_NAV
+
+	tests := []struct {
+		name        string
+		authority   func() string
+		initial     []types.PriceSourceType
+		sources     []types.PriceSourceType
+		expectErr   string
+		expectEvent bool
+	}{
+		{
+			name:        "admin sets the chain",
+			authority:   func() string { return s.adminAddr.String() },
+			sources:     []types.PriceSourceType{internal, marker},
+			expectEvent: true,
+		},
+		{
+			name:        "admin clears the chain",
+			authority:   func() string { return s.adminAddr.String() },
+			initial:     []types.PriceSourceType{internal, marker},
+			expectEvent: true,
+		},
+		{
+			name:      "unchanged chain is a no-op",
+			authority: func() string { return s.adminAddr.String() },
+			initial:   []types.PriceSourceType{marker},
+			sources:   []types.PriceSourceType{marker},
+		},
+		{
+			name:      "non-admin is rejected",
+			authority: func() string { return s.assetManagerAddr.String() },
+			sources:   []types.PriceSourceType{marker},
+			expectErr: "failed to validate admin",
+		},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+			vault := s.setupNAVChangeLimitVault(underlying, share, held, 0)
+			s.setPriceSources(vault, tc.initial...)
+			s.ctx = s.ctx.WithEventManager(sdk.NewEventManager())
+
+			_, err := keeper.NewMsgServer(s.simApp.VaultKeeper).UpdatePriceSources(s.ctx, &types.MsgUpdatePriceSourcesRequest{
+				Authority:    tc.authority(),
+				VaultAddress: vault.Address,
+				PriceSources: tc.sources,
+			})
+			if tc.expectErr != "" {
+				s.Require().ErrorContains(err, tc.expectErr, "UpdatePriceSources error")
+				return
+			}
+			s.Require().NoError(err, "UpdatePriceSources should succeed")
+
+			updated, err := s.k.GetVault(s.ctx, vault.GetAddress())
+			s.Require().NoError(err, "should reload vault")
+			s.Assert().Equal(tc.sources, updated.PriceSources, "stored price sources")
+			if tc.expectEvent {
+				s.requireTypedEventEmitted(types.NewEventPriceSourcesUpdated(vault.Address, tc.authority(), tc.sources))
+				return
+			}
+			for _, ev := range s.ctx.EventManager().Events() {
+				s.Assert().NotEqual("provlabs.vault.v1.EventPriceSourcesUpdated", ev.Type, "no event should be emitted for an unchanged chain")
+			}
+		})
+	}
+}
+
+func (s *TestSuite) TestMsgServer_AcceptAsset_RecordsSettlementPrice() {
+	underlying, share, asset := "under", "vshare", "rwacoin"
+	externalID := "p2p-settlement-price"
+
+	sourceAmount := sdk.NewCoins(sdk.NewInt64Coin(asset, 10))
+	targetAmount := sdk.NewCoins(sdk.NewInt64Coin(underlying, 5))
+	vault, _, source := s.setupAcceptAssetScenario(acceptAssetScenario{
+		underlying:    underlying,
+		share:         share,
+		assetMarker:   asset,
+		seedNav:       &types.VaultNAV{Denom: asset, Price: sdk.NewInt64Coin(underlying, 5), Volume: sdkmath.NewInt(10)},
+		fundSource:    sourceAmount,
+		fundPrincipal: targetAmount,
+		sourceAmount:  sourceAmount,
+		targetAmount:  targetAmount,
+		externalID:    externalID,
+	})
+
+	_, err := keeper.NewMsgServer(s.simApp.VaultKeeper).AcceptAsset(s.ctx, &types.MsgAcceptAssetRequest{
+		Authority:    s.assetManagerAddr.String(),
+		VaultAddress: vault.Address,
+		Source:       source.String(),
+		ExternalId:   externalID,
+	})
+	s.Require().NoError(err, "AcceptAsset should succeed")
+
+	price, err := s.k.SettlementPrices.Get(s.ctx, collections.Join3(vault.GetAddress(), asset, underlying))
+	s.Require().NoError(err, "settlement price should be recorded")
+	s.Assert().Equal(sdk.NewInt64Coin(underlying, 5), price.Price, "settlement price")
+	s.Assert().Equal(sdkmath.NewInt(10), price.Volume, "settlement volume")
+	s.Assert().Equal(vault.Address, price.Source, "settlement price source")
+	s.Assert().Equal(s.ctx.BlockHeight(), price.UpdatedBlockHeight, "settlement price height")
+}
+
+func (s *TestSuite) TestQueryServer_NavValue_PriceSource() {
+	underlying, share, held, loan := "under", "vshare", "heldcoin", "loancoin"
+	vault := s.setupStaleNAVVault(underlying, share, held, 0, false)
+	s.requireSimpleMarker(loan)
+	s.setSettlementPrice(vault, loan, sdk.NewInt64Coin(underlying, 5), 2)
+	queryServer := keeper.NewQueryServer(s.simApp.VaultKeeper)
+
+	_, err := queryServer.NavValue(s.ctx, &types.QueryNavValueRequest{Id: vault.Address, Denom: loan})
+	s.Require().ErrorContains(err, "no NAV entry for vault", "NavValue without a pricing source")
+
+	s.setPriceSources(vault, types.PriceSourceType_PRICE_SOURCE_TYPE_INTERNAL_NAV, types.PriceSourceType_PRICE_SOURCE_TYPE_EXCHANGE_SETTLEMENT)
+
+	resp, err := queryServer.NavValue(s.ctx, &types.QueryNavValueRequest{Id: vault.Address, Denom: loan})
+	s.Require().NoError(err, "NavValue for a settlement-priced denom should succeed")
+	s.Assert().Equal(types.VaultNAV{}, resp.Nav, "settlement-priced denom has no internal entry")
+	s.Assert().Equal(types.PriceSourceType_PRICE_SOURCE_TYPE_EXCHANGE_SETTLEMENT, resp.PriceSource, "price source")
+	s.Assert().Equal(sdk.NewInt64Coin(underlying, 5), resp.Price.Price, "price")
+
+	resp, err = queryServer.NavValue(s.ctx, &types.QueryNavValueRequest{Id: share, Denom: held})
+	s.Require().NoError(err, "NavValue for an internally priced denom should succeed")
+	s.Assert().Equal(held, resp.Nav.Denom, "internal entry denom")
+	s.Assert().Equal(types.PriceSourceType_PRICE_SOURCE_TYPE_INTERNAL_NAV, resp.PriceSource, "price source")
+	s.Assert().Equal(resp.Nav.Price, resp.Price.Price, "internal price")
+}
@@ -93,11 +93,26 @@ func (k queryServer) Vault(goCtx context.Context, req *types.QueryVaultRequest)
 	principal := k.BankKeeper.GetAllBalances(goCtx, marker.GetAddress())
 	reserves := k.BankKeeper.GetAllBalances(goCtx, vault.GetAddress())
 
+	// A vault whose stale NAVs leave a held denom unpriced is still reported, flagged by
+	// nav_stale, without a total vault value.
 	tvv, err := k.EstimateTotalVaultValue(ctx, vault)
+	if errors.Is(err, types.ErrStaleNAV) {
+		tvv, err = sdk.NewInt64Coin(vault.UnderlyingAsset, 0), nil
+	}
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to estimate total vault value: %v", err)
 	}
 
+	oldestNAV, oldestNAVAge, _, err := k.OldestHeldNAV(ctx, *vault)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get oldest held NAV: %v", err)
+	}
+
+	buffer, err := k.GetLiquidityBuffer(ctx, *vault)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get liquidity buffer: %v", err)
+	}
+
 	return &types.QueryVaultResponse{
 		Vault: *vault,
 		Principal: types.AccountBalance{
@@ -108,7 +123,11 @@ func (k queryServer) Vault(goCtx context.Context, req *types.QueryVaultRequest)
 			Address: vault.GetAddress().String(),
 			Coins:   reserves,
 		},
-		TotalVaultValue: tvv,
+		TotalVaultValue:     tvv,
+		OldestNavDenom:      oldestNAV.Denom,
+		OldestNavAgeSeconds: oldestNAVAge,
+		NavStale:            vault.MaxNavAgeSeconds > 0 && oldestNAVAge > vault.MaxNavAgeSeconds,
+		LiquidityBuffer:     buffer,
 	}, nil
 }
 
@@ -157,10 +176,20 @@ func (k queryServer) EstimateSwapIn(goCtx context.Context, req *types.QueryEstim
 		return nil, status.Errorf(codes.Internal, "failed to calculate shares: %v", err)
 	}
 
+	var capacity *sdk.Coin
+	remaining, capped, err := k.netRemainingCapacity(ctx, vault)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to calculate remaining capacity: %v", err)
+	}
+	if capped {
+		capacity = &sdk.Coin{Denom: vault.UnderlyingAsset, Amount: remaining}
+	}
+
 	return &types.QueryEstimateSwapInResponse{
-		Assets: estimatedShares,
-		Height: ctx.BlockHeight(),
-		Time:   ctx.BlockTime().UTC(),
+		Assets:            estimatedShares,
+		Height:            ctx.BlockHeight(),
+		Time:              ctx.BlockTime().UTC(),
+		RemainingCapacity: capacity,
 	}, nil
 }
 
@@ -320,7 +349,8 @@ func (k queryServer) Params(goCtx context.Context, req *types.QueryParamsRequest
 	}, nil
 }
 
-// VaultNavs returns a paginated list of all internal NAV entries for a vault.
+// VaultNavs returns a paginated list of all internal NAV entries for a vault, along with
+// every valuation haircut the vault applies.
 func (k queryServer) VaultNavs(goCtx context.Context, req *types.QueryVaultNavsRequest) (*types.QueryVaultNavsResponse, error) {
 	if req == nil || req.Id == "" {
 		return nil, status.Error(codes.InvalidArgument, "id must be provided")
@@ -350,13 +380,21 @@ func (k queryServer) VaultNavs(goCtx context.Context, req *types.QueryVaultNavsR
 		return nil, status.Errorf(codes.Internal, "failed to paginate vault navs: %v", err)
 	}
 
+	haircuts, err := k.GetAssetHaircuts(ctx, vaultAddr)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get vault asset haircuts: %v", err)
+	}
+
 	return &types.QueryVaultNavsResponse{
 		Navs:       navs,
 		Pagination: pageRes,
+		Haircuts:   haircuts,
 	}, nil
 }
 
-// NavValue returns a single internal NAV entry for a vault and denom, or NotFound.
+// NavValue returns a vault's internal NAV entry for a denom together with the price that
+// values the denom under the vault's price-source chain, or NotFound when no source in the
+// chain prices it.
 func (k queryServer) NavValue(goCtx context.Context, req *types.QueryNavValueRequest) (*types.QueryNavValueResponse, error) {
 	if req == nil || req.Id == "" {
 		return nil, status.Error(codes.InvalidArgument, "id must be provided")
@@ -375,16 +413,141 @@ func (k queryServer) NavValue(goCtx context.Context, req *types.QueryNavValueReq
 		return nil, status.Errorf(codes.Internal, "failed to find vault account %s: %v", req.Id, err)
 	}
 
-	nav, err := k.GetVaultNAV(ctx, vault.GetAddress(), req.Denom)
+	price, source, found, err := k.resolveNAV(ctx, *vault, req.Denom)
 	if err != nil {
-		if errors.Is(err, collections.ErrNotFound) {
-			return nil, status.Errorf(codes.NotFound, "no NAV entry for vault %s denom %s", req.Id, req.Denom)
-		}
+		return nil, status.Errorf(codes.Internal, "failed to resolve vault nav: %v", err)
+	}
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "no NAV entry for vault %s denom %s", req.Id, req.Denom)
+	}
+
+	nav, err := k.GetVaultNAV(ctx, vault.GetAddress(), req.Denom)
+	if err != nil && !errors.Is(err, collections.ErrNotFound) {
 		return nil, status.Errorf(codes.Internal, "failed to get vault nav: %v", err)
 	}
 
 	return &types.QueryNavValueResponse{
-		Nav: nav,
+		Nav:         nav,
+		PriceSource: source,
+		Price:       price,
+	}, nil
+}
+
+// PendingNAVProposals returns a paginated list of a vault's NAV updates awaiting approval
+// by its NAV approver.
+func (k queryServer) PendingNAVProposals(goCtx context.Context, req *types.QueryPendingNAVProposalsRequest) (*types.QueryPendingNAVProposalsResponse, error) {
+	if req == nil || req.Id == "" {
+		return nil, status.Error(codes.InvalidArgument, "id must be provided")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	vault, err := k.FindVaultAccount(ctx, req.Id)
+	if err != nil {
+		if errors.Is(err, types.ErrVaultNotFound) {
+			return nil, status.Errorf(codes.NotFound, "vault account %s not found", req.Id)
+		}
+		return nil, status.Errorf(codes.Internal, "failed to find vault account %s: %v", req.Id, err)
+	}
+
+	proposals, pageRes, err := query.CollectionPaginate(
+		ctx,
+		k.Keeper.PendingNAVProposals,
+		req.Pagination,
+		func(_ collections.Pair[sdk.AccAddress, string], value types.PendingNAVProposal) (types.PendingNAVProposal, error) {
+			return value, nil
+		},
+		query.WithCollectionPaginationPairPrefix[sdk.AccAddress, string](vault.GetAddress()),
+	)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to paginate NAV proposals: %v", err)
+	}
+
+	return &types.QueryPendingNAVProposalsResponse{
+		Proposals:  proposals,
+		Pagination: pageRes,
+	}, nil
+}
+
+// NAVSources returns each NAV source's submission for a vault and denom, whether it is
+// fresh enough to count toward the vault's quorum, and the aggregated NAV when the
+// quorum is met.
+func (k queryServer) NAVSources(goCtx context.Context, req *types.QueryNAVSourcesRequest) (*types.QueryNAVSourcesResponse, error) {
+	if req == nil || req.Id == "" {
+		return nil, status.Error(codes.InvalidArgument, "id must be provided")
+	}
+	if req.Denom == "" {
+		return nil, status.Error(codes.InvalidArgument, "denom must be provided")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	vault, err := k.FindVaultAccount(ctx, req.Id)
+	if err != nil {
+		if errors.Is(err, types.ErrVaultNotFound) {
+			return nil, status.Errorf(codes.NotFound, "vault account %s not found", req.Id)
+		}
+		return nil, status.Errorf(codes.Internal, "failed to find vault account %s: %v", req.Id, err)
+	}
+
+	contributions, err := k.NAVSourceContributions(ctx, *vault, req.Denom)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get NAV source contributions: %v", err)
+	}
+	agg, found, err := k.AggregatedSourceNAV(ctx, *vault, req.Denom)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to aggregate NAV source submissions: %v", err)
+	}
+
+	resp := &types.QueryNAVSourcesResponse{
+		Contributions: contributions,
+		Quorum:        vault.NavSourceQuorum,
+		Aggregation:   vault.NavAggregation,
+	}
+	if found {
+		resp.Aggregate = &agg
+	}
+	return resp, nil
+}
+
+// NavHistory returns a vault's NAV history for a denom in ascending time order, limited
+// to [start_time, end_time) when either bound is set. A non-zero interval_seconds
+// downsamples the series to the first point in each epoch-aligned interval.
+func (k queryServer) NavHistory(goCtx context.Context, req *types.QueryNavHistoryRequest) (*types.QueryNavHistoryResponse, error) {
+	if req == nil || req.Id == "" {
+		return nil, status.Error(codes.InvalidArgument, "id must be provided")
+	}
+	if req.Denom == "" {
+		return nil, status.Error(codes.InvalidArgument, "denom must be provided")
+	}
+	if req.StartTime != nil && req.EndTime != nil && !req.StartTime.Before(*req.EndTime) {
+		return nil, status.Error(codes.InvalidArgument, "start_time must be before end_time")
+	}
+	if req.IntervalSeconds > types.MaxNAVHistoryRetention {
+		return nil, status.Errorf(codes.InvalidArgument, "interval_seconds cannot exceed %d", types.MaxNAVHistoryRetention)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	vault, err := k.FindVaultAccount(ctx, req.Id)
+	if err != nil {
+		if errors.Is(err, types.ErrVaultNotFound) {
+			return nil, status.Errorf(codes.NotFound, "vault account %s not found", req.Id)
+		}
+		return nil, status.Errorf(codes.Internal, "failed to find vault account %s: %v", req.Id, err)
+	}
+
+	entries, pageRes, err := k.navHistoryPage(ctx, vault.GetAddress(), req.Denom, req.StartTime, req.EndTime, req.IntervalSeconds, req.Pagination)
+	if err != nil {
+		if errors.Is(err, types.ErrInvalidRequest) {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		return nil, status.Errorf(codes.Internal, "failed to paginate NAV history: %v", err)
+	}
+
+	return &types.QueryNavHistoryResponse{
+		Entries:    entries,
+		Pagination: pageRes,
 	}, nil
 }
 
@@ -474,3 +637,314 @@ func toPayment(payment *exchange.Payment) types.Payment {
 		ExternalId:   payment.ExternalId,
 	}
 }
+
+// SharePrice returns the vault's NAV per share as a decimal, quoted per the share unit
+// from the share denom's bank metadata (see GetShareUnit). The gross price values the
+// vault before its outstanding AUM fee, the net price after it, and the estimated price
+// additionally accrues unpaid interest and AUM fees to the current block.
+//
+// A paused vault's value is frozen at its paused balance, which is already net of fees,
+// so all three prices equal that balance per share.
+func (k queryServer) SharePrice(goCtx context.Context, req *types.QuerySharePriceRequest) (*types.QuerySharePriceResponse, error) {
+	if req == nil || req.Id == "" {
+		return nil, status.Error(codes.InvalidArgument, "id must be provided")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	vault, err := k.FindVaultAccount(ctx, req.Id)
+	if err != nil {
+		if errors.Is(err, types.ErrVaultNotFound) {
+			return nil, status.Errorf(codes.NotFound, "vault account %s not found", req.Id)
+		}
+		return nil, status.Errorf(codes.Internal, "failed to find vault account %s: %v", req.Id, err)
+	}
+
+	gross, err := k.GetTVV(ctx, *vault)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get total vault value: %v", err)
+	}
+	net, err := k.GetNetTVV(ctx, *vault)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get net total vault value: %v", err)
+	}
+	estimated := net
+	if !vault.Paused {
+		estimatedTVV, err := k.EstimateTotalVaultValue(ctx, vault)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to estimate total vault value: %v", err)
+		}
+		estimated = estimatedTVV.Amount
+	}
+
+	shareUnit, exponent := k.GetShareUnit(ctx, *vault)
+	grossPrice, err := NAVPerShareUnit(gross, vault.TotalShares.Amount, exponent)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to compute gross share price: %v", err)
+	}
+	netPrice, err := NAVPerShareUnit(net, vault.TotalShares.Amount, exponent)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to compute net share price: %v", err)
+	}
+	estimatedPrice, err := NAVPerShareUnit(estimated, vault.TotalShares.Amount, exponent)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to compute estimated share price: %v", err)
+	}
+
+	resp := &types.QuerySharePriceResponse{
+		Gross:      grossPrice,
+		Net:        netPrice,
+		Estimated:  estimatedPrice,
+		PriceDenom: vault.UnderlyingAsset,
+		ShareUnit:  shareUnit,
+		Exponent:   exponent,
+		Height:     ctx.BlockHeight(),
+		Time:       ctx.BlockTime().UTC(),
+	}
+	return resp, nil
+}
+
+// VaultTWAP returns the vault's time-weighted average share price over the requested window,
+// read from its share price accumulator.
+func (k queryServer) VaultTWAP(goCtx context.Context, req *types.QueryVaultTWAPRequest) (*types.QueryVaultTWAPResponse, error) {
+	if req == nil || req.Id == "" {
+		return nil, status.Error(codes.InvalidArgument, "id must be provided")
+	}
+	if req.StartTime == nil {
+		return nil, status.Error(codes.InvalidArgument, "start_time must be provided")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	end := ctx.BlockTime().UTC()
+	if req.EndTime != nil {
+		if req.EndTime.After(end) {
+			return nil, status.Error(codes.InvalidArgument, "end_time cannot be after the current block time")
+		}
+		end = req.EndTime.UTC()
+	}
+	if !req.StartTime.Before(end) {
+		return nil, status.Error(codes.InvalidArgument, "start_time must be before end_time")
+	}
+
+	vault, err := k.FindVaultAccount(ctx, req.Id)
+	if err != nil {
+		if errors.Is(err, types.ErrVaultNotFound) {
+			return nil, status.Errorf(codes.NotFound, "vault account %s not found", req.Id)
+		}
+		return nil, status.Errorf(codes.Internal, "failed to find vault account %s: %v", req.Id, err)
+	}
+
+	twap, start, err := k.GetSharePriceTWAP(ctx, vault.GetAddress(), req.StartTime.UTC(), end)
+	if err != nil {
+		if errors.Is(err, ErrNoSharePriceHistory) {
+			return nil, status.Error(codes.NotFound, err.Error())
+		}
+		return nil, status.Errorf(codes.Internal, "failed to compute share price TWAP: %v", err)
+	}
+
+	return &types.QueryVaultTWAPResponse{
+		Twap:       twap,
+		PriceDenom: vault.UnderlyingAsset,
+		StartTime:  start,
+		EndTime:    end,
+	}, nil
+}
+
+// VaultPerformance returns the vault's share price return metrics over trailing 7, 30, 90 and
+// 365 day windows, read from its share price observations.
+func (k queryServer) VaultPerformance(goCtx context.Context, req *types.QueryVaultPerformanceRequest) (*types.QueryVaultPerformanceResponse, error) {
+	if req == nil || req.Id == "" {
+		return nil, status.Error(codes.InvalidArgument, "id must be provided")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	vault, err := k.FindVaultAccount(ctx, req.Id)
+	if err != nil {
+		if errors.Is(err, types.ErrVaultNotFound) {
+			return nil, status.Errorf(codes.NotFound, "vault account %s not found", req.Id)
+		}
+		return nil, status.Errorf(codes.Internal, "failed to find vault account %s: %v", req.Id, err)
+	}
+
+	windows := make([]types.VaultPerformanceWindow, 0, len(performanceWindowDays))
+	for _, days := range performanceWindowDays {
+		window, err := k.GetVaultPerformance(ctx, vault.GetAddress(), days)
+		if err != nil {
+			if errors.Is(err, ErrNoSharePriceHistory) {
+				return nil, status.Error(codes.NotFound, err.Error())
+			}
+			return nil, status.Errorf(codes.Internal, "failed to compute %d day performance: %v", days, err)
+		}
+		windows = append(windows, window)
+	}
+
+	return &types.QueryVaultPerformanceResponse{
+		Windows:    windows,
+		PriceDenom: vault.UnderlyingAsset,
+		Height:     ctx.BlockHeight(),
+		Time:       ctx.BlockTime().UTC(),
+	}, nil
+}
+
+// VaultComposition returns the value of each denom a vault holds as a share of its total
+// vault value, against the vault's concentration limits.
+func (k queryServer) VaultComposition(goCtx context.Context, req *types.QueryVaultCompositionRequest) (*types.QueryVaultCompositionResponse, error) {
+	if req == nil || req.Id == "" {
+		return nil, status.Error(codes.InvalidArgument, "id must be provided")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	vault, err := k.FindVaultAccount(ctx, req.Id)
+	if err != nil {
+		if errors.Is(err, types.ErrVaultNotFound) {
+			return nil, status.Errorf(codes.NotFound, "vault account %s not found", req.Id)
+		}
+		return nil, status.Errorf(codes.Internal, "failed to find vault account %s: %v", req.Id, err)
+	}
+
+	total, assets, nonUnderlying, err := k.GetVaultComposition(ctx, *vault)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to compute vault composition: %v", err)
+	}
+
+	return &types.QueryVaultCompositionResponse{
+		TotalValue:    total,
+		Assets:        assets,
+		NonUnderlying: nonUnderlying,
+		Height:        ctx.BlockHeight(),
+		Time:          ctx.BlockTime().UTC(),
+	}, nil
+}
+
+// VaultHoldings returns each denom a vault holds with the price and valuation its total
+// vault value is built from, and the held balances left unvalued.
+func (k queryServer) VaultHoldings(goCtx context.Context, req *types.QueryVaultHoldingsRequest) (*types.QueryVaultHoldingsResponse, error) {
+	if req == nil || req.Id == "" {
+		return nil, status.Error(codes.InvalidArgument, "id must be provided")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	vault, err := k.FindVaultAccount(ctx, req.Id)
+	if err != nil {
+		if errors.Is(err, types.ErrVaultNotFound) {
+			return nil, status.Errorf(codes.NotFound, "vault account %s not found", req.Id)
+		}
+		return nil, status.Errorf(codes.Internal, "failed to find vault account %s: %v", req.Id, err)
+	}
+
+	total, holdings, unvalued, err := k.GetVaultHoldings(ctx, *vault)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to compute vault holdings: %v", err)
+	}
+
+	return &types.QueryVaultHoldingsResponse{
+		TotalValue: total,
+		Holdings:   holdings,
+		Unvalued:   unvalued,
+		Height:     ctx.BlockHeight(),
+		Time:       ctx.BlockTime().UTC(),
+	}, nil
+}
+
+// ScheduledActions returns a paginated list of the scheduled actions awaiting a vault's
+// timelock, in execute time order.
+func (k queryServer) ScheduledActions(goCtx context.Context, req *types.QueryScheduledActionsRequest) (*types.QueryScheduledActionsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	if req.Id == "" {
+		return nil, status.Error(codes.InvalidArgument, "id must be provided")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	vault, err := k.FindVaultAccount(ctx, req.Id)
+	if err != nil {
+		if errors.Is(err, types.ErrVaultNotFound) {
+			return nil, status.Errorf(codes.NotFound, "vault account %s not found", req.Id)
+		}
+		return nil, status.Errorf(codes.Internal, "failed to find vault account %s: %v", req.Id, err)
+	}
+
+	actions, pageRes, err := query.CollectionFilteredPaginate(
+		ctx,
+		k.ScheduledActionQueue.IndexedMap,
+		req.Pagination,
+		func(key collections.Triple[int64, uint64, sdk.AccAddress], _ types.ScheduledAction) (include bool, err error) {
+			return vault.Address == key.K3().String(), nil
+		},
+		func(_ collections.Triple[int64, uint64, sdk.AccAddress], value types.ScheduledAction) (types.ScheduledAction, error) {
+			return value, nil
+		},
+	)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to paginate scheduled actions: %v", err)
+	}
+
+	return &types.QueryScheduledActionsResponse{
+		ScheduledActions: actions,
+		Pagination:       pageRes,
+	}, nil
+}
+
+// InvestorEligibility reports whether an address is eligible to swap into, and receive
+// shares of, a vault under the vault's eligibility policy.
+func (k queryServer) InvestorEligibility(goCtx context.Context, req *types.QueryInvestorEligibilityRequest) (*types.QueryInvestorEligibilityResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	if req.Id == "" {
+		return nil, status.Error(codes.InvalidArgument, "id must be provided")
+	}
+	addr, err := sdk.AccAddressFromBech32(req.Address)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid address %q: %v", req.Address, err)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	vault, err := k.FindVaultAccount(ctx, req.Id)
+	if err != nil {
+		if errors.Is(err, types.ErrVaultNotFound) {
+			return nil, status.Errorf(codes.NotFound, "vault account %s not found", req.Id)
+		}
+		return nil, status.Errorf(codes.Internal, "failed to find vault account %s: %v", req.Id, err)
+	}
+
+	eligible, allowlisted, missing, err := k.CheckEligibility(ctx, vault, addr)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to check eligibility: %v", err)
+	}
+
+	return &types.QueryInvestorEligibilityResponse{
+		Eligible:          eligible,
+		Allowlisted:       allowlisted,
+		MissingAttributes: missing,
+	}, nil
+}
+
+// CircuitBreaker returns the module-wide circuit breaker and its guardian.
+func (k queryServer) CircuitBreaker(goCtx context.Context, req *types.QueryCircuitBreakerRequest) (*types.QueryCircuitBreakerResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	params, err := k.Keeper.Params.Get(ctx)
+	if err != nil {
+		if !errors.Is(err, collections.ErrNotFound) {
+			return nil, status.Errorf(codes.Internal, "failed to get params: %v", err)
+		}
+		params = types.DefaultParams()
+	}
+
+	return &types.QueryCircuitBreakerResponse{
+		CircuitBreaker: params.CircuitBreaker,
+		Guardian:       params.CircuitBreakerGuardian,
+	}, nil
+}
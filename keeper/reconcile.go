@@ -49,9 +49,14 @@ var navReferenceVolume = sdkmath.NewIntFromUint64(math.MaxUint64)
 // the original object upon successful completion.
 //
 // The process follows a strict mathematical ordering:
-// 1. Interest is processed first to ensure the Total Vault Value (TVV) is updated.
-// 2. AUM fees are then calculated and collected based on the post-interest TVV.
-// 3. Timeouts are rescheduled and the updated NAV is published.
+// 1. Outbound exchange payments the target has accepted or rejected are closed and their
+// escrow swept back to the principal. Escrowed funds already count toward TVV, so this
+// moves no value.
+// 2. Interest is processed to ensure the Total Vault Value (TVV) is updated.
+// 3. AUM fees are then calculated and collected based on the post-interest TVV.
+// 4. Timeouts are rescheduled and the updated NAV is published.
+// 5. The resulting share price is recorded in the vault's share price accumulator; a failure
+// to record it is logged and does not fail the reconciliation.
 func (k Keeper) reconcileVault(ctx sdk.Context, vault *types.VaultAccount) error {
 	if vault == nil {
 		return fmt.Errorf("vault account cannot be nil")
@@ -64,6 +69,10 @@ func (k Keeper) reconcileVault(ctx sdk.Context, vault *types.VaultAccount) error
 	v := vault.Clone()
 	currentBlockTime := cacheCtx.BlockTime().Unix()
 
+	if err := k.closeCompletedOutboundPayments(cacheCtx, v); err != nil {
+		return fmt.Errorf("close completed outbound payments: %w", err)
+	}
+
 	if v.PeriodStart != 0 && currentBlockTime > v.PeriodStart {
 		if err := k.PerformVaultInterestTransfer(cacheCtx, v); err != nil {
 			return fmt.Errorf("perform vault interest transfer: %w", err)
@@ -89,6 +98,10 @@ func (k Keeper) reconcileVault(ctx sdk.Context, vault *types.VaultAccount) error
 		}
 	}
 
+	if err := k.recordSharePrice(cacheCtx, v); err != nil {
+		k.getLogger(ctx).Error("failed to record share price", "vault", v.GetAddress().String(), "err", err)
+	}
+
 	if err := k.SafeAddPayoutVerification(cacheCtx, v); err != nil {
 		return fmt.Errorf("reconcileVault: failed to SafeAddPayoutVerification for vault %s: %w", v.GetAddress().String(), err)
 	}
@@ -146,15 +159,28 @@ func (k Keeper) setShareDenomNAV(ctx sdk.Context, vault *types.VaultAccount, vau
 		return nil
 	}
 
-	return k.MarkerKeeper.SetNetAssetValue(
-		ctx,
-		vaultMarker,
-		markertypes.NetAssetValue{
-			Price:  sdk.NewCoin(vault.UnderlyingAsset, price),
-			Volume: volume.Uint64(),
-		},
-		types.ModuleName,
-	)
+	nav := markertypes.NetAssetValue{
+		Price:  sdk.NewCoin(vault.UnderlyingAsset, price),
+		Volume: volume.Uint64(),
+	}
+	if err := k.MarkerKeeper.SetNetAssetValue(ctx, vaultMarker, nav, types.ModuleName); err != nil {
+		return err
+	}
+	return k.recordShareNAVHistory(ctx, vault, nav)
+}
+
+// recordShareNAVHistory appends a share NAV just published to the vault's principal marker
+// to the vault's NAV history under the share denom, so the share price series can be
+// charted without an external indexer.
+func (k Keeper) recordShareNAVHistory(ctx sdk.Context, vault *types.VaultAccount, nav markertypes.NetAssetValue) error {
+	return k.recordNAVHistory(ctx, vault.GetAddress(), types.VaultNAV{
+		Denom:              vault.TotalShares.Denom,
+		Price:              nav.Price,
+		Volume:             sdkmath.NewIntFromUint64(nav.Volume),
+		Source:             types.ModuleName,
+		UpdatedBlockHeight: ctx.BlockHeight(),
+		UpdatedTime:        ctx.BlockTime().UTC(),
+	})
 }
 
 // publishShareNav records the Net Asset Value (NAV) for the vault's share denom
@@ -171,6 +197,9 @@ func (k Keeper) setShareDenomNAV(ctx sdk.Context, vault *types.VaultAccount, vau
 // at most once; a NAV left at zero price but non-zero volume (which the scaled truncation path can
 // publish) is still re-zeroed. When the TVV is non-positive, no NAV is published.
 //
+// Every published share NAV, including the zeroing overwrite, is appended to the vault's
+// NAV history under the share denom.
+//
 // If NAV publication fails, the error is logged and the operation continues
 // without failing the overall vault reconciliation process.
 func (k Keeper) publishShareNav(ctx sdk.Context, vault *types.VaultAccount) error {
@@ -192,6 +221,8 @@ func (k Keeper) publishShareNav(ctx sdk.Context, vault *types.VaultAccount) erro
 			}
 			if err = k.MarkerKeeper.SetNetAssetValue(ctx, vaultMarker, zeroNAV, types.ModuleName); err != nil {
 				k.getLogger(ctx).Error("failed to zero share NAV for empty vault", "err", err)
+			} else if err = k.recordShareNAVHistory(ctx, vault, zeroNAV); err != nil {
+				k.getLogger(ctx).Error("failed to record zeroed share NAV history", "err", err)
 			}
 		}
 		return nil
@@ -0,0 +1,66 @@
+package keeper
+
+import (
+	"fmt"
+
+	"github.com/provlabs/vault/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// AddRoleGrant grants role to address on the vault and emits an EventRoleGranted. It
+// returns an error if the address already holds the role. The caller is responsible for
+// verifying that authority may manage the vault's roles.
+func (k *Keeper) AddRoleGrant(ctx sdk.Context, vault *types.VaultAccount, role types.VaultRole, address, authority string) error {
+	if !vault.GrantRole(role, address) {
+		return fmt.Errorf("%s already holds role %s", address, role)
+	}
+	if err := k.SetVaultAccount(ctx, vault); err != nil {
+		return fmt.Errorf("failed to set vault account: %w", err)
+	}
+	k.emitEvent(ctx, types.NewEventRoleGranted(vault.Address, authority, role, address))
+	return nil
+}
+
+// RemoveRoleGrant revokes role from address on the vault and emits an EventRoleRevoked. It
+// returns an error if the address does not hold the role. The caller is responsible for
+// verifying that authority may manage the vault's roles.
+func (k *Keeper) RemoveRoleGrant(ctx sdk.Context, vault *types.VaultAccount, role types.VaultRole, address, authority string) error {
+	if !vault.RevokeRole(role, address) {
+		return fmt.Errorf("%s does not hold role %s", address, role)
+	}
+	if err := k.SetVaultAccount(ctx, vault); err != nil {
+		return fmt.Errorf("failed to set vault account: %w", err)
+	}
+	k.emitEvent(ctx, types.NewEventRoleRevoked(vault.Address, authority, role, address))
+	return nil
+}
+
+// moveRoles revokes roles from the previous holder of an authority field and grants them to
+// the new one, emitting an event for each grant that changes. An empty from or to skips that
+// side, and an unchanged holder moves nothing. The admin keeps its management roles when it
+// hands a field off, so pointing the asset manager at the admin and back never strips the
+// admin of the roles it was created with. The caller persists the vault.
+func (k *Keeper) moveRoles(ctx sdk.Context, vault *types.VaultAccount, roles []types.VaultRole, from, to, authority string) {
+	if from == to {
+		return
+	}
+	for _, role := range roles {
+		if from != "" && !(from == vault.Admin && isManagementRole(role)) && vault.RevokeRole(role, from) {
+			k.emitEvent(ctx, types.NewEventRoleRevoked(vault.Address, authority, role, from))
+		}
+		if to != "" && vault.GrantRole(role, to) {
+			k.emitEvent(ctx, types.NewEventRoleGranted(vault.Address, authority, role, to))
+		}
+	}
+}
+
+// isManagementRole reports whether role is one of types.ManagementRoles.
+func isManagementRole(role types.VaultRole) bool {
+	for _, r := range types.ManagementRoles() {
+		if r == role {
+			return true
+		}
+	}
+	return false
+}
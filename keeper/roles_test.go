@@ -0,0 +1,167 @@
+package keeper_test
+
+import (
+	"github.com/provlabs/vault/keeper"
+	"github.com/provlabs/vault/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func (s *TestSuite) TestMsgServer_GrantAndRevokeRole() {
+	underlying, share := "under", "vshare"
+	pauser := sdk.AccAddress("pauserAddr__________").String()
+	pauserRole := types.VaultRole_VAULT_ROLE_PAUSER
+
+	tests := []struct {
+		name        string
+		setup       func(vault *types.VaultAccount)
+		signer      func() string
+		revoke      bool
+		expectedErr string
+	}{
+		{
+			name: "admin grants a role",
+		},
+		{
+			name: "admin revokes a role",
+			setup: func(vault *types.VaultAccount) {
+				s.Require().NoError(s.k.AddRoleGrant(s.ctx, vault, pauserRole, pauser, s.adminAddr.String()), "failed to seed grant")
+			},
+			revoke: true,
+		},
+		{
+			name:        "non-admin cannot grant",
+			signer:      func() string { return pauser },
+			expectedErr: "failed to validate admin",
+		},
+		{
+			name: "repeat grant is rejected",
+			setup: func(vault *types.VaultAccount) {
+				s.Require().NoError(s.k.AddRoleGrant(s.ctx, vault, pauserRole, pauser, s.adminAddr.String()), "failed to seed grant")
+			},
+			expectedErr: "already holds role VAULT_ROLE_PAUSER",
+		},
+		{
+			name:        "revoking a role that is not held is rejected",
+			revoke:      true,
+			expectedErr: "does not hold role VAULT_ROLE_PAUSER",
+		},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+			vault := s.setupBaseVault(underlying, share)
+			if tc.setup != nil {
+				tc.setup(vault)
+			}
+			signer := s.adminAddr.String()
+			if tc.signer != nil {
+				signer = tc.signer()
+			}
+			s.ctx = s.ctx.WithEventManager(sdk.NewEventManager())
+
+			var err error
+			if tc.revoke {
+				_, err = keeper.NewMsgServer(s.simApp.VaultKeeper).RevokeRole(s.ctx, &types.MsgRevokeRoleRequest{
+					Authority:    signer,
+					VaultAddress: vault.Address,
+					Role:         pauserRole,
+					Address:      pauser,
+				})
+			} else {
+				_, err = keeper.NewMsgServer(s.simApp.VaultKeeper).GrantRole(s.ctx, &types.MsgGrantRoleRequest{
+					Authority:    signer,
+					VaultAddress: vault.Address,
+					Role:         pauserRole,
+					Address:      pauser,
+				})
+			}
+			if tc.expectedErr != "" {
+				s.Require().ErrorContains(err, tc.expectedErr, "role change error")
+				return
+			}
+			s.Require().NoError(err, "role change should succeed")
+
+			got, err := s.k.GetVault(s.ctx, vault.GetAddress())
+			s.Require().NoError(err, "GetVault")
+			s.Assert().Equal(!tc.revoke, got.HasRole(pauser, pauserRole), "pauser role after change")
+			if tc.revoke {
+				s.requireTypedEventEmitted(types.NewEventRoleRevoked(vault.Address, signer, pauserRole, pauser))
+			} else {
+				s.requireTypedEventEmitted(types.NewEventRoleGranted(vault.Address, signer, pauserRole, pauser))
+			}
+		})
+	}
+}
+
+func (s *TestSuite) TestMsgServer_RoleScopedAuthority() {
+	underlying, share := "under", "vshare"
+	s.setupBaseVault(underlying, share)
+	vaultAddr := types.GetVaultAddress(share)
+	pauser := sdk.AccAddress("pauserAddr__________").String()
+
+	vault, err := s.k.GetVault(s.ctx, vaultAddr)
+	s.Require().NoError(err, "GetVault")
+	s.Require().NoError(s.k.AddRoleGrant(s.ctx, vault, types.VaultRole_VAULT_ROLE_PAUSER, pauser, s.adminAddr.String()), "failed to grant pauser")
+
+	msgServer := keeper.NewMsgServer(s.simApp.VaultKeeper)
+	_, err = msgServer.UpdateInterestRate(s.ctx, &types.MsgUpdateInterestRateRequest{
+		Authority:    pauser,
+		VaultAddress: vaultAddr.String(),
+		NewRate:      "0.05",
+	})
+	s.Require().ErrorContains(err, "does not hold role VAULT_ROLE_RATE_SETTER", "a pauser must not be able to set rates")
+
+	_, err = msgServer.PauseVault(s.ctx, &types.MsgPauseVaultRequest{
+		Authority:    pauser,
+		VaultAddress: vaultAddr.String(),
+		Reason:       "incident",
+	})
+	s.Require().NoError(err, "a pauser should be able to pause")
+
+	s.Require().NoError(s.k.RemoveRoleGrant(s.ctx, vault, types.VaultRole_VAULT_ROLE_PAUSER, s.adminAddr.String(), s.adminAddr.String()), "failed to revoke admin pauser")
+	_, err = msgServer.UnpauseVault(s.ctx, &types.MsgUnpauseVaultRequest{
+		Authority:    s.adminAddr.String(),
+		VaultAddress: vaultAddr.String(),
+	})
+	s.Require().ErrorContains(err, "does not hold role VAULT_ROLE_PAUSER", "an admin without the pauser role must not be able to unpause")
+}
+
+func (s *TestSuite) TestMsgServer_SetAssetManager_MovesRoles() {
+	underlying, share := "under", "vshare"
+	admin := s.adminAddr.String()
+	first := s.assetManagerAddr.String()
+	second := sdk.AccAddress("secondManagerAddr___").String()
+
+	vault, _ := s.setupAssetSettlementVault(underlying, share)
+	for _, role := range types.AssetManagerRoles() {
+		s.Assert().True(vault.HasRole(first, role), "asset manager should hold %s", role)
+	}
+
+	setManager := func(manager string) *types.VaultAccount {
+		_, err := keeper.NewMsgServer(s.simApp.VaultKeeper).SetAssetManager(s.ctx, &types.MsgSetAssetManagerRequest{
+			Admin:        admin,
+			VaultAddress: vault.Address,
+			AssetManager: manager,
+		})
+		s.Require().NoError(err, "SetAssetManager %q", manager)
+		got, err := s.k.GetVault(s.ctx, vault.GetAddress())
+		s.Require().NoError(err, "GetVault")
+		return got
+	}
+
+	got := setManager(second)
+	for _, role := range types.AssetManagerRoles() {
+		s.Assert().False(got.HasRole(first, role), "previous asset manager should lose %s", role)
+		s.Assert().True(got.HasRole(second, role), "new asset manager should hold %s", role)
+	}
+
+	setManager(admin)
+	got = setManager("")
+	for _, role := range types.ManagementRoles() {
+		s.Assert().True(got.HasRole(admin, role), "admin should keep %s after serving as asset manager", role)
+	}
+	s.Assert().False(got.HasRole(admin, types.VaultRole_VAULT_ROLE_SETTLEMENT_MANAGER), "admin should lose settlement once the asset manager is cleared")
+	s.Assert().False(got.HasRole(second, types.VaultRole_VAULT_ROLE_SETTLEMENT_MANAGER), "replaced asset manager should hold no settlement role")
+}
@@ -5,6 +5,8 @@ import (
 
 	"github.com/provlabs/vault/types"
 
+	"cosmossdk.io/math"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/provenance-io/provenance/x/exchange"
@@ -39,46 +41,246 @@ func (k *Keeper) returnToPrincipal(ctx sdk.Context, vault *types.VaultAccount, a
 	return k.BankKeeper.SendCoins(markertypes.WithBypass(ctx), vault.GetAddress(), vault.PrincipalMarkerAddress(), amt)
 }
 
-// removeDrainedSettlementNAV drops the vault's internal NAV entry for an asset denom
-// that an outbound settlement has just emptied out of the principal marker, so a stale
-// price cannot linger for an asset the vault no longer holds. Reacquiring the denom
-// then requires a fresh price from the NAV authority.
-//
-// Settling never writes the internal NAV table. checkSettlementNAVGuardrail has already
-// proven the trade executed at exactly the price the NAV authority recorded, so a
-// settlement has no new price to contribute, and leaving the entry untouched keeps the
-// price attributed to the authority that set it rather than to the asset manager that
-// traded against it.
-func (k *Keeper) removeDrainedSettlementNAV(ctx sdk.Context, vault *types.VaultAccount, assetDenom, direction string) error {
-	if direction != types.AssetDirectionOutbound {
+// assetSettlement is a pending exchange-module payment resolved against a vault and
+// checked against its internal NAV, ready to settle.
+type assetSettlement struct {
+	payment     *exchange.Payment
+	direction   string
+	assetCoins  sdk.Coins
+	paymentCoin sdk.Coin
+	navValue    math.LegacyDec
+}
+
+// resolveAssetSettlement looks up the pending payment identified by source and externalID
+// and runs every check that does not move funds: the payment must target the vault, carry
+// the vault's underlying asset on at most one leg, acquire only assets the vault's
+// acquisition policy permits, settle within the vault's settlement
+// tolerance of the internal NAV of each asset denom, and those NAVs must be fresh.
+func (k *Keeper) resolveAssetSettlement(ctx sdk.Context, vault *types.VaultAccount, source, externalID string) (assetSettlement, error) {
+	payment, err := k.ExchangeKeeper.GetPayment(ctx, sdk.MustAccAddressFromBech32(source), externalID)
+	if err != nil {
+		return assetSettlement{}, fmt.Errorf("failed to get payment: %w", err)
+	}
+	if payment == nil {
+		return assetSettlement{}, fmt.Errorf("payment not found for source %s and external id %q", source, externalID)
+	}
+	if payment.Target != vault.Address {
+		return assetSettlement{}, fmt.Errorf("payment target %s is not vault %s", payment.Target, vault.Address)
+	}
+
+	direction, err := assetSettlementDirection(vault, payment)
+	if err != nil {
+		return assetSettlement{}, err
+	}
+	assetCoins, paymentCoin, err := settlementLegCoins(payment, direction, vault.UnderlyingAsset)
+	if err != nil {
+		return assetSettlement{}, fmt.Errorf("failed to resolve settlement leg coins: %w", err)
+	}
+	if err := k.requireSettlementAcquisitionPermitted(ctx, vault, assetCoins, direction); err != nil {
+		return assetSettlement{}, err
+	}
+	navValue, err := k.checkSettlementNAVGuardrail(ctx, vault, assetCoins, paymentCoin)
+	if err != nil {
+		return assetSettlement{}, err
+	}
+	if err := k.requireFreshNAVs(ctx, vault, assetCoins.Denoms()...); err != nil {
+		return assetSettlement{}, err
+	}
+
+	return assetSettlement{
+		payment:     payment,
+		direction:   direction,
+		assetCoins:  assetCoins,
+		paymentCoin: paymentCoin,
+		navValue:    navValue,
+	}, nil
+}
+
+// requireSettlementAcquisitionPermitted checks each asset coin an inbound settlement
+// acquires against the vault's acquisition policy. An outbound settlement acquires no
+// asset, so the vault can always sell a position its policy no longer permits.
+func (k *Keeper) requireSettlementAcquisitionPermitted(ctx sdk.Context, vault *types.VaultAccount, assetCoins sdk.Coins, direction string) error {
+	if direction != types.AssetDirectionInbound {
 		return nil
 	}
-	if !k.BankKeeper.GetBalance(ctx, vault.PrincipalMarkerAddress(), assetDenom).IsZero() {
+	for _, coin := range assetCoins {
+		if err := k.requireAcquisitionPermitted(ctx, vault, coin.Denom); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// requirePrincipalBalance returns an error unless the vault's principal marker holds at
+// least amt, the funds one or more settlements will pay out.
+func (k *Keeper) requirePrincipalBalance(ctx sdk.Context, vault *types.VaultAccount, amt sdk.Coins) error {
+	principalAddr := vault.PrincipalMarkerAddress()
+	for _, coin := range amt {
+		if bal := k.BankKeeper.GetBalance(ctx, principalAddr, coin.Denom); bal.Amount.LT(coin.Amount) {
+			return fmt.Errorf("insufficient principal balance for %s: have %s, need %s", coin.Denom, bal.Amount, coin.Amount)
+		}
+	}
+	return nil
+}
+
+// settleAsset settles a resolved payment, staging its target amount through the vault
+// account, accepting it on the exchange module, and stowing its source amount back in the
+// principal marker. The accepted event reports the gain or loss the vault realized against
+// its internal NAV. When the vault marks its NAV on settlement, a single-coin asset leg that
+// traded away from NAV then reprices the denom's entry (see settlementMark). Finally it
+// records the settlement price of a single-coin asset leg and drops the NAV entry of any
+// asset denom an outbound settlement drained. An inbound settlement that leaves the vault
+// breaching one of its concentration limits with a higher weight than before, or short of
+// its liquidity buffer (see requireLiquidityBuffer), is rejected.
+func (k *Keeper) settleAsset(ctx sdk.Context, vault *types.VaultAccount, settlement assetSettlement) error {
+	payment := settlement.payment
+	var before *assetComposition
+	if settlement.direction == types.AssetDirectionInbound {
+		var err error
+		if before, err = k.concentrationSnapshot(ctx, vault); err != nil {
+			return err
+		}
+	}
+	if err := k.stageFromPrincipal(ctx, vault, payment.TargetAmount); err != nil {
+		return fmt.Errorf("failed to stage target amount from principal to vault: %w", err)
+	}
+	if err := k.ExchangeKeeper.AcceptPayment(ctx, payment); err != nil {
+		return fmt.Errorf("failed to accept payment: %w", err)
+	}
+	if err := k.returnToPrincipal(ctx, vault, payment.SourceAmount); err != nil {
+		return fmt.Errorf("failed to move source amount from vault to principal: %w", err)
+	}
+
+	mark, err := k.settlementMark(ctx, vault, settlement)
+	if err != nil {
+		return err
+	}
+	gainLoss := settlement.navValue.Sub(math.LegacyNewDecFromInt(settlement.paymentCoin.Amount))
+	if settlement.direction == types.AssetDirectionOutbound {
+		gainLoss = gainLoss.Neg()
+	}
+	navValue := sdk.NewDecCoinFromDec(settlement.paymentCoin.Denom, settlement.navValue)
+	k.emitEvent(ctx, types.NewEventAssetAccepted(vault.Address, payment.Source, payment.ExternalId, payment.SourceAmount, payment.TargetAmount, settlement.direction, navValue, gainLoss, mark != nil))
+	if mark != nil {
+		if err := k.SetVaultNAV(ctx, vault, *mark, ""); err != nil {
+			return fmt.Errorf("failed to mark internal NAV for denom %q to settlement price: %w", mark.Denom, err)
+		}
+	}
+
+	if len(settlement.assetCoins) == 1 {
+		if err := k.recordSettlementPrice(ctx, vault, settlement.assetCoins[0], settlement.paymentCoin); err != nil {
+			return err
+		}
+	}
+	if err := k.removeDrainedSettlementNAV(ctx, vault, settlement.assetCoins.Denoms(), settlement.direction); err != nil {
+		return err
+	}
+	if err := k.requireSettlementConcentration(ctx, vault, before); err != nil {
+		return err
+	}
+	if settlement.direction == types.AssetDirectionInbound {
+		return k.requireLiquidityBuffer(ctx, vault)
+	}
+	return nil
+}
+
+// settlementNAVSource is the source recorded on an internal NAV entry a settlement marked.
+const settlementNAVSource = "settlement"
+
+// settlementMark returns the internal NAV entry a settled payment marks its asset to, or nil
+// when it marks nothing. A settlement marks only when the vault has update_nav_on_settlement
+// set, its asset leg is a single coin, and it traded away from the NAV-implied value. The
+// mark prices the asset at the trade, with the payment coin as the price of the asset
+// amount. A mark is skipped when the asset is no longer held, since an outbound settlement
+// that drained the denom removes its entry, and when the move exceeds the vault's
+// max_nav_change_bips: the asset manager's trade may not reprice a held denom further
+// than the NAV authority could on its own.
+func (k *Keeper) settlementMark(ctx sdk.Context, vault *types.VaultAccount, settlement assetSettlement) (*types.VaultNAV, error) {
+	if !vault.UpdateNavOnSettlement || len(settlement.assetCoins) != 1 {
+		return nil, nil
+	}
+	assetCoin := settlement.assetCoins[0]
+	if settlement.navValue.Equal(math.LegacyNewDecFromInt(settlement.paymentCoin.Amount)) {
+		return nil, nil
+	}
+	if k.heldBalance(ctx, *vault, assetCoin.Denom).IsZero() {
+		return nil, nil
+	}
+
+	mark := types.VaultNAV{
+		Denom:  assetCoin.Denom,
+		Price:  settlement.paymentCoin,
+		Volume: assetCoin.Amount,
+		Source: settlementNAVSource,
+	}
+	needsApproval, _, err := k.requiresNAVApproval(ctx, vault, mark)
+	if err != nil {
+		return nil, fmt.Errorf("failed to check NAV change limit for settlement mark: %w", err)
+	}
+	if needsApproval {
+		return nil, nil
+	}
+	return &mark, nil
+}
+
+// SetSettlementTolerance updates the settlement tolerance and NAV-on-settlement setting for a vault.
+func (k *Keeper) SetSettlementTolerance(ctx sdk.Context, vault *types.VaultAccount, toleranceBips uint32, updateNAVOnSettlement bool, authority string) error {
+	if vault.SettlementToleranceBips == toleranceBips && vault.UpdateNavOnSettlement == updateNAVOnSettlement {
+		return nil
+	}
+	vault.SettlementToleranceBips = toleranceBips
+	vault.UpdateNavOnSettlement = updateNAVOnSettlement
+	if err := k.SetVaultAccount(ctx, vault); err != nil {
+		return fmt.Errorf("failed to set vault account: %w", err)
+	}
+	k.emitEvent(ctx, types.NewEventSettlementToleranceUpdated(vault.Address, authority, toleranceBips, updateNAVOnSettlement))
+	return nil
+}
+
+// removeDrainedSettlementNAV drops the vault's internal NAV entry for each asset denom
+// that an outbound settlement has just emptied out of the vault's holdings (its principal
+// marker and payment escrow account), so a stale price cannot linger for an asset the
+// vault no longer holds. Reacquiring the denom then requires a fresh price from the NAV
+// authority.
+//
+// Unless the vault marks its NAV on settlement (see settlementMark), settling never writes
+// the internal NAV table. checkSettlementNAVGuardrail has already proven the trade executed
+// within the vault's tolerance of the price the NAV authority recorded, and leaving the
+// entry untouched keeps the price attributed to the authority that set it rather than to
+// the asset manager that traded against it.
+func (k *Keeper) removeDrainedSettlementNAV(ctx sdk.Context, vault *types.VaultAccount, assetDenoms []string, direction string) error {
+	if direction != types.AssetDirectionOutbound {
 		return nil
 	}
-	if err := k.RemoveVaultNAV(ctx, vault, assetDenom, ""); err != nil {
-		return fmt.Errorf("failed to remove internal NAV for drained denom %q: %w", assetDenom, err)
+	for _, assetDenom := range assetDenoms {
+		if !k.heldBalance(ctx, *vault, assetDenom).IsZero() {
+			continue
+		}
+		if err := k.RemoveVaultNAV(ctx, vault, assetDenom, ""); err != nil {
+			return fmt.Errorf("failed to remove internal NAV for drained denom %q: %w", assetDenom, err)
+		}
 	}
 	return nil
 }
 
-// settlementLegCoins resolves a payment's legs into the single asset coin and the
-// single underlying-asset coin for the given settlement direction. The NAV guardrail
-// prices exactly one asset coin against one payment coin, so the asset leg with zero
-// or multiple coins is rejected. A zero-priced settlement carries no coin on
-// the payment leg (the zero coin is stripped); an empty payment leg yields a zero coin
-// of underlyingDenom, but a payment leg carrying more than one coin is rejected.
-func settlementLegCoins(payment *exchange.Payment, direction, underlyingDenom string) (assetCoin, paymentCoin sdk.Coin, err error) {
+// settlementLegCoins resolves a payment's legs into the asset coins and the single
+// underlying-asset coin for the given settlement direction. The asset leg may carry
+// several coins, such as a bundle of positions bought from one counterparty; the NAV
+// guardrail values each against its own NAV entry, so an empty asset leg is rejected. A
+// zero-priced settlement carries no coin on the payment leg (the zero coin is stripped);
+// an empty payment leg yields a zero coin of underlyingDenom, but a payment leg carrying
+// more than one coin is rejected.
+func settlementLegCoins(payment *exchange.Payment, direction, underlyingDenom string) (assetCoins sdk.Coins, paymentCoin sdk.Coin, err error) {
 	assetLeg, paymentLeg := payment.TargetAmount, payment.SourceAmount
 	if direction == types.AssetDirectionInbound {
 		assetLeg, paymentLeg = payment.SourceAmount, payment.TargetAmount
 	}
-	if len(assetLeg) != 1 || len(paymentLeg) > 1 {
-		return sdk.Coin{}, sdk.Coin{}, fmt.Errorf("payment legs must carry one asset coin and at most one payment coin to settle against the vault NAV: source_amount=%q target_amount=%q", payment.SourceAmount, payment.TargetAmount)
+	if len(assetLeg) == 0 || len(paymentLeg) > 1 {
+		return nil, sdk.Coin{}, fmt.Errorf("payment legs must carry at least one asset coin and at most one payment coin to settle against the vault NAV: source_amount=%q target_amount=%q", payment.SourceAmount, payment.TargetAmount)
 	}
 	paymentCoin = sdk.NewInt64Coin(underlyingDenom, 0)
 	if len(paymentLeg) == 1 {
 		paymentCoin = paymentLeg[0]
 	}
-	return assetLeg[0], paymentCoin, nil
+	return assetLeg, paymentCoin, nil
 }
@@ -1,12 +1,15 @@
 package keeper_test
 
 import (
+	"slices"
+
 	"cosmossdk.io/collections"
 	sdkmath "cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/provenance-io/provenance/x/exchange"
 	markertypes "github.com/provenance-io/provenance/x/marker/types"
 
+	"github.com/provlabs/vault/keeper"
 	"github.com/provlabs/vault/types"
 )
 
@@ -67,7 +70,7 @@ func (s *TestSuite) TestKeeper_RemoveDrainedSettlementNAV() {
 			}
 
 			s.ctx = s.ctx.WithEventManager(sdk.NewEventManager())
-			err := s.k.TestAccessor_removeDrainedSettlementNAV(s.T(), s.ctx, vault, asset, tc.direction)
+			err := s.k.TestAccessor_removeDrainedSettlementNAV(s.T(), s.ctx, vault, []string{asset}, tc.direction)
 
 			var removedEvents []sdk.Event
 			for _, ev := range s.ctx.EventManager().Events() {
@@ -105,7 +108,7 @@ func (s *TestSuite) TestSettlementLegCoins() {
 		sourceAmount        sdk.Coins
 		targetAmount        sdk.Coins
 		direction           string
-		expectedAssetCoin   sdk.Coin
+		expectedAssetCoins  sdk.Coins
 		expectedPaymentCoin sdk.Coin
 		expectedErrContains string
 	}{
@@ -114,7 +117,7 @@ func (s *TestSuite) TestSettlementLegCoins() {
 			sourceAmount:        sdk.NewCoins(sdk.NewInt64Coin("rwa", 10)),
 			targetAmount:        sdk.NewCoins(sdk.NewInt64Coin("pay", 5)),
 			direction:           types.AssetDirectionInbound,
-			expectedAssetCoin:   sdk.NewInt64Coin("rwa", 10),
+			expectedAssetCoins:  sdk.NewCoins(sdk.NewInt64Coin("rwa", 10)),
 			expectedPaymentCoin: sdk.NewInt64Coin("pay", 5),
 		},
 		{
@@ -122,7 +125,7 @@ func (s *TestSuite) TestSettlementLegCoins() {
 			sourceAmount:        sdk.NewCoins(sdk.NewInt64Coin("pay", 5)),
 			targetAmount:        sdk.NewCoins(sdk.NewInt64Coin("rwa", 10)),
 			direction:           types.AssetDirectionOutbound,
-			expectedAssetCoin:   sdk.NewInt64Coin("rwa", 10),
+			expectedAssetCoins:  sdk.NewCoins(sdk.NewInt64Coin("rwa", 10)),
 			expectedPaymentCoin: sdk.NewInt64Coin("pay", 5),
 		},
 		{
@@ -130,21 +133,30 @@ func (s *TestSuite) TestSettlementLegCoins() {
 			sourceAmount:        sdk.NewCoins(),
 			targetAmount:        sdk.NewCoins(sdk.NewInt64Coin("pay", 5)),
 			direction:           types.AssetDirectionInbound,
-			expectedErrContains: "one asset coin",
+			expectedErrContains: "at least one asset coin",
 		},
 		{
-			name:                "asset leg with multiple coins is rejected",
+			name:                "inbound asset leg with multiple coins yields every coin as an asset coin",
 			sourceAmount:        sdk.NewCoins(sdk.NewInt64Coin("rwa", 10), sdk.NewInt64Coin("othercoin", 5)),
 			targetAmount:        sdk.NewCoins(sdk.NewInt64Coin("pay", 5)),
 			direction:           types.AssetDirectionInbound,
-			expectedErrContains: "one asset coin",
+			expectedAssetCoins:  sdk.NewCoins(sdk.NewInt64Coin("rwa", 10), sdk.NewInt64Coin("othercoin", 5)),
+			expectedPaymentCoin: sdk.NewInt64Coin("pay", 5),
+		},
+		{
+			name:                "outbound asset leg with multiple coins yields every coin as an asset coin",
+			sourceAmount:        sdk.NewCoins(sdk.NewInt64Coin("pay", 5)),
+			targetAmount:        sdk.NewCoins(sdk.NewInt64Coin("rwa", 10), sdk.NewInt64Coin("othercoin", 5)),
+			direction:           types.AssetDirectionOutbound,
+			expectedAssetCoins:  sdk.NewCoins(sdk.NewInt64Coin("rwa", 10), sdk.NewInt64Coin("othercoin", 5)),
+			expectedPaymentCoin: sdk.NewInt64Coin("pay", 5),
 		},
 		{
 			name:                "empty payment leg yields a zero payment coin for a zero-priced settlement",
 			sourceAmount:        sdk.NewCoins(sdk.NewInt64Coin("rwa", 10)),
 			targetAmount:        sdk.NewCoins(),
 			direction:           types.AssetDirectionInbound,
-			expectedAssetCoin:   sdk.NewInt64Coin("rwa", 10),
+			expectedAssetCoins:  sdk.NewCoins(sdk.NewInt64Coin("rwa", 10)),
 			expectedPaymentCoin: sdk.NewInt64Coin("pay", 0),
 		},
 		{
@@ -160,7 +172,7 @@ func (s *TestSuite) TestSettlementLegCoins() {
 		s.Run(tc.name, func() {
 			payment := &exchange.Payment{SourceAmount: tc.sourceAmount, TargetAmount: tc.targetAmount}
 
-			assetCoin, paymentCoin, err := s.k.TestAccessor_settlementLegCoins(s.T(), payment, tc.direction, "pay")
+			assetCoins, paymentCoin, err := s.k.TestAccessor_settlementLegCoins(s.T(), payment, tc.direction, "pay")
 
 			if tc.expectedErrContains != "" {
 				s.Require().ErrorContains(err, tc.expectedErrContains, "settlementLegCoins should reject source=%s target=%s", tc.sourceAmount, tc.targetAmount)
@@ -168,7 +180,7 @@ func (s *TestSuite) TestSettlementLegCoins() {
 			}
 
 			s.Require().NoError(err, "settlementLegCoins should resolve source=%s target=%s direction=%s", tc.sourceAmount, tc.targetAmount, tc.direction)
-			s.Assert().Equal(tc.expectedAssetCoin, assetCoin, "asset coin mismatch for direction %s", tc.direction)
+			s.Assert().Equal(tc.expectedAssetCoins, assetCoins, "asset coins mismatch for direction %s", tc.direction)
 			s.Assert().Equal(tc.expectedPaymentCoin, paymentCoin, "payment coin mismatch for direction %s", tc.direction)
 		})
 	}
@@ -290,3 +302,408 @@ func (s *TestSuite) TestKeeper_ReturnToPrincipal_BypassIsLoadBearing() {
 	s.assertBalance(vaultAddr, restricted, sdkmath.NewInt(0))
 	s.assertBalance(principalAddr, restricted, sdkmath.NewInt(10))
 }
+
+func (s *TestSuite) TestKeeper_RemoveDrainedSettlementNAV_PerDenom() {
+	underlying, share := "under", "vshare"
+	drained, remaining := "rwadrained", "rwaremaining"
+
+	vault := s.setupBaseVault(underlying, share)
+	for _, denom := range []string{drained, remaining} {
+		s.requireSimpleMarker(denom)
+		s.setVaultNAV(vault, denom, sdk.NewInt64Coin(underlying, 5), 10)
+	}
+	s.Require().NoError(FundAccount(s.ctx, s.simApp.BankKeeper, vault.PrincipalMarkerAddress(), sdk.NewCoins(sdk.NewInt64Coin(remaining, 3))), "failed to fund principal with %s", remaining)
+
+	s.Require().NoError(s.k.TestAccessor_removeDrainedSettlementNAV(s.T(), s.ctx, vault, []string{drained, remaining}, types.AssetDirectionOutbound),
+		"removeDrainedSettlementNAV should succeed for a bundle")
+
+	_, err := s.k.GetVaultNAV(s.ctx, vault.GetAddress(), drained)
+	s.Assert().ErrorIs(err, collections.ErrNotFound, "NAV entry for the drained denom %s should be removed", drained)
+	_, err = s.k.GetVaultNAV(s.ctx, vault.GetAddress(), remaining)
+	s.Assert().NoError(err, "NAV entry for the still-held denom %s should be kept", remaining)
+}
+
+func (s *TestSuite) TestMsgServer_AcceptAsset_Bundle() {
+	underlying, share := "under", "vshare"
+	rwa, rwb := "rwacoin", "rwbcoin"
+	externalID := "bundle"
+
+	tests := []struct {
+		name                string
+		fundSource          sdk.Coins
+		fundPrincipal       sdk.Coins
+		sourceAmount        sdk.Coins
+		targetAmount        sdk.Coins
+		expectedPrincipal   sdk.Coins
+		expectNavRemoved    []string
+		expectedErrContains string
+	}{
+		{
+			name:              "inbound bundle at the summed NAV value settles every coin",
+			fundSource:        sdk.NewCoins(sdk.NewInt64Coin(rwa, 10), sdk.NewInt64Coin(rwb, 3)),
+			fundPrincipal:     sdk.NewCoins(sdk.NewInt64Coin(underlying, 11)),
+			sourceAmount:      sdk.NewCoins(sdk.NewInt64Coin(rwa, 10), sdk.NewInt64Coin(rwb, 3)),
+			targetAmount:      sdk.NewCoins(sdk.NewInt64Coin(underlying, 11)),
+			expectedPrincipal: sdk.NewCoins(sdk.NewInt64Coin(rwa, 10), sdk.NewInt64Coin(rwb, 3)),
+		},
+		{
+			name:              "outbound bundle removes the NAV entry of each drained denom only",
+			fundSource:        sdk.NewCoins(sdk.NewInt64Coin(underlying, 11)),
+			fundPrincipal:     sdk.NewCoins(sdk.NewInt64Coin(rwa, 10), sdk.NewInt64Coin(rwb, 4)),
+			sourceAmount:      sdk.NewCoins(sdk.NewInt64Coin(underlying, 11)),
+			targetAmount:      sdk.NewCoins(sdk.NewInt64Coin(rwa, 10), sdk.NewInt64Coin(rwb, 3)),
+			expectedPrincipal: sdk.NewCoins(sdk.NewInt64Coin(rwb, 1), sdk.NewInt64Coin(underlying, 11)),
+			expectNavRemoved:  []string{rwa},
+		},
+		{
+			name:                "bundle off the summed NAV value is rejected",
+			fundSource:          sdk.NewCoins(sdk.NewInt64Coin(rwa, 10), sdk.NewInt64Coin(rwb, 3)),
+			fundPrincipal:       sdk.NewCoins(sdk.NewInt64Coin(underlying, 12)),
+			sourceAmount:        sdk.NewCoins(sdk.NewInt64Coin(rwa, 10), sdk.NewInt64Coin(rwb, 3)),
+			targetAmount:        sdk.NewCoins(sdk.NewInt64Coin(underlying, 12)),
+			expectedErrContains: "does not match internal NAV",
+		},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			origCtx := s.ctx
+			defer func() { s.ctx = origCtx }()
+			s.ctx, _ = s.ctx.CacheContext()
+
+			s.requireSimpleMarker(rwb)
+			vault, principalAddr, source := s.setupAcceptAssetScenario(acceptAssetScenario{
+				underlying:    underlying,
+				share:         share,
+				assetMarker:   rwa,
+				seedNav:       &types.VaultNAV{Denom: rwa, Price: sdk.NewInt64Coin(underlying, 5), Volume: sdkmath.NewInt(10)},
+				fundSource:    tc.fundSource,
+				fundPrincipal: tc.fundPrincipal,
+				sourceAmount:  tc.sourceAmount,
+				targetAmount:  tc.targetAmount,
+				externalID:    externalID,
+			})
+			s.setVaultNAV(vault, rwb, sdk.NewInt64Coin(underlying, 2), 1)
+
+			_, err := keeper.NewMsgServer(s.simApp.VaultKeeper).AcceptAsset(s.ctx, &types.MsgAcceptAssetRequest{
+				Authority:    s.assetManagerAddr.String(),
+				VaultAddress: vault.GetAddress().String(),
+				Source:       source.String(),
+				ExternalId:   externalID,
+			})
+			if tc.expectedErrContains != "" {
+				s.Require().ErrorContains(err, tc.expectedErrContains, "AcceptAsset should reject case %q", tc.name)
+				return
+			}
+			s.Require().NoError(err, "AcceptAsset should settle case %q", tc.name)
+
+			s.Assert().Equal(tc.expectedPrincipal.String(), s.simApp.BankKeeper.GetAllBalances(s.ctx, principalAddr).String(), "principal balances after settling case %q", tc.name)
+			for _, denom := range []string{rwa, rwb} {
+				_, err := s.k.SettlementPrices.Get(s.ctx, collections.Join3(vault.GetAddress(), denom, underlying))
+				s.Assert().ErrorIs(err, collections.ErrNotFound, "a bundle should not record a settlement price for %s", denom)
+				_, err = s.k.GetVaultNAV(s.ctx, vault.GetAddress(), denom)
+				if slices.Contains(tc.expectNavRemoved, denom) {
+					s.Assert().ErrorIs(err, collections.ErrNotFound, "NAV entry for drained %s should be removed", denom)
+				} else {
+					s.Assert().NoError(err, "NAV entry for %s should be kept", denom)
+				}
+			}
+		})
+	}
+}
+
+func (s *TestSuite) TestMsgServer_BatchAcceptAssets() {
+	underlying, share, asset := "under", "vshare", "rwacoin"
+	missingPayment := types.AssetPaymentRef{ExternalId: "missing"}
+
+	tests := []struct {
+		name                string
+		fundPrincipal       sdk.Coins
+		externalIDs         []string
+		includeMissing      bool
+		bestEffort          bool
+		expectedAccepted    []bool
+		expectedResultErrs  []string
+		expectedPrincipal   sdk.Coins
+		expectedErrContains string
+	}{
+		{
+			name:              "all-or-nothing settles every payment",
+			fundPrincipal:     sdk.NewCoins(sdk.NewInt64Coin(underlying, 7)),
+			externalIDs:       []string{"loan-1", "loan-2"},
+			expectedAccepted:  []bool{true, true},
+			expectedPrincipal: sdk.NewCoins(sdk.NewInt64Coin(asset, 14)),
+		},
+		{
+			name:                "all-or-nothing rejects the batch on a payment off its NAV",
+			fundPrincipal:       sdk.NewCoins(sdk.NewInt64Coin(underlying, 10)),
+			externalIDs:         []string{"loan-1", "loan-2", "off-nav"},
+			expectedErrContains: "failed to resolve payment at index 2",
+		},
+		{
+			name:               "best effort settles the payments that pass and reports the others",
+			fundPrincipal:      sdk.NewCoins(sdk.NewInt64Coin(underlying, 10)),
+			externalIDs:        []string{"loan-1", "off-nav", "loan-2"},
+			includeMissing:     true,
+			bestEffort:         true,
+			expectedAccepted:   []bool{true, false, true, false},
+			expectedResultErrs: []string{"", "does not match internal NAV", "", "payment not found"},
+			expectedPrincipal:  sdk.NewCoins(sdk.NewInt64Coin(asset, 14), sdk.NewInt64Coin(underlying, 3)),
+		},
+		{
+			name:                "aggregate principal shortfall rejects the batch up front",
+			fundPrincipal:       sdk.NewCoins(sdk.NewInt64Coin(underlying, 6)),
+			externalIDs:         []string{"loan-1", "loan-2"},
+			expectedErrContains: "insufficient principal balance for under: have 6, need 7",
+		},
+		{
+			name:                "aggregate principal shortfall rejects a best effort batch too",
+			fundPrincipal:       sdk.NewCoins(sdk.NewInt64Coin(underlying, 6)),
+			externalIDs:         []string{"loan-1", "loan-2"},
+			bestEffort:          true,
+			expectedErrContains: "insufficient principal balance for under: have 6, need 7",
+		},
+	}
+
+	payments := map[string][2]sdk.Coins{
+		"loan-1":  {sdk.NewCoins(sdk.NewInt64Coin(asset, 10)), sdk.NewCoins(sdk.NewInt64Coin(underlying, 5))},
+		"loan-2":  {sdk.NewCoins(sdk.NewInt64Coin(asset, 4)), sdk.NewCoins(sdk.NewInt64Coin(underlying, 2))},
+		"off-nav": {sdk.NewCoins(sdk.NewInt64Coin(asset, 4)), sdk.NewCoins(sdk.NewInt64Coin(underlying, 3))},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			origCtx := s.ctx
+			defer func() { s.ctx = origCtx }()
+			s.ctx, _ = s.ctx.CacheContext()
+
+			vault, principalAddr, source := s.setupAcceptAssetScenario(acceptAssetScenario{
+				underlying:    underlying,
+				share:         share,
+				assetMarker:   asset,
+				seedNav:       &types.VaultNAV{Denom: asset, Price: sdk.NewInt64Coin(underlying, 5), Volume: sdkmath.NewInt(10)},
+				fundSource:    sdk.NewCoins(sdk.NewInt64Coin(asset, 18)),
+				fundPrincipal: tc.fundPrincipal,
+				omitPayment:   true,
+			})
+			refs := make([]types.AssetPaymentRef, 0, len(tc.externalIDs)+1)
+			for _, id := range tc.externalIDs {
+				s.createPayment(source, vault.GetAddress(), payments[id][0], payments[id][1], id)
+				refs = append(refs, types.AssetPaymentRef{Source: source.String(), ExternalId: id})
+			}
+			if tc.includeMissing {
+				missing := missingPayment
+				missing.Source = source.String()
+				refs = append(refs, missing)
+			}
+			principalBefore := s.simApp.BankKeeper.GetAllBalances(s.ctx, principalAddr)
+
+			resp, err := keeper.NewMsgServer(s.simApp.VaultKeeper).BatchAcceptAssets(s.ctx, &types.MsgBatchAcceptAssetsRequest{
+				Authority:    s.assetManagerAddr.String(),
+				VaultAddress: vault.GetAddress().String(),
+				Payments:     refs,
+				BestEffort:   tc.bestEffort,
+			})
+			if tc.expectedErrContains != "" {
+				s.Require().ErrorContains(err, tc.expectedErrContains, "BatchAcceptAssets should reject case %q", tc.name)
+				s.Assert().Equal(principalBefore.String(), s.simApp.BankKeeper.GetAllBalances(s.ctx, principalAddr).String(), "a rejected batch should not move principal funds")
+				return
+			}
+			s.Require().NoError(err, "BatchAcceptAssets should succeed for case %q", tc.name)
+
+			s.Require().Len(resp.Results, len(refs), "one result per requested payment")
+			var accepted, failed uint32
+			for i, result := range resp.Results {
+				s.Assert().Equal(refs[i].ExternalId, result.ExternalId, "result %d should be in request order", i)
+				s.Assert().Equal(tc.expectedAccepted[i], result.Accepted, "accepted flag for payment %q", result.ExternalId)
+				if result.Accepted {
+					accepted++
+					s.Assert().Empty(result.Error, "an accepted payment should report no error")
+				} else {
+					failed++
+					s.Assert().Contains(result.Error, tc.expectedResultErrs[i], "error for payment %q", result.ExternalId)
+				}
+				if i >= len(tc.externalIDs) {
+					continue
+				}
+				payment, err := s.simApp.ExchangeKeeper.GetPayment(s.ctx, source, result.ExternalId)
+				s.Require().NoError(err, "GetPayment(%q)", result.ExternalId)
+				s.Assert().Equal(result.Accepted, payment == nil, "payment %q should be pending only when not accepted", result.ExternalId)
+			}
+			s.Assert().Equal(tc.expectedPrincipal.String(), s.simApp.BankKeeper.GetAllBalances(s.ctx, principalAddr).String(), "principal balances after the batch")
+			s.requireTypedEventEmitted(types.NewEventAssetsBatchAccepted(vault.GetAddress().String(), s.assetManagerAddr.String(), accepted, failed))
+		})
+	}
+}
+
+func (s *TestSuite) TestMsgServer_AcceptAsset_SettlementTolerance() {
+	underlying, share, asset := "under", "vshare", "rwacoin"
+	externalID := "tolerance"
+	approver := sdk.AccAddress("nav_approver________").String()
+	inbound := func(pay int64) (sdk.Coins, sdk.Coins) {
+		return sdk.NewCoins(sdk.NewInt64Coin(asset, 10)), sdk.NewCoins(sdk.NewInt64Coin(underlying, pay))
+	}
+	outbound := func(pay int64) (sdk.Coins, sdk.Coins) {
+		return sdk.NewCoins(sdk.NewInt64Coin(underlying, pay)), sdk.NewCoins(sdk.NewInt64Coin(asset, 10))
+	}
+
+	tests := []struct {
+		name                string
+		legs                func(int64) (sdk.Coins, sdk.Coins)
+		pay                 int64
+		direction           string
+		updateNAV           bool
+		maxNavChangeBips    uint32
+		expectedGainLoss    string
+		expectedNAVPrice    int64
+		expectedErrContains string
+	}{
+		{
+			name:             "inbound above NAV within the tolerance reports a loss and keeps the NAV",
+			legs:             inbound,
+			pay:              101,
+			direction:        types.AssetDirectionInbound,
+			expectedGainLoss: "-1.000000000000000000",
+			expectedNAVPrice: 100,
+		},
+		{
+			name:             "inbound below NAV marks the NAV to the trade when enabled",
+			legs:             inbound,
+			pay:              99,
+			direction:        types.AssetDirectionInbound,
+			updateNAV:        true,
+			expectedGainLoss: "1.000000000000000000",
+			expectedNAVPrice: 99,
+		},
+		{
+			name:             "outbound above NAV reports a gain and marks the NAV when enabled",
+			legs:             outbound,
+			pay:              102,
+			direction:        types.AssetDirectionOutbound,
+			updateNAV:        true,
+			expectedGainLoss: "2.000000000000000000",
+			expectedNAVPrice: 102,
+		},
+		{
+			name:             "mark beyond the NAV change limit is skipped",
+			legs:             inbound,
+			pay:              99,
+			direction:        types.AssetDirectionInbound,
+			updateNAV:        true,
+			maxNavChangeBips: 50,
+			expectedGainLoss: "1.000000000000000000",
+			expectedNAVPrice: 100,
+		},
+		{
+			name:                "settlement outside the tolerance is rejected",
+			legs:                inbound,
+			pay:                 103,
+			direction:           types.AssetDirectionInbound,
+			expectedErrContains: "is more than 200 bips from internal NAV",
+		},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			origCtx := s.ctx
+			defer func() { s.ctx = origCtx }()
+			s.ctx, _ = s.ctx.CacheContext()
+
+			sourceAmount, targetAmount := tc.legs(tc.pay)
+			fundPrincipal := targetAmount
+			if tc.direction == types.AssetDirectionOutbound {
+				fundPrincipal = sdk.NewCoins(sdk.NewInt64Coin(asset, 20))
+			}
+			vault, _, source := s.setupAcceptAssetScenario(acceptAssetScenario{
+				underlying:    underlying,
+				share:         share,
+				assetMarker:   asset,
+				seedNav:       &types.VaultNAV{Denom: asset, Price: sdk.NewInt64Coin(underlying, 100), Volume: sdkmath.NewInt(10)},
+				fundSource:    sourceAmount,
+				fundPrincipal: fundPrincipal,
+				sourceAmount:  sourceAmount,
+				targetAmount:  targetAmount,
+				externalID:    externalID,
+			})
+			vault.SettlementToleranceBips = 200
+			vault.UpdateNavOnSettlement = tc.updateNAV
+			if tc.maxNavChangeBips > 0 {
+				vault.MaxNavChangeBips = tc.maxNavChangeBips
+				vault.NavApprover = approver
+			}
+			s.Require().NoError(s.k.SetVaultAccount(s.ctx, vault), "failed to store vault settlement tolerance")
+
+			s.ctx = s.ctx.WithEventManager(sdk.NewEventManager())
+			_, err := keeper.NewMsgServer(s.simApp.VaultKeeper).AcceptAsset(s.ctx, &types.MsgAcceptAssetRequest{
+				Authority:    s.assetManagerAddr.String(),
+				VaultAddress: vault.GetAddress().String(),
+				Source:       source.String(),
+				ExternalId:   externalID,
+			})
+			if tc.expectedErrContains != "" {
+				s.Require().ErrorContains(err, tc.expectedErrContains, "AcceptAsset should reject case %q", tc.name)
+				return
+			}
+			s.Require().NoError(err, "AcceptAsset should settle case %q", tc.name)
+
+			gainLoss, err := sdkmath.LegacyNewDecFromStr(tc.expectedGainLoss)
+			s.Require().NoError(err, "invalid expected gain or loss")
+			navUpdated := tc.expectedNAVPrice != 100
+			s.requireTypedEventEmitted(types.NewEventAssetAccepted(vault.Address, source.String(), externalID, sourceAmount, targetAmount, tc.direction, sdk.NewDecCoin(underlying, sdkmath.NewInt(100)), gainLoss, navUpdated))
+
+			nav, err := s.k.GetVaultNAV(s.ctx, vault.GetAddress(), asset)
+			s.Require().NoError(err, "NAV entry for %s should be kept", asset)
+			s.Assert().Equal(sdk.NewInt64Coin(underlying, tc.expectedNAVPrice).String(), nav.Price.String(), "NAV price after settling case %q", tc.name)
+			if navUpdated {
+				s.Assert().Equal("settlement", nav.Source, "marked NAV source")
+			}
+		})
+	}
+}
+
+func (s *TestSuite) TestMsgServer_UpdateSettlementTolerance() {
+	underlying, share := "under", "vshare"
+
+	tests := []struct {
+		name          string
+		signer        func() string
+		toleranceBips uint32
+		updateNAV     bool
+		expectErr     string
+		expectEvent   bool
+	}{
+		{name: "admin sets the tolerance", signer: func() string { return s.adminAddr.String() }, toleranceBips: 150, updateNAV: true, expectEvent: true},
+		{name: "unchanged tolerance is a no-op", signer: func() string { return s.adminAddr.String() }},
+		{name: "asset manager cannot set the tolerance", signer: func() string { return s.assetManagerAddr.String() }, toleranceBips: 150, expectErr: "failed to validate admin"},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+			vault, _ := s.setupAssetSettlementVault(underlying, share)
+			s.ctx = s.ctx.WithEventManager(sdk.NewEventManager())
+
+			_, err := keeper.NewMsgServer(s.simApp.VaultKeeper).UpdateSettlementTolerance(s.ctx, &types.MsgUpdateSettlementToleranceRequest{
+				Authority:               tc.signer(),
+				VaultAddress:            vault.Address,
+				SettlementToleranceBips: tc.toleranceBips,
+				UpdateNavOnSettlement:   tc.updateNAV,
+			})
+			if tc.expectErr != "" {
+				s.Require().ErrorContains(err, tc.expectErr, "UpdateSettlementTolerance error")
+				return
+			}
+			s.Require().NoError(err, "UpdateSettlementTolerance should succeed")
+
+			updated, err := s.k.GetVault(s.ctx, vault.GetAddress())
+			s.Require().NoError(err, "should reload vault")
+			s.Assert().Equal(tc.toleranceBips, updated.SettlementToleranceBips, "stored settlement tolerance bips")
+			s.Assert().Equal(tc.updateNAV, updated.UpdateNavOnSettlement, "stored update NAV on settlement")
+			if tc.expectEvent {
+				s.requireTypedEventEmitted(types.NewEventSettlementToleranceUpdated(vault.Address, tc.signer(), tc.toleranceBips, tc.updateNAV))
+			} else {
+				s.Assert().Empty(s.ctx.EventManager().Events(), "no-op update should not emit events")
+			}
+		})
+	}
+}
@@ -0,0 +1,244 @@
+package keeper
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/provlabs/vault/interest"
+	"github.com/provlabs/vault/types"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// ErrNoSharePriceHistory is returned when a vault has no share price observations covering
+// the requested time.
+var ErrNoSharePriceHistory = errors.New("no share price history")
+
+// sharePriceExponent is the share exponent accumulator prices are quoted at: 10^6 base shares,
+// the utils.ShareScalar shares a first deposit mints per underlying unit, so a vault starts at
+// a price of 1.
+const sharePriceExponent = 6
+
+// performanceWindowDays are the trailing windows, in days, VaultPerformance reports on.
+var performanceWindowDays = []uint32{7, 30, 90, 365}
+
+// sharePriceRetention returns the module's share price observation retention in seconds,
+// falling back to the default when params have not been stored.
+func (k Keeper) sharePriceRetention(ctx sdk.Context) uint64 {
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		params = types.DefaultParams()
+	}
+	return params.SharePriceRetentionSeconds
+}
+
+// recordSharePrice advances the vault's cumulative share price accumulator to the current
+// block time and records the vault's current net share price. Recording is disabled when
+// Params.share_price_retention_seconds is zero.
+//
+// The accumulator adds the last observed price multiplied by the seconds since it was
+// observed. A price equal to the last observation records nothing, since the accumulator
+// extends it linearly anyway, and a later price in the same block replaces that block's
+// observation. Observations older than the retention window are pruned, except the newest of
+// them, which stays as the price in effect at the window's start.
+func (k Keeper) recordSharePrice(ctx sdk.Context, vault *types.VaultAccount) error {
+	retention := k.sharePriceRetention(ctx)
+	if retention == 0 {
+		return nil
+	}
+
+	vaultAddr := vault.GetAddress()
+	net, err := k.GetNetTVV(ctx, *vault)
+	if err != nil {
+		return fmt.Errorf("failed to get net TVV: %w", err)
+	}
+	price, err := NAVPerShareUnit(net, vault.TotalShares.Amount, sharePriceExponent)
+	if err != nil {
+		return fmt.Errorf("failed to compute share price: %w", err)
+	}
+
+	now := ctx.BlockTime().UTC()
+	cumulative := math.LegacyZeroDec()
+	last, err := k.sharePriceObservationAt(ctx, vaultAddr, now)
+	switch {
+	case errors.Is(err, ErrNoSharePriceHistory):
+	case err != nil:
+		return err
+	case last.Price.Equal(price):
+		return nil
+	default:
+		cumulative = accumulatedSharePrice(last, now)
+	}
+
+	observation := types.SharePriceObservation{
+		Time:        now,
+		BlockHeight: ctx.BlockHeight(),
+		Price:       price,
+		Cumulative:  cumulative,
+	}
+	if err := k.SharePriceObservations.Set(ctx, collections.Join(vaultAddr, now), observation); err != nil {
+		return fmt.Errorf("failed to record share price for vault %s: %w", vaultAddr, err)
+	}
+
+	cutoff := now.Add(-time.Duration(retention) * time.Second)
+	var expired []collections.Pair[sdk.AccAddress, time.Time]
+	err = k.SharePriceObservations.Walk(ctx, collections.NewPrefixedPairRange[sdk.AccAddress, time.Time](vaultAddr), func(key collections.Pair[sdk.AccAddress, time.Time], _ types.SharePriceObservation) (bool, error) {
+		if !key.K2().Before(cutoff) {
+			return true, nil
+		}
+		expired = append(expired, key)
+		return false, nil
+	})
+	if err != nil {
+		return fmt.Errorf("failed to walk share price observations for vault %s: %w", vaultAddr, err)
+	}
+	if len(expired) > 0 {
+		expired = expired[:len(expired)-1]
+	}
+	for _, key := range expired {
+		if err := k.SharePriceObservations.Remove(ctx, key); err != nil {
+			return fmt.Errorf("failed to prune share price observations for vault %s: %w", vaultAddr, err)
+		}
+	}
+	return nil
+}
+
+// accumulatedSharePrice returns the accumulator value at t, extending observation's price
+// linearly from its time. t must not be before the observation.
+func accumulatedSharePrice(observation types.SharePriceObservation, t time.Time) math.LegacyDec {
+	elapsed := t.Unix() - observation.Time.Unix()
+	return observation.Cumulative.Add(observation.Price.MulInt64(elapsed))
+}
+
+// sharePriceObservationAt returns the vault's observation in effect at t: the latest one
+// recorded at or before t. It returns ErrNoSharePriceHistory when there is none.
+func (k Keeper) sharePriceObservationAt(ctx sdk.Context, vaultAddr sdk.AccAddress, t time.Time) (types.SharePriceObservation, error) {
+	rng := collections.NewPrefixedPairRange[sdk.AccAddress, time.Time](vaultAddr).EndInclusive(t).Descending()
+	iter, err := k.SharePriceObservations.Iterate(ctx, rng)
+	if err != nil {
+		return types.SharePriceObservation{}, fmt.Errorf("failed to iterate share price observations for vault %s: %w", vaultAddr, err)
+	}
+	defer iter.Close()
+	if !iter.Valid() {
+		return types.SharePriceObservation{}, fmt.Errorf("%w for vault %s at %s", ErrNoSharePriceHistory, vaultAddr, t)
+	}
+	return iter.Value()
+}
+
+// firstSharePriceObservation returns the vault's earliest retained observation, or
+// ErrNoSharePriceHistory when there is none.
+func (k Keeper) firstSharePriceObservation(ctx sdk.Context, vaultAddr sdk.AccAddress) (types.SharePriceObservation, error) {
+	iter, err := k.SharePriceObservations.Iterate(ctx, collections.NewPrefixedPairRange[sdk.AccAddress, time.Time](vaultAddr))
+	if err != nil {
+		return types.SharePriceObservation{}, fmt.Errorf("failed to iterate share price observations for vault %s: %w", vaultAddr, err)
+	}
+	defer iter.Close()
+	if !iter.Valid() {
+		return types.SharePriceObservation{}, fmt.Errorf("%w for vault %s", ErrNoSharePriceHistory, vaultAddr)
+	}
+	return iter.Value()
+}
+
+// clampToSharePriceHistory returns start moved forward to the vault's first retained
+// observation when it is earlier, and whether start was left unchanged.
+func (k Keeper) clampToSharePriceHistory(ctx sdk.Context, vaultAddr sdk.AccAddress, start time.Time) (time.Time, bool, error) {
+	first, err := k.firstSharePriceObservation(ctx, vaultAddr)
+	if err != nil {
+		return time.Time{}, false, err
+	}
+	if start.Before(first.Time) {
+		return first.Time, false, nil
+	}
+	return start, true, nil
+}
+
+// GetSharePriceTWAP returns the vault's time-weighted average share price between start and
+// end, read from its share price accumulator, along with the start actually averaged from:
+// start moved forward to the first retained observation when the history does not reach it.
+// A window with no duration returns the price in effect at its start.
+func (k Keeper) GetSharePriceTWAP(ctx sdk.Context, vaultAddr sdk.AccAddress, start, end time.Time) (math.LegacyDec, time.Time, error) {
+	start, _, err := k.clampToSharePriceHistory(ctx, vaultAddr, start)
+	if err != nil {
+		return math.LegacyDec{}, time.Time{}, err
+	}
+	if end.Before(start) {
+		return math.LegacyDec{}, time.Time{}, fmt.Errorf("%w for vault %s before %s", ErrNoSharePriceHistory, vaultAddr, start)
+	}
+
+	startObservation, err := k.sharePriceObservationAt(ctx, vaultAddr, start)
+	if err != nil {
+		return math.LegacyDec{}, time.Time{}, err
+	}
+	seconds := end.Unix() - start.Unix()
+	if seconds <= 0 {
+		return startObservation.Price, start, nil
+	}
+	endObservation, err := k.sharePriceObservationAt(ctx, vaultAddr, end)
+	if err != nil {
+		return math.LegacyDec{}, time.Time{}, err
+	}
+
+	accumulated := accumulatedSharePrice(endObservation, end).Sub(accumulatedSharePrice(startObservation, start))
+	return accumulated.QuoInt64(seconds), start, nil
+}
+
+// GetVaultPerformance returns the vault's share price return metrics over a trailing window
+// of days ending at the current block time, read from its share price observations. The
+// window starts at the first retained observation when the history does not reach back the
+// full window, in which case it is reported as incomplete.
+func (k Keeper) GetVaultPerformance(ctx sdk.Context, vaultAddr sdk.AccAddress, days uint32) (types.VaultPerformanceWindow, error) {
+	now := ctx.BlockTime().UTC()
+	start, complete, err := k.clampToSharePriceHistory(ctx, vaultAddr, now.Add(-time.Duration(days)*interest.SecondsPerDay*time.Second))
+	if err != nil {
+		return types.VaultPerformanceWindow{}, err
+	}
+	startObservation, err := k.sharePriceObservationAt(ctx, vaultAddr, start)
+	if err != nil {
+		return types.VaultPerformanceWindow{}, err
+	}
+	endObservation, err := k.sharePriceObservationAt(ctx, vaultAddr, now)
+	if err != nil {
+		return types.VaultPerformanceWindow{}, err
+	}
+
+	window := types.VaultPerformanceWindow{
+		WindowDays:       days,
+		StartTime:        start,
+		Complete:         complete,
+		StartPrice:       startObservation.Price,
+		EndPrice:         endObservation.Price,
+		RealizedYield:    math.LegacyZeroDec(),
+		AnnualizedReturn: math.LegacyZeroDec(),
+		MaxDrawdown:      math.LegacyZeroDec(),
+	}
+	if startObservation.Price.IsPositive() {
+		window.RealizedYield = endObservation.Price.Quo(startObservation.Price).Sub(math.LegacyOneDec())
+	}
+	if seconds := now.Unix() - start.Unix(); seconds > 0 {
+		window.AnnualizedReturn = window.RealizedYield.MulInt64(interest.SecondsPerYear).QuoInt64(seconds)
+	}
+
+	peak := startObservation.Price
+	rng := collections.NewPrefixedPairRange[sdk.AccAddress, time.Time](vaultAddr).StartExclusive(start).EndInclusive(now)
+	err = k.SharePriceObservations.Walk(ctx, rng, func(_ collections.Pair[sdk.AccAddress, time.Time], observation types.SharePriceObservation) (bool, error) {
+		if observation.Price.GT(peak) {
+			peak = observation.Price
+			return false, nil
+		}
+		if peak.IsPositive() {
+			drawdown := peak.Sub(observation.Price).Quo(peak)
+			if drawdown.GT(window.MaxDrawdown) {
+				window.MaxDrawdown = drawdown
+			}
+		}
+		return false, nil
+	})
+	if err != nil {
+		return types.VaultPerformanceWindow{}, fmt.Errorf("failed to walk share price observations for vault %s: %w", vaultAddr, err)
+	}
+	return window, nil
+}
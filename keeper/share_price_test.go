@@ -0,0 +1,586 @@
+package keeper_test
+
+import (
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/provlabs/vault/keeper"
+	"github.com/provlabs/vault/types"
+	"github.com/provlabs/vault/utils"
+
+	"cosmossdk.io/collections"
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	markertypes "github.com/provenance-io/provenance/x/marker/types"
+)
+
+func TestNAVPerShareUnit(t *testing.T) {
+	tests := []struct {
+		name        string
+		value       sdkmath.Int
+		totalShares sdkmath.Int
+		exponent    uint32
+		expected    string
+		errContains string
+	}{
+		{
+			name:        "zero supply at base unit returns parity",
+			value:       sdkmath.ZeroInt(),
+			totalShares: sdkmath.ZeroInt(),
+			exponent:    0,
+			expected:    "0.000001000000000000",
+		},
+		{
+			name:        "zero supply at six decimal display unit returns one",
+			value:       sdkmath.NewInt(500),
+			totalShares: sdkmath.ZeroInt(),
+			exponent:    6,
+			expected:    "1.000000000000000000",
+		},
+		{
+			name:        "keeps precision below one underlying unit per base share",
+			value:       sdkmath.NewInt(1005),
+			totalShares: utils.ShareScalar.MulRaw(1000),
+			exponent:    0,
+			expected:    "0.000001005000000000",
+		},
+		{
+			name:        "scales to the display unit",
+			value:       sdkmath.NewInt(1005),
+			totalShares: utils.ShareScalar.MulRaw(1000),
+			exponent:    6,
+			expected:    "1.005000000000000000",
+		},
+		{
+			name:        "truncates at decimal precision",
+			value:       sdkmath.NewInt(1),
+			totalShares: sdkmath.NewInt(3),
+			exponent:    0,
+			expected:    "0.333333333333333333",
+		},
+		{
+			name:        "scales to the largest allowed exponent",
+			value:       sdkmath.NewInt(1),
+			totalShares: sdkmath.NewInt(1),
+			exponent:    types.MaxShareUnitExponent,
+			expected:    "1000000000000000000.000000000000000000",
+		},
+		{
+			name:        "errors when the exponent exceeds the maximum",
+			value:       sdkmath.NewInt(1),
+			totalShares: sdkmath.NewInt(1),
+			exponent:    types.MaxShareUnitExponent + 1,
+			errContains: "share unit exponent 19 exceeds the maximum of 18",
+		},
+		{
+			name:        "errors when the price exceeds the decimal range",
+			value:       sdkmath.NewIntFromBigInt(new(big.Int).Lsh(big.NewInt(1), 200)),
+			totalShares: sdkmath.NewInt(1),
+			exponent:    types.MaxShareUnitExponent,
+			errContains: "exceeds the decimal range",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			price, err := keeper.NAVPerShareUnit(tc.value, tc.totalShares, tc.exponent)
+			if tc.errContains != "" {
+				require.ErrorContains(t, err, tc.errContains, "NAVPerShareUnit error")
+				return
+			}
+			require.NoError(t, err, "NAVPerShareUnit should not error")
+			require.Equal(t, tc.expected, price.String(), "NAVPerShareUnit price")
+		})
+	}
+}
+
+func (s *TestSuite) TestQueryServer_SharePrice() {
+	underlying := "under"
+	share := "vaultshares"
+	vaultAddr := types.GetVaultAddress(share)
+
+	vault := s.setupBaseVault(underlying, share)
+	s.Require().NoError(s.k.BankKeeper.SendCoins(markertypes.WithBypass(s.ctx), s.adminAddr, vault.PrincipalMarkerAddress(), sdk.NewCoins(
+		sdk.NewInt64Coin(underlying, 1000),
+	)), "should fund vault for TVV")
+	shares := sdk.NewCoin(share, utils.ShareScalar.MulRaw(800))
+	s.Require().NoError(s.k.MarkerKeeper.MintCoin(s.ctx, vault.GetAddress(), shares), "should mint share supply")
+	vault.TotalShares = shares
+	vault.OutstandingAumFee = sdk.NewInt64Coin(underlying, 100)
+	s.k.AuthKeeper.SetAccount(s.ctx, vault)
+	s.ctx = s.ctx.WithBlockHeight(42)
+
+	queryServer := keeper.NewQueryServer(s.simApp.VaultKeeper)
+
+	tests := []struct {
+		name        string
+		setup       func()
+		req         *types.QuerySharePriceRequest
+		expectErr   bool
+		errContains string
+		validate    func(resp *types.QuerySharePriceResponse)
+	}{
+		{
+			name: "falls back to the share base denom without metadata",
+			req:  &types.QuerySharePriceRequest{Id: vaultAddr.String()},
+			validate: func(resp *types.QuerySharePriceResponse) {
+				s.Assert().Equal(share, resp.ShareUnit, "share unit should be the base denom")
+				s.Assert().Equal(uint32(0), resp.Exponent, "exponent should be zero")
+				s.Assert().Equal("0.000001250000000000", resp.Gross.String(), "gross price mismatch")
+				s.Assert().Equal("0.000001125000000000", resp.Net.String(), "net price mismatch")
+				s.Assert().Equal(underlying, resp.PriceDenom, "price denom mismatch")
+				s.Assert().Equal(int64(42), resp.Height, "height mismatch")
+			},
+		},
+		{
+			name: "prices the display unit from share metadata",
+			setup: func() {
+				s.k.BankKeeper.SetDenomMetaData(s.ctx, banktypes.Metadata{
+					Base:    share,
+					Display: "vshare",
+					Name:    "Vault Shares",
+					Symbol:  "VSHARE",
+					DenomUnits: []*banktypes.DenomUnit{
+						{Denom: share, Exponent: 0},
+						{Denom: "vshare", Exponent: 6},
+					},
+				})
+			},
+			req: &types.QuerySharePriceRequest{Id: vaultAddr.String()},
+			validate: func(resp *types.QuerySharePriceResponse) {
+				s.Assert().Equal("vshare", resp.ShareUnit, "share unit should be the display unit")
+				s.Assert().Equal(uint32(6), resp.Exponent, "exponent should match the display unit")
+				s.Assert().Equal(sdkmath.LegacyMustNewDecFromStr("1.25"), resp.Gross, "gross price mismatch")
+				s.Assert().Equal(sdkmath.LegacyMustNewDecFromStr("1.125"), resp.Net, "net price mismatch")
+				s.Assert().True(resp.Estimated.LTE(resp.Net), "estimated price %s should not exceed net %s", resp.Estimated, resp.Net)
+			},
+		},
+		{
+			name: "paused vault prices every variant from the paused balance",
+			setup: func() {
+				paused, err := s.k.GetVault(s.ctx, vaultAddr)
+				s.Require().NoError(err, "should load vault")
+				paused.Paused = true
+				paused.PausedBalance = sdk.NewInt64Coin(underlying, 400)
+				s.k.AuthKeeper.SetAccount(s.ctx, paused)
+			},
+			req: &types.QuerySharePriceRequest{Id: vaultAddr.String()},
+			validate: func(resp *types.QuerySharePriceResponse) {
+				s.Assert().Equal(sdkmath.LegacyMustNewDecFromStr("0.5"), resp.Gross, "gross price mismatch")
+				s.Assert().Equal(resp.Gross, resp.Net, "net should equal gross while paused")
+				s.Assert().Equal(resp.Gross, resp.Estimated, "estimated should equal gross while paused")
+			},
+		},
+		{
+			name:        "empty id",
+			req:         &types.QuerySharePriceRequest{},
+			expectErr:   true,
+			errContains: "id must be provided",
+		},
+		{
+			name:        "vault not found",
+			req:         &types.QuerySharePriceRequest{Id: types.GetVaultAddress("missing").String()},
+			expectErr:   true,
+			errContains: "not found",
+		},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			if tc.setup != nil {
+				tc.setup()
+			}
+			resp, err := queryServer.SharePrice(s.ctx, tc.req)
+			if tc.expectErr {
+				s.Require().ErrorContains(err, tc.errContains, "SharePrice error mismatch")
+				return
+			}
+			s.Require().NoError(err, "SharePrice should not error")
+			tc.validate(resp)
+		})
+	}
+}
+
+// setupSharePriceVault returns a vault holding 1,000 heldcoin priced at one underlying unit
+// each, with 1,000 * ShareScalar shares outstanding, so its share price starts at 1.
+func (s *TestSuite) setupSharePriceVault(underlying, share, held string) *types.VaultAccount {
+	vault := s.setupStaleNAVVault(underlying, share, held, 0, false)
+	vault.TotalShares = sdk.NewCoin(share, utils.ShareScalar.MulRaw(1_000))
+	s.Require().NoError(s.k.SetVaultAccount(s.ctx, vault), "should set vault shares")
+	return vault
+}
+
+// observeSharePrice reprices the vault's 1,000 held units to total underlying units and
+// records the resulting share price, total / 1000, at the current block time.
+func (s *TestSuite) observeSharePrice(vault *types.VaultAccount, underlying, held string, total int64) {
+	s.setVaultNAV(vault, held, sdk.NewInt64Coin(underlying, total), 1_000)
+	s.Require().NoError(s.k.TestAccessor_recordSharePrice(s.T(), s.ctx, vault), "recordSharePrice should not error")
+}
+
+// sharePriceObservations returns the vault's recorded share price observations in time order.
+func (s *TestSuite) sharePriceObservations(vault *types.VaultAccount) []types.SharePriceObservation {
+	var observations []types.SharePriceObservation
+	err := s.k.SharePriceObservations.Walk(s.ctx, collections.NewPrefixedPairRange[sdk.AccAddress, time.Time](vault.GetAddress()), func(_ collections.Pair[sdk.AccAddress, time.Time], observation types.SharePriceObservation) (bool, error) {
+		observations = append(observations, observation)
+		return false, nil
+	})
+	s.Require().NoError(err, "SharePriceObservations.Walk should not error")
+	return observations
+}
+
+// setSharePriceRetention stores module params with the given share price retention.
+func (s *TestSuite) setSharePriceRetention(retention uint64) {
+	params := types.DefaultParams()
+	params.SharePriceRetentionSeconds = retention
+	s.Require().NoError(s.k.Params.Set(s.ctx, params), "should set params with share price retention %d", retention)
+}
+
+func (s *TestSuite) TestRecordSharePrice() {
+	underlying, share, held := "under", "vshare", "heldcoin"
+
+	tests := []struct {
+		name             string
+		retention        uint64
+		totals           []int64
+		step             time.Duration
+		expectPrices     []string
+		expectCumulative []string
+	}{
+		{
+			name:             "first observation starts the accumulator at zero",
+			retention:        types.DefaultSharePriceRetention,
+			totals:           []int64{1_000},
+			expectPrices:     []string{"1"},
+			expectCumulative: []string{"0"},
+		},
+		{
+			name:             "each price change accumulates the previous price over the elapsed seconds",
+			retention:        types.DefaultSharePriceRetention,
+			totals:           []int64{1_000, 2_000, 1_500},
+			step:             100 * time.Second,
+			expectPrices:     []string{"1", "2", "1.5"},
+			expectCumulative: []string{"0", "100", "300"},
+		},
+		{
+			name:             "an unchanged price records nothing",
+			retention:        types.DefaultSharePriceRetention,
+			totals:           []int64{1_000, 1_000, 2_000},
+			step:             100 * time.Second,
+			expectPrices:     []string{"1", "2"},
+			expectCumulative: []string{"0", "200"},
+		},
+		{
+			name:             "a later price in the same block replaces the earlier one",
+			retention:        types.DefaultSharePriceRetention,
+			totals:           []int64{1_000, 2_000},
+			expectPrices:     []string{"2"},
+			expectCumulative: []string{"0"},
+		},
+		{
+			name:             "observations older than the retention window are pruned except the newest",
+			retention:        90,
+			totals:           []int64{1_000, 2_000, 3_000, 4_000},
+			step:             time.Minute,
+			expectPrices:     []string{"2", "3", "4"},
+			expectCumulative: []string{"60", "180", "360"},
+		},
+		{
+			name:      "zero retention disables recording",
+			retention: 0,
+			totals:    []int64{1_000, 2_000},
+			step:      time.Minute,
+		},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+			s.setSharePriceRetention(tc.retention)
+			vault := s.setupSharePriceVault(underlying, share, held)
+			for i, total := range tc.totals {
+				if i > 0 {
+					s.SetCtxBlockTime(s.ctx.BlockTime().Add(tc.step))
+				}
+				s.observeSharePrice(vault, underlying, held, total)
+			}
+
+			var prices, cumulative []string
+			for _, observation := range s.sharePriceObservations(vault) {
+				prices = append(prices, observation.Price.String())
+				cumulative = append(cumulative, observation.Cumulative.String())
+			}
+			s.Assert().Equal(decStrings(tc.expectPrices), prices, "recorded share prices")
+			s.Assert().Equal(decStrings(tc.expectCumulative), cumulative, "recorded share price accumulator")
+		})
+	}
+}
+
+func (s *TestSuite) TestReconcileVault_RecordsSharePrice() {
+	underlying, share, held := "under", "vshare", "heldcoin"
+	vault := s.setupSharePriceVault(underlying, share, held)
+
+	s.Require().NoError(s.k.TestAccessor_reconcileVault(s.T(), s.ctx, vault), "reconcileVault should not error")
+
+	observation, err := s.k.SharePriceObservations.Get(s.ctx, collections.Join(vault.GetAddress(), s.ctx.BlockTime().UTC()))
+	s.Require().NoError(err, "reconcileVault should record a share price observation")
+	s.Assert().Equal(sdkmath.LegacyOneDec(), observation.Price, "recorded share price")
+	s.Assert().Equal(s.ctx.BlockHeight(), observation.BlockHeight, "recorded share price block height")
+}
+
+func (s *TestSuite) TestGetSharePriceTWAP() {
+	underlying, share, held := "under", "vshare", "heldcoin"
+	vault := s.setupSharePriceVault(underlying, share, held)
+	t0 := s.ctx.BlockTime()
+	s.observeSharePrice(vault, underlying, held, 1_000)
+	s.SetCtxBlockTime(t0.Add(100 * time.Second))
+	s.observeSharePrice(vault, underlying, held, 2_000)
+	s.SetCtxBlockTime(t0.Add(200 * time.Second))
+	s.observeSharePrice(vault, underlying, held, 3_000)
+	s.SetCtxBlockTime(t0.Add(300 * time.Second))
+
+	tests := []struct {
+		name        string
+		start       time.Time
+		end         time.Time
+		expectTWAP  string
+		expectStart time.Time
+		errContains string
+	}{
+		{
+			name:        "averages over the whole history",
+			start:       t0,
+			end:         t0.Add(300 * time.Second),
+			expectTWAP:  "2",
+			expectStart: t0,
+		},
+		{
+			name:        "interpolates the accumulator between observations",
+			start:       t0.Add(50 * time.Second),
+			end:         t0.Add(150 * time.Second),
+			expectTWAP:  "1.5",
+			expectStart: t0.Add(50 * time.Second),
+		},
+		{
+			name:        "start before the history is moved to the first observation",
+			start:       t0.Add(-time.Hour),
+			end:         t0.Add(100 * time.Second),
+			expectTWAP:  "1",
+			expectStart: t0,
+		},
+		{
+			name:        "a window without duration returns the price in effect",
+			start:       t0.Add(150 * time.Second),
+			end:         t0.Add(150 * time.Second),
+			expectTWAP:  "2",
+			expectStart: t0.Add(150 * time.Second),
+		},
+		{
+			name:        "a window ending before the history errors",
+			start:       t0.Add(-time.Hour),
+			end:         t0.Add(-time.Minute),
+			errContains: "no share price history",
+		},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			twap, start, err := s.k.GetSharePriceTWAP(s.ctx, vault.GetAddress(), tc.start, tc.end)
+			if tc.errContains != "" {
+				s.Require().ErrorIs(err, keeper.ErrNoSharePriceHistory, "GetSharePriceTWAP error")
+				s.Assert().ErrorContains(err, tc.errContains, "GetSharePriceTWAP error message")
+				return
+			}
+			s.Require().NoError(err, "GetSharePriceTWAP should not error")
+			s.Assert().Equal(sdkmath.LegacyMustNewDecFromStr(tc.expectTWAP), twap, "TWAP")
+			s.Assert().Equal(tc.expectStart, start, "averaged window start")
+		})
+	}
+}
+
+func (s *TestSuite) TestGetVaultPerformance() {
+	underlying, share, held := "under", "vshare", "heldcoin"
+	day := 24 * time.Hour
+	vault := s.setupSharePriceVault(underlying, share, held)
+	t0 := s.ctx.BlockTime()
+	for i, total := range []int64{1_000, 1_200, 900, 1_100} {
+		s.SetCtxBlockTime(t0.Add(time.Duration(i) * day))
+		s.observeSharePrice(vault, underlying, held, total)
+	}
+	s.SetCtxBlockTime(t0.Add(10 * day))
+
+	tests := []struct {
+		name             string
+		days             uint32
+		expectStart      time.Time
+		expectComplete   bool
+		expectStartPrice string
+		expectYield      string
+		expectAnnualized string
+		expectDrawdown   string
+	}{
+		{
+			name:             "window within the history",
+			days:             7,
+			expectStart:      t0.Add(3 * day),
+			expectComplete:   true,
+			expectStartPrice: "1.1",
+			expectYield:      "0",
+			expectAnnualized: "0",
+			expectDrawdown:   "0",
+		},
+		{
+			name:             "window longer than the history starts at the first observation",
+			days:             30,
+			expectStart:      t0,
+			expectComplete:   false,
+			expectStartPrice: "1",
+			expectYield:      "0.1",
+			expectAnnualized: "3.65",
+			expectDrawdown:   "0.25",
+		},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			window, err := s.k.GetVaultPerformance(s.ctx, vault.GetAddress(), tc.days)
+			s.Require().NoError(err, "GetVaultPerformance should not error")
+			s.Assert().Equal(tc.days, window.WindowDays, "window days")
+			s.Assert().Equal(tc.expectStart, window.StartTime, "window start")
+			s.Assert().Equal(tc.expectComplete, window.Complete, "window complete")
+			s.Assert().Equal(decStrings([]string{tc.expectStartPrice, "1.1", tc.expectYield, tc.expectAnnualized, tc.expectDrawdown}),
+				[]string{window.StartPrice.String(), window.EndPrice.String(), window.RealizedYield.String(), window.AnnualizedReturn.String(), window.MaxDrawdown.String()},
+				"start price, end price, realized yield, annualized return and max drawdown")
+		})
+	}
+}
+
+func (s *TestSuite) TestQueryServer_VaultTWAP() {
+	underlying, share, held := "under", "vshare", "heldcoin"
+	vault := s.setupSharePriceVault(underlying, share, held)
+	vaultAddr := vault.GetAddress()
+	t0 := s.ctx.BlockTime()
+	s.observeSharePrice(vault, underlying, held, 1_000)
+	s.SetCtxBlockTime(t0.Add(100 * time.Second))
+	s.observeSharePrice(vault, underlying, held, 3_000)
+	s.SetCtxBlockTime(t0.Add(200 * time.Second))
+	empty := s.setupBaseVault("emptyunder", "emptyshare")
+
+	queryServer := keeper.NewQueryServer(s.simApp.VaultKeeper)
+	at := func(d time.Duration) *time.Time {
+		t := t0.Add(d)
+		return &t
+	}
+
+	tests := []struct {
+		name        string
+		req         *types.QueryVaultTWAPRequest
+		errContains string
+		validate    func(resp *types.QueryVaultTWAPResponse)
+	}{
+		{
+			name: "end defaults to the block time",
+			req:  &types.QueryVaultTWAPRequest{Id: vaultAddr.String(), StartTime: at(0)},
+			validate: func(resp *types.QueryVaultTWAPResponse) {
+				s.Assert().Equal(sdkmath.LegacyNewDec(2), resp.Twap, "TWAP mismatch")
+				s.Assert().Equal(underlying, resp.PriceDenom, "price denom mismatch")
+				s.Assert().Equal(t0, resp.StartTime, "start time mismatch")
+				s.Assert().Equal(t0.Add(200*time.Second), resp.EndTime, "end time mismatch")
+			},
+		},
+		{
+			name: "explicit end time",
+			req:  &types.QueryVaultTWAPRequest{Id: share, StartTime: at(-time.Hour), EndTime: at(100 * time.Second)},
+			validate: func(resp *types.QueryVaultTWAPResponse) {
+				s.Assert().Equal(sdkmath.LegacyOneDec(), resp.Twap, "TWAP mismatch")
+				s.Assert().Equal(t0, resp.StartTime, "start time should move to the first observation")
+			},
+		},
+		{
+			name:        "empty id",
+			req:         &types.QueryVaultTWAPRequest{StartTime: at(0)},
+			errContains: "id must be provided",
+		},
+		{
+			name:        "missing start time",
+			req:         &types.QueryVaultTWAPRequest{Id: vaultAddr.String()},
+			errContains: "start_time must be provided",
+		},
+		{
+			name:        "start time not before end time",
+			req:         &types.QueryVaultTWAPRequest{Id: vaultAddr.String(), StartTime: at(100 * time.Second), EndTime: at(100 * time.Second)},
+			errContains: "start_time must be before end_time",
+		},
+		{
+			name:        "end time after the block time",
+			req:         &types.QueryVaultTWAPRequest{Id: vaultAddr.String(), StartTime: at(0), EndTime: at(time.Hour)},
+			errContains: "end_time cannot be after the current block time",
+		},
+		{
+			name:        "vault not found",
+			req:         &types.QueryVaultTWAPRequest{Id: types.GetVaultAddress("missing").String(), StartTime: at(0)},
+			errContains: "not found",
+		},
+		{
+			name:        "vault without share price history",
+			req:         &types.QueryVaultTWAPRequest{Id: empty.GetAddress().String(), StartTime: at(0)},
+			errContains: "no share price history",
+		},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			resp, err := queryServer.VaultTWAP(s.ctx, tc.req)
+			if tc.errContains != "" {
+				s.Require().ErrorContains(err, tc.errContains, "VaultTWAP error mismatch")
+				return
+			}
+			s.Require().NoError(err, "VaultTWAP should not error")
+			tc.validate(resp)
+		})
+	}
+}
+
+func (s *TestSuite) TestQueryServer_VaultPerformance() {
+	underlying, share, held := "under", "vshare", "heldcoin"
+	vault := s.setupSharePriceVault(underlying, share, held)
+	s.observeSharePrice(vault, underlying, held, 1_000)
+	s.SetCtxBlockTime(s.ctx.BlockTime().Add(400 * 24 * time.Hour))
+	s.observeSharePrice(vault, underlying, held, 1_050)
+	s.ctx = s.ctx.WithBlockHeight(42)
+	empty := s.setupBaseVault("emptyunder", "emptyshare")
+
+	queryServer := keeper.NewQueryServer(s.simApp.VaultKeeper)
+
+	resp, err := queryServer.VaultPerformance(s.ctx, &types.QueryVaultPerformanceRequest{Id: vault.GetAddress().String()})
+	s.Require().NoError(err, "VaultPerformance should not error")
+	s.Require().Len(resp.Windows, 4, "VaultPerformance should report four windows")
+	for i, days := range []uint32{7, 30, 90, 365} {
+		s.Assert().Equal(days, resp.Windows[i].WindowDays, "window %d days", i)
+		s.Assert().True(resp.Windows[i].Complete, "window %d should be complete", i)
+		s.Assert().Equal(sdkmath.LegacyMustNewDecFromStr("1.05"), resp.Windows[i].EndPrice, "window %d end price", i)
+	}
+	s.Assert().Equal(sdkmath.LegacyMustNewDecFromStr("0.05"), resp.Windows[0].RealizedYield, "7 day window should start from the price in effect at its start")
+	s.Assert().Equal(underlying, resp.PriceDenom, "price denom mismatch")
+	s.Assert().Equal(int64(42), resp.Height, "height mismatch")
+
+	_, err = queryServer.VaultPerformance(s.ctx, &types.QueryVaultPerformanceRequest{})
+	s.Assert().ErrorContains(err, "id must be provided", "empty id should be rejected")
+	_, err = queryServer.VaultPerformance(s.ctx, &types.QueryVaultPerformanceRequest{Id: types.GetVaultAddress("missing").String()})
+	s.Assert().ErrorContains(err, "not found", "unknown vault should be rejected")
+	_, err = queryServer.VaultPerformance(s.ctx, &types.QueryVaultPerformanceRequest{Id: empty.GetAddress().String()})
+	s.Assert().ErrorContains(err, "no share price history", "vault without history should be rejected")
+}
+
+// decStrings converts decimal literals to their canonical LegacyDec string form.
+func decStrings(values []string) []string {
+	var out []string
+	for _, v := range values {
+		out = append(out, sdkmath.LegacyMustNewDecFromStr(v).String())
+	}
+	return out
+}
@@ -382,8 +382,8 @@ func (s *TestSuite) requireSimpleMarker(denom string) {
 }
 
 // scopeSpecUUID is the scope specification every scope written by requireScope points at.
-// The vault module never reads the specification, so a single shared value keeps the
-// fixture minimal.
+// The vault module reads the specification only to apply a vault's acquisition policy, so
+// a single shared value keeps the fixture minimal.
 const scopeSpecUUID = "00000000-0000-4000-8000-0000000000ff"
 
 // requireScope writes a metadata scope for the given scope UUID and returns the scope's
@@ -0,0 +1,208 @@
+package keeper
+
+import (
+	"errors"
+	"fmt"
+
+	"cosmossdk.io/collections"
+
+	"github.com/provlabs/vault/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// SetTimelock sets the delay, in seconds, applied to the vault's sensitive configuration
+// changes and emits an EventTimelockUpdated. A zero timelock applies changes immediately.
+// The caller is responsible for verifying that authority is the vault admin and for holding
+// a lowered timelock behind the current one.
+func (k *Keeper) SetTimelock(ctx sdk.Context, vault *types.VaultAccount, timelockSeconds uint64, authority string) error {
+	if timelockSeconds > types.MaxTimelockSeconds {
+		return fmt.Errorf("timelock of %d seconds exceeds the maximum of %d seconds", timelockSeconds, types.MaxTimelockSeconds)
+	}
+	if vault.TimelockSeconds == timelockSeconds {
+		return nil
+	}
+	vault.TimelockSeconds = timelockSeconds
+	if err := k.SetVaultAccount(ctx, vault); err != nil {
+		return fmt.Errorf("failed to set vault account: %w", err)
+	}
+	k.emitEvent(ctx, types.NewEventTimelockUpdated(vault.Address, authority, timelockSeconds))
+	return nil
+}
+
+// scheduleIfTimelocked enqueues msg as a scheduled action of the vault when the vault has a
+// timelock, emitting an EventActionScheduled, and reports whether it did so. When the vault
+// has no timelock nothing is enqueued and the caller applies msg immediately. The caller is
+// responsible for verifying that authority may sign msg.
+func (k *Keeper) scheduleIfTimelocked(ctx sdk.Context, vault *types.VaultAccount, authority string, msg sdk.Msg) (bool, error) {
+	if vault.TimelockSeconds == 0 {
+		return false, nil
+	}
+
+	executeTime := ctx.BlockTime().Unix() + int64(vault.TimelockSeconds) //nolint:gosec // bounded by MaxTimelockSeconds
+	action, err := types.NewScheduledAction(vault.Address, authority, executeTime, msg)
+	if err != nil {
+		return false, err
+	}
+	id, err := k.ScheduledActionQueue.Enqueue(ctx, action)
+	if err != nil {
+		return false, fmt.Errorf("failed to enqueue scheduled action: %w", err)
+	}
+
+	k.emitEvent(ctx, types.NewEventActionScheduled(vault.Address, authority, id, action.MsgTypeURL(), executeTime))
+	return true, nil
+}
+
+// RemoveScheduledAction discards the vault's scheduled action with the given id and emits an
+// EventScheduledActionCancelled. The caller is responsible for verifying that authority may
+// cancel the vault's scheduled actions.
+func (k *Keeper) RemoveScheduledAction(ctx sdk.Context, vault *types.VaultAccount, actionID uint64, authority string) error {
+	action, err := k.ScheduledActionQueue.GetByID(ctx, actionID)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return fmt.Errorf("scheduled action %d not found", actionID)
+		}
+		return fmt.Errorf("failed to get scheduled action %d: %w", actionID, err)
+	}
+	if action.VaultAddress != vault.Address {
+		return fmt.Errorf("scheduled action %d does not belong to vault %s", actionID, vault.Address)
+	}
+	if err := k.ScheduledActionQueue.Dequeue(ctx, action); err != nil {
+		return fmt.Errorf("failed to dequeue scheduled action %d: %w", actionID, err)
+	}
+
+	k.emitEvent(ctx, types.NewEventScheduledActionCancelled(vault.Address, authority, actionID, action.MsgTypeURL()))
+	return nil
+}
+
+// handleScheduledActions executes up to batchSize scheduled actions whose execute time has
+// been reached, in execute time order. Every visited action is removed from the queue. An
+// action that fails is discarded rather than retried: its state changes are rolled back and
+// an EventScheduledActionFailed is emitted in place of an EventScheduledActionExecuted.
+func (k *Keeper) handleScheduledActions(ctx sdk.Context, batchSize int) error {
+	var due []types.ScheduledAction
+	err := k.ScheduledActionQueue.WalkDue(ctx, ctx.BlockTime().Unix(), func(action types.ScheduledAction) (bool, error) {
+		if len(due) == batchSize {
+			return true, nil
+		}
+		due = append(due, action)
+		return false, nil
+	})
+	if err != nil {
+		return fmt.Errorf("failed to walk scheduled action queue: %w", err)
+	}
+
+	for _, action := range due {
+		if err := k.ScheduledActionQueue.Dequeue(ctx, action); err != nil {
+			return fmt.Errorf("failed to dequeue scheduled action %d: %w", action.Id, err)
+		}
+		if err := k.executeScheduledAction(ctx, action); err != nil {
+			k.getLogger(ctx).Error("failed to execute scheduled action",
+				"id", action.Id, "vault", action.VaultAddress, "msg", action.MsgTypeURL(), "error", err)
+			k.emitEvent(ctx, types.NewEventScheduledActionFailed(action.VaultAddress, action.Id, action.MsgTypeURL(), err.Error()))
+			continue
+		}
+		k.emitEvent(ctx, types.NewEventScheduledActionExecuted(action.VaultAddress, action.Id, action.MsgTypeURL()))
+	}
+	return nil
+}
+
+// executeScheduledAction applies the action's message to its vault in a cache context that is
+// committed only on success.
+func (k *Keeper) executeScheduledAction(ctx sdk.Context, action types.ScheduledAction) error {
+	var msg sdk.Msg
+	if err := k.cdc.UnpackAny(action.Msg, &msg); err != nil {
+		return fmt.Errorf("failed to unpack scheduled message: %w", err)
+	}
+	vaultAddr, err := sdk.AccAddressFromBech32(action.VaultAddress)
+	if err != nil {
+		return fmt.Errorf("invalid vault address %s: %w", action.VaultAddress, err)
+	}
+
+	cacheCtx, write := ctx.CacheContext()
+	vault, err := k.getVault(cacheCtx, vaultAddr)
+	if err != nil {
+		return fmt.Errorf("failed to get vault %s: %w", action.VaultAddress, err)
+	}
+	if err := k.applyScheduledMsg(cacheCtx, vault, msg); err != nil {
+		return err
+	}
+	write()
+	return nil
+}
+
+// applyScheduledMsg re-checks that the message's signer still holds the authority it had when
+// the message was scheduled, then applies the message to the vault. An admin or role holder
+// replaced during the timelock window therefore cannot have its scheduled changes applied.
+func (k *Keeper) applyScheduledMsg(ctx sdk.Context, vault *types.VaultAccount, msg sdk.Msg) error {
+	switch m := msg.(type) {
+	case *types.MsgUpdateWithdrawalDelayRequest:
+		if err := vault.ValidateRole(m.Authority, types.VaultRole_VAULT_ROLE_LIMITS_MANAGER); err != nil {
+			return fmt.Errorf("failed to validate limits manager role: %w", err)
+		}
+		return k.SetWithdrawalDelay(ctx, vault, m.WithdrawalDelaySeconds, m.Authority)
+	case *types.MsgUpdateMaxSwapOutValueRequest:
+		if err := vault.ValidateRole(m.Authority, types.VaultRole_VAULT_ROLE_LIMITS_MANAGER); err != nil {
+			return fmt.Errorf("failed to validate limits manager role: %w", err)
+		}
+		return k.SetMaxSwapOutValue(ctx, vault, m.MaxSwapOutValue, m.Authority)
+	case *types.MsgSetBridgeAddressRequest:
+		if err := vault.ValidateAdmin(m.Admin); err != nil {
+			return fmt.Errorf("failed to validate admin: %w", err)
+		}
+		return k.SetVaultBridgeAddress(ctx, vault, m.BridgeAddress, m.Admin)
+	case *types.MsgSetAssetManagerRequest:
+		if err := vault.ValidateAdmin(m.Admin); err != nil {
+			return fmt.Errorf("failed to validate admin: %w", err)
+		}
+		return k.SetVaultAssetManager(ctx, vault, m.AssetManager, m.Admin)
+	case *types.MsgUpdateNAVAuthorityRequest:
+		if err := vault.ValidateAdmin(m.Signer); err != nil {
+			return fmt.Errorf("failed to validate admin: %w", err)
+		}
+		return k.SetNAVAuthority(ctx, vault, m.NewAuthority, m.Signer)
+	case *types.MsgUpdateNAVChangeLimitRequest:
+		if err := vault.ValidateAdmin(m.Authority); err != nil {
+			return fmt.Errorf("failed to validate admin: %w", err)
+		}
+		return k.SetNAVChangeLimit(ctx, vault, m.MaxNavChangeBips, m.NavApprover, m.Authority)
+	case *types.MsgUpdateNAVSourcesRequest:
+		if err := vault.ValidateAdmin(m.Authority); err != nil {
+			return fmt.Errorf("failed to validate admin: %w", err)
+		}
+		if err := k.reconcileVault(ctx, vault); err != nil {
+			return fmt.Errorf("failed to reconcile vault before NAV source update: %w", err)
+		}
+		return k.SetNAVSources(ctx, vault, m.NavSources, m.NavSourceQuorum, m.NavAggregation, m.NavSourceMaxAgeSeconds, m.Authority)
+	case *types.MsgUpdatePriceSourcesRequest:
+		if err := vault.ValidateAdmin(m.Authority); err != nil {
+			return fmt.Errorf("failed to validate admin: %w", err)
+		}
+		if err := k.reconcileVault(ctx, vault); err != nil {
+			return fmt.Errorf("failed to reconcile vault before price source update: %w", err)
+		}
+		return k.SetPriceSources(ctx, vault, m.PriceSources, m.Authority)
+	case *types.MsgGrantRoleRequest:
+		if err := vault.ValidateAdmin(m.Authority); err != nil {
+			return fmt.Errorf("failed to validate admin: %w", err)
+		}
+		return k.AddRoleGrant(ctx, vault, m.Role, m.Address, m.Authority)
+	case *types.MsgRevokeRoleRequest:
+		if err := vault.ValidateAdmin(m.Authority); err != nil {
+			return fmt.Errorf("failed to validate admin: %w", err)
+		}
+		return k.RemoveRoleGrant(ctx, vault, m.Role, m.Address, m.Authority)
+	case *types.MsgProposeAdminTransferRequest:
+		if err := vault.ValidateAdmin(m.Authority); err != nil {
+			return fmt.Errorf("failed to validate admin: %w", err)
+		}
+		return k.SetPendingAdminTransfer(ctx, vault, m.NewAdmin, m.ExpirationSeconds, m.Authority)
+	case *types.MsgUpdateTimelockRequest:
+		if err := vault.ValidateAdmin(m.Authority); err != nil {
+			return fmt.Errorf("failed to validate admin: %w", err)
+		}
+		return k.SetTimelock(ctx, vault, m.TimelockSeconds, m.Authority)
+	default:
+		return fmt.Errorf("unsupported scheduled message %s", sdk.MsgTypeURL(msg))
+	}
+}
@@ -0,0 +1,483 @@
+package keeper_test
+
+import (
+	"time"
+
+	"github.com/provlabs/vault/keeper"
+	"github.com/provlabs/vault/types"
+
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// setTimelock gives the vault a timelock of the given number of seconds.
+func (s *TestSuite) setTimelock(vault *types.VaultAccount, seconds uint64) {
+	s.T().Helper()
+	_, err := keeper.NewMsgServer(s.simApp.VaultKeeper).UpdateTimelock(s.ctx, &types.MsgUpdateTimelockRequest{
+		Authority:       s.adminAddr.String(),
+		VaultAddress:    vault.Address,
+		TimelockSeconds: seconds,
+	})
+	s.Require().NoError(err, "UpdateTimelock")
+}
+
+// scheduledActions returns the vault's scheduled actions as reported by the ScheduledActions query.
+func (s *TestSuite) scheduledActions(vault *types.VaultAccount) []types.ScheduledAction {
+	s.T().Helper()
+	resp, err := keeper.NewQueryServer(s.simApp.VaultKeeper).ScheduledActions(s.ctx, &types.QueryScheduledActionsRequest{Id: vault.Address})
+	s.Require().NoError(err, "ScheduledActions")
+	return resp.ScheduledActions
+}
+
+func (s *TestSuite) TestMsgServer_TimelockedActionsExecuteAfterDelay() {
+	underlying, share := "under", "vshare"
+	newAddr := sdk.AccAddress("newAddr_____________").String()
+
+	tests := []struct {
+		name    string
+		send    func(msgServer types.MsgServer, vault *types.VaultAccount) error
+		applied func(vault *types.VaultAccount) bool
+	}{
+		{
+			name: "withdrawal delay",
+			send: func(msgServer types.MsgServer, vault *types.VaultAccount) error {
+				_, err := msgServer.UpdateWithdrawalDelay(s.ctx, &types.MsgUpdateWithdrawalDelayRequest{
+					Authority:              s.adminAddr.String(),
+					VaultAddress:           vault.Address,
+					WithdrawalDelaySeconds: 7200,
+				})
+				return err
+			},
+			applied: func(vault *types.VaultAccount) bool { return vault.WithdrawalDelaySeconds == 7200 },
+		},
+		{
+			name: "max swap out value",
+			send: func(msgServer types.MsgServer, vault *types.VaultAccount) error {
+				_, err := msgServer.UpdateMaxSwapOutValue(s.ctx, &types.MsgUpdateMaxSwapOutValueRequest{
+					Authority:       s.adminAddr.String(),
+					VaultAddress:    vault.Address,
+					MaxSwapOutValue: "5000",
+				})
+				return err
+			},
+			applied: func(vault *types.VaultAccount) bool { return vault.MaxSwapOutValue == "5000" },
+		},
+		{
+			name: "bridge address",
+			send: func(msgServer types.MsgServer, vault *types.VaultAccount) error {
+				_, err := msgServer.SetBridgeAddress(s.ctx, &types.MsgSetBridgeAddressRequest{
+					Admin:         s.adminAddr.String(),
+					VaultAddress:  vault.Address,
+					BridgeAddress: newAddr,
+				})
+				return err
+			},
+			applied: func(vault *types.VaultAccount) bool { return vault.BridgeAddress == newAddr },
+		},
+		{
+			name: "asset manager",
+			send: func(msgServer types.MsgServer, vault *types.VaultAccount) error {
+				_, err := msgServer.SetAssetManager(s.ctx, &types.MsgSetAssetManagerRequest{
+					Admin:        s.adminAddr.String(),
+					VaultAddress: vault.Address,
+					AssetManager: newAddr,
+				})
+				return err
+			},
+			applied: func(vault *types.VaultAccount) bool {
+				return vault.AssetManager == newAddr && vault.HasRole(newAddr, types.VaultRole_VAULT_ROLE_SETTLEMENT_MANAGER)
+			},
+		},
+		{
+			name: "NAV authority",
+			send: func(msgServer types.MsgServer, vault *types.VaultAccount) error {
+				_, err := msgServer.UpdateNAVAuthority(s.ctx, &types.MsgUpdateNAVAuthorityRequest{
+					Signer:       s.adminAddr.String(),
+					VaultAddress: vault.Address,
+					NewAuthority: newAddr,
+				})
+				return err
+			},
+			applied: func(vault *types.VaultAccount) bool { return vault.NavAuthority == newAddr },
+		},
+		{
+			name: "NAV change limit",
+			send: func(msgServer types.MsgServer, vault *types.VaultAccount) error {
+				_, err := msgServer.UpdateNAVChangeLimit(s.ctx, &types.MsgUpdateNAVChangeLimitRequest{
+					Authority:        s.adminAddr.String(),
+					VaultAddress:     vault.Address,
+					MaxNavChangeBips: 500,
+					NavApprover:      newAddr,
+				})
+				return err
+			},
+			applied: func(vault *types.VaultAccount) bool {
+				return vault.MaxNavChangeBips == 500 && vault.NavApprover == newAddr
+			},
+		},
+		{
+			name: "NAV sources",
+			send: func(msgServer types.MsgServer, vault *types.VaultAccount) error {
+				_, err := msgServer.UpdateNAVSources(s.ctx, &types.MsgUpdateNAVSourcesRequest{
+					Authority:              s.adminAddr.String(),
+					VaultAddress:           vault.Address,
+					NavSources:             []string{newAddr},
+					NavSourceQuorum:        1,
+					NavSourceMaxAgeSeconds: 3600,
+				})
+				return err
+			},
+			applied: func(vault *types.VaultAccount) bool {
+				return len(vault.NavSources) == 1 && vault.NavSources[0] == newAddr && vault.NavSourceQuorum == 1
+			},
+		},
+		{
+			name: "price sources",
+			send: func(msgServer types.MsgServer, vault *types.VaultAccount) error {
+				_, err := msgServer.UpdatePriceSources(s.ctx, &types.MsgUpdatePriceSourcesRequest{
+					Authority:    s.adminAddr.String(),
+					VaultAddress: vault.Address,
+					PriceSources: []types.PriceSourceType{types.PriceSourceType_PRICE_SOURCE_TYPE_MARKER_NAV},
+				})
+				return err
+			},
+			applied: func(vault *types.VaultAccount) bool {
+				return len(vault.PriceSources) == 1 && vault.PriceSources[0] == types.PriceSourceType_PRICE_SOURCE_TYPE_MARKER_NAV
+			},
+		},
+		{
+			name: "role grant",
+			send: func(msgServer types.MsgServer, vault *types.VaultAccount) error {
+				_, err := msgServer.GrantRole(s.ctx, &types.MsgGrantRoleRequest{
+					Authority:    s.adminAddr.String(),
+					VaultAddress: vault.Address,
+					Role:         types.VaultRole_VAULT_ROLE_SETTLEMENT_MANAGER,
+					Address:      newAddr,
+				})
+				return err
+			},
+			applied: func(vault *types.VaultAccount) bool {
+				return vault.HasRole(newAddr, types.VaultRole_VAULT_ROLE_SETTLEMENT_MANAGER)
+			},
+		},
+		{
+			name: "guardian revocation",
+			send: func(msgServer types.MsgServer, vault *types.VaultAccount) error {
+				current, err := s.k.GetVault(s.ctx, vault.GetAddress())
+				s.Require().NoError(err, "GetVault")
+				s.Require().NoError(s.k.AddRoleGrant(s.ctx, current, types.VaultRole_VAULT_ROLE_GUARDIAN, newAddr, s.adminAddr.String()), "AddRoleGrant guardian")
+				_, err = msgServer.RevokeRole(s.ctx, &types.MsgRevokeRoleRequest{
+					Authority:    s.adminAddr.String(),
+					VaultAddress: vault.Address,
+					Role:         types.VaultRole_VAULT_ROLE_GUARDIAN,
+					Address:      newAddr,
+				})
+				return err
+			},
+			applied: func(vault *types.VaultAccount) bool {
+				return !vault.HasRole(newAddr, types.VaultRole_VAULT_ROLE_GUARDIAN)
+			},
+		},
+		{
+			name: "admin transfer proposal",
+			send: func(msgServer types.MsgServer, vault *types.VaultAccount) error {
+				_, err := msgServer.ProposeAdminTransfer(s.ctx, &types.MsgProposeAdminTransferRequest{
+					Authority:    s.adminAddr.String(),
+					VaultAddress: vault.Address,
+					NewAdmin:     newAddr,
+				})
+				return err
+			},
+			applied: func(vault *types.VaultAccount) bool {
+				return vault.PendingAdminTransfer != nil && vault.PendingAdminTransfer.NewAdmin == newAddr
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+			vault := s.setupBaseVault(underlying, share)
+			msgServer := keeper.NewMsgServer(s.simApp.VaultKeeper)
+			s.setTimelock(vault, 3600)
+			scheduledAt := s.ctx.BlockTime()
+
+			s.ctx = s.ctx.WithEventManager(sdk.NewEventManager())
+			s.Require().NoError(tc.send(msgServer, vault), "sending the timelocked message")
+
+			actions := s.scheduledActions(vault)
+			s.Require().Len(actions, 1, "the message should be held as a scheduled action")
+			action := actions[0]
+			s.Assert().Equal(scheduledAt.Unix()+3600, action.ExecuteTime, "execute time")
+			s.requireTypedEventEmitted(types.NewEventActionScheduled(vault.Address, s.adminAddr.String(), action.Id, action.MsgTypeURL(), action.ExecuteTime))
+
+			got, err := s.k.GetVault(s.ctx, vault.GetAddress())
+			s.Require().NoError(err, "GetVault")
+			s.Assert().False(tc.applied(got), "the change should not apply before the timelock elapses")
+
+			s.ctx = s.ctx.WithBlockTime(scheduledAt.Add(time.Hour - time.Second))
+			s.Require().NoError(s.k.TestAccessor_handleScheduledActions(s.T(), s.ctx, keeper.MaxScheduledActionsPerBlock), "handleScheduledActions before delay")
+			got, err = s.k.GetVault(s.ctx, vault.GetAddress())
+			s.Require().NoError(err, "GetVault")
+			s.Assert().False(tc.applied(got), "the change should not apply one second before the timelock elapses")
+
+			s.ctx = s.ctx.WithBlockTime(scheduledAt.Add(time.Hour)).WithEventManager(sdk.NewEventManager())
+			s.Require().NoError(s.k.TestAccessor_handleScheduledActions(s.T(), s.ctx, keeper.MaxScheduledActionsPerBlock), "handleScheduledActions at delay")
+			got, err = s.k.GetVault(s.ctx, vault.GetAddress())
+			s.Require().NoError(err, "GetVault")
+			s.Assert().True(tc.applied(got), "the change should apply once the timelock elapses")
+			s.Require().NoError(got.Validate(), "vault should remain valid")
+			s.requireTypedEventEmitted(types.NewEventScheduledActionExecuted(vault.Address, action.Id, action.MsgTypeURL()))
+			s.Assert().Empty(s.scheduledActions(vault), "the executed action should leave the queue")
+		})
+	}
+}
+
+func (s *TestSuite) TestMsgServer_TimelockedNAVSourcesCannotRepriceBeforeDelay() {
+	underlying, share, held := "under", "vshare", "heldcoin"
+	s.SetupTest()
+	vault := s.setupStaleNAVVault(underlying, share, held, 0, false)
+	msgServer := keeper.NewMsgServer(s.simApp.VaultKeeper)
+	s.setTimelock(vault, 3600)
+	before, err := s.k.GetNetTVV(s.ctx, *vault)
+	s.Require().NoError(err, "GetNetTVV before")
+
+	_, err = msgServer.UpdateNAVChangeLimit(s.ctx, &types.MsgUpdateNAVChangeLimitRequest{
+		Authority:    s.adminAddr.String(),
+		VaultAddress: vault.Address,
+	})
+	s.Require().NoError(err, "UpdateNAVChangeLimit should be scheduled")
+	_, err = msgServer.UpdateNAVSources(s.ctx, &types.MsgUpdateNAVSourcesRequest{
+		Authority:              s.adminAddr.String(),
+		VaultAddress:           vault.Address,
+		NavSources:             []string{s.adminAddr.String()},
+		NavSourceQuorum:        1,
+		NavSourceMaxAgeSeconds: 3600,
+	})
+	s.Require().NoError(err, "UpdateNAVSources should be scheduled")
+	s.Assert().Len(s.scheduledActions(vault), 2, "both changes should be held as scheduled actions")
+
+	_, err = msgServer.SubmitSourceNAV(s.ctx, &types.MsgSubmitSourceNAVRequest{
+		Source:       s.adminAddr.String(),
+		VaultAddress: vault.Address,
+		Denom:        held,
+		Price:        sdk.NewInt64Coin(underlying, 1_000),
+		Volume:       sdkmath.NewInt(1),
+	})
+	s.Require().ErrorContains(err, "not a NAV source", "the admin should not be a NAV source before the timelock elapses")
+
+	after, err := s.k.GetNetTVV(s.ctx, *vault)
+	s.Require().NoError(err, "GetNetTVV after")
+	s.Assert().Equal(before.String(), after.String(), "the vault should still be priced by its NAV authority")
+}
+
+func (s *TestSuite) TestMsgServer_RevokeRole_NonGuardianNotTimelocked() {
+	underlying, share := "under", "vshare"
+	vault := s.setupBaseVault(underlying, share)
+	s.setTimelock(vault, 3600)
+
+	_, err := keeper.NewMsgServer(s.simApp.VaultKeeper).RevokeRole(s.ctx, &types.MsgRevokeRoleRequest{
+		Authority:    s.adminAddr.String(),
+		VaultAddress: vault.Address,
+		Role:         types.VaultRole_VAULT_ROLE_PAUSER,
+		Address:      s.adminAddr.String(),
+	})
+	s.Require().NoError(err, "RevokeRole")
+	got, err := s.k.GetVault(s.ctx, vault.GetAddress())
+	s.Require().NoError(err, "GetVault")
+	s.Assert().False(got.HasRole(s.adminAddr.String(), types.VaultRole_VAULT_ROLE_PAUSER), "revoking a non-guardian role should apply immediately")
+	s.Assert().Empty(s.scheduledActions(vault), "revoking a non-guardian role should not be scheduled")
+}
+
+func (s *TestSuite) TestMsgServer_UpdateTimelock() {
+	underlying, share := "under", "vshare"
+	admin := s.adminAddr.String()
+
+	vault := s.setupBaseVault(underlying, share)
+	msgServer := keeper.NewMsgServer(s.simApp.VaultKeeper)
+
+	_, err := msgServer.UpdateTimelock(s.ctx, &types.MsgUpdateTimelockRequest{
+		Authority:       s.assetManagerAddr.String(),
+		VaultAddress:    vault.Address,
+		TimelockSeconds: 3600,
+	})
+	s.Require().ErrorContains(err, "failed to validate admin", "only the admin may set the timelock")
+
+	s.ctx = s.ctx.WithEventManager(sdk.NewEventManager())
+	s.setTimelock(vault, 3600)
+	s.requireTypedEventEmitted(types.NewEventTimelockUpdated(vault.Address, admin, 3600))
+	s.setTimelock(vault, 7200)
+	got, err := s.k.GetVault(s.ctx, vault.GetAddress())
+	s.Require().NoError(err, "GetVault")
+	s.Assert().Equal(uint64(7200), got.TimelockSeconds, "raising the timelock should apply immediately")
+
+	s.setTimelock(vault, 0)
+	got, err = s.k.GetVault(s.ctx, vault.GetAddress())
+	s.Require().NoError(err, "GetVault")
+	s.Assert().Equal(uint64(7200), got.TimelockSeconds, "lowering the timelock should be held by the current timelock")
+	s.Require().Len(s.scheduledActions(vault), 1, "lowering the timelock should be scheduled")
+
+	s.ctx = s.ctx.WithBlockTime(s.ctx.BlockTime().Add(2 * time.Hour))
+	s.Require().NoError(s.k.TestAccessor_handleScheduledActions(s.T(), s.ctx, keeper.MaxScheduledActionsPerBlock), "handleScheduledActions")
+	got, err = s.k.GetVault(s.ctx, vault.GetAddress())
+	s.Require().NoError(err, "GetVault")
+	s.Assert().Zero(got.TimelockSeconds, "the lowered timelock should apply after the delay")
+}
+
+func (s *TestSuite) TestMsgServer_CancelScheduledAction() {
+	underlying, share := "under", "vshare"
+	admin := s.adminAddr.String()
+	guardian := sdk.AccAddress("guardianAddr________").String()
+	stranger := sdk.AccAddress("strangerAddr________").String()
+
+	tests := []struct {
+		name        string
+		authority   string
+		otherVault  bool
+		missingID   bool
+		expectedErr string
+	}{
+		{
+			name:      "admin cancels",
+			authority: admin,
+		},
+		{
+			name:      "guardian cancels",
+			authority: guardian,
+		},
+		{
+			name:        "stranger cannot cancel",
+			authority:   stranger,
+			expectedErr: "failed to validate admin or guardian",
+		},
+		{
+			name:        "unknown action",
+			authority:   admin,
+			missingID:   true,
+			expectedErr: "not found",
+		},
+		{
+			name:        "action of another vault",
+			authority:   admin,
+			otherVault:  true,
+			expectedErr: "does not belong to vault",
+		},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+			vault := s.setupBaseVault(underlying, share)
+			other := s.setupBaseVault("other", "vother")
+			msgServer := keeper.NewMsgServer(s.simApp.VaultKeeper)
+			_, err := msgServer.GrantRole(s.ctx, &types.MsgGrantRoleRequest{
+				Authority:    admin,
+				VaultAddress: vault.Address,
+				Role:         types.VaultRole_VAULT_ROLE_GUARDIAN,
+				Address:      guardian,
+			})
+			s.Require().NoError(err, "GrantRole guardian")
+
+			s.setTimelock(vault, 3600)
+			_, err = msgServer.UpdateWithdrawalDelay(s.ctx, &types.MsgUpdateWithdrawalDelayRequest{
+				Authority:              admin,
+				VaultAddress:           vault.Address,
+				WithdrawalDelaySeconds: 7200,
+			})
+			s.Require().NoError(err, "UpdateWithdrawalDelay")
+			actions := s.scheduledActions(vault)
+			s.Require().Len(actions, 1, "one scheduled action")
+			action := actions[0]
+
+			target, id := vault.Address, action.Id
+			if tc.otherVault {
+				target = other.Address
+			}
+			if tc.missingID {
+				id = action.Id + 100
+			}
+			s.ctx = s.ctx.WithEventManager(sdk.NewEventManager())
+			_, err = msgServer.CancelScheduledAction(s.ctx, &types.MsgCancelScheduledActionRequest{
+				Authority:    tc.authority,
+				VaultAddress: target,
+				ActionId:     id,
+			})
+			if tc.expectedErr != "" {
+				s.Require().ErrorContains(err, tc.expectedErr, "CancelScheduledAction error")
+				s.Assert().Len(s.scheduledActions(vault), 1, "the action should stay scheduled")
+				return
+			}
+			s.Require().NoError(err, "CancelScheduledAction")
+			s.requireTypedEventEmitted(types.NewEventScheduledActionCancelled(vault.Address, tc.authority, action.Id, action.MsgTypeURL()))
+			s.Assert().Empty(s.scheduledActions(vault), "the cancelled action should leave the queue")
+
+			s.ctx = s.ctx.WithBlockTime(s.ctx.BlockTime().Add(2 * time.Hour))
+			s.Require().NoError(s.k.TestAccessor_handleScheduledActions(s.T(), s.ctx, keeper.MaxScheduledActionsPerBlock), "handleScheduledActions")
+			got, err := s.k.GetVault(s.ctx, vault.GetAddress())
+			s.Require().NoError(err, "GetVault")
+			s.Assert().NotEqual(uint64(7200), got.WithdrawalDelaySeconds, "a cancelled action should never apply")
+		})
+	}
+}
+
+func (s *TestSuite) TestHandleScheduledActions_FailsWhenSignerLosesAuthority() {
+	underlying, share := "under", "vshare"
+	admin := s.adminAddr.String()
+	attacker := sdk.AccAddress("attackerAddr________").String()
+	recovered := sdk.AccAddress("recoveredAddr_______").String()
+
+	vault := s.setupBaseVault(underlying, share)
+	msgServer := keeper.NewMsgServer(s.simApp.VaultKeeper)
+	s.setTimelock(vault, 3600)
+
+	_, err := msgServer.SetBridgeAddress(s.ctx, &types.MsgSetBridgeAddressRequest{
+		Admin:         admin,
+		VaultAddress:  vault.Address,
+		BridgeAddress: attacker,
+	})
+	s.Require().NoError(err, "SetBridgeAddress")
+	actions := s.scheduledActions(vault)
+	s.Require().Len(actions, 1, "one scheduled action")
+	action := actions[0]
+
+	_, err = msgServer.RecoverVaultAdmin(s.ctx, &types.MsgRecoverVaultAdminRequest{
+		Authority:    s.govAuthority,
+		VaultAddress: vault.Address,
+		NewAdmin:     recovered,
+	})
+	s.Require().NoError(err, "RecoverVaultAdmin")
+
+	s.ctx = s.ctx.WithBlockTime(s.ctx.BlockTime().Add(time.Hour)).WithEventManager(sdk.NewEventManager())
+	s.Require().NoError(s.k.TestAccessor_handleScheduledActions(s.T(), s.ctx, keeper.MaxScheduledActionsPerBlock), "handleScheduledActions")
+
+	got, err := s.k.GetVault(s.ctx, vault.GetAddress())
+	s.Require().NoError(err, "GetVault")
+	s.Assert().Empty(got.BridgeAddress, "the replaced admin's change should not apply")
+	s.requireTypedEventEmitted(types.NewEventScheduledActionFailed(vault.Address, action.Id, action.MsgTypeURL(),
+		"failed to validate admin: unauthorized: "+admin+" is not the vault admin"))
+	s.Assert().Empty(s.scheduledActions(vault), "the failed action should be discarded")
+}
+
+func (s *TestSuite) TestHandleScheduledActions_BatchSize() {
+	underlying, share := "under", "vshare"
+	vault := s.setupBaseVault(underlying, share)
+	msgServer := keeper.NewMsgServer(s.simApp.VaultKeeper)
+	s.setTimelock(vault, 60)
+
+	for _, delay := range []uint64{100, 200, 300} {
+		_, err := msgServer.UpdateWithdrawalDelay(s.ctx, &types.MsgUpdateWithdrawalDelayRequest{
+			Authority:              s.adminAddr.String(),
+			VaultAddress:           vault.Address,
+			WithdrawalDelaySeconds: delay,
+		})
+		s.Require().NoError(err, "UpdateWithdrawalDelay %d", delay)
+	}
+
+	s.ctx = s.ctx.WithBlockTime(s.ctx.BlockTime().Add(time.Minute))
+	s.Require().NoError(s.k.TestAccessor_handleScheduledActions(s.T(), s.ctx, 2), "handleScheduledActions")
+	s.Assert().Len(s.scheduledActions(vault), 1, "only the batch size should be processed per call")
+	got, err := s.k.GetVault(s.ctx, vault.GetAddress())
+	s.Require().NoError(err, "GetVault")
+	s.Assert().Equal(uint64(200), got.WithdrawalDelaySeconds, "actions should execute in scheduling order")
+}
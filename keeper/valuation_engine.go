@@ -3,6 +3,7 @@ package keeper
 import (
 	"errors"
 	"fmt"
+	"math/big"
 
 	"github.com/provlabs/vault/types"
 	"github.com/provlabs/vault/utils"
@@ -29,8 +30,25 @@ var ErrInternalNAVNotFound = errors.New("internal NAV entry not found")
 var ErrInternalNAVPriceCycle = errors.New("internal NAV price chain contains a cycle")
 
 // UnitPriceFraction returns the unit price of srcDenom expressed in the vault's
-// underlying asset as an integer fraction (numerator, denominator), sourced
-// exclusively from the per-vault Internal NAV table.
+// underlying asset as an integer fraction (numerator, denominator). It is
+// UnitPriceFractionWithSource without the price source.
+func (k Keeper) UnitPriceFraction(ctx sdk.Context, srcDenom string, vault types.VaultAccount) (math.Int, math.Int, error) {
+	num, den, _, err := k.UnitPriceFractionWithSource(ctx, srcDenom, vault)
+	return num, den, err
+}
+
+// UnitPriceFractionWithSource returns the unit price of srcDenom expressed in the
+// vault's underlying asset as an integer fraction (numerator, denominator), along
+// with the price source that valued srcDenom.
+//
+// # Price sources
+//
+// Each denom is priced by the vault's price-source chain (see resolveNAV): the
+// sources in vault.price_sources are consulted in order and the first with a price
+// for the denom values it. An unset chain consults only the per-vault Internal NAV
+// table, and the marker NAV and exchange settlement sources only ever price in the
+// underlying asset, so the description below of the Internal NAV table applies to
+// every source.
 //
 // # Semantics
 //
@@ -50,14 +68,23 @@ var ErrInternalNAVPriceCycle = errors.New("internal NAV price chain contains a c
 //
 //	1 srcDenom = (price_0 * price_1 * …) / (volume_0 * volume_1 * …) underlying
 //
+// When the vault has registered NAV sources and a denom has at least
+// nav_source_quorum fresh source submissions, the aggregate of those submissions
+// (see AggregatedSourceNAV) replaces that denom's entry in the walk. The entry
+// must still exist: the NAV authority's price registers the denom and remains
+// the fallback until the quorum is met.
+//
 // Suitable for floor(x * num / den) integer arithmetic.
 //
 // Identity fast-path
-//   - If srcDenom == vault.UnderlyingAsset, returns (1, 1) without a lookup.
+//   - If srcDenom == vault.UnderlyingAsset, returns (1, 1) and the internal NAV
+//     source without a lookup.
 //
 // Errors
-//   - Wraps ErrInternalNAVNotFound when no entry exists for srcDenom on this
-//     vault. Callers should classify with errors.Is(err, ErrInternalNAVNotFound)
+//   - Wraps types.ErrStaleNAV when the only sources that price srcDenom reject their
+//     price as older than the vault's max_nav_age_seconds (see resolveNAV).
+//   - Wraps ErrInternalNAVNotFound when no source in the chain prices srcDenom on
+//     this vault. Callers should classify with errors.Is(err, ErrInternalNAVNotFound)
 //     rather than matching on the formatted error string.
 //   - Returns wrapped errors for any other Internal NAV lookup failure.
 //   - Defensive: rejects nav.Volume <= 0 or a negative nav.Price.Amount (these
@@ -75,22 +102,29 @@ var ErrInternalNAVPriceCycle = errors.New("internal NAV price chain contains a c
 // table was seeded, so it terminates even for state written outside SetVaultNAV's
 // accepted-denom validation. Under that validation real chains are a single hop
 // (srcDenom -> underlying).
-func (k Keeper) UnitPriceFraction(ctx sdk.Context, srcDenom string, vault types.VaultAccount) (math.Int, math.Int, error) {
+func (k Keeper) UnitPriceFractionWithSource(ctx sdk.Context, srcDenom string, vault types.VaultAccount) (math.Int, math.Int, types.PriceSourceType, error) {
 	num, den := math.NewInt(1), math.NewInt(1)
 	visited := make(map[string]struct{})
+	priceSource := types.PriceSourceType_PRICE_SOURCE_TYPE_INTERNAL_NAV
 
 	for denom := srcDenom; denom != vault.UnderlyingAsset; {
 		if _, seen := visited[denom]; seen {
-			return math.Int{}, math.Int{}, fmt.Errorf("price chain revisits denom %q on vault %s: %w", denom, vault.GetAddress(), ErrInternalNAVPriceCycle)
+			return math.Int{}, math.Int{}, priceSource, fmt.Errorf("price chain revisits denom %q on vault %s: %w", denom, vault.GetAddress(), ErrInternalNAVPriceCycle)
 		}
 		visited[denom] = struct{}{}
 
-		nav, err := k.GetVaultNAV(ctx, vault.GetAddress(), denom)
+		nav, sourceType, found, err := k.resolveNAV(ctx, vault, denom)
 		if err != nil {
-			if errors.Is(err, collections.ErrNotFound) {
-				return math.Int{}, math.Int{}, fmt.Errorf("no internal NAV entry for denom %q on vault %s: %w", denom, vault.GetAddress(), ErrInternalNAVNotFound)
+			return math.Int{}, math.Int{}, priceSource, err
+		}
+		if !found {
+			if !vault.UsesExternalPriceSources() {
+				return math.Int{}, math.Int{}, priceSource, fmt.Errorf("no internal NAV entry for denom %q on vault %s: %w", denom, vault.GetAddress(), ErrInternalNAVNotFound)
 			}
-			return math.Int{}, math.Int{}, fmt.Errorf("failed to get internal NAV for denom %q on vault %s: %w", denom, vault.GetAddress(), err)
+			return math.Int{}, math.Int{}, priceSource, fmt.Errorf("no price source prices denom %q on vault %s: %w", denom, vault.GetAddress(), ErrInternalNAVNotFound)
+		}
+		if denom == srcDenom {
+			priceSource = sourceType
 		}
 
 		if nav.Volume.IsNil() || !nav.Volume.IsPositive() {
@@ -103,7 +137,7 @@ func (k Keeper) UnitPriceFraction(ctx sdk.Context, srcDenom string, vault types.
 				"denom", denom,
 				"volume", volumeForLog,
 			)
-			return math.Int{}, math.Int{}, fmt.Errorf("internal NAV volume must be positive for denom %q on vault %s", denom, vault.GetAddress())
+			return math.Int{}, math.Int{}, priceSource, fmt.Errorf("internal NAV volume must be positive for denom %q on vault %s", denom, vault.GetAddress())
 		}
 		if nav.Price.Amount.IsNil() || nav.Price.Amount.IsNegative() {
 			priceForLog := "<nil>"
@@ -115,22 +149,22 @@ func (k Keeper) UnitPriceFraction(ctx sdk.Context, srcDenom string, vault types.
 				"denom", denom,
 				"price", priceForLog,
 			)
-			return math.Int{}, math.Int{}, fmt.Errorf("internal NAV price must not be negative for denom %q on vault %s", denom, vault.GetAddress())
+			return math.Int{}, math.Int{}, priceSource, fmt.Errorf("internal NAV price must not be negative for denom %q on vault %s", denom, vault.GetAddress())
 		}
 
 		num, err = num.SafeMul(nav.Price.Amount)
 		if err != nil {
-			return math.Int{}, math.Int{}, fmt.Errorf("failed to scale price chain numerator %s by NAV price %s for denom %q: %w", num, nav.Price.Amount, denom, err)
+			return math.Int{}, math.Int{}, priceSource, fmt.Errorf("failed to scale price chain numerator %s by NAV price %s for denom %q: %w", num, nav.Price.Amount, denom, err)
 		}
 		den, err = den.SafeMul(nav.Volume)
 		if err != nil {
-			return math.Int{}, math.Int{}, fmt.Errorf("failed to scale price chain denominator %s by NAV volume %s for denom %q: %w", den, nav.Volume, denom, err)
+			return math.Int{}, math.Int{}, priceSource, fmt.Errorf("failed to scale price chain denominator %s by NAV volume %s for denom %q: %w", den, nav.Volume, denom, err)
 		}
 
 		denom = nav.Price.Denom
 	}
 
-	return num, den, nil
+	return num, den, priceSource, nil
 }
 
 // ToUnderlyingAssetAmount converts an input coin into its value expressed in
@@ -164,7 +198,9 @@ func (k Keeper) ToUnderlyingAssetAmount(ctx sdk.Context, vault types.VaultAccoun
 //
 // Source of truth (when not paused):
 //   - TVV sums the balances held at the vault’s *principal* account, i.e. the marker
-//     address for vault.PrincipalMarkerAddress().
+//     address for vault.PrincipalMarkerAddress(), together with its payment escrow
+//     account, which holds the vault's side of its open outbound exchange payments
+//     (see heldBalance).
 //   - The vault account’s own balances are treated as *reserves* and are not included here.
 //
 // Computation (when not paused), valuing only the denoms the vault actually prices
@@ -174,6 +210,8 @@ func (k Keeper) ToUnderlyingAssetAmount(ctx sdk.Context, vault types.VaultAccoun
 //     (e.g. nft/scope… acquired via AcceptAsset) from its principal balance at its
 //     internal NAV. The underlying and the share denom are skipped here because the
 //     underlying is already counted above and the share denom is never priced.
+//   - Reduce each held denom's value by the vault's haircut for it, if any (see
+//     SetAssetHaircut).
 //   - Sum the converted amounts (floor at each multiplication/division step).
 //
 // Iterating the NAV table (a protocol-controlled key set written only by SetVaultNAV
@@ -182,6 +220,12 @@ func (k Keeper) ToUnderlyingAssetAmount(ctx sdk.Context, vault types.VaultAccoun
 // valued nor able to inflate the per-call work. This preserves the prior behavior in
 // which such denoms were skipped.
 //
+// A vault whose price-source chain includes the marker NAV or exchange settlement
+// sources can value denoms that have no internal NAV entry, so for those vaults the
+// principal's balances are iterated instead, and a held denom that no source prices
+// is skipped. A held denom whose only prices are stale is not skipped: the stale error
+// is returned.
+//
 // Because a held asset's internal NAV is set by the NAV authority, a vault's TVV
 // (and the interest/fee/share-price base derived from it) moves when that NAV is
 // updated — a deliberate economic/trust surface.
@@ -190,8 +234,27 @@ func (k Keeper) GetTVV(ctx sdk.Context, vault types.VaultAccount) (math.Int, err
 		return vault.PausedBalance.Amount, nil
 	}
 
-	principal := vault.PrincipalMarkerAddress()
-	total := k.BankKeeper.GetBalance(ctx, principal, vault.UnderlyingAsset).Amount
+	total := k.heldBalance(ctx, vault, vault.UnderlyingAsset).Amount
+
+	if vault.UsesExternalPriceSources() {
+		for _, balance := range k.heldBalances(ctx, vault) {
+			if balance.Denom == vault.TotalShares.Denom || balance.Denom == vault.UnderlyingAsset || balance.IsZero() {
+				continue
+			}
+			val, err := k.haircutHeldValue(ctx, vault, balance)
+			if err != nil {
+				if errors.Is(err, ErrInternalNAVNotFound) {
+					continue
+				}
+				return math.Int{}, err
+			}
+			total, err = total.SafeAdd(val)
+			if err != nil {
+				return math.Int{}, fmt.Errorf("failed to add balance %s to total vault value: %w", val, err)
+			}
+		}
+		return total, nil
+	}
 
 	navRange := collections.NewPrefixedPairRange[sdk.AccAddress, string](vault.GetAddress())
 	err := k.NAVs.Walk(ctx, navRange, func(key collections.Pair[sdk.AccAddress, string], _ types.VaultNAV) (bool, error) {
@@ -199,13 +262,13 @@ func (k Keeper) GetTVV(ctx sdk.Context, vault types.VaultAccount) (math.Int, err
 		if denom == vault.TotalShares.Denom || denom == vault.UnderlyingAsset {
 			return false, nil
 		}
-		balance := k.BankKeeper.GetBalance(ctx, principal, denom)
+		balance := k.heldBalance(ctx, vault, denom)
 		if balance.IsZero() {
 			return false, nil
 		}
-		val, err := k.ToUnderlyingAssetAmount(ctx, vault, balance)
+		val, err := k.haircutHeldValue(ctx, vault, balance)
 		if err != nil {
-			return true, fmt.Errorf("failed to convert held denom %q balance to underlying: %w", denom, err)
+			return true, err
 		}
 		total, err = total.SafeAdd(val)
 		if err != nil {
@@ -220,6 +283,36 @@ func (k Keeper) GetTVV(ctx sdk.Context, vault types.VaultAccount) (math.Int, err
 	return total, nil
 }
 
+// heldBalance returns the vault's holding of denom: its principal marker balance plus its
+// payment escrow account balance. The escrow holds the vault's side of its open outbound
+// exchange payments, and the proceeds of completed ones until they are swept back to the
+// principal, so both count as vault assets.
+func (k Keeper) heldBalance(ctx sdk.Context, vault types.VaultAccount, denom string) sdk.Coin {
+	held := k.BankKeeper.GetBalance(ctx, vault.PrincipalMarkerAddress(), denom)
+	return held.Add(k.BankKeeper.GetBalance(ctx, vault.PaymentEscrowAddress(), denom))
+}
+
+// heldBalances returns every balance the vault holds across its principal marker and its
+// payment escrow account. See heldBalance.
+func (k Keeper) heldBalances(ctx sdk.Context, vault types.VaultAccount) sdk.Coins {
+	held := k.BankKeeper.GetAllBalances(ctx, vault.PrincipalMarkerAddress())
+	return held.Add(k.BankKeeper.GetAllBalances(ctx, vault.PaymentEscrowAddress())...)
+}
+
+// haircutHeldValue converts a held balance to the underlying asset and reduces the
+// result by the vault's haircut for the balance's denom, flooring the result.
+func (k Keeper) haircutHeldValue(ctx sdk.Context, vault types.VaultAccount, balance sdk.Coin) (math.Int, error) {
+	val, err := k.ToUnderlyingAssetAmount(ctx, vault, balance)
+	if err != nil {
+		return math.Int{}, fmt.Errorf("failed to convert held denom %q balance to underlying: %w", balance.Denom, err)
+	}
+	haircutBips, err := k.GetAssetHaircut(ctx, vault.GetAddress(), balance.Denom)
+	if err != nil {
+		return math.Int{}, err
+	}
+	return types.ApplyHaircut(val, haircutBips), nil
+}
+
 // GetNetTVV returns the Total Vault Value (TVV) expressed in
 // vault.UnderlyingAsset, net of the vault's OutstandingAumFee liability.
 //
@@ -272,6 +365,47 @@ func (k Keeper) GetNAVPerShare(ctx sdk.Context, vault types.VaultAccount) (math.
 	return tvv.Quo(vault.TotalShares.Amount), nil
 }
 
+// GetShareUnit returns the share denom unit that share prices are quoted per, and its
+// exponent relative to the share base denom. It is the display unit from the share
+// denom's bank metadata when that metadata is set and lists its display unit, and the
+// share base denom (exponent 0) otherwise.
+func (k Keeper) GetShareUnit(ctx sdk.Context, vault types.VaultAccount) (string, uint32) {
+	metadata, found := k.BankKeeper.GetDenomMetaData(ctx, vault.TotalShares.Denom)
+	if found && metadata.Display != "" {
+		for _, unit := range metadata.DenomUnits {
+			if unit != nil && unit.Denom == metadata.Display {
+				return unit.Denom, unit.Exponent
+			}
+		}
+	}
+	return vault.TotalShares.Denom, 0
+}
+
+// NAVPerShareUnit returns value / totalShares scaled to 10^exponent shares, as a
+// decimal truncated to LegacyDec precision. It keeps the precision GetNAVPerShare's
+// integer floor loses once the ShareScalar multiplier makes each share worth a small
+// fraction of an underlying unit.
+//
+// With no shares outstanding it returns the initial parity price, at which a first
+// deposit mints ShareScalar shares per underlying unit. It returns an error when exponent
+// exceeds types.MaxShareUnitExponent or the scaled price exceeds the LegacyDec range.
+func NAVPerShareUnit(value, totalShares math.Int, exponent uint32) (math.LegacyDec, error) {
+	if exponent > types.MaxShareUnitExponent {
+		return math.LegacyDec{}, fmt.Errorf("share unit exponent %d exceeds the maximum of %d", exponent, types.MaxShareUnitExponent)
+	}
+	num := new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(exponent)+math.LegacyPrecision), nil)
+	den := utils.ShareScalar.BigInt()
+	if totalShares.IsPositive() {
+		num.Mul(num, value.BigInt())
+		den = totalShares.BigInt()
+	}
+	num.Quo(num, den)
+	if num.BitLen() > math.MaxBitLen {
+		return math.LegacyDec{}, fmt.Errorf("price of %s per %s shares at exponent %d exceeds the decimal range", value, totalShares, exponent)
+	}
+	return math.LegacyNewDecFromBigIntWithPrec(num, math.LegacyPrecision), nil
+}
+
 // ConvertDepositToShares converts a deposit in the vault's
 // underlying asset into the share amount it purchases, using the current net TVV
 // and total share supply (pro-rata, floor arithmetic). Callers validate the
@@ -364,6 +364,76 @@ func (s *TestSuite) TestGetTVV_IncludesUnderlyingPricedHeldAsset() {
 	s.Require().Equal(math.NewInt(115), tvv, "TVV should be 100 underlying + floor(10 * 3 / 2) = 115")
 }
 
+func (s *TestSuite) TestGetTVV_AppliesAssetHaircut() {
+	underlyingDenom := "ylds"
+	heldDenom := "usdc"
+	shareDenom := "vshare"
+
+	tests := []struct {
+		name        string
+		haircutBips uint32
+		expectTVV   int64
+	}{
+		{name: "no haircut values the held asset in full", haircutBips: 0, expectTVV: 1_005},
+		{name: "haircut reduces the held asset value", haircutBips: 2_500, expectTVV: 1_003},
+		{name: "haircut floors the reduced value", haircutBips: 1, expectTVV: 1_004},
+		{name: "full haircut values the held asset at zero", haircutBips: types.MaxHaircutBips, expectTVV: 1_000},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+			vault := s.setupHeldAssetVault(underlyingDenom, shareDenom, heldDenom, 1, 2)
+			s.Require().NoError(FundAccount(s.ctx, s.simApp.BankKeeper, vault.PrincipalMarkerAddress(), sdk.NewCoins(
+				sdk.NewInt64Coin(underlyingDenom, 1000),
+				sdk.NewInt64Coin(heldDenom, 10),
+			)), "should fund principal with underlying and held-asset coins")
+			s.Require().NoError(s.k.SetAssetHaircut(s.ctx, vault, heldDenom, tc.haircutBips, s.adminAddr.String()), "SetAssetHaircut should succeed")
+
+			tvv, err := s.k.GetTVV(s.ctx, *vault)
+			s.Require().NoError(err, "GetTVV should succeed")
+			s.Assert().Equal(math.NewInt(tc.expectTVV), tvv, "1000 ylds + 10 usdc at 1/2 less a %d bips haircut", tc.haircutBips)
+		})
+	}
+}
+
+func (s *TestSuite) TestGetTVV_AppliesAssetHaircutToExternallyPricedAsset() {
+	underlyingDenom := "ylds"
+	shareDenom := "vshare"
+	loanDenom := "loancoin"
+	vault := s.setupBaseVault(underlyingDenom, shareDenom)
+	s.requireSimpleMarker(loanDenom)
+	s.setSettlementPrice(vault, loanDenom, sdk.NewInt64Coin(underlyingDenom, 4), 1)
+	s.setPriceSources(vault, types.PriceSourceType_PRICE_SOURCE_TYPE_INTERNAL_NAV, types.PriceSourceType_PRICE_SOURCE_TYPE_EXCHANGE_SETTLEMENT)
+	s.Require().NoError(FundAccount(s.ctx, s.simApp.BankKeeper, vault.PrincipalMarkerAddress(), sdk.NewCoins(sdk.NewInt64Coin(loanDenom, 100))),
+		"should fund principal with %s", loanDenom)
+	s.Require().NoError(s.k.SetAssetHaircut(s.ctx, vault, loanDenom, 5_000, s.adminAddr.String()), "SetAssetHaircut should succeed")
+
+	tvv, err := s.k.GetTVV(s.ctx, *vault)
+	s.Require().NoError(err, "GetTVV should succeed")
+	s.Assert().Equal(math.NewInt(200), tvv, "100 loancoin at 4 less a 50% haircut")
+}
+
+func (s *TestSuite) TestConvertDepositToShares_HaircutRaisesSharesMinted() {
+	underlyingDenom := "ylds"
+	heldDenom := "usdc"
+	shareDenom := "vshare"
+	vault := s.setupHeldAssetVault(underlyingDenom, shareDenom, heldDenom, 1, 1)
+	s.Require().NoError(FundAccount(s.ctx, s.simApp.BankKeeper, vault.PrincipalMarkerAddress(), sdk.NewCoins(sdk.NewInt64Coin(heldDenom, 1_000))),
+		"should fund principal with held asset")
+	vault.TotalShares = sdk.NewCoin(shareDenom, math.NewInt(1_000).Mul(utils.ShareScalar))
+	s.Require().NoError(s.k.SetVaultAccount(s.ctx, vault), "should set vault shares")
+	deposit := sdk.NewInt64Coin(underlyingDenom, 100)
+
+	fullShares, err := s.k.ConvertDepositToShares(s.ctx, *vault, deposit)
+	s.Require().NoError(err, "conversion without a haircut should succeed")
+	s.Require().NoError(s.k.SetAssetHaircut(s.ctx, vault, heldDenom, 5_000, s.adminAddr.String()), "SetAssetHaircut should succeed")
+	haircutShares, err := s.k.ConvertDepositToShares(s.ctx, *vault, deposit)
+	s.Require().NoError(err, "conversion with a haircut should succeed")
+
+	s.Assert().True(haircutShares.Amount.GT(fullShares.Amount), "a haircut on the held asset should raise the shares a deposit mints: %s vs %s", haircutShares, fullShares)
+}
+
 func (s *TestSuite) TestGetTVV_SkipsHeldAssetWithoutNAV() {
 	underlyingDenom := "ylds"
 	shareDenom := "vshare"
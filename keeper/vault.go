@@ -250,6 +250,14 @@ func (k *Keeper) SwapIn(ctx sdk.Context, vaultAddr, recipient sdk.AccAddress, as
 		return nil, fmt.Errorf("failed to validate asset: %w", err)
 	}
 
+	if err = k.requireEligible(ctx, vault, recipient); err != nil {
+		return nil, err
+	}
+
+	if err = k.requireFreshNAVs(ctx, vault); err != nil {
+		return nil, err
+	}
+
 	accept, reason, err := k.AllowSwapInAmount(ctx, asset, *vault)
 	if err != nil {
 		return nil, fmt.Errorf("swap in amount not allowed: %w", err)
@@ -262,6 +270,14 @@ func (k *Keeper) SwapIn(ctx sdk.Context, vaultAddr, recipient sdk.AccAddress, as
 		return nil, fmt.Errorf("failed to reconcile vault: %w", err)
 	}
 
+	if err = k.requireWithinPositionCap(ctx, vault, recipient, asset); err != nil {
+		return nil, err
+	}
+
+	if err = k.requireWithinCapacity(ctx, vault, asset); err != nil {
+		return nil, err
+	}
+
 	principalAddress := vault.PrincipalMarkerAddress()
 
 	shares, err := k.ConvertDepositToShares(ctx, *vault, asset)
@@ -339,6 +355,10 @@ func (k *Keeper) SwapOut(ctx sdk.Context, vaultAddr, owner sdk.AccAddress, share
 		return 0, fmt.Errorf("swap out denom must be share denom %v : %v", shares.Denom, vault.TotalShares.Denom)
 	}
 
+	if err = k.requireFreshNAVs(ctx, vault); err != nil {
+		return 0, err
+	}
+
 	assets, err := k.ConvertSharesToRedeemCoin(ctx, *vault, shares.Amount)
 	if err != nil {
 		return 0, fmt.Errorf("failed to calculate assets from shares: %w", err)
@@ -470,6 +490,29 @@ func (k Keeper) ValidateInterestRateLimits(minRateStr, maxRateStr string) error
 	return nil
 }
 
+// SetVaultBridgeAddress sets the single external address allowed to mint or burn the vault's
+// shares and emits an EventBridgeAddressSet.
+func (k *Keeper) SetVaultBridgeAddress(ctx sdk.Context, vault *types.VaultAccount, bridgeAddress, admin string) error {
+	vault.BridgeAddress = bridgeAddress
+	if err := k.SetVaultAccount(ctx, vault); err != nil {
+		return fmt.Errorf("failed to set vault account: %w", err)
+	}
+	k.emitEvent(ctx, types.NewEventBridgeAddressSet(vault.Address, admin, bridgeAddress))
+	return nil
+}
+
+// SetVaultAssetManager sets or clears the vault's asset manager, moving the asset manager
+// roles from the previous asset manager to the new one, and emits an EventAssetManagerSet.
+func (k *Keeper) SetVaultAssetManager(ctx sdk.Context, vault *types.VaultAccount, assetManager, admin string) error {
+	k.moveRoles(ctx, vault, types.AssetManagerRoles(), vault.AssetManager, assetManager, admin)
+	vault.AssetManager = assetManager
+	if err := k.SetVaultAccount(ctx, vault); err != nil {
+		return fmt.Errorf("failed to set vault account: %w", err)
+	}
+	k.emitEvent(ctx, types.NewEventAssetManagerSet(vault.Address, admin, assetManager))
+	return nil
+}
+
 func (k *Keeper) SetWithdrawalDelay(ctx sdk.Context, vault *types.VaultAccount, delaySeconds uint64, authority string) error {
 	if vault.WithdrawalDelaySeconds == delaySeconds {
 		return nil
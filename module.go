@@ -38,7 +38,10 @@ import (
 // v1->v2 migration handler is registered in RegisterServices so the SDK module
 // manager can drive the migration via RunMigrations when an upstream upgrade
 // handler advances the chain.
-const ConsensusVersion = 2
+//
+// Bumped from 2 to 3 to accompany Migrator.Migrate2to3, which seeds every vault's
+// role table from its admin, asset manager and NAV authority fields.
+const ConsensusVersion = 3
 
 var (
 	_ module.AppModuleBasic      = AppModule{}
@@ -156,6 +159,9 @@ func (m AppModule) RegisterServices(cfg module.Configurator) {
 	if err := cfg.RegisterMigration(types.ModuleName, 1, migrator.Migrate1to2); err != nil {
 		panic(fmt.Sprintf("failed to register %s v1->v2 migration: %v", types.ModuleName, err))
 	}
+	if err := cfg.RegisterMigration(types.ModuleName, 2, migrator.Migrate2to3); err != nil {
+		panic(fmt.Sprintf("failed to register %s v2->v3 migration: %v", types.ModuleName, err))
+	}
 }
 
 // Proto field names referenced by the AutoCLI positional argument descriptors.
@@ -298,7 +304,7 @@ func (AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 					RpcMethod: "UpdateInterestRate",
 					Use:       "update-interest-rate [authority] [vault_address] [new_rate]",
 					Alias:     []string{"uir"},
-					Short:     "Update the vault's current/desired annual interest rate (admin or asset manager)",
+					Short:     "Update the vault's current/desired annual interest rate (rate-setter role)",
 					Long:      "Update the current or target annual interest rate for a vault. Rate is a decimal (e.g. 0.05 for 5%).",
 					Example:   fmt.Sprintf("%s update-interest-rate %s %s 0.05", txStart, exampleAuthorityAddr, exampleVaultAddr),
 					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
@@ -389,7 +395,7 @@ func (AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 					RpcMethod: "DepositInterestFunds",
 					Use:       "deposit-interest-funds [authority] [vault_address] [amount]",
 					Alias:     []string{"dif"},
-					Short:     "Deposit funds into a vault for paying interest (admin or asset manager)",
+					Short:     "Deposit funds into a vault for paying interest (reserve-funder role)",
 					Long:      "Deposit coins used exclusively for interest payouts. Amount is one or more coins, e.g. 5000nhash.",
 					Example:   fmt.Sprintf("%s deposit-interest-funds %s %s 5000nhash", txStart, exampleAuthorityAddr, exampleVaultAddr),
 					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
@@ -402,7 +408,7 @@ func (AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 					RpcMethod: "WithdrawInterestFunds",
 					Use:       "withdraw-interest-funds [authority] [vault_address] [amount]",
 					Alias:     []string{"wif"},
-					Short:     "Withdraw unused interest funds (admin or asset manager)",
+					Short:     "Withdraw unused interest funds (reserve-funder role)",
 					Long:      "Withdraw coins from the vault’s interest pool that are not allocated or required for current payouts.",
 					Example:   fmt.Sprintf("%s withdraw-interest-funds %s %s 1000nhash", txStart, exampleAuthorityAddr, exampleVaultAddr),
 					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
@@ -415,7 +421,7 @@ func (AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 					RpcMethod: "DepositPrincipalFunds",
 					Use:       "deposit-principal-funds [authority] [vault_address] [amount]",
 					Alias:     []string{"dpf"},
-					Short:     "Deposit principal funds into the vault’s marker (admin or asset manager)",
+					Short:     "Deposit principal funds into the vault’s marker (reserve-funder role)",
 					Long:      "Deposit principal coins managed by the vault. These funds back redemptions and operations per policy.",
 					Example:   fmt.Sprintf("%s deposit-principal-funds %s %s 100000nhash", txStart, exampleAuthorityAddr, exampleVaultAddr),
 					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
@@ -428,7 +434,7 @@ func (AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 					RpcMethod: "WithdrawPrincipalFunds",
 					Use:       "withdraw-principal-funds [authority] [vault_address] [amount]",
 					Alias:     []string{"wpf"},
-					Short:     "Withdraw principal funds from the vault’s marker (admin or asset manager)",
+					Short:     "Withdraw principal funds from the vault’s marker (reserve-funder role)",
 					Long:      "Withdraw principal coins managed by the vault. This reduces available backing for redemptions.",
 					Example:   fmt.Sprintf("%s withdraw-principal-funds %s %s 10000nhash", txStart, exampleAuthorityAddr, exampleVaultAddr),
 					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
@@ -441,7 +447,7 @@ func (AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 					RpcMethod: "ExpeditePendingSwapOut",
 					Use:       "expedite-pending-swap-out [authority] [request_id]",
 					Alias:     []string{"epso"},
-					Short:     "Expedite a pending swap out (admin or asset manager)",
+					Short:     "Expedite a pending swap out (limits-manager role)",
 					Long:      "Prioritize a pending swap-out request to be processed sooner if policy permits.",
 					Example:   fmt.Sprintf("%s expedite-pending-swap-out %s 1", txStart, exampleAuthorityAddr),
 					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
@@ -453,7 +459,7 @@ func (AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 					RpcMethod: "PauseVault",
 					Use:       "pause [authority] [vault_address] [reason]",
 					Alias:     []string{"pv"},
-					Short:     "Pause a vault (admin or asset manager), disabling user-facing operations",
+					Short:     "Pause a vault (pauser role), disabling user-facing operations",
 					Long:      "Pause user-facing operations such as swap-in and swap-out. Provide a short reason string.",
 					Example:   fmt.Sprintf("%s pause %s %s 'rebalancing collateral'", txStart, exampleAuthorityAddr, exampleVaultAddr),
 					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
@@ -466,7 +472,7 @@ func (AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 					RpcMethod: "UnpauseVault",
 					Use:       "unpause [authority] [vault_address]",
 					Alias:     []string{"upv"},
-					Short:     "Unpause a vault (admin or asset manager)",
+					Short:     "Unpause a vault (pauser role)",
 					Long:      "Resume user-facing operations after a pause.",
 					Example:   fmt.Sprintf("%s unpause %s %s", txStart, exampleAuthorityAddr, exampleVaultAddr),
 					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
@@ -531,7 +537,7 @@ func (AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 					Use:       "set-asset-manager [admin] [vault_address] [asset_manager]",
 					Alias:     []string{"sam"},
 					Short:     "Set or clear the asset manager address for a vault (pass empty string to clear)",
-					Long:      "Configure an asset manager with authority over specific admin actions. Pass empty string to clear.",
+					Long:      "Configure an asset manager with authority over specific admin actions. The asset manager roles move from the previous asset manager to the new one. Pass empty string to clear.",
 					Example:   fmt.Sprintf("%s set-asset-manager %s %s %s", txStart, exampleAdminAddr, exampleVaultAddr, exampleAssetMgrAddr),
 					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
 						{ProtoField: fieldAdmin},
@@ -545,7 +551,7 @@ func (AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 					Alias:     []string{"up"},
 					Short:     "Update module parameters",
 					Long:      "Update the module-level parameters. Requires governance authority.",
-					Example:   fmt.Sprintf("%s update-params %s '{\"tech_fee_address\":\"%s\",\"default_aum_fee_bips\":15}'", txStart, exampleAuthorityAddr, exampleAuthorityAddr),
+					Example:   fmt.Sprintf("%s update-params %s '{\"tech_fee_address\":\"%s\",\"default_aum_fee_bips\":15,\"nav_history_retention_seconds\":\"7776000\"}'", txStart, exampleAuthorityAddr, exampleAuthorityAddr),
 					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
 						{ProtoField: fieldAuthority},
 						{ProtoField: "params"},
@@ -569,7 +575,7 @@ func (AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 					Use:       "update-vault-nav [signer] [vault_address] [denom] [price] [volume] [source]",
 					Alias:     []string{"uvn"},
 					Short:     "Create or update a vault's internal NAV entry for a denom",
-					Long:      "Set the internal net asset value for a denom on a vault. The denom need not be held yet; pricing it is what authorizes the asset manager to acquire it. price is the total value of volume units, denominated in the vault's underlying asset. Must be signed by the vault's NAV authority. The denom cannot be the vault's share denom or underlying asset.",
+					Long:      "Set the internal net asset value for a denom on a vault. The denom need not be held yet; pricing it is what authorizes the asset manager to acquire it. price is the total value of volume units, denominated in the vault's underlying asset. Must be signed by a holder of the vault's nav-authority role. The denom cannot be the vault's share denom or underlying asset.",
 					Example:   fmt.Sprintf("%s update-vault-nav %s %s usdc 1000000nhash 1000000 my-oracle", txStart, exampleAuthorityAddr, exampleVaultAddr),
 					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
 						{ProtoField: fieldSigner},
@@ -585,7 +591,7 @@ func (AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 					Use:       "remove-vault-nav [signer] [vault_address] [denom]",
 					Alias:     []string{"rvn"},
 					Short:     "Remove a vault's internal NAV entry for a denom the vault does not hold",
-					Long:      "Delete the internal net asset value entry for a denom, revoking the authorization to acquire it at that price. Must be signed by the vault's NAV authority. A denom the vault still holds cannot be removed; write it down with update-vault-nav instead.",
+					Long:      "Delete the internal net asset value entry for a denom, revoking the authorization to acquire it at that price. Must be signed by a holder of the vault's nav-authority role. A denom the vault still holds cannot be removed; write it down with update-vault-nav instead.",
 					Example:   fmt.Sprintf("%s remove-vault-nav %s %s usdc", txStart, exampleAuthorityAddr, exampleVaultAddr),
 					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
 						{ProtoField: fieldSigner},
@@ -606,6 +612,445 @@ func (AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 						{ProtoField: "new_authority"},
 					},
 				},
+				{
+					RpcMethod: "UpdateNAVStalenessLimit",
+					Use:       "update-nav-staleness-limit [authority] [vault_address] [max_nav_age_seconds] [auto_pause_on_stale_nav]",
+					Alias:     []string{"unsl"},
+					Short:     "Set the maximum age of a vault's internal NAV entries",
+					Long:      "Set how old (in seconds) a held denom's internal NAV may become before swap-ins, swap-outs, and asset settlements are blocked. A limit of 0 disables the check. When auto_pause_on_stale_nav is true, a stale NAV also pauses the vault at the end of the block. Must be signed by the vault admin.",
+					Example:   fmt.Sprintf("%s update-nav-staleness-limit %s %s 86400 true", txStart, exampleAdminAddr, exampleVaultAddr),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: fieldAuthority},
+						{ProtoField: fieldVaultAddress},
+						{ProtoField: "max_nav_age_seconds"},
+						{ProtoField: "auto_pause_on_stale_nav", Optional: true},
+					},
+				},
+				{
+					RpcMethod: "UpdateNAVChangeLimit",
+					Use:       "update-nav-change-limit [authority] [vault_address] [max_nav_change_bips] [nav_approver]",
+					Alias:     []string{"uncl"},
+					Short:     "Set the largest single-update move of a vault's internal NAV",
+					Long:      "Set the largest move, in basis points of the current price, that the NAV authority may apply to a held denom's internal NAV in one update. Larger updates are held as pending proposals until nav_approver confirms them. A limit of 0 disables the guardrail; nav_approver is required otherwise. Must be signed by the vault admin.",
+					Example:   fmt.Sprintf("%s update-nav-change-limit %s %s 500 %s", txStart, exampleAdminAddr, exampleVaultAddr, exampleAuthorityAddr),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: fieldAuthority},
+						{ProtoField: fieldVaultAddress},
+						{ProtoField: "max_nav_change_bips"},
+						{ProtoField: "nav_approver", Optional: true},
+					},
+				},
+				{
+					RpcMethod: "UpdateSettlementTolerance",
+					Use:       "update-settlement-tolerance [authority] [vault_address] [settlement_tolerance_bips]",
+					Alias:     []string{"ust"},
+					Short:     "Set how far asset settlements may trade from a vault's internal NAV",
+					Long:      "Set the band, in basis points of the asset leg's NAV-implied value, within which an asset settlement's payment leg may deviate from the vault's internal NAV. A tolerance of 0 requires an exact match. With --update-nav-on-settlement, a single-denom settlement that trades away from NAV marks the denom's internal NAV to its price, unless the move exceeds max_nav_change_bips. Must be signed by the vault admin.",
+					Example:   fmt.Sprintf("%s update-settlement-tolerance %s %s 50 --update-nav-on-settlement", txStart, exampleAdminAddr, exampleVaultAddr),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: fieldAuthority},
+						{ProtoField: fieldVaultAddress},
+						{ProtoField: "settlement_tolerance_bips"},
+					},
+				},
+				{
+					RpcMethod: "ApproveNAVProposal",
+					Use:       "approve-nav-proposal [approver] [vault_address] [denom]",
+					Alias:     []string{"anp"},
+					Short:     "Approve a pending NAV proposal for a vault",
+					Long:      "Apply a pending internal NAV update that exceeded the vault's max_nav_change_bips. Must be signed by the vault's NAV approver, who cannot approve a proposal it submitted.",
+					Example:   fmt.Sprintf("%s approve-nav-proposal %s %s usdc", txStart, exampleAuthorityAddr, exampleVaultAddr),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "approver"},
+						{ProtoField: fieldVaultAddress},
+						{ProtoField: fieldDenom},
+					},
+				},
+				{
+					RpcMethod: "UpdateNAVSources",
+					Use:       "update-nav-sources [authority] [vault_address] [nav_source_quorum] [nav_source_max_age_seconds] [nav_sources...]",
+					Alias:     []string{"uns"},
+					Short:     "Set a vault's independent NAV sources and how their prices are aggregated",
+					Long:      "Replace the set of NAV sources allowed to submit per-source prices for a vault. Once nav_source_quorum submissions for a denom are no older than nav_source_max_age_seconds, their median (or minimum, with --nav-aggregation min) is used for valuation in place of the NAV authority's entry. A quorum of 0 disables aggregation. Submissions from removed sources are discarded. Must be signed by the vault admin.",
+					Example:   fmt.Sprintf("%s update-nav-sources %s %s 2 3600 %s %s --nav-aggregation median", txStart, exampleAdminAddr, exampleVaultAddr, exampleAuthorityAddr, exampleAssetMgrAddr),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: fieldAuthority},
+						{ProtoField: fieldVaultAddress},
+						{ProtoField: "nav_source_quorum"},
+						{ProtoField: "nav_source_max_age_seconds"},
+						{ProtoField: "nav_sources", Varargs: true},
+					},
+				},
+				{
+					RpcMethod: "SubmitSourceNAV",
+					Use:       "submit-source-nav [source] [vault_address] [denom] [price] [volume]",
+					Alias:     []string{"ssn"},
+					Short:     "Submit a NAV source's price for a vault denom",
+					Long:      "Record a NAV source's price for a denom in the vault's per-source table. The price is the total value of volume units of the denom, denominated in the vault's underlying asset. Must be signed by one of the vault's NAV sources.",
+					Example:   fmt.Sprintf("%s submit-source-nav %s %s usdc 1000000nhash 1000000", txStart, exampleAuthorityAddr, exampleVaultAddr),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "source"},
+						{ProtoField: fieldVaultAddress},
+						{ProtoField: fieldDenom},
+						{ProtoField: "price"},
+						{ProtoField: "volume"},
+					},
+				},
+				{
+					RpcMethod: "BatchUpdateVaultNAV",
+					Use:       "batch-update-vault-nav [signer] [vault_address] [updates...]",
+					Alias:     []string{"buvn"},
+					Short:     "Create or update many of a vault's internal NAV entries at once",
+					Long:      "Set the internal net asset value for many denoms on a vault in one message, reconciling the vault once. Each update is a JSON object with denom, price, volume, and an optional source, validated exactly as update-vault-nav validates its arguments; any invalid update rejects the whole batch. Updates exceeding the vault's max_nav_change_bips are held as pending NAV proposals. Must be signed by a holder of the vault's nav-authority role.",
+					Example:   fmt.Sprintf(`%s batch-update-vault-nav %s %s '{"denom":"usdc","price":{"denom":"nhash","amount":"1000000"},"volume":"1000000"}' '{"denom":"usdt","price":{"denom":"nhash","amount":"999000"},"volume":"1000000","source":"my-oracle"}'`, txStart, exampleAuthorityAddr, exampleVaultAddr),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: fieldSigner},
+						{ProtoField: fieldVaultAddress},
+						{ProtoField: "updates", Varargs: true},
+					},
+				},
+				{
+					RpcMethod: "UpdatePriceSources",
+					Use:       "update-price-sources [authority] [vault_address] [price_sources...]",
+					Alias:     []string{"ups"},
+					Short:     "Set the ordered price sources that value a vault's holdings",
+					Long:      "Replace the chain of price sources consulted, in order, to value each denom the vault holds: internal-nav (the vault's internal NAV table), marker-nav (the marker module's net asset value in the underlying asset), and exchange-settlement (the most recent exchange settlement of the denom against the underlying asset). The first source with a price values the denom. Omitting every source restores the default of internal-nav only. Must be signed by the vault admin.",
+					Example:   fmt.Sprintf("%s update-price-sources %s %s internal-nav marker-nav exchange-settlement", txStart, exampleAdminAddr, exampleVaultAddr),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: fieldAuthority},
+						{ProtoField: fieldVaultAddress},
+						{ProtoField: "price_sources", Varargs: true},
+					},
+				},
+				{
+					RpcMethod: "UpdateAssetHaircut",
+					Use:       "update-asset-haircut [signer] [vault_address] [denom] [haircut_bips]",
+					Alias:     []string{"uah"},
+					Short:     "Set the valuation haircut a vault applies to a held denom",
+					Long:      "Set the haircut, in basis points, applied to a held denom's value when computing the vault's total value, and so its share price. 10000 values the denom at zero; 0 removes the haircut. The underlying asset and share denom cannot carry a haircut. Must be signed by a holder of the vault's nav-authority role.",
+					Example:   fmt.Sprintf("%s update-asset-haircut %s %s usdc 250", txStart, exampleAuthorityAddr, exampleVaultAddr),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: fieldSigner},
+						{ProtoField: fieldVaultAddress},
+						{ProtoField: "denom"},
+						{ProtoField: "haircut_bips"},
+					},
+				},
+				{
+					RpcMethod: "BatchAcceptAssets",
+					Use:       "batch-accept-assets [authority] [vault_address] [payments...]",
+					Alias:     []string{"baa"},
+					Short:     "Settle many pending exchange payments targeting a vault at once",
+					Long:      "Settle many pending exchange-module payments whose target is the vault in one message, reconciling the vault once. Each payment is a JSON object with source and external_id, checked against the vault's internal NAV exactly as an individual settlement is, and the principal must cover every payment's target amount before the first settles. By default any payment that cannot be settled rejects the whole batch; with --best-effort, failed payments are reported and left pending. Must be signed by a holder of the vault's settlement-manager role.",
+					Example:   fmt.Sprintf(`%s batch-accept-assets %s %s '{"source":"%s","external_id":"loan-1"}' '{"source":"%s","external_id":"loan-2"}' --best-effort`, txStart, exampleAssetMgrAddr, exampleVaultAddr, exampleOwnerAddr, exampleOwnerAddr),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: fieldAuthority},
+						{ProtoField: fieldVaultAddress},
+						{ProtoField: "payments", Varargs: true},
+					},
+				},
+				{
+					RpcMethod: "CreateAssetPayment",
+					Use:       "create-asset-payment [authority] [vault_address] [target] [source_amount] [target_amount] [external_id] [expiration_seconds]",
+					Alias:     []string{"cap"},
+					Short:     "Offer a vault asset trade to a counterparty as an exchange payment",
+					Long:      "Create an exchange-module payment from the vault's payment escrow account to the target. Exactly one leg must carry the vault's underlying asset, and the asset leg must match the vault's internal NAV of each of its denoms exactly. The source amount is moved from the principal marker into escrow until the payment is accepted, rejected, cancelled, or expires, after which the escrow is swept back to the principal. Must be signed by a holder of the vault's settlement-manager role.",
+					Example:   fmt.Sprintf(`%s create-asset-payment %s %s %s 1usdc 1000000nhash loan-sale-1 86400`, txStart, exampleAssetMgrAddr, exampleVaultAddr, exampleOwnerAddr),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: fieldAuthority},
+						{ProtoField: fieldVaultAddress},
+						{ProtoField: "target"},
+						{ProtoField: "source_amount"},
+						{ProtoField: "target_amount"},
+						{ProtoField: "external_id"},
+						{ProtoField: "expiration_seconds"},
+					},
+				},
+				{
+					RpcMethod: "CancelAssetPayment",
+					Use:       "cancel-asset-payment [authority] [vault_address] [external_id]",
+					Alias:     []string{"cnap"},
+					Short:     "Cancel an open vault-created exchange payment",
+					Long:      "Cancel one of the vault's open exchange-module payments and sweep its escrowed source amount back to the principal marker. Allowed while the vault is paused. Must be signed by a holder of the vault's settlement-manager role.",
+					Example:   fmt.Sprintf(`%s cancel-asset-payment %s %s loan-sale-1`, txStart, exampleAssetMgrAddr, exampleVaultAddr),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: fieldAuthority},
+						{ProtoField: fieldVaultAddress},
+						{ProtoField: "external_id"},
+					},
+				},
+				{
+					RpcMethod: "ExpireAssetPayment",
+					Use:       "expire-asset-payment [signer] [vault_address] [external_id]",
+					Alias:     []string{"eap"},
+					Short:     "Close a vault-created exchange payment past its expiry",
+					Long:      "Cancel one of the vault's exchange-module payments once its expiry time has passed and sweep its escrowed source amount back to the principal marker. Anyone may sign.",
+					Example:   fmt.Sprintf(`%s expire-asset-payment %s %s loan-sale-1`, txStart, exampleOwnerAddr, exampleVaultAddr),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: fieldSigner},
+						{ProtoField: fieldVaultAddress},
+						{ProtoField: "external_id"},
+					},
+				},
+				{
+					RpcMethod: "UpdateAcquisitionPolicy",
+					Use:       "update-acquisition-policy [authority] [vault_address]",
+					Alias:     []string{"uap"},
+					Short:     "Restrict which assets a vault may price and acquire",
+					Long:      "Replace the vault's acquisition policy. denom_patterns lists the denoms the vault may newly price or acquire, each an exact denom or a prefix ending in *. scope_specification_ids further limits nft/ scope denoms to scopes written against one of the listed specifications. An empty list permits everything. Denoms the vault already holds can still be repriced, and outbound sales are never blocked. Must be signed by the vault admin.",
+					Example:   fmt.Sprintf(`%s update-acquisition-policy %s %s --policy '{"denom_patterns":["nft/*"],"scope_specification_ids":["scopespec1qsg3zyg3yg3yxvuyg3242424242shk3a23"]}'`, txStart, exampleAdminAddr, exampleVaultAddr),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: fieldAuthority},
+						{ProtoField: fieldVaultAddress},
+					},
+					FlagOptions: map[string]*autocliv1.FlagOptions{
+						"policy": {
+							Name:  "policy",
+							Usage: "The acquisition policy as JSON, with denom_patterns and scope_specification_ids lists. Omit to permit everything.",
+						},
+					},
+				},
+				{
+					RpcMethod: "UpdateConcentrationLimits",
+					Use:       "update-concentration-limits [authority] [vault_address] [max_asset_concentration_bips] [max_non_underlying_bips]",
+					Alias:     []string{"ucl"},
+					Short:     "Cap how much of a vault's value its non-underlying assets may make up",
+					Long:      "Set the largest share of total vault value, in basis points, that any single non-underlying held denom (max_asset_concentration_bips) and all non-underlying held denoms together (max_non_underlying_bips) may make up. An inbound asset settlement that would push a holding over its limit is rejected; an internal NAV update that does so emits a warning event. A limit of 0 disables it. Must be signed by the vault admin.",
+					Example:   fmt.Sprintf("%s update-concentration-limits %s %s 1500 8000", txStart, exampleAdminAddr, exampleVaultAddr),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: fieldAuthority},
+						{ProtoField: fieldVaultAddress},
+						{ProtoField: "max_asset_concentration_bips"},
+						{ProtoField: "max_non_underlying_bips"},
+					},
+				},
+				{
+					RpcMethod: "UpdateMinLiquidity",
+					Use:       "update-min-liquidity [authority] [vault_address] [min_liquidity_bips]",
+					Alias:     []string{"uml"},
+					Short:     "Set how much of a vault's value must stay liquid in its underlying asset",
+					Long:      "Set the share of total vault value, in basis points, that the principal marker must keep in the underlying asset on top of the value of queued swap-outs. Inbound asset settlements, inbound vault-created payments, and principal withdrawals that would dip into it fail. A value of 0 disables the buffer. Must be signed by the vault admin.",
+					Example:   fmt.Sprintf("%s update-min-liquidity %s %s 1000", txStart, exampleAdminAddr, exampleVaultAddr),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: fieldAuthority},
+						{ProtoField: fieldVaultAddress},
+						{ProtoField: "min_liquidity_bips"},
+					},
+				},
+				{
+					RpcMethod: "CollectAssetIncome",
+					Use:       "collect-asset-income [authority] [vault_address] [source_asset] [amount]",
+					Alias:     []string{"cai"},
+					Short:     "Move income earned by a held asset into a vault's interest reserves",
+					Long:      "Move income earned by a held asset, such as coupon payments on an nft/scope loan, from the vault's principal marker into the vault account's interest reserves. Amount must be in the vault's underlying asset, and the vault must hold the source asset. Pass --to-principal to move the amount from the reserves back to the principal instead. Must be signed by a holder of the vault's settlement-manager role.",
+					Example:   fmt.Sprintf("%s collect-asset-income %s %s nft/loan-1 5000nhash", txStart, exampleAssetMgrAddr, exampleVaultAddr),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: fieldAuthority},
+						{ProtoField: fieldVaultAddress},
+						{ProtoField: "source_asset"},
+						{ProtoField: fieldAmount},
+					},
+					FlagOptions: map[string]*autocliv1.FlagOptions{
+						"to_principal": {
+							Name:  "to-principal",
+							Usage: "Return the amount from the interest reserves to the principal instead.",
+						},
+					},
+				},
+				{
+					RpcMethod: "GrantRole",
+					Use:       "grant-role [authority] [vault_address] [role] [address]",
+					Alias:     []string{"gr"},
+					Short:     "Grant a role on a vault to an address",
+					Long:      "Grant one of the vault roles to an address: pauser, rate-setter, limits-manager, reserve-funder, nav-authority or settlement-manager. Each operational message is authorized by a single role, so an address can be given only the access it needs. Must be signed by the vault admin.",
+					Example:   fmt.Sprintf("%s grant-role %s %s pauser %s", txStart, exampleAdminAddr, exampleVaultAddr, exampleAssetMgrAddr),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: fieldAuthority},
+						{ProtoField: fieldVaultAddress},
+						{ProtoField: "role"},
+						{ProtoField: "address"},
+					},
+				},
+				{
+					RpcMethod: "RevokeRole",
+					Use:       "revoke-role [authority] [vault_address] [role] [address]",
+					Alias:     []string{"rr"},
+					Short:     "Revoke a role on a vault from an address",
+					Long:      "Revoke one of the vault roles from an address: pauser, rate-setter, limits-manager, reserve-funder, nav-authority or settlement-manager. Must be signed by the vault admin.",
+					Example:   fmt.Sprintf("%s revoke-role %s %s pauser %s", txStart, exampleAdminAddr, exampleVaultAddr, exampleAssetMgrAddr),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: fieldAuthority},
+						{ProtoField: fieldVaultAddress},
+						{ProtoField: "role"},
+						{ProtoField: "address"},
+					},
+				},
+				{
+					RpcMethod: "ProposeAdminTransfer",
+					Use:       "propose-admin-transfer [authority] [vault_address] [new_admin]",
+					Alias:     []string{"pat"},
+					Short:     "Propose a new admin for a vault",
+					Long:      "Nominate a new admin for a vault, replacing any pending nomination. The nominee becomes admin only once it accepts with accept-admin-transfer. Set --expiration-seconds to limit how long the nomination can be accepted. Must be signed by the vault admin.",
+					Example:   fmt.Sprintf("%s propose-admin-transfer %s %s %s --expiration-seconds 604800", txStart, exampleAdminAddr, exampleVaultAddr, exampleAssetMgrAddr),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: fieldAuthority},
+						{ProtoField: fieldVaultAddress},
+						{ProtoField: "new_admin"},
+					},
+					FlagOptions: map[string]*autocliv1.FlagOptions{
+						"expiration_seconds": {
+							Name:  "expiration-seconds",
+							Usage: "Seconds after which the nomination can no longer be accepted. Omit or 0 for no expiry.",
+						},
+					},
+				},
+				{
+					RpcMethod: "AcceptAdminTransfer",
+					Use:       "accept-admin-transfer [new_admin] [vault_address]",
+					Alias:     []string{"aat"},
+					Short:     "Accept a pending vault admin transfer",
+					Long:      "Become the admin of a vault. The vault's roles, and its NAV authority and asset manager where they were the previous admin, pass to the new admin. Must be signed by the nominated admin before the nomination expires.",
+					Example:   fmt.Sprintf("%s accept-admin-transfer %s %s", txStart, exampleAssetMgrAddr, exampleVaultAddr),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "new_admin"},
+						{ProtoField: fieldVaultAddress},
+					},
+				},
+				{
+					RpcMethod: "CancelAdminTransfer",
+					Use:       "cancel-admin-transfer [authority] [vault_address]",
+					Alias:     []string{"cat"},
+					Short:     "Cancel a pending vault admin transfer",
+					Long:      "Withdraw the vault's pending admin nomination. Must be signed by the vault admin.",
+					Example:   fmt.Sprintf("%s cancel-admin-transfer %s %s", txStart, exampleAdminAddr, exampleVaultAddr),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: fieldAuthority},
+						{ProtoField: fieldVaultAddress},
+					},
+				},
+				{
+					RpcMethod: "RecoverVaultAdmin",
+					Use:       "recover-vault-admin [authority] [vault_address] [new_admin]",
+					Alias:     []string{"rva"},
+					Short:     "Replace a vault's admin by governance",
+					Long:      "Replace a vault's admin without the current admin's signature, for vaults whose admin key has been lost. Any pending admin nomination is cancelled. Requires governance authority, so this command is meant to be run with --generate-only and submitted as a governance proposal.",
+					Example:   fmt.Sprintf("%s recover-vault-admin %s %s %s --generate-only", txStart, exampleAuthorityAddr, exampleVaultAddr, exampleAdminAddr),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: fieldAuthority},
+						{ProtoField: fieldVaultAddress},
+						{ProtoField: "new_admin"},
+					},
+				},
+				{
+					RpcMethod: "UpdateTimelock",
+					Use:       "update-timelock [authority] [vault_address] [timelock_seconds]",
+					Alias:     []string{"utl"},
+					Short:     "Set the delay applied to a vault's sensitive configuration changes",
+					Long:      "Set the number of seconds that withdrawal delay, max swap-out, bridge address, asset manager and NAV authority changes are held as scheduled actions before they take effect. Zero applies them immediately. Raising the timelock takes effect at once; lowering it is itself held by the current timelock. Only the vault admin may sign.",
+					Example:   fmt.Sprintf("%s update-timelock %s %s 86400", txStart, exampleAdminAddr, exampleVaultAddr),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: fieldAuthority},
+						{ProtoField: fieldVaultAddress},
+						{ProtoField: "timelock_seconds"},
+					},
+				},
+				{
+					RpcMethod: "CancelScheduledAction",
+					Use:       "cancel-scheduled-action [authority] [vault_address] [action_id]",
+					Alias:     []string{"csa"},
+					Short:     "Cancel a vault's scheduled action before it executes",
+					Long:      "Discard a configuration change held by the vault's timelock. The vault admin or a holder of the vault's guardian role may sign.",
+					Example:   fmt.Sprintf("%s cancel-scheduled-action %s %s 1", txStart, exampleAdminAddr, exampleVaultAddr),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: fieldAuthority},
+						{ProtoField: fieldVaultAddress},
+						{ProtoField: "action_id"},
+					},
+				},
+				{
+					RpcMethod: "UpdateEligibilityPolicy",
+					Use:       "update-eligibility-policy [authority] [vault_address]",
+					Alias:     []string{"uep"},
+					Short:     "Restrict which addresses may swap into and receive shares of a vault",
+					Long:      "Replace the vault's investor eligibility policy. When enabled, only addresses on the vault's allowlist, or holding every attribute in required_attributes, may swap in or be sent the vault's shares. An attribute name starting with *. matches any attribute ending with the rest of the name. Existing shareholders are not affected. Must be signed by the vault admin.",
+					Example:   fmt.Sprintf(`%s update-eligibility-policy %s %s --policy '{"enabled":true,"required_attributes":["accredited.kyc.provenance.io"]}'`, txStart, exampleAdminAddr, exampleVaultAddr),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: fieldAuthority},
+						{ProtoField: fieldVaultAddress},
+					},
+					FlagOptions: map[string]*autocliv1.FlagOptions{
+						"policy": {
+							Name:  "policy",
+							Usage: "The eligibility policy as JSON, with enabled and a required_attributes list. Omit to disable the policy.",
+						},
+					},
+				},
+				{
+					RpcMethod: "UpdateAllowlist",
+					Use:       "update-allowlist [authority] [vault_address]",
+					Alias:     []string{"ual"},
+					Short:     "Add or remove addresses from a vault's investor allowlist",
+					Long:      "Add addresses to, and remove addresses from, the vault's investor allowlist. Allowlisted addresses are eligible under the vault's eligibility policy regardless of their attributes. Must be signed by the vault admin.",
+					Example:   fmt.Sprintf("%s update-allowlist %s %s --add %s", txStart, exampleAdminAddr, exampleVaultAddr, exampleAdminAddr),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: fieldAuthority},
+						{ProtoField: fieldVaultAddress},
+					},
+					FlagOptions: map[string]*autocliv1.FlagOptions{
+						"add": {
+							Name:  "add",
+							Usage: "Addresses to add to the allowlist.",
+						},
+						"remove": {
+							Name:  "remove",
+							Usage: "Addresses to remove from the allowlist.",
+						},
+					},
+				},
+				{
+					RpcMethod: "UpdatePositionCap",
+					Use:       "update-position-cap [authority] [vault_address] [max_position_value]",
+					Alias:     []string{"upc"},
+					Short:     "Cap the position a single account may build in a vault",
+					Long:      "Set the largest value, in the underlying asset, of the shares a single account may hold after a swap-in. Use an empty string to clear the cap. The --exemptions list replaces the addresses the cap does not apply to. Existing positions above the cap are not affected. Must be signed by the vault admin.",
+					Example:   fmt.Sprintf("%s update-position-cap %s %s 1000000 --exemptions %s", txStart, exampleAdminAddr, exampleVaultAddr, exampleAdminAddr),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: fieldAuthority},
+						{ProtoField: fieldVaultAddress},
+						{ProtoField: "max_position_value"},
+					},
+					FlagOptions: map[string]*autocliv1.FlagOptions{
+						"exemptions": {
+							Name:  "exemptions",
+							Usage: "Addresses exempt from the cap. Omit to exempt no one.",
+						},
+					},
+				},
+				{
+					RpcMethod: "UpdateMaxTotalValue",
+					Use:       "update-max-total-value [authority] [vault_address] [max_total_value]",
+					Alias:     []string{"umtv"},
+					Short:     "Cap the net total value a vault may reach through swap-ins",
+					Long:      "Set the vault's deposit capacity: swap-ins that would bring its net total value above this amount, in underlying_asset units, are rejected. Use an empty string to clear the cap. Must be signed by a holder of the vault's limits manager role.",
+					Example:   fmt.Sprintf("%s update-max-total-value %s %s 10000000", txStart, exampleAuthorityAddr, exampleVaultAddr),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: fieldAuthority},
+						{ProtoField: fieldVaultAddress},
+						{ProtoField: "max_total_value"},
+					},
+				},
+				{
+					RpcMethod: "UpdateCircuitBreaker",
+					Use:       "update-circuit-breaker [authority] [circuit_breaker]",
+					Alias:     []string{"ucb"},
+					Short:     "Trip or reset the module-wide circuit breaker",
+					Long:      "Replace the set of operations halted across every vault: swap-ins, swap-outs, settlements, and bridge operations. Omitted flags are cleared, so resetting the breaker is a single message. A governance proposal also sets the guardian with --guardian, and omitting it removes the guardian; the guardian itself must omit --guardian. Must be signed by the circuit breaker guardian, or submitted as a governance proposal with --generate-only.",
+					Example:   fmt.Sprintf("%s update-circuit-breaker %s '{\"swap_in_halted\":true,\"settlement_halted\":true}'", txStart, exampleAdminAddr),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: fieldAuthority},
+						{ProtoField: "circuit_breaker"},
+					},
+				},
 			},
 		},
 		Query: &autocliv1.ServiceCommandDescriptor{
@@ -687,7 +1132,7 @@ func (AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 					Use:       "navs [id]",
 					Alias:     []string{"vn"},
 					Short:     "Query all internal NAV entries for a vault",
-					Long:      "List the internal NAV table entries for the provided vault address or share denom.",
+					Long:      "List the internal NAV table entries for the provided vault address or share denom, along with the vault's valuation haircuts.",
 					Example:   fmt.Sprintf("%s navs %s", queryStart, exampleVaultAddr),
 					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
 						{ProtoField: "id"},
@@ -705,6 +1150,97 @@ func (AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 						{ProtoField: fieldDenom},
 					},
 				},
+				{
+					RpcMethod: "PendingNAVProposals",
+					Use:       "nav-proposals [id]",
+					Alias:     []string{"np"},
+					Short:     "Query a vault's pending NAV proposals",
+					Long:      "List the internal NAV updates awaiting approval for the provided vault address or share denom because they exceeded the vault's max_nav_change_bips.",
+					Example:   fmt.Sprintf("%s nav-proposals %s", queryStart, exampleVaultAddr),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "id"},
+					},
+				},
+				{
+					RpcMethod: "NAVSources",
+					Use:       "nav-sources [id] [denom]",
+					Alias:     []string{"ns"},
+					Short:     "Query each NAV source's price for a vault denom",
+					Long:      "List every NAV source submission for the provided vault address or share denom and asset denom, whether each is fresh enough to count toward the quorum, and the aggregated NAV when the quorum is met.",
+					Example:   fmt.Sprintf("%s nav-sources %s usdc", queryStart, exampleVaultAddr),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "id"},
+						{ProtoField: fieldDenom},
+					},
+				},
+				{
+					RpcMethod: "NavHistory",
+					Use:       "nav-history [id] [denom]",
+					Alias:     []string{"nh"},
+					Short:     "Query the NAV history of a vault denom",
+					Long:      "List the recorded NAV points for the provided vault address or share denom and asset denom in ascending time order. Pass the vault's share denom as denom for its published share NAV history. --start-time and --end-time bound the range, and --interval-seconds keeps only the first point in each interval.",
+					Example:   fmt.Sprintf("%s nav-history %s usdc --start-time 2026-01-01T00:00:00Z --interval-seconds 86400", queryStart, exampleVaultAddr),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "id"},
+						{ProtoField: fieldDenom},
+					},
+				},
+				{
+					RpcMethod: "SharePrice",
+					Use:       "share-price [id]",
+					Alias:     []string{"sp"},
+					Short:     "Query a vault's decimal NAV per share",
+					Long:      "Show the NAV per share of the provided vault address or share denom as a decimal in the underlying asset: gross, net of the outstanding AUM fee, and estimated with unpaid interest and fees accrued to the current block. Prices are per share display unit when the share denom has bank metadata, and per base share otherwise.",
+					Example:   fmt.Sprintf("%s share-price %s", queryStart, exampleVaultAddr),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "id"},
+					},
+				},
+				{
+					RpcMethod: "VaultTWAP",
+					Use:       "twap [id] [start_time]",
+					Alias:     []string{"tw"},
+					Short:     "Query a vault's time-weighted average share price",
+					Long:      "Show the time-weighted average share price of the provided vault address or share denom from start_time to --end-time (default: the current block time), in underlying units per 1,000,000 base shares. The window starts at the vault's earliest retained share price observation when start_time is earlier.",
+					Example:   fmt.Sprintf("%s twap %s 2026-01-01T00:00:00Z --end-time 2026-02-01T00:00:00Z", queryStart, exampleVaultAddr),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "id"},
+						{ProtoField: "start_time"},
+					},
+				},
+				{
+					RpcMethod: "VaultPerformance",
+					Use:       "performance [id]",
+					Alias:     []string{"perf"},
+					Short:     "Query a vault's share price return metrics",
+					Long:      "Show the realized yield, simple annualized return and max drawdown of the provided vault address or share denom's share price over trailing 7, 30, 90 and 365 day windows.",
+					Example:   fmt.Sprintf("%s performance %s", queryStart, exampleVaultAddr),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "id"},
+					},
+				},
+				{
+					RpcMethod: "VaultComposition",
+					Use:       "composition [id]",
+					Alias:     []string{"comp"},
+					Short:     "Query the weight of each asset a vault holds against its concentration limits",
+					Long:      "Show the value of each denom held by the provided vault address or share denom, and of its non-underlying holdings combined, as a share of total vault value in basis points, alongside the vault's concentration limits.",
+					Example:   fmt.Sprintf("%s composition %s", queryStart, exampleVaultAddr),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "id"},
+					},
+				},
+				{
+					RpcMethod: "VaultHoldings",
+					Use:       "holdings [id]",
+					Alias:     []string{"hold"},
+					Short:     "Query the valuation of each asset a vault holds",
+					Long:      "Show each denom held by the provided vault address or share denom with its balance, the NAV it was valued at and the source and age of that NAV, its haircut, its value in the underlying asset and its share of total vault value in basis points. Held balances that no price source values are listed separately.",
+					Example:   fmt.Sprintf("%s holdings %s", queryStart, exampleVaultAddr),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "id"},
+					},
+				},
 				{
 					RpcMethod: "VaultPayment",
 					Use:       "payment [id] [source] [external_id]",
@@ -729,6 +1265,37 @@ func (AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 						{ProtoField: "id"},
 					},
 				},
+				{
+					RpcMethod: "ScheduledActions",
+					Use:       "scheduled-actions [id]",
+					Alias:     []string{"sa"},
+					Short:     "Query the scheduled actions awaiting a vault's timelock",
+					Long:      "List the configuration changes held by the timelock of the provided vault address or share denom, in execute time order.",
+					Example:   fmt.Sprintf("%s scheduled-actions %s", queryStart, exampleVaultAddr),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "id"},
+					},
+				},
+				{
+					RpcMethod: "InvestorEligibility",
+					Use:       "investor-eligibility [id] [address]",
+					Alias:     []string{"elig"},
+					Short:     "Query whether an address is eligible to invest in a vault",
+					Long:      "Report whether the address may swap into, and receive shares of, the provided vault address or share denom under the vault's eligibility policy, whether it is on the vault's allowlist, and which required attributes it lacks.",
+					Example:   fmt.Sprintf("%s investor-eligibility %s %s", queryStart, exampleVaultAddr, exampleAdminAddr),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "id"},
+						{ProtoField: "address"},
+					},
+				},
+				{
+					RpcMethod: "CircuitBreaker",
+					Use:       "circuit-breaker",
+					Alias:     []string{"cb"},
+					Short:     "Query the module-wide circuit breaker",
+					Long:      "Return which operations are halted across every vault and the guardian allowed to change the circuit breaker alongside governance.",
+					Example:   fmt.Sprintf("%s circuit-breaker", queryStart),
+				},
 			},
 		},
 	}
@@ -0,0 +1,220 @@
+package queue
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/provlabs/vault/types"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/collections/indexes"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// ScheduledActionIndexes defines the indexes for the scheduled action queue.
+type ScheduledActionIndexes struct {
+	ByVault *indexes.Multi[sdk.AccAddress, collections.Triple[int64, uint64, sdk.AccAddress], types.ScheduledAction]
+	ByID    *indexes.Unique[uint64, collections.Triple[int64, uint64, sdk.AccAddress], types.ScheduledAction]
+}
+
+// IndexesList returns the list of indexes for the scheduled action queue.
+func (i ScheduledActionIndexes) IndexesList() []collections.Index[collections.Triple[int64, uint64, sdk.AccAddress], types.ScheduledAction] {
+	return []collections.Index[
+		collections.Triple[int64, uint64, sdk.AccAddress], types.ScheduledAction]{i.ByVault, i.ByID}
+}
+
+// NewScheduledActionIndexes creates a new ScheduledActionIndexes object.
+func NewScheduledActionIndexes(sb *collections.SchemaBuilder) ScheduledActionIndexes {
+	return ScheduledActionIndexes{
+		ByVault: indexes.NewMulti(
+			sb,
+			types.ScheduledActionByVaultIndexPrefix,
+			types.ScheduledActionByVaultIndexName,
+			sdk.AccAddressKey,
+			collections.TripleKeyCodec(collections.Int64Key, collections.Uint64Key, sdk.AccAddressKey),
+			func(pk collections.Triple[int64, uint64, sdk.AccAddress], _ types.ScheduledAction) (sdk.AccAddress, error) {
+				return pk.K3(), nil
+			},
+		),
+		ByID: indexes.NewUnique(
+			sb,
+			types.ScheduledActionByIDIndexPrefix,
+			types.ScheduledActionByIDIndexName,
+			collections.Uint64Key,
+			collections.TripleKeyCodec(collections.Int64Key, collections.Uint64Key, sdk.AccAddressKey),
+			func(pk collections.Triple[int64, uint64, sdk.AccAddress], _ types.ScheduledAction) (uint64, error) {
+				return pk.K2(), nil
+			},
+		),
+	}
+}
+
+// ScheduledActionQueue is a queue for the sensitive configuration messages held by vault
+// timelocks.
+type ScheduledActionQueue struct {
+	// IndexedMap is the indexed map of scheduled actions. The key is a triple of (execute time, id, vault).
+	IndexedMap *collections.IndexedMap[collections.Triple[int64, uint64, sdk.AccAddress], types.ScheduledAction, ScheduledActionIndexes]
+	// Sequence is the sequence for generating unique scheduled action IDs.
+	Sequence collections.Sequence
+}
+
+// NewScheduledActionQueue creates a new ScheduledActionQueue.
+func NewScheduledActionQueue(builder *collections.SchemaBuilder, cdc codec.BinaryCodec) *ScheduledActionQueue {
+	keyCodec := collections.TripleKeyCodec(
+		collections.Int64Key,
+		collections.Uint64Key,
+		sdk.AccAddressKey,
+	)
+	valueCodec := codec.CollValue[types.ScheduledAction](cdc)
+	return &ScheduledActionQueue{
+		IndexedMap: collections.NewIndexedMap(
+			builder,
+			types.ScheduledActionQueuePrefix,
+			types.ScheduledActionQueueName,
+			keyCodec,
+			valueCodec,
+			NewScheduledActionIndexes(builder),
+		),
+		Sequence: collections.NewSequence(builder, types.ScheduledActionQueueSeqPrefix, types.ScheduledActionQueueSeqName),
+	}
+}
+
+// key returns the queue key of a scheduled action.
+func (q *ScheduledActionQueue) key(action types.ScheduledAction) (collections.Triple[int64, uint64, sdk.AccAddress], error) {
+	vault, err := sdk.AccAddressFromBech32(action.VaultAddress)
+	if err != nil {
+		return collections.Triple[int64, uint64, sdk.AccAddress]{}, err
+	}
+	return collections.Join3(action.ExecuteTime, action.Id, vault), nil
+}
+
+// Enqueue assigns the action the next ID and adds it to the queue, returning the ID.
+func (q *ScheduledActionQueue) Enqueue(ctx context.Context, action types.ScheduledAction) (uint64, error) {
+	if err := action.Validate(); err != nil {
+		return 0, fmt.Errorf("invalid scheduled action: %w", err)
+	}
+	id, err := q.Sequence.Next(ctx)
+	if err != nil {
+		return 0, err
+	}
+	action.Id = id
+	key, err := q.key(action)
+	if err != nil {
+		return 0, err
+	}
+	return id, q.IndexedMap.Set(ctx, key, action)
+}
+
+// Dequeue removes a scheduled action from the queue. Removing an action that is not queued
+// is a no-op.
+func (q *ScheduledActionQueue) Dequeue(ctx context.Context, action types.ScheduledAction) error {
+	key, err := q.key(action)
+	if err != nil {
+		return err
+	}
+	if ok, _ := q.IndexedMap.Has(ctx, key); !ok {
+		return nil
+	}
+	return q.IndexedMap.Remove(ctx, key)
+}
+
+// GetByID gets the scheduled action by ID.
+func (q *ScheduledActionQueue) GetByID(ctx context.Context, id uint64) (types.ScheduledAction, error) {
+	pk, err := q.IndexedMap.Indexes.ByID.MatchExact(ctx, id)
+	if err != nil {
+		return types.ScheduledAction{}, err
+	}
+	return q.IndexedMap.Get(ctx, pk)
+}
+
+// WalkDue iterates over all scheduled actions with an execute time <= now, in execute time
+// order. Iteration stops when an action with a later execute time is encountered or when the
+// callback returns stop=true or an error.
+func (q *ScheduledActionQueue) WalkDue(ctx context.Context, now int64, fn func(action types.ScheduledAction) (stop bool, err error)) error {
+	return q.IndexedMap.Walk(ctx, nil, func(key collections.Triple[int64, uint64, sdk.AccAddress], value types.ScheduledAction) (stop bool, err error) {
+		if key.K1() > now {
+			return true, nil
+		}
+		return fn(value)
+	})
+}
+
+// Walk iterates over all scheduled actions in execute time order.
+// Iteration stops when the callback returns stop=true or an error.
+func (q *ScheduledActionQueue) Walk(ctx context.Context, fn func(action types.ScheduledAction) (stop bool, err error)) error {
+	return q.IndexedMap.Walk(ctx, nil, func(_ collections.Triple[int64, uint64, sdk.AccAddress], value types.ScheduledAction) (stop bool, err error) {
+		return fn(value)
+	})
+}
+
+// WalkByVault iterates over all scheduled actions for a specific vault.
+// Iteration stops when the callback returns stop=true or an error.
+func (q *ScheduledActionQueue) WalkByVault(ctx context.Context, vaultAddr sdk.AccAddress, fn func(action types.ScheduledAction) (stop bool, err error)) error {
+	iter, err := q.IndexedMap.Indexes.ByVault.MatchExact(ctx, vaultAddr)
+	if err != nil {
+		return err
+	}
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		pk, err := iter.PrimaryKey()
+		if err != nil {
+			return err
+		}
+		action, err := q.IndexedMap.Get(ctx, pk)
+		if err != nil {
+			return err
+		}
+		if stop, err := fn(action); stop || err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Import imports the scheduled action queue from genesis.
+func (q *ScheduledActionQueue) Import(ctx context.Context, genQueue *types.ScheduledActionQueue) error {
+	if genQueue == nil {
+		return fmt.Errorf("genesis queue is nil")
+	}
+	for _, action := range genQueue.Actions {
+		if err := action.Validate(); err != nil {
+			return fmt.Errorf("invalid scheduled action %d: %w", action.Id, err)
+		}
+		key, err := q.key(action)
+		if err != nil {
+			return err
+		}
+		if err := q.IndexedMap.Set(ctx, key, action); err != nil {
+			return fmt.Errorf("failed to enqueue scheduled action %d: %w", action.Id, err)
+		}
+	}
+	if err := q.Sequence.Set(ctx, genQueue.LatestSequenceNumber); err != nil {
+		return fmt.Errorf("failed to set latest sequence number for scheduled action queue: %w", err)
+	}
+	return nil
+}
+
+// Export exports the scheduled action queue to genesis.
+func (q *ScheduledActionQueue) Export(ctx context.Context) (*types.ScheduledActionQueue, error) {
+	actions := make([]types.ScheduledAction, 0)
+	err := q.Walk(ctx, func(action types.ScheduledAction) (stop bool, err error) {
+		actions = append(actions, action)
+		return false, nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to walk scheduled action queue: %w", err)
+	}
+
+	latestSequenceNumber, err := q.Sequence.Peek(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get latest sequence number for scheduled action queue: %w", err)
+	}
+
+	return &types.ScheduledActionQueue{
+		LatestSequenceNumber: latestSequenceNumber,
+		Actions:              actions,
+	}, nil
+}
@@ -0,0 +1,160 @@
+package queue_test
+
+import (
+	"testing"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/log"
+	storetypes "cosmossdk.io/store/types"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/provlabs/vault/queue"
+	vtypes "github.com/provlabs/vault/types"
+	"github.com/provlabs/vault/utils"
+	"github.com/provlabs/vault/utils/mocks"
+)
+
+func newTestScheduledActionQueue(t *testing.T) (sdk.Context, *queue.ScheduledActionQueue) {
+	t.Helper()
+	storeKey := storetypes.NewKVStoreKey(vtypes.ModuleName)
+	testCtx := testutil.DefaultContextWithDB(t, storeKey, storetypes.NewTransientStoreKey("transient_test"))
+
+	cfg := mocks.MakeTestEncodingConfig("provlabs")
+	sdkCfg := sdk.GetConfig()
+	sdkCfg.SetBech32PrefixForAccount("provlabs", "provlabspub")
+	sdkCfg.SetBech32PrefixForValidator("provlabsvaloper", "provlabsvaloperpub")
+	sdkCfg.SetBech32PrefixForConsensusNode("provlabsvalcons", "provlabsvalconspub")
+	vtypes.RegisterInterfaces(cfg.InterfaceRegistry)
+
+	kvStoreService := runtime.NewKVStoreService(storeKey)
+	sb := collections.NewSchemaBuilder(kvStoreService)
+
+	q := queue.NewScheduledActionQueue(sb, cfg.Codec)
+	_, err := sb.Build()
+	require.NoError(t, err, "schema build should not error")
+	return testCtx.Ctx.WithLogger(log.NewNopLogger()), q
+}
+
+func newTestScheduledAction(t *testing.T, vault, authority string, executeTime int64) vtypes.ScheduledAction {
+	t.Helper()
+	action, err := vtypes.NewScheduledAction(vault, authority, executeTime, &vtypes.MsgUpdateWithdrawalDelayRequest{
+		Authority:              authority,
+		VaultAddress:           vault,
+		WithdrawalDelaySeconds: 60,
+	})
+	require.NoError(t, err, "creating a scheduled action should not error")
+	return action
+}
+
+func TestScheduledActionQueue_EnqueueAssignsSequentialIDs(t *testing.T) {
+	ctx, q := newTestScheduledActionQueue(t)
+	vault := utils.TestProvlabsAddress().Bech32
+	authority := utils.TestProvlabsAddress().Bech32
+
+	first, err := q.Enqueue(ctx, newTestScheduledAction(t, vault, authority, 100))
+	require.NoError(t, err, "first enqueue should succeed")
+	second, err := q.Enqueue(ctx, newTestScheduledAction(t, vault, authority, 50))
+	require.NoError(t, err, "second enqueue should succeed")
+	require.Equal(t, first+1, second, "ids should be assigned sequentially")
+
+	got, err := q.GetByID(ctx, second)
+	require.NoError(t, err, "get by id should find the second action")
+	require.Equal(t, second, got.Id, "stored action should carry its assigned id")
+	require.Equal(t, int64(50), got.ExecuteTime, "stored action should keep its execute time")
+	require.Equal(t, sdk.MsgTypeURL(&vtypes.MsgUpdateWithdrawalDelayRequest{}), got.MsgTypeURL(), "stored action should keep its message type")
+}
+
+func TestScheduledActionQueue_EnqueueRejectsInvalidAction(t *testing.T) {
+	ctx, q := newTestScheduledActionQueue(t)
+
+	_, err := q.Enqueue(ctx, vtypes.ScheduledAction{VaultAddress: "invalid"})
+	require.ErrorContains(t, err, "invalid scheduled action", "enqueue should reject an invalid action")
+}
+
+func TestScheduledActionQueue_WalkDue(t *testing.T) {
+	ctx, q := newTestScheduledActionQueue(t)
+	vault := utils.TestProvlabsAddress().Bech32
+	authority := utils.TestProvlabsAddress().Bech32
+
+	for _, executeTime := range []int64{300, 100, 200} {
+		_, err := q.Enqueue(ctx, newTestScheduledAction(t, vault, authority, executeTime))
+		require.NoError(t, err, "enqueue should succeed")
+	}
+
+	var times []int64
+	err := q.WalkDue(ctx, 200, func(action vtypes.ScheduledAction) (bool, error) {
+		times = append(times, action.ExecuteTime)
+		return false, nil
+	})
+	require.NoError(t, err, "walk due should not error")
+	require.Equal(t, []int64{100, 200}, times, "only due actions should be visited, in execute time order")
+}
+
+func TestScheduledActionQueue_WalkByVault(t *testing.T) {
+	ctx, q := newTestScheduledActionQueue(t)
+	vault := utils.TestProvlabsAddress()
+	other := utils.TestProvlabsAddress().Bech32
+	authority := utils.TestProvlabsAddress().Bech32
+
+	_, err := q.Enqueue(ctx, newTestScheduledAction(t, vault.Bech32, authority, 100))
+	require.NoError(t, err, "enqueue for vault should succeed")
+	_, err = q.Enqueue(ctx, newTestScheduledAction(t, other, authority, 100))
+	require.NoError(t, err, "enqueue for other vault should succeed")
+
+	count := 0
+	err = q.WalkByVault(ctx, vault.Bytes, func(action vtypes.ScheduledAction) (bool, error) {
+		require.Equal(t, vault.Bech32, action.VaultAddress, "walk by vault should only visit the vault's actions")
+		count++
+		return false, nil
+	})
+	require.NoError(t, err, "walk by vault should not error")
+	require.Equal(t, 1, count, "walk by vault should visit exactly one action")
+}
+
+func TestScheduledActionQueue_Dequeue(t *testing.T) {
+	ctx, q := newTestScheduledActionQueue(t)
+	vault := utils.TestProvlabsAddress().Bech32
+	authority := utils.TestProvlabsAddress().Bech32
+
+	id, err := q.Enqueue(ctx, newTestScheduledAction(t, vault, authority, 100))
+	require.NoError(t, err, "enqueue should succeed")
+	action, err := q.GetByID(ctx, id)
+	require.NoError(t, err, "get by id should succeed")
+
+	require.NoError(t, q.Dequeue(ctx, action), "dequeue should succeed")
+	_, err = q.GetByID(ctx, id)
+	require.ErrorIs(t, err, collections.ErrNotFound, "dequeued action should no longer be found")
+	require.NoError(t, q.Dequeue(ctx, action), "dequeuing an absent action should be a no-op")
+}
+
+func TestScheduledActionQueue_ImportExport(t *testing.T) {
+	ctx, q := newTestScheduledActionQueue(t)
+	vault := utils.TestProvlabsAddress().Bech32
+	authority := utils.TestProvlabsAddress().Bech32
+
+	first := newTestScheduledAction(t, vault, authority, 200)
+	first.Id = 3
+	second := newTestScheduledAction(t, vault, authority, 100)
+	second.Id = 7
+	genQueue := &vtypes.ScheduledActionQueue{
+		LatestSequenceNumber: 8,
+		Actions:              []vtypes.ScheduledAction{first, second},
+	}
+	require.NoError(t, q.Import(ctx, genQueue), "import should succeed")
+
+	exported, err := q.Export(ctx)
+	require.NoError(t, err, "export should succeed")
+	require.Equal(t, uint64(8), exported.LatestSequenceNumber, "latest sequence number should round trip")
+	require.Len(t, exported.Actions, 2, "both actions should be exported")
+	require.Equal(t, uint64(7), exported.Actions[0].Id, "actions should be exported in execute time order")
+	require.Equal(t, uint64(3), exported.Actions[1].Id, "actions should be exported in execute time order")
+
+	id, err := q.Enqueue(ctx, newTestScheduledAction(t, vault, authority, 300))
+	require.NoError(t, err, "enqueue after import should succeed")
+	require.Equal(t, uint64(8), id, "enqueue after import should continue from the imported sequence")
+
+	require.Error(t, q.Import(ctx, nil), "importing a nil queue should error")
+}
@@ -36,6 +36,8 @@ import (
 //     app.VaultKeeper.MarkerKeeper, app.VaultKeeper.MetadataKeeper, app.VaultKeeper.NameKeeper, and
 //     app.VaultKeeper.AttrKeeper) to enable restricted marker management, scope resolution, name resolution,
 //     and attribute-based gating within the vault module.
+//   - Appends the VaultKeeper's send restriction to the BankKeeper so that transfers of vault shares
+//     honor each vault's investor eligibility policy.
 //   - Registers the modules with the app for inclusion in BeginBlocker, EndBlocker, InitGenesis, etc.
 //
 // This function is typically called during app initialization to ensure
@@ -115,6 +117,7 @@ func (app *SimApp) RegisterProvenanceModules() error {
 	app.VaultKeeper.AttrKeeper = app.AttributeKeeper
 	app.VaultKeeper.ExchangeKeeper = app.ExchangeKeeper
 	app.VaultKeeper.ExchangeQueryServer = exchangekeeper.NewQueryServer(app.ExchangeKeeper)
+	app.BankKeeper.AppendSendRestriction(app.VaultKeeper.SendRestrictionFn)
 
 	return app.RegisterModules(
 		name.NewAppModule(app.appCodec, app.NameKeeper, app.AccountKeeper, app.BankKeeper),
@@ -9,6 +9,13 @@ import (
 var (
 	ErrInvalidRequest = errors.Register(ModuleName, 0, "invalid request")
 	ErrVaultNotFound  = errors.Register(ModuleName, 1, "vault not found")
+	// ErrStaleNAV is returned when a pricing-dependent operation is attempted while an
+	// internal NAV entry the operation relies on is older than the vault's max_nav_age_seconds.
+	ErrStaleNAV = errors.Register(ModuleName, 2, "internal NAV is stale")
+	// ErrInsufficientLiquidity is returned when an operation would leave a vault's principal
+	// holding less of its underlying asset than its queued swap-outs and min_liquidity_bips
+	// buffer require.
+	ErrInsufficientLiquidity = errors.Register(ModuleName, 3, "insufficient liquidity")
 )
 
 // Refund-classification sentinel errors raised inside this module and wrapped at their source, so
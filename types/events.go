@@ -36,6 +36,25 @@ const (
 	AssetDirectionOutbound = "outbound"
 )
 
+const (
+	// IncomeDirectionToReserves indicates asset income moved from the vault's principal into
+	// its interest reserves.
+	IncomeDirectionToReserves = "to_reserves"
+	// IncomeDirectionToPrincipal indicates asset income moved from the vault's interest
+	// reserves back to its principal.
+	IncomeDirectionToPrincipal = "to_principal"
+)
+
+const (
+	// PaymentClosedCancelled indicates the asset manager cancelled an outbound payment.
+	PaymentClosedCancelled = "cancelled"
+	// PaymentClosedExpired indicates an outbound payment was expired after its expiry time.
+	PaymentClosedExpired = "expired"
+	// PaymentClosedCompleted indicates the target accepted or rejected an outbound payment
+	// on the exchange module.
+	PaymentClosedCompleted = "completed"
+)
+
 // NewEventVaultCreated creates a new EventVaultCreated event.
 func NewEventVaultCreated(vault *VaultAccount) *EventVaultCreated {
 	return &EventVaultCreated{
@@ -422,14 +441,17 @@ func NewEventNAVAuthorityUpdated(vaultAddress, admin, newAuthority string) *Even
 
 // NewEventAssetAccepted creates a new EventAssetAccepted event. direction is one
 // of AssetDirectionInbound or AssetDirectionOutbound.
-func NewEventAssetAccepted(vaultAddress, source, externalID string, sourceAmount, targetAmount sdk.Coins, direction string) *EventAssetAccepted {
+func NewEventAssetAccepted(vaultAddress, source, externalID string, sourceAmount, targetAmount sdk.Coins, direction string, navValue sdk.DecCoin, realizedGainLoss sdkmath.LegacyDec, navUpdated bool) *EventAssetAccepted {
 	return &EventAssetAccepted{
-		VaultAddress: vaultAddress,
-		Source:       source,
-		ExternalId:   externalID,
-		SourceAmount: sourceAmount.String(),
-		TargetAmount: targetAmount.String(),
-		Direction:    direction,
+		VaultAddress:     vaultAddress,
+		Source:           source,
+		ExternalId:       externalID,
+		SourceAmount:     sourceAmount.String(),
+		TargetAmount:     targetAmount.String(),
+		Direction:        direction,
+		NavValue:         navValue.String(),
+		RealizedGainLoss: realizedGainLoss.String(),
+		NavUpdated:       navUpdated,
 	}
 }
 
@@ -441,3 +463,363 @@ func NewEventAssetRejected(vaultAddress, source, externalID string) *EventAssetR
 		ExternalId:   externalID,
 	}
 }
+
+// NewEventNAVStalenessLimitUpdated creates a new EventNAVStalenessLimitUpdated event.
+func NewEventNAVStalenessLimitUpdated(vaultAddress, authority string, maxNAVAgeSeconds uint64, autoPause bool) *EventNAVStalenessLimitUpdated {
+	return &EventNAVStalenessLimitUpdated{
+		VaultAddress:        vaultAddress,
+		Authority:           authority,
+		MaxNavAgeSeconds:    maxNAVAgeSeconds,
+		AutoPauseOnStaleNav: autoPause,
+	}
+}
+
+// NewEventNAVChangeLimitUpdated creates a new EventNAVChangeLimitUpdated event.
+func NewEventNAVChangeLimitUpdated(vaultAddress, authority string, maxNAVChangeBips uint32, navApprover string) *EventNAVChangeLimitUpdated {
+	return &EventNAVChangeLimitUpdated{
+		VaultAddress:     vaultAddress,
+		Authority:        authority,
+		MaxNavChangeBips: maxNAVChangeBips,
+		NavApprover:      navApprover,
+	}
+}
+
+// NewEventSettlementToleranceUpdated creates a new EventSettlementToleranceUpdated event.
+func NewEventSettlementToleranceUpdated(vaultAddress, authority string, toleranceBips uint32, updateNAVOnSettlement bool) *EventSettlementToleranceUpdated {
+	return &EventSettlementToleranceUpdated{
+		VaultAddress:            vaultAddress,
+		Authority:               authority,
+		SettlementToleranceBips: toleranceBips,
+		UpdateNavOnSettlement:   updateNAVOnSettlement,
+	}
+}
+
+// NewEventNAVProposed creates a new EventNAVProposed event from the pending proposal and
+// the NAV entry it would replace.
+func NewEventNAVProposed(proposal PendingNAVProposal, previous VaultNAV) *EventNAVProposed {
+	return &EventNAVProposed{
+		VaultAddress:   proposal.VaultAddress,
+		Denom:          proposal.Nav.Denom,
+		Price:          proposal.Nav.Price.String(),
+		Volume:         proposal.Nav.Volume.String(),
+		PreviousPrice:  previous.Price.String(),
+		PreviousVolume: previous.Volume.String(),
+		Proposer:       proposal.Proposer,
+		ExpiryTime:     proposal.ExpiryTime.Unix(),
+	}
+}
+
+// NewEventNAVProposalApproved creates a new EventNAVProposalApproved event.
+func NewEventNAVProposalApproved(proposal PendingNAVProposal, approver string) *EventNAVProposalApproved {
+	return &EventNAVProposalApproved{
+		VaultAddress: proposal.VaultAddress,
+		Denom:        proposal.Nav.Denom,
+		Price:        proposal.Nav.Price.String(),
+		Volume:       proposal.Nav.Volume.String(),
+		Proposer:     proposal.Proposer,
+		Approver:     approver,
+	}
+}
+
+// NewEventNAVProposalExpired creates a new EventNAVProposalExpired event.
+func NewEventNAVProposalExpired(proposal PendingNAVProposal) *EventNAVProposalExpired {
+	return &EventNAVProposalExpired{
+		VaultAddress: proposal.VaultAddress,
+		Denom:        proposal.Nav.Denom,
+		Price:        proposal.Nav.Price.String(),
+		Volume:       proposal.Nav.Volume.String(),
+		Proposer:     proposal.Proposer,
+	}
+}
+
+// NewEventNAVSourcesUpdated creates a new EventNAVSourcesUpdated event.
+func NewEventNAVSourcesUpdated(vaultAddress, authority string, sources []string, quorum uint32, aggregation NAVAggregation, maxAgeSeconds uint64) *EventNAVSourcesUpdated {
+	return &EventNAVSourcesUpdated{
+		VaultAddress:           vaultAddress,
+		Authority:              authority,
+		NavSources:             sources,
+		NavSourceQuorum:        quorum,
+		NavAggregation:         aggregation.String(),
+		NavSourceMaxAgeSeconds: maxAgeSeconds,
+	}
+}
+
+// NewEventSourceNAVSubmitted creates a new EventSourceNAVSubmitted event.
+func NewEventSourceNAVSubmitted(submission NAVSourceSubmission) *EventSourceNAVSubmitted {
+	return &EventSourceNAVSubmitted{
+		VaultAddress: submission.VaultAddress,
+		Denom:        submission.Nav.Denom,
+		Price:        submission.Nav.Price.String(),
+		Volume:       submission.Nav.Volume.String(),
+		Source:       submission.Source,
+	}
+}
+
+// NewEventVaultNAVBatchUpdated creates a new EventVaultNAVBatchUpdated summarizing a NAV
+// batch: the denoms written directly and the denoms held for the NAV approver.
+func NewEventVaultNAVBatchUpdated(vaultAddress, signer string, updatedDenoms, pendingDenoms []string) *EventVaultNAVBatchUpdated {
+	return &EventVaultNAVBatchUpdated{
+		VaultAddress:  vaultAddress,
+		Signer:        signer,
+		UpdatedDenoms: updatedDenoms,
+		PendingDenoms: pendingDenoms,
+	}
+}
+
+// NewEventPriceSourcesUpdated creates a new EventPriceSourcesUpdated event.
+func NewEventPriceSourcesUpdated(vaultAddress, authority string, sources []PriceSourceType) *EventPriceSourcesUpdated {
+	names := make([]string, len(sources))
+	for i, source := range sources {
+		names[i] = source.String()
+	}
+	return &EventPriceSourcesUpdated{
+		VaultAddress: vaultAddress,
+		Authority:    authority,
+		PriceSources: names,
+	}
+}
+
+// NewEventAssetHaircutUpdated creates a new EventAssetHaircutUpdated event.
+func NewEventAssetHaircutUpdated(vaultAddress, signer, denom string, haircutBips uint32) *EventAssetHaircutUpdated {
+	return &EventAssetHaircutUpdated{
+		VaultAddress: vaultAddress,
+		Signer:       signer,
+		Denom:        denom,
+		HaircutBips:  haircutBips,
+	}
+}
+
+// NewEventAssetsBatchAccepted creates a new EventAssetsBatchAccepted summarizing a settlement
+// batch: the number of payments settled and the number left pending.
+func NewEventAssetsBatchAccepted(vaultAddress, authority string, accepted, failed uint32) *EventAssetsBatchAccepted {
+	return &EventAssetsBatchAccepted{
+		VaultAddress: vaultAddress,
+		Authority:    authority,
+		Accepted:     accepted,
+		Failed:       failed,
+	}
+}
+
+// NewEventAssetPaymentCreated creates a new EventAssetPaymentCreated event.
+func NewEventAssetPaymentCreated(vaultAddress, source string, payment OutboundPayment) *EventAssetPaymentCreated {
+	return &EventAssetPaymentCreated{
+		VaultAddress: vaultAddress,
+		Source:       source,
+		Target:       payment.Target,
+		ExternalId:   payment.ExternalId,
+		SourceAmount: payment.SourceAmount.String(),
+		TargetAmount: payment.TargetAmount.String(),
+		Direction:    payment.Direction,
+		ExpiryTime:   payment.ExpiryTime.Unix(),
+	}
+}
+
+// NewEventAssetPaymentClosed creates a new EventAssetPaymentClosed event.
+func NewEventAssetPaymentClosed(vaultAddress, externalID, reason string) *EventAssetPaymentClosed {
+	return &EventAssetPaymentClosed{
+		VaultAddress: vaultAddress,
+		ExternalId:   externalID,
+		Reason:       reason,
+	}
+}
+
+// NewEventAcquisitionPolicyUpdated creates a new EventAcquisitionPolicyUpdated event.
+func NewEventAcquisitionPolicyUpdated(vaultAddress, authority string, policy AcquisitionPolicy) *EventAcquisitionPolicyUpdated {
+	return &EventAcquisitionPolicyUpdated{
+		VaultAddress:          vaultAddress,
+		Authority:             authority,
+		DenomPatterns:         policy.DenomPatterns,
+		ScopeSpecificationIds: policy.ScopeSpecificationIds,
+	}
+}
+
+// NewEventConcentrationLimitsUpdated creates a new EventConcentrationLimitsUpdated event.
+func NewEventConcentrationLimitsUpdated(vaultAddress, authority string, maxAssetConcentrationBips, maxNonUnderlyingBips uint32) *EventConcentrationLimitsUpdated {
+	return &EventConcentrationLimitsUpdated{
+		VaultAddress:              vaultAddress,
+		Authority:                 authority,
+		MaxAssetConcentrationBips: maxAssetConcentrationBips,
+		MaxNonUnderlyingBips:      maxNonUnderlyingBips,
+	}
+}
+
+// NewEventConcentrationLimitBreached creates a new EventConcentrationLimitBreached event. An
+// empty denom reports a breach of the combined non-underlying limit.
+func NewEventConcentrationLimitBreached(vaultAddress, denom string, weightBips sdkmath.LegacyDec, limitBips uint32) *EventConcentrationLimitBreached {
+	return &EventConcentrationLimitBreached{
+		VaultAddress: vaultAddress,
+		Denom:        denom,
+		WeightBips:   weightBips.String(),
+		LimitBips:    limitBips,
+	}
+}
+
+// NewEventMinLiquidityUpdated creates a new EventMinLiquidityUpdated event.
+func NewEventMinLiquidityUpdated(vaultAddress, authority string, minLiquidityBips uint32) *EventMinLiquidityUpdated {
+	return &EventMinLiquidityUpdated{
+		VaultAddress:     vaultAddress,
+		Authority:        authority,
+		MinLiquidityBips: minLiquidityBips,
+	}
+}
+
+// NewEventAssetIncomeCollected creates a new EventAssetIncomeCollected event.
+func NewEventAssetIncomeCollected(vaultAddress, authority, sourceAsset string, amount sdk.Coin, direction string) *EventAssetIncomeCollected {
+	return &EventAssetIncomeCollected{
+		VaultAddress: vaultAddress,
+		Authority:    authority,
+		SourceAsset:  sourceAsset,
+		Amount:       amount.String(),
+		Direction:    direction,
+	}
+}
+
+// NewEventRoleGranted creates a new EventRoleGranted event.
+func NewEventRoleGranted(vaultAddress, authority string, role VaultRole, address string) *EventRoleGranted {
+	return &EventRoleGranted{
+		VaultAddress: vaultAddress,
+		Authority:    authority,
+		Role:         role.String(),
+		Address:      address,
+	}
+}
+
+// NewEventRoleRevoked creates a new EventRoleRevoked event.
+func NewEventRoleRevoked(vaultAddress, authority string, role VaultRole, address string) *EventRoleRevoked {
+	return &EventRoleRevoked{
+		VaultAddress: vaultAddress,
+		Authority:    authority,
+		Role:         role.String(),
+		Address:      address,
+	}
+}
+
+// NewEventAdminTransferProposed creates a new EventAdminTransferProposed event.
+func NewEventAdminTransferProposed(vaultAddress, admin string, transfer PendingAdminTransfer) *EventAdminTransferProposed {
+	var expiry int64
+	if transfer.ExpiryTime != nil {
+		expiry = transfer.ExpiryTime.Unix()
+	}
+	return &EventAdminTransferProposed{
+		VaultAddress: vaultAddress,
+		Admin:        admin,
+		NewAdmin:     transfer.NewAdmin,
+		ExpiryTime:   expiry,
+	}
+}
+
+// NewEventAdminTransferCancelled creates a new EventAdminTransferCancelled event.
+func NewEventAdminTransferCancelled(vaultAddress, authority, newAdmin string) *EventAdminTransferCancelled {
+	return &EventAdminTransferCancelled{
+		VaultAddress: vaultAddress,
+		Authority:    authority,
+		NewAdmin:     newAdmin,
+	}
+}
+
+// NewEventAdminTransferred creates a new EventAdminTransferred event.
+func NewEventAdminTransferred(vaultAddress, authority, previousAdmin, newAdmin string) *EventAdminTransferred {
+	return &EventAdminTransferred{
+		VaultAddress:  vaultAddress,
+		Authority:     authority,
+		PreviousAdmin: previousAdmin,
+		NewAdmin:      newAdmin,
+	}
+}
+
+// NewEventTimelockUpdated creates a new EventTimelockUpdated event.
+func NewEventTimelockUpdated(vaultAddress, authority string, timelockSeconds uint64) *EventTimelockUpdated {
+	return &EventTimelockUpdated{
+		VaultAddress:    vaultAddress,
+		Authority:       authority,
+		TimelockSeconds: timelockSeconds,
+	}
+}
+
+// NewEventActionScheduled creates a new EventActionScheduled event.
+func NewEventActionScheduled(vaultAddress, authority string, actionID uint64, msgTypeURL string, executeTime int64) *EventActionScheduled {
+	return &EventActionScheduled{
+		VaultAddress: vaultAddress,
+		Authority:    authority,
+		ActionId:     actionID,
+		MsgTypeUrl:   msgTypeURL,
+		ExecuteTime:  executeTime,
+	}
+}
+
+// NewEventScheduledActionExecuted creates a new EventScheduledActionExecuted event.
+func NewEventScheduledActionExecuted(vaultAddress string, actionID uint64, msgTypeURL string) *EventScheduledActionExecuted {
+	return &EventScheduledActionExecuted{
+		VaultAddress: vaultAddress,
+		ActionId:     actionID,
+		MsgTypeUrl:   msgTypeURL,
+	}
+}
+
+// NewEventScheduledActionFailed creates a new EventScheduledActionFailed event.
+func NewEventScheduledActionFailed(vaultAddress string, actionID uint64, msgTypeURL, reason string) *EventScheduledActionFailed {
+	return &EventScheduledActionFailed{
+		VaultAddress: vaultAddress,
+		ActionId:     actionID,
+		MsgTypeUrl:   msgTypeURL,
+		Reason:       reason,
+	}
+}
+
+// NewEventScheduledActionCancelled creates a new EventScheduledActionCancelled event.
+func NewEventScheduledActionCancelled(vaultAddress, authority string, actionID uint64, msgTypeURL string) *EventScheduledActionCancelled {
+	return &EventScheduledActionCancelled{
+		VaultAddress: vaultAddress,
+		Authority:    authority,
+		ActionId:     actionID,
+		MsgTypeUrl:   msgTypeURL,
+	}
+}
+
+// NewEventEligibilityPolicyUpdated creates a new EventEligibilityPolicyUpdated event.
+func NewEventEligibilityPolicyUpdated(vaultAddress, authority string, policy EligibilityPolicy) *EventEligibilityPolicyUpdated {
+	return &EventEligibilityPolicyUpdated{
+		VaultAddress:       vaultAddress,
+		Authority:          authority,
+		Enabled:            policy.Enabled,
+		RequiredAttributes: policy.RequiredAttributes,
+	}
+}
+
+// NewEventAllowlistUpdated creates a new EventAllowlistUpdated event.
+func NewEventAllowlistUpdated(vaultAddress, authority string, added, removed []string) *EventAllowlistUpdated {
+	return &EventAllowlistUpdated{
+		VaultAddress: vaultAddress,
+		Authority:    authority,
+		Added:        added,
+		Removed:      removed,
+	}
+}
+
+// NewEventPositionCapUpdated creates a new EventPositionCapUpdated event.
+func NewEventPositionCapUpdated(vaultAddress, authority, maxPositionValue string, exemptions []string) *EventPositionCapUpdated {
+	return &EventPositionCapUpdated{
+		VaultAddress:     vaultAddress,
+		Authority:        authority,
+		MaxPositionValue: maxPositionValue,
+		Exemptions:       exemptions,
+	}
+}
+
+// NewEventMaxTotalValueUpdated creates a new EventMaxTotalValueUpdated event.
+func NewEventMaxTotalValueUpdated(vaultAddress, authority, maxTotalValue string) *EventMaxTotalValueUpdated {
+	return &EventMaxTotalValueUpdated{
+		VaultAddress:  vaultAddress,
+		Authority:     authority,
+		MaxTotalValue: maxTotalValue,
+	}
+}
+
+// NewEventCircuitBreakerUpdated creates a new EventCircuitBreakerUpdated event.
+func NewEventCircuitBreakerUpdated(authority string, breaker CircuitBreaker, guardian string) *EventCircuitBreakerUpdated {
+	return &EventCircuitBreakerUpdated{
+		Authority:      authority,
+		CircuitBreaker: &breaker,
+		Guardian:       guardian,
+	}
+}
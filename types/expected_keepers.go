@@ -52,10 +52,12 @@ type BankKeeper interface {
 	SendCoins(context context.Context, fromAddr sdk.AccAddress, toAddr sdk.AccAddress, amt sdk.Coins) error
 	GetAllBalances(context context.Context, addr sdk.AccAddress) sdk.Coins
 	GetBalance(context context.Context, addr sdk.AccAddress, denom string) sdk.Coin
+	SpendableCoins(context context.Context, addr sdk.AccAddress) sdk.Coins
 	GetSupply(context context.Context, denom string) sdk.Coin
 	MintCoins(ctx context.Context, moduleName string, amt sdk.Coins) error
 	SendCoinsFromModuleToAccount(ctx context.Context, senderModule string, recipientAddr sdk.AccAddress, amt sdk.Coins) error
 	SetDenomMetaData(context context.Context, denomMetaData banktypes.Metadata)
+	GetDenomMetaData(context context.Context, denom string) (banktypes.Metadata, bool)
 }
 
 type NameKeeper interface {
@@ -65,6 +67,7 @@ type NameKeeper interface {
 
 type AttributeKeeper interface {
 	SetAttribute(ctx sdk.Context, attr attrtypes.Attribute, owner sdk.AccAddress) error
+	GetAllAttributesAddr(ctx sdk.Context, addr []byte) ([]attrtypes.Attribute, error)
 }
 
 // ExchangeKeeper defines the subset of the Provenance exchange module keeper
@@ -73,6 +76,8 @@ type ExchangeKeeper interface {
 	GetPayment(ctx sdk.Context, source sdk.AccAddress, externalID string) (*exchange.Payment, error)
 	AcceptPayment(ctx sdk.Context, payment *exchange.Payment) error
 	RejectPayment(ctx sdk.Context, target, source sdk.AccAddress, externalID string) error
+	CreatePayment(ctx sdk.Context, payment *exchange.Payment) error
+	CancelPayments(ctx sdk.Context, source sdk.AccAddress, externalIDs []string) error
 }
 
 // ExchangeQueryServer defines the subset of the Provenance exchange module
@@ -5,6 +5,8 @@ import (
 	"math"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/provenance-io/provenance/x/exchange"
 )
 
 // DefaultGenesisState returns the default genesis state
@@ -131,5 +133,231 @@ func (gs GenesisState) Validate() error {
 		navKeys[key] = true
 	}
 
+	proposalKeys := make(map[string]bool)
+	for i, proposal := range gs.PendingNavProposals {
+		if _, err := sdk.AccAddressFromBech32(proposal.VaultAddress); err != nil {
+			return fmt.Errorf("invalid nav proposal vault address at index %d: %w", i, err)
+		}
+		if _, exists := vaults[proposal.VaultAddress]; !exists {
+			return fmt.Errorf("nav proposal at index %d is not for an imported vault: %s", i, proposal.VaultAddress)
+		}
+		if _, err := sdk.AccAddressFromBech32(proposal.Proposer); err != nil {
+			return fmt.Errorf("invalid nav proposal proposer at index %d: %w", i, err)
+		}
+		if err := sdk.ValidateDenom(proposal.Nav.Denom); err != nil {
+			return fmt.Errorf("invalid nav proposal denom at index %d: %w", i, err)
+		}
+		key := proposal.VaultAddress + "/" + proposal.Nav.Denom
+		if proposalKeys[key] {
+			return fmt.Errorf("duplicate nav proposal for vault %s denom %s", proposal.VaultAddress, proposal.Nav.Denom)
+		}
+		proposalKeys[key] = true
+	}
+
+	submissionKeys := make(map[string]bool)
+	for i, submission := range gs.NavSourceSubmissions {
+		if _, err := sdk.AccAddressFromBech32(submission.VaultAddress); err != nil {
+			return fmt.Errorf("invalid nav source submission vault address at index %d: %w", i, err)
+		}
+		if _, exists := vaults[submission.VaultAddress]; !exists {
+			return fmt.Errorf("nav source submission at index %d is not for an imported vault: %s", i, submission.VaultAddress)
+		}
+		if _, err := sdk.AccAddressFromBech32(submission.Source); err != nil {
+			return fmt.Errorf("invalid nav source submission source at index %d: %w", i, err)
+		}
+		if err := sdk.ValidateDenom(submission.Nav.Denom); err != nil {
+			return fmt.Errorf("invalid nav source submission denom at index %d: %w", i, err)
+		}
+		key := submission.VaultAddress + "/" + submission.Nav.Denom + "/" + submission.Source
+		if submissionKeys[key] {
+			return fmt.Errorf("duplicate nav source submission for vault %s denom %s source %s", submission.VaultAddress, submission.Nav.Denom, submission.Source)
+		}
+		submissionKeys[key] = true
+	}
+
+	historyKeys := make(map[string]bool)
+	for i, entry := range gs.NavHistory {
+		if _, err := sdk.AccAddressFromBech32(entry.VaultAddress); err != nil {
+			return fmt.Errorf("invalid nav history vault address at index %d: %w", i, err)
+		}
+		if _, exists := vaults[entry.VaultAddress]; !exists {
+			return fmt.Errorf("nav history entry at index %d is not for an imported vault: %s", i, entry.VaultAddress)
+		}
+		if err := sdk.ValidateDenom(entry.Nav.Denom); err != nil {
+			return fmt.Errorf("invalid nav history denom at index %d: %w", i, err)
+		}
+		if err := entry.Nav.Price.Validate(); err != nil {
+			return fmt.Errorf("invalid nav history price at index %d: %w", i, err)
+		}
+		key := fmt.Sprintf("%s/%s/%d", entry.VaultAddress, entry.Nav.Denom, entry.Nav.UpdatedTime.UnixNano())
+		if historyKeys[key] {
+			return fmt.Errorf("duplicate nav history entry for vault %s denom %s at %s", entry.VaultAddress, entry.Nav.Denom, entry.Nav.UpdatedTime)
+		}
+		historyKeys[key] = true
+	}
+
+	settlementKeys := make(map[string]bool)
+	for i, entry := range gs.SettlementPrices {
+		if _, err := sdk.AccAddressFromBech32(entry.VaultAddress); err != nil {
+			return fmt.Errorf("invalid settlement price vault address at index %d: %w", i, err)
+		}
+		if _, exists := vaults[entry.VaultAddress]; !exists {
+			return fmt.Errorf("settlement price at index %d is not for an imported vault: %s", i, entry.VaultAddress)
+		}
+		price := entry.Nav
+		if err := sdk.ValidateDenom(price.Denom); err != nil {
+			return fmt.Errorf("invalid settlement price denom at index %d: %w", i, err)
+		}
+		if err := price.Price.Validate(); err != nil {
+			return fmt.Errorf("invalid settlement price at index %d: %w", i, err)
+		}
+		if price.Volume.IsNil() || !price.Volume.IsPositive() {
+			return fmt.Errorf("settlement price volume at index %d must be positive", i)
+		}
+		key := entry.VaultAddress + "/" + price.Denom + "/" + price.Price.Denom
+		if settlementKeys[key] {
+			return fmt.Errorf("duplicate settlement price for vault %s denom %s priced in %s", entry.VaultAddress, price.Denom, price.Price.Denom)
+		}
+		settlementKeys[key] = true
+	}
+
+	haircutKeys := make(map[string]bool)
+	for i, entry := range gs.AssetHaircuts {
+		if _, err := sdk.AccAddressFromBech32(entry.VaultAddress); err != nil {
+			return fmt.Errorf("invalid asset haircut vault address at index %d: %w", i, err)
+		}
+		v, exists := vaults[entry.VaultAddress]
+		if !exists {
+			return fmt.Errorf("asset haircut at index %d is not for an imported vault: %s", i, entry.VaultAddress)
+		}
+		if err := sdk.ValidateDenom(entry.Haircut.Denom); err != nil {
+			return fmt.Errorf("invalid asset haircut denom at index %d: %w", i, err)
+		}
+		if entry.Haircut.Denom == v.UnderlyingAsset || entry.Haircut.Denom == v.TotalShares.Denom {
+			return fmt.Errorf("asset haircut at index %d cannot apply to vault %s underlying or share denom %s", i, entry.VaultAddress, entry.Haircut.Denom)
+		}
+		if entry.Haircut.HaircutBips == 0 {
+			return fmt.Errorf("asset haircut at index %d must be positive", i)
+		}
+		if err := ValidateHaircutBips(entry.Haircut.HaircutBips); err != nil {
+			return fmt.Errorf("invalid asset haircut at index %d: %w", i, err)
+		}
+		key := entry.VaultAddress + "/" + entry.Haircut.Denom
+		if haircutKeys[key] {
+			return fmt.Errorf("duplicate asset haircut for vault %s denom %s", entry.VaultAddress, entry.Haircut.Denom)
+		}
+		haircutKeys[key] = true
+	}
+
+	observationKeys := make(map[string]bool)
+	for i, entry := range gs.SharePriceObservations {
+		if _, err := sdk.AccAddressFromBech32(entry.VaultAddress); err != nil {
+			return fmt.Errorf("invalid share price observation vault address at index %d: %w", i, err)
+		}
+		if _, exists := vaults[entry.VaultAddress]; !exists {
+			return fmt.Errorf("share price observation at index %d is not for an imported vault: %s", i, entry.VaultAddress)
+		}
+		if entry.Observation.Price.IsNil() || entry.Observation.Price.IsNegative() {
+			return fmt.Errorf("share price observation price at index %d must be non-negative", i)
+		}
+		if entry.Observation.Cumulative.IsNil() || entry.Observation.Cumulative.IsNegative() {
+			return fmt.Errorf("share price observation cumulative at index %d must be non-negative", i)
+		}
+		key := fmt.Sprintf("%s/%d", entry.VaultAddress, entry.Observation.Time.UnixNano())
+		if observationKeys[key] {
+			return fmt.Errorf("duplicate share price observation for vault %s at %s", entry.VaultAddress, entry.Observation.Time)
+		}
+		observationKeys[key] = true
+	}
+
+	paymentKeys := make(map[string]bool)
+	for i, entry := range gs.OutboundPayments {
+		if _, err := sdk.AccAddressFromBech32(entry.VaultAddress); err != nil {
+			return fmt.Errorf("invalid outbound payment vault address at index %d: %w", i, err)
+		}
+		if _, exists := vaults[entry.VaultAddress]; !exists {
+			return fmt.Errorf("outbound payment at index %d is not for an imported vault: %s", i, entry.VaultAddress)
+		}
+		if err := exchange.ValidateExternalID(entry.Payment.ExternalId); err != nil {
+			return fmt.Errorf("invalid outbound payment external id at index %d: %w", i, err)
+		}
+		if _, err := sdk.AccAddressFromBech32(entry.Payment.Target); err != nil {
+			return fmt.Errorf("invalid outbound payment target at index %d: %w", i, err)
+		}
+		if err := entry.Payment.SourceAmount.Validate(); err != nil {
+			return fmt.Errorf("invalid outbound payment source amount at index %d: %w", i, err)
+		}
+		if err := entry.Payment.TargetAmount.Validate(); err != nil {
+			return fmt.Errorf("invalid outbound payment target amount at index %d: %w", i, err)
+		}
+		if entry.Payment.Direction != AssetDirectionInbound && entry.Payment.Direction != AssetDirectionOutbound {
+			return fmt.Errorf("invalid outbound payment direction at index %d: %q", i, entry.Payment.Direction)
+		}
+		key := entry.VaultAddress + "/" + entry.Payment.ExternalId
+		if paymentKeys[key] {
+			return fmt.Errorf("duplicate outbound payment for vault %s and external id %q", entry.VaultAddress, entry.Payment.ExternalId)
+		}
+		paymentKeys[key] = true
+	}
+
+	actionIDs := make(map[uint64]bool)
+	for i, action := range gs.ScheduledActionQueue.Actions {
+		if err := action.Validate(); err != nil {
+			return fmt.Errorf("invalid scheduled action at index %d: %w", i, err)
+		}
+		if _, exists := vaults[action.VaultAddress]; !exists {
+			return fmt.Errorf("scheduled action at index %d is not for an imported vault: %s", i, action.VaultAddress)
+		}
+		if action.Id >= gs.ScheduledActionQueue.LatestSequenceNumber {
+			return fmt.Errorf("scheduled action id %d must be below latest sequence number %d", action.Id, gs.ScheduledActionQueue.LatestSequenceNumber)
+		}
+		if actionIDs[action.Id] {
+			return fmt.Errorf("duplicate scheduled action id %d", action.Id)
+		}
+		actionIDs[action.Id] = true
+	}
+
+	allowlistKeys := make(map[string]bool)
+	for i, entry := range gs.Allowlist {
+		if _, err := sdk.AccAddressFromBech32(entry.VaultAddress); err != nil {
+			return fmt.Errorf("invalid allowlist vault address at index %d: %w", i, err)
+		}
+		if _, exists := vaults[entry.VaultAddress]; !exists {
+			return fmt.Errorf("allowlist entry at index %d is not for an imported vault: %s", i, entry.VaultAddress)
+		}
+		if _, err := sdk.AccAddressFromBech32(entry.Address); err != nil {
+			return fmt.Errorf("invalid allowlist address at index %d: %w", i, err)
+		}
+		key := entry.VaultAddress + "/" + entry.Address
+		if allowlistKeys[key] {
+			return fmt.Errorf("duplicate allowlist entry for vault %s and address %s", entry.VaultAddress, entry.Address)
+		}
+		allowlistKeys[key] = true
+	}
+
+	referenceKeys := make(map[string]bool)
+	for i, entry := range gs.NavReferences {
+		if _, err := sdk.AccAddressFromBech32(entry.VaultAddress); err != nil {
+			return fmt.Errorf("invalid nav reference vault address at index %d: %w", i, err)
+		}
+		if _, exists := vaults[entry.VaultAddress]; !exists {
+			return fmt.Errorf("nav reference at index %d is not for an imported vault: %s", i, entry.VaultAddress)
+		}
+		if err := sdk.ValidateDenom(entry.Nav.Denom); err != nil {
+			return fmt.Errorf("invalid nav reference denom at index %d: %w", i, err)
+		}
+		if err := entry.Nav.Price.Validate(); err != nil {
+			return fmt.Errorf("invalid nav reference price at index %d: %w", i, err)
+		}
+		if entry.Nav.Volume.IsNil() || !entry.Nav.Volume.IsPositive() {
+			return fmt.Errorf("nav reference volume at index %d must be positive", i)
+		}
+		key := entry.VaultAddress + "/" + entry.Nav.Denom
+		if referenceKeys[key] {
+			return fmt.Errorf("duplicate nav reference for vault %s denom %s", entry.VaultAddress, entry.Nav.Denom)
+		}
+		referenceKeys[key] = true
+	}
+
 	return nil
 }
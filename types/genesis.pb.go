@@ -273,6 +273,29 @@ type GenesisState struct {
 	Params Params `protobuf:"bytes,5,opt,name=params,proto3" json:"params"`
 	// navs contains the internal NAV table entries for all vaults at genesis.
 	Navs []VaultNAVEntry `protobuf:"bytes,6,rep,name=navs,proto3" json:"navs"`
+	// pending_nav_proposals contains the NAV updates awaiting approval for all vaults at genesis.
+	PendingNavProposals []PendingNAVProposal `protobuf:"bytes,7,rep,name=pending_nav_proposals,json=pendingNavProposals,proto3" json:"pending_nav_proposals"`
+	// nav_source_submissions contains the per-source NAV submissions for all vaults at genesis.
+	NavSourceSubmissions []NAVSourceSubmission `protobuf:"bytes,8,rep,name=nav_source_submissions,json=navSourceSubmissions,proto3" json:"nav_source_submissions"`
+	// nav_history contains the NAV history time series points for all vaults at genesis.
+	NavHistory []VaultNAVEntry `protobuf:"bytes,9,rep,name=nav_history,json=navHistory,proto3" json:"nav_history"`
+	// settlement_prices contains each vault's most recent exchange settlement price for
+	// each (denom, price denom) pair at genesis.
+	SettlementPrices []VaultNAVEntry `protobuf:"bytes,10,rep,name=settlement_prices,json=settlementPrices,proto3" json:"settlement_prices"`
+	// asset_haircuts contains the valuation haircuts configured for all vaults at genesis.
+	AssetHaircuts []AssetHaircutEntry `protobuf:"bytes,11,rep,name=asset_haircuts,json=assetHaircuts,proto3" json:"asset_haircuts"`
+	// share_price_observations contains the share price accumulator observations for all
+	// vaults at genesis.
+	SharePriceObservations []SharePriceObservationEntry `protobuf:"bytes,12,rep,name=share_price_observations,json=sharePriceObservations,proto3" json:"share_price_observations"`
+	// outbound_payments contains the open exchange payments created by vaults at genesis.
+	OutboundPayments []OutboundPaymentEntry `protobuf:"bytes,13,rep,name=outbound_payments,json=outboundPayments,proto3" json:"outbound_payments"`
+	// scheduled_action_queue contains the scheduled actions awaiting their vaults' timelocks.
+	ScheduledActionQueue ScheduledActionQueue `protobuf:"bytes,14,opt,name=scheduled_action_queue,json=scheduledActionQueue,proto3" json:"scheduled_action_queue"`
+	// allowlist contains the investor allowlist entries for all vaults at genesis.
+	Allowlist []AllowlistEntry `protobuf:"bytes,15,rep,name=allowlist,proto3" json:"allowlist"`
+	// nav_references contains, for all vaults at genesis, the price each denom's NAV change
+	// limit is measured against.
+	NavReferences []VaultNAVEntry `protobuf:"bytes,16,rep,name=nav_references,json=navReferences,proto3" json:"nav_references"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -350,52 +373,431 @@ func (m *GenesisState) GetNavs() []VaultNAVEntry {
 	return nil
 }
 
+func (m *GenesisState) GetPendingNavProposals() []PendingNAVProposal {
+	if m != nil {
+		return m.PendingNavProposals
+	}
+	return nil
+}
+
+func (m *GenesisState) GetNavSourceSubmissions() []NAVSourceSubmission {
+	if m != nil {
+		return m.NavSourceSubmissions
+	}
+	return nil
+}
+
+func (m *GenesisState) GetNavHistory() []VaultNAVEntry {
+	if m != nil {
+		return m.NavHistory
+	}
+	return nil
+}
+
+func (m *GenesisState) GetSettlementPrices() []VaultNAVEntry {
+	if m != nil {
+		return m.SettlementPrices
+	}
+	return nil
+}
+
+func (m *GenesisState) GetAssetHaircuts() []AssetHaircutEntry {
+	if m != nil {
+		return m.AssetHaircuts
+	}
+	return nil
+}
+
+func (m *GenesisState) GetSharePriceObservations() []SharePriceObservationEntry {
+	if m != nil {
+		return m.SharePriceObservations
+	}
+	return nil
+}
+
+func (m *GenesisState) GetOutboundPayments() []OutboundPaymentEntry {
+	if m != nil {
+		return m.OutboundPayments
+	}
+	return nil
+}
+
+func (m *GenesisState) GetScheduledActionQueue() ScheduledActionQueue {
+	if m != nil {
+		return m.ScheduledActionQueue
+	}
+	return ScheduledActionQueue{}
+}
+
+func (m *GenesisState) GetAllowlist() []AllowlistEntry {
+	if m != nil {
+		return m.Allowlist
+	}
+	return nil
+}
+
+func (m *GenesisState) GetNavReferences() []VaultNAVEntry {
+	if m != nil {
+		return m.NavReferences
+	}
+	return nil
+}
+
+// AllowlistEntry pairs a vault address with an address on its investor allowlist for genesis
+// import and export.
+type AllowlistEntry struct {
+	// vault_address is the bech32 address of the vault that owns the allowlist.
+	VaultAddress string `protobuf:"bytes,1,opt,name=vault_address,json=vaultAddress,proto3" json:"vault_address,omitempty"`
+	// address is the bech32 address on the allowlist.
+	Address string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+}
+
+func (m *AllowlistEntry) Reset()         { *m = AllowlistEntry{} }
+func (m *AllowlistEntry) String() string { return proto.CompactTextString(m) }
+func (*AllowlistEntry) ProtoMessage()    {}
+func (*AllowlistEntry) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b040ce5c03c7bf33, []int{5}
+}
+func (m *AllowlistEntry) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AllowlistEntry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AllowlistEntry.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AllowlistEntry) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AllowlistEntry.Merge(m, src)
+}
+func (m *AllowlistEntry) XXX_Size() int {
+	return m.Size()
+}
+func (m *AllowlistEntry) XXX_DiscardUnknown() {
+	xxx_messageInfo_AllowlistEntry.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AllowlistEntry proto.InternalMessageInfo
+
+func (m *AllowlistEntry) GetVaultAddress() string {
+	if m != nil {
+		return m.VaultAddress
+	}
+	return ""
+}
+
+func (m *AllowlistEntry) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+// ScheduledActionQueue holds the latest sequence number and all scheduled actions.
+type ScheduledActionQueue struct {
+	// latest_sequence_number is the most recently assigned scheduled action ID.
+	LatestSequenceNumber uint64 `protobuf:"varint,1,opt,name=latest_sequence_number,json=latestSequenceNumber,proto3" json:"latest_sequence_number,omitempty"`
+	// actions contains all scheduled actions awaiting execution.
+	Actions []ScheduledAction `protobuf:"bytes,2,rep,name=actions,proto3" json:"actions"`
+}
+
+func (m *ScheduledActionQueue) Reset()         { *m = ScheduledActionQueue{} }
+func (m *ScheduledActionQueue) String() string { return proto.CompactTextString(m) }
+func (*ScheduledActionQueue) ProtoMessage()    {}
+func (*ScheduledActionQueue) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b040ce5c03c7bf33, []int{6}
+}
+func (m *ScheduledActionQueue) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ScheduledActionQueue) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ScheduledActionQueue.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ScheduledActionQueue) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ScheduledActionQueue.Merge(m, src)
+}
+func (m *ScheduledActionQueue) XXX_Size() int {
+	return m.Size()
+}
+func (m *ScheduledActionQueue) XXX_DiscardUnknown() {
+	xxx_messageInfo_ScheduledActionQueue.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ScheduledActionQueue proto.InternalMessageInfo
+
+func (m *ScheduledActionQueue) GetLatestSequenceNumber() uint64 {
+	if m != nil {
+		return m.LatestSequenceNumber
+	}
+	return 0
+}
+
+func (m *ScheduledActionQueue) GetActions() []ScheduledAction {
+	if m != nil {
+		return m.Actions
+	}
+	return nil
+}
+
+// AssetHaircutEntry pairs a vault address with one of its valuation haircuts for genesis
+// import and export.
+type AssetHaircutEntry struct {
+	// vault_address is the bech32 address of the vault that owns this haircut.
+	VaultAddress string `protobuf:"bytes,1,opt,name=vault_address,json=vaultAddress,proto3" json:"vault_address,omitempty"`
+	// haircut is the valuation haircut configured by the vault.
+	Haircut AssetHaircut `protobuf:"bytes,2,opt,name=haircut,proto3" json:"haircut"`
+}
+
+func (m *AssetHaircutEntry) Reset()         { *m = AssetHaircutEntry{} }
+func (m *AssetHaircutEntry) String() string { return proto.CompactTextString(m) }
+func (*AssetHaircutEntry) ProtoMessage()    {}
+func (*AssetHaircutEntry) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b040ce5c03c7bf33, []int{7}
+}
+func (m *AssetHaircutEntry) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AssetHaircutEntry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AssetHaircutEntry.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AssetHaircutEntry) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AssetHaircutEntry.Merge(m, src)
+}
+func (m *AssetHaircutEntry) XXX_Size() int {
+	return m.Size()
+}
+func (m *AssetHaircutEntry) XXX_DiscardUnknown() {
+	xxx_messageInfo_AssetHaircutEntry.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AssetHaircutEntry proto.InternalMessageInfo
+
+func (m *AssetHaircutEntry) GetVaultAddress() string {
+	if m != nil {
+		return m.VaultAddress
+	}
+	return ""
+}
+
+func (m *AssetHaircutEntry) GetHaircut() AssetHaircut {
+	if m != nil {
+		return m.Haircut
+	}
+	return AssetHaircut{}
+}
+
+// SharePriceObservationEntry pairs a vault address with one of its share price
+// accumulator observations for genesis import and export.
+type SharePriceObservationEntry struct {
+	// vault_address is the bech32 address of the vault that owns this observation.
+	VaultAddress string `protobuf:"bytes,1,opt,name=vault_address,json=vaultAddress,proto3" json:"vault_address,omitempty"`
+	// observation is the share price accumulator observation.
+	Observation SharePriceObservation `protobuf:"bytes,2,opt,name=observation,proto3" json:"observation"`
+}
+
+func (m *SharePriceObservationEntry) Reset()         { *m = SharePriceObservationEntry{} }
+func (m *SharePriceObservationEntry) String() string { return proto.CompactTextString(m) }
+func (*SharePriceObservationEntry) ProtoMessage()    {}
+func (*SharePriceObservationEntry) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b040ce5c03c7bf33, []int{8}
+}
+func (m *SharePriceObservationEntry) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SharePriceObservationEntry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SharePriceObservationEntry.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SharePriceObservationEntry) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SharePriceObservationEntry.Merge(m, src)
+}
+func (m *SharePriceObservationEntry) XXX_Size() int {
+	return m.Size()
+}
+func (m *SharePriceObservationEntry) XXX_DiscardUnknown() {
+	xxx_messageInfo_SharePriceObservationEntry.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SharePriceObservationEntry proto.InternalMessageInfo
+
+func (m *SharePriceObservationEntry) GetVaultAddress() string {
+	if m != nil {
+		return m.VaultAddress
+	}
+	return ""
+}
+
+func (m *SharePriceObservationEntry) GetObservation() SharePriceObservation {
+	if m != nil {
+		return m.Observation
+	}
+	return SharePriceObservation{}
+}
+
+// OutboundPaymentEntry pairs a vault address with one of its open outbound exchange
+// payments for genesis import and export.
+type OutboundPaymentEntry struct {
+	// vault_address is the bech32 address of the vault that created the payment.
+	VaultAddress string `protobuf:"bytes,1,opt,name=vault_address,json=vaultAddress,proto3" json:"vault_address,omitempty"`
+	// payment is the open outbound payment.
+	Payment OutboundPayment `protobuf:"bytes,2,opt,name=payment,proto3" json:"payment"`
+}
+
+func (m *OutboundPaymentEntry) Reset()         { *m = OutboundPaymentEntry{} }
+func (m *OutboundPaymentEntry) String() string { return proto.CompactTextString(m) }
+func (*OutboundPaymentEntry) ProtoMessage()    {}
+func (*OutboundPaymentEntry) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b040ce5c03c7bf33, []int{9}
+}
+func (m *OutboundPaymentEntry) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *OutboundPaymentEntry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_OutboundPaymentEntry.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *OutboundPaymentEntry) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OutboundPaymentEntry.Merge(m, src)
+}
+func (m *OutboundPaymentEntry) XXX_Size() int {
+	return m.Size()
+}
+func (m *OutboundPaymentEntry) XXX_DiscardUnknown() {
+	xxx_messageInfo_OutboundPaymentEntry.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_OutboundPaymentEntry proto.InternalMessageInfo
+
+func (m *OutboundPaymentEntry) GetVaultAddress() string {
+	if m != nil {
+		return m.VaultAddress
+	}
+	return ""
+}
+
+func (m *OutboundPaymentEntry) GetPayment() OutboundPayment {
+	if m != nil {
+		return m.Payment
+	}
+	return OutboundPayment{}
+}
+
 func init() {
 	proto.RegisterType((*QueueEntry)(nil), "provlabs.vault.v1.QueueEntry")
 	proto.RegisterType((*PendingSwapOutQueueEntry)(nil), "provlabs.vault.v1.PendingSwapOutQueueEntry")
 	proto.RegisterType((*PendingSwapOutQueue)(nil), "provlabs.vault.v1.PendingSwapOutQueue")
 	proto.RegisterType((*VaultNAVEntry)(nil), "provlabs.vault.v1.VaultNAVEntry")
 	proto.RegisterType((*GenesisState)(nil), "provlabs.vault.v1.GenesisState")
+	proto.RegisterType((*AllowlistEntry)(nil), "provlabs.vault.v1.AllowlistEntry")
+	proto.RegisterType((*ScheduledActionQueue)(nil), "provlabs.vault.v1.ScheduledActionQueue")
+	proto.RegisterType((*AssetHaircutEntry)(nil), "provlabs.vault.v1.AssetHaircutEntry")
+	proto.RegisterType((*SharePriceObservationEntry)(nil), "provlabs.vault.v1.SharePriceObservationEntry")
+	proto.RegisterType((*OutboundPaymentEntry)(nil), "provlabs.vault.v1.OutboundPaymentEntry")
 }
 
 func init() { proto.RegisterFile("provlabs/vault/v1/genesis.proto", fileDescriptor_b040ce5c03c7bf33) }
 
 var fileDescriptor_b040ce5c03c7bf33 = []byte{
-	// 534 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x93, 0x4f, 0x6b, 0x13, 0x5d,
-	0x14, 0xc6, 0x33, 0xc9, 0xbc, 0xe9, 0xdb, 0x93, 0x56, 0xe9, 0x6d, 0x28, 0x63, 0xa4, 0x93, 0x18,
-	0x41, 0x02, 0xc2, 0x84, 0xa6, 0x05, 0x51, 0x70, 0x91, 0x80, 0xb8, 0x10, 0x5a, 0x4d, 0xb4, 0x0b,
-	0x37, 0xc3, 0x4d, 0x72, 0x1a, 0x07, 0x92, 0x3b, 0xd3, 0xb9, 0xf7, 0x4e, 0xc9, 0xd6, 0x4f, 0xe0,
-	0xd2, 0x8f, 0xd4, 0x8d, 0xd0, 0xa5, 0x2b, 0x91, 0xe4, 0x8b, 0xc8, 0xfd, 0x33, 0x68, 0x9b, 0x54,
-	0xbb, 0xca, 0xcd, 0x3d, 0xe7, 0xfc, 0xce, 0x73, 0x9e, 0x33, 0x17, 0xea, 0x49, 0x1a, 0x67, 0x53,
-	0x3a, 0xe4, 0xed, 0x8c, 0xca, 0xa9, 0x68, 0x67, 0x07, 0xed, 0x09, 0x32, 0xe4, 0x11, 0x0f, 0x92,
-	0x34, 0x16, 0x31, 0xd9, 0xc9, 0x13, 0x02, 0x9d, 0x10, 0x64, 0x07, 0xb5, 0xea, 0x24, 0x9e, 0xc4,
-	0x3a, 0xda, 0x56, 0x27, 0x93, 0x58, 0xf3, 0x57, 0x49, 0x09, 0x4d, 0xe9, 0xcc, 0x82, 0x6a, 0xfb,
-	0xab, 0x71, 0x43, 0xd4, 0xe1, 0xe6, 0x11, 0xc0, 0x3b, 0x89, 0x12, 0x5f, 0x31, 0x91, 0xce, 0x09,
-	0x01, 0x57, 0x44, 0x33, 0xf4, 0x9c, 0x86, 0xd3, 0x72, 0xfb, 0xfa, 0xac, 0xee, 0xe8, 0x78, 0x9c,
-	0x7a, 0xc5, 0x86, 0xd3, 0xda, 0xec, 0xeb, 0x73, 0xf3, 0xb3, 0x03, 0xde, 0x5b, 0x64, 0xe3, 0x88,
-	0x4d, 0x06, 0x17, 0x34, 0x39, 0x91, 0xe2, 0x16, 0x48, 0xc9, 0x42, 0xee, 0x41, 0x31, 0x1a, 0x6b,
-	0x84, 0xdb, 0x2f, 0x46, 0x63, 0xd2, 0x83, 0xff, 0xf9, 0x05, 0x4d, 0xc2, 0x58, 0x0a, 0xaf, 0xd4,
-	0x70, 0x5a, 0x95, 0xce, 0xa3, 0x60, 0x65, 0xe2, 0xe0, 0x7a, 0x8b, 0x9e, 0x7b, 0xf9, 0xa3, 0x5e,
-	0xe8, 0x6f, 0x70, 0xf3, 0xb7, 0xf9, 0xd5, 0x81, 0xdd, 0x35, 0x22, 0xc8, 0x11, 0xec, 0x4d, 0xa9,
-	0x40, 0x2e, 0x42, 0x8e, 0xe7, 0x12, 0xd9, 0x08, 0x43, 0x26, 0x67, 0x43, 0x4c, 0xed, 0x58, 0x55,
-	0x13, 0x1d, 0xd8, 0xe0, 0xb1, 0x8e, 0x91, 0x37, 0xb0, 0x81, 0x4c, 0xa4, 0x11, 0x72, 0xaf, 0xd8,
-	0x28, 0xb5, 0x2a, 0x9d, 0xa7, 0xff, 0x14, 0xf4, 0x7b, 0xe6, 0x5c, 0x9a, 0x25, 0x34, 0x23, 0xd8,
-	0x3e, 0x55, 0x35, 0xc7, 0xdd, 0x53, 0xe3, 0xc9, 0x63, 0xd8, 0xd6, 0x90, 0x50, 0xd9, 0x87, 0x9c,
-	0x6b, 0x29, 0x9b, 0xfd, 0x2d, 0x7d, 0xd9, 0x35, 0x77, 0xe4, 0x10, 0x4a, 0x8c, 0x66, 0xda, 0xa5,
-	0x4a, 0xe7, 0xe1, 0x9a, 0xf6, 0x39, 0xd3, 0xb6, 0x53, 0xd9, 0xcd, 0x6f, 0x25, 0xd8, 0x7a, 0x6d,
-	0x3e, 0x9d, 0x81, 0xa0, 0x02, 0xc9, 0x4b, 0x28, 0xeb, 0x02, 0xd5, 0x43, 0xcd, 0x51, 0xbf, 0x0d,
-	0xd4, 0x1d, 0x8d, 0x62, 0xc9, 0x72, 0x5b, 0x6d, 0x11, 0xf9, 0x00, 0xd5, 0x84, 0xce, 0x63, 0x29,
-	0x42, 0xb5, 0x38, 0xf5, 0x7b, 0xae, 0xc6, 0xb4, 0xa6, 0xec, 0xaf, 0x81, 0xad, 0xd8, 0x40, 0x0c,
-	0xe0, 0xbd, 0xa9, 0x37, 0x4b, 0xa1, 0xb0, 0x97, 0x18, 0xf3, 0xc2, 0x7c, 0xf1, 0x16, 0x6c, 0xd6,
-	0xff, 0xe4, 0x6e, 0x6e, 0xdb, 0x0e, 0xbb, 0xc9, 0x9a, 0xbd, 0x9f, 0xc0, 0xce, 0x19, 0xe2, 0x0d,
-	0xd9, 0xee, 0xdd, 0x65, 0xdf, 0x3f, 0x43, 0xbc, 0xa6, 0xf9, 0x19, 0x94, 0xcd, 0x53, 0xf2, 0xfe,
-	0xd3, 0x1a, 0x1f, 0xac, 0xd3, 0xa8, 0x13, 0x72, 0x0f, 0x4d, 0x3a, 0x79, 0x01, 0x2e, 0xa3, 0x19,
-	0xf7, 0xca, 0xba, 0x79, 0xe3, 0x2f, 0x9b, 0xfc, 0xb3, 0xbf, 0xae, 0xe9, 0x3d, 0xbf, 0x5c, 0xf8,
-	0xce, 0xd5, 0xc2, 0x77, 0x7e, 0x2e, 0x7c, 0xe7, 0xcb, 0xd2, 0x2f, 0x5c, 0x2d, 0xfd, 0xc2, 0xf7,
-	0xa5, 0x5f, 0xf8, 0x58, 0x9f, 0x44, 0xe2, 0x93, 0x1c, 0x06, 0xa3, 0x78, 0xd6, 0xbe, 0xf1, 0xa8,
-	0xc5, 0x3c, 0x41, 0x3e, 0x2c, 0xeb, 0x27, 0x7d, 0xf8, 0x2b, 0x00, 0x00, 0xff, 0xff, 0x46, 0x0c,
-	0x7c, 0x3d, 0x5d, 0x04, 0x00, 0x00,
+	// 943 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x56, 0x5d, 0x6f, 0x1a, 0x47,
+	0x14, 0xf5, 0xda, 0xd4, 0xc4, 0x17, 0x9b, 0x84, 0x09, 0x45, 0x5b, 0x57, 0x01, 0xba, 0xfd, 0x42,
+	0xaa, 0x0a, 0x8a, 0x13, 0xa9, 0x6a, 0xa5, 0xaa, 0x02, 0x29, 0x4a, 0xa4, 0xaa, 0x40, 0xa0, 0xf5,
+	0x43, 0x5e, 0x56, 0xc3, 0x32, 0x86, 0x95, 0x96, 0x9d, 0xcd, 0xde, 0xd9, 0xb5, 0x78, 0xaa, 0xd4,
+	0x5f, 0x10, 0xa9, 0x2f, 0xfd, 0x49, 0xe9, 0x5b, 0x1e, 0xfb, 0x54, 0x55, 0xf6, 0x1f, 0xa9, 0xe6,
+	0x63, 0x63, 0x30, 0xeb, 0x1a, 0xf7, 0x89, 0x65, 0xef, 0xb9, 0xe7, 0x9c, 0x39, 0x33, 0x97, 0x01,
+	0x1a, 0x51, 0xcc, 0xd3, 0x80, 0x4e, 0xb0, 0x93, 0xd2, 0x24, 0x10, 0x9d, 0xf4, 0x71, 0x67, 0xc6,
+	0x42, 0x86, 0x3e, 0xb6, 0xa3, 0x98, 0x0b, 0x4e, 0x2a, 0x19, 0xa0, 0xad, 0x00, 0xed, 0xf4, 0xf1,
+	0x71, 0x75, 0xc6, 0x67, 0x5c, 0x55, 0x3b, 0xf2, 0x49, 0x03, 0x8f, 0xeb, 0x9b, 0x4c, 0x11, 0x8d,
+	0xe9, 0xc2, 0x10, 0x1d, 0x3f, 0xda, 0xac, 0x6b, 0x46, 0x55, 0x76, 0x9e, 0x02, 0xbc, 0x4c, 0x58,
+	0xc2, 0x9e, 0x85, 0x22, 0x5e, 0x12, 0x02, 0x05, 0xe1, 0x2f, 0x98, 0x6d, 0x35, 0xad, 0x56, 0x61,
+	0xa4, 0x9e, 0xe5, 0x3b, 0x3a, 0x9d, 0xc6, 0xf6, 0x6e, 0xd3, 0x6a, 0x1d, 0x8c, 0xd4, 0xb3, 0xf3,
+	0x9b, 0x05, 0xf6, 0x90, 0x85, 0x53, 0x3f, 0x9c, 0x8d, 0xcf, 0x69, 0x34, 0x48, 0xc4, 0x0d, 0x24,
+	0x7b, 0x86, 0xa4, 0x0c, 0xbb, 0xfe, 0x54, 0x51, 0x14, 0x46, 0xbb, 0xfe, 0x94, 0xf4, 0xe0, 0x1e,
+	0x9e, 0xd3, 0xc8, 0xe5, 0x89, 0xb0, 0xf7, 0x9a, 0x56, 0xab, 0x74, 0xf2, 0x49, 0x7b, 0x63, 0xc5,
+	0xed, 0x75, 0x89, 0x5e, 0xe1, 0xed, 0xdf, 0x8d, 0x9d, 0x51, 0x11, 0xf5, 0x57, 0xe7, 0x0f, 0x0b,
+	0x1e, 0xe6, 0x98, 0x20, 0x4f, 0xa1, 0x16, 0x50, 0xc1, 0x50, 0xb8, 0xc8, 0x5e, 0x27, 0x2c, 0xf4,
+	0x98, 0x1b, 0x26, 0x8b, 0x09, 0x8b, 0xcd, 0xb2, 0xaa, 0xba, 0x3a, 0x36, 0xc5, 0xbe, 0xaa, 0x91,
+	0x1f, 0xa1, 0xc8, 0x42, 0x11, 0xfb, 0x0c, 0xed, 0xdd, 0xe6, 0x5e, 0xab, 0x74, 0xf2, 0xd5, 0xad,
+	0x86, 0xae, 0xd6, 0x9c, 0x59, 0x33, 0x0c, 0x8e, 0x0f, 0x47, 0xa7, 0xb2, 0xa7, 0xdf, 0x3d, 0xd5,
+	0x99, 0x7c, 0x0a, 0x47, 0x8a, 0xc4, 0x95, 0xf1, 0x31, 0x44, 0x65, 0xe5, 0x60, 0x74, 0xa8, 0x5e,
+	0x76, 0xf5, 0x3b, 0xf2, 0x04, 0xf6, 0x42, 0x9a, 0xaa, 0x94, 0x4a, 0x27, 0x1f, 0xe7, 0xc8, 0x67,
+	0x9c, 0x46, 0x4e, 0xa2, 0x9d, 0x3f, 0x01, 0x0e, 0x9f, 0xeb, 0xa3, 0x33, 0x16, 0x54, 0x30, 0xf2,
+	0x3d, 0xec, 0xab, 0x06, 0xa9, 0x21, 0xd7, 0xd1, 0xb8, 0x89, 0xa8, 0xeb, 0x79, 0x3c, 0x09, 0xb3,
+	0x58, 0x4d, 0x13, 0xf9, 0x05, 0xaa, 0x11, 0x5d, 0xf2, 0x44, 0xb8, 0x72, 0xe3, 0xe4, 0xe7, 0x6b,
+	0xb9, 0x4c, 0x13, 0xca, 0xa3, 0x1c, 0xb2, 0x8d, 0x18, 0x88, 0x26, 0xf8, 0x59, 0xf7, 0xeb, 0x4d,
+	0xa1, 0x50, 0x8b, 0x74, 0x78, 0x6e, 0xb6, 0xf1, 0x86, 0x58, 0x6f, 0xff, 0x17, 0xdb, 0xa5, 0x6d,
+	0x14, 0x1e, 0x46, 0x39, 0xfb, 0x3e, 0x80, 0xca, 0x19, 0x63, 0xd7, 0x6c, 0x17, 0xb6, 0xb7, 0x7d,
+	0xff, 0x8c, 0xb1, 0x35, 0xcf, 0xdf, 0xc0, 0xbe, 0x1e, 0x25, 0xfb, 0x03, 0xe5, 0xf1, 0xa3, 0x3c,
+	0x8f, 0x0a, 0x90, 0x65, 0xa8, 0xe1, 0xe4, 0x3b, 0x28, 0x84, 0x34, 0x45, 0x7b, 0x5f, 0x89, 0x37,
+	0xff, 0x63, 0x27, 0x57, 0xf5, 0x55, 0x0f, 0x71, 0xe1, 0xc3, 0x2c, 0xa8, 0x90, 0xa6, 0x6e, 0x14,
+	0xf3, 0x88, 0x23, 0x0d, 0xd0, 0x2e, 0x2a, 0xb2, 0xcf, 0x6f, 0xce, 0xa9, 0xdf, 0x3d, 0x1d, 0x1a,
+	0xf4, 0xb5, 0x98, 0xfa, 0x34, 0xcd, 0x2a, 0x48, 0x26, 0x50, 0x93, 0xc4, 0xc8, 0x93, 0xd8, 0x63,
+	0x2e, 0x26, 0x93, 0x85, 0x8f, 0xe8, 0xf3, 0x10, 0xed, 0x7b, 0x4a, 0x21, 0x6f, 0x27, 0xfa, 0xdd,
+	0xd3, 0xb1, 0xc2, 0x8f, 0xdf, 0xc3, 0x8d, 0x44, 0x35, 0xa4, 0xe9, 0xf5, 0x12, 0x92, 0xe7, 0x50,
+	0x92, 0x1a, 0x73, 0x1f, 0x05, 0x8f, 0x97, 0xf6, 0xc1, 0x9d, 0x72, 0x80, 0x90, 0xa6, 0x2f, 0x74,
+	0x27, 0x19, 0x43, 0x05, 0x99, 0x10, 0x01, 0x5b, 0xb0, 0x50, 0xb8, 0x51, 0xec, 0x7b, 0x0c, 0x6d,
+	0xb8, 0x13, 0xdd, 0x83, 0x2b, 0x82, 0xa1, 0xea, 0x27, 0x2f, 0xa1, 0x4c, 0x11, 0x99, 0x70, 0xe7,
+	0xd4, 0x8f, 0xbd, 0x44, 0xa0, 0x5d, 0x52, 0x8c, 0x9f, 0xe5, 0x30, 0x76, 0x25, 0xf0, 0x85, 0xc6,
+	0xad, 0xb2, 0x1e, 0xd1, 0x95, 0x02, 0x92, 0x05, 0xd8, 0x38, 0xa7, 0x31, 0xd3, 0x16, 0x5d, 0x3e,
+	0x41, 0x16, 0xa7, 0x54, 0xa8, 0x58, 0x0f, 0x15, 0xf9, 0xd7, 0x39, 0xe4, 0x63, 0xd9, 0xa2, 0x4c,
+	0x0d, 0xae, 0x1a, 0x56, 0x55, 0x6a, 0x98, 0x87, 0x40, 0xf2, 0x0a, 0x2a, 0x3c, 0x11, 0x13, 0x9e,
+	0x84, 0x53, 0x37, 0xa2, 0x4b, 0xb9, 0x36, 0xb4, 0x8f, 0x94, 0xce, 0x97, 0x39, 0x3a, 0x03, 0x83,
+	0x1d, 0x6a, 0xe8, 0x5a, 0x3a, 0x7c, 0xbd, 0x86, 0xc4, 0x83, 0x1a, 0x7a, 0x73, 0x36, 0x4d, 0x02,
+	0x36, 0x75, 0xa9, 0x27, 0x05, 0xcd, 0x2c, 0x95, 0xd5, 0x14, 0xe4, 0x09, 0x8c, 0xb3, 0x86, 0xae,
+	0xc2, 0xaf, 0x8e, 0x6a, 0x15, 0x73, 0x6a, 0xe4, 0x19, 0x1c, 0xd0, 0x20, 0xe0, 0xe7, 0x81, 0x8f,
+	0xc2, 0xbe, 0xaf, 0x8c, 0xe7, 0x5d, 0x00, 0xdd, 0x0c, 0xb3, 0x6a, 0xf9, 0xaa, 0x93, 0xfc, 0x04,
+	0x65, 0x79, 0xce, 0x62, 0x76, 0xc6, 0x62, 0xf9, 0x5b, 0x8e, 0xf6, 0x83, 0x3b, 0x9d, 0x8d, 0xa3,
+	0x90, 0xa6, 0xa3, 0xf7, 0xcd, 0xce, 0x00, 0xca, 0xeb, 0x8a, 0xdb, 0xfd, 0x6e, 0xdb, 0x50, 0xcc,
+	0xca, 0xfa, 0x92, 0xcc, 0xbe, 0x3a, 0x6f, 0x2c, 0xa8, 0xe6, 0x65, 0xf3, 0x3f, 0xef, 0xa8, 0x1e,
+	0x14, 0xf5, 0x86, 0x64, 0x77, 0x94, 0x73, 0xfb, 0x5e, 0x64, 0x57, 0x93, 0x69, 0x74, 0x96, 0x50,
+	0xd9, 0x38, 0xd3, 0xdb, 0x2d, 0xf3, 0x07, 0x28, 0x9a, 0x81, 0x31, 0x57, 0x54, 0xe3, 0x96, 0x79,
+	0xc9, 0xa4, 0x4d, 0x97, 0xf3, 0xbb, 0x05, 0xc7, 0x37, 0x1f, 0xf9, 0xed, 0x4c, 0x0c, 0xa1, 0xb4,
+	0x32, 0x5c, 0xc6, 0x48, 0x6b, 0xdb, 0xd9, 0x32, 0x8e, 0x56, 0x29, 0x9c, 0x5f, 0xa1, 0x9a, 0x37,
+	0x1f, 0xdb, 0xd9, 0xe9, 0x41, 0xd1, 0xcc, 0x9f, 0xb1, 0xe2, 0xdc, 0x3e, 0x7e, 0x59, 0x2c, 0xa6,
+	0xb1, 0xf7, 0xed, 0xdb, 0x8b, 0xba, 0xf5, 0xee, 0xa2, 0x6e, 0xfd, 0x73, 0x51, 0xb7, 0xde, 0x5c,
+	0xd6, 0x77, 0xde, 0x5d, 0xd6, 0x77, 0xfe, 0xba, 0xac, 0xef, 0xbc, 0x6a, 0xcc, 0x7c, 0x31, 0x4f,
+	0x26, 0x6d, 0x8f, 0x2f, 0x3a, 0xd7, 0xfe, 0xc6, 0x89, 0x65, 0xc4, 0x70, 0xb2, 0xaf, 0xfe, 0xc4,
+	0x3d, 0xf9, 0x37, 0x00, 0x00, 0xff, 0xff, 0xa0, 0xf5, 0x0f, 0x4e, 0x4f, 0x0a, 0x00, 0x00,
 }
 
 func (m *QueueEntry) Marshal() (dAtA []byte, err error) {
@@ -578,10 +980,10 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
-	if len(m.Navs) > 0 {
-		for iNdEx := len(m.Navs) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.NavReferences) > 0 {
+		for iNdEx := len(m.NavReferences) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.Navs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.NavReferences[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -589,11 +991,27 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 				i = encodeVarintGenesis(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0x32
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x82
+		}
+	}
+	if len(m.Allowlist) > 0 {
+		for iNdEx := len(m.Allowlist) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Allowlist[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x7a
 		}
 	}
 	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		size, err := m.ScheduledActionQueue.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
 			return 0, err
 		}
@@ -601,11 +1019,11 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i = encodeVarintGenesis(dAtA, i, uint64(size))
 	}
 	i--
-	dAtA[i] = 0x2a
-	if len(m.FeeTimeoutQueue) > 0 {
-		for iNdEx := len(m.FeeTimeoutQueue) - 1; iNdEx >= 0; iNdEx-- {
+	dAtA[i] = 0x72
+	if len(m.OutboundPayments) > 0 {
+		for iNdEx := len(m.OutboundPayments) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.FeeTimeoutQueue[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.OutboundPayments[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -613,23 +1031,27 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 				i = encodeVarintGenesis(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0x22
+			dAtA[i] = 0x6a
 		}
 	}
-	{
-		size, err := m.PendingSwapOutQueue.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
+	if len(m.SharePriceObservations) > 0 {
+		for iNdEx := len(m.SharePriceObservations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SharePriceObservations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x62
 		}
-		i -= size
-		i = encodeVarintGenesis(dAtA, i, uint64(size))
 	}
-	i--
-	dAtA[i] = 0x1a
-	if len(m.PayoutTimeoutQueue) > 0 {
-		for iNdEx := len(m.PayoutTimeoutQueue) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.AssetHaircuts) > 0 {
+		for iNdEx := len(m.AssetHaircuts) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.PayoutTimeoutQueue[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.AssetHaircuts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -637,13 +1059,13 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 				i = encodeVarintGenesis(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0x12
+			dAtA[i] = 0x5a
 		}
 	}
-	if len(m.Vaults) > 0 {
-		for iNdEx := len(m.Vaults) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.SettlementPrices) > 0 {
+		for iNdEx := len(m.SettlementPrices) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.Vaults[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.SettlementPrices[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -651,97 +1073,414 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 				i = encodeVarintGenesis(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0xa
+			dAtA[i] = 0x52
+		}
+	}
+	if len(m.NavHistory) > 0 {
+		for iNdEx := len(m.NavHistory) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.NavHistory[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if len(m.NavSourceSubmissions) > 0 {
+		for iNdEx := len(m.NavSourceSubmissions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.NavSourceSubmissions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if len(m.PendingNavProposals) > 0 {
+		for iNdEx := len(m.PendingNavProposals) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PendingNavProposals[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.Navs) > 0 {
+		for iNdEx := len(m.Navs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Navs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	if len(m.FeeTimeoutQueue) > 0 {
+		for iNdEx := len(m.FeeTimeoutQueue) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FeeTimeoutQueue[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	{
+		size, err := m.PendingSwapOutQueue.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.PayoutTimeoutQueue) > 0 {
+		for iNdEx := len(m.PayoutTimeoutQueue) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PayoutTimeoutQueue[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Vaults) > 0 {
+		for iNdEx := len(m.Vaults) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Vaults[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
 		}
 	}
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintGenesis(dAtA []byte, offset int, v uint64) int {
-	offset -= sovGenesis(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *AllowlistEntry) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *QueueEntry) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *AllowlistEntry) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AllowlistEntry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Time != 0 {
-		n += 1 + sovGenesis(uint64(m.Time))
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x12
 	}
-	l = len(m.Addr)
-	if l > 0 {
-		n += 1 + l + sovGenesis(uint64(l))
+	if len(m.VaultAddress) > 0 {
+		i -= len(m.VaultAddress)
+		copy(dAtA[i:], m.VaultAddress)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.VaultAddress)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *PendingSwapOutQueueEntry) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *ScheduledActionQueue) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *ScheduledActionQueue) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ScheduledActionQueue) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Time != 0 {
-		n += 1 + sovGenesis(uint64(m.Time))
+	if len(m.Actions) > 0 {
+		for iNdEx := len(m.Actions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Actions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
 	}
-	if m.Id != 0 {
-		n += 1 + sovGenesis(uint64(m.Id))
+	if m.LatestSequenceNumber != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.LatestSequenceNumber))
+		i--
+		dAtA[i] = 0x8
 	}
-	l = m.SwapOut.Size()
-	n += 1 + l + sovGenesis(uint64(l))
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *PendingSwapOutQueue) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *AssetHaircutEntry) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *AssetHaircutEntry) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AssetHaircutEntry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.LatestSequenceNumber != 0 {
-		n += 1 + sovGenesis(uint64(m.LatestSequenceNumber))
-	}
-	if len(m.Entries) > 0 {
-		for _, e := range m.Entries {
-			l = e.Size()
-			n += 1 + l + sovGenesis(uint64(l))
+	{
+		size, err := m.Haircut.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
 		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
 	}
-	return n
+	i--
+	dAtA[i] = 0x12
+	if len(m.VaultAddress) > 0 {
+		i -= len(m.VaultAddress)
+		copy(dAtA[i:], m.VaultAddress)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.VaultAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *VaultNAVEntry) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.VaultAddress)
-	if l > 0 {
-		n += 1 + l + sovGenesis(uint64(l))
+func (m *SharePriceObservationEntry) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	l = m.Nav.Size()
-	n += 1 + l + sovGenesis(uint64(l))
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *GenesisState) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *SharePriceObservationEntry) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SharePriceObservationEntry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.Vaults) > 0 {
-		for _, e := range m.Vaults {
+	{
+		size, err := m.Observation.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.VaultAddress) > 0 {
+		i -= len(m.VaultAddress)
+		copy(dAtA[i:], m.VaultAddress)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.VaultAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *OutboundPaymentEntry) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *OutboundPaymentEntry) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *OutboundPaymentEntry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Payment.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.VaultAddress) > 0 {
+		i -= len(m.VaultAddress)
+		copy(dAtA[i:], m.VaultAddress)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.VaultAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintGenesis(dAtA []byte, offset int, v uint64) int {
+	offset -= sovGenesis(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueueEntry) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Time != 0 {
+		n += 1 + sovGenesis(uint64(m.Time))
+	}
+	l = len(m.Addr)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	return n
+}
+
+func (m *PendingSwapOutQueueEntry) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Time != 0 {
+		n += 1 + sovGenesis(uint64(m.Time))
+	}
+	if m.Id != 0 {
+		n += 1 + sovGenesis(uint64(m.Id))
+	}
+	l = m.SwapOut.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	return n
+}
+
+func (m *PendingSwapOutQueue) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.LatestSequenceNumber != 0 {
+		n += 1 + sovGenesis(uint64(m.LatestSequenceNumber))
+	}
+	if len(m.Entries) > 0 {
+		for _, e := range m.Entries {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *VaultNAVEntry) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.VaultAddress)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = m.Nav.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	return n
+}
+
+func (m *GenesisState) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Vaults) > 0 {
+		for _, e := range m.Vaults {
 			l = e.Size()
 			n += 1 + l + sovGenesis(uint64(l))
 		}
@@ -768,6 +1507,142 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.PendingNavProposals) > 0 {
+		for _, e := range m.PendingNavProposals {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.NavSourceSubmissions) > 0 {
+		for _, e := range m.NavSourceSubmissions {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.NavHistory) > 0 {
+		for _, e := range m.NavHistory {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.SettlementPrices) > 0 {
+		for _, e := range m.SettlementPrices {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.AssetHaircuts) > 0 {
+		for _, e := range m.AssetHaircuts {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.SharePriceObservations) > 0 {
+		for _, e := range m.SharePriceObservations {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.OutboundPayments) > 0 {
+		for _, e := range m.OutboundPayments {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	l = m.ScheduledActionQueue.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.Allowlist) > 0 {
+		for _, e := range m.Allowlist {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.NavReferences) > 0 {
+		for _, e := range m.NavReferences {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *AllowlistEntry) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.VaultAddress)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	return n
+}
+
+func (m *ScheduledActionQueue) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.LatestSequenceNumber != 0 {
+		n += 1 + sovGenesis(uint64(m.LatestSequenceNumber))
+	}
+	if len(m.Actions) > 0 {
+		for _, e := range m.Actions {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *AssetHaircutEntry) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.VaultAddress)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = m.Haircut.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	return n
+}
+
+func (m *SharePriceObservationEntry) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.VaultAddress)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = m.Observation.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	return n
+}
+
+func (m *OutboundPaymentEntry) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.VaultAddress)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = m.Payment.Size()
+	n += 1 + l + sovGenesis(uint64(l))
 	return n
 }
 
@@ -796,21 +1671,934 @@ func (m *QueueEntry) Unmarshal(dAtA []byte) error {
 			if b < 0x80 {
 				break
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueueEntry: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueueEntry: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueueEntry: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueueEntry: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
+			}
+			m.Time = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Time |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Addr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Addr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PendingSwapOutQueueEntry) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PendingSwapOutQueueEntry: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PendingSwapOutQueueEntry: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
+			}
+			m.Time = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Time |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SwapOut", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SwapOut.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PendingSwapOutQueue) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PendingSwapOutQueue: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PendingSwapOutQueue: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LatestSequenceNumber", wireType)
+			}
+			m.LatestSequenceNumber = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LatestSequenceNumber |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Entries", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Entries = append(m.Entries, PendingSwapOutQueueEntry{})
+			if err := m.Entries[len(m.Entries)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *VaultNAVEntry) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: VaultNAVEntry: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: VaultNAVEntry: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VaultAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VaultAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nav", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Nav.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GenesisState) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GenesisState: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GenesisState: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Vaults", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Vaults = append(m.Vaults, VaultAccount{})
+			if err := m.Vaults[len(m.Vaults)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PayoutTimeoutQueue", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PayoutTimeoutQueue = append(m.PayoutTimeoutQueue, QueueEntry{})
+			if err := m.PayoutTimeoutQueue[len(m.PayoutTimeoutQueue)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingSwapOutQueue", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.PendingSwapOutQueue.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeTimeoutQueue", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FeeTimeoutQueue = append(m.FeeTimeoutQueue, QueueEntry{})
+			if err := m.FeeTimeoutQueue[len(m.FeeTimeoutQueue)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Navs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Navs = append(m.Navs, VaultNAVEntry{})
+			if err := m.Navs[len(m.Navs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingNavProposals", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PendingNavProposals = append(m.PendingNavProposals, PendingNAVProposal{})
+			if err := m.PendingNavProposals[len(m.PendingNavProposals)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NavSourceSubmissions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NavSourceSubmissions = append(m.NavSourceSubmissions, NAVSourceSubmission{})
+			if err := m.NavSourceSubmissions[len(m.NavSourceSubmissions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NavHistory", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NavHistory = append(m.NavHistory, VaultNAVEntry{})
+			if err := m.NavHistory[len(m.NavHistory)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SettlementPrices", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SettlementPrices = append(m.SettlementPrices, VaultNAVEntry{})
+			if err := m.SettlementPrices[len(m.SettlementPrices)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AssetHaircuts", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AssetHaircuts = append(m.AssetHaircuts, AssetHaircutEntry{})
+			if err := m.AssetHaircuts[len(m.AssetHaircuts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SharePriceObservations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SharePriceObservations = append(m.SharePriceObservations, SharePriceObservationEntry{})
+			if err := m.SharePriceObservations[len(m.SharePriceObservations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OutboundPayments", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OutboundPayments = append(m.OutboundPayments, OutboundPaymentEntry{})
+			if err := m.OutboundPayments[len(m.OutboundPayments)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScheduledActionQueue", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
-			m.Time = 0
+			if err := m.ScheduledActionQueue.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Allowlist", wireType)
+			}
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -820,16 +2608,31 @@ func (m *QueueEntry) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Time |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 2:
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Allowlist = append(m.Allowlist, AllowlistEntry{})
+			if err := m.Allowlist[len(m.Allowlist)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 16:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Addr", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NavReferences", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -839,23 +2642,25 @@ func (m *QueueEntry) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenesis
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Addr = string(dAtA[iNdEx:postIndex])
+			m.NavReferences = append(m.NavReferences, VaultNAVEntry{})
+			if err := m.NavReferences[len(m.NavReferences)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -878,7 +2683,7 @@ func (m *QueueEntry) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PendingSwapOutQueueEntry) Unmarshal(dAtA []byte) error {
+func (m *AllowlistEntry) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -901,17 +2706,17 @@ func (m *PendingSwapOutQueueEntry) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PendingSwapOutQueueEntry: wiretype end group for non-group")
+			return fmt.Errorf("proto: AllowlistEntry: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PendingSwapOutQueueEntry: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: AllowlistEntry: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VaultAddress", wireType)
 			}
-			m.Time = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -921,35 +2726,29 @@ func (m *PendingSwapOutQueueEntry) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Time |= int64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
 			}
-			m.Id = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenesis
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Id |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
 			}
-		case 3:
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VaultAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SwapOut", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -959,24 +2758,23 @@ func (m *PendingSwapOutQueueEntry) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenesis
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.SwapOut.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Address = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -999,7 +2797,7 @@ func (m *PendingSwapOutQueueEntry) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PendingSwapOutQueue) Unmarshal(dAtA []byte) error {
+func (m *ScheduledActionQueue) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1022,10 +2820,10 @@ func (m *PendingSwapOutQueue) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PendingSwapOutQueue: wiretype end group for non-group")
+			return fmt.Errorf("proto: ScheduledActionQueue: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PendingSwapOutQueue: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ScheduledActionQueue: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1049,7 +2847,7 @@ func (m *PendingSwapOutQueue) Unmarshal(dAtA []byte) error {
 			}
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Entries", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Actions", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1076,8 +2874,8 @@ func (m *PendingSwapOutQueue) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Entries = append(m.Entries, PendingSwapOutQueueEntry{})
-			if err := m.Entries[len(m.Entries)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Actions = append(m.Actions, ScheduledAction{})
+			if err := m.Actions[len(m.Actions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1102,7 +2900,7 @@ func (m *PendingSwapOutQueue) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *VaultNAVEntry) Unmarshal(dAtA []byte) error {
+func (m *AssetHaircutEntry) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1125,10 +2923,10 @@ func (m *VaultNAVEntry) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: VaultNAVEntry: wiretype end group for non-group")
+			return fmt.Errorf("proto: AssetHaircutEntry: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: VaultNAVEntry: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: AssetHaircutEntry: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1165,7 +2963,7 @@ func (m *VaultNAVEntry) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Nav", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Haircut", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1192,7 +2990,7 @@ func (m *VaultNAVEntry) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Nav.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Haircut.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1217,7 +3015,7 @@ func (m *VaultNAVEntry) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GenesisState) Unmarshal(dAtA []byte) error {
+func (m *SharePriceObservationEntry) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1240,17 +3038,17 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GenesisState: wiretype end group for non-group")
+			return fmt.Errorf("proto: SharePriceObservationEntry: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GenesisState: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SharePriceObservationEntry: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Vaults", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field VaultAddress", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -1260,29 +3058,27 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenesis
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Vaults = append(m.Vaults, VaultAccount{})
-			if err := m.Vaults[len(m.Vaults)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.VaultAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PayoutTimeoutQueue", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Observation", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1309,83 +3105,65 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PayoutTimeoutQueue = append(m.PayoutTimeoutQueue, QueueEntry{})
-			if err := m.PayoutTimeoutQueue[len(m.PayoutTimeoutQueue)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Observation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PendingSwapOutQueue", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenesis
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenesis
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.PendingSwapOutQueue.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FeeTimeoutQueue", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenesis
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenesis
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenesis
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *OutboundPaymentEntry) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
 			}
-			if postIndex > l {
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			m.FeeTimeoutQueue = append(m.FeeTimeoutQueue, QueueEntry{})
-			if err := m.FeeTimeoutQueue[len(m.FeeTimeoutQueue)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			iNdEx = postIndex
-		case 5:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: OutboundPaymentEntry: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: OutboundPaymentEntry: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field VaultAddress", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -1395,28 +3173,27 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenesis
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.VaultAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 6:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Navs", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Payment", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1443,8 +3220,7 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Navs = append(m.Navs, VaultNAVEntry{})
-			if err := m.Navs[len(m.Navs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Payment.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3,6 +3,7 @@ package types_test
 import (
 	"math"
 	"testing"
+	"time"
 
 	sdkmath "cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -154,6 +155,98 @@ func TestGenesisState_Validate(t *testing.T) {
 			},
 			expectedErr: "pending swap out queue vault address at index 0 is not an imported vault",
 		},
+		{
+			name: "invalid scheduled action",
+			genState: types.GenesisState{
+				Params: types.DefaultParams(),
+				ScheduledActionQueue: types.ScheduledActionQueue{
+					LatestSequenceNumber: 1,
+					Actions: []types.ScheduledAction{
+						{VaultAddress: invalidAddr},
+					},
+				},
+			},
+			expectedErr: "invalid scheduled action at index 0",
+		},
+		{
+			name: "scheduled action not for an imported vault",
+			genState: types.GenesisState{
+				Params: types.DefaultParams(),
+				ScheduledActionQueue: types.ScheduledActionQueue{
+					LatestSequenceNumber: 1,
+					Actions: []types.ScheduledAction{
+						newTestGenesisScheduledAction(t, validAddr, 0),
+					},
+				},
+			},
+			expectedErr: "scheduled action at index 0 is not for an imported vault",
+		},
+		{
+			name: "scheduled action id not below latest sequence number",
+			genState: types.GenesisState{
+				Params: types.DefaultParams(),
+				Vaults: []types.VaultAccount{validVault},
+				ScheduledActionQueue: types.ScheduledActionQueue{
+					LatestSequenceNumber: 1,
+					Actions: []types.ScheduledAction{
+						newTestGenesisScheduledAction(t, validAddr, 1),
+					},
+				},
+			},
+			expectedErr: "scheduled action id 1 must be below latest sequence number 1",
+		},
+		{
+			name: "duplicate scheduled action id",
+			genState: types.GenesisState{
+				Params: types.DefaultParams(),
+				Vaults: []types.VaultAccount{validVault},
+				ScheduledActionQueue: types.ScheduledActionQueue{
+					LatestSequenceNumber: 2,
+					Actions: []types.ScheduledAction{
+						newTestGenesisScheduledAction(t, validAddr, 1),
+						newTestGenesisScheduledAction(t, validAddr, 1),
+					},
+				},
+			},
+			expectedErr: "duplicate scheduled action id 1",
+		},
+		{
+			name: "valid allowlist",
+			genState: types.GenesisState{
+				Params:    types.DefaultParams(),
+				Vaults:    []types.VaultAccount{validVault},
+				Allowlist: []types.AllowlistEntry{{VaultAddress: validAddr, Address: validAddr}},
+			},
+		},
+		{
+			name: "allowlist entry for a vault that is not imported",
+			genState: types.GenesisState{
+				Params:    types.DefaultParams(),
+				Allowlist: []types.AllowlistEntry{{VaultAddress: validAddr, Address: validAddr}},
+			},
+			expectedErr: "allowlist entry at index 0 is not for an imported vault",
+		},
+		{
+			name: "invalid allowlist address",
+			genState: types.GenesisState{
+				Params:    types.DefaultParams(),
+				Vaults:    []types.VaultAccount{validVault},
+				Allowlist: []types.AllowlistEntry{{VaultAddress: validAddr, Address: invalidAddr}},
+			},
+			expectedErr: "invalid allowlist address at index 0",
+		},
+		{
+			name: "duplicate allowlist entry",
+			genState: types.GenesisState{
+				Params: types.DefaultParams(),
+				Vaults: []types.VaultAccount{validVault},
+				Allowlist: []types.AllowlistEntry{
+					{VaultAddress: validAddr, Address: validAddr},
+					{VaultAddress: validAddr, Address: validAddr},
+				},
+			},
+			expectedErr: "duplicate allowlist entry",
+		},
 		{
 			name: "invalid tech fee address in params",
 			genState: types.GenesisState{
@@ -173,6 +266,36 @@ func TestGenesisState_Validate(t *testing.T) {
 			},
 			expectedErr: "invalid params: invalid DefaultAumFeeBips",
 		},
+		{
+			name: "nav history retention above the maximum in params",
+			genState: types.GenesisState{
+				Params: types.Params{
+					TechFeeAddress:             validAddr,
+					NavHistoryRetentionSeconds: types.MaxNAVHistoryRetention + 1,
+				},
+			},
+			expectedErr: "invalid params: invalid NavHistoryRetentionSeconds",
+		},
+		{
+			name: "share price retention above the maximum in params",
+			genState: types.GenesisState{
+				Params: types.Params{
+					TechFeeAddress:             validAddr,
+					SharePriceRetentionSeconds: types.MaxNAVHistoryRetention + 1,
+				},
+			},
+			expectedErr: "invalid params: invalid SharePriceRetentionSeconds",
+		},
+		{
+			name: "invalid circuit breaker guardian in params",
+			genState: types.GenesisState{
+				Params: types.Params{
+					TechFeeAddress:         validAddr,
+					CircuitBreakerGuardian: "invalid",
+				},
+			},
+			expectedErr: "invalid params: invalid CircuitBreakerGuardian",
+		},
 		{
 			name: "valid nav entry for an imported vault",
 			genState: types.GenesisState{
@@ -406,6 +529,438 @@ func TestGenesisState_Validate(t *testing.T) {
 			},
 			expectedErr: "duplicate nav entry for vault",
 		},
+		{
+			name: "valid pending nav proposal",
+			genState: types.GenesisState{
+				Params: types.DefaultParams(),
+				Vaults: []types.VaultAccount{validVault},
+				PendingNavProposals: []types.PendingNAVProposal{
+					{
+						VaultAddress: validAddr,
+						Nav:          types.VaultNAV{Denom: "rwa", Price: sdk.NewInt64Coin("under", 100), Volume: sdkmath.NewInt(1)},
+						Proposer:     validAddr,
+					},
+				},
+			},
+		},
+		{
+			name: "pending nav proposal for unknown vault",
+			genState: types.GenesisState{
+				Params: types.DefaultParams(),
+				Vaults: []types.VaultAccount{validVault},
+				PendingNavProposals: []types.PendingNAVProposal{
+					{
+						VaultAddress: utils.TestAddress().Bech32,
+						Nav:          types.VaultNAV{Denom: "rwa", Price: sdk.NewInt64Coin("under", 100), Volume: sdkmath.NewInt(1)},
+						Proposer:     validAddr,
+					},
+				},
+			},
+			expectedErr: "nav proposal at index 0 is not for an imported vault",
+		},
+		{
+			name: "pending nav proposal with invalid proposer",
+			genState: types.GenesisState{
+				Params: types.DefaultParams(),
+				Vaults: []types.VaultAccount{validVault},
+				PendingNavProposals: []types.PendingNAVProposal{
+					{
+						VaultAddress: validAddr,
+						Nav:          types.VaultNAV{Denom: "rwa", Price: sdk.NewInt64Coin("under", 100), Volume: sdkmath.NewInt(1)},
+						Proposer:     "bad",
+					},
+				},
+			},
+			expectedErr: "invalid nav proposal proposer at index 0",
+		},
+		{
+			name: "duplicate pending nav proposal for the same vault and denom",
+			genState: types.GenesisState{
+				Params: types.DefaultParams(),
+				Vaults: []types.VaultAccount{validVault},
+				PendingNavProposals: []types.PendingNAVProposal{
+					{
+						VaultAddress: validAddr,
+						Nav:          types.VaultNAV{Denom: "rwa", Price: sdk.NewInt64Coin("under", 100), Volume: sdkmath.NewInt(1)},
+						Proposer:     validAddr,
+					},
+					{
+						VaultAddress: validAddr,
+						Nav:          types.VaultNAV{Denom: "rwa", Price: sdk.NewInt64Coin("under", 50), Volume: sdkmath.NewInt(1)},
+						Proposer:     validAddr,
+					},
+				},
+			},
+			expectedErr: "duplicate nav proposal for vault",
+		},
+		{
+			name: "valid nav source submission",
+			genState: types.GenesisState{
+				Params: types.DefaultParams(),
+				Vaults: []types.VaultAccount{validVault},
+				NavSourceSubmissions: []types.NAVSourceSubmission{
+					{
+						VaultAddress: validAddr,
+						Source:       validAddr,
+						Nav:          types.VaultNAV{Denom: "rwa", Price: sdk.NewInt64Coin("under", 100), Volume: sdkmath.NewInt(1)},
+					},
+				},
+			},
+		},
+		{
+			name: "nav source submission for unknown vault",
+			genState: types.GenesisState{
+				Params: types.DefaultParams(),
+				Vaults: []types.VaultAccount{validVault},
+				NavSourceSubmissions: []types.NAVSourceSubmission{
+					{
+						VaultAddress: utils.TestAddress().Bech32,
+						Source:       validAddr,
+						Nav:          types.VaultNAV{Denom: "rwa", Price: sdk.NewInt64Coin("under", 100), Volume: sdkmath.NewInt(1)},
+					},
+				},
+			},
+			expectedErr: "nav source submission at index 0 is not for an imported vault",
+		},
+		{
+			name: "nav source submission with invalid source",
+			genState: types.GenesisState{
+				Params: types.DefaultParams(),
+				Vaults: []types.VaultAccount{validVault},
+				NavSourceSubmissions: []types.NAVSourceSubmission{
+					{
+						VaultAddress: validAddr,
+						Source:       "bad",
+						Nav:          types.VaultNAV{Denom: "rwa", Price: sdk.NewInt64Coin("under", 100), Volume: sdkmath.NewInt(1)},
+					},
+				},
+			},
+			expectedErr: "invalid nav source submission source at index 0",
+		},
+		{
+			name: "duplicate nav source submission for the same vault, denom, and source",
+			genState: types.GenesisState{
+				Params: types.DefaultParams(),
+				Vaults: []types.VaultAccount{validVault},
+				NavSourceSubmissions: []types.NAVSourceSubmission{
+					{
+						VaultAddress: validAddr,
+						Source:       validAddr,
+						Nav:          types.VaultNAV{Denom: "rwa", Price: sdk.NewInt64Coin("under", 100), Volume: sdkmath.NewInt(1)},
+					},
+					{
+						VaultAddress: validAddr,
+						Source:       validAddr,
+						Nav:          types.VaultNAV{Denom: "rwa", Price: sdk.NewInt64Coin("under", 50), Volume: sdkmath.NewInt(1)},
+					},
+				},
+			},
+			expectedErr: "duplicate nav source submission for vault",
+		},
+		{
+			name: "valid nav history",
+			genState: types.GenesisState{
+				Params: types.DefaultParams(),
+				Vaults: []types.VaultAccount{validVault},
+				NavHistory: []types.VaultNAVEntry{
+					{
+						VaultAddress: validAddr,
+						Nav:          types.VaultNAV{Denom: "rwa", Price: sdk.NewInt64Coin("under", 100), Volume: sdkmath.NewInt(1), UpdatedTime: time.Unix(1700000000, 0).UTC()},
+					},
+					{
+						VaultAddress: validAddr,
+						Nav:          types.VaultNAV{Denom: "rwa", Price: sdk.NewInt64Coin("under", 110), Volume: sdkmath.NewInt(1), UpdatedTime: time.Unix(1700000060, 0).UTC()},
+					},
+				},
+			},
+		},
+		{
+			name: "nav history entry for unknown vault",
+			genState: types.GenesisState{
+				Params: types.DefaultParams(),
+				Vaults: []types.VaultAccount{validVault},
+				NavHistory: []types.VaultNAVEntry{
+					{
+						VaultAddress: utils.TestAddress().Bech32,
+						Nav:          types.VaultNAV{Denom: "rwa", Price: sdk.NewInt64Coin("under", 100), Volume: sdkmath.NewInt(1)},
+					},
+				},
+			},
+			expectedErr: "nav history entry at index 0 is not for an imported vault",
+		},
+		{
+			name: "duplicate nav history entry for the same vault, denom, and time",
+			genState: types.GenesisState{
+				Params: types.DefaultParams(),
+				Vaults: []types.VaultAccount{validVault},
+				NavHistory: []types.VaultNAVEntry{
+					{
+						VaultAddress: validAddr,
+						Nav:          types.VaultNAV{Denom: "rwa", Price: sdk.NewInt64Coin("under", 100), Volume: sdkmath.NewInt(1), UpdatedTime: time.Unix(1700000000, 0).UTC()},
+					},
+					{
+						VaultAddress: validAddr,
+						Nav:          types.VaultNAV{Denom: "rwa", Price: sdk.NewInt64Coin("under", 50), Volume: sdkmath.NewInt(1), UpdatedTime: time.Unix(1700000000, 0).UTC()},
+					},
+				},
+			},
+			expectedErr: "duplicate nav history entry for vault",
+		},
+		{
+			name: "valid settlement prices",
+			genState: types.GenesisState{
+				Params: types.DefaultParams(),
+				Vaults: []types.VaultAccount{validVault},
+				SettlementPrices: []types.VaultNAVEntry{
+					{VaultAddress: validAddr, Nav: types.VaultNAV{Denom: "rwa", Price: sdk.NewInt64Coin("under", 100), Volume: sdkmath.NewInt(1), Source: validAddr}},
+					{VaultAddress: validAddr, Nav: types.VaultNAV{Denom: "rwa", Price: sdk.NewInt64Coin("other", 90), Volume: sdkmath.NewInt(1), Source: validAddr}},
+				},
+			},
+		},
+		{
+			name: "settlement price with zero volume",
+			genState: types.GenesisState{
+				Params: types.DefaultParams(),
+				Vaults: []types.VaultAccount{validVault},
+				SettlementPrices: []types.VaultNAVEntry{
+					{VaultAddress: validAddr, Nav: types.VaultNAV{Denom: "rwa", Price: sdk.NewInt64Coin("under", 100), Volume: sdkmath.ZeroInt()}},
+				},
+			},
+			expectedErr: "settlement price volume at index 0 must be positive",
+		},
+		{
+			name: "duplicate settlement price for the same denom pair",
+			genState: types.GenesisState{
+				Params: types.DefaultParams(),
+				Vaults: []types.VaultAccount{validVault},
+				SettlementPrices: []types.VaultNAVEntry{
+					{VaultAddress: validAddr, Nav: types.VaultNAV{Denom: "rwa", Price: sdk.NewInt64Coin("under", 100), Volume: sdkmath.NewInt(1)}},
+					{VaultAddress: validAddr, Nav: types.VaultNAV{Denom: "rwa", Price: sdk.NewInt64Coin("under", 90), Volume: sdkmath.NewInt(1)}},
+				},
+			},
+			expectedErr: "duplicate settlement price for vault " + validAddr + " denom rwa priced in under",
+		},
+		{
+			name: "settlement price for a vault not in genesis",
+			genState: types.GenesisState{
+				Params: types.DefaultParams(),
+				Vaults: []types.VaultAccount{validVault},
+				SettlementPrices: []types.VaultNAVEntry{
+					{VaultAddress: types.GetVaultAddress("othershare").String(), Nav: types.VaultNAV{Denom: "rwa", Price: sdk.NewInt64Coin("under", 100), Volume: sdkmath.NewInt(1)}},
+				},
+			},
+			expectedErr: "settlement price at index 0 is not for an imported vault",
+		},
+		{
+			name: "valid nav references",
+			genState: types.GenesisState{
+				Params: types.DefaultParams(),
+				Vaults: []types.VaultAccount{validVault},
+				NavReferences: []types.VaultNAVEntry{
+					{VaultAddress: validAddr, Nav: types.VaultNAV{Denom: "rwa", Price: sdk.NewInt64Coin("under", 100), Volume: sdkmath.NewInt(1)}},
+					{VaultAddress: validAddr, Nav: types.VaultNAV{Denom: "rwb", Price: sdk.NewInt64Coin("under", 90), Volume: sdkmath.NewInt(1)}},
+				},
+			},
+		},
+		{
+			name: "nav reference for a vault not in genesis",
+			genState: types.GenesisState{
+				Params: types.DefaultParams(),
+				Vaults: []types.VaultAccount{validVault},
+				NavReferences: []types.VaultNAVEntry{
+					{VaultAddress: types.GetVaultAddress("othershare").String(), Nav: types.VaultNAV{Denom: "rwa", Price: sdk.NewInt64Coin("under", 100), Volume: sdkmath.NewInt(1)}},
+				},
+			},
+			expectedErr: "nav reference at index 0 is not for an imported vault",
+		},
+		{
+			name: "duplicate nav reference",
+			genState: types.GenesisState{
+				Params: types.DefaultParams(),
+				Vaults: []types.VaultAccount{validVault},
+				NavReferences: []types.VaultNAVEntry{
+					{VaultAddress: validAddr, Nav: types.VaultNAV{Denom: "rwa", Price: sdk.NewInt64Coin("under", 100), Volume: sdkmath.NewInt(1)}},
+					{VaultAddress: validAddr, Nav: types.VaultNAV{Denom: "rwa", Price: sdk.NewInt64Coin("under", 90), Volume: sdkmath.NewInt(1)}},
+				},
+			},
+			expectedErr: "duplicate nav reference for vault " + validAddr + " denom rwa",
+		},
+		{
+			name: "valid asset haircut",
+			genState: types.GenesisState{
+				Params: types.DefaultParams(),
+				Vaults: []types.VaultAccount{validVault},
+				AssetHaircuts: []types.AssetHaircutEntry{
+					{VaultAddress: validAddr, Haircut: types.AssetHaircut{Denom: "rwa", HaircutBips: 2_500}},
+				},
+			},
+		},
+		{
+			name: "asset haircut for unknown vault",
+			genState: types.GenesisState{
+				Params: types.DefaultParams(),
+				Vaults: []types.VaultAccount{validVault},
+				AssetHaircuts: []types.AssetHaircutEntry{
+					{VaultAddress: utils.TestAddress().Bech32, Haircut: types.AssetHaircut{Denom: "rwa", HaircutBips: 2_500}},
+				},
+			},
+			expectedErr: "asset haircut at index 0 is not for an imported vault",
+		},
+		{
+			name: "asset haircut on the underlying asset",
+			genState: types.GenesisState{
+				Params: types.DefaultParams(),
+				Vaults: []types.VaultAccount{validVault},
+				AssetHaircuts: []types.AssetHaircutEntry{
+					{VaultAddress: validAddr, Haircut: types.AssetHaircut{Denom: validVault.UnderlyingAsset, HaircutBips: 2_500}},
+				},
+			},
+			expectedErr: "cannot apply to vault",
+		},
+		{
+			name: "zero asset haircut",
+			genState: types.GenesisState{
+				Params: types.DefaultParams(),
+				Vaults: []types.VaultAccount{validVault},
+				AssetHaircuts: []types.AssetHaircutEntry{
+					{VaultAddress: validAddr, Haircut: types.AssetHaircut{Denom: "rwa"}},
+				},
+			},
+			expectedErr: "asset haircut at index 0 must be positive",
+		},
+		{
+			name: "asset haircut above the maximum",
+			genState: types.GenesisState{
+				Params: types.DefaultParams(),
+				Vaults: []types.VaultAccount{validVault},
+				AssetHaircuts: []types.AssetHaircutEntry{
+					{VaultAddress: validAddr, Haircut: types.AssetHaircut{Denom: "rwa", HaircutBips: 10_001}},
+				},
+			},
+			expectedErr: "invalid haircut bips: 10001 (max 10000)",
+		},
+		{
+			name: "duplicate asset haircut",
+			genState: types.GenesisState{
+				Params: types.DefaultParams(),
+				Vaults: []types.VaultAccount{validVault},
+				AssetHaircuts: []types.AssetHaircutEntry{
+					{VaultAddress: validAddr, Haircut: types.AssetHaircut{Denom: "rwa", HaircutBips: 2_500}},
+					{VaultAddress: validAddr, Haircut: types.AssetHaircut{Denom: "rwa", HaircutBips: 100}},
+				},
+			},
+			expectedErr: "duplicate asset haircut for vault",
+		},
+		{
+			name: "valid share price observation",
+			genState: types.GenesisState{
+				Params: types.DefaultParams(),
+				Vaults: []types.VaultAccount{validVault},
+				SharePriceObservations: []types.SharePriceObservationEntry{
+					{VaultAddress: validAddr, Observation: types.SharePriceObservation{Time: time.Unix(1_700_000_000, 0).UTC(), Price: sdkmath.LegacyOneDec(), Cumulative: sdkmath.LegacyZeroDec()}},
+				},
+			},
+		},
+		{
+			name: "share price observation for unknown vault",
+			genState: types.GenesisState{
+				Params: types.DefaultParams(),
+				Vaults: []types.VaultAccount{validVault},
+				SharePriceObservations: []types.SharePriceObservationEntry{
+					{VaultAddress: utils.TestAddress().Bech32, Observation: types.SharePriceObservation{Time: time.Unix(1_700_000_000, 0).UTC(), Price: sdkmath.LegacyOneDec(), Cumulative: sdkmath.LegacyZeroDec()}},
+				},
+			},
+			expectedErr: "share price observation at index 0 is not for an imported vault",
+		},
+		{
+			name: "share price observation with negative price",
+			genState: types.GenesisState{
+				Params: types.DefaultParams(),
+				Vaults: []types.VaultAccount{validVault},
+				SharePriceObservations: []types.SharePriceObservationEntry{
+					{VaultAddress: validAddr, Observation: types.SharePriceObservation{Time: time.Unix(1_700_000_000, 0).UTC(), Price: sdkmath.LegacyNewDec(-1), Cumulative: sdkmath.LegacyZeroDec()}},
+				},
+			},
+			expectedErr: "share price observation price at index 0 must be non-negative",
+		},
+		{
+			name: "share price observation without cumulative",
+			genState: types.GenesisState{
+				Params: types.DefaultParams(),
+				Vaults: []types.VaultAccount{validVault},
+				SharePriceObservations: []types.SharePriceObservationEntry{
+					{VaultAddress: validAddr, Observation: types.SharePriceObservation{Time: time.Unix(1_700_000_000, 0).UTC(), Price: sdkmath.LegacyOneDec()}},
+				},
+			},
+			expectedErr: "share price observation cumulative at index 0 must be non-negative",
+		},
+		{
+			name: "duplicate share price observation",
+			genState: types.GenesisState{
+				Params: types.DefaultParams(),
+				Vaults: []types.VaultAccount{validVault},
+				SharePriceObservations: []types.SharePriceObservationEntry{
+					{VaultAddress: validAddr, Observation: types.SharePriceObservation{Time: time.Unix(1_700_000_000, 0).UTC(), Price: sdkmath.LegacyOneDec(), Cumulative: sdkmath.LegacyZeroDec()}},
+					{VaultAddress: validAddr, Observation: types.SharePriceObservation{Time: time.Unix(1_700_000_000, 0).UTC(), Price: sdkmath.LegacyOneDec(), Cumulative: sdkmath.LegacyZeroDec()}},
+				},
+			},
+			expectedErr: "duplicate share price observation for vault",
+		},
+		{
+			name: "valid outbound payment",
+			genState: types.GenesisState{
+				Params: types.DefaultParams(),
+				Vaults: []types.VaultAccount{validVault},
+				OutboundPayments: []types.OutboundPaymentEntry{
+					{VaultAddress: validAddr, Payment: types.OutboundPayment{ExternalId: "sale-1", Target: utils.TestAddress().Bech32, SourceAmount: sdk.NewCoins(sdk.NewInt64Coin("rwa", 1)), TargetAmount: sdk.NewCoins(sdk.NewInt64Coin("under", 100)), Direction: types.AssetDirectionOutbound, ExpiryTime: time.Unix(1_700_000_000, 0).UTC()}},
+				},
+			},
+		},
+		{
+			name: "outbound payment for unknown vault",
+			genState: types.GenesisState{
+				Params: types.DefaultParams(),
+				Vaults: []types.VaultAccount{validVault},
+				OutboundPayments: []types.OutboundPaymentEntry{
+					{VaultAddress: utils.TestAddress().Bech32, Payment: types.OutboundPayment{ExternalId: "sale-1", Target: utils.TestAddress().Bech32, SourceAmount: sdk.NewCoins(sdk.NewInt64Coin("rwa", 1)), TargetAmount: sdk.NewCoins(sdk.NewInt64Coin("under", 100)), Direction: types.AssetDirectionOutbound}},
+				},
+			},
+			expectedErr: "outbound payment at index 0 is not for an imported vault",
+		},
+		{
+			name: "outbound payment with invalid target",
+			genState: types.GenesisState{
+				Params: types.DefaultParams(),
+				Vaults: []types.VaultAccount{validVault},
+				OutboundPayments: []types.OutboundPaymentEntry{
+					{VaultAddress: validAddr, Payment: types.OutboundPayment{ExternalId: "sale-1", Target: "bad", SourceAmount: sdk.NewCoins(sdk.NewInt64Coin("rwa", 1)), TargetAmount: sdk.NewCoins(sdk.NewInt64Coin("under", 100)), Direction: types.AssetDirectionOutbound}},
+				},
+			},
+			expectedErr: "invalid outbound payment target at index 0",
+		},
+		{
+			name: "outbound payment with unknown direction",
+			genState: types.GenesisState{
+				Params: types.DefaultParams(),
+				Vaults: []types.VaultAccount{validVault},
+				OutboundPayments: []types.OutboundPaymentEntry{
+					{VaultAddress: validAddr, Payment: types.OutboundPayment{ExternalId: "sale-1", Target: utils.TestAddress().Bech32, SourceAmount: sdk.NewCoins(sdk.NewInt64Coin("rwa", 1)), TargetAmount: sdk.NewCoins(sdk.NewInt64Coin("under", 100)), Direction: "sideways"}},
+				},
+			},
+			expectedErr: "invalid outbound payment direction at index 0",
+		},
+		{
+			name: "duplicate outbound payment",
+			genState: types.GenesisState{
+				Params: types.DefaultParams(),
+				Vaults: []types.VaultAccount{validVault},
+				OutboundPayments: []types.OutboundPaymentEntry{
+					{VaultAddress: validAddr, Payment: types.OutboundPayment{ExternalId: "sale-1", Target: utils.TestAddress().Bech32, SourceAmount: sdk.NewCoins(sdk.NewInt64Coin("rwa", 1)), TargetAmount: sdk.NewCoins(sdk.NewInt64Coin("under", 100)), Direction: types.AssetDirectionOutbound}},
+					{VaultAddress: validAddr, Payment: types.OutboundPayment{ExternalId: "sale-1", Target: utils.TestAddress().Bech32, SourceAmount: sdk.NewCoins(sdk.NewInt64Coin("rwa", 2)), TargetAmount: sdk.NewCoins(sdk.NewInt64Coin("under", 200)), Direction: types.AssetDirectionOutbound}},
+				},
+			},
+			expectedErr: "duplicate outbound payment for vault",
+		},
 	}
 
 	for _, tt := range tests {
@@ -420,3 +975,14 @@ func TestGenesisState_Validate(t *testing.T) {
 		})
 	}
 }
+
+func newTestGenesisScheduledAction(t *testing.T, vaultAddr string, id uint64) types.ScheduledAction {
+	t.Helper()
+	action, err := types.NewScheduledAction(vaultAddr, vaultAddr, 100, &types.MsgUpdateWithdrawalDelayRequest{
+		Authority:    vaultAddr,
+		VaultAddress: vaultAddr,
+	})
+	require.NoError(t, err, "creating a scheduled action should not error")
+	action.Id = id
+	return action
+}
@@ -66,6 +66,68 @@ var (
 	NAVsKeyPrefix = collections.NewPrefix(11)
 	// NAVsName is a human-readable name for the internal NAV table collection.
 	NAVsName = "vault_navs"
+
+	// PendingNAVProposalsKeyPrefix is the prefix for pending NAV proposals, keyed by (vault address, denom).
+	PendingNAVProposalsKeyPrefix = collections.NewPrefix(12)
+	// PendingNAVProposalsName is a human-readable name for the pending NAV proposals collection.
+	PendingNAVProposalsName = "pending_nav_proposals"
+
+	// NAVSourceSubmissionsKeyPrefix is the prefix for per-source NAV submissions, keyed by (vault address, denom, source address).
+	NAVSourceSubmissionsKeyPrefix = collections.NewPrefix(13)
+	// NAVSourceSubmissionsName is a human-readable name for the per-source NAV submissions collection.
+	NAVSourceSubmissionsName = "nav_source_submissions"
+
+	// NAVHistoryKeyPrefix is the prefix for the NAV history time series, keyed by (vault address, denom, block time).
+	NAVHistoryKeyPrefix = collections.NewPrefix(14)
+	// NAVHistoryName is a human-readable name for the NAV history collection.
+	NAVHistoryName = "nav_history"
+
+	// SettlementPricesKeyPrefix is the prefix for the most recent exchange settlement prices, keyed by (vault, denom, price denom).
+	SettlementPricesKeyPrefix = collections.NewPrefix(15)
+	// SettlementPricesName is a human-readable name for the settlement prices collection.
+	SettlementPricesName = "settlement_prices"
+
+	// AssetHaircutsKeyPrefix is the prefix for per-vault valuation haircuts, keyed by (vault address, denom).
+	AssetHaircutsKeyPrefix = collections.NewPrefix(16)
+	// AssetHaircutsName is a human-readable name for the asset haircuts collection.
+	AssetHaircutsName = "asset_haircuts"
+
+	// SharePriceObservationsKeyPrefix is the prefix for the share price accumulator observations, keyed by (vault address, block time).
+	SharePriceObservationsKeyPrefix = collections.NewPrefix(17)
+	// SharePriceObservationsName is a human-readable name for the share price observations collection.
+	SharePriceObservationsName = "share_price_observations"
+
+	// OutboundPaymentsKeyPrefix is the prefix for the open exchange payments created by vaults, keyed by (vault address, external id).
+	OutboundPaymentsKeyPrefix = collections.NewPrefix(18)
+	// OutboundPaymentsName is a human-readable name for the outbound payments collection.
+	OutboundPaymentsName = "outbound_payments"
+
+	// ScheduledActionQueuePrefix is the prefix for the scheduled action queue, keyed by (execute time, id, vault address).
+	ScheduledActionQueuePrefix = collections.NewPrefix(19)
+	// ScheduledActionQueueName is a human-readable name for the scheduled action queue collection.
+	ScheduledActionQueueName = "scheduled_action_queue"
+	// ScheduledActionQueueSeqPrefix is the prefix for the scheduled action queue sequence.
+	ScheduledActionQueueSeqPrefix = collections.NewPrefix(20)
+	// ScheduledActionQueueSeqName is a human-readable name for the scheduled action queue sequence.
+	ScheduledActionQueueSeqName = "scheduled_action_seq"
+	// ScheduledActionByVaultIndexPrefix is the prefix for the scheduled action queue vault index.
+	ScheduledActionByVaultIndexPrefix = collections.NewPrefix(21)
+	// ScheduledActionByVaultIndexName is a human-readable name for the scheduled action queue vault index.
+	ScheduledActionByVaultIndexName = "scheduled_action_by_vault"
+	// ScheduledActionByIDIndexPrefix is the prefix for the scheduled action queue by id index.
+	ScheduledActionByIDIndexPrefix = collections.NewPrefix(22)
+	// ScheduledActionByIDIndexName is a human-readable name for the scheduled action queue by id index.
+	ScheduledActionByIDIndexName = "scheduled_action_by_id"
+
+	// InvestorAllowlistKeyPrefix is the prefix for the per-vault investor allowlists, keyed by (vault address, investor address).
+	InvestorAllowlistKeyPrefix = collections.NewPrefix(23)
+	// InvestorAllowlistName is a human-readable name for the investor allowlist collection.
+	InvestorAllowlistName = "investor_allowlist"
+
+	// NAVReferencesKeyPrefix is the prefix for the prices the NAV change limit measures against, keyed by (vault address, denom).
+	NAVReferencesKeyPrefix = collections.NewPrefix(24)
+	// NAVReferencesName is a human-readable name for the NAV references collection.
+	NAVReferencesName = "nav_references"
 )
 
 var (
@@ -83,9 +145,28 @@ var (
 const (
 	// DefaultAumFeeBips is the default AUM fee rate in basis points (15 bps = 0.15%).
 	DefaultAumFeeBips = 15
+	// DefaultNAVHistoryRetention is the default NAV history retention in seconds (90 days).
+	DefaultNAVHistoryRetention = 86_400 * 90
+	// MaxNAVHistoryRetention caps the NAV history retention in seconds (10 years).
+	MaxNAVHistoryRetention = 31_536_000 * 10
+	// MaxTimelockSeconds caps a vault's timelock in seconds (30 days), so a timelock cannot
+	// freeze a vault's configuration indefinitely.
+	MaxTimelockSeconds = 86_400 * 30
+	// MaxShareUnitExponent caps the display unit exponent share prices are quoted at, so a
+	// share denom's metadata cannot make pricing compute an arbitrarily large power of ten.
+	MaxShareUnitExponent = 18
+	// DefaultSharePriceRetention is the default share price observation retention in seconds
+	// (366 days, covering the longest performance window).
+	DefaultSharePriceRetention = 86_400 * 366
 )
 
 // GetVaultAddress returns the module account address for the given shareDenom.
 func GetVaultAddress(shareDenom string) sdk.AccAddress {
 	return sdk.AccAddress(crypto.AddressHash([]byte(fmt.Sprintf("%s/%s", ModuleName, shareDenom))))
 }
+
+// GetPaymentEscrowAddress returns the account address that escrows the exchange payments
+// created by the vault at vaultAddr.
+func GetPaymentEscrowAddress(vaultAddr sdk.AccAddress) sdk.AccAddress {
+	return sdk.AccAddress(crypto.AddressHash([]byte(fmt.Sprintf("%s/payment_escrow/%s", ModuleName, vaultAddr))))
+}
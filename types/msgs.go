@@ -3,6 +3,7 @@ package types
 import (
 	"errors"
 	fmt "fmt"
+	"slices"
 	"strings"
 
 	sdkmath "cosmossdk.io/math"
@@ -19,6 +20,19 @@ const maxDenomMetadataDescriptionLength = 200
 // per-entry state and emitted events from being inflated by an unbounded value.
 const MaxNAVSourceLength = 200
 
+// MaxNAVBatchUpdates bounds the number of entries in a MsgBatchUpdateVaultNAVRequest so a
+// single message cannot grow the block's work without limit.
+const MaxNAVBatchUpdates = 500
+
+// MaxAssetBatchPayments bounds the number of payments in a MsgBatchAcceptAssetsRequest. Each
+// payment moves funds through the vault several times, so the bound is tighter than
+// MaxNAVBatchUpdates.
+const MaxAssetBatchPayments = 100
+
+// MaxAssetPaymentExpirationSeconds bounds how long a payment created by the vault may stay
+// open (one year), so its escrow cannot be tied up indefinitely.
+const MaxAssetPaymentExpirationSeconds = 31_536_000
+
 // maxPauseReasonLength bounds the operator-supplied pause reason, which is
 // persisted to vault state on every pause, to keep an unbounded value from
 // inflating state.
@@ -59,6 +73,36 @@ var AllRequestMsgs = []sdk.Msg{
 	(*MsgUpdateNAVAuthorityRequest)(nil),
 	(*MsgAcceptAssetRequest)(nil),
 	(*MsgRejectAssetRequest)(nil),
+	(*MsgUpdateNAVStalenessLimitRequest)(nil),
+	(*MsgUpdateNAVChangeLimitRequest)(nil),
+	(*MsgUpdateSettlementToleranceRequest)(nil),
+	(*MsgUpdateAcquisitionPolicyRequest)(nil),
+	(*MsgUpdateConcentrationLimitsRequest)(nil),
+	(*MsgUpdateMinLiquidityRequest)(nil),
+	(*MsgApproveNAVProposalRequest)(nil),
+	(*MsgUpdateNAVSourcesRequest)(nil),
+	(*MsgSubmitSourceNAVRequest)(nil),
+	(*MsgBatchUpdateVaultNAVRequest)(nil),
+	(*MsgUpdatePriceSourcesRequest)(nil),
+	(*MsgUpdateAssetHaircutRequest)(nil),
+	(*MsgBatchAcceptAssetsRequest)(nil),
+	(*MsgCreateAssetPaymentRequest)(nil),
+	(*MsgCancelAssetPaymentRequest)(nil),
+	(*MsgExpireAssetPaymentRequest)(nil),
+	(*MsgCollectAssetIncomeRequest)(nil),
+	(*MsgGrantRoleRequest)(nil),
+	(*MsgRevokeRoleRequest)(nil),
+	(*MsgProposeAdminTransferRequest)(nil),
+	(*MsgAcceptAdminTransferRequest)(nil),
+	(*MsgCancelAdminTransferRequest)(nil),
+	(*MsgRecoverVaultAdminRequest)(nil),
+	(*MsgUpdateTimelockRequest)(nil),
+	(*MsgCancelScheduledActionRequest)(nil),
+	(*MsgUpdateEligibilityPolicyRequest)(nil),
+	(*MsgUpdateAllowlistRequest)(nil),
+	(*MsgUpdatePositionCapRequest)(nil),
+	(*MsgUpdateMaxTotalValueRequest)(nil),
+	(*MsgUpdateCircuitBreakerRequest)(nil),
 }
 
 // ValidateBasic performs stateless validation on MsgCreateVaultRequest.
@@ -636,3 +680,516 @@ func (m MsgRejectAssetRequest) ValidateBasic() error {
 	}
 	return nil
 }
+
+// ValidateBasic performs stateless validation on MsgUpdateNAVStalenessLimitRequest.
+func (m MsgUpdateNAVStalenessLimitRequest) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Authority); err != nil {
+		return fmt.Errorf("invalid authority address: %q: %w", m.Authority, err)
+	}
+	if _, err := sdk.AccAddressFromBech32(m.VaultAddress); err != nil {
+		return fmt.Errorf("invalid vault address: %q: %w", m.VaultAddress, err)
+	}
+	if m.MaxNavAgeSeconds > MaxNAVAge {
+		return fmt.Errorf("max NAV age cannot exceed %d seconds", MaxNAVAge)
+	}
+	return nil
+}
+
+// ValidateBasic performs stateless validation on MsgUpdateNAVChangeLimitRequest.
+func (m MsgUpdateNAVChangeLimitRequest) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Authority); err != nil {
+		return fmt.Errorf("invalid authority address: %q: %w", m.Authority, err)
+	}
+	if _, err := sdk.AccAddressFromBech32(m.VaultAddress); err != nil {
+		return fmt.Errorf("invalid vault address: %q: %w", m.VaultAddress, err)
+	}
+	if m.NavApprover != "" {
+		if _, err := sdk.AccAddressFromBech32(m.NavApprover); err != nil {
+			return fmt.Errorf("invalid nav approver address: %q: %w", m.NavApprover, err)
+		}
+	}
+	return ValidateNAVChangeLimit(m.MaxNavChangeBips, m.NavApprover)
+}
+
+// ValidateBasic performs stateless validation on MsgUpdateSettlementToleranceRequest.
+func (m MsgUpdateSettlementToleranceRequest) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Authority); err != nil {
+		return fmt.Errorf("invalid authority address: %q: %w", m.Authority, err)
+	}
+	if _, err := sdk.AccAddressFromBech32(m.VaultAddress); err != nil {
+		return fmt.Errorf("invalid vault address: %q: %w", m.VaultAddress, err)
+	}
+	if m.SettlementToleranceBips > MaxSettlementToleranceBips {
+		return fmt.Errorf("settlement tolerance bips cannot exceed %d: %d", MaxSettlementToleranceBips, m.SettlementToleranceBips)
+	}
+	return nil
+}
+
+// ValidateBasic performs stateless validation on MsgApproveNAVProposalRequest.
+func (m MsgApproveNAVProposalRequest) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Approver); err != nil {
+		return fmt.Errorf("invalid approver address: %q: %w", m.Approver, err)
+	}
+	if _, err := sdk.AccAddressFromBech32(m.VaultAddress); err != nil {
+		return fmt.Errorf("invalid vault address: %q: %w", m.VaultAddress, err)
+	}
+	if err := sdk.ValidateDenom(m.Denom); err != nil {
+		return fmt.Errorf("invalid denom: %q: %w", m.Denom, err)
+	}
+	return nil
+}
+
+// ValidateBasic performs stateless validation on MsgUpdateNAVSourcesRequest.
+func (m MsgUpdateNAVSourcesRequest) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Authority); err != nil {
+		return fmt.Errorf("invalid authority address: %q: %w", m.Authority, err)
+	}
+	if _, err := sdk.AccAddressFromBech32(m.VaultAddress); err != nil {
+		return fmt.Errorf("invalid vault address: %q: %w", m.VaultAddress, err)
+	}
+	return ValidateNAVSources(m.NavSources, m.NavSourceQuorum, m.NavAggregation, m.NavSourceMaxAgeSeconds)
+}
+
+// ValidateBasic performs stateless validation on MsgSubmitSourceNAVRequest.
+func (m MsgSubmitSourceNAVRequest) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Source); err != nil {
+		return fmt.Errorf("invalid source address: %q: %w", m.Source, err)
+	}
+	if _, err := sdk.AccAddressFromBech32(m.VaultAddress); err != nil {
+		return fmt.Errorf("invalid vault address: %q: %w", m.VaultAddress, err)
+	}
+	if err := sdk.ValidateDenom(m.Denom); err != nil {
+		return fmt.Errorf("invalid denom: %q: %w", m.Denom, err)
+	}
+	if err := m.Price.Validate(); err != nil {
+		return fmt.Errorf("invalid price coin %v: %w", m.Price, err)
+	}
+	if m.Denom == m.Price.Denom {
+		return fmt.Errorf("NAV denom %q and price denom must differ", m.Denom)
+	}
+	if m.Volume.IsNil() || !m.Volume.IsPositive() {
+		return fmt.Errorf("volume must be positive")
+	}
+	return nil
+}
+
+// ValidateBasic performs stateless validation on MsgBatchUpdateVaultNAVRequest. Each
+// entry is checked exactly as MsgUpdateVaultNAVRequest checks its fields, and a denom
+// may appear at most once so the batch's outcome does not depend on entry order.
+func (m MsgBatchUpdateVaultNAVRequest) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Signer); err != nil {
+		return fmt.Errorf("invalid signer address: %q: %w", m.Signer, err)
+	}
+	if _, err := sdk.AccAddressFromBech32(m.VaultAddress); err != nil {
+		return fmt.Errorf("invalid vault address: %q: %w", m.VaultAddress, err)
+	}
+	if len(m.Updates) == 0 {
+		return fmt.Errorf("at least one NAV update is required")
+	}
+	if len(m.Updates) > MaxNAVBatchUpdates {
+		return fmt.Errorf("too many NAV updates (expected <= %d, actual: %d)", MaxNAVBatchUpdates, len(m.Updates))
+	}
+	seen := make(map[string]bool, len(m.Updates))
+	for i, update := range m.Updates {
+		if err := update.Validate(); err != nil {
+			return fmt.Errorf("invalid NAV update at index %d: %w", i, err)
+		}
+		if seen[update.Denom] {
+			return fmt.Errorf("duplicate NAV update for denom %q", update.Denom)
+		}
+		seen[update.Denom] = true
+	}
+	return nil
+}
+
+// Validate performs stateless validation on a single NAVUpdate. A zero price is
+// permitted so a held asset can be written down to zero, as in MsgUpdateVaultNAVRequest.
+func (u NAVUpdate) Validate() error {
+	if err := sdk.ValidateDenom(u.Denom); err != nil {
+		return fmt.Errorf("invalid denom: %q: %w", u.Denom, err)
+	}
+	if err := u.Price.Validate(); err != nil {
+		return fmt.Errorf("invalid price coin %v: %w", u.Price, err)
+	}
+	if u.Denom == u.Price.Denom {
+		return fmt.Errorf("NAV denom %q and price denom must differ", u.Denom)
+	}
+	if u.Volume.IsNil() || !u.Volume.IsPositive() {
+		return fmt.Errorf("volume must be positive")
+	}
+	if len(u.Source) > MaxNAVSourceLength {
+		return fmt.Errorf("source too long (expected <= %d, actual: %d)", MaxNAVSourceLength, len(u.Source))
+	}
+	return nil
+}
+
+// ValidateBasic performs stateless validation on MsgUpdatePriceSourcesRequest.
+func (m MsgUpdatePriceSourcesRequest) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Authority); err != nil {
+		return fmt.Errorf("invalid authority address: %q: %w", m.Authority, err)
+	}
+	if _, err := sdk.AccAddressFromBech32(m.VaultAddress); err != nil {
+		return fmt.Errorf("invalid vault address: %q: %w", m.VaultAddress, err)
+	}
+	return ValidatePriceSources(m.PriceSources)
+}
+
+// ValidateBasic performs stateless validation on MsgUpdateAssetHaircutRequest.
+func (m MsgUpdateAssetHaircutRequest) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Signer); err != nil {
+		return fmt.Errorf("invalid signer address: %q: %w", m.Signer, err)
+	}
+	if _, err := sdk.AccAddressFromBech32(m.VaultAddress); err != nil {
+		return fmt.Errorf("invalid vault address: %q: %w", m.VaultAddress, err)
+	}
+	if err := sdk.ValidateDenom(m.Denom); err != nil {
+		return fmt.Errorf("invalid denom: %q: %w", m.Denom, err)
+	}
+	return ValidateHaircutBips(m.HaircutBips)
+}
+
+// ValidateBasic performs stateless validation on MsgBatchAcceptAssetsRequest. Each payment
+// reference is checked exactly as MsgAcceptAssetRequest checks its source and external id,
+// and a payment may appear at most once.
+func (m MsgBatchAcceptAssetsRequest) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Authority); err != nil {
+		return fmt.Errorf("invalid authority address: %q: %w", m.Authority, err)
+	}
+	if _, err := sdk.AccAddressFromBech32(m.VaultAddress); err != nil {
+		return fmt.Errorf("invalid vault address: %q: %w", m.VaultAddress, err)
+	}
+	if len(m.Payments) == 0 {
+		return fmt.Errorf("at least one payment is required")
+	}
+	if len(m.Payments) > MaxAssetBatchPayments {
+		return fmt.Errorf("too many payments (expected <= %d, actual: %d)", MaxAssetBatchPayments, len(m.Payments))
+	}
+	seen := make(map[AssetPaymentRef]bool, len(m.Payments))
+	for i, ref := range m.Payments {
+		if err := ref.Validate(); err != nil {
+			return fmt.Errorf("invalid payment at index %d: %w", i, err)
+		}
+		if seen[ref] {
+			return fmt.Errorf("duplicate payment for source %s and external id %q", ref.Source, ref.ExternalId)
+		}
+		seen[ref] = true
+	}
+	return nil
+}
+
+// Validate performs stateless validation on a single AssetPaymentRef.
+func (r AssetPaymentRef) Validate() error {
+	if _, err := sdk.AccAddressFromBech32(r.Source); err != nil {
+		return fmt.Errorf("invalid source address: %q: %w", r.Source, err)
+	}
+	if err := exchange.ValidateExternalID(r.ExternalId); err != nil {
+		return fmt.Errorf("invalid external id: %w", err)
+	}
+	return nil
+}
+
+// ValidateBasic performs stateless validation on MsgCreateAssetPaymentRequest.
+func (m MsgCreateAssetPaymentRequest) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Authority); err != nil {
+		return fmt.Errorf("invalid authority address: %q: %w", m.Authority, err)
+	}
+	if _, err := sdk.AccAddressFromBech32(m.VaultAddress); err != nil {
+		return fmt.Errorf("invalid vault address: %q: %w", m.VaultAddress, err)
+	}
+	if _, err := sdk.AccAddressFromBech32(m.Target); err != nil {
+		return fmt.Errorf("invalid target address: %q: %w", m.Target, err)
+	}
+	if m.Target == m.VaultAddress {
+		return fmt.Errorf("target cannot be the vault itself")
+	}
+	if err := m.SourceAmount.Validate(); err != nil {
+		return fmt.Errorf("invalid source amount %q: %w", m.SourceAmount, err)
+	}
+	if err := m.TargetAmount.Validate(); err != nil {
+		return fmt.Errorf("invalid target amount %q: %w", m.TargetAmount, err)
+	}
+	if m.SourceAmount.IsZero() && m.TargetAmount.IsZero() {
+		return fmt.Errorf("source amount and target amount cannot both be zero")
+	}
+	if err := exchange.ValidateExternalID(m.ExternalId); err != nil {
+		return fmt.Errorf("invalid external id: %w", err)
+	}
+	if m.ExpirationSeconds == 0 || m.ExpirationSeconds > MaxAssetPaymentExpirationSeconds {
+		return fmt.Errorf("expiration seconds must be between 1 and %d: got %d", MaxAssetPaymentExpirationSeconds, m.ExpirationSeconds)
+	}
+	return nil
+}
+
+// ValidateBasic performs stateless validation on MsgCancelAssetPaymentRequest.
+func (m MsgCancelAssetPaymentRequest) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Authority); err != nil {
+		return fmt.Errorf("invalid authority address: %q: %w", m.Authority, err)
+	}
+	if _, err := sdk.AccAddressFromBech32(m.VaultAddress); err != nil {
+		return fmt.Errorf("invalid vault address: %q: %w", m.VaultAddress, err)
+	}
+	if err := exchange.ValidateExternalID(m.ExternalId); err != nil {
+		return fmt.Errorf("invalid external id: %w", err)
+	}
+	return nil
+}
+
+// ValidateBasic performs stateless validation on MsgExpireAssetPaymentRequest.
+func (m MsgExpireAssetPaymentRequest) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Signer); err != nil {
+		return fmt.Errorf("invalid signer address: %q: %w", m.Signer, err)
+	}
+	if _, err := sdk.AccAddressFromBech32(m.VaultAddress); err != nil {
+		return fmt.Errorf("invalid vault address: %q: %w", m.VaultAddress, err)
+	}
+	if err := exchange.ValidateExternalID(m.ExternalId); err != nil {
+		return fmt.Errorf("invalid external id: %w", err)
+	}
+	return nil
+}
+
+// ValidateBasic performs stateless validation on MsgUpdateAcquisitionPolicyRequest.
+func (m MsgUpdateAcquisitionPolicyRequest) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Authority); err != nil {
+		return fmt.Errorf("invalid authority address: %q: %w", m.Authority, err)
+	}
+	if _, err := sdk.AccAddressFromBech32(m.VaultAddress); err != nil {
+		return fmt.Errorf("invalid vault address: %q: %w", m.VaultAddress, err)
+	}
+	if err := ValidateAcquisitionPolicy(m.Policy); err != nil {
+		return fmt.Errorf("invalid acquisition policy: %w", err)
+	}
+	return nil
+}
+
+// ValidateBasic performs stateless validation on MsgUpdateConcentrationLimitsRequest.
+func (m MsgUpdateConcentrationLimitsRequest) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Authority); err != nil {
+		return fmt.Errorf("invalid authority address: %q: %w", m.Authority, err)
+	}
+	if _, err := sdk.AccAddressFromBech32(m.VaultAddress); err != nil {
+		return fmt.Errorf("invalid vault address: %q: %w", m.VaultAddress, err)
+	}
+	return ValidateConcentrationLimits(m.MaxAssetConcentrationBips, m.MaxNonUnderlyingBips)
+}
+
+// ValidateBasic performs stateless validation on MsgUpdateMinLiquidityRequest.
+func (m MsgUpdateMinLiquidityRequest) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Authority); err != nil {
+		return fmt.Errorf("invalid authority address: %q: %w", m.Authority, err)
+	}
+	if _, err := sdk.AccAddressFromBech32(m.VaultAddress); err != nil {
+		return fmt.Errorf("invalid vault address: %q: %w", m.VaultAddress, err)
+	}
+	if m.MinLiquidityBips > MaxMinLiquidityBips {
+		return fmt.Errorf("min liquidity bips cannot exceed %d: %d", MaxMinLiquidityBips, m.MinLiquidityBips)
+	}
+	return nil
+}
+
+// ValidateBasic performs stateless validation on MsgCollectAssetIncomeRequest.
+func (m MsgCollectAssetIncomeRequest) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Authority); err != nil {
+		return fmt.Errorf("invalid authority address: %q: %w", m.Authority, err)
+	}
+	if _, err := sdk.AccAddressFromBech32(m.VaultAddress); err != nil {
+		return fmt.Errorf("invalid vault address: %q: %w", m.VaultAddress, err)
+	}
+	if err := sdk.ValidateDenom(m.SourceAsset); err != nil {
+		return fmt.Errorf("invalid source asset: %q: %w", m.SourceAsset, err)
+	}
+	if err := m.Amount.Validate(); err != nil {
+		return fmt.Errorf("invalid income amount: %w", err)
+	}
+	if !m.Amount.Amount.IsPositive() {
+		return fmt.Errorf("income amount must be greater than zero")
+	}
+	if m.SourceAsset == m.Amount.Denom {
+		return fmt.Errorf("source asset %q cannot be the income denom", m.SourceAsset)
+	}
+	return nil
+}
+
+// ValidateBasic performs stateless validation on MsgGrantRoleRequest.
+func (m MsgGrantRoleRequest) ValidateBasic() error {
+	return validateRoleChange(m.Authority, m.VaultAddress, m.Role, m.Address)
+}
+
+// ValidateBasic performs stateless validation on MsgRevokeRoleRequest.
+func (m MsgRevokeRoleRequest) ValidateBasic() error {
+	return validateRoleChange(m.Authority, m.VaultAddress, m.Role, m.Address)
+}
+
+// validateRoleChange performs the stateless validation shared by role grants and revocations.
+func validateRoleChange(authority, vaultAddress string, role VaultRole, address string) error {
+	if _, err := sdk.AccAddressFromBech32(authority); err != nil {
+		return fmt.Errorf("invalid authority address: %q: %w", authority, err)
+	}
+	if _, err := sdk.AccAddressFromBech32(vaultAddress); err != nil {
+		return fmt.Errorf("invalid vault address: %q: %w", vaultAddress, err)
+	}
+	if err := ValidateVaultRole(role); err != nil {
+		return fmt.Errorf("invalid role: %w", err)
+	}
+	if _, err := sdk.AccAddressFromBech32(address); err != nil {
+		return fmt.Errorf("invalid role address: %q: %w", address, err)
+	}
+	return nil
+}
+
+// ValidateBasic performs stateless validation on MsgProposeAdminTransferRequest.
+func (m MsgProposeAdminTransferRequest) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Authority); err != nil {
+		return fmt.Errorf("invalid authority address: %q: %w", m.Authority, err)
+	}
+	if _, err := sdk.AccAddressFromBech32(m.VaultAddress); err != nil {
+		return fmt.Errorf("invalid vault address: %q: %w", m.VaultAddress, err)
+	}
+	if _, err := sdk.AccAddressFromBech32(m.NewAdmin); err != nil {
+		return fmt.Errorf("invalid new admin address: %q: %w", m.NewAdmin, err)
+	}
+	return nil
+}
+
+// ValidateBasic performs stateless validation on MsgAcceptAdminTransferRequest.
+func (m MsgAcceptAdminTransferRequest) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.NewAdmin); err != nil {
+		return fmt.Errorf("invalid new admin address: %q: %w", m.NewAdmin, err)
+	}
+	if _, err := sdk.AccAddressFromBech32(m.VaultAddress); err != nil {
+		return fmt.Errorf("invalid vault address: %q: %w", m.VaultAddress, err)
+	}
+	return nil
+}
+
+// ValidateBasic performs stateless validation on MsgCancelAdminTransferRequest.
+func (m MsgCancelAdminTransferRequest) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Authority); err != nil {
+		return fmt.Errorf("invalid authority address: %q: %w", m.Authority, err)
+	}
+	if _, err := sdk.AccAddressFromBech32(m.VaultAddress); err != nil {
+		return fmt.Errorf("invalid vault address: %q: %w", m.VaultAddress, err)
+	}
+	return nil
+}
+
+// ValidateBasic performs stateless validation on MsgRecoverVaultAdminRequest.
+func (m MsgRecoverVaultAdminRequest) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Authority); err != nil {
+		return fmt.Errorf("invalid authority address: %q: %w", m.Authority, err)
+	}
+	if _, err := sdk.AccAddressFromBech32(m.VaultAddress); err != nil {
+		return fmt.Errorf("invalid vault address: %q: %w", m.VaultAddress, err)
+	}
+	if _, err := sdk.AccAddressFromBech32(m.NewAdmin); err != nil {
+		return fmt.Errorf("invalid new admin address: %q: %w", m.NewAdmin, err)
+	}
+	return nil
+}
+
+// ValidateBasic performs stateless validation on MsgUpdateTimelockRequest.
+func (m MsgUpdateTimelockRequest) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Authority); err != nil {
+		return fmt.Errorf("invalid authority address: %q: %w", m.Authority, err)
+	}
+	if _, err := sdk.AccAddressFromBech32(m.VaultAddress); err != nil {
+		return fmt.Errorf("invalid vault address: %q: %w", m.VaultAddress, err)
+	}
+	if m.TimelockSeconds > MaxTimelockSeconds {
+		return fmt.Errorf("timelock seconds cannot exceed %d", MaxTimelockSeconds)
+	}
+	return nil
+}
+
+// ValidateBasic performs stateless validation on MsgCancelScheduledActionRequest.
+func (m MsgCancelScheduledActionRequest) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Authority); err != nil {
+		return fmt.Errorf("invalid authority address: %q: %w", m.Authority, err)
+	}
+	if _, err := sdk.AccAddressFromBech32(m.VaultAddress); err != nil {
+		return fmt.Errorf("invalid vault address: %q: %w", m.VaultAddress, err)
+	}
+	return nil
+}
+
+// ValidateBasic performs stateless validation on MsgUpdateEligibilityPolicyRequest.
+func (m MsgUpdateEligibilityPolicyRequest) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Authority); err != nil {
+		return fmt.Errorf("invalid authority address: %q: %w", m.Authority, err)
+	}
+	if _, err := sdk.AccAddressFromBech32(m.VaultAddress); err != nil {
+		return fmt.Errorf("invalid vault address: %q: %w", m.VaultAddress, err)
+	}
+	if err := ValidateEligibilityPolicy(m.Policy); err != nil {
+		return fmt.Errorf("invalid eligibility policy: %w", err)
+	}
+	return nil
+}
+
+// ValidateBasic performs stateless validation on MsgUpdateAllowlistRequest.
+func (m MsgUpdateAllowlistRequest) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Authority); err != nil {
+		return fmt.Errorf("invalid authority address: %q: %w", m.Authority, err)
+	}
+	if _, err := sdk.AccAddressFromBech32(m.VaultAddress); err != nil {
+		return fmt.Errorf("invalid vault address: %q: %w", m.VaultAddress, err)
+	}
+	if len(m.Add) == 0 && len(m.Remove) == 0 {
+		return fmt.Errorf("at least one address must be added or removed")
+	}
+	if len(m.Add) > MaxAllowlistUpdateEntries || len(m.Remove) > MaxAllowlistUpdateEntries {
+		return fmt.Errorf("cannot add or remove more than %d addresses in a single update", MaxAllowlistUpdateEntries)
+	}
+	seen := make(map[string]bool, len(m.Add)+len(m.Remove))
+	for _, addr := range append(slices.Clone(m.Add), m.Remove...) {
+		if _, err := sdk.AccAddressFromBech32(addr); err != nil {
+			return fmt.Errorf("invalid allowlist address: %q: %w", addr, err)
+		}
+		if seen[addr] {
+			return fmt.Errorf("address %s is listed more than once", addr)
+		}
+		seen[addr] = true
+	}
+	return nil
+}
+
+// ValidateBasic performs stateless validation on MsgUpdatePositionCapRequest.
+func (m MsgUpdatePositionCapRequest) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Authority); err != nil {
+		return fmt.Errorf("invalid authority address: %q: %w", m.Authority, err)
+	}
+	if _, err := sdk.AccAddressFromBech32(m.VaultAddress); err != nil {
+		return fmt.Errorf("invalid vault address: %q: %w", m.VaultAddress, err)
+	}
+	if err := ValidatePositionCap(m.MaxPositionValue, m.Exemptions); err != nil {
+		return fmt.Errorf("invalid position cap: %w", err)
+	}
+	return nil
+}
+
+// ValidateBasic performs stateless validation on MsgUpdateMaxTotalValueRequest.
+func (m MsgUpdateMaxTotalValueRequest) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Authority); err != nil {
+		return fmt.Errorf("invalid authority address: %q: %w", m.Authority, err)
+	}
+	if _, err := sdk.AccAddressFromBech32(m.VaultAddress); err != nil {
+		return fmt.Errorf("invalid vault address: %q: %w", m.VaultAddress, err)
+	}
+	if err := ValidateMaxTotalValue(m.MaxTotalValue); err != nil {
+		return fmt.Errorf("invalid max total value: %w", err)
+	}
+	return nil
+}
+
+// ValidateBasic performs stateless validation on MsgUpdateCircuitBreakerRequest.
+func (m MsgUpdateCircuitBreakerRequest) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Authority); err != nil {
+		return fmt.Errorf("invalid authority address: %q: %w", m.Authority, err)
+	}
+	if m.Guardian != "" {
+		if _, err := sdk.AccAddressFromBech32(m.Guardian); err != nil {
+			return fmt.Errorf("invalid guardian address: %q: %w", m.Guardian, err)
+		}
+	}
+	return nil
+}
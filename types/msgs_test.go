@@ -2360,3 +2360,1717 @@ func TestMsgRejectAssetRequest_ValidateBasic(t *testing.T) {
 		},
 	})
 }
+
+func TestMsgUpdateNAVStalenessLimitRequest_ValidateBasic(t *testing.T) {
+	addr := NewTestAddress()
+
+	RunValidateBasicTable(t, []validateBasicCase{
+		{
+			name: "valid",
+			msg: types.MsgUpdateNAVStalenessLimitRequest{
+				Authority:           addr,
+				VaultAddress:        addr,
+				MaxNavAgeSeconds:    86_400,
+				AutoPauseOnStaleNav: true,
+			},
+		},
+		{
+			name: "valid zero limit disables the check",
+			msg: types.MsgUpdateNAVStalenessLimitRequest{
+				Authority:    addr,
+				VaultAddress: addr,
+			},
+		},
+		{
+			name: "valid at max",
+			msg: types.MsgUpdateNAVStalenessLimitRequest{
+				Authority:        addr,
+				VaultAddress:     addr,
+				MaxNavAgeSeconds: types.MaxNAVAge,
+			},
+		},
+		{
+			name: "invalid authority",
+			msg: types.MsgUpdateNAVStalenessLimitRequest{
+				Authority:    "bad",
+				VaultAddress: addr,
+			},
+			expectedErr: "invalid authority address",
+		},
+		{
+			name: "invalid vault address",
+			msg: types.MsgUpdateNAVStalenessLimitRequest{
+				Authority:    addr,
+				VaultAddress: "bad",
+			},
+			expectedErr: "invalid vault address",
+		},
+		{
+			name: "limit above max",
+			msg: types.MsgUpdateNAVStalenessLimitRequest{
+				Authority:        addr,
+				VaultAddress:     addr,
+				MaxNavAgeSeconds: types.MaxNAVAge + 1,
+			},
+			expectedErr: "max NAV age cannot exceed",
+		},
+	})
+}
+
+func TestMsgUpdateNAVChangeLimitRequest_ValidateBasic(t *testing.T) {
+	addr := NewTestAddress()
+
+	RunValidateBasicTable(t, []validateBasicCase{
+		{
+			name: "valid",
+			msg: types.MsgUpdateNAVChangeLimitRequest{
+				Authority:        addr,
+				VaultAddress:     addr,
+				MaxNavChangeBips: 500,
+				NavApprover:      addr,
+			},
+		},
+		{
+			name: "valid zero limit disables the guardrail",
+			msg: types.MsgUpdateNAVChangeLimitRequest{
+				Authority:    addr,
+				VaultAddress: addr,
+			},
+		},
+		{
+			name: "invalid authority",
+			msg: types.MsgUpdateNAVChangeLimitRequest{
+				Authority:    "bad",
+				VaultAddress: addr,
+			},
+			expectedErr: "invalid authority address",
+		},
+		{
+			name: "invalid vault address",
+			msg: types.MsgUpdateNAVChangeLimitRequest{
+				Authority:    addr,
+				VaultAddress: "bad",
+			},
+			expectedErr: "invalid vault address",
+		},
+		{
+			name: "invalid nav approver",
+			msg: types.MsgUpdateNAVChangeLimitRequest{
+				Authority:        addr,
+				VaultAddress:     addr,
+				MaxNavChangeBips: 500,
+				NavApprover:      "bad",
+			},
+			expectedErr: "invalid nav approver address",
+		},
+		{
+			name: "limit without nav approver",
+			msg: types.MsgUpdateNAVChangeLimitRequest{
+				Authority:        addr,
+				VaultAddress:     addr,
+				MaxNavChangeBips: 500,
+			},
+			expectedErr: "nav approver is required",
+		},
+		{
+			name: "limit above max",
+			msg: types.MsgUpdateNAVChangeLimitRequest{
+				Authority:        addr,
+				VaultAddress:     addr,
+				MaxNavChangeBips: types.MaxNAVChangeBips + 1,
+				NavApprover:      addr,
+			},
+			expectedErr: "max NAV change bips cannot exceed",
+		},
+	})
+}
+
+func TestMsgApproveNAVProposalRequest_ValidateBasic(t *testing.T) {
+	addr := NewTestAddress()
+
+	RunValidateBasicTable(t, []validateBasicCase{
+		{
+			name: "valid",
+			msg: types.MsgApproveNAVProposalRequest{
+				Approver:     addr,
+				VaultAddress: addr,
+				Denom:        "rwa",
+			},
+		},
+		{
+			name: "invalid approver",
+			msg: types.MsgApproveNAVProposalRequest{
+				Approver:     "bad",
+				VaultAddress: addr,
+				Denom:        "rwa",
+			},
+			expectedErr: "invalid approver address",
+		},
+		{
+			name: "invalid vault address",
+			msg: types.MsgApproveNAVProposalRequest{
+				Approver:     addr,
+				VaultAddress: "bad",
+				Denom:        "rwa",
+			},
+			expectedErr: "invalid vault address",
+		},
+		{
+			name: "invalid denom",
+			msg: types.MsgApproveNAVProposalRequest{
+				Approver:     addr,
+				VaultAddress: addr,
+				Denom:        "",
+			},
+			expectedErr: "invalid denom",
+		},
+	})
+}
+
+func TestMsgUpdateNAVSourcesRequest_ValidateBasic(t *testing.T) {
+	addr := NewTestAddress()
+	source := NewTestAddress()
+
+	RunValidateBasicTable(t, []validateBasicCase{
+		{
+			name: "valid",
+			msg: types.MsgUpdateNAVSourcesRequest{
+				Authority:              addr,
+				VaultAddress:           addr,
+				NavSources:             []string{addr, source},
+				NavSourceQuorum:        2,
+				NavAggregation:         types.NAVAggregation_NAV_AGGREGATION_MIN,
+				NavSourceMaxAgeSeconds: 3600,
+			},
+		},
+		{
+			name: "valid empty sources disable aggregation",
+			msg: types.MsgUpdateNAVSourcesRequest{
+				Authority:    addr,
+				VaultAddress: addr,
+			},
+		},
+		{
+			name: "invalid authority",
+			msg: types.MsgUpdateNAVSourcesRequest{
+				Authority:    "bad",
+				VaultAddress: addr,
+			},
+			expectedErr: "invalid authority address",
+		},
+		{
+			name: "invalid vault address",
+			msg: types.MsgUpdateNAVSourcesRequest{
+				Authority:    addr,
+				VaultAddress: "bad",
+			},
+			expectedErr: "invalid vault address",
+		},
+		{
+			name: "invalid source address",
+			msg: types.MsgUpdateNAVSourcesRequest{
+				Authority:    addr,
+				VaultAddress: addr,
+				NavSources:   []string{"bad"},
+			},
+			expectedErr: "invalid nav source address",
+		},
+		{
+			name: "duplicate source",
+			msg: types.MsgUpdateNAVSourcesRequest{
+				Authority:    addr,
+				VaultAddress: addr,
+				NavSources:   []string{source, source},
+			},
+			expectedErr: "duplicate nav source",
+		},
+		{
+			name: "unknown aggregation",
+			msg: types.MsgUpdateNAVSourcesRequest{
+				Authority:      addr,
+				VaultAddress:   addr,
+				NavAggregation: types.NAVAggregation(7),
+			},
+			expectedErr: "unknown nav aggregation",
+		},
+		{
+			name: "quorum above source count",
+			msg: types.MsgUpdateNAVSourcesRequest{
+				Authority:              addr,
+				VaultAddress:           addr,
+				NavSources:             []string{source},
+				NavSourceQuorum:        2,
+				NavSourceMaxAgeSeconds: 3600,
+			},
+			expectedErr: "cannot exceed the number of nav sources",
+		},
+		{
+			name: "quorum without max age",
+			msg: types.MsgUpdateNAVSourcesRequest{
+				Authority:       addr,
+				VaultAddress:    addr,
+				NavSources:      []string{source},
+				NavSourceQuorum: 1,
+			},
+			expectedErr: "nav source max age is required",
+		},
+		{
+			name: "max age above max",
+			msg: types.MsgUpdateNAVSourcesRequest{
+				Authority:              addr,
+				VaultAddress:           addr,
+				NavSourceMaxAgeSeconds: types.MaxNAVAge + 1,
+			},
+			expectedErr: "nav source max age cannot exceed",
+		},
+	})
+}
+
+func TestMsgSubmitSourceNAVRequest_ValidateBasic(t *testing.T) {
+	addr := NewTestAddress()
+
+	RunValidateBasicTable(t, []validateBasicCase{
+		{
+			name: "valid",
+			msg: types.MsgSubmitSourceNAVRequest{
+				Source:       addr,
+				VaultAddress: addr,
+				Denom:        "rwa",
+				Price:        sdk.NewInt64Coin("usdc", 100),
+				Volume:       sdkmath.NewInt(1),
+			},
+		},
+		{
+			name: "invalid source",
+			msg: types.MsgSubmitSourceNAVRequest{
+				Source:       "bad",
+				VaultAddress: addr,
+				Denom:        "rwa",
+				Price:        sdk.NewInt64Coin("usdc", 100),
+				Volume:       sdkmath.NewInt(1),
+			},
+			expectedErr: "invalid source address",
+		},
+		{
+			name: "invalid vault address",
+			msg: types.MsgSubmitSourceNAVRequest{
+				Source:       addr,
+				VaultAddress: "bad",
+				Denom:        "rwa",
+				Price:        sdk.NewInt64Coin("usdc", 100),
+				Volume:       sdkmath.NewInt(1),
+			},
+			expectedErr: "invalid vault address",
+		},
+		{
+			name: "invalid denom",
+			msg: types.MsgSubmitSourceNAVRequest{
+				Source:       addr,
+				VaultAddress: addr,
+				Denom:        "",
+				Price:        sdk.NewInt64Coin("usdc", 100),
+				Volume:       sdkmath.NewInt(1),
+			},
+			expectedErr: "invalid denom",
+		},
+		{
+			name: "price denom matches denom",
+			msg: types.MsgSubmitSourceNAVRequest{
+				Source:       addr,
+				VaultAddress: addr,
+				Denom:        "usdc",
+				Price:        sdk.NewInt64Coin("usdc", 100),
+				Volume:       sdkmath.NewInt(1),
+			},
+			expectedErr: "price denom must differ",
+		},
+		{
+			name: "zero volume",
+			msg: types.MsgSubmitSourceNAVRequest{
+				Source:       addr,
+				VaultAddress: addr,
+				Denom:        "rwa",
+				Price:        sdk.NewInt64Coin("usdc", 100),
+				Volume:       sdkmath.ZeroInt(),
+			},
+			expectedErr: "volume must be positive",
+		},
+	})
+}
+
+func TestMsgBatchUpdateVaultNAVRequest_ValidateBasic(t *testing.T) {
+	addr := NewTestAddress()
+	update := func(denom string) types.NAVUpdate {
+		return types.NAVUpdate{Denom: denom, Price: sdk.NewInt64Coin("usdc", 100), Volume: sdkmath.NewInt(1)}
+	}
+	tooMany := make([]types.NAVUpdate, types.MaxNAVBatchUpdates+1)
+	for i := range tooMany {
+		tooMany[i] = update(fmt.Sprintf("rwa%d", i))
+	}
+
+	RunValidateBasicTable(t, []validateBasicCase{
+		{
+			name: "valid",
+			msg: types.MsgBatchUpdateVaultNAVRequest{
+				Signer:       addr,
+				VaultAddress: addr,
+				Updates:      []types.NAVUpdate{update("rwa"), update("loan")},
+			},
+		},
+		{
+			name: "zero price is allowed",
+			msg: types.MsgBatchUpdateVaultNAVRequest{
+				Signer:       addr,
+				VaultAddress: addr,
+				Updates:      []types.NAVUpdate{{Denom: "rwa", Price: sdk.NewInt64Coin("usdc", 0), Volume: sdkmath.NewInt(1)}},
+			},
+		},
+		{
+			name: "invalid signer",
+			msg: types.MsgBatchUpdateVaultNAVRequest{
+				Signer:       "bad",
+				VaultAddress: addr,
+				Updates:      []types.NAVUpdate{update("rwa")},
+			},
+			expectedErr: "invalid signer address",
+		},
+		{
+			name: "invalid vault address",
+			msg: types.MsgBatchUpdateVaultNAVRequest{
+				Signer:       addr,
+				VaultAddress: "bad",
+				Updates:      []types.NAVUpdate{update("rwa")},
+			},
+			expectedErr: "invalid vault address",
+		},
+		{
+			name: "no updates",
+			msg: types.MsgBatchUpdateVaultNAVRequest{
+				Signer:       addr,
+				VaultAddress: addr,
+			},
+			expectedErr: "at least one NAV update is required",
+		},
+		{
+			name: "too many updates",
+			msg: types.MsgBatchUpdateVaultNAVRequest{
+				Signer:       addr,
+				VaultAddress: addr,
+				Updates:      tooMany,
+			},
+			expectedErr: "too many NAV updates",
+		},
+		{
+			name: "invalid update",
+			msg: types.MsgBatchUpdateVaultNAVRequest{
+				Signer:       addr,
+				VaultAddress: addr,
+				Updates:      []types.NAVUpdate{update("rwa"), {Denom: "loan", Price: sdk.NewInt64Coin("usdc", 100), Volume: sdkmath.ZeroInt()}},
+			},
+			expectedErr: "invalid NAV update at index 1: volume must be positive",
+		},
+		{
+			name: "source too long",
+			msg: types.MsgBatchUpdateVaultNAVRequest{
+				Signer:       addr,
+				VaultAddress: addr,
+				Updates: []types.NAVUpdate{{
+					Denom:  "rwa",
+					Price:  sdk.NewInt64Coin("usdc", 100),
+					Volume: sdkmath.NewInt(1),
+					Source: strings.Repeat("a", types.MaxNAVSourceLength+1),
+				}},
+			},
+			expectedErr: "source too long",
+		},
+		{
+			name: "duplicate denom",
+			msg: types.MsgBatchUpdateVaultNAVRequest{
+				Signer:       addr,
+				VaultAddress: addr,
+				Updates:      []types.NAVUpdate{update("rwa"), update("rwa")},
+			},
+			expectedErr: "duplicate NAV update for denom",
+		},
+	})
+}
+
+func TestMsgUpdatePriceSourcesRequest_ValidateBasic(t *testing.T) {
+	addr := NewTestAddress()
+
+	RunValidateBasicTable(t, []validateBasicCase{
+		{
+			name: "valid",
+			msg: types.MsgUpdatePriceSourcesRequest{
+				Authority:    addr,
+				VaultAddress: addr,
+				PriceSources: []types.PriceSourceType{
+					types.PriceSourceType_PRICE_SOURCE_TYPE_INTERNAL_NAV,
+					types.PriceSourceType_PRICE_SOURCE_TYPE_MARKER_NAV,
+					types.PriceSourceType_PRICE_SOURCE_TYPE_EXCHANGE_SETTLEMENT,
+				},
+			},
+		},
+		{
+			name: "valid empty chain restores the default",
+			msg: types.MsgUpdatePriceSourcesRequest{
+				Authority:    addr,
+				VaultAddress: addr,
+			},
+		},
+		{
+			name: "invalid authority",
+			msg: types.MsgUpdatePriceSourcesRequest{
+				Authority:    "bad",
+				VaultAddress: addr,
+			},
+			expectedErr: "invalid authority address",
+		},
+		{
+			name: "invalid vault address",
+			msg: types.MsgUpdatePriceSourcesRequest{
+				Authority:    addr,
+				VaultAddress: "bad",
+			},
+			expectedErr: "invalid vault address",
+		},
+		{
+			name: "unknown price source",
+			msg: types.MsgUpdatePriceSourcesRequest{
+				Authority:    addr,
+				VaultAddress: addr,
+				PriceSources: []types.PriceSourceType{types.PriceSourceType(99)},
+			},
+			expectedErr: "unknown price source: 99",
+		},
+		{
+			name: "duplicate price source",
+			msg: types.MsgUpdatePriceSourcesRequest{
+				Authority:    addr,
+				VaultAddress: addr,
+				PriceSources: []types.PriceSourceType{
+					types.PriceSourceType_PRICE_SOURCE_TYPE_MARKER_NAV,
+					types.PriceSourceType_PRICE_SOURCE_TYPE_MARKER_NAV,
+				},
+			},
+			expectedErr: "duplicate price source: PRICE_SOURCE_TYPE_MARKER_NAV",
+		},
+	})
+}
+
+func TestMsgUpdateAssetHaircutRequest_ValidateBasic(t *testing.T) {
+	addr := NewTestAddress()
+
+	RunValidateBasicTable(t, []validateBasicCase{
+		{
+			name: "valid",
+			msg: types.MsgUpdateAssetHaircutRequest{
+				Signer:       addr,
+				VaultAddress: addr,
+				Denom:        "rwa",
+				HaircutBips:  2_500,
+			},
+		},
+		{
+			name: "valid zero removes the haircut",
+			msg: types.MsgUpdateAssetHaircutRequest{
+				Signer:       addr,
+				VaultAddress: addr,
+				Denom:        "rwa",
+			},
+		},
+		{
+			name: "valid full haircut",
+			msg: types.MsgUpdateAssetHaircutRequest{
+				Signer:       addr,
+				VaultAddress: addr,
+				Denom:        "rwa",
+				HaircutBips:  types.MaxHaircutBips,
+			},
+		},
+		{
+			name: "invalid signer",
+			msg: types.MsgUpdateAssetHaircutRequest{
+				Signer:       "bad",
+				VaultAddress: addr,
+				Denom:        "rwa",
+			},
+			expectedErr: "invalid signer address",
+		},
+		{
+			name: "invalid vault address",
+			msg: types.MsgUpdateAssetHaircutRequest{
+				Signer:       addr,
+				VaultAddress: "bad",
+				Denom:        "rwa",
+			},
+			expectedErr: "invalid vault address",
+		},
+		{
+			name: "invalid denom",
+			msg: types.MsgUpdateAssetHaircutRequest{
+				Signer:       addr,
+				VaultAddress: addr,
+				Denom:        "!",
+			},
+			expectedErr: "invalid denom",
+		},
+		{
+			name: "haircut above the maximum",
+			msg: types.MsgUpdateAssetHaircutRequest{
+				Signer:       addr,
+				VaultAddress: addr,
+				Denom:        "rwa",
+				HaircutBips:  types.MaxHaircutBips + 1,
+			},
+			expectedErr: "invalid haircut bips: 10001 (max 10000)",
+		},
+	})
+}
+
+func TestMsgBatchAcceptAssetsRequest_ValidateBasic(t *testing.T) {
+	addr := NewTestAddress()
+	ref := func(externalID string) types.AssetPaymentRef {
+		return types.AssetPaymentRef{Source: addr, ExternalId: externalID}
+	}
+	tooMany := make([]types.AssetPaymentRef, types.MaxAssetBatchPayments+1)
+	for i := range tooMany {
+		tooMany[i] = ref(fmt.Sprintf("loan-%d", i))
+	}
+
+	RunValidateBasicTable(t, []validateBasicCase{
+		{
+			name: "valid",
+			msg: types.MsgBatchAcceptAssetsRequest{
+				Authority:    addr,
+				VaultAddress: addr,
+				Payments:     []types.AssetPaymentRef{ref("loan-1"), ref("loan-2")},
+				BestEffort:   true,
+			},
+		},
+		{
+			name: "invalid authority",
+			msg: types.MsgBatchAcceptAssetsRequest{
+				Authority:    "bad",
+				VaultAddress: addr,
+				Payments:     []types.AssetPaymentRef{ref("loan-1")},
+			},
+			expectedErr: "invalid authority address",
+		},
+		{
+			name: "invalid vault address",
+			msg: types.MsgBatchAcceptAssetsRequest{
+				Authority:    addr,
+				VaultAddress: "bad",
+				Payments:     []types.AssetPaymentRef{ref("loan-1")},
+			},
+			expectedErr: "invalid vault address",
+		},
+		{
+			name: "no payments",
+			msg: types.MsgBatchAcceptAssetsRequest{
+				Authority:    addr,
+				VaultAddress: addr,
+			},
+			expectedErr: "at least one payment is required",
+		},
+		{
+			name: "too many payments",
+			msg: types.MsgBatchAcceptAssetsRequest{
+				Authority:    addr,
+				VaultAddress: addr,
+				Payments:     tooMany,
+			},
+			expectedErr: "too many payments",
+		},
+		{
+			name: "invalid source",
+			msg: types.MsgBatchAcceptAssetsRequest{
+				Authority:    addr,
+				VaultAddress: addr,
+				Payments:     []types.AssetPaymentRef{ref("loan-1"), {Source: "bad", ExternalId: "loan-2"}},
+			},
+			expectedErr: "invalid payment at index 1: invalid source address",
+		},
+		{
+			name: "invalid external id",
+			msg: types.MsgBatchAcceptAssetsRequest{
+				Authority:    addr,
+				VaultAddress: addr,
+				Payments:     []types.AssetPaymentRef{ref(strings.Repeat("x", 101))},
+			},
+			expectedErr: "invalid payment at index 0: invalid external id",
+		},
+		{
+			name: "duplicate payment",
+			msg: types.MsgBatchAcceptAssetsRequest{
+				Authority:    addr,
+				VaultAddress: addr,
+				Payments:     []types.AssetPaymentRef{ref("loan-1"), ref("loan-1")},
+			},
+			expectedErr: "duplicate payment",
+		},
+	})
+}
+
+func TestMsgUpdateSettlementToleranceRequest_ValidateBasic(t *testing.T) {
+	addr := NewTestAddress()
+
+	RunValidateBasicTable(t, []validateBasicCase{
+		{
+			name: "valid",
+			msg: types.MsgUpdateSettlementToleranceRequest{
+				Authority:               addr,
+				VaultAddress:            addr,
+				SettlementToleranceBips: 50,
+				UpdateNavOnSettlement:   true,
+			},
+		},
+		{
+			name: "valid zero tolerance requires exact NAV",
+			msg: types.MsgUpdateSettlementToleranceRequest{
+				Authority:    addr,
+				VaultAddress: addr,
+			},
+		},
+		{
+			name: "invalid authority",
+			msg: types.MsgUpdateSettlementToleranceRequest{
+				Authority:    "bad",
+				VaultAddress: addr,
+			},
+			expectedErr: "invalid authority address",
+		},
+		{
+			name: "invalid vault address",
+			msg: types.MsgUpdateSettlementToleranceRequest{
+				Authority:    addr,
+				VaultAddress: "bad",
+			},
+			expectedErr: "invalid vault address",
+		},
+		{
+			name: "tolerance too large",
+			msg: types.MsgUpdateSettlementToleranceRequest{
+				Authority:               addr,
+				VaultAddress:            addr,
+				SettlementToleranceBips: types.MaxSettlementToleranceBips + 1,
+			},
+			expectedErr: "settlement tolerance bips cannot exceed",
+		},
+	})
+}
+
+func TestMsgCreateAssetPaymentRequest_ValidateBasic(t *testing.T) {
+	addr := NewTestAddress()
+	target := NewTestAddress()
+	asset := sdk.NewCoins(sdk.NewInt64Coin("rwa", 1))
+	underlying := sdk.NewCoins(sdk.NewInt64Coin("under", 100))
+	valid := func() types.MsgCreateAssetPaymentRequest {
+		return types.MsgCreateAssetPaymentRequest{
+			Authority:         addr,
+			VaultAddress:      addr,
+			Target:            target,
+			SourceAmount:      asset,
+			TargetAmount:      underlying,
+			ExternalId:        "sale-1",
+			ExpirationSeconds: 3_600,
+		}
+	}
+	with := func(mutate func(*types.MsgCreateAssetPaymentRequest)) types.MsgCreateAssetPaymentRequest {
+		msg := valid()
+		mutate(&msg)
+		return msg
+	}
+
+	RunValidateBasicTable(t, []validateBasicCase{
+		{
+			name: "valid",
+			msg:  valid(),
+		},
+		{
+			name: "valid with only a target amount",
+			msg:  with(func(m *types.MsgCreateAssetPaymentRequest) { m.SourceAmount = nil }),
+		},
+		{
+			name:        "invalid authority",
+			msg:         with(func(m *types.MsgCreateAssetPaymentRequest) { m.Authority = "bad" }),
+			expectedErr: "invalid authority address",
+		},
+		{
+			name:        "invalid vault address",
+			msg:         with(func(m *types.MsgCreateAssetPaymentRequest) { m.VaultAddress = "bad" }),
+			expectedErr: "invalid vault address",
+		},
+		{
+			name:        "invalid target",
+			msg:         with(func(m *types.MsgCreateAssetPaymentRequest) { m.Target = "bad" }),
+			expectedErr: "invalid target address",
+		},
+		{
+			name:        "target is the vault",
+			msg:         with(func(m *types.MsgCreateAssetPaymentRequest) { m.Target = addr }),
+			expectedErr: "target cannot be the vault itself",
+		},
+		{
+			name: "invalid source amount",
+			msg: with(func(m *types.MsgCreateAssetPaymentRequest) {
+				m.SourceAmount = sdk.Coins{{Denom: "rwa", Amount: sdkmath.NewInt(-1)}}
+			}),
+			expectedErr: "invalid source amount",
+		},
+		{
+			name: "invalid target amount",
+			msg: with(func(m *types.MsgCreateAssetPaymentRequest) {
+				m.TargetAmount = sdk.Coins{{Denom: "under", Amount: sdkmath.NewInt(-1)}}
+			}),
+			expectedErr: "invalid target amount",
+		},
+		{
+			name: "both amounts zero",
+			msg: with(func(m *types.MsgCreateAssetPaymentRequest) {
+				m.SourceAmount = nil
+				m.TargetAmount = nil
+			}),
+			expectedErr: "source amount and target amount cannot both be zero",
+		},
+		{
+			name:        "invalid external id",
+			msg:         with(func(m *types.MsgCreateAssetPaymentRequest) { m.ExternalId = strings.Repeat("x", 101) }),
+			expectedErr: "invalid external id",
+		},
+		{
+			name:        "zero expiration",
+			msg:         with(func(m *types.MsgCreateAssetPaymentRequest) { m.ExpirationSeconds = 0 }),
+			expectedErr: "expiration seconds must be between 1 and",
+		},
+		{
+			name: "expiration too long",
+			msg: with(func(m *types.MsgCreateAssetPaymentRequest) {
+				m.ExpirationSeconds = types.MaxAssetPaymentExpirationSeconds + 1
+			}),
+			expectedErr: "expiration seconds must be between 1 and",
+		},
+	})
+}
+
+func TestMsgCancelAssetPaymentRequest_ValidateBasic(t *testing.T) {
+	addr := NewTestAddress()
+
+	RunValidateBasicTable(t, []validateBasicCase{
+		{
+			name: "valid",
+			msg:  types.MsgCancelAssetPaymentRequest{Authority: addr, VaultAddress: addr, ExternalId: "sale-1"},
+		},
+		{
+			name:        "invalid authority",
+			msg:         types.MsgCancelAssetPaymentRequest{Authority: "bad", VaultAddress: addr, ExternalId: "sale-1"},
+			expectedErr: "invalid authority address",
+		},
+		{
+			name:        "invalid vault address",
+			msg:         types.MsgCancelAssetPaymentRequest{Authority: addr, VaultAddress: "bad", ExternalId: "sale-1"},
+			expectedErr: "invalid vault address",
+		},
+		{
+			name:        "invalid external id",
+			msg:         types.MsgCancelAssetPaymentRequest{Authority: addr, VaultAddress: addr, ExternalId: strings.Repeat("x", 101)},
+			expectedErr: "invalid external id",
+		},
+	})
+}
+
+func TestMsgExpireAssetPaymentRequest_ValidateBasic(t *testing.T) {
+	addr := NewTestAddress()
+
+	RunValidateBasicTable(t, []validateBasicCase{
+		{
+			name: "valid",
+			msg:  types.MsgExpireAssetPaymentRequest{Signer: addr, VaultAddress: addr, ExternalId: "sale-1"},
+		},
+		{
+			name:        "invalid signer",
+			msg:         types.MsgExpireAssetPaymentRequest{Signer: "bad", VaultAddress: addr, ExternalId: "sale-1"},
+			expectedErr: "invalid signer address",
+		},
+		{
+			name:        "invalid vault address",
+			msg:         types.MsgExpireAssetPaymentRequest{Signer: addr, VaultAddress: "bad", ExternalId: "sale-1"},
+			expectedErr: "invalid vault address",
+		},
+		{
+			name:        "invalid external id",
+			msg:         types.MsgExpireAssetPaymentRequest{Signer: addr, VaultAddress: addr, ExternalId: strings.Repeat("x", 101)},
+			expectedErr: "invalid external id",
+		},
+	})
+}
+
+func TestMsgUpdateAcquisitionPolicyRequest_ValidateBasic(t *testing.T) {
+	addr := NewTestAddress()
+
+	RunValidateBasicTable(t, []validateBasicCase{
+		{
+			name: "valid",
+			msg: types.MsgUpdateAcquisitionPolicyRequest{
+				Authority:    addr,
+				VaultAddress: addr,
+				Policy:       types.AcquisitionPolicy{DenomPatterns: []string{"nft/*"}},
+			},
+		},
+		{
+			name: "valid empty policy permits everything",
+			msg: types.MsgUpdateAcquisitionPolicyRequest{
+				Authority:    addr,
+				VaultAddress: addr,
+			},
+		},
+		{
+			name: "invalid authority",
+			msg: types.MsgUpdateAcquisitionPolicyRequest{
+				Authority:    "bad",
+				VaultAddress: addr,
+			},
+			expectedErr: "invalid authority address",
+		},
+		{
+			name: "invalid vault address",
+			msg: types.MsgUpdateAcquisitionPolicyRequest{
+				Authority:    addr,
+				VaultAddress: "bad",
+			},
+			expectedErr: "invalid vault address",
+		},
+		{
+			name: "invalid policy",
+			msg: types.MsgUpdateAcquisitionPolicyRequest{
+				Authority:    addr,
+				VaultAddress: addr,
+				Policy:       types.AcquisitionPolicy{DenomPatterns: []string{"*"}},
+			},
+			expectedErr: "invalid acquisition policy",
+		},
+	})
+}
+
+func TestMsgUpdateConcentrationLimitsRequest_ValidateBasic(t *testing.T) {
+	addr := NewTestAddress()
+
+	RunValidateBasicTable(t, []validateBasicCase{
+		{
+			name: "valid",
+			msg: types.MsgUpdateConcentrationLimitsRequest{
+				Authority:                 addr,
+				VaultAddress:              addr,
+				MaxAssetConcentrationBips: 1_500,
+				MaxNonUnderlyingBips:      8_000,
+			},
+		},
+		{
+			name: "valid zero limits disable the checks",
+			msg: types.MsgUpdateConcentrationLimitsRequest{
+				Authority:    addr,
+				VaultAddress: addr,
+			},
+		},
+		{
+			name: "invalid authority",
+			msg: types.MsgUpdateConcentrationLimitsRequest{
+				Authority:    "bad",
+				VaultAddress: addr,
+			},
+			expectedErr: "invalid authority address",
+		},
+		{
+			name: "invalid vault address",
+			msg: types.MsgUpdateConcentrationLimitsRequest{
+				Authority:    addr,
+				VaultAddress: "bad",
+			},
+			expectedErr: "invalid vault address",
+		},
+		{
+			name: "single-asset limit too large",
+			msg: types.MsgUpdateConcentrationLimitsRequest{
+				Authority:                 addr,
+				VaultAddress:              addr,
+				MaxAssetConcentrationBips: types.MaxConcentrationBips + 1,
+			},
+			expectedErr: "max asset concentration bips cannot exceed",
+		},
+		{
+			name: "non-underlying limit too large",
+			msg: types.MsgUpdateConcentrationLimitsRequest{
+				Authority:            addr,
+				VaultAddress:         addr,
+				MaxNonUnderlyingBips: types.MaxConcentrationBips + 1,
+			},
+			expectedErr: "max non-underlying bips cannot exceed",
+		},
+	})
+}
+
+func TestMsgUpdateMinLiquidityRequest_ValidateBasic(t *testing.T) {
+	addr := NewTestAddress()
+
+	RunValidateBasicTable(t, []validateBasicCase{
+		{
+			name: "valid",
+			msg: types.MsgUpdateMinLiquidityRequest{
+				Authority:        addr,
+				VaultAddress:     addr,
+				MinLiquidityBips: 1_000,
+			},
+		},
+		{
+			name: "valid zero buffer disables the check",
+			msg: types.MsgUpdateMinLiquidityRequest{
+				Authority:    addr,
+				VaultAddress: addr,
+			},
+		},
+		{
+			name: "invalid authority",
+			msg: types.MsgUpdateMinLiquidityRequest{
+				Authority:    "bad",
+				VaultAddress: addr,
+			},
+			expectedErr: "invalid authority address",
+		},
+		{
+			name: "invalid vault address",
+			msg: types.MsgUpdateMinLiquidityRequest{
+				Authority:    addr,
+				VaultAddress: "bad",
+			},
+			expectedErr: "invalid vault address",
+		},
+		{
+			name: "buffer too large",
+			msg: types.MsgUpdateMinLiquidityRequest{
+				Authority:        addr,
+				VaultAddress:     addr,
+				MinLiquidityBips: types.MaxMinLiquidityBips + 1,
+			},
+			expectedErr: "min liquidity bips cannot exceed",
+		},
+	})
+}
+
+func TestMsgCollectAssetIncomeRequest_ValidateBasic(t *testing.T) {
+	addr := NewTestAddress()
+	income := sdk.NewInt64Coin("under", 500)
+
+	RunValidateBasicTable(t, []validateBasicCase{
+		{
+			name: "valid",
+			msg: types.MsgCollectAssetIncomeRequest{
+				Authority:    addr,
+				VaultAddress: addr,
+				SourceAsset:  "nft/loan-1",
+				Amount:       income,
+			},
+		},
+		{
+			name: "valid return to principal",
+			msg: types.MsgCollectAssetIncomeRequest{
+				Authority:    addr,
+				VaultAddress: addr,
+				SourceAsset:  "nft/loan-1",
+				Amount:       income,
+				ToPrincipal:  true,
+			},
+		},
+		{
+			name: "invalid authority",
+			msg: types.MsgCollectAssetIncomeRequest{
+				Authority:    "bad",
+				VaultAddress: addr,
+				SourceAsset:  "nft/loan-1",
+				Amount:       income,
+			},
+			expectedErr: "invalid authority address",
+		},
+		{
+			name: "invalid vault address",
+			msg: types.MsgCollectAssetIncomeRequest{
+				Authority:    addr,
+				VaultAddress: "bad",
+				SourceAsset:  "nft/loan-1",
+				Amount:       income,
+			},
+			expectedErr: "invalid vault address",
+		},
+		{
+			name: "invalid source asset",
+			msg: types.MsgCollectAssetIncomeRequest{
+				Authority:    addr,
+				VaultAddress: addr,
+				SourceAsset:  "!",
+				Amount:       income,
+			},
+			expectedErr: "invalid source asset",
+		},
+		{
+			name: "invalid amount",
+			msg: types.MsgCollectAssetIncomeRequest{
+				Authority:    addr,
+				VaultAddress: addr,
+				SourceAsset:  "nft/loan-1",
+				Amount:       sdk.Coin{Denom: "!", Amount: sdkmath.NewInt(1)},
+			},
+			expectedErr: "invalid income amount",
+		},
+		{
+			name: "zero amount",
+			msg: types.MsgCollectAssetIncomeRequest{
+				Authority:    addr,
+				VaultAddress: addr,
+				SourceAsset:  "nft/loan-1",
+				Amount:       sdk.NewInt64Coin("under", 0),
+			},
+			expectedErr: "income amount must be greater than zero",
+		},
+		{
+			name: "source asset is the income denom",
+			msg: types.MsgCollectAssetIncomeRequest{
+				Authority:    addr,
+				VaultAddress: addr,
+				SourceAsset:  "under",
+				Amount:       income,
+			},
+			expectedErr: `source asset "under" cannot be the income denom`,
+		},
+	})
+}
+
+func TestMsgGrantRoleRequest_ValidateBasic(t *testing.T) {
+	addr := NewTestAddress()
+
+	RunValidateBasicTable(t, []validateBasicCase{
+		{
+			name: "valid",
+			msg: types.MsgGrantRoleRequest{
+				Authority:    addr,
+				VaultAddress: addr,
+				Role:         types.VaultRole_VAULT_ROLE_PAUSER,
+				Address:      addr,
+			},
+		},
+		{
+			name: "invalid authority",
+			msg: types.MsgGrantRoleRequest{
+				Authority:    "bad",
+				VaultAddress: addr,
+				Role:         types.VaultRole_VAULT_ROLE_PAUSER,
+				Address:      addr,
+			},
+			expectedErr: "invalid authority address",
+		},
+		{
+			name: "invalid vault address",
+			msg: types.MsgGrantRoleRequest{
+				Authority:    addr,
+				VaultAddress: "bad",
+				Role:         types.VaultRole_VAULT_ROLE_PAUSER,
+				Address:      addr,
+			},
+			expectedErr: "invalid vault address",
+		},
+		{
+			name: "unspecified role",
+			msg: types.MsgGrantRoleRequest{
+				Authority:    addr,
+				VaultAddress: addr,
+				Address:      addr,
+			},
+			expectedErr: "invalid role: role must be specified",
+		},
+		{
+			name: "unknown role",
+			msg: types.MsgGrantRoleRequest{
+				Authority:    addr,
+				VaultAddress: addr,
+				Role:         types.VaultRole(99),
+				Address:      addr,
+			},
+			expectedErr: "invalid role: unknown role: 99",
+		},
+		{
+			name: "invalid role address",
+			msg: types.MsgGrantRoleRequest{
+				Authority:    addr,
+				VaultAddress: addr,
+				Role:         types.VaultRole_VAULT_ROLE_PAUSER,
+				Address:      "bad",
+			},
+			expectedErr: "invalid role address",
+		},
+	})
+}
+
+func TestMsgRevokeRoleRequest_ValidateBasic(t *testing.T) {
+	addr := NewTestAddress()
+
+	RunValidateBasicTable(t, []validateBasicCase{
+		{
+			name: "valid",
+			msg: types.MsgRevokeRoleRequest{
+				Authority:    addr,
+				VaultAddress: addr,
+				Role:         types.VaultRole_VAULT_ROLE_PAUSER,
+				Address:      addr,
+			},
+		},
+		{
+			name: "invalid authority",
+			msg: types.MsgRevokeRoleRequest{
+				Authority:    "bad",
+				VaultAddress: addr,
+				Role:         types.VaultRole_VAULT_ROLE_PAUSER,
+				Address:      addr,
+			},
+			expectedErr: "invalid authority address",
+		},
+		{
+			name: "invalid vault address",
+			msg: types.MsgRevokeRoleRequest{
+				Authority:    addr,
+				VaultAddress: "bad",
+				Role:         types.VaultRole_VAULT_ROLE_PAUSER,
+				Address:      addr,
+			},
+			expectedErr: "invalid vault address",
+		},
+		{
+			name: "unspecified role",
+			msg: types.MsgRevokeRoleRequest{
+				Authority:    addr,
+				VaultAddress: addr,
+				Address:      addr,
+			},
+			expectedErr: "invalid role: role must be specified",
+		},
+		{
+			name: "unknown role",
+			msg: types.MsgRevokeRoleRequest{
+				Authority:    addr,
+				VaultAddress: addr,
+				Role:         types.VaultRole(99),
+				Address:      addr,
+			},
+			expectedErr: "invalid role: unknown role: 99",
+		},
+		{
+			name: "invalid role address",
+			msg: types.MsgRevokeRoleRequest{
+				Authority:    addr,
+				VaultAddress: addr,
+				Role:         types.VaultRole_VAULT_ROLE_PAUSER,
+				Address:      "bad",
+			},
+			expectedErr: "invalid role address",
+		},
+	})
+}
+
+func TestMsgProposeAdminTransferRequest_ValidateBasic(t *testing.T) {
+	addr := NewTestAddress()
+
+	RunValidateBasicTable(t, []validateBasicCase{
+		{
+			name: "valid",
+			msg: types.MsgProposeAdminTransferRequest{
+				Authority:    addr,
+				VaultAddress: addr,
+				NewAdmin:     addr,
+			},
+		},
+		{
+			name: "valid with expiration",
+			msg: types.MsgProposeAdminTransferRequest{
+				Authority:         addr,
+				VaultAddress:      addr,
+				NewAdmin:          addr,
+				ExpirationSeconds: 86400,
+			},
+		},
+		{
+			name: "invalid authority",
+			msg: types.MsgProposeAdminTransferRequest{
+				Authority:    "bad",
+				VaultAddress: addr,
+				NewAdmin:     addr,
+			},
+			expectedErr: "invalid authority address",
+		},
+		{
+			name: "invalid vault address",
+			msg: types.MsgProposeAdminTransferRequest{
+				Authority:    addr,
+				VaultAddress: "bad",
+				NewAdmin:     addr,
+			},
+			expectedErr: "invalid vault address",
+		},
+		{
+			name: "invalid new admin",
+			msg: types.MsgProposeAdminTransferRequest{
+				Authority:    addr,
+				VaultAddress: addr,
+				NewAdmin:     "bad",
+			},
+			expectedErr: "invalid new admin address",
+		},
+	})
+}
+
+func TestMsgAcceptAdminTransferRequest_ValidateBasic(t *testing.T) {
+	addr := NewTestAddress()
+
+	RunValidateBasicTable(t, []validateBasicCase{
+		{
+			name: "valid",
+			msg: types.MsgAcceptAdminTransferRequest{
+				NewAdmin:     addr,
+				VaultAddress: addr,
+			},
+		},
+		{
+			name: "invalid new admin",
+			msg: types.MsgAcceptAdminTransferRequest{
+				NewAdmin:     "bad",
+				VaultAddress: addr,
+			},
+			expectedErr: "invalid new admin address",
+		},
+		{
+			name: "invalid vault address",
+			msg: types.MsgAcceptAdminTransferRequest{
+				NewAdmin:     addr,
+				VaultAddress: "bad",
+			},
+			expectedErr: "invalid vault address",
+		},
+	})
+}
+
+func TestMsgCancelAdminTransferRequest_ValidateBasic(t *testing.T) {
+	addr := NewTestAddress()
+
+	RunValidateBasicTable(t, []validateBasicCase{
+		{
+			name: "valid",
+			msg: types.MsgCancelAdminTransferRequest{
+				Authority:    addr,
+				VaultAddress: addr,
+			},
+		},
+		{
+			name: "invalid authority",
+			msg: types.MsgCancelAdminTransferRequest{
+				Authority:    "bad",
+				VaultAddress: addr,
+			},
+			expectedErr: "invalid authority address",
+		},
+		{
+			name: "invalid vault address",
+			msg: types.MsgCancelAdminTransferRequest{
+				Authority:    addr,
+				VaultAddress: "bad",
+			},
+			expectedErr: "invalid vault address",
+		},
+	})
+}
+
+func TestMsgRecoverVaultAdminRequest_ValidateBasic(t *testing.T) {
+	addr := NewTestAddress()
+
+	RunValidateBasicTable(t, []validateBasicCase{
+		{
+			name: "valid",
+			msg: types.MsgRecoverVaultAdminRequest{
+				Authority:    addr,
+				VaultAddress: addr,
+				NewAdmin:     addr,
+			},
+		},
+		{
+			name: "invalid authority",
+			msg: types.MsgRecoverVaultAdminRequest{
+				Authority:    "bad",
+				VaultAddress: addr,
+				NewAdmin:     addr,
+			},
+			expectedErr: "invalid authority address",
+		},
+		{
+			name: "invalid vault address",
+			msg: types.MsgRecoverVaultAdminRequest{
+				Authority:    addr,
+				VaultAddress: "bad",
+				NewAdmin:     addr,
+			},
+			expectedErr: "invalid vault address",
+		},
+		{
+			name: "invalid new admin",
+			msg: types.MsgRecoverVaultAdminRequest{
+				Authority:    addr,
+				VaultAddress: addr,
+				NewAdmin:     "bad",
+			},
+			expectedErr: "invalid new admin address",
+		},
+	})
+}
+
+func TestMsgUpdateTimelockRequest_ValidateBasic(t *testing.T) {
+	addr := NewTestAddress()
+
+	RunValidateBasicTable(t, []validateBasicCase{
+		{
+			name: "valid",
+			msg: types.MsgUpdateTimelockRequest{
+				Authority:       addr,
+				VaultAddress:    addr,
+				TimelockSeconds: 86_400,
+			},
+		},
+		{
+			name: "valid zero disables the timelock",
+			msg: types.MsgUpdateTimelockRequest{
+				Authority:    addr,
+				VaultAddress: addr,
+			},
+		},
+		{
+			name: "invalid authority",
+			msg: types.MsgUpdateTimelockRequest{
+				Authority:    "bad",
+				VaultAddress: addr,
+			},
+			expectedErr: "invalid authority address",
+		},
+		{
+			name: "invalid vault address",
+			msg: types.MsgUpdateTimelockRequest{
+				Authority:    addr,
+				VaultAddress: "bad",
+			},
+			expectedErr: "invalid vault address",
+		},
+		{
+			name: "timelock exceeds maximum",
+			msg: types.MsgUpdateTimelockRequest{
+				Authority:       addr,
+				VaultAddress:    addr,
+				TimelockSeconds: types.MaxTimelockSeconds + 1,
+			},
+			expectedErr: "timelock seconds cannot exceed",
+		},
+	})
+}
+
+func TestMsgCancelScheduledActionRequest_ValidateBasic(t *testing.T) {
+	addr := NewTestAddress()
+
+	RunValidateBasicTable(t, []validateBasicCase{
+		{
+			name: "valid",
+			msg: types.MsgCancelScheduledActionRequest{
+				Authority:    addr,
+				VaultAddress: addr,
+				ActionId:     1,
+			},
+		},
+		{
+			name: "invalid authority",
+			msg: types.MsgCancelScheduledActionRequest{
+				Authority:    "bad",
+				VaultAddress: addr,
+			},
+			expectedErr: "invalid authority address",
+		},
+		{
+			name: "invalid vault address",
+			msg: types.MsgCancelScheduledActionRequest{
+				Authority:    addr,
+				VaultAddress: "bad",
+			},
+			expectedErr: "invalid vault address",
+		},
+	})
+}
+
+func TestMsgUpdateEligibilityPolicyRequest_ValidateBasic(t *testing.T) {
+	addr := NewTestAddress()
+
+	RunValidateBasicTable(t, []validateBasicCase{
+		{
+			name: "valid",
+			msg: types.MsgUpdateEligibilityPolicyRequest{
+				Authority:    addr,
+				VaultAddress: addr,
+				Policy:       types.EligibilityPolicy{Enabled: true, RequiredAttributes: []string{"accredited.kyc.io"}},
+			},
+		},
+		{
+			name: "valid disabled policy",
+			msg: types.MsgUpdateEligibilityPolicyRequest{
+				Authority:    addr,
+				VaultAddress: addr,
+			},
+		},
+		{
+			name: "invalid authority",
+			msg: types.MsgUpdateEligibilityPolicyRequest{
+				Authority:    "bad",
+				VaultAddress: addr,
+			},
+			expectedErr: "invalid authority address",
+		},
+		{
+			name: "invalid vault address",
+			msg: types.MsgUpdateEligibilityPolicyRequest{
+				Authority:    addr,
+				VaultAddress: "bad",
+			},
+			expectedErr: "invalid vault address",
+		},
+		{
+			name: "invalid policy",
+			msg: types.MsgUpdateEligibilityPolicyRequest{
+				Authority:    addr,
+				VaultAddress: addr,
+				Policy:       types.EligibilityPolicy{Enabled: true, RequiredAttributes: []string{"kyc.*.io"}},
+			},
+			expectedErr: "invalid eligibility policy",
+		},
+	})
+}
+
+func TestMsgUpdateAllowlistRequest_ValidateBasic(t *testing.T) {
+	addr := NewTestAddress()
+	investor := NewTestAddress()
+	other := NewTestAddress()
+	tooMany := make([]string, types.MaxAllowlistUpdateEntries+1)
+	for i := range tooMany {
+		tooMany[i] = NewTestAddress()
+	}
+
+	RunValidateBasicTable(t, []validateBasicCase{
+		{
+			name: "valid",
+			msg: types.MsgUpdateAllowlistRequest{
+				Authority:    addr,
+				VaultAddress: addr,
+				Add:          []string{investor},
+				Remove:       []string{other},
+			},
+		},
+		{
+			name: "invalid authority",
+			msg: types.MsgUpdateAllowlistRequest{
+				Authority:    "bad",
+				VaultAddress: addr,
+				Add:          []string{investor},
+			},
+			expectedErr: "invalid authority address",
+		},
+		{
+			name: "invalid vault address",
+			msg: types.MsgUpdateAllowlistRequest{
+				Authority:    addr,
+				VaultAddress: "bad",
+				Add:          []string{investor},
+			},
+			expectedErr: "invalid vault address",
+		},
+		{
+			name: "no addresses",
+			msg: types.MsgUpdateAllowlistRequest{
+				Authority:    addr,
+				VaultAddress: addr,
+			},
+			expectedErr: "at least one address must be added or removed",
+		},
+		{
+			name: "too many addresses",
+			msg: types.MsgUpdateAllowlistRequest{
+				Authority:    addr,
+				VaultAddress: addr,
+				Add:          tooMany,
+			},
+			expectedErr: "cannot add or remove more than",
+		},
+		{
+			name: "invalid address",
+			msg: types.MsgUpdateAllowlistRequest{
+				Authority:    addr,
+				VaultAddress: addr,
+				Remove:       []string{"bad"},
+			},
+			expectedErr: "invalid allowlist address",
+		},
+		{
+			name: "address both added and removed",
+			msg: types.MsgUpdateAllowlistRequest{
+				Authority:    addr,
+				VaultAddress: addr,
+				Add:          []string{investor},
+				Remove:       []string{investor},
+			},
+			expectedErr: "is listed more than once",
+		},
+	})
+}
+
+func TestMsgUpdatePositionCapRequest_ValidateBasic(t *testing.T) {
+	addr := NewTestAddress()
+
+	RunValidateBasicTable(t, []validateBasicCase{
+		{
+			name: "valid",
+			msg: types.MsgUpdatePositionCapRequest{
+				Authority:        addr,
+				VaultAddress:     addr,
+				MaxPositionValue: "1000",
+				Exemptions:       []string{addr},
+			},
+		},
+		{
+			name: "valid cleared cap",
+			msg: types.MsgUpdatePositionCapRequest{
+				Authority:    addr,
+				VaultAddress: addr,
+			},
+		},
+		{
+			name: "invalid authority",
+			msg: types.MsgUpdatePositionCapRequest{
+				Authority:    "bad",
+				VaultAddress: addr,
+			},
+			expectedErr: "invalid authority address",
+		},
+		{
+			name: "invalid vault address",
+			msg: types.MsgUpdatePositionCapRequest{
+				Authority:    addr,
+				VaultAddress: "bad",
+			},
+			expectedErr: "invalid vault address",
+		},
+		{
+			name: "zero cap",
+			msg: types.MsgUpdatePositionCapRequest{
+				Authority:        addr,
+				VaultAddress:     addr,
+				MaxPositionValue: "0",
+			},
+			expectedErr: "invalid position cap",
+		},
+		{
+			name: "invalid exemption",
+			msg: types.MsgUpdatePositionCapRequest{
+				Authority:        addr,
+				VaultAddress:     addr,
+				MaxPositionValue: "1000",
+				Exemptions:       []string{"bad"},
+			},
+			expectedErr: "invalid position cap",
+		},
+	})
+}
+
+func TestMsgUpdateMaxTotalValueRequest_ValidateBasic(t *testing.T) {
+	addr := NewTestAddress()
+
+	RunValidateBasicTable(t, []validateBasicCase{
+		{
+			name: "valid",
+			msg: types.MsgUpdateMaxTotalValueRequest{
+				Authority:     addr,
+				VaultAddress:  addr,
+				MaxTotalValue: "1000000",
+			},
+		},
+		{
+			name: "valid cleared cap",
+			msg: types.MsgUpdateMaxTotalValueRequest{
+				Authority:    addr,
+				VaultAddress: addr,
+			},
+		},
+		{
+			name: "invalid authority",
+			msg: types.MsgUpdateMaxTotalValueRequest{
+				Authority:    "bad",
+				VaultAddress: addr,
+			},
+			expectedErr: "invalid authority address",
+		},
+		{
+			name: "invalid vault address",
+			msg: types.MsgUpdateMaxTotalValueRequest{
+				Authority:    addr,
+				VaultAddress: "bad",
+			},
+			expectedErr: "invalid vault address",
+		},
+		{
+			name: "zero cap",
+			msg: types.MsgUpdateMaxTotalValueRequest{
+				Authority:     addr,
+				VaultAddress:  addr,
+				MaxTotalValue: "0",
+			},
+			expectedErr: "invalid max total value",
+		},
+	})
+}
+
+func TestMsgUpdateCircuitBreakerRequest_ValidateBasic(t *testing.T) {
+	addr := NewTestAddress()
+
+	RunValidateBasicTable(t, []validateBasicCase{
+		{
+			name: "valid halt",
+			msg: types.MsgUpdateCircuitBreakerRequest{
+				Authority:      addr,
+				CircuitBreaker: types.CircuitBreaker{SwapInHalted: true, BridgeHalted: true},
+			},
+		},
+		{
+			name: "valid reset",
+			msg: types.MsgUpdateCircuitBreakerRequest{
+				Authority: addr,
+			},
+		},
+		{
+			name: "valid guardian",
+			msg: types.MsgUpdateCircuitBreakerRequest{
+				Authority: addr,
+				Guardian:  NewTestAddress(),
+			},
+		},
+		{
+			name: "invalid authority",
+			msg: types.MsgUpdateCircuitBreakerRequest{
+				Authority: "bad",
+			},
+			expectedErr: "invalid authority address",
+		},
+		{
+			name: "invalid guardian",
+			msg: types.MsgUpdateCircuitBreakerRequest{
+				Authority: addr,
+				Guardian:  "bad",
+			},
+			expectedErr: "invalid guardian address",
+		},
+	})
+}
@@ -9,8 +9,10 @@ import (
 // DefaultParams returns default vault module parameters.
 func DefaultParams() Params {
 	return Params{
-		DefaultAumFeeBips: DefaultAumFeeBips,
-		TechFeeAddress:    DefaultTechFeeAddress.String(),
+		DefaultAumFeeBips:          DefaultAumFeeBips,
+		TechFeeAddress:             DefaultTechFeeAddress.String(),
+		NavHistoryRetentionSeconds: DefaultNAVHistoryRetention,
+		SharePriceRetentionSeconds: DefaultSharePriceRetention,
 	}
 }
 
@@ -36,5 +38,45 @@ func (p Params) Validate() error {
 		return fmt.Errorf("invalid DefaultAumFeeBips: %d (max 10000)", p.DefaultAumFeeBips)
 	}
 
+	if p.NavHistoryRetentionSeconds > MaxNAVHistoryRetention {
+		return fmt.Errorf("invalid NavHistoryRetentionSeconds: %d (max %d)", p.NavHistoryRetentionSeconds, MaxNAVHistoryRetention)
+	}
+
+	if p.SharePriceRetentionSeconds > MaxNAVHistoryRetention {
+		return fmt.Errorf("invalid SharePriceRetentionSeconds: %d (max %d)", p.SharePriceRetentionSeconds, MaxNAVHistoryRetention)
+	}
+
+	if p.CircuitBreakerGuardian != "" {
+		if _, err := sdk.AccAddressFromBech32(p.CircuitBreakerGuardian); err != nil {
+			return fmt.Errorf("invalid CircuitBreakerGuardian: %w", err)
+		}
+	}
+
 	return nil
 }
+
+// HaltedOperation returns the name of the halted operation class that msg belongs to, or an
+// empty string if the circuit breaker does not block msg. Messages that unwind an operation,
+// such as rejecting or cancelling an asset payment, are never blocked so that funds can be
+// returned while the breaker is tripped.
+func (c CircuitBreaker) HaltedOperation(msg sdk.Msg) string {
+	switch msg.(type) {
+	case *MsgSwapInRequest:
+		if c.SwapInHalted {
+			return "swap-in"
+		}
+	case *MsgSwapOutRequest, *MsgExpeditePendingSwapOutRequest:
+		if c.SwapOutHalted {
+			return "swap-out"
+		}
+	case *MsgAcceptAssetRequest, *MsgBatchAcceptAssetsRequest, *MsgCreateAssetPaymentRequest:
+		if c.SettlementHalted {
+			return "settlement"
+		}
+	case *MsgBridgeMintSharesRequest, *MsgBridgeBurnSharesRequest:
+		if c.BridgeHalted {
+			return "bridge"
+		}
+	}
+	return ""
+}
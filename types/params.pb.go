@@ -31,6 +31,20 @@ type Params struct {
 	TechFeeAddress string `protobuf:"bytes,1,opt,name=tech_fee_address,json=techFeeAddress,proto3" json:"tech_fee_address,omitempty"`
 	// default_aum_fee_bips is the default fee rate (in basis points) applied to new vaults upon creation.
 	DefaultAumFeeBips uint32 `protobuf:"varint,2,opt,name=default_aum_fee_bips,json=defaultAumFeeBips,proto3" json:"default_aum_fee_bips,omitempty"`
+	// nav_history_retention_seconds is how long internal NAV changes and published share NAVs
+	// are kept in the NAV history time series. A value of 0 disables NAV history recording.
+	NavHistoryRetentionSeconds uint64 `protobuf:"varint,3,opt,name=nav_history_retention_seconds,json=navHistoryRetentionSeconds,proto3" json:"nav_history_retention_seconds,omitempty"`
+	// share_price_retention_seconds is how long share price accumulator observations are kept.
+	// It bounds the windows VaultTWAP and VaultPerformance can cover. A value of 0 disables
+	// recording.
+	SharePriceRetentionSeconds uint64 `protobuf:"varint,4,opt,name=share_price_retention_seconds,json=sharePriceRetentionSeconds,proto3" json:"share_price_retention_seconds,omitempty"`
+	// circuit_breaker_guardian may trip and reset the circuit breaker alongside governance. An
+	// empty string "" leaves the circuit breaker to governance alone. It is set through
+	// UpdateCircuitBreaker; UpdateParams keeps the stored value.
+	CircuitBreakerGuardian string `protobuf:"bytes,5,opt,name=circuit_breaker_guardian,json=circuitBreakerGuardian,proto3" json:"circuit_breaker_guardian,omitempty"`
+	// circuit_breaker halts classes of operations across every vault. It is set through
+	// UpdateCircuitBreaker; UpdateParams keeps the stored value.
+	CircuitBreaker CircuitBreaker `protobuf:"bytes,6,opt,name=circuit_breaker,json=circuitBreaker,proto3" json:"circuit_breaker"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -80,30 +94,148 @@ func (m *Params) GetDefaultAumFeeBips() uint32 {
 	return 0
 }
 
+func (m *Params) GetNavHistoryRetentionSeconds() uint64 {
+	if m != nil {
+		return m.NavHistoryRetentionSeconds
+	}
+	return 0
+}
+
+func (m *Params) GetSharePriceRetentionSeconds() uint64 {
+	if m != nil {
+		return m.SharePriceRetentionSeconds
+	}
+	return 0
+}
+
+func (m *Params) GetCircuitBreakerGuardian() string {
+	if m != nil {
+		return m.CircuitBreakerGuardian
+	}
+	return ""
+}
+
+func (m *Params) GetCircuitBreaker() CircuitBreaker {
+	if m != nil {
+		return m.CircuitBreaker
+	}
+	return CircuitBreaker{}
+}
+
+// CircuitBreaker is the module-wide emergency halt. Each flag independently blocks one class
+// of operation in every vault, whatever the vault's own pause and toggle settings.
+type CircuitBreaker struct {
+	// swap_in_halted blocks swap-ins.
+	SwapInHalted bool `protobuf:"varint,1,opt,name=swap_in_halted,json=swapInHalted,proto3" json:"swap_in_halted,omitempty"`
+	// swap_out_halted blocks swap-out requests and expedites, and holds pending swap-outs in
+	// the queue instead of paying them out.
+	SwapOutHalted bool `protobuf:"varint,2,opt,name=swap_out_halted,json=swapOutHalted,proto3" json:"swap_out_halted,omitempty"`
+	// settlement_halted blocks accepting asset payments and creating outbound asset payments.
+	SettlementHalted bool `protobuf:"varint,3,opt,name=settlement_halted,json=settlementHalted,proto3" json:"settlement_halted,omitempty"`
+	// bridge_halted blocks bridge mints and burns of vault shares.
+	BridgeHalted bool `protobuf:"varint,4,opt,name=bridge_halted,json=bridgeHalted,proto3" json:"bridge_halted,omitempty"`
+}
+
+func (m *CircuitBreaker) Reset()         { *m = CircuitBreaker{} }
+func (m *CircuitBreaker) String() string { return proto.CompactTextString(m) }
+func (*CircuitBreaker) ProtoMessage()    {}
+func (*CircuitBreaker) Descriptor() ([]byte, []int) {
+	return fileDescriptor_023bfbd86d9377d5, []int{1}
+}
+func (m *CircuitBreaker) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CircuitBreaker) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CircuitBreaker.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CircuitBreaker) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CircuitBreaker.Merge(m, src)
+}
+func (m *CircuitBreaker) XXX_Size() int {
+	return m.Size()
+}
+func (m *CircuitBreaker) XXX_DiscardUnknown() {
+	xxx_messageInfo_CircuitBreaker.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CircuitBreaker proto.InternalMessageInfo
+
+func (m *CircuitBreaker) GetSwapInHalted() bool {
+	if m != nil {
+		return m.SwapInHalted
+	}
+	return false
+}
+
+func (m *CircuitBreaker) GetSwapOutHalted() bool {
+	if m != nil {
+		return m.SwapOutHalted
+	}
+	return false
+}
+
+func (m *CircuitBreaker) GetSettlementHalted() bool {
+	if m != nil {
+		return m.SettlementHalted
+	}
+	return false
+}
+
+func (m *CircuitBreaker) GetBridgeHalted() bool {
+	if m != nil {
+		return m.BridgeHalted
+	}
+	return false
+}
+
 func init() {
 	proto.RegisterType((*Params)(nil), "provlabs.vault.v1.Params")
+	proto.RegisterType((*CircuitBreaker)(nil), "provlabs.vault.v1.CircuitBreaker")
 }
 
 func init() { proto.RegisterFile("provlabs/vault/v1/params.proto", fileDescriptor_023bfbd86d9377d5) }
 
 var fileDescriptor_023bfbd86d9377d5 = []byte{
-	// 252 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0x2b, 0x28, 0xca, 0x2f,
-	0xcb, 0x49, 0x4c, 0x2a, 0xd6, 0x2f, 0x4b, 0x2c, 0xcd, 0x29, 0xd1, 0x2f, 0x33, 0xd4, 0x2f, 0x48,
-	0x2c, 0x4a, 0xcc, 0x2d, 0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x12, 0x84, 0xc9, 0xeb, 0x81,
-	0xe5, 0xf5, 0xca, 0x0c, 0xa5, 0x24, 0x93, 0xf3, 0x8b, 0x73, 0xf3, 0x8b, 0xe3, 0xc1, 0x0a, 0xf4,
-	0x21, 0x1c, 0x88, 0x6a, 0x29, 0x91, 0xf4, 0xfc, 0xf4, 0x7c, 0x88, 0x38, 0x88, 0x05, 0x11, 0x55,
-	0x6a, 0x66, 0xe4, 0x62, 0x0b, 0x00, 0x1b, 0x2a, 0xe4, 0xc4, 0x25, 0x50, 0x92, 0x9a, 0x9c, 0x11,
-	0x9f, 0x96, 0x9a, 0x1a, 0x9f, 0x98, 0x92, 0x52, 0x94, 0x5a, 0x5c, 0x2c, 0xc1, 0xa8, 0xc0, 0xa8,
-	0xc1, 0xe9, 0x24, 0x71, 0x69, 0x8b, 0xae, 0x08, 0xd4, 0x30, 0x47, 0x88, 0x4c, 0x70, 0x49, 0x51,
-	0x66, 0x5e, 0x7a, 0x10, 0x1f, 0x48, 0x87, 0x5b, 0x6a, 0x2a, 0x54, 0x54, 0x48, 0x9f, 0x4b, 0x24,
-	0x25, 0x35, 0x0d, 0xe4, 0x9a, 0xf8, 0xc4, 0xd2, 0x5c, 0xb0, 0x51, 0x49, 0x99, 0x05, 0xc5, 0x12,
-	0x4c, 0x0a, 0x8c, 0x1a, 0xbc, 0x41, 0x82, 0x50, 0x39, 0xc7, 0xd2, 0x5c, 0xb7, 0xd4, 0x54, 0xa7,
-	0xcc, 0x82, 0x62, 0x2b, 0x96, 0x17, 0x0b, 0xe4, 0x19, 0x9d, 0x2c, 0x4f, 0x3c, 0x92, 0x63, 0xbc,
-	0xf0, 0x48, 0x8e, 0xf1, 0xc1, 0x23, 0x39, 0xc6, 0x09, 0x8f, 0xe5, 0x18, 0x2e, 0x3c, 0x96, 0x63,
-	0xb8, 0xf1, 0x58, 0x8e, 0x21, 0x4a, 0x3e, 0x3d, 0xb3, 0x24, 0xa3, 0x34, 0x49, 0x2f, 0x39, 0x3f,
-	0x57, 0x1f, 0x2d, 0x38, 0x4a, 0x2a, 0x0b, 0x52, 0x8b, 0x93, 0xd8, 0xc0, 0xfe, 0x30, 0x06, 0x04,
-	0x00, 0x00, 0xff, 0xff, 0xe7, 0x81, 0x83, 0x83, 0x2d, 0x01, 0x00, 0x00,
+	// 474 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x92, 0xc1, 0x8a, 0xd3, 0x40,
+	0x18, 0xc7, 0x3b, 0xdb, 0x5a, 0x74, 0xb4, 0xdd, 0x6d, 0x28, 0x12, 0x0b, 0xa6, 0x75, 0x15, 0x29,
+	0x88, 0x09, 0xab, 0x27, 0xbd, 0x35, 0xc2, 0xba, 0x9e, 0x2c, 0xd9, 0x9b, 0x97, 0x61, 0x92, 0x7c,
+	0x9b, 0x0c, 0x36, 0x33, 0x61, 0x66, 0x12, 0xd9, 0xb7, 0xf0, 0x11, 0x7c, 0x03, 0x2f, 0xe2, 0x33,
+	0xec, 0x71, 0xf1, 0xe4, 0x49, 0xa4, 0xbd, 0xf8, 0x18, 0x92, 0x99, 0x54, 0xe9, 0xae, 0xe0, 0x2d,
+	0xf9, 0xff, 0x7f, 0xdf, 0x2f, 0x43, 0xe6, 0xc3, 0x5e, 0x29, 0x45, 0xbd, 0xa2, 0xb1, 0x0a, 0x6a,
+	0x5a, 0xad, 0x74, 0x50, 0x1f, 0x05, 0x25, 0x95, 0xb4, 0x50, 0x7e, 0x29, 0x85, 0x16, 0xce, 0x68,
+	0xdb, 0xfb, 0xa6, 0xf7, 0xeb, 0xa3, 0xc9, 0xbd, 0x44, 0xa8, 0x42, 0x28, 0x62, 0x80, 0xc0, 0xbe,
+	0x58, 0x7a, 0x32, 0xce, 0x44, 0x26, 0x6c, 0xde, 0x3c, 0xd9, 0xf4, 0xf0, 0x73, 0x17, 0xf7, 0x97,
+	0x46, 0xea, 0x84, 0xf8, 0x40, 0x43, 0x92, 0x93, 0x33, 0x00, 0x42, 0xd3, 0x54, 0x82, 0x52, 0x2e,
+	0x9a, 0xa1, 0xf9, 0xad, 0xd0, 0xfd, 0xf6, 0xe5, 0xe9, 0xb8, 0x95, 0x2d, 0x6c, 0x73, 0xaa, 0x25,
+	0xe3, 0x59, 0x34, 0x6c, 0x26, 0x8e, 0x01, 0xda, 0xd4, 0x09, 0xf0, 0x38, 0x85, 0xb3, 0xe6, 0x34,
+	0x84, 0x56, 0x85, 0x51, 0xc5, 0xac, 0x54, 0xee, 0xde, 0x0c, 0xcd, 0x07, 0xd1, 0xa8, 0xed, 0x16,
+	0x55, 0x71, 0x0c, 0x10, 0xb2, 0x52, 0x39, 0x0b, 0x7c, 0x9f, 0xd3, 0x9a, 0xe4, 0x4c, 0x69, 0x21,
+	0xcf, 0x89, 0x04, 0x0d, 0x5c, 0x33, 0xc1, 0x89, 0x82, 0x44, 0xf0, 0x54, 0xb9, 0xdd, 0x19, 0x9a,
+	0xf7, 0xa2, 0x09, 0xa7, 0xf5, 0x89, 0x65, 0xa2, 0x2d, 0x72, 0x6a, 0x89, 0x46, 0xa1, 0x72, 0x2a,
+	0x81, 0x94, 0x92, 0x25, 0xf0, 0x0f, 0x45, 0xcf, 0x2a, 0x0c, 0xb4, 0x6c, 0x98, 0x6b, 0x8a, 0x08,
+	0xbb, 0x09, 0x93, 0x49, 0xc5, 0x34, 0x89, 0x25, 0xd0, 0xf7, 0x20, 0x49, 0x56, 0x51, 0x99, 0x32,
+	0xca, 0xdd, 0x1b, 0xff, 0xf9, 0x05, 0x77, 0xdb, 0xc9, 0xd0, 0x0e, 0xbe, 0x6e, 0xe7, 0x9c, 0x25,
+	0xde, 0xbf, 0xe2, 0x74, 0xfb, 0x33, 0x34, 0xbf, 0xfd, 0xec, 0x81, 0x7f, 0xed, 0xde, 0xfc, 0x57,
+	0x3b, 0x8e, 0xb0, 0x77, 0xf1, 0x63, 0xda, 0x89, 0x86, 0xbb, 0xe6, 0x97, 0xbd, 0x5f, 0x9f, 0xa6,
+	0xe8, 0xf0, 0x2b, 0xc2, 0xc3, 0x5d, 0xdc, 0x79, 0x84, 0x87, 0xea, 0x03, 0x2d, 0x09, 0xe3, 0x24,
+	0xa7, 0x2b, 0x0d, 0xa9, 0xb9, 0xb7, 0x9b, 0xd1, 0x9d, 0x26, 0x7d, 0xc3, 0x4f, 0x4c, 0xe6, 0x3c,
+	0xc6, 0xfb, 0x86, 0x12, 0x95, 0xde, 0x62, 0x7b, 0x06, 0x1b, 0x34, 0xf1, 0xdb, 0x4a, 0xb7, 0xdc,
+	0x13, 0x3c, 0x52, 0xa0, 0xf5, 0x0a, 0x0a, 0xe0, 0x7f, 0xc8, 0xae, 0x21, 0x0f, 0xfe, 0x16, 0x2d,
+	0xfc, 0x10, 0x0f, 0x62, 0xc9, 0xd2, 0x0c, 0xb6, 0x60, 0xcf, 0x7e, 0xd9, 0x86, 0x16, 0xb2, 0x07,
+	0x0f, 0x5f, 0x5c, 0xac, 0x3d, 0x74, 0xb9, 0xf6, 0xd0, 0xcf, 0xb5, 0x87, 0x3e, 0x6e, 0xbc, 0xce,
+	0xe5, 0xc6, 0xeb, 0x7c, 0xdf, 0x78, 0x9d, 0x77, 0xd3, 0x8c, 0xe9, 0xbc, 0x8a, 0xfd, 0x44, 0x14,
+	0xc1, 0x95, 0x9d, 0xd7, 0xe7, 0x25, 0xa8, 0xb8, 0x6f, 0x96, 0xf5, 0xf9, 0xef, 0x00, 0x00, 0x00,
+	0xff, 0xff, 0xe7, 0x81, 0x78, 0x42, 0x12, 0x03, 0x00, 0x00,
 }
 
 func (this *Params) Equal(that interface{}) bool {
@@ -131,6 +263,51 @@ func (this *Params) Equal(that interface{}) bool {
 	if this.DefaultAumFeeBips != that1.DefaultAumFeeBips {
 		return false
 	}
+	if this.NavHistoryRetentionSeconds != that1.NavHistoryRetentionSeconds {
+		return false
+	}
+	if this.SharePriceRetentionSeconds != that1.SharePriceRetentionSeconds {
+		return false
+	}
+	if this.CircuitBreakerGuardian != that1.CircuitBreakerGuardian {
+		return false
+	}
+	if !this.CircuitBreaker.Equal(&that1.CircuitBreaker) {
+		return false
+	}
+	return true
+}
+func (this *CircuitBreaker) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*CircuitBreaker)
+	if !ok {
+		that2, ok := that.(CircuitBreaker)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.SwapInHalted != that1.SwapInHalted {
+		return false
+	}
+	if this.SwapOutHalted != that1.SwapOutHalted {
+		return false
+	}
+	if this.SettlementHalted != that1.SettlementHalted {
+		return false
+	}
+	if this.BridgeHalted != that1.BridgeHalted {
+		return false
+	}
 	return true
 }
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -153,6 +330,33 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	{
+		size, err := m.CircuitBreaker.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	if len(m.CircuitBreakerGuardian) > 0 {
+		i -= len(m.CircuitBreakerGuardian)
+		copy(dAtA[i:], m.CircuitBreakerGuardian)
+		i = encodeVarintParams(dAtA, i, uint64(len(m.CircuitBreakerGuardian)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.SharePriceRetentionSeconds != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.SharePriceRetentionSeconds))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.NavHistoryRetentionSeconds != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.NavHistoryRetentionSeconds))
+		i--
+		dAtA[i] = 0x18
+	}
 	if m.DefaultAumFeeBips != 0 {
 		i = encodeVarintParams(dAtA, i, uint64(m.DefaultAumFeeBips))
 		i--
@@ -168,6 +372,69 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *CircuitBreaker) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CircuitBreaker) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CircuitBreaker) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.BridgeHalted {
+		i--
+		if m.BridgeHalted {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.SettlementHalted {
+		i--
+		if m.SettlementHalted {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.SwapOutHalted {
+		i--
+		if m.SwapOutHalted {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.SwapInHalted {
+		i--
+		if m.SwapInHalted {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintParams(dAtA []byte, offset int, v uint64) int {
 	offset -= sovParams(v)
 	base := offset
@@ -192,6 +459,39 @@ func (m *Params) Size() (n int) {
 	if m.DefaultAumFeeBips != 0 {
 		n += 1 + sovParams(uint64(m.DefaultAumFeeBips))
 	}
+	if m.NavHistoryRetentionSeconds != 0 {
+		n += 1 + sovParams(uint64(m.NavHistoryRetentionSeconds))
+	}
+	if m.SharePriceRetentionSeconds != 0 {
+		n += 1 + sovParams(uint64(m.SharePriceRetentionSeconds))
+	}
+	l = len(m.CircuitBreakerGuardian)
+	if l > 0 {
+		n += 1 + l + sovParams(uint64(l))
+	}
+	l = m.CircuitBreaker.Size()
+	n += 1 + l + sovParams(uint64(l))
+	return n
+}
+
+func (m *CircuitBreaker) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SwapInHalted {
+		n += 2
+	}
+	if m.SwapOutHalted {
+		n += 2
+	}
+	if m.SettlementHalted {
+		n += 2
+	}
+	if m.BridgeHalted {
+		n += 2
+	}
 	return n
 }
 
@@ -281,6 +581,239 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NavHistoryRetentionSeconds", wireType)
+			}
+			m.NavHistoryRetentionSeconds = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NavHistoryRetentionSeconds |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SharePriceRetentionSeconds", wireType)
+			}
+			m.SharePriceRetentionSeconds = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SharePriceRetentionSeconds |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CircuitBreakerGuardian", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CircuitBreakerGuardian = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CircuitBreaker", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CircuitBreaker.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParams(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParams
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CircuitBreaker) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CircuitBreaker: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CircuitBreaker: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SwapInHalted", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.SwapInHalted = bool(v != 0)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SwapOutHalted", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.SwapOutHalted = bool(v != 0)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SettlementHalted", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.SettlementHalted = bool(v != 0)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BridgeHalted", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.BridgeHalted = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipParams(dAtA[iNdEx:])
@@ -5,6 +5,7 @@ package types
 
 import (
 	context "context"
+	cosmossdk_io_math "cosmossdk.io/math"
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-proto"
 	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
@@ -470,8 +471,24 @@ type QueryVaultResponse struct {
 	// total_vault_value is the estimated total value of the vault in its
 	// underlying asset. It includes current principal and estimated unpaid
 	// interest (at query block height), but excludes reserves. The value is approximate and may differ
-	// from the reconciled amount.
+	// from the reconciled amount. It is zero when nav_stale is set and no price source has a fresh
+	// price for a held denom.
 	TotalVaultValue types.Coin `protobuf:"bytes,4,opt,name=total_vault_value,json=totalVaultValue,proto3" json:"total_vault_value"`
+	// oldest_nav_denom is the held denom whose price was updated least recently. Each denom is
+	// measured by the price valuing it: the NAV source aggregate when its quorum is met,
+	// otherwise the price the vault's price-source chain resolves, or the internal NAV entry
+	// when no source has a fresh price. It is empty when the vault holds no NAV-priced assets
+	// beyond its underlying asset.
+	OldestNavDenom string `protobuf:"bytes,5,opt,name=oldest_nav_denom,json=oldestNavDenom,proto3" json:"oldest_nav_denom,omitempty"`
+	// oldest_nav_age_seconds is the age (in seconds, at the query block time) of the price
+	// for oldest_nav_denom. It is 0 when oldest_nav_denom is empty.
+	OldestNavAgeSeconds uint64 `protobuf:"varint,6,opt,name=oldest_nav_age_seconds,json=oldestNavAgeSeconds,proto3" json:"oldest_nav_age_seconds,omitempty"`
+	// nav_stale is true when oldest_nav_age_seconds exceeds the vault's max_nav_age_seconds,
+	// in which case swaps and asset settlements are blocked until the NAV is refreshed.
+	NavStale bool `protobuf:"varint,7,opt,name=nav_stale,json=navStale,proto3" json:"nav_stale,omitempty"`
+	// liquidity_buffer reports the vault's underlying asset liquidity against its
+	// min_liquidity_bips buffer.
+	LiquidityBuffer LiquidityBuffer `protobuf:"bytes,8,opt,name=liquidity_buffer,json=liquidityBuffer,proto3" json:"liquidity_buffer"`
 }
 
 func (m *QueryVaultResponse) Reset()         { *m = QueryVaultResponse{} }
@@ -535,6 +552,117 @@ func (m *QueryVaultResponse) GetTotalVaultValue() types.Coin {
 	return types.Coin{}
 }
 
+func (m *QueryVaultResponse) GetOldestNavDenom() string {
+	if m != nil {
+		return m.OldestNavDenom
+	}
+	return ""
+}
+
+func (m *QueryVaultResponse) GetOldestNavAgeSeconds() uint64 {
+	if m != nil {
+		return m.OldestNavAgeSeconds
+	}
+	return 0
+}
+
+func (m *QueryVaultResponse) GetNavStale() bool {
+	if m != nil {
+		return m.NavStale
+	}
+	return false
+}
+
+func (m *QueryVaultResponse) GetLiquidityBuffer() LiquidityBuffer {
+	if m != nil {
+		return m.LiquidityBuffer
+	}
+	return LiquidityBuffer{}
+}
+
+// LiquidityBuffer reports how much of a vault's underlying asset is free once queued
+// swap-outs and the vault's minimum liquidity buffer are set aside.
+type LiquidityBuffer struct {
+	// min_liquidity_bips is the vault's buffer in basis points of total vault value.
+	MinLiquidityBips uint32 `protobuf:"varint,1,opt,name=min_liquidity_bips,json=minLiquidityBips,proto3" json:"min_liquidity_bips,omitempty"`
+	// liquid is the underlying asset balance of the vault's principal marker.
+	Liquid types.Coin `protobuf:"bytes,2,opt,name=liquid,proto3" json:"liquid"`
+	// reserved_redemptions is the value of the vault's queued swap-outs at the current share price.
+	ReservedRedemptions types.Coin `protobuf:"bytes,3,opt,name=reserved_redemptions,json=reservedRedemptions,proto3" json:"reserved_redemptions"`
+	// required is min_liquidity_bips of the vault's live total value.
+	Required types.Coin `protobuf:"bytes,4,opt,name=required,proto3" json:"required"`
+	// shortfall is how far liquid falls short of reserved_redemptions plus required, or zero.
+	Shortfall types.Coin `protobuf:"bytes,5,opt,name=shortfall,proto3" json:"shortfall"`
+}
+
+func (m *LiquidityBuffer) Reset()         { *m = LiquidityBuffer{} }
+func (m *LiquidityBuffer) String() string { return proto.CompactTextString(m) }
+func (*LiquidityBuffer) ProtoMessage()    {}
+func (*LiquidityBuffer) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a1276ddd190bfbca, []int{9}
+}
+func (m *LiquidityBuffer) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *LiquidityBuffer) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_LiquidityBuffer.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *LiquidityBuffer) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LiquidityBuffer.Merge(m, src)
+}
+func (m *LiquidityBuffer) XXX_Size() int {
+	return m.Size()
+}
+func (m *LiquidityBuffer) XXX_DiscardUnknown() {
+	xxx_messageInfo_LiquidityBuffer.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LiquidityBuffer proto.InternalMessageInfo
+
+func (m *LiquidityBuffer) GetMinLiquidityBips() uint32 {
+	if m != nil {
+		return m.MinLiquidityBips
+	}
+	return 0
+}
+
+func (m *LiquidityBuffer) GetLiquid() types.Coin {
+	if m != nil {
+		return m.Liquid
+	}
+	return types.Coin{}
+}
+
+func (m *LiquidityBuffer) GetReservedRedemptions() types.Coin {
+	if m != nil {
+		return m.ReservedRedemptions
+	}
+	return types.Coin{}
+}
+
+func (m *LiquidityBuffer) GetRequired() types.Coin {
+	if m != nil {
+		return m.Required
+	}
+	return types.Coin{}
+}
+
+func (m *LiquidityBuffer) GetShortfall() types.Coin {
+	if m != nil {
+		return m.Shortfall
+	}
+	return types.Coin{}
+}
+
 // QueryEstimateSwapInRequest is the request message for the Query/EstimateSwapIn endpoint.
 type QueryEstimateSwapInRequest struct {
 	// vault_address is the bech32 address of the vault to query.
@@ -547,7 +675,7 @@ func (m *QueryEstimateSwapInRequest) Reset()         { *m = QueryEstimateSwapInR
 func (m *QueryEstimateSwapInRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryEstimateSwapInRequest) ProtoMessage()    {}
 func (*QueryEstimateSwapInRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a1276ddd190bfbca, []int{9}
+	return fileDescriptor_a1276ddd190bfbca, []int{10}
 }
 func (m *QueryEstimateSwapInRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -598,13 +726,17 @@ type QueryEstimateSwapInResponse struct {
 	Height int64 `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
 	// The UTC block time when the estimate occurred.
 	Time time.Time `protobuf:"bytes,3,opt,name=time,proto3,stdtime" json:"time"`
+	// remaining_capacity is how much more value, in the underlying_asset, swap-ins may add
+	// before the vault's net total value (net of its outstanding AUM fee) reaches its
+	// max_total_value. Unset when the vault has no cap.
+	RemainingCapacity *types.Coin `protobuf:"bytes,4,opt,name=remaining_capacity,json=remainingCapacity,proto3" json:"remaining_capacity,omitempty"`
 }
 
 func (m *QueryEstimateSwapInResponse) Reset()         { *m = QueryEstimateSwapInResponse{} }
 func (m *QueryEstimateSwapInResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryEstimateSwapInResponse) ProtoMessage()    {}
 func (*QueryEstimateSwapInResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a1276ddd190bfbca, []int{10}
+	return fileDescriptor_a1276ddd190bfbca, []int{11}
 }
 func (m *QueryEstimateSwapInResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -654,6 +786,13 @@ func (m *QueryEstimateSwapInResponse) GetTime() time.Time {
 	return time.Time{}
 }
 
+func (m *QueryEstimateSwapInResponse) GetRemainingCapacity() *types.Coin {
+	if m != nil {
+		return m.RemainingCapacity
+	}
+	return nil
+}
+
 // QueryEstimateSwapOutRequest is the request message for the Query/EstimateSwapOut endpoint.
 type QueryEstimateSwapOutRequest struct {
 	// vault_address is the bech32 address of the vault to query.
@@ -670,7 +809,7 @@ func (m *QueryEstimateSwapOutRequest) Reset()         { *m = QueryEstimateSwapOu
 func (m *QueryEstimateSwapOutRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryEstimateSwapOutRequest) ProtoMessage()    {}
 func (*QueryEstimateSwapOutRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a1276ddd190bfbca, []int{11}
+	return fileDescriptor_a1276ddd190bfbca, []int{12}
 }
 func (m *QueryEstimateSwapOutRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -735,7 +874,7 @@ func (m *QueryEstimateSwapOutResponse) Reset()         { *m = QueryEstimateSwapO
 func (m *QueryEstimateSwapOutResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryEstimateSwapOutResponse) ProtoMessage()    {}
 func (*QueryEstimateSwapOutResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a1276ddd190bfbca, []int{12}
+	return fileDescriptor_a1276ddd190bfbca, []int{13}
 }
 func (m *QueryEstimateSwapOutResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -793,7 +932,7 @@ func (m *QueryParamsRequest) Reset()         { *m = QueryParamsRequest{} }
 func (m *QueryParamsRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryParamsRequest) ProtoMessage()    {}
 func (*QueryParamsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a1276ddd190bfbca, []int{13}
+	return fileDescriptor_a1276ddd190bfbca, []int{14}
 }
 func (m *QueryParamsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -832,7 +971,7 @@ func (m *QueryParamsResponse) Reset()         { *m = QueryParamsResponse{} }
 func (m *QueryParamsResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryParamsResponse) ProtoMessage()    {}
 func (*QueryParamsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a1276ddd190bfbca, []int{14}
+	return fileDescriptor_a1276ddd190bfbca, []int{15}
 }
 func (m *QueryParamsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -880,7 +1019,7 @@ func (m *QueryVaultNavsRequest) Reset()         { *m = QueryVaultNavsRequest{} }
 func (m *QueryVaultNavsRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryVaultNavsRequest) ProtoMessage()    {}
 func (*QueryVaultNavsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a1276ddd190bfbca, []int{15}
+	return fileDescriptor_a1276ddd190bfbca, []int{16}
 }
 func (m *QueryVaultNavsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -929,13 +1068,15 @@ type QueryVaultNavsResponse struct {
 	Navs []VaultNAV `protobuf:"bytes,1,rep,name=navs,proto3" json:"navs"`
 	// pagination defines the pagination in the response.
 	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	// haircuts is every valuation haircut configured by the vault. It is not paginated.
+	Haircuts []AssetHaircut `protobuf:"bytes,3,rep,name=haircuts,proto3" json:"haircuts"`
 }
 
 func (m *QueryVaultNavsResponse) Reset()         { *m = QueryVaultNavsResponse{} }
 func (m *QueryVaultNavsResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryVaultNavsResponse) ProtoMessage()    {}
 func (*QueryVaultNavsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a1276ddd190bfbca, []int{16}
+	return fileDescriptor_a1276ddd190bfbca, []int{17}
 }
 func (m *QueryVaultNavsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -978,6 +1119,13 @@ func (m *QueryVaultNavsResponse) GetPagination() *query.PageResponse {
 	return nil
 }
 
+func (m *QueryVaultNavsResponse) GetHaircuts() []AssetHaircut {
+	if m != nil {
+		return m.Haircuts
+	}
+	return nil
+}
+
 // QueryNavValueRequest is the request message for the Query/NavValue endpoint.
 type QueryNavValueRequest struct {
 	// id is the bech32 address of the vault or the vault's share denom to query.
@@ -990,7 +1138,7 @@ func (m *QueryNavValueRequest) Reset()         { *m = QueryNavValueRequest{} }
 func (m *QueryNavValueRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryNavValueRequest) ProtoMessage()    {}
 func (*QueryNavValueRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a1276ddd190bfbca, []int{17}
+	return fileDescriptor_a1276ddd190bfbca, []int{18}
 }
 func (m *QueryNavValueRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1035,15 +1183,21 @@ func (m *QueryNavValueRequest) GetDenom() string {
 
 // QueryNavValueResponse is the response message for the Query/NavValue endpoint.
 type QueryNavValueResponse struct {
-	// nav is the internal NAV entry for the requested vault and denom.
+	// nav is the internal NAV entry for the requested vault and denom. It is empty when the
+	// denom has no internal entry and is priced by another source in the vault's chain.
 	Nav VaultNAV `protobuf:"bytes,1,opt,name=nav,proto3" json:"nav"`
+	// price_source is the source in the vault's price-source chain that currently values
+	// the denom.
+	PriceSource PriceSourceType `protobuf:"varint,2,opt,name=price_source,json=priceSource,proto3,enum=provlabs.vault.v1.PriceSourceType" json:"price_source,omitempty"`
+	// price is the price used for valuation: the value of price.volume units of the denom.
+	Price VaultNAV `protobuf:"bytes,3,opt,name=price,proto3" json:"price"`
 }
 
 func (m *QueryNavValueResponse) Reset()         { *m = QueryNavValueResponse{} }
 func (m *QueryNavValueResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryNavValueResponse) ProtoMessage()    {}
 func (*QueryNavValueResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a1276ddd190bfbca, []int{18}
+	return fileDescriptor_a1276ddd190bfbca, []int{19}
 }
 func (m *QueryNavValueResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1079,35 +1233,40 @@ func (m *QueryNavValueResponse) GetNav() VaultNAV {
 	return VaultNAV{}
 }
 
-// Payment is the vault module's view of a Provenance exchange-module payment. It
-// mirrors the exchange Payment, exposing only the fields relevant to the vault's
-// asset settlement workflow.
-type Payment struct {
-	// source is the account that created the payment and owns the escrowed source_amount.
-	Source string `protobuf:"bytes,1,opt,name=source,proto3" json:"source,omitempty"`
-	// source_amount is the funds the source pays the target. A hold is placed on this
-	// amount in the source account until the payment is accepted, rejected, or cancelled.
-	SourceAmount github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=source_amount,json=sourceAmount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"source_amount"`
-	// target is the account that can accept the payment; for these queries it is the vault.
-	Target string `protobuf:"bytes,3,opt,name=target,proto3" json:"target,omitempty"`
-	// target_amount is the funds the target pays the source in exchange for source_amount.
-	TargetAmount github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,4,rep,name=target_amount,json=targetAmount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"target_amount"`
-	// external_id, together with source, uniquely identifies the payment.
-	ExternalId string `protobuf:"bytes,5,opt,name=external_id,json=externalId,proto3" json:"external_id,omitempty"`
+func (m *QueryNavValueResponse) GetPriceSource() PriceSourceType {
+	if m != nil {
+		return m.PriceSource
+	}
+	return PriceSourceType_PRICE_SOURCE_TYPE_INTERNAL_NAV
 }
 
-func (m *Payment) Reset()         { *m = Payment{} }
-func (m *Payment) String() string { return proto.CompactTextString(m) }
-func (*Payment) ProtoMessage()    {}
-func (*Payment) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a1276ddd190bfbca, []int{19}
+func (m *QueryNavValueResponse) GetPrice() VaultNAV {
+	if m != nil {
+		return m.Price
+	}
+	return VaultNAV{}
 }
-func (m *Payment) XXX_Unmarshal(b []byte) error {
+
+// QueryPendingNAVProposalsRequest is the request message for the Query/PendingNAVProposals endpoint.
+type QueryPendingNAVProposalsRequest struct {
+	// id is the bech32 address of the vault or the vault's share denom to query.
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryPendingNAVProposalsRequest) Reset()         { *m = QueryPendingNAVProposalsRequest{} }
+func (m *QueryPendingNAVProposalsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryPendingNAVProposalsRequest) ProtoMessage()    {}
+func (*QueryPendingNAVProposalsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a1276ddd190bfbca, []int{20}
+}
+func (m *QueryPendingNAVProposalsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *Payment) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryPendingNAVProposalsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_Payment.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryPendingNAVProposalsRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1117,75 +1276,107 @@ func (m *Payment) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 		return b[:n], nil
 	}
 }
-func (m *Payment) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_Payment.Merge(m, src)
+func (m *QueryPendingNAVProposalsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPendingNAVProposalsRequest.Merge(m, src)
 }
-func (m *Payment) XXX_Size() int {
+func (m *QueryPendingNAVProposalsRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *Payment) XXX_DiscardUnknown() {
-	xxx_messageInfo_Payment.DiscardUnknown(m)
+func (m *QueryPendingNAVProposalsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPendingNAVProposalsRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_Payment proto.InternalMessageInfo
+var xxx_messageInfo_QueryPendingNAVProposalsRequest proto.InternalMessageInfo
 
-func (m *Payment) GetSource() string {
+func (m *QueryPendingNAVProposalsRequest) GetId() string {
 	if m != nil {
-		return m.Source
+		return m.Id
 	}
 	return ""
 }
 
-func (m *Payment) GetSourceAmount() github_com_cosmos_cosmos_sdk_types.Coins {
+func (m *QueryPendingNAVProposalsRequest) GetPagination() *query.PageRequest {
 	if m != nil {
-		return m.SourceAmount
+		return m.Pagination
 	}
 	return nil
 }
 
-func (m *Payment) GetTarget() string {
-	if m != nil {
-		return m.Target
+// QueryPendingNAVProposalsResponse is the response message for the Query/PendingNAVProposals endpoint.
+type QueryPendingNAVProposalsResponse struct {
+	// proposals is the list of the vault's pending NAV proposals.
+	Proposals []PendingNAVProposal `protobuf:"bytes,1,rep,name=proposals,proto3" json:"proposals"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryPendingNAVProposalsResponse) Reset()         { *m = QueryPendingNAVProposalsResponse{} }
+func (m *QueryPendingNAVProposalsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryPendingNAVProposalsResponse) ProtoMessage()    {}
+func (*QueryPendingNAVProposalsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a1276ddd190bfbca, []int{21}
+}
+func (m *QueryPendingNAVProposalsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPendingNAVProposalsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPendingNAVProposalsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return ""
+}
+func (m *QueryPendingNAVProposalsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPendingNAVProposalsResponse.Merge(m, src)
+}
+func (m *QueryPendingNAVProposalsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPendingNAVProposalsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPendingNAVProposalsResponse.DiscardUnknown(m)
 }
 
-func (m *Payment) GetTargetAmount() github_com_cosmos_cosmos_sdk_types.Coins {
+var xxx_messageInfo_QueryPendingNAVProposalsResponse proto.InternalMessageInfo
+
+func (m *QueryPendingNAVProposalsResponse) GetProposals() []PendingNAVProposal {
 	if m != nil {
-		return m.TargetAmount
+		return m.Proposals
 	}
 	return nil
 }
 
-func (m *Payment) GetExternalId() string {
+func (m *QueryPendingNAVProposalsResponse) GetPagination() *query.PageResponse {
 	if m != nil {
-		return m.ExternalId
+		return m.Pagination
 	}
-	return ""
+	return nil
 }
 
-// QueryVaultPaymentRequest is the request message for the Query/VaultPayment endpoint.
-type QueryVaultPaymentRequest struct {
+// QueryNAVSourcesRequest is the request message for the Query/NAVSources endpoint.
+type QueryNAVSourcesRequest struct {
 	// id is the bech32 address of the vault or the vault's share denom to query.
 	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	// source is the bech32 address of the account that created the payment.
-	Source string `protobuf:"bytes,2,opt,name=source,proto3" json:"source,omitempty"`
-	// external_id, together with source, uniquely identifies the payment.
-	ExternalId string `protobuf:"bytes,3,opt,name=external_id,json=externalId,proto3" json:"external_id,omitempty"`
+	// denom is the asset denomination whose source submissions are being queried.
+	Denom string `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty"`
 }
 
-func (m *QueryVaultPaymentRequest) Reset()         { *m = QueryVaultPaymentRequest{} }
-func (m *QueryVaultPaymentRequest) String() string { return proto.CompactTextString(m) }
-func (*QueryVaultPaymentRequest) ProtoMessage()    {}
-func (*QueryVaultPaymentRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a1276ddd190bfbca, []int{20}
+func (m *QueryNAVSourcesRequest) Reset()         { *m = QueryNAVSourcesRequest{} }
+func (m *QueryNAVSourcesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryNAVSourcesRequest) ProtoMessage()    {}
+func (*QueryNAVSourcesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a1276ddd190bfbca, []int{22}
 }
-func (m *QueryVaultPaymentRequest) XXX_Unmarshal(b []byte) error {
+func (m *QueryNAVSourcesRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryVaultPaymentRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryNAVSourcesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryVaultPaymentRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryNAVSourcesRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1195,57 +1386,57 @@ func (m *QueryVaultPaymentRequest) XXX_Marshal(b []byte, deterministic bool) ([]
 		return b[:n], nil
 	}
 }
-func (m *QueryVaultPaymentRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryVaultPaymentRequest.Merge(m, src)
+func (m *QueryNAVSourcesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryNAVSourcesRequest.Merge(m, src)
 }
-func (m *QueryVaultPaymentRequest) XXX_Size() int {
+func (m *QueryNAVSourcesRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryVaultPaymentRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryVaultPaymentRequest.DiscardUnknown(m)
+func (m *QueryNAVSourcesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryNAVSourcesRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryVaultPaymentRequest proto.InternalMessageInfo
+var xxx_messageInfo_QueryNAVSourcesRequest proto.InternalMessageInfo
 
-func (m *QueryVaultPaymentRequest) GetId() string {
+func (m *QueryNAVSourcesRequest) GetId() string {
 	if m != nil {
 		return m.Id
 	}
 	return ""
 }
 
-func (m *QueryVaultPaymentRequest) GetSource() string {
-	if m != nil {
-		return m.Source
-	}
-	return ""
-}
-
-func (m *QueryVaultPaymentRequest) GetExternalId() string {
+func (m *QueryNAVSourcesRequest) GetDenom() string {
 	if m != nil {
-		return m.ExternalId
+		return m.Denom
 	}
 	return ""
 }
 
-// QueryVaultPaymentResponse is the response message for the Query/VaultPayment endpoint.
-type QueryVaultPaymentResponse struct {
-	// payment is the pending exchange-module payment targeting the vault.
-	Payment Payment `protobuf:"bytes,1,opt,name=payment,proto3" json:"payment"`
+// QueryNAVSourcesResponse is the response message for the Query/NAVSources endpoint.
+type QueryNAVSourcesResponse struct {
+	// contributions lists the submission of each NAV source that has priced the denom.
+	Contributions []NAVSourceContribution `protobuf:"bytes,1,rep,name=contributions,proto3" json:"contributions"`
+	// quorum is the vault's nav_source_quorum.
+	Quorum uint32 `protobuf:"varint,2,opt,name=quorum,proto3" json:"quorum,omitempty"`
+	// aggregation is the vault's nav_aggregation.
+	Aggregation NAVAggregation `protobuf:"varint,3,opt,name=aggregation,proto3,enum=provlabs.vault.v1.NAVAggregation" json:"aggregation,omitempty"`
+	// aggregate is the aggregated NAV used for valuation. It is unset while the quorum is
+	// not met, in which case the NAV authority's entry is used.
+	Aggregate *VaultNAV `protobuf:"bytes,4,opt,name=aggregate,proto3" json:"aggregate,omitempty"`
 }
 
-func (m *QueryVaultPaymentResponse) Reset()         { *m = QueryVaultPaymentResponse{} }
-func (m *QueryVaultPaymentResponse) String() string { return proto.CompactTextString(m) }
-func (*QueryVaultPaymentResponse) ProtoMessage()    {}
-func (*QueryVaultPaymentResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a1276ddd190bfbca, []int{21}
+func (m *QueryNAVSourcesResponse) Reset()         { *m = QueryNAVSourcesResponse{} }
+func (m *QueryNAVSourcesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryNAVSourcesResponse) ProtoMessage()    {}
+func (*QueryNAVSourcesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a1276ddd190bfbca, []int{23}
 }
-func (m *QueryVaultPaymentResponse) XXX_Unmarshal(b []byte) error {
+func (m *QueryNAVSourcesResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryVaultPaymentResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryNAVSourcesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryVaultPaymentResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryNAVSourcesResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1255,45 +1446,69 @@ func (m *QueryVaultPaymentResponse) XXX_Marshal(b []byte, deterministic bool) ([
 		return b[:n], nil
 	}
 }
-func (m *QueryVaultPaymentResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryVaultPaymentResponse.Merge(m, src)
+func (m *QueryNAVSourcesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryNAVSourcesResponse.Merge(m, src)
 }
-func (m *QueryVaultPaymentResponse) XXX_Size() int {
+func (m *QueryNAVSourcesResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryVaultPaymentResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryVaultPaymentResponse.DiscardUnknown(m)
+func (m *QueryNAVSourcesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryNAVSourcesResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryVaultPaymentResponse proto.InternalMessageInfo
+var xxx_messageInfo_QueryNAVSourcesResponse proto.InternalMessageInfo
 
-func (m *QueryVaultPaymentResponse) GetPayment() Payment {
+func (m *QueryNAVSourcesResponse) GetContributions() []NAVSourceContribution {
 	if m != nil {
-		return m.Payment
+		return m.Contributions
 	}
-	return Payment{}
+	return nil
 }
 
-// QueryVaultPaymentsRequest is the request message for the Query/VaultPayments endpoint.
-type QueryVaultPaymentsRequest struct {
-	// id is the bech32 address of the vault or the vault's share denom to query.
-	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	// pagination defines an optional pagination for the request.
-	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+func (m *QueryNAVSourcesResponse) GetQuorum() uint32 {
+	if m != nil {
+		return m.Quorum
+	}
+	return 0
 }
 
-func (m *QueryVaultPaymentsRequest) Reset()         { *m = QueryVaultPaymentsRequest{} }
-func (m *QueryVaultPaymentsRequest) String() string { return proto.CompactTextString(m) }
-func (*QueryVaultPaymentsRequest) ProtoMessage()    {}
-func (*QueryVaultPaymentsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a1276ddd190bfbca, []int{22}
+func (m *QueryNAVSourcesResponse) GetAggregation() NAVAggregation {
+	if m != nil {
+		return m.Aggregation
+	}
+	return NAVAggregation_NAV_AGGREGATION_MEDIAN
 }
-func (m *QueryVaultPaymentsRequest) XXX_Unmarshal(b []byte) error {
+
+func (m *QueryNAVSourcesResponse) GetAggregate() *VaultNAV {
+	if m != nil {
+		return m.Aggregate
+	}
+	return nil
+}
+
+// NAVSourceContribution is one NAV source's submission as seen by the Query/NAVSources endpoint.
+type NAVSourceContribution struct {
+	// source is the address of the NAV source.
+	Source string `protobuf:"bytes,1,opt,name=source,proto3" json:"source,omitempty"`
+	// nav is the source's latest submission for the denom.
+	Nav VaultNAV `protobuf:"bytes,2,opt,name=nav,proto3" json:"nav"`
+	// fresh is true when the submission is within nav_source_max_age_seconds and counts
+	// toward the quorum.
+	Fresh bool `protobuf:"varint,3,opt,name=fresh,proto3" json:"fresh,omitempty"`
+}
+
+func (m *NAVSourceContribution) Reset()         { *m = NAVSourceContribution{} }
+func (m *NAVSourceContribution) String() string { return proto.CompactTextString(m) }
+func (*NAVSourceContribution) ProtoMessage()    {}
+func (*NAVSourceContribution) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a1276ddd190bfbca, []int{24}
+}
+func (m *NAVSourceContribution) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryVaultPaymentsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *NAVSourceContribution) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryVaultPaymentsRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_NAVSourceContribution.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1303,52 +1518,69 @@ func (m *QueryVaultPaymentsRequest) XXX_Marshal(b []byte, deterministic bool) ([
 		return b[:n], nil
 	}
 }
-func (m *QueryVaultPaymentsRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryVaultPaymentsRequest.Merge(m, src)
+func (m *NAVSourceContribution) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_NAVSourceContribution.Merge(m, src)
 }
-func (m *QueryVaultPaymentsRequest) XXX_Size() int {
+func (m *NAVSourceContribution) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryVaultPaymentsRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryVaultPaymentsRequest.DiscardUnknown(m)
+func (m *NAVSourceContribution) XXX_DiscardUnknown() {
+	xxx_messageInfo_NAVSourceContribution.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryVaultPaymentsRequest proto.InternalMessageInfo
+var xxx_messageInfo_NAVSourceContribution proto.InternalMessageInfo
 
-func (m *QueryVaultPaymentsRequest) GetId() string {
+func (m *NAVSourceContribution) GetSource() string {
 	if m != nil {
-		return m.Id
+		return m.Source
 	}
 	return ""
 }
 
-func (m *QueryVaultPaymentsRequest) GetPagination() *query.PageRequest {
+func (m *NAVSourceContribution) GetNav() VaultNAV {
 	if m != nil {
-		return m.Pagination
+		return m.Nav
 	}
-	return nil
+	return VaultNAV{}
 }
 
-// QueryVaultPaymentsResponse is the response message for the Query/VaultPayments endpoint.
-type QueryVaultPaymentsResponse struct {
-	// payments is the list of pending exchange-module payments targeting the vault.
-	Payments []Payment `protobuf:"bytes,1,rep,name=payments,proto3" json:"payments"`
-	// pagination defines the pagination in the response.
-	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+func (m *NAVSourceContribution) GetFresh() bool {
+	if m != nil {
+		return m.Fresh
+	}
+	return false
 }
 
-func (m *QueryVaultPaymentsResponse) Reset()         { *m = QueryVaultPaymentsResponse{} }
-func (m *QueryVaultPaymentsResponse) String() string { return proto.CompactTextString(m) }
-func (*QueryVaultPaymentsResponse) ProtoMessage()    {}
-func (*QueryVaultPaymentsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a1276ddd190bfbca, []int{23}
+// QueryNavHistoryRequest is the request message for the Query/NavHistory endpoint.
+type QueryNavHistoryRequest struct {
+	// id is the bech32 address of the vault or the vault's share denom to query.
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// denom is the asset denomination whose NAV history is being queried. Use the vault's
+	// share denom for its published share NAV history.
+	Denom string `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty"`
+	// start_time optionally excludes points recorded before this time.
+	StartTime *time.Time `protobuf:"bytes,3,opt,name=start_time,json=startTime,proto3,stdtime" json:"start_time,omitempty"`
+	// end_time optionally excludes points recorded at or after this time.
+	EndTime *time.Time `protobuf:"bytes,4,opt,name=end_time,json=endTime,proto3,stdtime" json:"end_time,omitempty"`
+	// interval_seconds optionally downsamples the series to the first point in each
+	// interval, with intervals aligned to the UNIX epoch. A value of 0 returns every point.
+	IntervalSeconds uint64 `protobuf:"varint,5,opt,name=interval_seconds,json=intervalSeconds,proto3" json:"interval_seconds,omitempty"`
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,6,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
-func (m *QueryVaultPaymentsResponse) XXX_Unmarshal(b []byte) error {
+
+func (m *QueryNavHistoryRequest) Reset()         { *m = QueryNavHistoryRequest{} }
+func (m *QueryNavHistoryRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryNavHistoryRequest) ProtoMessage()    {}
+func (*QueryNavHistoryRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a1276ddd190bfbca, []int{25}
+}
+func (m *QueryNavHistoryRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryVaultPaymentsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryNavHistoryRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryVaultPaymentsResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryNavHistoryRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1358,2070 +1590,10374 @@ func (m *QueryVaultPaymentsResponse) XXX_Marshal(b []byte, deterministic bool) (
 		return b[:n], nil
 	}
 }
-func (m *QueryVaultPaymentsResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryVaultPaymentsResponse.Merge(m, src)
+func (m *QueryNavHistoryRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryNavHistoryRequest.Merge(m, src)
 }
-func (m *QueryVaultPaymentsResponse) XXX_Size() int {
+func (m *QueryNavHistoryRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryVaultPaymentsResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryVaultPaymentsResponse.DiscardUnknown(m)
+func (m *QueryNavHistoryRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryNavHistoryRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryVaultPaymentsResponse proto.InternalMessageInfo
+var xxx_messageInfo_QueryNavHistoryRequest proto.InternalMessageInfo
 
-func (m *QueryVaultPaymentsResponse) GetPayments() []Payment {
+func (m *QueryNavHistoryRequest) GetId() string {
 	if m != nil {
-		return m.Payments
+		return m.Id
+	}
+	return ""
+}
+
+func (m *QueryNavHistoryRequest) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *QueryNavHistoryRequest) GetStartTime() *time.Time {
+	if m != nil {
+		return m.StartTime
 	}
 	return nil
 }
 
-func (m *QueryVaultPaymentsResponse) GetPagination() *query.PageResponse {
+func (m *QueryNavHistoryRequest) GetEndTime() *time.Time {
 	if m != nil {
-		return m.Pagination
+		return m.EndTime
 	}
 	return nil
 }
 
-func init() {
-	proto.RegisterType((*QueryVaultPendingSwapOutsRequest)(nil), "provlabs.vault.v1.QueryVaultPendingSwapOutsRequest")
-	proto.RegisterType((*QueryVaultPendingSwapOutsResponse)(nil), "provlabs.vault.v1.QueryVaultPendingSwapOutsResponse")
-	proto.RegisterType((*QueryPendingSwapOutsRequest)(nil), "provlabs.vault.v1.QueryPendingSwapOutsRequest")
-	proto.RegisterType((*QueryPendingSwapOutsResponse)(nil), "provlabs.vault.v1.QueryPendingSwapOutsResponse")
-	proto.RegisterType((*PendingSwapOutWithTimeout)(nil), "provlabs.vault.v1.PendingSwapOutWithTimeout")
-	proto.RegisterType((*QueryVaultsRequest)(nil), "provlabs.vault.v1.QueryVaultsRequest")
-	proto.RegisterType((*QueryVaultsResponse)(nil), "provlabs.vault.v1.QueryVaultsResponse")
-	proto.RegisterType((*QueryVaultRequest)(nil), "provlabs.vault.v1.QueryVaultRequest")
-	proto.RegisterType((*QueryVaultResponse)(nil), "provlabs.vault.v1.QueryVaultResponse")
-	proto.RegisterType((*QueryEstimateSwapInRequest)(nil), "provlabs.vault.v1.QueryEstimateSwapInRequest")
-	proto.RegisterType((*QueryEstimateSwapInResponse)(nil), "provlabs.vault.v1.QueryEstimateSwapInResponse")
-	proto.RegisterType((*QueryEstimateSwapOutRequest)(nil), "provlabs.vault.v1.QueryEstimateSwapOutRequest")
-	proto.RegisterType((*QueryEstimateSwapOutResponse)(nil), "provlabs.vault.v1.QueryEstimateSwapOutResponse")
-	proto.RegisterType((*QueryParamsRequest)(nil), "provlabs.vault.v1.QueryParamsRequest")
-	proto.RegisterType((*QueryParamsResponse)(nil), "provlabs.vault.v1.QueryParamsResponse")
-	proto.RegisterType((*QueryVaultNavsRequest)(nil), "provlabs.vault.v1.QueryVaultNavsRequest")
-	proto.RegisterType((*QueryVaultNavsResponse)(nil), "provlabs.vault.v1.QueryVaultNavsResponse")
-	proto.RegisterType((*QueryNavValueRequest)(nil), "provlabs.vault.v1.QueryNavValueRequest")
-	proto.RegisterType((*QueryNavValueResponse)(nil), "provlabs.vault.v1.QueryNavValueResponse")
-	proto.RegisterType((*Payment)(nil), "provlabs.vault.v1.Payment")
-	proto.RegisterType((*QueryVaultPaymentRequest)(nil), "provlabs.vault.v1.QueryVaultPaymentRequest")
-	proto.RegisterType((*QueryVaultPaymentResponse)(nil), "provlabs.vault.v1.QueryVaultPaymentResponse")
-	proto.RegisterType((*QueryVaultPaymentsRequest)(nil), "provlabs.vault.v1.QueryVaultPaymentsRequest")
-	proto.RegisterType((*QueryVaultPaymentsResponse)(nil), "provlabs.vault.v1.QueryVaultPaymentsResponse")
+func (m *QueryNavHistoryRequest) GetIntervalSeconds() uint64 {
+	if m != nil {
+		return m.IntervalSeconds
+	}
+	return 0
 }
 
-func init() { proto.RegisterFile("provlabs/vault/v1/query.proto", fileDescriptor_a1276ddd190bfbca) }
+func (m *QueryNavHistoryRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
 
-var fileDescriptor_a1276ddd190bfbca = []byte{
-	// 1440 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x58, 0x4f, 0x6f, 0x1b, 0x45,
-	0x14, 0xcf, 0x38, 0x89, 0x9b, 0xbc, 0xa4, 0x7f, 0x32, 0xa4, 0xc5, 0x71, 0x1b, 0x3b, 0xdd, 0x92,
-	0x26, 0x2d, 0xcd, 0x6e, 0x93, 0x14, 0x41, 0x4b, 0x8b, 0x94, 0x94, 0x82, 0x2a, 0x50, 0x9a, 0xba,
-	0x55, 0x2b, 0x21, 0x84, 0x35, 0xb1, 0x07, 0x67, 0x85, 0xbd, 0xbb, 0xd9, 0x19, 0xbb, 0xb4, 0x55,
-	0x0e, 0x20, 0x8e, 0x1c, 0x2a, 0x81, 0x04, 0x17, 0x4e, 0x5c, 0xa0, 0x07, 0x0e, 0x88, 0xef, 0x40,
-	0x0f, 0x20, 0x2a, 0xb8, 0x70, 0xa2, 0xa8, 0xe5, 0x13, 0xf0, 0x09, 0xd0, 0xce, 0xbc, 0x8d, 0xbd,
-	0xce, 0xae, 0xed, 0xa0, 0x44, 0x82, 0x53, 0xbc, 0xb3, 0xef, 0xcf, 0xef, 0xf7, 0x9b, 0xf7, 0x66,
-	0xdf, 0x04, 0x26, 0x3d, 0xdf, 0x6d, 0x54, 0xd9, 0x9a, 0xb0, 0x1a, 0xac, 0x5e, 0x95, 0x56, 0x63,
-	0xde, 0xda, 0xa8, 0x73, 0xff, 0xae, 0xe9, 0xf9, 0xae, 0x74, 0xe9, 0x58, 0xf8, 0xda, 0x54, 0xaf,
-	0xcd, 0xc6, 0x7c, 0xf6, 0x74, 0xc9, 0x15, 0x35, 0x57, 0x58, 0x6b, 0x4c, 0x70, 0x6d, 0x6b, 0x35,
-	0xe6, 0xd7, 0xb8, 0x64, 0xf3, 0x96, 0xc7, 0x2a, 0xb6, 0xc3, 0xa4, 0xed, 0x3a, 0xda, 0x3d, 0x9b,
-	0x6b, 0xb5, 0x0d, 0xad, 0x4a, 0xae, 0x1d, 0xbe, 0x9f, 0xd0, 0xef, 0x8b, 0xea, 0xc9, 0xd2, 0x0f,
-	0xf8, 0x6a, 0xbc, 0xe2, 0x56, 0x5c, 0xbd, 0x1e, 0xfc, 0xc2, 0xd5, 0x63, 0x15, 0xd7, 0xad, 0x54,
-	0xb9, 0xc5, 0x3c, 0xdb, 0x62, 0x8e, 0xe3, 0x4a, 0x95, 0x2d, 0xf4, 0xc9, 0xe3, 0x5b, 0xf5, 0xb4,
-	0x56, 0x7f, 0xdf, 0x92, 0x76, 0x8d, 0x0b, 0xc9, 0x6a, 0x5e, 0x88, 0x67, 0x3b, 0x5b, 0x8f, 0xf9,
-	0xac, 0x16, 0x06, 0x88, 0x51, 0x43, 0xf3, 0x56, 0xaf, 0x8d, 0x7b, 0x30, 0x75, 0x3d, 0x20, 0x7c,
-	0x2b, 0x58, 0x5b, 0xe5, 0x4e, 0xd9, 0x76, 0x2a, 0x37, 0xee, 0x30, 0xef, 0x5a, 0x5d, 0x8a, 0x02,
-	0xdf, 0xa8, 0x73, 0x21, 0xe9, 0x01, 0x48, 0xd9, 0xe5, 0x0c, 0x99, 0x22, 0xb3, 0xc3, 0x85, 0x94,
-	0x5d, 0xa6, 0x6f, 0x00, 0x34, 0x65, 0xc9, 0xa4, 0xa6, 0xc8, 0xec, 0xc8, 0xc2, 0x49, 0x13, 0xa9,
-	0x06, 0xba, 0x98, 0x5a, 0x6f, 0x54, 0xc7, 0x5c, 0x65, 0x15, 0x8e, 0xb1, 0x0a, 0x2d, 0x9e, 0xc6,
-	0x4f, 0x04, 0x8e, 0x77, 0x48, 0x2e, 0x3c, 0xd7, 0x11, 0x9c, 0xbe, 0x07, 0x63, 0x9e, 0x7e, 0x55,
-	0x14, 0x77, 0x98, 0x57, 0x74, 0xeb, 0x52, 0x64, 0xc8, 0x54, 0xff, 0xec, 0xc8, 0xc2, 0x19, 0x73,
-	0xdb, 0x5e, 0x9a, 0xd1, 0x30, 0xb7, 0x6d, 0xb9, 0x7e, 0xd3, 0xae, 0x71, 0xb7, 0x2e, 0x97, 0x07,
-	0x1e, 0xfd, 0x91, 0xef, 0x2b, 0x1c, 0xf4, 0xa2, 0x79, 0xe8, 0x9b, 0x31, 0x6c, 0x66, 0xba, 0xb2,
-	0xd1, 0xe0, 0x22, 0x74, 0x38, 0x1c, 0x55, 0x6c, 0x12, 0x54, 0x8c, 0xaa, 0x46, 0xfe, 0xb5, 0x6a,
-	0x3f, 0x12, 0x38, 0x16, 0x9f, 0xe7, 0xff, 0x26, 0xd8, 0xcf, 0x04, 0x26, 0x12, 0xb3, 0xd3, 0x49,
-	0x00, 0x5f, 0xd3, 0x2f, 0x62, 0xf5, 0x0d, 0x14, 0x86, 0x71, 0xe5, 0x6a, 0x99, 0x5e, 0x87, 0x43,
-	0xed, 0x2c, 0x11, 0xcb, 0xf1, 0xae, 0x24, 0x91, 0xd9, 0x81, 0x28, 0x33, 0xfa, 0x1a, 0xec, 0x93,
-	0x3a, 0x79, 0xa6, 0x5f, 0x45, 0xca, 0x9a, 0xba, 0xfb, 0xcc, 0xb0, 0xfb, 0xcc, 0x9b, 0x61, 0xf7,
-	0x2d, 0x0f, 0x05, 0x21, 0x1e, 0x3c, 0xc9, 0x93, 0x42, 0xe8, 0x64, 0xbc, 0x0b, 0xb4, 0x59, 0xce,
-	0xbb, 0xbe, 0xef, 0x5f, 0x11, 0x78, 0x2e, 0x12, 0x1e, 0xb7, 0xfb, 0x12, 0xa4, 0x15, 0xcd, 0x70,
-	0x8f, 0xf3, 0x31, 0xf4, 0x95, 0xcb, 0x52, 0xa9, 0xe4, 0xd6, 0x9d, 0x90, 0x3c, 0x3a, 0xed, 0xde,
-	0x6e, 0x9e, 0x80, 0xb1, 0x26, 0xbc, 0x84, 0xa3, 0xc3, 0x78, 0x98, 0x6a, 0xd5, 0x68, 0x8b, 0xc3,
-	0xab, 0x30, 0xa8, 0xe0, 0xa0, 0x3c, 0x3d, 0x52, 0xd0, 0x3e, 0xf4, 0x0a, 0x0c, 0x7b, 0xbe, 0xed,
-	0x94, 0x6c, 0x8f, 0x55, 0x3b, 0x94, 0x40, 0xe8, 0xcb, 0xaa, 0xcc, 0x29, 0x71, 0x0c, 0xd1, 0xf4,
-	0xa4, 0x97, 0x61, 0xc8, 0xe7, 0x82, 0xfb, 0x0d, 0x2e, 0x70, 0xfb, 0x7b, 0x8e, 0xb2, 0xe5, 0x48,
-	0xdf, 0x82, 0x31, 0xe9, 0x4a, 0x56, 0x2d, 0x2a, 0x87, 0x62, 0x83, 0x55, 0xeb, 0x3c, 0x33, 0xa0,
-	0xa2, 0x4d, 0x44, 0x44, 0x0d, 0xe5, 0xbc, 0xec, 0xda, 0x4e, 0xd8, 0x68, 0xca, 0x53, 0xf1, 0xbc,
-	0x15, 0xf8, 0x19, 0xf7, 0x20, 0xab, 0xb4, 0xba, 0x22, 0xa4, 0x5d, 0x63, 0x92, 0x07, 0x75, 0x7a,
-	0xd5, 0x09, 0xa5, 0x3d, 0x01, 0xfb, 0x75, 0x12, 0x56, 0x2e, 0xfb, 0x5c, 0x08, 0x54, 0x79, 0x54,
-	0x2d, 0x2e, 0xe9, 0x35, 0xfa, 0x32, 0xa4, 0x99, 0x10, 0x5c, 0x0a, 0x14, 0xa6, 0x2b, 0x08, 0x34,
-	0x37, 0xbe, 0x21, 0x78, 0x9a, 0xb5, 0x27, 0xc7, 0x1d, 0x6b, 0x06, 0x26, 0x3b, 0x0a, 0x4c, 0x8f,
-	0x40, 0x7a, 0x9d, 0xdb, 0x95, 0x75, 0xdd, 0xad, 0xfd, 0x05, 0x7c, 0xa2, 0xaf, 0xc0, 0x40, 0xd0,
-	0x47, 0x3b, 0xea, 0x3c, 0xe5, 0x61, 0x7c, 0x14, 0x07, 0xf5, 0x5a, 0x5d, 0xee, 0x48, 0xa8, 0x23,
-	0x90, 0x16, 0xeb, 0xcc, 0xe7, 0x5a, 0xa8, 0xe1, 0x02, 0x3e, 0xd1, 0x69, 0x18, 0xf5, 0x79, 0x99,
-	0xf3, 0x5a, 0xb1, 0xcc, 0x1d, 0xb7, 0xa6, 0xe0, 0x0d, 0x2f, 0xa7, 0x32, 0xa4, 0x30, 0xa2, 0xd7,
-	0x5f, 0x0f, 0x96, 0x8d, 0x6f, 0xc3, 0x43, 0x79, 0x1b, 0x86, 0xff, 0x9e, 0x5e, 0xe3, 0xd8, 0x82,
-	0xab, 0x6a, 0x4c, 0x40, 0x95, 0x8c, 0x15, 0x3c, 0x5d, 0xc2, 0xd5, 0x26, 0x6e, 0x3d, 0x4e, 0x6c,
-	0xe1, 0x8e, 0x39, 0x5c, 0x95, 0x41, 0x88, 0x5b, 0x9b, 0x1b, 0x2e, 0x1c, 0x6e, 0x36, 0xfa, 0x0a,
-	0x6b, 0xec, 0xf9, 0x34, 0xf1, 0x25, 0x81, 0x23, 0xed, 0x19, 0x91, 0xc4, 0x4b, 0x30, 0xe0, 0xb0,
-	0x46, 0x78, 0x40, 0x1e, 0x4d, 0x3a, 0x5d, 0x56, 0x96, 0x6e, 0x21, 0x09, 0x65, 0xbe, 0x7b, 0x47,
-	0xe3, 0x45, 0x18, 0x57, 0xc8, 0x56, 0x58, 0x43, 0x75, 0x76, 0x92, 0x14, 0xe3, 0x30, 0xa8, 0xab,
-	0x4c, 0xd7, 0xa0, 0x7e, 0x30, 0xde, 0x46, 0x25, 0x9b, 0xde, 0x48, 0x6b, 0x11, 0xfa, 0x1d, 0xd6,
-	0xc0, 0x8d, 0xe9, 0x81, 0x55, 0x60, 0x6d, 0xfc, 0x9d, 0x82, 0x7d, 0xab, 0xec, 0x6e, 0x8d, 0x3b,
-	0x92, 0x9e, 0x85, 0xb4, 0x70, 0xeb, 0x7e, 0x89, 0x6b, 0x0c, 0xcb, 0x99, 0x5f, 0x7f, 0x98, 0x1b,
-	0x47, 0x7e, 0xd8, 0x18, 0x37, 0xa4, 0x6f, 0x3b, 0x95, 0x02, 0xda, 0x51, 0x0f, 0xf6, 0xeb, 0x5f,
-	0x45, 0x56, 0x0b, 0xce, 0xc1, 0x4c, 0x4a, 0x49, 0xda, 0xa1, 0x9a, 0xcf, 0x06, 0xa9, 0x1f, 0x3e,
-	0xc9, 0xcf, 0x56, 0x6c, 0xb9, 0x5e, 0x5f, 0x33, 0x4b, 0x6e, 0x0d, 0xa7, 0x62, 0xfc, 0x33, 0x27,
-	0xca, 0x1f, 0x58, 0xf2, 0xae, 0xc7, 0x85, 0x72, 0x10, 0x85, 0x51, 0x9d, 0x61, 0x49, 0x25, 0x08,
-	0x30, 0x4a, 0xe6, 0x57, 0xb8, 0xc4, 0xd6, 0xeb, 0x80, 0x51, 0xdb, 0x05, 0x18, 0xf5, 0xaf, 0x10,
-	0xe3, 0xc0, 0x1e, 0x60, 0xd4, 0x19, 0x10, 0x63, 0x1e, 0x46, 0xf8, 0x87, 0x92, 0xfb, 0x0e, 0xab,
-	0x06, 0xb3, 0xca, 0xa0, 0xda, 0x3d, 0x08, 0x97, 0xae, 0x96, 0x8d, 0x4d, 0xc8, 0xb4, 0x0c, 0xba,
-	0x5a, 0xfd, 0xa4, 0x22, 0x68, 0x6e, 0x4a, 0xaa, 0xc7, 0x4d, 0x69, 0x4b, 0xdf, 0xbf, 0x2d, 0xfd,
-	0x6d, 0x98, 0x88, 0x49, 0x8f, 0x55, 0x74, 0x01, 0xf6, 0x79, 0x7a, 0x09, 0x2b, 0x29, 0x1b, 0xdb,
-	0xe2, 0xca, 0x02, 0x0b, 0x29, 0x74, 0x30, 0x44, 0x4c, 0xe0, 0x3d, 0x6f, 0xf4, 0xaf, 0x09, 0x7e,
-	0x17, 0xdb, 0xb2, 0x22, 0x9f, 0x8b, 0x30, 0x84, 0xf0, 0xc2, 0x86, 0xef, 0x4e, 0x68, 0xcb, 0x63,
-	0xd7, 0x7a, 0x7e, 0xe1, 0x97, 0x51, 0x18, 0x54, 0x28, 0xe9, 0x06, 0xa4, 0xf5, 0xc8, 0x46, 0xa7,
-	0x63, 0x80, 0x6c, 0x9f, 0x18, 0xb3, 0x27, 0xbb, 0x99, 0xe9, 0x74, 0x46, 0xe6, 0xe3, 0xdf, 0xfe,
-	0xfa, 0x2c, 0x45, 0xe9, 0xa1, 0xb6, 0xab, 0x9d, 0xa0, 0x02, 0x06, 0x95, 0x2d, 0x7d, 0xa1, 0x63,
-	0xa8, 0x30, 0xe1, 0x74, 0x17, 0x2b, 0xcc, 0x37, 0xa9, 0xf2, 0x3d, 0x4f, 0x0f, 0xb7, 0xe7, 0xb3,
-	0xee, 0xdb, 0xe5, 0x4d, 0xfa, 0x90, 0xc0, 0x81, 0xe8, 0xb4, 0x40, 0xe7, 0x92, 0x02, 0xc7, 0x8e,
-	0x34, 0x59, 0xb3, 0x57, 0x73, 0x04, 0x74, 0x5e, 0x01, 0x5a, 0xa4, 0xf3, 0xdb, 0x01, 0x45, 0xbe,
-	0xf8, 0x9b, 0x16, 0xc7, 0x00, 0xfa, 0xae, 0x60, 0x3b, 0xf4, 0x3b, 0x02, 0x07, 0xdb, 0xbe, 0xd5,
-	0xb4, 0xa7, 0xf4, 0xcd, 0xc1, 0x22, 0x6b, 0xf5, 0x6c, 0x8f, 0x78, 0x2f, 0x28, 0xbc, 0xe7, 0xe8,
-	0xc2, 0x0e, 0xf1, 0x06, 0xd7, 0xa1, 0x2f, 0x08, 0x1c, 0x6c, 0xbb, 0xf1, 0x25, 0x03, 0x8e, 0xbf,
-	0x82, 0x26, 0x03, 0x4e, 0xb8, 0x4a, 0x1a, 0x27, 0x14, 0xe0, 0x49, 0x7a, 0xb4, 0xe5, 0x9f, 0x0b,
-	0xed, 0x57, 0x4b, 0xfa, 0x3d, 0x81, 0xf1, 0xb8, 0x1b, 0x3c, 0x5d, 0xec, 0x58, 0x56, 0x09, 0x18,
-	0xcf, 0xed, 0xcc, 0x09, 0x81, 0x5a, 0x0a, 0xe8, 0x29, 0x3a, 0x13, 0x5b, 0x9a, 0x31, 0xa0, 0x37,
-	0x20, 0xad, 0xc7, 0x96, 0xe4, 0xa6, 0x8c, 0xcc, 0x47, 0xc9, 0x4d, 0x19, 0x1d, 0x98, 0xe2, 0x9a,
-	0x52, 0x4f, 0x44, 0xf4, 0x13, 0x02, 0xc3, 0x5b, 0xb3, 0x09, 0x9d, 0xed, 0xc8, 0xb3, 0x65, 0x60,
-	0xca, 0x9e, 0xea, 0xc1, 0x12, 0x93, 0x1b, 0x2a, 0xf9, 0x31, 0x9a, 0x8d, 0x97, 0x41, 0x4d, 0x35,
-	0x9f, 0x12, 0x18, 0x0a, 0x47, 0x09, 0x3a, 0x93, 0x14, 0xbb, 0x6d, 0x54, 0xc9, 0xce, 0x76, 0x37,
-	0x44, 0x0c, 0x73, 0x0a, 0xc3, 0x0c, 0x9d, 0x4e, 0xc6, 0x60, 0xdd, 0x57, 0x93, 0xcd, 0xa5, 0xd3,
-	0xa7, 0x37, 0xe9, 0x03, 0x02, 0xa3, 0xad, 0x07, 0x39, 0x7d, 0xb1, 0x73, 0x01, 0x44, 0x3e, 0x9e,
-	0xd9, 0x33, 0xbd, 0x19, 0x23, 0xb4, 0x69, 0x05, 0x2d, 0x4f, 0x27, 0x13, 0xaa, 0x04, 0x11, 0x7c,
-	0x4e, 0x60, 0x7f, 0xe4, 0xdb, 0x42, 0x7b, 0x4a, 0xb3, 0xb5, 0x61, 0x73, 0x3d, 0x5a, 0x23, 0xaa,
-	0x93, 0x0a, 0xd5, 0x14, 0xcd, 0x75, 0x44, 0x25, 0x96, 0xcf, 0x3f, 0x7a, 0x9a, 0x23, 0x8f, 0x9f,
-	0xe6, 0xc8, 0x9f, 0x4f, 0x73, 0xe4, 0xc1, 0xb3, 0x5c, 0xdf, 0xe3, 0x67, 0xb9, 0xbe, 0xdf, 0x9f,
-	0xe5, 0xfa, 0xde, 0xc9, 0xb7, 0xcc, 0x2d, 0x6d, 0xff, 0xee, 0x53, 0x43, 0xcb, 0x5a, 0x5a, 0x5d,
-	0x0b, 0x16, 0xff, 0x09, 0x00, 0x00, 0xff, 0xff, 0x07, 0x09, 0x57, 0xd6, 0x1b, 0x15, 0x00, 0x00,
+// QueryNavHistoryResponse is the response message for the Query/NavHistory endpoint.
+type QueryNavHistoryResponse struct {
+	// entries are the NAV points in ascending time order. Each point's updated_time is the
+	// block time at which it was recorded.
+	Entries []VaultNAV `protobuf:"bytes,1,rep,name=entries,proto3" json:"entries"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
+func (m *QueryNavHistoryResponse) Reset()         { *m = QueryNavHistoryResponse{} }
+func (m *QueryNavHistoryResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryNavHistoryResponse) ProtoMessage()    {}
+func (*QueryNavHistoryResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a1276ddd190bfbca, []int{26}
+}
+func (m *QueryNavHistoryResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryNavHistoryResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryNavHistoryResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryNavHistoryResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryNavHistoryResponse.Merge(m, src)
+}
+func (m *QueryNavHistoryResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryNavHistoryResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryNavHistoryResponse.DiscardUnknown(m)
+}
 
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+var xxx_messageInfo_QueryNavHistoryResponse proto.InternalMessageInfo
 
-// QueryClient is the client API for Query service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type QueryClient interface {
-	// Vaults returns a paginated list of all vaults.
-	Vaults(ctx context.Context, in *QueryVaultsRequest, opts ...grpc.CallOption) (*QueryVaultsResponse, error)
-	// Vault returns the configuration and state of a specific vault.
-	Vault(ctx context.Context, in *QueryVaultRequest, opts ...grpc.CallOption) (*QueryVaultResponse, error)
-	// EstimateSwapIn estimates the amount of shares that would be received for a given amount of underlying assets.
-	EstimateSwapIn(ctx context.Context, in *QueryEstimateSwapInRequest, opts ...grpc.CallOption) (*QueryEstimateSwapInResponse, error)
-	// EstimateSwapOut estimates the amount of underlying assets that would be received for a given amount of shares.
-	EstimateSwapOut(ctx context.Context, in *QueryEstimateSwapOutRequest, opts ...grpc.CallOption) (*QueryEstimateSwapOutResponse, error)
-	// PendingSwapOuts returns a paginated list of all pending swap outs.
-	PendingSwapOuts(ctx context.Context, in *QueryPendingSwapOutsRequest, opts ...grpc.CallOption) (*QueryPendingSwapOutsResponse, error)
-	// VaultPendingSwapOuts returns a paginated list of all pending swap outs for a specific vault.
-	VaultPendingSwapOuts(ctx context.Context, in *QueryVaultPendingSwapOutsRequest, opts ...grpc.CallOption) (*QueryVaultPendingSwapOutsResponse, error)
-	// Params returns the current module parameters.
-	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
-	// VaultNavs returns a paginated list of all internal NAV entries for a vault.
-	VaultNavs(ctx context.Context, in *QueryVaultNavsRequest, opts ...grpc.CallOption) (*QueryVaultNavsResponse, error)
-	// NavValue returns a single internal NAV entry for a vault and denom.
-	NavValue(ctx context.Context, in *QueryNavValueRequest, opts ...grpc.CallOption) (*QueryNavValueResponse, error)
-	// VaultPayment returns a single pending exchange-module payment targeting a vault,
-	// identified by the payment's source account and external id.
-	VaultPayment(ctx context.Context, in *QueryVaultPaymentRequest, opts ...grpc.CallOption) (*QueryVaultPaymentResponse, error)
-	// VaultPayments returns a paginated list of all pending exchange-module payments
-	// targeting a vault.
-	VaultPayments(ctx context.Context, in *QueryVaultPaymentsRequest, opts ...grpc.CallOption) (*QueryVaultPaymentsResponse, error)
+func (m *QueryNavHistoryResponse) GetEntries() []VaultNAV {
+	if m != nil {
+		return m.Entries
+	}
+	return nil
 }
 
-type queryClient struct {
-	cc grpc1.ClientConn
+func (m *QueryNavHistoryResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
 }
 
-func NewQueryClient(cc grpc1.ClientConn) QueryClient {
-	return &queryClient{cc}
+// Payment is the vault module's view of a Provenance exchange-module payment. It
+// mirrors the exchange Payment, exposing only the fields relevant to the vault's
+// asset settlement workflow.
+type Payment struct {
+	// source is the account that created the payment and owns the escrowed source_amount.
+	Source string `protobuf:"bytes,1,opt,name=source,proto3" json:"source,omitempty"`
+	// source_amount is the funds the source pays the target. A hold is placed on this
+	// amount in the source account until the payment is accepted, rejected, or cancelled.
+	SourceAmount github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=source_amount,json=sourceAmount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"source_amount"`
+	// target is the account that can accept the payment; for these queries it is the vault.
+	Target string `protobuf:"bytes,3,opt,name=target,proto3" json:"target,omitempty"`
+	// target_amount is the funds the target pays the source in exchange for source_amount.
+	TargetAmount github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,4,rep,name=target_amount,json=targetAmount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"target_amount"`
+	// external_id, together with source, uniquely identifies the payment.
+	ExternalId string `protobuf:"bytes,5,opt,name=external_id,json=externalId,proto3" json:"external_id,omitempty"`
 }
 
-func (c *queryClient) Vaults(ctx context.Context, in *QueryVaultsRequest, opts ...grpc.CallOption) (*QueryVaultsResponse, error) {
-	out := new(QueryVaultsResponse)
-	err := c.cc.Invoke(ctx, "/provlabs.vault.v1.Query/Vaults", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *Payment) Reset()         { *m = Payment{} }
+func (m *Payment) String() string { return proto.CompactTextString(m) }
+func (*Payment) ProtoMessage()    {}
+func (*Payment) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a1276ddd190bfbca, []int{27}
+}
+func (m *Payment) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Payment) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Payment.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *Payment) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Payment.Merge(m, src)
+}
+func (m *Payment) XXX_Size() int {
+	return m.Size()
+}
+func (m *Payment) XXX_DiscardUnknown() {
+	xxx_messageInfo_Payment.DiscardUnknown(m)
 }
 
-func (c *queryClient) Vault(ctx context.Context, in *QueryVaultRequest, opts ...grpc.CallOption) (*QueryVaultResponse, error) {
-	out := new(QueryVaultResponse)
-	err := c.cc.Invoke(ctx, "/provlabs.vault.v1.Query/Vault", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_Payment proto.InternalMessageInfo
+
+func (m *Payment) GetSource() string {
+	if m != nil {
+		return m.Source
 	}
-	return out, nil
+	return ""
 }
 
-func (c *queryClient) EstimateSwapIn(ctx context.Context, in *QueryEstimateSwapInRequest, opts ...grpc.CallOption) (*QueryEstimateSwapInResponse, error) {
-	out := new(QueryEstimateSwapInResponse)
-	err := c.cc.Invoke(ctx, "/provlabs.vault.v1.Query/EstimateSwapIn", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *Payment) GetSourceAmount() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.SourceAmount
 	}
-	return out, nil
+	return nil
 }
 
-func (c *queryClient) EstimateSwapOut(ctx context.Context, in *QueryEstimateSwapOutRequest, opts ...grpc.CallOption) (*QueryEstimateSwapOutResponse, error) {
-	out := new(QueryEstimateSwapOutResponse)
-	err := c.cc.Invoke(ctx, "/provlabs.vault.v1.Query/EstimateSwapOut", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *Payment) GetTarget() string {
+	if m != nil {
+		return m.Target
 	}
-	return out, nil
+	return ""
 }
 
-func (c *queryClient) PendingSwapOuts(ctx context.Context, in *QueryPendingSwapOutsRequest, opts ...grpc.CallOption) (*QueryPendingSwapOutsResponse, error) {
-	out := new(QueryPendingSwapOutsResponse)
-	err := c.cc.Invoke(ctx, "/provlabs.vault.v1.Query/PendingSwapOuts", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *Payment) GetTargetAmount() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.TargetAmount
 	}
-	return out, nil
+	return nil
 }
 
-func (c *queryClient) VaultPendingSwapOuts(ctx context.Context, in *QueryVaultPendingSwapOutsRequest, opts ...grpc.CallOption) (*QueryVaultPendingSwapOutsResponse, error) {
-	out := new(QueryVaultPendingSwapOutsResponse)
-	err := c.cc.Invoke(ctx, "/provlabs.vault.v1.Query/VaultPendingSwapOuts", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *Payment) GetExternalId() string {
+	if m != nil {
+		return m.ExternalId
 	}
-	return out, nil
+	return ""
 }
 
-func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
-	out := new(QueryParamsResponse)
-	err := c.cc.Invoke(ctx, "/provlabs.vault.v1.Query/Params", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+// QueryVaultPaymentRequest is the request message for the Query/VaultPayment endpoint.
+type QueryVaultPaymentRequest struct {
+	// id is the bech32 address of the vault or the vault's share denom to query.
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// source is the bech32 address of the account that created the payment.
+	Source string `protobuf:"bytes,2,opt,name=source,proto3" json:"source,omitempty"`
+	// external_id, together with source, uniquely identifies the payment.
+	ExternalId string `protobuf:"bytes,3,opt,name=external_id,json=externalId,proto3" json:"external_id,omitempty"`
 }
 
-func (c *queryClient) VaultNavs(ctx context.Context, in *QueryVaultNavsRequest, opts ...grpc.CallOption) (*QueryVaultNavsResponse, error) {
-	out := new(QueryVaultNavsResponse)
-	err := c.cc.Invoke(ctx, "/provlabs.vault.v1.Query/VaultNavs", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryVaultPaymentRequest) Reset()         { *m = QueryVaultPaymentRequest{} }
+func (m *QueryVaultPaymentRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryVaultPaymentRequest) ProtoMessage()    {}
+func (*QueryVaultPaymentRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a1276ddd190bfbca, []int{28}
+}
+func (m *QueryVaultPaymentRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryVaultPaymentRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryVaultPaymentRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryVaultPaymentRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryVaultPaymentRequest.Merge(m, src)
+}
+func (m *QueryVaultPaymentRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryVaultPaymentRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryVaultPaymentRequest.DiscardUnknown(m)
 }
 
-func (c *queryClient) NavValue(ctx context.Context, in *QueryNavValueRequest, opts ...grpc.CallOption) (*QueryNavValueResponse, error) {
-	out := new(QueryNavValueResponse)
-	err := c.cc.Invoke(ctx, "/provlabs.vault.v1.Query/NavValue", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryVaultPaymentRequest proto.InternalMessageInfo
+
+func (m *QueryVaultPaymentRequest) GetId() string {
+	if m != nil {
+		return m.Id
 	}
-	return out, nil
+	return ""
 }
 
-func (c *queryClient) VaultPayment(ctx context.Context, in *QueryVaultPaymentRequest, opts ...grpc.CallOption) (*QueryVaultPaymentResponse, error) {
-	out := new(QueryVaultPaymentResponse)
-	err := c.cc.Invoke(ctx, "/provlabs.vault.v1.Query/VaultPayment", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryVaultPaymentRequest) GetSource() string {
+	if m != nil {
+		return m.Source
 	}
-	return out, nil
+	return ""
 }
 
-func (c *queryClient) VaultPayments(ctx context.Context, in *QueryVaultPaymentsRequest, opts ...grpc.CallOption) (*QueryVaultPaymentsResponse, error) {
-	out := new(QueryVaultPaymentsResponse)
-	err := c.cc.Invoke(ctx, "/provlabs.vault.v1.Query/VaultPayments", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryVaultPaymentRequest) GetExternalId() string {
+	if m != nil {
+		return m.ExternalId
 	}
-	return out, nil
+	return ""
 }
 
-// QueryServer is the server API for Query service.
-type QueryServer interface {
-	// Vaults returns a paginated list of all vaults.
-	Vaults(context.Context, *QueryVaultsRequest) (*QueryVaultsResponse, error)
-	// Vault returns the configuration and state of a specific vault.
-	Vault(context.Context, *QueryVaultRequest) (*QueryVaultResponse, error)
-	// EstimateSwapIn estimates the amount of shares that would be received for a given amount of underlying assets.
-	EstimateSwapIn(context.Context, *QueryEstimateSwapInRequest) (*QueryEstimateSwapInResponse, error)
-	// EstimateSwapOut estimates the amount of underlying assets that would be received for a given amount of shares.
-	EstimateSwapOut(context.Context, *QueryEstimateSwapOutRequest) (*QueryEstimateSwapOutResponse, error)
-	// PendingSwapOuts returns a paginated list of all pending swap outs.
-	PendingSwapOuts(context.Context, *QueryPendingSwapOutsRequest) (*QueryPendingSwapOutsResponse, error)
-	// VaultPendingSwapOuts returns a paginated list of all pending swap outs for a specific vault.
-	VaultPendingSwapOuts(context.Context, *QueryVaultPendingSwapOutsRequest) (*QueryVaultPendingSwapOutsResponse, error)
-	// Params returns the current module parameters.
-	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
-	// VaultNavs returns a paginated list of all internal NAV entries for a vault.
-	VaultNavs(context.Context, *QueryVaultNavsRequest) (*QueryVaultNavsResponse, error)
-	// NavValue returns a single internal NAV entry for a vault and denom.
-	NavValue(context.Context, *QueryNavValueRequest) (*QueryNavValueResponse, error)
-	// VaultPayment returns a single pending exchange-module payment targeting a vault,
-	// identified by the payment's source account and external id.
-	VaultPayment(context.Context, *QueryVaultPaymentRequest) (*QueryVaultPaymentResponse, error)
-	// VaultPayments returns a paginated list of all pending exchange-module payments
-	// targeting a vault.
-	VaultPayments(context.Context, *QueryVaultPaymentsRequest) (*QueryVaultPaymentsResponse, error)
+// QueryVaultPaymentResponse is the response message for the Query/VaultPayment endpoint.
+type QueryVaultPaymentResponse struct {
+	// payment is the pending exchange-module payment targeting the vault.
+	Payment Payment `protobuf:"bytes,1,opt,name=payment,proto3" json:"payment"`
 }
 
-// UnimplementedQueryServer can be embedded to have forward compatible implementations.
-type UnimplementedQueryServer struct {
+func (m *QueryVaultPaymentResponse) Reset()         { *m = QueryVaultPaymentResponse{} }
+func (m *QueryVaultPaymentResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryVaultPaymentResponse) ProtoMessage()    {}
+func (*QueryVaultPaymentResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a1276ddd190bfbca, []int{29}
 }
-
-func (*UnimplementedQueryServer) Vaults(ctx context.Context, req *QueryVaultsRequest) (*QueryVaultsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Vaults not implemented")
+func (m *QueryVaultPaymentResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedQueryServer) Vault(ctx context.Context, req *QueryVaultRequest) (*QueryVaultResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Vault not implemented")
+func (m *QueryVaultPaymentResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryVaultPaymentResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedQueryServer) EstimateSwapIn(ctx context.Context, req *QueryEstimateSwapInRequest) (*QueryEstimateSwapInResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method EstimateSwapIn not implemented")
+func (m *QueryVaultPaymentResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryVaultPaymentResponse.Merge(m, src)
 }
-func (*UnimplementedQueryServer) EstimateSwapOut(ctx context.Context, req *QueryEstimateSwapOutRequest) (*QueryEstimateSwapOutResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method EstimateSwapOut not implemented")
+func (m *QueryVaultPaymentResponse) XXX_Size() int {
+	return m.Size()
 }
-func (*UnimplementedQueryServer) PendingSwapOuts(ctx context.Context, req *QueryPendingSwapOutsRequest) (*QueryPendingSwapOutsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method PendingSwapOuts not implemented")
+func (m *QueryVaultPaymentResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryVaultPaymentResponse.DiscardUnknown(m)
 }
-func (*UnimplementedQueryServer) VaultPendingSwapOuts(ctx context.Context, req *QueryVaultPendingSwapOutsRequest) (*QueryVaultPendingSwapOutsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method VaultPendingSwapOuts not implemented")
+
+var xxx_messageInfo_QueryVaultPaymentResponse proto.InternalMessageInfo
+
+func (m *QueryVaultPaymentResponse) GetPayment() Payment {
+	if m != nil {
+		return m.Payment
+	}
+	return Payment{}
 }
-func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+
+// QueryVaultPaymentsRequest is the request message for the Query/VaultPayments endpoint.
+type QueryVaultPaymentsRequest struct {
+	// id is the bech32 address of the vault or the vault's share denom to query.
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
-func (*UnimplementedQueryServer) VaultNavs(ctx context.Context, req *QueryVaultNavsRequest) (*QueryVaultNavsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method VaultNavs not implemented")
+
+func (m *QueryVaultPaymentsRequest) Reset()         { *m = QueryVaultPaymentsRequest{} }
+func (m *QueryVaultPaymentsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryVaultPaymentsRequest) ProtoMessage()    {}
+func (*QueryVaultPaymentsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a1276ddd190bfbca, []int{30}
 }
-func (*UnimplementedQueryServer) NavValue(ctx context.Context, req *QueryNavValueRequest) (*QueryNavValueResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method NavValue not implemented")
+func (m *QueryVaultPaymentsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedQueryServer) VaultPayment(ctx context.Context, req *QueryVaultPaymentRequest) (*QueryVaultPaymentResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method VaultPayment not implemented")
+func (m *QueryVaultPaymentsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryVaultPaymentsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedQueryServer) VaultPayments(ctx context.Context, req *QueryVaultPaymentsRequest) (*QueryVaultPaymentsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method VaultPayments not implemented")
+func (m *QueryVaultPaymentsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryVaultPaymentsRequest.Merge(m, src)
 }
-
-func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
-	s.RegisterService(&_Query_serviceDesc, srv)
+func (m *QueryVaultPaymentsRequest) XXX_Size() int {
+	return m.Size()
 }
-
-func _Query_Vaults_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryVaultsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Vaults(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/provlabs.vault.v1.Query/Vaults",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Vaults(ctx, req.(*QueryVaultsRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+func (m *QueryVaultPaymentsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryVaultPaymentsRequest.DiscardUnknown(m)
 }
 
-func _Query_Vault_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryVaultRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Vault(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/provlabs.vault.v1.Query/Vault",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Vault(ctx, req.(*QueryVaultRequest))
+var xxx_messageInfo_QueryVaultPaymentsRequest proto.InternalMessageInfo
+
+func (m *QueryVaultPaymentsRequest) GetId() string {
+	if m != nil {
+		return m.Id
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Query_EstimateSwapIn_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryEstimateSwapInRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).EstimateSwapIn(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/provlabs.vault.v1.Query/EstimateSwapIn",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).EstimateSwapIn(ctx, req.(*QueryEstimateSwapInRequest))
+func (m *QueryVaultPaymentsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Query_EstimateSwapOut_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryEstimateSwapOutRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).EstimateSwapOut(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/provlabs.vault.v1.Query/EstimateSwapOut",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).EstimateSwapOut(ctx, req.(*QueryEstimateSwapOutRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+// QueryVaultPaymentsResponse is the response message for the Query/VaultPayments endpoint.
+type QueryVaultPaymentsResponse struct {
+	// payments is the list of pending exchange-module payments targeting the vault.
+	Payments []Payment `protobuf:"bytes,1,rep,name=payments,proto3" json:"payments"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func _Query_PendingSwapOuts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryPendingSwapOutsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).PendingSwapOuts(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/provlabs.vault.v1.Query/PendingSwapOuts",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).PendingSwapOuts(ctx, req.(*QueryPendingSwapOutsRequest))
+func (m *QueryVaultPaymentsResponse) Reset()         { *m = QueryVaultPaymentsResponse{} }
+func (m *QueryVaultPaymentsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryVaultPaymentsResponse) ProtoMessage()    {}
+func (*QueryVaultPaymentsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a1276ddd190bfbca, []int{31}
+}
+func (m *QueryVaultPaymentsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryVaultPaymentsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryVaultPaymentsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *QueryVaultPaymentsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryVaultPaymentsResponse.Merge(m, src)
+}
+func (m *QueryVaultPaymentsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryVaultPaymentsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryVaultPaymentsResponse.DiscardUnknown(m)
 }
 
-func _Query_VaultPendingSwapOuts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryVaultPendingSwapOutsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).VaultPendingSwapOuts(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/provlabs.vault.v1.Query/VaultPendingSwapOuts",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).VaultPendingSwapOuts(ctx, req.(*QueryVaultPendingSwapOutsRequest))
+var xxx_messageInfo_QueryVaultPaymentsResponse proto.InternalMessageInfo
+
+func (m *QueryVaultPaymentsResponse) GetPayments() []Payment {
+	if m != nil {
+		return m.Payments
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryParamsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Params(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/provlabs.vault.v1.Query/Params",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+func (m *QueryVaultPaymentsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Query_VaultNavs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryVaultNavsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).VaultNavs(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/provlabs.vault.v1.Query/VaultNavs",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).VaultNavs(ctx, req.(*QueryVaultNavsRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+// QuerySharePriceRequest is the request message for the Query/SharePrice endpoint.
+type QuerySharePriceRequest struct {
+	// id is the bech32 address of the vault or the vault's share denom to query.
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 }
 
-func _Query_NavValue_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryNavValueRequest)
-	if err := dec(in); err != nil {
-		return nil, err
+func (m *QuerySharePriceRequest) Reset()         { *m = QuerySharePriceRequest{} }
+func (m *QuerySharePriceRequest) String() string { return proto.CompactTextString(m) }
+func (*QuerySharePriceRequest) ProtoMessage()    {}
+func (*QuerySharePriceRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a1276ddd190bfbca, []int{32}
+}
+func (m *QuerySharePriceRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySharePriceRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySharePriceRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).NavValue(ctx, in)
+}
+func (m *QuerySharePriceRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySharePriceRequest.Merge(m, src)
+}
+func (m *QuerySharePriceRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySharePriceRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySharePriceRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuerySharePriceRequest proto.InternalMessageInfo
+
+func (m *QuerySharePriceRequest) GetId() string {
+	if m != nil {
+		return m.Id
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/provlabs.vault.v1.Query/NavValue",
+	return ""
+}
+
+// QuerySharePriceResponse is the response message for the Query/SharePrice endpoint. Each
+// price is the value, in base units of price_denom, of one share_unit: 10^exponent base units
+// of the share denom.
+type QuerySharePriceResponse struct {
+	// gross is the NAV per share before deducting the vault's outstanding AUM fee.
+	Gross cosmossdk_io_math.LegacyDec `protobuf:"bytes,1,opt,name=gross,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"gross"`
+	// net is the NAV per share net of the outstanding AUM fee. Swaps are priced from this value.
+	Net cosmossdk_io_math.LegacyDec `protobuf:"bytes,2,opt,name=net,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"net"`
+	// estimated is the net NAV per share with unpaid interest and AUM fees accrued to the
+	// current block, as used by the swap estimate queries.
+	Estimated cosmossdk_io_math.LegacyDec `protobuf:"bytes,3,opt,name=estimated,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"estimated"`
+	// price_denom is the denom the prices are quoted in: the vault's underlying asset.
+	PriceDenom string `protobuf:"bytes,4,opt,name=price_denom,json=priceDenom,proto3" json:"price_denom,omitempty"`
+	// share_unit is the share denom unit priced: the display denom from the share denom's bank
+	// metadata when set, or the share base denom otherwise.
+	ShareUnit string `protobuf:"bytes,5,opt,name=share_unit,json=shareUnit,proto3" json:"share_unit,omitempty"`
+	// exponent is the number of decimal places between share_unit and the share base denom.
+	Exponent uint32 `protobuf:"varint,6,opt,name=exponent,proto3" json:"exponent,omitempty"`
+	// The block height when the price was computed.
+	Height int64 `protobuf:"varint,7,opt,name=height,proto3" json:"height,omitempty"`
+	// The UTC block time when the price was computed.
+	Time time.Time `protobuf:"bytes,8,opt,name=time,proto3,stdtime" json:"time"`
+}
+
+func (m *QuerySharePriceResponse) Reset()         { *m = QuerySharePriceResponse{} }
+func (m *QuerySharePriceResponse) String() string { return proto.CompactTextString(m) }
+func (*QuerySharePriceResponse) ProtoMessage()    {}
+func (*QuerySharePriceResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a1276ddd190bfbca, []int{33}
+}
+func (m *QuerySharePriceResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySharePriceResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySharePriceResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).NavValue(ctx, req.(*QueryNavValueRequest))
+}
+func (m *QuerySharePriceResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySharePriceResponse.Merge(m, src)
+}
+func (m *QuerySharePriceResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySharePriceResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySharePriceResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuerySharePriceResponse proto.InternalMessageInfo
+
+func (m *QuerySharePriceResponse) GetPriceDenom() string {
+	if m != nil {
+		return m.PriceDenom
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Query_VaultPayment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryVaultPaymentRequest)
-	if err := dec(in); err != nil {
-		return nil, err
+func (m *QuerySharePriceResponse) GetShareUnit() string {
+	if m != nil {
+		return m.ShareUnit
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).VaultPayment(ctx, in)
+	return ""
+}
+
+func (m *QuerySharePriceResponse) GetExponent() uint32 {
+	if m != nil {
+		return m.Exponent
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/provlabs.vault.v1.Query/VaultPayment",
+	return 0
+}
+
+func (m *QuerySharePriceResponse) GetHeight() int64 {
+	if m != nil {
+		return m.Height
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).VaultPayment(ctx, req.(*QueryVaultPaymentRequest))
+	return 0
+}
+
+func (m *QuerySharePriceResponse) GetTime() time.Time {
+	if m != nil {
+		return m.Time
 	}
-	return interceptor(ctx, in, info, handler)
+	return time.Time{}
 }
 
-func _Query_VaultPayments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryVaultPaymentsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
+// QueryVaultTWAPRequest is the request message for the Query/VaultTWAP endpoint.
+type QueryVaultTWAPRequest struct {
+	// id is the bech32 address of the vault or the vault's share denom to query.
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// start_time is the start of the averaging window. It is required.
+	StartTime *time.Time `protobuf:"bytes,2,opt,name=start_time,json=startTime,proto3,stdtime" json:"start_time,omitempty"`
+	// end_time is the end of the averaging window. It defaults to the current block time and
+	// cannot be after it.
+	EndTime *time.Time `protobuf:"bytes,3,opt,name=end_time,json=endTime,proto3,stdtime" json:"end_time,omitempty"`
+}
+
+func (m *QueryVaultTWAPRequest) Reset()         { *m = QueryVaultTWAPRequest{} }
+func (m *QueryVaultTWAPRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryVaultTWAPRequest) ProtoMessage()    {}
+func (*QueryVaultTWAPRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a1276ddd190bfbca, []int{34}
+}
+func (m *QueryVaultTWAPRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryVaultTWAPRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryVaultTWAPRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).VaultPayments(ctx, in)
+}
+func (m *QueryVaultTWAPRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryVaultTWAPRequest.Merge(m, src)
+}
+func (m *QueryVaultTWAPRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryVaultTWAPRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryVaultTWAPRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryVaultTWAPRequest proto.InternalMessageInfo
+
+func (m *QueryVaultTWAPRequest) GetId() string {
+	if m != nil {
+		return m.Id
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/provlabs.vault.v1.Query/VaultPayments",
+	return ""
+}
+
+func (m *QueryVaultTWAPRequest) GetStartTime() *time.Time {
+	if m != nil {
+		return m.StartTime
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).VaultPayments(ctx, req.(*QueryVaultPaymentsRequest))
+	return nil
+}
+
+func (m *QueryVaultTWAPRequest) GetEndTime() *time.Time {
+	if m != nil {
+		return m.EndTime
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-var Query_serviceDesc = _Query_serviceDesc
-var _Query_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "provlabs.vault.v1.Query",
-	HandlerType: (*QueryServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "Vaults",
-			Handler:    _Query_Vaults_Handler,
-		},
-		{
-			MethodName: "Vault",
-			Handler:    _Query_Vault_Handler,
-		},
-		{
-			MethodName: "EstimateSwapIn",
-			Handler:    _Query_EstimateSwapIn_Handler,
-		},
-		{
-			MethodName: "EstimateSwapOut",
-			Handler:    _Query_EstimateSwapOut_Handler,
-		},
-		{
-			MethodName: "PendingSwapOuts",
-			Handler:    _Query_PendingSwapOuts_Handler,
-		},
-		{
-			MethodName: "VaultPendingSwapOuts",
-			Handler:    _Query_VaultPendingSwapOuts_Handler,
-		},
-		{
-			MethodName: "Params",
-			Handler:    _Query_Params_Handler,
-		},
-		{
-			MethodName: "VaultNavs",
-			Handler:    _Query_VaultNavs_Handler,
-		},
-		{
-			MethodName: "NavValue",
-			Handler:    _Query_NavValue_Handler,
-		},
-		{
-			MethodName: "VaultPayment",
-			Handler:    _Query_VaultPayment_Handler,
-		},
-		{
-			MethodName: "VaultPayments",
-			Handler:    _Query_VaultPayments_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "provlabs/vault/v1/query.proto",
+// QueryVaultTWAPResponse is the response message for the Query/VaultTWAP endpoint.
+type QueryVaultTWAPResponse struct {
+	// twap is the time-weighted average share price over the window, in price_denom units per
+	// ShareScalar base shares. When the window has no duration it is the price in effect at
+	// its start.
+	Twap cosmossdk_io_math.LegacyDec `protobuf:"bytes,1,opt,name=twap,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"twap"`
+	// price_denom is the denom the price is quoted in: the vault's underlying asset.
+	PriceDenom string `protobuf:"bytes,2,opt,name=price_denom,json=priceDenom,proto3" json:"price_denom,omitempty"`
+	// start_time is the start of the window averaged. It is later than the requested start
+	// when the vault's share price history does not reach back that far.
+	StartTime time.Time `protobuf:"bytes,3,opt,name=start_time,json=startTime,proto3,stdtime" json:"start_time"`
+	// end_time is the end of the window averaged.
+	EndTime time.Time `protobuf:"bytes,4,opt,name=end_time,json=endTime,proto3,stdtime" json:"end_time"`
+}
+
+func (m *QueryVaultTWAPResponse) Reset()         { *m = QueryVaultTWAPResponse{} }
+func (m *QueryVaultTWAPResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryVaultTWAPResponse) ProtoMessage()    {}
+func (*QueryVaultTWAPResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a1276ddd190bfbca, []int{35}
+}
+func (m *QueryVaultTWAPResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (m *QueryVaultPendingSwapOutsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryVaultTWAPResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryVaultTWAPResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *QueryVaultPendingSwapOutsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryVaultTWAPResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryVaultTWAPResponse.Merge(m, src)
+}
+func (m *QueryVaultTWAPResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryVaultTWAPResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryVaultTWAPResponse.DiscardUnknown(m)
 }
 
-func (m *QueryVaultPendingSwapOutsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
+var xxx_messageInfo_QueryVaultTWAPResponse proto.InternalMessageInfo
+
+func (m *QueryVaultTWAPResponse) GetPriceDenom() string {
+	if m != nil {
+		return m.PriceDenom
 	}
-	if len(m.Id) > 0 {
-		i -= len(m.Id)
-		copy(dAtA[i:], m.Id)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Id)))
-		i--
-		dAtA[i] = 0xa
+	return ""
+}
+
+func (m *QueryVaultTWAPResponse) GetStartTime() time.Time {
+	if m != nil {
+		return m.StartTime
 	}
-	return len(dAtA) - i, nil
+	return time.Time{}
 }
 
-func (m *QueryVaultPendingSwapOutsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryVaultTWAPResponse) GetEndTime() time.Time {
+	if m != nil {
+		return m.EndTime
 	}
-	return dAtA[:n], nil
+	return time.Time{}
 }
 
-func (m *QueryVaultPendingSwapOutsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryVaultPerformanceRequest is the request message for the Query/VaultPerformance endpoint.
+type QueryVaultPerformanceRequest struct {
+	// id is the bech32 address of the vault or the vault's share denom to query.
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 }
 
-func (m *QueryVaultPendingSwapOutsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.PendingSwapOuts) > 0 {
-		for iNdEx := len(m.PendingSwapOuts) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.PendingSwapOuts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+func (m *QueryVaultPerformanceRequest) Reset()         { *m = QueryVaultPerformanceRequest{} }
+func (m *QueryVaultPerformanceRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryVaultPerformanceRequest) ProtoMessage()    {}
+func (*QueryVaultPerformanceRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a1276ddd190bfbca, []int{36}
+}
+func (m *QueryVaultPerformanceRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryVaultPerformanceRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryVaultPerformanceRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryVaultPerformanceRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryVaultPerformanceRequest.Merge(m, src)
+}
+func (m *QueryVaultPerformanceRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryVaultPerformanceRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryVaultPerformanceRequest.DiscardUnknown(m)
 }
 
-func (m *QueryPendingSwapOutsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryVaultPerformanceRequest proto.InternalMessageInfo
+
+func (m *QueryVaultPerformanceRequest) GetId() string {
+	if m != nil {
+		return m.Id
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *QueryPendingSwapOutsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryVaultPerformanceResponse is the response message for the Query/VaultPerformance endpoint.
+type QueryVaultPerformanceResponse struct {
+	// windows are the return metrics over trailing 7, 30, 90 and 365 day windows, in that order.
+	Windows []VaultPerformanceWindow `protobuf:"bytes,1,rep,name=windows,proto3" json:"windows"`
+	// price_denom is the denom share prices are quoted in: the vault's underlying asset.
+	PriceDenom string `protobuf:"bytes,2,opt,name=price_denom,json=priceDenom,proto3" json:"price_denom,omitempty"`
+	// The block height when the metrics were computed.
+	Height int64 `protobuf:"varint,3,opt,name=height,proto3" json:"height,omitempty"`
+	// The UTC block time when the metrics were computed.
+	Time time.Time `protobuf:"bytes,4,opt,name=time,proto3,stdtime" json:"time"`
 }
 
-func (m *QueryPendingSwapOutsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+func (m *QueryVaultPerformanceResponse) Reset()         { *m = QueryVaultPerformanceResponse{} }
+func (m *QueryVaultPerformanceResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryVaultPerformanceResponse) ProtoMessage()    {}
+func (*QueryVaultPerformanceResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a1276ddd190bfbca, []int{37}
+}
+func (m *QueryVaultPerformanceResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryVaultPerformanceResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryVaultPerformanceResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryVaultPerformanceResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryVaultPerformanceResponse.Merge(m, src)
+}
+func (m *QueryVaultPerformanceResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryVaultPerformanceResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryVaultPerformanceResponse.DiscardUnknown(m)
 }
 
-func (m *QueryPendingSwapOutsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryVaultPerformanceResponse proto.InternalMessageInfo
+
+func (m *QueryVaultPerformanceResponse) GetWindows() []VaultPerformanceWindow {
+	if m != nil {
+		return m.Windows
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryPendingSwapOutsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryVaultPerformanceResponse) GetPriceDenom() string {
+	if m != nil {
+		return m.PriceDenom
+	}
+	return ""
 }
 
-func (m *QueryPendingSwapOutsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
+func (m *QueryVaultPerformanceResponse) GetHeight() int64 {
+	if m != nil {
+		return m.Height
 	}
-	if len(m.PendingSwapOuts) > 0 {
-		for iNdEx := len(m.PendingSwapOuts) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.PendingSwapOuts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+	return 0
+}
+
+func (m *QueryVaultPerformanceResponse) GetTime() time.Time {
+	if m != nil {
+		return m.Time
 	}
-	return len(dAtA) - i, nil
+	return time.Time{}
 }
 
-func (m *PendingSwapOutWithTimeout) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+// VaultPerformanceWindow holds a vault's share price return metrics over one trailing window
+// ending at the current block time. Returns are decimal fractions: 0.05 is 5%.
+type VaultPerformanceWindow struct {
+	// window_days is the requested length of the window in days.
+	WindowDays uint32 `protobuf:"varint,1,opt,name=window_days,json=windowDays,proto3" json:"window_days,omitempty"`
+	// start_time is the start of the window measured. It is later than the requested start
+	// when the vault's share price history does not reach back that far.
+	StartTime time.Time `protobuf:"bytes,2,opt,name=start_time,json=startTime,proto3,stdtime" json:"start_time"`
+	// complete is true when the share price history covers the whole requested window.
+	Complete bool `protobuf:"varint,3,opt,name=complete,proto3" json:"complete,omitempty"`
+	// start_price is the share price in effect at start_time, in price_denom units per
+	// ShareScalar base shares.
+	StartPrice cosmossdk_io_math.LegacyDec `protobuf:"bytes,4,opt,name=start_price,json=startPrice,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"start_price"`
+	// end_price is the last observed share price, in price_denom units per ShareScalar base
+	// shares.
+	EndPrice cosmossdk_io_math.LegacyDec `protobuf:"bytes,5,opt,name=end_price,json=endPrice,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"end_price"`
+	// realized_yield is the share price change over the measured window: end_price /
+	// start_price - 1.
+	RealizedYield cosmossdk_io_math.LegacyDec `protobuf:"bytes,6,opt,name=realized_yield,json=realizedYield,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"realized_yield"`
+	// annualized_return is realized_yield scaled linearly to 365 days by the measured window's
+	// length, without compounding.
+	AnnualizedReturn cosmossdk_io_math.LegacyDec `protobuf:"bytes,7,opt,name=annualized_return,json=annualizedReturn,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"annualized_return"`
+	// max_drawdown is the largest fall in share price from a running peak within the measured
+	// window, as a fraction of that peak.
+	MaxDrawdown cosmossdk_io_math.LegacyDec `protobuf:"bytes,8,opt,name=max_drawdown,json=maxDrawdown,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"max_drawdown"`
+}
+
+func (m *VaultPerformanceWindow) Reset()         { *m = VaultPerformanceWindow{} }
+func (m *VaultPerformanceWindow) String() string { return proto.CompactTextString(m) }
+func (*VaultPerformanceWindow) ProtoMessage()    {}
+func (*VaultPerformanceWindow) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a1276ddd190bfbca, []int{38}
+}
+func (m *VaultPerformanceWindow) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *VaultPerformanceWindow) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_VaultPerformanceWindow.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *VaultPerformanceWindow) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VaultPerformanceWindow.Merge(m, src)
+}
+func (m *VaultPerformanceWindow) XXX_Size() int {
+	return m.Size()
+}
+func (m *VaultPerformanceWindow) XXX_DiscardUnknown() {
+	xxx_messageInfo_VaultPerformanceWindow.DiscardUnknown(m)
 }
 
-func (m *PendingSwapOutWithTimeout) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_VaultPerformanceWindow proto.InternalMessageInfo
+
+func (m *VaultPerformanceWindow) GetWindowDays() uint32 {
+	if m != nil {
+		return m.WindowDays
+	}
+	return 0
 }
 
-func (m *PendingSwapOutWithTimeout) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	n5, err5 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.Timeout, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Timeout):])
-	if err5 != nil {
-		return 0, err5
+func (m *VaultPerformanceWindow) GetStartTime() time.Time {
+	if m != nil {
+		return m.StartTime
 	}
-	i -= n5
-	i = encodeVarintQuery(dAtA, i, uint64(n5))
-	i--
-	dAtA[i] = 0x1a
-	{
-		size, err := m.PendingSwapOut.MarshalToSizedBuffer(dAtA[:i])
+	return time.Time{}
+}
+
+func (m *VaultPerformanceWindow) GetComplete() bool {
+	if m != nil {
+		return m.Complete
+	}
+	return false
+}
+
+// QueryVaultCompositionRequest is the request message for the Query/VaultComposition endpoint.
+type QueryVaultCompositionRequest struct {
+	// id is the bech32 address of the vault or the vault's share denom to query.
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (m *QueryVaultCompositionRequest) Reset()         { *m = QueryVaultCompositionRequest{} }
+func (m *QueryVaultCompositionRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryVaultCompositionRequest) ProtoMessage()    {}
+func (*QueryVaultCompositionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a1276ddd190bfbca, []int{39}
+}
+func (m *QueryVaultCompositionRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryVaultCompositionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryVaultCompositionRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0x12
-	if m.RequestId != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.RequestId))
-		i--
-		dAtA[i] = 0x8
+}
+func (m *QueryVaultCompositionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryVaultCompositionRequest.Merge(m, src)
+}
+func (m *QueryVaultCompositionRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryVaultCompositionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryVaultCompositionRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryVaultCompositionRequest proto.InternalMessageInfo
+
+func (m *QueryVaultCompositionRequest) GetId() string {
+	if m != nil {
+		return m.Id
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *QueryVaultsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+// QueryVaultCompositionResponse is the response message for the Query/VaultComposition endpoint.
+type QueryVaultCompositionResponse struct {
+	// total_value is the vault's live total value in its underlying asset, gross of fees and
+	// ignoring any pause snapshot.
+	TotalValue types.Coin `protobuf:"bytes,1,opt,name=total_value,json=totalValue,proto3" json:"total_value"`
+	// assets holds the weight of each valued denom the vault holds, in denom order. The
+	// underlying asset is included but is not subject to a limit.
+	Assets []AssetWeight `protobuf:"bytes,2,rep,name=assets,proto3" json:"assets"`
+	// non_underlying holds the combined weight of every non-underlying denom. Its denom is empty.
+	NonUnderlying AssetWeight `protobuf:"bytes,3,opt,name=non_underlying,json=nonUnderlying,proto3" json:"non_underlying"`
+	// The block height when the composition was computed.
+	Height int64 `protobuf:"varint,4,opt,name=height,proto3" json:"height,omitempty"`
+	// The UTC block time when the composition was computed.
+	Time time.Time `protobuf:"bytes,5,opt,name=time,proto3,stdtime" json:"time"`
+}
+
+func (m *QueryVaultCompositionResponse) Reset()         { *m = QueryVaultCompositionResponse{} }
+func (m *QueryVaultCompositionResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryVaultCompositionResponse) ProtoMessage()    {}
+func (*QueryVaultCompositionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a1276ddd190bfbca, []int{40}
+}
+func (m *QueryVaultCompositionResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryVaultCompositionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryVaultCompositionResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *QueryVaultCompositionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryVaultCompositionResponse.Merge(m, src)
+}
+func (m *QueryVaultCompositionResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryVaultCompositionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryVaultCompositionResponse.DiscardUnknown(m)
 }
 
-func (m *QueryVaultsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_QueryVaultCompositionResponse proto.InternalMessageInfo
+
+func (m *QueryVaultCompositionResponse) GetTotalValue() types.Coin {
+	if m != nil {
+		return m.TotalValue
+	}
+	return types.Coin{}
 }
 
-func (m *QueryVaultsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+func (m *QueryVaultCompositionResponse) GetAssets() []AssetWeight {
+	if m != nil {
+		return m.Assets
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *QueryVaultsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryVaultCompositionResponse) GetNonUnderlying() AssetWeight {
+	if m != nil {
+		return m.NonUnderlying
 	}
-	return dAtA[:n], nil
+	return AssetWeight{}
 }
 
-func (m *QueryVaultsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryVaultCompositionResponse) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
 }
 
-func (m *QueryVaultsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
+func (m *QueryVaultCompositionResponse) GetTime() time.Time {
+	if m != nil {
+		return m.Time
 	}
-	if len(m.Vaults) > 0 {
-		for iNdEx := len(m.Vaults) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Vaults[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+	return time.Time{}
+}
+
+// AssetWeight holds a vault holding's value as a share of total vault value and the
+// concentration limit that applies to it.
+type AssetWeight struct {
+	// denom is the held denom, or empty for the combined non-underlying holdings.
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	// value is the holding's value in the vault's underlying asset, after haircuts.
+	Value types.Coin `protobuf:"bytes,2,opt,name=value,proto3" json:"value"`
+	// weight_bips is the holding's share of total vault value in basis points.
+	WeightBips cosmossdk_io_math.LegacyDec `protobuf:"bytes,3,opt,name=weight_bips,json=weightBips,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"weight_bips"`
+	// limit_bips is the concentration limit that applies to the holding, or 0 when none does.
+	LimitBips uint32 `protobuf:"varint,4,opt,name=limit_bips,json=limitBips,proto3" json:"limit_bips,omitempty"`
+	// breached is true when weight_bips exceeds a non-zero limit_bips.
+	Breached bool `protobuf:"varint,5,opt,name=breached,proto3" json:"breached,omitempty"`
+}
+
+func (m *AssetWeight) Reset()         { *m = AssetWeight{} }
+func (m *AssetWeight) String() string { return proto.CompactTextString(m) }
+func (*AssetWeight) ProtoMessage()    {}
+func (*AssetWeight) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a1276ddd190bfbca, []int{41}
+}
+func (m *AssetWeight) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AssetWeight) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AssetWeight.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *AssetWeight) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AssetWeight.Merge(m, src)
+}
+func (m *AssetWeight) XXX_Size() int {
+	return m.Size()
+}
+func (m *AssetWeight) XXX_DiscardUnknown() {
+	xxx_messageInfo_AssetWeight.DiscardUnknown(m)
 }
 
-func (m *QueryVaultRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_AssetWeight proto.InternalMessageInfo
+
+func (m *AssetWeight) GetDenom() string {
+	if m != nil {
+		return m.Denom
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *QueryVaultRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *AssetWeight) GetValue() types.Coin {
+	if m != nil {
+		return m.Value
+	}
+	return types.Coin{}
 }
 
-func (m *QueryVaultRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Id) > 0 {
-		i -= len(m.Id)
-		copy(dAtA[i:], m.Id)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Id)))
-		i--
-		dAtA[i] = 0xa
+func (m *AssetWeight) GetLimitBips() uint32 {
+	if m != nil {
+		return m.LimitBips
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *QueryVaultResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *AssetWeight) GetBreached() bool {
+	if m != nil {
+		return m.Breached
 	}
-	return dAtA[:n], nil
+	return false
 }
 
-func (m *QueryVaultResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryVaultHoldingsRequest is the request message for the Query/VaultHoldings endpoint.
+type QueryVaultHoldingsRequest struct {
+	// id is the bech32 address of the vault or the vault's share denom to query.
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 }
 
-func (m *QueryVaultResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.TotalVaultValue.MarshalToSizedBuffer(dAtA[:i])
+func (m *QueryVaultHoldingsRequest) Reset()         { *m = QueryVaultHoldingsRequest{} }
+func (m *QueryVaultHoldingsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryVaultHoldingsRequest) ProtoMessage()    {}
+func (*QueryVaultHoldingsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a1276ddd190bfbca, []int{42}
+}
+func (m *QueryVaultHoldingsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryVaultHoldingsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryVaultHoldingsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0x22
-	{
-		size, err := m.Reserves.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x1a
-	{
-		size, err := m.Principal.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x12
-	{
-		size, err := m.Vault.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+}
+func (m *QueryVaultHoldingsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryVaultHoldingsRequest.Merge(m, src)
+}
+func (m *QueryVaultHoldingsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryVaultHoldingsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryVaultHoldingsRequest.DiscardUnknown(m)
 }
 
-func (m *QueryEstimateSwapInRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryVaultHoldingsRequest proto.InternalMessageInfo
+
+func (m *QueryVaultHoldingsRequest) GetId() string {
+	if m != nil {
+		return m.Id
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *QueryEstimateSwapInRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryVaultHoldingsResponse is the response message for the Query/VaultHoldings endpoint.
+type QueryVaultHoldingsResponse struct {
+	// total_value is the vault's live total value in its underlying asset: the sum of every
+	// holding's value. It equals the vault's TVV except while the vault is paused, when TVV
+	// is the pause snapshot.
+	TotalValue types.Coin `protobuf:"bytes,1,opt,name=total_value,json=totalValue,proto3" json:"total_value"`
+	// holdings lists each valued denom the vault holds, in denom order. The underlying asset
+	// is included at its identity price.
+	Holdings []VaultHolding `protobuf:"bytes,2,rep,name=holdings,proto3" json:"holdings"`
+	// unvalued holds the balances the vault holds that no price source values. They are left
+	// out of total vault value.
+	Unvalued github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=unvalued,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"unvalued"`
+	// The block height when the holdings were valued.
+	Height int64 `protobuf:"varint,4,opt,name=height,proto3" json:"height,omitempty"`
+	// The UTC block time when the holdings were valued.
+	Time time.Time `protobuf:"bytes,5,opt,name=time,proto3,stdtime" json:"time"`
+}
+
+func (m *QueryVaultHoldingsResponse) Reset()         { *m = QueryVaultHoldingsResponse{} }
+func (m *QueryVaultHoldingsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryVaultHoldingsResponse) ProtoMessage()    {}
+func (*QueryVaultHoldingsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a1276ddd190bfbca, []int{43}
+}
+func (m *QueryVaultHoldingsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (m *QueryEstimateSwapInRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Assets.MarshalToSizedBuffer(dAtA[:i])
+func (m *QueryVaultHoldingsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryVaultHoldingsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0x12
-	if len(m.VaultAddress) > 0 {
-		i -= len(m.VaultAddress)
-		copy(dAtA[i:], m.VaultAddress)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.VaultAddress)))
-		i--
-		dAtA[i] = 0xa
+}
+func (m *QueryVaultHoldingsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryVaultHoldingsResponse.Merge(m, src)
+}
+func (m *QueryVaultHoldingsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryVaultHoldingsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryVaultHoldingsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryVaultHoldingsResponse proto.InternalMessageInfo
+
+func (m *QueryVaultHoldingsResponse) GetTotalValue() types.Coin {
+	if m != nil {
+		return m.TotalValue
 	}
-	return len(dAtA) - i, nil
+	return types.Coin{}
 }
 
-func (m *QueryEstimateSwapInResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryVaultHoldingsResponse) GetHoldings() []VaultHolding {
+	if m != nil {
+		return m.Holdings
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryEstimateSwapInResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryVaultHoldingsResponse) GetUnvalued() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Unvalued
+	}
+	return nil
 }
 
-func (m *QueryEstimateSwapInResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	n14, err14 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.Time, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Time):])
-	if err14 != nil {
-		return 0, err14
+func (m *QueryVaultHoldingsResponse) GetHeight() int64 {
+	if m != nil {
+		return m.Height
 	}
-	i -= n14
-	i = encodeVarintQuery(dAtA, i, uint64(n14))
-	i--
-	dAtA[i] = 0x1a
-	if m.Height != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.Height))
-		i--
-		dAtA[i] = 0x10
+	return 0
+}
+
+func (m *QueryVaultHoldingsResponse) GetTime() time.Time {
+	if m != nil {
+		return m.Time
 	}
-	{
-		size, err := m.Assets.MarshalToSizedBuffer(dAtA[:i])
+	return time.Time{}
+}
+
+// VaultHolding is a single denom a vault holds across its principal marker and payment
+// escrow account, with the price it was valued at.
+type VaultHolding struct {
+	// balance is the vault's holding of the denom.
+	Balance types.Coin `protobuf:"bytes,1,opt,name=balance,proto3" json:"balance"`
+	// nav is the price the holding was valued at, as supplied by price_source. It is unset
+	// for the underlying asset, which counts at its identity price.
+	Nav *VaultNAV `protobuf:"bytes,2,opt,name=nav,proto3" json:"nav,omitempty"`
+	// price_source is the source in the vault's price-source chain that supplied nav. It is
+	// only meaningful when nav is set.
+	PriceSource PriceSourceType `protobuf:"varint,3,opt,name=price_source,json=priceSource,proto3,enum=provlabs.vault.v1.PriceSourceType" json:"price_source,omitempty"`
+	// age_blocks is the number of blocks since nav was last updated.
+	AgeBlocks int64 `protobuf:"varint,4,opt,name=age_blocks,json=ageBlocks,proto3" json:"age_blocks,omitempty"`
+	// age_seconds is the number of seconds since nav was last updated, or 0 when the source
+	// does not record an update time.
+	AgeSeconds int64 `protobuf:"varint,5,opt,name=age_seconds,json=ageSeconds,proto3" json:"age_seconds,omitempty"`
+	// haircut_bips is the valuation haircut the vault applies to the denom.
+	HaircutBips uint32 `protobuf:"varint,6,opt,name=haircut_bips,json=haircutBips,proto3" json:"haircut_bips,omitempty"`
+	// value is the holding's value in the vault's underlying asset, after the haircut.
+	Value types.Coin `protobuf:"bytes,7,opt,name=value,proto3" json:"value"`
+	// weight_bips is the holding's share of total_value in basis points.
+	WeightBips cosmossdk_io_math.LegacyDec `protobuf:"bytes,8,opt,name=weight_bips,json=weightBips,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"weight_bips"`
+}
+
+func (m *VaultHolding) Reset()         { *m = VaultHolding{} }
+func (m *VaultHolding) String() string { return proto.CompactTextString(m) }
+func (*VaultHolding) ProtoMessage()    {}
+func (*VaultHolding) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a1276ddd190bfbca, []int{44}
+}
+func (m *VaultHolding) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *VaultHolding) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_VaultHolding.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+}
+func (m *VaultHolding) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VaultHolding.Merge(m, src)
+}
+func (m *VaultHolding) XXX_Size() int {
+	return m.Size()
+}
+func (m *VaultHolding) XXX_DiscardUnknown() {
+	xxx_messageInfo_VaultHolding.DiscardUnknown(m)
 }
 
-func (m *QueryEstimateSwapOutRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_VaultHolding proto.InternalMessageInfo
+
+func (m *VaultHolding) GetBalance() types.Coin {
+	if m != nil {
+		return m.Balance
 	}
-	return dAtA[:n], nil
+	return types.Coin{}
 }
 
-func (m *QueryEstimateSwapOutRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *VaultHolding) GetNav() *VaultNAV {
+	if m != nil {
+		return m.Nav
+	}
+	return nil
 }
 
-func (m *QueryEstimateSwapOutRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.RedeemDenom) > 0 {
-		i -= len(m.RedeemDenom)
-		copy(dAtA[i:], m.RedeemDenom)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.RedeemDenom)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.Shares) > 0 {
-		i -= len(m.Shares)
-		copy(dAtA[i:], m.Shares)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Shares)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.VaultAddress) > 0 {
-		i -= len(m.VaultAddress)
-		copy(dAtA[i:], m.VaultAddress)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.VaultAddress)))
-		i--
-		dAtA[i] = 0xa
+func (m *VaultHolding) GetPriceSource() PriceSourceType {
+	if m != nil {
+		return m.PriceSource
 	}
-	return len(dAtA) - i, nil
+	return PriceSourceType_PRICE_SOURCE_TYPE_INTERNAL_NAV
 }
 
-func (m *QueryEstimateSwapOutResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *VaultHolding) GetAgeBlocks() int64 {
+	if m != nil {
+		return m.AgeBlocks
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *QueryEstimateSwapOutResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *VaultHolding) GetAgeSeconds() int64 {
+	if m != nil {
+		return m.AgeSeconds
+	}
+	return 0
 }
 
-func (m *QueryEstimateSwapOutResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	n16, err16 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.Time, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Time):])
-	if err16 != nil {
-		return 0, err16
-	}
-	i -= n16
-	i = encodeVarintQuery(dAtA, i, uint64(n16))
-	i--
-	dAtA[i] = 0x1a
-	if m.Height != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.Height))
-		i--
-		dAtA[i] = 0x10
-	}
-	{
-		size, err := m.Assets.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+func (m *VaultHolding) GetHaircutBips() uint32 {
+	if m != nil {
+		return m.HaircutBips
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *VaultHolding) GetValue() types.Coin {
+	if m != nil {
+		return m.Value
 	}
-	return dAtA[:n], nil
+	return types.Coin{}
 }
 
-func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryScheduledActionsRequest is the request message for the Query/ScheduledActions endpoint.
+type QueryScheduledActionsRequest struct {
+	// id is the bech32 address of the vault or the vault's share denom to query.
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *QueryScheduledActionsRequest) Reset()         { *m = QueryScheduledActionsRequest{} }
+func (m *QueryScheduledActionsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryScheduledActionsRequest) ProtoMessage()    {}
+func (*QueryScheduledActionsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a1276ddd190bfbca, []int{45}
 }
-
-func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
-}
-
-func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryScheduledActionsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+func (m *QueryScheduledActionsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryScheduledActionsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
 }
-
-func (m *QueryVaultNavsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *QueryScheduledActionsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryScheduledActionsRequest.Merge(m, src)
 }
-
-func (m *QueryVaultNavsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryScheduledActionsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryScheduledActionsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryScheduledActionsRequest.DiscardUnknown(m)
 }
 
-func (m *QueryVaultNavsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Id) > 0 {
-		i -= len(m.Id)
-		copy(dAtA[i:], m.Id)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Id)))
-		i--
-		dAtA[i] = 0xa
+var xxx_messageInfo_QueryScheduledActionsRequest proto.InternalMessageInfo
+
+func (m *QueryScheduledActionsRequest) GetId() string {
+	if m != nil {
+		return m.Id
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *QueryVaultNavsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryScheduledActionsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryVaultNavsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryScheduledActionsResponse is the response message for the Query/ScheduledActions endpoint.
+type QueryScheduledActionsResponse struct {
+	// scheduled_actions is the list of the vault's scheduled actions.
+	ScheduledActions []ScheduledAction `protobuf:"bytes,1,rep,name=scheduled_actions,json=scheduledActions,proto3" json:"scheduled_actions"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryVaultNavsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+func (m *QueryScheduledActionsResponse) Reset()         { *m = QueryScheduledActionsResponse{} }
+func (m *QueryScheduledActionsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryScheduledActionsResponse) ProtoMessage()    {}
+func (*QueryScheduledActionsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a1276ddd190bfbca, []int{46}
+}
+func (m *QueryScheduledActionsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryScheduledActionsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryScheduledActionsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x12
+		return b[:n], nil
 	}
-	if len(m.Navs) > 0 {
-		for iNdEx := len(m.Navs) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Navs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+}
+func (m *QueryScheduledActionsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryScheduledActionsResponse.Merge(m, src)
+}
+func (m *QueryScheduledActionsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryScheduledActionsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryScheduledActionsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryScheduledActionsResponse proto.InternalMessageInfo
+
+func (m *QueryScheduledActionsResponse) GetScheduledActions() []ScheduledAction {
+	if m != nil {
+		return m.ScheduledActions
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *QueryNavValueRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryScheduledActionsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryNavValueRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryInvestorEligibilityRequest is the request message for the Query/InvestorEligibility endpoint.
+type QueryInvestorEligibilityRequest struct {
+	// id is the bech32 address of the vault or the vault's share denom to query.
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// address is the bech32 address of the investor to check.
+	Address string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
 }
 
-func (m *QueryNavValueRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Denom) > 0 {
-		i -= len(m.Denom)
-		copy(dAtA[i:], m.Denom)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
-		i--
-		dAtA[i] = 0x12
+func (m *QueryInvestorEligibilityRequest) Reset()         { *m = QueryInvestorEligibilityRequest{} }
+func (m *QueryInvestorEligibilityRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryInvestorEligibilityRequest) ProtoMessage()    {}
+func (*QueryInvestorEligibilityRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a1276ddd190bfbca, []int{47}
+}
+func (m *QueryInvestorEligibilityRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryInvestorEligibilityRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryInvestorEligibilityRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if len(m.Id) > 0 {
-		i -= len(m.Id)
-		copy(dAtA[i:], m.Id)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Id)))
-		i--
-		dAtA[i] = 0xa
+}
+func (m *QueryInvestorEligibilityRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryInvestorEligibilityRequest.Merge(m, src)
+}
+func (m *QueryInvestorEligibilityRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryInvestorEligibilityRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryInvestorEligibilityRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryInvestorEligibilityRequest proto.InternalMessageInfo
+
+func (m *QueryInvestorEligibilityRequest) GetId() string {
+	if m != nil {
+		return m.Id
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *QueryNavValueResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryInvestorEligibilityRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *QueryNavValueResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryInvestorEligibilityResponse is the response message for the Query/InvestorEligibility endpoint.
+type QueryInvestorEligibilityResponse struct {
+	// eligible is whether the address may subscribe to and hold the vault's shares.
+	Eligible bool `protobuf:"varint,1,opt,name=eligible,proto3" json:"eligible,omitempty"`
+	// allowlisted is whether the address is on the vault's allowlist.
+	Allowlisted bool `protobuf:"varint,2,opt,name=allowlisted,proto3" json:"allowlisted,omitempty"`
+	// missing_attributes are the required attributes the address does not hold. It is empty
+	// when the policy is disabled or requires no attributes.
+	MissingAttributes []string `protobuf:"bytes,3,rep,name=missing_attributes,json=missingAttributes,proto3" json:"missing_attributes,omitempty"`
 }
 
-func (m *QueryNavValueResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Nav.MarshalToSizedBuffer(dAtA[:i])
+func (m *QueryInvestorEligibilityResponse) Reset()         { *m = QueryInvestorEligibilityResponse{} }
+func (m *QueryInvestorEligibilityResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryInvestorEligibilityResponse) ProtoMessage()    {}
+func (*QueryInvestorEligibilityResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a1276ddd190bfbca, []int{48}
+}
+func (m *QueryInvestorEligibilityResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryInvestorEligibilityResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryInvestorEligibilityResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+}
+func (m *QueryInvestorEligibilityResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryInvestorEligibilityResponse.Merge(m, src)
+}
+func (m *QueryInvestorEligibilityResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryInvestorEligibilityResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryInvestorEligibilityResponse.DiscardUnknown(m)
 }
 
-func (m *Payment) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryInvestorEligibilityResponse proto.InternalMessageInfo
+
+func (m *QueryInvestorEligibilityResponse) GetEligible() bool {
+	if m != nil {
+		return m.Eligible
 	}
-	return dAtA[:n], nil
+	return false
 }
 
-func (m *Payment) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryInvestorEligibilityResponse) GetAllowlisted() bool {
+	if m != nil {
+		return m.Allowlisted
+	}
+	return false
 }
 
-func (m *Payment) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.ExternalId) > 0 {
-		i -= len(m.ExternalId)
-		copy(dAtA[i:], m.ExternalId)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.ExternalId)))
-		i--
-		dAtA[i] = 0x2a
+func (m *QueryInvestorEligibilityResponse) GetMissingAttributes() []string {
+	if m != nil {
+		return m.MissingAttributes
 	}
-	if len(m.TargetAmount) > 0 {
-		for iNdEx := len(m.TargetAmount) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.TargetAmount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x22
+	return nil
+}
+
+// QueryCircuitBreakerRequest is the request message for the Query/CircuitBreaker endpoint.
+type QueryCircuitBreakerRequest struct {
+}
+
+func (m *QueryCircuitBreakerRequest) Reset()         { *m = QueryCircuitBreakerRequest{} }
+func (m *QueryCircuitBreakerRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryCircuitBreakerRequest) ProtoMessage()    {}
+func (*QueryCircuitBreakerRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a1276ddd190bfbca, []int{49}
+}
+func (m *QueryCircuitBreakerRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryCircuitBreakerRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryCircuitBreakerRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if len(m.Target) > 0 {
-		i -= len(m.Target)
-		copy(dAtA[i:], m.Target)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Target)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.SourceAmount) > 0 {
-		for iNdEx := len(m.SourceAmount) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.SourceAmount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x12
+}
+func (m *QueryCircuitBreakerRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryCircuitBreakerRequest.Merge(m, src)
+}
+func (m *QueryCircuitBreakerRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryCircuitBreakerRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryCircuitBreakerRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryCircuitBreakerRequest proto.InternalMessageInfo
+
+// QueryCircuitBreakerResponse is the response message for the Query/CircuitBreaker endpoint.
+type QueryCircuitBreakerResponse struct {
+	// circuit_breaker is the set of operations currently halted across every vault.
+	CircuitBreaker CircuitBreaker `protobuf:"bytes,1,opt,name=circuit_breaker,json=circuitBreaker,proto3" json:"circuit_breaker"`
+	// guardian is the address that may change the circuit breaker alongside governance. Empty
+	// when only governance may.
+	Guardian string `protobuf:"bytes,2,opt,name=guardian,proto3" json:"guardian,omitempty"`
+}
+
+func (m *QueryCircuitBreakerResponse) Reset()         { *m = QueryCircuitBreakerResponse{} }
+func (m *QueryCircuitBreakerResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryCircuitBreakerResponse) ProtoMessage()    {}
+func (*QueryCircuitBreakerResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a1276ddd190bfbca, []int{50}
+}
+func (m *QueryCircuitBreakerResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryCircuitBreakerResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryCircuitBreakerResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if len(m.Source) > 0 {
-		i -= len(m.Source)
-		copy(dAtA[i:], m.Source)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Source)))
-		i--
-		dAtA[i] = 0xa
+}
+func (m *QueryCircuitBreakerResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryCircuitBreakerResponse.Merge(m, src)
+}
+func (m *QueryCircuitBreakerResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryCircuitBreakerResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryCircuitBreakerResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryCircuitBreakerResponse proto.InternalMessageInfo
+
+func (m *QueryCircuitBreakerResponse) GetCircuitBreaker() CircuitBreaker {
+	if m != nil {
+		return m.CircuitBreaker
 	}
-	return len(dAtA) - i, nil
+	return CircuitBreaker{}
 }
 
-func (m *QueryVaultPaymentRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryCircuitBreakerResponse) GetGuardian() string {
+	if m != nil {
+		return m.Guardian
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *QueryVaultPaymentRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func init() {
+	proto.RegisterType((*QueryVaultPendingSwapOutsRequest)(nil), "provlabs.vault.v1.QueryVaultPendingSwapOutsRequest")
+	proto.RegisterType((*QueryVaultPendingSwapOutsResponse)(nil), "provlabs.vault.v1.QueryVaultPendingSwapOutsResponse")
+	proto.RegisterType((*QueryPendingSwapOutsRequest)(nil), "provlabs.vault.v1.QueryPendingSwapOutsRequest")
+	proto.RegisterType((*QueryPendingSwapOutsResponse)(nil), "provlabs.vault.v1.QueryPendingSwapOutsResponse")
+	proto.RegisterType((*PendingSwapOutWithTimeout)(nil), "provlabs.vault.v1.PendingSwapOutWithTimeout")
+	proto.RegisterType((*QueryVaultsRequest)(nil), "provlabs.vault.v1.QueryVaultsRequest")
+	proto.RegisterType((*QueryVaultsResponse)(nil), "provlabs.vault.v1.QueryVaultsResponse")
+	proto.RegisterType((*QueryVaultRequest)(nil), "provlabs.vault.v1.QueryVaultRequest")
+	proto.RegisterType((*QueryVaultResponse)(nil), "provlabs.vault.v1.QueryVaultResponse")
+	proto.RegisterType((*LiquidityBuffer)(nil), "provlabs.vault.v1.LiquidityBuffer")
+	proto.RegisterType((*QueryEstimateSwapInRequest)(nil), "provlabs.vault.v1.QueryEstimateSwapInRequest")
+	proto.RegisterType((*QueryEstimateSwapInResponse)(nil), "provlabs.vault.v1.QueryEstimateSwapInResponse")
+	proto.RegisterType((*QueryEstimateSwapOutRequest)(nil), "provlabs.vault.v1.QueryEstimateSwapOutRequest")
+	proto.RegisterType((*QueryEstimateSwapOutResponse)(nil), "provlabs.vault.v1.QueryEstimateSwapOutResponse")
+	proto.RegisterType((*QueryParamsRequest)(nil), "provlabs.vault.v1.QueryParamsRequest")
+	proto.RegisterType((*QueryParamsResponse)(nil), "provlabs.vault.v1.QueryParamsResponse")
+	proto.RegisterType((*QueryVaultNavsRequest)(nil), "provlabs.vault.v1.QueryVaultNavsRequest")
+	proto.RegisterType((*QueryVaultNavsResponse)(nil), "provlabs.vault.v1.QueryVaultNavsResponse")
+	proto.RegisterType((*QueryNavValueRequest)(nil), "provlabs.vault.v1.QueryNavValueRequest")
+	proto.RegisterType((*QueryNavValueResponse)(nil), "provlabs.vault.v1.QueryNavValueResponse")
+	proto.RegisterType((*QueryPendingNAVProposalsRequest)(nil), "provlabs.vault.v1.QueryPendingNAVProposalsRequest")
+	proto.RegisterType((*QueryPendingNAVProposalsResponse)(nil), "provlabs.vault.v1.QueryPendingNAVProposalsResponse")
+	proto.RegisterType((*QueryNAVSourcesRequest)(nil), "provlabs.vault.v1.QueryNAVSourcesRequest")
+	proto.RegisterType((*QueryNAVSourcesResponse)(nil), "provlabs.vault.v1.QueryNAVSourcesResponse")
+	proto.RegisterType((*NAVSourceContribution)(nil), "provlabs.vault.v1.NAVSourceContribution")
+	proto.RegisterType((*QueryNavHistoryRequest)(nil), "provlabs.vault.v1.QueryNavHistoryRequest")
+	proto.RegisterType((*QueryNavHistoryResponse)(nil), "provlabs.vault.v1.QueryNavHistoryResponse")
+	proto.RegisterType((*Payment)(nil), "provlabs.vault.v1.Payment")
+	proto.RegisterType((*QueryVaultPaymentRequest)(nil), "provlabs.vault.v1.QueryVaultPaymentRequest")
+	proto.RegisterType((*QueryVaultPaymentResponse)(nil), "provlabs.vault.v1.QueryVaultPaymentResponse")
+	proto.RegisterType((*QueryVaultPaymentsRequest)(nil), "provlabs.vault.v1.QueryVaultPaymentsRequest")
+	proto.RegisterType((*QueryVaultPaymentsResponse)(nil), "provlabs.vault.v1.QueryVaultPaymentsResponse")
+	proto.RegisterType((*QuerySharePriceRequest)(nil), "provlabs.vault.v1.QuerySharePriceRequest")
+	proto.RegisterType((*QuerySharePriceResponse)(nil), "provlabs.vault.v1.QuerySharePriceResponse")
+	proto.RegisterType((*QueryVaultTWAPRequest)(nil), "provlabs.vault.v1.QueryVaultTWAPRequest")
+	proto.RegisterType((*QueryVaultTWAPResponse)(nil), "provlabs.vault.v1.QueryVaultTWAPResponse")
+	proto.RegisterType((*QueryVaultPerformanceRequest)(nil), "provlabs.vault.v1.QueryVaultPerformanceRequest")
+	proto.RegisterType((*QueryVaultPerformanceResponse)(nil), "provlabs.vault.v1.QueryVaultPerformanceResponse")
+	proto.RegisterType((*VaultPerformanceWindow)(nil), "provlabs.vault.v1.VaultPerformanceWindow")
+	proto.RegisterType((*QueryVaultCompositionRequest)(nil), "provlabs.vault.v1.QueryVaultCompositionRequest")
+	proto.RegisterType((*QueryVaultCompositionResponse)(nil), "provlabs.vault.v1.QueryVaultCompositionResponse")
+	proto.RegisterType((*AssetWeight)(nil), "provlabs.vault.v1.AssetWeight")
+	proto.RegisterType((*QueryVaultHoldingsRequest)(nil), "provlabs.vault.v1.QueryVaultHoldingsRequest")
+	proto.RegisterType((*QueryVaultHoldingsResponse)(nil), "provlabs.vault.v1.QueryVaultHoldingsResponse")
+	proto.RegisterType((*VaultHolding)(nil), "provlabs.vault.v1.VaultHolding")
+	proto.RegisterType((*QueryScheduledActionsRequest)(nil), "provlabs.vault.v1.QueryScheduledActionsRequest")
+	proto.RegisterType((*QueryScheduledActionsResponse)(nil), "provlabs.vault.v1.QueryScheduledActionsResponse")
+	proto.RegisterType((*QueryInvestorEligibilityRequest)(nil), "provlabs.vault.v1.QueryInvestorEligibilityRequest")
+	proto.RegisterType((*QueryInvestorEligibilityResponse)(nil), "provlabs.vault.v1.QueryInvestorEligibilityResponse")
+	proto.RegisterType((*QueryCircuitBreakerRequest)(nil), "provlabs.vault.v1.QueryCircuitBreakerRequest")
+	proto.RegisterType((*QueryCircuitBreakerResponse)(nil), "provlabs.vault.v1.QueryCircuitBreakerResponse")
 }
 
-func (m *QueryVaultPaymentRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.ExternalId) > 0 {
-		i -= len(m.ExternalId)
-		copy(dAtA[i:], m.ExternalId)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.ExternalId)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.Source) > 0 {
-		i -= len(m.Source)
-		copy(dAtA[i:], m.Source)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Source)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Id) > 0 {
-		i -= len(m.Id)
-		copy(dAtA[i:], m.Id)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Id)))
-		i--
-		dAtA[i] = 0xa
+func init() { proto.RegisterFile("provlabs/vault/v1/query.proto", fileDescriptor_a1276ddd190bfbca) }
+
+var fileDescriptor_a1276ddd190bfbca = []byte{
+	// 3208 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x5b, 0x5d, 0x6c, 0x1c, 0x57,
+	0x15, 0xce, 0xec, 0xae, 0xed, 0xdd, 0xe3, 0xd8, 0xb1, 0x6f, 0x9c, 0x74, 0xb3, 0x89, 0x7f, 0x32,
+	0x21, 0x8d, 0xf3, 0xe3, 0xdd, 0x26, 0x6e, 0x55, 0xd2, 0x5f, 0xd6, 0x4e, 0x68, 0xa2, 0x56, 0xae,
+	0xbb, 0x76, 0x13, 0x40, 0xa8, 0xab, 0xeb, 0x9d, 0x9b, 0xf5, 0x55, 0x66, 0x67, 0xc6, 0xf3, 0xb3,
+	0x8e, 0x5b, 0xe5, 0x01, 0x84, 0xe0, 0x01, 0x04, 0x15, 0x54, 0x42, 0x3c, 0xf0, 0x23, 0x21, 0x2a,
+	0x51, 0x04, 0x48, 0x50, 0xf1, 0x08, 0x8f, 0xf4, 0x01, 0x50, 0x55, 0x5e, 0x10, 0x3f, 0x2d, 0x6a,
+	0x79, 0xe1, 0xb5, 0xaf, 0xbc, 0xa0, 0xb9, 0x3f, 0x33, 0xb3, 0xb3, 0x33, 0xde, 0x9f, 0x3a, 0x12,
+	0x3c, 0xc5, 0x73, 0xef, 0x39, 0xe7, 0x7e, 0xe7, 0xdc, 0x73, 0xee, 0x3d, 0xe7, 0xdc, 0x0d, 0xcc,
+	0x5a, 0xb6, 0xd9, 0xd6, 0xf1, 0x96, 0x53, 0x69, 0x63, 0x4f, 0x77, 0x2b, 0xed, 0xcb, 0x95, 0x1d,
+	0x8f, 0xd8, 0x7b, 0x65, 0xcb, 0x36, 0x5d, 0x13, 0x4d, 0xcb, 0xe9, 0x32, 0x9b, 0x2e, 0xb7, 0x2f,
+	0x97, 0x2e, 0x34, 0x4c, 0xa7, 0x65, 0x3a, 0x95, 0x2d, 0xec, 0x10, 0x4e, 0x5b, 0x69, 0x5f, 0xde,
+	0x22, 0x2e, 0xbe, 0x5c, 0xb1, 0x70, 0x93, 0x1a, 0xd8, 0xa5, 0xa6, 0xc1, 0xd9, 0x4b, 0x73, 0x51,
+	0x5a, 0x49, 0xd5, 0x30, 0xa9, 0x9c, 0x3f, 0xc1, 0xe7, 0xeb, 0xec, 0xab, 0xc2, 0x3f, 0xc4, 0xd4,
+	0x4c, 0xd3, 0x6c, 0x9a, 0x7c, 0xdc, 0xff, 0x4b, 0x8c, 0x9e, 0x6a, 0x9a, 0x66, 0x53, 0x27, 0x15,
+	0x6c, 0xd1, 0x0a, 0x36, 0x0c, 0xd3, 0x65, 0xab, 0x49, 0x9e, 0x79, 0x31, 0xcb, 0xbe, 0xb6, 0xbc,
+	0x3b, 0x15, 0x97, 0xb6, 0x88, 0xe3, 0xe2, 0x96, 0x25, 0xf1, 0x74, 0x6b, 0x6b, 0x61, 0x1b, 0xb7,
+	0xa4, 0x80, 0x04, 0x6b, 0x70, 0xbd, 0xd9, 0xb4, 0xfa, 0x2a, 0x2c, 0xbc, 0xe4, 0x2b, 0x7c, 0xcb,
+	0x1f, 0x5b, 0x27, 0x86, 0x46, 0x8d, 0xe6, 0xc6, 0x2e, 0xb6, 0x5e, 0xf4, 0x5c, 0xa7, 0x46, 0x76,
+	0x3c, 0xe2, 0xb8, 0x68, 0x12, 0x32, 0x54, 0x2b, 0x2a, 0x0b, 0xca, 0x62, 0xa1, 0x96, 0xa1, 0x1a,
+	0xfa, 0x2c, 0x40, 0x68, 0x96, 0x62, 0x66, 0x41, 0x59, 0x1c, 0xbf, 0xf2, 0x70, 0x59, 0xa8, 0xea,
+	0xdb, 0xa5, 0xcc, 0xed, 0x2d, 0xac, 0x53, 0x5e, 0xc7, 0x4d, 0x22, 0x64, 0xd5, 0x22, 0x9c, 0xea,
+	0x1f, 0x14, 0x38, 0xbd, 0xcf, 0xe2, 0x8e, 0x65, 0x1a, 0x0e, 0x41, 0xaf, 0xc0, 0xb4, 0xc5, 0xa7,
+	0xea, 0xce, 0x2e, 0xb6, 0xea, 0xa6, 0xe7, 0x3a, 0x45, 0x65, 0x21, 0xbb, 0x38, 0x7e, 0xe5, 0x52,
+	0xb9, 0x6b, 0x2f, 0xcb, 0x9d, 0x62, 0x6e, 0x53, 0x77, 0x7b, 0x93, 0xb6, 0x88, 0xe9, 0xb9, 0x2b,
+	0xb9, 0x77, 0xde, 0x9f, 0x3f, 0x54, 0x3b, 0x62, 0x75, 0xae, 0x83, 0x9e, 0x4b, 0xd0, 0xe6, 0x5c,
+	0x4f, 0x6d, 0x38, 0xb8, 0x0e, 0x75, 0x08, 0x9c, 0x64, 0xda, 0xa4, 0x58, 0xb1, 0xd3, 0x6a, 0xca,
+	0xd0, 0x56, 0xfb, 0xbd, 0x02, 0xa7, 0x92, 0xd7, 0xf9, 0x7f, 0x33, 0xd8, 0x1f, 0x15, 0x38, 0x91,
+	0xba, 0x3a, 0x9a, 0x05, 0xb0, 0xb9, 0xfa, 0x75, 0xe1, 0x7d, 0xb9, 0x5a, 0x41, 0x8c, 0xdc, 0xd4,
+	0xd0, 0x4b, 0x30, 0x15, 0xd7, 0x52, 0x60, 0x39, 0xdd, 0x53, 0x49, 0xa1, 0xd9, 0x64, 0xa7, 0x66,
+	0xe8, 0x19, 0x18, 0x73, 0xf9, 0xe2, 0xc5, 0x2c, 0x93, 0x54, 0x2a, 0xf3, 0xe8, 0x2b, 0xcb, 0xe8,
+	0x2b, 0x6f, 0xca, 0xe8, 0x5b, 0xc9, 0xfb, 0x22, 0x5e, 0xff, 0x60, 0x5e, 0xa9, 0x49, 0x26, 0xf5,
+	0x8b, 0x80, 0x42, 0x77, 0x3e, 0xf0, 0x7d, 0xff, 0xbe, 0x02, 0x47, 0x3b, 0xc4, 0x8b, 0xed, 0x7e,
+	0x1a, 0x46, 0x99, 0x9a, 0x72, 0x8f, 0xe7, 0x13, 0xd4, 0x67, 0x2c, 0xd5, 0x46, 0xc3, 0xf4, 0x0c,
+	0xa9, 0xbc, 0x60, 0x3a, 0xb8, 0xdd, 0x3c, 0x03, 0xd3, 0x21, 0xbc, 0x94, 0xa3, 0x43, 0xfd, 0x4f,
+	0x36, 0x6a, 0xa3, 0x40, 0x87, 0x27, 0x61, 0x84, 0xc1, 0x11, 0xe6, 0xe9, 0x53, 0x05, 0xce, 0x83,
+	0xae, 0x43, 0xc1, 0xb2, 0xa9, 0xd1, 0xa0, 0x16, 0xd6, 0xf7, 0x71, 0x01, 0xc9, 0x8b, 0x75, 0x6c,
+	0x34, 0x88, 0x10, 0x11, 0x72, 0xa2, 0x55, 0xc8, 0xdb, 0xc4, 0x21, 0x76, 0x9b, 0x38, 0x62, 0xfb,
+	0xfb, 0x96, 0x12, 0x30, 0xa2, 0xe7, 0x61, 0xda, 0x35, 0x5d, 0xac, 0xd7, 0x19, 0x43, 0xbd, 0x8d,
+	0x75, 0x8f, 0x14, 0x73, 0x4c, 0xda, 0x89, 0x0e, 0xa3, 0x4a, 0x73, 0xae, 0x9a, 0xd4, 0x90, 0x81,
+	0xc6, 0x38, 0x99, 0x9e, 0xb7, 0x7c, 0x3e, 0xb4, 0x08, 0x53, 0xa6, 0xae, 0xf9, 0x01, 0x60, 0xe0,
+	0x76, 0x5d, 0x23, 0x86, 0xd9, 0x2a, 0x8e, 0x30, 0x53, 0x4e, 0xf2, 0xf1, 0x35, 0xdc, 0xbe, 0xe6,
+	0x8f, 0xa2, 0x65, 0x38, 0x1e, 0xa1, 0xc4, 0x4d, 0x52, 0x77, 0x48, 0xc3, 0x34, 0x34, 0xa7, 0x38,
+	0xca, 0xe2, 0xe6, 0x68, 0x40, 0x5f, 0x6d, 0x92, 0x0d, 0x3e, 0x85, 0x4e, 0x42, 0xc1, 0xa7, 0x76,
+	0x5c, 0xac, 0x93, 0xe2, 0xd8, 0x82, 0xb2, 0x98, 0xaf, 0xe5, 0x0d, 0xdc, 0xde, 0xf0, 0xbf, 0xd1,
+	0x06, 0x4c, 0xe9, 0x74, 0xc7, 0xa3, 0x1a, 0x75, 0xf7, 0xea, 0x5b, 0xde, 0x9d, 0x3b, 0xc4, 0x2e,
+	0xe6, 0x99, 0x1e, 0x6a, 0x82, 0x55, 0x5e, 0x90, 0xa4, 0x2b, 0x8c, 0x52, 0x2a, 0xa4, 0x77, 0x0e,
+	0xab, 0xef, 0x64, 0xe0, 0x48, 0x8c, 0x14, 0x5d, 0x02, 0xd4, 0xa2, 0x46, 0x3d, 0xb2, 0x18, 0xb5,
+	0x1c, 0xe6, 0x07, 0x13, 0xb5, 0xa9, 0x16, 0x35, 0x42, 0x7a, 0x6a, 0x39, 0xe8, 0x71, 0x18, 0xe5,
+	0x94, 0x62, 0xa3, 0x7b, 0x1a, 0x55, 0x90, 0xa3, 0x1a, 0xcc, 0x88, 0x4d, 0xd2, 0xea, 0x36, 0xd1,
+	0x48, 0xcb, 0x62, 0xb7, 0xac, 0xd8, 0xe9, 0x9e, 0x62, 0x8e, 0x4a, 0xe6, 0x5a, 0xc8, 0x8b, 0x9e,
+	0xf4, 0x3d, 0x66, 0xc7, 0xa3, 0x36, 0xd1, 0xfa, 0xdd, 0xe3, 0x80, 0x01, 0x3d, 0x0d, 0x05, 0x67,
+	0xdb, 0xb4, 0xdd, 0x3b, 0x58, 0xd7, 0xd9, 0xae, 0xf6, 0xc1, 0x1d, 0x72, 0xa8, 0xaf, 0x42, 0x89,
+	0xc5, 0xd1, 0x75, 0xc7, 0xa5, 0x2d, 0xec, 0x12, 0xff, 0x0c, 0xbb, 0x69, 0xc8, 0xb0, 0x3b, 0x03,
+	0x13, 0xdc, 0x01, 0xb1, 0xa6, 0xd9, 0xc4, 0x71, 0x44, 0x04, 0x1e, 0x66, 0x83, 0x55, 0x3e, 0xe6,
+	0xdb, 0x12, 0x3b, 0x0e, 0x71, 0x9d, 0xbe, 0x6d, 0xc9, 0xc9, 0xd5, 0x8f, 0x15, 0x71, 0xd3, 0xc5,
+	0x17, 0x17, 0xd1, 0x1c, 0x0a, 0x56, 0x06, 0x12, 0x8c, 0x8e, 0xc3, 0xe8, 0x36, 0xa1, 0xcd, 0x6d,
+	0x7e, 0x92, 0x67, 0x6b, 0xe2, 0x0b, 0x7d, 0x1a, 0x72, 0xfe, 0x19, 0x3b, 0xd0, 0xa9, 0xcc, 0x38,
+	0xd0, 0x0d, 0x40, 0x36, 0x69, 0x61, 0x6a, 0xf8, 0xf7, 0x44, 0x03, 0x5b, 0xb8, 0x41, 0xdd, 0xbd,
+	0x9e, 0x9b, 0x55, 0x9b, 0x0e, 0x98, 0x56, 0x05, 0x8f, 0xfa, 0xa5, 0x24, 0xa5, 0x5f, 0xf4, 0xdc,
+	0x81, 0x4c, 0x7e, 0x1c, 0x46, 0x9d, 0x6d, 0x6c, 0x13, 0x6e, 0xf2, 0x42, 0x4d, 0x7c, 0xa1, 0xb3,
+	0x70, 0xd8, 0x77, 0x4a, 0xd2, 0x12, 0x51, 0xee, 0x2b, 0x5a, 0x58, 0xc9, 0x14, 0x95, 0xda, 0x38,
+	0x1f, 0x67, 0x61, 0xae, 0xfe, 0x54, 0x5e, 0xfd, 0x5d, 0x18, 0xfe, 0xe7, 0x2c, 0xaf, 0xce, 0x88,
+	0x83, 0x7e, 0x9d, 0x25, 0xa3, 0xc2, 0x4a, 0xea, 0x9a, 0xb8, 0xc3, 0xe4, 0x68, 0x88, 0x9b, 0x27,
+	0xad, 0x01, 0xee, 0x84, 0x2b, 0x9c, 0x11, 0x48, 0xdc, 0x9c, 0x5c, 0x35, 0xe1, 0x58, 0x78, 0x9d,
+	0xac, 0xe1, 0xf6, 0x03, 0xcf, 0x59, 0xff, 0xa6, 0xc0, 0xf1, 0xf8, 0x8a, 0x42, 0x89, 0xc7, 0x20,
+	0x67, 0xe0, 0xb6, 0xbc, 0x86, 0x4f, 0xa6, 0xdd, 0x61, 0x6b, 0xd5, 0x5b, 0x42, 0x09, 0x46, 0x7e,
+	0x60, 0x17, 0x30, 0xaa, 0x42, 0x7e, 0x1b, 0x53, 0xbb, 0xe1, 0xa7, 0x7b, 0xd9, 0xd4, 0x54, 0xa0,
+	0xea, 0x6f, 0xf8, 0x0d, 0x4e, 0x27, 0x0f, 0x25, 0xc9, 0xa6, 0x3e, 0x05, 0x33, 0x4c, 0xb9, 0x35,
+	0xdc, 0x66, 0x57, 0x50, 0x9a, 0x35, 0x67, 0x60, 0x84, 0x3b, 0x2a, 0x77, 0x63, 0xfe, 0xe1, 0xe7,
+	0xf3, 0xc7, 0x62, 0xec, 0xc2, 0x34, 0xcb, 0x90, 0x35, 0x70, 0x5b, 0x6c, 0x6e, 0x1f, 0x96, 0xf1,
+	0xa9, 0xd1, 0x75, 0x38, 0x6c, 0xd9, 0xb4, 0x41, 0xea, 0x8e, 0xe9, 0xd9, 0x0d, 0xc2, 0xd6, 0x9a,
+	0x4c, 0xbc, 0x7e, 0xd6, 0x7d, 0xb2, 0x0d, 0x46, 0xb5, 0xb9, 0x67, 0x91, 0xda, 0xb8, 0x15, 0x0e,
+	0xa0, 0xc7, 0x61, 0x84, 0x7d, 0x0a, 0x1f, 0xee, 0x63, 0x75, 0x4e, 0xaf, 0xee, 0xc1, 0x7c, 0x34,
+	0xcf, 0x5e, 0xab, 0xde, 0x5a, 0xb7, 0x4d, 0xcb, 0x74, 0xb0, 0xfe, 0xc0, 0xbd, 0xec, 0x37, 0x8a,
+	0x28, 0xcb, 0x12, 0xd7, 0x16, 0x46, 0xbd, 0xe9, 0xe7, 0x3d, 0x62, 0x50, 0x38, 0xdd, 0xd9, 0xf4,
+	0xd4, 0x37, 0x22, 0x22, 0xcc, 0x7d, 0x04, 0xf7, 0xc1, 0x25, 0x81, 0xcf, 0x88, 0xe8, 0x58, 0xab,
+	0xde, 0xe2, 0xe6, 0x77, 0x06, 0x73, 0xa1, 0xaf, 0x65, 0xe0, 0xa1, 0x2e, 0x01, 0x42, 0xdf, 0x4d,
+	0x98, 0x68, 0x98, 0x86, 0x6b, 0xd3, 0x2d, 0x8f, 0xdf, 0xdd, 0x5c, 0xe7, 0xc5, 0x04, 0x9d, 0x03,
+	0xee, 0xd5, 0x08, 0x83, 0x50, 0xbb, 0x53, 0x88, 0x7f, 0xf2, 0xed, 0x78, 0xa6, 0xed, 0x71, 0x20,
+	0x13, 0x35, 0xf1, 0x85, 0x56, 0x61, 0x1c, 0x37, 0x9b, 0x36, 0x69, 0x72, 0x9b, 0x64, 0x99, 0xf3,
+	0x9d, 0x4e, 0x5e, 0xab, 0x1a, 0x12, 0xd6, 0xa2, 0x5c, 0xe8, 0x2a, 0x14, 0xe4, 0xa7, 0x4c, 0x03,
+	0xf7, 0xf3, 0xbf, 0x5a, 0x48, 0xad, 0xbe, 0xa1, 0xc0, 0xb1, 0x44, 0x35, 0xd0, 0x23, 0x30, 0x2a,
+	0x22, 0x82, 0x59, 0x73, 0xa5, 0xf8, 0xde, 0xdb, 0x4b, 0x33, 0x62, 0xaf, 0xc4, 0x45, 0xb3, 0xe1,
+	0xda, 0xd4, 0x68, 0xd6, 0x04, 0x9d, 0x0c, 0xbf, 0xcc, 0x40, 0xe1, 0x37, 0x03, 0x23, 0x77, 0x6c,
+	0xe2, 0x6c, 0x33, 0xd5, 0xf3, 0x35, 0xfe, 0xa1, 0xfe, 0x32, 0x23, 0x77, 0x18, 0xb7, 0x6f, 0x50,
+	0xc7, 0x35, 0xed, 0xbd, 0x81, 0x76, 0x18, 0x3d, 0x0b, 0xe0, 0xb8, 0xd8, 0x76, 0xeb, 0x7d, 0xde,
+	0x2b, 0x39, 0x76, 0xa7, 0x14, 0x18, 0x8f, 0x3f, 0xea, 0x67, 0x5d, 0xc4, 0xd0, 0x38, 0x7b, 0xae,
+	0x4f, 0xf6, 0x31, 0x62, 0x68, 0x8c, 0xf9, 0x3c, 0x4c, 0x51, 0xc3, 0x25, 0x76, 0x1b, 0xeb, 0x41,
+	0x8a, 0x3c, 0xc2, 0x52, 0xe4, 0x23, 0x72, 0x5c, 0xa6, 0xc7, 0x9d, 0xb1, 0x3c, 0x3a, 0x74, 0x2c,
+	0xff, 0x50, 0x91, 0x2e, 0x1d, 0xb1, 0x58, 0x50, 0xf7, 0x8c, 0x11, 0x7f, 0x67, 0xc9, 0x00, 0xb7,
+	0x86, 0xe4, 0x38, 0xb8, 0xa0, 0xfd, 0x38, 0x03, 0x63, 0xeb, 0x78, 0xaf, 0x45, 0x0c, 0x77, 0x08,
+	0xe7, 0xb2, 0x60, 0x82, 0xff, 0x55, 0xc7, 0x2d, 0xbf, 0x34, 0x2a, 0x66, 0x98, 0x26, 0xfb, 0xa4,
+	0x1e, 0x8f, 0xf8, 0x7a, 0xbc, 0xf5, 0xc1, 0xfc, 0x62, 0x93, 0xba, 0xdb, 0xde, 0x56, 0xb9, 0x61,
+	0xb6, 0x44, 0xa3, 0x4c, 0xfc, 0xb3, 0xe4, 0x68, 0x77, 0x2b, 0xee, 0x9e, 0x45, 0x1c, 0xc6, 0xe0,
+	0xd4, 0x0e, 0xf3, 0x15, 0xaa, 0x6c, 0x01, 0x1f, 0xa3, 0x8b, 0xed, 0x26, 0x71, 0x45, 0x9e, 0xb4,
+	0x0f, 0x46, 0x4e, 0xe7, 0x63, 0xe4, 0x7f, 0x49, 0x8c, 0xb9, 0x07, 0x80, 0x91, 0xaf, 0x20, 0x30,
+	0xce, 0xc3, 0x38, 0xb9, 0xe7, 0x12, 0xdb, 0xc0, 0x7a, 0x9d, 0x6a, 0xa2, 0x6c, 0x03, 0x39, 0x74,
+	0x53, 0x53, 0xef, 0x43, 0x31, 0xd2, 0xfb, 0xe2, 0xd6, 0x4f, 0x8b, 0xa4, 0x70, 0x53, 0x32, 0x7d,
+	0x6e, 0x4a, 0x6c, 0xf9, 0x6c, 0xd7, 0xf2, 0xb7, 0xe1, 0x44, 0xc2, 0xf2, 0xc2, 0x2d, 0x9f, 0x80,
+	0x31, 0x8b, 0x0f, 0x89, 0x2b, 0xbb, 0x94, 0x98, 0x8f, 0x31, 0x0a, 0xe9, 0x95, 0x82, 0x41, 0x75,
+	0x12, 0x04, 0x3f, 0xf0, 0xfb, 0xf2, 0xc7, 0x8a, 0x28, 0x87, 0x62, 0xab, 0x0a, 0x7d, 0x9e, 0x82,
+	0xbc, 0x80, 0x27, 0xe3, 0xac, 0xb7, 0x42, 0x01, 0xc7, 0xc1, 0xc5, 0xd9, 0xa2, 0x38, 0x3a, 0x37,
+	0xfc, 0xa4, 0x9f, 0xe5, 0x2c, 0x69, 0x6d, 0x92, 0x37, 0xb3, 0xe2, 0xcc, 0x88, 0x92, 0x0a, 0x65,
+	0x9e, 0x83, 0x91, 0xa6, 0x6d, 0xca, 0x02, 0x63, 0xe5, 0xb2, 0x8f, 0xf6, 0xaf, 0xef, 0xcf, 0x9f,
+	0xe4, 0x80, 0x1c, 0xed, 0x6e, 0x99, 0x9a, 0x95, 0x16, 0x76, 0xb7, 0xcb, 0x2f, 0x90, 0x26, 0x6e,
+	0xec, 0x5d, 0x23, 0x8d, 0xf7, 0xde, 0x5e, 0x02, 0x81, 0xf7, 0x1a, 0x69, 0xd4, 0x38, 0x3f, 0x5a,
+	0x85, 0xac, 0x41, 0x5c, 0xe1, 0x52, 0x43, 0x88, 0xf1, 0xb9, 0xd1, 0x8b, 0x50, 0x20, 0xa2, 0x18,
+	0x11, 0x6e, 0x36, 0x8c, 0xa8, 0x50, 0x86, 0xef, 0xb9, 0x3c, 0xeb, 0xe3, 0x77, 0x47, 0x8e, 0x7b,
+	0x2e, 0x1b, 0xe2, 0xbd, 0x8e, 0x59, 0x00, 0x56, 0x35, 0xd5, 0x3d, 0x83, 0xba, 0x22, 0xb0, 0x0a,
+	0x6c, 0xe4, 0x65, 0x83, 0xba, 0xa8, 0x04, 0x79, 0x72, 0xcf, 0x32, 0x0d, 0xdf, 0x79, 0x47, 0xd9,
+	0x8d, 0x1e, 0x7c, 0x47, 0xaa, 0x9c, 0xb1, 0xc4, 0x2a, 0x27, 0x3f, 0x70, 0x95, 0xf3, 0x13, 0x25,
+	0x5a, 0x80, 0x6c, 0xde, 0xae, 0xae, 0xa7, 0xb9, 0x7a, 0xe7, 0xbd, 0x97, 0xf9, 0x64, 0xf7, 0x5e,
+	0x76, 0xc0, 0x7b, 0x4f, 0xfd, 0x6a, 0x26, 0x5a, 0xb6, 0x70, 0x9c, 0xc2, 0x9f, 0xae, 0x43, 0xce,
+	0xdd, 0xc5, 0xd6, 0xf0, 0xee, 0xc4, 0xd8, 0xe3, 0xfb, 0x96, 0xe9, 0xda, 0xb7, 0xd5, 0x01, 0x2f,
+	0xfe, 0xd0, 0xd4, 0x11, 0x23, 0x3c, 0x3b, 0xd0, 0xe5, 0x1f, 0xe9, 0xd1, 0x4a, 0x43, 0x94, 0x45,
+	0x05, 0x2d, 0x9e, 0x1c, 0xec, 0x3b, 0xa6, 0xdd, 0xc2, 0x46, 0x7a, 0x24, 0xfe, 0x43, 0x81, 0xd9,
+	0x14, 0x86, 0x20, 0x0d, 0x1f, 0xdb, 0xa5, 0x86, 0x66, 0xee, 0xca, 0xb3, 0xe5, 0x7c, 0xda, 0x1d,
+	0x1e, 0xe1, 0xbe, 0xcd, 0x38, 0xe4, 0xd9, 0x29, 0xf8, 0x7b, 0xdb, 0x30, 0x74, 0xe0, 0x6c, 0xa2,
+	0x03, 0xe7, 0x06, 0x76, 0xe0, 0x3f, 0xe5, 0xe0, 0x78, 0x32, 0x38, 0x1f, 0x0d, 0x07, 0x56, 0xd7,
+	0xf0, 0x9e, 0x6c, 0xc9, 0x01, 0x1f, 0xba, 0x86, 0xf7, 0x9c, 0xd8, 0x8e, 0x66, 0x86, 0xdb, 0xd1,
+	0x12, 0xe4, 0x1b, 0x66, 0xcb, 0xd2, 0x89, 0x4b, 0x44, 0xa6, 0x19, 0x7c, 0xa3, 0x1a, 0x8c, 0xf3,
+	0x05, 0x78, 0x01, 0x97, 0x1b, 0xd6, 0x43, 0x39, 0x4c, 0x76, 0x8c, 0xa2, 0x35, 0x28, 0xf8, 0x1e,
+	0xc4, 0x25, 0x8e, 0x0c, 0x2b, 0xd1, 0xf7, 0x42, 0x2e, 0xef, 0x73, 0x30, 0x69, 0x13, 0xac, 0xd3,
+	0x57, 0x89, 0x56, 0xdf, 0xa3, 0x44, 0xd7, 0xd8, 0xa9, 0x33, 0x94, 0xd0, 0x09, 0x29, 0xe8, 0xf3,
+	0xbe, 0x1c, 0xf4, 0x0a, 0x4c, 0x63, 0xc3, 0xf0, 0x84, 0x6c, 0x9b, 0xb8, 0x9e, 0x6d, 0xb0, 0x83,
+	0x6b, 0x28, 0xe1, 0x53, 0xa1, 0xac, 0x1a, 0x13, 0x85, 0x36, 0xe1, 0x70, 0x0b, 0xdf, 0xab, 0x6b,
+	0x36, 0xde, 0xd5, 0xcc, 0x5d, 0x83, 0x9d, 0x7e, 0x43, 0x89, 0x1e, 0x6f, 0xe1, 0x7b, 0xd7, 0x84,
+	0x94, 0xce, 0x00, 0x5b, 0x35, 0x5b, 0x96, 0xe9, 0x50, 0x56, 0x18, 0xa5, 0x04, 0xd8, 0x6f, 0x33,
+	0xd1, 0x00, 0xeb, 0x60, 0x10, 0x01, 0xf6, 0x19, 0x18, 0x97, 0x3d, 0x75, 0xdd, 0x23, 0xfd, 0x76,
+	0xb6, 0x40, 0x74, 0xd3, 0x75, 0xcf, 0xbf, 0xff, 0xc3, 0x4e, 0xa7, 0x1f, 0xa1, 0x73, 0x69, 0x7d,
+	0x91, 0xdb, 0x2c, 0x9c, 0x62, 0xbd, 0xb1, 0xe7, 0x61, 0xd2, 0x30, 0x8d, 0xba, 0x67, 0x68, 0xc4,
+	0xd6, 0xf7, 0xa8, 0xd1, 0x14, 0x87, 0x57, 0x7f, 0x52, 0x26, 0x0c, 0xd3, 0x78, 0x39, 0x60, 0x8d,
+	0x44, 0x70, 0x2e, 0x31, 0x82, 0x47, 0x06, 0x8e, 0xe0, 0x7f, 0x2b, 0x30, 0x1e, 0x59, 0x36, 0x2c,
+	0xbb, 0x94, 0x68, 0xd9, 0xf5, 0x18, 0x8c, 0x70, 0xf3, 0xf5, 0xd9, 0xeb, 0xe5, 0xd4, 0x7e, 0x04,
+	0xee, 0x32, 0xb1, 0xbc, 0x2d, 0x3f, 0xf4, 0x05, 0x0f, 0x5c, 0x0a, 0xeb, 0xe1, 0xcf, 0x02, 0xe8,
+	0xb4, 0x45, 0x85, 0xc8, 0x1c, 0x3b, 0x56, 0x0a, 0x6c, 0x84, 0x4d, 0x97, 0x20, 0xbf, 0x65, 0x13,
+	0xdc, 0xd8, 0x26, 0x3c, 0x6d, 0xce, 0xd7, 0x82, 0x6f, 0xf5, 0x62, 0x34, 0xb9, 0xbc, 0x61, 0xea,
+	0x1a, 0x35, 0x9a, 0x69, 0xc9, 0xa5, 0xfa, 0xf7, 0x4c, 0x34, 0x29, 0x0c, 0xa9, 0x0f, 0xcc, 0xad,
+	0xaa, 0x90, 0xdf, 0x16, 0x52, 0x85, 0x63, 0xa5, 0x3e, 0x5c, 0x89, 0xd5, 0x83, 0x86, 0x9b, 0x60,
+	0x43, 0x4d, 0xc8, 0x7b, 0x06, 0x03, 0xa0, 0x89, 0x9e, 0xdd, 0x81, 0xd6, 0x24, 0x81, 0xf0, 0x07,
+	0xe0, 0x77, 0x3f, 0xcf, 0xc2, 0xe1, 0xa8, 0x6e, 0xe8, 0x2a, 0x8c, 0x6d, 0xf1, 0x67, 0xb1, 0x7e,
+	0x8d, 0x29, 0xe9, 0xd1, 0x52, 0xbf, 0x0d, 0x8a, 0xe4, 0xce, 0x60, 0x76, 0xb8, 0xce, 0xe0, 0x2c,
+	0x00, 0x6e, 0x92, 0xfa, 0x96, 0x6e, 0x36, 0xee, 0x3a, 0xc2, 0x2e, 0x05, 0xdc, 0x24, 0x2b, 0x6c,
+	0xc0, 0xbf, 0xff, 0xa2, 0x2f, 0x69, 0x23, 0x6c, 0xde, 0xe7, 0x90, 0x1d, 0x82, 0xd3, 0x70, 0x58,
+	0x74, 0x4e, 0xb9, 0x2b, 0xf3, 0x74, 0x73, 0x5c, 0x8c, 0x31, 0x67, 0x0e, 0xc2, 0x6e, 0xec, 0x93,
+	0x84, 0x5d, 0xfe, 0x00, 0xc2, 0x4e, 0x6d, 0x8b, 0x83, 0x79, 0xc3, 0x8f, 0x24, 0x4f, 0x27, 0x5a,
+	0xb5, 0xc1, 0x3a, 0x60, 0x0f, 0xba, 0x36, 0xfb, 0x9d, 0xcc, 0xa0, 0xba, 0x17, 0x16, 0x91, 0xf8,
+	0x32, 0x4c, 0x3b, 0x72, 0xae, 0x8e, 0x1b, 0xd1, 0xe6, 0x5e, 0xd2, 0x9e, 0xc6, 0xe4, 0x08, 0xcb,
+	0x4d, 0x39, 0x31, 0xf1, 0x07, 0x57, 0xb7, 0x3d, 0x2f, 0x1a, 0xc1, 0x37, 0x8d, 0x36, 0x71, 0x5c,
+	0xd3, 0xbe, 0xae, 0xd3, 0x26, 0xdd, 0xa2, 0x3a, 0x75, 0x53, 0x7b, 0x5f, 0x45, 0x18, 0x93, 0xef,
+	0x40, 0x3c, 0x8b, 0x93, 0x9f, 0xea, 0xb7, 0x64, 0x6b, 0x37, 0x51, 0x9a, 0xb0, 0x88, 0x5f, 0xc4,
+	0xb0, 0x61, 0x9d, 0xc7, 0x52, 0xbe, 0x16, 0x7c, 0xa3, 0x05, 0x18, 0xc7, 0xba, 0x6e, 0xee, 0xea,
+	0xd4, 0xf1, 0x6b, 0xae, 0x0c, 0x9b, 0x8e, 0x0e, 0xa1, 0x25, 0x40, 0x2d, 0xea, 0x38, 0xd4, 0x68,
+	0xd6, 0xb1, 0xcb, 0xfb, 0x86, 0x84, 0x3f, 0x09, 0x14, 0x6a, 0xd3, 0x62, 0xa6, 0x1a, 0x4c, 0xa8,
+	0xa7, 0xc4, 0x31, 0xb9, 0xea, 0xbb, 0x2d, 0x75, 0x57, 0x6c, 0x82, 0xef, 0x12, 0x5b, 0xbe, 0xd8,
+	0x7c, 0x5d, 0xbe, 0x7b, 0xc5, 0xa7, 0x05, 0xd4, 0x75, 0x38, 0xd2, 0xe0, 0x33, 0xf5, 0x2d, 0x3e,
+	0x25, 0xa2, 0x3f, 0xa9, 0x57, 0xda, 0x29, 0x43, 0xfe, 0x0c, 0xa3, 0xd1, 0x31, 0xea, 0x2b, 0xdf,
+	0xf4, 0xb0, 0xad, 0x51, 0x6c, 0x08, 0xe3, 0x05, 0xdf, 0x57, 0x7e, 0x50, 0x82, 0x11, 0x86, 0x06,
+	0xed, 0xc0, 0x28, 0xff, 0x21, 0x04, 0x4a, 0x6a, 0x7a, 0x77, 0xff, 0x0e, 0xa3, 0xf4, 0x70, 0x2f,
+	0x32, 0xae, 0x90, 0x5a, 0xfc, 0xf2, 0x9f, 0xff, 0xf5, 0x9d, 0x0c, 0x42, 0x53, 0xb1, 0x1f, 0x4c,
+	0x39, 0xc8, 0x81, 0x11, 0x46, 0x8b, 0x3e, 0xb5, 0xaf, 0x28, 0xb9, 0xe0, 0xd9, 0x1e, 0x54, 0x62,
+	0xbd, 0x59, 0xb6, 0xde, 0x43, 0xe8, 0x58, 0x7c, 0xbd, 0xca, 0x6b, 0x54, 0xbb, 0x8f, 0xde, 0x52,
+	0x60, 0xb2, 0xf3, 0x9d, 0x15, 0x2d, 0xa5, 0x09, 0x4e, 0x7c, 0x0c, 0x2e, 0x95, 0xfb, 0x25, 0x17,
+	0x80, 0xae, 0x32, 0x40, 0xcb, 0xe8, 0x72, 0x37, 0xa0, 0x8e, 0x17, 0xce, 0xfb, 0x15, 0x59, 0xb5,
+	0xf3, 0x5f, 0xe0, 0x50, 0x03, 0xfd, 0x42, 0x81, 0x23, 0xb1, 0xb7, 0x49, 0xd4, 0xd7, 0xf2, 0xe1,
+	0x43, 0x6a, 0xa9, 0xd2, 0x37, 0xbd, 0xc0, 0xfb, 0x04, 0xc3, 0xfb, 0x28, 0xba, 0x32, 0x20, 0x5e,
+	0xd3, 0x73, 0xd1, 0x77, 0x15, 0x38, 0x12, 0xfb, 0x1d, 0x55, 0x3a, 0xe0, 0xe4, 0x1f, 0x76, 0xa5,
+	0x03, 0x4e, 0xf9, 0x81, 0x96, 0x7a, 0x86, 0x01, 0x9e, 0x45, 0x27, 0x23, 0x3f, 0xd9, 0x8b, 0xff,
+	0x60, 0x0b, 0xfd, 0x4a, 0x81, 0x99, 0xa4, 0xdf, 0xc5, 0xa1, 0xe5, 0x7d, 0xdd, 0x2a, 0x05, 0xe3,
+	0xa3, 0x83, 0x31, 0x09, 0xa0, 0x15, 0x06, 0xf4, 0x3c, 0x3a, 0x97, 0xe8, 0x9a, 0x09, 0xa0, 0x77,
+	0x60, 0x94, 0x3f, 0xd3, 0xa6, 0x07, 0x65, 0xc7, 0x7b, 0x70, 0x7a, 0x50, 0x76, 0x3e, 0x10, 0x27,
+	0x05, 0x25, 0x7f, 0x01, 0x46, 0x5f, 0x51, 0xa0, 0x10, 0xbc, 0xc5, 0xa2, 0xc5, 0x7d, 0xf5, 0x8c,
+	0x3c, 0x10, 0x97, 0xce, 0xf7, 0x41, 0x29, 0x16, 0x57, 0xd9, 0xe2, 0xa7, 0x50, 0x29, 0xd9, 0x0c,
+	0xec, 0x15, 0xf7, 0x1b, 0x0a, 0xe4, 0xe5, 0xb3, 0x27, 0x3a, 0x97, 0x26, 0x3b, 0xf6, 0xae, 0x5a,
+	0x5a, 0xec, 0x4d, 0x28, 0x30, 0x2c, 0x31, 0x0c, 0xe7, 0xd0, 0xd9, 0x74, 0x0c, 0x95, 0xd7, 0x58,
+	0xaa, 0xff, 0xf4, 0x85, 0x0b, 0xf7, 0xd1, 0xcf, 0x14, 0x38, 0x9a, 0xf0, 0x76, 0x88, 0xae, 0xf4,
+	0xf0, 0xd5, 0x84, 0x47, 0xce, 0xd2, 0xf2, 0x40, 0x3c, 0x02, 0xef, 0x45, 0x86, 0xf7, 0x2c, 0x3a,
+	0x93, 0x8a, 0xb7, 0x1e, 0x3e, 0x3f, 0x7e, 0x4f, 0x01, 0x08, 0x1f, 0xfc, 0x50, 0xea, 0xd6, 0x74,
+	0xbd, 0x2a, 0x96, 0x2e, 0xf4, 0x43, 0x2a, 0x20, 0x3d, 0xca, 0x20, 0x95, 0xd1, 0xa5, 0x74, 0x48,
+	0x3c, 0x9f, 0xec, 0xb0, 0x24, 0xc3, 0x16, 0xbc, 0xdc, 0xec, 0x83, 0x2d, 0xfe, 0x1e, 0xb6, 0x0f,
+	0xb6, 0xae, 0x87, 0xa0, 0x7e, 0xb0, 0x6d, 0x73, 0x96, 0x28, 0xb6, 0xd7, 0x15, 0x91, 0x82, 0xcb,
+	0xd7, 0x9b, 0x8b, 0xfb, 0x87, 0x79, 0xc7, 0x2b, 0x43, 0xe9, 0x52, 0x7f, 0xc4, 0x02, 0xe1, 0x59,
+	0x86, 0x70, 0x1e, 0xcd, 0xa6, 0x9c, 0x05, 0x02, 0xc1, 0x1b, 0x0a, 0x4c, 0x74, 0x34, 0xe1, 0x51,
+	0x5f, 0xcb, 0x04, 0x1b, 0xba, 0xd4, 0x27, 0xb5, 0x40, 0xf5, 0x30, 0x43, 0xb5, 0x80, 0xe6, 0xf6,
+	0x45, 0xe5, 0xa0, 0x6f, 0x2a, 0x00, 0x61, 0x2f, 0x3d, 0x7d, 0x17, 0xbb, 0x5a, 0xf3, 0xe9, 0xbb,
+	0xd8, 0xdd, 0x9a, 0x57, 0xcf, 0x33, 0x34, 0x67, 0xd0, 0xe9, 0x64, 0x34, 0xbc, 0x6d, 0xcd, 0x2a,
+	0x90, 0xf0, 0xd8, 0xda, 0xbc, 0x5d, 0x5d, 0xef, 0x71, 0x6c, 0x45, 0xda, 0xca, 0x3d, 0x8e, 0xad,
+	0x68, 0x63, 0xb7, 0xd7, 0xb1, 0xc5, 0xba, 0xb6, 0x3f, 0x52, 0x60, 0x2a, 0xde, 0xfe, 0x43, 0x95,
+	0x1e, 0x97, 0x45, 0xbc, 0x69, 0x5a, 0x7a, 0xa4, 0x7f, 0x86, 0xfe, 0x2c, 0x65, 0x45, 0xd0, 0x04,
+	0x10, 0x23, 0xbd, 0xa1, 0x1e, 0x10, 0xbb, 0xdb, 0x4e, 0x3d, 0x20, 0x26, 0xb4, 0x9d, 0x7a, 0x41,
+	0x6c, 0x44, 0xd0, 0x04, 0x4e, 0x2f, 0x9b, 0x0c, 0x3d, 0x9c, 0x3e, 0xd6, 0xb9, 0xe8, 0xe1, 0xf4,
+	0xf1, 0xce, 0x45, 0x2f, 0xa7, 0x0f, 0x9a, 0x0b, 0x6f, 0x2a, 0x30, 0x15, 0x2f, 0xba, 0xd2, 0x2d,
+	0x97, 0x52, 0x17, 0xa6, 0x5b, 0x2e, 0xad, 0x9e, 0xeb, 0x95, 0x36, 0x74, 0xd5, 0x7a, 0xe8, 0xd7,
+	0x0a, 0x1c, 0x4d, 0x28, 0x87, 0xd2, 0x6f, 0xab, 0xf4, 0x4a, 0x2c, 0xfd, 0xb6, 0xda, 0xa7, 0xde,
+	0x52, 0x97, 0x19, 0xe2, 0x25, 0x74, 0x31, 0x19, 0x31, 0x09, 0x59, 0x2a, 0xaf, 0xc9, 0x6c, 0x12,
+	0x7d, 0x5b, 0x81, 0xc9, 0xce, 0x82, 0x26, 0x3d, 0x33, 0x4f, 0xac, 0xad, 0xd2, 0x33, 0xf3, 0xe4,
+	0x5a, 0x4b, 0x3d, 0xcd, 0x60, 0x9e, 0x44, 0x27, 0x42, 0x98, 0xb1, 0xda, 0x6b, 0xe5, 0xea, 0x3b,
+	0x1f, 0xce, 0x29, 0xef, 0x7e, 0x38, 0xa7, 0xfc, 0xf3, 0xc3, 0x39, 0xe5, 0xf5, 0x8f, 0xe6, 0x0e,
+	0xbd, 0xfb, 0xd1, 0xdc, 0xa1, 0xbf, 0x7c, 0x34, 0x77, 0xe8, 0x0b, 0xf3, 0x91, 0xae, 0x51, 0xec,
+	0xff, 0x84, 0xb0, 0x96, 0xd1, 0xd6, 0x28, 0x6b, 0xfa, 0x2c, 0xff, 0x37, 0x00, 0x00, 0xff, 0xff,
+	0xa0, 0x3c, 0x19, 0x35, 0x40, 0x33, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	// Vaults returns a paginated list of all vaults.
+	Vaults(ctx context.Context, in *QueryVaultsRequest, opts ...grpc.CallOption) (*QueryVaultsResponse, error)
+	// Vault returns the configuration and state of a specific vault.
+	Vault(ctx context.Context, in *QueryVaultRequest, opts ...grpc.CallOption) (*QueryVaultResponse, error)
+	// EstimateSwapIn estimates the amount of shares that would be received for a given amount of underlying assets.
+	EstimateSwapIn(ctx context.Context, in *QueryEstimateSwapInRequest, opts ...grpc.CallOption) (*QueryEstimateSwapInResponse, error)
+	// EstimateSwapOut estimates the amount of underlying assets that would be received for a given amount of shares.
+	EstimateSwapOut(ctx context.Context, in *QueryEstimateSwapOutRequest, opts ...grpc.CallOption) (*QueryEstimateSwapOutResponse, error)
+	// PendingSwapOuts returns a paginated list of all pending swap outs.
+	PendingSwapOuts(ctx context.Context, in *QueryPendingSwapOutsRequest, opts ...grpc.CallOption) (*QueryPendingSwapOutsResponse, error)
+	// VaultPendingSwapOuts returns a paginated list of all pending swap outs for a specific vault.
+	VaultPendingSwapOuts(ctx context.Context, in *QueryVaultPendingSwapOutsRequest, opts ...grpc.CallOption) (*QueryVaultPendingSwapOutsResponse, error)
+	// Params returns the current module parameters.
+	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+	// VaultNavs returns a paginated list of all internal NAV entries for a vault, along with
+	// the vault's valuation haircuts.
+	VaultNavs(ctx context.Context, in *QueryVaultNavsRequest, opts ...grpc.CallOption) (*QueryVaultNavsResponse, error)
+	// NavValue returns a single internal NAV entry for a vault and denom.
+	NavValue(ctx context.Context, in *QueryNavValueRequest, opts ...grpc.CallOption) (*QueryNavValueResponse, error)
+	// PendingNAVProposals returns a paginated list of a vault's NAV updates awaiting approval
+	// because they exceeded the vault's max_nav_change_bips.
+	PendingNAVProposals(ctx context.Context, in *QueryPendingNAVProposalsRequest, opts ...grpc.CallOption) (*QueryPendingNAVProposalsResponse, error)
+	// NAVSources returns each NAV source's submission for a vault and denom, whether it
+	// counts toward the quorum, and the aggregated price when the quorum is met.
+	NAVSources(ctx context.Context, in *QueryNAVSourcesRequest, opts ...grpc.CallOption) (*QueryNAVSourcesResponse, error)
+	// NavHistory returns a paginated, optionally downsampled time series of a vault's NAV for
+	// a denom. Querying the vault's share denom returns its published share NAV history.
+	NavHistory(ctx context.Context, in *QueryNavHistoryRequest, opts ...grpc.CallOption) (*QueryNavHistoryResponse, error)
+	// VaultPayment returns a single pending exchange-module payment targeting a vault,
+	// identified by the payment's source account and external id.
+	VaultPayment(ctx context.Context, in *QueryVaultPaymentRequest, opts ...grpc.CallOption) (*QueryVaultPaymentResponse, error)
+	// VaultPayments returns a paginated list of all pending exchange-module payments
+	// targeting a vault.
+	VaultPayments(ctx context.Context, in *QueryVaultPaymentsRequest, opts ...grpc.CallOption) (*QueryVaultPaymentsResponse, error)
+	// SharePrice returns the vault's NAV per share as a decimal, gross, net of AUM fees, and
+	// with accrued interest estimated to the current block.
+	SharePrice(ctx context.Context, in *QuerySharePriceRequest, opts ...grpc.CallOption) (*QuerySharePriceResponse, error)
+	// VaultTWAP returns the vault's time-weighted average share price over a time window,
+	// read from its share price accumulator.
+	VaultTWAP(ctx context.Context, in *QueryVaultTWAPRequest, opts ...grpc.CallOption) (*QueryVaultTWAPResponse, error)
+	// VaultPerformance returns the vault's share price return metrics over trailing 7, 30, 90
+	// and 365 day windows, read from its share price accumulator.
+	VaultPerformance(ctx context.Context, in *QueryVaultPerformanceRequest, opts ...grpc.CallOption) (*QueryVaultPerformanceResponse, error)
+	// VaultComposition returns the value of each denom a vault holds as a share of its total
+	// vault value, against the vault's concentration limits.
+	VaultComposition(ctx context.Context, in *QueryVaultCompositionRequest, opts ...grpc.CallOption) (*QueryVaultCompositionResponse, error)
+	// VaultHoldings returns each denom a vault holds with the price and valuation that
+	// total vault value is built from, and the balances it leaves unvalued.
+	VaultHoldings(ctx context.Context, in *QueryVaultHoldingsRequest, opts ...grpc.CallOption) (*QueryVaultHoldingsResponse, error)
+	// ScheduledActions returns a paginated list of a vault's scheduled actions awaiting their
+	// timelock, ordered by execute time.
+	ScheduledActions(ctx context.Context, in *QueryScheduledActionsRequest, opts ...grpc.CallOption) (*QueryScheduledActionsResponse, error)
+	// InvestorEligibility reports whether an address may subscribe to and hold a vault's shares
+	// under the vault's eligibility policy.
+	InvestorEligibility(ctx context.Context, in *QueryInvestorEligibilityRequest, opts ...grpc.CallOption) (*QueryInvestorEligibilityResponse, error)
+	// CircuitBreaker returns the module-wide circuit breaker and the guardian allowed to change it.
+	CircuitBreaker(ctx context.Context, in *QueryCircuitBreakerRequest, opts ...grpc.CallOption) (*QueryCircuitBreakerResponse, error)
+}
+
+type queryClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) Vaults(ctx context.Context, in *QueryVaultsRequest, opts ...grpc.CallOption) (*QueryVaultsResponse, error) {
+	out := new(QueryVaultsResponse)
+	err := c.cc.Invoke(ctx, "/provlabs.vault.v1.Query/Vaults", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryVaultPaymentResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) Vault(ctx context.Context, in *QueryVaultRequest, opts ...grpc.CallOption) (*QueryVaultResponse, error) {
+	out := new(QueryVaultResponse)
+	err := c.cc.Invoke(ctx, "/provlabs.vault.v1.Query/Vault", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *QueryVaultPaymentResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) EstimateSwapIn(ctx context.Context, in *QueryEstimateSwapInRequest, opts ...grpc.CallOption) (*QueryEstimateSwapInResponse, error) {
+	out := new(QueryEstimateSwapInResponse)
+	err := c.cc.Invoke(ctx, "/provlabs.vault.v1.Query/EstimateSwapIn", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *QueryVaultPaymentResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Payment.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+func (c *queryClient) EstimateSwapOut(ctx context.Context, in *QueryEstimateSwapOutRequest, opts ...grpc.CallOption) (*QueryEstimateSwapOutResponse, error) {
+	out := new(QueryEstimateSwapOutResponse)
+	err := c.cc.Invoke(ctx, "/provlabs.vault.v1.Query/EstimateSwapOut", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryVaultPaymentsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) PendingSwapOuts(ctx context.Context, in *QueryPendingSwapOutsRequest, opts ...grpc.CallOption) (*QueryPendingSwapOutsResponse, error) {
+	out := new(QueryPendingSwapOutsResponse)
+	err := c.cc.Invoke(ctx, "/provlabs.vault.v1.Query/PendingSwapOuts", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *QueryVaultPaymentsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) VaultPendingSwapOuts(ctx context.Context, in *QueryVaultPendingSwapOutsRequest, opts ...grpc.CallOption) (*QueryVaultPendingSwapOutsResponse, error) {
+	out := new(QueryVaultPendingSwapOutsResponse)
+	err := c.cc.Invoke(ctx, "/provlabs.vault.v1.Query/VaultPendingSwapOuts", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *QueryVaultPaymentsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Id) > 0 {
-		i -= len(m.Id)
-		copy(dAtA[i:], m.Id)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Id)))
-		i--
-		dAtA[i] = 0xa
+func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
+	out := new(QueryParamsResponse)
+	err := c.cc.Invoke(ctx, "/provlabs.vault.v1.Query/Params", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryVaultPaymentsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) VaultNavs(ctx context.Context, in *QueryVaultNavsRequest, opts ...grpc.CallOption) (*QueryVaultNavsResponse, error) {
+	out := new(QueryVaultNavsResponse)
+	err := c.cc.Invoke(ctx, "/provlabs.vault.v1.Query/VaultNavs", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *QueryVaultPaymentsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) NavValue(ctx context.Context, in *QueryNavValueRequest, opts ...grpc.CallOption) (*QueryNavValueResponse, error) {
+	out := new(QueryNavValueResponse)
+	err := c.cc.Invoke(ctx, "/provlabs.vault.v1.Query/NavValue", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *QueryVaultPaymentsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Payments) > 0 {
-		for iNdEx := len(m.Payments) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Payments[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+func (c *queryClient) PendingNAVProposals(ctx context.Context, in *QueryPendingNAVProposalsRequest, opts ...grpc.CallOption) (*QueryPendingNAVProposalsResponse, error) {
+	out := new(QueryPendingNAVProposalsResponse)
+	err := c.cc.Invoke(ctx, "/provlabs.vault.v1.Query/PendingNAVProposals", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (c *queryClient) NAVSources(ctx context.Context, in *QueryNAVSourcesRequest, opts ...grpc.CallOption) (*QueryNAVSourcesResponse, error) {
+	out := new(QueryNAVSourcesResponse)
+	err := c.cc.Invoke(ctx, "/provlabs.vault.v1.Query/NAVSources", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return out, nil
 }
-func (m *QueryVaultPendingSwapOutsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Id)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+
+func (c *queryClient) NavHistory(ctx context.Context, in *QueryNavHistoryRequest, opts ...grpc.CallOption) (*QueryNavHistoryResponse, error) {
+	out := new(QueryNavHistoryResponse)
+	err := c.cc.Invoke(ctx, "/provlabs.vault.v1.Query/NavHistory", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *QueryVaultPendingSwapOutsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.PendingSwapOuts) > 0 {
-		for _, e := range m.PendingSwapOuts {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
-	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+func (c *queryClient) VaultPayment(ctx context.Context, in *QueryVaultPaymentRequest, opts ...grpc.CallOption) (*QueryVaultPaymentResponse, error) {
+	out := new(QueryVaultPaymentResponse)
+	err := c.cc.Invoke(ctx, "/provlabs.vault.v1.Query/VaultPayment", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *QueryPendingSwapOutsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+func (c *queryClient) VaultPayments(ctx context.Context, in *QueryVaultPaymentsRequest, opts ...grpc.CallOption) (*QueryVaultPaymentsResponse, error) {
+	out := new(QueryVaultPaymentsResponse)
+	err := c.cc.Invoke(ctx, "/provlabs.vault.v1.Query/VaultPayments", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *QueryPendingSwapOutsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.PendingSwapOuts) > 0 {
-		for _, e := range m.PendingSwapOuts {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
-	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+func (c *queryClient) SharePrice(ctx context.Context, in *QuerySharePriceRequest, opts ...grpc.CallOption) (*QuerySharePriceResponse, error) {
+	out := new(QuerySharePriceResponse)
+	err := c.cc.Invoke(ctx, "/provlabs.vault.v1.Query/SharePrice", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *PendingSwapOutWithTimeout) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.RequestId != 0 {
-		n += 1 + sovQuery(uint64(m.RequestId))
+func (c *queryClient) VaultTWAP(ctx context.Context, in *QueryVaultTWAPRequest, opts ...grpc.CallOption) (*QueryVaultTWAPResponse, error) {
+	out := new(QueryVaultTWAPResponse)
+	err := c.cc.Invoke(ctx, "/provlabs.vault.v1.Query/VaultTWAP", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	l = m.PendingSwapOut.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Timeout)
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return out, nil
 }
 
-func (m *QueryVaultsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+func (c *queryClient) VaultPerformance(ctx context.Context, in *QueryVaultPerformanceRequest, opts ...grpc.CallOption) (*QueryVaultPerformanceResponse, error) {
+	out := new(QueryVaultPerformanceResponse)
+	err := c.cc.Invoke(ctx, "/provlabs.vault.v1.Query/VaultPerformance", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *QueryVaultsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.Vaults) > 0 {
-		for _, e := range m.Vaults {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
-	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+func (c *queryClient) VaultComposition(ctx context.Context, in *QueryVaultCompositionRequest, opts ...grpc.CallOption) (*QueryVaultCompositionResponse, error) {
+	out := new(QueryVaultCompositionResponse)
+	err := c.cc.Invoke(ctx, "/provlabs.vault.v1.Query/VaultComposition", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *QueryVaultRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *queryClient) VaultHoldings(ctx context.Context, in *QueryVaultHoldingsRequest, opts ...grpc.CallOption) (*QueryVaultHoldingsResponse, error) {
+	out := new(QueryVaultHoldingsResponse)
+	err := c.cc.Invoke(ctx, "/provlabs.vault.v1.Query/VaultHoldings", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.Id)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	return out, nil
+}
+
+func (c *queryClient) ScheduledActions(ctx context.Context, in *QueryScheduledActionsRequest, opts ...grpc.CallOption) (*QueryScheduledActionsResponse, error) {
+	out := new(QueryScheduledActionsResponse)
+	err := c.cc.Invoke(ctx, "/provlabs.vault.v1.Query/ScheduledActions", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *QueryVaultResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *queryClient) InvestorEligibility(ctx context.Context, in *QueryInvestorEligibilityRequest, opts ...grpc.CallOption) (*QueryInvestorEligibilityResponse, error) {
+	out := new(QueryInvestorEligibilityResponse)
+	err := c.cc.Invoke(ctx, "/provlabs.vault.v1.Query/InvestorEligibility", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = m.Vault.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	l = m.Principal.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	l = m.Reserves.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	l = m.TotalVaultValue.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return out, nil
 }
 
-func (m *QueryEstimateSwapInRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *queryClient) CircuitBreaker(ctx context.Context, in *QueryCircuitBreakerRequest, opts ...grpc.CallOption) (*QueryCircuitBreakerResponse, error) {
+	out := new(QueryCircuitBreakerResponse)
+	err := c.cc.Invoke(ctx, "/provlabs.vault.v1.Query/CircuitBreaker", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.VaultAddress)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	l = m.Assets.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return out, nil
 }
 
-func (m *QueryEstimateSwapInResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.Assets.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	if m.Height != 0 {
-		n += 1 + sovQuery(uint64(m.Height))
-	}
-	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Time)
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	// Vaults returns a paginated list of all vaults.
+	Vaults(context.Context, *QueryVaultsRequest) (*QueryVaultsResponse, error)
+	// Vault returns the configuration and state of a specific vault.
+	Vault(context.Context, *QueryVaultRequest) (*QueryVaultResponse, error)
+	// EstimateSwapIn estimates the amount of shares that would be received for a given amount of underlying assets.
+	EstimateSwapIn(context.Context, *QueryEstimateSwapInRequest) (*QueryEstimateSwapInResponse, error)
+	// EstimateSwapOut estimates the amount of underlying assets that would be received for a given amount of shares.
+	EstimateSwapOut(context.Context, *QueryEstimateSwapOutRequest) (*QueryEstimateSwapOutResponse, error)
+	// PendingSwapOuts returns a paginated list of all pending swap outs.
+	PendingSwapOuts(context.Context, *QueryPendingSwapOutsRequest) (*QueryPendingSwapOutsResponse, error)
+	// VaultPendingSwapOuts returns a paginated list of all pending swap outs for a specific vault.
+	VaultPendingSwapOuts(context.Context, *QueryVaultPendingSwapOutsRequest) (*QueryVaultPendingSwapOutsResponse, error)
+	// Params returns the current module parameters.
+	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+	// VaultNavs returns a paginated list of all internal NAV entries for a vault, along with
+	// the vault's valuation haircuts.
+	VaultNavs(context.Context, *QueryVaultNavsRequest) (*QueryVaultNavsResponse, error)
+	// NavValue returns a single internal NAV entry for a vault and denom.
+	NavValue(context.Context, *QueryNavValueRequest) (*QueryNavValueResponse, error)
+	// PendingNAVProposals returns a paginated list of a vault's NAV updates awaiting approval
+	// because they exceeded the vault's max_nav_change_bips.
+	PendingNAVProposals(context.Context, *QueryPendingNAVProposalsRequest) (*QueryPendingNAVProposalsResponse, error)
+	// NAVSources returns each NAV source's submission for a vault and denom, whether it
+	// counts toward the quorum, and the aggregated price when the quorum is met.
+	NAVSources(context.Context, *QueryNAVSourcesRequest) (*QueryNAVSourcesResponse, error)
+	// NavHistory returns a paginated, optionally downsampled time series of a vault's NAV for
+	// a denom. Querying the vault's share denom returns its published share NAV history.
+	NavHistory(context.Context, *QueryNavHistoryRequest) (*QueryNavHistoryResponse, error)
+	// VaultPayment returns a single pending exchange-module payment targeting a vault,
+	// identified by the payment's source account and external id.
+	VaultPayment(context.Context, *QueryVaultPaymentRequest) (*QueryVaultPaymentResponse, error)
+	// VaultPayments returns a paginated list of all pending exchange-module payments
+	// targeting a vault.
+	VaultPayments(context.Context, *QueryVaultPaymentsRequest) (*QueryVaultPaymentsResponse, error)
+	// SharePrice returns the vault's NAV per share as a decimal, gross, net of AUM fees, and
+	// with accrued interest estimated to the current block.
+	SharePrice(context.Context, *QuerySharePriceRequest) (*QuerySharePriceResponse, error)
+	// VaultTWAP returns the vault's time-weighted average share price over a time window,
+	// read from its share price accumulator.
+	VaultTWAP(context.Context, *QueryVaultTWAPRequest) (*QueryVaultTWAPResponse, error)
+	// VaultPerformance returns the vault's share price return metrics over trailing 7, 30, 90
+	// and 365 day windows, read from its share price accumulator.
+	VaultPerformance(context.Context, *QueryVaultPerformanceRequest) (*QueryVaultPerformanceResponse, error)
+	// VaultComposition returns the value of each denom a vault holds as a share of its total
+	// vault value, against the vault's concentration limits.
+	VaultComposition(context.Context, *QueryVaultCompositionRequest) (*QueryVaultCompositionResponse, error)
+	// VaultHoldings returns each denom a vault holds with the price and valuation that
+	// total vault value is built from, and the balances it leaves unvalued.
+	VaultHoldings(context.Context, *QueryVaultHoldingsRequest) (*QueryVaultHoldingsResponse, error)
+	// ScheduledActions returns a paginated list of a vault's scheduled actions awaiting their
+	// timelock, ordered by execute time.
+	ScheduledActions(context.Context, *QueryScheduledActionsRequest) (*QueryScheduledActionsResponse, error)
+	// InvestorEligibility reports whether an address may subscribe to and hold a vault's shares
+	// under the vault's eligibility policy.
+	InvestorEligibility(context.Context, *QueryInvestorEligibilityRequest) (*QueryInvestorEligibilityResponse, error)
+	// CircuitBreaker returns the module-wide circuit breaker and the guardian allowed to change it.
+	CircuitBreaker(context.Context, *QueryCircuitBreakerRequest) (*QueryCircuitBreakerResponse, error)
 }
 
-func (m *QueryEstimateSwapOutRequest) Size() (n int) {
-	if m == nil {
-		return 0
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
+}
+
+func (*UnimplementedQueryServer) Vaults(ctx context.Context, req *QueryVaultsRequest) (*QueryVaultsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Vaults not implemented")
+}
+func (*UnimplementedQueryServer) Vault(ctx context.Context, req *QueryVaultRequest) (*QueryVaultResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Vault not implemented")
+}
+func (*UnimplementedQueryServer) EstimateSwapIn(ctx context.Context, req *QueryEstimateSwapInRequest) (*QueryEstimateSwapInResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EstimateSwapIn not implemented")
+}
+func (*UnimplementedQueryServer) EstimateSwapOut(ctx context.Context, req *QueryEstimateSwapOutRequest) (*QueryEstimateSwapOutResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EstimateSwapOut not implemented")
+}
+func (*UnimplementedQueryServer) PendingSwapOuts(ctx context.Context, req *QueryPendingSwapOutsRequest) (*QueryPendingSwapOutsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PendingSwapOuts not implemented")
+}
+func (*UnimplementedQueryServer) VaultPendingSwapOuts(ctx context.Context, req *QueryVaultPendingSwapOutsRequest) (*QueryVaultPendingSwapOutsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VaultPendingSwapOuts not implemented")
+}
+func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+}
+func (*UnimplementedQueryServer) VaultNavs(ctx context.Context, req *QueryVaultNavsRequest) (*QueryVaultNavsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VaultNavs not implemented")
+}
+func (*UnimplementedQueryServer) NavValue(ctx context.Context, req *QueryNavValueRequest) (*QueryNavValueResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method NavValue not implemented")
+}
+func (*UnimplementedQueryServer) PendingNAVProposals(ctx context.Context, req *QueryPendingNAVProposalsRequest) (*QueryPendingNAVProposalsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PendingNAVProposals not implemented")
+}
+func (*UnimplementedQueryServer) NAVSources(ctx context.Context, req *QueryNAVSourcesRequest) (*QueryNAVSourcesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method NAVSources not implemented")
+}
+func (*UnimplementedQueryServer) NavHistory(ctx context.Context, req *QueryNavHistoryRequest) (*QueryNavHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method NavHistory not implemented")
+}
+func (*UnimplementedQueryServer) VaultPayment(ctx context.Context, req *QueryVaultPaymentRequest) (*QueryVaultPaymentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VaultPayment not implemented")
+}
+func (*UnimplementedQueryServer) VaultPayments(ctx context.Context, req *QueryVaultPaymentsRequest) (*QueryVaultPaymentsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VaultPayments not implemented")
+}
+func (*UnimplementedQueryServer) SharePrice(ctx context.Context, req *QuerySharePriceRequest) (*QuerySharePriceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SharePrice not implemented")
+}
+func (*UnimplementedQueryServer) VaultTWAP(ctx context.Context, req *QueryVaultTWAPRequest) (*QueryVaultTWAPResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VaultTWAP not implemented")
+}
+func (*UnimplementedQueryServer) VaultPerformance(ctx context.Context, req *QueryVaultPerformanceRequest) (*QueryVaultPerformanceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VaultPerformance not implemented")
+}
+func (*UnimplementedQueryServer) VaultComposition(ctx context.Context, req *QueryVaultCompositionRequest) (*QueryVaultCompositionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VaultComposition not implemented")
+}
+func (*UnimplementedQueryServer) VaultHoldings(ctx context.Context, req *QueryVaultHoldingsRequest) (*QueryVaultHoldingsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VaultHoldings not implemented")
+}
+func (*UnimplementedQueryServer) ScheduledActions(ctx context.Context, req *QueryScheduledActionsRequest) (*QueryScheduledActionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ScheduledActions not implemented")
+}
+func (*UnimplementedQueryServer) InvestorEligibility(ctx context.Context, req *QueryInvestorEligibilityRequest) (*QueryInvestorEligibilityResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method InvestorEligibility not implemented")
+}
+func (*UnimplementedQueryServer) CircuitBreaker(ctx context.Context, req *QueryCircuitBreakerRequest) (*QueryCircuitBreakerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CircuitBreaker not implemented")
+}
+
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
+}
+
+func _Query_Vaults_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryVaultsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.VaultAddress)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if interceptor == nil {
+		return srv.(QueryServer).Vaults(ctx, in)
 	}
-	l = len(m.Shares)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provlabs.vault.v1.Query/Vaults",
 	}
-	l = len(m.RedeemDenom)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Vaults(ctx, req.(*QueryVaultsRequest))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryEstimateSwapOutResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_Vault_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryVaultRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = m.Assets.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	if m.Height != 0 {
-		n += 1 + sovQuery(uint64(m.Height))
+	if interceptor == nil {
+		return srv.(QueryServer).Vault(ctx, in)
 	}
-	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Time)
-	n += 1 + l + sovQuery(uint64(l))
-	return n
-}
-
-func (m *QueryParamsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provlabs.vault.v1.Query/Vault",
 	}
-	var l int
-	_ = l
-	return n
-}
-
-func (m *QueryParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Vault(ctx, req.(*QueryVaultRequest))
 	}
-	var l int
-	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryVaultNavsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_EstimateSwapIn_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryEstimateSwapInRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.Id)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if interceptor == nil {
+		return srv.(QueryServer).EstimateSwapIn(ctx, in)
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provlabs.vault.v1.Query/EstimateSwapIn",
 	}
-	return n
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).EstimateSwapIn(ctx, req.(*QueryEstimateSwapInRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryVaultNavsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_EstimateSwapOut_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryEstimateSwapOutRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if len(m.Navs) > 0 {
-		for _, e := range m.Navs {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
+	if interceptor == nil {
+		return srv.(QueryServer).EstimateSwapOut(ctx, in)
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provlabs.vault.v1.Query/EstimateSwapOut",
 	}
-	return n
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).EstimateSwapOut(ctx, req.(*QueryEstimateSwapOutRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryNavValueRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_PendingSwapOuts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPendingSwapOutsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.Id)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if interceptor == nil {
+		return srv.(QueryServer).PendingSwapOuts(ctx, in)
 	}
-	l = len(m.Denom)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provlabs.vault.v1.Query/PendingSwapOuts",
 	}
-	return n
-}
-
-func (m *QueryNavValueResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PendingSwapOuts(ctx, req.(*QueryPendingSwapOutsRequest))
 	}
-	var l int
-	_ = l
-	l = m.Nav.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *Payment) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_VaultPendingSwapOuts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryVaultPendingSwapOutsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.Source)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if interceptor == nil {
+		return srv.(QueryServer).VaultPendingSwapOuts(ctx, in)
 	}
-	if len(m.SourceAmount) > 0 {
-		for _, e := range m.SourceAmount {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provlabs.vault.v1.Query/VaultPendingSwapOuts",
 	}
-	l = len(m.Target)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).VaultPendingSwapOuts(ctx, req.(*QueryVaultPendingSwapOutsRequest))
 	}
-	if len(m.TargetAmount) > 0 {
-		for _, e := range m.TargetAmount {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	l = len(m.ExternalId)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if interceptor == nil {
+		return srv.(QueryServer).Params(ctx, in)
 	}
-	return n
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provlabs.vault.v1.Query/Params",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryVaultPaymentRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_VaultNavs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryVaultNavsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.Id)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if interceptor == nil {
+		return srv.(QueryServer).VaultNavs(ctx, in)
 	}
-	l = len(m.Source)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provlabs.vault.v1.Query/VaultNavs",
 	}
-	l = len(m.ExternalId)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).VaultNavs(ctx, req.(*QueryVaultNavsRequest))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryVaultPaymentResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_NavValue_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryNavValueRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = m.Payment.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	if interceptor == nil {
+		return srv.(QueryServer).NavValue(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provlabs.vault.v1.Query/NavValue",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).NavValue(ctx, req.(*QueryNavValueRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryVaultPaymentsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_PendingNAVProposals_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPendingNAVProposalsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.Id)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if interceptor == nil {
+		return srv.(QueryServer).PendingNAVProposals(ctx, in)
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provlabs.vault.v1.Query/PendingNAVProposals",
 	}
-	return n
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PendingNAVProposals(ctx, req.(*QueryPendingNAVProposalsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryVaultPaymentsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_NAVSources_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryNAVSourcesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if len(m.Payments) > 0 {
-		for _, e := range m.Payments {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	if interceptor == nil {
+		return srv.(QueryServer).NAVSources(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provlabs.vault.v1.Query/NAVSources",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).NAVSources(ctx, req.(*QueryNAVSourcesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_NavHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryNavHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).NavHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provlabs.vault.v1.Query/NavHistory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).NavHistory(ctx, req.(*QueryNavHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_VaultPayment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryVaultPaymentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).VaultPayment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provlabs.vault.v1.Query/VaultPayment",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).VaultPayment(ctx, req.(*QueryVaultPaymentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_VaultPayments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryVaultPaymentsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).VaultPayments(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provlabs.vault.v1.Query/VaultPayments",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).VaultPayments(ctx, req.(*QueryVaultPaymentsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_SharePrice_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySharePriceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SharePrice(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provlabs.vault.v1.Query/SharePrice",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SharePrice(ctx, req.(*QuerySharePriceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_VaultTWAP_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryVaultTWAPRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).VaultTWAP(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provlabs.vault.v1.Query/VaultTWAP",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).VaultTWAP(ctx, req.(*QueryVaultTWAPRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_VaultPerformance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryVaultPerformanceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).VaultPerformance(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provlabs.vault.v1.Query/VaultPerformance",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).VaultPerformance(ctx, req.(*QueryVaultPerformanceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_VaultComposition_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryVaultCompositionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).VaultComposition(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provlabs.vault.v1.Query/VaultComposition",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).VaultComposition(ctx, req.(*QueryVaultCompositionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_VaultHoldings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryVaultHoldingsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).VaultHoldings(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provlabs.vault.v1.Query/VaultHoldings",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).VaultHoldings(ctx, req.(*QueryVaultHoldingsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ScheduledActions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryScheduledActionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ScheduledActions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provlabs.vault.v1.Query/ScheduledActions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ScheduledActions(ctx, req.(*QueryScheduledActionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_InvestorEligibility_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryInvestorEligibilityRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).InvestorEligibility(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provlabs.vault.v1.Query/InvestorEligibility",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).InvestorEligibility(ctx, req.(*QueryInvestorEligibilityRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_CircuitBreaker_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryCircuitBreakerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).CircuitBreaker(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provlabs.vault.v1.Query/CircuitBreaker",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).CircuitBreaker(ctx, req.(*QueryCircuitBreakerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var Query_serviceDesc = _Query_serviceDesc
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "provlabs.vault.v1.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Vaults",
+			Handler:    _Query_Vaults_Handler,
+		},
+		{
+			MethodName: "Vault",
+			Handler:    _Query_Vault_Handler,
+		},
+		{
+			MethodName: "EstimateSwapIn",
+			Handler:    _Query_EstimateSwapIn_Handler,
+		},
+		{
+			MethodName: "EstimateSwapOut",
+			Handler:    _Query_EstimateSwapOut_Handler,
+		},
+		{
+			MethodName: "PendingSwapOuts",
+			Handler:    _Query_PendingSwapOuts_Handler,
+		},
+		{
+			MethodName: "VaultPendingSwapOuts",
+			Handler:    _Query_VaultPendingSwapOuts_Handler,
+		},
+		{
+			MethodName: "Params",
+			Handler:    _Query_Params_Handler,
+		},
+		{
+			MethodName: "VaultNavs",
+			Handler:    _Query_VaultNavs_Handler,
+		},
+		{
+			MethodName: "NavValue",
+			Handler:    _Query_NavValue_Handler,
+		},
+		{
+			MethodName: "PendingNAVProposals",
+			Handler:    _Query_PendingNAVProposals_Handler,
+		},
+		{
+			MethodName: "NAVSources",
+			Handler:    _Query_NAVSources_Handler,
+		},
+		{
+			MethodName: "NavHistory",
+			Handler:    _Query_NavHistory_Handler,
+		},
+		{
+			MethodName: "VaultPayment",
+			Handler:    _Query_VaultPayment_Handler,
+		},
+		{
+			MethodName: "VaultPayments",
+			Handler:    _Query_VaultPayments_Handler,
+		},
+		{
+			MethodName: "SharePrice",
+			Handler:    _Query_SharePrice_Handler,
+		},
+		{
+			MethodName: "VaultTWAP",
+			Handler:    _Query_VaultTWAP_Handler,
+		},
+		{
+			MethodName: "VaultPerformance",
+			Handler:    _Query_VaultPerformance_Handler,
+		},
+		{
+			MethodName: "VaultComposition",
+			Handler:    _Query_VaultComposition_Handler,
+		},
+		{
+			MethodName: "VaultHoldings",
+			Handler:    _Query_VaultHoldings_Handler,
+		},
+		{
+			MethodName: "ScheduledActions",
+			Handler:    _Query_ScheduledActions_Handler,
+		},
+		{
+			MethodName: "InvestorEligibility",
+			Handler:    _Query_InvestorEligibility_Handler,
+		},
+		{
+			MethodName: "CircuitBreaker",
+			Handler:    _Query_CircuitBreaker_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "provlabs/vault/v1/query.proto",
+}
+
+func (m *QueryVaultPendingSwapOutsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryVaultPendingSwapOutsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryVaultPendingSwapOutsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryVaultPendingSwapOutsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryVaultPendingSwapOutsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryVaultPendingSwapOutsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.PendingSwapOuts) > 0 {
+		for iNdEx := len(m.PendingSwapOuts) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PendingSwapOuts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPendingSwapOutsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPendingSwapOutsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPendingSwapOutsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPendingSwapOutsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPendingSwapOutsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPendingSwapOutsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.PendingSwapOuts) > 0 {
+		for iNdEx := len(m.PendingSwapOuts) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PendingSwapOuts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PendingSwapOutWithTimeout) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PendingSwapOutWithTimeout) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PendingSwapOutWithTimeout) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n5, err5 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.Timeout, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Timeout):])
+	if err5 != nil {
+		return 0, err5
+	}
+	i -= n5
+	i = encodeVarintQuery(dAtA, i, uint64(n5))
+	i--
+	dAtA[i] = 0x1a
+	{
+		size, err := m.PendingSwapOut.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.RequestId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.RequestId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryVaultsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryVaultsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryVaultsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryVaultsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryVaultsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryVaultsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Vaults) > 0 {
+		for iNdEx := len(m.Vaults) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Vaults[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryVaultRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryVaultRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryVaultRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryVaultResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryVaultResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryVaultResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.LiquidityBuffer.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x42
+	if m.NavStale {
+		i--
+		if m.NavStale {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.OldestNavAgeSeconds != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.OldestNavAgeSeconds))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.OldestNavDenom) > 0 {
+		i -= len(m.OldestNavDenom)
+		copy(dAtA[i:], m.OldestNavDenom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.OldestNavDenom)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	{
+		size, err := m.TotalVaultValue.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size, err := m.Reserves.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size, err := m.Principal.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.Vault.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *LiquidityBuffer) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *LiquidityBuffer) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LiquidityBuffer) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Shortfall.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size, err := m.Required.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size, err := m.ReservedRedemptions.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size, err := m.Liquid.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.MinLiquidityBips != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.MinLiquidityBips))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryEstimateSwapInRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryEstimateSwapInRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryEstimateSwapInRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Assets.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.VaultAddress) > 0 {
+		i -= len(m.VaultAddress)
+		copy(dAtA[i:], m.VaultAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.VaultAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryEstimateSwapInResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryEstimateSwapInResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryEstimateSwapInResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.RemainingCapacity != nil {
+		{
+			size, err := m.RemainingCapacity.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	n20, err20 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.Time, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Time):])
+	if err20 != nil {
+		return 0, err20
+	}
+	i -= n20
+	i = encodeVarintQuery(dAtA, i, uint64(n20))
+	i--
+	dAtA[i] = 0x1a
+	if m.Height != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x10
+	}
+	{
+		size, err := m.Assets.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryEstimateSwapOutRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryEstimateSwapOutRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryEstimateSwapOutRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.RedeemDenom) > 0 {
+		i -= len(m.RedeemDenom)
+		copy(dAtA[i:], m.RedeemDenom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.RedeemDenom)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Shares) > 0 {
+		i -= len(m.Shares)
+		copy(dAtA[i:], m.Shares)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Shares)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.VaultAddress) > 0 {
+		i -= len(m.VaultAddress)
+		copy(dAtA[i:], m.VaultAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.VaultAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryEstimateSwapOutResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryEstimateSwapOutResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryEstimateSwapOutResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n22, err22 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.Time, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Time):])
+	if err22 != nil {
+		return 0, err22
+	}
+	i -= n22
+	i = encodeVarintQuery(dAtA, i, uint64(n22))
+	i--
+	dAtA[i] = 0x1a
+	if m.Height != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x10
+	}
+	{
+		size, err := m.Assets.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryVaultNavsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryVaultNavsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryVaultNavsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryVaultNavsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryVaultNavsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryVaultNavsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Haircuts) > 0 {
+		for iNdEx := len(m.Haircuts) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Haircuts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Navs) > 0 {
+		for iNdEx := len(m.Navs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Navs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryNavValueRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryNavValueRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryNavValueRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryNavValueResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryNavValueResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryNavValueResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Price.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.PriceSource != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.PriceSource))
+		i--
+		dAtA[i] = 0x10
+	}
+	{
+		size, err := m.Nav.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPendingNAVProposalsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPendingNAVProposalsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPendingNAVProposalsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPendingNAVProposalsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPendingNAVProposalsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPendingNAVProposalsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Proposals) > 0 {
+		for iNdEx := len(m.Proposals) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Proposals[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryNAVSourcesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryNAVSourcesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryNAVSourcesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryNAVSourcesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryNAVSourcesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryNAVSourcesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Aggregate != nil {
+		{
+			size, err := m.Aggregate.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Aggregation != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Aggregation))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Quorum != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Quorum))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Contributions) > 0 {
+		for iNdEx := len(m.Contributions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Contributions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *NAVSourceContribution) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *NAVSourceContribution) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *NAVSourceContribution) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Fresh {
+		i--
+		if m.Fresh {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	{
+		size, err := m.Nav.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Source) > 0 {
+		i -= len(m.Source)
+		copy(dAtA[i:], m.Source)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Source)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryNavHistoryRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryNavHistoryRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryNavHistoryRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.IntervalSeconds != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.IntervalSeconds))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.EndTime != nil {
+		n34, err34 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.EndTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.EndTime):])
+		if err34 != nil {
+			return 0, err34
+		}
+		i -= n34
+		i = encodeVarintQuery(dAtA, i, uint64(n34))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.StartTime != nil {
+		n35, err35 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.StartTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.StartTime):])
+		if err35 != nil {
+			return 0, err35
+		}
+		i -= n35
+		i = encodeVarintQuery(dAtA, i, uint64(n35))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryNavHistoryResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryNavHistoryResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryNavHistoryResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Entries) > 0 {
+		for iNdEx := len(m.Entries) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Entries[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Payment) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Payment) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Payment) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ExternalId) > 0 {
+		i -= len(m.ExternalId)
+		copy(dAtA[i:], m.ExternalId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ExternalId)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.TargetAmount) > 0 {
+		for iNdEx := len(m.TargetAmount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TargetAmount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.Target) > 0 {
+		i -= len(m.Target)
+		copy(dAtA[i:], m.Target)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Target)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.SourceAmount) > 0 {
+		for iNdEx := len(m.SourceAmount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SourceAmount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Source) > 0 {
+		i -= len(m.Source)
+		copy(dAtA[i:], m.Source)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Source)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryVaultPaymentRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryVaultPaymentRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryVaultPaymentRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ExternalId) > 0 {
+		i -= len(m.ExternalId)
+		copy(dAtA[i:], m.ExternalId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ExternalId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Source) > 0 {
+		i -= len(m.Source)
+		copy(dAtA[i:], m.Source)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Source)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryVaultPaymentResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryVaultPaymentResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryVaultPaymentResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Payment.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryVaultPaymentsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryVaultPaymentsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryVaultPaymentsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryVaultPaymentsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryVaultPaymentsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryVaultPaymentsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Payments) > 0 {
+		for iNdEx := len(m.Payments) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Payments[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySharePriceRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySharePriceRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySharePriceRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySharePriceResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySharePriceResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySharePriceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n40, err40 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.Time, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Time):])
+	if err40 != nil {
+		return 0, err40
+	}
+	i -= n40
+	i = encodeVarintQuery(dAtA, i, uint64(n40))
+	i--
+	dAtA[i] = 0x42
+	if m.Height != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.Exponent != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Exponent))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.ShareUnit) > 0 {
+		i -= len(m.ShareUnit)
+		copy(dAtA[i:], m.ShareUnit)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ShareUnit)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.PriceDenom) > 0 {
+		i -= len(m.PriceDenom)
+		copy(dAtA[i:], m.PriceDenom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.PriceDenom)))
+		i--
+		dAtA[i] = 0x22
+	}
+	{
+		size := m.Estimated.Size()
+		i -= size
+		if _, err := m.Estimated.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.Net.Size()
+		i -= size
+		if _, err := m.Net.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.Gross.Size()
+		i -= size
+		if _, err := m.Gross.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryVaultTWAPRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryVaultTWAPRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryVaultTWAPRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.EndTime != nil {
+		n41, err41 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.EndTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.EndTime):])
+		if err41 != nil {
+			return 0, err41
+		}
+		i -= n41
+		i = encodeVarintQuery(dAtA, i, uint64(n41))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.StartTime != nil {
+		n42, err42 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.StartTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.StartTime):])
+		if err42 != nil {
+			return 0, err42
+		}
+		i -= n42
+		i = encodeVarintQuery(dAtA, i, uint64(n42))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryVaultTWAPResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryVaultTWAPResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryVaultTWAPResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n43, err43 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.EndTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.EndTime):])
+	if err43 != nil {
+		return 0, err43
+	}
+	i -= n43
+	i = encodeVarintQuery(dAtA, i, uint64(n43))
+	i--
+	dAtA[i] = 0x22
+	n44, err44 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.StartTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.StartTime):])
+	if err44 != nil {
+		return 0, err44
+	}
+	i -= n44
+	i = encodeVarintQuery(dAtA, i, uint64(n44))
+	i--
+	dAtA[i] = 0x1a
+	if len(m.PriceDenom) > 0 {
+		i -= len(m.PriceDenom)
+		copy(dAtA[i:], m.PriceDenom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.PriceDenom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	{
+		size := m.Twap.Size()
+		i -= size
+		if _, err := m.Twap.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryVaultPerformanceRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryVaultPerformanceRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryVaultPerformanceRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryVaultPerformanceResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryVaultPerformanceResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryVaultPerformanceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n45, err45 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.Time, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Time):])
+	if err45 != nil {
+		return 0, err45
+	}
+	i -= n45
+	i = encodeVarintQuery(dAtA, i, uint64(n45))
+	i--
+	dAtA[i] = 0x22
+	if m.Height != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.PriceDenom) > 0 {
+		i -= len(m.PriceDenom)
+		copy(dAtA[i:], m.PriceDenom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.PriceDenom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Windows) > 0 {
+		for iNdEx := len(m.Windows) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Windows[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *VaultPerformanceWindow) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *VaultPerformanceWindow) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *VaultPerformanceWindow) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.MaxDrawdown.Size()
+		i -= size
+		if _, err := m.MaxDrawdown.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x42
+	{
+		size := m.AnnualizedReturn.Size()
+		i -= size
+		if _, err := m.AnnualizedReturn.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	{
+		size := m.RealizedYield.Size()
+		i -= size
+		if _, err := m.RealizedYield.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	{
+		size := m.EndPrice.Size()
+		i -= size
+		if _, err := m.EndPrice.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.StartPrice.Size()
+		i -= size
+		if _, err := m.StartPrice.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if m.Complete {
+		i--
+		if m.Complete {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	n46, err46 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.StartTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.StartTime):])
+	if err46 != nil {
+		return 0, err46
+	}
+	i -= n46
+	i = encodeVarintQuery(dAtA, i, uint64(n46))
+	i--
+	dAtA[i] = 0x12
+	if m.WindowDays != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.WindowDays))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryVaultCompositionRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryVaultCompositionRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryVaultCompositionRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryVaultCompositionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryVaultCompositionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryVaultCompositionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n47, err47 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.Time, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Time):])
+	if err47 != nil {
+		return 0, err47
+	}
+	i -= n47
+	i = encodeVarintQuery(dAtA, i, uint64(n47))
+	i--
+	dAtA[i] = 0x2a
+	if m.Height != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x20
+	}
+	{
+		size, err := m.NonUnderlying.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Assets) > 0 {
+		for iNdEx := len(m.Assets) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Assets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	{
+		size, err := m.TotalValue.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *AssetWeight) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AssetWeight) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AssetWeight) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Breached {
+		i--
+		if m.Breached {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.LimitBips != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.LimitBips))
+		i--
+		dAtA[i] = 0x20
+	}
+	{
+		size := m.WeightBips.Size()
+		i -= size
+		if _, err := m.WeightBips.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size, err := m.Value.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryVaultHoldingsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryVaultHoldingsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryVaultHoldingsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryVaultHoldingsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryVaultHoldingsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryVaultHoldingsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n51, err51 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.Time, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Time):])
+	if err51 != nil {
+		return 0, err51
+	}
+	i -= n51
+	i = encodeVarintQuery(dAtA, i, uint64(n51))
+	i--
+	dAtA[i] = 0x2a
+	if m.Height != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Unvalued) > 0 {
+		for iNdEx := len(m.Unvalued) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Unvalued[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Holdings) > 0 {
+		for iNdEx := len(m.Holdings) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Holdings[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	{
+		size, err := m.TotalValue.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *VaultHolding) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *VaultHolding) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *VaultHolding) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.WeightBips.Size()
+		i -= size
+		if _, err := m.WeightBips.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x42
+	{
+		size, err := m.Value.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	if m.HaircutBips != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.HaircutBips))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.AgeSeconds != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.AgeSeconds))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.AgeBlocks != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.AgeBlocks))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.PriceSource != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.PriceSource))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Nav != nil {
+		{
+			size, err := m.Nav.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	{
+		size, err := m.Balance.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryScheduledActionsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryScheduledActionsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryScheduledActionsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryScheduledActionsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryScheduledActionsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryScheduledActionsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ScheduledActions) > 0 {
+		for iNdEx := len(m.ScheduledActions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ScheduledActions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryInvestorEligibilityRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryInvestorEligibilityRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryInvestorEligibilityRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryInvestorEligibilityResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryInvestorEligibilityResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryInvestorEligibilityResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.MissingAttributes) > 0 {
+		for iNdEx := len(m.MissingAttributes) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.MissingAttributes[iNdEx])
+			copy(dAtA[i:], m.MissingAttributes[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.MissingAttributes[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.Allowlisted {
+		i--
+		if m.Allowlisted {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Eligible {
+		i--
+		if m.Eligible {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryCircuitBreakerRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCircuitBreakerRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCircuitBreakerRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryCircuitBreakerResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCircuitBreakerResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCircuitBreakerResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Guardian) > 0 {
+		i -= len(m.Guardian)
+		copy(dAtA[i:], m.Guardian)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Guardian)))
+		i--
+		dAtA[i] = 0x12
+	}
+	{
+		size, err := m.CircuitBreaker.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryVaultPendingSwapOutsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryVaultPendingSwapOutsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.PendingSwapOuts) > 0 {
+		for _, e := range m.PendingSwapOuts {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryPendingSwapOutsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryPendingSwapOutsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.PendingSwapOuts) > 0 {
+		for _, e := range m.PendingSwapOuts {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *PendingSwapOutWithTimeout) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.RequestId != 0 {
+		n += 1 + sovQuery(uint64(m.RequestId))
+	}
+	l = m.PendingSwapOut.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Timeout)
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryVaultsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryVaultsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Vaults) > 0 {
+		for _, e := range m.Vaults {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryVaultRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryVaultResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Vault.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.Principal.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.Reserves.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.TotalVaultValue.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = len(m.OldestNavDenom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.OldestNavAgeSeconds != 0 {
+		n += 1 + sovQuery(uint64(m.OldestNavAgeSeconds))
+	}
+	if m.NavStale {
+		n += 2
+	}
+	l = m.LiquidityBuffer.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *LiquidityBuffer) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MinLiquidityBips != 0 {
+		n += 1 + sovQuery(uint64(m.MinLiquidityBips))
+	}
+	l = m.Liquid.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.ReservedRedemptions.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.Required.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.Shortfall.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryEstimateSwapInRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.VaultAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = m.Assets.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryEstimateSwapInResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Assets.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.Height != 0 {
+		n += 1 + sovQuery(uint64(m.Height))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Time)
+	n += 1 + l + sovQuery(uint64(l))
+	if m.RemainingCapacity != nil {
+		l = m.RemainingCapacity.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryEstimateSwapOutRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.VaultAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Shares)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.RedeemDenom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryEstimateSwapOutResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Assets.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.Height != 0 {
+		n += 1 + sovQuery(uint64(m.Height))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Time)
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryVaultNavsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryVaultNavsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Navs) > 0 {
+		for _, e := range m.Navs {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if len(m.Haircuts) > 0 {
+		for _, e := range m.Haircuts {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryNavValueRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryNavValueResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Nav.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.PriceSource != 0 {
+		n += 1 + sovQuery(uint64(m.PriceSource))
+	}
+	l = m.Price.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryPendingNAVProposalsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryPendingNAVProposalsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Proposals) > 0 {
+		for _, e := range m.Proposals {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryNAVSourcesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryNAVSourcesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Contributions) > 0 {
+		for _, e := range m.Contributions {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Quorum != 0 {
+		n += 1 + sovQuery(uint64(m.Quorum))
+	}
+	if m.Aggregation != 0 {
+		n += 1 + sovQuery(uint64(m.Aggregation))
+	}
+	if m.Aggregate != nil {
+		l = m.Aggregate.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *NAVSourceContribution) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Source)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = m.Nav.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.Fresh {
+		n += 2
+	}
+	return n
+}
+
+func (m *QueryNavHistoryRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.StartTime != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.StartTime)
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.EndTime != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.EndTime)
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.IntervalSeconds != 0 {
+		n += 1 + sovQuery(uint64(m.IntervalSeconds))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryNavHistoryResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Entries) > 0 {
+		for _, e := range m.Entries {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *Payment) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Source)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if len(m.SourceAmount) > 0 {
+		for _, e := range m.SourceAmount {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	l = len(m.Target)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if len(m.TargetAmount) > 0 {
+		for _, e := range m.TargetAmount {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	l = len(m.ExternalId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryVaultPaymentRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Source)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.ExternalId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryVaultPaymentResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Payment.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryVaultPaymentsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryVaultPaymentsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Payments) > 0 {
+		for _, e := range m.Payments {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QuerySharePriceRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QuerySharePriceResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Gross.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.Net.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.Estimated.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = len(m.PriceDenom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.ShareUnit)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Exponent != 0 {
+		n += 1 + sovQuery(uint64(m.Exponent))
+	}
+	if m.Height != 0 {
+		n += 1 + sovQuery(uint64(m.Height))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Time)
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryVaultTWAPRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.StartTime != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.StartTime)
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.EndTime != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.EndTime)
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryVaultTWAPResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Twap.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = len(m.PriceDenom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.StartTime)
+	n += 1 + l + sovQuery(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.EndTime)
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryVaultPerformanceRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryVaultPerformanceResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Windows) > 0 {
+		for _, e := range m.Windows {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	l = len(m.PriceDenom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Height != 0 {
+		n += 1 + sovQuery(uint64(m.Height))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Time)
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *VaultPerformanceWindow) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.WindowDays != 0 {
+		n += 1 + sovQuery(uint64(m.WindowDays))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.StartTime)
+	n += 1 + l + sovQuery(uint64(l))
+	if m.Complete {
+		n += 2
+	}
+	l = m.StartPrice.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.EndPrice.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.RealizedYield.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.AnnualizedReturn.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.MaxDrawdown.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryVaultCompositionRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryVaultCompositionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.TotalValue.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if len(m.Assets) > 0 {
+		for _, e := range m.Assets {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	l = m.NonUnderlying.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.Height != 0 {
+		n += 1 + sovQuery(uint64(m.Height))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Time)
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *AssetWeight) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = m.Value.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.WeightBips.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.LimitBips != 0 {
+		n += 1 + sovQuery(uint64(m.LimitBips))
+	}
+	if m.Breached {
+		n += 2
+	}
+	return n
+}
+
+func (m *QueryVaultHoldingsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryVaultHoldingsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.TotalValue.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if len(m.Holdings) > 0 {
+		for _, e := range m.Holdings {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.Unvalued) > 0 {
+		for _, e := range m.Unvalued {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Height != 0 {
+		n += 1 + sovQuery(uint64(m.Height))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Time)
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *VaultHolding) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Balance.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.Nav != nil {
+		l = m.Nav.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.PriceSource != 0 {
+		n += 1 + sovQuery(uint64(m.PriceSource))
+	}
+	if m.AgeBlocks != 0 {
+		n += 1 + sovQuery(uint64(m.AgeBlocks))
+	}
+	if m.AgeSeconds != 0 {
+		n += 1 + sovQuery(uint64(m.AgeSeconds))
+	}
+	if m.HaircutBips != 0 {
+		n += 1 + sovQuery(uint64(m.HaircutBips))
+	}
+	l = m.Value.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.WeightBips.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryScheduledActionsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryScheduledActionsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.ScheduledActions) > 0 {
+		for _, e := range m.ScheduledActions {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryInvestorEligibilityRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryInvestorEligibilityResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Eligible {
+		n += 2
+	}
+	if m.Allowlisted {
+		n += 2
+	}
+	if len(m.MissingAttributes) > 0 {
+		for _, s := range m.MissingAttributes {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryCircuitBreakerRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryCircuitBreakerResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.CircuitBreaker.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = len(m.Guardian)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryVaultPendingSwapOutsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryVaultPendingSwapOutsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryVaultPendingSwapOutsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryVaultPendingSwapOutsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryVaultPendingSwapOutsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryVaultPendingSwapOutsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingSwapOuts", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PendingSwapOuts = append(m.PendingSwapOuts, PendingSwapOutWithTimeout{})
+			if err := m.PendingSwapOuts[len(m.PendingSwapOuts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPendingSwapOutsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPendingSwapOutsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPendingSwapOutsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPendingSwapOutsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPendingSwapOutsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPendingSwapOutsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingSwapOuts", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PendingSwapOuts = append(m.PendingSwapOuts, PendingSwapOutWithTimeout{})
+			if err := m.PendingSwapOuts[len(m.PendingSwapOuts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PendingSwapOutWithTimeout) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PendingSwapOutWithTimeout: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PendingSwapOutWithTimeout: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequestId", wireType)
+			}
+			m.RequestId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RequestId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingSwapOut", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.PendingSwapOut.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timeout", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.Timeout, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryVaultsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryVaultsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryVaultsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryVaultsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryVaultsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryVaultsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Vaults", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Vaults = append(m.Vaults, VaultAccount{})
+			if err := m.Vaults[len(m.Vaults)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryVaultRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryVaultRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryVaultRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryVaultResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryVaultResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryVaultResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Vault", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Vault.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Principal", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Principal.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reserves", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Reserves.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalVaultValue", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TotalVaultValue.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OldestNavDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OldestNavDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OldestNavAgeSeconds", wireType)
+			}
+			m.OldestNavAgeSeconds = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OldestNavAgeSeconds |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NavStale", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.NavStale = bool(v != 0)
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LiquidityBuffer", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.LiquidityBuffer.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *LiquidityBuffer) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LiquidityBuffer: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LiquidityBuffer: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinLiquidityBips", wireType)
+			}
+			m.MinLiquidityBips = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MinLiquidityBips |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Liquid", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Liquid.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReservedRedemptions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ReservedRedemptions.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Required", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Required.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Shortfall", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Shortfall.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryEstimateSwapInRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryEstimateSwapInRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryEstimateSwapInRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VaultAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VaultAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Assets", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Assets.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryEstimateSwapInResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryEstimateSwapInResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryEstimateSwapInResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Assets", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Assets.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.Time, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RemainingCapacity", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.RemainingCapacity == nil {
+				m.RemainingCapacity = &types.Coin{}
+			}
+			if err := m.RemainingCapacity.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryEstimateSwapOutRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryEstimateSwapOutRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryEstimateSwapOutRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VaultAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VaultAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Shares", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Shares = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RedeemDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RedeemDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryEstimateSwapOutResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryEstimateSwapOutResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryEstimateSwapOutResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Assets", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Assets.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.Time, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryVaultNavsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryVaultNavsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryVaultNavsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryVaultNavsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryVaultNavsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryVaultNavsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Navs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Navs = append(m.Navs, VaultNAV{})
+			if err := m.Navs[len(m.Navs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Haircuts", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Haircuts = append(m.Haircuts, AssetHaircut{})
+			if err := m.Haircuts[len(m.Haircuts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryNavValueRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryNavValueRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryNavValueRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryNavValueResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryNavValueResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryNavValueResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nav", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Nav.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PriceSource", wireType)
+			}
+			m.PriceSource = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PriceSource |= PriceSourceType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Price.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPendingNAVProposalsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPendingNAVProposalsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPendingNAVProposalsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPendingNAVProposalsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPendingNAVProposalsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPendingNAVProposalsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Proposals", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Proposals = append(m.Proposals, PendingNAVProposal{})
+			if err := m.Proposals[len(m.Proposals)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryNAVSourcesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryNAVSourcesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryNAVSourcesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryNAVSourcesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryNAVSourcesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryNAVSourcesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Contributions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Contributions = append(m.Contributions, NAVSourceContribution{})
+			if err := m.Contributions[len(m.Contributions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Quorum", wireType)
+			}
+			m.Quorum = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Quorum |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Aggregation", wireType)
+			}
+			m.Aggregation = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Aggregation |= NAVAggregation(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Aggregate", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Aggregate == nil {
+				m.Aggregate = &VaultNAV{}
+			}
+			if err := m.Aggregate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *NAVSourceContribution) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: NAVSourceContribution: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: NAVSourceContribution: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Source", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Source = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nav", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Nav.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fresh", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Fresh = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryNavHistoryRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryNavHistoryRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryNavHistoryRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.StartTime == nil {
+				m.StartTime = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.StartTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.EndTime == nil {
+				m.EndTime = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.EndTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IntervalSeconds", wireType)
+			}
+			m.IntervalSeconds = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.IntervalSeconds |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryNavHistoryResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryNavHistoryResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryNavHistoryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Entries", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Entries = append(m.Entries, VaultNAV{})
+			if err := m.Entries[len(m.Entries)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Payment) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Payment: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Payment: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Source", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Source = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SourceAmount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SourceAmount = append(m.SourceAmount, types.Coin{})
+			if err := m.SourceAmount[len(m.SourceAmount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Target", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Target = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetAmount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TargetAmount = append(m.TargetAmount, types.Coin{})
+			if err := m.TargetAmount[len(m.TargetAmount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExternalId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ExternalId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryVaultPaymentRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryVaultPaymentRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryVaultPaymentRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Source", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Source = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExternalId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ExternalId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryVaultPaymentResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryVaultPaymentResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryVaultPaymentResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Payment", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Payment.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
-}
 
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *QueryVaultPendingSwapOutsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryVaultPaymentsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3444,10 +11980,10 @@ func (m *QueryVaultPendingSwapOutsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryVaultPendingSwapOutsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryVaultPaymentsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryVaultPendingSwapOutsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryVaultPaymentsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -3539,7 +12075,7 @@ func (m *QueryVaultPendingSwapOutsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryVaultPendingSwapOutsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryVaultPaymentsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3562,15 +12098,15 @@ func (m *QueryVaultPendingSwapOutsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryVaultPendingSwapOutsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryVaultPaymentsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryVaultPendingSwapOutsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryVaultPaymentsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PendingSwapOuts", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Payments", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3597,8 +12133,8 @@ func (m *QueryVaultPendingSwapOutsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PendingSwapOuts = append(m.PendingSwapOuts, PendingSwapOutWithTimeout{})
-			if err := m.PendingSwapOuts[len(m.PendingSwapOuts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Payments = append(m.Payments, Payment{})
+			if err := m.Payments[len(m.Payments)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3659,7 +12195,7 @@ func (m *QueryVaultPendingSwapOutsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryPendingSwapOutsRequest) Unmarshal(dAtA []byte) error {
+func (m *QuerySharePriceRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3682,17 +12218,17 @@ func (m *QueryPendingSwapOutsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryPendingSwapOutsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QuerySharePriceRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryPendingSwapOutsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QuerySharePriceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3702,27 +12238,23 @@ func (m *QueryPendingSwapOutsRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Id = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3745,7 +12277,7 @@ func (m *QueryPendingSwapOutsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryPendingSwapOutsResponse) Unmarshal(dAtA []byte) error {
+func (m *QuerySharePriceResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3768,17 +12300,17 @@ func (m *QueryPendingSwapOutsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryPendingSwapOutsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QuerySharePriceResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryPendingSwapOutsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QuerySharePriceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PendingSwapOuts", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Gross", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3788,29 +12320,199 @@ func (m *QueryPendingSwapOutsResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Gross.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Net", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Net.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Estimated", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Estimated.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PriceDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PriceDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ShareUnit", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ShareUnit = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Exponent", wireType)
+			}
+			m.Exponent = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Exponent |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.PendingSwapOuts = append(m.PendingSwapOuts, PendingSwapOutWithTimeout{})
-			if err := m.PendingSwapOuts[len(m.PendingSwapOuts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 2:
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3837,10 +12539,7 @@ func (m *QueryPendingSwapOutsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.Time, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3865,7 +12564,7 @@ func (m *QueryPendingSwapOutsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PendingSwapOutWithTimeout) Unmarshal(dAtA []byte) error {
+func (m *QueryVaultTWAPRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3888,17 +12587,17 @@ func (m *PendingSwapOutWithTimeout) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PendingSwapOutWithTimeout: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryVaultTWAPRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PendingSwapOutWithTimeout: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryVaultTWAPRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RequestId", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
 			}
-			m.RequestId = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3908,14 +12607,27 @@ func (m *PendingSwapOutWithTimeout) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.RequestId |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PendingSwapOut", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3942,13 +12654,16 @@ func (m *PendingSwapOutWithTimeout) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.PendingSwapOut.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.StartTime == nil {
+				m.StartTime = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.StartTime, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Timeout", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EndTime", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3975,7 +12690,10 @@ func (m *PendingSwapOutWithTimeout) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.Timeout, dAtA[iNdEx:postIndex]); err != nil {
+			if m.EndTime == nil {
+				m.EndTime = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.EndTime, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -4000,7 +12718,7 @@ func (m *PendingSwapOutWithTimeout) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryVaultsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryVaultTWAPResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4023,17 +12741,17 @@ func (m *QueryVaultsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryVaultsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryVaultTWAPResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryVaultsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryVaultTWAPResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Twap", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4043,81 +12761,61 @@ func (m *QueryVaultsRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Twap.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PriceDenom", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryVaultsResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryVaultsResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryVaultsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.PriceDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Vaults", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4144,14 +12842,13 @@ func (m *QueryVaultsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Vaults = append(m.Vaults, VaultAccount{})
-			if err := m.Vaults[len(m.Vaults)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.StartTime, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EndTime", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4178,10 +12875,7 @@ func (m *QueryVaultsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.EndTime, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -4206,7 +12900,7 @@ func (m *QueryVaultsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryVaultRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryVaultPerformanceRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4229,10 +12923,10 @@ func (m *QueryVaultRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryVaultRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryVaultPerformanceRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryVaultRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryVaultPerformanceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -4288,7 +12982,7 @@ func (m *QueryVaultRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryVaultResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryVaultPerformanceResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4311,15 +13005,15 @@ func (m *QueryVaultResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryVaultResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryVaultPerformanceResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryVaultResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryVaultPerformanceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Vault", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Windows", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4346,15 +13040,16 @@ func (m *QueryVaultResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Vault.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Windows = append(m.Windows, VaultPerformanceWindow{})
+			if err := m.Windows[len(m.Windows)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Principal", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PriceDenom", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4364,30 +13059,29 @@ func (m *QueryVaultResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Principal.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.PriceDenom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Reserves", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
 			}
-			var msglen int
+			m.Height = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4397,28 +13091,14 @@ func (m *QueryVaultResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.Height |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.Reserves.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TotalVaultValue", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4445,7 +13125,7 @@ func (m *QueryVaultResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.TotalVaultValue.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.Time, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -4470,7 +13150,7 @@ func (m *QueryVaultResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryEstimateSwapInRequest) Unmarshal(dAtA []byte) error {
+func (m *VaultPerformanceWindow) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4493,15 +13173,87 @@ func (m *QueryEstimateSwapInRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryEstimateSwapInRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: VaultPerformanceWindow: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryEstimateSwapInRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: VaultPerformanceWindow: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WindowDays", wireType)
+			}
+			m.WindowDays = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.WindowDays |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.StartTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Complete", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Complete = bool(v != 0)
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VaultAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StartPrice", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4529,13 +13281,15 @@ func (m *QueryEstimateSwapInRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.VaultAddress = string(dAtA[iNdEx:postIndex])
+			if err := m.StartPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 2:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Assets", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EndPrice", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4545,80 +13299,31 @@ func (m *QueryEstimateSwapInRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Assets.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.EndPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryEstimateSwapInResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryEstimateSwapInResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryEstimateSwapInResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Assets", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RealizedYield", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4628,30 +13333,31 @@ func (m *QueryEstimateSwapInResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Assets.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.RealizedYield.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AnnualizedReturn", wireType)
 			}
-			m.Height = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4661,16 +13367,31 @@ func (m *QueryEstimateSwapInResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Height |= int64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 3:
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.AnnualizedReturn.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxDrawdown", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4680,22 +13401,23 @@ func (m *QueryEstimateSwapInResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.Time, dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.MaxDrawdown.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -4720,7 +13442,7 @@ func (m *QueryEstimateSwapInResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryEstimateSwapOutRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryVaultCompositionRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4743,15 +13465,15 @@ func (m *QueryEstimateSwapOutRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryEstimateSwapOutRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryVaultCompositionRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryEstimateSwapOutRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryVaultCompositionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VaultAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4779,13 +13501,63 @@ func (m *QueryEstimateSwapOutRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.VaultAddress = string(dAtA[iNdEx:postIndex])
+			m.Id = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryVaultCompositionResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryVaultCompositionResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryVaultCompositionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Shares", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalValue", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4795,29 +13567,30 @@ func (m *QueryEstimateSwapOutRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Shares = string(dAtA[iNdEx:postIndex])
+			if err := m.TotalValue.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 3:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RedeemDenom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Assets", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4827,77 +13600,29 @@ func (m *QueryEstimateSwapOutRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RedeemDenom = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
+			m.Assets = append(m.Assets, AssetWeight{})
+			if err := m.Assets[len(m.Assets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryEstimateSwapOutResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryEstimateSwapOutResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryEstimateSwapOutResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Assets", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NonUnderlying", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4924,11 +13649,11 @@ func (m *QueryEstimateSwapOutResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Assets.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.NonUnderlying.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 4:
 			if wireType != 0 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
 			}
@@ -4947,7 +13672,7 @@ func (m *QueryEstimateSwapOutResponse) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-		case 3:
+		case 5:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
 			}
@@ -5001,7 +13726,7 @@ func (m *QueryEstimateSwapOutResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+func (m *AssetWeight) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5024,65 +13749,47 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: AssetWeight: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: AssetWeight: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5109,10 +13816,83 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Value.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WeightBips", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.WeightBips.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LimitBips", wireType)
+			}
+			m.LimitBips = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LimitBips |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Breached", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Breached = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -5134,7 +13914,7 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryVaultNavsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryVaultHoldingsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5157,10 +13937,10 @@ func (m *QueryVaultNavsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryVaultNavsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryVaultHoldingsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryVaultNavsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryVaultHoldingsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -5195,42 +13975,6 @@ func (m *QueryVaultNavsRequest) Unmarshal(dAtA []byte) error {
 			}
 			m.Id = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -5252,7 +13996,7 @@ func (m *QueryVaultNavsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryVaultNavsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryVaultHoldingsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5275,15 +14019,15 @@ func (m *QueryVaultNavsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryVaultNavsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryVaultHoldingsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryVaultNavsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryVaultHoldingsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Navs", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalValue", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5310,14 +14054,13 @@ func (m *QueryVaultNavsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Navs = append(m.Navs, VaultNAV{})
-			if err := m.Navs[len(m.Navs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.TotalValue.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Holdings", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5339,73 +14082,21 @@ func (m *QueryVaultNavsResponse) Unmarshal(dAtA []byte) error {
 			}
 			postIndex := iNdEx + msglen
 			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryNavValueRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryNavValueRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryNavValueRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Holdings = append(m.Holdings, VaultHolding{})
+			if err := m.Holdings[len(m.Holdings)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Unvalued", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -5415,29 +14106,50 @@ func (m *QueryNavValueRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Id = string(dAtA[iNdEx:postIndex])
+			m.Unvalued = append(m.Unvalued, types.Coin{})
+			if err := m.Unvalued[len(m.Unvalued)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 2:
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -5447,23 +14159,24 @@ func (m *QueryNavValueRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Denom = string(dAtA[iNdEx:postIndex])
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.Time, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -5486,7 +14199,7 @@ func (m *QueryNavValueRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryNavValueResponse) Unmarshal(dAtA []byte) error {
+func (m *VaultHolding) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5509,15 +14222,15 @@ func (m *QueryNavValueResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryNavValueResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: VaultHolding: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryNavValueResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: VaultHolding: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Nav", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Balance", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5544,65 +14257,70 @@ func (m *QueryNavValueResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Nav.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Balance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nav", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			if (iNdEx + skippy) > l {
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *Payment) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
+			if m.Nav == nil {
+				m.Nav = &VaultNAV{}
 			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			if err := m.Nav.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PriceSource", wireType)
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: Payment: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Payment: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Source", wireType)
+			m.PriceSource = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PriceSource |= PriceSourceType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			var stringLen uint64
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AgeBlocks", wireType)
+			}
+			m.AgeBlocks = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -5612,27 +14330,52 @@ func (m *Payment) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.AgeBlocks |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AgeSeconds", wireType)
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
+			m.AgeSeconds = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AgeSeconds |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HaircutBips", wireType)
 			}
-			m.Source = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
+			m.HaircutBips = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.HaircutBips |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SourceAmount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5659,14 +14402,13 @@ func (m *Payment) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SourceAmount = append(m.SourceAmount, types.Coin{})
-			if err := m.SourceAmount[len(m.SourceAmount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Value.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Target", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field WeightBips", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5694,13 +14436,65 @@ func (m *Payment) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Target = string(dAtA[iNdEx:postIndex])
+			if err := m.WeightBips.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 4:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryScheduledActionsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryScheduledActionsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryScheduledActionsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TargetAmount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -5710,31 +14504,29 @@ func (m *Payment) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TargetAmount = append(m.TargetAmount, types.Coin{})
-			if err := m.TargetAmount[len(m.TargetAmount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Id = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExternalId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -5744,23 +14536,27 @@ func (m *Payment) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ExternalId = string(dAtA[iNdEx:postIndex])
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -5783,7 +14579,7 @@ func (m *Payment) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryVaultPaymentRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryScheduledActionsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5806,17 +14602,17 @@ func (m *QueryVaultPaymentRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryVaultPaymentRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryScheduledActionsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryVaultPaymentRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryScheduledActionsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ScheduledActions", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -5826,29 +14622,31 @@ func (m *QueryVaultPaymentRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Id = string(dAtA[iNdEx:postIndex])
+			m.ScheduledActions = append(m.ScheduledActions, ScheduledAction{})
+			if err := m.ScheduledActions[len(m.ScheduledActions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Source", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -5858,55 +14656,27 @@ func (m *QueryVaultPaymentRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Source = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExternalId", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.ExternalId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -5929,7 +14699,7 @@ func (m *QueryVaultPaymentRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryVaultPaymentResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryInvestorEligibilityRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5952,17 +14722,17 @@ func (m *QueryVaultPaymentResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryVaultPaymentResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryInvestorEligibilityRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryVaultPaymentResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryInvestorEligibilityRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Payment", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -5972,24 +14742,55 @@ func (m *QueryVaultPaymentResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Payment.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
+			m.Address = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -6012,7 +14813,7 @@ func (m *QueryVaultPaymentResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryVaultPaymentsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryInvestorEligibilityResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6035,17 +14836,17 @@ func (m *QueryVaultPaymentsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryVaultPaymentsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryInvestorEligibilityResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryVaultPaymentsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryInvestorEligibilityResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Eligible", wireType)
 			}
-			var stringLen uint64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -6055,29 +14856,37 @@ func (m *QueryVaultPaymentsRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
+			m.Eligible = bool(v != 0)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Allowlisted", wireType)
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			m.Id = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
+			m.Allowlisted = bool(v != 0)
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MissingAttributes", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -6087,28 +14896,74 @@ func (m *QueryVaultPaymentsRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.MissingAttributes = append(m.MissingAttributes, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
 				return err
 			}
-			iNdEx = postIndex
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryCircuitBreakerRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryCircuitBreakerRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryCircuitBreakerRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -6130,7 +14985,7 @@ func (m *QueryVaultPaymentsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryVaultPaymentsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryCircuitBreakerResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6153,15 +15008,15 @@ func (m *QueryVaultPaymentsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryVaultPaymentsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryCircuitBreakerResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryVaultPaymentsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryCircuitBreakerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Payments", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CircuitBreaker", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6188,16 +15043,15 @@ func (m *QueryVaultPaymentsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Payments = append(m.Payments, Payment{})
-			if err := m.Payments[len(m.Payments)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.CircuitBreaker.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Guardian", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -6207,27 +15061,23 @@ func (m *QueryVaultPaymentsResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Guardian = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -0,0 +1,128 @@
+package types
+
+import (
+	"fmt"
+	"sort"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// ManagementRoles returns the roles that cover the vault management actions the admin and
+// the asset manager could each perform before per-vault roles: pausing, rate changes, limit
+// changes and funding flows. New vaults grant them to the admin.
+func ManagementRoles() []VaultRole {
+	return []VaultRole{
+		VaultRole_VAULT_ROLE_PAUSER,
+		VaultRole_VAULT_ROLE_RATE_SETTER,
+		VaultRole_VAULT_ROLE_LIMITS_MANAGER,
+		VaultRole_VAULT_ROLE_RESERVE_FUNDER,
+	}
+}
+
+// AssetManagerRoles returns the roles held by a vault's asset manager: the management roles
+// plus asset settlement, which the admin never held.
+func AssetManagerRoles() []VaultRole {
+	return append(ManagementRoles(), VaultRole_VAULT_ROLE_SETTLEMENT_MANAGER)
+}
+
+// SeedRoleGrants grants the roles implied by the vault's authority fields: the management
+// roles to the admin, the asset manager roles to the asset manager when one is set, and the
+// NAV authority role to the NAV authority. Existing grants are kept, so the call is
+// idempotent. It reports whether any grant was added.
+func (v *VaultAccount) SeedRoleGrants() bool {
+	changed := false
+	for _, role := range ManagementRoles() {
+		changed = v.GrantRole(role, v.Admin) || changed
+	}
+	if v.AssetManager != "" {
+		for _, role := range AssetManagerRoles() {
+			changed = v.GrantRole(role, v.AssetManager) || changed
+		}
+	}
+	return v.GrantRole(VaultRole_VAULT_ROLE_NAV_AUTHORITY, v.GetNAVAuthority()) || changed
+}
+
+// ValidateVaultRole returns an error if role is not a grantable role.
+func ValidateVaultRole(role VaultRole) error {
+	if role == VaultRole_VAULT_ROLE_UNSPECIFIED {
+		return fmt.Errorf("role must be specified")
+	}
+	if _, ok := VaultRole_name[int32(role)]; !ok {
+		return fmt.Errorf("unknown role: %d", role)
+	}
+	return nil
+}
+
+// roleGrantLess orders role grants by role and then address.
+func roleGrantLess(a, b RoleGrant) bool {
+	if a.Role != b.Role {
+		return a.Role < b.Role
+	}
+	return a.Address < b.Address
+}
+
+// ValidateRoleGrants returns an error if any grant is for an invalid role or address, or if
+// the grants are not strictly sorted by role and then address.
+func ValidateRoleGrants(grants []RoleGrant) error {
+	for i, grant := range grants {
+		if err := ValidateVaultRole(grant.Role); err != nil {
+			return fmt.Errorf("invalid role grant %d: %w", i, err)
+		}
+		if _, err := sdk.AccAddressFromBech32(grant.Address); err != nil {
+			return fmt.Errorf("invalid role grant %d address %q: %w", i, grant.Address, err)
+		}
+		if i > 0 && !roleGrantLess(grants[i-1], grant) {
+			return fmt.Errorf("role grants must be sorted by role and address without duplicates: %s for %s", grant.Role, grant.Address)
+		}
+	}
+	return nil
+}
+
+// roleGrantIndex returns the position of the grant of role to address in the vault's sorted
+// role table and whether it exists.
+func (v VaultAccount) roleGrantIndex(role VaultRole, address string) (int, bool) {
+	grant := RoleGrant{Role: role, Address: address}
+	i := sort.Search(len(v.RoleGrants), func(i int) bool {
+		return !roleGrantLess(v.RoleGrants[i], grant)
+	})
+	return i, i < len(v.RoleGrants) && v.RoleGrants[i] == grant
+}
+
+// HasRole reports whether address holds role on the vault.
+func (v VaultAccount) HasRole(address string, role VaultRole) bool {
+	_, found := v.roleGrantIndex(role, address)
+	return found
+}
+
+// ValidateRole checks whether the given address holds role on the vault. It guards each
+// operational message with the single role that message needs, so an address can be trusted
+// with, say, pausing without also being able to move funds.
+func (v VaultAccount) ValidateRole(authority string, role VaultRole) error {
+	if !v.HasRole(authority, role) {
+		return fmt.Errorf("unauthorized authority: %s does not hold role %s", authority, role)
+	}
+	return nil
+}
+
+// GrantRole adds a grant of role to address, keeping the role table sorted. It reports
+// whether the grant was added; an existing grant is left as it is.
+func (v *VaultAccount) GrantRole(role VaultRole, address string) bool {
+	i, found := v.roleGrantIndex(role, address)
+	if found {
+		return false
+	}
+	v.RoleGrants = append(v.RoleGrants, RoleGrant{})
+	copy(v.RoleGrants[i+1:], v.RoleGrants[i:])
+	v.RoleGrants[i] = RoleGrant{Role: role, Address: address}
+	return true
+}
+
+// RevokeRole removes the grant of role to address. It reports whether a grant was removed.
+func (v *VaultAccount) RevokeRole(role VaultRole, address string) bool {
+	i, found := v.roleGrantIndex(role, address)
+	if !found {
+		return false
+	}
+	v.RoleGrants = append(v.RoleGrants[:i], v.RoleGrants[i+1:]...)
+	return true
+}
@@ -0,0 +1,146 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/provlabs/vault/types"
+)
+
+func TestValidateRoleGrants(t *testing.T) {
+	admin, oracle, _, _ := makeNAVAuthorityFixtures()
+	low, high := admin, oracle
+	if high < low {
+		low, high = high, low
+	}
+
+	tests := []struct {
+		name        string
+		grants      []types.RoleGrant
+		expectedErr string
+	}{
+		{
+			name: "empty table is valid",
+		},
+		{
+			name: "sorted by role and then address is valid",
+			grants: []types.RoleGrant{
+				{Role: types.VaultRole_VAULT_ROLE_PAUSER, Address: low},
+				{Role: types.VaultRole_VAULT_ROLE_PAUSER, Address: high},
+				{Role: types.VaultRole_VAULT_ROLE_SETTLEMENT_MANAGER, Address: low},
+			},
+		},
+		{
+			name:        "unspecified role",
+			grants:      []types.RoleGrant{{Role: types.VaultRole_VAULT_ROLE_UNSPECIFIED, Address: admin}},
+			expectedErr: "role must be specified",
+		},
+		{
+			name:        "unknown role",
+			grants:      []types.RoleGrant{{Role: types.VaultRole(99), Address: admin}},
+			expectedErr: "unknown role: 99",
+		},
+		{
+			name:        "invalid address",
+			grants:      []types.RoleGrant{{Role: types.VaultRole_VAULT_ROLE_PAUSER, Address: "bad"}},
+			expectedErr: `invalid role grant 0 address "bad"`,
+		},
+		{
+			name: "duplicate grant",
+			grants: []types.RoleGrant{
+				{Role: types.VaultRole_VAULT_ROLE_PAUSER, Address: admin},
+				{Role: types.VaultRole_VAULT_ROLE_PAUSER, Address: admin},
+			},
+			expectedErr: "role grants must be sorted by role and address without duplicates",
+		},
+		{
+			name: "unsorted roles",
+			grants: []types.RoleGrant{
+				{Role: types.VaultRole_VAULT_ROLE_RATE_SETTER, Address: admin},
+				{Role: types.VaultRole_VAULT_ROLE_PAUSER, Address: admin},
+			},
+			expectedErr: "role grants must be sorted by role and address without duplicates",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := types.ValidateRoleGrants(tc.grants)
+			if tc.expectedErr != "" {
+				require.ErrorContains(t, err, tc.expectedErr, "ValidateRoleGrants error")
+				return
+			}
+			require.NoError(t, err, "ValidateRoleGrants")
+		})
+	}
+}
+
+func TestVaultAccount_GrantAndRevokeRole(t *testing.T) {
+	admin, oracle, other, _ := makeNAVAuthorityFixtures()
+	vault := types.VaultAccount{Admin: admin}
+
+	require.True(t, vault.GrantRole(types.VaultRole_VAULT_ROLE_SETTLEMENT_MANAGER, oracle), "first grant should be added")
+	require.True(t, vault.GrantRole(types.VaultRole_VAULT_ROLE_PAUSER, other), "second grant should be added")
+	require.True(t, vault.GrantRole(types.VaultRole_VAULT_ROLE_PAUSER, admin), "third grant should be added")
+	require.False(t, vault.GrantRole(types.VaultRole_VAULT_ROLE_PAUSER, admin), "repeat grant should be a no-op")
+	require.Len(t, vault.RoleGrants, 3, "role table size")
+	require.NoError(t, types.ValidateRoleGrants(vault.RoleGrants), "grants should stay sorted")
+
+	require.True(t, vault.HasRole(admin, types.VaultRole_VAULT_ROLE_PAUSER), "admin should hold pauser")
+	require.False(t, vault.HasRole(admin, types.VaultRole_VAULT_ROLE_SETTLEMENT_MANAGER), "admin should not hold settlement manager")
+
+	require.True(t, vault.RevokeRole(types.VaultRole_VAULT_ROLE_PAUSER, admin), "revoke should remove the grant")
+	require.False(t, vault.RevokeRole(types.VaultRole_VAULT_ROLE_PAUSER, admin), "repeat revoke should be a no-op")
+	require.False(t, vault.HasRole(admin, types.VaultRole_VAULT_ROLE_PAUSER), "admin should no longer hold pauser")
+	require.True(t, vault.HasRole(other, types.VaultRole_VAULT_ROLE_PAUSER), "other pauser should be unaffected")
+	require.Len(t, vault.RoleGrants, 2, "role table size after revoke")
+}
+
+func TestVaultAccount_ValidateRole(t *testing.T) {
+	admin, manager, other, _ := makeNAVAuthorityFixtures()
+	vault := types.VaultAccount{Admin: admin, AssetManager: manager}
+	vault.GrantRole(types.VaultRole_VAULT_ROLE_SETTLEMENT_MANAGER, manager)
+
+	tests := []struct {
+		name        string
+		authority   string
+		role        types.VaultRole
+		expectedErr string
+	}{
+		{
+			name:      "holder of the role is authorized",
+			authority: manager,
+			role:      types.VaultRole_VAULT_ROLE_SETTLEMENT_MANAGER,
+		},
+		{
+			name:        "admin without the role is not authorized",
+			authority:   admin,
+			role:        types.VaultRole_VAULT_ROLE_SETTLEMENT_MANAGER,
+			expectedErr: "does not hold role VAULT_ROLE_SETTLEMENT_MANAGER",
+		},
+		{
+			name:        "holder of another role is not authorized",
+			authority:   manager,
+			role:        types.VaultRole_VAULT_ROLE_PAUSER,
+			expectedErr: "unauthorized authority",
+		},
+		{
+			name:        "stranger is not authorized",
+			authority:   other,
+			role:        types.VaultRole_VAULT_ROLE_SETTLEMENT_MANAGER,
+			expectedErr: "unauthorized authority",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := vault.ValidateRole(tc.authority, tc.role)
+			if tc.expectedErr != "" {
+				require.ErrorContains(t, err, tc.expectedErr, "ValidateRole error")
+				return
+			}
+			require.NoError(t, err, "ValidateRole")
+		})
+	}
+}
@@ -0,0 +1,57 @@
+package types
+
+import (
+	"fmt"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var _ codectypes.UnpackInterfacesMessage = ScheduledAction{}
+
+// NewScheduledAction creates a ScheduledAction that applies msg, signed by authority, to the
+// vault at vaultAddress once the block time reaches executeTime. The id is assigned when the
+// action is enqueued.
+func NewScheduledAction(vaultAddress, authority string, executeTime int64, msg sdk.Msg) (ScheduledAction, error) {
+	anyMsg, err := codectypes.NewAnyWithValue(msg)
+	if err != nil {
+		return ScheduledAction{}, fmt.Errorf("failed to pack scheduled message: %w", err)
+	}
+	return ScheduledAction{
+		VaultAddress: vaultAddress,
+		Authority:    authority,
+		ExecuteTime:  executeTime,
+		Msg:          anyMsg,
+	}, nil
+}
+
+// Validate performs basic validation of the scheduled action.
+func (a ScheduledAction) Validate() error {
+	if _, err := sdk.AccAddressFromBech32(a.VaultAddress); err != nil {
+		return fmt.Errorf("invalid vault address %s: %w", a.VaultAddress, err)
+	}
+	if _, err := sdk.AccAddressFromBech32(a.Authority); err != nil {
+		return fmt.Errorf("invalid authority address %s: %w", a.Authority, err)
+	}
+	if a.ExecuteTime < 0 {
+		return fmt.Errorf("execute time cannot be negative")
+	}
+	if a.Msg == nil || a.Msg.TypeUrl == "" {
+		return fmt.Errorf("scheduled message cannot be empty")
+	}
+	return nil
+}
+
+// MsgTypeURL returns the type URL of the scheduled message, or an empty string if none is set.
+func (a ScheduledAction) MsgTypeURL() string {
+	if a.Msg == nil {
+		return ""
+	}
+	return a.Msg.TypeUrl
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces.
+func (a ScheduledAction) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	var msg sdk.Msg
+	return unpacker.UnpackAny(a.Msg, &msg)
+}
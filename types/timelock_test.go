@@ -0,0 +1,72 @@
+package types_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/provlabs/vault/types"
+)
+
+func TestScheduledAction_Validate(t *testing.T) {
+	addr := NewTestAddress()
+	valid, err := types.NewScheduledAction(addr, addr, 100, &types.MsgSetBridgeAddressRequest{
+		Admin:         addr,
+		VaultAddress:  addr,
+		BridgeAddress: addr,
+	})
+	require.NoError(t, err, "creating a scheduled action should not error")
+
+	tests := []struct {
+		name        string
+		modify      func(a *types.ScheduledAction)
+		expectedErr string
+	}{
+		{
+			name:   "valid",
+			modify: func(_ *types.ScheduledAction) {},
+		},
+		{
+			name:        "invalid vault address",
+			modify:      func(a *types.ScheduledAction) { a.VaultAddress = "bad" },
+			expectedErr: "invalid vault address",
+		},
+		{
+			name:        "invalid authority",
+			modify:      func(a *types.ScheduledAction) { a.Authority = "bad" },
+			expectedErr: "invalid authority address",
+		},
+		{
+			name:        "negative execute time",
+			modify:      func(a *types.ScheduledAction) { a.ExecuteTime = -1 },
+			expectedErr: "execute time cannot be negative",
+		},
+		{
+			name:        "missing message",
+			modify:      func(a *types.ScheduledAction) { a.Msg = nil },
+			expectedErr: "scheduled message cannot be empty",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			action := valid
+			tc.modify(&action)
+			err := action.Validate()
+			if tc.expectedErr == "" {
+				require.NoError(t, err, "Validate should not error")
+				return
+			}
+			require.ErrorContains(t, err, tc.expectedErr, "Validate error")
+		})
+	}
+}
+
+func TestScheduledAction_MsgTypeURL(t *testing.T) {
+	addr := NewTestAddress()
+	action, err := types.NewScheduledAction(addr, addr, 100, &types.MsgSetBridgeAddressRequest{})
+	require.NoError(t, err, "creating a scheduled action should not error")
+	require.Equal(t, sdk.MsgTypeURL(&types.MsgSetBridgeAddressRequest{}), action.MsgTypeURL(), "type url of the packed message")
+	require.Empty(t, types.ScheduledAction{}.MsgTypeURL(), "type url of an action without a message")
+}
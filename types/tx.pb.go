@@ -8,6 +8,7 @@ import (
 	cosmossdk_io_math "cosmossdk.io/math"
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-proto"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
 	types1 "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/cosmos-sdk/types/msgservice"
 	_ "github.com/cosmos/cosmos-sdk/x/bank/types"
@@ -755,7 +756,7 @@ var xxx_messageInfo_MsgUpdateMaxInterestRateResponse proto.InternalMessageInfo
 
 // MsgUpdateInterestRateRequest is the request message for updating the annual interest rate of a vault.
 type MsgUpdateInterestRateRequest struct {
-	// authority is the address of the vault administrator or asset manager.
+	// authority is the address of a holder of the vault's rate setter role.
 	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
 	// vault_address is the bech32 address of the vault.
 	VaultAddress string `protobuf:"bytes,2,opt,name=vault_address,json=vaultAddress,proto3" json:"vault_address,omitempty"`
@@ -856,7 +857,7 @@ var xxx_messageInfo_MsgUpdateInterestRateResponse proto.InternalMessageInfo
 
 // MsgUpdateWithdrawalDelayRequest is the request message for updating the withdrawal delay of a vault.
 type MsgUpdateWithdrawalDelayRequest struct {
-	// authority is the address of the vault admin or asset manager.
+	// authority is the address of a holder of the vault's limits manager role.
 	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
 	// vault_address is the bech32 address of the vault.
 	VaultAddress string `protobuf:"bytes,2,opt,name=vault_address,json=vaultAddress,proto3" json:"vault_address,omitempty"`
@@ -958,7 +959,7 @@ var xxx_messageInfo_MsgUpdateWithdrawalDelayResponse proto.InternalMessageInfo
 
 // MsgUpdateMinSwapInValueRequest is the request message for updating the minimum swap-in value of a vault.
 type MsgUpdateMinSwapInValueRequest struct {
-	// authority is the bech32 address of the vault administrator or asset manager.
+	// authority is the address of a holder of the vault's limits manager role.
 	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
 	// vault_address is the bech32 address of the vault.
 	VaultAddress string `protobuf:"bytes,2,opt,name=vault_address,json=vaultAddress,proto3" json:"vault_address,omitempty"`
@@ -1061,7 +1062,7 @@ var xxx_messageInfo_MsgUpdateMinSwapInValueResponse proto.InternalMessageInfo
 
 // MsgUpdateMinSwapOutValueRequest is the request message for updating the minimum swap-out value of a vault.
 type MsgUpdateMinSwapOutValueRequest struct {
-	// authority is the bech32 address of the vault administrator or asset manager.
+	// authority is the address of a holder of the vault's limits manager role.
 	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
 	// vault_address is the bech32 address of the vault.
 	VaultAddress string `protobuf:"bytes,2,opt,name=vault_address,json=vaultAddress,proto3" json:"vault_address,omitempty"`
@@ -1164,7 +1165,7 @@ var xxx_messageInfo_MsgUpdateMinSwapOutValueResponse proto.InternalMessageInfo
 
 // MsgUpdateMaxSwapInValueRequest is the request message for updating the maximum swap-in value of a vault.
 type MsgUpdateMaxSwapInValueRequest struct {
-	// authority is the bech32 address of the vault administrator or asset manager.
+	// authority is the address of a holder of the vault's limits manager role.
 	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
 	// vault_address is the bech32 address of the vault.
 	VaultAddress string `protobuf:"bytes,2,opt,name=vault_address,json=vaultAddress,proto3" json:"vault_address,omitempty"`
@@ -1267,7 +1268,7 @@ var xxx_messageInfo_MsgUpdateMaxSwapInValueResponse proto.InternalMessageInfo
 
 // MsgUpdateMaxSwapOutValueRequest is the request message for updating the maximum swap-out value of a vault.
 type MsgUpdateMaxSwapOutValueRequest struct {
-	// authority is the bech32 address of the vault administrator or asset manager.
+	// authority is the address of a holder of the vault's limits manager role.
 	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
 	// vault_address is the bech32 address of the vault.
 	VaultAddress string `protobuf:"bytes,2,opt,name=vault_address,json=vaultAddress,proto3" json:"vault_address,omitempty"`
@@ -1573,7 +1574,7 @@ var xxx_messageInfo_MsgToggleSwapOutResponse proto.InternalMessageInfo
 // MsgDepositInterestFundsRequest is the request message for depositing funds to be used for paying interest.
 type MsgDepositInterestFundsRequest struct {
 	// authority is the address of the account depositing the funds.
-	// Must match either the vault admin or the configured asset manager.
+	// Must hold the vault's reserve funder role.
 	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
 	// vault_address is the bech32 address of the vault to which funds are being deposited.
 	VaultAddress string `protobuf:"bytes,2,opt,name=vault_address,json=vaultAddress,proto3" json:"vault_address,omitempty"`
@@ -1674,7 +1675,7 @@ var xxx_messageInfo_MsgDepositInterestFundsResponse proto.InternalMessageInfo
 
 // MsgWithdrawInterestFundsRequest is the request message for withdrawing unused interest funds.
 type MsgWithdrawInterestFundsRequest struct {
-	// authority is the address of the vault administrator or asset manager initiating the withdrawal.
+	// authority is the address of a holder of the vault's reserve funder role initiating the withdrawal.
 	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
 	// vault_address is the bech32 address of the vault from which funds are being withdrawn.
 	VaultAddress string `protobuf:"bytes,2,opt,name=vault_address,json=vaultAddress,proto3" json:"vault_address,omitempty"`
@@ -1776,7 +1777,7 @@ var xxx_messageInfo_MsgWithdrawInterestFundsResponse proto.InternalMessageInfo
 // MsgDepositPrincipalFundsRequest is the request message for depositing principal funds into a vault.
 type MsgDepositPrincipalFundsRequest struct {
 	// authority is the address of the account depositing the funds.
-	// Must match either the vault admin or the configured asset manager.
+	// Must hold the vault's reserve funder role.
 	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
 	// vault_address is the bech32 address of the vault to which funds are being deposited.
 	VaultAddress string `protobuf:"bytes,2,opt,name=vault_address,json=vaultAddress,proto3" json:"vault_address,omitempty"`
@@ -1877,7 +1878,7 @@ var xxx_messageInfo_MsgDepositPrincipalFundsResponse proto.InternalMessageInfo
 
 // MsgWithdrawPrincipalFundsRequest is the request message for withdrawing principal funds from a vault.
 type MsgWithdrawPrincipalFundsRequest struct {
-	// authority is the address of the vault administrator or asset manager initiating the withdrawal.
+	// authority is the address of a holder of the vault's reserve funder role initiating the withdrawal.
 	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
 	// vault_address is the bech32 address of the vault from which funds are being withdrawn.
 	VaultAddress string `protobuf:"bytes,2,opt,name=vault_address,json=vaultAddress,proto3" json:"vault_address,omitempty"`
@@ -1978,7 +1979,7 @@ var xxx_messageInfo_MsgWithdrawPrincipalFundsResponse proto.InternalMessageInfo
 
 // MsgExpeditePendingSwapOutRequest is the request message for expediting a swap out from a vault.
 type MsgExpeditePendingSwapOutRequest struct {
-	// authority is the address of the vault admin or asset manager initiating the expedite.
+	// authority is the address of a holder of the vault's limits manager role initiating the expedite.
 	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
 	// request_id is the id of the pending swap out to expedite.
 	RequestId uint64 `protobuf:"varint,2,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
@@ -2071,7 +2072,7 @@ var xxx_messageInfo_MsgExpeditePendingSwapOutResponse proto.InternalMessageInfo
 // MsgPauseVaultRequest is the request message to pause a vault. When processed,
 // the vault disables user-facing swap operations and records the provided reason.
 type MsgPauseVaultRequest struct {
-	// authority is the address of the vault administrator or asset manager initiating the pause.
+	// authority is the address of a holder of the vault's pauser role initiating the pause.
 	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
 	// vault_address is the bech32 address of the vault to pause.
 	VaultAddress string `protobuf:"bytes,2,opt,name=vault_address,json=vaultAddress,proto3" json:"vault_address,omitempty"`
@@ -2188,7 +2189,7 @@ var xxx_messageInfo_MsgPauseVaultResponse proto.InternalMessageInfo
 // MsgUnpauseVaultRequest is the request message to unpause a vault. When processed,
 // the vault re-enables user-facing swap operations (subject to existing flags).
 type MsgUnpauseVaultRequest struct {
-	// authority is the address of the vault administrator or asset manager initiating the unpause.
+	// authority is the address of a holder of the vault's pauser role initiating the unpause.
 	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
 	// vault_address is the bech32 address of the vault to unpause.
 	VaultAddress string `protobuf:"bytes,2,opt,name=vault_address,json=vaultAddress,proto3" json:"vault_address,omitempty"`
@@ -2980,7 +2981,7 @@ var xxx_messageInfo_MsgUpdateVaultAUMFeeBipsResponse proto.InternalMessageInfo
 
 // MsgUpdateVaultNAVRequest is the request message for creating or updating a vault's internal NAV entry.
 type MsgUpdateVaultNAVRequest struct {
-	// signer is the address of the vault's NAV authority authorizing this update.
+	// signer is the address of a holder of the vault's NAV authority role authorizing this update.
 	Signer string `protobuf:"bytes,1,opt,name=signer,proto3" json:"signer,omitempty"`
 	// vault_address is the bech32 address of the vault whose NAV entry is being updated.
 	VaultAddress string `protobuf:"bytes,2,opt,name=vault_address,json=vaultAddress,proto3" json:"vault_address,omitempty"`
@@ -3067,6 +3068,9 @@ func (m *MsgUpdateVaultNAVRequest) GetSource() string {
 
 // MsgUpdateVaultNAVResponse is the response message for the UpdateVaultNAV endpoint.
 type MsgUpdateVaultNAVResponse struct {
+	// pending_approval is true when the update exceeded the vault's max_nav_change_bips and
+	// was stored as a pending NAV proposal instead of being applied.
+	PendingApproval bool `protobuf:"varint,1,opt,name=pending_approval,json=pendingApproval,proto3" json:"pending_approval,omitempty"`
 }
 
 func (m *MsgUpdateVaultNAVResponse) Reset()         { *m = MsgUpdateVaultNAVResponse{} }
@@ -3102,12 +3106,19 @@ func (m *MsgUpdateVaultNAVResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgUpdateVaultNAVResponse proto.InternalMessageInfo
 
+func (m *MsgUpdateVaultNAVResponse) GetPendingApproval() bool {
+	if m != nil {
+		return m.PendingApproval
+	}
+	return false
+}
+
 // MsgRemoveVaultNAVRequest is the request message for deleting a vault's internal NAV
 // entry for a denom. Only entries for denoms the vault does not hold may be removed; a
 // held asset is written down through UpdateVaultNAV instead, so that its value stays
 // accounted for.
 type MsgRemoveVaultNAVRequest struct {
-	// signer is the address of the vault's NAV authority authorizing this removal.
+	// signer is the address of a holder of the vault's NAV authority role authorizing this removal.
 	Signer string `protobuf:"bytes,1,opt,name=signer,proto3" json:"signer,omitempty"`
 	// vault_address is the bech32 address of the vault whose NAV entry is being removed.
 	VaultAddress string `protobuf:"bytes,2,opt,name=vault_address,json=vaultAddress,proto3" json:"vault_address,omitempty"`
@@ -3312,7 +3323,7 @@ var xxx_messageInfo_MsgUpdateNAVAuthorityResponse proto.InternalMessageInfo
 // external_id. The vault settles only payments where one leg is the vault's underlying
 // asset; the settlement direction (inbound or outbound) is derived from which leg that is.
 type MsgAcceptAssetRequest struct {
-	// authority is the address of the vault's asset manager authorizing the settlement.
+	// authority is the address of a holder of the vault's settlement manager role authorizing the settlement.
 	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
 	// vault_address is the bech32 address of the vault, which must be the payment's target.
 	VaultAddress string `protobuf:"bytes,2,opt,name=vault_address,json=vaultAddress,proto3" json:"vault_address,omitempty"`
@@ -3424,7 +3435,7 @@ var xxx_messageInfo_MsgAcceptAssetResponse proto.InternalMessageInfo
 // payment whose target is the vault. The exchange module cancels the payment and refunds
 // the source's escrow. The payment is identified by its source account and external_id.
 type MsgRejectAssetRequest struct {
-	// authority is the address of the vault's asset manager authorizing the rejection.
+	// authority is the address of a holder of the vault's settlement manager role authorizing the rejection.
 	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
 	// vault_address is the bech32 address of the vault, which must be the payment's target.
 	VaultAddress string `protobuf:"bytes,2,opt,name=vault_address,json=vaultAddress,proto3" json:"vault_address,omitempty"`
@@ -3532,4934 +3543,19874 @@ func (m *MsgRejectAssetResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgRejectAssetResponse proto.InternalMessageInfo
 
-func init() {
-	proto.RegisterType((*MsgCreateVaultRequest)(nil), "provlabs.vault.v1.MsgCreateVaultRequest")
-	proto.RegisterType((*MsgCreateVaultResponse)(nil), "provlabs.vault.v1.MsgCreateVaultResponse")
-	proto.RegisterType((*MsgSetShareDenomMetadataRequest)(nil), "provlabs.vault.v1.MsgSetShareDenomMetadataRequest")
-	proto.RegisterType((*MsgSetShareDenomMetadataResponse)(nil), "provlabs.vault.v1.MsgSetShareDenomMetadataResponse")
-	proto.RegisterType((*MsgSwapInRequest)(nil), "provlabs.vault.v1.MsgSwapInRequest")
-	proto.RegisterType((*MsgSwapInResponse)(nil), "provlabs.vault.v1.MsgSwapInResponse")
-	proto.RegisterType((*MsgSwapOutRequest)(nil), "provlabs.vault.v1.MsgSwapOutRequest")
-	proto.RegisterType((*MsgSwapOutResponse)(nil), "provlabs.vault.v1.MsgSwapOutResponse")
-	proto.RegisterType((*MsgUpdateMinInterestRateRequest)(nil), "provlabs.vault.v1.MsgUpdateMinInterestRateRequest")
-	proto.RegisterType((*MsgUpdateMinInterestRateResponse)(nil), "provlabs.vault.v1.MsgUpdateMinInterestRateResponse")
-	proto.RegisterType((*MsgUpdateMaxInterestRateRequest)(nil), "provlabs.vault.v1.MsgUpdateMaxInterestRateRequest")
-	proto.RegisterType((*MsgUpdateMaxInterestRateResponse)(nil), "provlabs.vault.v1.MsgUpdateMaxInterestRateResponse")
-	proto.RegisterType((*MsgUpdateInterestRateRequest)(nil), "provlabs.vault.v1.MsgUpdateInterestRateRequest")
-	proto.RegisterType((*MsgUpdateInterestRateResponse)(nil), "provlabs.vault.v1.MsgUpdateInterestRateResponse")
-	proto.RegisterType((*MsgUpdateWithdrawalDelayRequest)(nil), "provlabs.vault.v1.MsgUpdateWithdrawalDelayRequest")
-	proto.RegisterType((*MsgUpdateWithdrawalDelayResponse)(nil), "provlabs.vault.v1.MsgUpdateWithdrawalDelayResponse")
-	proto.RegisterType((*MsgUpdateMinSwapInValueRequest)(nil), "provlabs.vault.v1.MsgUpdateMinSwapInValueRequest")
-	proto.RegisterType((*MsgUpdateMinSwapInValueResponse)(nil), "provlabs.vault.v1.MsgUpdateMinSwapInValueResponse")
-	proto.RegisterType((*MsgUpdateMinSwapOutValueRequest)(nil), "provlabs.vault.v1.MsgUpdateMinSwapOutValueRequest")
-	proto.RegisterType((*MsgUpdateMinSwapOutValueResponse)(nil), "provlabs.vault.v1.MsgUpdateMinSwapOutValueResponse")
-	proto.RegisterType((*MsgUpdateMaxSwapInValueRequest)(nil), "provlabs.vault.v1.MsgUpdateMaxSwapInValueRequest")
-	proto.RegisterType((*MsgUpdateMaxSwapInValueResponse)(nil), "provlabs.vault.v1.MsgUpdateMaxSwapInValueResponse")
-	proto.RegisterType((*MsgUpdateMaxSwapOutValueRequest)(nil), "provlabs.vault.v1.MsgUpdateMaxSwapOutValueRequest")
-	proto.RegisterType((*MsgUpdateMaxSwapOutValueResponse)(nil), "provlabs.vault.v1.MsgUpdateMaxSwapOutValueResponse")
-	proto.RegisterType((*MsgToggleSwapInRequest)(nil), "provlabs.vault.v1.MsgToggleSwapInRequest")
-	proto.RegisterType((*MsgToggleSwapInResponse)(nil), "provlabs.vault.v1.MsgToggleSwapInResponse")
-	proto.RegisterType((*MsgToggleSwapOutRequest)(nil), "provlabs.vault.v1.MsgToggleSwapOutRequest")
-	proto.RegisterType((*MsgToggleSwapOutResponse)(nil), "provlabs.vault.v1.MsgToggleSwapOutResponse")
-	proto.RegisterType((*MsgDepositInterestFundsRequest)(nil), "provlabs.vault.v1.MsgDepositInterestFundsRequest")
-	proto.RegisterType((*MsgDepositInterestFundsResponse)(nil), "provlabs.vault.v1.MsgDepositInterestFundsResponse")
-	proto.RegisterType((*MsgWithdrawInterestFundsRequest)(nil), "provlabs.vault.v1.MsgWithdrawInterestFundsRequest")
-	proto.RegisterType((*MsgWithdrawInterestFundsResponse)(nil), "provlabs.vault.v1.MsgWithdrawInterestFundsResponse")
-	proto.RegisterType((*MsgDepositPrincipalFundsRequest)(nil), "provlabs.vault.v1.MsgDepositPrincipalFundsRequest")
-	proto.RegisterType((*MsgDepositPrincipalFundsResponse)(nil), "provlabs.vault.v1.MsgDepositPrincipalFundsResponse")
-	proto.RegisterType((*MsgWithdrawPrincipalFundsRequest)(nil), "provlabs.vault.v1.MsgWithdrawPrincipalFundsRequest")
-	proto.RegisterType((*MsgWithdrawPrincipalFundsResponse)(nil), "provlabs.vault.v1.MsgWithdrawPrincipalFundsResponse")
-	proto.RegisterType((*MsgExpeditePendingSwapOutRequest)(nil), "provlabs.vault.v1.MsgExpeditePendingSwapOutRequest")
-	proto.RegisterType((*MsgExpeditePendingSwapOutResponse)(nil), "provlabs.vault.v1.MsgExpeditePendingSwapOutResponse")
-	proto.RegisterType((*MsgPauseVaultRequest)(nil), "provlabs.vault.v1.MsgPauseVaultRequest")
-	proto.RegisterType((*MsgPauseVaultResponse)(nil), "provlabs.vault.v1.MsgPauseVaultResponse")
-	proto.RegisterType((*MsgUnpauseVaultRequest)(nil), "provlabs.vault.v1.MsgUnpauseVaultRequest")
-	proto.RegisterType((*MsgUnpauseVaultResponse)(nil), "provlabs.vault.v1.MsgUnpauseVaultResponse")
-	proto.RegisterType((*MsgSetBridgeAddressRequest)(nil), "provlabs.vault.v1.MsgSetBridgeAddressRequest")
-	proto.RegisterType((*MsgSetBridgeAddressResponse)(nil), "provlabs.vault.v1.MsgSetBridgeAddressResponse")
-	proto.RegisterType((*MsgToggleBridgeRequest)(nil), "provlabs.vault.v1.MsgToggleBridgeRequest")
-	proto.RegisterType((*MsgToggleBridgeResponse)(nil), "provlabs.vault.v1.MsgToggleBridgeResponse")
-	proto.RegisterType((*MsgBridgeMintSharesRequest)(nil), "provlabs.vault.v1.MsgBridgeMintSharesRequest")
-	proto.RegisterType((*MsgBridgeMintSharesResponse)(nil), "provlabs.vault.v1.MsgBridgeMintSharesResponse")
-	proto.RegisterType((*MsgBridgeBurnSharesRequest)(nil), "provlabs.vault.v1.MsgBridgeBurnSharesRequest")
-	proto.RegisterType((*MsgBridgeBurnSharesResponse)(nil), "provlabs.vault.v1.MsgBridgeBurnSharesResponse")
-	proto.RegisterType((*MsgSetAssetManagerRequest)(nil), "provlabs.vault.v1.MsgSetAssetManagerRequest")
-	proto.RegisterType((*MsgSetAssetManagerResponse)(nil), "provlabs.vault.v1.MsgSetAssetManagerResponse")
-	proto.RegisterType((*MsgUpdateParamsRequest)(nil), "provlabs.vault.v1.MsgUpdateParamsRequest")
-	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "provlabs.vault.v1.MsgUpdateParamsResponse")
-	proto.RegisterType((*MsgUpdateVaultAUMFeeBipsRequest)(nil), "provlabs.vault.v1.MsgUpdateVaultAUMFeeBipsRequest")
-	proto.RegisterType((*MsgUpdateVaultAUMFeeBipsResponse)(nil), "provlabs.vault.v1.MsgUpdateVaultAUMFeeBipsResponse")
-	proto.RegisterType((*MsgUpdateVaultNAVRequest)(nil), "provlabs.vault.v1.MsgUpdateVaultNAVRequest")
-	proto.RegisterType((*MsgUpdateVaultNAVResponse)(nil), "provlabs.vault.v1.MsgUpdateVaultNAVResponse")
-	proto.RegisterType((*MsgRemoveVaultNAVRequest)(nil), "provlabs.vault.v1.MsgRemoveVaultNAVRequest")
-	proto.RegisterType((*MsgRemoveVaultNAVResponse)(nil), "provlabs.vault.v1.MsgRemoveVaultNAVResponse")
-	proto.RegisterType((*MsgUpdateNAVAuthorityRequest)(nil), "provlabs.vault.v1.MsgUpdateNAVAuthorityRequest")
-	proto.RegisterType((*MsgUpdateNAVAuthorityResponse)(nil), "provlabs.vault.v1.MsgUpdateNAVAuthorityResponse")
-	proto.RegisterType((*MsgAcceptAssetRequest)(nil), "provlabs.vault.v1.MsgAcceptAssetRequest")
-	proto.RegisterType((*MsgAcceptAssetResponse)(nil), "provlabs.vault.v1.MsgAcceptAssetResponse")
-	proto.RegisterType((*MsgRejectAssetRequest)(nil), "provlabs.vault.v1.MsgRejectAssetRequest")
-	proto.RegisterType((*MsgRejectAssetResponse)(nil), "provlabs.vault.v1.MsgRejectAssetResponse")
+// MsgUpdateNAVStalenessLimitRequest is the request message for configuring how old a vault's
+// internal NAV entries may become before swaps and settlements are blocked.
+type MsgUpdateNAVStalenessLimitRequest struct {
+	// authority is the address of the vault admin.
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// vault_address is the bech32 address of the vault to update.
+	VaultAddress string `protobuf:"bytes,2,opt,name=vault_address,json=vaultAddress,proto3" json:"vault_address,omitempty"`
+	// max_nav_age_seconds is the maximum age (in seconds) of a held denom's internal NAV.
+	// A value of 0 disables the staleness check.
+	MaxNavAgeSeconds uint64 `protobuf:"varint,3,opt,name=max_nav_age_seconds,json=maxNavAgeSeconds,proto3" json:"max_nav_age_seconds,omitempty"`
+	// auto_pause_on_stale_nav, when true, pauses the vault automatically in the EndBlocker
+	// once a held denom's NAV exceeds max_nav_age_seconds.
+	AutoPauseOnStaleNav bool `protobuf:"varint,4,opt,name=auto_pause_on_stale_nav,json=autoPauseOnStaleNav,proto3" json:"auto_pause_on_stale_nav,omitempty"`
 }
 
-func init() { proto.RegisterFile("provlabs/vault/v1/tx.proto", fileDescriptor_1bb9c8306211b32d) }
-
-var fileDescriptor_1bb9c8306211b32d = []byte{
-	// 2221 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x5a, 0xcf, 0x6f, 0x1c, 0xb7,
-	0x15, 0xf6, 0x68, 0xa5, 0xb5, 0x44, 0xfd, 0xb0, 0x4d, 0xc8, 0xf6, 0x6a, 0x13, 0xaf, 0x9c, 0x75,
-	0x83, 0x38, 0x76, 0xb4, 0x6b, 0xc9, 0x6d, 0xd3, 0x1a, 0x30, 0x02, 0xc9, 0x6e, 0x50, 0x1f, 0x36,
-	0x31, 0x56, 0xb5, 0x0a, 0x14, 0x28, 0x16, 0xdc, 0x1d, 0x66, 0x35, 0xf5, 0x0e, 0x67, 0x32, 0x3f,
-	0x76, 0x57, 0x87, 0x02, 0x41, 0x8b, 0x1c, 0x7a, 0x2b, 0xd0, 0x5e, 0x7a, 0xe8, 0xb5, 0xe8, 0xa1,
-	0x07, 0x17, 0xf0, 0xa5, 0x28, 0x50, 0xa0, 0x97, 0xd4, 0xa7, 0x22, 0x35, 0x0a, 0xb4, 0xe8, 0x21,
-	0x28, 0xec, 0x43, 0xfe, 0x86, 0xde, 0x8a, 0x21, 0xb9, 0x3b, 0xc3, 0x19, 0x92, 0x33, 0x4a, 0xeb,
-	0x8d, 0x95, 0x93, 0x34, 0xe4, 0x23, 0xf9, 0x7d, 0x7c, 0x8f, 0xe4, 0xe3, 0xc7, 0x05, 0x55, 0xd7,
-	0x73, 0x86, 0x03, 0xd4, 0xf5, 0x9b, 0x43, 0x14, 0x0e, 0x82, 0xe6, 0x70, 0xbb, 0x19, 0x8c, 0x1b,
-	0xae, 0xe7, 0x04, 0x0e, 0x3c, 0x37, 0xa9, 0x6b, 0xd0, 0xba, 0xc6, 0x70, 0xbb, 0x5a, 0xeb, 0x39,
-	0xbe, 0xed, 0xf8, 0xcd, 0x2e, 0x22, 0x0f, 0x9b, 0xc3, 0xed, 0x2e, 0x0e, 0xd0, 0x36, 0xfd, 0x60,
-	0x4d, 0x12, 0xf5, 0x3e, 0x9e, 0xd6, 0xf7, 0x1c, 0x8b, 0xf0, 0xfa, 0x8b, 0xbc, 0xde, 0xf6, 0xfb,
-	0xd1, 0x50, 0xb6, 0xdf, 0xe7, 0x15, 0x1b, 0xac, 0xa2, 0x43, 0xbf, 0x9a, 0xec, 0x83, 0x57, 0xad,
-	0xf7, 0x9d, 0xbe, 0xc3, 0xca, 0xa3, 0xff, 0x26, 0x23, 0x65, 0x81, 0xbb, 0xc8, 0x43, 0x36, 0x6f,
-	0x55, 0xff, 0x64, 0x1e, 0x9c, 0x6f, 0xf9, 0xfd, 0x3b, 0x1e, 0x46, 0x01, 0x3e, 0x88, 0x4c, 0xda,
-	0xf8, 0xc3, 0x10, 0xfb, 0x01, 0x6c, 0x80, 0x05, 0x64, 0xda, 0x16, 0xa9, 0x18, 0x97, 0x8d, 0xab,
-	0x4b, 0x7b, 0x95, 0xa7, 0x8f, 0xb7, 0xd6, 0xf9, 0x80, 0xbb, 0xa6, 0xe9, 0x61, 0xdf, 0xdf, 0x0f,
-	0x3c, 0x8b, 0xf4, 0xdb, 0xcc, 0x0c, 0x6e, 0x82, 0x65, 0xff, 0x10, 0x79, 0xb8, 0x63, 0x62, 0xe2,
-	0xd8, 0x95, 0xb9, 0xa8, 0x55, 0x1b, 0xd0, 0xa2, 0xbb, 0x51, 0x09, 0x7c, 0x13, 0x9c, 0x0d, 0x89,
-	0x89, 0xbd, 0xc1, 0x91, 0x45, 0xfa, 0x1d, 0xe4, 0xfb, 0x38, 0xa8, 0x94, 0xa8, 0xd5, 0x99, 0xb8,
-	0x7c, 0x37, 0x2a, 0x86, 0x6f, 0x80, 0x55, 0x17, 0x1d, 0xd9, 0x98, 0x04, 0xbc, 0xb7, 0x79, 0x8a,
-	0x61, 0xae, 0x62, 0xb4, 0x57, 0x78, 0x05, 0xeb, 0xf3, 0x5b, 0xa0, 0x32, 0xb2, 0x82, 0x43, 0xd3,
-	0x43, 0x23, 0x34, 0xe8, 0x98, 0x78, 0x80, 0x8e, 0x3a, 0x3e, 0xee, 0x39, 0xc4, 0xf4, 0x2b, 0x0b,
-	0x97, 0x8d, 0xab, 0xf3, 0xed, 0x0b, 0x71, 0xfd, 0xdd, 0xa8, 0x7a, 0x9f, 0xd5, 0xc2, 0x77, 0xc0,
-	0x39, 0xdb, 0x22, 0x1d, 0x7f, 0x84, 0xdc, 0x8e, 0x45, 0x3a, 0x43, 0x34, 0x08, 0x71, 0xa5, 0x4c,
-	0x87, 0x59, 0x7f, 0xfa, 0x78, 0xeb, 0x2c, 0xa7, 0x7a, 0x8f, 0x04, 0x9c, 0xe6, 0x9a, 0x6d, 0x91,
-	0xfd, 0x11, 0x72, 0xef, 0x91, 0x83, 0xc8, 0x16, 0xee, 0x02, 0x38, 0xed, 0xc0, 0x09, 0x03, 0xde,
-	0xc3, 0x69, 0x4d, 0x0f, 0x67, 0x78, 0x0f, 0xef, 0x87, 0x01, 0xeb, 0x22, 0xc2, 0x80, 0xc6, 0x29,
-	0x0c, 0x8b, 0x5a, 0x0c, 0x68, 0x9c, 0xc6, 0x30, 0xe9, 0x20, 0xc6, 0xb0, 0xa4, 0xc5, 0xc0, 0x7a,
-	0x98, 0x62, 0xf8, 0x26, 0x58, 0x42, 0x61, 0x70, 0xe8, 0x78, 0x56, 0x70, 0x54, 0x01, 0x39, 0xae,
-	0x8e, 0x4d, 0x6f, 0xad, 0xfd, 0xe4, 0xf3, 0x47, 0xd7, 0xe2, 0xef, 0xfa, 0x6d, 0x70, 0x21, 0x1d,
-	0x47, 0xbe, 0xeb, 0x10, 0x1f, 0xc3, 0x2b, 0x60, 0x95, 0xc6, 0x5e, 0x07, 0xb1, 0xbe, 0x58, 0x40,
-	0xb5, 0x57, 0x68, 0x21, 0xef, 0xbf, 0xfe, 0x1f, 0x03, 0x6c, 0xb6, 0xfc, 0xfe, 0x3e, 0x0e, 0xf6,
-	0xa7, 0x11, 0xd3, 0xc2, 0x01, 0x32, 0x51, 0x80, 0x26, 0x11, 0x19, 0x82, 0x45, 0x9b, 0x17, 0xd1,
-	0x3e, 0x96, 0x77, 0x2e, 0x35, 0x38, 0x4c, 0xba, 0xb6, 0xf8, 0x42, 0x6a, 0x4c, 0xda, 0xed, 0xdd,
-	0x7a, 0xf2, 0xd9, 0xe6, 0xa9, 0x7f, 0x7d, 0xb6, 0xb9, 0xd3, 0xb7, 0x82, 0xc3, 0xb0, 0xdb, 0xe8,
-	0x39, 0x36, 0x5f, 0x33, 0xfc, 0xcf, 0x96, 0x6f, 0x3e, 0x6c, 0x8e, 0xd9, 0x32, 0x0d, 0x8e, 0x5c,
-	0xec, 0x4f, 0xdb, 0xb6, 0xa7, 0x43, 0xc5, 0x0b, 0x61, 0xae, 0xd8, 0x42, 0xc8, 0xf0, 0x2d, 0x65,
-	0xf9, 0xde, 0x02, 0xd1, 0xf4, 0xb1, 0x06, 0xf5, 0x3a, 0xb8, 0xac, 0xa6, 0xce, 0x26, 0xb1, 0xfe,
-	0x3b, 0x03, 0x9c, 0x8d, 0x8c, 0xa8, 0xf3, 0x13, 0x4b, 0xd4, 0x19, 0x11, 0xec, 0xe5, 0x2f, 0x51,
-	0x6a, 0x96, 0x45, 0x36, 0x97, 0x45, 0x06, 0xdf, 0x06, 0x65, 0xba, 0x36, 0x19, 0xee, 0xe5, 0x9d,
-	0x8d, 0x78, 0x8e, 0x7d, 0x3c, 0x9d, 0xe3, 0x3b, 0x8e, 0x45, 0xf6, 0xe6, 0xa3, 0xf9, 0x6d, 0x73,
-	0x73, 0x4e, 0x89, 0x8e, 0x54, 0xff, 0x21, 0x38, 0x97, 0x40, 0xcb, 0x03, 0xe1, 0xbb, 0xe0, 0x0c,
-	0xdd, 0x0e, 0xfc, 0x8e, 0x87, 0x7b, 0xd8, 0x1a, 0x62, 0x93, 0xbb, 0x31, 0x77, 0x88, 0x35, 0xd6,
-	0xae, 0xcd, 0x9b, 0xd5, 0xff, 0x6e, 0x4c, 0xfb, 0x7f, 0x3f, 0x0c, 0x5e, 0xca, 0xe9, 0x80, 0xaf,
-	0x83, 0x15, 0x0f, 0x9b, 0x18, 0xdb, 0x99, 0x2d, 0x6c, 0x99, 0x95, 0x53, 0x47, 0x0b, 0xb3, 0x76,
-	0x13, 0xc0, 0x24, 0x2b, 0x3e, 0x6d, 0x97, 0x00, 0xf0, 0x18, 0xc3, 0x8e, 0xc5, 0x66, 0x6c, 0xbe,
-	0xbd, 0xc4, 0x4b, 0xee, 0x99, 0xf5, 0x5f, 0xb3, 0x95, 0xf3, 0xc0, 0x35, 0x51, 0x80, 0x5b, 0x16,
-	0xb9, 0x47, 0x02, 0xec, 0x61, 0x3f, 0x68, 0xa3, 0x00, 0x7f, 0xd1, 0xbd, 0xbc, 0xd0, 0xcc, 0x6c,
-	0x80, 0xc5, 0x68, 0x03, 0xf4, 0x50, 0x80, 0x79, 0x88, 0x9f, 0xb6, 0x2d, 0x12, 0x0d, 0x2b, 0x89,
-	0x6e, 0x05, 0x3c, 0x1e, 0xdd, 0x22, 0x07, 0x34, 0x9e, 0x29, 0x07, 0x34, 0x16, 0x39, 0xa0, 0xb1,
-	0x9e, 0x43, 0x1a, 0x1e, 0xe7, 0xf0, 0x1b, 0x03, 0xbc, 0x3a, 0x35, 0x92, 0x11, 0x10, 0x76, 0x5a,
-	0xa3, 0xf0, 0x4e, 0x5b, 0x98, 0x08, 0xc1, 0x23, 0x81, 0x08, 0xc1, 0x23, 0x4a, 0x24, 0xbd, 0x53,
-	0x6f, 0x82, 0x4b, 0x0a, 0x9c, 0x9c, 0xc9, 0x93, 0xa4, 0x37, 0xbe, 0x2f, 0x1e, 0x9f, 0x33, 0x21,
-	0xa3, 0x3b, 0xd5, 0x4b, 0xba, 0x53, 0x3d, 0xc3, 0x35, 0xe9, 0xb8, 0x0c, 0x13, 0x4e, 0xf7, 0xaf,
-	0x06, 0xa8, 0x25, 0x23, 0x34, 0x71, 0xc0, 0xce, 0x84, 0xad, 0x34, 0x13, 0x29, 0x15, 0xcf, 0x44,
-	0x32, 0xa4, 0x5f, 0x13, 0x37, 0x04, 0x81, 0x0f, 0xe7, 0xfc, 0x37, 0x23, 0x6b, 0x33, 0x49, 0x09,
-	0x66, 0x42, 0x5a, 0x9e, 0x3d, 0x95, 0x8e, 0x91, 0x3d, 0x69, 0x7d, 0x9d, 0xa1, 0x24, 0xf5, 0xb5,
-	0x90, 0x4c, 0xcd, 0xcc, 0xd7, 0x99, 0x8c, 0xaf, 0x54, 0x3c, 0xe3, 0xd3, 0xfb, 0x3a, 0xc5, 0x47,
-	0xea, 0x6b, 0x31, 0xfd, 0x9b, 0x99, 0xaf, 0xb3, 0x59, 0x6a, 0xe9, 0x18, 0x59, 0xaa, 0xde, 0xd7,
-	0x69, 0x4a, 0x9c, 0xf7, 0x2f, 0x0c, 0x9a, 0x92, 0x7e, 0xcf, 0xe9, 0xf7, 0x07, 0x38, 0x93, 0x38,
-	0xfd, 0xff, 0xcf, 0x92, 0x0a, 0x38, 0x8d, 0x09, 0xea, 0x0e, 0xb0, 0x49, 0xb9, 0x2d, 0xb6, 0x27,
-	0x9f, 0xc2, 0x51, 0xb2, 0x01, 0x2e, 0x66, 0x40, 0x71, 0xc0, 0xbf, 0x34, 0x52, 0x75, 0x62, 0x6e,
-	0xf3, 0x65, 0x21, 0xae, 0x82, 0x4a, 0x16, 0x15, 0x87, 0xfc, 0x09, 0x5b, 0x4f, 0x77, 0xb1, 0xeb,
-	0xf8, 0x56, 0x30, 0x39, 0x4d, 0xde, 0x0d, 0x89, 0xe9, 0xcf, 0x24, 0xb4, 0xa2, 0xec, 0xcc, 0x76,
-	0x42, 0x12, 0x14, 0xcf, 0xce, 0xa8, 0xb9, 0x62, 0x1d, 0xc9, 0x79, 0x70, 0xae, 0x7f, 0x61, 0xeb,
-	0x68, 0x72, 0x8c, 0x9c, 0x60, 0xb2, 0x6c, 0xf5, 0x28, 0x88, 0x88, 0x6c, 0xf9, 0x8c, 0xdc, 0xf7,
-	0x2c, 0xd2, 0xb3, 0x5c, 0x34, 0x38, 0xb1, 0x6c, 0x15, 0x44, 0xe2, 0x94, 0x27, 0x39, 0x25, 0x27,
-	0x99, 0xee, 0x15, 0xf0, 0x9a, 0x86, 0x09, 0xe7, 0xfb, 0x33, 0xc6, 0xf7, 0x3b, 0x63, 0x17, 0x9b,
-	0x56, 0x80, 0xef, 0x63, 0x62, 0x5a, 0x24, 0x7d, 0x9f, 0xfa, 0xa2, 0x7c, 0xc5, 0x0b, 0xcb, 0x5c,
-	0xea, 0xc2, 0xa2, 0x00, 0xac, 0x82, 0xc2, 0x01, 0xff, 0xde, 0x00, 0xeb, 0x2d, 0xbf, 0x7f, 0x1f,
-	0x85, 0xbe, 0x28, 0x53, 0xbd, 0x50, 0xa7, 0x5c, 0x00, 0x65, 0x0f, 0x23, 0xdf, 0x21, 0x3c, 0xa7,
-	0xe6, 0x5f, 0x70, 0x1d, 0x2c, 0x7c, 0xe0, 0x78, 0x3d, 0x4c, 0x2f, 0x75, 0x8b, 0x6d, 0xf6, 0x91,
-	0x21, 0x76, 0x91, 0x4a, 0x6b, 0x49, 0xc8, 0x9c, 0xcc, 0xc7, 0xec, 0x64, 0x7a, 0x40, 0xdc, 0x99,
-	0xd2, 0xc9, 0x00, 0x64, 0x67, 0x91, 0x08, 0x83, 0x43, 0xfc, 0x93, 0x01, 0xaa, 0x4c, 0x94, 0xd8,
-	0xf3, 0x2c, 0xb3, 0x8f, 0x79, 0x17, 0x2f, 0xf4, 0x38, 0x7a, 0x07, 0xac, 0x75, 0xe9, 0x60, 0xa2,
-	0x72, 0xa2, 0xe9, 0x7d, 0xb5, 0x9b, 0x04, 0x27, 0x9c, 0x5a, 0x97, 0xc0, 0x2b, 0x52, 0xfc, 0xb2,
-	0xe4, 0x80, 0x99, 0xbc, 0x64, 0xc9, 0xc1, 0x04, 0x14, 0x07, 0xfc, 0x07, 0xe6, 0x10, 0x56, 0xda,
-	0xb2, 0x08, 0x13, 0x8b, 0xa6, 0x0e, 0xb9, 0x01, 0xca, 0x6c, 0x2e, 0x72, 0x51, 0x73, 0xbb, 0xc2,
-	0xbb, 0x12, 0x93, 0x5e, 0x0a, 0xef, 0x4a, 0xcc, 0xfc, 0xd6, 0x72, 0xc4, 0x8a, 0x0f, 0xc5, 0x7d,
-	0x91, 0x85, 0x2e, 0xa3, 0xb6, 0x17, 0x7a, 0xe4, 0x84, 0x52, 0x4b, 0x42, 0xe7, 0xd4, 0xfe, 0x68,
-	0x80, 0x0d, 0x16, 0x86, 0x54, 0xd8, 0x6e, 0x21, 0x82, 0xfa, 0xd8, 0x7b, 0xa1, 0x91, 0x76, 0x1b,
-	0xac, 0x52, 0x05, 0xaa, 0x63, 0xb3, 0xc1, 0x72, 0x17, 0xd1, 0x0a, 0x4a, 0x40, 0x13, 0xc2, 0xf1,
-	0xd5, 0xc9, 0x1e, 0x20, 0x82, 0xe7, 0xdc, 0x7e, 0xc5, 0x77, 0x31, 0x9a, 0x84, 0xdf, 0xa7, 0x8f,
-	0x0a, 0xff, 0xeb, 0x2e, 0xf6, 0x36, 0x28, 0xb3, 0xd7, 0x09, 0xca, 0x2c, 0xf2, 0x49, 0xe6, 0x6d,
-	0xa5, 0xc1, 0x46, 0x9a, 0xf8, 0x84, 0x99, 0xab, 0x76, 0x36, 0x01, 0x1a, 0x87, 0xfd, 0x28, 0x79,
-	0x1d, 0xa2, 0x9b, 0xde, 0xee, 0x83, 0xd6, 0xbb, 0x18, 0xef, 0x59, 0xee, 0x6c, 0x4e, 0xfa, 0xcb,
-	0x60, 0x05, 0x85, 0x76, 0xe7, 0x03, 0x8c, 0x3b, 0x5d, 0xcb, 0x65, 0xe1, 0xb7, 0xda, 0x06, 0x28,
-	0xb4, 0x39, 0x0a, 0xed, 0x6d, 0x27, 0x83, 0x98, 0xd3, 0xfa, 0xed, 0x1c, 0x4d, 0xd3, 0x13, 0x46,
-	0xef, 0xed, 0x1e, 0x24, 0x96, 0x90, 0x6f, 0xf5, 0x8b, 0x48, 0xa3, 0xdc, 0xae, 0x18, 0x93, 0x75,
-	0xb0, 0xc0, 0xb4, 0x4d, 0x76, 0x3a, 0xb2, 0x0f, 0xf8, 0x0d, 0xb0, 0xe0, 0x7a, 0x16, 0x3f, 0x1c,
-	0x0b, 0xac, 0x2b, 0x66, 0x0d, 0xef, 0x80, 0xf2, 0xd0, 0x19, 0x84, 0x36, 0xa6, 0x0f, 0x37, 0x4b,
-	0x7b, 0xd7, 0xb9, 0x78, 0x7f, 0x9e, 0x35, 0xf7, 0xcd, 0x87, 0x0d, 0xcb, 0x69, 0xda, 0x28, 0x38,
-	0x8c, 0x2e, 0x8a, 0x4f, 0x1f, 0x6f, 0x81, 0xf8, 0xda, 0xd8, 0xe6, 0x4d, 0xa3, 0x03, 0xdb, 0x77,
-	0xc2, 0xe8, 0x64, 0x2e, 0xb3, 0x03, 0x9b, 0x7d, 0xf1, 0x35, 0xcb, 0xb8, 0xd5, 0x5f, 0xa1, 0x6b,
-	0x32, 0x3d, 0x53, 0xf1, 0x25, 0x2c, 0x9a, 0xc7, 0x36, 0xb6, 0x9d, 0xe1, 0x97, 0x3a, 0x8f, 0x32,
-	0xcc, 0x69, 0x54, 0x1c, 0xf3, 0x9f, 0x93, 0xd2, 0xe3, 0x7b, 0xbb, 0x07, 0xbb, 0x93, 0xc8, 0x79,
-	0xc1, 0xb8, 0x6f, 0x83, 0x55, 0x82, 0x47, 0x9d, 0x78, 0xa9, 0xe4, 0x6e, 0x35, 0x04, 0x8f, 0xa6,
-	0xe0, 0x44, 0x82, 0x49, 0x55, 0x52, 0xa4, 0xc0, 0x49, 0xfe, 0xc3, 0xa0, 0xe9, 0xd4, 0x6e, 0xaf,
-	0x87, 0x5d, 0xb6, 0x21, 0xcd, 0x64, 0xb5, 0xde, 0x98, 0x46, 0x54, 0x29, 0x77, 0xea, 0xa8, 0x1d,
-	0xdc, 0x04, 0xcb, 0x78, 0x1c, 0x60, 0x8f, 0xa0, 0x41, 0x94, 0xff, 0xce, 0xb3, 0x87, 0xd0, 0x49,
-	0x91, 0x24, 0x01, 0xae, 0xd0, 0x7d, 0x54, 0x20, 0x26, 0x72, 0x6e, 0xe3, 0x1f, 0xe1, 0xde, 0x57,
-	0x91, 0xb3, 0x40, 0x8c, 0x71, 0xde, 0xf9, 0xb8, 0x06, 0x4a, 0x2d, 0xbf, 0x0f, 0xbb, 0x60, 0x39,
-	0xf1, 0x9a, 0x08, 0xaf, 0x4a, 0x8e, 0x02, 0xe9, 0xc3, 0x75, 0xf5, 0xcd, 0x02, 0x96, 0xfc, 0x69,
-	0xe5, 0x23, 0x03, 0x9c, 0x97, 0xbe, 0xbb, 0xc1, 0x1d, 0x79, 0x27, 0xba, 0xf7, 0xc9, 0xea, 0xcd,
-	0x63, 0xb5, 0xe1, 0x10, 0xf6, 0x41, 0x99, 0xc9, 0x40, 0xf0, 0x8a, 0xa2, 0x79, 0x52, 0xb9, 0xaa,
-	0x7e, 0x4d, 0x6f, 0xc4, 0x3b, 0x3d, 0x00, 0xa7, 0xf9, 0x05, 0x0a, 0x6a, 0x1a, 0xc4, 0x57, 0xbd,
-	0xea, 0xeb, 0x39, 0x56, 0x89, 0xf9, 0x92, 0xbe, 0xe4, 0xa8, 0xe6, 0x4b, 0xf7, 0x2a, 0xa5, 0x9a,
-	0x2f, 0xed, 0x53, 0x51, 0x12, 0x82, 0xf8, 0x10, 0x93, 0x03, 0x41, 0xfa, 0xa8, 0x94, 0x03, 0x41,
-	0xfe, 0xd2, 0x03, 0x47, 0x00, 0x66, 0x5f, 0x4f, 0x60, 0x53, 0xd7, 0x95, 0x6c, 0xec, 0x1b, 0xc5,
-	0x1b, 0x64, 0xb8, 0xa7, 0xde, 0x32, 0xf4, 0xdc, 0xe5, 0x4f, 0x38, 0x7a, 0xee, 0x8a, 0xc7, 0x12,
-	0xf8, 0x63, 0xb0, 0x2e, 0x7b, 0x58, 0x80, 0xdb, 0x39, 0xbe, 0xcc, 0x0a, 0xed, 0xd5, 0x9d, 0xe3,
-	0x34, 0x91, 0x05, 0xa0, 0xf0, 0x3b, 0x86, 0x22, 0xbd, 0xa5, 0x54, 0xef, 0xdc, 0x00, 0x94, 0xc9,
-	0xca, 0x89, 0x19, 0x10, 0x7f, 0x8b, 0xb1, 0x9d, 0x13, 0x4a, 0xc7, 0x9e, 0x01, 0xa9, 0x9a, 0x2f,
-	0xc6, 0xff, 0x31, 0x66, 0x40, 0xaa, 0xfb, 0xe7, 0xc6, 0xbf, 0x74, 0x06, 0x30, 0x58, 0x49, 0xea,
-	0xd7, 0x50, 0xb1, 0xe1, 0x4a, 0x84, 0xf7, 0xea, 0xb5, 0x22, 0xa6, 0x7c, 0x98, 0x43, 0xb0, 0x2a,
-	0x88, 0xce, 0x30, 0xb7, 0x71, 0x62, 0x43, 0xbb, 0x5e, 0xc8, 0x36, 0x76, 0xa9, 0x4c, 0xf9, 0x55,
-	0xb9, 0x54, 0xa3, 0x76, 0xab, 0x5c, 0xaa, 0x13, 0x96, 0xa9, 0x4b, 0xa5, 0x62, 0xac, 0xca, 0xa5,
-	0x3a, 0x09, 0x5a, 0xe5, 0x52, 0xad, 0xda, 0x4b, 0x21, 0x48, 0x15, 0x52, 0xa8, 0x27, 0x24, 0x15,
-	0x4a, 0x55, 0x10, 0xb4, 0x12, 0x2c, 0xfc, 0xa9, 0x01, 0x2e, 0xc8, 0x55, 0x4b, 0x98, 0x43, 0x49,
-	0x0e, 0xe2, 0xeb, 0xc7, 0x6b, 0x94, 0x40, 0x21, 0x97, 0x22, 0x55, 0x28, 0xb4, 0x1a, 0xaa, 0x0a,
-	0x85, 0x5e, 0xed, 0x84, 0x1d, 0x00, 0x62, 0xd9, 0x10, 0xbe, 0x21, 0xef, 0x23, 0xa3, 0x85, 0x56,
-	0xaf, 0xe6, 0x1b, 0xc6, 0x4b, 0x38, 0x29, 0xfb, 0xa9, 0x96, 0xb0, 0x44, 0xa1, 0x54, 0x2d, 0x61,
-	0x99, 0x8a, 0x08, 0x3f, 0x04, 0x67, 0xd3, 0x0a, 0x1c, 0xdc, 0x52, 0x66, 0x49, 0x32, 0xa5, 0xb1,
-	0xda, 0x28, 0x6a, 0x9e, 0xde, 0x9c, 0x58, 0xb5, 0x7e, 0x73, 0x12, 0x84, 0x3f, 0xfd, 0xe6, 0x24,
-	0xca, 0x71, 0x11, 0xb3, 0xb4, 0x9e, 0xa5, 0x62, 0xa6, 0x90, 0xec, 0x54, 0xcc, 0x54, 0x32, 0x59,
-	0x3c, 0x64, 0xac, 0x33, 0xe9, 0x87, 0xcc, 0x48, 0x69, 0xfa, 0x21, 0xb3, 0xf2, 0x15, 0x24, 0xe0,
-	0x4c, 0x4a, 0xfd, 0x81, 0x6f, 0x29, 0xfd, 0x21, 0x51, 0xb8, 0xaa, 0x5b, 0x05, 0xad, 0x13, 0x61,
-	0x99, 0xd0, 0x6c, 0x94, 0x61, 0x99, 0x95, 0x9c, 0x94, 0x61, 0x29, 0x91, 0x80, 0x12, 0x67, 0x68,
-	0x4a, 0x4d, 0xd1, 0x9f, 0xa1, 0x72, 0xb1, 0x48, 0x7f, 0x86, 0x2a, 0xe4, 0x1a, 0xf8, 0x10, 0xac,
-	0x89, 0x02, 0x04, 0xbc, 0x9e, 0xdb, 0x4d, 0x2c, 0x44, 0x54, 0xdf, 0x2a, 0x66, 0x1c, 0x0f, 0x26,
-	0x2a, 0x07, 0xaa, 0xc1, 0xa4, 0xaa, 0x87, 0x6a, 0x30, 0xb9, 0x18, 0x11, 0x67, 0xc7, 0xc9, 0x5b,
-	0xbc, 0x3e, 0x3b, 0x96, 0x48, 0x16, 0xfa, 0xec, 0x58, 0x26, 0x10, 0x44, 0x17, 0xc6, 0xc4, 0x1d,
-	0x5a, 0x75, 0x61, 0xcc, 0xea, 0x07, 0xaa, 0x0b, 0xa3, 0xe4, 0x42, 0x1e, 0x8d, 0x91, 0xb8, 0xb3,
-	0xaa, 0xc6, 0xc8, 0xde, 0xd7, 0x55, 0x63, 0x48, 0x2e, 0xc0, 0xd5, 0x85, 0x8f, 0x3e, 0x7f, 0x74,
-	0xcd, 0xd8, 0xfb, 0xf6, 0x93, 0x67, 0x35, 0xe3, 0xd3, 0x67, 0x35, 0xe3, 0xdf, 0xcf, 0x6a, 0xc6,
-	0xcf, 0x9f, 0xd7, 0x4e, 0x7d, 0xfa, 0xbc, 0x76, 0xea, 0x9f, 0xcf, 0x6b, 0xa7, 0x7e, 0xb0, 0x99,
-	0xf8, 0x39, 0x6b, 0xea, 0xe7, 0xdd, 0xf4, 0x47, 0xac, 0xdd, 0x32, 0xfd, 0x6d, 0xf7, 0xcd, 0xff,
-	0x06, 0x00, 0x00, 0xff, 0xff, 0xbe, 0x03, 0x6c, 0x79, 0xb6, 0x2e, 0x00, 0x00,
+func (m *MsgUpdateNAVStalenessLimitRequest) Reset()         { *m = MsgUpdateNAVStalenessLimitRequest{} }
+func (m *MsgUpdateNAVStalenessLimitRequest) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateNAVStalenessLimitRequest) ProtoMessage()    {}
+func (*MsgUpdateNAVStalenessLimitRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1bb9c8306211b32d, []int{66}
 }
-
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
-
-// MsgClient is the client API for Msg service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type MsgClient interface {
-	// CreateVault creates a new vault.
-	// Must be signed by the governance module account, so vaults can only be created
-	// through a passed governance proposal.
-	CreateVault(ctx context.Context, in *MsgCreateVaultRequest, opts ...grpc.CallOption) (*MsgCreateVaultResponse, error)
-	// SetShareDenomMetadata allows Denom Metadata (see bank module) to be set for the vault's share denom.
-	// Similar to marker's SetDenomMetadata, but scoped to a specific vault. Only the vault admin may call this.
-	SetShareDenomMetadata(ctx context.Context, in *MsgSetShareDenomMetadataRequest, opts ...grpc.CallOption) (*MsgSetShareDenomMetadataResponse, error)
-	// SwapIn exchanges underlying assets for vault shares by depositing them into a vault.
-	SwapIn(ctx context.Context, in *MsgSwapInRequest, opts ...grpc.CallOption) (*MsgSwapInResponse, error)
-	// SwapOut exchanges vault shares for underlying assets by withdrawing from a vault.
-	SwapOut(ctx context.Context, in *MsgSwapOutRequest, opts ...grpc.CallOption) (*MsgSwapOutResponse, error)
-	// UpdateMinInterestRate sets the minimum allowed annual interest rate for a vault.
-	UpdateMinInterestRate(ctx context.Context, in *MsgUpdateMinInterestRateRequest, opts ...grpc.CallOption) (*MsgUpdateMinInterestRateResponse, error)
-	// UpdateMaxInterestRate sets the maximum allowed annual interest rate for a vault.
-	UpdateMaxInterestRate(ctx context.Context, in *MsgUpdateMaxInterestRateRequest, opts ...grpc.CallOption) (*MsgUpdateMaxInterestRateResponse, error)
-	// UpdateInterestRate allows the interest admin to update the current annual interest rate within limits.
-	UpdateInterestRate(ctx context.Context, in *MsgUpdateInterestRateRequest, opts ...grpc.CallOption) (*MsgUpdateInterestRateResponse, error)
-	// UpdateWithdrawalDelay allows updating the withdrawal delay for a vault.
-	UpdateWithdrawalDelay(ctx context.Context, in *MsgUpdateWithdrawalDelayRequest, opts ...grpc.CallOption) (*MsgUpdateWithdrawalDelayResponse, error)
-	// UpdateMinSwapInValue sets the minimum allowed value for a swap-in operation.
-	UpdateMinSwapInValue(ctx context.Context, in *MsgUpdateMinSwapInValueRequest, opts ...grpc.CallOption) (*MsgUpdateMinSwapInValueResponse, error)
-	// UpdateMinSwapOutValue sets the minimum allowed value for a swap-out operation.
-	UpdateMinSwapOutValue(ctx context.Context, in *MsgUpdateMinSwapOutValueRequest, opts ...grpc.CallOption) (*MsgUpdateMinSwapOutValueResponse, error)
-	// UpdateMaxSwapInValue sets the maximum allowed value for a swap-in operation.
-	UpdateMaxSwapInValue(ctx context.Context, in *MsgUpdateMaxSwapInValueRequest, opts ...grpc.CallOption) (*MsgUpdateMaxSwapInValueResponse, error)
-	// UpdateMaxSwapOutValue sets the maximum allowed value for a swap-out operation.
-	UpdateMaxSwapOutValue(ctx context.Context, in *MsgUpdateMaxSwapOutValueRequest, opts ...grpc.CallOption) (*MsgUpdateMaxSwapOutValueResponse, error)
-	// ToggleSwapIn allows enabling or disabling swap-in operations for a vault.
-	ToggleSwapIn(ctx context.Context, in *MsgToggleSwapInRequest, opts ...grpc.CallOption) (*MsgToggleSwapInResponse, error)
-	// ToggleSwapOut allows enabling or disabling swap-out operations for a vault.
-	ToggleSwapOut(ctx context.Context, in *MsgToggleSwapOutRequest, opts ...grpc.CallOption) (*MsgToggleSwapOutResponse, error)
-	// DepositInterestFunds allows depositing funds into the vault for paying interest.
-	// May be signed by the vault admin or the configured asset manager.
-	DepositInterestFunds(ctx context.Context, in *MsgDepositInterestFundsRequest, opts ...grpc.CallOption) (*MsgDepositInterestFundsResponse, error)
-	// WithdrawInterestFunds allows withdrawing unused interest funds.
-	// May be signed by the vault admin or the configured asset manager.
-	WithdrawInterestFunds(ctx context.Context, in *MsgWithdrawInterestFundsRequest, opts ...grpc.CallOption) (*MsgWithdrawInterestFundsResponse, error)
-	// DepositPrincipalFunds allows depositing principal funds into a vault.
-	// May be signed by the vault admin or the configured asset manager.
-	DepositPrincipalFunds(ctx context.Context, in *MsgDepositPrincipalFundsRequest, opts ...grpc.CallOption) (*MsgDepositPrincipalFundsResponse, error)
-	// WithdrawPrincipalFunds allows withdrawing principal funds from a vault.
-	// May be signed by the vault admin or the configured asset manager.
-	WithdrawPrincipalFunds(ctx context.Context, in *MsgWithdrawPrincipalFundsRequest, opts ...grpc.CallOption) (*MsgWithdrawPrincipalFundsResponse, error)
-	// ExpeditePendingSwapOut expedites a pending swap out from a vault.
-	ExpeditePendingSwapOut(ctx context.Context, in *MsgExpeditePendingSwapOutRequest, opts ...grpc.CallOption) (*MsgExpeditePendingSwapOutResponse, error)
-	// PauseVault pauses user-facing swap operations for a vault and records a reason.
-	// May be signed by the vault admin or the configured asset manager.
-	PauseVault(ctx context.Context, in *MsgPauseVaultRequest, opts ...grpc.CallOption) (*MsgPauseVaultResponse, error)
-	// UnpauseVault re-enables user-facing swap operations for a vault.
-	// May be signed by the vault admin or the configured asset manager.
-	UnpauseVault(ctx context.Context, in *MsgUnpauseVaultRequest, opts ...grpc.CallOption) (*MsgUnpauseVaultResponse, error)
-	// SetBridgeAddress sets the single external bridge address allowed to mint or burn shares for a vault.
-	SetBridgeAddress(ctx context.Context, in *MsgSetBridgeAddressRequest, opts ...grpc.CallOption) (*MsgSetBridgeAddressResponse, error)
-	// ToggleBridge enables or disables the bridge functionality for a vault.
-	ToggleBridge(ctx context.Context, in *MsgToggleBridgeRequest, opts ...grpc.CallOption) (*MsgToggleBridgeResponse, error)
-	// BridgeMintShares mints local share marker supply for a vault; must be signed by the configured bridge address.
-	BridgeMintShares(ctx context.Context, in *MsgBridgeMintSharesRequest, opts ...grpc.CallOption) (*MsgBridgeMintSharesResponse, error)
-	// BridgeBurnShares burns local share marker supply for a vault; must be signed by the configured bridge address.
-	BridgeBurnShares(ctx context.Context, in *MsgBridgeBurnSharesRequest, opts ...grpc.CallOption) (*MsgBridgeBurnSharesResponse, error)
-	// SetAssetManager sets or clears the optional asset manager address for a vault.
-	// The vault admin must sign this transaction. Passing an empty address clears it.
-	SetAssetManager(ctx context.Context, in *MsgSetAssetManagerRequest, opts ...grpc.CallOption) (*MsgSetAssetManagerResponse, error)
-	// UpdateParams updates the module parameters.
-	UpdateParams(ctx context.Context, in *MsgUpdateParamsRequest, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
-	// UpdateVaultAUMFeeBips updates the AUM fee bips for a specific vault.
-	UpdateVaultAUMFeeBips(ctx context.Context, in *MsgUpdateVaultAUMFeeBipsRequest, opts ...grpc.CallOption) (*MsgUpdateVaultAUMFeeBipsResponse, error)
-	// UpdateVaultNAV creates or updates a vault's internal net asset value entry for a denom.
-	// Must be signed by the vault's NAV authority.
-	UpdateVaultNAV(ctx context.Context, in *MsgUpdateVaultNAVRequest, opts ...grpc.CallOption) (*MsgUpdateVaultNAVResponse, error)
-	// RemoveVaultNAV deletes a vault's internal net asset value entry for a denom the vault
-	// does not hold, revoking the authorization to acquire it at that price.
-	// Must be signed by the vault's NAV authority.
-	RemoveVaultNAV(ctx context.Context, in *MsgRemoveVaultNAVRequest, opts ...grpc.CallOption) (*MsgRemoveVaultNAVResponse, error)
-	// UpdateNAVAuthority rotates the address authorized to mutate a vault's internal NAV table.
-	// Must be signed by the vault admin.
-	UpdateNAVAuthority(ctx context.Context, in *MsgUpdateNAVAuthorityRequest, opts ...grpc.CallOption) (*MsgUpdateNAVAuthorityResponse, error)
-	// AcceptAsset settles a pending exchange-module payment whose target is the vault,
-	// exchanging an external asset for the vault's underlying asset.
-	// Must be signed by the vault's asset manager; the admin cannot settle.
-	AcceptAsset(ctx context.Context, in *MsgAcceptAssetRequest, opts ...grpc.CallOption) (*MsgAcceptAssetResponse, error)
-	// RejectAsset declines a pending exchange-module payment whose target is the vault,
-	// causing the exchange module to cancel it and refund the source's escrow.
-	// Must be signed by the vault's asset manager; the admin cannot reject.
-	RejectAsset(ctx context.Context, in *MsgRejectAssetRequest, opts ...grpc.CallOption) (*MsgRejectAssetResponse, error)
+func (m *MsgUpdateNAVStalenessLimitRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-type msgClient struct {
-	cc grpc1.ClientConn
+func (m *MsgUpdateNAVStalenessLimitRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateNAVStalenessLimitRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-
-func NewMsgClient(cc grpc1.ClientConn) MsgClient {
-	return &msgClient{cc}
+func (m *MsgUpdateNAVStalenessLimitRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateNAVStalenessLimitRequest.Merge(m, src)
 }
-
-func (c *msgClient) CreateVault(ctx context.Context, in *MsgCreateVaultRequest, opts ...grpc.CallOption) (*MsgCreateVaultResponse, error) {
-	out := new(MsgCreateVaultResponse)
-	err := c.cc.Invoke(ctx, "/provlabs.vault.v1.Msg/CreateVault", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *MsgUpdateNAVStalenessLimitRequest) XXX_Size() int {
+	return m.Size()
 }
-
-func (c *msgClient) SetShareDenomMetadata(ctx context.Context, in *MsgSetShareDenomMetadataRequest, opts ...grpc.CallOption) (*MsgSetShareDenomMetadataResponse, error) {
-	out := new(MsgSetShareDenomMetadataResponse)
-	err := c.cc.Invoke(ctx, "/provlabs.vault.v1.Msg/SetShareDenomMetadata", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *MsgUpdateNAVStalenessLimitRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateNAVStalenessLimitRequest.DiscardUnknown(m)
 }
 
-func (c *msgClient) SwapIn(ctx context.Context, in *MsgSwapInRequest, opts ...grpc.CallOption) (*MsgSwapInResponse, error) {
-	out := new(MsgSwapInResponse)
-	err := c.cc.Invoke(ctx, "/provlabs.vault.v1.Msg/SwapIn", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
-}
+var xxx_messageInfo_MsgUpdateNAVStalenessLimitRequest proto.InternalMessageInfo
 
-func (c *msgClient) SwapOut(ctx context.Context, in *MsgSwapOutRequest, opts ...grpc.CallOption) (*MsgSwapOutResponse, error) {
-	out := new(MsgSwapOutResponse)
-	err := c.cc.Invoke(ctx, "/provlabs.vault.v1.Msg/SwapOut", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgUpdateNAVStalenessLimitRequest) GetAuthority() string {
+	if m != nil {
+		return m.Authority
 	}
-	return out, nil
+	return ""
 }
 
-func (c *msgClient) UpdateMinInterestRate(ctx context.Context, in *MsgUpdateMinInterestRateRequest, opts ...grpc.CallOption) (*MsgUpdateMinInterestRateResponse, error) {
-	out := new(MsgUpdateMinInterestRateResponse)
-	err := c.cc.Invoke(ctx, "/provlabs.vault.v1.Msg/UpdateMinInterestRate", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgUpdateNAVStalenessLimitRequest) GetVaultAddress() string {
+	if m != nil {
+		return m.VaultAddress
 	}
-	return out, nil
+	return ""
 }
 
-func (c *msgClient) UpdateMaxInterestRate(ctx context.Context, in *MsgUpdateMaxInterestRateRequest, opts ...grpc.CallOption) (*MsgUpdateMaxInterestRateResponse, error) {
-	out := new(MsgUpdateMaxInterestRateResponse)
-	err := c.cc.Invoke(ctx, "/provlabs.vault.v1.Msg/UpdateMaxInterestRate", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgUpdateNAVStalenessLimitRequest) GetMaxNavAgeSeconds() uint64 {
+	if m != nil {
+		return m.MaxNavAgeSeconds
 	}
-	return out, nil
+	return 0
 }
 
-func (c *msgClient) UpdateInterestRate(ctx context.Context, in *MsgUpdateInterestRateRequest, opts ...grpc.CallOption) (*MsgUpdateInterestRateResponse, error) {
-	out := new(MsgUpdateInterestRateResponse)
-	err := c.cc.Invoke(ctx, "/provlabs.vault.v1.Msg/UpdateInterestRate", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgUpdateNAVStalenessLimitRequest) GetAutoPauseOnStaleNav() bool {
+	if m != nil {
+		return m.AutoPauseOnStaleNav
 	}
-	return out, nil
+	return false
 }
 
-func (c *msgClient) UpdateWithdrawalDelay(ctx context.Context, in *MsgUpdateWithdrawalDelayRequest, opts ...grpc.CallOption) (*MsgUpdateWithdrawalDelayResponse, error) {
-	out := new(MsgUpdateWithdrawalDelayResponse)
-	err := c.cc.Invoke(ctx, "/provlabs.vault.v1.Msg/UpdateWithdrawalDelay", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+// MsgUpdateNAVStalenessLimitResponse is the response message for the UpdateNAVStalenessLimit endpoint.
+type MsgUpdateNAVStalenessLimitResponse struct {
 }
 
-func (c *msgClient) UpdateMinSwapInValue(ctx context.Context, in *MsgUpdateMinSwapInValueRequest, opts ...grpc.CallOption) (*MsgUpdateMinSwapInValueResponse, error) {
-	out := new(MsgUpdateMinSwapInValueResponse)
-	err := c.cc.Invoke(ctx, "/provlabs.vault.v1.Msg/UpdateMinSwapInValue", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *MsgUpdateNAVStalenessLimitResponse) Reset()         { *m = MsgUpdateNAVStalenessLimitResponse{} }
+func (m *MsgUpdateNAVStalenessLimitResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateNAVStalenessLimitResponse) ProtoMessage()    {}
+func (*MsgUpdateNAVStalenessLimitResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1bb9c8306211b32d, []int{67}
 }
-
-func (c *msgClient) UpdateMinSwapOutValue(ctx context.Context, in *MsgUpdateMinSwapOutValueRequest, opts ...grpc.CallOption) (*MsgUpdateMinSwapOutValueResponse, error) {
-	out := new(MsgUpdateMinSwapOutValueResponse)
-	err := c.cc.Invoke(ctx, "/provlabs.vault.v1.Msg/UpdateMinSwapOutValue", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgUpdateNAVStalenessLimitResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateNAVStalenessLimitResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateNAVStalenessLimitResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *MsgUpdateNAVStalenessLimitResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateNAVStalenessLimitResponse.Merge(m, src)
+}
+func (m *MsgUpdateNAVStalenessLimitResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateNAVStalenessLimitResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateNAVStalenessLimitResponse.DiscardUnknown(m)
 }
 
-func (c *msgClient) UpdateMaxSwapInValue(ctx context.Context, in *MsgUpdateMaxSwapInValueRequest, opts ...grpc.CallOption) (*MsgUpdateMaxSwapInValueResponse, error) {
-	out := new(MsgUpdateMaxSwapInValueResponse)
-	err := c.cc.Invoke(ctx, "/provlabs.vault.v1.Msg/UpdateMaxSwapInValue", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+var xxx_messageInfo_MsgUpdateNAVStalenessLimitResponse proto.InternalMessageInfo
+
+// MsgUpdateNAVChangeLimitRequest is the request message for configuring the largest NAV move
+// the NAV authority may apply on its own, and who approves larger moves.
+type MsgUpdateNAVChangeLimitRequest struct {
+	// authority is the address of the vault admin.
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// vault_address is the bech32 address of the vault to update.
+	VaultAddress string `protobuf:"bytes,2,opt,name=vault_address,json=vaultAddress,proto3" json:"vault_address,omitempty"`
+	// max_nav_change_bips is the largest move, in basis points of the current price, a single
+	// NAV update may apply to a held denom. A value of 0 disables the guardrail.
+	MaxNavChangeBips uint32 `protobuf:"varint,3,opt,name=max_nav_change_bips,json=maxNavChangeBips,proto3" json:"max_nav_change_bips,omitempty"`
+	// nav_approver is the address that confirms NAV proposals exceeding max_nav_change_bips.
+	// It is required while max_nav_change_bips is non-zero.
+	NavApprover string `protobuf:"bytes,4,opt,name=nav_approver,json=navApprover,proto3" json:"nav_approver,omitempty"`
 }
 
-func (c *msgClient) UpdateMaxSwapOutValue(ctx context.Context, in *MsgUpdateMaxSwapOutValueRequest, opts ...grpc.CallOption) (*MsgUpdateMaxSwapOutValueResponse, error) {
-	out := new(MsgUpdateMaxSwapOutValueResponse)
-	err := c.cc.Invoke(ctx, "/provlabs.vault.v1.Msg/UpdateMaxSwapOutValue", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgUpdateNAVChangeLimitRequest) Reset()         { *m = MsgUpdateNAVChangeLimitRequest{} }
+func (m *MsgUpdateNAVChangeLimitRequest) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateNAVChangeLimitRequest) ProtoMessage()    {}
+func (*MsgUpdateNAVChangeLimitRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1bb9c8306211b32d, []int{68}
+}
+func (m *MsgUpdateNAVChangeLimitRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateNAVChangeLimitRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateNAVChangeLimitRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *MsgUpdateNAVChangeLimitRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateNAVChangeLimitRequest.Merge(m, src)
+}
+func (m *MsgUpdateNAVChangeLimitRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateNAVChangeLimitRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateNAVChangeLimitRequest.DiscardUnknown(m)
 }
 
-func (c *msgClient) ToggleSwapIn(ctx context.Context, in *MsgToggleSwapInRequest, opts ...grpc.CallOption) (*MsgToggleSwapInResponse, error) {
-	out := new(MsgToggleSwapInResponse)
-	err := c.cc.Invoke(ctx, "/provlabs.vault.v1.Msg/ToggleSwapIn", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgUpdateNAVChangeLimitRequest proto.InternalMessageInfo
+
+func (m *MsgUpdateNAVChangeLimitRequest) GetAuthority() string {
+	if m != nil {
+		return m.Authority
 	}
-	return out, nil
+	return ""
 }
 
-func (c *msgClient) ToggleSwapOut(ctx context.Context, in *MsgToggleSwapOutRequest, opts ...grpc.CallOption) (*MsgToggleSwapOutResponse, error) {
-	out := new(MsgToggleSwapOutResponse)
-	err := c.cc.Invoke(ctx, "/provlabs.vault.v1.Msg/ToggleSwapOut", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgUpdateNAVChangeLimitRequest) GetVaultAddress() string {
+	if m != nil {
+		return m.VaultAddress
 	}
-	return out, nil
+	return ""
 }
 
-func (c *msgClient) DepositInterestFunds(ctx context.Context, in *MsgDepositInterestFundsRequest, opts ...grpc.CallOption) (*MsgDepositInterestFundsResponse, error) {
-	out := new(MsgDepositInterestFundsResponse)
-	err := c.cc.Invoke(ctx, "/provlabs.vault.v1.Msg/DepositInterestFunds", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgUpdateNAVChangeLimitRequest) GetMaxNavChangeBips() uint32 {
+	if m != nil {
+		return m.MaxNavChangeBips
 	}
-	return out, nil
+	return 0
 }
 
-func (c *msgClient) WithdrawInterestFunds(ctx context.Context, in *MsgWithdrawInterestFundsRequest, opts ...grpc.CallOption) (*MsgWithdrawInterestFundsResponse, error) {
-	out := new(MsgWithdrawInterestFundsResponse)
-	err := c.cc.Invoke(ctx, "/provlabs.vault.v1.Msg/WithdrawInterestFunds", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgUpdateNAVChangeLimitRequest) GetNavApprover() string {
+	if m != nil {
+		return m.NavApprover
 	}
-	return out, nil
+	return ""
 }
 
-func (c *msgClient) DepositPrincipalFunds(ctx context.Context, in *MsgDepositPrincipalFundsRequest, opts ...grpc.CallOption) (*MsgDepositPrincipalFundsResponse, error) {
-	out := new(MsgDepositPrincipalFundsResponse)
-	err := c.cc.Invoke(ctx, "/provlabs.vault.v1.Msg/DepositPrincipalFunds", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+// MsgUpdateNAVChangeLimitResponse is the response message for the UpdateNAVChangeLimit endpoint.
+type MsgUpdateNAVChangeLimitResponse struct {
 }
 
-func (c *msgClient) WithdrawPrincipalFunds(ctx context.Context, in *MsgWithdrawPrincipalFundsRequest, opts ...grpc.CallOption) (*MsgWithdrawPrincipalFundsResponse, error) {
-	out := new(MsgWithdrawPrincipalFundsResponse)
-	err := c.cc.Invoke(ctx, "/provlabs.vault.v1.Msg/WithdrawPrincipalFunds", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgUpdateNAVChangeLimitResponse) Reset()         { *m = MsgUpdateNAVChangeLimitResponse{} }
+func (m *MsgUpdateNAVChangeLimitResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateNAVChangeLimitResponse) ProtoMessage()    {}
+func (*MsgUpdateNAVChangeLimitResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1bb9c8306211b32d, []int{69}
+}
+func (m *MsgUpdateNAVChangeLimitResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateNAVChangeLimitResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateNAVChangeLimitResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *MsgUpdateNAVChangeLimitResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateNAVChangeLimitResponse.Merge(m, src)
+}
+func (m *MsgUpdateNAVChangeLimitResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateNAVChangeLimitResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateNAVChangeLimitResponse.DiscardUnknown(m)
 }
 
-func (c *msgClient) ExpeditePendingSwapOut(ctx context.Context, in *MsgExpeditePendingSwapOutRequest, opts ...grpc.CallOption) (*MsgExpeditePendingSwapOutResponse, error) {
-	out := new(MsgExpeditePendingSwapOutResponse)
-	err := c.cc.Invoke(ctx, "/provlabs.vault.v1.Msg/ExpeditePendingSwapOut", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+var xxx_messageInfo_MsgUpdateNAVChangeLimitResponse proto.InternalMessageInfo
+
+// MsgUpdateSettlementToleranceRequest is the request message for configuring the band around the
+// internal NAV within which asset settlements may trade.
+type MsgUpdateSettlementToleranceRequest struct {
+	// authority is the address of the vault admin.
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// vault_address is the bech32 address of the vault to update.
+	VaultAddress string `protobuf:"bytes,2,opt,name=vault_address,json=vaultAddress,proto3" json:"vault_address,omitempty"`
+	// settlement_tolerance_bips is how far, in basis points of the asset leg's NAV-implied value,
+	// a settlement's payment leg may deviate from it. A value of 0 requires an exact match.
+	SettlementToleranceBips uint32 `protobuf:"varint,3,opt,name=settlement_tolerance_bips,json=settlementToleranceBips,proto3" json:"settlement_tolerance_bips,omitempty"`
+	// update_nav_on_settlement marks a single-denom asset's internal NAV to the price of each
+	// settlement that traded away from it.
+	UpdateNavOnSettlement bool `protobuf:"varint,4,opt,name=update_nav_on_settlement,json=updateNavOnSettlement,proto3" json:"update_nav_on_settlement,omitempty"`
 }
 
-func (c *msgClient) PauseVault(ctx context.Context, in *MsgPauseVaultRequest, opts ...grpc.CallOption) (*MsgPauseVaultResponse, error) {
-	out := new(MsgPauseVaultResponse)
-	err := c.cc.Invoke(ctx, "/provlabs.vault.v1.Msg/PauseVault", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgUpdateSettlementToleranceRequest) Reset()         { *m = MsgUpdateSettlementToleranceRequest{} }
+func (m *MsgUpdateSettlementToleranceRequest) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateSettlementToleranceRequest) ProtoMessage()    {}
+func (*MsgUpdateSettlementToleranceRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1bb9c8306211b32d, []int{70}
+}
+func (m *MsgUpdateSettlementToleranceRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateSettlementToleranceRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateSettlementToleranceRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *MsgUpdateSettlementToleranceRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateSettlementToleranceRequest.Merge(m, src)
+}
+func (m *MsgUpdateSettlementToleranceRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateSettlementToleranceRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateSettlementToleranceRequest.DiscardUnknown(m)
 }
 
-func (c *msgClient) UnpauseVault(ctx context.Context, in *MsgUnpauseVaultRequest, opts ...grpc.CallOption) (*MsgUnpauseVaultResponse, error) {
-	out := new(MsgUnpauseVaultResponse)
-	err := c.cc.Invoke(ctx, "/provlabs.vault.v1.Msg/UnpauseVault", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgUpdateSettlementToleranceRequest proto.InternalMessageInfo
+
+func (m *MsgUpdateSettlementToleranceRequest) GetAuthority() string {
+	if m != nil {
+		return m.Authority
 	}
-	return out, nil
+	return ""
 }
 
-func (c *msgClient) SetBridgeAddress(ctx context.Context, in *MsgSetBridgeAddressRequest, opts ...grpc.CallOption) (*MsgSetBridgeAddressResponse, error) {
-	out := new(MsgSetBridgeAddressResponse)
-	err := c.cc.Invoke(ctx, "/provlabs.vault.v1.Msg/SetBridgeAddress", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgUpdateSettlementToleranceRequest) GetVaultAddress() string {
+	if m != nil {
+		return m.VaultAddress
 	}
-	return out, nil
+	return ""
 }
 
-func (c *msgClient) ToggleBridge(ctx context.Context, in *MsgToggleBridgeRequest, opts ...grpc.CallOption) (*MsgToggleBridgeResponse, error) {
-	out := new(MsgToggleBridgeResponse)
-	err := c.cc.Invoke(ctx, "/provlabs.vault.v1.Msg/ToggleBridge", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgUpdateSettlementToleranceRequest) GetSettlementToleranceBips() uint32 {
+	if m != nil {
+		return m.SettlementToleranceBips
 	}
-	return out, nil
+	return 0
 }
 
-func (c *msgClient) BridgeMintShares(ctx context.Context, in *MsgBridgeMintSharesRequest, opts ...grpc.CallOption) (*MsgBridgeMintSharesResponse, error) {
-	out := new(MsgBridgeMintSharesResponse)
-	err := c.cc.Invoke(ctx, "/provlabs.vault.v1.Msg/BridgeMintShares", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgUpdateSettlementToleranceRequest) GetUpdateNavOnSettlement() bool {
+	if m != nil {
+		return m.UpdateNavOnSettlement
 	}
-	return out, nil
+	return false
 }
 
-func (c *msgClient) BridgeBurnShares(ctx context.Context, in *MsgBridgeBurnSharesRequest, opts ...grpc.CallOption) (*MsgBridgeBurnSharesResponse, error) {
-	out := new(MsgBridgeBurnSharesResponse)
-	err := c.cc.Invoke(ctx, "/provlabs.vault.v1.Msg/BridgeBurnShares", in, out, opts...)
-	if err != nil {
-		return nil, err
+// MsgUpdateSettlementToleranceResponse is the response message for the UpdateSettlementTolerance endpoint.
+type MsgUpdateSettlementToleranceResponse struct {
+}
+
+func (m *MsgUpdateSettlementToleranceResponse) Reset()         { *m = MsgUpdateSettlementToleranceResponse{} }
+func (m *MsgUpdateSettlementToleranceResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateSettlementToleranceResponse) ProtoMessage()    {}
+func (*MsgUpdateSettlementToleranceResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1bb9c8306211b32d, []int{71}
+}
+func (m *MsgUpdateSettlementToleranceResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateSettlementToleranceResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateSettlementToleranceResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *MsgUpdateSettlementToleranceResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateSettlementToleranceResponse.Merge(m, src)
+}
+func (m *MsgUpdateSettlementToleranceResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateSettlementToleranceResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateSettlementToleranceResponse.DiscardUnknown(m)
 }
 
-func (c *msgClient) SetAssetManager(ctx context.Context, in *MsgSetAssetManagerRequest, opts ...grpc.CallOption) (*MsgSetAssetManagerResponse, error) {
-	out := new(MsgSetAssetManagerResponse)
-	err := c.cc.Invoke(ctx, "/provlabs.vault.v1.Msg/SetAssetManager", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgUpdateSettlementToleranceResponse proto.InternalMessageInfo
+
+// MsgApproveNAVProposalRequest is the request message for applying a pending NAV proposal.
+type MsgApproveNAVProposalRequest struct {
+	// approver is the address of the vault's NAV approver.
+	Approver string `protobuf:"bytes,1,opt,name=approver,proto3" json:"approver,omitempty"`
+	// vault_address is the bech32 address of the vault the proposal prices.
+	VaultAddress string `protobuf:"bytes,2,opt,name=vault_address,json=vaultAddress,proto3" json:"vault_address,omitempty"`
+	// denom is the asset denomination of the pending proposal.
+	Denom string `protobuf:"bytes,3,opt,name=denom,proto3" json:"denom,omitempty"`
+}
+
+func (m *MsgApproveNAVProposalRequest) Reset()         { *m = MsgApproveNAVProposalRequest{} }
+func (m *MsgApproveNAVProposalRequest) String() string { return proto.CompactTextString(m) }
+func (*MsgApproveNAVProposalRequest) ProtoMessage()    {}
+func (*MsgApproveNAVProposalRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1bb9c8306211b32d, []int{72}
+}
+func (m *MsgApproveNAVProposalRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgApproveNAVProposalRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgApproveNAVProposalRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *MsgApproveNAVProposalRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgApproveNAVProposalRequest.Merge(m, src)
+}
+func (m *MsgApproveNAVProposalRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgApproveNAVProposalRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgApproveNAVProposalRequest.DiscardUnknown(m)
 }
 
-func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParamsRequest, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error) {
-	out := new(MsgUpdateParamsResponse)
-	err := c.cc.Invoke(ctx, "/provlabs.vault.v1.Msg/UpdateParams", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgApproveNAVProposalRequest proto.InternalMessageInfo
+
+func (m *MsgApproveNAVProposalRequest) GetApprover() string {
+	if m != nil {
+		return m.Approver
 	}
-	return out, nil
+	return ""
 }
 
-func (c *msgClient) UpdateVaultAUMFeeBips(ctx context.Context, in *MsgUpdateVaultAUMFeeBipsRequest, opts ...grpc.CallOption) (*MsgUpdateVaultAUMFeeBipsResponse, error) {
-	out := new(MsgUpdateVaultAUMFeeBipsResponse)
-	err := c.cc.Invoke(ctx, "/provlabs.vault.v1.Msg/UpdateVaultAUMFeeBips", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgApproveNAVProposalRequest) GetVaultAddress() string {
+	if m != nil {
+		return m.VaultAddress
 	}
-	return out, nil
+	return ""
 }
 
-func (c *msgClient) UpdateVaultNAV(ctx context.Context, in *MsgUpdateVaultNAVRequest, opts ...grpc.CallOption) (*MsgUpdateVaultNAVResponse, error) {
-	out := new(MsgUpdateVaultNAVResponse)
-	err := c.cc.Invoke(ctx, "/provlabs.vault.v1.Msg/UpdateVaultNAV", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgApproveNAVProposalRequest) GetDenom() string {
+	if m != nil {
+		return m.Denom
 	}
-	return out, nil
+	return ""
 }
 
-func (c *msgClient) RemoveVaultNAV(ctx context.Context, in *MsgRemoveVaultNAVRequest, opts ...grpc.CallOption) (*MsgRemoveVaultNAVResponse, error) {
-	out := new(MsgRemoveVaultNAVResponse)
-	err := c.cc.Invoke(ctx, "/provlabs.vault.v1.Msg/RemoveVaultNAV", in, out, opts...)
-	if err != nil {
-		return nil, err
+// MsgApproveNAVProposalResponse is the response message for the ApproveNAVProposal endpoint.
+type MsgApproveNAVProposalResponse struct {
+}
+
+func (m *MsgApproveNAVProposalResponse) Reset()         { *m = MsgApproveNAVProposalResponse{} }
+func (m *MsgApproveNAVProposalResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgApproveNAVProposalResponse) ProtoMessage()    {}
+func (*MsgApproveNAVProposalResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1bb9c8306211b32d, []int{73}
+}
+func (m *MsgApproveNAVProposalResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgApproveNAVProposalResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgApproveNAVProposalResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *MsgApproveNAVProposalResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgApproveNAVProposalResponse.Merge(m, src)
+}
+func (m *MsgApproveNAVProposalResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgApproveNAVProposalResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgApproveNAVProposalResponse.DiscardUnknown(m)
 }
 
-func (c *msgClient) UpdateNAVAuthority(ctx context.Context, in *MsgUpdateNAVAuthorityRequest, opts ...grpc.CallOption) (*MsgUpdateNAVAuthorityResponse, error) {
-	out := new(MsgUpdateNAVAuthorityResponse)
-	err := c.cc.Invoke(ctx, "/provlabs.vault.v1.Msg/UpdateNAVAuthority", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgApproveNAVProposalResponse proto.InternalMessageInfo
+
+// MsgUpdateNAVSourcesRequest is the request message for configuring a vault's NAV sources
+// and how their submissions are aggregated.
+type MsgUpdateNAVSourcesRequest struct {
+	// authority is the address of the vault admin.
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// vault_address is the bech32 address of the vault to update.
+	VaultAddress string `protobuf:"bytes,2,opt,name=vault_address,json=vaultAddress,proto3" json:"vault_address,omitempty"`
+	// nav_sources is the complete new set of NAV source addresses. Submissions from
+	// sources left out of the set are discarded.
+	NavSources []string `protobuf:"bytes,3,rep,name=nav_sources,json=navSources,proto3" json:"nav_sources,omitempty"`
+	// nav_source_quorum is the number of fresh submissions a denom needs before the
+	// aggregated price is used. A value of 0 disables aggregation.
+	NavSourceQuorum uint32 `protobuf:"varint,4,opt,name=nav_source_quorum,json=navSourceQuorum,proto3" json:"nav_source_quorum,omitempty"`
+	// nav_aggregation selects how fresh submissions are combined.
+	NavAggregation NAVAggregation `protobuf:"varint,5,opt,name=nav_aggregation,json=navAggregation,proto3,enum=provlabs.vault.v1.NAVAggregation" json:"nav_aggregation,omitempty"`
+	// nav_source_max_age_seconds is how long a submission counts toward the quorum.
+	NavSourceMaxAgeSeconds uint64 `protobuf:"varint,6,opt,name=nav_source_max_age_seconds,json=navSourceMaxAgeSeconds,proto3" json:"nav_source_max_age_seconds,omitempty"`
+}
+
+func (m *MsgUpdateNAVSourcesRequest) Reset()         { *m = MsgUpdateNAVSourcesRequest{} }
+func (m *MsgUpdateNAVSourcesRequest) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateNAVSourcesRequest) ProtoMessage()    {}
+func (*MsgUpdateNAVSourcesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1bb9c8306211b32d, []int{74}
+}
+func (m *MsgUpdateNAVSourcesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateNAVSourcesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateNAVSourcesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *MsgUpdateNAVSourcesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateNAVSourcesRequest.Merge(m, src)
+}
+func (m *MsgUpdateNAVSourcesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateNAVSourcesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateNAVSourcesRequest.DiscardUnknown(m)
 }
 
-func (c *msgClient) AcceptAsset(ctx context.Context, in *MsgAcceptAssetRequest, opts ...grpc.CallOption) (*MsgAcceptAssetResponse, error) {
-	out := new(MsgAcceptAssetResponse)
-	err := c.cc.Invoke(ctx, "/provlabs.vault.v1.Msg/AcceptAsset", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgUpdateNAVSourcesRequest proto.InternalMessageInfo
+
+func (m *MsgUpdateNAVSourcesRequest) GetAuthority() string {
+	if m != nil {
+		return m.Authority
 	}
-	return out, nil
+	return ""
 }
 
-func (c *msgClient) RejectAsset(ctx context.Context, in *MsgRejectAssetRequest, opts ...grpc.CallOption) (*MsgRejectAssetResponse, error) {
-	out := new(MsgRejectAssetResponse)
-	err := c.cc.Invoke(ctx, "/provlabs.vault.v1.Msg/RejectAsset", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgUpdateNAVSourcesRequest) GetVaultAddress() string {
+	if m != nil {
+		return m.VaultAddress
 	}
-	return out, nil
+	return ""
 }
 
-// MsgServer is the server API for Msg service.
-type MsgServer interface {
-	// CreateVault creates a new vault.
-	// Must be signed by the governance module account, so vaults can only be created
-	// through a passed governance proposal.
-	CreateVault(context.Context, *MsgCreateVaultRequest) (*MsgCreateVaultResponse, error)
-	// SetShareDenomMetadata allows Denom Metadata (see bank module) to be set for the vault's share denom.
-	// Similar to marker's SetDenomMetadata, but scoped to a specific vault. Only the vault admin may call this.
-	SetShareDenomMetadata(context.Context, *MsgSetShareDenomMetadataRequest) (*MsgSetShareDenomMetadataResponse, error)
-	// SwapIn exchanges underlying assets for vault shares by depositing them into a vault.
-	SwapIn(context.Context, *MsgSwapInRequest) (*MsgSwapInResponse, error)
-	// SwapOut exchanges vault shares for underlying assets by withdrawing from a vault.
-	SwapOut(context.Context, *MsgSwapOutRequest) (*MsgSwapOutResponse, error)
-	// UpdateMinInterestRate sets the minimum allowed annual interest rate for a vault.
-	UpdateMinInterestRate(context.Context, *MsgUpdateMinInterestRateRequest) (*MsgUpdateMinInterestRateResponse, error)
-	// UpdateMaxInterestRate sets the maximum allowed annual interest rate for a vault.
-	UpdateMaxInterestRate(context.Context, *MsgUpdateMaxInterestRateRequest) (*MsgUpdateMaxInterestRateResponse, error)
-	// UpdateInterestRate allows the interest admin to update the current annual interest rate within limits.
-	UpdateInterestRate(context.Context, *MsgUpdateInterestRateRequest) (*MsgUpdateInterestRateResponse, error)
-	// UpdateWithdrawalDelay allows updating the withdrawal delay for a vault.
-	UpdateWithdrawalDelay(context.Context, *MsgUpdateWithdrawalDelayRequest) (*MsgUpdateWithdrawalDelayResponse, error)
-	// UpdateMinSwapInValue sets the minimum allowed value for a swap-in operation.
-	UpdateMinSwapInValue(context.Context, *MsgUpdateMinSwapInValueRequest) (*MsgUpdateMinSwapInValueResponse, error)
-	// UpdateMinSwapOutValue sets the minimum allowed value for a swap-out operation.
-	UpdateMinSwapOutValue(context.Context, *MsgUpdateMinSwapOutValueRequest) (*MsgUpdateMinSwapOutValueResponse, error)
-	// UpdateMaxSwapInValue sets the maximum allowed value for a swap-in operation.
-	UpdateMaxSwapInValue(context.Context, *MsgUpdateMaxSwapInValueRequest) (*MsgUpdateMaxSwapInValueResponse, error)
-	// UpdateMaxSwapOutValue sets the maximum allowed value for a swap-out operation.
-	UpdateMaxSwapOutValue(context.Context, *MsgUpdateMaxSwapOutValueRequest) (*MsgUpdateMaxSwapOutValueResponse, error)
-	// ToggleSwapIn allows enabling or disabling swap-in operations for a vault.
-	ToggleSwapIn(context.Context, *MsgToggleSwapInRequest) (*MsgToggleSwapInResponse, error)
-	// ToggleSwapOut allows enabling or disabling swap-out operations for a vault.
-	ToggleSwapOut(context.Context, *MsgToggleSwapOutRequest) (*MsgToggleSwapOutResponse, error)
-	// DepositInterestFunds allows depositing funds into the vault for paying interest.
-	// May be signed by the vault admin or the configured asset manager.
-	DepositInterestFunds(context.Context, *MsgDepositInterestFundsRequest) (*MsgDepositInterestFundsResponse, error)
-	// WithdrawInterestFunds allows withdrawing unused interest funds.
-	// May be signed by the vault admin or the configured asset manager.
-	WithdrawInterestFunds(context.Context, *MsgWithdrawInterestFundsRequest) (*MsgWithdrawInterestFundsResponse, error)
-	// DepositPrincipalFunds allows depositing principal funds into a vault.
-	// May be signed by the vault admin or the configured asset manager.
-	DepositPrincipalFunds(context.Context, *MsgDepositPrincipalFundsRequest) (*MsgDepositPrincipalFundsResponse, error)
-	// WithdrawPrincipalFunds allows withdrawing principal funds from a vault.
-	// May be signed by the vault admin or the configured asset manager.
-	WithdrawPrincipalFunds(context.Context, *MsgWithdrawPrincipalFundsRequest) (*MsgWithdrawPrincipalFundsResponse, error)
-	// ExpeditePendingSwapOut expedites a pending swap out from a vault.
-	ExpeditePendingSwapOut(context.Context, *MsgExpeditePendingSwapOutRequest) (*MsgExpeditePendingSwapOutResponse, error)
-	// PauseVault pauses user-facing swap operations for a vault and records a reason.
-	// May be signed by the vault admin or the configured asset manager.
-	PauseVault(context.Context, *MsgPauseVaultRequest) (*MsgPauseVaultResponse, error)
-	// UnpauseVault re-enables user-facing swap operations for a vault.
-	// May be signed by the vault admin or the configured asset manager.
-	UnpauseVault(context.Context, *MsgUnpauseVaultRequest) (*MsgUnpauseVaultResponse, error)
-	// SetBridgeAddress sets the single external bridge address allowed to mint or burn shares for a vault.
-	SetBridgeAddress(context.Context, *MsgSetBridgeAddressRequest) (*MsgSetBridgeAddressResponse, error)
-	// ToggleBridge enables or disables the bridge functionality for a vault.
-	ToggleBridge(context.Context, *MsgToggleBridgeRequest) (*MsgToggleBridgeResponse, error)
-	// BridgeMintShares mints local share marker supply for a vault; must be signed by the configured bridge address.
-	BridgeMintShares(context.Context, *MsgBridgeMintSharesRequest) (*MsgBridgeMintSharesResponse, error)
-	// BridgeBurnShares burns local share marker supply for a vault; must be signed by the configured bridge address.
-	BridgeBurnShares(context.Context, *MsgBridgeBurnSharesRequest) (*MsgBridgeBurnSharesResponse, error)
-	// SetAssetManager sets or clears the optional asset manager address for a vault.
-	// The vault admin must sign this transaction. Passing an empty address clears it.
-	SetAssetManager(context.Context, *MsgSetAssetManagerRequest) (*MsgSetAssetManagerResponse, error)
-	// UpdateParams updates the module parameters.
-	UpdateParams(context.Context, *MsgUpdateParamsRequest) (*MsgUpdateParamsResponse, error)
-	// UpdateVaultAUMFeeBips updates the AUM fee bips for a specific vault.
-	UpdateVaultAUMFeeBips(context.Context, *MsgUpdateVaultAUMFeeBipsRequest) (*MsgUpdateVaultAUMFeeBipsResponse, error)
-	// UpdateVaultNAV creates or updates a vault's internal net asset value entry for a denom.
-	// Must be signed by the vault's NAV authority.
-	UpdateVaultNAV(context.Context, *MsgUpdateVaultNAVRequest) (*MsgUpdateVaultNAVResponse, error)
-	// RemoveVaultNAV deletes a vault's internal net asset value entry for a denom the vault
-	// does not hold, revoking the authorization to acquire it at that price.
-	// Must be signed by the vault's NAV authority.
-	RemoveVaultNAV(context.Context, *MsgRemoveVaultNAVRequest) (*MsgRemoveVaultNAVResponse, error)
-	// UpdateNAVAuthority rotates the address authorized to mutate a vault's internal NAV table.
-	// Must be signed by the vault admin.
-	UpdateNAVAuthority(context.Context, *MsgUpdateNAVAuthorityRequest) (*MsgUpdateNAVAuthorityResponse, error)
-	// AcceptAsset settles a pending exchange-module payment whose target is the vault,
-	// exchanging an external asset for the vault's underlying asset.
-	// Must be signed by the vault's asset manager; the admin cannot settle.
-	AcceptAsset(context.Context, *MsgAcceptAssetRequest) (*MsgAcceptAssetResponse, error)
-	// RejectAsset declines a pending exchange-module payment whose target is the vault,
-	// causing the exchange module to cancel it and refund the source's escrow.
-	// Must be signed by the vault's asset manager; the admin cannot reject.
-	RejectAsset(context.Context, *MsgRejectAssetRequest) (*MsgRejectAssetResponse, error)
+func (m *MsgUpdateNAVSourcesRequest) GetNavSources() []string {
+	if m != nil {
+		return m.NavSources
+	}
+	return nil
 }
 
-// UnimplementedMsgServer can be embedded to have forward compatible implementations.
-type UnimplementedMsgServer struct {
+func (m *MsgUpdateNAVSourcesRequest) GetNavSourceQuorum() uint32 {
+	if m != nil {
+		return m.NavSourceQuorum
+	}
+	return 0
 }
 
-func (*UnimplementedMsgServer) CreateVault(ctx context.Context, req *MsgCreateVaultRequest) (*MsgCreateVaultResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CreateVault not implemented")
+func (m *MsgUpdateNAVSourcesRequest) GetNavAggregation() NAVAggregation {
+	if m != nil {
+		return m.NavAggregation
+	}
+	return NAVAggregation_NAV_AGGREGATION_MEDIAN
 }
-func (*UnimplementedMsgServer) SetShareDenomMetadata(ctx context.Context, req *MsgSetShareDenomMetadataRequest) (*MsgSetShareDenomMetadataResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SetShareDenomMetadata not implemented")
+
+func (m *MsgUpdateNAVSourcesRequest) GetNavSourceMaxAgeSeconds() uint64 {
+	if m != nil {
+		return m.NavSourceMaxAgeSeconds
+	}
+	return 0
 }
-func (*UnimplementedMsgServer) SwapIn(ctx context.Context, req *MsgSwapInRequest) (*MsgSwapInResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SwapIn not implemented")
+
+// MsgUpdateNAVSourcesResponse is the response message for the UpdateNAVSources endpoint.
+type MsgUpdateNAVSourcesResponse struct {
 }
-func (*UnimplementedMsgServer) SwapOut(ctx context.Context, req *MsgSwapOutRequest) (*MsgSwapOutResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SwapOut not implemented")
+
+func (m *MsgUpdateNAVSourcesResponse) Reset()         { *m = MsgUpdateNAVSourcesResponse{} }
+func (m *MsgUpdateNAVSourcesResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateNAVSourcesResponse) ProtoMessage()    {}
+func (*MsgUpdateNAVSourcesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1bb9c8306211b32d, []int{75}
 }
-func (*UnimplementedMsgServer) UpdateMinInterestRate(ctx context.Context, req *MsgUpdateMinInterestRateRequest) (*MsgUpdateMinInterestRateResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateMinInterestRate not implemented")
+func (m *MsgUpdateNAVSourcesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedMsgServer) UpdateMaxInterestRate(ctx context.Context, req *MsgUpdateMaxInterestRateRequest) (*MsgUpdateMaxInterestRateResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateMaxInterestRate not implemented")
+func (m *MsgUpdateNAVSourcesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateNAVSourcesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedMsgServer) UpdateInterestRate(ctx context.Context, req *MsgUpdateInterestRateRequest) (*MsgUpdateInterestRateResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateInterestRate not implemented")
+func (m *MsgUpdateNAVSourcesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateNAVSourcesResponse.Merge(m, src)
 }
-func (*UnimplementedMsgServer) UpdateWithdrawalDelay(ctx context.Context, req *MsgUpdateWithdrawalDelayRequest) (*MsgUpdateWithdrawalDelayResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateWithdrawalDelay not implemented")
+func (m *MsgUpdateNAVSourcesResponse) XXX_Size() int {
+	return m.Size()
 }
-func (*UnimplementedMsgServer) UpdateMinSwapInValue(ctx context.Context, req *MsgUpdateMinSwapInValueRequest) (*MsgUpdateMinSwapInValueResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateMinSwapInValue not implemented")
+func (m *MsgUpdateNAVSourcesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateNAVSourcesResponse.DiscardUnknown(m)
 }
-func (*UnimplementedMsgServer) UpdateMinSwapOutValue(ctx context.Context, req *MsgUpdateMinSwapOutValueRequest) (*MsgUpdateMinSwapOutValueResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateMinSwapOutValue not implemented")
+
+var xxx_messageInfo_MsgUpdateNAVSourcesResponse proto.InternalMessageInfo
+
+// MsgSubmitSourceNAVRequest is the request message for a NAV source submitting its price
+// for a denom.
+type MsgSubmitSourceNAVRequest struct {
+	// source is the address of one of the vault's NAV sources.
+	Source string `protobuf:"bytes,1,opt,name=source,proto3" json:"source,omitempty"`
+	// vault_address is the bech32 address of the vault being priced.
+	VaultAddress string `protobuf:"bytes,2,opt,name=vault_address,json=vaultAddress,proto3" json:"vault_address,omitempty"`
+	// denom is the asset denomination being priced. It must not be the vault's
+	// share denom.
+	Denom string `protobuf:"bytes,3,opt,name=denom,proto3" json:"denom,omitempty"`
+	// price is the total value of `volume` units of the denom, denominated in
+	// the vault's underlying asset.
+	Price types1.Coin `protobuf:"bytes,4,opt,name=price,proto3" json:"price"`
+	// volume is the number of units of the denom that price covers. It must be positive.
+	Volume cosmossdk_io_math.Int `protobuf:"bytes,5,opt,name=volume,proto3,customtype=cosmossdk.io/math.Int" json:"volume"`
 }
-func (*UnimplementedMsgServer) UpdateMaxSwapInValue(ctx context.Context, req *MsgUpdateMaxSwapInValueRequest) (*MsgUpdateMaxSwapInValueResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateMaxSwapInValue not implemented")
+
+func (m *MsgSubmitSourceNAVRequest) Reset()         { *m = MsgSubmitSourceNAVRequest{} }
+func (m *MsgSubmitSourceNAVRequest) String() string { return proto.CompactTextString(m) }
+func (*MsgSubmitSourceNAVRequest) ProtoMessage()    {}
+func (*MsgSubmitSourceNAVRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1bb9c8306211b32d, []int{76}
 }
-func (*UnimplementedMsgServer) UpdateMaxSwapOutValue(ctx context.Context, req *MsgUpdateMaxSwapOutValueRequest) (*MsgUpdateMaxSwapOutValueResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateMaxSwapOutValue not implemented")
+func (m *MsgSubmitSourceNAVRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedMsgServer) ToggleSwapIn(ctx context.Context, req *MsgToggleSwapInRequest) (*MsgToggleSwapInResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ToggleSwapIn not implemented")
+func (m *MsgSubmitSourceNAVRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSubmitSourceNAVRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedMsgServer) ToggleSwapOut(ctx context.Context, req *MsgToggleSwapOutRequest) (*MsgToggleSwapOutResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ToggleSwapOut not implemented")
+func (m *MsgSubmitSourceNAVRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSubmitSourceNAVRequest.Merge(m, src)
 }
-func (*UnimplementedMsgServer) DepositInterestFunds(ctx context.Context, req *MsgDepositInterestFundsRequest) (*MsgDepositInterestFundsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DepositInterestFunds not implemented")
+func (m *MsgSubmitSourceNAVRequest) XXX_Size() int {
+	return m.Size()
 }
-func (*UnimplementedMsgServer) WithdrawInterestFunds(ctx context.Context, req *MsgWithdrawInterestFundsRequest) (*MsgWithdrawInterestFundsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method WithdrawInterestFunds not implemented")
+func (m *MsgSubmitSourceNAVRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSubmitSourceNAVRequest.DiscardUnknown(m)
 }
-func (*UnimplementedMsgServer) DepositPrincipalFunds(ctx context.Context, req *MsgDepositPrincipalFundsRequest) (*MsgDepositPrincipalFundsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DepositPrincipalFunds not implemented")
+
+var xxx_messageInfo_MsgSubmitSourceNAVRequest proto.InternalMessageInfo
+
+func (m *MsgSubmitSourceNAVRequest) GetSource() string {
+	if m != nil {
+		return m.Source
+	}
+	return ""
 }
-func (*UnimplementedMsgServer) WithdrawPrincipalFunds(ctx context.Context, req *MsgWithdrawPrincipalFundsRequest) (*MsgWithdrawPrincipalFundsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method WithdrawPrincipalFunds not implemented")
+
+func (m *MsgSubmitSourceNAVRequest) GetVaultAddress() string {
+	if m != nil {
+		return m.VaultAddress
+	}
+	return ""
 }
-func (*UnimplementedMsgServer) ExpeditePendingSwapOut(ctx context.Context, req *MsgExpeditePendingSwapOutRequest) (*MsgExpeditePendingSwapOutResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ExpeditePendingSwapOut not implemented")
+
+func (m *MsgSubmitSourceNAVRequest) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
 }
-func (*UnimplementedMsgServer) PauseVault(ctx context.Context, req *MsgPauseVaultRequest) (*MsgPauseVaultResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method PauseVault not implemented")
+
+func (m *MsgSubmitSourceNAVRequest) GetPrice() types1.Coin {
+	if m != nil {
+		return m.Price
+	}
+	return types1.Coin{}
 }
-func (*UnimplementedMsgServer) UnpauseVault(ctx context.Context, req *MsgUnpauseVaultRequest) (*MsgUnpauseVaultResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UnpauseVault not implemented")
+
+// MsgSubmitSourceNAVResponse is the response message for the SubmitSourceNAV endpoint.
+type MsgSubmitSourceNAVResponse struct {
 }
-func (*UnimplementedMsgServer) SetBridgeAddress(ctx context.Context, req *MsgSetBridgeAddressRequest) (*MsgSetBridgeAddressResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SetBridgeAddress not implemented")
+
+func (m *MsgSubmitSourceNAVResponse) Reset()         { *m = MsgSubmitSourceNAVResponse{} }
+func (m *MsgSubmitSourceNAVResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSubmitSourceNAVResponse) ProtoMessage()    {}
+func (*MsgSubmitSourceNAVResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1bb9c8306211b32d, []int{77}
 }
-func (*UnimplementedMsgServer) ToggleBridge(ctx context.Context, req *MsgToggleBridgeRequest) (*MsgToggleBridgeResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ToggleBridge not implemented")
+func (m *MsgSubmitSourceNAVResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedMsgServer) BridgeMintShares(ctx context.Context, req *MsgBridgeMintSharesRequest) (*MsgBridgeMintSharesResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method BridgeMintShares not implemented")
+func (m *MsgSubmitSourceNAVResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSubmitSourceNAVResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedMsgServer) BridgeBurnShares(ctx context.Context, req *MsgBridgeBurnSharesRequest) (*MsgBridgeBurnSharesResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method BridgeBurnShares not implemented")
+func (m *MsgSubmitSourceNAVResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSubmitSourceNAVResponse.Merge(m, src)
 }
-func (*UnimplementedMsgServer) SetAssetManager(ctx context.Context, req *MsgSetAssetManagerRequest) (*MsgSetAssetManagerResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SetAssetManager not implemented")
+func (m *MsgSubmitSourceNAVResponse) XXX_Size() int {
+	return m.Size()
 }
-func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgUpdateParamsRequest) (*MsgUpdateParamsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
+func (m *MsgSubmitSourceNAVResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSubmitSourceNAVResponse.DiscardUnknown(m)
 }
-func (*UnimplementedMsgServer) UpdateVaultAUMFeeBips(ctx context.Context, req *MsgUpdateVaultAUMFeeBipsRequest) (*MsgUpdateVaultAUMFeeBipsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateVaultAUMFeeBips not implemented")
+
+var xxx_messageInfo_MsgSubmitSourceNAVResponse proto.InternalMessageInfo
+
+// NAVUpdate is a single internal NAV entry within a MsgBatchUpdateVaultNAVRequest.
+type NAVUpdate struct {
+	// denom is the asset denomination being priced. It must not be the vault's
+	// share denom.
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	// price is the total value of `volume` units of the denom, denominated in
+	// the vault's underlying asset.
+	Price types1.Coin `protobuf:"bytes,2,opt,name=price,proto3" json:"price"`
+	// volume is the number of units of the denom that price covers. It must be positive.
+	Volume cosmossdk_io_math.Int `protobuf:"bytes,3,opt,name=volume,proto3,customtype=cosmossdk.io/math.Int" json:"volume"`
+	// source identifies the origin of this NAV update (for example an oracle name).
+	// It is optional.
+	Source string `protobuf:"bytes,4,opt,name=source,proto3" json:"source,omitempty"`
 }
-func (*UnimplementedMsgServer) UpdateVaultNAV(ctx context.Context, req *MsgUpdateVaultNAVRequest) (*MsgUpdateVaultNAVResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateVaultNAV not implemented")
+
+func (m *NAVUpdate) Reset()         { *m = NAVUpdate{} }
+func (m *NAVUpdate) String() string { return proto.CompactTextString(m) }
+func (*NAVUpdate) ProtoMessage()    {}
+func (*NAVUpdate) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1bb9c8306211b32d, []int{78}
 }
-func (*UnimplementedMsgServer) RemoveVaultNAV(ctx context.Context, req *MsgRemoveVaultNAVRequest) (*MsgRemoveVaultNAVResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method RemoveVaultNAV not implemented")
+func (m *NAVUpdate) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedMsgServer) UpdateNAVAuthority(ctx context.Context, req *MsgUpdateNAVAuthorityRequest) (*MsgUpdateNAVAuthorityResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateNAVAuthority not implemented")
+func (m *NAVUpdate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_NAVUpdate.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedMsgServer) AcceptAsset(ctx context.Context, req *MsgAcceptAssetRequest) (*MsgAcceptAssetResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method AcceptAsset not implemented")
+func (m *NAVUpdate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_NAVUpdate.Merge(m, src)
 }
-func (*UnimplementedMsgServer) RejectAsset(ctx context.Context, req *MsgRejectAssetRequest) (*MsgRejectAssetResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method RejectAsset not implemented")
+func (m *NAVUpdate) XXX_Size() int {
+	return m.Size()
 }
-
-func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
-	s.RegisterService(&_Msg_serviceDesc, srv)
+func (m *NAVUpdate) XXX_DiscardUnknown() {
+	xxx_messageInfo_NAVUpdate.DiscardUnknown(m)
 }
 
-func _Msg_CreateVault_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgCreateVaultRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).CreateVault(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/provlabs.vault.v1.Msg/CreateVault",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).CreateVault(ctx, req.(*MsgCreateVaultRequest))
+var xxx_messageInfo_NAVUpdate proto.InternalMessageInfo
+
+func (m *NAVUpdate) GetDenom() string {
+	if m != nil {
+		return m.Denom
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Msg_SetShareDenomMetadata_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgSetShareDenomMetadataRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).SetShareDenomMetadata(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/provlabs.vault.v1.Msg/SetShareDenomMetadata",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).SetShareDenomMetadata(ctx, req.(*MsgSetShareDenomMetadataRequest))
+func (m *NAVUpdate) GetPrice() types1.Coin {
+	if m != nil {
+		return m.Price
 	}
-	return interceptor(ctx, in, info, handler)
+	return types1.Coin{}
 }
 
-func _Msg_SwapIn_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgSwapInRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).SwapIn(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/provlabs.vault.v1.Msg/SwapIn",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).SwapIn(ctx, req.(*MsgSwapInRequest))
+func (m *NAVUpdate) GetSource() string {
+	if m != nil {
+		return m.Source
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Msg_SwapOut_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgSwapOutRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).SwapOut(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/provlabs.vault.v1.Msg/SwapOut",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).SwapOut(ctx, req.(*MsgSwapOutRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+// MsgBatchUpdateVaultNAVRequest is the request message for creating or updating many of a
+// vault's internal NAV entries in one message.
+type MsgBatchUpdateVaultNAVRequest struct {
+	// signer is the address of a holder of the vault's NAV authority role authorizing these updates.
+	Signer string `protobuf:"bytes,1,opt,name=signer,proto3" json:"signer,omitempty"`
+	// vault_address is the bech32 address of the vault whose NAV entries are being updated.
+	VaultAddress string `protobuf:"bytes,2,opt,name=vault_address,json=vaultAddress,proto3" json:"vault_address,omitempty"`
+	// updates are the NAV entries to write, at most one per denom.
+	Updates []NAVUpdate `protobuf:"bytes,3,rep,name=updates,proto3" json:"updates"`
 }
 
-func _Msg_UpdateMinInterestRate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgUpdateMinInterestRateRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).UpdateMinInterestRate(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/provlabs.vault.v1.Msg/UpdateMinInterestRate",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).UpdateMinInterestRate(ctx, req.(*MsgUpdateMinInterestRateRequest))
+func (m *MsgBatchUpdateVaultNAVRequest) Reset()         { *m = MsgBatchUpdateVaultNAVRequest{} }
+func (m *MsgBatchUpdateVaultNAVRequest) String() string { return proto.CompactTextString(m) }
+func (*MsgBatchUpdateVaultNAVRequest) ProtoMessage()    {}
+func (*MsgBatchUpdateVaultNAVRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1bb9c8306211b32d, []int{79}
+}
+func (m *MsgBatchUpdateVaultNAVRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgBatchUpdateVaultNAVRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgBatchUpdateVaultNAVRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *MsgBatchUpdateVaultNAVRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgBatchUpdateVaultNAVRequest.Merge(m, src)
+}
+func (m *MsgBatchUpdateVaultNAVRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgBatchUpdateVaultNAVRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgBatchUpdateVaultNAVRequest.DiscardUnknown(m)
 }
 
-func _Msg_UpdateMaxInterestRate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgUpdateMaxInterestRateRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).UpdateMaxInterestRate(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/provlabs.vault.v1.Msg/UpdateMaxInterestRate",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).UpdateMaxInterestRate(ctx, req.(*MsgUpdateMaxInterestRateRequest))
+var xxx_messageInfo_MsgBatchUpdateVaultNAVRequest proto.InternalMessageInfo
+
+func (m *MsgBatchUpdateVaultNAVRequest) GetSigner() string {
+	if m != nil {
+		return m.Signer
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Msg_UpdateInterestRate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgUpdateInterestRateRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).UpdateInterestRate(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/provlabs.vault.v1.Msg/UpdateInterestRate",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).UpdateInterestRate(ctx, req.(*MsgUpdateInterestRateRequest))
+func (m *MsgBatchUpdateVaultNAVRequest) GetVaultAddress() string {
+	if m != nil {
+		return m.VaultAddress
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Msg_UpdateWithdrawalDelay_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgUpdateWithdrawalDelayRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).UpdateWithdrawalDelay(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/provlabs.vault.v1.Msg/UpdateWithdrawalDelay",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).UpdateWithdrawalDelay(ctx, req.(*MsgUpdateWithdrawalDelayRequest))
+func (m *MsgBatchUpdateVaultNAVRequest) GetUpdates() []NAVUpdate {
+	if m != nil {
+		return m.Updates
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Msg_UpdateMinSwapInValue_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgUpdateMinSwapInValueRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).UpdateMinSwapInValue(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/provlabs.vault.v1.Msg/UpdateMinSwapInValue",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).UpdateMinSwapInValue(ctx, req.(*MsgUpdateMinSwapInValueRequest))
+// MsgBatchUpdateVaultNAVResponse is the response message for the BatchUpdateVaultNAV endpoint.
+type MsgBatchUpdateVaultNAVResponse struct {
+	// pending_denoms lists the denoms whose update exceeded the vault's max_nav_change_bips
+	// and was stored as a pending NAV proposal instead of being applied.
+	PendingDenoms []string `protobuf:"bytes,1,rep,name=pending_denoms,json=pendingDenoms,proto3" json:"pending_denoms,omitempty"`
+}
+
+func (m *MsgBatchUpdateVaultNAVResponse) Reset()         { *m = MsgBatchUpdateVaultNAVResponse{} }
+func (m *MsgBatchUpdateVaultNAVResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgBatchUpdateVaultNAVResponse) ProtoMessage()    {}
+func (*MsgBatchUpdateVaultNAVResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1bb9c8306211b32d, []int{80}
+}
+func (m *MsgBatchUpdateVaultNAVResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgBatchUpdateVaultNAVResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgBatchUpdateVaultNAVResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *MsgBatchUpdateVaultNAVResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgBatchUpdateVaultNAVResponse.Merge(m, src)
+}
+func (m *MsgBatchUpdateVaultNAVResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgBatchUpdateVaultNAVResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgBatchUpdateVaultNAVResponse.DiscardUnknown(m)
 }
 
-func _Msg_UpdateMinSwapOutValue_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgUpdateMinSwapOutValueRequest)
-	if err := dec(in); err != nil {
-		return nil, err
+var xxx_messageInfo_MsgBatchUpdateVaultNAVResponse proto.InternalMessageInfo
+
+func (m *MsgBatchUpdateVaultNAVResponse) GetPendingDenoms() []string {
+	if m != nil {
+		return m.PendingDenoms
 	}
-	if interceptor == nil {
-		return srv.(MsgServer).UpdateMinSwapOutValue(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/provlabs.vault.v1.Msg/UpdateMinSwapOutValue",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).UpdateMinSwapOutValue(ctx, req.(*MsgUpdateMinSwapOutValueRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Msg_UpdateMaxSwapInValue_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgUpdateMaxSwapInValueRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).UpdateMaxSwapInValue(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/provlabs.vault.v1.Msg/UpdateMaxSwapInValue",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).UpdateMaxSwapInValue(ctx, req.(*MsgUpdateMaxSwapInValueRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+// MsgUpdatePriceSourcesRequest is the request message for setting a vault's price-source chain.
+type MsgUpdatePriceSourcesRequest struct {
+	// authority is the address of the vault administrator authorizing this update.
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// vault_address is the bech32 address of the vault being updated.
+	VaultAddress string `protobuf:"bytes,2,opt,name=vault_address,json=vaultAddress,proto3" json:"vault_address,omitempty"`
+	// price_sources is the new ordered chain of price sources. Each source may appear at
+	// most once; an empty chain consults only the internal NAV table.
+	PriceSources []PriceSourceType `protobuf:"varint,3,rep,packed,name=price_sources,json=priceSources,proto3,enum=provlabs.vault.v1.PriceSourceType" json:"price_sources,omitempty"`
 }
 
-func _Msg_UpdateMaxSwapOutValue_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgUpdateMaxSwapOutValueRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).UpdateMaxSwapOutValue(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/provlabs.vault.v1.Msg/UpdateMaxSwapOutValue",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).UpdateMaxSwapOutValue(ctx, req.(*MsgUpdateMaxSwapOutValueRequest))
+func (m *MsgUpdatePriceSourcesRequest) Reset()         { *m = MsgUpdatePriceSourcesRequest{} }
+func (m *MsgUpdatePriceSourcesRequest) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdatePriceSourcesRequest) ProtoMessage()    {}
+func (*MsgUpdatePriceSourcesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1bb9c8306211b32d, []int{81}
+}
+func (m *MsgUpdatePriceSourcesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdatePriceSourcesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdatePriceSourcesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *MsgUpdatePriceSourcesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdatePriceSourcesRequest.Merge(m, src)
+}
+func (m *MsgUpdatePriceSourcesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdatePriceSourcesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdatePriceSourcesRequest.DiscardUnknown(m)
 }
 
-func _Msg_ToggleSwapIn_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgToggleSwapInRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).ToggleSwapIn(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/provlabs.vault.v1.Msg/ToggleSwapIn",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).ToggleSwapIn(ctx, req.(*MsgToggleSwapInRequest))
+var xxx_messageInfo_MsgUpdatePriceSourcesRequest proto.InternalMessageInfo
+
+func (m *MsgUpdatePriceSourcesRequest) GetAuthority() string {
+	if m != nil {
+		return m.Authority
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Msg_ToggleSwapOut_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgToggleSwapOutRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).ToggleSwapOut(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/provlabs.vault.v1.Msg/ToggleSwapOut",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).ToggleSwapOut(ctx, req.(*MsgToggleSwapOutRequest))
+func (m *MsgUpdatePriceSourcesRequest) GetVaultAddress() string {
+	if m != nil {
+		return m.VaultAddress
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Msg_DepositInterestFunds_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgDepositInterestFundsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).DepositInterestFunds(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/provlabs.vault.v1.Msg/DepositInterestFunds",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).DepositInterestFunds(ctx, req.(*MsgDepositInterestFundsRequest))
+func (m *MsgUpdatePriceSourcesRequest) GetPriceSources() []PriceSourceType {
+	if m != nil {
+		return m.PriceSources
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Msg_WithdrawInterestFunds_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgWithdrawInterestFundsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).WithdrawInterestFunds(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/provlabs.vault.v1.Msg/WithdrawInterestFunds",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).WithdrawInterestFunds(ctx, req.(*MsgWithdrawInterestFundsRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+// MsgUpdatePriceSourcesResponse is the response message for the UpdatePriceSources endpoint.
+type MsgUpdatePriceSourcesResponse struct {
 }
 
-func _Msg_DepositPrincipalFunds_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgDepositPrincipalFundsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).DepositPrincipalFunds(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/provlabs.vault.v1.Msg/DepositPrincipalFunds",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).DepositPrincipalFunds(ctx, req.(*MsgDepositPrincipalFundsRequest))
+func (m *MsgUpdatePriceSourcesResponse) Reset()         { *m = MsgUpdatePriceSourcesResponse{} }
+func (m *MsgUpdatePriceSourcesResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdatePriceSourcesResponse) ProtoMessage()    {}
+func (*MsgUpdatePriceSourcesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1bb9c8306211b32d, []int{82}
+}
+func (m *MsgUpdatePriceSourcesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdatePriceSourcesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdatePriceSourcesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *MsgUpdatePriceSourcesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdatePriceSourcesResponse.Merge(m, src)
+}
+func (m *MsgUpdatePriceSourcesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdatePriceSourcesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdatePriceSourcesResponse.DiscardUnknown(m)
 }
 
-func _Msg_WithdrawPrincipalFunds_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgWithdrawPrincipalFundsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).WithdrawPrincipalFunds(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/provlabs.vault.v1.Msg/WithdrawPrincipalFunds",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).WithdrawPrincipalFunds(ctx, req.(*MsgWithdrawPrincipalFundsRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+var xxx_messageInfo_MsgUpdatePriceSourcesResponse proto.InternalMessageInfo
+
+// MsgUpdateAssetHaircutRequest is the request message for setting the valuation haircut a
+// vault applies to a held denom.
+type MsgUpdateAssetHaircutRequest struct {
+	// signer is the address of a holder of the vault's NAV authority role authorizing this update.
+	Signer string `protobuf:"bytes,1,opt,name=signer,proto3" json:"signer,omitempty"`
+	// vault_address is the bech32 address of the vault being updated.
+	VaultAddress string `protobuf:"bytes,2,opt,name=vault_address,json=vaultAddress,proto3" json:"vault_address,omitempty"`
+	// denom is the held asset denomination the haircut applies to.
+	Denom string `protobuf:"bytes,3,opt,name=denom,proto3" json:"denom,omitempty"`
+	// haircut_bips is the new haircut in basis points, at most 10000. Zero removes the haircut.
+	HaircutBips uint32 `protobuf:"varint,4,opt,name=haircut_bips,json=haircutBips,proto3" json:"haircut_bips,omitempty"`
 }
 
-func _Msg_ExpeditePendingSwapOut_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgExpeditePendingSwapOutRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).ExpeditePendingSwapOut(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/provlabs.vault.v1.Msg/ExpeditePendingSwapOut",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).ExpeditePendingSwapOut(ctx, req.(*MsgExpeditePendingSwapOutRequest))
+func (m *MsgUpdateAssetHaircutRequest) Reset()         { *m = MsgUpdateAssetHaircutRequest{} }
+func (m *MsgUpdateAssetHaircutRequest) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateAssetHaircutRequest) ProtoMessage()    {}
+func (*MsgUpdateAssetHaircutRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1bb9c8306211b32d, []int{83}
+}
+func (m *MsgUpdateAssetHaircutRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateAssetHaircutRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateAssetHaircutRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *MsgUpdateAssetHaircutRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateAssetHaircutRequest.Merge(m, src)
+}
+func (m *MsgUpdateAssetHaircutRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateAssetHaircutRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateAssetHaircutRequest.DiscardUnknown(m)
 }
 
-func _Msg_PauseVault_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgPauseVaultRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).PauseVault(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/provlabs.vault.v1.Msg/PauseVault",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).PauseVault(ctx, req.(*MsgPauseVaultRequest))
+var xxx_messageInfo_MsgUpdateAssetHaircutRequest proto.InternalMessageInfo
+
+func (m *MsgUpdateAssetHaircutRequest) GetSigner() string {
+	if m != nil {
+		return m.Signer
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Msg_UnpauseVault_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgUnpauseVaultRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).UnpauseVault(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/provlabs.vault.v1.Msg/UnpauseVault",
+func (m *MsgUpdateAssetHaircutRequest) GetVaultAddress() string {
+	if m != nil {
+		return m.VaultAddress
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).UnpauseVault(ctx, req.(*MsgUnpauseVaultRequest))
+	return ""
+}
+
+func (m *MsgUpdateAssetHaircutRequest) GetDenom() string {
+	if m != nil {
+		return m.Denom
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Msg_SetBridgeAddress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgSetBridgeAddressRequest)
-	if err := dec(in); err != nil {
-		return nil, err
+func (m *MsgUpdateAssetHaircutRequest) GetHaircutBips() uint32 {
+	if m != nil {
+		return m.HaircutBips
 	}
-	if interceptor == nil {
-		return srv.(MsgServer).SetBridgeAddress(ctx, in)
+	return 0
+}
+
+// MsgUpdateAssetHaircutResponse is the response message for the UpdateAssetHaircut endpoint.
+type MsgUpdateAssetHaircutResponse struct {
+}
+
+func (m *MsgUpdateAssetHaircutResponse) Reset()         { *m = MsgUpdateAssetHaircutResponse{} }
+func (m *MsgUpdateAssetHaircutResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateAssetHaircutResponse) ProtoMessage()    {}
+func (*MsgUpdateAssetHaircutResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1bb9c8306211b32d, []int{84}
+}
+func (m *MsgUpdateAssetHaircutResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateAssetHaircutResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateAssetHaircutResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/provlabs.vault.v1.Msg/SetBridgeAddress",
+}
+func (m *MsgUpdateAssetHaircutResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateAssetHaircutResponse.Merge(m, src)
+}
+func (m *MsgUpdateAssetHaircutResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateAssetHaircutResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateAssetHaircutResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateAssetHaircutResponse proto.InternalMessageInfo
+
+// AssetPaymentRef identifies a pending exchange-module payment within a
+// MsgBatchAcceptAssetsRequest.
+type AssetPaymentRef struct {
+	// source is the bech32 address of the account that created the pending payment.
+	Source string `protobuf:"bytes,1,opt,name=source,proto3" json:"source,omitempty"`
+	// external_id, together with source, uniquely identifies the pending payment.
+	ExternalId string `protobuf:"bytes,2,opt,name=external_id,json=externalId,proto3" json:"external_id,omitempty"`
+}
+
+func (m *AssetPaymentRef) Reset()         { *m = AssetPaymentRef{} }
+func (m *AssetPaymentRef) String() string { return proto.CompactTextString(m) }
+func (*AssetPaymentRef) ProtoMessage()    {}
+func (*AssetPaymentRef) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1bb9c8306211b32d, []int{85}
+}
+func (m *AssetPaymentRef) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AssetPaymentRef) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AssetPaymentRef.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).SetBridgeAddress(ctx, req.(*MsgSetBridgeAddressRequest))
+}
+func (m *AssetPaymentRef) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AssetPaymentRef.Merge(m, src)
+}
+func (m *AssetPaymentRef) XXX_Size() int {
+	return m.Size()
+}
+func (m *AssetPaymentRef) XXX_DiscardUnknown() {
+	xxx_messageInfo_AssetPaymentRef.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AssetPaymentRef proto.InternalMessageInfo
+
+func (m *AssetPaymentRef) GetSource() string {
+	if m != nil {
+		return m.Source
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Msg_ToggleBridge_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgToggleBridgeRequest)
-	if err := dec(in); err != nil {
-		return nil, err
+func (m *AssetPaymentRef) GetExternalId() string {
+	if m != nil {
+		return m.ExternalId
 	}
-	if interceptor == nil {
-		return srv.(MsgServer).ToggleBridge(ctx, in)
+	return ""
+}
+
+// MsgBatchAcceptAssetsRequest is the request message for settling many pending exchange-module
+// payments whose target is the vault in one message.
+type MsgBatchAcceptAssetsRequest struct {
+	// authority is the address of a holder of the vault's settlement manager role authorizing the settlements.
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// vault_address is the bech32 address of the vault, which must be every payment's target.
+	VaultAddress string `protobuf:"bytes,2,opt,name=vault_address,json=vaultAddress,proto3" json:"vault_address,omitempty"`
+	// payments are the pending payments to settle, each at most once, in settlement order.
+	Payments []AssetPaymentRef `protobuf:"bytes,3,rep,name=payments,proto3" json:"payments"`
+	// best_effort settles every payment that can be settled and reports the others as failed.
+	// When false, any payment that cannot be settled rejects the whole batch.
+	BestEffort bool `protobuf:"varint,4,opt,name=best_effort,json=bestEffort,proto3" json:"best_effort,omitempty"`
+}
+
+func (m *MsgBatchAcceptAssetsRequest) Reset()         { *m = MsgBatchAcceptAssetsRequest{} }
+func (m *MsgBatchAcceptAssetsRequest) String() string { return proto.CompactTextString(m) }
+func (*MsgBatchAcceptAssetsRequest) ProtoMessage()    {}
+func (*MsgBatchAcceptAssetsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1bb9c8306211b32d, []int{86}
+}
+func (m *MsgBatchAcceptAssetsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgBatchAcceptAssetsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgBatchAcceptAssetsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/provlabs.vault.v1.Msg/ToggleBridge",
+}
+func (m *MsgBatchAcceptAssetsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgBatchAcceptAssetsRequest.Merge(m, src)
+}
+func (m *MsgBatchAcceptAssetsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgBatchAcceptAssetsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgBatchAcceptAssetsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgBatchAcceptAssetsRequest proto.InternalMessageInfo
+
+func (m *MsgBatchAcceptAssetsRequest) GetAuthority() string {
+	if m != nil {
+		return m.Authority
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).ToggleBridge(ctx, req.(*MsgToggleBridgeRequest))
+	return ""
+}
+
+func (m *MsgBatchAcceptAssetsRequest) GetVaultAddress() string {
+	if m != nil {
+		return m.VaultAddress
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Msg_BridgeMintShares_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgBridgeMintSharesRequest)
-	if err := dec(in); err != nil {
-		return nil, err
+func (m *MsgBatchAcceptAssetsRequest) GetPayments() []AssetPaymentRef {
+	if m != nil {
+		return m.Payments
 	}
-	if interceptor == nil {
-		return srv.(MsgServer).BridgeMintShares(ctx, in)
+	return nil
+}
+
+func (m *MsgBatchAcceptAssetsRequest) GetBestEffort() bool {
+	if m != nil {
+		return m.BestEffort
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/provlabs.vault.v1.Msg/BridgeMintShares",
+	return false
+}
+
+// AssetPaymentResult reports the outcome of one payment in a MsgBatchAcceptAssetsRequest.
+type AssetPaymentResult struct {
+	// source is the bech32 address of the account that created the payment.
+	Source string `protobuf:"bytes,1,opt,name=source,proto3" json:"source,omitempty"`
+	// external_id, together with source, identifies the payment.
+	ExternalId string `protobuf:"bytes,2,opt,name=external_id,json=externalId,proto3" json:"external_id,omitempty"`
+	// accepted is true when the payment was settled.
+	Accepted bool `protobuf:"varint,3,opt,name=accepted,proto3" json:"accepted,omitempty"`
+	// error describes why the payment was not settled. It is empty when accepted is true.
+	Error string `protobuf:"bytes,4,opt,name=error,proto3" json:"error,omitempty"`
+}
+
+func (m *AssetPaymentResult) Reset()         { *m = AssetPaymentResult{} }
+func (m *AssetPaymentResult) String() string { return proto.CompactTextString(m) }
+func (*AssetPaymentResult) ProtoMessage()    {}
+func (*AssetPaymentResult) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1bb9c8306211b32d, []int{87}
+}
+func (m *AssetPaymentResult) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AssetPaymentResult) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AssetPaymentResult.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).BridgeMintShares(ctx, req.(*MsgBridgeMintSharesRequest))
+}
+func (m *AssetPaymentResult) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AssetPaymentResult.Merge(m, src)
+}
+func (m *AssetPaymentResult) XXX_Size() int {
+	return m.Size()
+}
+func (m *AssetPaymentResult) XXX_DiscardUnknown() {
+	xxx_messageInfo_AssetPaymentResult.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AssetPaymentResult proto.InternalMessageInfo
+
+func (m *AssetPaymentResult) GetSource() string {
+	if m != nil {
+		return m.Source
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Msg_BridgeBurnShares_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgBridgeBurnSharesRequest)
-	if err := dec(in); err != nil {
-		return nil, err
+func (m *AssetPaymentResult) GetExternalId() string {
+	if m != nil {
+		return m.ExternalId
 	}
-	if interceptor == nil {
-		return srv.(MsgServer).BridgeBurnShares(ctx, in)
+	return ""
+}
+
+func (m *AssetPaymentResult) GetAccepted() bool {
+	if m != nil {
+		return m.Accepted
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/provlabs.vault.v1.Msg/BridgeBurnShares",
+	return false
+}
+
+func (m *AssetPaymentResult) GetError() string {
+	if m != nil {
+		return m.Error
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).BridgeBurnShares(ctx, req.(*MsgBridgeBurnSharesRequest))
+	return ""
+}
+
+// MsgBatchAcceptAssetsResponse is the response message for the BatchAcceptAssets endpoint.
+type MsgBatchAcceptAssetsResponse struct {
+	// results holds one entry per requested payment, in request order.
+	Results []AssetPaymentResult `protobuf:"bytes,1,rep,name=results,proto3" json:"results"`
+}
+
+func (m *MsgBatchAcceptAssetsResponse) Reset()         { *m = MsgBatchAcceptAssetsResponse{} }
+func (m *MsgBatchAcceptAssetsResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgBatchAcceptAssetsResponse) ProtoMessage()    {}
+func (*MsgBatchAcceptAssetsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1bb9c8306211b32d, []int{88}
+}
+func (m *MsgBatchAcceptAssetsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgBatchAcceptAssetsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgBatchAcceptAssetsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *MsgBatchAcceptAssetsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgBatchAcceptAssetsResponse.Merge(m, src)
+}
+func (m *MsgBatchAcceptAssetsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgBatchAcceptAssetsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgBatchAcceptAssetsResponse.DiscardUnknown(m)
 }
 
-func _Msg_SetAssetManager_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgSetAssetManagerRequest)
-	if err := dec(in); err != nil {
-		return nil, err
+var xxx_messageInfo_MsgBatchAcceptAssetsResponse proto.InternalMessageInfo
+
+func (m *MsgBatchAcceptAssetsResponse) GetResults() []AssetPaymentResult {
+	if m != nil {
+		return m.Results
 	}
-	if interceptor == nil {
-		return srv.(MsgServer).SetAssetManager(ctx, in)
+	return nil
+}
+
+// MsgCreateAssetPaymentRequest is the request message for creating an x/exchange payment
+// from the vault. The vault settles only payments where one leg is the vault's underlying
+// asset; the settlement direction is derived from which leg that is.
+type MsgCreateAssetPaymentRequest struct {
+	// authority is the address of a holder of the vault's settlement manager role authorizing the payment.
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// vault_address is the bech32 address of the vault creating the payment.
+	VaultAddress string `protobuf:"bytes,2,opt,name=vault_address,json=vaultAddress,proto3" json:"vault_address,omitempty"`
+	// target is the bech32 address of the counterparty that may accept the payment.
+	Target string `protobuf:"bytes,3,opt,name=target,proto3" json:"target,omitempty"`
+	// source_amount is the funds the vault offers, escrowed from its principal.
+	SourceAmount github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,4,rep,name=source_amount,json=sourceAmount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"source_amount"`
+	// target_amount is the funds the vault asks for in return.
+	TargetAmount github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,5,rep,name=target_amount,json=targetAmount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"target_amount"`
+	// external_id, together with the vault's payment escrow address, identifies the payment.
+	ExternalId string `protobuf:"bytes,6,opt,name=external_id,json=externalId,proto3" json:"external_id,omitempty"`
+	// expiration_seconds is how long after the current block time the payment stays open before
+	// anyone may expire it. It must be positive.
+	ExpirationSeconds uint64 `protobuf:"varint,7,opt,name=expiration_seconds,json=expirationSeconds,proto3" json:"expiration_seconds,omitempty"`
+}
+
+func (m *MsgCreateAssetPaymentRequest) Reset()         { *m = MsgCreateAssetPaymentRequest{} }
+func (m *MsgCreateAssetPaymentRequest) String() string { return proto.CompactTextString(m) }
+func (*MsgCreateAssetPaymentRequest) ProtoMessage()    {}
+func (*MsgCreateAssetPaymentRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1bb9c8306211b32d, []int{89}
+}
+func (m *MsgCreateAssetPaymentRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCreateAssetPaymentRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCreateAssetPaymentRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/provlabs.vault.v1.Msg/SetAssetManager",
+}
+func (m *MsgCreateAssetPaymentRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCreateAssetPaymentRequest.Merge(m, src)
+}
+func (m *MsgCreateAssetPaymentRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCreateAssetPaymentRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCreateAssetPaymentRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCreateAssetPaymentRequest proto.InternalMessageInfo
+
+func (m *MsgCreateAssetPaymentRequest) GetAuthority() string {
+	if m != nil {
+		return m.Authority
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).SetAssetManager(ctx, req.(*MsgSetAssetManagerRequest))
+	return ""
+}
+
+func (m *MsgCreateAssetPaymentRequest) GetVaultAddress() string {
+	if m != nil {
+		return m.VaultAddress
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgUpdateParamsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
+func (m *MsgCreateAssetPaymentRequest) GetTarget() string {
+	if m != nil {
+		return m.Target
 	}
-	if interceptor == nil {
-		return srv.(MsgServer).UpdateParams(ctx, in)
+	return ""
+}
+
+func (m *MsgCreateAssetPaymentRequest) GetSourceAmount() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.SourceAmount
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/provlabs.vault.v1.Msg/UpdateParams",
+	return nil
+}
+
+func (m *MsgCreateAssetPaymentRequest) GetTargetAmount() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.TargetAmount
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).UpdateParams(ctx, req.(*MsgUpdateParamsRequest))
+	return nil
+}
+
+func (m *MsgCreateAssetPaymentRequest) GetExternalId() string {
+	if m != nil {
+		return m.ExternalId
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Msg_UpdateVaultAUMFeeBips_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgUpdateVaultAUMFeeBipsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).UpdateVaultAUMFeeBips(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/provlabs.vault.v1.Msg/UpdateVaultAUMFeeBips",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).UpdateVaultAUMFeeBips(ctx, req.(*MsgUpdateVaultAUMFeeBipsRequest))
+func (m *MsgCreateAssetPaymentRequest) GetExpirationSeconds() uint64 {
+	if m != nil {
+		return m.ExpirationSeconds
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-func _Msg_UpdateVaultNAV_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgUpdateVaultNAVRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).UpdateVaultNAV(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/provlabs.vault.v1.Msg/UpdateVaultNAV",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).UpdateVaultNAV(ctx, req.(*MsgUpdateVaultNAVRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+// MsgCreateAssetPaymentResponse is the response message for the CreateAssetPayment endpoint.
+type MsgCreateAssetPaymentResponse struct {
+	// source is the bech32 address of the vault's payment escrow account, the payment's source
+	// on the exchange module.
+	Source string `protobuf:"bytes,1,opt,name=source,proto3" json:"source,omitempty"`
 }
 
-func _Msg_RemoveVaultNAV_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgRemoveVaultNAVRequest)
-	if err := dec(in); err != nil {
-		return nil, err
+func (m *MsgCreateAssetPaymentResponse) Reset()         { *m = MsgCreateAssetPaymentResponse{} }
+func (m *MsgCreateAssetPaymentResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgCreateAssetPaymentResponse) ProtoMessage()    {}
+func (*MsgCreateAssetPaymentResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1bb9c8306211b32d, []int{90}
+}
+func (m *MsgCreateAssetPaymentResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCreateAssetPaymentResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCreateAssetPaymentResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if interceptor == nil {
-		return srv.(MsgServer).RemoveVaultNAV(ctx, in)
+}
+func (m *MsgCreateAssetPaymentResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCreateAssetPaymentResponse.Merge(m, src)
+}
+func (m *MsgCreateAssetPaymentResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCreateAssetPaymentResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCreateAssetPaymentResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCreateAssetPaymentResponse proto.InternalMessageInfo
+
+func (m *MsgCreateAssetPaymentResponse) GetSource() string {
+	if m != nil {
+		return m.Source
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/provlabs.vault.v1.Msg/RemoveVaultNAV",
+	return ""
+}
+
+// MsgCancelAssetPaymentRequest is the request message for cancelling an open payment
+// created by the vault.
+type MsgCancelAssetPaymentRequest struct {
+	// authority is the address of a holder of the vault's settlement manager role authorizing the cancellation.
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// vault_address is the bech32 address of the vault that created the payment.
+	VaultAddress string `protobuf:"bytes,2,opt,name=vault_address,json=vaultAddress,proto3" json:"vault_address,omitempty"`
+	// external_id identifies the payment to cancel.
+	ExternalId string `protobuf:"bytes,3,opt,name=external_id,json=externalId,proto3" json:"external_id,omitempty"`
+}
+
+func (m *MsgCancelAssetPaymentRequest) Reset()         { *m = MsgCancelAssetPaymentRequest{} }
+func (m *MsgCancelAssetPaymentRequest) String() string { return proto.CompactTextString(m) }
+func (*MsgCancelAssetPaymentRequest) ProtoMessage()    {}
+func (*MsgCancelAssetPaymentRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1bb9c8306211b32d, []int{91}
+}
+func (m *MsgCancelAssetPaymentRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCancelAssetPaymentRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCancelAssetPaymentRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).RemoveVaultNAV(ctx, req.(*MsgRemoveVaultNAVRequest))
+}
+func (m *MsgCancelAssetPaymentRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCancelAssetPaymentRequest.Merge(m, src)
+}
+func (m *MsgCancelAssetPaymentRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCancelAssetPaymentRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCancelAssetPaymentRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCancelAssetPaymentRequest proto.InternalMessageInfo
+
+func (m *MsgCancelAssetPaymentRequest) GetAuthority() string {
+	if m != nil {
+		return m.Authority
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Msg_UpdateNAVAuthority_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgUpdateNAVAuthorityRequest)
-	if err := dec(in); err != nil {
-		return nil, err
+func (m *MsgCancelAssetPaymentRequest) GetVaultAddress() string {
+	if m != nil {
+		return m.VaultAddress
 	}
-	if interceptor == nil {
-		return srv.(MsgServer).UpdateNAVAuthority(ctx, in)
+	return ""
+}
+
+func (m *MsgCancelAssetPaymentRequest) GetExternalId() string {
+	if m != nil {
+		return m.ExternalId
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/provlabs.vault.v1.Msg/UpdateNAVAuthority",
+	return ""
+}
+
+// MsgCancelAssetPaymentResponse is the response message for the CancelAssetPayment endpoint.
+type MsgCancelAssetPaymentResponse struct {
+}
+
+func (m *MsgCancelAssetPaymentResponse) Reset()         { *m = MsgCancelAssetPaymentResponse{} }
+func (m *MsgCancelAssetPaymentResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgCancelAssetPaymentResponse) ProtoMessage()    {}
+func (*MsgCancelAssetPaymentResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1bb9c8306211b32d, []int{92}
+}
+func (m *MsgCancelAssetPaymentResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCancelAssetPaymentResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCancelAssetPaymentResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).UpdateNAVAuthority(ctx, req.(*MsgUpdateNAVAuthorityRequest))
+}
+func (m *MsgCancelAssetPaymentResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCancelAssetPaymentResponse.Merge(m, src)
+}
+func (m *MsgCancelAssetPaymentResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCancelAssetPaymentResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCancelAssetPaymentResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCancelAssetPaymentResponse proto.InternalMessageInfo
+
+// MsgExpireAssetPaymentRequest is the request message for expiring an open payment created
+// by the vault once its expiry_time has passed.
+type MsgExpireAssetPaymentRequest struct {
+	// signer is the address of the account submitting the expiry. Any account may sign.
+	Signer string `protobuf:"bytes,1,opt,name=signer,proto3" json:"signer,omitempty"`
+	// vault_address is the bech32 address of the vault that created the payment.
+	VaultAddress string `protobuf:"bytes,2,opt,name=vault_address,json=vaultAddress,proto3" json:"vault_address,omitempty"`
+	// external_id identifies the payment to expire.
+	ExternalId string `protobuf:"bytes,3,opt,name=external_id,json=externalId,proto3" json:"external_id,omitempty"`
+}
+
+func (m *MsgExpireAssetPaymentRequest) Reset()         { *m = MsgExpireAssetPaymentRequest{} }
+func (m *MsgExpireAssetPaymentRequest) String() string { return proto.CompactTextString(m) }
+func (*MsgExpireAssetPaymentRequest) ProtoMessage()    {}
+func (*MsgExpireAssetPaymentRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1bb9c8306211b32d, []int{93}
+}
+func (m *MsgExpireAssetPaymentRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgExpireAssetPaymentRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgExpireAssetPaymentRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *MsgExpireAssetPaymentRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgExpireAssetPaymentRequest.Merge(m, src)
+}
+func (m *MsgExpireAssetPaymentRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgExpireAssetPaymentRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgExpireAssetPaymentRequest.DiscardUnknown(m)
 }
 
-func _Msg_AcceptAsset_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgAcceptAssetRequest)
-	if err := dec(in); err != nil {
-		return nil, err
+var xxx_messageInfo_MsgExpireAssetPaymentRequest proto.InternalMessageInfo
+
+func (m *MsgExpireAssetPaymentRequest) GetSigner() string {
+	if m != nil {
+		return m.Signer
 	}
-	if interceptor == nil {
-		return srv.(MsgServer).AcceptAsset(ctx, in)
+	return ""
+}
+
+func (m *MsgExpireAssetPaymentRequest) GetVaultAddress() string {
+	if m != nil {
+		return m.VaultAddress
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/provlabs.vault.v1.Msg/AcceptAsset",
+	return ""
+}
+
+func (m *MsgExpireAssetPaymentRequest) GetExternalId() string {
+	if m != nil {
+		return m.ExternalId
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).AcceptAsset(ctx, req.(*MsgAcceptAssetRequest))
+	return ""
+}
+
+// MsgExpireAssetPaymentResponse is the response message for the ExpireAssetPayment endpoint.
+type MsgExpireAssetPaymentResponse struct {
+}
+
+func (m *MsgExpireAssetPaymentResponse) Reset()         { *m = MsgExpireAssetPaymentResponse{} }
+func (m *MsgExpireAssetPaymentResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgExpireAssetPaymentResponse) ProtoMessage()    {}
+func (*MsgExpireAssetPaymentResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1bb9c8306211b32d, []int{94}
+}
+func (m *MsgExpireAssetPaymentResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgExpireAssetPaymentResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgExpireAssetPaymentResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *MsgExpireAssetPaymentResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgExpireAssetPaymentResponse.Merge(m, src)
+}
+func (m *MsgExpireAssetPaymentResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgExpireAssetPaymentResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgExpireAssetPaymentResponse.DiscardUnknown(m)
 }
 
-func _Msg_RejectAsset_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgRejectAssetRequest)
-	if err := dec(in); err != nil {
-		return nil, err
+var xxx_messageInfo_MsgExpireAssetPaymentResponse proto.InternalMessageInfo
+
+// MsgUpdateAcquisitionPolicyRequest is the request message for replacing a vault's acquisition policy.
+type MsgUpdateAcquisitionPolicyRequest struct {
+	// authority is the address of the vault admin.
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// vault_address is the bech32 address of the vault to update.
+	VaultAddress string `protobuf:"bytes,2,opt,name=vault_address,json=vaultAddress,proto3" json:"vault_address,omitempty"`
+	// policy is the new acquisition policy. An empty policy permits any asset.
+	Policy AcquisitionPolicy `protobuf:"bytes,3,opt,name=policy,proto3" json:"policy"`
+}
+
+func (m *MsgUpdateAcquisitionPolicyRequest) Reset()         { *m = MsgUpdateAcquisitionPolicyRequest{} }
+func (m *MsgUpdateAcquisitionPolicyRequest) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateAcquisitionPolicyRequest) ProtoMessage()    {}
+func (*MsgUpdateAcquisitionPolicyRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1bb9c8306211b32d, []int{95}
+}
+func (m *MsgUpdateAcquisitionPolicyRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateAcquisitionPolicyRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateAcquisitionPolicyRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if interceptor == nil {
-		return srv.(MsgServer).RejectAsset(ctx, in)
+}
+func (m *MsgUpdateAcquisitionPolicyRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateAcquisitionPolicyRequest.Merge(m, src)
+}
+func (m *MsgUpdateAcquisitionPolicyRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateAcquisitionPolicyRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateAcquisitionPolicyRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateAcquisitionPolicyRequest proto.InternalMessageInfo
+
+func (m *MsgUpdateAcquisitionPolicyRequest) GetAuthority() string {
+	if m != nil {
+		return m.Authority
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/provlabs.vault.v1.Msg/RejectAsset",
+	return ""
+}
+
+func (m *MsgUpdateAcquisitionPolicyRequest) GetVaultAddress() string {
+	if m != nil {
+		return m.VaultAddress
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).RejectAsset(ctx, req.(*MsgRejectAssetRequest))
+	return ""
+}
+
+func (m *MsgUpdateAcquisitionPolicyRequest) GetPolicy() AcquisitionPolicy {
+	if m != nil {
+		return m.Policy
 	}
-	return interceptor(ctx, in, info, handler)
+	return AcquisitionPolicy{}
 }
 
-var Msg_serviceDesc = _Msg_serviceDesc
-var _Msg_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "provlabs.vault.v1.Msg",
-	HandlerType: (*MsgServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "CreateVault",
-			Handler:    _Msg_CreateVault_Handler,
-		},
-		{
-			MethodName: "SetShareDenomMetadata",
-			Handler:    _Msg_SetShareDenomMetadata_Handler,
-		},
-		{
-			MethodName: "SwapIn",
-			Handler:    _Msg_SwapIn_Handler,
-		},
-		{
-			MethodName: "SwapOut",
-			Handler:    _Msg_SwapOut_Handler,
-		},
-		{
-			MethodName: "UpdateMinInterestRate",
-			Handler:    _Msg_UpdateMinInterestRate_Handler,
-		},
-		{
-			MethodName: "UpdateMaxInterestRate",
-			Handler:    _Msg_UpdateMaxInterestRate_Handler,
-		},
-		{
-			MethodName: "UpdateInterestRate",
-			Handler:    _Msg_UpdateInterestRate_Handler,
-		},
-		{
-			MethodName: "UpdateWithdrawalDelay",
-			Handler:    _Msg_UpdateWithdrawalDelay_Handler,
-		},
-		{
-			MethodName: "UpdateMinSwapInValue",
-			Handler:    _Msg_UpdateMinSwapInValue_Handler,
-		},
-		{
-			MethodName: "UpdateMinSwapOutValue",
-			Handler:    _Msg_UpdateMinSwapOutValue_Handler,
-		},
-		{
-			MethodName: "UpdateMaxSwapInValue",
-			Handler:    _Msg_UpdateMaxSwapInValue_Handler,
-		},
-		{
-			MethodName: "UpdateMaxSwapOutValue",
-			Handler:    _Msg_UpdateMaxSwapOutValue_Handler,
-		},
-		{
-			MethodName: "ToggleSwapIn",
-			Handler:    _Msg_ToggleSwapIn_Handler,
-		},
-		{
-			MethodName: "ToggleSwapOut",
-			Handler:    _Msg_ToggleSwapOut_Handler,
-		},
-		{
-			MethodName: "DepositInterestFunds",
-			Handler:    _Msg_DepositInterestFunds_Handler,
-		},
-		{
-			MethodName: "WithdrawInterestFunds",
-			Handler:    _Msg_WithdrawInterestFunds_Handler,
-		},
-		{
-			MethodName: "DepositPrincipalFunds",
-			Handler:    _Msg_DepositPrincipalFunds_Handler,
-		},
-		{
-			MethodName: "WithdrawPrincipalFunds",
-			Handler:    _Msg_WithdrawPrincipalFunds_Handler,
-		},
-		{
-			MethodName: "ExpeditePendingSwapOut",
-			Handler:    _Msg_ExpeditePendingSwapOut_Handler,
-		},
-		{
-			MethodName: "PauseVault",
-			Handler:    _Msg_PauseVault_Handler,
-		},
-		{
-			MethodName: "UnpauseVault",
-			Handler:    _Msg_UnpauseVault_Handler,
-		},
-		{
-			MethodName: "SetBridgeAddress",
-			Handler:    _Msg_SetBridgeAddress_Handler,
-		},
-		{
-			MethodName: "ToggleBridge",
-			Handler:    _Msg_ToggleBridge_Handler,
-		},
-		{
-			MethodName: "BridgeMintShares",
-			Handler:    _Msg_BridgeMintShares_Handler,
-		},
-		{
-			MethodName: "BridgeBurnShares",
-			Handler:    _Msg_BridgeBurnShares_Handler,
-		},
-		{
-			MethodName: "SetAssetManager",
-			Handler:    _Msg_SetAssetManager_Handler,
-		},
-		{
-			MethodName: "UpdateParams",
-			Handler:    _Msg_UpdateParams_Handler,
-		},
-		{
-			MethodName: "UpdateVaultAUMFeeBips",
-			Handler:    _Msg_UpdateVaultAUMFeeBips_Handler,
-		},
-		{
-			MethodName: "UpdateVaultNAV",
-			Handler:    _Msg_UpdateVaultNAV_Handler,
-		},
-		{
-			MethodName: "RemoveVaultNAV",
-			Handler:    _Msg_RemoveVaultNAV_Handler,
-		},
-		{
-			MethodName: "UpdateNAVAuthority",
-			Handler:    _Msg_UpdateNAVAuthority_Handler,
-		},
-		{
-			MethodName: "AcceptAsset",
-			Handler:    _Msg_AcceptAsset_Handler,
-		},
-		{
-			MethodName: "RejectAsset",
-			Handler:    _Msg_RejectAsset_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "provlabs/vault/v1/tx.proto",
+// MsgUpdateAcquisitionPolicyResponse is the response message for the UpdateAcquisitionPolicy endpoint.
+type MsgUpdateAcquisitionPolicyResponse struct {
 }
 
-func (m *MsgCreateVaultRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgUpdateAcquisitionPolicyResponse) Reset()         { *m = MsgUpdateAcquisitionPolicyResponse{} }
+func (m *MsgUpdateAcquisitionPolicyResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateAcquisitionPolicyResponse) ProtoMessage()    {}
+func (*MsgUpdateAcquisitionPolicyResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1bb9c8306211b32d, []int{96}
+}
+func (m *MsgUpdateAcquisitionPolicyResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateAcquisitionPolicyResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateAcquisitionPolicyResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *MsgUpdateAcquisitionPolicyResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateAcquisitionPolicyResponse.Merge(m, src)
+}
+func (m *MsgUpdateAcquisitionPolicyResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateAcquisitionPolicyResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateAcquisitionPolicyResponse.DiscardUnknown(m)
 }
 
-func (m *MsgCreateVaultRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_MsgUpdateAcquisitionPolicyResponse proto.InternalMessageInfo
+
+// MsgUpdateConcentrationLimitsRequest is the request message for updating a vault's
+// concentration limits.
+type MsgUpdateConcentrationLimitsRequest struct {
+	// authority is the address of the vault admin.
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// vault_address is the bech32 address of the vault to update.
+	VaultAddress string `protobuf:"bytes,2,opt,name=vault_address,json=vaultAddress,proto3" json:"vault_address,omitempty"`
+	// max_asset_concentration_bips is the largest share of total vault value, in basis points,
+	// any single non-underlying held denom may make up. 0 disables the limit.
+	MaxAssetConcentrationBips uint32 `protobuf:"varint,3,opt,name=max_asset_concentration_bips,json=maxAssetConcentrationBips,proto3" json:"max_asset_concentration_bips,omitempty"`
+	// max_non_underlying_bips is the largest share of total vault value, in basis points, all
+	// non-underlying held denoms together may make up. 0 disables the limit.
+	MaxNonUnderlyingBips uint32 `protobuf:"varint,4,opt,name=max_non_underlying_bips,json=maxNonUnderlyingBips,proto3" json:"max_non_underlying_bips,omitempty"`
 }
 
-func (m *MsgCreateVaultRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Authority) > 0 {
-		i -= len(m.Authority)
-		copy(dAtA[i:], m.Authority)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
-		i--
-		dAtA[i] = 0x52
-	}
-	if len(m.MaxSwapOutValue) > 0 {
-		i -= len(m.MaxSwapOutValue)
-		copy(dAtA[i:], m.MaxSwapOutValue)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.MaxSwapOutValue)))
-		i--
-		dAtA[i] = 0x4a
+func (m *MsgUpdateConcentrationLimitsRequest) Reset()         { *m = MsgUpdateConcentrationLimitsRequest{} }
+func (m *MsgUpdateConcentrationLimitsRequest) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateConcentrationLimitsRequest) ProtoMessage()    {}
+func (*MsgUpdateConcentrationLimitsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1bb9c8306211b32d, []int{97}
+}
+func (m *MsgUpdateConcentrationLimitsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateConcentrationLimitsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateConcentrationLimitsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if len(m.MaxSwapInValue) > 0 {
-		i -= len(m.MaxSwapInValue)
-		copy(dAtA[i:], m.MaxSwapInValue)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.MaxSwapInValue)))
-		i--
-		dAtA[i] = 0x42
+}
+func (m *MsgUpdateConcentrationLimitsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateConcentrationLimitsRequest.Merge(m, src)
+}
+func (m *MsgUpdateConcentrationLimitsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateConcentrationLimitsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateConcentrationLimitsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateConcentrationLimitsRequest proto.InternalMessageInfo
+
+func (m *MsgUpdateConcentrationLimitsRequest) GetAuthority() string {
+	if m != nil {
+		return m.Authority
 	}
-	if len(m.MinSwapOutValue) > 0 {
-		i -= len(m.MinSwapOutValue)
-		copy(dAtA[i:], m.MinSwapOutValue)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.MinSwapOutValue)))
-		i--
-		dAtA[i] = 0x3a
+	return ""
+}
+
+func (m *MsgUpdateConcentrationLimitsRequest) GetVaultAddress() string {
+	if m != nil {
+		return m.VaultAddress
 	}
-	if len(m.MinSwapInValue) > 0 {
-		i -= len(m.MinSwapInValue)
-		copy(dAtA[i:], m.MinSwapInValue)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.MinSwapInValue)))
-		i--
-		dAtA[i] = 0x32
+	return ""
+}
+
+func (m *MsgUpdateConcentrationLimitsRequest) GetMaxAssetConcentrationBips() uint32 {
+	if m != nil {
+		return m.MaxAssetConcentrationBips
 	}
-	if m.WithdrawalDelaySeconds != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.WithdrawalDelaySeconds))
-		i--
-		dAtA[i] = 0x28
+	return 0
+}
+
+func (m *MsgUpdateConcentrationLimitsRequest) GetMaxNonUnderlyingBips() uint32 {
+	if m != nil {
+		return m.MaxNonUnderlyingBips
 	}
-	if len(m.PaymentDenom) > 0 {
-		i -= len(m.PaymentDenom)
-		copy(dAtA[i:], m.PaymentDenom)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.PaymentDenom)))
-		i--
-		dAtA[i] = 0x22
+	return 0
+}
+
+// MsgUpdateConcentrationLimitsResponse is the response message for the UpdateConcentrationLimits endpoint.
+type MsgUpdateConcentrationLimitsResponse struct {
+}
+
+func (m *MsgUpdateConcentrationLimitsResponse) Reset()         { *m = MsgUpdateConcentrationLimitsResponse{} }
+func (m *MsgUpdateConcentrationLimitsResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateConcentrationLimitsResponse) ProtoMessage()    {}
+func (*MsgUpdateConcentrationLimitsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1bb9c8306211b32d, []int{98}
+}
+func (m *MsgUpdateConcentrationLimitsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateConcentrationLimitsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateConcentrationLimitsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if len(m.UnderlyingAsset) > 0 {
-		i -= len(m.UnderlyingAsset)
-		copy(dAtA[i:], m.UnderlyingAsset)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.UnderlyingAsset)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.ShareDenom) > 0 {
-		i -= len(m.ShareDenom)
-		copy(dAtA[i:], m.ShareDenom)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.ShareDenom)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Admin) > 0 {
-		i -= len(m.Admin)
-		copy(dAtA[i:], m.Admin)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Admin)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
 }
-
-func (m *MsgCreateVaultResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *MsgUpdateConcentrationLimitsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateConcentrationLimitsResponse.Merge(m, src)
 }
-
-func (m *MsgCreateVaultResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgUpdateConcentrationLimitsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateConcentrationLimitsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateConcentrationLimitsResponse.DiscardUnknown(m)
 }
 
-func (m *MsgCreateVaultResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.VaultAddress) > 0 {
-		i -= len(m.VaultAddress)
-		copy(dAtA[i:], m.VaultAddress)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.VaultAddress)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+var xxx_messageInfo_MsgUpdateConcentrationLimitsResponse proto.InternalMessageInfo
+
+// MsgUpdateMinLiquidityRequest is the request message for updating a vault's minimum
+// liquidity buffer.
+type MsgUpdateMinLiquidityRequest struct {
+	// authority is the address of the vault admin.
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// vault_address is the bech32 address of the vault to update.
+	VaultAddress string `protobuf:"bytes,2,opt,name=vault_address,json=vaultAddress,proto3" json:"vault_address,omitempty"`
+	// min_liquidity_bips is the share of total vault value, in basis points, the principal must
+	// keep in the underlying asset beyond queued swap-outs. 0 disables the buffer.
+	MinLiquidityBips uint32 `protobuf:"varint,3,opt,name=min_liquidity_bips,json=minLiquidityBips,proto3" json:"min_liquidity_bips,omitempty"`
 }
 
-func (m *MsgSetShareDenomMetadataRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgUpdateMinLiquidityRequest) Reset()         { *m = MsgUpdateMinLiquidityRequest{} }
+func (m *MsgUpdateMinLiquidityRequest) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateMinLiquidityRequest) ProtoMessage()    {}
+func (*MsgUpdateMinLiquidityRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1bb9c8306211b32d, []int{99}
+}
+func (m *MsgUpdateMinLiquidityRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateMinLiquidityRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateMinLiquidityRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *MsgSetShareDenomMetadataRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgUpdateMinLiquidityRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateMinLiquidityRequest.Merge(m, src)
+}
+func (m *MsgUpdateMinLiquidityRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateMinLiquidityRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateMinLiquidityRequest.DiscardUnknown(m)
 }
 
-func (m *MsgSetShareDenomMetadataRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.VaultAddress) > 0 {
-		i -= len(m.VaultAddress)
-		copy(dAtA[i:], m.VaultAddress)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.VaultAddress)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.Admin) > 0 {
-		i -= len(m.Admin)
-		copy(dAtA[i:], m.Admin)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Admin)))
-		i--
-		dAtA[i] = 0x12
-	}
-	{
-		size := m.Metadata.Size()
-		i -= size
-		if _, err := m.Metadata.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintTx(dAtA, i, uint64(size))
+var xxx_messageInfo_MsgUpdateMinLiquidityRequest proto.InternalMessageInfo
+
+func (m *MsgUpdateMinLiquidityRequest) GetAuthority() string {
+	if m != nil {
+		return m.Authority
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *MsgSetShareDenomMetadataResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgUpdateMinLiquidityRequest) GetVaultAddress() string {
+	if m != nil {
+		return m.VaultAddress
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *MsgSetShareDenomMetadataResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgUpdateMinLiquidityRequest) GetMinLiquidityBips() uint32 {
+	if m != nil {
+		return m.MinLiquidityBips
+	}
+	return 0
 }
 
-func (m *MsgSetShareDenomMetadataResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+// MsgUpdateMinLiquidityResponse is the response message for the UpdateMinLiquidity endpoint.
+type MsgUpdateMinLiquidityResponse struct {
 }
 
-func (m *MsgSwapInRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgUpdateMinLiquidityResponse) Reset()         { *m = MsgUpdateMinLiquidityResponse{} }
+func (m *MsgUpdateMinLiquidityResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateMinLiquidityResponse) ProtoMessage()    {}
+func (*MsgUpdateMinLiquidityResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1bb9c8306211b32d, []int{100}
+}
+func (m *MsgUpdateMinLiquidityResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateMinLiquidityResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateMinLiquidityResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *MsgSwapInRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgUpdateMinLiquidityResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateMinLiquidityResponse.Merge(m, src)
+}
+func (m *MsgUpdateMinLiquidityResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateMinLiquidityResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateMinLiquidityResponse.DiscardUnknown(m)
 }
 
-func (m *MsgSwapInRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Assets.MarshalToSizedBuffer(dAtA[:i])
+var xxx_messageInfo_MsgUpdateMinLiquidityResponse proto.InternalMessageInfo
+
+// MsgCollectAssetIncomeRequest is the request message for moving income earned by a held
+// asset between a vault's principal and its interest reserves.
+type MsgCollectAssetIncomeRequest struct {
+	// authority is the address of a holder of the vault's settlement manager role.
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// vault_address is the bech32 address of the vault.
+	VaultAddress string `protobuf:"bytes,2,opt,name=vault_address,json=vaultAddress,proto3" json:"vault_address,omitempty"`
+	// source_asset is the denom of the held asset that earned the income, such as an nft/scope
+	// loan paying coupons. The vault must hold it.
+	SourceAsset string `protobuf:"bytes,3,opt,name=source_asset,json=sourceAsset,proto3" json:"source_asset,omitempty"`
+	// amount is the income to move, in the vault's underlying asset.
+	Amount types1.Coin `protobuf:"bytes,4,opt,name=amount,proto3" json:"amount"`
+	// to_principal reverses the move, returning amount from the interest reserves to the
+	// principal.
+	ToPrincipal bool `protobuf:"varint,5,opt,name=to_principal,json=toPrincipal,proto3" json:"to_principal,omitempty"`
+}
+
+func (m *MsgCollectAssetIncomeRequest) Reset()         { *m = MsgCollectAssetIncomeRequest{} }
+func (m *MsgCollectAssetIncomeRequest) String() string { return proto.CompactTextString(m) }
+func (*MsgCollectAssetIncomeRequest) ProtoMessage()    {}
+func (*MsgCollectAssetIncomeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1bb9c8306211b32d, []int{101}
+}
+func (m *MsgCollectAssetIncomeRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCollectAssetIncomeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCollectAssetIncomeRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintTx(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x1a
-	if len(m.VaultAddress) > 0 {
-		i -= len(m.VaultAddress)
-		copy(dAtA[i:], m.VaultAddress)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.VaultAddress)))
-		i--
-		dAtA[i] = 0x12
+		return b[:n], nil
 	}
-	if len(m.Owner) > 0 {
-		i -= len(m.Owner)
-		copy(dAtA[i:], m.Owner)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Owner)))
-		i--
-		dAtA[i] = 0xa
+}
+func (m *MsgCollectAssetIncomeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCollectAssetIncomeRequest.Merge(m, src)
+}
+func (m *MsgCollectAssetIncomeRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCollectAssetIncomeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCollectAssetIncomeRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCollectAssetIncomeRequest proto.InternalMessageInfo
+
+func (m *MsgCollectAssetIncomeRequest) GetAuthority() string {
+	if m != nil {
+		return m.Authority
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *MsgSwapInResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgCollectAssetIncomeRequest) GetVaultAddress() string {
+	if m != nil {
+		return m.VaultAddress
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *MsgSwapInResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgCollectAssetIncomeRequest) GetSourceAsset() string {
+	if m != nil {
+		return m.SourceAsset
+	}
+	return ""
 }
 
-func (m *MsgSwapInResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.SharesReceived.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintTx(dAtA, i, uint64(size))
+func (m *MsgCollectAssetIncomeRequest) GetAmount() types1.Coin {
+	if m != nil {
+		return m.Amount
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+	return types1.Coin{}
 }
 
-func (m *MsgSwapOutRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgCollectAssetIncomeRequest) GetToPrincipal() bool {
+	if m != nil {
+		return m.ToPrincipal
 	}
-	return dAtA[:n], nil
+	return false
 }
 
-func (m *MsgSwapOutRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// MsgCollectAssetIncomeResponse is the response message for the CollectAssetIncome endpoint.
+type MsgCollectAssetIncomeResponse struct {
 }
 
-func (m *MsgSwapOutRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.RedeemDenom) > 0 {
-		i -= len(m.RedeemDenom)
-		copy(dAtA[i:], m.RedeemDenom)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.RedeemDenom)))
-		i--
-		dAtA[i] = 0x22
-	}
-	{
-		size, err := m.Assets.MarshalToSizedBuffer(dAtA[:i])
+func (m *MsgCollectAssetIncomeResponse) Reset()         { *m = MsgCollectAssetIncomeResponse{} }
+func (m *MsgCollectAssetIncomeResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgCollectAssetIncomeResponse) ProtoMessage()    {}
+func (*MsgCollectAssetIncomeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1bb9c8306211b32d, []int{102}
+}
+func (m *MsgCollectAssetIncomeResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCollectAssetIncomeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCollectAssetIncomeResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintTx(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x1a
-	if len(m.VaultAddress) > 0 {
-		i -= len(m.VaultAddress)
-		copy(dAtA[i:], m.VaultAddress)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.VaultAddress)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Owner) > 0 {
-		i -= len(m.Owner)
-		copy(dAtA[i:], m.Owner)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Owner)))
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-
-func (m *MsgSwapOutResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *MsgCollectAssetIncomeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCollectAssetIncomeResponse.Merge(m, src)
+}
+func (m *MsgCollectAssetIncomeResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCollectAssetIncomeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCollectAssetIncomeResponse.DiscardUnknown(m)
 }
 
-func (m *MsgSwapOutResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_MsgCollectAssetIncomeResponse proto.InternalMessageInfo
+
+// MsgGrantRoleRequest is the request message for granting a role on a vault.
+type MsgGrantRoleRequest struct {
+	// authority is the address of the vault admin.
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// vault_address is the bech32 address of the vault.
+	VaultAddress string `protobuf:"bytes,2,opt,name=vault_address,json=vaultAddress,proto3" json:"vault_address,omitempty"`
+	// role is the role to grant.
+	Role VaultRole `protobuf:"varint,3,opt,name=role,proto3,enum=provlabs.vault.v1.VaultRole" json:"role,omitempty"`
+	// address is the bech32 address to grant the role to.
+	Address string `protobuf:"bytes,4,opt,name=address,proto3" json:"address,omitempty"`
 }
 
-func (m *MsgSwapOutResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.RequestId != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.RequestId))
-		i--
-		dAtA[i] = 0x8
+func (m *MsgGrantRoleRequest) Reset()         { *m = MsgGrantRoleRequest{} }
+func (m *MsgGrantRoleRequest) String() string { return proto.CompactTextString(m) }
+func (*MsgGrantRoleRequest) ProtoMessage()    {}
+func (*MsgGrantRoleRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1bb9c8306211b32d, []int{103}
+}
+func (m *MsgGrantRoleRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgGrantRoleRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgGrantRoleRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *MsgGrantRoleRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgGrantRoleRequest.Merge(m, src)
+}
+func (m *MsgGrantRoleRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgGrantRoleRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgGrantRoleRequest.DiscardUnknown(m)
 }
 
-func (m *MsgUpdateMinInterestRateRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgGrantRoleRequest proto.InternalMessageInfo
+
+func (m *MsgGrantRoleRequest) GetAuthority() string {
+	if m != nil {
+		return m.Authority
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *MsgUpdateMinInterestRateRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgGrantRoleRequest) GetVaultAddress() string {
+	if m != nil {
+		return m.VaultAddress
+	}
+	return ""
 }
 
-func (m *MsgUpdateMinInterestRateRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.MinRate) > 0 {
-		i -= len(m.MinRate)
-		copy(dAtA[i:], m.MinRate)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.MinRate)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.VaultAddress) > 0 {
-		i -= len(m.VaultAddress)
-		copy(dAtA[i:], m.VaultAddress)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.VaultAddress)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Admin) > 0 {
-		i -= len(m.Admin)
-		copy(dAtA[i:], m.Admin)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Admin)))
-		i--
-		dAtA[i] = 0xa
+func (m *MsgGrantRoleRequest) GetRole() VaultRole {
+	if m != nil {
+		return m.Role
 	}
-	return len(dAtA) - i, nil
+	return VaultRole_VAULT_ROLE_UNSPECIFIED
 }
 
-func (m *MsgUpdateMinInterestRateResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgGrantRoleRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *MsgUpdateMinInterestRateResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// MsgGrantRoleResponse is the response message for the GrantRole endpoint.
+type MsgGrantRoleResponse struct {
 }
 
-func (m *MsgUpdateMinInterestRateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *MsgGrantRoleResponse) Reset()         { *m = MsgGrantRoleResponse{} }
+func (m *MsgGrantRoleResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgGrantRoleResponse) ProtoMessage()    {}
+func (*MsgGrantRoleResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1bb9c8306211b32d, []int{104}
 }
-
-func (m *MsgUpdateMaxInterestRateRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgGrantRoleResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgGrantRoleResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgGrantRoleResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *MsgGrantRoleResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgGrantRoleResponse.Merge(m, src)
+}
+func (m *MsgGrantRoleResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgGrantRoleResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgGrantRoleResponse.DiscardUnknown(m)
 }
 
-func (m *MsgUpdateMaxInterestRateRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_MsgGrantRoleResponse proto.InternalMessageInfo
+
+// MsgRevokeRoleRequest is the request message for revoking a role on a vault.
+type MsgRevokeRoleRequest struct {
+	// authority is the address of the vault admin.
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// vault_address is the bech32 address of the vault.
+	VaultAddress string `protobuf:"bytes,2,opt,name=vault_address,json=vaultAddress,proto3" json:"vault_address,omitempty"`
+	// role is the role to revoke.
+	Role VaultRole `protobuf:"varint,3,opt,name=role,proto3,enum=provlabs.vault.v1.VaultRole" json:"role,omitempty"`
+	// address is the bech32 address to revoke the role from.
+	Address string `protobuf:"bytes,4,opt,name=address,proto3" json:"address,omitempty"`
 }
 
-func (m *MsgUpdateMaxInterestRateRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.MaxRate) > 0 {
-		i -= len(m.MaxRate)
-		copy(dAtA[i:], m.MaxRate)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.MaxRate)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.VaultAddress) > 0 {
-		i -= len(m.VaultAddress)
-		copy(dAtA[i:], m.VaultAddress)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.VaultAddress)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Admin) > 0 {
-		i -= len(m.Admin)
-		copy(dAtA[i:], m.Admin)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Admin)))
-		i--
-		dAtA[i] = 0xa
+func (m *MsgRevokeRoleRequest) Reset()         { *m = MsgRevokeRoleRequest{} }
+func (m *MsgRevokeRoleRequest) String() string { return proto.CompactTextString(m) }
+func (*MsgRevokeRoleRequest) ProtoMessage()    {}
+func (*MsgRevokeRoleRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1bb9c8306211b32d, []int{105}
+}
+func (m *MsgRevokeRoleRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRevokeRoleRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRevokeRoleRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *MsgRevokeRoleRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRevokeRoleRequest.Merge(m, src)
+}
+func (m *MsgRevokeRoleRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRevokeRoleRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRevokeRoleRequest.DiscardUnknown(m)
 }
 
-func (m *MsgUpdateMaxInterestRateResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgRevokeRoleRequest proto.InternalMessageInfo
+
+func (m *MsgRevokeRoleRequest) GetAuthority() string {
+	if m != nil {
+		return m.Authority
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *MsgUpdateMaxInterestRateResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgRevokeRoleRequest) GetVaultAddress() string {
+	if m != nil {
+		return m.VaultAddress
+	}
+	return ""
 }
 
-func (m *MsgUpdateMaxInterestRateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *MsgRevokeRoleRequest) GetRole() VaultRole {
+	if m != nil {
+		return m.Role
+	}
+	return VaultRole_VAULT_ROLE_UNSPECIFIED
 }
 
-func (m *MsgUpdateInterestRateRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgRevokeRoleRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *MsgUpdateInterestRateRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// MsgRevokeRoleResponse is the response message for the RevokeRole endpoint.
+type MsgRevokeRoleResponse struct {
 }
 
-func (m *MsgUpdateInterestRateRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.NewRate) > 0 {
-		i -= len(m.NewRate)
-		copy(dAtA[i:], m.NewRate)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.NewRate)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.VaultAddress) > 0 {
-		i -= len(m.VaultAddress)
-		copy(dAtA[i:], m.VaultAddress)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.VaultAddress)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Authority) > 0 {
-		i -= len(m.Authority)
-		copy(dAtA[i:], m.Authority)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+func (m *MsgRevokeRoleResponse) Reset()         { *m = MsgRevokeRoleResponse{} }
+func (m *MsgRevokeRoleResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRevokeRoleResponse) ProtoMessage()    {}
+func (*MsgRevokeRoleResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1bb9c8306211b32d, []int{106}
 }
-
-func (m *MsgUpdateInterestRateResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgRevokeRoleResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRevokeRoleResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRevokeRoleResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *MsgUpdateInterestRateResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgRevokeRoleResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRevokeRoleResponse.Merge(m, src)
+}
+func (m *MsgRevokeRoleResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRevokeRoleResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRevokeRoleResponse.DiscardUnknown(m)
 }
 
-func (m *MsgUpdateInterestRateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+var xxx_messageInfo_MsgRevokeRoleResponse proto.InternalMessageInfo
+
+// MsgProposeAdminTransferRequest is the request message for proposing a new vault admin.
+type MsgProposeAdminTransferRequest struct {
+	// authority is the address of the vault admin.
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// vault_address is the bech32 address of the vault.
+	VaultAddress string `protobuf:"bytes,2,opt,name=vault_address,json=vaultAddress,proto3" json:"vault_address,omitempty"`
+	// new_admin is the bech32 address proposed as the vault's admin. It must accept the
+	// transfer before it takes effect.
+	NewAdmin string `protobuf:"bytes,3,opt,name=new_admin,json=newAdmin,proto3" json:"new_admin,omitempty"`
+	// expiration_seconds is how long after the current block time the proposal can be
+	// accepted. Zero means the proposal does not expire.
+	ExpirationSeconds uint64 `protobuf:"varint,4,opt,name=expiration_seconds,json=expirationSeconds,proto3" json:"expiration_seconds,omitempty"`
 }
 
-func (m *MsgUpdateWithdrawalDelayRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgProposeAdminTransferRequest) Reset()         { *m = MsgProposeAdminTransferRequest{} }
+func (m *MsgProposeAdminTransferRequest) String() string { return proto.CompactTextString(m) }
+func (*MsgProposeAdminTransferRequest) ProtoMessage()    {}
+func (*MsgProposeAdminTransferRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1bb9c8306211b32d, []int{107}
+}
+func (m *MsgProposeAdminTransferRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgProposeAdminTransferRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgProposeAdminTransferRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *MsgUpdateWithdrawalDelayRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgProposeAdminTransferRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgProposeAdminTransferRequest.Merge(m, src)
+}
+func (m *MsgProposeAdminTransferRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgProposeAdminTransferRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgProposeAdminTransferRequest.DiscardUnknown(m)
 }
 
-func (m *MsgUpdateWithdrawalDelayRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.WithdrawalDelaySeconds != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.WithdrawalDelaySeconds))
-		i--
-		dAtA[i] = 0x18
-	}
-	if len(m.VaultAddress) > 0 {
-		i -= len(m.VaultAddress)
-		copy(dAtA[i:], m.VaultAddress)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.VaultAddress)))
-		i--
-		dAtA[i] = 0x12
+var xxx_messageInfo_MsgProposeAdminTransferRequest proto.InternalMessageInfo
+
+func (m *MsgProposeAdminTransferRequest) GetAuthority() string {
+	if m != nil {
+		return m.Authority
 	}
-	if len(m.Authority) > 0 {
-		i -= len(m.Authority)
-		copy(dAtA[i:], m.Authority)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
-		i--
-		dAtA[i] = 0xa
+	return ""
+}
+
+func (m *MsgProposeAdminTransferRequest) GetVaultAddress() string {
+	if m != nil {
+		return m.VaultAddress
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *MsgUpdateWithdrawalDelayResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgProposeAdminTransferRequest) GetNewAdmin() string {
+	if m != nil {
+		return m.NewAdmin
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *MsgUpdateWithdrawalDelayResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgProposeAdminTransferRequest) GetExpirationSeconds() uint64 {
+	if m != nil {
+		return m.ExpirationSeconds
+	}
+	return 0
 }
 
-func (m *MsgUpdateWithdrawalDelayResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+// MsgProposeAdminTransferResponse is the response message for the ProposeAdminTransfer endpoint.
+type MsgProposeAdminTransferResponse struct {
 }
 
-func (m *MsgUpdateMinSwapInValueRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgProposeAdminTransferResponse) Reset()         { *m = MsgProposeAdminTransferResponse{} }
+func (m *MsgProposeAdminTransferResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgProposeAdminTransferResponse) ProtoMessage()    {}
+func (*MsgProposeAdminTransferResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1bb9c8306211b32d, []int{108}
+}
+func (m *MsgProposeAdminTransferResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgProposeAdminTransferResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgProposeAdminTransferResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *MsgProposeAdminTransferResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgProposeAdminTransferResponse.Merge(m, src)
+}
+func (m *MsgProposeAdminTransferResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgProposeAdminTransferResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgProposeAdminTransferResponse.DiscardUnknown(m)
 }
 
-func (m *MsgUpdateMinSwapInValueRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_MsgProposeAdminTransferResponse proto.InternalMessageInfo
+
+// MsgAcceptAdminTransferRequest is the request message for accepting a pending admin transfer.
+type MsgAcceptAdminTransferRequest struct {
+	// new_admin is the address of the proposed admin accepting the transfer.
+	NewAdmin string `protobuf:"bytes,1,opt,name=new_admin,json=newAdmin,proto3" json:"new_admin,omitempty"`
+	// vault_address is the bech32 address of the vault.
+	VaultAddress string `protobuf:"bytes,2,opt,name=vault_address,json=vaultAddress,proto3" json:"vault_address,omitempty"`
 }
 
-func (m *MsgUpdateMinSwapInValueRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.MinSwapInValue) > 0 {
-		i -= len(m.MinSwapInValue)
-		copy(dAtA[i:], m.MinSwapInValue)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.MinSwapInValue)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.VaultAddress) > 0 {
-		i -= len(m.VaultAddress)
-		copy(dAtA[i:], m.VaultAddress)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.VaultAddress)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Authority) > 0 {
-		i -= len(m.Authority)
-		copy(dAtA[i:], m.Authority)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
-		i--
-		dAtA[i] = 0xa
+func (m *MsgAcceptAdminTransferRequest) Reset()         { *m = MsgAcceptAdminTransferRequest{} }
+func (m *MsgAcceptAdminTransferRequest) String() string { return proto.CompactTextString(m) }
+func (*MsgAcceptAdminTransferRequest) ProtoMessage()    {}
+func (*MsgAcceptAdminTransferRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1bb9c8306211b32d, []int{109}
+}
+func (m *MsgAcceptAdminTransferRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgAcceptAdminTransferRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgAcceptAdminTransferRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *MsgAcceptAdminTransferRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAcceptAdminTransferRequest.Merge(m, src)
+}
+func (m *MsgAcceptAdminTransferRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgAcceptAdminTransferRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAcceptAdminTransferRequest.DiscardUnknown(m)
 }
 
-func (m *MsgUpdateMinSwapInValueResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgAcceptAdminTransferRequest proto.InternalMessageInfo
+
+func (m *MsgAcceptAdminTransferRequest) GetNewAdmin() string {
+	if m != nil {
+		return m.NewAdmin
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *MsgUpdateMinSwapInValueResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgAcceptAdminTransferRequest) GetVaultAddress() string {
+	if m != nil {
+		return m.VaultAddress
+	}
+	return ""
 }
 
-func (m *MsgUpdateMinSwapInValueResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+// MsgAcceptAdminTransferResponse is the response message for the AcceptAdminTransfer endpoint.
+type MsgAcceptAdminTransferResponse struct {
 }
 
-func (m *MsgUpdateMinSwapOutValueRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgAcceptAdminTransferResponse) Reset()         { *m = MsgAcceptAdminTransferResponse{} }
+func (m *MsgAcceptAdminTransferResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgAcceptAdminTransferResponse) ProtoMessage()    {}
+func (*MsgAcceptAdminTransferResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1bb9c8306211b32d, []int{110}
+}
+func (m *MsgAcceptAdminTransferResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgAcceptAdminTransferResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgAcceptAdminTransferResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *MsgUpdateMinSwapOutValueRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgAcceptAdminTransferResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAcceptAdminTransferResponse.Merge(m, src)
+}
+func (m *MsgAcceptAdminTransferResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgAcceptAdminTransferResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAcceptAdminTransferResponse.DiscardUnknown(m)
 }
 
-func (m *MsgUpdateMinSwapOutValueRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.MinSwapOutValue) > 0 {
-		i -= len(m.MinSwapOutValue)
-		copy(dAtA[i:], m.MinSwapOutValue)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.MinSwapOutValue)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.VaultAddress) > 0 {
-		i -= len(m.VaultAddress)
-		copy(dAtA[i:], m.VaultAddress)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.VaultAddress)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Authority) > 0 {
-		i -= len(m.Authority)
-		copy(dAtA[i:], m.Authority)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
-}
+var xxx_messageInfo_MsgAcceptAdminTransferResponse proto.InternalMessageInfo
 
-func (m *MsgUpdateMinSwapOutValueResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+// MsgCancelAdminTransferRequest is the request message for withdrawing a pending admin
+// transfer.
+type MsgCancelAdminTransferRequest struct {
+	// authority is the address of the vault admin.
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// vault_address is the bech32 address of the vault.
+	VaultAddress string `protobuf:"bytes,2,opt,name=vault_address,json=vaultAddress,proto3" json:"vault_address,omitempty"`
 }
 
-func (m *MsgUpdateMinSwapOutValueResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgCancelAdminTransferRequest) Reset()         { *m = MsgCancelAdminTransferRequest{} }
+func (m *MsgCancelAdminTransferRequest) String() string { return proto.CompactTextString(m) }
+func (*MsgCancelAdminTransferRequest) ProtoMessage()    {}
+func (*MsgCancelAdminTransferRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1bb9c8306211b32d, []int{111}
 }
-
-func (m *MsgUpdateMinSwapOutValueResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *MsgCancelAdminTransferRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (m *MsgUpdateMaxSwapInValueRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgCancelAdminTransferRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCancelAdminTransferRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *MsgUpdateMaxSwapInValueRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgCancelAdminTransferRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCancelAdminTransferRequest.Merge(m, src)
+}
+func (m *MsgCancelAdminTransferRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCancelAdminTransferRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCancelAdminTransferRequest.DiscardUnknown(m)
 }
 
-func (m *MsgUpdateMaxSwapInValueRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.MaxSwapInValue) > 0 {
-		i -= len(m.MaxSwapInValue)
-		copy(dAtA[i:], m.MaxSwapInValue)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.MaxSwapInValue)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.VaultAddress) > 0 {
-		i -= len(m.VaultAddress)
-		copy(dAtA[i:], m.VaultAddress)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.VaultAddress)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Authority) > 0 {
-		i -= len(m.Authority)
-		copy(dAtA[i:], m.Authority)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
-		i--
-		dAtA[i] = 0xa
+var xxx_messageInfo_MsgCancelAdminTransferRequest proto.InternalMessageInfo
+
+func (m *MsgCancelAdminTransferRequest) GetAuthority() string {
+	if m != nil {
+		return m.Authority
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *MsgUpdateMaxSwapInValueResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgCancelAdminTransferRequest) GetVaultAddress() string {
+	if m != nil {
+		return m.VaultAddress
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *MsgUpdateMaxSwapInValueResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// MsgCancelAdminTransferResponse is the response message for the CancelAdminTransfer endpoint.
+type MsgCancelAdminTransferResponse struct {
 }
 
-func (m *MsgUpdateMaxSwapInValueResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *MsgCancelAdminTransferResponse) Reset()         { *m = MsgCancelAdminTransferResponse{} }
+func (m *MsgCancelAdminTransferResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgCancelAdminTransferResponse) ProtoMessage()    {}
+func (*MsgCancelAdminTransferResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1bb9c8306211b32d, []int{112}
 }
-
-func (m *MsgUpdateMaxSwapOutValueRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgCancelAdminTransferResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCancelAdminTransferResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCancelAdminTransferResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *MsgCancelAdminTransferResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCancelAdminTransferResponse.Merge(m, src)
+}
+func (m *MsgCancelAdminTransferResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCancelAdminTransferResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCancelAdminTransferResponse.DiscardUnknown(m)
 }
 
-func (m *MsgUpdateMaxSwapOutValueRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_MsgCancelAdminTransferResponse proto.InternalMessageInfo
+
+// MsgRecoverVaultAdminRequest is the request message for replacing a vault's admin through
+// governance.
+type MsgRecoverVaultAdminRequest struct {
+	// authority is the address of the governance module account.
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// vault_address is the bech32 address of the vault.
+	VaultAddress string `protobuf:"bytes,2,opt,name=vault_address,json=vaultAddress,proto3" json:"vault_address,omitempty"`
+	// new_admin is the bech32 address that becomes the vault's admin.
+	NewAdmin string `protobuf:"bytes,3,opt,name=new_admin,json=newAdmin,proto3" json:"new_admin,omitempty"`
 }
 
-func (m *MsgUpdateMaxSwapOutValueRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.MaxSwapOutValue) > 0 {
-		i -= len(m.MaxSwapOutValue)
-		copy(dAtA[i:], m.MaxSwapOutValue)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.MaxSwapOutValue)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.VaultAddress) > 0 {
-		i -= len(m.VaultAddress)
-		copy(dAtA[i:], m.VaultAddress)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.VaultAddress)))
-		i--
-		dAtA[i] = 0x12
+func (m *MsgRecoverVaultAdminRequest) Reset()         { *m = MsgRecoverVaultAdminRequest{} }
+func (m *MsgRecoverVaultAdminRequest) String() string { return proto.CompactTextString(m) }
+func (*MsgRecoverVaultAdminRequest) ProtoMessage()    {}
+func (*MsgRecoverVaultAdminRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1bb9c8306211b32d, []int{113}
+}
+func (m *MsgRecoverVaultAdminRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRecoverVaultAdminRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRecoverVaultAdminRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if len(m.Authority) > 0 {
-		i -= len(m.Authority)
-		copy(dAtA[i:], m.Authority)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
-		i--
-		dAtA[i] = 0xa
+}
+func (m *MsgRecoverVaultAdminRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRecoverVaultAdminRequest.Merge(m, src)
+}
+func (m *MsgRecoverVaultAdminRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRecoverVaultAdminRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRecoverVaultAdminRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRecoverVaultAdminRequest proto.InternalMessageInfo
+
+func (m *MsgRecoverVaultAdminRequest) GetAuthority() string {
+	if m != nil {
+		return m.Authority
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *MsgUpdateMaxSwapOutValueResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgRecoverVaultAdminRequest) GetVaultAddress() string {
+	if m != nil {
+		return m.VaultAddress
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *MsgUpdateMaxSwapOutValueResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgRecoverVaultAdminRequest) GetNewAdmin() string {
+	if m != nil {
+		return m.NewAdmin
+	}
+	return ""
 }
 
-func (m *MsgUpdateMaxSwapOutValueResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+// MsgRecoverVaultAdminResponse is the response message for the RecoverVaultAdmin endpoint.
+type MsgRecoverVaultAdminResponse struct {
 }
 
-func (m *MsgToggleSwapInRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgRecoverVaultAdminResponse) Reset()         { *m = MsgRecoverVaultAdminResponse{} }
+func (m *MsgRecoverVaultAdminResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRecoverVaultAdminResponse) ProtoMessage()    {}
+func (*MsgRecoverVaultAdminResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1bb9c8306211b32d, []int{114}
+}
+func (m *MsgRecoverVaultAdminResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRecoverVaultAdminResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRecoverVaultAdminResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *MsgRecoverVaultAdminResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRecoverVaultAdminResponse.Merge(m, src)
+}
+func (m *MsgRecoverVaultAdminResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRecoverVaultAdminResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRecoverVaultAdminResponse.DiscardUnknown(m)
 }
 
-func (m *MsgToggleSwapInRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_MsgRecoverVaultAdminResponse proto.InternalMessageInfo
+
+// MsgUpdateTimelockRequest is the request message for setting a vault's timelock.
+type MsgUpdateTimelockRequest struct {
+	// authority is the address of the vault admin.
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// vault_address is the bech32 address of the vault.
+	VaultAddress string `protobuf:"bytes,2,opt,name=vault_address,json=vaultAddress,proto3" json:"vault_address,omitempty"`
+	// timelock_seconds is the new delay applied to sensitive configuration changes. Zero
+	// disables the timelock.
+	TimelockSeconds uint64 `protobuf:"varint,3,opt,name=timelock_seconds,json=timelockSeconds,proto3" json:"timelock_seconds,omitempty"`
 }
 
-func (m *MsgToggleSwapInRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Enabled {
-		i--
-		if m.Enabled {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
+func (m *MsgUpdateTimelockRequest) Reset()         { *m = MsgUpdateTimelockRequest{} }
+func (m *MsgUpdateTimelockRequest) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateTimelockRequest) ProtoMessage()    {}
+func (*MsgUpdateTimelockRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1bb9c8306211b32d, []int{115}
+}
+func (m *MsgUpdateTimelockRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateTimelockRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateTimelockRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x18
-	}
-	if len(m.VaultAddress) > 0 {
-		i -= len(m.VaultAddress)
-		copy(dAtA[i:], m.VaultAddress)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.VaultAddress)))
-		i--
-		dAtA[i] = 0x12
+		return b[:n], nil
 	}
-	if len(m.Admin) > 0 {
-		i -= len(m.Admin)
-		copy(dAtA[i:], m.Admin)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Admin)))
-		i--
-		dAtA[i] = 0xa
+}
+func (m *MsgUpdateTimelockRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateTimelockRequest.Merge(m, src)
+}
+func (m *MsgUpdateTimelockRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateTimelockRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateTimelockRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateTimelockRequest proto.InternalMessageInfo
+
+func (m *MsgUpdateTimelockRequest) GetAuthority() string {
+	if m != nil {
+		return m.Authority
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *MsgToggleSwapInResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgUpdateTimelockRequest) GetVaultAddress() string {
+	if m != nil {
+		return m.VaultAddress
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *MsgToggleSwapInResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgUpdateTimelockRequest) GetTimelockSeconds() uint64 {
+	if m != nil {
+		return m.TimelockSeconds
+	}
+	return 0
 }
 
-func (m *MsgToggleSwapInResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+// MsgUpdateTimelockResponse is the response message for the UpdateTimelock endpoint.
+type MsgUpdateTimelockResponse struct {
 }
 
-func (m *MsgToggleSwapOutRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgUpdateTimelockResponse) Reset()         { *m = MsgUpdateTimelockResponse{} }
+func (m *MsgUpdateTimelockResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateTimelockResponse) ProtoMessage()    {}
+func (*MsgUpdateTimelockResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1bb9c8306211b32d, []int{116}
+}
+func (m *MsgUpdateTimelockResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateTimelockResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateTimelockResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *MsgUpdateTimelockResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateTimelockResponse.Merge(m, src)
+}
+func (m *MsgUpdateTimelockResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateTimelockResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateTimelockResponse.DiscardUnknown(m)
 }
 
-func (m *MsgToggleSwapOutRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_MsgUpdateTimelockResponse proto.InternalMessageInfo
+
+// MsgCancelScheduledActionRequest is the request message for discarding a scheduled action.
+type MsgCancelScheduledActionRequest struct {
+	// authority is the address of the vault admin or a holder of the vault's guardian role.
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// vault_address is the bech32 address of the vault.
+	VaultAddress string `protobuf:"bytes,2,opt,name=vault_address,json=vaultAddress,proto3" json:"vault_address,omitempty"`
+	// action_id is the id of the scheduled action to cancel.
+	ActionId uint64 `protobuf:"varint,3,opt,name=action_id,json=actionId,proto3" json:"action_id,omitempty"`
 }
 
-func (m *MsgToggleSwapOutRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Enabled {
-		i--
-		if m.Enabled {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
+func (m *MsgCancelScheduledActionRequest) Reset()         { *m = MsgCancelScheduledActionRequest{} }
+func (m *MsgCancelScheduledActionRequest) String() string { return proto.CompactTextString(m) }
+func (*MsgCancelScheduledActionRequest) ProtoMessage()    {}
+func (*MsgCancelScheduledActionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1bb9c8306211b32d, []int{117}
+}
+func (m *MsgCancelScheduledActionRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCancelScheduledActionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCancelScheduledActionRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x18
-	}
-	if len(m.VaultAddress) > 0 {
-		i -= len(m.VaultAddress)
-		copy(dAtA[i:], m.VaultAddress)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.VaultAddress)))
-		i--
-		dAtA[i] = 0x12
+		return b[:n], nil
 	}
-	if len(m.Admin) > 0 {
-		i -= len(m.Admin)
-		copy(dAtA[i:], m.Admin)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Admin)))
-		i--
-		dAtA[i] = 0xa
+}
+func (m *MsgCancelScheduledActionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCancelScheduledActionRequest.Merge(m, src)
+}
+func (m *MsgCancelScheduledActionRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCancelScheduledActionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCancelScheduledActionRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCancelScheduledActionRequest proto.InternalMessageInfo
+
+func (m *MsgCancelScheduledActionRequest) GetAuthority() string {
+	if m != nil {
+		return m.Authority
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *MsgToggleSwapOutResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgCancelScheduledActionRequest) GetVaultAddress() string {
+	if m != nil {
+		return m.VaultAddress
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *MsgToggleSwapOutResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgCancelScheduledActionRequest) GetActionId() uint64 {
+	if m != nil {
+		return m.ActionId
+	}
+	return 0
 }
 
-func (m *MsgToggleSwapOutResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+// MsgCancelScheduledActionResponse is the response message for the CancelScheduledAction endpoint.
+type MsgCancelScheduledActionResponse struct {
 }
 
-func (m *MsgDepositInterestFundsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgCancelScheduledActionResponse) Reset()         { *m = MsgCancelScheduledActionResponse{} }
+func (m *MsgCancelScheduledActionResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgCancelScheduledActionResponse) ProtoMessage()    {}
+func (*MsgCancelScheduledActionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1bb9c8306211b32d, []int{118}
+}
+func (m *MsgCancelScheduledActionResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCancelScheduledActionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCancelScheduledActionResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *MsgCancelScheduledActionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCancelScheduledActionResponse.Merge(m, src)
+}
+func (m *MsgCancelScheduledActionResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCancelScheduledActionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCancelScheduledActionResponse.DiscardUnknown(m)
 }
 
-func (m *MsgDepositInterestFundsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_MsgCancelScheduledActionResponse proto.InternalMessageInfo
+
+// MsgUpdateEligibilityPolicyRequest is the request message for replacing a vault's eligibility policy.
+type MsgUpdateEligibilityPolicyRequest struct {
+	// authority is the address of the vault admin.
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// vault_address is the bech32 address of the vault to update.
+	VaultAddress string `protobuf:"bytes,2,opt,name=vault_address,json=vaultAddress,proto3" json:"vault_address,omitempty"`
+	// policy is the new eligibility policy.
+	Policy EligibilityPolicy `protobuf:"bytes,3,opt,name=policy,proto3" json:"policy"`
 }
 
-func (m *MsgDepositInterestFundsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+func (m *MsgUpdateEligibilityPolicyRequest) Reset()         { *m = MsgUpdateEligibilityPolicyRequest{} }
+func (m *MsgUpdateEligibilityPolicyRequest) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateEligibilityPolicyRequest) ProtoMessage()    {}
+func (*MsgUpdateEligibilityPolicyRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1bb9c8306211b32d, []int{119}
+}
+func (m *MsgUpdateEligibilityPolicyRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateEligibilityPolicyRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateEligibilityPolicyRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintTx(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x1a
-	if len(m.VaultAddress) > 0 {
-		i -= len(m.VaultAddress)
-		copy(dAtA[i:], m.VaultAddress)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.VaultAddress)))
-		i--
-		dAtA[i] = 0x12
+		return b[:n], nil
 	}
-	if len(m.Authority) > 0 {
-		i -= len(m.Authority)
-		copy(dAtA[i:], m.Authority)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
-		i--
-		dAtA[i] = 0xa
+}
+func (m *MsgUpdateEligibilityPolicyRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateEligibilityPolicyRequest.Merge(m, src)
+}
+func (m *MsgUpdateEligibilityPolicyRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateEligibilityPolicyRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateEligibilityPolicyRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateEligibilityPolicyRequest proto.InternalMessageInfo
+
+func (m *MsgUpdateEligibilityPolicyRequest) GetAuthority() string {
+	if m != nil {
+		return m.Authority
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *MsgDepositInterestFundsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgUpdateEligibilityPolicyRequest) GetVaultAddress() string {
+	if m != nil {
+		return m.VaultAddress
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *MsgDepositInterestFundsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgUpdateEligibilityPolicyRequest) GetPolicy() EligibilityPolicy {
+	if m != nil {
+		return m.Policy
+	}
+	return EligibilityPolicy{}
 }
 
-func (m *MsgDepositInterestFundsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+// MsgUpdateEligibilityPolicyResponse is the response message for the UpdateEligibilityPolicy endpoint.
+type MsgUpdateEligibilityPolicyResponse struct {
 }
 
-func (m *MsgWithdrawInterestFundsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgUpdateEligibilityPolicyResponse) Reset()         { *m = MsgUpdateEligibilityPolicyResponse{} }
+func (m *MsgUpdateEligibilityPolicyResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateEligibilityPolicyResponse) ProtoMessage()    {}
+func (*MsgUpdateEligibilityPolicyResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1bb9c8306211b32d, []int{120}
+}
+func (m *MsgUpdateEligibilityPolicyResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateEligibilityPolicyResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateEligibilityPolicyResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *MsgUpdateEligibilityPolicyResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateEligibilityPolicyResponse.Merge(m, src)
+}
+func (m *MsgUpdateEligibilityPolicyResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateEligibilityPolicyResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateEligibilityPolicyResponse.DiscardUnknown(m)
 }
 
-func (m *MsgWithdrawInterestFundsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_MsgUpdateEligibilityPolicyResponse proto.InternalMessageInfo
+
+// MsgUpdateAllowlistRequest is the request message for changing a vault's investor allowlist.
+type MsgUpdateAllowlistRequest struct {
+	// authority is the address of the vault admin.
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// vault_address is the bech32 address of the vault to update.
+	VaultAddress string `protobuf:"bytes,2,opt,name=vault_address,json=vaultAddress,proto3" json:"vault_address,omitempty"`
+	// add are the bech32 addresses to add to the allowlist.
+	Add []string `protobuf:"bytes,3,rep,name=add,proto3" json:"add,omitempty"`
+	// remove are the bech32 addresses to remove from the allowlist.
+	Remove []string `protobuf:"bytes,4,rep,name=remove,proto3" json:"remove,omitempty"`
 }
 
-func (m *MsgWithdrawInterestFundsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+func (m *MsgUpdateAllowlistRequest) Reset()         { *m = MsgUpdateAllowlistRequest{} }
+func (m *MsgUpdateAllowlistRequest) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateAllowlistRequest) ProtoMessage()    {}
+func (*MsgUpdateAllowlistRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1bb9c8306211b32d, []int{121}
+}
+func (m *MsgUpdateAllowlistRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateAllowlistRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateAllowlistRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintTx(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x1a
-	if len(m.VaultAddress) > 0 {
-		i -= len(m.VaultAddress)
-		copy(dAtA[i:], m.VaultAddress)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.VaultAddress)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Authority) > 0 {
-		i -= len(m.Authority)
-		copy(dAtA[i:], m.Authority)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *MsgUpdateAllowlistRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateAllowlistRequest.Merge(m, src)
+}
+func (m *MsgUpdateAllowlistRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateAllowlistRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateAllowlistRequest.DiscardUnknown(m)
 }
 
-func (m *MsgWithdrawInterestFundsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgUpdateAllowlistRequest proto.InternalMessageInfo
+
+func (m *MsgUpdateAllowlistRequest) GetAuthority() string {
+	if m != nil {
+		return m.Authority
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *MsgWithdrawInterestFundsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgUpdateAllowlistRequest) GetVaultAddress() string {
+	if m != nil {
+		return m.VaultAddress
+	}
+	return ""
 }
 
-func (m *MsgWithdrawInterestFundsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *MsgUpdateAllowlistRequest) GetAdd() []string {
+	if m != nil {
+		return m.Add
+	}
+	return nil
 }
 
-func (m *MsgDepositPrincipalFundsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgUpdateAllowlistRequest) GetRemove() []string {
+	if m != nil {
+		return m.Remove
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *MsgDepositPrincipalFundsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// MsgUpdateAllowlistResponse is the response message for the UpdateAllowlist endpoint.
+type MsgUpdateAllowlistResponse struct {
 }
 
-func (m *MsgDepositPrincipalFundsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+func (m *MsgUpdateAllowlistResponse) Reset()         { *m = MsgUpdateAllowlistResponse{} }
+func (m *MsgUpdateAllowlistResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateAllowlistResponse) ProtoMessage()    {}
+func (*MsgUpdateAllowlistResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1bb9c8306211b32d, []int{122}
+}
+func (m *MsgUpdateAllowlistResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateAllowlistResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateAllowlistResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintTx(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x1a
-	if len(m.VaultAddress) > 0 {
-		i -= len(m.VaultAddress)
-		copy(dAtA[i:], m.VaultAddress)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.VaultAddress)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Authority) > 0 {
-		i -= len(m.Authority)
-		copy(dAtA[i:], m.Authority)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-
-func (m *MsgDepositPrincipalFundsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *MsgUpdateAllowlistResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateAllowlistResponse.Merge(m, src)
 }
-
-func (m *MsgDepositPrincipalFundsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgUpdateAllowlistResponse) XXX_Size() int {
+	return m.Size()
 }
-
-func (m *MsgDepositPrincipalFundsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *MsgUpdateAllowlistResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateAllowlistResponse.DiscardUnknown(m)
 }
 
-func (m *MsgWithdrawPrincipalFundsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
-}
+var xxx_messageInfo_MsgUpdateAllowlistResponse proto.InternalMessageInfo
 
-func (m *MsgWithdrawPrincipalFundsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// MsgUpdatePositionCapRequest is the request message for setting a vault's per-account position cap.
+type MsgUpdatePositionCapRequest struct {
+	// authority is the address of a holder of the vault's limits manager role.
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// vault_address is the bech32 address of the vault to update.
+	VaultAddress string `protobuf:"bytes,2,opt,name=vault_address,json=vaultAddress,proto3" json:"vault_address,omitempty"`
+	// max_position_value is the largest value, measured in the underlying_asset, of the shares a
+	// single account may hold after a swap-in or share transfer.
+	// - Values must be positive (> 0).
+	// - An empty string "" clears the cap.
+	MaxPositionValue string `protobuf:"bytes,3,opt,name=max_position_value,json=maxPositionValue,proto3" json:"max_position_value,omitempty"`
+	// exemptions are the addresses the cap does not apply to. They replace the vault's current
+	// exemptions.
+	Exemptions []string `protobuf:"bytes,4,rep,name=exemptions,proto3" json:"exemptions,omitempty"`
 }
 
-func (m *MsgWithdrawPrincipalFundsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+func (m *MsgUpdatePositionCapRequest) Reset()         { *m = MsgUpdatePositionCapRequest{} }
+func (m *MsgUpdatePositionCapRequest) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdatePositionCapRequest) ProtoMessage()    {}
+func (*MsgUpdatePositionCapRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1bb9c8306211b32d, []int{123}
+}
+func (m *MsgUpdatePositionCapRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdatePositionCapRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdatePositionCapRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintTx(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x1a
-	if len(m.VaultAddress) > 0 {
-		i -= len(m.VaultAddress)
-		copy(dAtA[i:], m.VaultAddress)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.VaultAddress)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Authority) > 0 {
-		i -= len(m.Authority)
-		copy(dAtA[i:], m.Authority)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *MsgUpdatePositionCapRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdatePositionCapRequest.Merge(m, src)
+}
+func (m *MsgUpdatePositionCapRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdatePositionCapRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdatePositionCapRequest.DiscardUnknown(m)
 }
 
-func (m *MsgWithdrawPrincipalFundsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgUpdatePositionCapRequest proto.InternalMessageInfo
+
+func (m *MsgUpdatePositionCapRequest) GetAuthority() string {
+	if m != nil {
+		return m.Authority
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *MsgWithdrawPrincipalFundsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgUpdatePositionCapRequest) GetVaultAddress() string {
+	if m != nil {
+		return m.VaultAddress
+	}
+	return ""
 }
 
-func (m *MsgWithdrawPrincipalFundsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *MsgUpdatePositionCapRequest) GetMaxPositionValue() string {
+	if m != nil {
+		return m.MaxPositionValue
+	}
+	return ""
 }
 
-func (m *MsgExpeditePendingSwapOutRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgUpdatePositionCapRequest) GetExemptions() []string {
+	if m != nil {
+		return m.Exemptions
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *MsgExpeditePendingSwapOutRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// MsgUpdatePositionCapResponse is the response message for the UpdatePositionCap endpoint.
+type MsgUpdatePositionCapResponse struct {
 }
 
-func (m *MsgExpeditePendingSwapOutRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.RequestId != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.RequestId))
-		i--
-		dAtA[i] = 0x10
-	}
-	if len(m.Authority) > 0 {
-		i -= len(m.Authority)
-		copy(dAtA[i:], m.Authority)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+func (m *MsgUpdatePositionCapResponse) Reset()         { *m = MsgUpdatePositionCapResponse{} }
+func (m *MsgUpdatePositionCapResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdatePositionCapResponse) ProtoMessage()    {}
+func (*MsgUpdatePositionCapResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1bb9c8306211b32d, []int{124}
 }
-
-func (m *MsgExpeditePendingSwapOutResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgUpdatePositionCapResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdatePositionCapResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdatePositionCapResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *MsgExpeditePendingSwapOutResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgUpdatePositionCapResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdatePositionCapResponse.Merge(m, src)
 }
-
-func (m *MsgExpeditePendingSwapOutResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *MsgUpdatePositionCapResponse) XXX_Size() int {
+	return m.Size()
 }
-
-func (m *MsgPauseVaultRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *MsgUpdatePositionCapResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdatePositionCapResponse.DiscardUnknown(m)
 }
 
-func (m *MsgPauseVaultRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_MsgUpdatePositionCapResponse proto.InternalMessageInfo
+
+// MsgUpdateMaxTotalValueRequest is the request message for setting a vault's deposit capacity.
+type MsgUpdateMaxTotalValueRequest struct {
+	// authority is the address of a holder of the vault's limits manager role.
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// vault_address is the bech32 address of the vault.
+	VaultAddress string `protobuf:"bytes,2,opt,name=vault_address,json=vaultAddress,proto3" json:"vault_address,omitempty"`
+	// max_total_value is the net total value, measured in the underlying_asset, swap-ins may
+	// bring the vault to.
+	// - Values must be positive (> 0).
+	// - An empty string "" clears the cap.
+	MaxTotalValue string `protobuf:"bytes,3,opt,name=max_total_value,json=maxTotalValue,proto3" json:"max_total_value,omitempty"`
 }
 
-func (m *MsgPauseVaultRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Force {
-		i--
-		if m.Force {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
+func (m *MsgUpdateMaxTotalValueRequest) Reset()         { *m = MsgUpdateMaxTotalValueRequest{} }
+func (m *MsgUpdateMaxTotalValueRequest) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateMaxTotalValueRequest) ProtoMessage()    {}
+func (*MsgUpdateMaxTotalValueRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1bb9c8306211b32d, []int{125}
+}
+func (m *MsgUpdateMaxTotalValueRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateMaxTotalValueRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateMaxTotalValueRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x20
-	}
-	if len(m.Reason) > 0 {
-		i -= len(m.Reason)
-		copy(dAtA[i:], m.Reason)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Reason)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.VaultAddress) > 0 {
-		i -= len(m.VaultAddress)
-		copy(dAtA[i:], m.VaultAddress)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.VaultAddress)))
-		i--
-		dAtA[i] = 0x12
+		return b[:n], nil
 	}
-	if len(m.Authority) > 0 {
-		i -= len(m.Authority)
-		copy(dAtA[i:], m.Authority)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
-		i--
-		dAtA[i] = 0xa
+}
+func (m *MsgUpdateMaxTotalValueRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateMaxTotalValueRequest.Merge(m, src)
+}
+func (m *MsgUpdateMaxTotalValueRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateMaxTotalValueRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateMaxTotalValueRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateMaxTotalValueRequest proto.InternalMessageInfo
+
+func (m *MsgUpdateMaxTotalValueRequest) GetAuthority() string {
+	if m != nil {
+		return m.Authority
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *MsgPauseVaultResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgUpdateMaxTotalValueRequest) GetVaultAddress() string {
+	if m != nil {
+		return m.VaultAddress
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *MsgPauseVaultResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgUpdateMaxTotalValueRequest) GetMaxTotalValue() string {
+	if m != nil {
+		return m.MaxTotalValue
+	}
+	return ""
 }
 
-func (m *MsgPauseVaultResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+// MsgUpdateMaxTotalValueResponse is the response message for the UpdateMaxTotalValue endpoint.
+type MsgUpdateMaxTotalValueResponse struct {
 }
 
-func (m *MsgUnpauseVaultRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgUpdateMaxTotalValueResponse) Reset()         { *m = MsgUpdateMaxTotalValueResponse{} }
+func (m *MsgUpdateMaxTotalValueResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateMaxTotalValueResponse) ProtoMessage()    {}
+func (*MsgUpdateMaxTotalValueResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1bb9c8306211b32d, []int{126}
+}
+func (m *MsgUpdateMaxTotalValueResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateMaxTotalValueResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateMaxTotalValueResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *MsgUpdateMaxTotalValueResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateMaxTotalValueResponse.Merge(m, src)
+}
+func (m *MsgUpdateMaxTotalValueResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateMaxTotalValueResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateMaxTotalValueResponse.DiscardUnknown(m)
 }
 
-func (m *MsgUnpauseVaultRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_MsgUpdateMaxTotalValueResponse proto.InternalMessageInfo
+
+// MsgUpdateCircuitBreakerRequest is the request message for setting the module-wide circuit
+// breaker.
+type MsgUpdateCircuitBreakerRequest struct {
+	// authority is the address of the governance module account or the circuit breaker guardian.
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// circuit_breaker is the new set of halted operations. It replaces the current one.
+	CircuitBreaker CircuitBreaker `protobuf:"bytes,2,opt,name=circuit_breaker,json=circuitBreaker,proto3" json:"circuit_breaker"`
+	// guardian is the new circuit breaker guardian.
+	// - When signed by governance, it replaces the current guardian; an empty string "" removes it.
+	// - When signed by the guardian, it must be empty and the current guardian is kept.
+	Guardian string `protobuf:"bytes,3,opt,name=guardian,proto3" json:"guardian,omitempty"`
 }
 
-func (m *MsgUnpauseVaultRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.VaultAddress) > 0 {
-		i -= len(m.VaultAddress)
-		copy(dAtA[i:], m.VaultAddress)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.VaultAddress)))
-		i--
-		dAtA[i] = 0x12
+func (m *MsgUpdateCircuitBreakerRequest) Reset()         { *m = MsgUpdateCircuitBreakerRequest{} }
+func (m *MsgUpdateCircuitBreakerRequest) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateCircuitBreakerRequest) ProtoMessage()    {}
+func (*MsgUpdateCircuitBreakerRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1bb9c8306211b32d, []int{127}
+}
+func (m *MsgUpdateCircuitBreakerRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateCircuitBreakerRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateCircuitBreakerRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if len(m.Authority) > 0 {
-		i -= len(m.Authority)
-		copy(dAtA[i:], m.Authority)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
-		i--
-		dAtA[i] = 0xa
+}
+func (m *MsgUpdateCircuitBreakerRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateCircuitBreakerRequest.Merge(m, src)
+}
+func (m *MsgUpdateCircuitBreakerRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateCircuitBreakerRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateCircuitBreakerRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateCircuitBreakerRequest proto.InternalMessageInfo
+
+func (m *MsgUpdateCircuitBreakerRequest) GetAuthority() string {
+	if m != nil {
+		return m.Authority
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *MsgUnpauseVaultResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgUpdateCircuitBreakerRequest) GetCircuitBreaker() CircuitBreaker {
+	if m != nil {
+		return m.CircuitBreaker
 	}
-	return dAtA[:n], nil
+	return CircuitBreaker{}
 }
 
-func (m *MsgUnpauseVaultResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgUpdateCircuitBreakerRequest) GetGuardian() string {
+	if m != nil {
+		return m.Guardian
+	}
+	return ""
 }
 
-func (m *MsgUnpauseVaultResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+// MsgUpdateCircuitBreakerResponse is the response message for the UpdateCircuitBreaker endpoint.
+type MsgUpdateCircuitBreakerResponse struct {
 }
 
-func (m *MsgSetBridgeAddressRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgUpdateCircuitBreakerResponse) Reset()         { *m = MsgUpdateCircuitBreakerResponse{} }
+func (m *MsgUpdateCircuitBreakerResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateCircuitBreakerResponse) ProtoMessage()    {}
+func (*MsgUpdateCircuitBreakerResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1bb9c8306211b32d, []int{128}
+}
+func (m *MsgUpdateCircuitBreakerResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateCircuitBreakerResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateCircuitBreakerResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *MsgSetBridgeAddressRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgUpdateCircuitBreakerResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateCircuitBreakerResponse.Merge(m, src)
+}
+func (m *MsgUpdateCircuitBreakerResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateCircuitBreakerResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateCircuitBreakerResponse.DiscardUnknown(m)
 }
 
-func (m *MsgSetBridgeAddressRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.BridgeAddress) > 0 {
-		i -= len(m.BridgeAddress)
-		copy(dAtA[i:], m.BridgeAddress)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.BridgeAddress)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.VaultAddress) > 0 {
-		i -= len(m.VaultAddress)
-		copy(dAtA[i:], m.VaultAddress)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.VaultAddress)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Admin) > 0 {
-		i -= len(m.Admin)
-		copy(dAtA[i:], m.Admin)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Admin)))
-		i--
-		dAtA[i] = 0xa
+var xxx_messageInfo_MsgUpdateCircuitBreakerResponse proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*MsgCreateVaultRequest)(nil), "provlabs.vault.v1.MsgCreateVaultRequest")
+	proto.RegisterType((*MsgCreateVaultResponse)(nil), "provlabs.vault.v1.MsgCreateVaultResponse")
+	proto.RegisterType((*MsgSetShareDenomMetadataRequest)(nil), "provlabs.vault.v1.MsgSetShareDenomMetadataRequest")
+	proto.RegisterType((*MsgSetShareDenomMetadataResponse)(nil), "provlabs.vault.v1.MsgSetShareDenomMetadataResponse")
+	proto.RegisterType((*MsgSwapInRequest)(nil), "provlabs.vault.v1.MsgSwapInRequest")
+	proto.RegisterType((*MsgSwapInResponse)(nil), "provlabs.vault.v1.MsgSwapInResponse")
+	proto.RegisterType((*MsgSwapOutRequest)(nil), "provlabs.vault.v1.MsgSwapOutRequest")
+	proto.RegisterType((*MsgSwapOutResponse)(nil), "provlabs.vault.v1.MsgSwapOutResponse")
+	proto.RegisterType((*MsgUpdateMinInterestRateRequest)(nil), "provlabs.vault.v1.MsgUpdateMinInterestRateRequest")
+	proto.RegisterType((*MsgUpdateMinInterestRateResponse)(nil), "provlabs.vault.v1.MsgUpdateMinInterestRateResponse")
+	proto.RegisterType((*MsgUpdateMaxInterestRateRequest)(nil), "provlabs.vault.v1.MsgUpdateMaxInterestRateRequest")
+	proto.RegisterType((*MsgUpdateMaxInterestRateResponse)(nil), "provlabs.vault.v1.MsgUpdateMaxInterestRateResponse")
+	proto.RegisterType((*MsgUpdateInterestRateRequest)(nil), "provlabs.vault.v1.MsgUpdateInterestRateRequest")
+	proto.RegisterType((*MsgUpdateInterestRateResponse)(nil), "provlabs.vault.v1.MsgUpdateInterestRateResponse")
+	proto.RegisterType((*MsgUpdateWithdrawalDelayRequest)(nil), "provlabs.vault.v1.MsgUpdateWithdrawalDelayRequest")
+	proto.RegisterType((*MsgUpdateWithdrawalDelayResponse)(nil), "provlabs.vault.v1.MsgUpdateWithdrawalDelayResponse")
+	proto.RegisterType((*MsgUpdateMinSwapInValueRequest)(nil), "provlabs.vault.v1.MsgUpdateMinSwapInValueRequest")
+	proto.RegisterType((*MsgUpdateMinSwapInValueResponse)(nil), "provlabs.vault.v1.MsgUpdateMinSwapInValueResponse")
+	proto.RegisterType((*MsgUpdateMinSwapOutValueRequest)(nil), "provlabs.vault.v1.MsgUpdateMinSwapOutValueRequest")
+	proto.RegisterType((*MsgUpdateMinSwapOutValueResponse)(nil), "provlabs.vault.v1.MsgUpdateMinSwapOutValueResponse")
+	proto.RegisterType((*MsgUpdateMaxSwapInValueRequest)(nil), "provlabs.vault.v1.MsgUpdateMaxSwapInValueRequest")
+	proto.RegisterType((*MsgUpdateMaxSwapInValueResponse)(nil), "provlabs.vault.v1.MsgUpdateMaxSwapInValueResponse")
+	proto.RegisterType((*MsgUpdateMaxSwapOutValueRequest)(nil), "provlabs.vault.v1.MsgUpdateMaxSwapOutValueRequest")
+	proto.RegisterType((*MsgUpdateMaxSwapOutValueResponse)(nil), "provlabs.vault.v1.MsgUpdateMaxSwapOutValueResponse")
+	proto.RegisterType((*MsgToggleSwapInRequest)(nil), "provlabs.vault.v1.MsgToggleSwapInRequest")
+	proto.RegisterType((*MsgToggleSwapInResponse)(nil), "provlabs.vault.v1.MsgToggleSwapInResponse")
+	proto.RegisterType((*MsgToggleSwapOutRequest)(nil), "provlabs.vault.v1.MsgToggleSwapOutRequest")
+	proto.RegisterType((*MsgToggleSwapOutResponse)(nil), "provlabs.vault.v1.MsgToggleSwapOutResponse")
+	proto.RegisterType((*MsgDepositInterestFundsRequest)(nil), "provlabs.vault.v1.MsgDepositInterestFundsRequest")
+	proto.RegisterType((*MsgDepositInterestFundsResponse)(nil), "provlabs.vault.v1.MsgDepositInterestFundsResponse")
+	proto.RegisterType((*MsgWithdrawInterestFundsRequest)(nil), "provlabs.vault.v1.MsgWithdrawInterestFundsRequest")
+	proto.RegisterType((*MsgWithdrawInterestFundsResponse)(nil), "provlabs.vault.v1.MsgWithdrawInterestFundsResponse")
+	proto.RegisterType((*MsgDepositPrincipalFundsRequest)(nil), "provlabs.vault.v1.MsgDepositPrincipalFundsRequest")
+	proto.RegisterType((*MsgDepositPrincipalFundsResponse)(nil), "provlabs.vault.v1.MsgDepositPrincipalFundsResponse")
+	proto.RegisterType((*MsgWithdrawPrincipalFundsRequest)(nil), "provlabs.vault.v1.MsgWithdrawPrincipalFundsRequest")
+	proto.RegisterType((*MsgWithdrawPrincipalFundsResponse)(nil), "provlabs.vault.v1.MsgWithdrawPrincipalFundsResponse")
+	proto.RegisterType((*MsgExpeditePendingSwapOutRequest)(nil), "provlabs.vault.v1.MsgExpeditePendingSwapOutRequest")
+	proto.RegisterType((*MsgExpeditePendingSwapOutResponse)(nil), "provlabs.vault.v1.MsgExpeditePendingSwapOutResponse")
+	proto.RegisterType((*MsgPauseVaultRequest)(nil), "provlabs.vault.v1.MsgPauseVaultRequest")
+	proto.RegisterType((*MsgPauseVaultResponse)(nil), "provlabs.vault.v1.MsgPauseVaultResponse")
+	proto.RegisterType((*MsgUnpauseVaultRequest)(nil), "provlabs.vault.v1.MsgUnpauseVaultRequest")
+	proto.RegisterType((*MsgUnpauseVaultResponse)(nil), "provlabs.vault.v1.MsgUnpauseVaultResponse")
+	proto.RegisterType((*MsgSetBridgeAddressRequest)(nil), "provlabs.vault.v1.MsgSetBridgeAddressRequest")
+	proto.RegisterType((*MsgSetBridgeAddressResponse)(nil), "provlabs.vault.v1.MsgSetBridgeAddressResponse")
+	proto.RegisterType((*MsgToggleBridgeRequest)(nil), "provlabs.vault.v1.MsgToggleBridgeRequest")
+	proto.RegisterType((*MsgToggleBridgeResponse)(nil), "provlabs.vault.v1.MsgToggleBridgeResponse")
+	proto.RegisterType((*MsgBridgeMintSharesRequest)(nil), "provlabs.vault.v1.MsgBridgeMintSharesRequest")
+	proto.RegisterType((*MsgBridgeMintSharesResponse)(nil), "provlabs.vault.v1.MsgBridgeMintSharesResponse")
+	proto.RegisterType((*MsgBridgeBurnSharesRequest)(nil), "provlabs.vault.v1.MsgBridgeBurnSharesRequest")
+	proto.RegisterType((*MsgBridgeBurnSharesResponse)(nil), "provlabs.vault.v1.MsgBridgeBurnSharesResponse")
+	proto.RegisterType((*MsgSetAssetManagerRequest)(nil), "provlabs.vault.v1.MsgSetAssetManagerRequest")
+	proto.RegisterType((*MsgSetAssetManagerResponse)(nil), "provlabs.vault.v1.MsgSetAssetManagerResponse")
+	proto.RegisterType((*MsgUpdateParamsRequest)(nil), "provlabs.vault.v1.MsgUpdateParamsRequest")
+	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "provlabs.vault.v1.MsgUpdateParamsResponse")
+	proto.RegisterType((*MsgUpdateVaultAUMFeeBipsRequest)(nil), "provlabs.vault.v1.MsgUpdateVaultAUMFeeBipsRequest")
+	proto.RegisterType((*MsgUpdateVaultAUMFeeBipsResponse)(nil), "provlabs.vault.v1.MsgUpdateVaultAUMFeeBipsResponse")
+	proto.RegisterType((*MsgUpdateVaultNAVRequest)(nil), "provlabs.vault.v1.MsgUpdateVaultNAVRequest")
+	proto.RegisterType((*MsgUpdateVaultNAVResponse)(nil), "provlabs.vault.v1.MsgUpdateVaultNAVResponse")
+	proto.RegisterType((*MsgRemoveVaultNAVRequest)(nil), "provlabs.vault.v1.MsgRemoveVaultNAVRequest")
+	proto.RegisterType((*MsgRemoveVaultNAVResponse)(nil), "provlabs.vault.v1.MsgRemoveVaultNAVResponse")
+	proto.RegisterType((*MsgUpdateNAVAuthorityRequest)(nil), "provlabs.vault.v1.MsgUpdateNAVAuthorityRequest")
+	proto.RegisterType((*MsgUpdateNAVAuthorityResponse)(nil), "provlabs.vault.v1.MsgUpdateNAVAuthorityResponse")
+	proto.RegisterType((*MsgAcceptAssetRequest)(nil), "provlabs.vault.v1.MsgAcceptAssetRequest")
+	proto.RegisterType((*MsgAcceptAssetResponse)(nil), "provlabs.vault.v1.MsgAcceptAssetResponse")
+	proto.RegisterType((*MsgRejectAssetRequest)(nil), "provlabs.vault.v1.MsgRejectAssetRequest")
+	proto.RegisterType((*MsgRejectAssetResponse)(nil), "provlabs.vault.v1.MsgRejectAssetResponse")
+	proto.RegisterType((*MsgUpdateNAVStalenessLimitRequest)(nil), "provlabs.vault.v1.MsgUpdateNAVStalenessLimitRequest")
+	proto.RegisterType((*MsgUpdateNAVStalenessLimitResponse)(nil), "provlabs.vault.v1.MsgUpdateNAVStalenessLimitResponse")
+	proto.RegisterType((*MsgUpdateNAVChangeLimitRequest)(nil), "provlabs.vault.v1.MsgUpdateNAVChangeLimitRequest")
+	proto.RegisterType((*MsgUpdateNAVChangeLimitResponse)(nil), "provlabs.vault.v1.MsgUpdateNAVChangeLimitResponse")
+	proto.RegisterType((*MsgUpdateSettlementToleranceRequest)(nil), "provlabs.vault.v1.MsgUpdateSettlementToleranceRequest")
+	proto.RegisterType((*MsgUpdateSettlementToleranceResponse)(nil), "provlabs.vault.v1.MsgUpdateSettlementToleranceResponse")
+	proto.RegisterType((*MsgApproveNAVProposalRequest)(nil), "provlabs.vault.v1.MsgApproveNAVProposalRequest")
+	proto.RegisterType((*MsgApproveNAVProposalResponse)(nil), "provlabs.vault.v1.MsgApproveNAVProposalResponse")
+	proto.RegisterType((*MsgUpdateNAVSourcesRequest)(nil), "provlabs.vault.v1.MsgUpdateNAVSourcesRequest")
+	proto.RegisterType((*MsgUpdateNAVSourcesResponse)(nil), "provlabs.vault.v1.MsgUpdateNAVSourcesResponse")
+	proto.RegisterType((*MsgSubmitSourceNAVRequest)(nil), "provlabs.vault.v1.MsgSubmitSourceNAVRequest")
+	proto.RegisterType((*MsgSubmitSourceNAVResponse)(nil), "provlabs.vault.v1.MsgSubmitSourceNAVResponse")
+	proto.RegisterType((*NAVUpdate)(nil), "provlabs.vault.v1.NAVUpdate")
+	proto.RegisterType((*MsgBatchUpdateVaultNAVRequest)(nil), "provlabs.vault.v1.MsgBatchUpdateVaultNAVRequest")
+	proto.RegisterType((*MsgBatchUpdateVaultNAVResponse)(nil), "provlabs.vault.v1.MsgBatchUpdateVaultNAVResponse")
+	proto.RegisterType((*MsgUpdatePriceSourcesRequest)(nil), "provlabs.vault.v1.MsgUpdatePriceSourcesRequest")
+	proto.RegisterType((*MsgUpdatePriceSourcesResponse)(nil), "provlabs.vault.v1.MsgUpdatePriceSourcesResponse")
+	proto.RegisterType((*MsgUpdateAssetHaircutRequest)(nil), "provlabs.vault.v1.MsgUpdateAssetHaircutRequest")
+	proto.RegisterType((*MsgUpdateAssetHaircutResponse)(nil), "provlabs.vault.v1.MsgUpdateAssetHaircutResponse")
+	proto.RegisterType((*AssetPaymentRef)(nil), "provlabs.vault.v1.AssetPaymentRef")
+	proto.RegisterType((*MsgBatchAcceptAssetsRequest)(nil), "provlabs.vault.v1.MsgBatchAcceptAssetsRequest")
+	proto.RegisterType((*AssetPaymentResult)(nil), "provlabs.vault.v1.AssetPaymentResult")
+	proto.RegisterType((*MsgBatchAcceptAssetsResponse)(nil), "provlabs.vault.v1.MsgBatchAcceptAssetsResponse")
+	proto.RegisterType((*MsgCreateAssetPaymentRequest)(nil), "provlabs.vault.v1.MsgCreateAssetPaymentRequest")
+	proto.RegisterType((*MsgCreateAssetPaymentResponse)(nil), "provlabs.vault.v1.MsgCreateAssetPaymentResponse")
+	proto.RegisterType((*MsgCancelAssetPaymentRequest)(nil), "provlabs.vault.v1.MsgCancelAssetPaymentRequest")
+	proto.RegisterType((*MsgCancelAssetPaymentResponse)(nil), "provlabs.vault.v1.MsgCancelAssetPaymentResponse")
+	proto.RegisterType((*MsgExpireAssetPaymentRequest)(nil), "provlabs.vault.v1.MsgExpireAssetPaymentRequest")
+	proto.RegisterType((*MsgExpireAssetPaymentResponse)(nil), "provlabs.vault.v1.MsgExpireAssetPaymentResponse")
+	proto.RegisterType((*MsgUpdateAcquisitionPolicyRequest)(nil), "provlabs.vault.v1.MsgUpdateAcquisitionPolicyRequest")
+	proto.RegisterType((*MsgUpdateAcquisitionPolicyResponse)(nil), "provlabs.vault.v1.MsgUpdateAcquisitionPolicyResponse")
+	proto.RegisterType((*MsgUpdateConcentrationLimitsRequest)(nil), "provlabs.vault.v1.MsgUpdateConcentrationLimitsRequest")
+	proto.RegisterType((*MsgUpdateConcentrationLimitsResponse)(nil), "provlabs.vault.v1.MsgUpdateConcentrationLimitsResponse")
+	proto.RegisterType((*MsgUpdateMinLiquidityRequest)(nil), "provlabs.vault.v1.MsgUpdateMinLiquidityRequest")
+	proto.RegisterType((*MsgUpdateMinLiquidityResponse)(nil), "provlabs.vault.v1.MsgUpdateMinLiquidityResponse")
+	proto.RegisterType((*MsgCollectAssetIncomeRequest)(nil), "provlabs.vault.v1.MsgCollectAssetIncomeRequest")
+	proto.RegisterType((*MsgCollectAssetIncomeResponse)(nil), "provlabs.vault.v1.MsgCollectAssetIncomeResponse")
+	proto.RegisterType((*MsgGrantRoleRequest)(nil), "provlabs.vault.v1.MsgGrantRoleRequest")
+	proto.RegisterType((*MsgGrantRoleResponse)(nil), "provlabs.vault.v1.MsgGrantRoleResponse")
+	proto.RegisterType((*MsgRevokeRoleRequest)(nil), "provlabs.vault.v1.MsgRevokeRoleRequest")
+	proto.RegisterType((*MsgRevokeRoleResponse)(nil), "provlabs.vault.v1.MsgRevokeRoleResponse")
+	proto.RegisterType((*MsgProposeAdminTransferRequest)(nil), "provlabs.vault.v1.MsgProposeAdminTransferRequest")
+	proto.RegisterType((*MsgProposeAdminTransferResponse)(nil), "provlabs.vault.v1.MsgProposeAdminTransferResponse")
+	proto.RegisterType((*MsgAcceptAdminTransferRequest)(nil), "provlabs.vault.v1.MsgAcceptAdminTransferRequest")
+	proto.RegisterType((*MsgAcceptAdminTransferResponse)(nil), "provlabs.vault.v1.MsgAcceptAdminTransferResponse")
+	proto.RegisterType((*MsgCancelAdminTransferRequest)(nil), "provlabs.vault.v1.MsgCancelAdminTransferRequest")
+	proto.RegisterType((*MsgCancelAdminTransferResponse)(nil), "provlabs.vault.v1.MsgCancelAdminTransferResponse")
+	proto.RegisterType((*MsgRecoverVaultAdminRequest)(nil), "provlabs.vault.v1.MsgRecoverVaultAdminRequest")
+	proto.RegisterType((*MsgRecoverVaultAdminResponse)(nil), "provlabs.vault.v1.MsgRecoverVaultAdminResponse")
+	proto.RegisterType((*MsgUpdateTimelockRequest)(nil), "provlabs.vault.v1.MsgUpdateTimelockRequest")
+	proto.RegisterType((*MsgUpdateTimelockResponse)(nil), "provlabs.vault.v1.MsgUpdateTimelockResponse")
+	proto.RegisterType((*MsgCancelScheduledActionRequest)(nil), "provlabs.vault.v1.MsgCancelScheduledActionRequest")
+	proto.RegisterType((*MsgCancelScheduledActionResponse)(nil), "provlabs.vault.v1.MsgCancelScheduledActionResponse")
+	proto.RegisterType((*MsgUpdateEligibilityPolicyRequest)(nil), "provlabs.vault.v1.MsgUpdateEligibilityPolicyRequest")
+	proto.RegisterType((*MsgUpdateEligibilityPolicyResponse)(nil), "provlabs.vault.v1.MsgUpdateEligibilityPolicyResponse")
+	proto.RegisterType((*MsgUpdateAllowlistRequest)(nil), "provlabs.vault.v1.MsgUpdateAllowlistRequest")
+	proto.RegisterType((*MsgUpdateAllowlistResponse)(nil), "provlabs.vault.v1.MsgUpdateAllowlistResponse")
+	proto.RegisterType((*MsgUpdatePositionCapRequest)(nil), "provlabs.vault.v1.MsgUpdatePositionCapRequest")
+	proto.RegisterType((*MsgUpdatePositionCapResponse)(nil), "provlabs.vault.v1.MsgUpdatePositionCapResponse")
+	proto.RegisterType((*MsgUpdateMaxTotalValueRequest)(nil), "provlabs.vault.v1.MsgUpdateMaxTotalValueRequest")
+	proto.RegisterType((*MsgUpdateMaxTotalValueResponse)(nil), "provlabs.vault.v1.MsgUpdateMaxTotalValueResponse")
+	proto.RegisterType((*MsgUpdateCircuitBreakerRequest)(nil), "provlabs.vault.v1.MsgUpdateCircuitBreakerRequest")
+	proto.RegisterType((*MsgUpdateCircuitBreakerResponse)(nil), "provlabs.vault.v1.MsgUpdateCircuitBreakerResponse")
+}
+
+func init() { proto.RegisterFile("provlabs/vault/v1/tx.proto", fileDescriptor_1bb9c8306211b32d) }
+
+var fileDescriptor_1bb9c8306211b32d = []byte{
+	// 4313 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x5c, 0x4b, 0x6c, 0x1c, 0xc7,
+	0xd1, 0xd6, 0x90, 0x14, 0x1f, 0xcd, 0xa7, 0x5a, 0x94, 0x48, 0xae, 0x24, 0x52, 0x5a, 0x49, 0xb6,
+	0x24, 0x9b, 0xa4, 0x48, 0x4b, 0x96, 0xad, 0xdf, 0x86, 0xb1, 0xa4, 0x64, 0x5b, 0x3f, 0x4c, 0x8a,
+	0x5e, 0x4a, 0xfc, 0x81, 0x1f, 0xff, 0x8f, 0x45, 0x73, 0xb7, 0xb5, 0x9c, 0x68, 0x1e, 0xab, 0x99,
+	0xd9, 0xe5, 0x2a, 0x40, 0x00, 0x23, 0x41, 0xde, 0x3e, 0x24, 0x48, 0x2e, 0x3e, 0x24, 0x97, 0x00,
+	0x41, 0x90, 0xf8, 0xe0, 0x04, 0xce, 0x21, 0x08, 0x10, 0x20, 0x17, 0xc7, 0xc8, 0x21, 0x70, 0x0c,
+	0x03, 0x0e, 0x72, 0x70, 0x02, 0xfb, 0xe0, 0xe4, 0x16, 0x23, 0x08, 0x10, 0xdf, 0x82, 0x7e, 0xcc,
+	0x4c, 0xcf, 0x4c, 0x77, 0xef, 0xae, 0x6c, 0xae, 0x25, 0x9f, 0xa4, 0x9d, 0x7e, 0x54, 0x7d, 0xd5,
+	0xd5, 0xd5, 0x55, 0xdd, 0x55, 0x04, 0xb9, 0x9a, 0xe7, 0x36, 0x2c, 0xb4, 0xed, 0x2f, 0x36, 0x50,
+	0xdd, 0x0a, 0x16, 0x1b, 0x4b, 0x8b, 0x41, 0x73, 0xa1, 0xe6, 0xb9, 0x81, 0x0b, 0x0f, 0x84, 0x6d,
+	0x0b, 0xb4, 0x6d, 0xa1, 0xb1, 0x94, 0x9b, 0x2d, 0xbb, 0xbe, 0xed, 0xfa, 0x8b, 0xdb, 0xc8, 0xb9,
+	0xbd, 0xd8, 0x58, 0xda, 0xc6, 0x01, 0x5a, 0xa2, 0x3f, 0xd8, 0x10, 0xa1, 0xdd, 0xc7, 0x51, 0x7b,
+	0xd9, 0x35, 0x1d, 0xde, 0x3e, 0xc5, 0xdb, 0x6d, 0xbf, 0x4a, 0x48, 0xd9, 0x7e, 0x95, 0x37, 0xcc,
+	0xb0, 0x86, 0x12, 0xfd, 0xb5, 0xc8, 0x7e, 0xf0, 0xa6, 0xc9, 0xaa, 0x5b, 0x75, 0xd9, 0x77, 0xf2,
+	0xbf, 0x90, 0x52, 0x96, 0xf1, 0x1a, 0xf2, 0x90, 0x1d, 0x8e, 0x3a, 0x96, 0x6d, 0x67, 0x28, 0x68,
+	0x73, 0xfe, 0x8d, 0x3e, 0x70, 0x68, 0xcd, 0xaf, 0xae, 0x7a, 0x18, 0x05, 0x78, 0x8b, 0x34, 0x14,
+	0xf1, 0x9d, 0x3a, 0xf6, 0x03, 0xb8, 0x00, 0xf6, 0xa3, 0x8a, 0x6d, 0x3a, 0xd3, 0xc6, 0x71, 0xe3,
+	0xcc, 0xd0, 0xca, 0xf4, 0xdb, 0xaf, 0xcf, 0x4f, 0x72, 0x7e, 0x0a, 0x95, 0x8a, 0x87, 0x7d, 0x7f,
+	0x33, 0xf0, 0x4c, 0xa7, 0x5a, 0x64, 0xdd, 0xe0, 0x1c, 0x18, 0xf6, 0x77, 0x90, 0x87, 0x4b, 0x15,
+	0xec, 0xb8, 0xf6, 0x74, 0x0f, 0x19, 0x55, 0x04, 0xf4, 0xd3, 0x15, 0xf2, 0x05, 0x9e, 0x05, 0x13,
+	0x75, 0xa7, 0x82, 0x3d, 0xeb, 0xae, 0xe9, 0x54, 0x4b, 0xc8, 0xf7, 0x71, 0x30, 0xdd, 0x4b, 0x7b,
+	0x8d, 0xc7, 0xdf, 0x0b, 0xe4, 0x33, 0x7c, 0x18, 0x8c, 0xd6, 0xd0, 0x5d, 0x1b, 0x3b, 0x01, 0x9f,
+	0xad, 0x8f, 0xf2, 0xd0, 0x33, 0x6d, 0x14, 0x47, 0x78, 0x03, 0x9b, 0xf3, 0x09, 0x30, 0xbd, 0x6b,
+	0x06, 0x3b, 0x15, 0x0f, 0xed, 0x22, 0xab, 0x54, 0xc1, 0x16, 0xba, 0x5b, 0xf2, 0x71, 0xd9, 0x75,
+	0x2a, 0xfe, 0xf4, 0xfe, 0xe3, 0xc6, 0x99, 0xbe, 0xe2, 0xe1, 0xb8, 0xfd, 0x0a, 0x69, 0xde, 0x64,
+	0xad, 0xf0, 0x19, 0x70, 0xc0, 0x36, 0x9d, 0x92, 0xbf, 0x8b, 0x6a, 0x25, 0xd3, 0x29, 0x35, 0x90,
+	0x55, 0xc7, 0xd3, 0xfd, 0x94, 0xcc, 0xe4, 0xdb, 0xaf, 0xcf, 0x4f, 0x70, 0xa8, 0xd7, 0x9c, 0x80,
+	0xc3, 0x1c, 0xb3, 0x4d, 0x67, 0x73, 0x17, 0xd5, 0xae, 0x39, 0x5b, 0xa4, 0x2f, 0x2c, 0x00, 0x18,
+	0x4d, 0xe0, 0xd6, 0x03, 0x3e, 0xc3, 0x80, 0x66, 0x86, 0x71, 0x3e, 0xc3, 0xf5, 0x7a, 0xc0, 0xa6,
+	0x20, 0x3c, 0xa0, 0x66, 0x8a, 0x87, 0x41, 0x2d, 0x0f, 0xa8, 0x99, 0xe6, 0x21, 0x9c, 0x20, 0xe6,
+	0x61, 0x48, 0xcb, 0x03, 0x9b, 0x21, 0xe2, 0xe1, 0x71, 0x30, 0x84, 0xea, 0xc1, 0x8e, 0xeb, 0x99,
+	0xc1, 0xdd, 0x69, 0xd0, 0x62, 0xa9, 0xe3, 0xae, 0x97, 0xc7, 0xbe, 0xfc, 0xe1, 0x6b, 0xe7, 0xe2,
+	0xdf, 0xf9, 0xa7, 0xc1, 0xe1, 0xb4, 0x1e, 0xf9, 0x35, 0xd7, 0xf1, 0x31, 0x3c, 0x09, 0x46, 0xa9,
+	0xc6, 0x95, 0x10, 0x9b, 0x8b, 0x29, 0x54, 0x71, 0x84, 0x7e, 0xe4, 0xf3, 0xe7, 0x3f, 0x36, 0xc0,
+	0xdc, 0x9a, 0x5f, 0xdd, 0xc4, 0xc1, 0x66, 0xa4, 0x31, 0x6b, 0x38, 0x40, 0x15, 0x14, 0xa0, 0x50,
+	0x23, 0xeb, 0x60, 0xd0, 0xe6, 0x9f, 0xe8, 0x1c, 0xc3, 0xcb, 0xc7, 0x16, 0x38, 0x9b, 0x74, 0xeb,
+	0xf1, 0x7d, 0xb6, 0x10, 0x8e, 0x5b, 0xb9, 0xfc, 0xe6, 0x7b, 0x73, 0xfb, 0xfe, 0xfc, 0xde, 0xdc,
+	0x72, 0xd5, 0x0c, 0x76, 0xea, 0xdb, 0x0b, 0x65, 0xd7, 0xe6, 0x5b, 0x8a, 0xff, 0x33, 0xef, 0x57,
+	0x6e, 0x2f, 0x36, 0xd9, 0x2e, 0x0e, 0xee, 0xd6, 0xb0, 0x1f, 0x8d, 0x2d, 0x46, 0xa4, 0xe2, 0x8d,
+	0xd0, 0xd3, 0xde, 0x46, 0xc8, 0xe0, 0xed, 0xcd, 0xe2, 0xbd, 0x0c, 0x88, 0xf8, 0xd8, 0x80, 0x7c,
+	0x1e, 0x1c, 0x57, 0x43, 0x67, 0x42, 0xcc, 0xbf, 0x6a, 0x80, 0x09, 0xd2, 0x89, 0x2e, 0xbe, 0xb0,
+	0x45, 0xdd, 0x5d, 0x07, 0x7b, 0xad, 0xb7, 0x28, 0xed, 0x96, 0xe5, 0xac, 0x27, 0xcb, 0x19, 0xbc,
+	0x04, 0xfa, 0xe9, 0xde, 0x64, 0x7c, 0x0f, 0x2f, 0xcf, 0xc4, 0x32, 0xf6, 0x71, 0x24, 0xe3, 0x55,
+	0xd7, 0x74, 0x56, 0xfa, 0x88, 0x7c, 0x8b, 0xbc, 0x3b, 0x87, 0x44, 0x29, 0xe5, 0xff, 0x1f, 0x1c,
+	0x10, 0xb8, 0xe5, 0x8a, 0xf0, 0x3c, 0x18, 0xa7, 0xe6, 0xc0, 0x2f, 0x79, 0xb8, 0x8c, 0xcd, 0x06,
+	0xae, 0xf0, 0x65, 0x6c, 0x49, 0x62, 0x8c, 0x8d, 0x2b, 0xf2, 0x61, 0xf9, 0x77, 0x8c, 0x68, 0xfe,
+	0xeb, 0xf5, 0xe0, 0xbe, 0x14, 0x07, 0x3c, 0x0d, 0x46, 0x3c, 0x5c, 0xc1, 0xd8, 0xce, 0x98, 0xb0,
+	0x61, 0xf6, 0x9d, 0x2e, 0x74, 0x42, 0x6a, 0x8f, 0x01, 0x28, 0xa2, 0xe2, 0x62, 0x3b, 0x06, 0x80,
+	0xc7, 0x10, 0x96, 0x4c, 0x26, 0xb1, 0xbe, 0xe2, 0x10, 0xff, 0x72, 0xad, 0x92, 0xff, 0x01, 0xdb,
+	0x39, 0x37, 0x6b, 0x15, 0x14, 0xe0, 0x35, 0xd3, 0xb9, 0xe6, 0x04, 0xd8, 0xc3, 0x7e, 0x50, 0x44,
+	0x01, 0xbe, 0x57, 0x5b, 0xde, 0x96, 0x64, 0x66, 0xc0, 0x20, 0x31, 0x80, 0x1e, 0x0a, 0x30, 0x57,
+	0xf1, 0x01, 0xdb, 0x74, 0x08, 0x59, 0x89, 0x76, 0x2b, 0xd8, 0xe3, 0xda, 0x9d, 0xc4, 0x80, 0x9a,
+	0x5d, 0xc5, 0x80, 0x9a, 0x49, 0x0c, 0xa8, 0xa9, 0xc7, 0x90, 0x66, 0x8f, 0x63, 0xf8, 0xb1, 0x01,
+	0x8e, 0x46, 0x9d, 0x64, 0x00, 0x12, 0x96, 0xd6, 0x68, 0xdb, 0xd2, 0xb6, 0x0d, 0xc4, 0xc1, 0xbb,
+	0x09, 0x20, 0x0e, 0xde, 0xa5, 0x40, 0xd2, 0x96, 0x7a, 0x0e, 0x1c, 0x53, 0xf0, 0xc9, 0x91, 0xbc,
+	0x29, 0xae, 0xc6, 0xff, 0x24, 0x8f, 0xcf, 0xae, 0x80, 0xd1, 0x9d, 0xea, 0xbd, 0xba, 0x53, 0x3d,
+	0x83, 0x55, 0x5c, 0xb8, 0x0c, 0x12, 0x0e, 0xf7, 0x0f, 0x06, 0x98, 0x15, 0x35, 0x54, 0x38, 0x60,
+	0xbb, 0x82, 0x56, 0xea, 0x89, 0xf4, 0xb6, 0xef, 0x89, 0x64, 0x40, 0x9f, 0x48, 0x1a, 0x84, 0x04,
+	0x1e, 0x8e, 0xf9, 0x8f, 0x46, 0xb6, 0x4f, 0xe8, 0x12, 0x74, 0x05, 0xb4, 0xdc, 0x7b, 0xea, 0xed,
+	0xc0, 0x7b, 0xd2, 0xae, 0x75, 0x06, 0x92, 0x74, 0xad, 0x13, 0xce, 0x54, 0xd7, 0xd6, 0x3a, 0xe3,
+	0xf1, 0xf5, 0xb6, 0xef, 0xf1, 0xe9, 0xd7, 0x3a, 0x85, 0x47, 0xba, 0xd6, 0x49, 0xf7, 0xaf, 0x6b,
+	0x6b, 0x9d, 0xf5, 0x52, 0x7b, 0x3b, 0xf0, 0x52, 0xf5, 0x6b, 0x9d, 0x86, 0xc4, 0x71, 0x7f, 0xcf,
+	0xa0, 0x2e, 0xe9, 0x0d, 0xb7, 0x5a, 0xb5, 0x70, 0xc6, 0x71, 0xfa, 0xf4, 0xcf, 0x92, 0x69, 0x30,
+	0x80, 0x1d, 0xb4, 0x6d, 0xe1, 0x0a, 0xc5, 0x36, 0x58, 0x0c, 0x7f, 0x26, 0x8e, 0x92, 0x19, 0x30,
+	0x95, 0x61, 0x8a, 0x33, 0xfc, 0x7d, 0x23, 0xd5, 0x96, 0xf4, 0x6d, 0x3e, 0x2b, 0x8e, 0x73, 0x60,
+	0x3a, 0xcb, 0x15, 0x67, 0xf9, 0x0d, 0xb6, 0x9f, 0xae, 0xe0, 0x9a, 0xeb, 0x9b, 0x41, 0x78, 0x9a,
+	0x3c, 0x5b, 0x77, 0x2a, 0x7e, 0x57, 0x54, 0x8b, 0x78, 0x67, 0xb6, 0x5b, 0x77, 0x82, 0xf6, 0xbd,
+	0x33, 0xda, 0x5d, 0xb1, 0x8f, 0xe4, 0x38, 0x38, 0xd6, 0xdf, 0xb1, 0x7d, 0x14, 0x1e, 0x23, 0x0f,
+	0x30, 0x58, 0xb6, 0x7b, 0x14, 0x40, 0x92, 0x68, 0xb9, 0x44, 0x36, 0x3c, 0xd3, 0x29, 0x9b, 0x35,
+	0x64, 0x3d, 0xb0, 0x68, 0x15, 0x40, 0x62, 0x97, 0x47, 0x14, 0xc9, 0x83, 0x0c, 0xf7, 0x24, 0x38,
+	0xa1, 0x41, 0xc2, 0xf1, 0x7e, 0x93, 0xe1, 0xbd, 0xda, 0xac, 0xe1, 0x8a, 0x19, 0xe0, 0x0d, 0xec,
+	0x54, 0x4c, 0x27, 0x1d, 0x4f, 0xdd, 0x2b, 0xde, 0x64, 0xc0, 0xd2, 0x93, 0x0a, 0x58, 0x14, 0x0c,
+	0xab, 0x58, 0xe1, 0x0c, 0xff, 0xdc, 0x00, 0x93, 0x6b, 0x7e, 0x75, 0x03, 0xd5, 0xfd, 0xe4, 0x35,
+	0xd5, 0x9e, 0x2e, 0xca, 0x61, 0xd0, 0xef, 0x61, 0xe4, 0xbb, 0x0e, 0xf7, 0xa9, 0xf9, 0x2f, 0x38,
+	0x09, 0xf6, 0xdf, 0x72, 0xbd, 0x32, 0xa6, 0x41, 0xdd, 0x60, 0x91, 0xfd, 0xc8, 0x00, 0x9b, 0xa2,
+	0x57, 0x6b, 0x22, 0xcb, 0x1c, 0xcc, 0x57, 0xd9, 0xc9, 0x74, 0xd3, 0xa9, 0x75, 0x15, 0x4e, 0x86,
+	0x41, 0x76, 0x16, 0x25, 0xd9, 0xe0, 0x2c, 0xfe, 0xc6, 0x00, 0x39, 0x76, 0x29, 0xb1, 0xe2, 0x99,
+	0x95, 0x2a, 0xe6, 0x53, 0xec, 0xe9, 0x71, 0xf4, 0x0c, 0x18, 0xdb, 0xa6, 0xc4, 0x92, 0x37, 0x27,
+	0x9a, 0xd9, 0x47, 0xb7, 0x45, 0xe6, 0x12, 0xa7, 0xd6, 0x31, 0x70, 0x44, 0xca, 0xbf, 0xcc, 0x39,
+	0x60, 0x5d, 0xee, 0x33, 0xe7, 0x20, 0x64, 0x8a, 0x33, 0xfc, 0x2b, 0xb6, 0x20, 0xec, 0xeb, 0x9a,
+	0xe9, 0xb0, 0xcb, 0xa2, 0x68, 0x41, 0xce, 0x83, 0x7e, 0x26, 0x8b, 0x96, 0x5c, 0xf3, 0x7e, 0x6d,
+	0x5b, 0x25, 0x76, 0xf5, 0xd2, 0xb6, 0x55, 0x62, 0xdd, 0x2f, 0x0f, 0x13, 0x54, 0x9c, 0x14, 0x5f,
+	0x8b, 0x2c, 0xeb, 0x32, 0x68, 0x2b, 0x75, 0xcf, 0x79, 0x40, 0xa1, 0x89, 0xac, 0x73, 0x68, 0xbf,
+	0x36, 0xc0, 0x0c, 0x53, 0x43, 0x7a, 0xb1, 0xbd, 0x86, 0x1c, 0x54, 0xc5, 0xde, 0x9e, 0x6a, 0xda,
+	0xd3, 0x60, 0x94, 0xde, 0x40, 0x95, 0x6c, 0x46, 0xac, 0xe5, 0x26, 0x1a, 0x41, 0x02, 0x6b, 0x09,
+	0x75, 0x3c, 0x1a, 0xda, 0x80, 0x24, 0xf3, 0x1c, 0xdb, 0x2b, 0xdc, 0x8a, 0x51, 0x27, 0x7c, 0x83,
+	0xbe, 0x39, 0x7c, 0x52, 0x2b, 0x76, 0x09, 0xf4, 0xb3, 0xc7, 0x0b, 0x8a, 0x8c, 0xac, 0x49, 0xe6,
+	0xe9, 0x65, 0x81, 0x51, 0x0a, 0xd7, 0x84, 0x75, 0x57, 0x59, 0xb6, 0x04, 0x6b, 0x9c, 0xed, 0xd7,
+	0xc4, 0x70, 0x88, 0x1a, 0xbd, 0xc2, 0xcd, 0xb5, 0x67, 0x31, 0x5e, 0x31, 0x6b, 0xdd, 0x39, 0xe9,
+	0x8f, 0x83, 0x11, 0x54, 0xb7, 0x4b, 0xb7, 0x30, 0x2e, 0x6d, 0x9b, 0x35, 0xa6, 0x7e, 0xa3, 0x45,
+	0x80, 0xea, 0x36, 0xe7, 0x42, 0x1b, 0xed, 0x64, 0x38, 0xe6, 0xb0, 0x7e, 0xd2, 0x43, 0xdd, 0x74,
+	0xa1, 0xd3, 0x7a, 0x61, 0x4b, 0xd8, 0x42, 0xbe, 0x59, 0x6d, 0xe7, 0x6a, 0x94, 0xf7, 0x6b, 0x0f,
+	0xc9, 0x24, 0xd8, 0xcf, 0xee, 0x36, 0xd9, 0xe9, 0xc8, 0x7e, 0xc0, 0x8b, 0x60, 0x7f, 0xcd, 0x33,
+	0xf9, 0xe1, 0xd8, 0xc6, 0xbe, 0x62, 0xbd, 0xe1, 0x2a, 0xe8, 0x6f, 0xb8, 0x56, 0xdd, 0xc6, 0xf4,
+	0xe1, 0x66, 0x68, 0xe5, 0x11, 0x7e, 0x79, 0x7f, 0x88, 0x0d, 0xf7, 0x2b, 0xb7, 0x17, 0x4c, 0x77,
+	0xd1, 0x46, 0xc1, 0x0e, 0x09, 0x14, 0xdf, 0x7e, 0x7d, 0x1e, 0xc4, 0x61, 0x63, 0x91, 0x0f, 0x25,
+	0x07, 0xb6, 0xef, 0xd6, 0xc9, 0xc9, 0xdc, 0xcf, 0x0e, 0x6c, 0xf6, 0x8b, 0xef, 0x59, 0x86, 0x2d,
+	0xff, 0x2c, 0xdd, 0x93, 0x69, 0x49, 0xf1, 0xdb, 0xd6, 0xb3, 0x60, 0xa2, 0xc6, 0x5c, 0x90, 0x12,
+	0xaa, 0x11, 0xed, 0x43, 0x16, 0x15, 0xda, 0x60, 0x71, 0x9c, 0x7f, 0x2f, 0xf0, 0xcf, 0x24, 0x5e,
+	0x23, 0x22, 0x2f, 0x62, 0xdb, 0x6d, 0x7c, 0xa6, 0x22, 0x4f, 0xc2, 0x3b, 0x42, 0xe1, 0xa5, 0xb9,
+	0xe2, 0x6a, 0xf2, 0x5b, 0xf1, 0x96, 0x72, 0xbd, 0xb0, 0x55, 0x08, 0x95, 0x6c, 0x8f, 0xf9, 0x7e,
+	0x1a, 0x8c, 0x3a, 0x78, 0xb7, 0x14, 0xef, 0xaa, 0x96, 0x56, 0xc9, 0xc1, 0xbb, 0x11, 0x73, 0x49,
+	0x80, 0xe2, 0x05, 0x66, 0x12, 0x02, 0x07, 0xf9, 0xae, 0x41, 0x3d, 0xaf, 0x42, 0xb9, 0x8c, 0x6b,
+	0xcc, 0x76, 0x75, 0x65, 0x63, 0x9f, 0x8f, 0x94, 0xaf, 0xb7, 0xa5, 0xe8, 0x68, 0x3f, 0x38, 0x07,
+	0x86, 0x71, 0x33, 0xc0, 0x9e, 0x83, 0x2c, 0xe2, 0x2a, 0xf7, 0xb1, 0x37, 0xd3, 0xf0, 0x93, 0xc4,
+	0x57, 0x9e, 0xa6, 0x26, 0x37, 0x01, 0x2c, 0x89, 0xb9, 0x88, 0xbf, 0x80, 0xcb, 0x9f, 0x47, 0xcc,
+	0x09, 0x60, 0x1c, 0xf3, 0xbf, 0x0c, 0x1a, 0x3a, 0x44, 0x9a, 0xb0, 0x19, 0x20, 0x0b, 0x3b, 0xd8,
+	0xf7, 0x5f, 0x30, 0x6d, 0xb3, 0x3b, 0xf8, 0xe7, 0xc1, 0x41, 0x1b, 0x35, 0x4b, 0x0e, 0x6a, 0x94,
+	0x50, 0x15, 0xa7, 0x6e, 0xa9, 0x27, 0x6c, 0xd4, 0x5c, 0x47, 0x8d, 0x42, 0x15, 0x87, 0xaf, 0xce,
+	0x17, 0xc0, 0x14, 0xaa, 0x07, 0x6e, 0x89, 0x7a, 0xdc, 0x25, 0xd7, 0x29, 0xf9, 0x84, 0x65, 0x32,
+	0x9c, 0x87, 0x12, 0x07, 0x49, 0x33, 0x0d, 0x19, 0xae, 0x3b, 0x14, 0xce, 0x3a, 0x6a, 0x64, 0x24,
+	0x72, 0x0a, 0xe4, 0x75, 0xb0, 0xb9, 0x74, 0x3e, 0x16, 0xef, 0x3a, 0xd7, 0x0b, 0x5b, 0xab, 0x3b,
+	0xc8, 0xa9, 0xe2, 0xcf, 0x44, 0x34, 0x65, 0x4a, 0x5a, 0x3c, 0xee, 0xb8, 0x68, 0x18, 0x4f, 0xe4,
+	0x20, 0x83, 0xff, 0x05, 0x46, 0xa8, 0x14, 0xa9, 0x75, 0xc5, 0x1e, 0x7f, 0x2f, 0x53, 0xb3, 0x33,
+	0xec, 0xa0, 0x46, 0x81, 0x77, 0xd6, 0x5e, 0x8b, 0xa6, 0xa1, 0x73, 0xf1, 0x7c, 0xbd, 0x07, 0x9c,
+	0x8c, 0xfa, 0x6c, 0xe2, 0x20, 0xb0, 0xb0, 0x8d, 0x9d, 0xe0, 0x86, 0x6b, 0x61, 0x0f, 0x39, 0xe5,
+	0xee, 0x5c, 0x8d, 0x5e, 0x06, 0x33, 0x7e, 0x44, 0xba, 0x14, 0x84, 0xb4, 0x45, 0x49, 0x4d, 0xf9,
+	0x59, 0xde, 0xa8, 0xc0, 0x2e, 0x81, 0xe9, 0x3a, 0x65, 0x9e, 0x8a, 0x98, 0xe8, 0x52, 0xd4, 0x91,
+	0x2b, 0xd3, 0x21, 0xd6, 0xbe, 0x8e, 0x1a, 0xd7, 0x9d, 0x18, 0x61, 0x46, 0x58, 0x0f, 0x81, 0x53,
+	0x7a, 0x41, 0x70, 0x89, 0xfd, 0x90, 0x9d, 0x1d, 0x5c, 0xe8, 0xeb, 0x85, 0xad, 0x0d, 0xcf, 0xad,
+	0xb9, 0x3e, 0xb2, 0x42, 0x51, 0x5d, 0x00, 0x83, 0xd1, 0xf2, 0xb5, 0x92, 0x54, 0xd4, 0xf3, 0x93,
+	0x9c, 0x7b, 0xa3, 0x04, 0x49, 0x34, 0x13, 0x3f, 0x18, 0x64, 0xfc, 0x71, 0x04, 0xff, 0xee, 0xa1,
+	0x1e, 0x6d, 0xbc, 0x73, 0xa8, 0x49, 0xea, 0x8e, 0xdb, 0xf7, 0x24, 0x20, 0x1a, 0x5b, 0x62, 0x56,
+	0x90, 0x2c, 0x6e, 0xaf, 0x76, 0x7a, 0xe0, 0xa0, 0x06, 0x67, 0x0f, 0x9e, 0x03, 0x07, 0xe2, 0xa1,
+	0xa5, 0x3b, 0x75, 0xd7, 0xab, 0xb3, 0xf7, 0xe4, 0xd1, 0xe2, 0x78, 0xd4, 0xed, 0x45, 0xfa, 0x19,
+	0xfe, 0x37, 0x18, 0x67, 0xc6, 0xa8, 0xea, 0xe1, 0x2a, 0x0a, 0x4c, 0xd7, 0xa1, 0xfe, 0xd4, 0xd8,
+	0xf2, 0x09, 0x89, 0x2f, 0x4d, 0x4e, 0xcf, 0xb8, 0x63, 0x71, 0x8c, 0x6c, 0xa9, 0xf8, 0x37, 0xbc,
+	0x0c, 0x72, 0x02, 0x5d, 0xb2, 0x99, 0x45, 0x1b, 0xd7, 0xcf, 0x5e, 0xe2, 0x22, 0x06, 0xd6, 0x50,
+	0x33, 0xb6, 0x74, 0x19, 0x25, 0x63, 0x81, 0x52, 0x56, 0xf2, 0x7c, 0x65, 0xbe, 0xdb, 0xc3, 0x02,
+	0xa5, 0xfa, 0xb6, 0x6d, 0x06, 0xac, 0x31, 0xe5, 0x4c, 0xb1, 0x53, 0xc6, 0x68, 0xf3, 0x94, 0x79,
+	0x30, 0xfd, 0xd7, 0xd0, 0xcf, 0xa1, 0x18, 0xc2, 0xf0, 0x2b, 0x2d, 0x12, 0x2e, 0xb1, 0x5f, 0x1a,
+	0x60, 0x68, 0xbd, 0xb0, 0xc5, 0x24, 0x1a, 0x43, 0x31, 0xa4, 0x50, 0x7a, 0xee, 0x11, 0x4a, 0xef,
+	0xa7, 0xe1, 0x8a, 0xf7, 0x89, 0xae, 0x38, 0xf1, 0x40, 0xc9, 0x2e, 0x5d, 0x41, 0x41, 0x79, 0xa7,
+	0xab, 0xd1, 0xca, 0x53, 0x60, 0x80, 0xd9, 0x43, 0xb6, 0xf9, 0x86, 0x97, 0x8f, 0xca, 0x77, 0x04,
+	0x63, 0x8a, 0x4b, 0x24, 0x1c, 0x92, 0xf4, 0x40, 0x9f, 0xa3, 0x27, 0xab, 0x14, 0x02, 0x0f, 0x23,
+	0x4e, 0x83, 0xb1, 0x30, 0x8c, 0xa0, 0x4b, 0xe1, 0x4f, 0x1b, 0x64, 0xc3, 0x17, 0x47, 0xf9, 0x57,
+	0x9a, 0xfc, 0xe1, 0xe7, 0xdf, 0x11, 0xdd, 0xf1, 0x0d, 0x22, 0xfc, 0x6e, 0x9a, 0xa4, 0xe7, 0xc0,
+	0x28, 0x5d, 0xf0, 0x84, 0x51, 0x1a, 0x5b, 0xce, 0xcb, 0xa2, 0xee, 0x98, 0xb7, 0x1b, 0x77, 0x6b,
+	0xb8, 0x38, 0x52, 0x13, 0x98, 0xd5, 0xa6, 0x18, 0x24, 0x51, 0xc5, 0xf7, 0x22, 0x31, 0x6e, 0xea,
+	0xd4, 0x3d, 0x8f, 0x4c, 0xaf, 0x1c, 0xdf, 0x3d, 0x77, 0x35, 0x62, 0x3d, 0x01, 0x46, 0x76, 0x18,
+	0x79, 0x76, 0xf0, 0x32, 0xd3, 0x3a, 0xcc, 0xbf, 0xd1, 0x90, 0x5c, 0x19, 0x80, 0x24, 0x99, 0xe7,
+	0xf0, 0x2a, 0x60, 0x9c, 0x7e, 0xdf, 0x60, 0xb9, 0x8a, 0x45, 0x7c, 0xeb, 0x1e, 0x4c, 0x58, 0xca,
+	0x51, 0xee, 0x49, 0x3b, 0xca, 0xf9, 0x8f, 0x0c, 0x76, 0xf9, 0x44, 0xd4, 0x50, 0x08, 0x09, 0xba,
+	0xa3, 0x3b, 0x57, 0xc0, 0x20, 0xcf, 0xc4, 0x0c, 0xb7, 0x93, 0x4c, 0x6d, 0x52, 0x52, 0xe0, 0x9b,
+	0x2a, 0x1a, 0x49, 0x30, 0x6e, 0x63, 0x3f, 0x28, 0xe1, 0x5b, 0xb7, 0x5c, 0x2f, 0x74, 0x5b, 0x00,
+	0xf9, 0x74, 0x95, 0x7e, 0xc9, 0x68, 0xd6, 0x2b, 0x06, 0x80, 0xc9, 0x49, 0xfd, 0xba, 0x15, 0xec,
+	0x81, 0x74, 0x61, 0x0e, 0x0c, 0x22, 0x2a, 0xd4, 0xe8, 0xca, 0x36, 0xfa, 0x4d, 0xd4, 0x08, 0x7b,
+	0x9e, 0xcb, 0x9d, 0xd4, 0x22, 0xfb, 0x91, 0xc7, 0x54, 0xa7, 0x25, 0xcb, 0xc1, 0x6d, 0xc2, 0x55,
+	0x30, 0xe0, 0x51, 0x76, 0x99, 0x31, 0x18, 0x5e, 0x3e, 0xdd, 0x52, 0x62, 0xa4, 0x77, 0x68, 0x89,
+	0xf8, 0xd8, 0xfc, 0x47, 0xbd, 0x94, 0x0e, 0x4b, 0xb5, 0x4c, 0x76, 0xef, 0x4e, 0xc0, 0x17, 0x20,
+	0xaf, 0x1a, 0xe6, 0xee, 0xea, 0x24, 0xcd, 0xfa, 0xc1, 0x1a, 0x18, 0xe5, 0x1e, 0x04, 0x7f, 0xe0,
+	0xea, 0xa3, 0xe0, 0x35, 0x87, 0xd1, 0x79, 0x02, 0xf8, 0xa7, 0x7f, 0x99, 0x3b, 0xa3, 0xcd, 0xdb,
+	0x64, 0x09, 0x9b, 0x64, 0x80, 0x5f, 0x1c, 0x61, 0x14, 0x0a, 0x94, 0x00, 0xa1, 0xc8, 0x68, 0x87,
+	0x14, 0xf7, 0xef, 0x01, 0x45, 0x46, 0x81, 0x53, 0x4c, 0x69, 0x53, 0x7f, 0x46, 0x9b, 0xe6, 0x01,
+	0xc4, 0xcd, 0x9a, 0xe9, 0x51, 0xc7, 0x2a, 0x72, 0xa1, 0x06, 0xa8, 0x0b, 0x75, 0x20, 0x6e, 0x51,
+	0x79, 0x4f, 0x2f, 0x52, 0x8b, 0x23, 0x5b, 0x72, 0xae, 0x5b, 0x1d, 0x6f, 0x80, 0xfc, 0xab, 0xcc,
+	0x04, 0xaf, 0x12, 0x17, 0xdf, 0xea, 0xba, 0x1a, 0xa5, 0x04, 0xd6, 0xdb, 0xf2, 0x16, 0x80, 0xd9,
+	0x5c, 0x19, 0xb7, 0xdc, 0xe6, 0xfe, 0x88, 0xe1, 0xb9, 0x4a, 0x64, 0x29, 0xdd, 0x16, 0x7b, 0x74,
+	0xa4, 0xb4, 0x44, 0x22, 0x39, 0x3a, 0x64, 0x4c, 0x72, 0x18, 0xef, 0x88, 0x77, 0x1a, 0x85, 0xf2,
+	0x9d, 0xba, 0xe9, 0x9b, 0x44, 0x33, 0x36, 0x5c, 0xcb, 0x2c, 0x77, 0x27, 0xfd, 0x6e, 0x05, 0xf4,
+	0xd7, 0x28, 0x35, 0xfe, 0x32, 0x72, 0x4a, 0x66, 0xa6, 0xd2, 0x9c, 0x45, 0x17, 0xf2, 0xf4, 0x97,
+	0xf6, 0xca, 0x42, 0x82, 0x8a, 0x83, 0xff, 0x96, 0x18, 0x93, 0xaf, 0xba, 0x4e, 0x19, 0x3b, 0x01,
+	0xdb, 0x18, 0x34, 0x76, 0xf7, 0xbb, 0x94, 0xa3, 0x75, 0x94, 0x86, 0x3a, 0xf4, 0x11, 0xa5, 0x2c,
+	0x32, 0x21, 0x86, 0xe5, 0x33, 0x36, 0x6a, 0xd2, 0x05, 0x4c, 0xb0, 0x49, 0x03, 0xf3, 0x8b, 0x60,
+	0x8a, 0x5e, 0x7c, 0xb8, 0x4e, 0x49, 0x28, 0x78, 0x10, 0x3c, 0x8b, 0x49, 0x1b, 0x35, 0xd7, 0x5d,
+	0xe7, 0x66, 0xd4, 0x28, 0xbd, 0xf5, 0x17, 0xc3, 0x72, 0xa9, 0x2c, 0xe2, 0xe7, 0xb3, 0xa3, 0x62,
+	0xe2, 0xdb, 0x0b, 0xe6, 0x9d, 0xba, 0x59, 0x11, 0xae, 0x74, 0xf7, 0x54, 0x5a, 0x8f, 0xb2, 0x44,
+	0x3e, 0x2b, 0x24, 0x9a, 0xbc, 0xe4, 0x11, 0xb8, 0x91, 0x62, 0x14, 0x3d, 0xa9, 0x24, 0xeb, 0xb1,
+	0x46, 0x50, 0x2b, 0xe5, 0x5a, 0x56, 0x78, 0xfd, 0x77, 0xcd, 0x29, 0xbb, 0x76, 0x77, 0xae, 0x67,
+	0x4e, 0x80, 0x91, 0xf0, 0xe8, 0x12, 0xca, 0x55, 0x86, 0xf9, 0x61, 0x43, 0x4b, 0x55, 0xe2, 0xbc,
+	0x8d, 0xbe, 0x8e, 0xf2, 0x36, 0xc8, 0xdc, 0x81, 0x5b, 0xaa, 0x85, 0xf9, 0x19, 0x34, 0x6a, 0x1c,
+	0x2c, 0x0e, 0x07, 0x6e, 0x94, 0xb2, 0xa1, 0xb2, 0x81, 0x12, 0x59, 0x70, 0x69, 0xfd, 0xcd, 0x00,
+	0x07, 0xd7, 0xfc, 0xea, 0x73, 0x1e, 0x72, 0x82, 0xa2, 0x6b, 0xe1, 0x2e, 0x79, 0x04, 0x7d, 0x9e,
+	0x6b, 0xb1, 0x58, 0x71, 0x4c, 0x1a, 0x54, 0xb1, 0xd4, 0x02, 0xc2, 0x0f, 0xed, 0x09, 0x97, 0xc1,
+	0x40, 0x38, 0x61, 0xab, 0x5b, 0xbe, 0xb0, 0x63, 0x46, 0x16, 0x87, 0x69, 0x3e, 0x88, 0x80, 0x94,
+	0x8b, 0xe0, 0xef, 0x2c, 0x51, 0xa4, 0x88, 0x1b, 0xee, 0x6d, 0xfc, 0x39, 0x97, 0xc1, 0x14, 0xbf,
+	0xf2, 0x8f, 0xa1, 0x72, 0x21, 0xfc, 0x93, 0x5d, 0xfd, 0xb2, 0xfb, 0x2f, 0x5c, 0xa8, 0xd8, 0xa6,
+	0x73, 0xc3, 0x43, 0x8e, 0x7f, 0x2b, 0x7e, 0x7b, 0xde, 0x53, 0x71, 0x5c, 0x04, 0x43, 0xf4, 0xb5,
+	0x87, 0x3e, 0x6e, 0xb7, 0xf2, 0x13, 0x07, 0x1d, 0xbc, 0x4b, 0x59, 0x54, 0x38, 0x49, 0x7d, 0xed,
+	0x3a, 0x49, 0xec, 0xd2, 0x57, 0x0e, 0x9a, 0x0b, 0xe6, 0xdb, 0xec, 0xf2, 0x81, 0xbb, 0xe7, 0x32,
+	0xb9, 0x24, 0x58, 0x37, 0xda, 0x66, 0xbd, 0x83, 0xfc, 0x9b, 0x68, 0xfa, 0xfc, 0x71, 0xba, 0x4a,
+	0x52, 0x66, 0x38, 0xbf, 0x2f, 0x1b, 0xa2, 0xdb, 0xd3, 0xed, 0x75, 0xcc, 0x48, 0x98, 0x31, 0x2c,
+	0xe5, 0x26, 0x7e, 0x5f, 0x3c, 0x42, 0x75, 0xb2, 0xec, 0x36, 0xb0, 0xc7, 0x1e, 0xab, 0x49, 0xc7,
+	0xfb, 0x58, 0xed, 0x32, 0x28, 0x67, 0xe9, 0x91, 0x23, 0x81, 0xc0, 0x31, 0xfe, 0xc2, 0x10, 0x9e,
+	0xda, 0x6f, 0x98, 0x36, 0xb6, 0xdc, 0xf2, 0xed, 0xae, 0x00, 0x3c, 0x0b, 0x26, 0x02, 0x4e, 0x2f,
+	0xf5, 0xd4, 0x34, 0x1e, 0x7e, 0x57, 0x6d, 0x8e, 0x23, 0xc2, 0xa3, 0x77, 0xcc, 0x33, 0x47, 0xf4,
+	0x33, 0x96, 0x13, 0xc1, 0x16, 0x76, 0xb3, 0xbc, 0x83, 0x2b, 0x75, 0x0b, 0x57, 0x0a, 0x65, 0x7a,
+	0x2b, 0xdc, 0x0d, 0x60, 0x47, 0xc0, 0x10, 0xa2, 0xd4, 0x42, 0x17, 0xba, 0x8f, 0x44, 0xdb, 0xe4,
+	0x83, 0x24, 0x14, 0x60, 0xe9, 0x10, 0x0a, 0x66, 0x65, 0x6e, 0xf4, 0x55, 0xcb, 0xac, 0x9a, 0xdb,
+	0xa6, 0x65, 0x06, 0x77, 0xef, 0x33, 0x37, 0x3a, 0xc3, 0x59, 0x07, 0x6e, 0xb4, 0x04, 0x55, 0xac,
+	0xa0, 0xf1, 0x62, 0x17, 0x2c, 0xcb, 0xdd, 0xb5, 0x4c, 0xbf, 0x3b, 0x71, 0xdd, 0x04, 0xe8, 0x45,
+	0x95, 0x0a, 0x7b, 0xdd, 0x28, 0x92, 0xff, 0xb2, 0x1c, 0x4a, 0xdb, 0x6d, 0x60, 0x1a, 0xf7, 0xd3,
+	0x1c, 0x4a, 0xf2, 0x2b, 0x03, 0xed, 0xa8, 0xf0, 0x34, 0x23, 0xf0, 0xcc, 0x21, 0x7d, 0xa3, 0x47,
+	0x78, 0x3f, 0xd8, 0x70, 0x59, 0xf4, 0xb0, 0x8a, 0x6a, 0x5d, 0x5a, 0x49, 0x5a, 0xc0, 0x50, 0xe3,
+	0x64, 0xdb, 0x28, 0x60, 0x98, 0xb0, 0x51, 0x33, 0xe4, 0x92, 0xd5, 0xd9, 0x3e, 0x01, 0x00, 0x6e,
+	0x62, 0xbb, 0x46, 0xbe, 0xf8, 0x4c, 0x14, 0xba, 0xd7, 0x9f, 0xb8, 0xaf, 0xc2, 0x3c, 0x49, 0x24,
+	0xc1, 0x45, 0xf5, 0x7b, 0x43, 0x74, 0xaa, 0x51, 0xf3, 0x86, 0x1b, 0x20, 0xab, 0x7b, 0xd5, 0x1e,
+	0x4f, 0x81, 0x71, 0x22, 0xac, 0x80, 0x50, 0x6d, 0x43, 0x52, 0xa3, 0xb6, 0xc8, 0xa1, 0xe2, 0xc4,
+	0x91, 0x62, 0xe1, 0x70, 0xff, 0x21, 0x3e, 0x73, 0xaf, 0x9a, 0x5e, 0xb9, 0x6e, 0x06, 0x2b, 0x1e,
+	0x46, 0xb7, 0x3f, 0xf9, 0x19, 0xb9, 0x01, 0xc6, 0xcb, 0x6c, 0xc2, 0xd2, 0x36, 0x9b, 0x91, 0x3f,
+	0xa4, 0xc8, 0xde, 0xd2, 0x92, 0xa4, 0xc3, 0xc2, 0xd5, 0x72, 0xe2, 0x2b, 0xbc, 0x00, 0x06, 0xab,
+	0x75, 0xe4, 0x55, 0x4c, 0xd4, 0xc6, 0x01, 0x15, 0xf6, 0xd4, 0xbe, 0x6e, 0xa7, 0x11, 0x33, 0xa9,
+	0x2c, 0xbf, 0x7b, 0x19, 0xf4, 0xae, 0xf9, 0x55, 0xb8, 0x0d, 0x86, 0x85, 0x9a, 0x6c, 0x78, 0x46,
+	0xc2, 0xb8, 0xb4, 0xfc, 0x3f, 0x77, 0xb6, 0x8d, 0x9e, 0xfc, 0xee, 0xe9, 0x25, 0x03, 0x1c, 0x92,
+	0x56, 0x2f, 0xc3, 0x65, 0xf9, 0x24, 0xba, 0x2a, 0xef, 0xdc, 0x63, 0x1d, 0x8d, 0xe1, 0x2c, 0x6c,
+	0x82, 0x7e, 0x56, 0x4c, 0x03, 0x4f, 0x2a, 0x86, 0x8b, 0xf5, 0x3f, 0xb9, 0x53, 0xfa, 0x4e, 0x7c,
+	0xd2, 0x2d, 0x30, 0xc0, 0xd3, 0xd0, 0xa1, 0x66, 0x40, 0x9c, 0x30, 0x9f, 0x3b, 0xdd, 0xa2, 0x97,
+	0x20, 0x2f, 0x69, 0x3d, 0xac, 0x4a, 0x5e, 0xba, 0xda, 0x5e, 0x95, 0xbc, 0xb4, 0x05, 0xb7, 0x22,
+	0x0b, 0xc9, 0x72, 0xd6, 0x16, 0x2c, 0x48, 0x4b, 0x73, 0x5b, 0xb0, 0x20, 0xaf, 0x97, 0x85, 0xbb,
+	0x00, 0x66, 0x6b, 0x50, 0xe1, 0xa2, 0x6e, 0x2a, 0x19, 0xed, 0xf3, 0xed, 0x0f, 0xc8, 0x60, 0x4f,
+	0x55, 0x84, 0xea, 0xb1, 0xcb, 0x0b, 0x61, 0xf5, 0xd8, 0x15, 0x25, 0xa7, 0xf0, 0x4b, 0x60, 0x52,
+	0x56, 0x9e, 0x09, 0x97, 0x5a, 0xac, 0x65, 0xb6, 0x5c, 0x31, 0xb7, 0xdc, 0xc9, 0x10, 0x99, 0x02,
+	0x26, 0xfe, 0x1a, 0x44, 0x3b, 0xb3, 0xa5, 0x6a, 0x07, 0x5b, 0x2a, 0xa0, 0xac, 0x38, 0x4f, 0x90,
+	0x40, 0xf2, 0x2f, 0x5a, 0x2c, 0xb5, 0x50, 0xa5, 0x8e, 0x25, 0x20, 0xad, 0x89, 0x4c, 0xea, 0x7f,
+	0x07, 0x12, 0x90, 0x56, 0x4f, 0xb6, 0xd4, 0x7f, 0xa9, 0x04, 0x30, 0x18, 0x11, 0xab, 0x00, 0xa1,
+	0xc2, 0xe0, 0x4a, 0xca, 0x17, 0x73, 0xe7, 0xda, 0xe9, 0xca, 0xc9, 0xec, 0x80, 0xd1, 0x44, 0xe9,
+	0x1e, 0x6c, 0x39, 0x58, 0x30, 0x68, 0x8f, 0xb4, 0xd5, 0x37, 0x5e, 0x52, 0x59, 0xfd, 0x9c, 0x6a,
+	0x49, 0x35, 0x35, 0x83, 0xaa, 0x25, 0xd5, 0x95, 0xe7, 0xd1, 0x25, 0x95, 0x96, 0xb4, 0xa9, 0x96,
+	0x54, 0x57, 0xc8, 0xa7, 0x5a, 0x52, 0x6d, 0xcd, 0x1c, 0x65, 0x41, 0x5a, 0x67, 0x06, 0xf5, 0x80,
+	0xa4, 0xe5, 0x66, 0x2a, 0x16, 0xb4, 0x85, 0x6c, 0xf0, 0x2b, 0x06, 0x38, 0x2c, 0xaf, 0xfd, 0x82,
+	0x2d, 0x20, 0xc9, 0x99, 0xb8, 0xd0, 0xd9, 0x20, 0x81, 0x0b, 0x79, 0x41, 0x97, 0x8a, 0x0b, 0x6d,
+	0x25, 0x9a, 0x8a, 0x0b, 0x7d, 0xcd, 0x18, 0x2c, 0x01, 0x10, 0x17, 0x5f, 0xc1, 0x87, 0xe5, 0x73,
+	0x64, 0x2a, 0xca, 0x72, 0x67, 0x5a, 0x77, 0x8c, 0xb7, 0xb0, 0x58, 0x3c, 0xa5, 0xda, 0xc2, 0x92,
+	0x3a, 0x2f, 0xd5, 0x16, 0x96, 0xd5, 0x62, 0xc1, 0x3b, 0x60, 0x22, 0x5d, 0xc7, 0x04, 0xe7, 0x95,
+	0x5e, 0x92, 0xac, 0x5e, 0x2b, 0xb7, 0xd0, 0x6e, 0xf7, 0xb4, 0x71, 0x62, 0xcd, 0x7a, 0xe3, 0x94,
+	0x28, 0x9f, 0xd2, 0x1b, 0xa7, 0x64, 0x51, 0x13, 0x41, 0x96, 0xae, 0x0a, 0x52, 0x21, 0x53, 0x14,
+	0x3e, 0xa9, 0x90, 0xa9, 0x8a, 0x8d, 0x62, 0x92, 0x71, 0xb5, 0x8e, 0x9e, 0x64, 0xa6, 0x20, 0x49,
+	0x4f, 0x32, 0x5b, 0x04, 0x04, 0x1d, 0x30, 0x9e, 0xaa, 0xa1, 0x81, 0x8f, 0x2a, 0xd7, 0x43, 0x52,
+	0x27, 0x94, 0x9b, 0x6f, 0xb3, 0xb7, 0xa0, 0x96, 0x42, 0xe5, 0x8b, 0x52, 0x2d, 0xb3, 0x85, 0x3b,
+	0x4a, 0xb5, 0x94, 0x14, 0xd2, 0x08, 0x67, 0x68, 0xaa, 0x26, 0x45, 0x7f, 0x86, 0xca, 0x4b, 0x6e,
+	0xf4, 0x67, 0xa8, 0xa2, 0xe8, 0x05, 0xde, 0x06, 0x63, 0xc9, 0xfc, 0x2b, 0xf8, 0x48, 0xcb, 0x69,
+	0xe2, 0x44, 0xb3, 0xdc, 0xa3, 0xed, 0x75, 0x8e, 0x89, 0x25, 0x8b, 0x2a, 0x54, 0xc4, 0xa4, 0x05,
+	0x21, 0x2a, 0x62, 0xf2, 0x3a, 0x8d, 0xd8, 0x3b, 0x16, 0x0b, 0x1c, 0xf4, 0xde, 0xb1, 0xa4, 0x9a,
+	0x43, 0xef, 0x1d, 0xcb, 0x6a, 0x27, 0x48, 0xc0, 0x28, 0x24, 0xaf, 0xa8, 0x02, 0xc6, 0x6c, 0x69,
+	0x85, 0x2a, 0x60, 0x94, 0xd4, 0x2a, 0x10, 0x1a, 0x42, 0x3a, 0xbf, 0x8a, 0x46, 0xb6, 0x94, 0x41,
+	0x45, 0x43, 0x52, 0x1b, 0x00, 0xbf, 0x66, 0x80, 0x29, 0x45, 0x86, 0x3c, 0xbc, 0xd0, 0x42, 0x2a,
+	0xd2, 0x3a, 0x82, 0xdc, 0xc5, 0x0e, 0x47, 0xa5, 0x3d, 0xdd, 0x64, 0x1e, 0xba, 0xde, 0xd3, 0x95,
+	0xa6, 0xeb, 0xeb, 0x3d, 0x5d, 0x79, 0x9a, 0x3b, 0x7c, 0xd9, 0x00, 0x33, 0xca, 0xd4, 0x6e, 0xf8,
+	0xb8, 0x6e, 0x46, 0x75, 0x52, 0x7c, 0xee, 0x52, 0xc7, 0xe3, 0x62, 0xbd, 0xce, 0xe6, 0x67, 0xab,
+	0xf4, 0x5a, 0x99, 0x69, 0xae, 0xd2, 0x6b, 0x75, 0xea, 0x37, 0xb1, 0xfb, 0xe9, 0xe4, 0x63, 0x95,
+	0xdd, 0x57, 0xa4, 0x87, 0xab, 0xec, 0xbe, 0x2a, 0xa7, 0x99, 0xda, 0xfd, 0x64, 0xf2, 0xae, 0xd2,
+	0xee, 0x4b, 0xd3, 0x9e, 0x95, 0x76, 0x5f, 0x9e, 0x11, 0x0c, 0xbf, 0x08, 0x0e, 0x4a, 0x52, 0x52,
+	0xa1, 0x42, 0x56, 0xea, 0x04, 0xdc, 0xdc, 0x52, 0x07, 0x23, 0xd2, 0xf6, 0x4a, 0x4c, 0xf7, 0xd4,
+	0xdb, 0x2b, 0x49, 0xba, 0xab, 0xde, 0x5e, 0xc9, 0x32, 0x49, 0x63, 0xc2, 0x62, 0x22, 0xa6, 0x9e,
+	0xb0, 0x24, 0xdf, 0x54, 0x4f, 0x58, 0x96, 0xe3, 0x09, 0x03, 0x70, 0x20, 0x93, 0xea, 0x07, 0x17,
+	0x34, 0x92, 0x93, 0xa4, 0x68, 0xe6, 0x16, 0xdb, 0xee, 0x1f, 0xc3, 0xcd, 0x66, 0x81, 0xa9, 0xe0,
+	0x2a, 0x53, 0x04, 0x55, 0x70, 0x35, 0x09, 0x66, 0x84, 0x70, 0x26, 0xf9, 0x4a, 0x49, 0x58, 0x95,
+	0x54, 0xa6, 0x24, 0xac, 0xcc, 0xeb, 0x22, 0x84, 0xb3, 0xe9, 0x52, 0x2a, 0xc2, 0xca, 0xec, 0x2f,
+	0x15, 0x61, 0x75, 0x26, 0x96, 0x70, 0x82, 0x64, 0x12, 0x96, 0xf4, 0x27, 0x88, 0x2a, 0x6b, 0x4b,
+	0x7f, 0x82, 0x28, 0xb3, 0xa2, 0x04, 0x13, 0x2e, 0x49, 0x03, 0xd2, 0x9b, 0x70, 0x75, 0x0e, 0x95,
+	0xde, 0x84, 0x6b, 0xf2, 0x8d, 0xe2, 0x1d, 0x27, 0x26, 0xec, 0xe8, 0x77, 0x9c, 0x24, 0x2b, 0x49,
+	0xbf, 0xe3, 0x64, 0xb9, 0x40, 0x54, 0x05, 0x33, 0xb9, 0x2f, 0x4a, 0x15, 0x54, 0x65, 0x0c, 0x29,
+	0x55, 0x50, 0x99, 0x56, 0x03, 0xff, 0x0f, 0x0c, 0x45, 0x89, 0x26, 0xf0, 0x21, 0xf9, 0xf0, 0x74,
+	0xce, 0x4d, 0xee, 0xe1, 0x96, 0xfd, 0xe2, 0x30, 0x35, 0x4e, 0xe1, 0x50, 0x85, 0xa9, 0x99, 0x7c,
+	0x96, 0xdc, 0x99, 0xd6, 0x1d, 0x63, 0x0f, 0x44, 0x96, 0x14, 0xa1, 0xf2, 0x40, 0x34, 0x59, 0x23,
+	0x2a, 0x0f, 0x44, 0x97, 0x73, 0x41, 0x8e, 0x25, 0x49, 0x8a, 0x83, 0xea, 0x58, 0x52, 0xa7, 0x66,
+	0xa8, 0x8e, 0x25, 0x4d, 0xfe, 0x04, 0xa1, 0x2d, 0xc9, 0x56, 0x80, 0x7a, 0x2b, 0xd4, 0x01, 0x6d,
+	0x4d, 0x2a, 0x04, 0x39, 0x20, 0x32, 0x39, 0x04, 0xaa, 0x03, 0x42, 0x95, 0x2f, 0xa1, 0x3a, 0x20,
+	0x94, 0xc9, 0x09, 0x71, 0x48, 0x14, 0x3e, 0xf2, 0xeb, 0x43, 0xa2, 0x54, 0xfa, 0x82, 0x3e, 0x24,
+	0x4a, 0xe7, 0x0d, 0xd0, 0x10, 0x50, 0xfa, 0x0e, 0xaf, 0x0a, 0x01, 0x75, 0x19, 0x06, 0xaa, 0x10,
+	0x50, 0xfb, 0xd0, 0x2f, 0x58, 0xe9, 0xcc, 0x7b, 0xb8, 0xde, 0x4a, 0xab, 0x92, 0x02, 0xf4, 0x56,
+	0x5a, 0xf9, 0xe8, 0x4e, 0xbc, 0xbd, 0xd4, 0xe3, 0x35, 0xd4, 0x0a, 0x33, 0xfd, 0x2e, 0x9f, 0x9b,
+	0x6f, 0xb3, 0x77, 0xac, 0x5e, 0x99, 0x37, 0x60, 0xa8, 0x75, 0x51, 0xb3, 0xcf, 0xe6, 0xb9, 0xc5,
+	0xb6, 0xfb, 0xc7, 0x1b, 0x4a, 0xf2, 0x18, 0x0b, 0xcf, 0xb7, 0xb8, 0x01, 0xcf, 0xbc, 0x41, 0xe7,
+	0x96, 0x3a, 0x18, 0x91, 0x8e, 0xa4, 0x92, 0x6f, 0x9e, 0xfa, 0x48, 0x4a, 0xfa, 0x22, 0xac, 0x8f,
+	0xa4, 0xe4, 0x4f, 0xaa, 0xb9, 0xfd, 0x2f, 0x7d, 0xf8, 0xda, 0x39, 0x63, 0xe5, 0xc9, 0x37, 0xdf,
+	0x9f, 0x35, 0xde, 0x7a, 0x7f, 0xd6, 0xf8, 0xeb, 0xfb, 0xb3, 0xc6, 0x77, 0x3e, 0x98, 0xdd, 0xf7,
+	0xd6, 0x07, 0xb3, 0xfb, 0xfe, 0xf4, 0xc1, 0xec, 0xbe, 0xff, 0x9d, 0x13, 0x8a, 0x07, 0x52, 0x7f,
+	0x75, 0x9d, 0x56, 0x0e, 0x6c, 0xf7, 0xd3, 0xbf, 0xb9, 0xfe, 0xd8, 0x7f, 0x02, 0x00, 0x00, 0xff,
+	0xff, 0xfa, 0x30, 0xf1, 0x10, 0x6d, 0x5e, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// MsgClient is the client API for Msg service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type MsgClient interface {
+	// CreateVault creates a new vault.
+	// Must be signed by the governance module account, so vaults can only be created
+	// through a passed governance proposal.
+	CreateVault(ctx context.Context, in *MsgCreateVaultRequest, opts ...grpc.CallOption) (*MsgCreateVaultResponse, error)
+	// SetShareDenomMetadata allows Denom Metadata (see bank module) to be set for the vault's share denom.
+	// Similar to marker's SetDenomMetadata, but scoped to a specific vault. Only the vault admin may call this.
+	SetShareDenomMetadata(ctx context.Context, in *MsgSetShareDenomMetadataRequest, opts ...grpc.CallOption) (*MsgSetShareDenomMetadataResponse, error)
+	// SwapIn exchanges underlying assets for vault shares by depositing them into a vault.
+	SwapIn(ctx context.Context, in *MsgSwapInRequest, opts ...grpc.CallOption) (*MsgSwapInResponse, error)
+	// SwapOut exchanges vault shares for underlying assets by withdrawing from a vault.
+	SwapOut(ctx context.Context, in *MsgSwapOutRequest, opts ...grpc.CallOption) (*MsgSwapOutResponse, error)
+	// UpdateMinInterestRate sets the minimum allowed annual interest rate for a vault.
+	UpdateMinInterestRate(ctx context.Context, in *MsgUpdateMinInterestRateRequest, opts ...grpc.CallOption) (*MsgUpdateMinInterestRateResponse, error)
+	// UpdateMaxInterestRate sets the maximum allowed annual interest rate for a vault.
+	UpdateMaxInterestRate(ctx context.Context, in *MsgUpdateMaxInterestRateRequest, opts ...grpc.CallOption) (*MsgUpdateMaxInterestRateResponse, error)
+	// UpdateInterestRate allows the interest admin to update the current annual interest rate within limits.
+	UpdateInterestRate(ctx context.Context, in *MsgUpdateInterestRateRequest, opts ...grpc.CallOption) (*MsgUpdateInterestRateResponse, error)
+	// UpdateWithdrawalDelay allows updating the withdrawal delay for a vault.
+	UpdateWithdrawalDelay(ctx context.Context, in *MsgUpdateWithdrawalDelayRequest, opts ...grpc.CallOption) (*MsgUpdateWithdrawalDelayResponse, error)
+	// UpdateMinSwapInValue sets the minimum allowed value for a swap-in operation.
+	UpdateMinSwapInValue(ctx context.Context, in *MsgUpdateMinSwapInValueRequest, opts ...grpc.CallOption) (*MsgUpdateMinSwapInValueResponse, error)
+	// UpdateMinSwapOutValue sets the minimum allowed value for a swap-out operation.
+	UpdateMinSwapOutValue(ctx context.Context, in *MsgUpdateMinSwapOutValueRequest, opts ...grpc.CallOption) (*MsgUpdateMinSwapOutValueResponse, error)
+	// UpdateMaxSwapInValue sets the maximum allowed value for a swap-in operation.
+	UpdateMaxSwapInValue(ctx context.Context, in *MsgUpdateMaxSwapInValueRequest, opts ...grpc.CallOption) (*MsgUpdateMaxSwapInValueResponse, error)
+	// UpdateMaxSwapOutValue sets the maximum allowed value for a swap-out operation.
+	UpdateMaxSwapOutValue(ctx context.Context, in *MsgUpdateMaxSwapOutValueRequest, opts ...grpc.CallOption) (*MsgUpdateMaxSwapOutValueResponse, error)
+	// ToggleSwapIn allows enabling or disabling swap-in operations for a vault.
+	ToggleSwapIn(ctx context.Context, in *MsgToggleSwapInRequest, opts ...grpc.CallOption) (*MsgToggleSwapInResponse, error)
+	// ToggleSwapOut allows enabling or disabling swap-out operations for a vault.
+	ToggleSwapOut(ctx context.Context, in *MsgToggleSwapOutRequest, opts ...grpc.CallOption) (*MsgToggleSwapOutResponse, error)
+	// DepositInterestFunds allows depositing funds into the vault for paying interest.
+	// Must be signed by a holder of the vault's reserve funder role.
+	DepositInterestFunds(ctx context.Context, in *MsgDepositInterestFundsRequest, opts ...grpc.CallOption) (*MsgDepositInterestFundsResponse, error)
+	// WithdrawInterestFunds allows withdrawing unused interest funds.
+	// Must be signed by a holder of the vault's reserve funder role.
+	WithdrawInterestFunds(ctx context.Context, in *MsgWithdrawInterestFundsRequest, opts ...grpc.CallOption) (*MsgWithdrawInterestFundsResponse, error)
+	// DepositPrincipalFunds allows depositing principal funds into a vault.
+	// Must be signed by a holder of the vault's reserve funder role.
+	DepositPrincipalFunds(ctx context.Context, in *MsgDepositPrincipalFundsRequest, opts ...grpc.CallOption) (*MsgDepositPrincipalFundsResponse, error)
+	// WithdrawPrincipalFunds allows withdrawing principal funds from a vault.
+	// Must be signed by a holder of the vault's reserve funder role.
+	WithdrawPrincipalFunds(ctx context.Context, in *MsgWithdrawPrincipalFundsRequest, opts ...grpc.CallOption) (*MsgWithdrawPrincipalFundsResponse, error)
+	// ExpeditePendingSwapOut expedites a pending swap out from a vault.
+	// Must be signed by a holder of the vault's limits manager role.
+	ExpeditePendingSwapOut(ctx context.Context, in *MsgExpeditePendingSwapOutRequest, opts ...grpc.CallOption) (*MsgExpeditePendingSwapOutResponse, error)
+	// PauseVault pauses user-facing swap operations for a vault and records a reason.
+	// Must be signed by a holder of the vault's pauser role.
+	PauseVault(ctx context.Context, in *MsgPauseVaultRequest, opts ...grpc.CallOption) (*MsgPauseVaultResponse, error)
+	// UnpauseVault re-enables user-facing swap operations for a vault.
+	// Must be signed by a holder of the vault's pauser role.
+	UnpauseVault(ctx context.Context, in *MsgUnpauseVaultRequest, opts ...grpc.CallOption) (*MsgUnpauseVaultResponse, error)
+	// SetBridgeAddress sets the single external bridge address allowed to mint or burn shares for a vault.
+	SetBridgeAddress(ctx context.Context, in *MsgSetBridgeAddressRequest, opts ...grpc.CallOption) (*MsgSetBridgeAddressResponse, error)
+	// ToggleBridge enables or disables the bridge functionality for a vault.
+	ToggleBridge(ctx context.Context, in *MsgToggleBridgeRequest, opts ...grpc.CallOption) (*MsgToggleBridgeResponse, error)
+	// BridgeMintShares mints local share marker supply for a vault; must be signed by the configured bridge address.
+	BridgeMintShares(ctx context.Context, in *MsgBridgeMintSharesRequest, opts ...grpc.CallOption) (*MsgBridgeMintSharesResponse, error)
+	// BridgeBurnShares burns local share marker supply for a vault; must be signed by the configured bridge address.
+	BridgeBurnShares(ctx context.Context, in *MsgBridgeBurnSharesRequest, opts ...grpc.CallOption) (*MsgBridgeBurnSharesResponse, error)
+	// SetAssetManager sets or clears the optional asset manager address for a vault.
+	// The vault admin must sign this transaction. Passing an empty address clears it. The asset
+	// manager's roles move from the previous asset manager to the new one.
+	SetAssetManager(ctx context.Context, in *MsgSetAssetManagerRequest, opts ...grpc.CallOption) (*MsgSetAssetManagerResponse, error)
+	// UpdateParams updates the module parameters.
+	UpdateParams(ctx context.Context, in *MsgUpdateParamsRequest, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
+	// UpdateVaultAUMFeeBips updates the AUM fee bips for a specific vault.
+	UpdateVaultAUMFeeBips(ctx context.Context, in *MsgUpdateVaultAUMFeeBipsRequest, opts ...grpc.CallOption) (*MsgUpdateVaultAUMFeeBipsResponse, error)
+	// UpdateVaultNAV creates or updates a vault's internal net asset value entry for a denom.
+	// Must be signed by a holder of the vault's NAV authority role.
+	UpdateVaultNAV(ctx context.Context, in *MsgUpdateVaultNAVRequest, opts ...grpc.CallOption) (*MsgUpdateVaultNAVResponse, error)
+	// RemoveVaultNAV deletes a vault's internal net asset value entry for a denom the vault
+	// does not hold, revoking the authorization to acquire it at that price.
+	// Must be signed by a holder of the vault's NAV authority role.
+	RemoveVaultNAV(ctx context.Context, in *MsgRemoveVaultNAVRequest, opts ...grpc.CallOption) (*MsgRemoveVaultNAVResponse, error)
+	// UpdateNAVAuthority rotates the address authorized to mutate a vault's internal NAV table.
+	// Must be signed by the vault admin.
+	UpdateNAVAuthority(ctx context.Context, in *MsgUpdateNAVAuthorityRequest, opts ...grpc.CallOption) (*MsgUpdateNAVAuthorityResponse, error)
+	// AcceptAsset settles a pending exchange-module payment whose target is the vault,
+	// exchanging an external asset for the vault's underlying asset.
+	// Must be signed by a holder of the vault's settlement manager role.
+	AcceptAsset(ctx context.Context, in *MsgAcceptAssetRequest, opts ...grpc.CallOption) (*MsgAcceptAssetResponse, error)
+	// RejectAsset declines a pending exchange-module payment whose target is the vault,
+	// causing the exchange module to cancel it and refund the source's escrow.
+	// Must be signed by a holder of the vault's settlement manager role.
+	RejectAsset(ctx context.Context, in *MsgRejectAssetRequest, opts ...grpc.CallOption) (*MsgRejectAssetResponse, error)
+	// UpdateNAVStalenessLimit sets the maximum age of a held denom's internal NAV before the
+	// vault's pricing-dependent operations are blocked, and whether a stale NAV auto-pauses the vault.
+	// Must be signed by the vault admin.
+	UpdateNAVStalenessLimit(ctx context.Context, in *MsgUpdateNAVStalenessLimitRequest, opts ...grpc.CallOption) (*MsgUpdateNAVStalenessLimitResponse, error)
+	// UpdateNAVChangeLimit sets the largest single-update move the NAV authority may apply to a
+	// held denom's internal NAV, and the approver who must confirm larger moves.
+	// Must be signed by the vault admin.
+	UpdateNAVChangeLimit(ctx context.Context, in *MsgUpdateNAVChangeLimitRequest, opts ...grpc.CallOption) (*MsgUpdateNAVChangeLimitResponse, error)
+	// UpdateSettlementTolerance sets how far asset settlements may trade from the vault's internal
+	// NAV, and whether a settlement marks the NAV to its price.
+	// Must be signed by the vault admin.
+	UpdateSettlementTolerance(ctx context.Context, in *MsgUpdateSettlementToleranceRequest, opts ...grpc.CallOption) (*MsgUpdateSettlementToleranceResponse, error)
+	// ApproveNAVProposal applies a pending NAV proposal that exceeded the vault's max_nav_change_bips.
+	// Must be signed by the vault's NAV approver, who may not be the proposal's proposer.
+	ApproveNAVProposal(ctx context.Context, in *MsgApproveNAVProposalRequest, opts ...grpc.CallOption) (*MsgApproveNAVProposalResponse, error)
+	// UpdateNAVSources sets the vault's independent NAV sources, the quorum of fresh submissions
+	// required before their aggregated price is used, and how submissions are aggregated.
+	// Must be signed by the vault admin.
+	UpdateNAVSources(ctx context.Context, in *MsgUpdateNAVSourcesRequest, opts ...grpc.CallOption) (*MsgUpdateNAVSourcesResponse, error)
+	// SubmitSourceNAV records a NAV source's price for a denom in the vault's per-source table.
+	// Must be signed by one of the vault's NAV sources.
+	SubmitSourceNAV(ctx context.Context, in *MsgSubmitSourceNAVRequest, opts ...grpc.CallOption) (*MsgSubmitSourceNAVResponse, error)
+	// BatchUpdateVaultNAV creates or updates a vault's internal net asset value entries for
+	// many denoms at once, reconciling the vault a single time.
+	// Must be signed by a holder of the vault's NAV authority role.
+	BatchUpdateVaultNAV(ctx context.Context, in *MsgBatchUpdateVaultNAVRequest, opts ...grpc.CallOption) (*MsgBatchUpdateVaultNAVResponse, error)
+	// UpdatePriceSources sets the ordered chain of sources a vault consults to price its
+	// held denoms. Must be signed by the vault admin.
+	UpdatePriceSources(ctx context.Context, in *MsgUpdatePriceSourcesRequest, opts ...grpc.CallOption) (*MsgUpdatePriceSourcesResponse, error)
+	// UpdateAssetHaircut sets the valuation haircut a vault applies to a held denom.
+	// Must be signed by a holder of the vault's NAV authority role.
+	UpdateAssetHaircut(ctx context.Context, in *MsgUpdateAssetHaircutRequest, opts ...grpc.CallOption) (*MsgUpdateAssetHaircutResponse, error)
+	// BatchAcceptAssets settles many pending exchange-module payments whose target is the vault,
+	// reconciling the vault a single time. Either every payment settles or none does, unless
+	// best_effort is set. Must be signed by a holder of the vault's settlement manager role.
+	BatchAcceptAssets(ctx context.Context, in *MsgBatchAcceptAssetsRequest, opts ...grpc.CallOption) (*MsgBatchAcceptAssetsResponse, error)
+	// CreateAssetPayment creates an x/exchange payment with the vault's payment escrow account
+	// as its source, offering a held asset or the underlying asset to a counterparty.
+	// Must be signed by a holder of the vault's settlement manager role.
+	CreateAssetPayment(ctx context.Context, in *MsgCreateAssetPaymentRequest, opts ...grpc.CallOption) (*MsgCreateAssetPaymentResponse, error)
+	// CancelAssetPayment cancels an open payment created by CreateAssetPayment and returns its
+	// escrow to the principal. Must be signed by a holder of the vault's settlement manager
+	// role.
+	CancelAssetPayment(ctx context.Context, in *MsgCancelAssetPaymentRequest, opts ...grpc.CallOption) (*MsgCancelAssetPaymentResponse, error)
+	// ExpireAssetPayment cancels an open payment created by CreateAssetPayment once it has
+	// expired and returns its escrow to the principal. Any account may sign it.
+	ExpireAssetPayment(ctx context.Context, in *MsgExpireAssetPaymentRequest, opts ...grpc.CallOption) (*MsgExpireAssetPaymentResponse, error)
+	// UpdateAcquisitionPolicy replaces the policy restricting which assets a vault may price and
+	// acquire. Must be signed by the vault admin.
+	UpdateAcquisitionPolicy(ctx context.Context, in *MsgUpdateAcquisitionPolicyRequest, opts ...grpc.CallOption) (*MsgUpdateAcquisitionPolicyResponse, error)
+	// UpdateConcentrationLimits sets the largest shares of total vault value a single
+	// non-underlying asset, and all non-underlying assets together, may make up. Must be signed
+	// by the vault admin.
+	UpdateConcentrationLimits(ctx context.Context, in *MsgUpdateConcentrationLimitsRequest, opts ...grpc.CallOption) (*MsgUpdateConcentrationLimitsResponse, error)
+	// UpdateMinLiquidity sets the share of total vault value a vault must keep liquid in its
+	// underlying asset. Must be signed by the vault admin.
+	UpdateMinLiquidity(ctx context.Context, in *MsgUpdateMinLiquidityRequest, opts ...grpc.CallOption) (*MsgUpdateMinLiquidityResponse, error)
+	// CollectAssetIncome moves income earned by a held asset from the vault's principal into
+	// its interest reserves, or back. Must be signed by a holder of the vault's settlement
+	// manager role.
+	CollectAssetIncome(ctx context.Context, in *MsgCollectAssetIncomeRequest, opts ...grpc.CallOption) (*MsgCollectAssetIncomeResponse, error)
+	// GrantRole authorizes an address to perform the operations of a role on a vault. Must be
+	// signed by the vault admin.
+	GrantRole(ctx context.Context, in *MsgGrantRoleRequest, opts ...grpc.CallOption) (*MsgGrantRoleResponse, error)
+	// RevokeRole removes an address's grant of a role on a vault. Must be signed by the vault
+	// admin.
+	RevokeRole(ctx context.Context, in *MsgRevokeRoleRequest, opts ...grpc.CallOption) (*MsgRevokeRoleResponse, error)
+	// ProposeAdminTransfer proposes a new admin for a vault, replacing any pending proposal.
+	// Must be signed by the vault admin.
+	ProposeAdminTransfer(ctx context.Context, in *MsgProposeAdminTransferRequest, opts ...grpc.CallOption) (*MsgProposeAdminTransferResponse, error)
+	// AcceptAdminTransfer completes a pending admin transfer. Must be signed by the proposed
+	// admin before the proposal expires.
+	AcceptAdminTransfer(ctx context.Context, in *MsgAcceptAdminTransferRequest, opts ...grpc.CallOption) (*MsgAcceptAdminTransferResponse, error)
+	// CancelAdminTransfer withdraws a pending admin transfer. Must be signed by the vault admin.
+	CancelAdminTransfer(ctx context.Context, in *MsgCancelAdminTransferRequest, opts ...grpc.CallOption) (*MsgCancelAdminTransferResponse, error)
+	// RecoverVaultAdmin replaces a vault's admin immediately, for vaults whose admin key is lost
+	// or compromised. Must be signed by the governance module account.
+	RecoverVaultAdmin(ctx context.Context, in *MsgRecoverVaultAdminRequest, opts ...grpc.CallOption) (*MsgRecoverVaultAdminResponse, error)
+	// UpdateTimelock sets the delay applied to the vault's sensitive configuration changes.
+	// Raising it takes effect immediately; lowering it is itself subject to the current timelock.
+	// Must be signed by the vault admin.
+	UpdateTimelock(ctx context.Context, in *MsgUpdateTimelockRequest, opts ...grpc.CallOption) (*MsgUpdateTimelockResponse, error)
+	// CancelScheduledAction discards a scheduled action before it executes. Must be signed by
+	// the vault admin or a holder of the vault's guardian role.
+	CancelScheduledAction(ctx context.Context, in *MsgCancelScheduledActionRequest, opts ...grpc.CallOption) (*MsgCancelScheduledActionResponse, error)
+	// UpdateEligibilityPolicy replaces the policy restricting which addresses may subscribe to
+	// and hold a vault's shares. Must be signed by the vault admin.
+	UpdateEligibilityPolicy(ctx context.Context, in *MsgUpdateEligibilityPolicyRequest, opts ...grpc.CallOption) (*MsgUpdateEligibilityPolicyResponse, error)
+	// UpdateAllowlist adds addresses to and removes addresses from a vault's investor
+	// allowlist. Must be signed by the vault admin.
+	UpdateAllowlist(ctx context.Context, in *MsgUpdateAllowlistRequest, opts ...grpc.CallOption) (*MsgUpdateAllowlistResponse, error)
+	// UpdatePositionCap sets the largest position a single account may build in a vault through
+	// swap-ins, and the addresses exempt from it. Must be signed by a holder of the vault's
+	// limits manager role.
+	UpdatePositionCap(ctx context.Context, in *MsgUpdatePositionCapRequest, opts ...grpc.CallOption) (*MsgUpdatePositionCapResponse, error)
+	// UpdateMaxTotalValue sets the net total value a vault may reach through swap-ins. Must be
+	// signed by a holder of the vault's limits manager role.
+	UpdateMaxTotalValue(ctx context.Context, in *MsgUpdateMaxTotalValueRequest, opts ...grpc.CallOption) (*MsgUpdateMaxTotalValueResponse, error)
+	// UpdateCircuitBreaker trips or resets the module-wide circuit breaker and, when signed by
+	// governance, appoints or removes its guardian. Must be signed by governance or the circuit
+	// breaker guardian.
+	UpdateCircuitBreaker(ctx context.Context, in *MsgUpdateCircuitBreakerRequest, opts ...grpc.CallOption) (*MsgUpdateCircuitBreakerResponse, error)
+}
+
+type msgClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewMsgClient(cc grpc1.ClientConn) MsgClient {
+	return &msgClient{cc}
+}
+
+func (c *msgClient) CreateVault(ctx context.Context, in *MsgCreateVaultRequest, opts ...grpc.CallOption) (*MsgCreateVaultResponse, error) {
+	out := new(MsgCreateVaultResponse)
+	err := c.cc.Invoke(ctx, "/provlabs.vault.v1.Msg/CreateVault", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *MsgSetBridgeAddressResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) SetShareDenomMetadata(ctx context.Context, in *MsgSetShareDenomMetadataRequest, opts ...grpc.CallOption) (*MsgSetShareDenomMetadataResponse, error) {
+	out := new(MsgSetShareDenomMetadataResponse)
+	err := c.cc.Invoke(ctx, "/provlabs.vault.v1.Msg/SetShareDenomMetadata", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *MsgSetBridgeAddressResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) SwapIn(ctx context.Context, in *MsgSwapInRequest, opts ...grpc.CallOption) (*MsgSwapInResponse, error) {
+	out := new(MsgSwapInResponse)
+	err := c.cc.Invoke(ctx, "/provlabs.vault.v1.Msg/SwapIn", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *MsgSetBridgeAddressResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (c *msgClient) SwapOut(ctx context.Context, in *MsgSwapOutRequest, opts ...grpc.CallOption) (*MsgSwapOutResponse, error) {
+	out := new(MsgSwapOutResponse)
+	err := c.cc.Invoke(ctx, "/provlabs.vault.v1.Msg/SwapOut", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *MsgToggleBridgeRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) UpdateMinInterestRate(ctx context.Context, in *MsgUpdateMinInterestRateRequest, opts ...grpc.CallOption) (*MsgUpdateMinInterestRateResponse, error) {
+	out := new(MsgUpdateMinInterestRateResponse)
+	err := c.cc.Invoke(ctx, "/provlabs.vault.v1.Msg/UpdateMinInterestRate", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *MsgToggleBridgeRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) UpdateMaxInterestRate(ctx context.Context, in *MsgUpdateMaxInterestRateRequest, opts ...grpc.CallOption) (*MsgUpdateMaxInterestRateResponse, error) {
+	out := new(MsgUpdateMaxInterestRateResponse)
+	err := c.cc.Invoke(ctx, "/provlabs.vault.v1.Msg/UpdateMaxInterestRate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *MsgToggleBridgeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Enabled {
-		i--
-		if m.Enabled {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x18
-	}
-	if len(m.VaultAddress) > 0 {
-		i -= len(m.VaultAddress)
-		copy(dAtA[i:], m.VaultAddress)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.VaultAddress)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Admin) > 0 {
-		i -= len(m.Admin)
-		copy(dAtA[i:], m.Admin)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Admin)))
-		i--
-		dAtA[i] = 0xa
+func (c *msgClient) UpdateInterestRate(ctx context.Context, in *MsgUpdateInterestRateRequest, opts ...grpc.CallOption) (*MsgUpdateInterestRateResponse, error) {
+	out := new(MsgUpdateInterestRateResponse)
+	err := c.cc.Invoke(ctx, "/provlabs.vault.v1.Msg/UpdateInterestRate", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *MsgToggleBridgeResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) UpdateWithdrawalDelay(ctx context.Context, in *MsgUpdateWithdrawalDelayRequest, opts ...grpc.CallOption) (*MsgUpdateWithdrawalDelayResponse, error) {
+	out := new(MsgUpdateWithdrawalDelayResponse)
+	err := c.cc.Invoke(ctx, "/provlabs.vault.v1.Msg/UpdateWithdrawalDelay", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *MsgToggleBridgeResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) UpdateMinSwapInValue(ctx context.Context, in *MsgUpdateMinSwapInValueRequest, opts ...grpc.CallOption) (*MsgUpdateMinSwapInValueResponse, error) {
+	out := new(MsgUpdateMinSwapInValueResponse)
+	err := c.cc.Invoke(ctx, "/provlabs.vault.v1.Msg/UpdateMinSwapInValue", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *MsgToggleBridgeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (c *msgClient) UpdateMinSwapOutValue(ctx context.Context, in *MsgUpdateMinSwapOutValueRequest, opts ...grpc.CallOption) (*MsgUpdateMinSwapOutValueResponse, error) {
+	out := new(MsgUpdateMinSwapOutValueResponse)
+	err := c.cc.Invoke(ctx, "/provlabs.vault.v1.Msg/UpdateMinSwapOutValue", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *MsgBridgeMintSharesRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) UpdateMaxSwapInValue(ctx context.Context, in *MsgUpdateMaxSwapInValueRequest, opts ...grpc.CallOption) (*MsgUpdateMaxSwapInValueResponse, error) {
+	out := new(MsgUpdateMaxSwapInValueResponse)
+	err := c.cc.Invoke(ctx, "/provlabs.vault.v1.Msg/UpdateMaxSwapInValue", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *MsgBridgeMintSharesRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) UpdateMaxSwapOutValue(ctx context.Context, in *MsgUpdateMaxSwapOutValueRequest, opts ...grpc.CallOption) (*MsgUpdateMaxSwapOutValueResponse, error) {
+	out := new(MsgUpdateMaxSwapOutValueResponse)
+	err := c.cc.Invoke(ctx, "/provlabs.vault.v1.Msg/UpdateMaxSwapOutValue", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *MsgBridgeMintSharesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Shares.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintTx(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x1a
-	if len(m.VaultAddress) > 0 {
-		i -= len(m.VaultAddress)
-		copy(dAtA[i:], m.VaultAddress)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.VaultAddress)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Bridge) > 0 {
-		i -= len(m.Bridge)
-		copy(dAtA[i:], m.Bridge)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Bridge)))
-		i--
-		dAtA[i] = 0xa
+func (c *msgClient) ToggleSwapIn(ctx context.Context, in *MsgToggleSwapInRequest, opts ...grpc.CallOption) (*MsgToggleSwapInResponse, error) {
+	out := new(MsgToggleSwapInResponse)
+	err := c.cc.Invoke(ctx, "/provlabs.vault.v1.Msg/ToggleSwapIn", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *MsgBridgeMintSharesResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) ToggleSwapOut(ctx context.Context, in *MsgToggleSwapOutRequest, opts ...grpc.CallOption) (*MsgToggleSwapOutResponse, error) {
+	out := new(MsgToggleSwapOutResponse)
+	err := c.cc.Invoke(ctx, "/provlabs.vault.v1.Msg/ToggleSwapOut", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *MsgBridgeMintSharesResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) DepositInterestFunds(ctx context.Context, in *MsgDepositInterestFundsRequest, opts ...grpc.CallOption) (*MsgDepositInterestFundsResponse, error) {
+	out := new(MsgDepositInterestFundsResponse)
+	err := c.cc.Invoke(ctx, "/provlabs.vault.v1.Msg/DepositInterestFunds", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *MsgBridgeMintSharesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (c *msgClient) WithdrawInterestFunds(ctx context.Context, in *MsgWithdrawInterestFundsRequest, opts ...grpc.CallOption) (*MsgWithdrawInterestFundsResponse, error) {
+	out := new(MsgWithdrawInterestFundsResponse)
+	err := c.cc.Invoke(ctx, "/provlabs.vault.v1.Msg/WithdrawInterestFunds", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *MsgBridgeBurnSharesRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) DepositPrincipalFunds(ctx context.Context, in *MsgDepositPrincipalFundsRequest, opts ...grpc.CallOption) (*MsgDepositPrincipalFundsResponse, error) {
+	out := new(MsgDepositPrincipalFundsResponse)
+	err := c.cc.Invoke(ctx, "/provlabs.vault.v1.Msg/DepositPrincipalFunds", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *MsgBridgeBurnSharesRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) WithdrawPrincipalFunds(ctx context.Context, in *MsgWithdrawPrincipalFundsRequest, opts ...grpc.CallOption) (*MsgWithdrawPrincipalFundsResponse, error) {
+	out := new(MsgWithdrawPrincipalFundsResponse)
+	err := c.cc.Invoke(ctx, "/provlabs.vault.v1.Msg/WithdrawPrincipalFunds", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *MsgBridgeBurnSharesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Shares.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintTx(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x1a
-	if len(m.VaultAddress) > 0 {
-		i -= len(m.VaultAddress)
-		copy(dAtA[i:], m.VaultAddress)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.VaultAddress)))
-		i--
-		dAtA[i] = 0x12
+func (c *msgClient) ExpeditePendingSwapOut(ctx context.Context, in *MsgExpeditePendingSwapOutRequest, opts ...grpc.CallOption) (*MsgExpeditePendingSwapOutResponse, error) {
+	out := new(MsgExpeditePendingSwapOutResponse)
+	err := c.cc.Invoke(ctx, "/provlabs.vault.v1.Msg/ExpeditePendingSwapOut", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if len(m.Bridge) > 0 {
-		i -= len(m.Bridge)
-		copy(dAtA[i:], m.Bridge)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Bridge)))
-		i--
-		dAtA[i] = 0xa
+	return out, nil
+}
+
+func (c *msgClient) PauseVault(ctx context.Context, in *MsgPauseVaultRequest, opts ...grpc.CallOption) (*MsgPauseVaultResponse, error) {
+	out := new(MsgPauseVaultResponse)
+	err := c.cc.Invoke(ctx, "/provlabs.vault.v1.Msg/PauseVault", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *MsgBridgeBurnSharesResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) UnpauseVault(ctx context.Context, in *MsgUnpauseVaultRequest, opts ...grpc.CallOption) (*MsgUnpauseVaultResponse, error) {
+	out := new(MsgUnpauseVaultResponse)
+	err := c.cc.Invoke(ctx, "/provlabs.vault.v1.Msg/UnpauseVault", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *MsgBridgeBurnSharesResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) SetBridgeAddress(ctx context.Context, in *MsgSetBridgeAddressRequest, opts ...grpc.CallOption) (*MsgSetBridgeAddressResponse, error) {
+	out := new(MsgSetBridgeAddressResponse)
+	err := c.cc.Invoke(ctx, "/provlabs.vault.v1.Msg/SetBridgeAddress", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *MsgBridgeBurnSharesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (c *msgClient) ToggleBridge(ctx context.Context, in *MsgToggleBridgeRequest, opts ...grpc.CallOption) (*MsgToggleBridgeResponse, error) {
+	out := new(MsgToggleBridgeResponse)
+	err := c.cc.Invoke(ctx, "/provlabs.vault.v1.Msg/ToggleBridge", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *MsgSetAssetManagerRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) BridgeMintShares(ctx context.Context, in *MsgBridgeMintSharesRequest, opts ...grpc.CallOption) (*MsgBridgeMintSharesResponse, error) {
+	out := new(MsgBridgeMintSharesResponse)
+	err := c.cc.Invoke(ctx, "/provlabs.vault.v1.Msg/BridgeMintShares", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *MsgSetAssetManagerRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) BridgeBurnShares(ctx context.Context, in *MsgBridgeBurnSharesRequest, opts ...grpc.CallOption) (*MsgBridgeBurnSharesResponse, error) {
+	out := new(MsgBridgeBurnSharesResponse)
+	err := c.cc.Invoke(ctx, "/provlabs.vault.v1.Msg/BridgeBurnShares", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *MsgSetAssetManagerRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.AssetManager) > 0 {
-		i -= len(m.AssetManager)
-		copy(dAtA[i:], m.AssetManager)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.AssetManager)))
-		i--
-		dAtA[i] = 0x1a
+func (c *msgClient) SetAssetManager(ctx context.Context, in *MsgSetAssetManagerRequest, opts ...grpc.CallOption) (*MsgSetAssetManagerResponse, error) {
+	out := new(MsgSetAssetManagerResponse)
+	err := c.cc.Invoke(ctx, "/provlabs.vault.v1.Msg/SetAssetManager", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if len(m.VaultAddress) > 0 {
-		i -= len(m.VaultAddress)
-		copy(dAtA[i:], m.VaultAddress)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.VaultAddress)))
-		i--
-		dAtA[i] = 0x12
+	return out, nil
+}
+
+func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParamsRequest, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error) {
+	out := new(MsgUpdateParamsResponse)
+	err := c.cc.Invoke(ctx, "/provlabs.vault.v1.Msg/UpdateParams", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if len(m.Admin) > 0 {
-		i -= len(m.Admin)
-		copy(dAtA[i:], m.Admin)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Admin)))
-		i--
-		dAtA[i] = 0xa
+	return out, nil
+}
+
+func (c *msgClient) UpdateVaultAUMFeeBips(ctx context.Context, in *MsgUpdateVaultAUMFeeBipsRequest, opts ...grpc.CallOption) (*MsgUpdateVaultAUMFeeBipsResponse, error) {
+	out := new(MsgUpdateVaultAUMFeeBipsResponse)
+	err := c.cc.Invoke(ctx, "/provlabs.vault.v1.Msg/UpdateVaultAUMFeeBips", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *MsgSetAssetManagerResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) UpdateVaultNAV(ctx context.Context, in *MsgUpdateVaultNAVRequest, opts ...grpc.CallOption) (*MsgUpdateVaultNAVResponse, error) {
+	out := new(MsgUpdateVaultNAVResponse)
+	err := c.cc.Invoke(ctx, "/provlabs.vault.v1.Msg/UpdateVaultNAV", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *MsgSetAssetManagerResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) RemoveVaultNAV(ctx context.Context, in *MsgRemoveVaultNAVRequest, opts ...grpc.CallOption) (*MsgRemoveVaultNAVResponse, error) {
+	out := new(MsgRemoveVaultNAVResponse)
+	err := c.cc.Invoke(ctx, "/provlabs.vault.v1.Msg/RemoveVaultNAV", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *MsgSetAssetManagerResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (c *msgClient) UpdateNAVAuthority(ctx context.Context, in *MsgUpdateNAVAuthorityRequest, opts ...grpc.CallOption) (*MsgUpdateNAVAuthorityResponse, error) {
+	out := new(MsgUpdateNAVAuthorityResponse)
+	err := c.cc.Invoke(ctx, "/provlabs.vault.v1.Msg/UpdateNAVAuthority", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *MsgUpdateParamsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) AcceptAsset(ctx context.Context, in *MsgAcceptAssetRequest, opts ...grpc.CallOption) (*MsgAcceptAssetResponse, error) {
+	out := new(MsgAcceptAssetResponse)
+	err := c.cc.Invoke(ctx, "/provlabs.vault.v1.Msg/AcceptAsset", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *MsgUpdateParamsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) RejectAsset(ctx context.Context, in *MsgRejectAssetRequest, opts ...grpc.CallOption) (*MsgRejectAssetResponse, error) {
+	out := new(MsgRejectAssetResponse)
+	err := c.cc.Invoke(ctx, "/provlabs.vault.v1.Msg/RejectAsset", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *MsgUpdateParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintTx(dAtA, i, uint64(size))
+func (c *msgClient) UpdateNAVStalenessLimit(ctx context.Context, in *MsgUpdateNAVStalenessLimitRequest, opts ...grpc.CallOption) (*MsgUpdateNAVStalenessLimitResponse, error) {
+	out := new(MsgUpdateNAVStalenessLimitResponse)
+	err := c.cc.Invoke(ctx, "/provlabs.vault.v1.Msg/UpdateNAVStalenessLimit", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	i--
-	dAtA[i] = 0x12
-	if len(m.Authority) > 0 {
-		i -= len(m.Authority)
-		copy(dAtA[i:], m.Authority)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
-		i--
-		dAtA[i] = 0xa
+	return out, nil
+}
+
+func (c *msgClient) UpdateNAVChangeLimit(ctx context.Context, in *MsgUpdateNAVChangeLimitRequest, opts ...grpc.CallOption) (*MsgUpdateNAVChangeLimitResponse, error) {
+	out := new(MsgUpdateNAVChangeLimitResponse)
+	err := c.cc.Invoke(ctx, "/provlabs.vault.v1.Msg/UpdateNAVChangeLimit", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *MsgUpdateParamsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) UpdateSettlementTolerance(ctx context.Context, in *MsgUpdateSettlementToleranceRequest, opts ...grpc.CallOption) (*MsgUpdateSettlementToleranceResponse, error) {
+	out := new(MsgUpdateSettlementToleranceResponse)
+	err := c.cc.Invoke(ctx, "/provlabs.vault.v1.Msg/UpdateSettlementTolerance", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *MsgUpdateParamsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) ApproveNAVProposal(ctx context.Context, in *MsgApproveNAVProposalRequest, opts ...grpc.CallOption) (*MsgApproveNAVProposalResponse, error) {
+	out := new(MsgApproveNAVProposalResponse)
+	err := c.cc.Invoke(ctx, "/provlabs.vault.v1.Msg/ApproveNAVProposal", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *MsgUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (c *msgClient) UpdateNAVSources(ctx context.Context, in *MsgUpdateNAVSourcesRequest, opts ...grpc.CallOption) (*MsgUpdateNAVSourcesResponse, error) {
+	out := new(MsgUpdateNAVSourcesResponse)
+	err := c.cc.Invoke(ctx, "/provlabs.vault.v1.Msg/UpdateNAVSources", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *MsgUpdateVaultAUMFeeBipsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) SubmitSourceNAV(ctx context.Context, in *MsgSubmitSourceNAVRequest, opts ...grpc.CallOption) (*MsgSubmitSourceNAVResponse, error) {
+	out := new(MsgSubmitSourceNAVResponse)
+	err := c.cc.Invoke(ctx, "/provlabs.vault.v1.Msg/SubmitSourceNAV", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *MsgUpdateVaultAUMFeeBipsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) BatchUpdateVaultNAV(ctx context.Context, in *MsgBatchUpdateVaultNAVRequest, opts ...grpc.CallOption) (*MsgBatchUpdateVaultNAVResponse, error) {
+	out := new(MsgBatchUpdateVaultNAVResponse)
+	err := c.cc.Invoke(ctx, "/provlabs.vault.v1.Msg/BatchUpdateVaultNAV", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *MsgUpdateVaultAUMFeeBipsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.AumFeeBips != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.AumFeeBips))
-		i--
-		dAtA[i] = 0x18
+func (c *msgClient) UpdatePriceSources(ctx context.Context, in *MsgUpdatePriceSourcesRequest, opts ...grpc.CallOption) (*MsgUpdatePriceSourcesResponse, error) {
+	out := new(MsgUpdatePriceSourcesResponse)
+	err := c.cc.Invoke(ctx, "/provlabs.vault.v1.Msg/UpdatePriceSources", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if len(m.VaultAddress) > 0 {
-		i -= len(m.VaultAddress)
-		copy(dAtA[i:], m.VaultAddress)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.VaultAddress)))
-		i--
-		dAtA[i] = 0x12
+	return out, nil
+}
+
+func (c *msgClient) UpdateAssetHaircut(ctx context.Context, in *MsgUpdateAssetHaircutRequest, opts ...grpc.CallOption) (*MsgUpdateAssetHaircutResponse, error) {
+	out := new(MsgUpdateAssetHaircutResponse)
+	err := c.cc.Invoke(ctx, "/provlabs.vault.v1.Msg/UpdateAssetHaircut", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if len(m.Authority) > 0 {
-		i -= len(m.Authority)
-		copy(dAtA[i:], m.Authority)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
-		i--
-		dAtA[i] = 0xa
+	return out, nil
+}
+
+func (c *msgClient) BatchAcceptAssets(ctx context.Context, in *MsgBatchAcceptAssetsRequest, opts ...grpc.CallOption) (*MsgBatchAcceptAssetsResponse, error) {
+	out := new(MsgBatchAcceptAssetsResponse)
+	err := c.cc.Invoke(ctx, "/provlabs.vault.v1.Msg/BatchAcceptAssets", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *MsgUpdateVaultAUMFeeBipsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) CreateAssetPayment(ctx context.Context, in *MsgCreateAssetPaymentRequest, opts ...grpc.CallOption) (*MsgCreateAssetPaymentResponse, error) {
+	out := new(MsgCreateAssetPaymentResponse)
+	err := c.cc.Invoke(ctx, "/provlabs.vault.v1.Msg/CreateAssetPayment", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *MsgUpdateVaultAUMFeeBipsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) CancelAssetPayment(ctx context.Context, in *MsgCancelAssetPaymentRequest, opts ...grpc.CallOption) (*MsgCancelAssetPaymentResponse, error) {
+	out := new(MsgCancelAssetPaymentResponse)
+	err := c.cc.Invoke(ctx, "/provlabs.vault.v1.Msg/CancelAssetPayment", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *MsgUpdateVaultAUMFeeBipsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (c *msgClient) ExpireAssetPayment(ctx context.Context, in *MsgExpireAssetPaymentRequest, opts ...grpc.CallOption) (*MsgExpireAssetPaymentResponse, error) {
+	out := new(MsgExpireAssetPaymentResponse)
+	err := c.cc.Invoke(ctx, "/provlabs.vault.v1.Msg/ExpireAssetPayment", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *MsgUpdateVaultNAVRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) UpdateAcquisitionPolicy(ctx context.Context, in *MsgUpdateAcquisitionPolicyRequest, opts ...grpc.CallOption) (*MsgUpdateAcquisitionPolicyResponse, error) {
+	out := new(MsgUpdateAcquisitionPolicyResponse)
+	err := c.cc.Invoke(ctx, "/provlabs.vault.v1.Msg/UpdateAcquisitionPolicy", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *MsgUpdateVaultNAVRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) UpdateConcentrationLimits(ctx context.Context, in *MsgUpdateConcentrationLimitsRequest, opts ...grpc.CallOption) (*MsgUpdateConcentrationLimitsResponse, error) {
+	out := new(MsgUpdateConcentrationLimitsResponse)
+	err := c.cc.Invoke(ctx, "/provlabs.vault.v1.Msg/UpdateConcentrationLimits", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *MsgUpdateVaultNAVRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Source) > 0 {
-		i -= len(m.Source)
-		copy(dAtA[i:], m.Source)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Source)))
-		i--
-		dAtA[i] = 0x32
+func (c *msgClient) UpdateMinLiquidity(ctx context.Context, in *MsgUpdateMinLiquidityRequest, opts ...grpc.CallOption) (*MsgUpdateMinLiquidityResponse, error) {
+	out := new(MsgUpdateMinLiquidityResponse)
+	err := c.cc.Invoke(ctx, "/provlabs.vault.v1.Msg/UpdateMinLiquidity", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	{
-		size := m.Volume.Size()
-		i -= size
-		if _, err := m.Volume.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintTx(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x2a
-	{
-		size, err := m.Price.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintTx(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x22
-	if len(m.Denom) > 0 {
-		i -= len(m.Denom)
-		copy(dAtA[i:], m.Denom)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Denom)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.VaultAddress) > 0 {
-		i -= len(m.VaultAddress)
-		copy(dAtA[i:], m.VaultAddress)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.VaultAddress)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Signer) > 0 {
-		i -= len(m.Signer)
-		copy(dAtA[i:], m.Signer)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *MsgUpdateVaultNAVResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) CollectAssetIncome(ctx context.Context, in *MsgCollectAssetIncomeRequest, opts ...grpc.CallOption) (*MsgCollectAssetIncomeResponse, error) {
+	out := new(MsgCollectAssetIncomeResponse)
+	err := c.cc.Invoke(ctx, "/provlabs.vault.v1.Msg/CollectAssetIncome", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *MsgUpdateVaultNAVResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *MsgUpdateVaultNAVResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *MsgRemoveVaultNAVRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) GrantRole(ctx context.Context, in *MsgGrantRoleRequest, opts ...grpc.CallOption) (*MsgGrantRoleResponse, error) {
+	out := new(MsgGrantRoleResponse)
+	err := c.cc.Invoke(ctx, "/provlabs.vault.v1.Msg/GrantRole", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *MsgRemoveVaultNAVRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	return out, nil
 }
 
-func (m *MsgRemoveVaultNAVRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Denom) > 0 {
-		i -= len(m.Denom)
-		copy(dAtA[i:], m.Denom)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Denom)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.VaultAddress) > 0 {
-		i -= len(m.VaultAddress)
-		copy(dAtA[i:], m.VaultAddress)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.VaultAddress)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Signer) > 0 {
-		i -= len(m.Signer)
-		copy(dAtA[i:], m.Signer)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
-		i--
-		dAtA[i] = 0xa
+func (c *msgClient) RevokeRole(ctx context.Context, in *MsgRevokeRoleRequest, opts ...grpc.CallOption) (*MsgRevokeRoleResponse, error) {
+	out := new(MsgRevokeRoleResponse)
+	err := c.cc.Invoke(ctx, "/provlabs.vault.v1.Msg/RevokeRole", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *MsgRemoveVaultNAVResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) ProposeAdminTransfer(ctx context.Context, in *MsgProposeAdminTransferRequest, opts ...grpc.CallOption) (*MsgProposeAdminTransferResponse, error) {
+	out := new(MsgProposeAdminTransferResponse)
+	err := c.cc.Invoke(ctx, "/provlabs.vault.v1.Msg/ProposeAdminTransfer", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *MsgRemoveVaultNAVResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *MsgRemoveVaultNAVResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *MsgUpdateNAVAuthorityRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) AcceptAdminTransfer(ctx context.Context, in *MsgAcceptAdminTransferRequest, opts ...grpc.CallOption) (*MsgAcceptAdminTransferResponse, error) {
+	out := new(MsgAcceptAdminTransferResponse)
+	err := c.cc.Invoke(ctx, "/provlabs.vault.v1.Msg/AcceptAdminTransfer", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *MsgUpdateNAVAuthorityRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	return out, nil
 }
 
-func (m *MsgUpdateNAVAuthorityRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.NewAuthority) > 0 {
-		i -= len(m.NewAuthority)
-		copy(dAtA[i:], m.NewAuthority)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.NewAuthority)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.VaultAddress) > 0 {
-		i -= len(m.VaultAddress)
-		copy(dAtA[i:], m.VaultAddress)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.VaultAddress)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Signer) > 0 {
-		i -= len(m.Signer)
-		copy(dAtA[i:], m.Signer)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
-		i--
-		dAtA[i] = 0xa
+func (c *msgClient) CancelAdminTransfer(ctx context.Context, in *MsgCancelAdminTransferRequest, opts ...grpc.CallOption) (*MsgCancelAdminTransferResponse, error) {
+	out := new(MsgCancelAdminTransferResponse)
+	err := c.cc.Invoke(ctx, "/provlabs.vault.v1.Msg/CancelAdminTransfer", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *MsgUpdateNAVAuthorityResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) RecoverVaultAdmin(ctx context.Context, in *MsgRecoverVaultAdminRequest, opts ...grpc.CallOption) (*MsgRecoverVaultAdminResponse, error) {
+	out := new(MsgRecoverVaultAdminResponse)
+	err := c.cc.Invoke(ctx, "/provlabs.vault.v1.Msg/RecoverVaultAdmin", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *MsgUpdateNAVAuthorityResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *MsgUpdateNAVAuthorityResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *MsgAcceptAssetRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) UpdateTimelock(ctx context.Context, in *MsgUpdateTimelockRequest, opts ...grpc.CallOption) (*MsgUpdateTimelockResponse, error) {
+	out := new(MsgUpdateTimelockResponse)
+	err := c.cc.Invoke(ctx, "/provlabs.vault.v1.Msg/UpdateTimelock", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *MsgAcceptAssetRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	return out, nil
 }
 
-func (m *MsgAcceptAssetRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.ExternalId) > 0 {
-		i -= len(m.ExternalId)
-		copy(dAtA[i:], m.ExternalId)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.ExternalId)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if len(m.Source) > 0 {
-		i -= len(m.Source)
-		copy(dAtA[i:], m.Source)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Source)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.VaultAddress) > 0 {
-		i -= len(m.VaultAddress)
-		copy(dAtA[i:], m.VaultAddress)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.VaultAddress)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Authority) > 0 {
-		i -= len(m.Authority)
-		copy(dAtA[i:], m.Authority)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
-		i--
-		dAtA[i] = 0xa
+func (c *msgClient) CancelScheduledAction(ctx context.Context, in *MsgCancelScheduledActionRequest, opts ...grpc.CallOption) (*MsgCancelScheduledActionResponse, error) {
+	out := new(MsgCancelScheduledActionResponse)
+	err := c.cc.Invoke(ctx, "/provlabs.vault.v1.Msg/CancelScheduledAction", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *MsgAcceptAssetResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) UpdateEligibilityPolicy(ctx context.Context, in *MsgUpdateEligibilityPolicyRequest, opts ...grpc.CallOption) (*MsgUpdateEligibilityPolicyResponse, error) {
+	out := new(MsgUpdateEligibilityPolicyResponse)
+	err := c.cc.Invoke(ctx, "/provlabs.vault.v1.Msg/UpdateEligibilityPolicy", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *MsgAcceptAssetResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) UpdateAllowlist(ctx context.Context, in *MsgUpdateAllowlistRequest, opts ...grpc.CallOption) (*MsgUpdateAllowlistResponse, error) {
+	out := new(MsgUpdateAllowlistResponse)
+	err := c.cc.Invoke(ctx, "/provlabs.vault.v1.Msg/UpdateAllowlist", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *MsgAcceptAssetResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (c *msgClient) UpdatePositionCap(ctx context.Context, in *MsgUpdatePositionCapRequest, opts ...grpc.CallOption) (*MsgUpdatePositionCapResponse, error) {
+	out := new(MsgUpdatePositionCapResponse)
+	err := c.cc.Invoke(ctx, "/provlabs.vault.v1.Msg/UpdatePositionCap", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *MsgRejectAssetRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) UpdateMaxTotalValue(ctx context.Context, in *MsgUpdateMaxTotalValueRequest, opts ...grpc.CallOption) (*MsgUpdateMaxTotalValueResponse, error) {
+	out := new(MsgUpdateMaxTotalValueResponse)
+	err := c.cc.Invoke(ctx, "/provlabs.vault.v1.Msg/UpdateMaxTotalValue", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *MsgRejectAssetRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *MsgRejectAssetRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.ExternalId) > 0 {
-		i -= len(m.ExternalId)
-		copy(dAtA[i:], m.ExternalId)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.ExternalId)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if len(m.Source) > 0 {
-		i -= len(m.Source)
-		copy(dAtA[i:], m.Source)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Source)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.VaultAddress) > 0 {
-		i -= len(m.VaultAddress)
-		copy(dAtA[i:], m.VaultAddress)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.VaultAddress)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Authority) > 0 {
-		i -= len(m.Authority)
-		copy(dAtA[i:], m.Authority)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
-}
-
-func (m *MsgRejectAssetResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) UpdateCircuitBreaker(ctx context.Context, in *MsgUpdateCircuitBreakerRequest, opts ...grpc.CallOption) (*MsgUpdateCircuitBreakerResponse, error) {
+	out := new(MsgUpdateCircuitBreakerResponse)
+	err := c.cc.Invoke(ctx, "/provlabs.vault.v1.Msg/UpdateCircuitBreaker", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *MsgRejectAssetResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// MsgServer is the server API for Msg service.
+type MsgServer interface {
+	// CreateVault creates a new vault.
+	// Must be signed by the governance module account, so vaults can only be created
+	// through a passed governance proposal.
+	CreateVault(context.Context, *MsgCreateVaultRequest) (*MsgCreateVaultResponse, error)
+	// SetShareDenomMetadata allows Denom Metadata (see bank module) to be set for the vault's share denom.
+	// Similar to marker's SetDenomMetadata, but scoped to a specific vault. Only the vault admin may call this.
+	SetShareDenomMetadata(context.Context, *MsgSetShareDenomMetadataRequest) (*MsgSetShareDenomMetadataResponse, error)
+	// SwapIn exchanges underlying assets for vault shares by depositing them into a vault.
+	SwapIn(context.Context, *MsgSwapInRequest) (*MsgSwapInResponse, error)
+	// SwapOut exchanges vault shares for underlying assets by withdrawing from a vault.
+	SwapOut(context.Context, *MsgSwapOutRequest) (*MsgSwapOutResponse, error)
+	// UpdateMinInterestRate sets the minimum allowed annual interest rate for a vault.
+	UpdateMinInterestRate(context.Context, *MsgUpdateMinInterestRateRequest) (*MsgUpdateMinInterestRateResponse, error)
+	// UpdateMaxInterestRate sets the maximum allowed annual interest rate for a vault.
+	UpdateMaxInterestRate(context.Context, *MsgUpdateMaxInterestRateRequest) (*MsgUpdateMaxInterestRateResponse, error)
+	// UpdateInterestRate allows the interest admin to update the current annual interest rate within limits.
+	UpdateInterestRate(context.Context, *MsgUpdateInterestRateRequest) (*MsgUpdateInterestRateResponse, error)
+	// UpdateWithdrawalDelay allows updating the withdrawal delay for a vault.
+	UpdateWithdrawalDelay(context.Context, *MsgUpdateWithdrawalDelayRequest) (*MsgUpdateWithdrawalDelayResponse, error)
+	// UpdateMinSwapInValue sets the minimum allowed value for a swap-in operation.
+	UpdateMinSwapInValue(context.Context, *MsgUpdateMinSwapInValueRequest) (*MsgUpdateMinSwapInValueResponse, error)
+	// UpdateMinSwapOutValue sets the minimum allowed value for a swap-out operation.
+	UpdateMinSwapOutValue(context.Context, *MsgUpdateMinSwapOutValueRequest) (*MsgUpdateMinSwapOutValueResponse, error)
+	// UpdateMaxSwapInValue sets the maximum allowed value for a swap-in operation.
+	UpdateMaxSwapInValue(context.Context, *MsgUpdateMaxSwapInValueRequest) (*MsgUpdateMaxSwapInValueResponse, error)
+	// UpdateMaxSwapOutValue sets the maximum allowed value for a swap-out operation.
+	UpdateMaxSwapOutValue(context.Context, *MsgUpdateMaxSwapOutValueRequest) (*MsgUpdateMaxSwapOutValueResponse, error)
+	// ToggleSwapIn allows enabling or disabling swap-in operations for a vault.
+	ToggleSwapIn(context.Context, *MsgToggleSwapInRequest) (*MsgToggleSwapInResponse, error)
+	// ToggleSwapOut allows enabling or disabling swap-out operations for a vault.
+	ToggleSwapOut(context.Context, *MsgToggleSwapOutRequest) (*MsgToggleSwapOutResponse, error)
+	// DepositInterestFunds allows depositing funds into the vault for paying interest.
+	// Must be signed by a holder of the vault's reserve funder role.
+	DepositInterestFunds(context.Context, *MsgDepositInterestFundsRequest) (*MsgDepositInterestFundsResponse, error)
+	// WithdrawInterestFunds allows withdrawing unused interest funds.
+	// Must be signed by a holder of the vault's reserve funder role.
+	WithdrawInterestFunds(context.Context, *MsgWithdrawInterestFundsRequest) (*MsgWithdrawInterestFundsResponse, error)
+	// DepositPrincipalFunds allows depositing principal funds into a vault.
+	// Must be signed by a holder of the vault's reserve funder role.
+	DepositPrincipalFunds(context.Context, *MsgDepositPrincipalFundsRequest) (*MsgDepositPrincipalFundsResponse, error)
+	// WithdrawPrincipalFunds allows withdrawing principal funds from a vault.
+	// Must be signed by a holder of the vault's reserve funder role.
+	WithdrawPrincipalFunds(context.Context, *MsgWithdrawPrincipalFundsRequest) (*MsgWithdrawPrincipalFundsResponse, error)
+	// ExpeditePendingSwapOut expedites a pending swap out from a vault.
+	// Must be signed by a holder of the vault's limits manager role.
+	ExpeditePendingSwapOut(context.Context, *MsgExpeditePendingSwapOutRequest) (*MsgExpeditePendingSwapOutResponse, error)
+	// PauseVault pauses user-facing swap operations for a vault and records a reason.
+	// Must be signed by a holder of the vault's pauser role.
+	PauseVault(context.Context, *MsgPauseVaultRequest) (*MsgPauseVaultResponse, error)
+	// UnpauseVault re-enables user-facing swap operations for a vault.
+	// Must be signed by a holder of the vault's pauser role.
+	UnpauseVault(context.Context, *MsgUnpauseVaultRequest) (*MsgUnpauseVaultResponse, error)
+	// SetBridgeAddress sets the single external bridge address allowed to mint or burn shares for a vault.
+	SetBridgeAddress(context.Context, *MsgSetBridgeAddressRequest) (*MsgSetBridgeAddressResponse, error)
+	// ToggleBridge enables or disables the bridge functionality for a vault.
+	ToggleBridge(context.Context, *MsgToggleBridgeRequest) (*MsgToggleBridgeResponse, error)
+	// BridgeMintShares mints local share marker supply for a vault; must be signed by the configured bridge address.
+	BridgeMintShares(context.Context, *MsgBridgeMintSharesRequest) (*MsgBridgeMintSharesResponse, error)
+	// BridgeBurnShares burns local share marker supply for a vault; must be signed by the configured bridge address.
+	BridgeBurnShares(context.Context, *MsgBridgeBurnSharesRequest) (*MsgBridgeBurnSharesResponse, error)
+	// SetAssetManager sets or clears the optional asset manager address for a vault.
+	// The vault admin must sign this transaction. Passing an empty address clears it. The asset
+	// manager's roles move from the previous asset manager to the new one.
+	SetAssetManager(context.Context, *MsgSetAssetManagerRequest) (*MsgSetAssetManagerResponse, error)
+	// UpdateParams updates the module parameters.
+	UpdateParams(context.Context, *MsgUpdateParamsRequest) (*MsgUpdateParamsResponse, error)
+	// UpdateVaultAUMFeeBips updates the AUM fee bips for a specific vault.
+	UpdateVaultAUMFeeBips(context.Context, *MsgUpdateVaultAUMFeeBipsRequest) (*MsgUpdateVaultAUMFeeBipsResponse, error)
+	// UpdateVaultNAV creates or updates a vault's internal net asset value entry for a denom.
+	// Must be signed by a holder of the vault's NAV authority role.
+	UpdateVaultNAV(context.Context, *MsgUpdateVaultNAVRequest) (*MsgUpdateVaultNAVResponse, error)
+	// RemoveVaultNAV deletes a vault's internal net asset value entry for a denom the vault
+	// does not hold, revoking the authorization to acquire it at that price.
+	// Must be signed by a holder of the vault's NAV authority role.
+	RemoveVaultNAV(context.Context, *MsgRemoveVaultNAVRequest) (*MsgRemoveVaultNAVResponse, error)
+	// UpdateNAVAuthority rotates the address authorized to mutate a vault's internal NAV table.
+	// Must be signed by the vault admin.
+	UpdateNAVAuthority(context.Context, *MsgUpdateNAVAuthorityRequest) (*MsgUpdateNAVAuthorityResponse, error)
+	// AcceptAsset settles a pending exchange-module payment whose target is the vault,
+	// exchanging an external asset for the vault's underlying asset.
+	// Must be signed by a holder of the vault's settlement manager role.
+	AcceptAsset(context.Context, *MsgAcceptAssetRequest) (*MsgAcceptAssetResponse, error)
+	// RejectAsset declines a pending exchange-module payment whose target is the vault,
+	// causing the exchange module to cancel it and refund the source's escrow.
+	// Must be signed by a holder of the vault's settlement manager role.
+	RejectAsset(context.Context, *MsgRejectAssetRequest) (*MsgRejectAssetResponse, error)
+	// UpdateNAVStalenessLimit sets the maximum age of a held denom's internal NAV before the
+	// vault's pricing-dependent operations are blocked, and whether a stale NAV auto-pauses the vault.
+	// Must be signed by the vault admin.
+	UpdateNAVStalenessLimit(context.Context, *MsgUpdateNAVStalenessLimitRequest) (*MsgUpdateNAVStalenessLimitResponse, error)
+	// UpdateNAVChangeLimit sets the largest single-update move the NAV authority may apply to a
+	// held denom's internal NAV, and the approver who must confirm larger moves.
+	// Must be signed by the vault admin.
+	UpdateNAVChangeLimit(context.Context, *MsgUpdateNAVChangeLimitRequest) (*MsgUpdateNAVChangeLimitResponse, error)
+	// UpdateSettlementTolerance sets how far asset settlements may trade from the vault's internal
+	// NAV, and whether a settlement marks the NAV to its price.
+	// Must be signed by the vault admin.
+	UpdateSettlementTolerance(context.Context, *MsgUpdateSettlementToleranceRequest) (*MsgUpdateSettlementToleranceResponse, error)
+	// ApproveNAVProposal applies a pending NAV proposal that exceeded the vault's max_nav_change_bips.
+	// Must be signed by the vault's NAV approver, who may not be the proposal's proposer.
+	ApproveNAVProposal(context.Context, *MsgApproveNAVProposalRequest) (*MsgApproveNAVProposalResponse, error)
+	// UpdateNAVSources sets the vault's independent NAV sources, the quorum of fresh submissions
+	// required before their aggregated price is used, and how submissions are aggregated.
+	// Must be signed by the vault admin.
+	UpdateNAVSources(context.Context, *MsgUpdateNAVSourcesRequest) (*MsgUpdateNAVSourcesResponse, error)
+	// SubmitSourceNAV records a NAV source's price for a denom in the vault's per-source table.
+	// Must be signed by one of the vault's NAV sources.
+	SubmitSourceNAV(context.Context, *MsgSubmitSourceNAVRequest) (*MsgSubmitSourceNAVResponse, error)
+	// BatchUpdateVaultNAV creates or updates a vault's internal net asset value entries for
+	// many denoms at once, reconciling the vault a single time.
+	// Must be signed by a holder of the vault's NAV authority role.
+	BatchUpdateVaultNAV(context.Context, *MsgBatchUpdateVaultNAVRequest) (*MsgBatchUpdateVaultNAVResponse, error)
+	// UpdatePriceSources sets the ordered chain of sources a vault consults to price its
+	// held denoms. Must be signed by the vault admin.
+	UpdatePriceSources(context.Context, *MsgUpdatePriceSourcesRequest) (*MsgUpdatePriceSourcesResponse, error)
+	// UpdateAssetHaircut sets the valuation haircut a vault applies to a held denom.
+	// Must be signed by a holder of the vault's NAV authority role.
+	UpdateAssetHaircut(context.Context, *MsgUpdateAssetHaircutRequest) (*MsgUpdateAssetHaircutResponse, error)
+	// BatchAcceptAssets settles many pending exchange-module payments whose target is the vault,
+	// reconciling the vault a single time. Either every payment settles or none does, unless
+	// best_effort is set. Must be signed by a holder of the vault's settlement manager role.
+	BatchAcceptAssets(context.Context, *MsgBatchAcceptAssetsRequest) (*MsgBatchAcceptAssetsResponse, error)
+	// CreateAssetPayment creates an x/exchange payment with the vault's payment escrow account
+	// as its source, offering a held asset or the underlying asset to a counterparty.
+	// Must be signed by a holder of the vault's settlement manager role.
+	CreateAssetPayment(context.Context, *MsgCreateAssetPaymentRequest) (*MsgCreateAssetPaymentResponse, error)
+	// CancelAssetPayment cancels an open payment created by CreateAssetPayment and returns its
+	// escrow to the principal. Must be signed by a holder of the vault's settlement manager
+	// role.
+	CancelAssetPayment(context.Context, *MsgCancelAssetPaymentRequest) (*MsgCancelAssetPaymentResponse, error)
+	// ExpireAssetPayment cancels an open payment created by CreateAssetPayment once it has
+	// expired and returns its escrow to the principal. Any account may sign it.
+	ExpireAssetPayment(context.Context, *MsgExpireAssetPaymentRequest) (*MsgExpireAssetPaymentResponse, error)
+	// UpdateAcquisitionPolicy replaces the policy restricting which assets a vault may price and
+	// acquire. Must be signed by the vault admin.
+	UpdateAcquisitionPolicy(context.Context, *MsgUpdateAcquisitionPolicyRequest) (*MsgUpdateAcquisitionPolicyResponse, error)
+	// UpdateConcentrationLimits sets the largest shares of total vault value a single
+	// non-underlying asset, and all non-underlying assets together, may make up. Must be signed
+	// by the vault admin.
+	UpdateConcentrationLimits(context.Context, *MsgUpdateConcentrationLimitsRequest) (*MsgUpdateConcentrationLimitsResponse, error)
+	// UpdateMinLiquidity sets the share of total vault value a vault must keep liquid in its
+	// underlying asset. Must be signed by the vault admin.
+	UpdateMinLiquidity(context.Context, *MsgUpdateMinLiquidityRequest) (*MsgUpdateMinLiquidityResponse, error)
+	// CollectAssetIncome moves income earned by a held asset from the vault's principal into
+	// its interest reserves, or back. Must be signed by a holder of the vault's settlement
+	// manager role.
+	CollectAssetIncome(context.Context, *MsgCollectAssetIncomeRequest) (*MsgCollectAssetIncomeResponse, error)
+	// GrantRole authorizes an address to perform the operations of a role on a vault. Must be
+	// signed by the vault admin.
+	GrantRole(context.Context, *MsgGrantRoleRequest) (*MsgGrantRoleResponse, error)
+	// RevokeRole removes an address's grant of a role on a vault. Must be signed by the vault
+	// admin.
+	RevokeRole(context.Context, *MsgRevokeRoleRequest) (*MsgRevokeRoleResponse, error)
+	// ProposeAdminTransfer proposes a new admin for a vault, replacing any pending proposal.
+	// Must be signed by the vault admin.
+	ProposeAdminTransfer(context.Context, *MsgProposeAdminTransferRequest) (*MsgProposeAdminTransferResponse, error)
+	// AcceptAdminTransfer completes a pending admin transfer. Must be signed by the proposed
+	// admin before the proposal expires.
+	AcceptAdminTransfer(context.Context, *MsgAcceptAdminTransferRequest) (*MsgAcceptAdminTransferResponse, error)
+	// CancelAdminTransfer withdraws a pending admin transfer. Must be signed by the vault admin.
+	CancelAdminTransfer(context.Context, *MsgCancelAdminTransferRequest) (*MsgCancelAdminTransferResponse, error)
+	// RecoverVaultAdmin replaces a vault's admin immediately, for vaults whose admin key is lost
+	// or compromised. Must be signed by the governance module account.
+	RecoverVaultAdmin(context.Context, *MsgRecoverVaultAdminRequest) (*MsgRecoverVaultAdminResponse, error)
+	// UpdateTimelock sets the delay applied to the vault's sensitive configuration changes.
+	// Raising it takes effect immediately; lowering it is itself subject to the current timelock.
+	// Must be signed by the vault admin.
+	UpdateTimelock(context.Context, *MsgUpdateTimelockRequest) (*MsgUpdateTimelockResponse, error)
+	// CancelScheduledAction discards a scheduled action before it executes. Must be signed by
+	// the vault admin or a holder of the vault's guardian role.
+	CancelScheduledAction(context.Context, *MsgCancelScheduledActionRequest) (*MsgCancelScheduledActionResponse, error)
+	// UpdateEligibilityPolicy replaces the policy restricting which addresses may subscribe to
+	// and hold a vault's shares. Must be signed by the vault admin.
+	UpdateEligibilityPolicy(context.Context, *MsgUpdateEligibilityPolicyRequest) (*MsgUpdateEligibilityPolicyResponse, error)
+	// UpdateAllowlist adds addresses to and removes addresses from a vault's investor
+	// allowlist. Must be signed by the vault admin.
+	UpdateAllowlist(context.Context, *MsgUpdateAllowlistRequest) (*MsgUpdateAllowlistResponse, error)
+	// UpdatePositionCap sets the largest position a single account may build in a vault through
+	// swap-ins, and the addresses exempt from it. Must be signed by a holder of the vault's
+	// limits manager role.
+	UpdatePositionCap(context.Context, *MsgUpdatePositionCapRequest) (*MsgUpdatePositionCapResponse, error)
+	// UpdateMaxTotalValue sets the net total value a vault may reach through swap-ins. Must be
+	// signed by a holder of the vault's limits manager role.
+	UpdateMaxTotalValue(context.Context, *MsgUpdateMaxTotalValueRequest) (*MsgUpdateMaxTotalValueResponse, error)
+	// UpdateCircuitBreaker trips or resets the module-wide circuit breaker and, when signed by
+	// governance, appoints or removes its guardian. Must be signed by governance or the circuit
+	// breaker guardian.
+	UpdateCircuitBreaker(context.Context, *MsgUpdateCircuitBreakerRequest) (*MsgUpdateCircuitBreakerResponse, error)
 }
 
-func (m *MsgRejectAssetResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+// UnimplementedMsgServer can be embedded to have forward compatible implementations.
+type UnimplementedMsgServer struct {
 }
 
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
+func (*UnimplementedMsgServer) CreateVault(ctx context.Context, req *MsgCreateVaultRequest) (*MsgCreateVaultResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateVault not implemented")
 }
-func (m *MsgCreateVaultRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Admin)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.ShareDenom)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.UnderlyingAsset)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.PaymentDenom)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if m.WithdrawalDelaySeconds != 0 {
-		n += 1 + sovTx(uint64(m.WithdrawalDelaySeconds))
-	}
-	l = len(m.MinSwapInValue)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.MinSwapOutValue)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.MaxSwapInValue)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.MaxSwapOutValue)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.Authority)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	return n
+func (*UnimplementedMsgServer) SetShareDenomMetadata(ctx context.Context, req *MsgSetShareDenomMetadataRequest) (*MsgSetShareDenomMetadataResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetShareDenomMetadata not implemented")
 }
-
-func (m *MsgCreateVaultResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.VaultAddress)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	return n
+func (*UnimplementedMsgServer) SwapIn(ctx context.Context, req *MsgSwapInRequest) (*MsgSwapInResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SwapIn not implemented")
 }
-
-func (m *MsgSetShareDenomMetadataRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.Metadata.Size()
-	n += 1 + l + sovTx(uint64(l))
-	l = len(m.Admin)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.VaultAddress)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	return n
+func (*UnimplementedMsgServer) SwapOut(ctx context.Context, req *MsgSwapOutRequest) (*MsgSwapOutResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SwapOut not implemented")
 }
-
-func (m *MsgSetShareDenomMetadataResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
+func (*UnimplementedMsgServer) UpdateMinInterestRate(ctx context.Context, req *MsgUpdateMinInterestRateRequest) (*MsgUpdateMinInterestRateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateMinInterestRate not implemented")
 }
-
-func (m *MsgSwapInRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Owner)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.VaultAddress)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = m.Assets.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
+func (*UnimplementedMsgServer) UpdateMaxInterestRate(ctx context.Context, req *MsgUpdateMaxInterestRateRequest) (*MsgUpdateMaxInterestRateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateMaxInterestRate not implemented")
+}
+func (*UnimplementedMsgServer) UpdateInterestRate(ctx context.Context, req *MsgUpdateInterestRateRequest) (*MsgUpdateInterestRateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateInterestRate not implemented")
+}
+func (*UnimplementedMsgServer) UpdateWithdrawalDelay(ctx context.Context, req *MsgUpdateWithdrawalDelayRequest) (*MsgUpdateWithdrawalDelayResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateWithdrawalDelay not implemented")
+}
+func (*UnimplementedMsgServer) UpdateMinSwapInValue(ctx context.Context, req *MsgUpdateMinSwapInValueRequest) (*MsgUpdateMinSwapInValueResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateMinSwapInValue not implemented")
+}
+func (*UnimplementedMsgServer) UpdateMinSwapOutValue(ctx context.Context, req *MsgUpdateMinSwapOutValueRequest) (*MsgUpdateMinSwapOutValueResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateMinSwapOutValue not implemented")
+}
+func (*UnimplementedMsgServer) UpdateMaxSwapInValue(ctx context.Context, req *MsgUpdateMaxSwapInValueRequest) (*MsgUpdateMaxSwapInValueResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateMaxSwapInValue not implemented")
+}
+func (*UnimplementedMsgServer) UpdateMaxSwapOutValue(ctx context.Context, req *MsgUpdateMaxSwapOutValueRequest) (*MsgUpdateMaxSwapOutValueResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateMaxSwapOutValue not implemented")
+}
+func (*UnimplementedMsgServer) ToggleSwapIn(ctx context.Context, req *MsgToggleSwapInRequest) (*MsgToggleSwapInResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ToggleSwapIn not implemented")
+}
+func (*UnimplementedMsgServer) ToggleSwapOut(ctx context.Context, req *MsgToggleSwapOutRequest) (*MsgToggleSwapOutResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ToggleSwapOut not implemented")
+}
+func (*UnimplementedMsgServer) DepositInterestFunds(ctx context.Context, req *MsgDepositInterestFundsRequest) (*MsgDepositInterestFundsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DepositInterestFunds not implemented")
+}
+func (*UnimplementedMsgServer) WithdrawInterestFunds(ctx context.Context, req *MsgWithdrawInterestFundsRequest) (*MsgWithdrawInterestFundsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WithdrawInterestFunds not implemented")
+}
+func (*UnimplementedMsgServer) DepositPrincipalFunds(ctx context.Context, req *MsgDepositPrincipalFundsRequest) (*MsgDepositPrincipalFundsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DepositPrincipalFunds not implemented")
+}
+func (*UnimplementedMsgServer) WithdrawPrincipalFunds(ctx context.Context, req *MsgWithdrawPrincipalFundsRequest) (*MsgWithdrawPrincipalFundsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WithdrawPrincipalFunds not implemented")
+}
+func (*UnimplementedMsgServer) ExpeditePendingSwapOut(ctx context.Context, req *MsgExpeditePendingSwapOutRequest) (*MsgExpeditePendingSwapOutResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExpeditePendingSwapOut not implemented")
+}
+func (*UnimplementedMsgServer) PauseVault(ctx context.Context, req *MsgPauseVaultRequest) (*MsgPauseVaultResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PauseVault not implemented")
+}
+func (*UnimplementedMsgServer) UnpauseVault(ctx context.Context, req *MsgUnpauseVaultRequest) (*MsgUnpauseVaultResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnpauseVault not implemented")
+}
+func (*UnimplementedMsgServer) SetBridgeAddress(ctx context.Context, req *MsgSetBridgeAddressRequest) (*MsgSetBridgeAddressResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetBridgeAddress not implemented")
+}
+func (*UnimplementedMsgServer) ToggleBridge(ctx context.Context, req *MsgToggleBridgeRequest) (*MsgToggleBridgeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ToggleBridge not implemented")
+}
+func (*UnimplementedMsgServer) BridgeMintShares(ctx context.Context, req *MsgBridgeMintSharesRequest) (*MsgBridgeMintSharesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BridgeMintShares not implemented")
+}
+func (*UnimplementedMsgServer) BridgeBurnShares(ctx context.Context, req *MsgBridgeBurnSharesRequest) (*MsgBridgeBurnSharesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BridgeBurnShares not implemented")
+}
+func (*UnimplementedMsgServer) SetAssetManager(ctx context.Context, req *MsgSetAssetManagerRequest) (*MsgSetAssetManagerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetAssetManager not implemented")
+}
+func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgUpdateParamsRequest) (*MsgUpdateParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
+}
+func (*UnimplementedMsgServer) UpdateVaultAUMFeeBips(ctx context.Context, req *MsgUpdateVaultAUMFeeBipsRequest) (*MsgUpdateVaultAUMFeeBipsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateVaultAUMFeeBips not implemented")
+}
+func (*UnimplementedMsgServer) UpdateVaultNAV(ctx context.Context, req *MsgUpdateVaultNAVRequest) (*MsgUpdateVaultNAVResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateVaultNAV not implemented")
+}
+func (*UnimplementedMsgServer) RemoveVaultNAV(ctx context.Context, req *MsgRemoveVaultNAVRequest) (*MsgRemoveVaultNAVResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveVaultNAV not implemented")
+}
+func (*UnimplementedMsgServer) UpdateNAVAuthority(ctx context.Context, req *MsgUpdateNAVAuthorityRequest) (*MsgUpdateNAVAuthorityResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateNAVAuthority not implemented")
+}
+func (*UnimplementedMsgServer) AcceptAsset(ctx context.Context, req *MsgAcceptAssetRequest) (*MsgAcceptAssetResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AcceptAsset not implemented")
+}
+func (*UnimplementedMsgServer) RejectAsset(ctx context.Context, req *MsgRejectAssetRequest) (*MsgRejectAssetResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RejectAsset not implemented")
+}
+func (*UnimplementedMsgServer) UpdateNAVStalenessLimit(ctx context.Context, req *MsgUpdateNAVStalenessLimitRequest) (*MsgUpdateNAVStalenessLimitResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateNAVStalenessLimit not implemented")
+}
+func (*UnimplementedMsgServer) UpdateNAVChangeLimit(ctx context.Context, req *MsgUpdateNAVChangeLimitRequest) (*MsgUpdateNAVChangeLimitResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateNAVChangeLimit not implemented")
+}
+func (*UnimplementedMsgServer) UpdateSettlementTolerance(ctx context.Context, req *MsgUpdateSettlementToleranceRequest) (*MsgUpdateSettlementToleranceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateSettlementTolerance not implemented")
+}
+func (*UnimplementedMsgServer) ApproveNAVProposal(ctx context.Context, req *MsgApproveNAVProposalRequest) (*MsgApproveNAVProposalResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ApproveNAVProposal not implemented")
+}
+func (*UnimplementedMsgServer) UpdateNAVSources(ctx context.Context, req *MsgUpdateNAVSourcesRequest) (*MsgUpdateNAVSourcesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateNAVSources not implemented")
+}
+func (*UnimplementedMsgServer) SubmitSourceNAV(ctx context.Context, req *MsgSubmitSourceNAVRequest) (*MsgSubmitSourceNAVResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SubmitSourceNAV not implemented")
+}
+func (*UnimplementedMsgServer) BatchUpdateVaultNAV(ctx context.Context, req *MsgBatchUpdateVaultNAVRequest) (*MsgBatchUpdateVaultNAVResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchUpdateVaultNAV not implemented")
+}
+func (*UnimplementedMsgServer) UpdatePriceSources(ctx context.Context, req *MsgUpdatePriceSourcesRequest) (*MsgUpdatePriceSourcesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdatePriceSources not implemented")
+}
+func (*UnimplementedMsgServer) UpdateAssetHaircut(ctx context.Context, req *MsgUpdateAssetHaircutRequest) (*MsgUpdateAssetHaircutResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateAssetHaircut not implemented")
+}
+func (*UnimplementedMsgServer) BatchAcceptAssets(ctx context.Context, req *MsgBatchAcceptAssetsRequest) (*MsgBatchAcceptAssetsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchAcceptAssets not implemented")
+}
+func (*UnimplementedMsgServer) CreateAssetPayment(ctx context.Context, req *MsgCreateAssetPaymentRequest) (*MsgCreateAssetPaymentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateAssetPayment not implemented")
+}
+func (*UnimplementedMsgServer) CancelAssetPayment(ctx context.Context, req *MsgCancelAssetPaymentRequest) (*MsgCancelAssetPaymentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelAssetPayment not implemented")
+}
+func (*UnimplementedMsgServer) ExpireAssetPayment(ctx context.Context, req *MsgExpireAssetPaymentRequest) (*MsgExpireAssetPaymentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExpireAssetPayment not implemented")
+}
+func (*UnimplementedMsgServer) UpdateAcquisitionPolicy(ctx context.Context, req *MsgUpdateAcquisitionPolicyRequest) (*MsgUpdateAcquisitionPolicyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateAcquisitionPolicy not implemented")
+}
+func (*UnimplementedMsgServer) UpdateConcentrationLimits(ctx context.Context, req *MsgUpdateConcentrationLimitsRequest) (*MsgUpdateConcentrationLimitsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateConcentrationLimits not implemented")
+}
+func (*UnimplementedMsgServer) UpdateMinLiquidity(ctx context.Context, req *MsgUpdateMinLiquidityRequest) (*MsgUpdateMinLiquidityResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateMinLiquidity not implemented")
+}
+func (*UnimplementedMsgServer) CollectAssetIncome(ctx context.Context, req *MsgCollectAssetIncomeRequest) (*MsgCollectAssetIncomeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CollectAssetIncome not implemented")
+}
+func (*UnimplementedMsgServer) GrantRole(ctx context.Context, req *MsgGrantRoleRequest) (*MsgGrantRoleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GrantRole not implemented")
+}
+func (*UnimplementedMsgServer) RevokeRole(ctx context.Context, req *MsgRevokeRoleRequest) (*MsgRevokeRoleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeRole not implemented")
+}
+func (*UnimplementedMsgServer) ProposeAdminTransfer(ctx context.Context, req *MsgProposeAdminTransferRequest) (*MsgProposeAdminTransferResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ProposeAdminTransfer not implemented")
+}
+func (*UnimplementedMsgServer) AcceptAdminTransfer(ctx context.Context, req *MsgAcceptAdminTransferRequest) (*MsgAcceptAdminTransferResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AcceptAdminTransfer not implemented")
+}
+func (*UnimplementedMsgServer) CancelAdminTransfer(ctx context.Context, req *MsgCancelAdminTransferRequest) (*MsgCancelAdminTransferResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelAdminTransfer not implemented")
+}
+func (*UnimplementedMsgServer) RecoverVaultAdmin(ctx context.Context, req *MsgRecoverVaultAdminRequest) (*MsgRecoverVaultAdminResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RecoverVaultAdmin not implemented")
+}
+func (*UnimplementedMsgServer) UpdateTimelock(ctx context.Context, req *MsgUpdateTimelockRequest) (*MsgUpdateTimelockResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateTimelock not implemented")
+}
+func (*UnimplementedMsgServer) CancelScheduledAction(ctx context.Context, req *MsgCancelScheduledActionRequest) (*MsgCancelScheduledActionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelScheduledAction not implemented")
+}
+func (*UnimplementedMsgServer) UpdateEligibilityPolicy(ctx context.Context, req *MsgUpdateEligibilityPolicyRequest) (*MsgUpdateEligibilityPolicyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateEligibilityPolicy not implemented")
+}
+func (*UnimplementedMsgServer) UpdateAllowlist(ctx context.Context, req *MsgUpdateAllowlistRequest) (*MsgUpdateAllowlistResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateAllowlist not implemented")
+}
+func (*UnimplementedMsgServer) UpdatePositionCap(ctx context.Context, req *MsgUpdatePositionCapRequest) (*MsgUpdatePositionCapResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdatePositionCap not implemented")
+}
+func (*UnimplementedMsgServer) UpdateMaxTotalValue(ctx context.Context, req *MsgUpdateMaxTotalValueRequest) (*MsgUpdateMaxTotalValueResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateMaxTotalValue not implemented")
+}
+func (*UnimplementedMsgServer) UpdateCircuitBreaker(ctx context.Context, req *MsgUpdateCircuitBreakerRequest) (*MsgUpdateCircuitBreakerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateCircuitBreaker not implemented")
 }
 
-func (m *MsgSwapInResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.SharesReceived.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
+func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
+	s.RegisterService(&_Msg_serviceDesc, srv)
 }
 
-func (m *MsgSwapOutRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_CreateVault_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCreateVaultRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.Owner)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if interceptor == nil {
+		return srv.(MsgServer).CreateVault(ctx, in)
 	}
-	l = len(m.VaultAddress)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provlabs.vault.v1.Msg/CreateVault",
 	}
-	l = m.Assets.Size()
-	n += 1 + l + sovTx(uint64(l))
-	l = len(m.RedeemDenom)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CreateVault(ctx, req.(*MsgCreateVaultRequest))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgSwapOutResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_SetShareDenomMetadata_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetShareDenomMetadataRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if m.RequestId != 0 {
-		n += 1 + sovTx(uint64(m.RequestId))
+	if interceptor == nil {
+		return srv.(MsgServer).SetShareDenomMetadata(ctx, in)
 	}
-	return n
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provlabs.vault.v1.Msg/SetShareDenomMetadata",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetShareDenomMetadata(ctx, req.(*MsgSetShareDenomMetadataRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgUpdateMinInterestRateRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_SwapIn_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSwapInRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.Admin)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if interceptor == nil {
+		return srv.(MsgServer).SwapIn(ctx, in)
 	}
-	l = len(m.VaultAddress)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provlabs.vault.v1.Msg/SwapIn",
 	}
-	l = len(m.MinRate)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SwapIn(ctx, req.(*MsgSwapInRequest))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgUpdateMinInterestRateResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_SwapOut_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSwapOutRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	if interceptor == nil {
+		return srv.(MsgServer).SwapOut(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provlabs.vault.v1.Msg/SwapOut",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SwapOut(ctx, req.(*MsgSwapOutRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgUpdateMaxInterestRateRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_UpdateMinInterestRate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateMinInterestRateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.Admin)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateMinInterestRate(ctx, in)
 	}
-	l = len(m.VaultAddress)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provlabs.vault.v1.Msg/UpdateMinInterestRate",
 	}
-	l = len(m.MaxRate)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateMinInterestRate(ctx, req.(*MsgUpdateMinInterestRateRequest))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgUpdateMaxInterestRateResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_UpdateMaxInterestRate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateMaxInterestRateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateMaxInterestRate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provlabs.vault.v1.Msg/UpdateMaxInterestRate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateMaxInterestRate(ctx, req.(*MsgUpdateMaxInterestRateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgUpdateInterestRateRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_UpdateInterestRate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateInterestRateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.Authority)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateInterestRate(ctx, in)
 	}
-	l = len(m.VaultAddress)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provlabs.vault.v1.Msg/UpdateInterestRate",
 	}
-	l = len(m.NewRate)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateInterestRate(ctx, req.(*MsgUpdateInterestRateRequest))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgUpdateInterestRateResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_UpdateWithdrawalDelay_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateWithdrawalDelayRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateWithdrawalDelay(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provlabs.vault.v1.Msg/UpdateWithdrawalDelay",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateWithdrawalDelay(ctx, req.(*MsgUpdateWithdrawalDelayRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgUpdateWithdrawalDelayRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_UpdateMinSwapInValue_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateMinSwapInValueRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.Authority)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateMinSwapInValue(ctx, in)
 	}
-	l = len(m.VaultAddress)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provlabs.vault.v1.Msg/UpdateMinSwapInValue",
 	}
-	if m.WithdrawalDelaySeconds != 0 {
-		n += 1 + sovTx(uint64(m.WithdrawalDelaySeconds))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateMinSwapInValue(ctx, req.(*MsgUpdateMinSwapInValueRequest))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgUpdateWithdrawalDelayResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_UpdateMinSwapOutValue_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateMinSwapOutValueRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateMinSwapOutValue(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provlabs.vault.v1.Msg/UpdateMinSwapOutValue",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateMinSwapOutValue(ctx, req.(*MsgUpdateMinSwapOutValueRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgUpdateMinSwapInValueRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_UpdateMaxSwapInValue_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateMaxSwapInValueRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.Authority)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateMaxSwapInValue(ctx, in)
 	}
-	l = len(m.VaultAddress)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provlabs.vault.v1.Msg/UpdateMaxSwapInValue",
 	}
-	l = len(m.MinSwapInValue)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateMaxSwapInValue(ctx, req.(*MsgUpdateMaxSwapInValueRequest))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgUpdateMinSwapInValueResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_UpdateMaxSwapOutValue_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateMaxSwapOutValueRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateMaxSwapOutValue(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provlabs.vault.v1.Msg/UpdateMaxSwapOutValue",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateMaxSwapOutValue(ctx, req.(*MsgUpdateMaxSwapOutValueRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgUpdateMinSwapOutValueRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_ToggleSwapIn_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgToggleSwapInRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.Authority)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if interceptor == nil {
+		return srv.(MsgServer).ToggleSwapIn(ctx, in)
 	}
-	l = len(m.VaultAddress)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provlabs.vault.v1.Msg/ToggleSwapIn",
 	}
-	l = len(m.MinSwapOutValue)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ToggleSwapIn(ctx, req.(*MsgToggleSwapInRequest))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgUpdateMinSwapOutValueResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_ToggleSwapOut_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgToggleSwapOutRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	if interceptor == nil {
+		return srv.(MsgServer).ToggleSwapOut(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provlabs.vault.v1.Msg/ToggleSwapOut",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ToggleSwapOut(ctx, req.(*MsgToggleSwapOutRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgUpdateMaxSwapInValueRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_DepositInterestFunds_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgDepositInterestFundsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.Authority)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if interceptor == nil {
+		return srv.(MsgServer).DepositInterestFunds(ctx, in)
 	}
-	l = len(m.VaultAddress)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provlabs.vault.v1.Msg/DepositInterestFunds",
 	}
-	l = len(m.MaxSwapInValue)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).DepositInterestFunds(ctx, req.(*MsgDepositInterestFundsRequest))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgUpdateMaxSwapInValueResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_WithdrawInterestFunds_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgWithdrawInterestFundsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
-}
-
-func (m *MsgUpdateMaxSwapOutValueRequest) Size() (n int) {
-	if m == nil {
-		return 0
+	if interceptor == nil {
+		return srv.(MsgServer).WithdrawInterestFunds(ctx, in)
 	}
-	var l int
-	_ = l
-	l = len(m.Authority)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.VaultAddress)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provlabs.vault.v1.Msg/WithdrawInterestFunds",
 	}
-	l = len(m.MaxSwapOutValue)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).WithdrawInterestFunds(ctx, req.(*MsgWithdrawInterestFundsRequest))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgUpdateMaxSwapOutValueResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_DepositPrincipalFunds_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgDepositPrincipalFundsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	if interceptor == nil {
+		return srv.(MsgServer).DepositPrincipalFunds(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provlabs.vault.v1.Msg/DepositPrincipalFunds",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).DepositPrincipalFunds(ctx, req.(*MsgDepositPrincipalFundsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgToggleSwapInRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_WithdrawPrincipalFunds_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgWithdrawPrincipalFundsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.Admin)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if interceptor == nil {
+		return srv.(MsgServer).WithdrawPrincipalFunds(ctx, in)
 	}
-	l = len(m.VaultAddress)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provlabs.vault.v1.Msg/WithdrawPrincipalFunds",
 	}
-	if m.Enabled {
-		n += 2
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).WithdrawPrincipalFunds(ctx, req.(*MsgWithdrawPrincipalFundsRequest))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgToggleSwapInResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_ExpeditePendingSwapOut_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgExpeditePendingSwapOutRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	if interceptor == nil {
+		return srv.(MsgServer).ExpeditePendingSwapOut(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provlabs.vault.v1.Msg/ExpeditePendingSwapOut",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ExpeditePendingSwapOut(ctx, req.(*MsgExpeditePendingSwapOutRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgToggleSwapOutRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_PauseVault_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgPauseVaultRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.Admin)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if interceptor == nil {
+		return srv.(MsgServer).PauseVault(ctx, in)
 	}
-	l = len(m.VaultAddress)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provlabs.vault.v1.Msg/PauseVault",
 	}
-	if m.Enabled {
-		n += 2
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).PauseVault(ctx, req.(*MsgPauseVaultRequest))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgToggleSwapOutResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_UnpauseVault_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUnpauseVaultRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
-}
-
-func (m *MsgDepositInterestFundsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+	if interceptor == nil {
+		return srv.(MsgServer).UnpauseVault(ctx, in)
 	}
-	var l int
-	_ = l
-	l = len(m.Authority)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provlabs.vault.v1.Msg/UnpauseVault",
 	}
-	l = len(m.VaultAddress)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UnpauseVault(ctx, req.(*MsgUnpauseVaultRequest))
 	}
-	l = m.Amount.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgDepositInterestFundsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_SetBridgeAddress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetBridgeAddressRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
-}
-
-func (m *MsgWithdrawInterestFundsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+	if interceptor == nil {
+		return srv.(MsgServer).SetBridgeAddress(ctx, in)
 	}
-	var l int
-	_ = l
-	l = len(m.Authority)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provlabs.vault.v1.Msg/SetBridgeAddress",
 	}
-	l = len(m.VaultAddress)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetBridgeAddress(ctx, req.(*MsgSetBridgeAddressRequest))
 	}
-	l = m.Amount.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgWithdrawInterestFundsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_ToggleBridge_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgToggleBridgeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
-}
-
-func (m *MsgDepositPrincipalFundsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+	if interceptor == nil {
+		return srv.(MsgServer).ToggleBridge(ctx, in)
 	}
-	var l int
-	_ = l
-	l = len(m.Authority)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provlabs.vault.v1.Msg/ToggleBridge",
 	}
-	l = len(m.VaultAddress)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ToggleBridge(ctx, req.(*MsgToggleBridgeRequest))
 	}
-	l = m.Amount.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgDepositPrincipalFundsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_BridgeMintShares_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgBridgeMintSharesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
-}
-
-func (m *MsgWithdrawPrincipalFundsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+	if interceptor == nil {
+		return srv.(MsgServer).BridgeMintShares(ctx, in)
 	}
-	var l int
-	_ = l
-	l = len(m.Authority)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provlabs.vault.v1.Msg/BridgeMintShares",
 	}
-	l = len(m.VaultAddress)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).BridgeMintShares(ctx, req.(*MsgBridgeMintSharesRequest))
 	}
-	l = m.Amount.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgWithdrawPrincipalFundsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_BridgeBurnShares_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgBridgeBurnSharesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
-}
-
-func (m *MsgExpeditePendingSwapOutRequest) Size() (n int) {
-	if m == nil {
-		return 0
+	if interceptor == nil {
+		return srv.(MsgServer).BridgeBurnShares(ctx, in)
 	}
-	var l int
-	_ = l
-	l = len(m.Authority)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provlabs.vault.v1.Msg/BridgeBurnShares",
 	}
-	if m.RequestId != 0 {
-		n += 1 + sovTx(uint64(m.RequestId))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).BridgeBurnShares(ctx, req.(*MsgBridgeBurnSharesRequest))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgExpeditePendingSwapOutResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_SetAssetManager_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetAssetManagerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	if interceptor == nil {
+		return srv.(MsgServer).SetAssetManager(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provlabs.vault.v1.Msg/SetAssetManager",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetAssetManager(ctx, req.(*MsgSetAssetManagerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgPauseVaultRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Authority)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	l = len(m.VaultAddress)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateParams(ctx, in)
 	}
-	l = len(m.Reason)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provlabs.vault.v1.Msg/UpdateParams",
 	}
-	if m.Force {
-		n += 2
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateParams(ctx, req.(*MsgUpdateParamsRequest))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgPauseVaultResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_UpdateVaultAUMFeeBips_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateVaultAUMFeeBipsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
-}
-
-func (m *MsgUnpauseVaultRequest) Size() (n int) {
-	if m == nil {
-		return 0
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateVaultAUMFeeBips(ctx, in)
 	}
-	var l int
-	_ = l
-	l = len(m.Authority)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provlabs.vault.v1.Msg/UpdateVaultAUMFeeBips",
 	}
-	l = len(m.VaultAddress)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateVaultAUMFeeBips(ctx, req.(*MsgUpdateVaultAUMFeeBipsRequest))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgUnpauseVaultResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_UpdateVaultNAV_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateVaultNAVRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateVaultNAV(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provlabs.vault.v1.Msg/UpdateVaultNAV",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateVaultNAV(ctx, req.(*MsgUpdateVaultNAVRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgSetBridgeAddressRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_RemoveVaultNAV_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRemoveVaultNAVRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.Admin)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if interceptor == nil {
+		return srv.(MsgServer).RemoveVaultNAV(ctx, in)
 	}
-	l = len(m.VaultAddress)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provlabs.vault.v1.Msg/RemoveVaultNAV",
 	}
-	l = len(m.BridgeAddress)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RemoveVaultNAV(ctx, req.(*MsgRemoveVaultNAVRequest))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgSetBridgeAddressResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_UpdateNAVAuthority_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateNAVAuthorityRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateNAVAuthority(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provlabs.vault.v1.Msg/UpdateNAVAuthority",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateNAVAuthority(ctx, req.(*MsgUpdateNAVAuthorityRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgToggleBridgeRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_AcceptAsset_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgAcceptAssetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.Admin)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if interceptor == nil {
+		return srv.(MsgServer).AcceptAsset(ctx, in)
 	}
-	l = len(m.VaultAddress)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provlabs.vault.v1.Msg/AcceptAsset",
 	}
-	if m.Enabled {
-		n += 2
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).AcceptAsset(ctx, req.(*MsgAcceptAssetRequest))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgToggleBridgeResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_RejectAsset_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRejectAssetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
-}
-
-func (m *MsgBridgeMintSharesRequest) Size() (n int) {
-	if m == nil {
-		return 0
+	if interceptor == nil {
+		return srv.(MsgServer).RejectAsset(ctx, in)
 	}
-	var l int
-	_ = l
-	l = len(m.Bridge)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provlabs.vault.v1.Msg/RejectAsset",
 	}
-	l = len(m.VaultAddress)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RejectAsset(ctx, req.(*MsgRejectAssetRequest))
 	}
-	l = m.Shares.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgBridgeMintSharesResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_UpdateNAVStalenessLimit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateNAVStalenessLimitRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateNAVStalenessLimit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provlabs.vault.v1.Msg/UpdateNAVStalenessLimit",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateNAVStalenessLimit(ctx, req.(*MsgUpdateNAVStalenessLimitRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgBridgeBurnSharesRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_UpdateNAVChangeLimit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateNAVChangeLimitRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.Bridge)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateNAVChangeLimit(ctx, in)
 	}
-	l = len(m.VaultAddress)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provlabs.vault.v1.Msg/UpdateNAVChangeLimit",
 	}
-	l = m.Shares.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
-}
-
-func (m *MsgBridgeBurnSharesResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateNAVChangeLimit(ctx, req.(*MsgUpdateNAVChangeLimitRequest))
 	}
-	var l int
-	_ = l
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgSetAssetManagerRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_UpdateSettlementTolerance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateSettlementToleranceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.Admin)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateSettlementTolerance(ctx, in)
 	}
-	l = len(m.VaultAddress)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provlabs.vault.v1.Msg/UpdateSettlementTolerance",
 	}
-	l = len(m.AssetManager)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateSettlementTolerance(ctx, req.(*MsgUpdateSettlementToleranceRequest))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgSetAssetManagerResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_ApproveNAVProposal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgApproveNAVProposalRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	if interceptor == nil {
+		return srv.(MsgServer).ApproveNAVProposal(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provlabs.vault.v1.Msg/ApproveNAVProposal",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ApproveNAVProposal(ctx, req.(*MsgApproveNAVProposalRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgUpdateParamsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_UpdateNAVSources_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateNAVSourcesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.Authority)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateNAVSources(ctx, in)
 	}
-	l = m.Params.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provlabs.vault.v1.Msg/UpdateNAVSources",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateNAVSources(ctx, req.(*MsgUpdateNAVSourcesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgUpdateParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_SubmitSourceNAV_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSubmitSourceNAVRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	if interceptor == nil {
+		return srv.(MsgServer).SubmitSourceNAV(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provlabs.vault.v1.Msg/SubmitSourceNAV",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SubmitSourceNAV(ctx, req.(*MsgSubmitSourceNAVRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgUpdateVaultAUMFeeBipsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_BatchUpdateVaultNAV_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgBatchUpdateVaultNAVRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.Authority)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if interceptor == nil {
+		return srv.(MsgServer).BatchUpdateVaultNAV(ctx, in)
 	}
-	l = len(m.VaultAddress)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provlabs.vault.v1.Msg/BatchUpdateVaultNAV",
 	}
-	if m.AumFeeBips != 0 {
-		n += 1 + sovTx(uint64(m.AumFeeBips))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).BatchUpdateVaultNAV(ctx, req.(*MsgBatchUpdateVaultNAVRequest))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgUpdateVaultAUMFeeBipsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_UpdatePriceSources_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdatePriceSourcesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	if interceptor == nil {
+		return srv.(MsgServer).UpdatePriceSources(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provlabs.vault.v1.Msg/UpdatePriceSources",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdatePriceSources(ctx, req.(*MsgUpdatePriceSourcesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgUpdateVaultNAVRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Signer)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+func _Msg_UpdateAssetHaircut_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateAssetHaircutRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	l = len(m.VaultAddress)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateAssetHaircut(ctx, in)
 	}
-	l = len(m.Denom)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provlabs.vault.v1.Msg/UpdateAssetHaircut",
 	}
-	l = m.Price.Size()
-	n += 1 + l + sovTx(uint64(l))
-	l = m.Volume.Size()
-	n += 1 + l + sovTx(uint64(l))
-	l = len(m.Source)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateAssetHaircut(ctx, req.(*MsgUpdateAssetHaircutRequest))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgUpdateVaultNAVResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_BatchAcceptAssets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgBatchAcceptAssetsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	if interceptor == nil {
+		return srv.(MsgServer).BatchAcceptAssets(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provlabs.vault.v1.Msg/BatchAcceptAssets",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).BatchAcceptAssets(ctx, req.(*MsgBatchAcceptAssetsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgRemoveVaultNAVRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_CreateAssetPayment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCreateAssetPaymentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.Signer)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if interceptor == nil {
+		return srv.(MsgServer).CreateAssetPayment(ctx, in)
 	}
-	l = len(m.VaultAddress)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provlabs.vault.v1.Msg/CreateAssetPayment",
 	}
-	l = len(m.Denom)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CreateAssetPayment(ctx, req.(*MsgCreateAssetPaymentRequest))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgRemoveVaultNAVResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_CancelAssetPayment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCancelAssetPaymentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	if interceptor == nil {
+		return srv.(MsgServer).CancelAssetPayment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provlabs.vault.v1.Msg/CancelAssetPayment",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CancelAssetPayment(ctx, req.(*MsgCancelAssetPaymentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgUpdateNAVAuthorityRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_ExpireAssetPayment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgExpireAssetPaymentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.Signer)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if interceptor == nil {
+		return srv.(MsgServer).ExpireAssetPayment(ctx, in)
 	}
-	l = len(m.VaultAddress)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provlabs.vault.v1.Msg/ExpireAssetPayment",
 	}
-	l = len(m.NewAuthority)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ExpireAssetPayment(ctx, req.(*MsgExpireAssetPaymentRequest))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgUpdateNAVAuthorityResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_UpdateAcquisitionPolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateAcquisitionPolicyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateAcquisitionPolicy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provlabs.vault.v1.Msg/UpdateAcquisitionPolicy",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateAcquisitionPolicy(ctx, req.(*MsgUpdateAcquisitionPolicyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UpdateConcentrationLimits_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateConcentrationLimitsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateConcentrationLimits(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provlabs.vault.v1.Msg/UpdateConcentrationLimits",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateConcentrationLimits(ctx, req.(*MsgUpdateConcentrationLimitsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UpdateMinLiquidity_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateMinLiquidityRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateMinLiquidity(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provlabs.vault.v1.Msg/UpdateMinLiquidity",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateMinLiquidity(ctx, req.(*MsgUpdateMinLiquidityRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_CollectAssetIncome_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCollectAssetIncomeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CollectAssetIncome(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provlabs.vault.v1.Msg/CollectAssetIncome",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CollectAssetIncome(ctx, req.(*MsgCollectAssetIncomeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_GrantRole_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgGrantRoleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).GrantRole(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provlabs.vault.v1.Msg/GrantRole",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).GrantRole(ctx, req.(*MsgGrantRoleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RevokeRole_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRevokeRoleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RevokeRole(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provlabs.vault.v1.Msg/RevokeRole",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RevokeRole(ctx, req.(*MsgRevokeRoleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ProposeAdminTransfer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgProposeAdminTransferRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ProposeAdminTransfer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provlabs.vault.v1.Msg/ProposeAdminTransfer",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ProposeAdminTransfer(ctx, req.(*MsgProposeAdminTransferRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_AcceptAdminTransfer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgAcceptAdminTransferRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).AcceptAdminTransfer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provlabs.vault.v1.Msg/AcceptAdminTransfer",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).AcceptAdminTransfer(ctx, req.(*MsgAcceptAdminTransferRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_CancelAdminTransfer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCancelAdminTransferRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CancelAdminTransfer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provlabs.vault.v1.Msg/CancelAdminTransfer",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CancelAdminTransfer(ctx, req.(*MsgCancelAdminTransferRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RecoverVaultAdmin_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRecoverVaultAdminRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RecoverVaultAdmin(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provlabs.vault.v1.Msg/RecoverVaultAdmin",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RecoverVaultAdmin(ctx, req.(*MsgRecoverVaultAdminRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UpdateTimelock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateTimelockRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateTimelock(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provlabs.vault.v1.Msg/UpdateTimelock",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateTimelock(ctx, req.(*MsgUpdateTimelockRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_CancelScheduledAction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCancelScheduledActionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CancelScheduledAction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provlabs.vault.v1.Msg/CancelScheduledAction",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CancelScheduledAction(ctx, req.(*MsgCancelScheduledActionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UpdateEligibilityPolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateEligibilityPolicyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateEligibilityPolicy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provlabs.vault.v1.Msg/UpdateEligibilityPolicy",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateEligibilityPolicy(ctx, req.(*MsgUpdateEligibilityPolicyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UpdateAllowlist_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateAllowlistRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateAllowlist(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provlabs.vault.v1.Msg/UpdateAllowlist",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateAllowlist(ctx, req.(*MsgUpdateAllowlistRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UpdatePositionCap_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdatePositionCapRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdatePositionCap(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provlabs.vault.v1.Msg/UpdatePositionCap",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdatePositionCap(ctx, req.(*MsgUpdatePositionCapRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UpdateMaxTotalValue_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateMaxTotalValueRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateMaxTotalValue(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provlabs.vault.v1.Msg/UpdateMaxTotalValue",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateMaxTotalValue(ctx, req.(*MsgUpdateMaxTotalValueRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UpdateCircuitBreaker_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateCircuitBreakerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateCircuitBreaker(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provlabs.vault.v1.Msg/UpdateCircuitBreaker",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateCircuitBreaker(ctx, req.(*MsgUpdateCircuitBreakerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var Msg_serviceDesc = _Msg_serviceDesc
+var _Msg_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "provlabs.vault.v1.Msg",
+	HandlerType: (*MsgServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateVault",
+			Handler:    _Msg_CreateVault_Handler,
+		},
+		{
+			MethodName: "SetShareDenomMetadata",
+			Handler:    _Msg_SetShareDenomMetadata_Handler,
+		},
+		{
+			MethodName: "SwapIn",
+			Handler:    _Msg_SwapIn_Handler,
+		},
+		{
+			MethodName: "SwapOut",
+			Handler:    _Msg_SwapOut_Handler,
+		},
+		{
+			MethodName: "UpdateMinInterestRate",
+			Handler:    _Msg_UpdateMinInterestRate_Handler,
+		},
+		{
+			MethodName: "UpdateMaxInterestRate",
+			Handler:    _Msg_UpdateMaxInterestRate_Handler,
+		},
+		{
+			MethodName: "UpdateInterestRate",
+			Handler:    _Msg_UpdateInterestRate_Handler,
+		},
+		{
+			MethodName: "UpdateWithdrawalDelay",
+			Handler:    _Msg_UpdateWithdrawalDelay_Handler,
+		},
+		{
+			MethodName: "UpdateMinSwapInValue",
+			Handler:    _Msg_UpdateMinSwapInValue_Handler,
+		},
+		{
+			MethodName: "UpdateMinSwapOutValue",
+			Handler:    _Msg_UpdateMinSwapOutValue_Handler,
+		},
+		{
+			MethodName: "UpdateMaxSwapInValue",
+			Handler:    _Msg_UpdateMaxSwapInValue_Handler,
+		},
+		{
+			MethodName: "UpdateMaxSwapOutValue",
+			Handler:    _Msg_UpdateMaxSwapOutValue_Handler,
+		},
+		{
+			MethodName: "ToggleSwapIn",
+			Handler:    _Msg_ToggleSwapIn_Handler,
+		},
+		{
+			MethodName: "ToggleSwapOut",
+			Handler:    _Msg_ToggleSwapOut_Handler,
+		},
+		{
+			MethodName: "DepositInterestFunds",
+			Handler:    _Msg_DepositInterestFunds_Handler,
+		},
+		{
+			MethodName: "WithdrawInterestFunds",
+			Handler:    _Msg_WithdrawInterestFunds_Handler,
+		},
+		{
+			MethodName: "DepositPrincipalFunds",
+			Handler:    _Msg_DepositPrincipalFunds_Handler,
+		},
+		{
+			MethodName: "WithdrawPrincipalFunds",
+			Handler:    _Msg_WithdrawPrincipalFunds_Handler,
+		},
+		{
+			MethodName: "ExpeditePendingSwapOut",
+			Handler:    _Msg_ExpeditePendingSwapOut_Handler,
+		},
+		{
+			MethodName: "PauseVault",
+			Handler:    _Msg_PauseVault_Handler,
+		},
+		{
+			MethodName: "UnpauseVault",
+			Handler:    _Msg_UnpauseVault_Handler,
+		},
+		{
+			MethodName: "SetBridgeAddress",
+			Handler:    _Msg_SetBridgeAddress_Handler,
+		},
+		{
+			MethodName: "ToggleBridge",
+			Handler:    _Msg_ToggleBridge_Handler,
+		},
+		{
+			MethodName: "BridgeMintShares",
+			Handler:    _Msg_BridgeMintShares_Handler,
+		},
+		{
+			MethodName: "BridgeBurnShares",
+			Handler:    _Msg_BridgeBurnShares_Handler,
+		},
+		{
+			MethodName: "SetAssetManager",
+			Handler:    _Msg_SetAssetManager_Handler,
+		},
+		{
+			MethodName: "UpdateParams",
+			Handler:    _Msg_UpdateParams_Handler,
+		},
+		{
+			MethodName: "UpdateVaultAUMFeeBips",
+			Handler:    _Msg_UpdateVaultAUMFeeBips_Handler,
+		},
+		{
+			MethodName: "UpdateVaultNAV",
+			Handler:    _Msg_UpdateVaultNAV_Handler,
+		},
+		{
+			MethodName: "RemoveVaultNAV",
+			Handler:    _Msg_RemoveVaultNAV_Handler,
+		},
+		{
+			MethodName: "UpdateNAVAuthority",
+			Handler:    _Msg_UpdateNAVAuthority_Handler,
+		},
+		{
+			MethodName: "AcceptAsset",
+			Handler:    _Msg_AcceptAsset_Handler,
+		},
+		{
+			MethodName: "RejectAsset",
+			Handler:    _Msg_RejectAsset_Handler,
+		},
+		{
+			MethodName: "UpdateNAVStalenessLimit",
+			Handler:    _Msg_UpdateNAVStalenessLimit_Handler,
+		},
+		{
+			MethodName: "UpdateNAVChangeLimit",
+			Handler:    _Msg_UpdateNAVChangeLimit_Handler,
+		},
+		{
+			MethodName: "UpdateSettlementTolerance",
+			Handler:    _Msg_UpdateSettlementTolerance_Handler,
+		},
+		{
+			MethodName: "ApproveNAVProposal",
+			Handler:    _Msg_ApproveNAVProposal_Handler,
+		},
+		{
+			MethodName: "UpdateNAVSources",
+			Handler:    _Msg_UpdateNAVSources_Handler,
+		},
+		{
+			MethodName: "SubmitSourceNAV",
+			Handler:    _Msg_SubmitSourceNAV_Handler,
+		},
+		{
+			MethodName: "BatchUpdateVaultNAV",
+			Handler:    _Msg_BatchUpdateVaultNAV_Handler,
+		},
+		{
+			MethodName: "UpdatePriceSources",
+			Handler:    _Msg_UpdatePriceSources_Handler,
+		},
+		{
+			MethodName: "UpdateAssetHaircut",
+			Handler:    _Msg_UpdateAssetHaircut_Handler,
+		},
+		{
+			MethodName: "BatchAcceptAssets",
+			Handler:    _Msg_BatchAcceptAssets_Handler,
+		},
+		{
+			MethodName: "CreateAssetPayment",
+			Handler:    _Msg_CreateAssetPayment_Handler,
+		},
+		{
+			MethodName: "CancelAssetPayment",
+			Handler:    _Msg_CancelAssetPayment_Handler,
+		},
+		{
+			MethodName: "ExpireAssetPayment",
+			Handler:    _Msg_ExpireAssetPayment_Handler,
+		},
+		{
+			MethodName: "UpdateAcquisitionPolicy",
+			Handler:    _Msg_UpdateAcquisitionPolicy_Handler,
+		},
+		{
+			MethodName: "UpdateConcentrationLimits",
+			Handler:    _Msg_UpdateConcentrationLimits_Handler,
+		},
+		{
+			MethodName: "UpdateMinLiquidity",
+			Handler:    _Msg_UpdateMinLiquidity_Handler,
+		},
+		{
+			MethodName: "CollectAssetIncome",
+			Handler:    _Msg_CollectAssetIncome_Handler,
+		},
+		{
+			MethodName: "GrantRole",
+			Handler:    _Msg_GrantRole_Handler,
+		},
+		{
+			MethodName: "RevokeRole",
+			Handler:    _Msg_RevokeRole_Handler,
+		},
+		{
+			MethodName: "ProposeAdminTransfer",
+			Handler:    _Msg_ProposeAdminTransfer_Handler,
+		},
+		{
+			MethodName: "AcceptAdminTransfer",
+			Handler:    _Msg_AcceptAdminTransfer_Handler,
+		},
+		{
+			MethodName: "CancelAdminTransfer",
+			Handler:    _Msg_CancelAdminTransfer_Handler,
+		},
+		{
+			MethodName: "RecoverVaultAdmin",
+			Handler:    _Msg_RecoverVaultAdmin_Handler,
+		},
+		{
+			MethodName: "UpdateTimelock",
+			Handler:    _Msg_UpdateTimelock_Handler,
+		},
+		{
+			MethodName: "CancelScheduledAction",
+			Handler:    _Msg_CancelScheduledAction_Handler,
+		},
+		{
+			MethodName: "UpdateEligibilityPolicy",
+			Handler:    _Msg_UpdateEligibilityPolicy_Handler,
+		},
+		{
+			MethodName: "UpdateAllowlist",
+			Handler:    _Msg_UpdateAllowlist_Handler,
+		},
+		{
+			MethodName: "UpdatePositionCap",
+			Handler:    _Msg_UpdatePositionCap_Handler,
+		},
+		{
+			MethodName: "UpdateMaxTotalValue",
+			Handler:    _Msg_UpdateMaxTotalValue_Handler,
+		},
+		{
+			MethodName: "UpdateCircuitBreaker",
+			Handler:    _Msg_UpdateCircuitBreaker_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "provlabs/vault/v1/tx.proto",
+}
+
+func (m *MsgCreateVaultRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCreateVaultRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCreateVaultRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0x52
+	}
+	if len(m.MaxSwapOutValue) > 0 {
+		i -= len(m.MaxSwapOutValue)
+		copy(dAtA[i:], m.MaxSwapOutValue)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.MaxSwapOutValue)))
+		i--
+		dAtA[i] = 0x4a
+	}
+	if len(m.MaxSwapInValue) > 0 {
+		i -= len(m.MaxSwapInValue)
+		copy(dAtA[i:], m.MaxSwapInValue)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.MaxSwapInValue)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if len(m.MinSwapOutValue) > 0 {
+		i -= len(m.MinSwapOutValue)
+		copy(dAtA[i:], m.MinSwapOutValue)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.MinSwapOutValue)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.MinSwapInValue) > 0 {
+		i -= len(m.MinSwapInValue)
+		copy(dAtA[i:], m.MinSwapInValue)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.MinSwapInValue)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.WithdrawalDelaySeconds != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.WithdrawalDelaySeconds))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.PaymentDenom) > 0 {
+		i -= len(m.PaymentDenom)
+		copy(dAtA[i:], m.PaymentDenom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.PaymentDenom)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.UnderlyingAsset) > 0 {
+		i -= len(m.UnderlyingAsset)
+		copy(dAtA[i:], m.UnderlyingAsset)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.UnderlyingAsset)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ShareDenom) > 0 {
+		i -= len(m.ShareDenom)
+		copy(dAtA[i:], m.ShareDenom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ShareDenom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Admin) > 0 {
+		i -= len(m.Admin)
+		copy(dAtA[i:], m.Admin)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Admin)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCreateVaultResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCreateVaultResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCreateVaultResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.VaultAddress) > 0 {
+		i -= len(m.VaultAddress)
+		copy(dAtA[i:], m.VaultAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.VaultAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetShareDenomMetadataRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetShareDenomMetadataRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetShareDenomMetadataRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.VaultAddress) > 0 {
+		i -= len(m.VaultAddress)
+		copy(dAtA[i:], m.VaultAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.VaultAddress)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Admin) > 0 {
+		i -= len(m.Admin)
+		copy(dAtA[i:], m.Admin)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Admin)))
+		i--
+		dAtA[i] = 0x12
+	}
+	{
+		size := m.Metadata.Size()
+		i -= size
+		if _, err := m.Metadata.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetShareDenomMetadataResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetShareDenomMetadataResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetShareDenomMetadataResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSwapInRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSwapInRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSwapInRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Assets.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.VaultAddress) > 0 {
+		i -= len(m.VaultAddress)
+		copy(dAtA[i:], m.VaultAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.VaultAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSwapInResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSwapInResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSwapInResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.SharesReceived.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSwapOutRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSwapOutRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSwapOutRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.RedeemDenom) > 0 {
+		i -= len(m.RedeemDenom)
+		copy(dAtA[i:], m.RedeemDenom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.RedeemDenom)))
+		i--
+		dAtA[i] = 0x22
+	}
+	{
+		size, err := m.Assets.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.VaultAddress) > 0 {
+		i -= len(m.VaultAddress)
+		copy(dAtA[i:], m.VaultAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.VaultAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSwapOutResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSwapOutResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSwapOutResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.RequestId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.RequestId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateMinInterestRateRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateMinInterestRateRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateMinInterestRateRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.MinRate) > 0 {
+		i -= len(m.MinRate)
+		copy(dAtA[i:], m.MinRate)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.MinRate)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.VaultAddress) > 0 {
+		i -= len(m.VaultAddress)
+		copy(dAtA[i:], m.VaultAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.VaultAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Admin) > 0 {
+		i -= len(m.Admin)
+		copy(dAtA[i:], m.Admin)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Admin)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateMinInterestRateResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateMinInterestRateResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateMinInterestRateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateMaxInterestRateRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateMaxInterestRateRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateMaxInterestRateRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.MaxRate) > 0 {
+		i -= len(m.MaxRate)
+		copy(dAtA[i:], m.MaxRate)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.MaxRate)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.VaultAddress) > 0 {
+		i -= len(m.VaultAddress)
+		copy(dAtA[i:], m.VaultAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.VaultAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Admin) > 0 {
+		i -= len(m.Admin)
+		copy(dAtA[i:], m.Admin)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Admin)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateMaxInterestRateResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateMaxInterestRateResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateMaxInterestRateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateInterestRateRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateInterestRateRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateInterestRateRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.NewRate) > 0 {
+		i -= len(m.NewRate)
+		copy(dAtA[i:], m.NewRate)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.NewRate)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.VaultAddress) > 0 {
+		i -= len(m.VaultAddress)
+		copy(dAtA[i:], m.VaultAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.VaultAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateInterestRateResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateInterestRateResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateInterestRateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateWithdrawalDelayRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateWithdrawalDelayRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateWithdrawalDelayRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.WithdrawalDelaySeconds != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.WithdrawalDelaySeconds))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.VaultAddress) > 0 {
+		i -= len(m.VaultAddress)
+		copy(dAtA[i:], m.VaultAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.VaultAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateWithdrawalDelayResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateWithdrawalDelayResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateWithdrawalDelayResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateMinSwapInValueRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateMinSwapInValueRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateMinSwapInValueRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.MinSwapInValue) > 0 {
+		i -= len(m.MinSwapInValue)
+		copy(dAtA[i:], m.MinSwapInValue)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.MinSwapInValue)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.VaultAddress) > 0 {
+		i -= len(m.VaultAddress)
+		copy(dAtA[i:], m.VaultAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.VaultAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateMinSwapInValueResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateMinSwapInValueResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateMinSwapInValueResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateMinSwapOutValueRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateMinSwapOutValueRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateMinSwapOutValueRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.MinSwapOutValue) > 0 {
+		i -= len(m.MinSwapOutValue)
+		copy(dAtA[i:], m.MinSwapOutValue)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.MinSwapOutValue)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.VaultAddress) > 0 {
+		i -= len(m.VaultAddress)
+		copy(dAtA[i:], m.VaultAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.VaultAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateMinSwapOutValueResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateMinSwapOutValueResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateMinSwapOutValueResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateMaxSwapInValueRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateMaxSwapInValueRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateMaxSwapInValueRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.MaxSwapInValue) > 0 {
+		i -= len(m.MaxSwapInValue)
+		copy(dAtA[i:], m.MaxSwapInValue)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.MaxSwapInValue)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.VaultAddress) > 0 {
+		i -= len(m.VaultAddress)
+		copy(dAtA[i:], m.VaultAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.VaultAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateMaxSwapInValueResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateMaxSwapInValueResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateMaxSwapInValueResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateMaxSwapOutValueRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateMaxSwapOutValueRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateMaxSwapOutValueRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.MaxSwapOutValue) > 0 {
+		i -= len(m.MaxSwapOutValue)
+		copy(dAtA[i:], m.MaxSwapOutValue)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.MaxSwapOutValue)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.VaultAddress) > 0 {
+		i -= len(m.VaultAddress)
+		copy(dAtA[i:], m.VaultAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.VaultAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateMaxSwapOutValueResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateMaxSwapOutValueResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateMaxSwapOutValueResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgToggleSwapInRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgToggleSwapInRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgToggleSwapInRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Enabled {
+		i--
+		if m.Enabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.VaultAddress) > 0 {
+		i -= len(m.VaultAddress)
+		copy(dAtA[i:], m.VaultAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.VaultAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Admin) > 0 {
+		i -= len(m.Admin)
+		copy(dAtA[i:], m.Admin)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Admin)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgToggleSwapInResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgToggleSwapInResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgToggleSwapInResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgToggleSwapOutRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgToggleSwapOutRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgToggleSwapOutRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Enabled {
+		i--
+		if m.Enabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.VaultAddress) > 0 {
+		i -= len(m.VaultAddress)
+		copy(dAtA[i:], m.VaultAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.VaultAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Admin) > 0 {
+		i -= len(m.Admin)
+		copy(dAtA[i:], m.Admin)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Admin)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgToggleSwapOutResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgToggleSwapOutResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgToggleSwapOutResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgDepositInterestFundsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDepositInterestFundsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDepositInterestFundsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.VaultAddress) > 0 {
+		i -= len(m.VaultAddress)
+		copy(dAtA[i:], m.VaultAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.VaultAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgDepositInterestFundsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDepositInterestFundsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDepositInterestFundsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgWithdrawInterestFundsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgWithdrawInterestFundsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgWithdrawInterestFundsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.VaultAddress) > 0 {
+		i -= len(m.VaultAddress)
+		copy(dAtA[i:], m.VaultAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.VaultAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgWithdrawInterestFundsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgWithdrawInterestFundsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgWithdrawInterestFundsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgDepositPrincipalFundsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDepositPrincipalFundsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDepositPrincipalFundsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.VaultAddress) > 0 {
+		i -= len(m.VaultAddress)
+		copy(dAtA[i:], m.VaultAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.VaultAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgDepositPrincipalFundsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDepositPrincipalFundsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDepositPrincipalFundsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgWithdrawPrincipalFundsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgWithdrawPrincipalFundsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgWithdrawPrincipalFundsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.VaultAddress) > 0 {
+		i -= len(m.VaultAddress)
+		copy(dAtA[i:], m.VaultAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.VaultAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgWithdrawPrincipalFundsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgWithdrawPrincipalFundsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgWithdrawPrincipalFundsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgExpeditePendingSwapOutRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgExpeditePendingSwapOutRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgExpeditePendingSwapOutRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.RequestId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.RequestId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgExpeditePendingSwapOutResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgExpeditePendingSwapOutResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgExpeditePendingSwapOutResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgPauseVaultRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgPauseVaultRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgPauseVaultRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Force {
+		i--
+		if m.Force {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.VaultAddress) > 0 {
+		i -= len(m.VaultAddress)
+		copy(dAtA[i:], m.VaultAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.VaultAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgPauseVaultResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgPauseVaultResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgPauseVaultResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUnpauseVaultRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUnpauseVaultRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUnpauseVaultRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.VaultAddress) > 0 {
+		i -= len(m.VaultAddress)
+		copy(dAtA[i:], m.VaultAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.VaultAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUnpauseVaultResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUnpauseVaultResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUnpauseVaultResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetBridgeAddressRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetBridgeAddressRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetBridgeAddressRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.BridgeAddress) > 0 {
+		i -= len(m.BridgeAddress)
+		copy(dAtA[i:], m.BridgeAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.BridgeAddress)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.VaultAddress) > 0 {
+		i -= len(m.VaultAddress)
+		copy(dAtA[i:], m.VaultAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.VaultAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Admin) > 0 {
+		i -= len(m.Admin)
+		copy(dAtA[i:], m.Admin)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Admin)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetBridgeAddressResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetBridgeAddressResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetBridgeAddressResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgToggleBridgeRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgToggleBridgeRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgToggleBridgeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Enabled {
+		i--
+		if m.Enabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.VaultAddress) > 0 {
+		i -= len(m.VaultAddress)
+		copy(dAtA[i:], m.VaultAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.VaultAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Admin) > 0 {
+		i -= len(m.Admin)
+		copy(dAtA[i:], m.Admin)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Admin)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgToggleBridgeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgToggleBridgeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgToggleBridgeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgBridgeMintSharesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgBridgeMintSharesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgBridgeMintSharesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Shares.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.VaultAddress) > 0 {
+		i -= len(m.VaultAddress)
+		copy(dAtA[i:], m.VaultAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.VaultAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Bridge) > 0 {
+		i -= len(m.Bridge)
+		copy(dAtA[i:], m.Bridge)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Bridge)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgBridgeMintSharesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgBridgeMintSharesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgBridgeMintSharesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgBridgeBurnSharesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgBridgeBurnSharesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgBridgeBurnSharesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Shares.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.VaultAddress) > 0 {
+		i -= len(m.VaultAddress)
+		copy(dAtA[i:], m.VaultAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.VaultAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Bridge) > 0 {
+		i -= len(m.Bridge)
+		copy(dAtA[i:], m.Bridge)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Bridge)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgBridgeBurnSharesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgBridgeBurnSharesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgBridgeBurnSharesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetAssetManagerRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetAssetManagerRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetAssetManagerRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.AssetManager) > 0 {
+		i -= len(m.AssetManager)
+		copy(dAtA[i:], m.AssetManager)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.AssetManager)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.VaultAddress) > 0 {
+		i -= len(m.VaultAddress)
+		copy(dAtA[i:], m.VaultAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.VaultAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Admin) > 0 {
+		i -= len(m.Admin)
+		copy(dAtA[i:], m.Admin)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Admin)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetAssetManagerResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetAssetManagerResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetAssetManagerResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateParamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateVaultAUMFeeBipsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateVaultAUMFeeBipsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateVaultAUMFeeBipsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.AumFeeBips != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.AumFeeBips))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.VaultAddress) > 0 {
+		i -= len(m.VaultAddress)
+		copy(dAtA[i:], m.VaultAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.VaultAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateVaultAUMFeeBipsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateVaultAUMFeeBipsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateVaultAUMFeeBipsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateVaultNAVRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateVaultNAVRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateVaultNAVRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Source) > 0 {
+		i -= len(m.Source)
+		copy(dAtA[i:], m.Source)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Source)))
+		i--
+		dAtA[i] = 0x32
+	}
+	{
+		size := m.Volume.Size()
+		i -= size
+		if _, err := m.Volume.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size, err := m.Price.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.VaultAddress) > 0 {
+		i -= len(m.VaultAddress)
+		copy(dAtA[i:], m.VaultAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.VaultAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateVaultNAVResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateVaultNAVResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateVaultNAVResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.PendingApproval {
+		i--
+		if m.PendingApproval {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRemoveVaultNAVRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRemoveVaultNAVRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRemoveVaultNAVRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.VaultAddress) > 0 {
+		i -= len(m.VaultAddress)
+		copy(dAtA[i:], m.VaultAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.VaultAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRemoveVaultNAVResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRemoveVaultNAVResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRemoveVaultNAVResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateNAVAuthorityRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateNAVAuthorityRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateNAVAuthorityRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.NewAuthority) > 0 {
+		i -= len(m.NewAuthority)
+		copy(dAtA[i:], m.NewAuthority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.NewAuthority)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.VaultAddress) > 0 {
+		i -= len(m.VaultAddress)
+		copy(dAtA[i:], m.VaultAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.VaultAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateNAVAuthorityResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateNAVAuthorityResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateNAVAuthorityResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgAcceptAssetRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAcceptAssetRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAcceptAssetRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ExternalId) > 0 {
+		i -= len(m.ExternalId)
+		copy(dAtA[i:], m.ExternalId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ExternalId)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Source) > 0 {
+		i -= len(m.Source)
+		copy(dAtA[i:], m.Source)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Source)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.VaultAddress) > 0 {
+		i -= len(m.VaultAddress)
+		copy(dAtA[i:], m.VaultAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.VaultAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgAcceptAssetResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAcceptAssetResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAcceptAssetResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRejectAssetRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRejectAssetRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRejectAssetRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ExternalId) > 0 {
+		i -= len(m.ExternalId)
+		copy(dAtA[i:], m.ExternalId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ExternalId)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Source) > 0 {
+		i -= len(m.Source)
+		copy(dAtA[i:], m.Source)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Source)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.VaultAddress) > 0 {
+		i -= len(m.VaultAddress)
+		copy(dAtA[i:], m.VaultAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.VaultAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRejectAssetResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRejectAssetResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRejectAssetResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateNAVStalenessLimitRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateNAVStalenessLimitRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateNAVStalenessLimitRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.AutoPauseOnStaleNav {
+		i--
+		if m.AutoPauseOnStaleNav {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.MaxNavAgeSeconds != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.MaxNavAgeSeconds))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.VaultAddress) > 0 {
+		i -= len(m.VaultAddress)
+		copy(dAtA[i:], m.VaultAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.VaultAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateNAVStalenessLimitResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateNAVStalenessLimitResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateNAVStalenessLimitResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateNAVChangeLimitRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateNAVChangeLimitRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateNAVChangeLimitRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.NavApprover) > 0 {
+		i -= len(m.NavApprover)
+		copy(dAtA[i:], m.NavApprover)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.NavApprover)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.MaxNavChangeBips != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.MaxNavChangeBips))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.VaultAddress) > 0 {
+		i -= len(m.VaultAddress)
+		copy(dAtA[i:], m.VaultAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.VaultAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateNAVChangeLimitResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateNAVChangeLimitResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateNAVChangeLimitResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateSettlementToleranceRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateSettlementToleranceRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateSettlementToleranceRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.UpdateNavOnSettlement {
+		i--
+		if m.UpdateNavOnSettlement {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.SettlementToleranceBips != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.SettlementToleranceBips))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.VaultAddress) > 0 {
+		i -= len(m.VaultAddress)
+		copy(dAtA[i:], m.VaultAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.VaultAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateSettlementToleranceResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateSettlementToleranceResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateSettlementToleranceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgApproveNAVProposalRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgApproveNAVProposalRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgApproveNAVProposalRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.VaultAddress) > 0 {
+		i -= len(m.VaultAddress)
+		copy(dAtA[i:], m.VaultAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.VaultAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Approver) > 0 {
+		i -= len(m.Approver)
+		copy(dAtA[i:], m.Approver)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Approver)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgApproveNAVProposalResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgApproveNAVProposalResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgApproveNAVProposalResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateNAVSourcesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateNAVSourcesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateNAVSourcesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.NavSourceMaxAgeSeconds != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.NavSourceMaxAgeSeconds))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.NavAggregation != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.NavAggregation))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.NavSourceQuorum != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.NavSourceQuorum))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.NavSources) > 0 {
+		for iNdEx := len(m.NavSources) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.NavSources[iNdEx])
+			copy(dAtA[i:], m.NavSources[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.NavSources[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.VaultAddress) > 0 {
+		i -= len(m.VaultAddress)
+		copy(dAtA[i:], m.VaultAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.VaultAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateNAVSourcesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateNAVSourcesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateNAVSourcesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSubmitSourceNAVRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSubmitSourceNAVRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSubmitSourceNAVRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Volume.Size()
+		i -= size
+		if _, err := m.Volume.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size, err := m.Price.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.VaultAddress) > 0 {
+		i -= len(m.VaultAddress)
+		copy(dAtA[i:], m.VaultAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.VaultAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Source) > 0 {
+		i -= len(m.Source)
+		copy(dAtA[i:], m.Source)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Source)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSubmitSourceNAVResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSubmitSourceNAVResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSubmitSourceNAVResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *NAVUpdate) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *NAVUpdate) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *NAVUpdate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Source) > 0 {
+		i -= len(m.Source)
+		copy(dAtA[i:], m.Source)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Source)))
+		i--
+		dAtA[i] = 0x22
+	}
+	{
+		size := m.Volume.Size()
+		i -= size
+		if _, err := m.Volume.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size, err := m.Price.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgBatchUpdateVaultNAVRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgBatchUpdateVaultNAVRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgBatchUpdateVaultNAVRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Updates) > 0 {
+		for iNdEx := len(m.Updates) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Updates[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.VaultAddress) > 0 {
+		i -= len(m.VaultAddress)
+		copy(dAtA[i:], m.VaultAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.VaultAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgBatchUpdateVaultNAVResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgBatchUpdateVaultNAVResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgBatchUpdateVaultNAVResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.PendingDenoms) > 0 {
+		for iNdEx := len(m.PendingDenoms) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.PendingDenoms[iNdEx])
+			copy(dAtA[i:], m.PendingDenoms[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.PendingDenoms[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdatePriceSourcesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdatePriceSourcesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdatePriceSourcesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.PriceSources) > 0 {
+		dAtA16 := make([]byte, len(m.PriceSources)*10)
+		var j15 int
+		for _, num := range m.PriceSources {
+			for num >= 1<<7 {
+				dAtA16[j15] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j15++
+			}
+			dAtA16[j15] = uint8(num)
+			j15++
+		}
+		i -= j15
+		copy(dAtA[i:], dAtA16[:j15])
+		i = encodeVarintTx(dAtA, i, uint64(j15))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.VaultAddress) > 0 {
+		i -= len(m.VaultAddress)
+		copy(dAtA[i:], m.VaultAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.VaultAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdatePriceSourcesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdatePriceSourcesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdatePriceSourcesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateAssetHaircutRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateAssetHaircutRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateAssetHaircutRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.HaircutBips != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.HaircutBips))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.VaultAddress) > 0 {
+		i -= len(m.VaultAddress)
+		copy(dAtA[i:], m.VaultAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.VaultAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateAssetHaircutResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateAssetHaircutResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateAssetHaircutResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *AssetPaymentRef) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AssetPaymentRef) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AssetPaymentRef) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ExternalId) > 0 {
+		i -= len(m.ExternalId)
+		copy(dAtA[i:], m.ExternalId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ExternalId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Source) > 0 {
+		i -= len(m.Source)
+		copy(dAtA[i:], m.Source)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Source)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgBatchAcceptAssetsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgBatchAcceptAssetsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgBatchAcceptAssetsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.BestEffort {
+		i--
+		if m.BestEffort {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Payments) > 0 {
+		for iNdEx := len(m.Payments) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Payments[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.VaultAddress) > 0 {
+		i -= len(m.VaultAddress)
+		copy(dAtA[i:], m.VaultAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.VaultAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AssetPaymentResult) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AssetPaymentResult) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AssetPaymentResult) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Error) > 0 {
+		i -= len(m.Error)
+		copy(dAtA[i:], m.Error)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Error)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Accepted {
+		i--
+		if m.Accepted {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.ExternalId) > 0 {
+		i -= len(m.ExternalId)
+		copy(dAtA[i:], m.ExternalId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ExternalId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Source) > 0 {
+		i -= len(m.Source)
+		copy(dAtA[i:], m.Source)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Source)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgBatchAcceptAssetsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgBatchAcceptAssetsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgBatchAcceptAssetsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Results) > 0 {
+		for iNdEx := len(m.Results) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Results[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCreateAssetPaymentRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCreateAssetPaymentRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCreateAssetPaymentRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ExpirationSeconds != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ExpirationSeconds))
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.ExternalId) > 0 {
+		i -= len(m.ExternalId)
+		copy(dAtA[i:], m.ExternalId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ExternalId)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.TargetAmount) > 0 {
+		for iNdEx := len(m.TargetAmount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TargetAmount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.SourceAmount) > 0 {
+		for iNdEx := len(m.SourceAmount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SourceAmount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.Target) > 0 {
+		i -= len(m.Target)
+		copy(dAtA[i:], m.Target)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Target)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.VaultAddress) > 0 {
+		i -= len(m.VaultAddress)
+		copy(dAtA[i:], m.VaultAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.VaultAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCreateAssetPaymentResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCreateAssetPaymentResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCreateAssetPaymentResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Source) > 0 {
+		i -= len(m.Source)
+		copy(dAtA[i:], m.Source)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Source)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCancelAssetPaymentRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCancelAssetPaymentRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCancelAssetPaymentRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ExternalId) > 0 {
+		i -= len(m.ExternalId)
+		copy(dAtA[i:], m.ExternalId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ExternalId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.VaultAddress) > 0 {
+		i -= len(m.VaultAddress)
+		copy(dAtA[i:], m.VaultAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.VaultAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCancelAssetPaymentResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCancelAssetPaymentResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCancelAssetPaymentResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgExpireAssetPaymentRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgExpireAssetPaymentRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgExpireAssetPaymentRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ExternalId) > 0 {
+		i -= len(m.ExternalId)
+		copy(dAtA[i:], m.ExternalId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ExternalId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.VaultAddress) > 0 {
+		i -= len(m.VaultAddress)
+		copy(dAtA[i:], m.VaultAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.VaultAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgExpireAssetPaymentResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgExpireAssetPaymentResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgExpireAssetPaymentResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateAcquisitionPolicyRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateAcquisitionPolicyRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateAcquisitionPolicyRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Policy.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.VaultAddress) > 0 {
+		i -= len(m.VaultAddress)
+		copy(dAtA[i:], m.VaultAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.VaultAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateAcquisitionPolicyResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateAcquisitionPolicyResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateAcquisitionPolicyResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateConcentrationLimitsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateConcentrationLimitsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateConcentrationLimitsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MaxNonUnderlyingBips != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.MaxNonUnderlyingBips))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.MaxAssetConcentrationBips != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.MaxAssetConcentrationBips))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.VaultAddress) > 0 {
+		i -= len(m.VaultAddress)
+		copy(dAtA[i:], m.VaultAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.VaultAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateConcentrationLimitsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateConcentrationLimitsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateConcentrationLimitsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateMinLiquidityRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateMinLiquidityRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateMinLiquidityRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MinLiquidityBips != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.MinLiquidityBips))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.VaultAddress) > 0 {
+		i -= len(m.VaultAddress)
+		copy(dAtA[i:], m.VaultAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.VaultAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateMinLiquidityResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateMinLiquidityResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateMinLiquidityResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCollectAssetIncomeRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCollectAssetIncomeRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCollectAssetIncomeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ToPrincipal {
+		i--
+		if m.ToPrincipal {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
+	{
+		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.SourceAsset) > 0 {
+		i -= len(m.SourceAsset)
+		copy(dAtA[i:], m.SourceAsset)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.SourceAsset)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.VaultAddress) > 0 {
+		i -= len(m.VaultAddress)
+		copy(dAtA[i:], m.VaultAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.VaultAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCollectAssetIncomeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCollectAssetIncomeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCollectAssetIncomeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgGrantRoleRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgGrantRoleRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgGrantRoleRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Role != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Role))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.VaultAddress) > 0 {
+		i -= len(m.VaultAddress)
+		copy(dAtA[i:], m.VaultAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.VaultAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgGrantRoleResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgGrantRoleResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgGrantRoleResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRevokeRoleRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRevokeRoleRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRevokeRoleRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Role != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Role))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.VaultAddress) > 0 {
+		i -= len(m.VaultAddress)
+		copy(dAtA[i:], m.VaultAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.VaultAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRevokeRoleResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRevokeRoleResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRevokeRoleResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgProposeAdminTransferRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgProposeAdminTransferRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgProposeAdminTransferRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ExpirationSeconds != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ExpirationSeconds))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.NewAdmin) > 0 {
+		i -= len(m.NewAdmin)
+		copy(dAtA[i:], m.NewAdmin)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.NewAdmin)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.VaultAddress) > 0 {
+		i -= len(m.VaultAddress)
+		copy(dAtA[i:], m.VaultAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.VaultAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgProposeAdminTransferResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgProposeAdminTransferResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgProposeAdminTransferResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgAcceptAdminTransferRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAcceptAdminTransferRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAcceptAdminTransferRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.VaultAddress) > 0 {
+		i -= len(m.VaultAddress)
+		copy(dAtA[i:], m.VaultAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.VaultAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.NewAdmin) > 0 {
+		i -= len(m.NewAdmin)
+		copy(dAtA[i:], m.NewAdmin)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.NewAdmin)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgAcceptAdminTransferResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAcceptAdminTransferResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAcceptAdminTransferResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCancelAdminTransferRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCancelAdminTransferRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCancelAdminTransferRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.VaultAddress) > 0 {
+		i -= len(m.VaultAddress)
+		copy(dAtA[i:], m.VaultAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.VaultAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCancelAdminTransferResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCancelAdminTransferResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCancelAdminTransferResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRecoverVaultAdminRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRecoverVaultAdminRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRecoverVaultAdminRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.NewAdmin) > 0 {
+		i -= len(m.NewAdmin)
+		copy(dAtA[i:], m.NewAdmin)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.NewAdmin)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.VaultAddress) > 0 {
+		i -= len(m.VaultAddress)
+		copy(dAtA[i:], m.VaultAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.VaultAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRecoverVaultAdminResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRecoverVaultAdminResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRecoverVaultAdminResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateTimelockRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateTimelockRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateTimelockRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.TimelockSeconds != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.TimelockSeconds))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.VaultAddress) > 0 {
+		i -= len(m.VaultAddress)
+		copy(dAtA[i:], m.VaultAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.VaultAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateTimelockResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateTimelockResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateTimelockResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCancelScheduledActionRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCancelScheduledActionRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCancelScheduledActionRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ActionId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ActionId))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.VaultAddress) > 0 {
+		i -= len(m.VaultAddress)
+		copy(dAtA[i:], m.VaultAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.VaultAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCancelScheduledActionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCancelScheduledActionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCancelScheduledActionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateEligibilityPolicyRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateEligibilityPolicyRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateEligibilityPolicyRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Policy.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.VaultAddress) > 0 {
+		i -= len(m.VaultAddress)
+		copy(dAtA[i:], m.VaultAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.VaultAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateEligibilityPolicyResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateEligibilityPolicyResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateEligibilityPolicyResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateAllowlistRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateAllowlistRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateAllowlistRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Remove) > 0 {
+		for iNdEx := len(m.Remove) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Remove[iNdEx])
+			copy(dAtA[i:], m.Remove[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.Remove[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.Add) > 0 {
+		for iNdEx := len(m.Add) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Add[iNdEx])
+			copy(dAtA[i:], m.Add[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.Add[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.VaultAddress) > 0 {
+		i -= len(m.VaultAddress)
+		copy(dAtA[i:], m.VaultAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.VaultAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateAllowlistResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateAllowlistResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateAllowlistResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdatePositionCapRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdatePositionCapRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdatePositionCapRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Exemptions) > 0 {
+		for iNdEx := len(m.Exemptions) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Exemptions[iNdEx])
+			copy(dAtA[i:], m.Exemptions[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.Exemptions[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.MaxPositionValue) > 0 {
+		i -= len(m.MaxPositionValue)
+		copy(dAtA[i:], m.MaxPositionValue)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.MaxPositionValue)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.VaultAddress) > 0 {
+		i -= len(m.VaultAddress)
+		copy(dAtA[i:], m.VaultAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.VaultAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdatePositionCapResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdatePositionCapResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdatePositionCapResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateMaxTotalValueRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateMaxTotalValueRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateMaxTotalValueRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.MaxTotalValue) > 0 {
+		i -= len(m.MaxTotalValue)
+		copy(dAtA[i:], m.MaxTotalValue)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.MaxTotalValue)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.VaultAddress) > 0 {
+		i -= len(m.VaultAddress)
+		copy(dAtA[i:], m.VaultAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.VaultAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateMaxTotalValueResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateMaxTotalValueResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateMaxTotalValueResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateCircuitBreakerRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateCircuitBreakerRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateCircuitBreakerRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Guardian) > 0 {
+		i -= len(m.Guardian)
+		copy(dAtA[i:], m.Guardian)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Guardian)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	{
+		size, err := m.CircuitBreaker.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateCircuitBreakerResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateCircuitBreakerResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateCircuitBreakerResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgCreateVaultRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Admin)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ShareDenom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.UnderlyingAsset)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.PaymentDenom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.WithdrawalDelaySeconds != 0 {
+		n += 1 + sovTx(uint64(m.WithdrawalDelaySeconds))
+	}
+	l = len(m.MinSwapInValue)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.MinSwapOutValue)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.MaxSwapInValue)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.MaxSwapOutValue)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgCreateVaultResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.VaultAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgSetShareDenomMetadataRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Metadata.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = len(m.Admin)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.VaultAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgSetShareDenomMetadataResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSwapInRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.VaultAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Assets.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgSwapInResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.SharesReceived.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgSwapOutRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.VaultAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Assets.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = len(m.RedeemDenom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgSwapOutResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.RequestId != 0 {
+		n += 1 + sovTx(uint64(m.RequestId))
+	}
+	return n
+}
+
+func (m *MsgUpdateMinInterestRateRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Admin)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.VaultAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.MinRate)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgUpdateMinInterestRateResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUpdateMaxInterestRateRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Admin)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.VaultAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.MaxRate)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgUpdateMaxInterestRateResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUpdateInterestRateRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.VaultAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.NewRate)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgUpdateInterestRateResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUpdateWithdrawalDelayRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.VaultAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.WithdrawalDelaySeconds != 0 {
+		n += 1 + sovTx(uint64(m.WithdrawalDelaySeconds))
+	}
+	return n
+}
+
+func (m *MsgUpdateWithdrawalDelayResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUpdateMinSwapInValueRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.VaultAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.MinSwapInValue)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgUpdateMinSwapInValueResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUpdateMinSwapOutValueRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.VaultAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.MinSwapOutValue)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgUpdateMinSwapOutValueResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUpdateMaxSwapInValueRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.VaultAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.MaxSwapInValue)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgUpdateMaxSwapInValueResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUpdateMaxSwapOutValueRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.VaultAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.MaxSwapOutValue)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgUpdateMaxSwapOutValueResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgToggleSwapInRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Admin)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.VaultAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Enabled {
+		n += 2
+	}
+	return n
+}
+
+func (m *MsgToggleSwapInResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgToggleSwapOutRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Admin)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.VaultAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Enabled {
+		n += 2
+	}
+	return n
+}
+
+func (m *MsgToggleSwapOutResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgDepositInterestFundsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.VaultAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgDepositInterestFundsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgWithdrawInterestFundsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.VaultAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgWithdrawInterestFundsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgDepositPrincipalFundsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.VaultAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgDepositPrincipalFundsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgWithdrawPrincipalFundsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.VaultAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgWithdrawPrincipalFundsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgExpeditePendingSwapOutRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.RequestId != 0 {
+		n += 1 + sovTx(uint64(m.RequestId))
+	}
+	return n
+}
+
+func (m *MsgExpeditePendingSwapOutResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgPauseVaultRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.VaultAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Force {
+		n += 2
+	}
+	return n
+}
+
+func (m *MsgPauseVaultResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUnpauseVaultRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.VaultAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgUnpauseVaultResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSetBridgeAddressRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Admin)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.VaultAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.BridgeAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgSetBridgeAddressResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgToggleBridgeRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Admin)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.VaultAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Enabled {
+		n += 2
+	}
+	return n
+}
+
+func (m *MsgToggleBridgeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgBridgeMintSharesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Bridge)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.VaultAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Shares.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgBridgeMintSharesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgBridgeBurnSharesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Bridge)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.VaultAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Shares.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgBridgeBurnSharesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSetAssetManagerRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Admin)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.VaultAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.AssetManager)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgSetAssetManagerResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUpdateParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Params.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgUpdateParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUpdateVaultAUMFeeBipsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.VaultAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.AumFeeBips != 0 {
+		n += 1 + sovTx(uint64(m.AumFeeBips))
+	}
+	return n
+}
+
+func (m *MsgUpdateVaultAUMFeeBipsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUpdateVaultNAVRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.VaultAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Price.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.Volume.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = len(m.Source)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgUpdateVaultNAVResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PendingApproval {
+		n += 2
+	}
+	return n
+}
+
+func (m *MsgRemoveVaultNAVRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.VaultAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgRemoveVaultNAVResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUpdateNAVAuthorityRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.VaultAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.NewAuthority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgUpdateNAVAuthorityResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
 }
 
 func (m *MsgAcceptAssetRequest) Size() (n int) {
 	if m == nil {
 		return 0
 	}
-	var l int
-	_ = l
-	l = len(m.Authority)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.VaultAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Source)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ExternalId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgAcceptAssetResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRejectAssetRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.VaultAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Source)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ExternalId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgRejectAssetResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUpdateNAVStalenessLimitRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.VaultAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.MaxNavAgeSeconds != 0 {
+		n += 1 + sovTx(uint64(m.MaxNavAgeSeconds))
+	}
+	if m.AutoPauseOnStaleNav {
+		n += 2
+	}
+	return n
+}
+
+func (m *MsgUpdateNAVStalenessLimitResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUpdateNAVChangeLimitRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.VaultAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.MaxNavChangeBips != 0 {
+		n += 1 + sovTx(uint64(m.MaxNavChangeBips))
+	}
+	l = len(m.NavApprover)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgUpdateNAVChangeLimitResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUpdateSettlementToleranceRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.VaultAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.SettlementToleranceBips != 0 {
+		n += 1 + sovTx(uint64(m.SettlementToleranceBips))
+	}
+	if m.UpdateNavOnSettlement {
+		n += 2
+	}
+	return n
+}
+
+func (m *MsgUpdateSettlementToleranceResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgApproveNAVProposalRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Approver)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.VaultAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgApproveNAVProposalResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUpdateNAVSourcesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.VaultAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.NavSources) > 0 {
+		for _, s := range m.NavSources {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if m.NavSourceQuorum != 0 {
+		n += 1 + sovTx(uint64(m.NavSourceQuorum))
+	}
+	if m.NavAggregation != 0 {
+		n += 1 + sovTx(uint64(m.NavAggregation))
+	}
+	if m.NavSourceMaxAgeSeconds != 0 {
+		n += 1 + sovTx(uint64(m.NavSourceMaxAgeSeconds))
+	}
+	return n
+}
+
+func (m *MsgUpdateNAVSourcesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSubmitSourceNAVRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Source)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.VaultAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Price.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.Volume.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgSubmitSourceNAVResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *NAVUpdate) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Price.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.Volume.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = len(m.Source)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgBatchUpdateVaultNAVRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.VaultAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Updates) > 0 {
+		for _, e := range m.Updates {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgBatchUpdateVaultNAVResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.PendingDenoms) > 0 {
+		for _, s := range m.PendingDenoms {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgUpdatePriceSourcesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.VaultAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.PriceSources) > 0 {
+		l = 0
+		for _, e := range m.PriceSources {
+			l += sovTx(uint64(e))
+		}
+		n += 1 + sovTx(uint64(l)) + l
+	}
+	return n
+}
+
+func (m *MsgUpdatePriceSourcesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUpdateAssetHaircutRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.VaultAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.HaircutBips != 0 {
+		n += 1 + sovTx(uint64(m.HaircutBips))
+	}
+	return n
+}
+
+func (m *MsgUpdateAssetHaircutResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *AssetPaymentRef) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Source)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ExternalId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgBatchAcceptAssetsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.VaultAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Payments) > 0 {
+		for _, e := range m.Payments {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if m.BestEffort {
+		n += 2
+	}
+	return n
+}
+
+func (m *AssetPaymentResult) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Source)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ExternalId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Accepted {
+		n += 2
+	}
+	l = len(m.Error)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgBatchAcceptAssetsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Results) > 0 {
+		for _, e := range m.Results {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgCreateAssetPaymentRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.VaultAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Target)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.SourceAmount) > 0 {
+		for _, e := range m.SourceAmount {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if len(m.TargetAmount) > 0 {
+		for _, e := range m.TargetAmount {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	l = len(m.ExternalId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.ExpirationSeconds != 0 {
+		n += 1 + sovTx(uint64(m.ExpirationSeconds))
+	}
+	return n
+}
+
+func (m *MsgCreateAssetPaymentResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Source)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgCancelAssetPaymentRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.VaultAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ExternalId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgCancelAssetPaymentResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgExpireAssetPaymentRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.VaultAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ExternalId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgExpireAssetPaymentResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUpdateAcquisitionPolicyRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.VaultAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Policy.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgUpdateAcquisitionPolicyResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUpdateConcentrationLimitsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.VaultAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.MaxAssetConcentrationBips != 0 {
+		n += 1 + sovTx(uint64(m.MaxAssetConcentrationBips))
+	}
+	if m.MaxNonUnderlyingBips != 0 {
+		n += 1 + sovTx(uint64(m.MaxNonUnderlyingBips))
+	}
+	return n
+}
+
+func (m *MsgUpdateConcentrationLimitsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUpdateMinLiquidityRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.VaultAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.MinLiquidityBips != 0 {
+		n += 1 + sovTx(uint64(m.MinLiquidityBips))
+	}
+	return n
+}
+
+func (m *MsgUpdateMinLiquidityResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgCollectAssetIncomeRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.VaultAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.SourceAsset)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if m.ToPrincipal {
+		n += 2
+	}
+	return n
+}
+
+func (m *MsgCollectAssetIncomeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgGrantRoleRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.VaultAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Role != 0 {
+		n += 1 + sovTx(uint64(m.Role))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgGrantRoleResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRevokeRoleRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.VaultAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Role != 0 {
+		n += 1 + sovTx(uint64(m.Role))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgRevokeRoleResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgProposeAdminTransferRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.VaultAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.NewAdmin)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.ExpirationSeconds != 0 {
+		n += 1 + sovTx(uint64(m.ExpirationSeconds))
+	}
+	return n
+}
+
+func (m *MsgProposeAdminTransferResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgAcceptAdminTransferRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.NewAdmin)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.VaultAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgAcceptAdminTransferResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgCancelAdminTransferRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.VaultAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgCancelAdminTransferResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRecoverVaultAdminRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.VaultAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.NewAdmin)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgRecoverVaultAdminResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUpdateTimelockRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.VaultAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.TimelockSeconds != 0 {
+		n += 1 + sovTx(uint64(m.TimelockSeconds))
+	}
+	return n
+}
+
+func (m *MsgUpdateTimelockResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgCancelScheduledActionRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.VaultAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.ActionId != 0 {
+		n += 1 + sovTx(uint64(m.ActionId))
+	}
+	return n
+}
+
+func (m *MsgCancelScheduledActionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUpdateEligibilityPolicyRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.VaultAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Policy.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgUpdateEligibilityPolicyResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUpdateAllowlistRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.VaultAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Add) > 0 {
+		for _, s := range m.Add {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if len(m.Remove) > 0 {
+		for _, s := range m.Remove {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgUpdateAllowlistResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUpdatePositionCapRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.VaultAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.MaxPositionValue)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Exemptions) > 0 {
+		for _, s := range m.Exemptions {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgUpdatePositionCapResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUpdateMaxTotalValueRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.VaultAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.MaxTotalValue)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgUpdateMaxTotalValueResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUpdateCircuitBreakerRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.CircuitBreaker.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = len(m.Guardian)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgUpdateCircuitBreakerResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgCreateVaultRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreateVaultRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreateVaultRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Admin", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Admin = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ShareDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ShareDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnderlyingAsset", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UnderlyingAsset = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PaymentDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PaymentDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WithdrawalDelaySeconds", wireType)
+			}
+			m.WithdrawalDelaySeconds = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.WithdrawalDelaySeconds |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinSwapInValue", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MinSwapInValue = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinSwapOutValue", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MinSwapOutValue = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxSwapInValue", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MaxSwapInValue = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxSwapOutValue", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MaxSwapOutValue = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCreateVaultResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreateVaultResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreateVaultResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VaultAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VaultAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetShareDenomMetadataRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetShareDenomMetadataRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetShareDenomMetadataRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Metadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Admin", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Admin = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VaultAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VaultAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetShareDenomMetadataResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetShareDenomMetadataResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetShareDenomMetadataResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSwapInRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSwapInRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSwapInRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VaultAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VaultAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Assets", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Assets.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSwapInResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSwapInResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSwapInResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SharesReceived", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SharesReceived.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSwapOutRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSwapOutRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSwapOutRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VaultAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VaultAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Assets", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Assets.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RedeemDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RedeemDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSwapOutResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSwapOutResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSwapOutResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequestId", wireType)
+			}
+			m.RequestId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RequestId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateMinInterestRateRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateMinInterestRateRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateMinInterestRateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Admin", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Admin = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VaultAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VaultAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MinRate = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateMinInterestRateResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateMinInterestRateResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateMinInterestRateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateMaxInterestRateRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateMaxInterestRateRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateMaxInterestRateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Admin", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Admin = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VaultAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VaultAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MaxRate = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateMaxInterestRateResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateMaxInterestRateResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateMaxInterestRateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateInterestRateRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateInterestRateRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateInterestRateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VaultAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VaultAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NewRate = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateInterestRateResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateInterestRateResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateInterestRateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateWithdrawalDelayRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateWithdrawalDelayRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateWithdrawalDelayRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VaultAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VaultAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WithdrawalDelaySeconds", wireType)
+			}
+			m.WithdrawalDelaySeconds = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.WithdrawalDelaySeconds |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateWithdrawalDelayResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateWithdrawalDelayResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateWithdrawalDelayResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateMinSwapInValueRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateMinSwapInValueRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateMinSwapInValueRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VaultAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VaultAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinSwapInValue", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MinSwapInValue = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateMinSwapInValueResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateMinSwapInValueResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateMinSwapInValueResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateMinSwapOutValueRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateMinSwapOutValueRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateMinSwapOutValueRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VaultAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VaultAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinSwapOutValue", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MinSwapOutValue = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateMinSwapOutValueResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateMinSwapOutValueResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateMinSwapOutValueResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateMaxSwapInValueRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateMaxSwapInValueRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateMaxSwapInValueRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VaultAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VaultAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxSwapInValue", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MaxSwapInValue = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateMaxSwapInValueResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateMaxSwapInValueResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateMaxSwapInValueResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateMaxSwapOutValueRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateMaxSwapOutValueRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateMaxSwapOutValueRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VaultAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VaultAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxSwapOutValue", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MaxSwapOutValue = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateMaxSwapOutValueResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateMaxSwapOutValueResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateMaxSwapOutValueResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgToggleSwapInRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgToggleSwapInRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgToggleSwapInRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Admin", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Admin = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VaultAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VaultAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Enabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Enabled = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgToggleSwapInResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgToggleSwapInResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgToggleSwapInResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgToggleSwapOutRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgToggleSwapOutRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgToggleSwapOutRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Admin", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Admin = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VaultAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VaultAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Enabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Enabled = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgToggleSwapOutResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgToggleSwapOutResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgToggleSwapOutResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgDepositInterestFundsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgDepositInterestFundsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgDepositInterestFundsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VaultAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VaultAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgDepositInterestFundsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgDepositInterestFundsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgDepositInterestFundsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgWithdrawInterestFundsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgWithdrawInterestFundsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgWithdrawInterestFundsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VaultAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VaultAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgWithdrawInterestFundsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgWithdrawInterestFundsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgWithdrawInterestFundsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgDepositPrincipalFundsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgDepositPrincipalFundsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgDepositPrincipalFundsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VaultAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VaultAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgDepositPrincipalFundsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgDepositPrincipalFundsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgDepositPrincipalFundsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgWithdrawPrincipalFundsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgWithdrawPrincipalFundsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgWithdrawPrincipalFundsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VaultAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VaultAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgWithdrawPrincipalFundsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgWithdrawPrincipalFundsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgWithdrawPrincipalFundsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgExpeditePendingSwapOutRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgExpeditePendingSwapOutRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgExpeditePendingSwapOutRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequestId", wireType)
+			}
+			m.RequestId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RequestId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgExpeditePendingSwapOutResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgExpeditePendingSwapOutResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgExpeditePendingSwapOutResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgPauseVaultRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgPauseVaultRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgPauseVaultRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VaultAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VaultAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Force", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Force = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgPauseVaultResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgPauseVaultResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgPauseVaultResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUnpauseVaultRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUnpauseVaultRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUnpauseVaultRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VaultAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VaultAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUnpauseVaultResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUnpauseVaultResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUnpauseVaultResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetBridgeAddressRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetBridgeAddressRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetBridgeAddressRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Admin", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Admin = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VaultAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VaultAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BridgeAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BridgeAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetBridgeAddressResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetBridgeAddressResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetBridgeAddressResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgToggleBridgeRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgToggleBridgeRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgToggleBridgeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Admin", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Admin = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VaultAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VaultAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Enabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Enabled = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgToggleBridgeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgToggleBridgeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgToggleBridgeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgBridgeMintSharesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgBridgeMintSharesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgBridgeMintSharesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Bridge", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Bridge = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VaultAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VaultAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Shares", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Shares.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgBridgeMintSharesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgBridgeMintSharesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgBridgeMintSharesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgBridgeBurnSharesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgBridgeBurnSharesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgBridgeBurnSharesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Bridge", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Bridge = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VaultAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VaultAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Shares", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Shares.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgBridgeBurnSharesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgBridgeBurnSharesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgBridgeBurnSharesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetAssetManagerRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetAssetManagerRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetAssetManagerRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Admin", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Admin = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VaultAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VaultAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AssetManager", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AssetManager = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetAssetManagerResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetAssetManagerResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetAssetManagerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateVaultAUMFeeBipsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateVaultAUMFeeBipsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateVaultAUMFeeBipsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VaultAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VaultAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AumFeeBips", wireType)
+			}
+			m.AumFeeBips = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AumFeeBips |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateVaultAUMFeeBipsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateVaultAUMFeeBipsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateVaultAUMFeeBipsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateVaultNAVRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateVaultNAVRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateVaultNAVRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VaultAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VaultAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Price.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Volume", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Volume.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Source", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Source = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateVaultNAVResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateVaultNAVResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateVaultNAVResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingApproval", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.PendingApproval = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRemoveVaultNAVRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRemoveVaultNAVRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRemoveVaultNAVRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VaultAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VaultAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRemoveVaultNAVResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRemoveVaultNAVResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRemoveVaultNAVResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateNAVAuthorityRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateNAVAuthorityRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateNAVAuthorityRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VaultAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VaultAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewAuthority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NewAuthority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	l = len(m.VaultAddress)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return nil
+}
+func (m *MsgUpdateNAVAuthorityResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateNAVAuthorityResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateNAVAuthorityResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	l = len(m.Source)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	l = len(m.ExternalId)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return nil
+}
+func (m *MsgAcceptAssetRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAcceptAssetRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAcceptAssetRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VaultAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VaultAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Source", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Source = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExternalId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ExternalId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	return n
-}
 
-func (m *MsgAcceptAssetResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	return n
+	return nil
 }
-
-func (m *MsgRejectAssetRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Authority)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.VaultAddress)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.Source)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.ExternalId)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+func (m *MsgAcceptAssetResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAcceptAssetResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAcceptAssetResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	return n
-}
 
-func (m *MsgRejectAssetResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	return n
-}
-
-func sovTx(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozTx(x uint64) (n int) {
-	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *MsgCreateVaultRequest) Unmarshal(dAtA []byte) error {
+func (m *MsgRejectAssetRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8482,15 +23433,15 @@ func (m *MsgCreateVaultRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCreateVaultRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRejectAssetRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCreateVaultRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRejectAssetRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Admin", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -8518,11 +23469,75 @@ func (m *MsgCreateVaultRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Admin = string(dAtA[iNdEx:postIndex])
+			m.Authority = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ShareDenom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field VaultAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VaultAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Source", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Source = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExternalId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -8543,18 +23558,118 @@ func (m *MsgCreateVaultRequest) Unmarshal(dAtA []byte) error {
 			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ExternalId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRejectAssetResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRejectAssetResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRejectAssetResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateNAVStalenessLimitRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
 			}
-			if postIndex > l {
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ShareDenom = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateNAVStalenessLimitRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateNAVStalenessLimitRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UnderlyingAsset", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -8582,11 +23697,11 @@ func (m *MsgCreateVaultRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.UnderlyingAsset = string(dAtA[iNdEx:postIndex])
+			m.Authority = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PaymentDenom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field VaultAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -8614,13 +23729,13 @@ func (m *MsgCreateVaultRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PaymentDenom = string(dAtA[iNdEx:postIndex])
+			m.VaultAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
+		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WithdrawalDelaySeconds", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxNavAgeSeconds", wireType)
 			}
-			m.WithdrawalDelaySeconds = 0
+			m.MaxNavAgeSeconds = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -8630,16 +23745,16 @@ func (m *MsgCreateVaultRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.WithdrawalDelaySeconds |= uint64(b&0x7F) << shift
+				m.MaxNavAgeSeconds |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 6:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MinSwapInValue", wireType)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AutoPauseOnStaleNav", wireType)
 			}
-			var stringLen uint64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -8649,27 +23764,115 @@ func (m *MsgCreateVaultRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			m.AutoPauseOnStaleNav = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateNAVStalenessLimitResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateNAVStalenessLimitResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateNAVStalenessLimitResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthTx
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.MinSwapInValue = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 7:
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateNAVChangeLimitRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateNAVChangeLimitRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateNAVChangeLimitRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MinSwapOutValue", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -8697,11 +23900,11 @@ func (m *MsgCreateVaultRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.MinSwapOutValue = string(dAtA[iNdEx:postIndex])
+			m.Authority = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 8:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxSwapInValue", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field VaultAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -8729,13 +23932,13 @@ func (m *MsgCreateVaultRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.MaxSwapInValue = string(dAtA[iNdEx:postIndex])
+			m.VaultAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 9:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxSwapOutValue", wireType)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxNavChangeBips", wireType)
 			}
-			var stringLen uint64
+			m.MaxNavChangeBips = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -8745,27 +23948,14 @@ func (m *MsgCreateVaultRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.MaxNavChangeBips |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.MaxSwapOutValue = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 10:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NavApprover", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -8793,7 +23983,7 @@ func (m *MsgCreateVaultRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Authority = string(dAtA[iNdEx:postIndex])
+			m.NavApprover = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -8810,73 +24000,41 @@ func (m *MsgCreateVaultRequest) Unmarshal(dAtA []byte) error {
 			iNdEx += skippy
 		}
 	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *MsgCreateVaultResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowTx
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCreateVaultResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCreateVaultResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VaultAddress", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateNAVChangeLimitResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
 			}
-			if postIndex > l {
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			m.VaultAddress = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateNAVChangeLimitResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateNAVChangeLimitResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -8898,7 +24056,7 @@ func (m *MsgCreateVaultResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSetShareDenomMetadataRequest) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateSettlementToleranceRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8921,17 +24079,17 @@ func (m *MsgSetShareDenomMetadataRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSetShareDenomMetadataRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateSettlementToleranceRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSetShareDenomMetadataRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateSettlementToleranceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -8941,28 +24099,27 @@ func (m *MsgSetShareDenomMetadataRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Metadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Admin", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field VaultAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -8990,13 +24147,13 @@ func (m *MsgSetShareDenomMetadataRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Admin = string(dAtA[iNdEx:postIndex])
+			m.VaultAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VaultAddress", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SettlementToleranceBips", wireType)
 			}
-			var stringLen uint64
+			m.SettlementToleranceBips = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -9006,24 +24163,31 @@ func (m *MsgSetShareDenomMetadataRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.SettlementToleranceBips |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpdateNavOnSettlement", wireType)
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			m.VaultAddress = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
+			m.UpdateNavOnSettlement = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -9045,7 +24209,7 @@ func (m *MsgSetShareDenomMetadataRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSetShareDenomMetadataResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateSettlementToleranceResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9068,10 +24232,10 @@ func (m *MsgSetShareDenomMetadataResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSetShareDenomMetadataResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateSettlementToleranceResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSetShareDenomMetadataResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateSettlementToleranceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -9095,7 +24259,7 @@ func (m *MsgSetShareDenomMetadataResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSwapInRequest) Unmarshal(dAtA []byte) error {
+func (m *MsgApproveNAVProposalRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9118,15 +24282,15 @@ func (m *MsgSwapInRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSwapInRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgApproveNAVProposalRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSwapInRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgApproveNAVProposalRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Approver", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -9154,7 +24318,7 @@ func (m *MsgSwapInRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Owner = string(dAtA[iNdEx:postIndex])
+			m.Approver = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
@@ -9190,9 +24354,9 @@ func (m *MsgSwapInRequest) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Assets", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -9202,24 +24366,23 @@ func (m *MsgSwapInRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Assets.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -9242,7 +24405,7 @@ func (m *MsgSwapInRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSwapInResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgApproveNAVProposalResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9265,45 +24428,12 @@ func (m *MsgSwapInResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSwapInResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgApproveNAVProposalResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSwapInResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgApproveNAVProposalResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SharesReceived", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.SharesReceived.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -9325,7 +24455,7 @@ func (m *MsgSwapInResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSwapOutRequest) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateNAVSourcesRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9348,15 +24478,15 @@ func (m *MsgSwapOutRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSwapOutRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateNAVSourcesRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSwapOutRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateNAVSourcesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -9384,7 +24514,7 @@ func (m *MsgSwapOutRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Owner = string(dAtA[iNdEx:postIndex])
+			m.Authority = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
@@ -9420,9 +24550,9 @@ func (m *MsgSwapOutRequest) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Assets", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NavSources", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -9432,30 +24562,29 @@ func (m *MsgSwapOutRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Assets.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.NavSources = append(m.NavSources, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RedeemDenom", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NavSourceQuorum", wireType)
 			}
-			var stringLen uint64
+			m.NavSourceQuorum = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -9465,24 +24594,49 @@ func (m *MsgSwapOutRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.NavSourceQuorum |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NavAggregation", wireType)
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
+			m.NavAggregation = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NavAggregation |= NAVAggregation(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NavSourceMaxAgeSeconds", wireType)
+			}
+			m.NavSourceMaxAgeSeconds = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NavSourceMaxAgeSeconds |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			m.RedeemDenom = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -9504,7 +24658,7 @@ func (m *MsgSwapOutRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSwapOutResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateNAVSourcesResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9527,31 +24681,12 @@ func (m *MsgSwapOutResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSwapOutResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateNAVSourcesResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSwapOutResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateNAVSourcesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RequestId", wireType)
-			}
-			m.RequestId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.RequestId |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -9573,7 +24708,7 @@ func (m *MsgSwapOutResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateMinInterestRateRequest) Unmarshal(dAtA []byte) error {
+func (m *MsgSubmitSourceNAVRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9596,15 +24731,47 @@ func (m *MsgUpdateMinInterestRateRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateMinInterestRateRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSubmitSourceNAVRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateMinInterestRateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSubmitSourceNAVRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Admin", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Source", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Source = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VaultAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -9632,11 +24799,11 @@ func (m *MsgUpdateMinInterestRateRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Admin = string(dAtA[iNdEx:postIndex])
+			m.VaultAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VaultAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -9664,11 +24831,44 @@ func (m *MsgUpdateMinInterestRateRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.VaultAddress = string(dAtA[iNdEx:postIndex])
+			m.Denom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MinRate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Price.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Volume", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -9696,7 +24896,9 @@ func (m *MsgUpdateMinInterestRateRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.MinRate = string(dAtA[iNdEx:postIndex])
+			if err := m.Volume.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -9719,7 +24921,7 @@ func (m *MsgUpdateMinInterestRateRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateMinInterestRateResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgSubmitSourceNAVResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9742,10 +24944,10 @@ func (m *MsgUpdateMinInterestRateResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateMinInterestRateResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSubmitSourceNAVResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateMinInterestRateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSubmitSourceNAVResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -9769,7 +24971,7 @@ func (m *MsgUpdateMinInterestRateResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateMaxInterestRateRequest) Unmarshal(dAtA []byte) error {
+func (m *NAVUpdate) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9792,15 +24994,15 @@ func (m *MsgUpdateMaxInterestRateRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateMaxInterestRateRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: NAVUpdate: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateMaxInterestRateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: NAVUpdate: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Admin", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -9828,13 +25030,13 @@ func (m *MsgUpdateMaxInterestRateRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Admin = string(dAtA[iNdEx:postIndex])
+			m.Denom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VaultAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -9844,27 +25046,28 @@ func (m *MsgUpdateMaxInterestRateRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.VaultAddress = string(dAtA[iNdEx:postIndex])
+			if err := m.Price.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxRate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Volume", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -9892,58 +25095,42 @@ func (m *MsgUpdateMaxInterestRateRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.MaxRate = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipTx(dAtA[iNdEx:])
-			if err != nil {
+			if err := m.Volume.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthTx
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Source", wireType)
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *MsgUpdateMaxInterestRateResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowTx
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
 			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateMaxInterestRateResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateMaxInterestRateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
+			m.Source = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -9965,7 +25152,7 @@ func (m *MsgUpdateMaxInterestRateResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateInterestRateRequest) Unmarshal(dAtA []byte) error {
+func (m *MsgBatchUpdateVaultNAVRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9988,15 +25175,15 @@ func (m *MsgUpdateInterestRateRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateInterestRateRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgBatchUpdateVaultNAVRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateInterestRateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgBatchUpdateVaultNAVRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -10024,7 +25211,7 @@ func (m *MsgUpdateInterestRateRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Authority = string(dAtA[iNdEx:postIndex])
+			m.Signer = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
@@ -10060,9 +25247,9 @@ func (m *MsgUpdateInterestRateRequest) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NewRate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Updates", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -10072,23 +25259,25 @@ func (m *MsgUpdateInterestRateRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.NewRate = string(dAtA[iNdEx:postIndex])
+			m.Updates = append(m.Updates, NAVUpdate{})
+			if err := m.Updates[len(m.Updates)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -10111,7 +25300,7 @@ func (m *MsgUpdateInterestRateRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateInterestRateResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgBatchUpdateVaultNAVResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10134,12 +25323,44 @@ func (m *MsgUpdateInterestRateResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateInterestRateResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgBatchUpdateVaultNAVResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateInterestRateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgBatchUpdateVaultNAVResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingDenoms", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PendingDenoms = append(m.PendingDenoms, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -10161,7 +25382,7 @@ func (m *MsgUpdateInterestRateResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateWithdrawalDelayRequest) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdatePriceSourcesRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10184,10 +25405,10 @@ func (m *MsgUpdateWithdrawalDelayRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateWithdrawalDelayRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdatePriceSourcesRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateWithdrawalDelayRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdatePriceSourcesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -10255,23 +25476,73 @@ func (m *MsgUpdateWithdrawalDelayRequest) Unmarshal(dAtA []byte) error {
 			m.VaultAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WithdrawalDelaySeconds", wireType)
-			}
-			m.WithdrawalDelaySeconds = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
+			if wireType == 0 {
+				var v PriceSourceType
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= PriceSourceType(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.PriceSources = append(m.PriceSources, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthTx
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthTx
+				}
+				if postIndex > l {
 					return io.ErrUnexpectedEOF
 				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.WithdrawalDelaySeconds |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+				var elementCount int
+				if elementCount != 0 && len(m.PriceSources) == 0 {
+					m.PriceSources = make([]PriceSourceType, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v PriceSourceType
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTx
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= PriceSourceType(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.PriceSources = append(m.PriceSources, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field PriceSources", wireType)
 			}
 		default:
 			iNdEx = preIndex
@@ -10294,7 +25565,7 @@ func (m *MsgUpdateWithdrawalDelayRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateWithdrawalDelayResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdatePriceSourcesResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10317,10 +25588,10 @@ func (m *MsgUpdateWithdrawalDelayResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateWithdrawalDelayResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdatePriceSourcesResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateWithdrawalDelayResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdatePriceSourcesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -10344,7 +25615,7 @@ func (m *MsgUpdateWithdrawalDelayResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateMinSwapInValueRequest) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateAssetHaircutRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10367,15 +25638,15 @@ func (m *MsgUpdateMinSwapInValueRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateMinSwapInValueRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateAssetHaircutRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateMinSwapInValueRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateAssetHaircutRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -10403,7 +25674,7 @@ func (m *MsgUpdateMinSwapInValueRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Authority = string(dAtA[iNdEx:postIndex])
+			m.Signer = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
@@ -10439,7 +25710,7 @@ func (m *MsgUpdateMinSwapInValueRequest) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MinSwapInValue", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -10467,8 +25738,27 @@ func (m *MsgUpdateMinSwapInValueRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.MinSwapInValue = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HaircutBips", wireType)
+			}
+			m.HaircutBips = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.HaircutBips |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -10490,7 +25780,7 @@ func (m *MsgUpdateMinSwapInValueRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateMinSwapInValueResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateAssetHaircutResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10513,10 +25803,10 @@ func (m *MsgUpdateMinSwapInValueResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateMinSwapInValueResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateAssetHaircutResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateMinSwapInValueResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateAssetHaircutResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -10540,7 +25830,7 @@ func (m *MsgUpdateMinSwapInValueResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateMinSwapOutValueRequest) Unmarshal(dAtA []byte) error {
+func (m *AssetPaymentRef) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10563,15 +25853,15 @@ func (m *MsgUpdateMinSwapOutValueRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateMinSwapOutValueRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: AssetPaymentRef: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateMinSwapOutValueRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: AssetPaymentRef: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Source", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -10599,43 +25889,11 @@ func (m *MsgUpdateMinSwapOutValueRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Authority = string(dAtA[iNdEx:postIndex])
+			m.Source = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VaultAddress", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.VaultAddress = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MinSwapOutValue", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ExternalId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -10663,7 +25921,7 @@ func (m *MsgUpdateMinSwapOutValueRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.MinSwapOutValue = string(dAtA[iNdEx:postIndex])
+			m.ExternalId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -10686,57 +25944,7 @@ func (m *MsgUpdateMinSwapOutValueRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateMinSwapOutValueResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowTx
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateMinSwapOutValueResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateMinSwapOutValueResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		default:
-			iNdEx = preIndex
-			skippy, err := skipTx(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthTx
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *MsgUpdateMaxSwapInValueRequest) Unmarshal(dAtA []byte) error {
+func (m *MsgBatchAcceptAssetsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10759,10 +25967,10 @@ func (m *MsgUpdateMaxSwapInValueRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateMaxSwapInValueRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgBatchAcceptAssetsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateMaxSwapInValueRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgBatchAcceptAssetsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -10831,9 +26039,9 @@ func (m *MsgUpdateMaxSwapInValueRequest) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxSwapInValue", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Payments", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -10843,74 +26051,46 @@ func (m *MsgUpdateMaxSwapInValueRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.MaxSwapInValue = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipTx(dAtA[iNdEx:])
-			if err != nil {
+			m.Payments = append(m.Payments, AssetPaymentRef{})
+			if err := m.Payments[len(m.Payments)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthTx
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *MsgUpdateMaxSwapInValueResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowTx
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BestEffort", wireType)
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateMaxSwapInValueResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateMaxSwapInValueResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
+			m.BestEffort = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -10932,7 +26112,7 @@ func (m *MsgUpdateMaxSwapInValueResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateMaxSwapOutValueRequest) Unmarshal(dAtA []byte) error {
+func (m *AssetPaymentResult) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10955,15 +26135,15 @@ func (m *MsgUpdateMaxSwapOutValueRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateMaxSwapOutValueRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: AssetPaymentResult: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateMaxSwapOutValueRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: AssetPaymentResult: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Source", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -10991,11 +26171,11 @@ func (m *MsgUpdateMaxSwapOutValueRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Authority = string(dAtA[iNdEx:postIndex])
+			m.Source = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VaultAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ExternalId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -11023,11 +26203,31 @@ func (m *MsgUpdateMaxSwapOutValueRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.VaultAddress = string(dAtA[iNdEx:postIndex])
+			m.ExternalId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Accepted", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Accepted = bool(v != 0)
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxSwapOutValue", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -11055,7 +26255,7 @@ func (m *MsgUpdateMaxSwapOutValueRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.MaxSwapOutValue = string(dAtA[iNdEx:postIndex])
+			m.Error = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -11078,7 +26278,7 @@ func (m *MsgUpdateMaxSwapOutValueRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateMaxSwapOutValueResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgBatchAcceptAssetsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -11091,22 +26291,56 @@ func (m *MsgUpdateMaxSwapOutValueResponse) Unmarshal(dAtA []byte) error {
 			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgBatchAcceptAssetsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgBatchAcceptAssetsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Results", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Results = append(m.Results, AssetPaymentResult{})
+			if err := m.Results[len(m.Results)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateMaxSwapOutValueResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateMaxSwapOutValueResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -11128,7 +26362,7 @@ func (m *MsgUpdateMaxSwapOutValueResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgToggleSwapInRequest) Unmarshal(dAtA []byte) error {
+func (m *MsgCreateAssetPaymentRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -11151,15 +26385,15 @@ func (m *MsgToggleSwapInRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgToggleSwapInRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgCreateAssetPaymentRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgToggleSwapInRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgCreateAssetPaymentRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Admin", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -11187,7 +26421,7 @@ func (m *MsgToggleSwapInRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Admin = string(dAtA[iNdEx:postIndex])
+			m.Authority = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
@@ -11222,10 +26456,10 @@ func (m *MsgToggleSwapInRequest) Unmarshal(dAtA []byte) error {
 			m.VaultAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Enabled", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Target", wireType)
 			}
-			var v int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -11235,117 +26469,63 @@ func (m *MsgToggleSwapInRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Enabled = bool(v != 0)
-		default:
-			iNdEx = preIndex
-			skippy, err := skipTx(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *MsgToggleSwapInResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowTx
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.Target = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SourceAmount", wireType)
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: MsgToggleSwapInResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgToggleSwapInResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		default:
-			iNdEx = preIndex
-			skippy, err := skipTx(dAtA[iNdEx:])
-			if err != nil {
-				return err
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *MsgToggleSwapOutRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowTx
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.SourceAmount = append(m.SourceAmount, types1.Coin{})
+			if err := m.SourceAmount[len(m.SourceAmount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: MsgToggleSwapOutRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgToggleSwapOutRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Admin", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetAmount", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -11355,27 +26535,29 @@ func (m *MsgToggleSwapOutRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Admin = string(dAtA[iNdEx:postIndex])
+			m.TargetAmount = append(m.TargetAmount, types1.Coin{})
+			if err := m.TargetAmount[len(m.TargetAmount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 2:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VaultAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ExternalId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -11403,13 +26585,13 @@ func (m *MsgToggleSwapOutRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.VaultAddress = string(dAtA[iNdEx:postIndex])
+			m.ExternalId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 7:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Enabled", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpirationSeconds", wireType)
 			}
-			var v int
+			m.ExpirationSeconds = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -11419,12 +26601,11 @@ func (m *MsgToggleSwapOutRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				m.ExpirationSeconds |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Enabled = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -11446,7 +26627,7 @@ func (m *MsgToggleSwapOutRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgToggleSwapOutResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgCreateAssetPaymentResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -11469,12 +26650,44 @@ func (m *MsgToggleSwapOutResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgToggleSwapOutResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgCreateAssetPaymentResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgToggleSwapOutResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgCreateAssetPaymentResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Source", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Source = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -11496,7 +26709,7 @@ func (m *MsgToggleSwapOutResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgDepositInterestFundsRequest) Unmarshal(dAtA []byte) error {
+func (m *MsgCancelAssetPaymentRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -11519,10 +26732,10 @@ func (m *MsgDepositInterestFundsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgDepositInterestFundsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgCancelAssetPaymentRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgDepositInterestFundsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgCancelAssetPaymentRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -11591,9 +26804,9 @@ func (m *MsgDepositInterestFundsRequest) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ExternalId", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -11603,24 +26816,23 @@ func (m *MsgDepositInterestFundsRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ExternalId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -11643,7 +26855,7 @@ func (m *MsgDepositInterestFundsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgDepositInterestFundsResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgCancelAssetPaymentResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -11666,10 +26878,10 @@ func (m *MsgDepositInterestFundsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgDepositInterestFundsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgCancelAssetPaymentResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgDepositInterestFundsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgCancelAssetPaymentResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -11693,7 +26905,7 @@ func (m *MsgDepositInterestFundsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgWithdrawInterestFundsRequest) Unmarshal(dAtA []byte) error {
+func (m *MsgExpireAssetPaymentRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -11716,15 +26928,15 @@ func (m *MsgWithdrawInterestFundsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgWithdrawInterestFundsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgExpireAssetPaymentRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgWithdrawInterestFundsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgExpireAssetPaymentRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -11752,7 +26964,7 @@ func (m *MsgWithdrawInterestFundsRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Authority = string(dAtA[iNdEx:postIndex])
+			m.Signer = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
@@ -11788,9 +27000,9 @@ func (m *MsgWithdrawInterestFundsRequest) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ExternalId", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -11800,24 +27012,23 @@ func (m *MsgWithdrawInterestFundsRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ExternalId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -11840,7 +27051,7 @@ func (m *MsgWithdrawInterestFundsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgWithdrawInterestFundsResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgExpireAssetPaymentResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -11863,10 +27074,10 @@ func (m *MsgWithdrawInterestFundsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgWithdrawInterestFundsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgExpireAssetPaymentResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgWithdrawInterestFundsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgExpireAssetPaymentResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -11890,7 +27101,7 @@ func (m *MsgWithdrawInterestFundsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgDepositPrincipalFundsRequest) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateAcquisitionPolicyRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -11913,10 +27124,10 @@ func (m *MsgDepositPrincipalFundsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgDepositPrincipalFundsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateAcquisitionPolicyRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgDepositPrincipalFundsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateAcquisitionPolicyRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -11985,7 +27196,7 @@ func (m *MsgDepositPrincipalFundsRequest) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Policy", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -12012,7 +27223,7 @@ func (m *MsgDepositPrincipalFundsRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Policy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -12037,7 +27248,7 @@ func (m *MsgDepositPrincipalFundsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgDepositPrincipalFundsResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateAcquisitionPolicyResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -12060,10 +27271,10 @@ func (m *MsgDepositPrincipalFundsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgDepositPrincipalFundsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateAcquisitionPolicyResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgDepositPrincipalFundsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateAcquisitionPolicyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -12087,7 +27298,7 @@ func (m *MsgDepositPrincipalFundsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgWithdrawPrincipalFundsRequest) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateConcentrationLimitsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -12110,10 +27321,10 @@ func (m *MsgWithdrawPrincipalFundsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgWithdrawPrincipalFundsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateConcentrationLimitsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgWithdrawPrincipalFundsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateConcentrationLimitsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -12181,10 +27392,10 @@ func (m *MsgWithdrawPrincipalFundsRequest) Unmarshal(dAtA []byte) error {
 			m.VaultAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxAssetConcentrationBips", wireType)
 			}
-			var msglen int
+			m.MaxAssetConcentrationBips = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -12194,25 +27405,30 @@ func (m *MsgWithdrawPrincipalFundsRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.MaxAssetConcentrationBips |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxNonUnderlyingBips", wireType)
 			}
-			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.MaxNonUnderlyingBips = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxNonUnderlyingBips |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -12234,7 +27450,7 @@ func (m *MsgWithdrawPrincipalFundsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgWithdrawPrincipalFundsResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateConcentrationLimitsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -12257,10 +27473,10 @@ func (m *MsgWithdrawPrincipalFundsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgWithdrawPrincipalFundsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateConcentrationLimitsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgWithdrawPrincipalFundsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateConcentrationLimitsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -12284,7 +27500,7 @@ func (m *MsgWithdrawPrincipalFundsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgExpeditePendingSwapOutRequest) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateMinLiquidityRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -12307,10 +27523,10 @@ func (m *MsgExpeditePendingSwapOutRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgExpeditePendingSwapOutRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateMinLiquidityRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgExpeditePendingSwapOutRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateMinLiquidityRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -12346,10 +27562,42 @@ func (m *MsgExpeditePendingSwapOutRequest) Unmarshal(dAtA []byte) error {
 			m.Authority = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VaultAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VaultAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RequestId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MinLiquidityBips", wireType)
 			}
-			m.RequestId = 0
+			m.MinLiquidityBips = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -12359,7 +27607,7 @@ func (m *MsgExpeditePendingSwapOutRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.RequestId |= uint64(b&0x7F) << shift
+				m.MinLiquidityBips |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -12385,7 +27633,7 @@ func (m *MsgExpeditePendingSwapOutRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgExpeditePendingSwapOutResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateMinLiquidityResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -12408,10 +27656,10 @@ func (m *MsgExpeditePendingSwapOutResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgExpeditePendingSwapOutResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateMinLiquidityResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgExpeditePendingSwapOutResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateMinLiquidityResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -12435,7 +27683,7 @@ func (m *MsgExpeditePendingSwapOutResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgPauseVaultRequest) Unmarshal(dAtA []byte) error {
+func (m *MsgCollectAssetIncomeRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -12458,10 +27706,10 @@ func (m *MsgPauseVaultRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgPauseVaultRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgCollectAssetIncomeRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgPauseVaultRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgCollectAssetIncomeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -12530,7 +27778,7 @@ func (m *MsgPauseVaultRequest) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SourceAsset", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -12558,11 +27806,44 @@ func (m *MsgPauseVaultRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Reason = string(dAtA[iNdEx:postIndex])
+			m.SourceAsset = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Force", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ToPrincipal", wireType)
 			}
 			var v int
 			for shift := uint(0); ; shift += 7 {
@@ -12579,7 +27860,7 @@ func (m *MsgPauseVaultRequest) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-			m.Force = bool(v != 0)
+			m.ToPrincipal = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -12601,7 +27882,7 @@ func (m *MsgPauseVaultRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgPauseVaultResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgCollectAssetIncomeResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -12624,10 +27905,10 @@ func (m *MsgPauseVaultResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgPauseVaultResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgCollectAssetIncomeResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgPauseVaultResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgCollectAssetIncomeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -12651,7 +27932,7 @@ func (m *MsgPauseVaultResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUnpauseVaultRequest) Unmarshal(dAtA []byte) error {
+func (m *MsgGrantRoleRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -12674,10 +27955,10 @@ func (m *MsgUnpauseVaultRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUnpauseVaultRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgGrantRoleRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUnpauseVaultRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgGrantRoleRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -12744,6 +28025,57 @@ func (m *MsgUnpauseVaultRequest) Unmarshal(dAtA []byte) error {
 			}
 			m.VaultAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Role", wireType)
+			}
+			m.Role = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Role |= VaultRole(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -12765,7 +28097,7 @@ func (m *MsgUnpauseVaultRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUnpauseVaultResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgGrantRoleResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -12788,10 +28120,10 @@ func (m *MsgUnpauseVaultResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUnpauseVaultResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgGrantRoleResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUnpauseVaultResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgGrantRoleResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -12815,7 +28147,7 @@ func (m *MsgUnpauseVaultResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSetBridgeAddressRequest) Unmarshal(dAtA []byte) error {
+func (m *MsgRevokeRoleRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -12838,15 +28170,15 @@ func (m *MsgSetBridgeAddressRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSetBridgeAddressRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRevokeRoleRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSetBridgeAddressRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRevokeRoleRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Admin", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -12874,7 +28206,7 @@ func (m *MsgSetBridgeAddressRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Admin = string(dAtA[iNdEx:postIndex])
+			m.Authority = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
@@ -12909,8 +28241,27 @@ func (m *MsgSetBridgeAddressRequest) Unmarshal(dAtA []byte) error {
 			m.VaultAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Role", wireType)
+			}
+			m.Role = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Role |= VaultRole(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BridgeAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -12938,7 +28289,7 @@ func (m *MsgSetBridgeAddressRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.BridgeAddress = string(dAtA[iNdEx:postIndex])
+			m.Address = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -12961,7 +28312,7 @@ func (m *MsgSetBridgeAddressRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSetBridgeAddressResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgRevokeRoleResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -12984,10 +28335,10 @@ func (m *MsgSetBridgeAddressResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSetBridgeAddressResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRevokeRoleResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSetBridgeAddressResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRevokeRoleResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -13011,7 +28362,7 @@ func (m *MsgSetBridgeAddressResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgToggleBridgeRequest) Unmarshal(dAtA []byte) error {
+func (m *MsgProposeAdminTransferRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -13024,25 +28375,57 @@ func (m *MsgToggleBridgeRequest) Unmarshal(dAtA []byte) error {
 			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: MsgToggleBridgeRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgToggleBridgeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgProposeAdminTransferRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgProposeAdminTransferRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Admin", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field VaultAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -13070,11 +28453,11 @@ func (m *MsgToggleBridgeRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Admin = string(dAtA[iNdEx:postIndex])
+			m.VaultAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VaultAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NewAdmin", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -13102,13 +28485,13 @@ func (m *MsgToggleBridgeRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.VaultAddress = string(dAtA[iNdEx:postIndex])
+			m.NewAdmin = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 4:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Enabled", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpirationSeconds", wireType)
 			}
-			var v int
+			m.ExpirationSeconds = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -13118,12 +28501,11 @@ func (m *MsgToggleBridgeRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				m.ExpirationSeconds |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Enabled = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -13145,7 +28527,7 @@ func (m *MsgToggleBridgeRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgToggleBridgeResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgProposeAdminTransferResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -13168,10 +28550,10 @@ func (m *MsgToggleBridgeResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgToggleBridgeResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgProposeAdminTransferResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgToggleBridgeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgProposeAdminTransferResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -13195,7 +28577,7 @@ func (m *MsgToggleBridgeResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgBridgeMintSharesRequest) Unmarshal(dAtA []byte) error {
+func (m *MsgAcceptAdminTransferRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -13218,15 +28600,15 @@ func (m *MsgBridgeMintSharesRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgBridgeMintSharesRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgAcceptAdminTransferRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgBridgeMintSharesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgAcceptAdminTransferRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Bridge", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NewAdmin", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -13254,7 +28636,7 @@ func (m *MsgBridgeMintSharesRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Bridge = string(dAtA[iNdEx:postIndex])
+			m.NewAdmin = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
@@ -13288,39 +28670,6 @@ func (m *MsgBridgeMintSharesRequest) Unmarshal(dAtA []byte) error {
 			}
 			m.VaultAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Shares", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.Shares.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -13342,7 +28691,7 @@ func (m *MsgBridgeMintSharesRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgBridgeMintSharesResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgAcceptAdminTransferResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -13365,10 +28714,10 @@ func (m *MsgBridgeMintSharesResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgBridgeMintSharesResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgAcceptAdminTransferResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgBridgeMintSharesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgAcceptAdminTransferResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -13392,7 +28741,7 @@ func (m *MsgBridgeMintSharesResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgBridgeBurnSharesRequest) Unmarshal(dAtA []byte) error {
+func (m *MsgCancelAdminTransferRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -13415,15 +28764,15 @@ func (m *MsgBridgeBurnSharesRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgBridgeBurnSharesRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgCancelAdminTransferRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgBridgeBurnSharesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgCancelAdminTransferRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Bridge", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -13451,7 +28800,7 @@ func (m *MsgBridgeBurnSharesRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Bridge = string(dAtA[iNdEx:postIndex])
+			m.Authority = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
@@ -13485,39 +28834,6 @@ func (m *MsgBridgeBurnSharesRequest) Unmarshal(dAtA []byte) error {
 			}
 			m.VaultAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Shares", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.Shares.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -13539,7 +28855,7 @@ func (m *MsgBridgeBurnSharesRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgBridgeBurnSharesResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgCancelAdminTransferResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -13562,10 +28878,10 @@ func (m *MsgBridgeBurnSharesResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgBridgeBurnSharesResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgCancelAdminTransferResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgBridgeBurnSharesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgCancelAdminTransferResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -13589,7 +28905,7 @@ func (m *MsgBridgeBurnSharesResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSetAssetManagerRequest) Unmarshal(dAtA []byte) error {
+func (m *MsgRecoverVaultAdminRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -13612,15 +28928,15 @@ func (m *MsgSetAssetManagerRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSetAssetManagerRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRecoverVaultAdminRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSetAssetManagerRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRecoverVaultAdminRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Admin", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -13648,7 +28964,7 @@ func (m *MsgSetAssetManagerRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Admin = string(dAtA[iNdEx:postIndex])
+			m.Authority = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
@@ -13684,7 +29000,7 @@ func (m *MsgSetAssetManagerRequest) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AssetManager", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NewAdmin", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -13712,7 +29028,7 @@ func (m *MsgSetAssetManagerRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.AssetManager = string(dAtA[iNdEx:postIndex])
+			m.NewAdmin = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -13735,7 +29051,7 @@ func (m *MsgSetAssetManagerRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSetAssetManagerResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgRecoverVaultAdminResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -13758,10 +29074,10 @@ func (m *MsgSetAssetManagerResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSetAssetManagerResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRecoverVaultAdminResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSetAssetManagerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRecoverVaultAdminResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -13785,7 +29101,7 @@ func (m *MsgSetAssetManagerResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateParamsRequest) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateTimelockRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -13808,10 +29124,10 @@ func (m *MsgUpdateParamsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateParamsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateTimelockRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateTimelockRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -13848,9 +29164,9 @@ func (m *MsgUpdateParamsRequest) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field VaultAddress", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -13860,25 +29176,43 @@ func (m *MsgUpdateParamsRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.VaultAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimelockSeconds", wireType)
+			}
+			m.TimelockSeconds = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TimelockSeconds |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -13900,7 +29234,7 @@ func (m *MsgUpdateParamsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateTimelockResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -13923,10 +29257,10 @@ func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateTimelockResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateTimelockResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -13950,7 +29284,7 @@ func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateVaultAUMFeeBipsRequest) Unmarshal(dAtA []byte) error {
+func (m *MsgCancelScheduledActionRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -13973,10 +29307,10 @@ func (m *MsgUpdateVaultAUMFeeBipsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateVaultAUMFeeBipsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgCancelScheduledActionRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateVaultAUMFeeBipsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgCancelScheduledActionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -14045,9 +29379,9 @@ func (m *MsgUpdateVaultAUMFeeBipsRequest) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AumFeeBips", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ActionId", wireType)
 			}
-			m.AumFeeBips = 0
+			m.ActionId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -14057,7 +29391,7 @@ func (m *MsgUpdateVaultAUMFeeBipsRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.AumFeeBips |= uint32(b&0x7F) << shift
+				m.ActionId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -14083,7 +29417,7 @@ func (m *MsgUpdateVaultAUMFeeBipsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateVaultAUMFeeBipsResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgCancelScheduledActionResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -14106,10 +29440,10 @@ func (m *MsgUpdateVaultAUMFeeBipsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateVaultAUMFeeBipsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgCancelScheduledActionResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateVaultAUMFeeBipsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgCancelScheduledActionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -14133,7 +29467,7 @@ func (m *MsgUpdateVaultAUMFeeBipsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateVaultNAVRequest) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateEligibilityPolicyRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -14147,88 +29481,24 @@ func (m *MsgUpdateVaultNAVRequest) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateVaultNAVRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateVaultNAVRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Signer = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VaultAddress", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			m.VaultAddress = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateEligibilityPolicyRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateEligibilityPolicyRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -14256,44 +29526,11 @@ func (m *MsgUpdateVaultNAVRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Denom = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.Price.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Volume", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field VaultAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -14321,15 +29558,13 @@ func (m *MsgUpdateVaultNAVRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Volume.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.VaultAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 6:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Source", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Policy", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -14339,23 +29574,24 @@ func (m *MsgUpdateVaultNAVRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Source = string(dAtA[iNdEx:postIndex])
+			if err := m.Policy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -14378,7 +29614,7 @@ func (m *MsgUpdateVaultNAVRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateVaultNAVResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateEligibilityPolicyResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -14401,10 +29637,10 @@ func (m *MsgUpdateVaultNAVResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateVaultNAVResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateEligibilityPolicyResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateVaultNAVResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateEligibilityPolicyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -14428,7 +29664,7 @@ func (m *MsgUpdateVaultNAVResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgRemoveVaultNAVRequest) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateAllowlistRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -14451,15 +29687,15 @@ func (m *MsgRemoveVaultNAVRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRemoveVaultNAVRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateAllowlistRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRemoveVaultNAVRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateAllowlistRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -14487,7 +29723,7 @@ func (m *MsgRemoveVaultNAVRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Signer = string(dAtA[iNdEx:postIndex])
+			m.Authority = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
@@ -14523,7 +29759,7 @@ func (m *MsgRemoveVaultNAVRequest) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Add", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -14551,7 +29787,39 @@ func (m *MsgRemoveVaultNAVRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Denom = string(dAtA[iNdEx:postIndex])
+			m.Add = append(m.Add, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Remove", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Remove = append(m.Remove, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -14574,7 +29842,7 @@ func (m *MsgRemoveVaultNAVRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgRemoveVaultNAVResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateAllowlistResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -14597,10 +29865,10 @@ func (m *MsgRemoveVaultNAVResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRemoveVaultNAVResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateAllowlistResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRemoveVaultNAVResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateAllowlistResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -14624,7 +29892,7 @@ func (m *MsgRemoveVaultNAVResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateNAVAuthorityRequest) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdatePositionCapRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -14647,15 +29915,15 @@ func (m *MsgUpdateNAVAuthorityRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateNAVAuthorityRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdatePositionCapRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateNAVAuthorityRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdatePositionCapRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -14683,7 +29951,7 @@ func (m *MsgUpdateNAVAuthorityRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Signer = string(dAtA[iNdEx:postIndex])
+			m.Authority = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
@@ -14719,7 +29987,7 @@ func (m *MsgUpdateNAVAuthorityRequest) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NewAuthority", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxPositionValue", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -14747,7 +30015,39 @@ func (m *MsgUpdateNAVAuthorityRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.NewAuthority = string(dAtA[iNdEx:postIndex])
+			m.MaxPositionValue = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Exemptions", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Exemptions = append(m.Exemptions, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -14770,7 +30070,7 @@ func (m *MsgUpdateNAVAuthorityRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateNAVAuthorityResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdatePositionCapResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -14793,10 +30093,10 @@ func (m *MsgUpdateNAVAuthorityResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateNAVAuthorityResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdatePositionCapResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateNAVAuthorityResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdatePositionCapResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -14820,7 +30120,7 @@ func (m *MsgUpdateNAVAuthorityResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgAcceptAssetRequest) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateMaxTotalValueRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -14843,10 +30143,10 @@ func (m *MsgAcceptAssetRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgAcceptAssetRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateMaxTotalValueRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgAcceptAssetRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateMaxTotalValueRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -14915,39 +30215,7 @@ func (m *MsgAcceptAssetRequest) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Source", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Source = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExternalId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxTotalValue", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -14975,7 +30243,7 @@ func (m *MsgAcceptAssetRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ExternalId = string(dAtA[iNdEx:postIndex])
+			m.MaxTotalValue = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -14998,7 +30266,7 @@ func (m *MsgAcceptAssetRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgAcceptAssetResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateMaxTotalValueResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -15021,10 +30289,10 @@ func (m *MsgAcceptAssetResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgAcceptAssetResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateMaxTotalValueResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgAcceptAssetResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateMaxTotalValueResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -15048,7 +30316,7 @@ func (m *MsgAcceptAssetResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgRejectAssetRequest) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateCircuitBreakerRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -15071,10 +30339,10 @@ func (m *MsgRejectAssetRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRejectAssetRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateCircuitBreakerRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRejectAssetRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateCircuitBreakerRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -15111,9 +30379,9 @@ func (m *MsgRejectAssetRequest) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VaultAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CircuitBreaker", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -15123,59 +30391,28 @@ func (m *MsgRejectAssetRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.VaultAddress = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Source", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			if err := m.CircuitBreaker.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.Source = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExternalId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Guardian", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -15203,7 +30440,7 @@ func (m *MsgRejectAssetRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ExternalId = string(dAtA[iNdEx:postIndex])
+			m.Guardian = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -15226,7 +30463,7 @@ func (m *MsgRejectAssetRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgRejectAssetResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateCircuitBreakerResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -15249,10 +30486,10 @@ func (m *MsgRejectAssetResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRejectAssetResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateCircuitBreakerResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRejectAssetResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateCircuitBreakerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -3,6 +3,10 @@ package types
 import (
 	fmt "fmt"
 	"math"
+	"slices"
+	"strings"
+	"time"
+	"unicode"
 
 	gproto "google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/protoadapt"
@@ -14,6 +18,7 @@ import (
 	proto "github.com/cosmos/gogoproto/proto"
 
 	markertypes "github.com/provenance-io/provenance/x/marker/types"
+	metadatatypes "github.com/provenance-io/provenance/x/metadata/types"
 )
 
 const (
@@ -23,6 +28,44 @@ const (
 	// MaxWithdrawalDelay caps the swap-out withdrawal delay in seconds (2 years).
 	MaxWithdrawalDelay = 31_536_000 * 2
 
+	// MaxNAVAge caps a vault's max_nav_age_seconds staleness limit in seconds (2 years).
+	MaxNAVAge = 31_536_000 * 2
+
+	// MaxNAVChangeBips caps a vault's max_nav_change_bips guardrail (100%).
+	MaxNAVChangeBips = 10_000
+
+	// MaxSettlementToleranceBips caps a vault's settlement_tolerance_bips band (100%).
+	MaxSettlementToleranceBips = 10_000
+
+	// MaxAcquisitionPolicyEntries caps the number of denom patterns, and separately the
+	// number of scope specification ids, in a vault's acquisition policy.
+	MaxAcquisitionPolicyEntries = 32
+
+	// MaxEligibilityAttributes caps the number of required attributes in a vault's
+	// eligibility policy.
+	MaxEligibilityAttributes = 16
+
+	// MaxAllowlistUpdateEntries caps the number of addresses added to, and separately
+	// removed from, a vault's allowlist in a single update.
+	MaxAllowlistUpdateEntries = 100
+
+	// MaxPositionCapExemptions caps the number of addresses exempt from a vault's
+	// position cap.
+	MaxPositionCapExemptions = 32
+
+	// MaxConcentrationBips caps a vault's concentration limits (100% of total vault value).
+	MaxConcentrationBips = 10_000
+
+	// MaxMinLiquidityBips caps a vault's min_liquidity_bips buffer (100% of total vault value).
+	MaxMinLiquidityBips = 10_000
+
+	// NAVProposalLifetime is how long, in seconds, a pending NAV proposal may await
+	// approval before it expires (1 day).
+	NAVProposalLifetime = 86_400
+
+	// MaxNAVSources caps the number of NAV sources a vault may register.
+	MaxNAVSources = 16
+
 	// MaxAbsInterestRate is the absolute ceiling on any interest rate's magnitude (100.0 == 10,000% APR),
 	// bounding the e^(rt) exponent so an admin-set rate cannot overflow the LegacyDec interest math.
 	MaxAbsInterestRate = "100.0"
@@ -90,6 +133,10 @@ type VaultAccountI interface {
 // payment_denom field is populated with the underlying asset purely for wire
 // compatibility; it carries no independent meaning.
 //
+// The admin is made the NAV authority and granted the management roles and the NAV
+// authority role, so a new vault starts with the admin able to perform every operation
+// other than asset settlement.
+//
 // The vault's operational limits are configured via:
 //   - withdrawalDelay: minimum seconds a swap-out must wait in queue.
 //   - aumFeeBips: annual management fee in basis points (1/100th of 1%).
@@ -101,7 +148,7 @@ type VaultAccountI interface {
 //
 // All swap limit values are represented as integer strings (cosmos.IntString).
 func NewVaultAccount(baseAcc *authtypes.BaseAccount, admin, shareDenom, underlyingAsset string, withdrawalDelay uint64, aumFeeBips uint32, minSwapInValue, minSwapOutValue, maxSwapInValue, maxSwapOutValue string) *VaultAccount {
-	return &VaultAccount{
+	vault := &VaultAccount{
 		BaseAccount:            baseAcc,
 		Admin:                  admin,
 		NavAuthority:           admin,
@@ -124,6 +171,8 @@ func NewVaultAccount(baseAcc *authtypes.BaseAccount, admin, shareDenom, underlyi
 		MaxSwapInValue:         maxSwapInValue,
 		MaxSwapOutValue:        maxSwapOutValue,
 	}
+	vault.SeedRoleGrants()
+	return vault
 }
 
 // Clone makes a VaultAccount instance copy.
@@ -208,6 +257,29 @@ func (v VaultAccount) Validate() error {
 		}
 	}
 
+	if v.NavApprover != "" {
+		if _, err := sdk.AccAddressFromBech32(v.NavApprover); err != nil {
+			return fmt.Errorf("invalid nav approver address: %w", err)
+		}
+	}
+
+	if err := ValidateRoleGrants(v.RoleGrants); err != nil {
+		return fmt.Errorf("invalid role grants: %w", err)
+	}
+
+	if v.PendingAdminTransfer != nil {
+		if _, err := sdk.AccAddressFromBech32(v.PendingAdminTransfer.NewAdmin); err != nil {
+			return fmt.Errorf("invalid pending admin %q: %w", v.PendingAdminTransfer.NewAdmin, err)
+		}
+		if v.PendingAdminTransfer.NewAdmin == v.Admin {
+			return fmt.Errorf("pending admin %s is already the vault admin", v.Admin)
+		}
+	}
+
+	if v.TimelockSeconds > MaxTimelockSeconds {
+		return fmt.Errorf("timelock seconds cannot exceed %d", MaxTimelockSeconds)
+	}
+
 	if v.BridgeAddress != "" {
 		if _, err := sdk.AccAddressFromBech32(v.BridgeAddress); err != nil {
 			return fmt.Errorf("invalid bridge address: %w", err)
@@ -303,6 +375,326 @@ func (v VaultAccount) Validate() error {
 		return fmt.Errorf("failed to validate swap-out limits: %w", err)
 	}
 
+	if v.MaxNavAgeSeconds > MaxNAVAge {
+		return fmt.Errorf("max NAV age cannot exceed %d seconds: %d", MaxNAVAge, v.MaxNavAgeSeconds)
+	}
+
+	if err := ValidateNAVChangeLimit(v.MaxNavChangeBips, v.NavApprover); err != nil {
+		return err
+	}
+
+	if v.SettlementToleranceBips > MaxSettlementToleranceBips {
+		return fmt.Errorf("settlement tolerance bips cannot exceed %d: %d", MaxSettlementToleranceBips, v.SettlementToleranceBips)
+	}
+
+	if err := ValidateNAVSources(v.NavSources, v.NavSourceQuorum, v.NavAggregation, v.NavSourceMaxAgeSeconds); err != nil {
+		return err
+	}
+
+	if err := ValidatePriceSources(v.PriceSources); err != nil {
+		return err
+	}
+
+	if err := ValidateAcquisitionPolicy(v.AcquisitionPolicy); err != nil {
+		return fmt.Errorf("invalid acquisition policy: %w", err)
+	}
+
+	if err := ValidateEligibilityPolicy(v.EligibilityPolicy); err != nil {
+		return fmt.Errorf("invalid eligibility policy: %w", err)
+	}
+
+	if err := ValidatePositionCap(v.MaxPositionValue, v.PositionCapExemptions); err != nil {
+		return fmt.Errorf("invalid position cap: %w", err)
+	}
+	if err := ValidateMaxTotalValue(v.MaxTotalValue); err != nil {
+		return fmt.Errorf("invalid max total value: %w", err)
+	}
+
+	if err := ValidateConcentrationLimits(v.MaxAssetConcentrationBips, v.MaxNonUnderlyingBips); err != nil {
+		return err
+	}
+
+	if v.MinLiquidityBips > MaxMinLiquidityBips {
+		return fmt.Errorf("min liquidity bips cannot exceed %d: %d", MaxMinLiquidityBips, v.MinLiquidityBips)
+	}
+
+	return nil
+}
+
+// ValidateConcentrationLimits checks that neither of a vault's concentration limits exceeds
+// MaxConcentrationBips. A limit of 0 disables it.
+func ValidateConcentrationLimits(maxAssetConcentrationBips, maxNonUnderlyingBips uint32) error {
+	if maxAssetConcentrationBips > MaxConcentrationBips {
+		return fmt.Errorf("max asset concentration bips cannot exceed %d: %d", MaxConcentrationBips, maxAssetConcentrationBips)
+	}
+	if maxNonUnderlyingBips > MaxConcentrationBips {
+		return fmt.Errorf("max non-underlying bips cannot exceed %d: %d", MaxConcentrationBips, maxNonUnderlyingBips)
+	}
+	return nil
+}
+
+// ValidatePriceSources checks a vault's price-source chain. Each source must be a known
+// PriceSourceType and may appear at most once.
+func ValidatePriceSources(sources []PriceSourceType) error {
+	seen := make(map[PriceSourceType]bool, len(sources))
+	for _, source := range sources {
+		if _, ok := PriceSourceType_name[int32(source)]; !ok {
+			return fmt.Errorf("unknown price source: %d", source)
+		}
+		if seen[source] {
+			return fmt.Errorf("duplicate price source: %s", source)
+		}
+		seen[source] = true
+	}
+	return nil
+}
+
+// ValidateAcquisitionPolicy checks a vault's acquisition policy. Each denom pattern must be
+// a valid denom, or a non-empty prefix followed by a single trailing "*". Each scope
+// specification id must be a bech32 scope specification address. Neither list may repeat
+// an entry or hold more than MaxAcquisitionPolicyEntries entries.
+func ValidateAcquisitionPolicy(policy AcquisitionPolicy) error {
+	if len(policy.DenomPatterns) > MaxAcquisitionPolicyEntries {
+		return fmt.Errorf("too many denom patterns: %d (max %d)", len(policy.DenomPatterns), MaxAcquisitionPolicyEntries)
+	}
+	seen := make(map[string]bool, len(policy.DenomPatterns))
+	for _, pattern := range policy.DenomPatterns {
+		prefix, wildcard := strings.CutSuffix(pattern, "*")
+		switch {
+		case !wildcard:
+			if err := sdk.ValidateDenom(pattern); err != nil {
+				return fmt.Errorf("invalid denom pattern %q: %w", pattern, err)
+			}
+		case prefix == "" || strings.Contains(prefix, "*"):
+			return fmt.Errorf("invalid denom pattern %q: a wildcard must follow a non-empty prefix and end the pattern", pattern)
+		}
+		if seen[pattern] {
+			return fmt.Errorf("duplicate denom pattern: %s", pattern)
+		}
+		seen[pattern] = true
+	}
+
+	if len(policy.ScopeSpecificationIds) > MaxAcquisitionPolicyEntries {
+		return fmt.Errorf("too many scope specification ids: %d (max %d)", len(policy.ScopeSpecificationIds), MaxAcquisitionPolicyEntries)
+	}
+	seen = make(map[string]bool, len(policy.ScopeSpecificationIds))
+	for _, id := range policy.ScopeSpecificationIds {
+		addr, err := metadatatypes.MetadataAddressFromBech32(id)
+		if err != nil {
+			return fmt.Errorf("invalid scope specification id %q: %w", id, err)
+		}
+		if !addr.IsScopeSpecificationAddress() {
+			return fmt.Errorf("invalid scope specification id %q: not a scope specification address", id)
+		}
+		if seen[id] {
+			return fmt.Errorf("duplicate scope specification id: %s", id)
+		}
+		seen[id] = true
+	}
+	return nil
+}
+
+// PermitsDenom reports whether the policy's denom patterns permit denom. An empty pattern
+// list permits every denom.
+func (p AcquisitionPolicy) PermitsDenom(denom string) bool {
+	if len(p.DenomPatterns) == 0 {
+		return true
+	}
+	for _, pattern := range p.DenomPatterns {
+		if prefix, wildcard := strings.CutSuffix(pattern, "*"); wildcard {
+			if strings.HasPrefix(denom, prefix) {
+				return true
+			}
+		} else if denom == pattern {
+			return true
+		}
+	}
+	return false
+}
+
+// PermitsScopeSpecification reports whether the policy permits a scope created from the
+// given scope specification. An empty list permits every scope specification.
+func (p AcquisitionPolicy) PermitsScopeSpecification(specID string) bool {
+	return len(p.ScopeSpecificationIds) == 0 || slices.Contains(p.ScopeSpecificationIds, specID)
+}
+
+// ValidateEligibilityPolicy checks a vault's eligibility policy. Each required attribute
+// must be a non-empty, lowercase name without whitespace, optionally starting with a "*."
+// wildcard; no other "*" may appear. The list may not repeat a name or hold more than
+// MaxEligibilityAttributes names.
+func ValidateEligibilityPolicy(policy EligibilityPolicy) error {
+	if len(policy.RequiredAttributes) > MaxEligibilityAttributes {
+		return fmt.Errorf("too many required attributes: %d (max %d)", len(policy.RequiredAttributes), MaxEligibilityAttributes)
+	}
+	seen := make(map[string]bool, len(policy.RequiredAttributes))
+	for _, name := range policy.RequiredAttributes {
+		suffix := strings.TrimPrefix(name, "*.")
+		switch {
+		case suffix == "":
+			return fmt.Errorf("invalid required attribute %q: name cannot be empty", name)
+		case strings.Contains(suffix, "*"):
+			return fmt.Errorf("invalid required attribute %q: a wildcard may only appear as a leading \"*.\"", name)
+		case strings.ContainsFunc(name, unicode.IsSpace):
+			return fmt.Errorf("invalid required attribute %q: name cannot contain whitespace", name)
+		case strings.ToLower(name) != name:
+			return fmt.Errorf("invalid required attribute %q: name must be lowercase", name)
+		}
+		if seen[name] {
+			return fmt.Errorf("duplicate required attribute: %s", name)
+		}
+		seen[name] = true
+	}
+	return nil
+}
+
+// MissingAttributes returns the policy's required attributes that are not matched by any of
+// the held attribute names, in policy order. A required name starting with "*." matches any
+// held name ending with the rest of the name, including its leading ".".
+func (p EligibilityPolicy) MissingAttributes(held []string) []string {
+	var missing []string
+	for _, required := range p.RequiredAttributes {
+		matched := slices.ContainsFunc(held, func(name string) bool {
+			if suffix, wildcard := strings.CutPrefix(required, "*"); wildcard {
+				return strings.HasSuffix(name, suffix)
+			}
+			return name == required
+		})
+		if !matched {
+			missing = append(missing, required)
+		}
+	}
+	return missing
+}
+
+// ValidatePositionCap checks a vault's position cap. maxValue must be empty, for no cap, or a
+// positive integer. Each exemption must be a bech32 address, listed at most once, and there
+// may be at most MaxPositionCapExemptions of them.
+func ValidatePositionCap(maxValue string, exemptions []string) error {
+	if maxValue != "" {
+		val, ok := sdkmath.NewIntFromString(maxValue)
+		if !ok {
+			return fmt.Errorf("invalid max position value: %s", maxValue)
+		}
+		if !val.IsPositive() {
+			return fmt.Errorf("max position value must be positive: %s", maxValue)
+		}
+	}
+	if len(exemptions) > MaxPositionCapExemptions {
+		return fmt.Errorf("too many position cap exemptions: %d (max %d)", len(exemptions), MaxPositionCapExemptions)
+	}
+	seen := make(map[string]bool, len(exemptions))
+	for _, addr := range exemptions {
+		if _, err := sdk.AccAddressFromBech32(addr); err != nil {
+			return fmt.Errorf("invalid position cap exemption %q: %w", addr, err)
+		}
+		if seen[addr] {
+			return fmt.Errorf("duplicate position cap exemption: %s", addr)
+		}
+		seen[addr] = true
+	}
+	return nil
+}
+
+// IsPositionCapExempt reports whether addr is exempt from the vault's position cap.
+func (v VaultAccount) IsPositionCapExempt(addr string) bool {
+	return slices.Contains(v.PositionCapExemptions, addr)
+}
+
+// ValidateMaxTotalValue checks a vault's deposit capacity. maxValue must be empty, for no cap,
+// or a positive integer amount of the underlying asset.
+func ValidateMaxTotalValue(maxValue string) error {
+	if maxValue == "" {
+		return nil
+	}
+	val, ok := sdkmath.NewIntFromString(maxValue)
+	if !ok {
+		return fmt.Errorf("invalid max total value: %s", maxValue)
+	}
+	if !val.IsPositive() {
+		return fmt.Errorf("max total value must be positive: %s", maxValue)
+	}
+	return nil
+}
+
+// PriceSourceChain returns the ordered sources consulted to price the vault's held
+// denoms. An unset chain consults only the internal NAV table.
+func (v VaultAccount) PriceSourceChain() []PriceSourceType {
+	if len(v.PriceSources) == 0 {
+		return []PriceSourceType{PriceSourceType_PRICE_SOURCE_TYPE_INTERNAL_NAV}
+	}
+	return v.PriceSources
+}
+
+// UsesExternalPriceSources reports whether the vault's price-source chain consults any
+// source other than the internal NAV table, in which case a held denom can be valued
+// without an internal NAV entry.
+func (v VaultAccount) UsesExternalPriceSources() bool {
+	for _, source := range v.PriceSources {
+		if source != PriceSourceType_PRICE_SOURCE_TYPE_INTERNAL_NAV {
+			return true
+		}
+	}
+	return false
+}
+
+// HasConcentrationLimits reports whether the vault sets either of its concentration limits.
+func (v VaultAccount) HasConcentrationLimits() bool {
+	return v.MaxAssetConcentrationBips > 0 || v.MaxNonUnderlyingBips > 0
+}
+
+// ValidateNAVSources checks a vault's NAV source configuration. Sources must be unique,
+// valid addresses and number at most MaxNAVSources. The quorum may not exceed the number
+// of sources, and an enabled quorum requires a positive freshness window no longer than
+// MaxNAVAge.
+func ValidateNAVSources(sources []string, quorum uint32, aggregation NAVAggregation, maxAgeSeconds uint64) error {
+	if len(sources) > MaxNAVSources {
+		return fmt.Errorf("nav sources cannot exceed %d: %d", MaxNAVSources, len(sources))
+	}
+	seen := make(map[string]bool, len(sources))
+	for _, source := range sources {
+		if _, err := sdk.AccAddressFromBech32(source); err != nil {
+			return fmt.Errorf("invalid nav source address %q: %w", source, err)
+		}
+		if seen[source] {
+			return fmt.Errorf("duplicate nav source: %s", source)
+		}
+		seen[source] = true
+	}
+	if _, ok := NAVAggregation_name[int32(aggregation)]; !ok {
+		return fmt.Errorf("unknown nav aggregation: %d", aggregation)
+	}
+	if int(quorum) > len(sources) {
+		return fmt.Errorf("nav source quorum %d cannot exceed the number of nav sources %d", quorum, len(sources))
+	}
+	if maxAgeSeconds > MaxNAVAge {
+		return fmt.Errorf("nav source max age cannot exceed %d seconds: %d", MaxNAVAge, maxAgeSeconds)
+	}
+	if quorum > 0 && maxAgeSeconds == 0 {
+		return fmt.Errorf("nav source max age is required when nav source quorum is set")
+	}
+	return nil
+}
+
+// IsNAVSource reports whether addr is one of the vault's registered NAV sources.
+func (v VaultAccount) IsNAVSource(addr string) bool {
+	for _, source := range v.NavSources {
+		if source == addr {
+			return true
+		}
+	}
+	return false
+}
+
+// ValidateNAVChangeLimit checks a max_nav_change_bips guardrail setting. The limit may not
+// exceed MaxNAVChangeBips, and an enabled limit requires a NAV approver to confirm the
+// updates it holds back.
+func ValidateNAVChangeLimit(maxNAVChangeBips uint32, navApprover string) error {
+	if maxNAVChangeBips > MaxNAVChangeBips {
+		return fmt.Errorf("max NAV change bips cannot exceed %d: %d", MaxNAVChangeBips, maxNAVChangeBips)
+	}
+	if maxNAVChangeBips > 0 && navApprover == "" {
+		return fmt.Errorf("nav approver is required when max NAV change bips is set")
+	}
 	return nil
 }
 
@@ -355,13 +747,10 @@ func (v *VaultAccount) GetNAVAuthority() string {
 	return v.Admin
 }
 
-// ValidateNAVAuthority returns an error if the given address is not the vault's
-// NAV authority.
-func (v *VaultAccount) ValidateNAVAuthority(signer string) error {
-	if signer != v.GetNAVAuthority() {
-		return fmt.Errorf("unauthorized: %s is not the vault NAV authority", signer)
-	}
-	return nil
+// IsExpired reports whether the transfer can no longer be accepted at blockTime. A transfer
+// without an expiry time never expires.
+func (p PendingAdminTransfer) IsExpired(blockTime time.Time) bool {
+	return p.ExpiryTime != nil && !blockTime.Before(*p.ExpiryTime)
 }
 
 // IsAcceptedDenom reports whether denom is allowed for vault I/O. Vaults are
@@ -392,32 +781,12 @@ func (v VaultAccount) PrincipalMarkerAddress() sdk.AccAddress {
 	return markertypes.MustGetMarkerAddress(v.TotalShares.Denom)
 }
 
-// ValidateManagementAuthority checks whether the given address is authorized to perform
-// vault management actions. Either the vault admin or the asset manager (if set) is allowed.
-func (v VaultAccount) ValidateManagementAuthority(authority string) error {
-	if authority == v.Admin {
-		return nil
-	}
-	if v.AssetManager != "" && authority == v.AssetManager {
-		return nil
-	}
-	return fmt.Errorf("unauthorized authority: %s", authority)
-}
-
-// ValidateAssetManagerAuthority checks whether the given address is the vault's asset
-// manager. It guards actions reserved for the asset manager alone (e.g. P2P settlement),
-// where the admin is deliberately excluded: the field is a role, not a person, so a vault
-// owner who wants a composite approval workflow (e.g. admin and manager both sign) points
-// the asset manager at a group address rather than the module offering per-vault
-// configurability. A vault with no asset manager cannot perform these actions at all.
-func (v VaultAccount) ValidateAssetManagerAuthority(authority string) error {
-	if v.AssetManager == "" {
-		return fmt.Errorf("no asset manager set")
-	}
-	if authority != v.AssetManager {
-		return fmt.Errorf("unauthorized authority: %s", authority)
-	}
-	return nil
+// PaymentEscrowAddress returns the account that escrows the exchange payments the vault
+// creates. It is kept apart from the vault account, whose underlying balance is the
+// interest reserve, so that escrowed funds and payment proceeds are never mistaken for
+// reserves.
+func (v VaultAccount) PaymentEscrowAddress() sdk.AccAddress {
+	return GetPaymentEscrowAddress(v.GetAddress())
 }
 
 // NewPendingSwapOut creates a new PendingSwapOut object.
@@ -465,3 +834,22 @@ func NewVaultNAV(denom string, price sdk.Coin, volume sdkmath.Int, source string
 		Source: source,
 	}
 }
+
+// MaxHaircutBips is the largest valuation haircut, which values a denom at zero.
+const MaxHaircutBips = 10_000
+
+// ValidateHaircutBips checks that a valuation haircut is within [0, MaxHaircutBips].
+func ValidateHaircutBips(haircutBips uint32) error {
+	if haircutBips > MaxHaircutBips {
+		return fmt.Errorf("invalid haircut bips: %d (max %d)", haircutBips, MaxHaircutBips)
+	}
+	return nil
+}
+
+// ApplyHaircut reduces value by haircutBips basis points, flooring the result.
+func ApplyHaircut(value sdkmath.Int, haircutBips uint32) sdkmath.Int {
+	if haircutBips == 0 {
+		return value
+	}
+	return value.MulRaw(int64(MaxHaircutBips - haircutBips)).QuoRaw(MaxHaircutBips)
+}
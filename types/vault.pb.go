@@ -7,6 +7,7 @@ import (
 	cosmossdk_io_math "cosmossdk.io/math"
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-proto"
+	types2 "github.com/cosmos/cosmos-sdk/codec/types"
 	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
 	types1 "github.com/cosmos/cosmos-sdk/types"
 	types "github.com/cosmos/cosmos-sdk/x/auth/types"
@@ -32,6 +33,126 @@ var _ = time.Kitchen
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// NAVAggregation selects how a vault combines its fresh NAV source submissions into the
+// price used for valuation.
+type NAVAggregation int32
+
+const (
+	// NAV_AGGREGATION_MEDIAN uses the median per-unit price. With an even number of fresh
+	// submissions it is the mean of the two middle prices.
+	NAVAggregation_NAV_AGGREGATION_MEDIAN NAVAggregation = 0
+	// NAV_AGGREGATION_MIN uses the lowest per-unit price, for conservative valuation.
+	NAVAggregation_NAV_AGGREGATION_MIN NAVAggregation = 1
+)
+
+var NAVAggregation_name = map[int32]string{
+	0: "NAV_AGGREGATION_MEDIAN",
+	1: "NAV_AGGREGATION_MIN",
+}
+
+var NAVAggregation_value = map[string]int32{
+	"NAV_AGGREGATION_MEDIAN": 0,
+	"NAV_AGGREGATION_MIN":    1,
+}
+
+func (x NAVAggregation) String() string {
+	return proto.EnumName(NAVAggregation_name, int32(x))
+}
+
+func (NAVAggregation) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_2e0d78aae3177bea, []int{0}
+}
+
+// PriceSourceType identifies a source a vault may consult to price a held denom.
+type PriceSourceType int32
+
+const (
+	// PRICE_SOURCE_TYPE_INTERNAL_NAV is the vault's internal NAV table, including the
+	// aggregate of its NAV sources once their quorum is met.
+	PriceSourceType_PRICE_SOURCE_TYPE_INTERNAL_NAV PriceSourceType = 0
+	// PRICE_SOURCE_TYPE_MARKER_NAV is the denom's marker-module net asset value in the
+	// vault's underlying asset.
+	PriceSourceType_PRICE_SOURCE_TYPE_MARKER_NAV PriceSourceType = 1
+	// PRICE_SOURCE_TYPE_EXCHANGE_SETTLEMENT is the price of the most recent vault settlement
+	// of the denom against the vault's underlying asset through the exchange module.
+	PriceSourceType_PRICE_SOURCE_TYPE_EXCHANGE_SETTLEMENT PriceSourceType = 2
+)
+
+var PriceSourceType_name = map[int32]string{
+	0: "PRICE_SOURCE_TYPE_INTERNAL_NAV",
+	1: "PRICE_SOURCE_TYPE_MARKER_NAV",
+	2: "PRICE_SOURCE_TYPE_EXCHANGE_SETTLEMENT",
+}
+
+var PriceSourceType_value = map[string]int32{
+	"PRICE_SOURCE_TYPE_INTERNAL_NAV":        0,
+	"PRICE_SOURCE_TYPE_MARKER_NAV":          1,
+	"PRICE_SOURCE_TYPE_EXCHANGE_SETTLEMENT": 2,
+}
+
+func (x PriceSourceType) String() string {
+	return proto.EnumName(PriceSourceType_name, int32(x))
+}
+
+func (PriceSourceType) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_2e0d78aae3177bea, []int{1}
+}
+
+// VaultRole is an operational role on a vault. Each role authorizes a fixed set of
+// messages, so operations staff can hold only the keys their job needs.
+type VaultRole int32
+
+const (
+	// VAULT_ROLE_UNSPECIFIED is not a role and cannot be granted.
+	VaultRole_VAULT_ROLE_UNSPECIFIED VaultRole = 0
+	// VAULT_ROLE_PAUSER may pause and unpause the vault.
+	VaultRole_VAULT_ROLE_PAUSER VaultRole = 1
+	// VAULT_ROLE_RATE_SETTER may change the vault's interest rate within its bounds.
+	VaultRole_VAULT_ROLE_RATE_SETTER VaultRole = 2
+	// VAULT_ROLE_LIMITS_MANAGER may change the withdrawal delay and swap limits, and expedite
+	// queued swap-outs.
+	VaultRole_VAULT_ROLE_LIMITS_MANAGER VaultRole = 3
+	// VAULT_ROLE_RESERVE_FUNDER may deposit and withdraw interest reserves and principal funds.
+	VaultRole_VAULT_ROLE_RESERVE_FUNDER VaultRole = 4
+	// VAULT_ROLE_NAV_AUTHORITY may write the vault's internal NAV table and asset haircuts.
+	VaultRole_VAULT_ROLE_NAV_AUTHORITY VaultRole = 5
+	// VAULT_ROLE_SETTLEMENT_MANAGER may settle, reject and offer asset trades, and collect
+	// asset income.
+	VaultRole_VAULT_ROLE_SETTLEMENT_MANAGER VaultRole = 6
+	// VAULT_ROLE_GUARDIAN may cancel the vault's scheduled actions before they execute.
+	VaultRole_VAULT_ROLE_GUARDIAN VaultRole = 7
+)
+
+var VaultRole_name = map[int32]string{
+	0: "VAULT_ROLE_UNSPECIFIED",
+	1: "VAULT_ROLE_PAUSER",
+	2: "VAULT_ROLE_RATE_SETTER",
+	3: "VAULT_ROLE_LIMITS_MANAGER",
+	4: "VAULT_ROLE_RESERVE_FUNDER",
+	5: "VAULT_ROLE_NAV_AUTHORITY",
+	6: "VAULT_ROLE_SETTLEMENT_MANAGER",
+	7: "VAULT_ROLE_GUARDIAN",
+}
+
+var VaultRole_value = map[string]int32{
+	"VAULT_ROLE_UNSPECIFIED":        0,
+	"VAULT_ROLE_PAUSER":             1,
+	"VAULT_ROLE_RATE_SETTER":        2,
+	"VAULT_ROLE_LIMITS_MANAGER":     3,
+	"VAULT_ROLE_RESERVE_FUNDER":     4,
+	"VAULT_ROLE_NAV_AUTHORITY":      5,
+	"VAULT_ROLE_SETTLEMENT_MANAGER": 6,
+	"VAULT_ROLE_GUARDIAN":           7,
+}
+
+func (x VaultRole) String() string {
+	return proto.EnumName(VaultRole_name, int32(x))
+}
+
+func (VaultRole) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_2e0d78aae3177bea, []int{2}
+}
+
 // VaultAccount represents a central holding place for assets, governed by a set of rules.
 // It is based on the ERC-4626 standard and builds upon the Provenance Marker module.
 type VaultAccount struct {
@@ -94,9 +215,10 @@ type VaultAccount struct {
 	// bridge_enabled indicates whether the bridge functionality is active. If false, the
 	// bridge_address has no effect and cannot mint or burn.
 	BridgeEnabled bool `protobuf:"varint,19,opt,name=bridge_enabled,json=bridgeEnabled,proto3" json:"bridge_enabled,omitempty"`
-	// asset_manager is an optional address that, when set, is authorized to manage certain
-	// collateral operations alongside the admin (e.g., pausing/unpausing, depositing/withdrawing
-	// principal or interest funds). If unset (empty string), only the admin may perform those actions.
+	// asset_manager is an optional address that, when set, holds the management roles and the
+	// settlement manager role (e.g., pausing/unpausing, depositing/withdrawing principal or
+	// interest funds, settling assets). Changing it via MsgSetAssetManager moves those roles from
+	// the previous asset manager to the new one.
 	AssetManager string `protobuf:"bytes,20,opt,name=asset_manager,json=assetManager,proto3" json:"asset_manager,omitempty"`
 	// fee_period_start is the start time (in Unix seconds) of the current AUM fee collection period.
 	// This is a module-managed timestamp kept in sync with the fee timeout queue machinery.
@@ -136,10 +258,90 @@ type VaultAccount struct {
 	// - Values must be positive (> 0).
 	// - An empty string "" indicates no maximum limit.
 	MaxSwapOutValue string `protobuf:"bytes,28,opt,name=max_swap_out_value,json=maxSwapOutValue,proto3" json:"max_swap_out_value,omitempty"`
-	// nav_authority is the address authorized to mutate this vault's internal NAV
-	// table via MsgUpdateVaultNAV. If empty, the vault admin is treated as the NAV
-	// authority. It is rotated only via MsgUpdateNAVAuthority.
+	// nav_authority is the address holding the NAV authority role, which authorizes mutating
+	// this vault's internal NAV table via MsgUpdateVaultNAV. If empty, the vault admin is
+	// treated as the NAV authority. Rotating it via MsgUpdateNAVAuthority moves the role.
 	NavAuthority string `protobuf:"bytes,29,opt,name=nav_authority,json=navAuthority,proto3" json:"nav_authority,omitempty"`
+	// max_nav_age_seconds is the maximum age (in seconds) an internal NAV entry for a held
+	// denom may reach before the vault treats its valuation as stale. While any held denom's
+	// NAV is older than this limit, swap-ins, swap-out requests, and asset settlements are
+	// rejected until the NAV authority refreshes the price.
+	// A value of 0 disables the staleness check.
+	MaxNavAgeSeconds uint64 `protobuf:"varint,30,opt,name=max_nav_age_seconds,json=maxNavAgeSeconds,proto3" json:"max_nav_age_seconds,omitempty"`
+	// auto_pause_on_stale_nav, when true, makes the EndBlocker automatically pause the vault
+	// once any held denom's NAV exceeds max_nav_age_seconds. It has no effect while
+	// max_nav_age_seconds is 0.
+	AutoPauseOnStaleNav bool `protobuf:"varint,31,opt,name=auto_pause_on_stale_nav,json=autoPauseOnStaleNav,proto3" json:"auto_pause_on_stale_nav,omitempty"`
+	// max_nav_change_bips is the largest move, in basis points of the current price, that
+	// the NAV authority may apply to a held denom's internal NAV in a single update. A
+	// larger update is stored as a PendingNAVProposal and takes effect only once
+	// nav_approver confirms it. A value of 0 disables the guardrail.
+	MaxNavChangeBips uint32 `protobuf:"varint,32,opt,name=max_nav_change_bips,json=maxNavChangeBips,proto3" json:"max_nav_change_bips,omitempty"`
+	// nav_approver is the address that confirms NAV proposals exceeding
+	// max_nav_change_bips. It must be set while the guardrail is enabled and can never
+	// approve a proposal it submitted itself.
+	NavApprover string `protobuf:"bytes,33,opt,name=nav_approver,json=navApprover,proto3" json:"nav_approver,omitempty"`
+	// nav_sources are the independent pricing agents permitted to submit per-source NAV
+	// prices for the vault's denoms. Submissions are recorded separately from the NAV
+	// authority's entry and aggregated once nav_source_quorum of them are fresh.
+	NavSources []string `protobuf:"bytes,34,rep,name=nav_sources,json=navSources,proto3" json:"nav_sources,omitempty"`
+	// nav_source_quorum is the number of fresh source submissions a denom needs before
+	// the aggregated source price replaces the NAV authority's entry in valuation. A
+	// value of 0 disables aggregation.
+	NavSourceQuorum uint32 `protobuf:"varint,35,opt,name=nav_source_quorum,json=navSourceQuorum,proto3" json:"nav_source_quorum,omitempty"`
+	// nav_aggregation selects how fresh source submissions are combined into one price.
+	NavAggregation NAVAggregation `protobuf:"varint,36,opt,name=nav_aggregation,json=navAggregation,proto3,enum=provlabs.vault.v1.NAVAggregation" json:"nav_aggregation,omitempty"`
+	// nav_source_max_age_seconds is how long a source submission counts toward the quorum
+	// after it was submitted. It must be positive while nav_source_quorum is non-zero.
+	NavSourceMaxAgeSeconds uint64 `protobuf:"varint,37,opt,name=nav_source_max_age_seconds,json=navSourceMaxAgeSeconds,proto3" json:"nav_source_max_age_seconds,omitempty"`
+	// price_sources is the ordered chain of sources consulted to price a held denom; the
+	// first source with a price for the denom values it. When empty, only the internal NAV
+	// table is consulted.
+	PriceSources []PriceSourceType `protobuf:"varint,38,rep,packed,name=price_sources,json=priceSources,proto3,enum=provlabs.vault.v1.PriceSourceType" json:"price_sources,omitempty"`
+	// settlement_tolerance_bips is how far, in basis points of the asset leg's NAV-implied
+	// value, an asset settlement's payment leg may deviate from the vault's internal NAV. A
+	// value of 0 requires settlements to trade at exactly the recorded NAV.
+	SettlementToleranceBips uint32 `protobuf:"varint,39,opt,name=settlement_tolerance_bips,json=settlementToleranceBips,proto3" json:"settlement_tolerance_bips,omitempty"`
+	// update_nav_on_settlement marks a single-denom asset's internal NAV to the price of each
+	// settlement that traded away from it, provided the move does not exceed
+	// max_nav_change_bips.
+	UpdateNavOnSettlement bool `protobuf:"varint,40,opt,name=update_nav_on_settlement,json=updateNavOnSettlement,proto3" json:"update_nav_on_settlement,omitempty"`
+	// acquisition_policy restricts which assets the vault may price and acquire. An empty
+	// policy permits any asset.
+	AcquisitionPolicy AcquisitionPolicy `protobuf:"bytes,41,opt,name=acquisition_policy,json=acquisitionPolicy,proto3" json:"acquisition_policy"`
+	// max_asset_concentration_bips caps the value of any single non-underlying held denom as a
+	// share of total vault value, in basis points. A value of 0 disables the limit.
+	MaxAssetConcentrationBips uint32 `protobuf:"varint,42,opt,name=max_asset_concentration_bips,json=maxAssetConcentrationBips,proto3" json:"max_asset_concentration_bips,omitempty"`
+	// max_non_underlying_bips caps the combined value of every non-underlying held denom as a
+	// share of total vault value, in basis points. A value of 0 disables the limit.
+	MaxNonUnderlyingBips uint32 `protobuf:"varint,43,opt,name=max_non_underlying_bips,json=maxNonUnderlyingBips,proto3" json:"max_non_underlying_bips,omitempty"`
+	// min_liquidity_bips is the share of total vault value, in basis points, the principal
+	// marker must keep in the underlying asset on top of the value of queued swap-outs after an
+	// inbound asset settlement or a principal withdrawal. A value of 0 disables the buffer.
+	MinLiquidityBips uint32 `protobuf:"varint,44,opt,name=min_liquidity_bips,json=minLiquidityBips,proto3" json:"min_liquidity_bips,omitempty"`
+	// role_grants is the vault's role table: each entry authorizes one address to perform the
+	// operations of one role. Grants are sorted by role and then address, and are managed by the
+	// admin via MsgGrantRole and MsgRevokeRole.
+	RoleGrants []RoleGrant `protobuf:"bytes,45,rep,name=role_grants,json=roleGrants,proto3" json:"role_grants"`
+	// pending_admin_transfer is the admin transfer proposed via MsgProposeAdminTransfer and
+	// awaiting acceptance by the proposed admin, if any.
+	PendingAdminTransfer *PendingAdminTransfer `protobuf:"bytes,46,opt,name=pending_admin_transfer,json=pendingAdminTransfer,proto3" json:"pending_admin_transfer,omitempty"`
+	// timelock_seconds delays the vault's sensitive configuration changes: while it is non-zero,
+	// such a message is held as a ScheduledAction and applied this many seconds later, unless
+	// the admin or a guardian cancels it first. A value of 0 applies changes immediately.
+	TimelockSeconds uint64 `protobuf:"varint,47,opt,name=timelock_seconds,json=timelockSeconds,proto3" json:"timelock_seconds,omitempty"`
+	// eligibility_policy restricts which addresses may subscribe to and hold the vault's
+	// shares. A disabled policy lets any address hold shares.
+	EligibilityPolicy EligibilityPolicy `protobuf:"bytes,48,opt,name=eligibility_policy,json=eligibilityPolicy,proto3" json:"eligibility_policy"`
+	// max_position_value caps the value, measured in the underlying_asset, of the shares a single
+	// account may hold after a swap-in or share transfer. An empty string "" indicates no cap.
+	MaxPositionValue string `protobuf:"bytes,49,opt,name=max_position_value,json=maxPositionValue,proto3" json:"max_position_value,omitempty"`
+	// position_cap_exemptions are the addresses max_position_value does not apply to, such as
+	// the bridge or a seed investor.
+	PositionCapExemptions []string `protobuf:"bytes,50,rep,name=position_cap_exemptions,json=positionCapExemptions,proto3" json:"position_cap_exemptions,omitempty"`
+	// max_total_value caps the vault's net total value, measured in the underlying_asset, that
+	// swap-ins may bring it to. An empty string "" indicates no cap.
+	MaxTotalValue string `protobuf:"bytes,51,opt,name=max_total_value,json=maxTotalValue,proto3" json:"max_total_value,omitempty"`
 }
 
 func (m *VaultAccount) Reset()         { *m = VaultAccount{} }
@@ -372,115 +574,184 @@ func (m *VaultAccount) GetNavAuthority() string {
 	return ""
 }
 
-// VaultNAV is a single internal net asset value entry recording the price of one
-// asset denom held by a vault. The vault module is the sole source of truth for
-// these values; the NAV authority maintains them via MsgUpdateVaultNAV.
-type VaultNAV struct {
-	// denom is the asset denomination this entry prices.
-	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
-	// price is the total value of `volume` units of the denom, denominated in the
-	// vault's underlying asset. The per-unit value is price divided by volume.
-	Price types1.Coin `protobuf:"bytes,2,opt,name=price,proto3" json:"price"`
-	// volume is the number of units of the denom that price covers. It must be
-	// positive; the per-unit value of the denom is price divided by volume.
-	Volume cosmossdk_io_math.Int `protobuf:"bytes,3,opt,name=volume,proto3,customtype=cosmossdk.io/math.Int" json:"volume"`
-	// source identifies the origin of this NAV entry (for example an oracle name
-	// or the settlement path), mirroring the Marker module's NAV source attribution.
-	Source string `protobuf:"bytes,4,opt,name=source,proto3" json:"source,omitempty"`
-	// updated_block_height is the block height at which this entry was last updated.
-	UpdatedBlockHeight int64 `protobuf:"varint,5,opt,name=updated_block_height,json=updatedBlockHeight,proto3" json:"updated_block_height,omitempty"`
-	// updated_time is the block time at which this entry was last updated.
-	UpdatedTime time.Time `protobuf:"bytes,6,opt,name=updated_time,json=updatedTime,proto3,stdtime" json:"updated_time"`
+func (m *VaultAccount) GetMaxNavAgeSeconds() uint64 {
+	if m != nil {
+		return m.MaxNavAgeSeconds
+	}
+	return 0
 }
 
-func (m *VaultNAV) Reset()         { *m = VaultNAV{} }
-func (m *VaultNAV) String() string { return proto.CompactTextString(m) }
-func (*VaultNAV) ProtoMessage()    {}
-func (*VaultNAV) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2e0d78aae3177bea, []int{1}
+func (m *VaultAccount) GetAutoPauseOnStaleNav() bool {
+	if m != nil {
+		return m.AutoPauseOnStaleNav
+	}
+	return false
 }
-func (m *VaultNAV) XXX_Unmarshal(b []byte) error {
-	return m.Unmarshal(b)
+
+func (m *VaultAccount) GetMaxNavChangeBips() uint32 {
+	if m != nil {
+		return m.MaxNavChangeBips
+	}
+	return 0
 }
-func (m *VaultNAV) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	if deterministic {
-		return xxx_messageInfo_VaultNAV.Marshal(b, m, deterministic)
-	} else {
-		b = b[:cap(b)]
-		n, err := m.MarshalToSizedBuffer(b)
-		if err != nil {
-			return nil, err
-		}
-		return b[:n], nil
+
+func (m *VaultAccount) GetNavApprover() string {
+	if m != nil {
+		return m.NavApprover
 	}
+	return ""
 }
-func (m *VaultNAV) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_VaultNAV.Merge(m, src)
+
+func (m *VaultAccount) GetNavSources() []string {
+	if m != nil {
+		return m.NavSources
+	}
+	return nil
 }
-func (m *VaultNAV) XXX_Size() int {
-	return m.Size()
+
+func (m *VaultAccount) GetNavSourceQuorum() uint32 {
+	if m != nil {
+		return m.NavSourceQuorum
+	}
+	return 0
 }
-func (m *VaultNAV) XXX_DiscardUnknown() {
-	xxx_messageInfo_VaultNAV.DiscardUnknown(m)
+
+func (m *VaultAccount) GetNavAggregation() NAVAggregation {
+	if m != nil {
+		return m.NavAggregation
+	}
+	return NAVAggregation_NAV_AGGREGATION_MEDIAN
 }
 
-var xxx_messageInfo_VaultNAV proto.InternalMessageInfo
+func (m *VaultAccount) GetNavSourceMaxAgeSeconds() uint64 {
+	if m != nil {
+		return m.NavSourceMaxAgeSeconds
+	}
+	return 0
+}
 
-func (m *VaultNAV) GetDenom() string {
+func (m *VaultAccount) GetPriceSources() []PriceSourceType {
 	if m != nil {
-		return m.Denom
+		return m.PriceSources
 	}
-	return ""
+	return nil
 }
 
-func (m *VaultNAV) GetPrice() types1.Coin {
+func (m *VaultAccount) GetSettlementToleranceBips() uint32 {
 	if m != nil {
-		return m.Price
+		return m.SettlementToleranceBips
 	}
-	return types1.Coin{}
+	return 0
 }
 
-func (m *VaultNAV) GetSource() string {
+func (m *VaultAccount) GetUpdateNavOnSettlement() bool {
 	if m != nil {
-		return m.Source
+		return m.UpdateNavOnSettlement
 	}
-	return ""
+	return false
 }
 
-func (m *VaultNAV) GetUpdatedBlockHeight() int64 {
+func (m *VaultAccount) GetAcquisitionPolicy() AcquisitionPolicy {
 	if m != nil {
-		return m.UpdatedBlockHeight
+		return m.AcquisitionPolicy
+	}
+	return AcquisitionPolicy{}
+}
+
+func (m *VaultAccount) GetMaxAssetConcentrationBips() uint32 {
+	if m != nil {
+		return m.MaxAssetConcentrationBips
 	}
 	return 0
 }
 
-func (m *VaultNAV) GetUpdatedTime() time.Time {
+func (m *VaultAccount) GetMaxNonUnderlyingBips() uint32 {
 	if m != nil {
-		return m.UpdatedTime
+		return m.MaxNonUnderlyingBips
 	}
-	return time.Time{}
+	return 0
 }
 
-// AccountBalance represents the coin balance of a single account.
-type AccountBalance struct {
-	// address is the account address.
-	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
-	// coins is the balance of the account.
-	Coins github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=coins,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"coins"`
+func (m *VaultAccount) GetMinLiquidityBips() uint32 {
+	if m != nil {
+		return m.MinLiquidityBips
+	}
+	return 0
 }
 
-func (m *AccountBalance) Reset()         { *m = AccountBalance{} }
-func (m *AccountBalance) String() string { return proto.CompactTextString(m) }
-func (*AccountBalance) ProtoMessage()    {}
-func (*AccountBalance) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2e0d78aae3177bea, []int{2}
+func (m *VaultAccount) GetRoleGrants() []RoleGrant {
+	if m != nil {
+		return m.RoleGrants
+	}
+	return nil
 }
-func (m *AccountBalance) XXX_Unmarshal(b []byte) error {
+
+func (m *VaultAccount) GetPendingAdminTransfer() *PendingAdminTransfer {
+	if m != nil {
+		return m.PendingAdminTransfer
+	}
+	return nil
+}
+
+func (m *VaultAccount) GetTimelockSeconds() uint64 {
+	if m != nil {
+		return m.TimelockSeconds
+	}
+	return 0
+}
+
+func (m *VaultAccount) GetEligibilityPolicy() EligibilityPolicy {
+	if m != nil {
+		return m.EligibilityPolicy
+	}
+	return EligibilityPolicy{}
+}
+
+func (m *VaultAccount) GetMaxPositionValue() string {
+	if m != nil {
+		return m.MaxPositionValue
+	}
+	return ""
+}
+
+func (m *VaultAccount) GetPositionCapExemptions() []string {
+	if m != nil {
+		return m.PositionCapExemptions
+	}
+	return nil
+}
+
+func (m *VaultAccount) GetMaxTotalValue() string {
+	if m != nil {
+		return m.MaxTotalValue
+	}
+	return ""
+}
+
+// EligibilityPolicy restricts a vault's investors, such as to the accredited investors a
+// regulated fund may accept. While enabled, an address is eligible when it is on the vault's
+// allowlist, or when required_attributes is non-empty and the address holds every one of them.
+type EligibilityPolicy struct {
+	// enabled turns the policy on. When false every address is eligible.
+	Enabled bool `protobuf:"varint,1,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	// required_attributes are the account attribute names an address must hold to be eligible
+	// without being allowlisted. A name starting with "*." matches any attribute ending with the
+	// rest of the name. When empty, only allowlisted addresses are eligible.
+	RequiredAttributes []string `protobuf:"bytes,2,rep,name=required_attributes,json=requiredAttributes,proto3" json:"required_attributes,omitempty"`
+}
+
+func (m *EligibilityPolicy) Reset()         { *m = EligibilityPolicy{} }
+func (m *EligibilityPolicy) String() string { return proto.CompactTextString(m) }
+func (*EligibilityPolicy) ProtoMessage()    {}
+func (*EligibilityPolicy) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2e0d78aae3177bea, []int{1}
+}
+func (m *EligibilityPolicy) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *AccountBalance) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *EligibilityPolicy) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_AccountBalance.Marshal(b, m, deterministic)
+		return xxx_messageInfo_EligibilityPolicy.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -490,47 +761,54 @@ func (m *AccountBalance) XXX_Marshal(b []byte, deterministic bool) ([]byte, erro
 		return b[:n], nil
 	}
 }
-func (m *AccountBalance) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_AccountBalance.Merge(m, src)
+func (m *EligibilityPolicy) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EligibilityPolicy.Merge(m, src)
 }
-func (m *AccountBalance) XXX_Size() int {
+func (m *EligibilityPolicy) XXX_Size() int {
 	return m.Size()
 }
-func (m *AccountBalance) XXX_DiscardUnknown() {
-	xxx_messageInfo_AccountBalance.DiscardUnknown(m)
+func (m *EligibilityPolicy) XXX_DiscardUnknown() {
+	xxx_messageInfo_EligibilityPolicy.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_AccountBalance proto.InternalMessageInfo
+var xxx_messageInfo_EligibilityPolicy proto.InternalMessageInfo
 
-// PendingSwapOut are swap outs that have not yet been processed and completed.
-type PendingSwapOut struct {
-	// owner is the address initiating the swap out.
-	Owner string `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty"`
-	// vault_address is the address of the vault processing the withdrawal.
-	VaultAddress string `protobuf:"bytes,2,opt,name=vault_address,json=vaultAddress,proto3" json:"vault_address,omitempty"`
-	// shares are the shares that were escrowed by the user.
-	Shares types1.Coin `protobuf:"bytes,3,opt,name=shares,proto3" json:"shares"`
-	// redeem_denom is the denomination of the asset to be redeemed. Always the
-	// vault's underlying_asset; retained for wire compatibility.
-	RedeemDenom string `protobuf:"bytes,4,opt,name=redeem_denom,json=redeemDenom,proto3" json:"redeem_denom,omitempty"` // Deprecated: Do not use.
-	// failure_count is the number of consecutive failed processing attempts that
-	// left this request queued. Each failure re-keys the request to a later retry
-	// time so it cannot hold the front of the queue. Reset when expedited.
-	FailureCount uint32 `protobuf:"varint,5,opt,name=failure_count,json=failureCount,proto3" json:"failure_count,omitempty"`
+func (m *EligibilityPolicy) GetEnabled() bool {
+	if m != nil {
+		return m.Enabled
+	}
+	return false
 }
 
-func (m *PendingSwapOut) Reset()         { *m = PendingSwapOut{} }
-func (m *PendingSwapOut) String() string { return proto.CompactTextString(m) }
-func (*PendingSwapOut) ProtoMessage()    {}
-func (*PendingSwapOut) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2e0d78aae3177bea, []int{3}
+func (m *EligibilityPolicy) GetRequiredAttributes() []string {
+	if m != nil {
+		return m.RequiredAttributes
+	}
+	return nil
 }
-func (m *PendingSwapOut) XXX_Unmarshal(b []byte) error {
+
+// PendingAdminTransfer is a proposed change of a vault's admin. It takes effect only when the
+// proposed admin accepts it, so a vault can never be handed to an address that cannot sign.
+type PendingAdminTransfer struct {
+	// new_admin is the bech32 address that may accept the transfer.
+	NewAdmin string `protobuf:"bytes,1,opt,name=new_admin,json=newAdmin,proto3" json:"new_admin,omitempty"`
+	// expiry_time is the block time at or after which the transfer can no longer be accepted.
+	// It is unset when the transfer does not expire.
+	ExpiryTime *time.Time `protobuf:"bytes,2,opt,name=expiry_time,json=expiryTime,proto3,stdtime" json:"expiry_time,omitempty"`
+}
+
+func (m *PendingAdminTransfer) Reset()         { *m = PendingAdminTransfer{} }
+func (m *PendingAdminTransfer) String() string { return proto.CompactTextString(m) }
+func (*PendingAdminTransfer) ProtoMessage()    {}
+func (*PendingAdminTransfer) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2e0d78aae3177bea, []int{2}
+}
+func (m *PendingAdminTransfer) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *PendingSwapOut) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *PendingAdminTransfer) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_PendingSwapOut.Marshal(b, m, deterministic)
+		return xxx_messageInfo_PendingAdminTransfer.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -540,779 +818,4820 @@ func (m *PendingSwapOut) XXX_Marshal(b []byte, deterministic bool) ([]byte, erro
 		return b[:n], nil
 	}
 }
-func (m *PendingSwapOut) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_PendingSwapOut.Merge(m, src)
+func (m *PendingAdminTransfer) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PendingAdminTransfer.Merge(m, src)
 }
-func (m *PendingSwapOut) XXX_Size() int {
+func (m *PendingAdminTransfer) XXX_Size() int {
 	return m.Size()
 }
-func (m *PendingSwapOut) XXX_DiscardUnknown() {
-	xxx_messageInfo_PendingSwapOut.DiscardUnknown(m)
+func (m *PendingAdminTransfer) XXX_DiscardUnknown() {
+	xxx_messageInfo_PendingAdminTransfer.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_PendingSwapOut proto.InternalMessageInfo
+var xxx_messageInfo_PendingAdminTransfer proto.InternalMessageInfo
 
-func (m *PendingSwapOut) GetOwner() string {
+func (m *PendingAdminTransfer) GetNewAdmin() string {
 	if m != nil {
-		return m.Owner
+		return m.NewAdmin
 	}
 	return ""
 }
 
-func (m *PendingSwapOut) GetVaultAddress() string {
+func (m *PendingAdminTransfer) GetExpiryTime() *time.Time {
 	if m != nil {
-		return m.VaultAddress
+		return m.ExpiryTime
 	}
-	return ""
+	return nil
 }
 
-func (m *PendingSwapOut) GetShares() types1.Coin {
-	if m != nil {
-		return m.Shares
-	}
-	return types1.Coin{}
+// ScheduledAction is a sensitive configuration message held by a vault's timelock until its
+// execute time.
+type ScheduledAction struct {
+	// id is the unique identifier of the scheduled action.
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// vault_address is the bech32 address of the vault the action configures.
+	VaultAddress string `protobuf:"bytes,2,opt,name=vault_address,json=vaultAddress,proto3" json:"vault_address,omitempty"`
+	// authority is the address that signed the scheduled message.
+	Authority string `protobuf:"bytes,3,opt,name=authority,proto3" json:"authority,omitempty"`
+	// execute_time is the UNIX timestamp (in seconds) at or after which the action is applied.
+	ExecuteTime int64 `protobuf:"varint,4,opt,name=execute_time,json=executeTime,proto3" json:"execute_time,omitempty"`
+	// msg is the scheduled message. Its signer's authority is checked again when it executes.
+	Msg *types2.Any `protobuf:"bytes,5,opt,name=msg,proto3" json:"msg,omitempty"`
 }
 
-// Deprecated: Do not use.
-func (m *PendingSwapOut) GetRedeemDenom() string {
-	if m != nil {
-		return m.RedeemDenom
-	}
-	return ""
+func (m *ScheduledAction) Reset()         { *m = ScheduledAction{} }
+func (m *ScheduledAction) String() string { return proto.CompactTextString(m) }
+func (*ScheduledAction) ProtoMessage()    {}
+func (*ScheduledAction) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2e0d78aae3177bea, []int{3}
 }
-
-func (m *PendingSwapOut) GetFailureCount() uint32 {
-	if m != nil {
-		return m.FailureCount
-	}
-	return 0
+func (m *ScheduledAction) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func init() {
-	proto.RegisterType((*VaultAccount)(nil), "provlabs.vault.v1.VaultAccount")
-	proto.RegisterType((*VaultNAV)(nil), "provlabs.vault.v1.VaultNAV")
-	proto.RegisterType((*AccountBalance)(nil), "provlabs.vault.v1.AccountBalance")
-	proto.RegisterType((*PendingSwapOut)(nil), "provlabs.vault.v1.PendingSwapOut")
+func (m *ScheduledAction) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ScheduledAction.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ScheduledAction) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ScheduledAction.Merge(m, src)
+}
+func (m *ScheduledAction) XXX_Size() int {
+	return m.Size()
+}
+func (m *ScheduledAction) XXX_DiscardUnknown() {
+	xxx_messageInfo_ScheduledAction.DiscardUnknown(m)
 }
 
-func init() { proto.RegisterFile("provlabs/vault/v1/vault.proto", fileDescriptor_2e0d78aae3177bea) }
+var xxx_messageInfo_ScheduledAction proto.InternalMessageInfo
 
-var fileDescriptor_2e0d78aae3177bea = []byte{
-	// 1167 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x56, 0xbf, 0x6f, 0xdb, 0x46,
-	0x14, 0x16, 0x6d, 0xcb, 0x71, 0x4e, 0x94, 0x6c, 0x5f, 0x94, 0xf4, 0x92, 0x36, 0x92, 0xea, 0x20,
-	0xad, 0x8a, 0xd6, 0x52, 0x9c, 0xa2, 0xe8, 0x0f, 0x20, 0x48, 0xa5, 0xa4, 0x69, 0x3c, 0xb4, 0x09,
-	0xe8, 0x20, 0x43, 0x17, 0xe2, 0x44, 0x3e, 0x4b, 0x84, 0xc9, 0x3b, 0x82, 0x77, 0x94, 0xed, 0xbd,
-	0x43, 0xc7, 0x6c, 0x5d, 0x33, 0x77, 0xe9, 0xe2, 0x3f, 0x22, 0x4b, 0x01, 0xc3, 0x53, 0xd1, 0xc1,
-	0x29, 0xec, 0xa5, 0x7f, 0x46, 0x71, 0x3f, 0x28, 0xff, 0x02, 0x64, 0x4f, 0xe2, 0x7d, 0xef, 0x7b,
-	0x1f, 0x9f, 0x1e, 0xbf, 0xbb, 0x77, 0xe8, 0x6e, 0x9a, 0xf1, 0x71, 0x4c, 0x07, 0xa2, 0x3b, 0xa6,
-	0x79, 0x2c, 0xbb, 0xe3, 0x35, 0xf3, 0xd0, 0x49, 0x33, 0x2e, 0x39, 0x5e, 0x2e, 0xc2, 0x1d, 0x83,
-	0x8e, 0xd7, 0xee, 0x34, 0x02, 0x2e, 0x12, 0x2e, 0xba, 0x34, 0x97, 0xa3, 0xee, 0x78, 0x6d, 0x00,
-	0x92, 0xae, 0xe9, 0x85, 0x49, 0x99, 0xc4, 0x07, 0x54, 0xc0, 0x24, 0x1e, 0xf0, 0x88, 0xd9, 0xf8,
-	0x6d, 0x13, 0xf7, 0xf5, 0xaa, 0x6b, 0x16, 0x36, 0x54, 0x1f, 0xf2, 0x21, 0x37, 0xb8, 0x7a, 0xb2,
-	0x68, 0x73, 0xc8, 0xf9, 0x30, 0x86, 0xae, 0x5e, 0x0d, 0xf2, 0xcd, 0xae, 0x8c, 0x12, 0x10, 0x92,
-	0x26, 0xa9, 0x21, 0xac, 0xfc, 0xe5, 0x22, 0xf7, 0xb5, 0x2a, 0xaf, 0x17, 0x04, 0x3c, 0x67, 0x12,
-	0xaf, 0x23, 0x57, 0xbd, 0xdd, 0xa7, 0x66, 0x4d, 0x9c, 0x96, 0xd3, 0xae, 0x3c, 0x6c, 0x75, 0xec,
-	0xcb, 0x74, 0xb1, 0xb6, 0xb2, 0x4e, 0x9f, 0x0a, 0xb0, 0x79, 0xfd, 0xb9, 0xfd, 0xc3, 0xa6, 0xe3,
-	0x55, 0x06, 0x27, 0x10, 0xee, 0x23, 0x57, 0x72, 0x49, 0x63, 0x5f, 0x8c, 0x68, 0x06, 0x82, 0xcc,
-	0x68, 0xa9, 0xdb, 0x85, 0x94, 0xa2, 0x4e, 0xa4, 0x9e, 0xf0, 0x88, 0xf5, 0xe7, 0xde, 0x1d, 0x36,
-	0x4b, 0x5e, 0x45, 0x27, 0x6d, 0xe8, 0x1c, 0xfc, 0x19, 0x5a, 0xca, 0x59, 0x08, 0x59, 0xbc, 0x1b,
-	0xb1, 0xa1, 0x4f, 0x85, 0x00, 0x49, 0x66, 0x5b, 0x4e, 0xfb, 0xba, 0xb7, 0x78, 0x82, 0xf7, 0x14,
-	0x8c, 0x3f, 0x45, 0xd5, 0x94, 0xee, 0x26, 0xc0, 0xa4, 0x1f, 0x02, 0xe3, 0x09, 0x99, 0x53, 0xbc,
-	0xfe, 0x0c, 0x71, 0x3c, 0xd7, 0x06, 0x9e, 0x2a, 0x1c, 0x77, 0x50, 0x99, 0x86, 0x49, 0xc4, 0x48,
-	0x59, 0x13, 0xc8, 0xc1, 0xde, 0x6a, 0xdd, 0xd6, 0xd4, 0x0b, 0xc3, 0x0c, 0x84, 0xd8, 0x90, 0x59,
-	0xc4, 0x86, 0x9e, 0xa1, 0xe1, 0xe7, 0xe8, 0x66, 0x90, 0x67, 0x99, 0x12, 0x8e, 0x98, 0x84, 0x0c,
-	0x84, 0xf4, 0x33, 0x2a, 0x81, 0xcc, 0xeb, 0xfc, 0xfa, 0xc1, 0xde, 0xea, 0x92, 0xcd, 0x7f, 0x0a,
-	0x81, 0xcd, 0xbd, 0x61, 0x53, 0xd6, 0x6d, 0x86, 0x47, 0x25, 0x28, 0xa5, 0x10, 0x44, 0x94, 0x41,
-	0x78, 0x4e, 0xe9, 0xda, 0x34, 0x25, 0x9b, 0x72, 0x46, 0xe9, 0x7b, 0xb4, 0x9c, 0x44, 0xec, 0x9c,
-	0xca, 0xc2, 0x14, 0x95, 0xc5, 0x24, 0x62, 0x17, 0x14, 0xe8, 0xce, 0x39, 0x85, 0xeb, 0x53, 0x15,
-	0xe8, 0xce, 0x19, 0x85, 0x8f, 0x91, 0x9b, 0x42, 0x16, 0xf1, 0xd0, 0x17, 0x92, 0x66, 0x92, 0xa0,
-	0x96, 0xd3, 0x9e, 0xf5, 0x2a, 0x06, 0xdb, 0x50, 0x10, 0xbe, 0x8f, 0x6a, 0x96, 0xa2, 0x8c, 0xc7,
-	0x73, 0x49, 0x2a, 0x9a, 0x54, 0x35, 0xe8, 0x2b, 0x03, 0xe2, 0x4f, 0xd0, 0xa2, 0xd8, 0xa6, 0xa9,
-	0x1f, 0x31, 0x1f, 0x18, 0x1d, 0xc4, 0x10, 0x12, 0xb7, 0xe5, 0xb4, 0x17, 0xbc, 0xaa, 0x82, 0xd7,
-	0xd9, 0x0f, 0x06, 0xc4, 0x6d, 0xb4, 0xa4, 0x79, 0x3c, 0x97, 0x13, 0x62, 0x55, 0x13, 0x6b, 0x0a,
-	0x7f, 0x91, 0xcb, 0x82, 0xf9, 0x0d, 0x22, 0xdb, 0x91, 0x1c, 0x85, 0x19, 0xdd, 0xa6, 0xb1, 0x1f,
-	0x42, 0x4c, 0x77, 0x7d, 0x01, 0x01, 0x67, 0xa1, 0x20, 0xb5, 0x96, 0xd3, 0x9e, 0xf3, 0x6e, 0x9d,
-	0xc4, 0x9f, 0xaa, 0xf0, 0x86, 0x89, 0xe2, 0x5b, 0x68, 0x3e, 0xa5, 0xb9, 0x80, 0x90, 0x2c, 0x6a,
-	0x65, 0xbb, 0xc2, 0xcf, 0x50, 0xcd, 0x3c, 0xf9, 0x03, 0x1a, 0x53, 0x16, 0x00, 0x59, 0xba, 0x9a,
-	0x9f, 0xab, 0x26, 0xad, 0x6f, 0xb2, 0xf0, 0x3d, 0x64, 0x01, 0x3f, 0x03, 0x2a, 0x38, 0x23, 0xcb,
-	0xda, 0xce, 0xae, 0x01, 0x3d, 0x8d, 0xe1, 0xc7, 0xa8, 0x36, 0xc8, 0xa2, 0x70, 0x08, 0x3e, 0x35,
-	0x8e, 0x24, 0xf8, 0x12, 0xaf, 0x56, 0x0d, 0xdf, 0x82, 0xaa, 0xf1, 0x56, 0xa0, 0xe8, 0xd3, 0x0d,
-	0xd3, 0x50, 0x83, 0x16, 0x6d, 0x7a, 0x84, 0xaa, 0x7a, 0x4f, 0xf9, 0x09, 0x65, 0x74, 0x08, 0x19,
-	0xa9, 0x5f, 0xf2, 0x1a, 0x57, 0xd3, 0x7f, 0x32, 0x6c, 0xf5, 0x3d, 0x36, 0x01, 0xfc, 0x33, 0x2e,
-	0xb8, 0xa9, 0x3f, 0x70, 0x6d, 0x13, 0xe0, 0xe5, 0x29, 0x23, 0x7c, 0x81, 0xf0, 0x29, 0x66, 0x61,
-	0x86, 0x5b, 0x9a, 0xbb, 0x34, 0xe1, 0x16, 0x7e, 0x78, 0x81, 0x6e, 0xf0, 0x5c, 0x0a, 0x49, 0x59,
-	0xa8, 0xb7, 0x7d, 0x9e, 0xf8, 0x9b, 0x00, 0xe4, 0x83, 0xab, 0x35, 0x7c, 0xf9, 0x54, 0x6e, 0x2f,
-	0x4f, 0x9e, 0x01, 0xe0, 0x16, 0x72, 0xad, 0x88, 0x3f, 0x88, 0x52, 0x41, 0x48, 0xcb, 0x69, 0x57,
-	0x3d, 0x44, 0x75, 0xb4, 0x1f, 0xa5, 0x02, 0x3f, 0x36, 0x1b, 0xaa, 0xb0, 0xe1, 0x98, 0xc6, 0x39,
-	0x90, 0xdb, 0x17, 0xb6, 0xc3, 0x3a, 0x93, 0xb6, 0x13, 0xb5, 0x24, 0x62, 0x1b, 0xda, 0x9d, 0xaf,
-	0x15, 0x17, 0xf7, 0x10, 0x9e, 0x08, 0x28, 0x7f, 0x1a, 0x85, 0x3b, 0x53, 0x14, 0x16, 0xad, 0xc2,
-	0x8b, 0x5c, 0x1a, 0x89, 0xc7, 0x66, 0x4b, 0x9e, 0xad, 0xe1, 0xc3, 0xa9, 0x35, 0xd0, 0x9d, 0xf3,
-	0x35, 0x14, 0x02, 0x27, 0x35, 0x7c, 0x34, 0xb5, 0x06, 0xa3, 0x30, 0xa9, 0xe1, 0x11, 0xaa, 0x32,
-	0x3a, 0xf6, 0xd5, 0x39, 0xcf, 0xb3, 0x48, 0xee, 0x92, 0xbb, 0x97, 0x39, 0x82, 0xd1, 0x71, 0xaf,
-	0x60, 0xaf, 0xfc, 0x39, 0x83, 0x16, 0xf4, 0x3c, 0xf9, 0xb9, 0xf7, 0x1a, 0xd7, 0x51, 0xd9, 0x9c,
-	0xc4, 0x8e, 0xb6, 0xb8, 0x59, 0xe0, 0xaf, 0x50, 0x39, 0xcd, 0xa2, 0x00, 0xae, 0x3a, 0x0f, 0x0c,
-	0x1b, 0x3f, 0x41, 0xf3, 0x63, 0x1e, 0xe7, 0x09, 0x98, 0xf3, 0xbf, 0xff, 0xb9, 0x0a, 0xfe, 0x73,
-	0xd8, 0xbc, 0x69, 0xd2, 0x45, 0xb8, 0xd5, 0x89, 0x78, 0x37, 0xa1, 0x72, 0xa4, 0xfe, 0xde, 0xc1,
-	0xde, 0x2a, 0x3a, 0xf9, 0xb3, 0x9e, 0x4d, 0x55, 0x9b, 0x5b, 0xf0, 0x3c, 0x0b, 0xc0, 0x0c, 0x07,
-	0xcf, 0xae, 0xf0, 0x03, 0x54, 0xcf, 0xd3, 0x90, 0x4a, 0xb5, 0xbb, 0x63, 0x1e, 0x6c, 0xf9, 0x23,
-	0x88, 0x86, 0x23, 0xa9, 0x27, 0xc4, 0xac, 0x87, 0x6d, 0xac, 0xaf, 0x42, 0xcf, 0x75, 0x04, 0xff,
-	0x88, 0xdc, 0x22, 0x43, 0xb9, 0x59, 0xcf, 0x82, 0xca, 0xc3, 0x3b, 0x1d, 0x33, 0x70, 0x3b, 0xc5,
-	0xc0, 0xed, 0xbc, 0x2a, 0x06, 0x6e, 0x7f, 0x41, 0x15, 0xfc, 0xe6, 0xbd, 0x9a, 0x92, 0x36, 0x53,
-	0xc5, 0x56, 0x7e, 0x77, 0x50, 0xad, 0x18, 0xa2, 0xf6, 0x88, 0x20, 0xe8, 0x5a, 0xb1, 0xed, 0x4d,
-	0xe7, 0x8a, 0x25, 0xa6, 0xa8, 0xac, 0xae, 0x03, 0x6a, 0x96, 0xce, 0x4e, 0xef, 0xdd, 0x03, 0xf5,
-	0xb6, 0x3f, 0xde, 0x37, 0xdb, 0xc3, 0x48, 0x8e, 0xf2, 0x41, 0x27, 0xe0, 0x89, 0xbd, 0x30, 0xd8,
-	0x9f, 0x55, 0x11, 0x6e, 0x75, 0xe5, 0x6e, 0x0a, 0x42, 0x27, 0x08, 0xcf, 0x28, 0x7f, 0xb7, 0xf0,
-	0xdb, 0xdb, 0x66, 0xe9, 0xbf, 0xb7, 0xcd, 0xd2, 0xca, 0xaf, 0x33, 0xa8, 0xf6, 0x12, 0xf4, 0x36,
-	0xb2, 0x16, 0x51, 0xa3, 0x93, 0x6f, 0x33, 0xc8, 0x4c, 0x5d, 0xd3, 0x46, 0xa7, 0xa6, 0x29, 0x37,
-	0xe9, 0xcb, 0xcf, 0xe4, 0x18, 0x9b, 0xb9, 0xcc, 0x4d, 0x9a, 0x5e, 0x9c, 0x62, 0x5f, 0xa3, 0x79,
-	0x7b, 0x77, 0x98, 0xbd, 0x9a, 0x57, 0x2c, 0x1d, 0xdf, 0x47, 0x6e, 0x06, 0x21, 0x40, 0x72, 0xe1,
-	0x2a, 0x50, 0x31, 0xb8, 0xb9, 0x09, 0xdc, 0x43, 0xd5, 0x4d, 0x1a, 0xc5, 0x79, 0x06, 0xbe, 0xb9,
-	0xed, 0x94, 0xf5, 0xb9, 0xe0, 0x5a, 0xf0, 0x89, 0xfe, 0x28, 0xdf, 0xbe, 0x3b, 0x6a, 0x38, 0xfb,
-	0x47, 0x0d, 0xe7, 0xdf, 0xa3, 0x86, 0xf3, 0xe6, 0xb8, 0x51, 0xda, 0x3f, 0x6e, 0x94, 0xfe, 0x3e,
-	0x6e, 0x94, 0x7e, 0x69, 0x9e, 0xea, 0xed, 0xb9, 0xbb, 0xa0, 0x6e, 0xec, 0x60, 0x5e, 0xdb, 0xe0,
-	0xcb, 0xff, 0x03, 0x00, 0x00, 0xff, 0xff, 0x76, 0x9d, 0xa2, 0xdb, 0x2a, 0x0a, 0x00, 0x00,
+func (m *ScheduledAction) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
 }
 
-func (m *VaultAccount) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *ScheduledAction) GetVaultAddress() string {
+	if m != nil {
+		return m.VaultAddress
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *VaultAccount) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *ScheduledAction) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
 }
 
-func (m *VaultAccount) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.NavAuthority) > 0 {
-		i -= len(m.NavAuthority)
-		copy(dAtA[i:], m.NavAuthority)
-		i = encodeVarintVault(dAtA, i, uint64(len(m.NavAuthority)))
-		i--
-		dAtA[i] = 0x1
-		i--
-		dAtA[i] = 0xea
-	}
-	if len(m.MaxSwapOutValue) > 0 {
-		i -= len(m.MaxSwapOutValue)
-		copy(dAtA[i:], m.MaxSwapOutValue)
-		i = encodeVarintVault(dAtA, i, uint64(len(m.MaxSwapOutValue)))
-		i--
-		dAtA[i] = 0x1
-		i--
-		dAtA[i] = 0xe2
+func (m *ScheduledAction) GetExecuteTime() int64 {
+	if m != nil {
+		return m.ExecuteTime
 	}
-	if len(m.MaxSwapInValue) > 0 {
-		i -= len(m.MaxSwapInValue)
-		copy(dAtA[i:], m.MaxSwapInValue)
-		i = encodeVarintVault(dAtA, i, uint64(len(m.MaxSwapInValue)))
-		i--
-		dAtA[i] = 0x1
-		i--
-		dAtA[i] = 0xda
+	return 0
+}
+
+func (m *ScheduledAction) GetMsg() *types2.Any {
+	if m != nil {
+		return m.Msg
 	}
-	if len(m.MinSwapOutValue) > 0 {
-		i -= len(m.MinSwapOutValue)
-		copy(dAtA[i:], m.MinSwapOutValue)
-		i = encodeVarintVault(dAtA, i, uint64(len(m.MinSwapOutValue)))
-		i--
-		dAtA[i] = 0x1
-		i--
-		dAtA[i] = 0xd2
+	return nil
+}
+
+// RoleGrant authorizes an address to perform the operations of a role on a vault.
+type RoleGrant struct {
+	// role is the granted role.
+	Role VaultRole `protobuf:"varint,1,opt,name=role,proto3,enum=provlabs.vault.v1.VaultRole" json:"role,omitempty"`
+	// address is the bech32 address holding the role.
+	Address string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+}
+
+func (m *RoleGrant) Reset()         { *m = RoleGrant{} }
+func (m *RoleGrant) String() string { return proto.CompactTextString(m) }
+func (*RoleGrant) ProtoMessage()    {}
+func (*RoleGrant) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2e0d78aae3177bea, []int{4}
+}
+func (m *RoleGrant) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RoleGrant) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RoleGrant.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if len(m.MinSwapInValue) > 0 {
-		i -= len(m.MinSwapInValue)
-		copy(dAtA[i:], m.MinSwapInValue)
-		i = encodeVarintVault(dAtA, i, uint64(len(m.MinSwapInValue)))
-		i--
-		dAtA[i] = 0x1
-		i--
-		dAtA[i] = 0xca
+}
+func (m *RoleGrant) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RoleGrant.Merge(m, src)
+}
+func (m *RoleGrant) XXX_Size() int {
+	return m.Size()
+}
+func (m *RoleGrant) XXX_DiscardUnknown() {
+	xxx_messageInfo_RoleGrant.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RoleGrant proto.InternalMessageInfo
+
+func (m *RoleGrant) GetRole() VaultRole {
+	if m != nil {
+		return m.Role
 	}
-	if m.AumFeeBips != 0 {
-		i = encodeVarintVault(dAtA, i, uint64(m.AumFeeBips))
-		i--
-		dAtA[i] = 0x1
-		i--
-		dAtA[i] = 0xc0
+	return VaultRole_VAULT_ROLE_UNSPECIFIED
+}
+
+func (m *RoleGrant) GetAddress() string {
+	if m != nil {
+		return m.Address
 	}
-	{
-		size, err := m.OutstandingAumFee.MarshalToSizedBuffer(dAtA[:i])
+	return ""
+}
+
+// VaultNAV is a single internal net asset value entry recording the price of one
+// asset denom held by a vault. The vault module is the sole source of truth for
+// these values; the NAV authority maintains them via MsgUpdateVaultNAV.
+type VaultNAV struct {
+	// denom is the asset denomination this entry prices.
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	// price is the total value of `volume` units of the denom, denominated in the
+	// vault's underlying asset. The per-unit value is price divided by volume.
+	Price types1.Coin `protobuf:"bytes,2,opt,name=price,proto3" json:"price"`
+	// volume is the number of units of the denom that price covers. It must be
+	// positive; the per-unit value of the denom is price divided by volume.
+	Volume cosmossdk_io_math.Int `protobuf:"bytes,3,opt,name=volume,proto3,customtype=cosmossdk.io/math.Int" json:"volume"`
+	// source identifies the origin of this NAV entry (for example an oracle name
+	// or the settlement path), mirroring the Marker module's NAV source attribution.
+	Source string `protobuf:"bytes,4,opt,name=source,proto3" json:"source,omitempty"`
+	// updated_block_height is the block height at which this entry was last updated.
+	UpdatedBlockHeight int64 `protobuf:"varint,5,opt,name=updated_block_height,json=updatedBlockHeight,proto3" json:"updated_block_height,omitempty"`
+	// updated_time is the block time at which this entry was last updated.
+	UpdatedTime time.Time `protobuf:"bytes,6,opt,name=updated_time,json=updatedTime,proto3,stdtime" json:"updated_time"`
+}
+
+func (m *VaultNAV) Reset()         { *m = VaultNAV{} }
+func (m *VaultNAV) String() string { return proto.CompactTextString(m) }
+func (*VaultNAV) ProtoMessage()    {}
+func (*VaultNAV) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2e0d78aae3177bea, []int{5}
+}
+func (m *VaultNAV) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *VaultNAV) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_VaultNAV.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintVault(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0x1
-	i--
-	dAtA[i] = 0xba
-	if m.FeePeriodTimeout != 0 {
-		i = encodeVarintVault(dAtA, i, uint64(m.FeePeriodTimeout))
-		i--
-		dAtA[i] = 0x1
-		i--
-		dAtA[i] = 0xb0
+}
+func (m *VaultNAV) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VaultNAV.Merge(m, src)
+}
+func (m *VaultNAV) XXX_Size() int {
+	return m.Size()
+}
+func (m *VaultNAV) XXX_DiscardUnknown() {
+	xxx_messageInfo_VaultNAV.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_VaultNAV proto.InternalMessageInfo
+
+func (m *VaultNAV) GetDenom() string {
+	if m != nil {
+		return m.Denom
 	}
-	if m.FeePeriodStart != 0 {
-		i = encodeVarintVault(dAtA, i, uint64(m.FeePeriodStart))
-		i--
-		dAtA[i] = 0x1
-		i--
-		dAtA[i] = 0xa8
+	return ""
+}
+
+func (m *VaultNAV) GetPrice() types1.Coin {
+	if m != nil {
+		return m.Price
 	}
-	if len(m.AssetManager) > 0 {
-		i -= len(m.AssetManager)
-		copy(dAtA[i:], m.AssetManager)
-		i = encodeVarintVault(dAtA, i, uint64(len(m.AssetManager)))
-		i--
-		dAtA[i] = 0x1
-		i--
-		dAtA[i] = 0xa2
+	return types1.Coin{}
+}
+
+func (m *VaultNAV) GetSource() string {
+	if m != nil {
+		return m.Source
 	}
-	if m.BridgeEnabled {
-		i--
-		if m.BridgeEnabled {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x1
-		i--
-		dAtA[i] = 0x98
+	return ""
+}
+
+func (m *VaultNAV) GetUpdatedBlockHeight() int64 {
+	if m != nil {
+		return m.UpdatedBlockHeight
 	}
-	if len(m.BridgeAddress) > 0 {
-		i -= len(m.BridgeAddress)
-		copy(dAtA[i:], m.BridgeAddress)
-		i = encodeVarintVault(dAtA, i, uint64(len(m.BridgeAddress)))
-		i--
-		dAtA[i] = 0x1
-		i--
-		dAtA[i] = 0x92
+	return 0
+}
+
+func (m *VaultNAV) GetUpdatedTime() time.Time {
+	if m != nil {
+		return m.UpdatedTime
 	}
-	if len(m.PausedReason) > 0 {
-		i -= len(m.PausedReason)
-		copy(dAtA[i:], m.PausedReason)
-		i = encodeVarintVault(dAtA, i, uint64(len(m.PausedReason)))
-		i--
-		dAtA[i] = 0x1
-		i--
-		dAtA[i] = 0x8a
-	}
-	{
-		size, err := m.PausedBalance.MarshalToSizedBuffer(dAtA[:i])
+	return time.Time{}
+}
+
+// PendingNAVProposal is an internal NAV update that moved a held denom's price by more
+// than the vault's max_nav_change_bips. It is held until the vault's nav_approver
+// confirms it or it expires.
+type PendingNAVProposal struct {
+	// vault_address is the bech32 address of the vault the proposal prices.
+	VaultAddress string `protobuf:"bytes,1,opt,name=vault_address,json=vaultAddress,proto3" json:"vault_address,omitempty"`
+	// nav is the proposed NAV entry. Its updated_block_height and updated_time record
+	// when the proposal was submitted; both are restamped when it is approved.
+	Nav VaultNAV `protobuf:"bytes,2,opt,name=nav,proto3" json:"nav"`
+	// proposer is the NAV authority address that submitted the update.
+	Proposer string `protobuf:"bytes,3,opt,name=proposer,proto3" json:"proposer,omitempty"`
+	// expiry_time is the block time at or after which the proposal can no longer be
+	// approved and is removed by the EndBlocker.
+	ExpiryTime time.Time `protobuf:"bytes,4,opt,name=expiry_time,json=expiryTime,proto3,stdtime" json:"expiry_time"`
+}
+
+func (m *PendingNAVProposal) Reset()         { *m = PendingNAVProposal{} }
+func (m *PendingNAVProposal) String() string { return proto.CompactTextString(m) }
+func (*PendingNAVProposal) ProtoMessage()    {}
+func (*PendingNAVProposal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2e0d78aae3177bea, []int{6}
+}
+func (m *PendingNAVProposal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PendingNAVProposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PendingNAVProposal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintVault(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x1
-	i--
-	dAtA[i] = 0x82
-	if m.Paused {
-		i--
-		if m.Paused {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x78
-	}
-	if m.WithdrawalDelaySeconds != 0 {
-		i = encodeVarintVault(dAtA, i, uint64(m.WithdrawalDelaySeconds))
-		i--
-		dAtA[i] = 0x70
-	}
-	if m.SwapOutEnabled {
-		i--
-		if m.SwapOutEnabled {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x68
-	}
-	if m.SwapInEnabled {
-		i--
-		if m.SwapInEnabled {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x60
-	}
-	if m.PeriodTimeout != 0 {
-		i = encodeVarintVault(dAtA, i, uint64(m.PeriodTimeout))
-		i--
-		dAtA[i] = 0x58
-	}
-	if m.PeriodStart != 0 {
-		i = encodeVarintVault(dAtA, i, uint64(m.PeriodStart))
-		i--
-		dAtA[i] = 0x50
-	}
-	if len(m.MaxInterestRate) > 0 {
-		i -= len(m.MaxInterestRate)
-		copy(dAtA[i:], m.MaxInterestRate)
-		i = encodeVarintVault(dAtA, i, uint64(len(m.MaxInterestRate)))
-		i--
-		dAtA[i] = 0x4a
-	}
-	if len(m.MinInterestRate) > 0 {
-		i -= len(m.MinInterestRate)
-		copy(dAtA[i:], m.MinInterestRate)
-		i = encodeVarintVault(dAtA, i, uint64(len(m.MinInterestRate)))
-		i--
-		dAtA[i] = 0x42
-	}
-	if len(m.DesiredInterestRate) > 0 {
-		i -= len(m.DesiredInterestRate)
-		copy(dAtA[i:], m.DesiredInterestRate)
-		i = encodeVarintVault(dAtA, i, uint64(len(m.DesiredInterestRate)))
-		i--
-		dAtA[i] = 0x3a
-	}
-	if len(m.CurrentInterestRate) > 0 {
-		i -= len(m.CurrentInterestRate)
-		copy(dAtA[i:], m.CurrentInterestRate)
-		i = encodeVarintVault(dAtA, i, uint64(len(m.CurrentInterestRate)))
-		i--
-		dAtA[i] = 0x32
-	}
-	if len(m.Admin) > 0 {
-		i -= len(m.Admin)
-		copy(dAtA[i:], m.Admin)
-		i = encodeVarintVault(dAtA, i, uint64(len(m.Admin)))
-		i--
-		dAtA[i] = 0x2a
-	}
-	if len(m.PaymentDenom) > 0 {
-		i -= len(m.PaymentDenom)
-		copy(dAtA[i:], m.PaymentDenom)
-		i = encodeVarintVault(dAtA, i, uint64(len(m.PaymentDenom)))
-		i--
-		dAtA[i] = 0x22
+		return b[:n], nil
 	}
-	if len(m.UnderlyingAsset) > 0 {
-		i -= len(m.UnderlyingAsset)
-		copy(dAtA[i:], m.UnderlyingAsset)
-		i = encodeVarintVault(dAtA, i, uint64(len(m.UnderlyingAsset)))
-		i--
-		dAtA[i] = 0x1a
+}
+func (m *PendingNAVProposal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PendingNAVProposal.Merge(m, src)
+}
+func (m *PendingNAVProposal) XXX_Size() int {
+	return m.Size()
+}
+func (m *PendingNAVProposal) XXX_DiscardUnknown() {
+	xxx_messageInfo_PendingNAVProposal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PendingNAVProposal proto.InternalMessageInfo
+
+func (m *PendingNAVProposal) GetVaultAddress() string {
+	if m != nil {
+		return m.VaultAddress
 	}
-	{
-		size, err := m.TotalShares.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintVault(dAtA, i, uint64(size))
+	return ""
+}
+
+func (m *PendingNAVProposal) GetNav() VaultNAV {
+	if m != nil {
+		return m.Nav
 	}
-	i--
-	dAtA[i] = 0x12
-	if m.BaseAccount != nil {
-		{
-			size, err := m.BaseAccount.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintVault(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+	return VaultNAV{}
+}
+
+func (m *PendingNAVProposal) GetProposer() string {
+	if m != nil {
+		return m.Proposer
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *VaultNAV) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *PendingNAVProposal) GetExpiryTime() time.Time {
+	if m != nil {
+		return m.ExpiryTime
 	}
-	return dAtA[:n], nil
+	return time.Time{}
 }
 
-func (m *VaultNAV) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// NAVSourceSubmission is a price for one denom submitted by one of a vault's NAV sources.
+type NAVSourceSubmission struct {
+	// vault_address is the bech32 address of the vault the submission prices.
+	VaultAddress string `protobuf:"bytes,1,opt,name=vault_address,json=vaultAddress,proto3" json:"vault_address,omitempty"`
+	// source is the address of the NAV source that submitted the price.
+	Source string `protobuf:"bytes,2,opt,name=source,proto3" json:"source,omitempty"`
+	// nav is the submitted NAV entry. Its updated_block_height and updated_time record
+	// when it was submitted and determine whether it is fresh.
+	Nav VaultNAV `protobuf:"bytes,3,opt,name=nav,proto3" json:"nav"`
 }
 
-func (m *VaultNAV) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	n5, err5 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.UpdatedTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.UpdatedTime):])
-	if err5 != nil {
-		return 0, err5
-	}
-	i -= n5
-	i = encodeVarintVault(dAtA, i, uint64(n5))
-	i--
-	dAtA[i] = 0x32
-	if m.UpdatedBlockHeight != 0 {
-		i = encodeVarintVault(dAtA, i, uint64(m.UpdatedBlockHeight))
-		i--
-		dAtA[i] = 0x28
-	}
-	if len(m.Source) > 0 {
-		i -= len(m.Source)
-		copy(dAtA[i:], m.Source)
-		i = encodeVarintVault(dAtA, i, uint64(len(m.Source)))
-		i--
-		dAtA[i] = 0x22
-	}
-	{
-		size := m.Volume.Size()
-		i -= size
-		if _, err := m.Volume.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintVault(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x1a
-	{
-		size, err := m.Price.MarshalToSizedBuffer(dAtA[:i])
+func (m *NAVSourceSubmission) Reset()         { *m = NAVSourceSubmission{} }
+func (m *NAVSourceSubmission) String() string { return proto.CompactTextString(m) }
+func (*NAVSourceSubmission) ProtoMessage()    {}
+func (*NAVSourceSubmission) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2e0d78aae3177bea, []int{7}
+}
+func (m *NAVSourceSubmission) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *NAVSourceSubmission) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_NAVSourceSubmission.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintVault(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0x12
-	if len(m.Denom) > 0 {
-		i -= len(m.Denom)
-		copy(dAtA[i:], m.Denom)
-		i = encodeVarintVault(dAtA, i, uint64(len(m.Denom)))
-		i--
-		dAtA[i] = 0xa
+}
+func (m *NAVSourceSubmission) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_NAVSourceSubmission.Merge(m, src)
+}
+func (m *NAVSourceSubmission) XXX_Size() int {
+	return m.Size()
+}
+func (m *NAVSourceSubmission) XXX_DiscardUnknown() {
+	xxx_messageInfo_NAVSourceSubmission.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_NAVSourceSubmission proto.InternalMessageInfo
+
+func (m *NAVSourceSubmission) GetVaultAddress() string {
+	if m != nil {
+		return m.VaultAddress
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *AccountBalance) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *NAVSourceSubmission) GetSource() string {
+	if m != nil {
+		return m.Source
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *AccountBalance) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *NAVSourceSubmission) GetNav() VaultNAV {
+	if m != nil {
+		return m.Nav
+	}
+	return VaultNAV{}
 }
 
-func (m *AccountBalance) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Coins) > 0 {
-		for iNdEx := len(m.Coins) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Coins[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintVault(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x12
+// AccountBalance represents the coin balance of a single account.
+type AccountBalance struct {
+	// address is the account address.
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// coins is the balance of the account.
+	Coins github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=coins,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"coins"`
+}
+
+func (m *AccountBalance) Reset()         { *m = AccountBalance{} }
+func (m *AccountBalance) String() string { return proto.CompactTextString(m) }
+func (*AccountBalance) ProtoMessage()    {}
+func (*AccountBalance) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2e0d78aae3177bea, []int{8}
+}
+func (m *AccountBalance) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AccountBalance) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AccountBalance.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if len(m.Address) > 0 {
-		i -= len(m.Address)
-		copy(dAtA[i:], m.Address)
-		i = encodeVarintVault(dAtA, i, uint64(len(m.Address)))
-		i--
-		dAtA[i] = 0xa
+}
+func (m *AccountBalance) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AccountBalance.Merge(m, src)
+}
+func (m *AccountBalance) XXX_Size() int {
+	return m.Size()
+}
+func (m *AccountBalance) XXX_DiscardUnknown() {
+	xxx_messageInfo_AccountBalance.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AccountBalance proto.InternalMessageInfo
+
+// PendingSwapOut are swap outs that have not yet been processed and completed.
+type PendingSwapOut struct {
+	// owner is the address initiating the swap out.
+	Owner string `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty"`
+	// vault_address is the address of the vault processing the withdrawal.
+	VaultAddress string `protobuf:"bytes,2,opt,name=vault_address,json=vaultAddress,proto3" json:"vault_address,omitempty"`
+	// shares are the shares that were escrowed by the user.
+	Shares types1.Coin `protobuf:"bytes,3,opt,name=shares,proto3" json:"shares"`
+	// redeem_denom is the denomination of the asset to be redeemed. Always the
+	// vault's underlying_asset; retained for wire compatibility.
+	RedeemDenom string `protobuf:"bytes,4,opt,name=redeem_denom,json=redeemDenom,proto3" json:"redeem_denom,omitempty"` // Deprecated: Do not use.
+	// failure_count is the number of consecutive failed processing attempts that
+	// left this request queued. Each failure re-keys the request to a later retry
+	// time so it cannot hold the front of the queue. Reset when expedited.
+	FailureCount uint32 `protobuf:"varint,5,opt,name=failure_count,json=failureCount,proto3" json:"failure_count,omitempty"`
+}
+
+func (m *PendingSwapOut) Reset()         { *m = PendingSwapOut{} }
+func (m *PendingSwapOut) String() string { return proto.CompactTextString(m) }
+func (*PendingSwapOut) ProtoMessage()    {}
+func (*PendingSwapOut) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2e0d78aae3177bea, []int{9}
+}
+func (m *PendingSwapOut) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PendingSwapOut) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PendingSwapOut.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *PendingSwapOut) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PendingSwapOut.Merge(m, src)
+}
+func (m *PendingSwapOut) XXX_Size() int {
+	return m.Size()
+}
+func (m *PendingSwapOut) XXX_DiscardUnknown() {
+	xxx_messageInfo_PendingSwapOut.DiscardUnknown(m)
 }
 
-func (m *PendingSwapOut) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_PendingSwapOut proto.InternalMessageInfo
+
+func (m *PendingSwapOut) GetOwner() string {
+	if m != nil {
+		return m.Owner
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *PendingSwapOut) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *PendingSwapOut) GetVaultAddress() string {
+	if m != nil {
+		return m.VaultAddress
+	}
+	return ""
 }
 
-func (m *PendingSwapOut) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.FailureCount != 0 {
-		i = encodeVarintVault(dAtA, i, uint64(m.FailureCount))
-		i--
-		dAtA[i] = 0x28
+func (m *PendingSwapOut) GetShares() types1.Coin {
+	if m != nil {
+		return m.Shares
 	}
-	if len(m.RedeemDenom) > 0 {
-		i -= len(m.RedeemDenom)
-		copy(dAtA[i:], m.RedeemDenom)
-		i = encodeVarintVault(dAtA, i, uint64(len(m.RedeemDenom)))
-		i--
-		dAtA[i] = 0x22
+	return types1.Coin{}
+}
+
+// Deprecated: Do not use.
+func (m *PendingSwapOut) GetRedeemDenom() string {
+	if m != nil {
+		return m.RedeemDenom
 	}
-	{
-		size, err := m.Shares.MarshalToSizedBuffer(dAtA[:i])
+	return ""
+}
+
+func (m *PendingSwapOut) GetFailureCount() uint32 {
+	if m != nil {
+		return m.FailureCount
+	}
+	return 0
+}
+
+// AcquisitionPolicy lists the assets a vault may price in its internal NAV table and acquire
+// through settlement, such as the collateral types named in its offering documents.
+type AcquisitionPolicy struct {
+	// denom_patterns are the denoms the vault may acquire. A pattern is an exact denom, or a
+	// prefix followed by a trailing "*" that matches every denom starting with the prefix
+	// (e.g. "nft/*"). When empty, any denom is permitted.
+	DenomPatterns []string `protobuf:"bytes,1,rep,name=denom_patterns,json=denomPatterns,proto3" json:"denom_patterns,omitempty"`
+	// scope_specification_ids are the bech32 scope specification ids a metadata value-owner
+	// denom's (nft/<scope-id>) scope must be created from. When empty, any scope is permitted.
+	ScopeSpecificationIds []string `protobuf:"bytes,2,rep,name=scope_specification_ids,json=scopeSpecificationIds,proto3" json:"scope_specification_ids,omitempty"`
+}
+
+func (m *AcquisitionPolicy) Reset()         { *m = AcquisitionPolicy{} }
+func (m *AcquisitionPolicy) String() string { return proto.CompactTextString(m) }
+func (*AcquisitionPolicy) ProtoMessage()    {}
+func (*AcquisitionPolicy) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2e0d78aae3177bea, []int{10}
+}
+func (m *AcquisitionPolicy) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AcquisitionPolicy) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AcquisitionPolicy.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintVault(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x1a
-	if len(m.VaultAddress) > 0 {
-		i -= len(m.VaultAddress)
-		copy(dAtA[i:], m.VaultAddress)
-		i = encodeVarintVault(dAtA, i, uint64(len(m.VaultAddress)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Owner) > 0 {
-		i -= len(m.Owner)
-		copy(dAtA[i:], m.Owner)
-		i = encodeVarintVault(dAtA, i, uint64(len(m.Owner)))
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *AcquisitionPolicy) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AcquisitionPolicy.Merge(m, src)
+}
+func (m *AcquisitionPolicy) XXX_Size() int {
+	return m.Size()
+}
+func (m *AcquisitionPolicy) XXX_DiscardUnknown() {
+	xxx_messageInfo_AcquisitionPolicy.DiscardUnknown(m)
 }
 
-func encodeVarintVault(dAtA []byte, offset int, v uint64) int {
-	offset -= sovVault(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+var xxx_messageInfo_AcquisitionPolicy proto.InternalMessageInfo
+
+func (m *AcquisitionPolicy) GetDenomPatterns() []string {
+	if m != nil {
+		return m.DenomPatterns
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return nil
 }
-func (m *VaultAccount) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.BaseAccount != nil {
-		l = m.BaseAccount.Size()
-		n += 1 + l + sovVault(uint64(l))
+
+func (m *AcquisitionPolicy) GetScopeSpecificationIds() []string {
+	if m != nil {
+		return m.ScopeSpecificationIds
 	}
-	l = m.TotalShares.Size()
-	n += 1 + l + sovVault(uint64(l))
-	l = len(m.UnderlyingAsset)
-	if l > 0 {
-		n += 1 + l + sovVault(uint64(l))
+	return nil
+}
+
+// AssetHaircut is the valuation haircut a vault applies to one held denom. The denom's
+// value is reduced by haircut_bips basis points wherever it counts toward total vault
+// value, and so toward share pricing.
+type AssetHaircut struct {
+	// denom is the held asset denomination the haircut applies to.
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	// haircut_bips is the haircut in basis points; 10000 values the denom at zero.
+	HaircutBips uint32 `protobuf:"varint,2,opt,name=haircut_bips,json=haircutBips,proto3" json:"haircut_bips,omitempty"`
+}
+
+func (m *AssetHaircut) Reset()         { *m = AssetHaircut{} }
+func (m *AssetHaircut) String() string { return proto.CompactTextString(m) }
+func (*AssetHaircut) ProtoMessage()    {}
+func (*AssetHaircut) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2e0d78aae3177bea, []int{11}
+}
+func (m *AssetHaircut) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AssetHaircut) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AssetHaircut.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	l = len(m.PaymentDenom)
-	if l > 0 {
-		n += 1 + l + sovVault(uint64(l))
+}
+func (m *AssetHaircut) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AssetHaircut.Merge(m, src)
+}
+func (m *AssetHaircut) XXX_Size() int {
+	return m.Size()
+}
+func (m *AssetHaircut) XXX_DiscardUnknown() {
+	xxx_messageInfo_AssetHaircut.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AssetHaircut proto.InternalMessageInfo
+
+func (m *AssetHaircut) GetDenom() string {
+	if m != nil {
+		return m.Denom
 	}
-	l = len(m.Admin)
-	if l > 0 {
-		n += 1 + l + sovVault(uint64(l))
+	return ""
+}
+
+func (m *AssetHaircut) GetHaircutBips() uint32 {
+	if m != nil {
+		return m.HaircutBips
 	}
-	l = len(m.CurrentInterestRate)
-	if l > 0 {
-		n += 1 + l + sovVault(uint64(l))
+	return 0
+}
+
+// SharePriceObservation is one point of a vault's cumulative share price accumulator,
+// recorded when reconciliation observes a new share price. Between observations the
+// price is taken to hold at the last observed value.
+type SharePriceObservation struct {
+	// time is the block time the observation was recorded at.
+	Time time.Time `protobuf:"bytes,1,opt,name=time,proto3,stdtime" json:"time"`
+	// block_height is the block height the observation was recorded at.
+	BlockHeight int64 `protobuf:"varint,2,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
+	// price is the net share price from time on, in underlying asset units per ShareScalar
+	// base shares (1 share at the initial deposit parity).
+	Price cosmossdk_io_math.LegacyDec `protobuf:"bytes,3,opt,name=price,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"price"`
+	// cumulative is the sum over the series of each earlier price multiplied by the seconds
+	// it was in effect, up to time.
+	Cumulative cosmossdk_io_math.LegacyDec `protobuf:"bytes,4,opt,name=cumulative,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"cumulative"`
+}
+
+func (m *SharePriceObservation) Reset()         { *m = SharePriceObservation{} }
+func (m *SharePriceObservation) String() string { return proto.CompactTextString(m) }
+func (*SharePriceObservation) ProtoMessage()    {}
+func (*SharePriceObservation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2e0d78aae3177bea, []int{12}
+}
+func (m *SharePriceObservation) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SharePriceObservation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SharePriceObservation.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	l = len(m.DesiredInterestRate)
-	if l > 0 {
-		n += 1 + l + sovVault(uint64(l))
+}
+func (m *SharePriceObservation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SharePriceObservation.Merge(m, src)
+}
+func (m *SharePriceObservation) XXX_Size() int {
+	return m.Size()
+}
+func (m *SharePriceObservation) XXX_DiscardUnknown() {
+	xxx_messageInfo_SharePriceObservation.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SharePriceObservation proto.InternalMessageInfo
+
+func (m *SharePriceObservation) GetTime() time.Time {
+	if m != nil {
+		return m.Time
 	}
-	l = len(m.MinInterestRate)
-	if l > 0 {
-		n += 1 + l + sovVault(uint64(l))
+	return time.Time{}
+}
+
+func (m *SharePriceObservation) GetBlockHeight() int64 {
+	if m != nil {
+		return m.BlockHeight
 	}
-	l = len(m.MaxInterestRate)
-	if l > 0 {
-		n += 1 + l + sovVault(uint64(l))
+	return 0
+}
+
+// OutboundPayment is an x/exchange payment created by the vault, with the vault's payment
+// escrow account as its source. The vault's side of the trade is staged from the principal
+// marker into the escrow account, where the exchange module holds it until the target
+// accepts or rejects the payment, or the vault cancels it.
+type OutboundPayment struct {
+	// external_id, together with the vault's payment escrow address, identifies the payment.
+	ExternalId string `protobuf:"bytes,1,opt,name=external_id,json=externalId,proto3" json:"external_id,omitempty"`
+	// target is the bech32 address of the counterparty that may accept the payment.
+	Target string `protobuf:"bytes,2,opt,name=target,proto3" json:"target,omitempty"`
+	// source_amount is the funds the vault offers, held in its payment escrow account.
+	SourceAmount github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=source_amount,json=sourceAmount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"source_amount"`
+	// target_amount is the funds the vault asks for in return.
+	TargetAmount github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,4,rep,name=target_amount,json=targetAmount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"target_amount"`
+	// direction indicates whether the asset moves into the vault ("inbound") or out of the
+	// vault ("outbound") relative to the vault's underlying asset.
+	Direction string `protobuf:"bytes,5,opt,name=direction,proto3" json:"direction,omitempty"`
+	// expiry_time is the block time at or after which anyone may expire the payment, releasing
+	// its escrow.
+	ExpiryTime time.Time `protobuf:"bytes,6,opt,name=expiry_time,json=expiryTime,proto3,stdtime" json:"expiry_time"`
+}
+
+func (m *OutboundPayment) Reset()         { *m = OutboundPayment{} }
+func (m *OutboundPayment) String() string { return proto.CompactTextString(m) }
+func (*OutboundPayment) ProtoMessage()    {}
+func (*OutboundPayment) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2e0d78aae3177bea, []int{13}
+}
+func (m *OutboundPayment) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *OutboundPayment) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_OutboundPayment.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if m.PeriodStart != 0 {
-		n += 1 + sovVault(uint64(m.PeriodStart))
+}
+func (m *OutboundPayment) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OutboundPayment.Merge(m, src)
+}
+func (m *OutboundPayment) XXX_Size() int {
+	return m.Size()
+}
+func (m *OutboundPayment) XXX_DiscardUnknown() {
+	xxx_messageInfo_OutboundPayment.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_OutboundPayment proto.InternalMessageInfo
+
+func (m *OutboundPayment) GetExternalId() string {
+	if m != nil {
+		return m.ExternalId
 	}
-	if m.PeriodTimeout != 0 {
-		n += 1 + sovVault(uint64(m.PeriodTimeout))
+	return ""
+}
+
+func (m *OutboundPayment) GetTarget() string {
+	if m != nil {
+		return m.Target
 	}
-	if m.SwapInEnabled {
-		n += 2
+	return ""
+}
+
+func (m *OutboundPayment) GetSourceAmount() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.SourceAmount
 	}
-	if m.SwapOutEnabled {
-		n += 2
+	return nil
+}
+
+func (m *OutboundPayment) GetTargetAmount() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.TargetAmount
 	}
-	if m.WithdrawalDelaySeconds != 0 {
-		n += 1 + sovVault(uint64(m.WithdrawalDelaySeconds))
+	return nil
+}
+
+func (m *OutboundPayment) GetDirection() string {
+	if m != nil {
+		return m.Direction
 	}
-	if m.Paused {
-		n += 2
+	return ""
+}
+
+func (m *OutboundPayment) GetExpiryTime() time.Time {
+	if m != nil {
+		return m.ExpiryTime
 	}
-	l = m.PausedBalance.Size()
-	n += 2 + l + sovVault(uint64(l))
-	l = len(m.PausedReason)
-	if l > 0 {
-		n += 2 + l + sovVault(uint64(l))
+	return time.Time{}
+}
+
+func init() {
+	proto.RegisterEnum("provlabs.vault.v1.NAVAggregation", NAVAggregation_name, NAVAggregation_value)
+	proto.RegisterEnum("provlabs.vault.v1.PriceSourceType", PriceSourceType_name, PriceSourceType_value)
+	proto.RegisterEnum("provlabs.vault.v1.VaultRole", VaultRole_name, VaultRole_value)
+	proto.RegisterType((*VaultAccount)(nil), "provlabs.vault.v1.VaultAccount")
+	proto.RegisterType((*EligibilityPolicy)(nil), "provlabs.vault.v1.EligibilityPolicy")
+	proto.RegisterType((*PendingAdminTransfer)(nil), "provlabs.vault.v1.PendingAdminTransfer")
+	proto.RegisterType((*ScheduledAction)(nil), "provlabs.vault.v1.ScheduledAction")
+	proto.RegisterType((*RoleGrant)(nil), "provlabs.vault.v1.RoleGrant")
+	proto.RegisterType((*VaultNAV)(nil), "provlabs.vault.v1.VaultNAV")
+	proto.RegisterType((*PendingNAVProposal)(nil), "provlabs.vault.v1.PendingNAVProposal")
+	proto.RegisterType((*NAVSourceSubmission)(nil), "provlabs.vault.v1.NAVSourceSubmission")
+	proto.RegisterType((*AccountBalance)(nil), "provlabs.vault.v1.AccountBalance")
+	proto.RegisterType((*PendingSwapOut)(nil), "provlabs.vault.v1.PendingSwapOut")
+	proto.RegisterType((*AcquisitionPolicy)(nil), "provlabs.vault.v1.AcquisitionPolicy")
+	proto.RegisterType((*AssetHaircut)(nil), "provlabs.vault.v1.AssetHaircut")
+	proto.RegisterType((*SharePriceObservation)(nil), "provlabs.vault.v1.SharePriceObservation")
+	proto.RegisterType((*OutboundPayment)(nil), "provlabs.vault.v1.OutboundPayment")
+}
+
+func init() { proto.RegisterFile("provlabs/vault/v1/vault.proto", fileDescriptor_2e0d78aae3177bea) }
+
+var fileDescriptor_2e0d78aae3177bea = []byte{
+	// 2547 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x59, 0x4b, 0x73, 0x1b, 0xc7,
+	0xf1, 0xe7, 0x02, 0x24, 0x45, 0x36, 0x1e, 0x04, 0x87, 0xa4, 0xb8, 0x7a, 0x91, 0x10, 0x6c, 0xd9,
+	0xb0, 0x6c, 0x82, 0x12, 0xfd, 0xf6, 0xff, 0xef, 0x52, 0x96, 0xe4, 0x8a, 0x42, 0x42, 0x82, 0xf0,
+	0x02, 0x64, 0x45, 0xa9, 0x4a, 0xb6, 0x06, 0xbb, 0x43, 0x70, 0xcb, 0xfb, 0xd2, 0xee, 0x2c, 0x44,
+	0xde, 0x52, 0xa9, 0xa4, 0x2a, 0x47, 0xdd, 0x72, 0xf5, 0x39, 0x55, 0xa9, 0x5c, 0x74, 0xcb, 0x17,
+	0x70, 0xf9, 0xe4, 0xf2, 0x29, 0x95, 0x83, 0x9d, 0xb2, 0x2f, 0x39, 0xe7, 0x13, 0xa4, 0xe6, 0xb1,
+	0x0b, 0xf0, 0x21, 0x52, 0xb2, 0x93, 0x13, 0x31, 0xdd, 0xbf, 0xee, 0x99, 0xe9, 0xf9, 0x75, 0x4f,
+	0xcf, 0x12, 0x6e, 0x85, 0x51, 0x30, 0x70, 0x71, 0x2f, 0x5e, 0x1d, 0xe0, 0xc4, 0xa5, 0xab, 0x83,
+	0xfb, 0xe2, 0x47, 0x23, 0x8c, 0x02, 0x1a, 0xa0, 0xd9, 0x54, 0xdd, 0x10, 0xd2, 0xc1, 0xfd, 0xeb,
+	0x4b, 0x56, 0x10, 0x7b, 0x41, 0xbc, 0x8a, 0x13, 0x7a, 0xb8, 0x3a, 0xb8, 0xdf, 0x23, 0x14, 0xdf,
+	0xe7, 0x03, 0x61, 0x92, 0xe9, 0x7b, 0x38, 0x26, 0x99, 0xde, 0x0a, 0x1c, 0x5f, 0xea, 0xaf, 0x09,
+	0xbd, 0xc9, 0x47, 0xab, 0x62, 0x20, 0x55, 0xf3, 0xfd, 0xa0, 0x1f, 0x08, 0x39, 0xfb, 0x95, 0x1a,
+	0xf4, 0x83, 0xa0, 0xef, 0x92, 0x55, 0x3e, 0xea, 0x25, 0x07, 0xab, 0xd8, 0x3f, 0x96, 0xaa, 0xe5,
+	0xd3, 0x2a, 0xea, 0x78, 0x24, 0xa6, 0xd8, 0x0b, 0x05, 0xa0, 0xf6, 0xd5, 0x22, 0x14, 0xf7, 0xd9,
+	0xca, 0x35, 0xcb, 0x0a, 0x12, 0x9f, 0xa2, 0x26, 0x14, 0xd9, 0xc2, 0x4c, 0x2c, 0xc6, 0xaa, 0x52,
+	0x55, 0xea, 0x85, 0xb5, 0x6a, 0x43, 0xae, 0x83, 0xef, 0x43, 0x2e, 0xba, 0xb1, 0x8e, 0x63, 0x22,
+	0xed, 0xd6, 0xc7, 0xbf, 0xfe, 0x76, 0x59, 0x31, 0x0a, 0xbd, 0xa1, 0x08, 0xad, 0x43, 0x91, 0x06,
+	0x14, 0xbb, 0x66, 0x7c, 0x88, 0x23, 0x12, 0xab, 0x39, 0xee, 0xea, 0x5a, 0xea, 0x8a, 0x41, 0x33,
+	0x57, 0x1b, 0x81, 0xe3, 0xaf, 0x8f, 0x7f, 0xf9, 0xed, 0xf2, 0x98, 0x51, 0xe0, 0x46, 0x1d, 0x6e,
+	0x83, 0xde, 0x82, 0x4a, 0xe2, 0xdb, 0x24, 0x72, 0x8f, 0x1d, 0xbf, 0x6f, 0xe2, 0x38, 0x26, 0x54,
+	0xcd, 0x57, 0x95, 0xfa, 0xb4, 0x31, 0x33, 0x94, 0x6b, 0x4c, 0x8c, 0xde, 0x84, 0x52, 0x88, 0x8f,
+	0x3d, 0xe2, 0x53, 0xd3, 0x26, 0x7e, 0xe0, 0xa9, 0xe3, 0x0c, 0xb7, 0x9e, 0x53, 0x15, 0xa3, 0x28,
+	0x15, 0x9b, 0x4c, 0x8e, 0x1a, 0x30, 0x81, 0x6d, 0xcf, 0xf1, 0xd5, 0x09, 0x0e, 0x50, 0xbf, 0x79,
+	0xbe, 0x32, 0x2f, 0xd7, 0xa4, 0xd9, 0x76, 0x44, 0xe2, 0xb8, 0x43, 0x23, 0xc7, 0xef, 0x1b, 0x02,
+	0x86, 0x1e, 0xc1, 0x82, 0x95, 0x44, 0x11, 0x73, 0xec, 0xf8, 0x94, 0x44, 0x24, 0xa6, 0x66, 0x84,
+	0x29, 0x51, 0x27, 0xb9, 0xfd, 0xfc, 0x37, 0xcf, 0x57, 0x2a, 0xd2, 0x7e, 0x93, 0x58, 0xd2, 0x76,
+	0x4e, 0x9a, 0x34, 0xa5, 0x85, 0x81, 0x29, 0x61, 0x9e, 0x6c, 0x12, 0x3b, 0x11, 0xb1, 0x4f, 0x79,
+	0xba, 0x72, 0x91, 0x27, 0x69, 0x72, 0xc2, 0xd3, 0xcf, 0x60, 0xd6, 0x73, 0xfc, 0x53, 0x5e, 0xa6,
+	0x2e, 0xf0, 0x32, 0xe3, 0x39, 0xfe, 0x19, 0x0f, 0xf8, 0xe8, 0x94, 0x87, 0xe9, 0x0b, 0x3d, 0xe0,
+	0xa3, 0x13, 0x1e, 0x6e, 0x43, 0x31, 0x24, 0x91, 0x13, 0xd8, 0x66, 0x4c, 0x71, 0x44, 0x55, 0xa8,
+	0x2a, 0xf5, 0xbc, 0x51, 0x10, 0xb2, 0x0e, 0x13, 0xa1, 0x3b, 0x50, 0x96, 0x10, 0x46, 0xbc, 0x20,
+	0xa1, 0x6a, 0x81, 0x83, 0x4a, 0x42, 0xda, 0x15, 0x42, 0xf4, 0x06, 0xcc, 0xc4, 0x4f, 0x71, 0x68,
+	0x3a, 0xbe, 0x49, 0x7c, 0xdc, 0x73, 0x89, 0xad, 0x16, 0xab, 0x4a, 0x7d, 0xca, 0x28, 0x31, 0x71,
+	0xd3, 0xd7, 0x85, 0x10, 0xd5, 0xa1, 0xc2, 0x71, 0x41, 0x42, 0x33, 0x60, 0x89, 0x03, 0xcb, 0x4c,
+	0xbe, 0x9b, 0xd0, 0x14, 0xf9, 0x11, 0xa8, 0x4f, 0x1d, 0x7a, 0x68, 0x47, 0xf8, 0x29, 0x76, 0x4d,
+	0x9b, 0xb8, 0xf8, 0xd8, 0x8c, 0x89, 0x15, 0xf8, 0x76, 0xac, 0x96, 0xab, 0x4a, 0x7d, 0xdc, 0xb8,
+	0x3a, 0xd4, 0x6f, 0x32, 0x75, 0x47, 0x68, 0xd1, 0x55, 0x98, 0x0c, 0x71, 0x12, 0x13, 0x5b, 0x9d,
+	0xe1, 0x9e, 0xe5, 0x08, 0x3d, 0x84, 0xb2, 0xf8, 0x65, 0xf6, 0xb0, 0x8b, 0x7d, 0x8b, 0xa8, 0x95,
+	0x97, 0xe3, 0x73, 0x49, 0x98, 0xad, 0x0b, 0x2b, 0xf4, 0x1a, 0x48, 0x81, 0x19, 0x11, 0x1c, 0x07,
+	0xbe, 0x3a, 0xcb, 0xe9, 0x5c, 0x14, 0x42, 0x83, 0xcb, 0xd0, 0x03, 0x28, 0xf7, 0x22, 0xc7, 0xee,
+	0x13, 0x13, 0x0b, 0x46, 0xaa, 0xe8, 0x12, 0xae, 0x96, 0x04, 0x5e, 0x0a, 0x59, 0xe0, 0xa5, 0x83,
+	0x34, 0x4e, 0x73, 0x22, 0xa0, 0x42, 0x9a, 0x86, 0xe9, 0x53, 0x28, 0xf1, 0x9c, 0x32, 0x3d, 0xec,
+	0xe3, 0x3e, 0x89, 0xd4, 0xf9, 0x4b, 0xa6, 0x29, 0x72, 0xf8, 0x8e, 0x40, 0xb3, 0xf3, 0x38, 0x20,
+	0xc4, 0x3c, 0xc1, 0x82, 0x05, 0x7e, 0xc0, 0xe5, 0x03, 0x42, 0xda, 0x23, 0x44, 0x78, 0x07, 0xd0,
+	0x08, 0x32, 0x25, 0xc3, 0x55, 0x8e, 0xad, 0x64, 0xd8, 0x94, 0x0f, 0xbb, 0x30, 0x17, 0x24, 0x34,
+	0xa6, 0xd8, 0xb7, 0x79, 0xda, 0x27, 0x9e, 0x79, 0x40, 0x88, 0xba, 0xf8, 0x72, 0x01, 0x9f, 0x1d,
+	0xb1, 0xd5, 0x12, 0xef, 0x21, 0x21, 0xa8, 0x0a, 0x45, 0xe9, 0xc4, 0xec, 0x39, 0x61, 0xac, 0xaa,
+	0x55, 0xa5, 0x5e, 0x32, 0x00, 0x73, 0xed, 0xba, 0x13, 0xc6, 0xe8, 0x81, 0x48, 0xa8, 0x94, 0x86,
+	0x03, 0xec, 0x26, 0x44, 0xbd, 0x76, 0x26, 0x1d, 0x9a, 0x3e, 0x95, 0x91, 0x28, 0x7b, 0x8e, 0xdf,
+	0xe1, 0xec, 0xdc, 0x67, 0x58, 0xa4, 0x01, 0xca, 0x1c, 0x30, 0x7e, 0x0a, 0x0f, 0xd7, 0x2f, 0xf0,
+	0x30, 0x23, 0x3d, 0xec, 0x26, 0x54, 0xb8, 0x78, 0x20, 0x52, 0xf2, 0xe4, 0x1a, 0x6e, 0x5c, 0xb8,
+	0x06, 0x7c, 0x74, 0x7a, 0x0d, 0xa9, 0x83, 0xe1, 0x1a, 0x6e, 0x5e, 0xb8, 0x06, 0xe1, 0x21, 0x5b,
+	0xc3, 0xa7, 0x50, 0xf2, 0xf1, 0xc0, 0x64, 0x75, 0x3e, 0x88, 0x1c, 0x7a, 0xac, 0xde, 0xba, 0x8c,
+	0x11, 0x3e, 0x1e, 0x68, 0x29, 0x1a, 0xad, 0xc0, 0x1c, 0x5b, 0x01, 0x77, 0xd1, 0x27, 0x59, 0xca,
+	0x2d, 0xf1, 0x94, 0xab, 0x78, 0xf8, 0xa8, 0x85, 0x07, 0x5a, 0x9f, 0xa4, 0xc9, 0xf6, 0x1e, 0x2c,
+	0xe2, 0x84, 0x06, 0x26, 0x27, 0xbf, 0x19, 0xf8, 0x8c, 0x43, 0x2e, 0x61, 0xe6, 0xea, 0x32, 0xe7,
+	0xeb, 0x1c, 0x53, 0xb7, 0x99, 0x76, 0xd7, 0xef, 0x30, 0x5d, 0x0b, 0x0f, 0x46, 0x27, 0xb1, 0x0e,
+	0xb1, 0xdf, 0x97, 0x87, 0x5a, 0xe5, 0x87, 0x2a, 0x27, 0xd9, 0xe0, 0x0a, 0x7e, 0xb4, 0xff, 0x07,
+	0x45, 0xbe, 0x9e, 0x90, 0xdd, 0xd5, 0x24, 0x52, 0x6f, 0x5f, 0xb2, 0xa3, 0x02, 0xdb, 0x91, 0x04,
+	0xa3, 0x8f, 0x81, 0x0d, 0xcd, 0x38, 0x48, 0x22, 0x8b, 0xc4, 0x6a, 0xad, 0x9a, 0xbf, 0xd0, 0x16,
+	0x7c, 0x3c, 0xe8, 0x08, 0x2c, 0xba, 0x0b, 0xb3, 0x43, 0x53, 0xf3, 0x49, 0x12, 0x44, 0x89, 0xa7,
+	0xbe, 0xc6, 0x17, 0x39, 0x93, 0xc1, 0x3e, 0xe3, 0x62, 0xf4, 0x73, 0x98, 0x11, 0x31, 0xeb, 0x47,
+	0xa4, 0x8f, 0xa9, 0x13, 0xf8, 0xea, 0xeb, 0x55, 0xa5, 0x5e, 0x5e, 0xbb, 0xdd, 0x38, 0xd3, 0x61,
+	0x34, 0x5a, 0xda, 0xbe, 0x36, 0x04, 0x1a, 0x65, 0xb6, 0xde, 0xe1, 0x18, 0x7d, 0x02, 0xd7, 0x47,
+	0xe6, 0x65, 0x91, 0x1a, 0x3d, 0x8a, 0x3b, 0xa2, 0xfa, 0x65, 0x0b, 0xd8, 0xc1, 0x47, 0x23, 0x07,
+	0xb2, 0x05, 0xa5, 0x30, 0x72, 0x2c, 0x92, 0x6d, 0xf8, 0x8d, 0x6a, 0xbe, 0x5e, 0x5e, 0xab, 0x9d,
+	0xb3, 0x8a, 0x36, 0xc3, 0x09, 0x1f, 0xdd, 0xe3, 0x90, 0x18, 0xc5, 0x70, 0x28, 0x88, 0xd1, 0x27,
+	0x70, 0x2d, 0x26, 0x94, 0xba, 0x84, 0x5f, 0xc8, 0x34, 0x70, 0x49, 0xc4, 0xca, 0x9f, 0x38, 0xa9,
+	0x37, 0x79, 0x10, 0x16, 0x87, 0x80, 0x6e, 0xaa, 0xe7, 0x07, 0xf6, 0x21, 0xa8, 0x49, 0x68, 0x63,
+	0xca, 0x89, 0xc0, 0x59, 0x91, 0x01, 0xd5, 0x3a, 0xa7, 0xc5, 0x82, 0xd0, 0xb7, 0xf0, 0x60, 0xd7,
+	0xef, 0x64, 0x4a, 0xf4, 0x18, 0x10, 0xb6, 0x9e, 0x24, 0x4e, 0xec, 0xb0, 0x40, 0x98, 0x61, 0xe0,
+	0x3a, 0xd6, 0xb1, 0xfa, 0x16, 0x2f, 0x1b, 0xaf, 0x9f, 0xb3, 0x05, 0x6d, 0x08, 0x6e, 0x73, 0x6c,
+	0x5a, 0x41, 0xf0, 0x69, 0x05, 0x7a, 0x00, 0x37, 0x79, 0x24, 0x79, 0xb5, 0xb4, 0x02, 0xdf, 0x22,
+	0x3e, 0x8d, 0x78, 0xbc, 0xc5, 0x96, 0xee, 0xf2, 0x2d, 0x5d, 0xf3, 0xf0, 0x11, 0xef, 0x46, 0x36,
+	0x46, 0x11, 0x7c, 0x53, 0xef, 0xc3, 0x22, 0x27, 0x6d, 0xe0, 0x9b, 0x23, 0x1d, 0x0d, 0xb7, 0x7d,
+	0x9b, 0xdb, 0xce, 0x33, 0xe2, 0x06, 0xfe, 0x5e, 0xa6, 0xe4, 0x66, 0xef, 0x88, 0xb2, 0xe2, 0x3a,
+	0x4f, 0x12, 0xc7, 0x76, 0xe8, 0xb1, 0xb0, 0x78, 0x47, 0x52, 0xdd, 0xf1, 0xb7, 0x53, 0x05, 0x47,
+	0x6f, 0x40, 0x21, 0x0a, 0x5c, 0x62, 0xf6, 0x23, 0xec, 0xd3, 0x58, 0x5d, 0xa9, 0xe6, 0xeb, 0x85,
+	0xb5, 0x9b, 0xe7, 0xec, 0xdc, 0x08, 0x5c, 0xb2, 0xc5, 0x40, 0x72, 0xc7, 0x10, 0xa5, 0x82, 0x18,
+	0xfd, 0x1a, 0xae, 0x86, 0x44, 0x56, 0x5e, 0xd6, 0x00, 0x99, 0x34, 0xc2, 0x7e, 0x7c, 0x40, 0x22,
+	0xb5, 0xc1, 0x23, 0xf9, 0xe6, 0x79, 0x64, 0x10, 0x06, 0x1a, 0xc3, 0x77, 0x25, 0xdc, 0x98, 0x0f,
+	0xcf, 0x91, 0xb2, 0x96, 0x8e, 0xd5, 0x7f, 0x37, 0xb0, 0x3e, 0xcf, 0x48, 0xb9, 0xca, 0x49, 0x39,
+	0x93, 0xca, 0x53, 0x36, 0x3e, 0x06, 0x44, 0x5c, 0xa7, 0xef, 0xf4, 0x1c, 0x97, 0x6d, 0x5d, 0x9e,
+	0xe7, 0xbd, 0x17, 0x9e, 0xa7, 0x3e, 0x04, 0x9f, 0x3c, 0x4f, 0x72, 0x5a, 0x81, 0xd6, 0x45, 0xa9,
+	0x0c, 0x03, 0xc9, 0x15, 0x51, 0x2a, 0xef, 0x5f, 0x50, 0x2a, 0x59, 0x61, 0x69, 0x4b, 0xb8, 0xa8,
+	0x95, 0x6d, 0x58, 0xcc, 0xec, 0x2d, 0x1c, 0x9a, 0xe4, 0x88, 0x78, 0x21, 0x1b, 0xc5, 0xea, 0xda,
+	0x25, 0x75, 0x62, 0x21, 0x35, 0xdc, 0xc0, 0xa1, 0x9e, 0x99, 0xa1, 0xff, 0x07, 0x56, 0x90, 0x4d,
+	0xd1, 0x36, 0x8b, 0x25, 0xbd, 0x7b, 0xc1, 0x92, 0x4a, 0x1e, 0x3e, 0xea, 0x32, 0x2c, 0x5f, 0x4f,
+	0xed, 0x37, 0x30, 0x7b, 0x26, 0x02, 0x48, 0x85, 0x2b, 0x69, 0x0b, 0xa0, 0xf0, 0xdc, 0x49, 0x87,
+	0x68, 0x15, 0xe6, 0x22, 0xf2, 0x24, 0xe1, 0xed, 0x28, 0xa6, 0x34, 0x72, 0x7a, 0x09, 0xe5, 0x6d,
+	0x7a, 0xbe, 0x3e, 0x6d, 0xa0, 0x54, 0xa5, 0x65, 0x9a, 0xda, 0x33, 0x05, 0xe6, 0xcf, 0x3b, 0x68,
+	0xf4, 0x3e, 0x4c, 0xfb, 0xe4, 0xa9, 0x60, 0x0b, 0x9f, 0xe5, 0xa2, 0xad, 0x4f, 0xf9, 0xe4, 0x29,
+	0x37, 0x47, 0x1a, 0x14, 0xc8, 0x51, 0xe8, 0x44, 0xc7, 0xbc, 0x21, 0x90, 0xef, 0x83, 0xeb, 0x0d,
+	0xf1, 0x66, 0x69, 0xa4, 0x6f, 0x96, 0x46, 0x37, 0x7d, 0xb3, 0xac, 0x8f, 0x3f, 0xfb, 0x6e, 0x59,
+	0x31, 0x40, 0x18, 0x31, 0x71, 0xed, 0xb7, 0x39, 0x98, 0xe9, 0x58, 0x87, 0xc4, 0x4e, 0x5c, 0x62,
+	0x6b, 0x16, 0xaf, 0x7f, 0x65, 0xc8, 0x39, 0x62, 0xb3, 0xe3, 0x46, 0xce, 0xe1, 0x4d, 0x0e, 0x67,
+	0x48, 0xd6, 0x4b, 0xe5, 0x2e, 0xbb, 0xd2, 0x38, 0x3c, 0x6d, 0xa5, 0x3e, 0x80, 0xe9, 0xe1, 0x6d,
+	0x98, 0xbf, 0xc4, 0x74, 0x08, 0x65, 0xed, 0x31, 0x39, 0x22, 0x56, 0x42, 0x89, 0xd8, 0xde, 0xb8,
+	0x68, 0x8f, 0xa5, 0x8c, 0xad, 0x1e, 0x6d, 0x40, 0xde, 0x8b, 0xfb, 0xfc, 0x1d, 0x52, 0x58, 0x9b,
+	0x3f, 0xb3, 0x71, 0xcd, 0x3f, 0x5e, 0xbf, 0xf1, 0xd5, 0xf3, 0x95, 0xc5, 0xf3, 0x1a, 0x9e, 0x9d,
+	0xb8, 0x6f, 0x30, 0xeb, 0xda, 0x13, 0x98, 0xce, 0xb2, 0x19, 0xdd, 0x83, 0x71, 0x96, 0xc9, 0x7c,
+	0xf7, 0xe5, 0x73, 0x33, 0x9f, 0xbf, 0xf6, 0x98, 0x81, 0xc1, 0x91, 0x68, 0x0d, 0xae, 0xbc, 0x6c,
+	0x5c, 0x52, 0x60, 0xed, 0xaf, 0x39, 0x98, 0xe2, 0x7e, 0x5a, 0xda, 0x3e, 0x9a, 0x87, 0x09, 0xf1,
+	0xde, 0xe2, 0x07, 0x6f, 0x88, 0x01, 0x7a, 0x1f, 0x26, 0xf8, 0x7d, 0xf0, 0xb2, 0xaf, 0x3e, 0x81,
+	0x46, 0x1b, 0x30, 0x39, 0x08, 0xdc, 0xc4, 0x23, 0x32, 0xd2, 0x6f, 0x33, 0xe5, 0x3f, 0xbe, 0x5d,
+	0x5e, 0x10, 0xe6, 0xb1, 0xfd, 0x79, 0xc3, 0x09, 0x56, 0x3d, 0x4c, 0x0f, 0x59, 0x1a, 0x7c, 0xf3,
+	0x7c, 0x05, 0x86, 0x49, 0x61, 0x48, 0x53, 0xd6, 0xc2, 0x8b, 0xeb, 0x4b, 0x3c, 0x01, 0x0d, 0x39,
+	0x42, 0xf7, 0x60, 0x5e, 0xdc, 0x1b, 0xb6, 0xd9, 0xe3, 0xe5, 0xe7, 0x90, 0x38, 0xfd, 0x43, 0xca,
+	0xe3, 0x9f, 0x37, 0x90, 0xd4, 0xad, 0x33, 0xd5, 0x23, 0xae, 0x41, 0x5b, 0x50, 0x4c, 0x2d, 0xf8,
+	0x19, 0x4e, 0x5e, 0x4a, 0xd1, 0x29, 0xb6, 0x60, 0x4e, 0xd3, 0x82, 0xb4, 0xe4, 0x3c, 0xfd, 0x43,
+	0x0e, 0x90, 0x4c, 0x9d, 0x96, 0xb6, 0xdf, 0x8e, 0x82, 0x30, 0x88, 0xb1, 0x7b, 0x96, 0x9a, 0xca,
+	0x2b, 0x51, 0xf3, 0x5d, 0xc8, 0xb3, 0x56, 0x49, 0x84, 0xf8, 0xc6, 0x8b, 0x0e, 0xbb, 0xa5, 0xed,
+	0xcb, 0x20, 0x33, 0x34, 0x7a, 0x0f, 0xa6, 0x42, 0x3e, 0x3f, 0x89, 0x2e, 0xa5, 0x73, 0x86, 0x44,
+	0xfa, 0xc9, 0x5c, 0x1d, 0x7f, 0x85, 0x40, 0x8c, 0xe6, 0xeb, 0xdf, 0x14, 0x98, 0x6b, 0x69, 0xfb,
+	0xa2, 0x4b, 0xe8, 0x24, 0x3d, 0xcf, 0x89, 0x63, 0x96, 0xb3, 0x3f, 0x31, 0x10, 0xf7, 0xb2, 0x13,
+	0xbf, 0x8c, 0xc3, 0x29, 0x17, 0x64, 0xe8, 0xf2, 0xaf, 0x12, 0xba, 0xda, 0x9f, 0x14, 0x28, 0xa7,
+	0x1f, 0x3c, 0xe4, 0x73, 0x4e, 0x1d, 0xa6, 0x8f, 0xe0, 0x7f, 0x3a, 0x44, 0x18, 0x26, 0xac, 0xc0,
+	0xf1, 0x45, 0x41, 0xbd, 0x30, 0x03, 0xee, 0xb1, 0x19, 0xfe, 0xfc, 0xdd, 0x72, 0xbd, 0xef, 0xd0,
+	0xc3, 0xa4, 0xd7, 0xb0, 0x02, 0x4f, 0x7e, 0xf7, 0x91, 0x7f, 0x56, 0x62, 0xfb, 0xf3, 0x55, 0x7a,
+	0x1c, 0x92, 0x98, 0x1b, 0xc4, 0x86, 0xf0, 0xfc, 0xc9, 0xd4, 0x1f, 0xbf, 0x58, 0x1e, 0xfb, 0xd7,
+	0x17, 0xcb, 0x63, 0xb5, 0xdf, 0xe7, 0xa0, 0x2c, 0xf9, 0x25, 0xdb, 0x79, 0xd4, 0x80, 0x89, 0xe0,
+	0xa9, 0x4f, 0xa2, 0x4b, 0x43, 0x29, 0x60, 0x3f, 0xb5, 0x4c, 0x7e, 0x08, 0x93, 0xf2, 0x3b, 0x4f,
+	0xfe, 0xe5, 0x32, 0x5e, 0xc2, 0xd1, 0x1d, 0x28, 0x46, 0xc4, 0x26, 0xc4, 0x3b, 0xf3, 0xd9, 0xa6,
+	0x20, 0xe4, 0xe2, 0xab, 0xcd, 0x6b, 0x50, 0x3a, 0xc0, 0x8e, 0x9b, 0x44, 0xc4, 0x14, 0x5f, 0xa6,
+	0x26, 0x78, 0x0f, 0x54, 0x94, 0xc2, 0x0d, 0x26, 0xab, 0x45, 0x30, 0x7b, 0xa6, 0xa7, 0x63, 0x6f,
+	0x61, 0xee, 0xd9, 0x0c, 0x31, 0xa5, 0x24, 0xf2, 0xd9, 0x49, 0xb1, 0x2b, 0xae, 0xc4, 0xa5, 0x6d,
+	0x29, 0x44, 0x1f, 0xc0, 0x62, 0x6c, 0x05, 0x21, 0x31, 0xe3, 0x90, 0x58, 0xce, 0x81, 0x63, 0x89,
+	0xee, 0xce, 0xb1, 0xd3, 0x2b, 0x71, 0x81, 0xab, 0x3b, 0xa3, 0xda, 0xa6, 0x1d, 0xd7, 0xb6, 0xa0,
+	0xc8, 0x5b, 0xbe, 0x47, 0xd8, 0x89, 0xac, 0x84, 0xbe, 0xa0, 0x1e, 0xde, 0x86, 0xe2, 0xa1, 0x00,
+	0x88, 0x0e, 0x2e, 0xc7, 0x57, 0x5f, 0x90, 0x32, 0xd6, 0xbc, 0xd5, 0x9e, 0xe5, 0x60, 0x81, 0x7f,
+	0xf6, 0xe2, 0x9d, 0xf5, 0x6e, 0x2f, 0x26, 0xd1, 0x40, 0x74, 0xf4, 0x1f, 0xc1, 0x38, 0xcf, 0x3a,
+	0xe5, 0x15, 0xb2, 0x8e, 0x5b, 0xb0, 0x69, 0x4f, 0x94, 0xba, 0x9c, 0xb8, 0x84, 0x7a, 0x27, 0x6a,
+	0x9c, 0xac, 0xd4, 0xa2, 0x18, 0xdc, 0x97, 0x15, 0xf7, 0xc6, 0xd9, 0x8a, 0xbb, 0x4d, 0xfa, 0xd8,
+	0x3a, 0xde, 0x24, 0xd6, 0x48, 0xdd, 0xdd, 0x24, 0x56, 0x5a, 0xbb, 0x3f, 0x03, 0xb0, 0x12, 0x2f,
+	0x71, 0x31, 0x75, 0x06, 0xb2, 0xf4, 0xfe, 0x18, 0x6f, 0x23, 0x4e, 0x6a, 0x7f, 0xc9, 0xc3, 0xcc,
+	0x6e, 0x42, 0x7b, 0x41, 0xe2, 0xdb, 0x6d, 0xf1, 0x0d, 0x0f, 0x2d, 0xb3, 0x4a, 0xc4, 0x8e, 0x0c,
+	0xbb, 0xa6, 0xbc, 0xe7, 0xa7, 0x59, 0x8d, 0x11, 0xa2, 0xa6, 0xcd, 0x8a, 0x01, 0xc5, 0x51, 0x9f,
+	0xd0, 0xcb, 0x8b, 0x81, 0xc0, 0xa1, 0x10, 0x4a, 0xf2, 0xb5, 0x84, 0x3d, 0xce, 0xad, 0xfc, 0x7f,
+	0x3f, 0x65, 0x8b, 0x62, 0x06, 0x8d, 0x4f, 0xc0, 0x66, 0x14, 0x73, 0xa7, 0x33, 0x8e, 0xff, 0x0f,
+	0x66, 0x14, 0x33, 0xc8, 0x19, 0x6f, 0xc2, 0xb4, 0xed, 0x44, 0x84, 0xb7, 0x48, 0xe2, 0xcb, 0xa7,
+	0x31, 0x14, 0x9c, 0x2e, 0xef, 0x93, 0x3f, 0xae, 0xbc, 0xdf, 0xd5, 0xa1, 0x7c, 0xf2, 0x71, 0x8a,
+	0xae, 0xc3, 0xd5, 0x96, 0xb6, 0x6f, 0x6a, 0x5b, 0x5b, 0x86, 0xbe, 0xa5, 0x75, 0x9b, 0xbb, 0x2d,
+	0x73, 0x47, 0xdf, 0x6c, 0x6a, 0xad, 0xca, 0x18, 0x5a, 0xe4, 0x77, 0xc1, 0x49, 0x5d, 0xb3, 0x55,
+	0x51, 0xee, 0xfe, 0x4e, 0x81, 0x99, 0x53, 0xcf, 0x4b, 0x54, 0x83, 0xa5, 0xb6, 0xd1, 0xdc, 0xd0,
+	0xcd, 0xce, 0xee, 0x9e, 0xb1, 0xa1, 0x9b, 0xdd, 0xc7, 0x6d, 0xdd, 0x6c, 0xb6, 0xba, 0xba, 0xd1,
+	0xd2, 0xb6, 0xcd, 0x96, 0xb6, 0x5f, 0x19, 0x43, 0x55, 0xb8, 0x79, 0x16, 0xb3, 0xa3, 0x19, 0xbf,
+	0xd0, 0x0d, 0x8e, 0x50, 0xd0, 0x5b, 0x70, 0xe7, 0x2c, 0x42, 0xff, 0xe5, 0xc6, 0x23, 0xad, 0xb5,
+	0xa5, 0x9b, 0x1d, 0xbd, 0xdb, 0xdd, 0xd6, 0x77, 0xf4, 0x56, 0xb7, 0x92, 0xbb, 0xfb, 0x6f, 0x05,
+	0xa6, 0xb3, 0x66, 0x89, 0xed, 0x63, 0x5f, 0xdb, 0xdb, 0xee, 0x9a, 0xc6, 0xee, 0xb6, 0x6e, 0xee,
+	0xb5, 0x3a, 0x6d, 0x7d, 0xa3, 0xf9, 0xb0, 0xa9, 0x6f, 0x56, 0xc6, 0xd0, 0x02, 0xcc, 0x8e, 0xe8,
+	0xda, 0xda, 0x5e, 0x47, 0x37, 0x2a, 0xca, 0x29, 0x13, 0x43, 0xeb, 0x8a, 0x09, 0x74, 0xa3, 0x92,
+	0x43, 0xb7, 0xe0, 0xda, 0x88, 0x6e, 0xbb, 0xb9, 0xd3, 0xec, 0x76, 0xcc, 0x1d, 0xad, 0xa5, 0x6d,
+	0xe9, 0x46, 0x25, 0x7f, 0x4a, 0x6d, 0xe8, 0x1d, 0xdd, 0xd8, 0xd7, 0xcd, 0x87, 0x7b, 0xad, 0x4d,
+	0xdd, 0xa8, 0x8c, 0xa3, 0x9b, 0xa0, 0x8e, 0xa8, 0x79, 0x0c, 0xf7, 0xba, 0x8f, 0x76, 0x8d, 0x66,
+	0xf7, 0x71, 0x65, 0x02, 0xdd, 0x86, 0x5b, 0x23, 0xda, 0xe1, 0x9e, 0x32, 0xff, 0x93, 0x2c, 0xf2,
+	0x23, 0x90, 0xad, 0x3d, 0xcd, 0xe0, 0x47, 0x72, 0x65, 0xfd, 0xe3, 0x2f, 0xbf, 0x5f, 0x52, 0xbe,
+	0xfe, 0x7e, 0x49, 0xf9, 0xe7, 0xf7, 0x4b, 0xca, 0xb3, 0x1f, 0x96, 0xc6, 0xbe, 0xfe, 0x61, 0x69,
+	0xec, 0xef, 0x3f, 0x2c, 0x8d, 0xfd, 0x6a, 0x79, 0x84, 0x77, 0xa7, 0xfe, 0x27, 0xc2, 0x49, 0xd7,
+	0x9b, 0xe4, 0x2c, 0x79, 0xf7, 0x3f, 0x01, 0x00, 0x00, 0xff, 0xff, 0x1d, 0xb3, 0xb5, 0x7a, 0x32,
+	0x19, 0x00, 0x00,
+}
+
+func (m *VaultAccount) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	l = len(m.BridgeAddress)
-	if l > 0 {
-		n += 2 + l + sovVault(uint64(l))
+	return dAtA[:n], nil
+}
+
+func (m *VaultAccount) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *VaultAccount) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.MaxTotalValue) > 0 {
+		i -= len(m.MaxTotalValue)
+		copy(dAtA[i:], m.MaxTotalValue)
+		i = encodeVarintVault(dAtA, i, uint64(len(m.MaxTotalValue)))
+		i--
+		dAtA[i] = 0x3
+		i--
+		dAtA[i] = 0x9a
 	}
-	if m.BridgeEnabled {
-		n += 3
+	if len(m.PositionCapExemptions) > 0 {
+		for iNdEx := len(m.PositionCapExemptions) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.PositionCapExemptions[iNdEx])
+			copy(dAtA[i:], m.PositionCapExemptions[iNdEx])
+			i = encodeVarintVault(dAtA, i, uint64(len(m.PositionCapExemptions[iNdEx])))
+			i--
+			dAtA[i] = 0x3
+			i--
+			dAtA[i] = 0x92
+		}
 	}
-	l = len(m.AssetManager)
-	if l > 0 {
-		n += 2 + l + sovVault(uint64(l))
+	if len(m.MaxPositionValue) > 0 {
+		i -= len(m.MaxPositionValue)
+		copy(dAtA[i:], m.MaxPositionValue)
+		i = encodeVarintVault(dAtA, i, uint64(len(m.MaxPositionValue)))
+		i--
+		dAtA[i] = 0x3
+		i--
+		dAtA[i] = 0x8a
 	}
-	if m.FeePeriodStart != 0 {
-		n += 2 + sovVault(uint64(m.FeePeriodStart))
+	{
+		size, err := m.EligibilityPolicy.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintVault(dAtA, i, uint64(size))
 	}
-	if m.FeePeriodTimeout != 0 {
-		n += 2 + sovVault(uint64(m.FeePeriodTimeout))
+	i--
+	dAtA[i] = 0x3
+	i--
+	dAtA[i] = 0x82
+	if m.TimelockSeconds != 0 {
+		i = encodeVarintVault(dAtA, i, uint64(m.TimelockSeconds))
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0xf8
 	}
-	l = m.OutstandingAumFee.Size()
-	n += 2 + l + sovVault(uint64(l))
-	if m.AumFeeBips != 0 {
-		n += 2 + sovVault(uint64(m.AumFeeBips))
+	if m.PendingAdminTransfer != nil {
+		{
+			size, err := m.PendingAdminTransfer.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintVault(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0xf2
 	}
-	l = len(m.MinSwapInValue)
-	if l > 0 {
-		n += 2 + l + sovVault(uint64(l))
+	if len(m.RoleGrants) > 0 {
+		for iNdEx := len(m.RoleGrants) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RoleGrants[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintVault(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2
+			i--
+			dAtA[i] = 0xea
+		}
 	}
-	l = len(m.MinSwapOutValue)
-	if l > 0 {
-		n += 2 + l + sovVault(uint64(l))
+	if m.MinLiquidityBips != 0 {
+		i = encodeVarintVault(dAtA, i, uint64(m.MinLiquidityBips))
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0xe0
 	}
-	l = len(m.MaxSwapInValue)
-	if l > 0 {
-		n += 2 + l + sovVault(uint64(l))
+	if m.MaxNonUnderlyingBips != 0 {
+		i = encodeVarintVault(dAtA, i, uint64(m.MaxNonUnderlyingBips))
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0xd8
 	}
-	l = len(m.MaxSwapOutValue)
-	if l > 0 {
-		n += 2 + l + sovVault(uint64(l))
+	if m.MaxAssetConcentrationBips != 0 {
+		i = encodeVarintVault(dAtA, i, uint64(m.MaxAssetConcentrationBips))
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0xd0
 	}
-	l = len(m.NavAuthority)
-	if l > 0 {
-		n += 2 + l + sovVault(uint64(l))
+	{
+		size, err := m.AcquisitionPolicy.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintVault(dAtA, i, uint64(size))
 	}
-	return n
-}
-
-func (m *VaultNAV) Size() (n int) {
-	if m == nil {
-		return 0
+	i--
+	dAtA[i] = 0x2
+	i--
+	dAtA[i] = 0xca
+	if m.UpdateNavOnSettlement {
+		i--
+		if m.UpdateNavOnSettlement {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0xc0
 	}
-	var l int
-	_ = l
-	l = len(m.Denom)
-	if l > 0 {
-		n += 1 + l + sovVault(uint64(l))
+	if m.SettlementToleranceBips != 0 {
+		i = encodeVarintVault(dAtA, i, uint64(m.SettlementToleranceBips))
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0xb8
+	}
+	if len(m.PriceSources) > 0 {
+		dAtA5 := make([]byte, len(m.PriceSources)*10)
+		var j4 int
+		for _, num := range m.PriceSources {
+			for num >= 1<<7 {
+				dAtA5[j4] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j4++
+			}
+			dAtA5[j4] = uint8(num)
+			j4++
+		}
+		i -= j4
+		copy(dAtA[i:], dAtA5[:j4])
+		i = encodeVarintVault(dAtA, i, uint64(j4))
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0xb2
 	}
-	l = m.Price.Size()
-	n += 1 + l + sovVault(uint64(l))
-	l = m.Volume.Size()
-	n += 1 + l + sovVault(uint64(l))
-	l = len(m.Source)
-	if l > 0 {
-		n += 1 + l + sovVault(uint64(l))
+	if m.NavSourceMaxAgeSeconds != 0 {
+		i = encodeVarintVault(dAtA, i, uint64(m.NavSourceMaxAgeSeconds))
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0xa8
 	}
-	if m.UpdatedBlockHeight != 0 {
-		n += 1 + sovVault(uint64(m.UpdatedBlockHeight))
+	if m.NavAggregation != 0 {
+		i = encodeVarintVault(dAtA, i, uint64(m.NavAggregation))
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0xa0
 	}
-	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.UpdatedTime)
+	if m.NavSourceQuorum != 0 {
+		i = encodeVarintVault(dAtA, i, uint64(m.NavSourceQuorum))
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0x98
+	}
+	if len(m.NavSources) > 0 {
+		for iNdEx := len(m.NavSources) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.NavSources[iNdEx])
+			copy(dAtA[i:], m.NavSources[iNdEx])
+			i = encodeVarintVault(dAtA, i, uint64(len(m.NavSources[iNdEx])))
+			i--
+			dAtA[i] = 0x2
+			i--
+			dAtA[i] = 0x92
+		}
+	}
+	if len(m.NavApprover) > 0 {
+		i -= len(m.NavApprover)
+		copy(dAtA[i:], m.NavApprover)
+		i = encodeVarintVault(dAtA, i, uint64(len(m.NavApprover)))
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0x8a
+	}
+	if m.MaxNavChangeBips != 0 {
+		i = encodeVarintVault(dAtA, i, uint64(m.MaxNavChangeBips))
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0x80
+	}
+	if m.AutoPauseOnStaleNav {
+		i--
+		if m.AutoPauseOnStaleNav {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xf8
+	}
+	if m.MaxNavAgeSeconds != 0 {
+		i = encodeVarintVault(dAtA, i, uint64(m.MaxNavAgeSeconds))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xf0
+	}
+	if len(m.NavAuthority) > 0 {
+		i -= len(m.NavAuthority)
+		copy(dAtA[i:], m.NavAuthority)
+		i = encodeVarintVault(dAtA, i, uint64(len(m.NavAuthority)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xea
+	}
+	if len(m.MaxSwapOutValue) > 0 {
+		i -= len(m.MaxSwapOutValue)
+		copy(dAtA[i:], m.MaxSwapOutValue)
+		i = encodeVarintVault(dAtA, i, uint64(len(m.MaxSwapOutValue)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xe2
+	}
+	if len(m.MaxSwapInValue) > 0 {
+		i -= len(m.MaxSwapInValue)
+		copy(dAtA[i:], m.MaxSwapInValue)
+		i = encodeVarintVault(dAtA, i, uint64(len(m.MaxSwapInValue)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xda
+	}
+	if len(m.MinSwapOutValue) > 0 {
+		i -= len(m.MinSwapOutValue)
+		copy(dAtA[i:], m.MinSwapOutValue)
+		i = encodeVarintVault(dAtA, i, uint64(len(m.MinSwapOutValue)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xd2
+	}
+	if len(m.MinSwapInValue) > 0 {
+		i -= len(m.MinSwapInValue)
+		copy(dAtA[i:], m.MinSwapInValue)
+		i = encodeVarintVault(dAtA, i, uint64(len(m.MinSwapInValue)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xca
+	}
+	if m.AumFeeBips != 0 {
+		i = encodeVarintVault(dAtA, i, uint64(m.AumFeeBips))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xc0
+	}
+	{
+		size, err := m.OutstandingAumFee.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintVault(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0xba
+	if m.FeePeriodTimeout != 0 {
+		i = encodeVarintVault(dAtA, i, uint64(m.FeePeriodTimeout))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xb0
+	}
+	if m.FeePeriodStart != 0 {
+		i = encodeVarintVault(dAtA, i, uint64(m.FeePeriodStart))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xa8
+	}
+	if len(m.AssetManager) > 0 {
+		i -= len(m.AssetManager)
+		copy(dAtA[i:], m.AssetManager)
+		i = encodeVarintVault(dAtA, i, uint64(len(m.AssetManager)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xa2
+	}
+	if m.BridgeEnabled {
+		i--
+		if m.BridgeEnabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x98
+	}
+	if len(m.BridgeAddress) > 0 {
+		i -= len(m.BridgeAddress)
+		copy(dAtA[i:], m.BridgeAddress)
+		i = encodeVarintVault(dAtA, i, uint64(len(m.BridgeAddress)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x92
+	}
+	if len(m.PausedReason) > 0 {
+		i -= len(m.PausedReason)
+		copy(dAtA[i:], m.PausedReason)
+		i = encodeVarintVault(dAtA, i, uint64(len(m.PausedReason)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x8a
+	}
+	{
+		size, err := m.PausedBalance.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintVault(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0x82
+	if m.Paused {
+		i--
+		if m.Paused {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x78
+	}
+	if m.WithdrawalDelaySeconds != 0 {
+		i = encodeVarintVault(dAtA, i, uint64(m.WithdrawalDelaySeconds))
+		i--
+		dAtA[i] = 0x70
+	}
+	if m.SwapOutEnabled {
+		i--
+		if m.SwapOutEnabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x68
+	}
+	if m.SwapInEnabled {
+		i--
+		if m.SwapInEnabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x60
+	}
+	if m.PeriodTimeout != 0 {
+		i = encodeVarintVault(dAtA, i, uint64(m.PeriodTimeout))
+		i--
+		dAtA[i] = 0x58
+	}
+	if m.PeriodStart != 0 {
+		i = encodeVarintVault(dAtA, i, uint64(m.PeriodStart))
+		i--
+		dAtA[i] = 0x50
+	}
+	if len(m.MaxInterestRate) > 0 {
+		i -= len(m.MaxInterestRate)
+		copy(dAtA[i:], m.MaxInterestRate)
+		i = encodeVarintVault(dAtA, i, uint64(len(m.MaxInterestRate)))
+		i--
+		dAtA[i] = 0x4a
+	}
+	if len(m.MinInterestRate) > 0 {
+		i -= len(m.MinInterestRate)
+		copy(dAtA[i:], m.MinInterestRate)
+		i = encodeVarintVault(dAtA, i, uint64(len(m.MinInterestRate)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if len(m.DesiredInterestRate) > 0 {
+		i -= len(m.DesiredInterestRate)
+		copy(dAtA[i:], m.DesiredInterestRate)
+		i = encodeVarintVault(dAtA, i, uint64(len(m.DesiredInterestRate)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.CurrentInterestRate) > 0 {
+		i -= len(m.CurrentInterestRate)
+		copy(dAtA[i:], m.CurrentInterestRate)
+		i = encodeVarintVault(dAtA, i, uint64(len(m.CurrentInterestRate)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.Admin) > 0 {
+		i -= len(m.Admin)
+		copy(dAtA[i:], m.Admin)
+		i = encodeVarintVault(dAtA, i, uint64(len(m.Admin)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.PaymentDenom) > 0 {
+		i -= len(m.PaymentDenom)
+		copy(dAtA[i:], m.PaymentDenom)
+		i = encodeVarintVault(dAtA, i, uint64(len(m.PaymentDenom)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.UnderlyingAsset) > 0 {
+		i -= len(m.UnderlyingAsset)
+		copy(dAtA[i:], m.UnderlyingAsset)
+		i = encodeVarintVault(dAtA, i, uint64(len(m.UnderlyingAsset)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	{
+		size, err := m.TotalShares.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintVault(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.BaseAccount != nil {
+		{
+			size, err := m.BaseAccount.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintVault(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EligibilityPolicy) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EligibilityPolicy) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EligibilityPolicy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.RequiredAttributes) > 0 {
+		for iNdEx := len(m.RequiredAttributes) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.RequiredAttributes[iNdEx])
+			copy(dAtA[i:], m.RequiredAttributes[iNdEx])
+			i = encodeVarintVault(dAtA, i, uint64(len(m.RequiredAttributes[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.Enabled {
+		i--
+		if m.Enabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PendingAdminTransfer) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PendingAdminTransfer) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PendingAdminTransfer) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ExpiryTime != nil {
+		n10, err10 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.ExpiryTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.ExpiryTime):])
+		if err10 != nil {
+			return 0, err10
+		}
+		i -= n10
+		i = encodeVarintVault(dAtA, i, uint64(n10))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.NewAdmin) > 0 {
+		i -= len(m.NewAdmin)
+		copy(dAtA[i:], m.NewAdmin)
+		i = encodeVarintVault(dAtA, i, uint64(len(m.NewAdmin)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ScheduledAction) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ScheduledAction) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ScheduledAction) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Msg != nil {
+		{
+			size, err := m.Msg.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintVault(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.ExecuteTime != 0 {
+		i = encodeVarintVault(dAtA, i, uint64(m.ExecuteTime))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintVault(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.VaultAddress) > 0 {
+		i -= len(m.VaultAddress)
+		copy(dAtA[i:], m.VaultAddress)
+		i = encodeVarintVault(dAtA, i, uint64(len(m.VaultAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintVault(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RoleGrant) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RoleGrant) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RoleGrant) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintVault(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Role != 0 {
+		i = encodeVarintVault(dAtA, i, uint64(m.Role))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *VaultNAV) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *VaultNAV) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *VaultNAV) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n12, err12 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.UpdatedTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.UpdatedTime):])
+	if err12 != nil {
+		return 0, err12
+	}
+	i -= n12
+	i = encodeVarintVault(dAtA, i, uint64(n12))
+	i--
+	dAtA[i] = 0x32
+	if m.UpdatedBlockHeight != 0 {
+		i = encodeVarintVault(dAtA, i, uint64(m.UpdatedBlockHeight))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.Source) > 0 {
+		i -= len(m.Source)
+		copy(dAtA[i:], m.Source)
+		i = encodeVarintVault(dAtA, i, uint64(len(m.Source)))
+		i--
+		dAtA[i] = 0x22
+	}
+	{
+		size := m.Volume.Size()
+		i -= size
+		if _, err := m.Volume.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintVault(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size, err := m.Price.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintVault(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintVault(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PendingNAVProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PendingNAVProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PendingNAVProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n14, err14 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.ExpiryTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.ExpiryTime):])
+	if err14 != nil {
+		return 0, err14
+	}
+	i -= n14
+	i = encodeVarintVault(dAtA, i, uint64(n14))
+	i--
+	dAtA[i] = 0x22
+	if len(m.Proposer) > 0 {
+		i -= len(m.Proposer)
+		copy(dAtA[i:], m.Proposer)
+		i = encodeVarintVault(dAtA, i, uint64(len(m.Proposer)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	{
+		size, err := m.Nav.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintVault(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.VaultAddress) > 0 {
+		i -= len(m.VaultAddress)
+		copy(dAtA[i:], m.VaultAddress)
+		i = encodeVarintVault(dAtA, i, uint64(len(m.VaultAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *NAVSourceSubmission) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *NAVSourceSubmission) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *NAVSourceSubmission) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Nav.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintVault(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Source) > 0 {
+		i -= len(m.Source)
+		copy(dAtA[i:], m.Source)
+		i = encodeVarintVault(dAtA, i, uint64(len(m.Source)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.VaultAddress) > 0 {
+		i -= len(m.VaultAddress)
+		copy(dAtA[i:], m.VaultAddress)
+		i = encodeVarintVault(dAtA, i, uint64(len(m.VaultAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AccountBalance) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AccountBalance) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AccountBalance) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Coins) > 0 {
+		for iNdEx := len(m.Coins) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Coins[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintVault(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintVault(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PendingSwapOut) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PendingSwapOut) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PendingSwapOut) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.FailureCount != 0 {
+		i = encodeVarintVault(dAtA, i, uint64(m.FailureCount))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.RedeemDenom) > 0 {
+		i -= len(m.RedeemDenom)
+		copy(dAtA[i:], m.RedeemDenom)
+		i = encodeVarintVault(dAtA, i, uint64(len(m.RedeemDenom)))
+		i--
+		dAtA[i] = 0x22
+	}
+	{
+		size, err := m.Shares.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintVault(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.VaultAddress) > 0 {
+		i -= len(m.VaultAddress)
+		copy(dAtA[i:], m.VaultAddress)
+		i = encodeVarintVault(dAtA, i, uint64(len(m.VaultAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintVault(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AcquisitionPolicy) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AcquisitionPolicy) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AcquisitionPolicy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ScopeSpecificationIds) > 0 {
+		for iNdEx := len(m.ScopeSpecificationIds) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.ScopeSpecificationIds[iNdEx])
+			copy(dAtA[i:], m.ScopeSpecificationIds[iNdEx])
+			i = encodeVarintVault(dAtA, i, uint64(len(m.ScopeSpecificationIds[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.DenomPatterns) > 0 {
+		for iNdEx := len(m.DenomPatterns) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.DenomPatterns[iNdEx])
+			copy(dAtA[i:], m.DenomPatterns[iNdEx])
+			i = encodeVarintVault(dAtA, i, uint64(len(m.DenomPatterns[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AssetHaircut) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AssetHaircut) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AssetHaircut) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.HaircutBips != 0 {
+		i = encodeVarintVault(dAtA, i, uint64(m.HaircutBips))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintVault(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SharePriceObservation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SharePriceObservation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SharePriceObservation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Cumulative.Size()
+		i -= size
+		if _, err := m.Cumulative.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintVault(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.Price.Size()
+		i -= size
+		if _, err := m.Price.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintVault(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.BlockHeight != 0 {
+		i = encodeVarintVault(dAtA, i, uint64(m.BlockHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	n18, err18 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.Time, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Time):])
+	if err18 != nil {
+		return 0, err18
+	}
+	i -= n18
+	i = encodeVarintVault(dAtA, i, uint64(n18))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *OutboundPayment) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *OutboundPayment) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *OutboundPayment) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n19, err19 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.ExpiryTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.ExpiryTime):])
+	if err19 != nil {
+		return 0, err19
+	}
+	i -= n19
+	i = encodeVarintVault(dAtA, i, uint64(n19))
+	i--
+	dAtA[i] = 0x32
+	if len(m.Direction) > 0 {
+		i -= len(m.Direction)
+		copy(dAtA[i:], m.Direction)
+		i = encodeVarintVault(dAtA, i, uint64(len(m.Direction)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.TargetAmount) > 0 {
+		for iNdEx := len(m.TargetAmount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TargetAmount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintVault(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.SourceAmount) > 0 {
+		for iNdEx := len(m.SourceAmount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SourceAmount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintVault(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Target) > 0 {
+		i -= len(m.Target)
+		copy(dAtA[i:], m.Target)
+		i = encodeVarintVault(dAtA, i, uint64(len(m.Target)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ExternalId) > 0 {
+		i -= len(m.ExternalId)
+		copy(dAtA[i:], m.ExternalId)
+		i = encodeVarintVault(dAtA, i, uint64(len(m.ExternalId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintVault(dAtA []byte, offset int, v uint64) int {
+	offset -= sovVault(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *VaultAccount) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BaseAccount != nil {
+		l = m.BaseAccount.Size()
+		n += 1 + l + sovVault(uint64(l))
+	}
+	l = m.TotalShares.Size()
+	n += 1 + l + sovVault(uint64(l))
+	l = len(m.UnderlyingAsset)
+	if l > 0 {
+		n += 1 + l + sovVault(uint64(l))
+	}
+	l = len(m.PaymentDenom)
+	if l > 0 {
+		n += 1 + l + sovVault(uint64(l))
+	}
+	l = len(m.Admin)
+	if l > 0 {
+		n += 1 + l + sovVault(uint64(l))
+	}
+	l = len(m.CurrentInterestRate)
+	if l > 0 {
+		n += 1 + l + sovVault(uint64(l))
+	}
+	l = len(m.DesiredInterestRate)
+	if l > 0 {
+		n += 1 + l + sovVault(uint64(l))
+	}
+	l = len(m.MinInterestRate)
+	if l > 0 {
+		n += 1 + l + sovVault(uint64(l))
+	}
+	l = len(m.MaxInterestRate)
+	if l > 0 {
+		n += 1 + l + sovVault(uint64(l))
+	}
+	if m.PeriodStart != 0 {
+		n += 1 + sovVault(uint64(m.PeriodStart))
+	}
+	if m.PeriodTimeout != 0 {
+		n += 1 + sovVault(uint64(m.PeriodTimeout))
+	}
+	if m.SwapInEnabled {
+		n += 2
+	}
+	if m.SwapOutEnabled {
+		n += 2
+	}
+	if m.WithdrawalDelaySeconds != 0 {
+		n += 1 + sovVault(uint64(m.WithdrawalDelaySeconds))
+	}
+	if m.Paused {
+		n += 2
+	}
+	l = m.PausedBalance.Size()
+	n += 2 + l + sovVault(uint64(l))
+	l = len(m.PausedReason)
+	if l > 0 {
+		n += 2 + l + sovVault(uint64(l))
+	}
+	l = len(m.BridgeAddress)
+	if l > 0 {
+		n += 2 + l + sovVault(uint64(l))
+	}
+	if m.BridgeEnabled {
+		n += 3
+	}
+	l = len(m.AssetManager)
+	if l > 0 {
+		n += 2 + l + sovVault(uint64(l))
+	}
+	if m.FeePeriodStart != 0 {
+		n += 2 + sovVault(uint64(m.FeePeriodStart))
+	}
+	if m.FeePeriodTimeout != 0 {
+		n += 2 + sovVault(uint64(m.FeePeriodTimeout))
+	}
+	l = m.OutstandingAumFee.Size()
+	n += 2 + l + sovVault(uint64(l))
+	if m.AumFeeBips != 0 {
+		n += 2 + sovVault(uint64(m.AumFeeBips))
+	}
+	l = len(m.MinSwapInValue)
+	if l > 0 {
+		n += 2 + l + sovVault(uint64(l))
+	}
+	l = len(m.MinSwapOutValue)
+	if l > 0 {
+		n += 2 + l + sovVault(uint64(l))
+	}
+	l = len(m.MaxSwapInValue)
+	if l > 0 {
+		n += 2 + l + sovVault(uint64(l))
+	}
+	l = len(m.MaxSwapOutValue)
+	if l > 0 {
+		n += 2 + l + sovVault(uint64(l))
+	}
+	l = len(m.NavAuthority)
+	if l > 0 {
+		n += 2 + l + sovVault(uint64(l))
+	}
+	if m.MaxNavAgeSeconds != 0 {
+		n += 2 + sovVault(uint64(m.MaxNavAgeSeconds))
+	}
+	if m.AutoPauseOnStaleNav {
+		n += 3
+	}
+	if m.MaxNavChangeBips != 0 {
+		n += 2 + sovVault(uint64(m.MaxNavChangeBips))
+	}
+	l = len(m.NavApprover)
+	if l > 0 {
+		n += 2 + l + sovVault(uint64(l))
+	}
+	if len(m.NavSources) > 0 {
+		for _, s := range m.NavSources {
+			l = len(s)
+			n += 2 + l + sovVault(uint64(l))
+		}
+	}
+	if m.NavSourceQuorum != 0 {
+		n += 2 + sovVault(uint64(m.NavSourceQuorum))
+	}
+	if m.NavAggregation != 0 {
+		n += 2 + sovVault(uint64(m.NavAggregation))
+	}
+	if m.NavSourceMaxAgeSeconds != 0 {
+		n += 2 + sovVault(uint64(m.NavSourceMaxAgeSeconds))
+	}
+	if len(m.PriceSources) > 0 {
+		l = 0
+		for _, e := range m.PriceSources {
+			l += sovVault(uint64(e))
+		}
+		n += 2 + sovVault(uint64(l)) + l
+	}
+	if m.SettlementToleranceBips != 0 {
+		n += 2 + sovVault(uint64(m.SettlementToleranceBips))
+	}
+	if m.UpdateNavOnSettlement {
+		n += 3
+	}
+	l = m.AcquisitionPolicy.Size()
+	n += 2 + l + sovVault(uint64(l))
+	if m.MaxAssetConcentrationBips != 0 {
+		n += 2 + sovVault(uint64(m.MaxAssetConcentrationBips))
+	}
+	if m.MaxNonUnderlyingBips != 0 {
+		n += 2 + sovVault(uint64(m.MaxNonUnderlyingBips))
+	}
+	if m.MinLiquidityBips != 0 {
+		n += 2 + sovVault(uint64(m.MinLiquidityBips))
+	}
+	if len(m.RoleGrants) > 0 {
+		for _, e := range m.RoleGrants {
+			l = e.Size()
+			n += 2 + l + sovVault(uint64(l))
+		}
+	}
+	if m.PendingAdminTransfer != nil {
+		l = m.PendingAdminTransfer.Size()
+		n += 2 + l + sovVault(uint64(l))
+	}
+	if m.TimelockSeconds != 0 {
+		n += 2 + sovVault(uint64(m.TimelockSeconds))
+	}
+	l = m.EligibilityPolicy.Size()
+	n += 2 + l + sovVault(uint64(l))
+	l = len(m.MaxPositionValue)
+	if l > 0 {
+		n += 2 + l + sovVault(uint64(l))
+	}
+	if len(m.PositionCapExemptions) > 0 {
+		for _, s := range m.PositionCapExemptions {
+			l = len(s)
+			n += 2 + l + sovVault(uint64(l))
+		}
+	}
+	l = len(m.MaxTotalValue)
+	if l > 0 {
+		n += 2 + l + sovVault(uint64(l))
+	}
+	return n
+}
+
+func (m *EligibilityPolicy) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Enabled {
+		n += 2
+	}
+	if len(m.RequiredAttributes) > 0 {
+		for _, s := range m.RequiredAttributes {
+			l = len(s)
+			n += 1 + l + sovVault(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *PendingAdminTransfer) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.NewAdmin)
+	if l > 0 {
+		n += 1 + l + sovVault(uint64(l))
+	}
+	if m.ExpiryTime != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.ExpiryTime)
+		n += 1 + l + sovVault(uint64(l))
+	}
+	return n
+}
+
+func (m *ScheduledAction) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovVault(uint64(m.Id))
+	}
+	l = len(m.VaultAddress)
+	if l > 0 {
+		n += 1 + l + sovVault(uint64(l))
+	}
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovVault(uint64(l))
+	}
+	if m.ExecuteTime != 0 {
+		n += 1 + sovVault(uint64(m.ExecuteTime))
+	}
+	if m.Msg != nil {
+		l = m.Msg.Size()
+		n += 1 + l + sovVault(uint64(l))
+	}
+	return n
+}
+
+func (m *RoleGrant) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Role != 0 {
+		n += 1 + sovVault(uint64(m.Role))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovVault(uint64(l))
+	}
+	return n
+}
+
+func (m *VaultNAV) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovVault(uint64(l))
+	}
+	l = m.Price.Size()
+	n += 1 + l + sovVault(uint64(l))
+	l = m.Volume.Size()
+	n += 1 + l + sovVault(uint64(l))
+	l = len(m.Source)
+	if l > 0 {
+		n += 1 + l + sovVault(uint64(l))
+	}
+	if m.UpdatedBlockHeight != 0 {
+		n += 1 + sovVault(uint64(m.UpdatedBlockHeight))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.UpdatedTime)
+	n += 1 + l + sovVault(uint64(l))
+	return n
+}
+
+func (m *PendingNAVProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.VaultAddress)
+	if l > 0 {
+		n += 1 + l + sovVault(uint64(l))
+	}
+	l = m.Nav.Size()
+	n += 1 + l + sovVault(uint64(l))
+	l = len(m.Proposer)
+	if l > 0 {
+		n += 1 + l + sovVault(uint64(l))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.ExpiryTime)
+	n += 1 + l + sovVault(uint64(l))
+	return n
+}
+
+func (m *NAVSourceSubmission) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.VaultAddress)
+	if l > 0 {
+		n += 1 + l + sovVault(uint64(l))
+	}
+	l = len(m.Source)
+	if l > 0 {
+		n += 1 + l + sovVault(uint64(l))
+	}
+	l = m.Nav.Size()
+	n += 1 + l + sovVault(uint64(l))
+	return n
+}
+
+func (m *AccountBalance) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovVault(uint64(l))
+	}
+	if len(m.Coins) > 0 {
+		for _, e := range m.Coins {
+			l = e.Size()
+			n += 1 + l + sovVault(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *PendingSwapOut) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovVault(uint64(l))
+	}
+	l = len(m.VaultAddress)
+	if l > 0 {
+		n += 1 + l + sovVault(uint64(l))
+	}
+	l = m.Shares.Size()
+	n += 1 + l + sovVault(uint64(l))
+	l = len(m.RedeemDenom)
+	if l > 0 {
+		n += 1 + l + sovVault(uint64(l))
+	}
+	if m.FailureCount != 0 {
+		n += 1 + sovVault(uint64(m.FailureCount))
+	}
+	return n
+}
+
+func (m *AcquisitionPolicy) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.DenomPatterns) > 0 {
+		for _, s := range m.DenomPatterns {
+			l = len(s)
+			n += 1 + l + sovVault(uint64(l))
+		}
+	}
+	if len(m.ScopeSpecificationIds) > 0 {
+		for _, s := range m.ScopeSpecificationIds {
+			l = len(s)
+			n += 1 + l + sovVault(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *AssetHaircut) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovVault(uint64(l))
+	}
+	if m.HaircutBips != 0 {
+		n += 1 + sovVault(uint64(m.HaircutBips))
+	}
+	return n
+}
+
+func (m *SharePriceObservation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Time)
+	n += 1 + l + sovVault(uint64(l))
+	if m.BlockHeight != 0 {
+		n += 1 + sovVault(uint64(m.BlockHeight))
+	}
+	l = m.Price.Size()
+	n += 1 + l + sovVault(uint64(l))
+	l = m.Cumulative.Size()
+	n += 1 + l + sovVault(uint64(l))
+	return n
+}
+
+func (m *OutboundPayment) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ExternalId)
+	if l > 0 {
+		n += 1 + l + sovVault(uint64(l))
+	}
+	l = len(m.Target)
+	if l > 0 {
+		n += 1 + l + sovVault(uint64(l))
+	}
+	if len(m.SourceAmount) > 0 {
+		for _, e := range m.SourceAmount {
+			l = e.Size()
+			n += 1 + l + sovVault(uint64(l))
+		}
+	}
+	if len(m.TargetAmount) > 0 {
+		for _, e := range m.TargetAmount {
+			l = e.Size()
+			n += 1 + l + sovVault(uint64(l))
+		}
+	}
+	l = len(m.Direction)
+	if l > 0 {
+		n += 1 + l + sovVault(uint64(l))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.ExpiryTime)
 	n += 1 + l + sovVault(uint64(l))
 	return n
 }
 
-func (m *AccountBalance) Size() (n int) {
-	if m == nil {
-		return 0
+func sovVault(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozVault(x uint64) (n int) {
+	return sovVault(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *VaultAccount) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowVault
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: VaultAccount: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: VaultAccount: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseAccount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVault
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthVault
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthVault
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.BaseAccount == nil {
+				m.BaseAccount = &types.BaseAccount{}
+			}
+			if err := m.BaseAccount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalShares", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVault
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthVault
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthVault
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TotalShares.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnderlyingAsset", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVault
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthVault
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthVault
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UnderlyingAsset = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PaymentDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVault
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthVault
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthVault
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PaymentDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Admin", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVault
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthVault
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthVault
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Admin = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrentInterestRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVault
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthVault
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthVault
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CurrentInterestRate = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DesiredInterestRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVault
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthVault
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthVault
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DesiredInterestRate = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinInterestRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVault
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthVault
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthVault
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MinInterestRate = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxInterestRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVault
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthVault
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthVault
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MaxInterestRate = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PeriodStart", wireType)
+			}
+			m.PeriodStart = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVault
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PeriodStart |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PeriodTimeout", wireType)
+			}
+			m.PeriodTimeout = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVault
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PeriodTimeout |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SwapInEnabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVault
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.SwapInEnabled = bool(v != 0)
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SwapOutEnabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVault
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.SwapOutEnabled = bool(v != 0)
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WithdrawalDelaySeconds", wireType)
+			}
+			m.WithdrawalDelaySeconds = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVault
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.WithdrawalDelaySeconds |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 15:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Paused", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVault
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Paused = bool(v != 0)
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PausedBalance", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVault
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthVault
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthVault
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.PausedBalance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PausedReason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVault
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthVault
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthVault
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PausedReason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 18:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BridgeAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVault
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthVault
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthVault
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BridgeAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 19:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BridgeEnabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVault
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.BridgeEnabled = bool(v != 0)
+		case 20:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AssetManager", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVault
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthVault
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthVault
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AssetManager = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 21:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeePeriodStart", wireType)
+			}
+			m.FeePeriodStart = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVault
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FeePeriodStart |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 22:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeePeriodTimeout", wireType)
+			}
+			m.FeePeriodTimeout = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVault
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FeePeriodTimeout |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 23:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OutstandingAumFee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVault
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthVault
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthVault
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.OutstandingAumFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 24:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AumFeeBips", wireType)
+			}
+			m.AumFeeBips = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVault
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AumFeeBips |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 25:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinSwapInValue", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVault
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthVault
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthVault
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MinSwapInValue = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 26:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinSwapOutValue", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVault
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthVault
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthVault
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MinSwapOutValue = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 27:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxSwapInValue", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVault
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthVault
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthVault
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MaxSwapInValue = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 28:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxSwapOutValue", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVault
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthVault
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthVault
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MaxSwapOutValue = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 29:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NavAuthority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVault
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthVault
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthVault
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NavAuthority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 30:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxNavAgeSeconds", wireType)
+			}
+			m.MaxNavAgeSeconds = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVault
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxNavAgeSeconds |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 31:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AutoPauseOnStaleNav", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVault
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.AutoPauseOnStaleNav = bool(v != 0)
+		case 32:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxNavChangeBips", wireType)
+			}
+			m.MaxNavChangeBips = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVault
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxNavChangeBips |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 33:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NavApprover", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVault
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthVault
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthVault
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NavApprover = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 34:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NavSources", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVault
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthVault
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthVault
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NavSources = append(m.NavSources, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 35:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NavSourceQuorum", wireType)
+			}
+			m.NavSourceQuorum = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVault
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NavSourceQuorum |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 36:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NavAggregation", wireType)
+			}
+			m.NavAggregation = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVault
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NavAggregation |= NAVAggregation(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 37:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NavSourceMaxAgeSeconds", wireType)
+			}
+			m.NavSourceMaxAgeSeconds = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVault
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NavSourceMaxAgeSeconds |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 38:
+			if wireType == 0 {
+				var v PriceSourceType
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowVault
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= PriceSourceType(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.PriceSources = append(m.PriceSources, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowVault
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthVault
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthVault
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				if elementCount != 0 && len(m.PriceSources) == 0 {
+					m.PriceSources = make([]PriceSourceType, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v PriceSourceType
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowVault
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= PriceSourceType(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.PriceSources = append(m.PriceSources, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field PriceSources", wireType)
+			}
+		case 39:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SettlementToleranceBips", wireType)
+			}
+			m.SettlementToleranceBips = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVault
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SettlementToleranceBips |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 40:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpdateNavOnSettlement", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVault
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.UpdateNavOnSettlement = bool(v != 0)
+		case 41:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AcquisitionPolicy", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVault
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthVault
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthVault
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.AcquisitionPolicy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 42:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxAssetConcentrationBips", wireType)
+			}
+			m.MaxAssetConcentrationBips = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVault
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxAssetConcentrationBips |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 43:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxNonUnderlyingBips", wireType)
+			}
+			m.MaxNonUnderlyingBips = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVault
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxNonUnderlyingBips |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 44:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinLiquidityBips", wireType)
+			}
+			m.MinLiquidityBips = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVault
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MinLiquidityBips |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 45:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RoleGrants", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVault
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthVault
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthVault
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RoleGrants = append(m.RoleGrants, RoleGrant{})
+			if err := m.RoleGrants[len(m.RoleGrants)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 46:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingAdminTransfer", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVault
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthVault
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthVault
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.PendingAdminTransfer == nil {
+				m.PendingAdminTransfer = &PendingAdminTransfer{}
+			}
+			if err := m.PendingAdminTransfer.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 47:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimelockSeconds", wireType)
+			}
+			m.TimelockSeconds = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVault
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TimelockSeconds |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 48:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EligibilityPolicy", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVault
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthVault
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthVault
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.EligibilityPolicy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 49:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxPositionValue", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVault
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthVault
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthVault
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MaxPositionValue = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 50:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PositionCapExemptions", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVault
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthVault
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthVault
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PositionCapExemptions = append(m.PositionCapExemptions, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 51:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxTotalValue", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVault
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthVault
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthVault
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MaxTotalValue = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipVault(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthVault
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EligibilityPolicy) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowVault
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EligibilityPolicy: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EligibilityPolicy: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Enabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVault
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Enabled = bool(v != 0)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequiredAttributes", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVault
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthVault
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthVault
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RequiredAttributes = append(m.RequiredAttributes, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipVault(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthVault
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PendingAdminTransfer) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowVault
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PendingAdminTransfer: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PendingAdminTransfer: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewAdmin", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVault
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthVault
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthVault
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NewAdmin = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiryTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVault
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthVault
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthVault
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ExpiryTime == nil {
+				m.ExpiryTime = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.ExpiryTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipVault(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthVault
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ScheduledAction) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowVault
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ScheduledAction: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ScheduledAction: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVault
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VaultAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVault
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthVault
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthVault
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VaultAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVault
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthVault
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthVault
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExecuteTime", wireType)
+			}
+			m.ExecuteTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVault
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExecuteTime |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Msg", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVault
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthVault
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthVault
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Msg == nil {
+				m.Msg = &types2.Any{}
+			}
+			if err := m.Msg.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipVault(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthVault
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovVault(uint64(l))
+	return nil
+}
+func (m *RoleGrant) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowVault
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RoleGrant: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RoleGrant: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Role", wireType)
+			}
+			m.Role = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVault
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Role |= VaultRole(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVault
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthVault
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthVault
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipVault(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthVault
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	if len(m.Coins) > 0 {
-		for _, e := range m.Coins {
-			l = e.Size()
-			n += 1 + l + sovVault(uint64(l))
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *VaultNAV) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowVault
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: VaultNAV: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: VaultNAV: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVault
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthVault
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthVault
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVault
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthVault
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthVault
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Price.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Volume", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVault
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthVault
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthVault
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Volume.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Source", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVault
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthVault
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthVault
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Source = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpdatedBlockHeight", wireType)
+			}
+			m.UpdatedBlockHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVault
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UpdatedBlockHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpdatedTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVault
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthVault
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthVault
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.UpdatedTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipVault(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthVault
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
 	}
-	return n
-}
 
-func (m *PendingSwapOut) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Owner)
-	if l > 0 {
-		n += 1 + l + sovVault(uint64(l))
-	}
-	l = len(m.VaultAddress)
-	if l > 0 {
-		n += 1 + l + sovVault(uint64(l))
-	}
-	l = m.Shares.Size()
-	n += 1 + l + sovVault(uint64(l))
-	l = len(m.RedeemDenom)
-	if l > 0 {
-		n += 1 + l + sovVault(uint64(l))
-	}
-	if m.FailureCount != 0 {
-		n += 1 + sovVault(uint64(m.FailureCount))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
-}
-
-func sovVault(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozVault(x uint64) (n int) {
-	return sovVault(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *VaultAccount) Unmarshal(dAtA []byte) error {
+func (m *PendingNAVProposal) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1335,17 +5654,17 @@ func (m *VaultAccount) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: VaultAccount: wiretype end group for non-group")
+			return fmt.Errorf("proto: PendingNAVProposal: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: VaultAccount: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PendingNAVProposal: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BaseAccount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field VaultAddress", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowVault
@@ -1355,31 +5674,27 @@ func (m *VaultAccount) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthVault
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthVault
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.BaseAccount == nil {
-				m.BaseAccount = &types.BaseAccount{}
-			}
-			if err := m.BaseAccount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.VaultAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TotalShares", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Nav", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1406,13 +5721,13 @@ func (m *VaultAccount) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.TotalShares.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Nav.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UnderlyingAsset", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Proposer", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1440,13 +5755,13 @@ func (m *VaultAccount) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.UnderlyingAsset = string(dAtA[iNdEx:postIndex])
+			m.Proposer = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PaymentDenom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiryTime", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowVault
@@ -1456,27 +5771,78 @@ func (m *VaultAccount) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthVault
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthVault
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PaymentDenom = string(dAtA[iNdEx:postIndex])
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.ExpiryTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 5:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipVault(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthVault
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *NAVSourceSubmission) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowVault
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: NAVSourceSubmission: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: NAVSourceSubmission: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Admin", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field VaultAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1504,11 +5870,11 @@ func (m *VaultAccount) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Admin = string(dAtA[iNdEx:postIndex])
+			m.VaultAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 6:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CurrentInterestRate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Source", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1536,13 +5902,13 @@ func (m *VaultAccount) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CurrentInterestRate = string(dAtA[iNdEx:postIndex])
+			m.Source = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 7:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DesiredInterestRate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Nav", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowVault
@@ -1552,59 +5918,78 @@ func (m *VaultAccount) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthVault
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthVault
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DesiredInterestRate = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 8:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MinInterestRate", wireType)
+			if err := m.Nav.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowVault
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipVault(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthVault
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthVault
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
 			}
-			if postIndex > l {
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AccountBalance) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowVault
+			}
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			m.MinInterestRate = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 9:
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AccountBalance: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AccountBalance: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxInterestRate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1632,13 +6017,13 @@ func (m *VaultAccount) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.MaxInterestRate = string(dAtA[iNdEx:postIndex])
+			m.Address = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 10:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PeriodStart", wireType)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Coins", wireType)
 			}
-			m.PeriodStart = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowVault
@@ -1648,114 +6033,81 @@ func (m *VaultAccount) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.PeriodStart |= int64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 11:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PeriodTimeout", wireType)
+			if msglen < 0 {
+				return ErrInvalidLengthVault
 			}
-			m.PeriodTimeout = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowVault
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.PeriodTimeout |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthVault
 			}
-		case 12:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SwapInEnabled", wireType)
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowVault
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			m.Coins = append(m.Coins, types1.Coin{})
+			if err := m.Coins[len(m.Coins)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.SwapInEnabled = bool(v != 0)
-		case 13:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SwapOutEnabled", wireType)
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipVault(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowVault
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthVault
 			}
-			m.SwapOutEnabled = bool(v != 0)
-		case 14:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WithdrawalDelaySeconds", wireType)
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
 			}
-			m.WithdrawalDelaySeconds = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowVault
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.WithdrawalDelaySeconds |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PendingSwapOut) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowVault
 			}
-		case 15:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Paused", wireType)
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowVault
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			m.Paused = bool(v != 0)
-		case 16:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PendingSwapOut: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PendingSwapOut: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PausedBalance", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowVault
@@ -1765,28 +6117,27 @@ func (m *VaultAccount) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthVault
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthVault
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.PausedBalance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 17:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PausedReason", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field VaultAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1814,13 +6165,13 @@ func (m *VaultAccount) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PausedReason = string(dAtA[iNdEx:postIndex])
+			m.VaultAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 18:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BridgeAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Shares", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowVault
@@ -1830,47 +6181,28 @@ func (m *VaultAccount) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthVault
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthVault
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.BridgeAddress = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 19:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BridgeEnabled", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowVault
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if err := m.Shares.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.BridgeEnabled = bool(v != 0)
-		case 20:
+			iNdEx = postIndex
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AssetManager", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RedeemDenom", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1898,51 +6230,13 @@ func (m *VaultAccount) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.AssetManager = string(dAtA[iNdEx:postIndex])
+			m.RedeemDenom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 21:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FeePeriodStart", wireType)
-			}
-			m.FeePeriodStart = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowVault
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.FeePeriodStart |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 22:
+		case 5:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FeePeriodTimeout", wireType)
-			}
-			m.FeePeriodTimeout = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowVault
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.FeePeriodTimeout |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 23:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OutstandingAumFee", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FailureCount", wireType)
 			}
-			var msglen int
+			m.FailureCount = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowVault
@@ -1952,47 +6246,64 @@ func (m *VaultAccount) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.FailureCount |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthVault
+		default:
+			iNdEx = preIndex
+			skippy, err := skipVault(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthVault
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.OutstandingAumFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AcquisitionPolicy) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowVault
 			}
-			iNdEx = postIndex
-		case 24:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AumFeeBips", wireType)
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
-			m.AumFeeBips = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowVault
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.AumFeeBips |= uint32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-		case 25:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AcquisitionPolicy: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AcquisitionPolicy: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MinSwapInValue", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DenomPatterns", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2020,11 +6331,11 @@ func (m *VaultAccount) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.MinSwapInValue = string(dAtA[iNdEx:postIndex])
+			m.DenomPatterns = append(m.DenomPatterns, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 26:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MinSwapOutValue", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ScopeSpecificationIds", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2052,43 +6363,61 @@ func (m *VaultAccount) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.MinSwapOutValue = string(dAtA[iNdEx:postIndex])
+			m.ScopeSpecificationIds = append(m.ScopeSpecificationIds, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 27:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxSwapInValue", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowVault
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipVault(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthVault
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthVault
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
 			}
-			if postIndex > l {
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AssetHaircut) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowVault
+			}
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			m.MaxSwapInValue = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 28:
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AssetHaircut: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AssetHaircut: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxSwapOutValue", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2116,13 +6445,13 @@ func (m *VaultAccount) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.MaxSwapOutValue = string(dAtA[iNdEx:postIndex])
+			m.Denom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 29:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NavAuthority", wireType)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HaircutBips", wireType)
 			}
-			var stringLen uint64
+			m.HaircutBips = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowVault
@@ -2132,24 +6461,11 @@ func (m *VaultAccount) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.HaircutBips |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthVault
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthVault
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.NavAuthority = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipVault(dAtA[iNdEx:])
@@ -2171,7 +6487,7 @@ func (m *VaultAccount) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *VaultNAV) Unmarshal(dAtA []byte) error {
+func (m *SharePriceObservation) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2194,17 +6510,17 @@ func (m *VaultNAV) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: VaultNAV: wiretype end group for non-group")
+			return fmt.Errorf("proto: SharePriceObservation: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: VaultNAV: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SharePriceObservation: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowVault
@@ -2214,29 +6530,30 @@ func (m *VaultNAV) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthVault
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthVault
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Denom = string(dAtA[iNdEx:postIndex])
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.Time, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
 			}
-			var msglen int
+			m.BlockHeight = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowVault
@@ -2246,28 +6563,14 @@ func (m *VaultNAV) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.BlockHeight |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthVault
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthVault
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.Price.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Volume", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2295,13 +6598,13 @@ func (m *VaultNAV) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Volume.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Price.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Source", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Cumulative", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2329,57 +6632,7 @@ func (m *VaultNAV) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Source = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UpdatedBlockHeight", wireType)
-			}
-			m.UpdatedBlockHeight = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowVault
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.UpdatedBlockHeight |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 6:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UpdatedTime", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowVault
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthVault
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthVault
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.UpdatedTime, dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Cumulative.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2404,7 +6657,7 @@ func (m *VaultNAV) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *AccountBalance) Unmarshal(dAtA []byte) error {
+func (m *OutboundPayment) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2427,15 +6680,15 @@ func (m *AccountBalance) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AccountBalance: wiretype end group for non-group")
+			return fmt.Errorf("proto: OutboundPayment: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AccountBalance: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: OutboundPayment: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ExternalId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2463,95 +6716,11 @@ func (m *AccountBalance) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Address = string(dAtA[iNdEx:postIndex])
+			m.ExternalId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Coins", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowVault
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthVault
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthVault
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Coins = append(m.Coins, types1.Coin{})
-			if err := m.Coins[len(m.Coins)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipVault(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthVault
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *PendingSwapOut) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowVault
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: PendingSwapOut: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PendingSwapOut: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Target", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2579,13 +6748,13 @@ func (m *PendingSwapOut) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Owner = string(dAtA[iNdEx:postIndex])
+			m.Target = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VaultAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SourceAmount", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowVault
@@ -2595,27 +6764,29 @@ func (m *PendingSwapOut) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthVault
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthVault
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.VaultAddress = string(dAtA[iNdEx:postIndex])
+			m.SourceAmount = append(m.SourceAmount, types1.Coin{})
+			if err := m.SourceAmount[len(m.SourceAmount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 3:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Shares", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetAmount", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2642,13 +6813,14 @@ func (m *PendingSwapOut) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Shares.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.TargetAmount = append(m.TargetAmount, types1.Coin{})
+			if err := m.TargetAmount[len(m.TargetAmount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RedeemDenom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Direction", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2676,13 +6848,13 @@ func (m *PendingSwapOut) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RedeemDenom = string(dAtA[iNdEx:postIndex])
+			m.Direction = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FailureCount", wireType)
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiryTime", wireType)
 			}
-			m.FailureCount = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowVault
@@ -2692,11 +6864,25 @@ func (m *PendingSwapOut) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.FailureCount |= uint32(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthVault
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthVault
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.ExpiryTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipVault(dAtA[iNdEx:])
@@ -3,13 +3,16 @@ package types_test
 import (
 	"fmt"
 	"testing"
+	"time"
 
 	"cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	metadatatypes "github.com/provenance-io/provenance/x/metadata/types"
 	"github.com/provlabs/vault/types"
 	"github.com/provlabs/vault/utils"
 )
@@ -727,6 +730,315 @@ func TestVaultAccount_Validate(t *testing.T) {
 			},
 			expectedErr: "",
 		},
+		{
+			name: "max nav age at limit is valid",
+			vaultAccount: types.VaultAccount{
+				BaseAccount:         baseAcc,
+				Admin:               validAdmin,
+				TotalShares:         sdk.NewInt64Coin(validDenom, 0),
+				UnderlyingAsset:     "uusd",
+				PaymentDenom:        "uusd",
+				CurrentInterestRate: "0.0",
+				DesiredInterestRate: "0.0",
+				OutstandingAumFee:   sdk.NewInt64Coin("uusd", 0),
+				MaxNavAgeSeconds:    types.MaxNAVAge,
+				AutoPauseOnStaleNav: true,
+			},
+			expectedErr: "",
+		},
+		{
+			name: "max nav age above limit",
+			vaultAccount: types.VaultAccount{
+				BaseAccount:         baseAcc,
+				Admin:               validAdmin,
+				TotalShares:         sdk.NewInt64Coin(validDenom, 0),
+				UnderlyingAsset:     "uusd",
+				PaymentDenom:        "uusd",
+				CurrentInterestRate: "0.0",
+				DesiredInterestRate: "0.0",
+				OutstandingAumFee:   sdk.NewInt64Coin("uusd", 0),
+				MaxNavAgeSeconds:    types.MaxNAVAge + 1,
+			},
+			expectedErr: "max NAV age cannot exceed",
+		},
+		{
+			name: "settlement tolerance above limit",
+			vaultAccount: types.VaultAccount{
+				BaseAccount:             baseAcc,
+				Admin:                   validAdmin,
+				TotalShares:             sdk.NewInt64Coin(validDenom, 0),
+				UnderlyingAsset:         "uusd",
+				PaymentDenom:            "uusd",
+				CurrentInterestRate:     "0.0",
+				DesiredInterestRate:     "0.0",
+				OutstandingAumFee:       sdk.NewInt64Coin("uusd", 0),
+				SettlementToleranceBips: types.MaxSettlementToleranceBips + 1,
+			},
+			expectedErr: "settlement tolerance bips cannot exceed",
+		},
+		{
+			name: "invalid role grants",
+			vaultAccount: types.VaultAccount{
+				BaseAccount:         baseAcc,
+				Admin:               validAdmin,
+				TotalShares:         sdk.NewInt64Coin(validDenom, 0),
+				UnderlyingAsset:     "uusd",
+				PaymentDenom:        "uusd",
+				CurrentInterestRate: "0.0",
+				DesiredInterestRate: "0.0",
+				OutstandingAumFee:   sdk.NewInt64Coin("uusd", 0),
+				RoleGrants:          []types.RoleGrant{{Role: types.VaultRole_VAULT_ROLE_UNSPECIFIED, Address: validAdmin}},
+			},
+			expectedErr: "invalid role grants",
+		},
+		{
+			name: "invalid pending admin",
+			vaultAccount: types.VaultAccount{
+				BaseAccount:          baseAcc,
+				Admin:                validAdmin,
+				TotalShares:          sdk.NewInt64Coin(validDenom, 0),
+				UnderlyingAsset:      "uusd",
+				PaymentDenom:         "uusd",
+				CurrentInterestRate:  "0.0",
+				DesiredInterestRate:  "0.0",
+				OutstandingAumFee:    sdk.NewInt64Coin("uusd", 0),
+				PendingAdminTransfer: &types.PendingAdminTransfer{NewAdmin: "bad"},
+			},
+			expectedErr: `invalid pending admin "bad"`,
+		},
+		{
+			name: "pending admin is already the admin",
+			vaultAccount: types.VaultAccount{
+				BaseAccount:          baseAcc,
+				Admin:                validAdmin,
+				TotalShares:          sdk.NewInt64Coin(validDenom, 0),
+				UnderlyingAsset:      "uusd",
+				PaymentDenom:         "uusd",
+				CurrentInterestRate:  "0.0",
+				DesiredInterestRate:  "0.0",
+				OutstandingAumFee:    sdk.NewInt64Coin("uusd", 0),
+				PendingAdminTransfer: &types.PendingAdminTransfer{NewAdmin: validAdmin},
+			},
+			expectedErr: "is already the vault admin",
+		},
+		{
+			name: "timelock exceeds maximum",
+			vaultAccount: types.VaultAccount{
+				BaseAccount:         baseAcc,
+				Admin:               validAdmin,
+				TotalShares:         sdk.NewInt64Coin(validDenom, 0),
+				UnderlyingAsset:     "uusd",
+				PaymentDenom:        "uusd",
+				CurrentInterestRate: "0.0",
+				DesiredInterestRate: "0.0",
+				OutstandingAumFee:   sdk.NewInt64Coin("uusd", 0),
+				TimelockSeconds:     types.MaxTimelockSeconds + 1,
+			},
+			expectedErr: "timelock seconds cannot exceed",
+		},
+		{
+			name: "invalid acquisition policy",
+			vaultAccount: types.VaultAccount{
+				BaseAccount:         baseAcc,
+				Admin:               validAdmin,
+				TotalShares:         sdk.NewInt64Coin(validDenom, 0),
+				UnderlyingAsset:     "uusd",
+				PaymentDenom:        "uusd",
+				CurrentInterestRate: "0.0",
+				DesiredInterestRate: "0.0",
+				OutstandingAumFee:   sdk.NewInt64Coin("uusd", 0),
+				AcquisitionPolicy:   types.AcquisitionPolicy{DenomPatterns: []string{"nft/*", "nft/*"}},
+			},
+			expectedErr: "invalid acquisition policy: duplicate denom pattern",
+		},
+		{
+			name: "invalid eligibility policy",
+			vaultAccount: types.VaultAccount{
+				BaseAccount:         baseAcc,
+				Admin:               validAdmin,
+				TotalShares:         sdk.NewInt64Coin(validDenom, 0),
+				UnderlyingAsset:     "uusd",
+				PaymentDenom:        "uusd",
+				CurrentInterestRate: "0.0",
+				DesiredInterestRate: "0.0",
+				OutstandingAumFee:   sdk.NewInt64Coin("uusd", 0),
+				EligibilityPolicy:   types.EligibilityPolicy{Enabled: true, RequiredAttributes: []string{"kyc.io", "kyc.io"}},
+			},
+			expectedErr: "invalid eligibility policy: duplicate required attribute",
+		},
+		{
+			name: "invalid position cap",
+			vaultAccount: types.VaultAccount{
+				BaseAccount:         baseAcc,
+				Admin:               validAdmin,
+				TotalShares:         sdk.NewInt64Coin(validDenom, 0),
+				UnderlyingAsset:     "uusd",
+				PaymentDenom:        "uusd",
+				CurrentInterestRate: "0.0",
+				DesiredInterestRate: "0.0",
+				OutstandingAumFee:   sdk.NewInt64Coin("uusd", 0),
+				MaxPositionValue:    "0",
+			},
+			expectedErr: "invalid position cap: max position value must be positive",
+		},
+		{
+			name: "invalid max total value",
+			vaultAccount: types.VaultAccount{
+				BaseAccount:         baseAcc,
+				Admin:               validAdmin,
+				TotalShares:         sdk.NewInt64Coin(validDenom, 0),
+				UnderlyingAsset:     "uusd",
+				PaymentDenom:        "uusd",
+				CurrentInterestRate: "0.0",
+				DesiredInterestRate: "0.0",
+				OutstandingAumFee:   sdk.NewInt64Coin("uusd", 0),
+				MaxTotalValue:       "-1",
+			},
+			expectedErr: "invalid max total value: max total value must be positive",
+		},
+		{
+			name: "concentration limit above limit",
+			vaultAccount: types.VaultAccount{
+				BaseAccount:               baseAcc,
+				Admin:                     validAdmin,
+				TotalShares:               sdk.NewInt64Coin(validDenom, 0),
+				UnderlyingAsset:           "uusd",
+				PaymentDenom:              "uusd",
+				CurrentInterestRate:       "0.0",
+				DesiredInterestRate:       "0.0",
+				OutstandingAumFee:         sdk.NewInt64Coin("uusd", 0),
+				MaxNonUnderlyingBips:      types.MaxConcentrationBips + 1,
+				MaxAssetConcentrationBips: 1_500,
+			},
+			expectedErr: "max non-underlying bips cannot exceed",
+		},
+		{
+			name: "min liquidity above limit",
+			vaultAccount: types.VaultAccount{
+				BaseAccount:         baseAcc,
+				Admin:               validAdmin,
+				TotalShares:         sdk.NewInt64Coin(validDenom, 0),
+				UnderlyingAsset:     "uusd",
+				PaymentDenom:        "uusd",
+				CurrentInterestRate: "0.0",
+				DesiredInterestRate: "0.0",
+				OutstandingAumFee:   sdk.NewInt64Coin("uusd", 0),
+				MinLiquidityBips:    types.MaxMinLiquidityBips + 1,
+			},
+			expectedErr: "min liquidity bips cannot exceed",
+		},
+		{
+			name: "nav change limit with approver is valid",
+			vaultAccount: types.VaultAccount{
+				BaseAccount:         baseAcc,
+				Admin:               validAdmin,
+				TotalShares:         sdk.NewInt64Coin(validDenom, 0),
+				UnderlyingAsset:     "uusd",
+				PaymentDenom:        "uusd",
+				CurrentInterestRate: "0.0",
+				DesiredInterestRate: "0.0",
+				OutstandingAumFee:   sdk.NewInt64Coin("uusd", 0),
+				MaxNavChangeBips:    500,
+				NavApprover:         validAssetManager,
+			},
+			expectedErr: "",
+		},
+		{
+			name: "nav change limit without approver",
+			vaultAccount: types.VaultAccount{
+				BaseAccount:         baseAcc,
+				Admin:               validAdmin,
+				TotalShares:         sdk.NewInt64Coin(validDenom, 0),
+				UnderlyingAsset:     "uusd",
+				PaymentDenom:        "uusd",
+				CurrentInterestRate: "0.0",
+				DesiredInterestRate: "0.0",
+				OutstandingAumFee:   sdk.NewInt64Coin("uusd", 0),
+				MaxNavChangeBips:    500,
+			},
+			expectedErr: "nav approver is required",
+		},
+		{
+			name: "invalid nav approver address",
+			vaultAccount: types.VaultAccount{
+				BaseAccount:         baseAcc,
+				Admin:               validAdmin,
+				TotalShares:         sdk.NewInt64Coin(validDenom, 0),
+				UnderlyingAsset:     "uusd",
+				PaymentDenom:        "uusd",
+				CurrentInterestRate: "0.0",
+				DesiredInterestRate: "0.0",
+				OutstandingAumFee:   sdk.NewInt64Coin("uusd", 0),
+				NavApprover:         "not-a-bech32",
+			},
+			expectedErr: "invalid nav approver address",
+		},
+		{
+			name: "nav sources with quorum and max age are valid",
+			vaultAccount: types.VaultAccount{
+				BaseAccount:            baseAcc,
+				Admin:                  validAdmin,
+				TotalShares:            sdk.NewInt64Coin(validDenom, 0),
+				UnderlyingAsset:        "uusd",
+				PaymentDenom:           "uusd",
+				CurrentInterestRate:    "0.0",
+				DesiredInterestRate:    "0.0",
+				OutstandingAumFee:      sdk.NewInt64Coin("uusd", 0),
+				NavSources:             []string{validAdmin, validAssetManager},
+				NavSourceQuorum:        2,
+				NavAggregation:         types.NAVAggregation_NAV_AGGREGATION_MIN,
+				NavSourceMaxAgeSeconds: 3600,
+			},
+			expectedErr: "",
+		},
+		{
+			name: "duplicate nav source",
+			vaultAccount: types.VaultAccount{
+				BaseAccount:         baseAcc,
+				Admin:               validAdmin,
+				TotalShares:         sdk.NewInt64Coin(validDenom, 0),
+				UnderlyingAsset:     "uusd",
+				PaymentDenom:        "uusd",
+				CurrentInterestRate: "0.0",
+				DesiredInterestRate: "0.0",
+				OutstandingAumFee:   sdk.NewInt64Coin("uusd", 0),
+				NavSources:          []string{validAdmin, validAdmin},
+			},
+			expectedErr: "duplicate nav source",
+		},
+		{
+			name: "nav source quorum above source count",
+			vaultAccount: types.VaultAccount{
+				BaseAccount:            baseAcc,
+				Admin:                  validAdmin,
+				TotalShares:            sdk.NewInt64Coin(validDenom, 0),
+				UnderlyingAsset:        "uusd",
+				PaymentDenom:           "uusd",
+				CurrentInterestRate:    "0.0",
+				DesiredInterestRate:    "0.0",
+				OutstandingAumFee:      sdk.NewInt64Coin("uusd", 0),
+				NavSources:             []string{validAdmin},
+				NavSourceQuorum:        2,
+				NavSourceMaxAgeSeconds: 3600,
+			},
+			expectedErr: "cannot exceed the number of nav sources",
+		},
+		{
+			name: "nav source quorum without max age",
+			vaultAccount: types.VaultAccount{
+				BaseAccount:         baseAcc,
+				Admin:               validAdmin,
+				TotalShares:         sdk.NewInt64Coin(validDenom, 0),
+				UnderlyingAsset:     "uusd",
+				PaymentDenom:        "uusd",
+				CurrentInterestRate: "0.0",
+				DesiredInterestRate: "0.0",
+				OutstandingAumFee:   sdk.NewInt64Coin("uusd", 0),
+				NavSources:          []string{validAdmin},
+				NavSourceQuorum:     1,
+			},
+			expectedErr: "nav source max age is required",
+		},
 	}
 
 	for _, tc := range tests {
@@ -769,6 +1081,10 @@ func TestNewVaultAccount(t *testing.T) {
 		t.Run(tc.name, func(t *testing.T) {
 			vault := types.NewVaultAccount(baseAcc, tc.admin, tc.shareDenom, tc.underlyingAsset, 0, 0, "", "", "", "")
 			require.Equal(t, tc.admin, vault.NavAuthority, "NewVaultAccount should default NavAuthority to admin for case: %s", tc.name)
+			for _, role := range append(types.ManagementRoles(), types.VaultRole_VAULT_ROLE_NAV_AUTHORITY) {
+				require.True(t, vault.HasRole(tc.admin, role), "NewVaultAccount should grant the admin %s for case: %s", role, tc.name)
+			}
+			require.False(t, vault.HasRole(tc.admin, types.VaultRole_VAULT_ROLE_SETTLEMENT_MANAGER), "NewVaultAccount should not grant the admin settlement for case: %s", tc.name)
 			require.Equal(t, tc.underlyingAsset, vault.PaymentDenom, "NewVaultAccount should set PaymentDenom to the underlying asset for case: %s", tc.name)
 			require.Equal(t, tc.underlyingAsset, vault.UnderlyingAsset, "NewVaultAccount should set UnderlyingAsset for case: %s", tc.name)
 			require.Equal(t, sdk.NewInt64Coin(tc.underlyingAsset, 0), vault.OutstandingAumFee, "NewVaultAccount should seed OutstandingAumFee as a zero coin of the underlying asset for case: %s", tc.name)
@@ -812,118 +1128,42 @@ func TestVaultAccount_GetNAVAuthority(t *testing.T) {
 	}
 }
 
-func TestVaultAccount_ValidateNAVAuthority(t *testing.T) {
-	admin, oracle, other, _ := makeNAVAuthorityFixtures()
-
-	tests := []struct {
-		name         string
-		navAuthority string
-		vaultAdmin   string
-		signer       string
-		expectedErr  string
-	}{
-		{
-			name:         "signer matches explicit nav authority",
-			navAuthority: oracle,
-			vaultAdmin:   admin,
-			signer:       oracle,
-			expectedErr:  "",
-		},
-		{
-			name:         "signer matches admin when nav authority is empty",
-			navAuthority: "",
-			vaultAdmin:   admin,
-			signer:       admin,
-			expectedErr:  "",
-		},
-		{
-			name:         "signer does not match explicit nav authority",
-			navAuthority: oracle,
-			vaultAdmin:   admin,
-			signer:       other,
-			expectedErr:  "is not the vault NAV authority",
-		},
-		{
-			name:         "admin does not satisfy explicit nav authority",
-			navAuthority: oracle,
-			vaultAdmin:   admin,
-			signer:       admin,
-			expectedErr:  "is not the vault NAV authority",
-		},
-		{
-			name:         "non-admin signer fails when nav authority is empty",
-			navAuthority: "",
-			vaultAdmin:   admin,
-			signer:       other,
-			expectedErr:  "is not the vault NAV authority",
-		},
-	}
-
-	for _, tc := range tests {
-		t.Run(tc.name, func(t *testing.T) {
-			vault := &types.VaultAccount{
-				Admin:        tc.vaultAdmin,
-				NavAuthority: tc.navAuthority,
-			}
-			err := vault.ValidateNAVAuthority(tc.signer)
-			if tc.expectedErr != "" {
-				require.Error(t, err, "expected an error for case: %s", tc.name)
-				require.Contains(t, err.Error(), tc.expectedErr, "error should contain expected substring for case: %s", tc.name)
-			} else {
-				require.NoError(t, err, "expected no error for case: %s", tc.name)
-			}
-		})
-	}
-}
-
-func TestVaultAccount_ValidateAssetManagerAuthority(t *testing.T) {
-	admin, manager, other, _ := makeNAVAuthorityFixtures()
+func TestPendingAdminTransfer_IsExpired(t *testing.T) {
+	now := time.Unix(1_700_000_000, 0).UTC()
+	expiry := now.Add(time.Hour)
 
 	tests := []struct {
-		name         string
-		assetManager string
-		authority    string
-		expectedErr  string
+		name      string
+		transfer  types.PendingAdminTransfer
+		blockTime time.Time
+		expected  bool
 	}{
 		{
-			name:         "asset manager may settle",
-			assetManager: manager,
-			authority:    manager,
-			expectedErr:  "",
+			name:      "no expiry never expires",
+			blockTime: now.Add(1000 * time.Hour),
 		},
 		{
-			name:         "admin may not settle even when an asset manager is set",
-			assetManager: manager,
-			authority:    admin,
-			expectedErr:  "unauthorized authority",
+			name:      "before expiry",
+			transfer:  types.PendingAdminTransfer{ExpiryTime: &expiry},
+			blockTime: now,
 		},
 		{
-			name:         "stranger may not settle",
-			assetManager: manager,
-			authority:    other,
-			expectedErr:  "unauthorized authority",
+			name:      "at expiry",
+			transfer:  types.PendingAdminTransfer{ExpiryTime: &expiry},
+			blockTime: expiry,
+			expected:  true,
 		},
 		{
-			name:         "no asset manager set disables settlement, even for the admin",
-			assetManager: "",
-			authority:    admin,
-			expectedErr:  "no asset manager set",
+			name:      "after expiry",
+			transfer:  types.PendingAdminTransfer{ExpiryTime: &expiry},
+			blockTime: expiry.Add(time.Second),
+			expected:  true,
 		},
 	}
 
 	for _, tc := range tests {
 		t.Run(tc.name, func(t *testing.T) {
-			vault := types.VaultAccount{
-				Admin:        admin,
-				AssetManager: tc.assetManager,
-			}
-			err := vault.ValidateAssetManagerAuthority(tc.authority)
-			if tc.expectedErr != "" {
-				require.Error(t, err, "expected an error for case: %s", tc.name)
-				require.Contains(t, err.Error(), tc.expectedErr, "error should contain expected substring for case: %s", tc.name)
-			} else {
-				require.NoError(t, err, "expected no error for case: %s", tc.name)
-			}
+			assert.Equal(t, tc.expected, tc.transfer.IsExpired(tc.blockTime), "IsExpired")
 		})
 	}
 }
@@ -1022,6 +1262,214 @@ func TestVaultAccount_IsAcceptedDenom(t *testing.T) {
 	}
 }
 
+func TestValidateAcquisitionPolicy(t *testing.T) {
+	spec := metadatatypes.ScopeSpecMetadataAddress(uuid.MustParse("00000000-0000-4000-8000-000000000001")).String()
+	scope := metadatatypes.ScopeMetadataAddress(uuid.MustParse("00000000-0000-4000-8000-000000000002")).String()
+	tooMany := make([]string, types.MaxAcquisitionPolicyEntries+1)
+	for i := range tooMany {
+		tooMany[i] = fmt.Sprintf("coin%d", i)
+	}
+
+	tests := []struct {
+		name        string
+		policy      types.AcquisitionPolicy
+		expectedErr string
+	}{
+		{name: "empty policy is valid"},
+		{
+			name:   "exact denoms, prefixes, and specifications are valid",
+			policy: types.AcquisitionPolicy{DenomPatterns: []string{"loancoin", "nft/*"}, ScopeSpecificationIds: []string{spec}},
+		},
+		{
+			name:        "bare wildcard is invalid",
+			policy:      types.AcquisitionPolicy{DenomPatterns: []string{"*"}},
+			expectedErr: "invalid denom pattern",
+		},
+		{
+			name:        "wildcard in the middle is invalid",
+			policy:      types.AcquisitionPolicy{DenomPatterns: []string{"nft*/x"}},
+			expectedErr: "invalid denom pattern",
+		},
+		{
+			name:        "too many denom patterns",
+			policy:      types.AcquisitionPolicy{DenomPatterns: tooMany},
+			expectedErr: "too many denom patterns",
+		},
+		{
+			name:        "scope address is not a specification",
+			policy:      types.AcquisitionPolicy{ScopeSpecificationIds: []string{scope}},
+			expectedErr: "invalid scope specification id",
+		},
+		{
+			name:        "duplicate specification",
+			policy:      types.AcquisitionPolicy{ScopeSpecificationIds: []string{spec, spec}},
+			expectedErr: "duplicate scope specification id",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := types.ValidateAcquisitionPolicy(tc.policy)
+			if tc.expectedErr != "" {
+				require.ErrorContains(t, err, tc.expectedErr, "ValidateAcquisitionPolicy error for case: %s", tc.name)
+				return
+			}
+			require.NoError(t, err, "ValidateAcquisitionPolicy should pass for case: %s", tc.name)
+		})
+	}
+}
+
+func TestAcquisitionPolicy_Permits(t *testing.T) {
+	spec := metadatatypes.ScopeSpecMetadataAddress(uuid.MustParse("00000000-0000-4000-8000-000000000001")).String()
+	other := metadatatypes.ScopeSpecMetadataAddress(uuid.MustParse("00000000-0000-4000-8000-000000000003")).String()
+	policy := types.AcquisitionPolicy{DenomPatterns: []string{"loancoin", "nft/*"}, ScopeSpecificationIds: []string{spec}}
+
+	assert.True(t, types.AcquisitionPolicy{}.PermitsDenom("anything"), "empty policy should permit any denom")
+	assert.True(t, policy.PermitsDenom("loancoin"), "exact pattern should permit its denom")
+	assert.False(t, policy.PermitsDenom("loancoin2"), "exact pattern should not permit a longer denom")
+	assert.True(t, policy.PermitsDenom("nft/scope1"), "prefix pattern should permit a matching denom")
+	assert.False(t, policy.PermitsDenom("bond"), "denom outside every pattern should not be permitted")
+
+	assert.True(t, types.AcquisitionPolicy{}.PermitsScopeSpecification(other), "empty policy should permit any specification")
+	assert.True(t, policy.PermitsScopeSpecification(spec), "listed specification should be permitted")
+	assert.False(t, policy.PermitsScopeSpecification(other), "unlisted specification should not be permitted")
+}
+
+func TestValidateEligibilityPolicy(t *testing.T) {
+	tooMany := make([]string, types.MaxEligibilityAttributes+1)
+	for i := range tooMany {
+		tooMany[i] = fmt.Sprintf("attr%d.kyc.io", i)
+	}
+
+	tests := []struct {
+		name        string
+		policy      types.EligibilityPolicy
+		expectedErr string
+	}{
+		{name: "empty policy is valid"},
+		{
+			name:   "exact names and wildcards are valid",
+			policy: types.EligibilityPolicy{Enabled: true, RequiredAttributes: []string{"accredited.kyc.io", "*.aml.io"}},
+		},
+		{
+			name:        "empty name is invalid",
+			policy:      types.EligibilityPolicy{RequiredAttributes: []string{""}},
+			expectedErr: "name cannot be empty",
+		},
+		{
+			name:        "bare wildcard is invalid",
+			policy:      types.EligibilityPolicy{RequiredAttributes: []string{"*."}},
+			expectedErr: "name cannot be empty",
+		},
+		{
+			name:        "wildcard in the middle is invalid",
+			policy:      types.EligibilityPolicy{RequiredAttributes: []string{"kyc.*.io"}},
+			expectedErr: "a wildcard may only appear",
+		},
+		{
+			name:        "whitespace is invalid",
+			policy:      types.EligibilityPolicy{RequiredAttributes: []string{"kyc io"}},
+			expectedErr: "cannot contain whitespace",
+		},
+		{
+			name:        "uppercase is invalid",
+			policy:      types.EligibilityPolicy{RequiredAttributes: []string{"KYC.io"}},
+			expectedErr: "must be lowercase",
+		},
+		{
+			name:        "duplicate name",
+			policy:      types.EligibilityPolicy{RequiredAttributes: []string{"kyc.io", "kyc.io"}},
+			expectedErr: "duplicate required attribute",
+		},
+		{
+			name:        "too many names",
+			policy:      types.EligibilityPolicy{RequiredAttributes: tooMany},
+			expectedErr: "too many required attributes",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := types.ValidateEligibilityPolicy(tc.policy)
+			if tc.expectedErr != "" {
+				require.ErrorContains(t, err, tc.expectedErr, "ValidateEligibilityPolicy error for case: %s", tc.name)
+				return
+			}
+			require.NoError(t, err, "ValidateEligibilityPolicy should pass for case: %s", tc.name)
+		})
+	}
+}
+
+func TestEligibilityPolicy_MissingAttributes(t *testing.T) {
+	policy := types.EligibilityPolicy{Enabled: true, RequiredAttributes: []string{"accredited.kyc.io", "*.aml.io"}}
+
+	assert.Empty(t, types.EligibilityPolicy{}.MissingAttributes(nil), "empty policy should require nothing")
+	assert.Equal(t, []string{"accredited.kyc.io", "*.aml.io"}, policy.MissingAttributes(nil), "no held attributes should miss every requirement")
+	assert.Empty(t, policy.MissingAttributes([]string{"accredited.kyc.io", "passed.aml.io"}), "exact and wildcard matches should satisfy the policy")
+	assert.Equal(t, []string{"*.aml.io"}, policy.MissingAttributes([]string{"accredited.kyc.io", "aml.io"}), "wildcard should require a name before the suffix")
+	assert.Equal(t, []string{"accredited.kyc.io"}, policy.MissingAttributes([]string{"not-accredited.kyc.io", "x.aml.io"}), "exact name should not match a longer name")
+}
+
+func TestValidatePositionCap(t *testing.T) {
+	addr := utils.TestAddress().Bech32
+	tooMany := make([]string, types.MaxPositionCapExemptions+1)
+	for i := range tooMany {
+		tooMany[i] = utils.TestAddress().Bech32
+	}
+
+	tests := []struct {
+		name        string
+		maxValue    string
+		exemptions  []string
+		expectedErr string
+	}{
+		{name: "no cap is valid"},
+		{name: "positive cap with exemptions is valid", maxValue: "1000", exemptions: []string{addr}},
+		{name: "non-numeric cap", maxValue: "abc", expectedErr: "invalid max position value"},
+		{name: "zero cap", maxValue: "0", expectedErr: "max position value must be positive"},
+		{name: "negative cap", maxValue: "-5", expectedErr: "max position value must be positive"},
+		{name: "invalid exemption", exemptions: []string{"bad"}, expectedErr: "invalid position cap exemption"},
+		{name: "duplicate exemption", exemptions: []string{addr, addr}, expectedErr: "duplicate position cap exemption"},
+		{name: "too many exemptions", exemptions: tooMany, expectedErr: "too many position cap exemptions"},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := types.ValidatePositionCap(tc.maxValue, tc.exemptions)
+			if tc.expectedErr != "" {
+				require.ErrorContains(t, err, tc.expectedErr, "ValidatePositionCap error for case: %s", tc.name)
+				return
+			}
+			require.NoError(t, err, "ValidatePositionCap should pass for case: %s", tc.name)
+		})
+	}
+}
+
+func TestValidateMaxTotalValue(t *testing.T) {
+	tests := []struct {
+		name        string
+		maxValue    string
+		expectedErr string
+	}{
+		{name: "no cap is valid"},
+		{name: "positive cap is valid", maxValue: "1000000"},
+		{name: "non-numeric cap", maxValue: "abc", expectedErr: "invalid max total value"},
+		{name: "zero cap", maxValue: "0", expectedErr: "max total value must be positive"},
+		{name: "negative cap", maxValue: "-5", expectedErr: "max total value must be positive"},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := types.ValidateMaxTotalValue(tc.maxValue)
+			if tc.expectedErr != "" {
+				require.ErrorContains(t, err, tc.expectedErr, "ValidateMaxTotalValue error for case: %s", tc.name)
+				return
+			}
+			require.NoError(t, err, "ValidateMaxTotalValue should pass for case: %s", tc.name)
+		})
+	}
+}
+
 func TestVaultAccount_ValidateAcceptedDenom(t *testing.T) {
 	tests := []struct {
 		name            string